@@ -11,6 +11,7 @@ import (
 
 	"github.com/go-drift/drift/cmd/drift/internal/cache"
 	"github.com/go-drift/drift/cmd/drift/internal/config"
+	"github.com/go-drift/drift/cmd/drift/internal/scaffold"
 	"github.com/go-drift/drift/cmd/drift/internal/workspace"
 	"github.com/go-drift/drift/cmd/drift/internal/xtool"
 )
@@ -199,6 +200,36 @@ func buildAndroid(ws *workspace.Workspace, opts androidBuildOptions) error {
 	return nil
 }
 
+// xcodeProjectArgs returns the xcodebuild arguments selecting the project to
+// build. When the Podfile declares pods, it runs pod install if the Podfile
+// changed since the last install and selects the CocoaPods workspace.
+func xcodeProjectArgs(ws *workspace.Workspace) ([]string, error) {
+	xcodeproj := filepath.Join(ws.IOSDir, "Runner.xcodeproj")
+	if !scaffold.HasPods(ws.IOSDir) {
+		return []string{"-project", xcodeproj}, nil
+	}
+
+	podfile, err := os.Stat(filepath.Join(ws.IOSDir, "Podfile"))
+	if err != nil {
+		return nil, err
+	}
+	manifest, err := os.Stat(filepath.Join(ws.IOSDir, "Pods", "Manifest.lock"))
+	if err != nil || manifest.ModTime().Before(podfile.ModTime()) {
+		if _, err := exec.LookPath("pod"); err != nil {
+			return nil, fmt.Errorf("drift.yaml declares CocoaPods dependencies, but pod was not found in PATH (install with: brew install cocoapods)")
+		}
+		fmt.Println("  Installing pods...")
+		cmd := exec.Command("pod", "install")
+		cmd.Dir = ws.IOSDir
+		cmd.Stdout = os.Stdout
+		cmd.Stderr = os.Stderr
+		if err := cmd.Run(); err != nil {
+			return nil, fmt.Errorf("pod install failed: %w", err)
+		}
+	}
+	return []string{"-workspace", filepath.Join(ws.IOSDir, "Runner.xcworkspace")}, nil
+}
+
 // buildIOS builds the iOS application.
 // If opts.device is true, builds for physical device (iphoneos SDK), otherwise simulator.
 func buildIOS(ws *workspace.Workspace, opts iosBuildOptions) error {
@@ -249,6 +280,11 @@ func buildIOS(ws *workspace.Workspace, opts iosBuildOptions) error {
 		return fmt.Errorf("xcode project setup required")
 	}
 
+	project, err := xcodeProjectArgs(ws)
+	if err != nil {
+		return err
+	}
+
 	var buildArgs []string
 	if opts.device {
 		// Device build requires team ID for code signing
@@ -265,22 +301,20 @@ func buildIOS(ws *workspace.Workspace, opts iosBuildOptions) error {
 			return fmt.Errorf("team ID required for device builds")
 		}
 
-		buildArgs = []string{
-			"-project", xcodeproj,
+		buildArgs = append(project,
 			"-scheme", "Runner",
 			"-configuration", configuration,
 			"-destination", "generic/platform=iOS",
 			"-allowProvisioningUpdates",
-			"DEVELOPMENT_TEAM=" + opts.teamID,
+			"DEVELOPMENT_TEAM="+opts.teamID,
 			"build",
-		}
+		)
 	} else {
-		buildArgs = []string{
-			"-project", xcodeproj,
+		buildArgs = append(project,
 			"-scheme", "Runner",
 			"-configuration", configuration,
 			"-destination", "generic/platform=iOS Simulator",
-		}
+		)
 		buildArgs = append(buildArgs, simulatorArchBuildSettings()...)
 		buildArgs = append(buildArgs, "build")
 	}
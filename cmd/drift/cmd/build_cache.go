@@ -0,0 +1,141 @@
+package cmd
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// goBuildKeyTemplate lists, for each non-standard package in the build,
+// its directory followed by every source file that feeds the compiler or
+// cgo. Standard packages are covered by the Go version.
+const goBuildKeyTemplate = `{{if not .Standard}}{{.Dir}}{{range .GoFiles}} {{.}}{{end}}{{range .CgoFiles}} {{.}}{{end}}` +
+	`{{range .CFiles}} {{.}}{{end}}{{range .CXXFiles}} {{.}}{{end}}{{range .MFiles}} {{.}}{{end}}` +
+	`{{range .HFiles}} {{.}}{{end}}{{range .SFiles}} {{.}}{{end}}{{range .SysoFiles}} {{.}}{{end}}` +
+	`{{range .EmbedFiles}} {{.}}{{end}}{{"\n"}}{{end}}`
+
+// goBuildKeyEnv lists the environment variables that change build output.
+var goBuildKeyEnv = []string{
+	"GOOS", "GOARCH", "GOARM", "GOAMD64", "GOFLAGS", "GOEXPERIMENT", "CGO_ENABLED",
+	"CC", "CXX", "CGO_CFLAGS", "CGO_CXXFLAGS", "CGO_CPPFLAGS", "CGO_LDFLAGS",
+}
+
+// goBuildKey returns a digest of everything that determines the output of
+// building the main package in dir with env: the Go version, the build
+// environment, the contents of every source file of every non-standard
+// dependency (reading overlaid files from their replacement), and the
+// files in extraFiles, such as static libraries passed to the linker.
+//
+// Like ccache, the key relies on file contents rather than timestamps, so
+// regenerated but identical bridge files still hit the cache.
+func goBuildKey(dir, overlayPath string, env []string, extraFiles ...string) (string, error) {
+	h := sha256.New()
+
+	version := exec.Command("go", "env", "GOVERSION")
+	version.Dir = dir
+	version.Env = env
+	out, err := version.Output()
+	if err != nil {
+		return "", fmt.Errorf("go env failed: %w", err)
+	}
+	fmt.Fprintf(h, "go %s", out)
+
+	for _, name := range goBuildKeyEnv {
+		fmt.Fprintf(h, "%s=%s\n", name, envValue(env, name))
+	}
+
+	args := []string{"list", "-deps", "-f", goBuildKeyTemplate}
+	replace := map[string]string{}
+	if overlayPath != "" {
+		args = append(args, "-overlay", overlayPath)
+		if replace, err = readOverlay(overlayPath); err != nil {
+			return "", err
+		}
+	}
+	list := exec.Command("go", append(args, ".")...)
+	list.Dir = dir
+	list.Env = env
+	var stderr bytes.Buffer
+	list.Stderr = &stderr
+	out, err = list.Output()
+	if err != nil {
+		return "", fmt.Errorf("go list failed: %w\n%s", err, stderr.String())
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(out))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		pkgDir := fields[0]
+		fmt.Fprintf(h, "package %s\n", pkgDir)
+		for _, file := range fields[1:] {
+			path := filepath.Join(pkgDir, file)
+			if r, ok := replace[path]; ok {
+				path = r
+			}
+			if err := hashFile(h, file, path); err != nil {
+				return "", err
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return "", err
+	}
+
+	for _, path := range extraFiles {
+		if err := hashFile(h, filepath.Base(path), path); err != nil {
+			return "", err
+		}
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// hashFile writes name and the contents of path to h.
+func hashFile(h io.Writer, name, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to hash build input: %w", err)
+	}
+	defer f.Close()
+	fmt.Fprintf(h, "file %s\n", name)
+	if _, err := io.Copy(h, f); err != nil {
+		return fmt.Errorf("failed to hash build input: %w", err)
+	}
+	return nil
+}
+
+// readOverlay returns the Replace map of a go build overlay file.
+func readOverlay(path string) (map[string]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read overlay: %w", err)
+	}
+	var overlay struct {
+		Replace map[string]string
+	}
+	if err := json.Unmarshal(data, &overlay); err != nil {
+		return nil, fmt.Errorf("failed to parse overlay: %w", err)
+	}
+	return overlay.Replace, nil
+}
+
+// envValue returns the last value of name in env, as exec.Cmd does.
+func envValue(env []string, name string) string {
+	for i := len(env) - 1; i >= 0; i-- {
+		if v, ok := strings.CutPrefix(env[i], name+"="); ok {
+			return v
+		}
+	}
+	return ""
+}
@@ -0,0 +1,76 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+)
+
+func TestGoBuildKey(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, content string) {
+		t.Helper()
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write("go.mod", "module example.com/app\n\ngo 1.24\n")
+	write("main.go", "package main\n\nfunc main() {}\n")
+
+	// The bridge file only exists through the overlay, as in real builds.
+	bridgeDir := t.TempDir()
+	bridge := filepath.Join(bridgeDir, "bridge.go")
+	if err := os.WriteFile(bridge, []byte("package main\n\nvar a = 1\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	overlay := filepath.Join(t.TempDir(), "overlay.json")
+	data, _ := json.Marshal(map[string]map[string]string{
+		"Replace": {filepath.Join(dir, "drift_bridge_bridge.go"): bridge},
+	})
+	if err := os.WriteFile(overlay, data, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	env := append(os.Environ(), "GOFLAGS=-mod=mod", "GOOS=linux", "GOARCH=arm64")
+	key := func(env []string) string {
+		t.Helper()
+		k, err := goBuildKey(dir, overlay, env)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return k
+	}
+
+	first := key(env)
+	write("main.go", "package main\n\nfunc main() {}\n")
+	if key(env) != first {
+		t.Error("expected rewriting identical contents to keep the key")
+	}
+	if key(append(env, "GOARCH=amd64")) == first {
+		t.Error("expected a different GOARCH to change the key")
+	}
+	if err := os.WriteFile(bridge, []byte("package main\n\nvar a = 2\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if key(env) == first {
+		t.Error("expected an overlaid file change to change the key")
+	}
+}
+
+func TestPrefixWriter(t *testing.T) {
+	var buf bytes.Buffer
+	var mu sync.Mutex
+	w := &prefixWriter{prefix: "[x86_64] ", mu: &mu, w: &buf}
+	w.Write([]byte("one\ntw"))
+	w.Write([]byte("o\nthree"))
+	if got := buf.String(); got != "[x86_64] one\n[x86_64] two\n" {
+		t.Errorf("expected complete lines only, got %q", got)
+	}
+	w.Flush()
+	if got := buf.String(); got != "[x86_64] one\n[x86_64] two\n[x86_64] three\n" {
+		t.Errorf("expected Flush to write the last line, got %q", got)
+	}
+}
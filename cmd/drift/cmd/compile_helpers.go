@@ -1,12 +1,16 @@
 package cmd
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
+	"io"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"runtime"
 	"strings"
+	"sync"
 
 	"github.com/go-drift/drift/cmd/drift/internal/cache"
 )
@@ -135,113 +139,235 @@ func findADB() string {
 	return "adb"
 }
 
-// compileGoForAndroid compiles Go code to shared libraries for all Android ABIs.
+// androidABI describes how to cross-compile for one Android ABI.
+type androidABI struct {
+	abi      string
+	goarch   string
+	goarm    string
+	cc       string
+	triple   string
+	skiaArch string
+}
+
+var androidABIs = []androidABI{
+	{"arm64-v8a", "arm64", "", "aarch64-linux-android29-clang", "aarch64-linux-android", "arm64"},
+	{"armeabi-v7a", "arm", "7", "armv7a-linux-androideabi29-clang", "arm-linux-androideabi", "arm"},
+	{"x86_64", "amd64", "", "x86_64-linux-android29-clang", "x86_64-linux-android", "amd64"},
+}
+
+// compileGoForAndroid compiles Go code to shared libraries for all Android
+// ABIs. The ABIs build in parallel, sharing the Go build cache, and each
+// libdrift.so is kept in the object cache keyed by its inputs so unchanged
+// ABIs are copied instead of relinked.
 func compileGoForAndroid(cfg androidCompileConfig) error {
+	toolchain, sysrootLib, err := findNDKToolchain()
+	if err != nil {
+		return err
+	}
+
+	abis := androidABIs
+	if cfg.targetABI != "" {
+		abis = nil
+		for _, abi := range androidABIs {
+			if abi.abi == cfg.targetABI {
+				abis = []androidABI{abi}
+				break
+			}
+		}
+		if abis == nil {
+			supported := make([]string, len(androidABIs))
+			for i, abi := range androidABIs {
+				supported[i] = abi.abi
+			}
+			return fmt.Errorf("unsupported Android ABI %q (supported: %s)", cfg.targetABI, strings.Join(supported, ", "))
+		}
+	}
+
+	// Resolve Skia first: a missing library is downloaded once for all ABIs.
+	skiaDirs := make([]string, len(abis))
+	for i, abi := range abis {
+		if _, skiaDirs[i], err = findSkiaLib(cfg.projectRoot, "android", abi.skiaArch, cfg.noFetch); err != nil {
+			return err
+		}
+	}
+
+	names := make([]string, len(abis))
+	for i, abi := range abis {
+		names[i] = abi.abi
+	}
+	fmt.Printf("  Compiling for %s...\n", strings.Join(names, ", "))
+
+	var mu sync.Mutex
+	errs := make([]error, len(abis))
+	var wg sync.WaitGroup
+	for i, abi := range abis {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			out := &prefixWriter{prefix: "  [" + abi.abi + "] ", mu: &mu, w: os.Stdout}
+			errs[i] = compileAndroidABI(cfg, abi, toolchain, sysrootLib, skiaDirs[i], out)
+			out.Flush()
+		}()
+	}
+	wg.Wait()
+
+	if err := cache.PruneObjects(cache.MaxObjects); err != nil {
+		fmt.Fprintf(os.Stderr, "  Warning: failed to prune the build cache: %v\n", err)
+	}
+	return errors.Join(errs...)
+}
+
+// findNDKToolchain locates the Android NDK from ANDROID_NDK_HOME or
+// ANDROID_NDK_ROOT and returns its clang bin directory and sysroot lib
+// directory.
+func findNDKToolchain() (toolchain, sysrootLib string, err error) {
 	ndkHome := os.Getenv("ANDROID_NDK_HOME")
 	if ndkHome == "" {
 		ndkHome = os.Getenv("ANDROID_NDK_ROOT")
 	}
 	if ndkHome == "" {
-		return fmt.Errorf("ANDROID_NDK_HOME or ANDROID_NDK_ROOT must be set")
+		return "", "", fmt.Errorf("ANDROID_NDK_HOME or ANDROID_NDK_ROOT must be set")
 	}
 
 	checkNDKVersion(ndkHome)
 
 	hostTag, err := detectNDKHostTag(ndkHome)
 	if err != nil {
-		return err
+		return "", "", err
 	}
 
-	toolchain := filepath.Join(ndkHome, "toolchains", "llvm", "prebuilt", hostTag, "bin")
-	sysrootLib := filepath.Join(ndkHome, "toolchains", "llvm", "prebuilt", hostTag, "sysroot", "usr", "lib")
+	prebuilt := filepath.Join(ndkHome, "toolchains", "llvm", "prebuilt", hostTag)
+	return filepath.Join(prebuilt, "bin"), filepath.Join(prebuilt, "sysroot", "usr", "lib"), nil
+}
 
-	abis := []struct {
-		abi      string
-		goarch   string
-		goarm    string
-		cc       string
-		triple   string
-		skiaArch string
-	}{
-		{"arm64-v8a", "arm64", "", "aarch64-linux-android29-clang", "aarch64-linux-android", "arm64"},
-		{"armeabi-v7a", "arm", "7", "armv7a-linux-androideabi29-clang", "arm-linux-androideabi", "arm"},
-		{"x86_64", "amd64", "", "x86_64-linux-android29-clang", "x86_64-linux-android", "amd64"},
+// androidGoEnv returns the environment for cross-compiling cgo code that
+// links Skia for abi.
+func androidGoEnv(abi androidABI, toolchain, skiaDir string) []string {
+	env := append(os.Environ(),
+		"CGO_ENABLED=1",
+		"GOOS=android",
+		"GOARCH="+abi.goarch,
+		"CC="+filepath.Join(toolchain, abi.cc),
+		"CXX="+filepath.Join(toolchain, abi.cc+"++"),
+		"CGO_LDFLAGS="+androidSkiaLinkerFlags(skiaDir),
+	)
+	if abi.goarm != "" {
+		env = append(env, "GOARM="+abi.goarm)
 	}
+	return env
+}
 
-	if cfg.targetABI != "" {
-		found := false
-		for _, abi := range abis {
-			if abi.abi == cfg.targetABI {
-				found = true
-				break
-			}
-		}
-		if !found {
-			supported := make([]string, len(abis))
-			for i, abi := range abis {
-				supported[i] = abi.abi
-			}
-			return fmt.Errorf("unsupported Android ABI %q (supported: %s)", cfg.targetABI, strings.Join(supported, ", "))
-		}
+// androidCppShared returns the libc++_shared.so to ship for abi: the copy
+// bundled with the Skia library, which matches the NDK Skia was built
+// with, or else the local NDK's. fromNDK reports the fallback; path is
+// empty if neither exists.
+func androidCppShared(abi androidABI, sysrootLib, skiaDir string) (path string, fromNDK bool) {
+	path = filepath.Join(skiaDir, "libc++_shared.so")
+	if _, err := os.Stat(path); err == nil {
+		return path, false
+	}
+	// Fallback to user's NDK (for custom DRIFT_SKIA_DIR or old cache)
+	path = filepath.Join(sysrootLib, abi.triple, "libc++_shared.so")
+	if _, err := os.Stat(path); err == nil {
+		return path, true
 	}
+	return "", false
+}
 
-	for _, abi := range abis {
-		if cfg.targetABI != "" && abi.abi != cfg.targetABI {
-			continue
-		}
-		fmt.Printf("  Compiling for %s...\n", abi.abi)
+// compileAndroidABI builds libdrift.so for one ABI into cfg.jniLibsDir,
+// reusing a cached copy when the inputs have not changed, and writes its
+// progress and compiler output to out.
+func compileAndroidABI(cfg androidCompileConfig, abi androidABI, toolchain, sysrootLib, skiaDir string, out io.Writer) error {
+	outDir := filepath.Join(cfg.jniLibsDir, abi.abi)
+	if err := os.MkdirAll(outDir, 0o755); err != nil {
+		return fmt.Errorf("failed to create output directory: %w", err)
+	}
+	libPath := filepath.Join(outDir, "libdrift.so")
 
-		_, skiaDir, err := findSkiaLib(cfg.projectRoot, "android", abi.skiaArch, cfg.noFetch)
-		if err != nil {
-			return err
-		}
+	env := androidGoEnv(abi, toolchain, skiaDir)
 
-		outDir := filepath.Join(cfg.jniLibsDir, abi.abi)
-		if err := os.MkdirAll(outDir, 0o755); err != nil {
-			return fmt.Errorf("failed to create output directory: %w", err)
-		}
+	key, err := goBuildKey(cfg.projectRoot, cfg.overlayPath, env, filepath.Join(skiaDir, "libdrift_skia.a"))
+	if err != nil {
+		fmt.Fprintf(out, "Warning: build cache disabled: %v\n", err)
+	}
 
+	if cached, ok := cache.LookupObject(key, "libdrift.so"); ok {
+		fmt.Fprintln(out, "Up to date (cached)")
+		if err := copyFile(cached, libPath); err != nil {
+			return fmt.Errorf("failed to copy cached library for %s: %w", abi.abi, err)
+		}
+	} else {
 		cmd := exec.Command("go", "build",
 			"-overlay", cfg.overlayPath,
 			"-buildmode=c-shared",
-			"-o", filepath.Join(outDir, "libdrift.so"),
+			"-o", libPath,
 			".")
 		cmd.Dir = cfg.projectRoot
-		cmd.Env = append(os.Environ(),
-			"CGO_ENABLED=1",
-			"GOOS=android",
-			"GOARCH="+abi.goarch,
-			"CC="+filepath.Join(toolchain, abi.cc),
-			"CXX="+filepath.Join(toolchain, abi.cc+"++"),
-			"CGO_LDFLAGS="+androidSkiaLinkerFlags(skiaDir),
-		)
-		if abi.goarm != "" {
-			cmd.Env = append(cmd.Env, "GOARM="+abi.goarm)
-		}
-		cmd.Stdout = os.Stdout
-		cmd.Stderr = os.Stderr
+		cmd.Env = env
+		cmd.Stdout = out
+		cmd.Stderr = out
 
 		if err := cmd.Run(); err != nil {
 			return fmt.Errorf("failed to build for %s: %w", abi.abi, err)
 		}
+		fmt.Fprintln(out, "Compiled")
 
-		// Copy libc++_shared.so from Skia cache (bundled with matching NDK)
-		cppShared := filepath.Join(skiaDir, "libc++_shared.so")
-		if _, err := os.Stat(cppShared); err != nil {
-			// Fallback to user's NDK (for custom DRIFT_SKIA_DIR or old cache)
-			cppShared = filepath.Join(sysrootLib, abi.triple, "libc++_shared.so")
-			if _, err := os.Stat(cppShared); err == nil {
-				fmt.Println("  Warning: using libc++_shared.so from local NDK (may cause ABI issues with older releases)")
-			}
-		}
-		if _, err := os.Stat(cppShared); err == nil {
-			if err := copyFile(cppShared, filepath.Join(outDir, "libc++_shared.so")); err != nil {
-				return fmt.Errorf("failed to copy libc++_shared.so: %w", err)
+		if key != "" {
+			if err := cache.StoreObject(key, "libdrift.so", libPath); err != nil {
+				fmt.Fprintf(out, "Warning: failed to cache library: %v\n", err)
 			}
 		}
+	}
 
-		os.Remove(filepath.Join(outDir, "libdrift.h"))
+	// Copy libc++_shared.so from Skia cache (bundled with matching NDK)
+	cppShared, fromNDK := androidCppShared(abi, sysrootLib, skiaDir)
+	if fromNDK {
+		fmt.Fprintln(out, "Warning: using libc++_shared.so from local NDK (may cause ABI issues with older releases)")
+	}
+	if cppShared != "" {
+		if err := copyFile(cppShared, filepath.Join(outDir, "libc++_shared.so")); err != nil {
+			return fmt.Errorf("failed to copy libc++_shared.so: %w", err)
+		}
 	}
 
+	os.Remove(filepath.Join(outDir, "libdrift.h"))
 	return nil
 }
+
+// prefixWriter prefixes each line written to it and forwards complete lines
+// to w under mu, so the output of parallel builds does not interleave
+// mid-line.
+type prefixWriter struct {
+	prefix string
+	mu     *sync.Mutex
+	w      io.Writer
+	buf    []byte
+}
+
+func (p *prefixWriter) Write(b []byte) (int, error) {
+	p.buf = append(p.buf, b...)
+	for {
+		i := bytes.IndexByte(p.buf, '\n')
+		if i < 0 {
+			break
+		}
+		p.writeLine(p.buf[:i+1])
+		p.buf = p.buf[i+1:]
+	}
+	return len(b), nil
+}
+
+// Flush writes any incomplete last line.
+func (p *prefixWriter) Flush() {
+	if len(p.buf) > 0 {
+		p.writeLine(append(p.buf, '\n'))
+		p.buf = nil
+	}
+}
+
+func (p *prefixWriter) writeLine(line []byte) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	io.WriteString(p.w, p.prefix)
+	p.w.Write(line)
+}
@@ -0,0 +1,219 @@
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/go-drift/drift/cmd/drift/internal/config"
+	"github.com/go-drift/drift/cmd/drift/internal/workspace"
+)
+
+// Device memory kinds, one remembered device per kind and project.
+const (
+	memoryAndroid      = "android"
+	memoryIOSDevice    = "ios-device"
+	memoryIOSSimulator = "ios-simulator"
+)
+
+// projectState is the per-project state file written by the CLI.
+type projectState struct {
+	// Devices maps a device memory kind to the last device ID used.
+	Devices map[string]string `json:"devices,omitempty"`
+}
+
+// deviceMemory remembers the last device chosen for one kind of target in a
+// project. The zero value remembers nothing.
+type deviceMemory struct {
+	path string
+	kind string
+}
+
+// newDeviceMemory returns the device memory for kind in the project at
+// root. Falls back to the zero value if the state file cannot be located.
+func newDeviceMemory(root string, cfg *config.Resolved, kind string) deviceMemory {
+	path, err := workspace.StateFile(root, cfg)
+	if err != nil {
+		return deviceMemory{}
+	}
+	return deviceMemory{path: path, kind: kind}
+}
+
+func (m deviceMemory) load() projectState {
+	var state projectState
+	if m.path == "" {
+		return state
+	}
+	if data, err := os.ReadFile(m.path); err == nil {
+		// A corrupt file is treated as empty and rewritten on the next save.
+		_ = json.Unmarshal(data, &state)
+	}
+	return state
+}
+
+// last returns the ID of the last device used, or "" if none.
+func (m deviceMemory) last() string {
+	return m.load().Devices[m.kind]
+}
+
+// remember records id as the last device used. Failures only print a
+// warning since the run itself is unaffected.
+func (m deviceMemory) remember(id string) {
+	if m.path == "" || id == "" {
+		return
+	}
+	state := m.load()
+	if state.Devices[m.kind] == id {
+		return
+	}
+	if state.Devices == nil {
+		state.Devices = map[string]string{}
+	}
+	state.Devices[m.kind] = id
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err == nil {
+		err = os.MkdirAll(filepath.Dir(m.path), 0o755)
+	}
+	if err == nil {
+		err = os.WriteFile(m.path, data, 0o644)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: failed to remember device: %v\n", err)
+	}
+}
+
+// errNotInteractive is returned by pickFromList when stdin is not a
+// terminal, so callers can fall back to a non-interactive choice.
+var errNotInteractive = errors.New("not running in an interactive terminal")
+
+// stdinIsTerminal reports whether stdin is an interactive terminal.
+func stdinIsTerminal() bool {
+	info, err := os.Stdin.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
+
+// pickFromList prints labels numbered from 1 and asks the user to choose
+// one, returning its index. Pressing enter chooses def.
+func pickFromList(title string, labels []string, def int) (int, error) {
+	if !stdinIsTerminal() {
+		return 0, errNotInteractive
+	}
+	fmt.Println(title)
+	for i, label := range labels {
+		marker := " "
+		if i == def {
+			marker = "*"
+		}
+		fmt.Printf("  %s [%d] %s\n", marker, i+1, label)
+	}
+	for {
+		line, err := promptLine(fmt.Sprintf("Choose a number [%d]: ", def+1))
+		if err != nil {
+			return 0, err
+		}
+		if line == "" {
+			return def, nil
+		}
+		n, err := strconv.Atoi(line)
+		if err == nil && n >= 1 && n <= len(labels) {
+			return n - 1, nil
+		}
+		fmt.Printf("  Enter a number from 1 to %d\n", len(labels))
+	}
+}
+
+// fuzzyMatch returns the indexes of the names that best match query. From
+// best to worst, a match is the whole name (ignoring case), query's words
+// appearing together in the name, or every word of query starting a word of
+// the name, so "15 pro" and "pix 7" match "iPhone 15 Pro" and "Pixel_7".
+// Among equally good matches the shortest names win, so "15 Pro" prefers
+// "iPhone 15 Pro" over "iPhone 15 Pro Max".
+func fuzzyMatch(query string, names []string) []int {
+	queryWords := nameWords(query)
+	if len(queryWords) == 0 {
+		return nil
+	}
+	bestScore, bestLen := 0, 0
+	var best []int
+	for i, name := range names {
+		score := matchScore(query, queryWords, name)
+		if score == 0 {
+			continue
+		}
+		n := len(name)
+		if score > bestScore || (score == bestScore && n < bestLen) {
+			bestScore, bestLen, best = score, n, nil
+		}
+		if score == bestScore && n == bestLen {
+			best = append(best, i)
+		}
+	}
+	return best
+}
+
+func matchScore(query string, queryWords []string, name string) int {
+	if strings.EqualFold(strings.TrimSpace(query), name) {
+		return 3
+	}
+	words := nameWords(name)
+	for start := 0; start+len(queryWords) <= len(words); start++ {
+		if equalWords(words[start:start+len(queryWords)], queryWords) {
+			return 2
+		}
+	}
+	for _, q := range queryWords {
+		found := false
+		for _, w := range words {
+			if strings.HasPrefix(w, q) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return 0
+		}
+	}
+	return 1
+}
+
+func equalWords(a, b []string) bool {
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// nameWords splits a device name into lowercase words at spaces,
+// punctuation and underscores, as in "Pixel_7_Pro" or "iPhone 15 (2nd)".
+func nameWords(s string) []string {
+	return strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// uniqueMatch narrows fuzzy matches to a single index. sameDevice reports
+// whether two matches are interchangeable, such as one simulator model
+// installed for several iOS versions, in which case the first wins. Returns
+// -1 and an error listing the candidates if the query is ambiguous.
+func uniqueMatch(query string, matches []int, names []string, sameDevice func(i, j int) bool) (int, error) {
+	if len(matches) == 0 {
+		return -1, nil
+	}
+	for _, m := range matches[1:] {
+		if !sameDevice(matches[0], m) {
+			var lines []string
+			for _, i := range matches {
+				lines = append(lines, "  "+names[i])
+			}
+			return -1, fmt.Errorf("%q matches more than one device:\n%s", query, strings.Join(lines, "\n"))
+		}
+	}
+	return matches[0], nil
+}
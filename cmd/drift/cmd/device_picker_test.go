@@ -0,0 +1,112 @@
+package cmd
+
+import (
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+func TestFuzzyMatch(t *testing.T) {
+	names := []string{"iPhone 15", "iPhone 15 Pro", "iPhone 15 Pro Max", "iPhone 16 Pro", "Pixel_7", "iPad Air (5th generation)"}
+	tests := []struct {
+		query string
+		want  []int
+	}{
+		{"iphone 15 pro", []int{1}},
+		{"15 Pro", []int{1}},
+		{"pro max", []int{2}},
+		{"pix 7", []int{4}},
+		{"Pixel 7", []int{4}},
+		{"air 5th", []int{5}},
+		{"Pro", []int{1, 3}},
+		{"Galaxy", nil},
+		{"", nil},
+	}
+	for _, tt := range tests {
+		if got := fuzzyMatch(tt.query, names); !slices.Equal(got, tt.want) {
+			t.Errorf("fuzzyMatch(%q) = %v, want %v", tt.query, got, tt.want)
+		}
+	}
+}
+
+func TestParseSimulators(t *testing.T) {
+	data := []byte(`{"devices": {
+		"com.apple.CoreSimulator.SimRuntime.iOS-17-2": [
+			{"name": "iPhone 15 Pro", "state": "Shutdown", "udid": "A"},
+			{"name": "iPad Air", "state": "Shutdown", "udid": "B"}
+		],
+		"com.apple.CoreSimulator.SimRuntime.iOS-18-0": [
+			{"name": "iPhone 15 Pro", "state": "Shutdown", "udid": "C"},
+			{"name": "iPad Air", "state": "Shutdown", "udid": "D"},
+			{"name": "iPhone 16", "state": "Shutdown", "udid": "E"}
+		],
+		"com.apple.CoreSimulator.SimRuntime.watchOS-10-2": [
+			{"name": "Apple Watch", "state": "Booted", "udid": "F"}
+		]
+	}}`)
+	sims, err := parseSimulators(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var udids []string
+	for _, s := range sims {
+		udids = append(udids, s.udid)
+	}
+	if want := []string{"C", "E", "D", "A", "B"}; !slices.Equal(udids, want) {
+		t.Fatalf("expected newest runtime and iPhones first %v, got %v", want, udids)
+	}
+	if sims[0].runtime != "iOS 18.0" {
+		t.Errorf("expected readable runtime name, got %q", sims[0].runtime)
+	}
+
+	// A model installed for several runtimes resolves to the newest.
+	sim, err := matchSimulator(sims, "15 pro", deviceMemory{})
+	if err != nil || sim.udid != "C" {
+		t.Errorf("expected iPhone 15 Pro on iOS 18.0, got %+v, %v", sim, err)
+	}
+	if _, err := matchSimulator(sims, "Galaxy", deviceMemory{}); err == nil {
+		t.Error("expected an error for an unknown simulator")
+	}
+	if sim, _ := matchSimulator(sims, "A", deviceMemory{}); sim.udid != "A" {
+		t.Errorf("expected a UDID match, got %+v", sim)
+	}
+}
+
+func TestDeviceMemory(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state", "project.json")
+	android := deviceMemory{path: path, kind: memoryAndroid}
+	sim := deviceMemory{path: path, kind: memoryIOSSimulator}
+
+	if got := android.last(); got != "" {
+		t.Fatalf("expected no remembered device, got %q", got)
+	}
+	android.remember("emulator-5554")
+	sim.remember("C")
+	if got := android.last(); got != "emulator-5554" {
+		t.Errorf("expected emulator-5554, got %q", got)
+	}
+	if got := sim.last(); got != "C" {
+		t.Errorf("expected kinds to be remembered separately, got %q", got)
+	}
+
+	// The zero value remembers nothing.
+	deviceMemory{}.remember("x")
+	if got := (deviceMemory{}).last(); got != "" {
+		t.Errorf("expected zero memory to be empty, got %q", got)
+	}
+}
+
+func TestMatchAndroidDevice_RemembersChoice(t *testing.T) {
+	mem := deviceMemory{path: filepath.Join(t.TempDir(), "state.json"), kind: memoryAndroid}
+	devices := []androidDevice{
+		{serial: "emulator-5554", model: "sdk_gphone64_x86_64"},
+		{serial: "R58M", model: "Pixel_7"},
+	}
+	mem.remember("R58M")
+	if serial, err := matchAndroidDevice(devices, "", mem); err != nil || serial != "R58M" {
+		t.Errorf("expected the remembered device, got %q, %v", serial, err)
+	}
+	if serial, err := matchAndroidDevice(devices, "pixel 7", mem); err != nil || serial != "R58M" {
+		t.Errorf("expected a fuzzy model match, got %q, %v", serial, err)
+	}
+}
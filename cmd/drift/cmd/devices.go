@@ -3,9 +3,12 @@ package cmd
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os/exec"
 	"runtime"
+	"slices"
+	"strconv"
 	"strings"
 
 	ios "github.com/danielpaulus/go-ios/ios"
@@ -20,6 +23,7 @@ func init() {
 Shows:
   - Connected Android devices and emulators
   - Connected iOS devices (via usbmuxd)
+  - iOS devices paired for wireless debugging (macOS only)
   - Available iOS simulators (macOS only)
 
 Use this to find device identifiers for running apps on specific devices.`,
@@ -46,6 +50,12 @@ func runDevices(args []string) error {
 	fmt.Println()
 
 	if runtime.GOOS == "darwin" {
+		fmt.Println("Wireless iOS devices:")
+		if err := listWirelessIOSDevices(); err != nil {
+			fmt.Printf("  (Could not list wireless iOS devices: %v)\n", err)
+		}
+		fmt.Println()
+
 		fmt.Println("iOS Simulators:")
 		if err := listIOSSimulators(); err != nil {
 			fmt.Printf("  (Could not list iOS simulators: %v)\n", err)
@@ -115,6 +125,9 @@ func listAndroidDevices() error {
 		fmt.Println("    2. Connect via USB")
 		fmt.Println("    3. Authorize the connection on your device")
 		fmt.Println()
+		fmt.Println("  To connect over Wi-Fi (Android 11+), turn on Wireless debugging and run:")
+		fmt.Println("    drift run android --pair <ip:port>")
+		fmt.Println()
 		fmt.Println("  To start an emulator:")
 		fmt.Println("    emulator -avd <avd-name>")
 	} else {
@@ -133,8 +146,10 @@ type androidDevice struct {
 
 // resolveAndroidDevice resolves a device identifier (name, serial, or empty
 // for auto-detect) into an adb serial string. Runs `adb devices -l` and
-// matches by serial (exact) or model name (case-insensitive).
-func resolveAndroidDevice(adb, id string) (string, error) {
+// matches by serial (exact), model name (case-insensitive), then a fuzzy
+// model name. With several devices and no identifier it reuses the device
+// remembered in mem, or asks the user to pick one.
+func resolveAndroidDevice(adb, id string, mem deviceMemory) (string, error) {
 	cmd := exec.Command(adb, "devices", "-l")
 	var out bytes.Buffer
 	cmd.Stdout = &out
@@ -163,21 +178,43 @@ func resolveAndroidDevice(adb, id string) (string, error) {
 		devices = append(devices, d)
 	}
 
+	serial, err := matchAndroidDevice(devices, id, mem)
+	if err != nil {
+		return "", err
+	}
+	mem.remember(serial)
+	return serial, nil
+}
+
+func matchAndroidDevice(devices []androidDevice, id string, mem deviceMemory) (string, error) {
 	if id == "" {
 		switch len(devices) {
 		case 0:
 			return "", fmt.Errorf("no connected Android devices found\nConnect a device via USB, or specify one with --device <name or serial>")
 		case 1:
 			d := devices[0]
-			if d.model != "" {
-				fmt.Printf("  Auto-detected device: %s (%s)\n", d.model, d.serial)
-			} else {
-				fmt.Printf("  Auto-detected device: %s\n", d.serial)
-			}
+			fmt.Printf("  Auto-detected device: %s\n", d.label())
 			return d.serial, nil
-		default:
+		}
+		last := mem.last()
+		for _, d := range devices {
+			if d.serial == last {
+				fmt.Printf("  Using last device: %s (pass --device to choose another)\n", d.label())
+				return d.serial, nil
+			}
+		}
+		labels := make([]string, len(devices))
+		for i, d := range devices {
+			labels[i] = d.label()
+		}
+		i, err := pickFromList("Multiple Android devices connected:", labels, 0)
+		if errors.Is(err, errNotInteractive) {
 			return "", fmt.Errorf("multiple Android devices connected, specify one with --device <name or serial>:\n%s", formatAndroidDeviceList(devices))
 		}
+		if err != nil {
+			return "", err
+		}
+		return devices[i].serial, nil
 	}
 
 	// Exact serial match.
@@ -194,6 +231,21 @@ func resolveAndroidDevice(adb, id string) (string, error) {
 		}
 	}
 
+	// Fuzzy model match, such as "pixel 7" for Pixel_7.
+	models := make([]string, len(devices))
+	labels := make([]string, len(devices))
+	for i, d := range devices {
+		models[i] = d.model
+		labels[i] = d.label()
+	}
+	i, err := uniqueMatch(id, fuzzyMatch(id, models), labels, func(i, j int) bool { return false })
+	if err != nil {
+		return "", err
+	}
+	if i >= 0 {
+		return devices[i].serial, nil
+	}
+
 	listing := formatAndroidDeviceList(devices)
 	if len(devices) == 0 {
 		listing = "  (none)"
@@ -201,14 +253,18 @@ func resolveAndroidDevice(adb, id string) (string, error) {
 	return "", fmt.Errorf("device %q not found\nConnected devices:\n%s", id, listing)
 }
 
+// label returns the device's model and serial for display.
+func (d androidDevice) label() string {
+	if d.model != "" {
+		return fmt.Sprintf("%s (%s)", d.model, d.serial)
+	}
+	return d.serial
+}
+
 func formatAndroidDeviceList(devices []androidDevice) string {
 	var lines []string
 	for _, d := range devices {
-		if d.model != "" {
-			lines = append(lines, fmt.Sprintf("  %s (%s)", d.model, d.serial))
-		} else {
-			lines = append(lines, fmt.Sprintf("  %s", d.serial))
-		}
+		lines = append(lines, "  "+d.label())
 	}
 	return strings.Join(lines, "\n")
 }
@@ -251,8 +307,10 @@ func listIOSDevices() error {
 
 // resolveDevice resolves a device identifier (name, UDID, or empty for
 // auto-detect) into an ios.DeviceEntry. Uses go-ios to enumerate connected
-// devices and match by UDID or device name (case-insensitive).
-func resolveDevice(id string) (ios.DeviceEntry, error) {
+// devices and match by UDID, device name (case-insensitive), then a fuzzy
+// device name. With several devices and no identifier it reuses the device
+// remembered in mem, or asks the user to pick one.
+func resolveDevice(id string, mem deviceMemory) (ios.DeviceEntry, error) {
 	deviceList, err := ios.ListDevices()
 	if err != nil {
 		return ios.DeviceEntry{}, fmt.Errorf("could not list iOS devices: %w", err)
@@ -266,37 +324,68 @@ func resolveDevice(id string) (ios.DeviceEntry, error) {
 		names[i] = deviceName(d)
 	}
 
+	i, err := matchIOSDevice(devices, names, id, mem)
+	if err != nil {
+		return ios.DeviceEntry{}, err
+	}
+	mem.remember(devices[i].Properties.SerialNumber)
+	return devices[i], nil
+}
+
+// matchIOSDevice returns the index of the device in devices identified by
+// id, as described for resolveDevice.
+func matchIOSDevice(devices []ios.DeviceEntry, names []string, id string, mem deviceMemory) (int, error) {
 	if id == "" {
 		switch len(devices) {
 		case 0:
-			return ios.DeviceEntry{}, fmt.Errorf("no connected iOS devices found\nConnect a device via USB, or specify one with --device <name-or-udid>")
+			return -1, fmt.Errorf("no connected iOS devices found\nConnect a device via USB, or specify one with --device <name-or-udid>")
 		case 1:
 			fmt.Printf("  Auto-detected device: %s (%s)\n", names[0], devices[0].Properties.SerialNumber)
-			return devices[0], nil
-		default:
-			return ios.DeviceEntry{}, fmt.Errorf("multiple iOS devices connected, specify one with --device <name-or-udid>:\n%s", formatDeviceListCached(devices, names))
+			return 0, nil
 		}
+		last := mem.last()
+		for i, d := range devices {
+			if d.Properties.SerialNumber == last {
+				fmt.Printf("  Using last device: %s (pass --device to choose another)\n", names[i])
+				return i, nil
+			}
+		}
+		labels := make([]string, len(devices))
+		for i, d := range devices {
+			labels[i] = fmt.Sprintf("%s (%s)", names[i], d.Properties.SerialNumber)
+		}
+		i, err := pickFromList("Multiple iOS devices connected:", labels, 0)
+		if errors.Is(err, errNotInteractive) {
+			return -1, fmt.Errorf("multiple iOS devices connected, specify one with --device <name-or-udid>:\n%s", formatDeviceListCached(devices, names))
+		}
+		return i, err
 	}
 
 	// Match by UDID first (case-sensitive).
-	for _, d := range devices {
+	for i, d := range devices {
 		if d.Properties.SerialNumber == id {
-			return d, nil
+			return i, nil
 		}
 	}
 
 	// Match by device name (case-insensitive).
-	for i, d := range devices {
+	for i := range devices {
 		if strings.EqualFold(names[i], id) {
-			return d, nil
+			return i, nil
 		}
 	}
 
+	// Fuzzy name match, such as "ada's iphone" for "Ada's iPhone 15".
+	i, err := uniqueMatch(id, fuzzyMatch(id, names), names, func(i, j int) bool { return false })
+	if err != nil || i >= 0 {
+		return i, err
+	}
+
 	listing := formatDeviceListCached(devices, names)
 	if len(devices) == 0 {
 		listing = "  (none)"
 	}
-	return ios.DeviceEntry{}, fmt.Errorf("device %q not found\nConnected devices:\n%s", id, listing)
+	return -1, fmt.Errorf("device %q not found\nConnected devices:\n%s", id, listing)
 }
 
 // deviceName returns the user-visible name of a device, falling back to the
@@ -323,16 +412,104 @@ func formatDeviceListCached(devices []ios.DeviceEntry, names []string) string {
 	return strings.Join(lines, "\n")
 }
 
+// listWirelessIOSDevices lists paired iOS devices reachable over the
+// network using devicectl.
+func listWirelessIOSDevices() error {
+	devices, err := listCoreDevices()
+	if err != nil {
+		return err
+	}
+	count := 0
+	for _, d := range devices {
+		if d.paired && d.wireless() {
+			count++
+			fmt.Printf("  [%d] %s (%s)\n", count, d.name, d.udid)
+		}
+	}
+	if count == 0 {
+		fmt.Println("  No wireless devices found")
+		fmt.Println("  Pair once over USB in Xcode (Window > Devices and Simulators, \"Connect via network\")")
+		return nil
+	}
+	fmt.Println()
+	fmt.Println("  Run with: drift run ios --wireless [--device <name or UDID>] --team-id TEAM_ID")
+	return nil
+}
+
 func listIOSSimulators() error {
+	simulators, err := listSimulators()
+	if err != nil {
+		return err
+	}
+
+	bootedCount := 0
+	fmt.Println("  Booted:")
+	for _, sim := range simulators {
+		if sim.booted {
+			bootedCount++
+			fmt.Printf("    [%d] %s (%s)\n", bootedCount, sim.name, sim.runtime)
+		}
+	}
+	if bootedCount == 0 {
+		fmt.Println("    (none)")
+	}
+
+	fmt.Println()
+	fmt.Println("  Available (run with 'drift run ios --simulator \"<name>\"'):")
+
+	availableCount := 0
+	for _, sim := range simulators {
+		if strings.Contains(sim.name, "iPhone") && availableCount < 5 {
+			availableCount++
+			fmt.Printf("    • %s (%s)\n", sim.name, sim.runtime)
+		}
+	}
+	if availableCount == 0 {
+		fmt.Println("    (none)")
+	} else {
+		fmt.Println("    ...")
+	}
+
+	return nil
+}
+
+// simulator is an available iOS Simulator device.
+type simulator struct {
+	name string
+	udid string
+	// runtime is the readable runtime name, e.g. "iOS 17.2".
+	runtime string
+	version []int
+	booted  bool
+}
+
+// label returns the simulator's name and runtime for display.
+func (s simulator) label() string {
+	label := fmt.Sprintf("%s (%s)", s.name, s.runtime)
+	if s.booted {
+		label += ", booted"
+	}
+	return label
+}
+
+// listSimulators lists the available iOS Simulators, sorted as by
+// parseSimulators.
+func listSimulators() ([]simulator, error) {
 	cmd := exec.Command("xcrun", "simctl", "list", "devices", "available", "--json")
 	var out bytes.Buffer
 	cmd.Stdout = &out
 	cmd.Stderr = &out
 
 	if err := cmd.Run(); err != nil {
-		return err
+		return nil, err
 	}
+	return parseSimulators(out.Bytes())
+}
 
+// parseSimulators parses the JSON output of `xcrun simctl list devices
+// --json`, keeping iOS simulators. They are sorted booted first, then
+// newest runtime, then iPhones before other devices, then by name.
+func parseSimulators(data []byte) ([]simulator, error) {
 	var result struct {
 		Devices map[string][]struct {
 			Name  string `json:"name"`
@@ -340,53 +517,135 @@ func listIOSSimulators() error {
 			UDID  string `json:"udid"`
 		} `json:"devices"`
 	}
-	if err := json.Unmarshal(out.Bytes(), &result); err != nil {
-		return fmt.Errorf("failed to parse simctl output: %w", err)
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse simctl output: %w", err)
 	}
 
-	bootedCount := 0
-	fmt.Println("  Booted:")
+	var simulators []simulator
 	for runtime, devices := range result.Devices {
 		if !strings.Contains(runtime, "iOS") {
 			continue
 		}
-		// Extract a readable runtime name (e.g. "iOS 17.2") from the identifier.
+		// Extract a readable runtime name (e.g. "iOS 17.2") from the identifier
+		// com.apple.CoreSimulator.SimRuntime.iOS-17-2.
 		runtimeName := runtime
+		var version []int
 		if i := strings.LastIndex(runtime, "SimRuntime."); i != -1 {
-			runtimeName = strings.ReplaceAll(runtime[i+len("SimRuntime."):], "-", " ")
-			// Collapse "iOS 17 2" into "iOS 17.2": put a dot before the last segment.
-			if parts := strings.SplitN(runtimeName, " ", 3); len(parts) == 3 {
-				runtimeName = parts[0] + " " + parts[1] + "." + parts[2]
+			parts := strings.Split(runtime[i+len("SimRuntime."):], "-")
+			for _, p := range parts[1:] {
+				n, _ := strconv.Atoi(p)
+				version = append(version, n)
+			}
+			runtimeName = parts[0]
+			if len(parts) > 1 {
+				runtimeName += " " + strings.Join(parts[1:], ".")
 			}
 		}
 		for _, d := range devices {
-			if d.State == "Booted" {
-				bootedCount++
-				fmt.Printf("    [%d] %s (%s)\n", bootedCount, d.Name, runtimeName)
+			simulators = append(simulators, simulator{
+				name:    d.Name,
+				udid:    d.UDID,
+				runtime: runtimeName,
+				version: version,
+				booted:  d.State == "Booted",
+			})
+		}
+	}
+	slices.SortFunc(simulators, func(a, b simulator) int {
+		if a.booted != b.booted {
+			if a.booted {
+				return -1
+			}
+			return 1
+		}
+		if c := slices.Compare(b.version, a.version); c != 0 {
+			return c
+		}
+		aPhone, bPhone := strings.HasPrefix(a.name, "iPhone"), strings.HasPrefix(b.name, "iPhone")
+		if aPhone != bPhone {
+			if aPhone {
+				return -1
 			}
+			return 1
 		}
+		return strings.Compare(a.name, b.name)
+	})
+	return simulators, nil
+}
+
+// resolveSimulator resolves a simulator name or UDID, or empty to choose
+// one. Names match fuzzily, so "15 Pro" finds "iPhone 15 Pro"; a model
+// installed for several iOS versions resolves to the newest. With no name
+// it reuses the simulator remembered in mem or the only booted one, and
+// otherwise asks the user to pick, defaulting to an iPhone on the newest
+// iOS.
+func resolveSimulator(query string, mem deviceMemory) (simulator, error) {
+	simulators, err := listSimulators()
+	if err != nil {
+		return simulator{}, fmt.Errorf("could not list iOS simulators: %w", err)
 	}
-	if bootedCount == 0 {
-		fmt.Println("    (none)")
+	sim, err := matchSimulator(simulators, query, mem)
+	if err != nil {
+		return simulator{}, err
 	}
+	mem.remember(sim.udid)
+	return sim, nil
+}
 
-	fmt.Println()
-	fmt.Println("  Available (run with 'drift run ios --simulator \"<name>\"'):")
+func matchSimulator(simulators []simulator, query string, mem deviceMemory) (simulator, error) {
+	if len(simulators) == 0 {
+		return simulator{}, fmt.Errorf("no iOS simulators available\nInstall an iOS runtime in Xcode (Settings > Platforms)")
+	}
 
-	availableCount := 0
-	for _, devices := range result.Devices {
-		for _, d := range devices {
-			if strings.Contains(d.Name, "iPhone") && availableCount < 5 {
-				availableCount++
-				fmt.Printf("    • %s\n", d.Name)
+	if query == "" {
+		last := mem.last()
+		for _, sim := range simulators {
+			if sim.udid == last {
+				fmt.Printf("  Using last simulator: %s (pass --simulator to choose another)\n", sim.label())
+				return sim, nil
 			}
 		}
-	}
-	if availableCount == 0 {
-		fmt.Println("    (none)")
-	} else {
-		fmt.Println("    ...")
+		if len(simulators) == 1 || (simulators[0].booted && !simulators[1].booted) {
+			fmt.Printf("  Auto-detected simulator: %s\n", simulators[0].label())
+			return simulators[0], nil
+		}
+		// Offer the booted simulators and those on the newest runtime.
+		var choices []simulator
+		for _, sim := range simulators {
+			if sim.booted || slices.Equal(sim.version, simulators[0].version) {
+				choices = append(choices, sim)
+			}
+		}
+		labels := make([]string, len(choices))
+		for i, sim := range choices {
+			labels[i] = sim.label()
+		}
+		i, err := pickFromList("Available iOS simulators:", labels, 0)
+		if errors.Is(err, errNotInteractive) {
+			fmt.Printf("  Using simulator: %s (pass --simulator to choose another)\n", choices[0].label())
+			return choices[0], nil
+		}
+		if err != nil {
+			return simulator{}, err
+		}
+		return choices[i], nil
 	}
 
-	return nil
+	names := make([]string, len(simulators))
+	for i, sim := range simulators {
+		if sim.udid == query {
+			return sim, nil
+		}
+		names[i] = sim.name
+	}
+	i, err := uniqueMatch(query, fuzzyMatch(query, names), names, func(i, j int) bool {
+		return names[i] == names[j]
+	})
+	if err != nil {
+		return simulator{}, err
+	}
+	if i < 0 {
+		return simulator{}, fmt.Errorf("simulator %q not found\nRun 'drift devices' to list available simulators", query)
+	}
+	return simulators[i], nil
 }
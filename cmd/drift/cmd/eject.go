@@ -8,7 +8,7 @@ import (
 	"time"
 
 	"github.com/go-drift/drift/cmd/drift/internal/config"
-	"github.com/go-drift/drift/cmd/drift/internal/icongen"
+	"github.com/go-drift/drift/cmd/drift/internal/scaffold"
 	"github.com/go-drift/drift/cmd/drift/internal/templates"
 	"github.com/go-drift/drift/cmd/drift/internal/workspace"
 )
@@ -34,8 +34,10 @@ The ejected project is a real, fully-functioning project with all template
 values substituted. You can edit Swift/Kotlin code, modify project settings,
 add dependencies, etc.
 
-Note: Changes to drift.yaml will NOT affect ejected platforms. To incorporate
-drift.yaml changes, delete the platform directory and re-eject.`,
+Note: Changes to drift.yaml will NOT affect ejected platforms, except for the
+native: section (dependencies, permissions, manifest and plist entries),
+which every build injects between drift marker comments. To incorporate
+other drift.yaml changes, delete the platform directory and re-eject.`,
 		Usage: "drift eject <ios|android|all> [--force]",
 		Run:   runEject,
 	})
@@ -128,22 +130,26 @@ func ejectPlatform(root string, cfg *config.Resolved, platform string, opts ejec
 		return fmt.Errorf("failed to create %s: %w", platformDir, err)
 	}
 
+	settings := scaffold.NewSettings(root, cfg, true)
 	tmplData := templates.NewTemplateData(templates.TemplateInput{
 		AppName:        cfg.AppName,
 		AndroidPackage: cfg.AppID,
 		IOSBundleID:    cfg.AppID,
 		Orientation:    cfg.Orientation,
 		AllowHTTP:      cfg.AllowHTTP,
+		Locales:        scaffold.IOSLocales(settings),
+		Version:        cfg.Version,
+		BuildNumber:    cfg.BuildNumber,
 	})
 
 	// Write platform files
 	switch platform {
 	case "ios":
-		if err := ejectIOS(platformDir, tmplData, root, cfg.Icon); err != nil {
+		if err := ejectIOS(platformDir, tmplData, settings); err != nil {
 			return err
 		}
 	case "android":
-		if err := ejectAndroid(platformDir, tmplData, root, cfg.Icon, cfg.IconBackground); err != nil {
+		if err := ejectAndroid(platformDir, tmplData, settings); err != nil {
 			return err
 		}
 	default:
@@ -231,7 +237,7 @@ func createBackup(dir string) (string, error) {
 	return "", fmt.Errorf("too many backups exist for %s", dir)
 }
 
-func ejectIOS(platformDir string, data *templates.TemplateData, projectRoot, iconPath string) error {
+func ejectIOS(platformDir string, data *templates.TemplateData, settings scaffold.Settings) error {
 	runnerDir := filepath.Join(platformDir, "Runner")
 
 	// Write iOS template files (Info.plist, Swift sources, LaunchScreen.storyboard)
@@ -245,14 +251,14 @@ func ejectIOS(platformDir string, data *templates.TemplateData, projectRoot, ico
 		return err
 	}
 
-	// Generate app icon assets
-	assetDir := filepath.Join(runnerDir, "Assets.xcassets")
-	iconSrc, err := icongen.LoadSource(projectRoot, iconPath)
-	if err != nil {
-		return fmt.Errorf("failed to load icon: %w", err)
+	// Write translated display names and usage descriptions
+	if err := scaffold.WriteIOSLocalizations(runnerDir, settings); err != nil {
+		return err
 	}
-	if err := iconSrc.GenerateIOS(assetDir); err != nil {
-		return fmt.Errorf("failed to generate iOS icons: %w", err)
+
+	// Generate app icon and launch screen assets
+	if err := scaffold.GenerateIOSIcons(filepath.Join(runnerDir, "Assets.xcassets"), settings); err != nil {
+		return err
 	}
 
 	// Write Xcode project files
@@ -264,7 +270,7 @@ func ejectIOS(platformDir string, data *templates.TemplateData, projectRoot, ico
 	return nil
 }
 
-func ejectAndroid(platformDir string, data *templates.TemplateData, projectRoot, iconPath, iconBackground string) error {
+func ejectAndroid(platformDir string, data *templates.TemplateData, settings scaffold.Settings) error {
 	appDir := filepath.Join(platformDir, "app")
 	srcDir := filepath.Join(appDir, "src", "main")
 	cppDir := filepath.Join(srcDir, "cpp")
@@ -310,13 +316,14 @@ func ejectAndroid(platformDir string, data *templates.TemplateData, projectRoot,
 		return err
 	}
 
-	// Generate icon assets
-	iconSrc, err := icongen.LoadSource(projectRoot, iconPath)
-	if err != nil {
-		return fmt.Errorf("failed to load icon: %w", err)
+	// Write the app name and its translations
+	if err := scaffold.WriteAndroidStrings(resDir, settings); err != nil {
+		return err
 	}
-	if err := iconSrc.GenerateAndroid(resDir, iconBackground); err != nil {
-		return fmt.Errorf("failed to generate android icons: %w", err)
+
+	// Generate icon and launch screen assets
+	if err := scaffold.GenerateAndroidIcons(resDir, settings); err != nil {
+		return err
 	}
 
 	// Write Kotlin files
@@ -50,21 +50,21 @@ func runLog(args []string) error {
 
 	switch platform {
 	case "android":
-		return logAndroid(args[1:])
+		return logAndroid(root, cfg, args[1:])
 	case "ios":
-		return logIOS(cfg, args[1:])
+		return logIOS(root, cfg, args[1:])
 	case "xtool":
-		return logXtool(cfg, args[1:])
+		return logXtool(root, cfg, args[1:])
 	default:
 		return fmt.Errorf("unknown platform %q (use android, ios, or xtool)", platform)
 	}
 }
 
 // logAndroid streams logs from Android device.
-func logAndroid(args []string) error {
+func logAndroid(root string, cfg *config.Resolved, args []string) error {
 	adb := findADB()
 	deviceID, _ := parseDeviceFlag(args)
-	serial, err := resolveAndroidDevice(adb, deviceID)
+	serial, err := resolveAndroidDevice(adb, deviceID, newDeviceMemory(root, cfg, memoryAndroid))
 	if err != nil {
 		return err
 	}
@@ -78,14 +78,14 @@ func logAndroid(args []string) error {
 }
 
 // logIOS streams logs from iOS simulator or physical device.
-func logIOS(cfg *config.Resolved, args []string) error {
+func logIOS(root string, cfg *config.Resolved, args []string) error {
 	deviceID, device := parseDeviceFlag(args)
 
 	ctx, cancel := signalContext()
 	defer cancel()
 
 	if device {
-		resolved, err := resolveDevice(deviceID)
+		resolved, err := resolveDevice(deviceID, newDeviceMemory(root, cfg, memoryIOSDevice))
 		if err != nil {
 			return err
 		}
@@ -101,10 +101,10 @@ func logIOS(cfg *config.Resolved, args []string) error {
 }
 
 // logXtool streams logs from a device built with xtool.
-func logXtool(cfg *config.Resolved, args []string) error {
+func logXtool(root string, cfg *config.Resolved, args []string) error {
 	deviceID, _ := parseDeviceFlag(args)
 
-	resolved, err := resolveDevice(deviceID)
+	resolved, err := resolveDevice(deviceID, newDeviceMemory(root, cfg, memoryIOSDevice))
 	if err != nil {
 		return err
 	}
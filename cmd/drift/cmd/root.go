@@ -97,7 +97,7 @@ func Execute() error {
 				return nil
 			}
 			filteredArgs = append(filteredArgs, arg)
-		case "-v", "--version", "version":
+		case "-v", "--version":
 			if len(filteredArgs) == 0 {
 				fmt.Printf("Drift CLI version %s (built %s)\n", Version, BuildTime)
 				return nil
@@ -3,6 +3,7 @@ package cmd
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/go-drift/drift/cmd/drift/internal/cache"
 	"github.com/go-drift/drift/cmd/drift/internal/config"
@@ -27,10 +28,20 @@ The command will:
 
 Flags:
   --watch            Watch for file changes and rebuild automatically
+  --debounce DELAY   Wait this long after the last change before rebuilding
+                     in watch mode, e.g. 300ms (default: watch.debounce or 500ms)
   --no-logs          Launch without streaming logs
   --no-fetch         Disable auto-download of missing Skia libraries
   --device [ID]      Target a specific device by name, serial, or UDID
-  --simulator NAME   Run on a specific iOS simulator (default: iPhone 15)
+  --connect ADDR     Android: adb connect to ADDR (ip[:port]) over Wi-Fi
+  --pair ADDR        Android: pair with ADDR (ip:port from "Pair device with
+                     pairing code"); prompts for the code unless --pair-code
+  --pair-code CODE   Android: pairing code for --pair
+  --tcpip            Android: switch the USB device to Wi-Fi so the cable
+                     can be unplugged
+  --wireless         iOS: run on a device paired for wireless debugging
+  --simulator NAME   Run on a specific iOS simulator, by name or UDID; names
+                     match loosely, so "15 Pro" finds "iPhone 15 Pro"
   --team-id TEAM_ID  Apple Developer Team ID for code signing (required for --device)
 
 For Android devices:
@@ -38,19 +49,39 @@ For Android devices:
   drift run android --device emulator-5554       Target by serial
   drift run android --device sdk_gphone64_x86_64 Target by model name
 
+For Android devices over Wi-Fi:
+  drift run android --tcpip                      Switch the USB device to Wi-Fi
+  drift run android --connect 192.168.1.20       Device already in tcpip mode
+  drift run android --pair 192.168.1.20:37099    Android 11+ wireless debugging
+
 For iOS simulators:
-  drift run ios --simulator "iPhone 15"
+  drift run ios                                  Pick a simulator (remembered)
+  drift run ios --simulator "15 Pro"             Newest iOS with a matching name
 
 For physical iOS devices:
   drift run ios --device --team-id ABC123XYZ
   drift run ios --device 00008030-... --team-id ABC123XYZ
+  drift run ios --wireless --team-id ABC123XYZ   Device paired over the network
 
 For xtool (Linux/macOS):
   drift run xtool                     Run on connected device
   drift run xtool --device UDID       Run on specific device
 
+When several devices are available and none is given, drift lists them and
+asks which to use. The choice, or the device given with --device or
+--simulator, is remembered for the project and reused while it is
+connected; pass --device or --simulator to switch.
+
+In watch mode, press r to rebuild and relaunch, c to clear the screen, and
+q to quit. Changes to files matching watch.ignore in drift.yaml, to _test.go
+files, and to packages the app does not import are skipped.
+
+Logs stream over Wi-Fi too. Android log streaming reconnects when the
+connection drops; on iOS, wireless devices that usbmuxd cannot reach stream
+the app's console output through devicectl instead of the system log.
+
 Note: Physical device deployment uses devicectl (requires Xcode 15+, iOS 17+)`,
-		Usage: "drift run <platform> [--watch] [--no-logs] [--no-fetch] [--device [UDID]] [--simulator NAME] [--team-id TEAM_ID]",
+		Usage: "drift run <platform> [--watch] [--debounce DELAY] [--no-logs] [--no-fetch] [--device [UDID]] [--connect ADDR] [--pair ADDR] [--tcpip] [--wireless] [--simulator NAME] [--team-id TEAM_ID]",
 		Run:   runRun,
 	})
 }
@@ -59,10 +90,21 @@ type runOptions struct {
 	noLogs  bool
 	noFetch bool
 	watch   bool
+	// debounce overrides the watch debounce from drift.yaml; negative when
+	// --debounce was not given.
+	debounce time.Duration
+}
+
+// watchOptions returns the watch settings for a run targeting goos.
+func (o runOptions) watchOptions(goos string) watchOptions {
+	return watchOptions{debounce: o.debounce, goos: goos}
 }
 
 func runRun(args []string) error {
-	platformArgs, opts := parseRunArgs(args)
+	platformArgs, opts, err := parseRunArgs(args)
+	if err != nil {
+		return err
+	}
 	if len(platformArgs) == 0 {
 		return fmt.Errorf("platform is required (android, ios, or xtool)\n\nUsage: drift run <platform> [--no-logs]")
 	}
@@ -98,22 +140,32 @@ func runRun(args []string) error {
 	}
 }
 
-func parseRunArgs(args []string) ([]string, runOptions) {
-	opts := runOptions{}
+func parseRunArgs(args []string) ([]string, runOptions, error) {
+	opts := runOptions{debounce: -1}
 	filtered := make([]string, 0, len(args))
-	for _, arg := range args {
-		switch arg {
+	for i := 0; i < len(args); i++ {
+		switch arg := args[i]; arg {
 		case "--no-logs":
 			opts.noLogs = true
 		case "--no-fetch":
 			opts.noFetch = true
 		case "--watch":
 			opts.watch = true
+		case "--debounce":
+			if i+1 >= len(args) {
+				return nil, opts, fmt.Errorf("--debounce requires a duration, e.g. 300ms")
+			}
+			d, err := time.ParseDuration(args[i+1])
+			if err != nil || d < 0 {
+				return nil, opts, fmt.Errorf("invalid --debounce %q: use a non-negative duration such as 300ms", args[i+1])
+			}
+			opts.debounce = d
+			i++
 		default:
 			filtered = append(filtered, arg)
 		}
 	}
-	return filtered, opts
+	return filtered, opts, nil
 }
 
 // parseDeviceFlag extracts the --device flag and its optional value from an
@@ -16,7 +16,17 @@ func runAndroid(ws *workspace.Workspace, cfg *config.Resolved, args []string, op
 	adb := findADB()
 
 	deviceID, _ := parseDeviceFlag(args)
-	serial, err := resolveAndroidDevice(adb, deviceID)
+	wireless, err := parseAndroidWirelessArgs(args)
+	if err != nil {
+		return err
+	}
+	mem := newDeviceMemory(ws.Root, cfg, memoryAndroid)
+	var serial string
+	if wireless.enabled() {
+		serial, err = connectAndroidWireless(adb, deviceID, wireless, mem)
+	} else {
+		serial, err = resolveAndroidDevice(adb, deviceID, mem)
+	}
 	if err != nil {
 		return err
 	}
@@ -57,10 +67,12 @@ func runAndroid(ws *workspace.Workspace, cfg *config.Resolved, args []string, op
 		if !opts.noLogs {
 			go streamAndroidLogs(ctx, adb, serial)
 		}
-		return watchAndRun(ctx, ws, func() error {
+		return watchAndRun(ctx, ws, opts.watchOptions("android"), func(change watchChange) error {
 			adbCommand(adb, serial, "shell", "am", "force-stop", cfg.AppID).Run()
-			if err := ws.Refresh(); err != nil {
-				return err
+			if change.config {
+				if err := ws.Refresh(); err != nil {
+					return err
+				}
 			}
 			if err := buildAndroid(ws, buildOpts); err != nil {
 				return err
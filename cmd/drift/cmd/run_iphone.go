@@ -7,6 +7,7 @@ import (
 	"path/filepath"
 	"runtime"
 
+	ios "github.com/danielpaulus/go-ios/ios"
 	"github.com/go-drift/drift/cmd/drift/internal/config"
 	"github.com/go-drift/drift/cmd/drift/internal/workspace"
 )
@@ -14,18 +15,18 @@ import (
 type iosRunOptions struct {
 	device    bool
 	deviceID  string
+	wireless  bool
 	simulator string
 	teamID    string
 	noLogs    bool
 	watch     bool
+	watchOpts watchOptions
 }
 
 // parseIOSRunArgs parses iOS-specific flags from the argument list and returns
 // the resolved options.
 func parseIOSRunArgs(args []string) iosRunOptions {
-	opts := iosRunOptions{
-		simulator: "iPhone 15",
-	}
+	var opts iosRunOptions
 	id, present := parseDeviceFlag(args)
 	if present {
 		opts.device = true
@@ -43,6 +44,9 @@ func parseIOSRunArgs(args []string) iosRunOptions {
 				opts.teamID = args[i+1]
 				i++
 			}
+		case "--wireless":
+			opts.device = true
+			opts.wireless = true
 		}
 	}
 	return opts
@@ -59,6 +63,7 @@ func runIOS(ws *workspace.Workspace, cfg *config.Resolved, args []string, opts r
 		iosOpts.noLogs = true
 	}
 	iosOpts.watch = opts.watch
+	iosOpts.watchOpts = opts.watchOptions("ios")
 
 	if iosOpts.device {
 		return runIOSDevice(ws, cfg, iosOpts, opts.noFetch)
@@ -68,6 +73,15 @@ func runIOS(ws *workspace.Workspace, cfg *config.Resolved, args []string, opts r
 
 // runIOSSimulator builds and runs on iOS simulator.
 func runIOSSimulator(ws *workspace.Workspace, cfg *config.Resolved, opts iosRunOptions, noFetch bool) error {
+	// Choose the simulator up front so the picker does not wait for a build.
+	sim, err := resolveSimulator(opts.simulator, newDeviceMemory(ws.Root, cfg, memoryIOSSimulator))
+	if err != nil {
+		return err
+	}
+	// simctl and xcodebuild address the simulator by UDID, since a model name
+	// can be installed for several iOS versions.
+	opts.simulator = sim.udid
+
 	buildOpts := iosBuildOptions{buildOptions: buildOptions{noFetch: noFetch}, release: false, device: false}
 	if err := buildIOS(ws, buildOpts); err != nil {
 		return err
@@ -76,7 +90,7 @@ func runIOSSimulator(ws *workspace.Workspace, cfg *config.Resolved, opts iosRunO
 	fmt.Println()
 	fmt.Println("Running on iOS Simulator...")
 
-	if err := bootSimulator(opts.simulator); err != nil {
+	if err := bootSimulator(sim); err != nil {
 		return err
 	}
 
@@ -121,10 +135,12 @@ func runIOSSimulator(ws *workspace.Workspace, cfg *config.Resolved, opts iosRunO
 			arch:        runtime.GOARCH,
 			noFetch:     noFetch,
 		}
-		return watchAndRun(ctx, ws, func() error {
+		return watchAndRun(ctx, ws, opts.watchOpts, func(change watchChange) error {
 			exec.Command("xcrun", "simctl", "terminate", opts.simulator, cfg.AppID).Run()
-			if err := ws.Refresh(); err != nil {
-				return err
+			if change.config {
+				if err := ws.Refresh(); err != nil {
+					return err
+				}
 			}
 			if err := compileGoForIOS(compileCfg); err != nil {
 				return err
@@ -152,12 +168,27 @@ func runIOSDevice(ws *workspace.Workspace, cfg *config.Resolved, opts iosRunOpti
 		return fmt.Errorf("xcrun not found; make sure Xcode command line tools are installed")
 	}
 
-	// Resolve the device identifier once for the session.
-	resolved, err := resolveDevice(opts.deviceID)
-	if err != nil {
-		return err
+	// Resolve the device identifier once for the session. Wireless devices
+	// are listed by devicectl; the syslog relay only reaches them when
+	// usbmuxd also sees them on the network.
+	var resolved ios.DeviceEntry
+	syslogAvailable := true
+	if opts.wireless {
+		device, err := resolveWirelessDevice(opts.deviceID)
+		if err != nil {
+			return err
+		}
+		opts.deviceID = device.udid
+		resolved, err = resolveDevice(device.udid, deviceMemory{})
+		syslogAvailable = err == nil
+	} else {
+		var err error
+		resolved, err = resolveDevice(opts.deviceID, newDeviceMemory(ws.Root, cfg, memoryIOSDevice))
+		if err != nil {
+			return err
+		}
+		opts.deviceID = resolved.Properties.SerialNumber
 	}
-	opts.deviceID = resolved.Properties.SerialNumber
 
 	buildOpts := iosBuildOptions{buildOptions: buildOptions{noFetch: noFetch}, release: false, device: true, teamID: opts.teamID}
 	if err := buildIOS(ws, buildOpts); err != nil {
@@ -183,13 +214,23 @@ func runIOSDevice(ws *workspace.Workspace, cfg *config.Resolved, opts iosRunOpti
 	ctx, cancel := signalContext()
 	defer cancel()
 
-	// Start log streaming before launch so startup logs are captured.
+	// Without the syslog relay, stream the app's console output instead;
+	// devicectl attaches to it as part of the launch.
+	launch := func() error { return devicectlLaunch(cfg.AppID, opts.deviceID) }
 	if !opts.noLogs {
-		go streamDeviceLogs(ctx, "Runner", resolved)
+		if syslogAvailable {
+			// Start log streaming before launch so startup logs are captured.
+			go streamDeviceLogs(ctx, "Runner", resolved)
+		} else {
+			launch = func() error {
+				go devicectlLaunchConsole(ctx, cfg.AppID, opts.deviceID)
+				return nil
+			}
+		}
 	}
 
 	fmt.Println("  Launching on device...")
-	if err := devicectlLaunch(cfg.AppID, opts.deviceID); err != nil {
+	if err := launch(); err != nil {
 		return err
 	}
 
@@ -206,10 +247,12 @@ func runIOSDevice(ws *workspace.Workspace, cfg *config.Resolved, opts iosRunOpti
 			arch:        "arm64",
 			noFetch:     noFetch,
 		}
-		return watchAndRun(ctx, ws, func() error {
+		return watchAndRun(ctx, ws, opts.watchOpts, func(change watchChange) error {
 			exec.Command("xcrun", "devicectl", "device", "process", "terminate", "--device", opts.deviceID, cfg.AppID).Run()
-			if err := ws.Refresh(); err != nil {
-				return err
+			if change.config {
+				if err := ws.Refresh(); err != nil {
+					return err
+				}
 			}
 			if err := compileGoForIOS(compileCfg); err != nil {
 				return err
@@ -220,7 +263,7 @@ func runIOSDevice(ws *workspace.Workspace, cfg *config.Resolved, opts iosRunOpti
 			if err := devicectlInstall(ws, opts); err != nil {
 				return err
 			}
-			return devicectlLaunch(cfg.AppID, opts.deviceID)
+			return launch()
 		})
 	}
 
@@ -235,32 +278,38 @@ func runIOSDevice(ws *workspace.Workspace, cfg *config.Resolved, opts iosRunOpti
 // iOS helper functions
 // --------------------------------------------------------------------
 
-// bootSimulator boots the named iOS Simulator. If the simulator is already
+// bootSimulator boots an iOS Simulator. If the simulator is already
 // booted (exit code 149), the error is silently ignored.
-func bootSimulator(name string) error {
-	fmt.Printf("  Booting %s...\n", name)
-	cmd := exec.Command("xcrun", "simctl", "boot", name)
+func bootSimulator(sim simulator) error {
+	if sim.booted {
+		return nil
+	}
+	fmt.Printf("  Booting %s...\n", sim.label())
+	cmd := exec.Command("xcrun", "simctl", "boot", sim.udid)
 	if err := cmd.Run(); err != nil {
 		// Exit code 149 means simulator is already booted
 		if exitErr, ok := err.(*exec.ExitError); ok && exitErr.ExitCode() == 149 {
 			// Already booted, continue
 		} else {
-			return fmt.Errorf("failed to boot simulator %s: %w", name, err)
+			return fmt.Errorf("failed to boot simulator %s: %w", sim.name, err)
 		}
 	}
 	return nil
 }
 
-// xcodebuildForSimulator runs xcodebuild targeting the named iOS Simulator.
-func xcodebuildForSimulator(ws *workspace.Workspace, simulator string) error {
-	xcodeproj := filepath.Join(ws.IOSDir, "Runner.xcodeproj")
-	buildArgs := []string{
-		"-project", xcodeproj,
+// xcodebuildForSimulator runs xcodebuild targeting the iOS Simulator with
+// the given UDID.
+func xcodebuildForSimulator(ws *workspace.Workspace, udid string) error {
+	project, err := xcodeProjectArgs(ws)
+	if err != nil {
+		return err
+	}
+	buildArgs := append(project,
 		"-scheme", "Runner",
 		"-configuration", "Debug",
-		"-destination", fmt.Sprintf("platform=iOS Simulator,name=%s", simulator),
+		"-destination", fmt.Sprintf("platform=iOS Simulator,id=%s", udid),
 		"-derivedDataPath", filepath.Join(ws.BuildDir, "DerivedData"),
-	}
+	)
 	buildArgs = append(buildArgs, simulatorArchBuildSettings()...)
 	buildArgs = append(buildArgs, "build")
 	cmd := exec.Command("xcodebuild", buildArgs...)
@@ -273,8 +322,8 @@ func xcodebuildForSimulator(ws *workspace.Workspace, simulator string) error {
 	return nil
 }
 
-// installIOSSimulatorApp installs the built .app bundle into the named iOS
-// Simulator using simctl.
+// installIOSSimulatorApp installs the built .app bundle into an iOS
+// Simulator, given by name or UDID, using simctl.
 func installIOSSimulatorApp(ws *workspace.Workspace, simulator string) error {
 	appPath := filepath.Join(ws.BuildDir, "DerivedData", "Build", "Products", "Debug-iphonesimulator", "Runner.app")
 	cmd := exec.Command("xcrun", "simctl", "install", simulator, appPath)
@@ -286,8 +335,8 @@ func installIOSSimulatorApp(ws *workspace.Workspace, simulator string) error {
 	return nil
 }
 
-// launchIOSSimulatorApp launches the app by bundle ID in the named iOS
-// Simulator using simctl.
+// launchIOSSimulatorApp launches the app by bundle ID in an iOS Simulator,
+// given by name or UDID, using simctl.
 func launchIOSSimulatorApp(appID, simulator string) error {
 	cmd := exec.Command("xcrun", "simctl", "launch", simulator, appID)
 	cmd.Stdout = os.Stdout
@@ -300,15 +349,17 @@ func launchIOSSimulatorApp(appID, simulator string) error {
 
 // xcodebuildForDevice runs xcodebuild targeting a physical iOS device.
 func xcodebuildForDevice(ws *workspace.Workspace, opts iosRunOptions) error {
-	xcodeproj := filepath.Join(ws.IOSDir, "Runner.xcodeproj")
-	buildArgs := []string{
-		"-project", xcodeproj,
+	project, err := xcodeProjectArgs(ws)
+	if err != nil {
+		return err
+	}
+	buildArgs := append(project,
 		"-scheme", "Runner",
 		"-configuration", "Debug",
 		"-destination", "generic/platform=iOS",
 		"-derivedDataPath", filepath.Join(ws.BuildDir, "DerivedData"),
 		"-allowProvisioningUpdates",
-	}
+	)
 	if opts.teamID != "" {
 		buildArgs = append(buildArgs, "DEVELOPMENT_TEAM="+opts.teamID)
 	}
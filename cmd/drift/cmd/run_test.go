@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"testing"
+	"time"
 
 	"github.com/fsnotify/fsnotify"
 )
@@ -48,6 +49,7 @@ func TestIsRelevantChange(t *testing.T) {
 		{"non-go file", fsnotify.Event{Name: "/app/README.md", Op: fsnotify.Write}, false},
 		{"chmod only", fsnotify.Event{Name: "/app/main.go", Op: fsnotify.Chmod}, false},
 		{"go file in subdir", fsnotify.Event{Name: "/app/pkg/util.go", Op: fsnotify.Write}, true},
+		{"go test file", fsnotify.Event{Name: "/app/main_test.go", Op: fsnotify.Write}, false},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -58,3 +60,64 @@ func TestIsRelevantChange(t *testing.T) {
 		})
 	}
 }
+
+func TestWatchFilter(t *testing.T) {
+	f := &watchFilter{
+		root:    "/app",
+		ignore:  []string{"*_gen.go", "tools", "internal/mocks"},
+		pkgDirs: map[string]bool{"/app": true, "/app/ui": true, "/app/internal/mocks": true},
+		fonts:   map[string]bool{"/app/assets/fonts/Inter.ttf": true},
+	}
+	tests := []struct {
+		name         string
+		file         string
+		wantRelevant bool
+		wantConfig   bool
+	}{
+		{"app package", "/app/main.go", true, false},
+		{"imported package", "/app/ui/home.go", true, false},
+		{"package not imported", "/app/examples/demo.go", false, false},
+		{"ignored file name", "/app/ui/strings_gen.go", false, false},
+		{"ignored directory name", "/app/tools/gen/main.go", false, false},
+		{"ignored path", "/app/internal/mocks/api.go", false, false},
+		{"config", "/app/drift.yaml", true, true},
+		{"bundled font", "/app/assets/fonts/Inter.ttf", true, false},
+		{"other asset", "/app/assets/fonts/Other.ttf", false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			relevant, config := f.check(fsnotify.Event{Name: tt.file, Op: fsnotify.Write})
+			if relevant != tt.wantRelevant || config != tt.wantConfig {
+				t.Errorf("check(%s) = (%v, %v), want (%v, %v)", tt.file, relevant, config, tt.wantRelevant, tt.wantConfig)
+			}
+		})
+	}
+
+	// Without a package list, every Go change counts.
+	f.pkgDirs = nil
+	if relevant, _ := f.check(fsnotify.Event{Name: "/app/examples/demo.go", Op: fsnotify.Write}); !relevant {
+		t.Error("expected changes to count when the package list is unknown")
+	}
+}
+
+func TestParseRunArgs(t *testing.T) {
+	args, opts, err := parseRunArgs([]string{"android", "--watch", "--debounce", "200ms", "--device", "emulator-5554"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(args) != 3 || args[0] != "android" || args[2] != "emulator-5554" {
+		t.Errorf("unexpected remaining args %v", args)
+	}
+	if !opts.watch || opts.debounce != 200*time.Millisecond {
+		t.Errorf("unexpected options %+v", opts)
+	}
+
+	if _, opts, _ := parseRunArgs([]string{"android"}); opts.debounce >= 0 {
+		t.Errorf("expected debounce unset without --debounce, got %v", opts.debounce)
+	}
+	for _, bad := range [][]string{{"android", "--debounce"}, {"android", "--debounce", "soon"}, {"android", "--debounce", "-1s"}} {
+		if _, _, err := parseRunArgs(bad); err == nil {
+			t.Errorf("parseRunArgs(%v): expected error", bad)
+		}
+	}
+}
@@ -16,9 +16,10 @@ import (
 )
 
 type xtoolRunOptions struct {
-	deviceID string
-	noLogs   bool
-	watch    bool
+	deviceID  string
+	noLogs    bool
+	watch     bool
+	watchOpts watchOptions
 }
 
 // parseXtoolRunArgs parses xtool-specific flags from the argument list and
@@ -97,9 +98,10 @@ func runXtool(ws *workspace.Workspace, cfg *config.Resolved, args []string, opts
 		xtoolOpts.noLogs = true
 	}
 	xtoolOpts.watch = opts.watch
+	xtoolOpts.watchOpts = opts.watchOptions("ios")
 
 	// Resolve the device once for the entire session.
-	baseDevice, err := resolveDevice(xtoolOpts.deviceID)
+	baseDevice, err := resolveDevice(xtoolOpts.deviceID, newDeviceMemory(ws.Root, cfg, memoryIOSDevice))
 	if err != nil {
 		return err
 	}
@@ -159,10 +161,12 @@ func runXtool(ws *workspace.Workspace, cfg *config.Resolved, args []string, opts
 	}
 
 	if xtoolOpts.watch {
-		return watchAndRun(ctx, ws, func() error {
+		return watchAndRun(ctx, ws, xtoolOpts.watchOpts, func(change watchChange) error {
 			killRunningApp(device, cfg.AppName)
-			if err := ws.Refresh(); err != nil {
-				return err
+			if change.config {
+				if err := ws.Refresh(); err != nil {
+					return err
+				}
 			}
 			if err := buildXtool(ws, buildOpts); err != nil {
 				return err
@@ -47,6 +47,9 @@ func runStatus(args []string) error {
 	shortHash := hex.EncodeToString(hash[:6])
 
 	fmt.Printf("Project: %s (%s)\n", cfg.AppName, cfg.AppID)
+	if cfg.Version != "" {
+		fmt.Printf("Version: %s\n", formatAppVersion(cfg.Version, cfg.BuildNumber))
+	}
 	fmt.Println()
 	fmt.Println("Platforms:")
 
@@ -3,11 +3,13 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"net"
 	"os"
 	"os/exec"
 	"os/signal"
 	"strings"
 	"syscall"
+	"time"
 
 	ios "github.com/danielpaulus/go-ios/ios"
 	"github.com/danielpaulus/go-ios/ios/syslog"
@@ -21,8 +23,9 @@ func signalContext() (context.Context, context.CancelFunc) {
 
 // streamAndroidLogs streams tag-filtered logcat output until ctx is
 // cancelled. Tag-based filtering survives app restarts, unlike PID-based.
-// When serial is non-empty, targets that specific device via `-s`.
-// Intended to run as a goroutine.
+// When serial is non-empty, targets that specific device via `-s`. For
+// devices connected over Wi-Fi, the stream resumes after the connection
+// drops. Intended to run as a goroutine.
 func streamAndroidLogs(ctx context.Context, adb, serial string) {
 	// Clear stale logs so the stream starts fresh
 	adbCommand(adb, serial, "logcat", "-c").Run()
@@ -43,10 +46,40 @@ func streamAndroidLogs(ctx context.Context, adb, serial string) {
 	if serial != "" {
 		logcatArgs = append([]string{"-s", serial}, logcatArgs...)
 	}
-	cmd := exec.CommandContext(ctx, adb, logcatArgs...)
-	cmd.Stdout = os.Stdout
-	cmd.Stderr = os.Stderr
-	cmd.Run() // exits when ctx is cancelled
+	for {
+		cmd := exec.CommandContext(ctx, adb, logcatArgs...)
+		cmd.Stdout = os.Stdout
+		cmd.Stderr = os.Stderr
+		cmd.Run() // exits when ctx is cancelled or the device disconnects
+		if ctx.Err() != nil || !isNetworkSerial(serial) {
+			return
+		}
+		fmt.Fprintf(os.Stderr, "Lost connection to %s, reconnecting...\n", serial)
+		if !reconnectAndroid(ctx, adb, serial) {
+			return
+		}
+		fmt.Fprintf(os.Stderr, "Reconnected to %s\n", serial)
+	}
+}
+
+// reconnectAndroid waits for a network device to come back, retrying adb
+// connect for host:port serials, until it is online or ctx is cancelled.
+func reconnectAndroid(ctx context.Context, adb, serial string) bool {
+	ticker := time.NewTicker(2 * time.Second)
+	defer ticker.Stop()
+	for {
+		if _, _, err := net.SplitHostPort(serial); err == nil {
+			exec.CommandContext(ctx, adb, "connect", serial).Run()
+		}
+		if out, err := adbCommand(adb, serial, "get-state").Output(); err == nil && strings.TrimSpace(string(out)) == "device" {
+			return true
+		}
+		select {
+		case <-ctx.Done():
+			return false
+		case <-ticker.C:
+		}
+	}
 }
 
 // streamDeviceLogs streams physical-device logs filtered by process name until
@@ -0,0 +1,388 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/go-drift/drift/cmd/drift/internal/config"
+)
+
+func init() {
+	RegisterCommand(&Command{
+		Name:  "test",
+		Short: "Run unit, widget, and device tests",
+		Long: `Run the project's tests and report the results together.
+
+Packages are sorted into phases by their tests:
+
+  unit     Tests that do not use the widget tester
+  widget   Tests that import github.com/go-drift/drift/pkg/testing, run
+           headless on this machine, including snapshot (golden) tests
+  device   Test files with the "device" build tag, run on a connected
+           Android device with --device
+
+Unit and widget tests run by default; give --unit or --widget to run one
+of them. Device tests are cross-compiled with the Android NDK and pushed to
+the device with adb along with the package's testdata directory, then run
+there against the device's CPU, GPU, and system libraries:
+
+  //go:build device
+
+  package app
+
+  func TestPhotoUpload(t *testing.T) { ... }
+
+Flags:
+  --unit                 Run unit tests
+  --widget               Run widget tests
+  --device [name]        Also run device tests, on the named device or
+                         serial when more than one is connected
+  --run <regexp>         Run only matching tests
+  --coverage             Write merged coverage of all phases to coverage.out
+  --coverprofile <file>  Write merged coverage to file
+  --update-snapshots     Rewrite snapshot files instead of comparing them
+  --no-fetch             Do not download Skia for device tests
+  -v, --verbose          Show all test output`,
+		Usage: "drift test [packages] [--unit] [--widget] [--device [name]] [--run regexp] [--coverage]",
+		Run:   runTest,
+	})
+}
+
+// driftTestingPackage is the widget tester's import path, whose use marks a
+// package's tests as widget tests.
+const driftTestingPackage = "github.com/go-drift/drift/pkg/testing"
+
+// deviceTestTag is the build tag of on-device test files.
+const deviceTestTag = "device"
+
+type testOptions struct {
+	packages        []string
+	unit            bool
+	widget          bool
+	device          bool
+	deviceID        string
+	run             string
+	coverprofile    string
+	updateSnapshots bool
+	noFetch         bool
+	verbose         bool
+}
+
+func parseTestArgs(args []string) (testOptions, error) {
+	var opts testOptions
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		// value returns the flag's value, from --flag=value or the next
+		// argument.
+		value := func() (string, error) {
+			if _, v, ok := strings.Cut(arg, "="); ok {
+				return v, nil
+			}
+			if i+1 >= len(args) {
+				return "", fmt.Errorf("%s requires a value", arg)
+			}
+			i++
+			return args[i], nil
+		}
+		name, _, _ := strings.Cut(arg, "=")
+		var err error
+		switch name {
+		case "--unit":
+			opts.unit = true
+		case "--widget":
+			opts.widget = true
+		case "--device":
+			opts.device = true
+			if v, ok := strings.CutPrefix(arg, "--device="); ok {
+				opts.deviceID = v
+			} else if i+1 < len(args) && !strings.HasPrefix(args[i+1], "-") && !isPackagePattern(args[i+1]) {
+				i++
+				opts.deviceID = args[i]
+			}
+		case "--run":
+			opts.run, err = value()
+		case "--coverage":
+			if opts.coverprofile == "" {
+				opts.coverprofile = "coverage.out"
+			}
+		case "--coverprofile":
+			opts.coverprofile, err = value()
+		case "--update-snapshots":
+			opts.updateSnapshots = true
+		case "--no-fetch":
+			opts.noFetch = true
+		case "-v", "--verbose":
+			opts.verbose = true
+		default:
+			if strings.HasPrefix(arg, "-") {
+				return opts, fmt.Errorf("unknown flag %q", arg)
+			}
+			opts.packages = append(opts.packages, arg)
+		}
+		if err != nil {
+			return opts, err
+		}
+	}
+	if !opts.unit && !opts.widget {
+		opts.unit, opts.widget = true, true
+	}
+	if len(opts.packages) == 0 {
+		opts.packages = []string{"./..."}
+	}
+	return opts, nil
+}
+
+// isPackagePattern reports whether arg looks like a package pattern rather
+// than a device name.
+func isPackagePattern(arg string) bool {
+	return strings.HasPrefix(arg, ".") || strings.Contains(arg, "/")
+}
+
+func runTest(args []string) error {
+	opts, err := parseTestArgs(args)
+	if err != nil {
+		return err
+	}
+
+	root, err := config.FindProjectRoot()
+	if err != nil {
+		return err
+	}
+
+	pkgs, err := listTestPackages(root, opts.packages)
+	if err != nil {
+		return err
+	}
+	unit, widget, device := classifyTestPackages(pkgs)
+
+	coverDir := ""
+	if opts.coverprofile != "" {
+		if coverDir, err = os.MkdirTemp("", "drift-cover-"); err != nil {
+			return err
+		}
+		defer os.RemoveAll(coverDir)
+	}
+
+	var reports []*testReport
+	var errs []error
+	phase := func(name string, pkgs []string, run func(*testReport, string) error) {
+		fmt.Printf("\nRunning %s tests", name)
+		if len(pkgs) == 0 {
+			fmt.Println(": no packages")
+			return
+		}
+		if len(pkgs) == 1 {
+			fmt.Println(" (1 package)...")
+		} else {
+			fmt.Printf(" (%d packages)...\n", len(pkgs))
+		}
+		report := newTestReport(name, opts.verbose, os.Stdout)
+		coverFile := ""
+		if coverDir != "" {
+			coverFile = filepath.Join(coverDir, name+".out")
+		}
+		if err := run(report, coverFile); err != nil {
+			errs = append(errs, fmt.Errorf("%s tests: %w", name, err))
+		}
+		reports = append(reports, report)
+	}
+
+	if opts.unit {
+		phase("unit", unit, func(r *testReport, cover string) error {
+			return runHostTests(root, unit, opts, cover, r)
+		})
+	}
+	if opts.widget {
+		phase("widget", widget, func(r *testReport, cover string) error {
+			return runHostTests(root, widget, opts, cover, r)
+		})
+	}
+	if opts.device {
+		phase("device", device, func(r *testReport, cover string) error {
+			return runDeviceTests(root, pkgs, device, opts, cover, r)
+		})
+	}
+
+	for _, r := range reports {
+		r.printFailures(os.Stdout)
+	}
+	if len(reports) > 0 {
+		printTestSummary(os.Stdout, reports)
+	}
+
+	if opts.coverprofile != "" {
+		if err := writeMergedCoverage(root, coverDir, opts.coverprofile); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	failed := 0
+	for _, r := range reports {
+		failed += r.failed
+	}
+	if failed > 0 {
+		errs = append(errs, fmt.Errorf("tests failed: %d", failed))
+	}
+	return errors.Join(errs...)
+}
+
+// testPackage is the part of go list's output used to sort packages into
+// phases.
+type testPackage struct {
+	ImportPath   string
+	Dir          string
+	TestGoFiles  []string
+	XTestGoFiles []string
+	TestImports  []string
+	XTestImports []string
+	// DeviceTestGoFiles lists the test files built only with the device
+	// tag.
+	DeviceTestGoFiles []string `json:"-"`
+}
+
+func (p testPackage) hasTests() bool {
+	return len(p.TestGoFiles)+len(p.XTestGoFiles) > 0
+}
+
+// listTestPackages lists the packages matching patterns, and finds their
+// device test files by listing them again with the device tag.
+func listTestPackages(root string, patterns []string) ([]testPackage, error) {
+	pkgs, err := goListTestPackages(root, nil, patterns)
+	if err != nil {
+		return nil, err
+	}
+	tagged, err := goListTestPackages(root, []string{"-tags", deviceTestTag}, patterns)
+	if err != nil {
+		return nil, err
+	}
+	byPath := make(map[string]testPackage, len(tagged))
+	for _, p := range tagged {
+		byPath[p.ImportPath] = p
+	}
+	for i, p := range pkgs {
+		for _, f := range append(byPath[p.ImportPath].TestGoFiles, byPath[p.ImportPath].XTestGoFiles...) {
+			if !slices.Contains(p.TestGoFiles, f) && !slices.Contains(p.XTestGoFiles, f) {
+				pkgs[i].DeviceTestGoFiles = append(pkgs[i].DeviceTestGoFiles, f)
+			}
+		}
+	}
+	return pkgs, nil
+}
+
+func goListTestPackages(root string, flags, patterns []string) ([]testPackage, error) {
+	args := append([]string{"list", "-e", "-json=ImportPath,Dir,TestGoFiles,XTestGoFiles,TestImports,XTestImports"}, flags...)
+	cmd := exec.Command("go", append(args, patterns...)...)
+	cmd.Dir = root
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("go list failed: %w\n%s", err, stderr.String())
+	}
+	return decodeTestPackages(bytes.NewReader(out))
+}
+
+// decodeTestPackages decodes go list -json output, a stream of objects.
+func decodeTestPackages(r io.Reader) ([]testPackage, error) {
+	var pkgs []testPackage
+	dec := json.NewDecoder(r)
+	for {
+		var p testPackage
+		if err := dec.Decode(&p); err == io.EOF {
+			return pkgs, nil
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to parse go list output: %w", err)
+		}
+		pkgs = append(pkgs, p)
+	}
+}
+
+// classifyTestPackages sorts packages with tests into unit and widget
+// phases, and returns those with device test files as the device phase. A
+// package can be in the device phase and one of the others.
+func classifyTestPackages(pkgs []testPackage) (unit, widget, device []string) {
+	for _, p := range pkgs {
+		if p.hasTests() {
+			if slices.Contains(p.TestImports, driftTestingPackage) || slices.Contains(p.XTestImports, driftTestingPackage) {
+				widget = append(widget, p.ImportPath)
+			} else {
+				unit = append(unit, p.ImportPath)
+			}
+		}
+		if len(p.DeviceTestGoFiles) > 0 {
+			device = append(device, p.ImportPath)
+		}
+	}
+	return unit, widget, device
+}
+
+// runHostTests runs go test -json on pkgs and feeds the results to report.
+func runHostTests(root string, pkgs []string, opts testOptions, coverFile string, report *testReport) error {
+	args := []string{"test", "-json"}
+	if opts.run != "" {
+		args = append(args, "-run", opts.run)
+	}
+	if coverFile != "" {
+		args = append(args, "-covermode=count", "-coverpkg=./...", "-coverprofile="+coverFile)
+	}
+	cmd := exec.Command("go", append(args, pkgs...)...)
+	cmd.Dir = root
+	cmd.Env = os.Environ()
+	if opts.updateSnapshots {
+		cmd.Env = append(cmd.Env, "DRIFT_UPDATE_SNAPSHOTS=1")
+	}
+	cmd.Stderr = os.Stderr
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return err
+	}
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+	readErr := report.read(stdout)
+	if err := cmd.Wait(); err != nil && report.ok() {
+		// Failing tests make go test exit with an error, which the report
+		// already shows. Anything else, such as a bad flag, is returned.
+		return fmt.Errorf("go test failed: %w", err)
+	}
+	return readErr
+}
+
+// writeMergedCoverage merges the phases' coverage profiles in dir into
+// path, relative to the project root, and prints the total.
+func writeMergedCoverage(root, dir, path string) error {
+	profile := newCoverProfile()
+	files, err := filepath.Glob(filepath.Join(dir, "*.out"))
+	if err != nil {
+		return err
+	}
+	for _, f := range files {
+		if err := profile.mergeFile(f); err != nil {
+			return err
+		}
+	}
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(root, path)
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to write coverage: %w", err)
+	}
+	if err := profile.write(f); err != nil {
+		f.Close()
+		return fmt.Errorf("failed to write coverage: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("failed to write coverage: %w", err)
+	}
+	fmt.Printf("\nCoverage: %.1f%% of statements (%s)\n", profile.percent(), path)
+	return nil
+}
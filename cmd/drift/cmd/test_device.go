@@ -0,0 +1,214 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-drift/drift/cmd/drift/internal/config"
+)
+
+// deviceTestDir is where test binaries are pushed on Android devices.
+// /data/local/tmp is writable and executable for the shell user.
+const deviceTestDir = "/data/local/tmp/drift-test"
+
+// runDeviceTests cross-compiles the device tests of pkgs for the connected
+// Android device, runs each package's test binary there, and feeds the
+// results to report.
+func runDeviceTests(root string, all []testPackage, pkgs []string, opts testOptions, coverFile string, report *testReport) error {
+	cfg, err := config.Resolve(root)
+	if err != nil {
+		return err
+	}
+	adb := findADB()
+	serial, err := resolveAndroidDevice(adb, opts.deviceID, newDeviceMemory(root, cfg, memoryAndroid))
+	if err != nil {
+		return err
+	}
+	abi, err := deviceTestABI(detectDeviceABI(adb, serial))
+	if err != nil {
+		return err
+	}
+	fmt.Printf("  Device %s (%s)\n", serial, abi.abi)
+
+	toolchain, sysrootLib, err := findNDKToolchain()
+	if err != nil {
+		return err
+	}
+	_, skiaDir, err := findSkiaLib(root, "android", abi.skiaArch, opts.noFetch)
+	if err != nil {
+		return err
+	}
+	env := androidGoEnv(abi, toolchain, skiaDir)
+
+	buildDir, err := os.MkdirTemp("", "drift-device-test-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(buildDir)
+
+	if err := adbCommand(adb, serial, "shell", "rm -rf "+deviceTestDir+" && mkdir -p "+deviceTestDir).Run(); err != nil {
+		return fmt.Errorf("failed to create %s on device: %w", deviceTestDir, err)
+	}
+	defer adbCommand(adb, serial, "shell", "rm", "-rf", deviceTestDir).Run()
+
+	// The test binaries link Skia dynamically against libc++.
+	if cppShared, _ := androidCppShared(abi, sysrootLib, skiaDir); cppShared != "" {
+		if err := adbPush(adb, serial, cppShared, deviceTestDir+"/libc++_shared.so"); err != nil {
+			return err
+		}
+	}
+
+	dirs := make(map[string]string, len(all))
+	for _, p := range all {
+		dirs[p.ImportPath] = p.Dir
+	}
+
+	var coverFiles []string
+	for i, pkg := range pkgs {
+		name := fmt.Sprintf("pkg%d", i)
+		binary := filepath.Join(buildDir, name+".test")
+		if err := buildDeviceTest(root, pkg, binary, env, coverFile != "", report); err != nil {
+			return err
+		}
+		if _, err := os.Stat(binary); err != nil {
+			// The build failed, which the report shows.
+			continue
+		}
+
+		remoteDir := path.Join(deviceTestDir, name)
+		if err := adbPush(adb, serial, binary, remoteDir+"/"+name+".test"); err != nil {
+			return err
+		}
+		if testdata := filepath.Join(dirs[pkg], "testdata"); isDir(testdata) {
+			if err := adbPush(adb, serial, testdata, remoteDir+"/testdata"); err != nil {
+				return err
+			}
+		}
+
+		remoteCover := ""
+		if coverFile != "" {
+			remoteCover = remoteDir + "/cover.out"
+		}
+		if err := runDeviceTestBinary(adb, serial, pkg, remoteDir, name+".test", remoteCover, opts, report); err != nil {
+			return err
+		}
+		if remoteCover != "" {
+			local := filepath.Join(buildDir, name+".cover")
+			if err := adbCommand(adb, serial, "pull", remoteCover, local).Run(); err == nil {
+				coverFiles = append(coverFiles, local)
+			}
+		}
+	}
+
+	if coverFile != "" {
+		profile := newCoverProfile()
+		for _, f := range coverFiles {
+			if err := profile.mergeFile(f); err != nil {
+				return err
+			}
+		}
+		f, err := os.Create(coverFile)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		return profile.write(f)
+	}
+	return nil
+}
+
+// deviceTestABI returns the build settings for the device's ABI.
+func deviceTestABI(name string) (androidABI, error) {
+	if name == "" {
+		return androidABI{}, fmt.Errorf("could not detect the device's ABI")
+	}
+	for _, abi := range androidABIs {
+		if abi.abi == name {
+			return abi, nil
+		}
+	}
+	return androidABI{}, fmt.Errorf("unsupported Android ABI %q", name)
+}
+
+// buildDeviceTest compiles pkg's tests with the device tag into binary. A
+// compile error is added to the report as a failed package.
+func buildDeviceTest(root, pkg, binary string, env []string, cover bool, report *testReport) error {
+	args := []string{"test", "-c", "-tags", deviceTestTag, "-o", binary}
+	if cover {
+		args = append(args, "-covermode=count", "-coverpkg=./...")
+	}
+	cmd := exec.Command("go", append(args, pkg)...)
+	cmd.Dir = root
+	cmd.Env = env
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		if _, ok := err.(*exec.ExitError); !ok {
+			return fmt.Errorf("failed to run go test: %w", err)
+		}
+		report.add(testEvent{Action: "output", Package: pkg, Output: string(out)})
+		report.add(testEvent{Action: "fail", Package: pkg})
+	}
+	return nil
+}
+
+// runDeviceTestBinary runs a pushed test binary in its directory on the
+// device and converts its output to events with go tool test2json.
+func runDeviceTestBinary(adb, serial, pkg, dir, binary, coverFile string, opts testOptions, report *testReport) error {
+	args := []string{"-test.v=test2json"}
+	if opts.run != "" {
+		args = append(args, "-test.run="+opts.run)
+	}
+	if coverFile != "" {
+		args = append(args, "-test.coverprofile="+coverFile)
+	}
+	script := fmt.Sprintf("cd %s && LD_LIBRARY_PATH=%s ./%s", dir, deviceTestDir, binary)
+	for _, a := range args {
+		script += " " + shellQuote(a)
+	}
+	if opts.updateSnapshots {
+		script = "DRIFT_UPDATE_SNAPSHOTS=1 " + script
+	}
+
+	// test2json runs adb itself, so a binary that crashes before reporting
+	// its tests still fails the package through adb's exit status.
+	adbArgs := adbCommand(adb, serial, "shell", script).Args
+	convert := exec.Command("go", append([]string{"tool", "test2json", "-t", "-p", pkg}, adbArgs...)...)
+	convert.Stderr = os.Stderr
+	events, err := convert.StdoutPipe()
+	if err != nil {
+		return err
+	}
+	if err := convert.Start(); err != nil {
+		return fmt.Errorf("failed to run tests on device: %w", err)
+	}
+	readErr := report.read(events)
+	// A failing package makes test2json exit with an error, which the
+	// report already shows.
+	convert.Wait()
+	return readErr
+}
+
+func adbPush(adb, serial, local, remote string) error {
+	cmd := adbCommand(adb, serial, "push", local, remote)
+	cmd.Stdout = io.Discard
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("failed to push %s to device: %w", filepath.Base(local), err)
+	}
+	return nil
+}
+
+func isDir(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && info.IsDir()
+}
+
+// shellQuote quotes s for the device shell.
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
@@ -0,0 +1,316 @@
+package cmd
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// testEvent is an event from go test -json (see go doc test2json).
+type testEvent struct {
+	Time        time.Time
+	Action      string
+	Package     string
+	ImportPath  string
+	Test        string
+	Elapsed     float64
+	Output      string
+	FailedBuild string
+}
+
+// testFailure is a failed test, or a package that failed without a failing
+// test, such as a build failure or a panic during init.
+type testFailure struct {
+	pkg    string
+	test   string
+	output string
+}
+
+// testReport collects go test -json events for one phase and prints a line
+// for each package as it finishes.
+type testReport struct {
+	phase    string
+	verbose  bool
+	out      io.Writer
+	packages int
+	passed   int
+	failed   int
+	skipped  int
+	elapsed  time.Duration
+	failures []testFailure
+
+	// output holds the output of running tests and packages, keyed by
+	// package and test name, until they finish.
+	output map[[2]string]*strings.Builder
+	// failedTests counts failed tests per package, so a package failure
+	// without one is reported by itself.
+	failedTests map[string]int
+	// buildOutput holds compiler output by import path.
+	buildOutput map[string]*strings.Builder
+}
+
+func newTestReport(phase string, verbose bool, out io.Writer) *testReport {
+	return &testReport{
+		phase:       phase,
+		verbose:     verbose,
+		out:         out,
+		output:      make(map[[2]string]*strings.Builder),
+		failedTests: make(map[string]int),
+		buildOutput: make(map[string]*strings.Builder),
+	}
+}
+
+// read consumes a stream of JSON events. Lines that are not events, such as
+// output from a test binary that was not converted, are printed as is.
+func (r *testReport) read(in io.Reader) error {
+	scanner := bufio.NewScanner(in)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		var ev testEvent
+		if len(line) == 0 || line[0] != '{' || json.Unmarshal(line, &ev) != nil {
+			fmt.Fprintf(r.out, "%s\n", line)
+			continue
+		}
+		r.add(ev)
+	}
+	return scanner.Err()
+}
+
+func (r *testReport) add(ev testEvent) {
+	switch ev.Action {
+	case "build-output":
+		b := r.buildOutput[ev.ImportPath]
+		if b == nil {
+			b = &strings.Builder{}
+			r.buildOutput[ev.ImportPath] = b
+		}
+		b.WriteString(ev.Output)
+		return
+	case "build-fail":
+		return
+	}
+
+	key := [2]string{ev.Package, ev.Test}
+	switch ev.Action {
+	case "output":
+		if r.verbose {
+			fmt.Fprint(r.out, ev.Output)
+		}
+		b := r.output[key]
+		if b == nil {
+			b = &strings.Builder{}
+			r.output[key] = b
+		}
+		b.WriteString(ev.Output)
+	case "pass", "fail", "skip":
+		output := ""
+		if b := r.output[key]; b != nil {
+			output = b.String()
+			delete(r.output, key)
+		}
+		if ev.Test == "" {
+			r.finishPackage(ev, output)
+		} else {
+			r.finishTest(ev, output)
+		}
+	}
+}
+
+func (r *testReport) finishTest(ev testEvent, output string) {
+	// Subtests are counted with their parent, but their failures are
+	// reported by themselves since their output is their own.
+	sub := strings.Contains(ev.Test, "/")
+	switch ev.Action {
+	case "pass":
+		if !sub {
+			r.passed++
+		}
+	case "skip":
+		if !sub {
+			r.skipped++
+		}
+	case "fail":
+		if !sub {
+			r.failed++
+		}
+		r.failedTests[ev.Package]++
+		// A parent whose subtests failed only repeats their output.
+		for _, f := range r.failures {
+			if f.pkg == ev.Package && strings.HasPrefix(f.test, ev.Test+"/") {
+				return
+			}
+		}
+		r.failures = append(r.failures, testFailure{pkg: ev.Package, test: ev.Test, output: output})
+	}
+}
+
+func (r *testReport) finishPackage(ev testEvent, output string) {
+	elapsed := time.Duration(ev.Elapsed * float64(time.Second))
+	status := "ok  "
+	switch ev.Action {
+	case "skip":
+		// A package without test files.
+		return
+	case "fail":
+		status = "FAIL"
+		if r.failedTests[ev.Package] == 0 {
+			if b := r.buildOutput[ev.FailedBuild]; ev.FailedBuild != "" && b != nil {
+				output = b.String() + output
+			}
+			r.failed++
+			r.failures = append(r.failures, testFailure{pkg: ev.Package, output: output})
+		}
+	}
+	r.packages++
+	r.elapsed += elapsed
+	fmt.Fprintf(r.out, "  %s %s (%s)\n", status, ev.Package, formatTestElapsed(elapsed))
+}
+
+// ok reports whether nothing failed.
+func (r *testReport) ok() bool {
+	return r.failed == 0
+}
+
+// printFailures writes each failure and its output, which go test
+// already indents.
+func (r *testReport) printFailures(w io.Writer) {
+	for _, f := range r.failures {
+		name := f.pkg
+		if f.test != "" {
+			name += " " + f.test
+		}
+		fmt.Fprintf(w, "\n--- FAIL [%s] %s\n", r.phase, name)
+		if out := strings.TrimRight(f.output, "\n"); out != "" {
+			fmt.Fprintln(w, out)
+		}
+	}
+}
+
+// printTestSummary writes a table of the phases' results.
+func printTestSummary(w io.Writer, reports []*testReport) {
+	fmt.Fprintf(w, "\n%-8s %8s %8s %8s %8s %10s\n", "Phase", "Packages", "Passed", "Failed", "Skipped", "Time")
+	var total testReport
+	for _, r := range reports {
+		fmt.Fprintf(w, "%-8s %8d %8d %8d %8d %10s\n", r.phase, r.packages, r.passed, r.failed, r.skipped, formatTestElapsed(r.elapsed))
+		total.packages += r.packages
+		total.passed += r.passed
+		total.failed += r.failed
+		total.skipped += r.skipped
+		total.elapsed += r.elapsed
+	}
+	if len(reports) > 1 {
+		fmt.Fprintf(w, "%-8s %8d %8d %8d %8d %10s\n", "total", total.packages, total.passed, total.failed, total.skipped, formatTestElapsed(total.elapsed))
+	}
+}
+
+func formatTestElapsed(d time.Duration) string {
+	return strconv.FormatFloat(d.Seconds(), 'f', 2, 64) + "s"
+}
+
+// coverProfile is a merged coverage profile.
+type coverProfile struct {
+	mode string
+	// blocks maps "file:start,end numStmts" to the block's count.
+	blocks map[string]int
+}
+
+func newCoverProfile() *coverProfile {
+	return &coverProfile{blocks: make(map[string]int)}
+}
+
+// merge adds a profile written by go test -coverprofile. The same block
+// reported by several profiles, as happens with -coverpkg, is counted
+// once in set mode and summed otherwise.
+func (p *coverProfile) merge(in io.Reader) error {
+	scanner := bufio.NewScanner(in)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		if mode, ok := strings.CutPrefix(line, "mode: "); ok {
+			if p.mode != "" && p.mode != mode {
+				return fmt.Errorf("cannot merge coverage mode %q into %q", mode, p.mode)
+			}
+			p.mode = mode
+			continue
+		}
+		i := strings.LastIndexByte(line, ' ')
+		if i < 0 {
+			return fmt.Errorf("malformed coverage line %q", line)
+		}
+		count, err := strconv.Atoi(line[i+1:])
+		if err != nil {
+			return fmt.Errorf("malformed coverage line %q", line)
+		}
+		block := line[:i]
+		if p.mode == "set" {
+			p.blocks[block] = max(p.blocks[block], count)
+		} else {
+			p.blocks[block] += count
+		}
+	}
+	return scanner.Err()
+}
+
+// mergeFile merges the profile at path. A missing file is ignored, since
+// go test writes none when every package fails to build.
+func (p *coverProfile) mergeFile(path string) error {
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if err := p.merge(f); err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	return nil
+}
+
+// write writes the profile in go test's format, sorted by block.
+func (p *coverProfile) write(w io.Writer) error {
+	mode := p.mode
+	if mode == "" {
+		mode = "set"
+	}
+	blocks := make([]string, 0, len(p.blocks))
+	for block := range p.blocks {
+		blocks = append(blocks, block)
+	}
+	sort.Strings(blocks)
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, "mode: %s\n", mode)
+	for _, block := range blocks {
+		fmt.Fprintf(bw, "%s %d\n", block, p.blocks[block])
+	}
+	return bw.Flush()
+}
+
+// percent returns the percentage of statements covered.
+func (p *coverProfile) percent() float64 {
+	var total, covered int
+	for block, count := range p.blocks {
+		n, err := strconv.Atoi(block[strings.LastIndexByte(block, ' ')+1:])
+		if err != nil {
+			continue
+		}
+		total += n
+		if count > 0 {
+			covered += n
+		}
+	}
+	if total == 0 {
+		return 0
+	}
+	return 100 * float64(covered) / float64(total)
+}
@@ -0,0 +1,151 @@
+package cmd
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestParseTestArgs(t *testing.T) {
+	opts, err := parseTestArgs([]string{"--device", "Pixel", "./ui/...", "--run=TestLogin", "--coverage"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !opts.unit || !opts.widget || !opts.device || opts.deviceID != "Pixel" {
+		t.Errorf("phases = unit %v, widget %v, device %v %q", opts.unit, opts.widget, opts.device, opts.deviceID)
+	}
+	if opts.run != "TestLogin" || opts.coverprofile != "coverage.out" || !slices.Equal(opts.packages, []string{"./ui/..."}) {
+		t.Errorf("opts = %+v", opts)
+	}
+
+	// A package pattern after --device is not a device name.
+	opts, err = parseTestArgs([]string{"--widget", "--device", "./ui"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if opts.unit || !opts.widget || opts.deviceID != "" || !slices.Equal(opts.packages, []string{"./ui"}) {
+		t.Errorf("opts = %+v", opts)
+	}
+
+	if _, err := parseTestArgs([]string{"--run"}); err == nil {
+		t.Error("expected an error for --run without a value")
+	}
+}
+
+func TestDecodeAndClassifyTestPackages(t *testing.T) {
+	pkgs, err := decodeTestPackages(strings.NewReader(`{
+	"ImportPath": "example.com/app/model",
+	"TestGoFiles": ["model_test.go"],
+	"TestImports": ["testing"]
+}
+{
+	"ImportPath": "example.com/app/ui",
+	"XTestGoFiles": ["ui_test.go"],
+	"XTestImports": ["github.com/go-drift/drift/pkg/testing", "testing"]
+}
+{
+	"ImportPath": "example.com/app"
+}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkgs[2].DeviceTestGoFiles = []string{"upload_device_test.go"}
+
+	unit, widget, device := classifyTestPackages(pkgs)
+	if !slices.Equal(unit, []string{"example.com/app/model"}) {
+		t.Errorf("unit = %v", unit)
+	}
+	if !slices.Equal(widget, []string{"example.com/app/ui"}) {
+		t.Errorf("widget = %v", widget)
+	}
+	if !slices.Equal(device, []string{"example.com/app"}) {
+		t.Errorf("device = %v", device)
+	}
+}
+
+func TestTestReport(t *testing.T) {
+	events := `{"Action":"start","Package":"example.com/app/ui"}
+{"Action":"run","Package":"example.com/app/ui","Test":"TestButton"}
+{"Action":"output","Package":"example.com/app/ui","Test":"TestButton","Output":"=== RUN   TestButton\n"}
+{"Action":"pass","Package":"example.com/app/ui","Test":"TestButton","Elapsed":0.01}
+{"Action":"run","Package":"example.com/app/ui","Test":"TestForm"}
+{"Action":"run","Package":"example.com/app/ui","Test":"TestForm/empty"}
+{"Action":"output","Package":"example.com/app/ui","Test":"TestForm/empty","Output":"    form_test.go:12: snapshot mismatch\n"}
+{"Action":"fail","Package":"example.com/app/ui","Test":"TestForm/empty","Elapsed":0}
+{"Action":"fail","Package":"example.com/app/ui","Test":"TestForm","Elapsed":0}
+{"Action":"skip","Package":"example.com/app/ui","Test":"TestSlow","Elapsed":0}
+{"Action":"fail","Package":"example.com/app/ui","Elapsed":1.5}
+{"ImportPath":"example.com/app/broken [example.com/app/broken.test]","Action":"build-output","Output":"broken.go:3:1: syntax error\n"}
+{"ImportPath":"example.com/app/broken [example.com/app/broken.test]","Action":"build-fail"}
+{"Action":"start","Package":"example.com/app/broken"}
+{"Action":"output","Package":"example.com/app/broken","Output":"FAIL\texample.com/app/broken [build failed]\n"}
+{"Action":"fail","Package":"example.com/app/broken","Elapsed":0,"FailedBuild":"example.com/app/broken [example.com/app/broken.test]"}
+{"Action":"skip","Package":"example.com/app/empty","Elapsed":0}
+`
+	var out strings.Builder
+	r := newTestReport("widget", false, &out)
+	if err := r.read(strings.NewReader(events)); err != nil {
+		t.Fatal(err)
+	}
+	if r.packages != 2 || r.passed != 1 || r.failed != 2 || r.skipped != 1 {
+		t.Errorf("packages %d, passed %d, failed %d, skipped %d; want 2, 1, 2, 1", r.packages, r.passed, r.failed, r.skipped)
+	}
+	if want := "  FAIL example.com/app/ui (1.50s)\n  FAIL example.com/app/broken (0.00s)\n"; out.String() != want {
+		t.Errorf("output = %q, want %q", out.String(), want)
+	}
+
+	// The parent of a failed subtest is not reported again.
+	if len(r.failures) != 2 || r.failures[0].test != "TestForm/empty" || r.failures[1].test != "" {
+		t.Fatalf("failures = %+v", r.failures)
+	}
+	if !strings.Contains(r.failures[1].output, "syntax error") {
+		t.Errorf("build failure output = %q", r.failures[1].output)
+	}
+
+	var failures strings.Builder
+	r.printFailures(&failures)
+	if !strings.Contains(failures.String(), "--- FAIL [widget] example.com/app/ui TestForm/empty\n    form_test.go:12: snapshot mismatch\n") {
+		t.Errorf("failures = %q", failures.String())
+	}
+}
+
+func TestCoverProfileMerge(t *testing.T) {
+	unit := `mode: count
+example.com/app/model/model.go:5.20,7.2 2 3
+example.com/app/ui/ui.go:10.30,12.2 1 0
+`
+	widget := `mode: count
+example.com/app/model/model.go:5.20,7.2 2 1
+example.com/app/ui/ui.go:10.30,12.2 1 4
+example.com/app/ui/ui.go:14.30,20.2 5 0
+`
+	p := newCoverProfile()
+	for _, in := range []string{unit, widget} {
+		if err := p.merge(strings.NewReader(in)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var out strings.Builder
+	if err := p.write(&out); err != nil {
+		t.Fatal(err)
+	}
+	want := `mode: count
+example.com/app/model/model.go:5.20,7.2 2 4
+example.com/app/ui/ui.go:10.30,12.2 1 4
+example.com/app/ui/ui.go:14.30,20.2 5 0
+`
+	if out.String() != want {
+		t.Errorf("merged profile:\n%s\nwant:\n%s", out.String(), want)
+	}
+	if got := p.percent(); got < 37.4 || got > 37.6 {
+		t.Errorf("percent = %.2f, want 37.5", got)
+	}
+
+	if err := p.merge(strings.NewReader("mode: set\n")); err == nil {
+		t.Error("expected an error merging a different mode")
+	}
+	if err := newCoverProfile().merge(strings.NewReader("mode: set\nbad line\n")); err == nil {
+		t.Error("expected an error for a malformed line")
+	}
+}
@@ -0,0 +1,340 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/go-drift/drift/cmd/drift/internal/config"
+	"github.com/go-drift/drift/cmd/drift/internal/scaffold"
+	"github.com/go-drift/drift/cmd/drift/internal/workspace"
+)
+
+func init() {
+	RegisterCommand(&Command{
+		Name:  "version",
+		Short: "Show the CLI version or bump the app version",
+		Long: `Show the Drift CLI version, or bump the app version in drift.yaml.
+
+  drift version                 Show the CLI version and the app version
+  drift version bump major      1.4.2 (41) -> 2.0.0 (42)
+  drift version bump minor      1.4.2 (41) -> 1.5.0 (42)
+  drift version bump patch      1.4.2 (41) -> 1.4.3 (42)
+  drift version bump build      1.4.2 (41) -> 1.4.2 (42)
+
+The app version is app.version in drift.yaml, shown to users as versionName
+on Android and CFBundleShortVersionString on iOS. The build number is
+app.build, used as versionCode and CFBundleVersion; every bump increments it
+since both stores require it to increase with each upload. Managed builds
+pick up the new version automatically, and ejected platforms are updated in
+place.
+
+If changelog.file is set in drift.yaml, the notes under its "Unreleased"
+heading move under a heading for the new version, and the release notes
+files listed in changelog.store_notes are written from them:
+
+  changelog:
+    file: CHANGELOG.md
+    heading: "## {{.Version}} ({{.Build}}) - {{.Date}}"
+    store_notes:
+      - path: fastlane/metadata/android/en-US/changelogs/{{.Build}}.txt
+        max_length: 500
+      - path: fastlane/metadata/en-US/release_notes.txt
+
+Templates can use {{.Version}}, {{.Build}}, {{.PreviousVersion}},
+{{.Date}}, {{.AppName}} and {{.Notes}}.
+
+Flags:
+  --no-changelog   Bump the version without touching the changelog`,
+		Usage: "drift version [bump major|minor|patch|build] [--no-changelog]",
+		Run:   runVersion,
+	})
+}
+
+func runVersion(args []string) error {
+	if len(args) == 0 {
+		fmt.Printf("Drift CLI version %s (built %s)\n", Version, BuildTime)
+		// Show the app version too when run inside a project.
+		if root, err := config.FindProjectRoot(); err == nil {
+			if cfg, err := config.Resolve(root); err == nil && cfg.Version != "" {
+				fmt.Printf("%s version %s\n", cfg.AppName, formatAppVersion(cfg.Version, cfg.BuildNumber))
+			}
+		}
+		return nil
+	}
+	if args[0] != "bump" {
+		return fmt.Errorf("unknown version command %q\n\nUsage: drift version bump major|minor|patch|build", args[0])
+	}
+
+	var part string
+	noChangelog := false
+	for _, arg := range args[1:] {
+		switch arg {
+		case "--no-changelog":
+			noChangelog = true
+		case "major", "minor", "patch", "build":
+			part = arg
+		default:
+			return fmt.Errorf("unknown argument %q\n\nUsage: drift version bump major|minor|patch|build", arg)
+		}
+	}
+	if part == "" {
+		return fmt.Errorf("specify what to bump: major, minor, patch, or build")
+	}
+
+	root, err := config.FindProjectRoot()
+	if err != nil {
+		return err
+	}
+	cfg, err := config.Resolve(root)
+	if err != nil {
+		return err
+	}
+
+	version, build, err := bumpVersion(cfg.Version, cfg.BuildNumber, part)
+	if err != nil {
+		return err
+	}
+
+	// Prepare the changelog first so a problem with it leaves everything
+	// unchanged.
+	var release *releaseNotes
+	if cfg.Changelog.File != "" && !noChangelog {
+		release, err = prepareReleaseNotes(root, cfg, version, build)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := config.SetAppVersion(root, version, build); err != nil {
+		return err
+	}
+	fmt.Printf("Bumped %s: %s -> %s\n", cfg.AppName,
+		formatAppVersion(displayVersion(cfg.Version), max(cfg.BuildNumber, 1)), formatAppVersion(version, build))
+
+	for _, platform := range []string{"android", "ios", "xtool"} {
+		if !workspace.IsEjected(root, platform) {
+			continue
+		}
+		dir := workspace.EjectedBuildDir(root, platform)
+		var err error
+		switch platform {
+		case "android":
+			err = scaffold.ApplyAndroidVersion(dir, version, build)
+		case "ios":
+			err = scaffold.ApplyIOSVersion(dir, version, build)
+		case "xtool":
+			err = scaffold.ApplyXtoolVersion(dir, version, build)
+		}
+		if err != nil {
+			return err
+		}
+		fmt.Printf("  Updated ejected %s project\n", platform)
+	}
+
+	if release != nil {
+		if err := release.write(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// displayVersion returns the version an app without app.version has, as
+// set by the project templates.
+func displayVersion(version string) string {
+	if version == "" {
+		return "1.0"
+	}
+	return version
+}
+
+func formatAppVersion(version string, build int) string {
+	if build == 0 {
+		return version
+	}
+	return fmt.Sprintf("%s (%d)", version, build)
+}
+
+// bumpVersion returns the version and build number after bumping part,
+// which is "major", "minor", "patch", or "build". Semantic bumps reset the
+// lower components and always produce three components. The build number
+// is incremented in every case; an unset build number counts as 1.
+func bumpVersion(version string, build int, part string) (string, int, error) {
+	nums, err := config.ParseAppVersion(displayVersion(version))
+	if err != nil {
+		return "", 0, err
+	}
+	build = max(build, 1) + 1
+	if build > config.MaxBuildNumber {
+		return "", 0, fmt.Errorf("build number would exceed %d", config.MaxBuildNumber)
+	}
+	if part == "build" {
+		return displayVersion(version), build, nil
+	}
+	for len(nums) < 3 {
+		nums = append(nums, 0)
+	}
+	switch part {
+	case "major":
+		nums = []int{nums[0] + 1, 0, 0}
+	case "minor":
+		nums = []int{nums[0], nums[1] + 1, 0}
+	case "patch":
+		nums[2]++
+	default:
+		return "", 0, fmt.Errorf("unknown version part %q (use major, minor, patch, or build)", part)
+	}
+	return fmt.Sprintf("%d.%d.%d", nums[0], nums[1], nums[2]), build, nil
+}
+
+// releaseData is available to changelog and store notes templates.
+type releaseData struct {
+	AppName         string
+	Version         string
+	PreviousVersion string
+	Build           int
+	Date            string
+	Notes           string
+}
+
+// releaseNotes holds the files to write for a release.
+type releaseNotes struct {
+	root     string
+	files    []releaseFile
+	warnings []string
+}
+
+// releaseFile is a file to write, relative to the project root.
+type releaseFile struct {
+	path    string
+	content string
+}
+
+func (r *releaseNotes) write() error {
+	for _, f := range r.files {
+		path := filepath.Join(r.root, f.path)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			return fmt.Errorf("failed to create %s: %w", filepath.Dir(path), err)
+		}
+		if err := os.WriteFile(path, []byte(f.content), 0o644); err != nil {
+			return fmt.Errorf("failed to write %s: %w", f.path, err)
+		}
+		fmt.Printf("  Wrote %s\n", f.path)
+	}
+	for _, w := range r.warnings {
+		fmt.Fprintf(os.Stderr, "Warning: %s\n", w)
+	}
+	return nil
+}
+
+// prepareReleaseNotes renders the updated changelog and the store notes
+// files for the new version without writing them.
+func prepareReleaseNotes(root string, cfg *config.Resolved, version string, build int) (*releaseNotes, error) {
+	changelogPath := filepath.Join(root, cfg.Changelog.File)
+	data, err := os.ReadFile(changelogPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read changelog: %w", err)
+	}
+
+	release := releaseData{
+		AppName:         cfg.AppName,
+		Version:         version,
+		PreviousVersion: displayVersion(cfg.Version),
+		Build:           build,
+		Date:            time.Now().Format("2006-01-02"),
+	}
+	heading, err := renderTemplate("changelog.heading", cfg.Changelog.Heading, release)
+	if err != nil {
+		return nil, err
+	}
+	updated, notes, err := releaseChangelog(string(data), heading)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", cfg.Changelog.File, err)
+	}
+	release.Notes = notes
+
+	r := &releaseNotes{root: root, files: []releaseFile{{cfg.Changelog.File, updated}}}
+	if notes == "" {
+		r.warnings = append(r.warnings, fmt.Sprintf("%s has no notes under Unreleased", cfg.Changelog.File))
+	}
+	for _, sn := range cfg.Changelog.StoreNotes {
+		path, err := renderTemplate("changelog.store_notes path", sn.Path, release)
+		if err != nil {
+			return nil, err
+		}
+		content, err := renderTemplate("changelog.store_notes template", sn.Template, release)
+		if err != nil {
+			return nil, err
+		}
+		content = strings.TrimSpace(content) + "\n"
+		if n := len([]rune(strings.TrimSpace(content))); sn.MaxLength > 0 && n > sn.MaxLength {
+			r.warnings = append(r.warnings, fmt.Sprintf("%s is %d characters, over its max_length of %d", path, n, sn.MaxLength))
+		}
+		r.files = append(r.files, releaseFile{path, content})
+	}
+	return r, nil
+}
+
+func renderTemplate(name, text string, data releaseData) (string, error) {
+	tmpl, err := template.New(name).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", name, err)
+	}
+	var b strings.Builder
+	if err := tmpl.Execute(&b, data); err != nil {
+		return "", fmt.Errorf("%s: %w", name, err)
+	}
+	return b.String(), nil
+}
+
+// unreleasedHeading matches Markdown headings such as "## Unreleased" and
+// "## [Unreleased]".
+var unreleasedHeading = regexp.MustCompile(`(?i)^(#{1,6})\s+\[?unreleased\]?\s*$`)
+
+// releaseChangelog moves the notes under the changelog's Unreleased heading
+// to a new section headed by heading, leaving an empty Unreleased section
+// above it. Returns the new changelog and the notes.
+func releaseChangelog(content, heading string) (string, string, error) {
+	lines := strings.Split(content, "\n")
+	start, level := -1, 0
+	for i, line := range lines {
+		if m := unreleasedHeading.FindStringSubmatch(strings.TrimSpace(line)); m != nil {
+			start, level = i, len(m[1])
+			break
+		}
+	}
+	if start < 0 {
+		return "", "", fmt.Errorf("no \"## Unreleased\" heading to take the release notes from")
+	}
+
+	// The section ends at the next heading of the same or a higher level.
+	end := len(lines)
+	for i := start + 1; i < len(lines); i++ {
+		if l := headingLevel(lines[i]); l > 0 && l <= level {
+			end = i
+			break
+		}
+	}
+	notes := strings.TrimSpace(strings.Join(lines[start+1:end], "\n"))
+
+	section := []string{lines[start], "", heading, ""}
+	if notes != "" {
+		section = append(section, notes, "")
+	}
+	out := append(append(append([]string{}, lines[:start]...), section...), lines[end:]...)
+	return strings.Join(out, "\n"), notes, nil
+}
+
+// headingLevel returns the level of a Markdown ATX heading line, or 0.
+func headingLevel(line string) int {
+	n := len(line) - len(strings.TrimLeft(line, "#"))
+	if n == 0 || n > 6 || (len(line) > n && line[n] != ' ') {
+		return 0
+	}
+	return n
+}
@@ -0,0 +1,123 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/go-drift/drift/cmd/drift/internal/config"
+)
+
+func TestBumpVersion(t *testing.T) {
+	tests := []struct {
+		version, part string
+		build         int
+		wantVersion   string
+		wantBuild     int
+	}{
+		{"1.4.2", "major", 41, "2.0.0", 42},
+		{"1.4.2", "minor", 41, "1.5.0", 42},
+		{"1.4.2", "patch", 41, "1.4.3", 42},
+		{"1.4.2", "build", 41, "1.4.2", 42},
+		{"1.4", "patch", 7, "1.4.1", 8},
+		{"", "minor", 0, "1.1.0", 2},
+	}
+	for _, tt := range tests {
+		version, build, err := bumpVersion(tt.version, tt.build, tt.part)
+		if err != nil || version != tt.wantVersion || build != tt.wantBuild {
+			t.Errorf("bumpVersion(%q, %d, %s) = %q, %d, %v; want %q, %d",
+				tt.version, tt.build, tt.part, version, build, err, tt.wantVersion, tt.wantBuild)
+		}
+	}
+	if _, _, err := bumpVersion("1.0", config.MaxBuildNumber, "build"); err == nil {
+		t.Error("expected an error past the maximum build number")
+	}
+}
+
+func TestReleaseChangelog(t *testing.T) {
+	in := `# Changelog
+
+## [Unreleased]
+
+### Added
+- Dark mode
+
+## 1.0.0 (1) - 2026-01-02
+
+- First release
+`
+	got, notes, err := releaseChangelog(in, "## 1.1.0 (2) - 2026-10-15")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if notes != "### Added\n- Dark mode" {
+		t.Errorf("unexpected notes %q", notes)
+	}
+	want := `# Changelog
+
+## [Unreleased]
+
+## 1.1.0 (2) - 2026-10-15
+
+### Added
+- Dark mode
+
+## 1.0.0 (1) - 2026-01-02
+
+- First release
+`
+	if got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+
+	if _, _, err := releaseChangelog("# Changelog\n", "## 1.1.0"); err == nil {
+		t.Error("expected an error without an Unreleased heading")
+	}
+}
+
+func TestPrepareReleaseNotes(t *testing.T) {
+	root := t.TempDir()
+	if err := os.WriteFile(filepath.Join(root, "CHANGELOG.md"), []byte("## Unreleased\n\n- Faster startup\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	cfg := &config.Resolved{
+		AppName: "demo",
+		Version: "1.0.0",
+		Changelog: config.ChangelogConfig{
+			File:    "CHANGELOG.md",
+			Heading: "## {{.Version}}",
+			StoreNotes: []config.StoreNotesConfig{
+				{Path: "fastlane/android/{{.Build}}.txt", Template: "{{.Notes}}", MaxLength: 5},
+				{Path: "fastlane/ios/release_notes.txt", Template: "New in {{.Version}} (was {{.PreviousVersion}}):\n{{.Notes}}"},
+			},
+		},
+	}
+	r, err := prepareReleaseNotes(root, cfg, "1.1.0", 8)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := r.write(); err != nil {
+		t.Fatal(err)
+	}
+
+	read := func(path string) string {
+		data, err := os.ReadFile(filepath.Join(root, path))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return string(data)
+	}
+	if got := read("CHANGELOG.md"); got != "## Unreleased\n\n## 1.1.0\n\n- Faster startup\n" {
+		t.Errorf("unexpected changelog:\n%s", got)
+	}
+	if got := read("fastlane/android/8.txt"); got != "- Faster startup\n" {
+		t.Errorf("unexpected Android notes %q", got)
+	}
+	if got := read("fastlane/ios/release_notes.txt"); !strings.HasPrefix(got, "New in 1.1.0 (was 1.0.0):\n- Faster") {
+		t.Errorf("unexpected iOS notes %q", got)
+	}
+	if len(r.warnings) != 1 || !strings.Contains(r.warnings[0], "max_length") {
+		t.Errorf("expected a max_length warning, got %v", r.warnings)
+	}
+}
@@ -1,10 +1,14 @@
 package cmd
 
 import (
+	"bufio"
 	"context"
 	"fmt"
 	"os"
+	"os/exec"
+	"path"
 	"path/filepath"
+	"runtime"
 	"strings"
 	"time"
 
@@ -12,26 +16,64 @@ import (
 	"github.com/go-drift/drift/cmd/drift/internal/workspace"
 )
 
+// watchOptions configures watchAndRun.
+type watchOptions struct {
+	// debounce overrides the drift.yaml watch.debounce when non-negative.
+	debounce time.Duration
+	// goos is the target platform, used to find the packages the app builds.
+	goos string
+}
+
+// watchChange describes what triggered a rebuild.
+type watchChange struct {
+	// config is set when drift.yaml changed or the rebuild was requested
+	// from the keyboard, so the workspace must be refreshed before building.
+	config bool
+}
+
 // watchAndRun watches for Go file changes in the project and calls rebuild
 // on each change. It debounces rapid successive events (e.g. editor
-// save-all) into a single rebuild.
-func watchAndRun(ctx context.Context, ws *workspace.Workspace, rebuild func() error) error {
+// save-all) into a single rebuild, skips changes matching watch.ignore or
+// outside the packages the app builds, and reads single-key commands from
+// the terminal.
+func watchAndRun(ctx context.Context, ws *workspace.Workspace, opts watchOptions, rebuild func(watchChange) error) error {
 	watcher, err := fsnotify.NewWatcher()
 	if err != nil {
 		return fmt.Errorf("failed to create file watcher: %w", err)
 	}
 	defer watcher.Close()
 
-	if err := addWatchDirs(watcher, ws.Root, ws.BuildDir); err != nil {
+	filter := newWatchFilter(ws, opts.goos)
+	if err := addWatchDirs(watcher, ws.Root, ws.BuildDir, filter); err != nil {
 		return fmt.Errorf("failed to watch project directories: %w", err)
 	}
 
-	fmt.Println("Watching for changes... (Ctrl+C to stop)")
+	debounceDelay := ws.Config.WatchDebounce
+	if opts.debounce >= 0 {
+		debounceDelay = opts.debounce
+	}
+
+	keys, restore := readKeys()
+	defer restore()
+
+	fmt.Println("Watching for changes... (r: rebuild, c: clear, q: quit, Ctrl+C to stop)")
 	fmt.Println()
 
-	const debounceDelay = 500 * time.Millisecond
 	var timer *time.Timer
 	var timerC <-chan time.Time
+	var pending watchChange
+
+	runRebuild := func(change watchChange) {
+		fmt.Println()
+		fmt.Println("Rebuilding...")
+		if err := rebuild(change); err != nil {
+			fmt.Fprintf(os.Stderr, "\nRebuild failed: %v\n", err)
+		}
+		// Imports and watch settings may have changed.
+		filter = newWatchFilter(ws, opts.goos)
+		fmt.Println()
+		fmt.Println("Watching for changes...")
+	}
 
 	for {
 		select {
@@ -47,13 +89,15 @@ func watchAndRun(ctx context.Context, ws *workspace.Workspace, rebuild func() er
 			}
 			// Watch newly created directories so changes in new packages are detected.
 			if event.Op&fsnotify.Create != 0 {
-				if info, err := os.Stat(event.Name); err == nil && info.IsDir() {
+				if info, err := os.Stat(event.Name); err == nil && info.IsDir() && !filter.ignored(event.Name) {
 					watcher.Add(event.Name)
 				}
 			}
-			if !isRelevantChange(event) {
+			relevant, config := filter.check(event)
+			if !relevant {
 				continue
 			}
+			pending.config = pending.config || config
 			if timer != nil {
 				timer.Stop()
 			}
@@ -62,13 +106,32 @@ func watchAndRun(ctx context.Context, ws *workspace.Workspace, rebuild func() er
 
 		case <-timerC:
 			timerC = nil
-			fmt.Println()
-			fmt.Println("Rebuilding...")
-			if err := rebuild(); err != nil {
-				fmt.Fprintf(os.Stderr, "\nRebuild failed: %v\n", err)
+			change := pending
+			pending = watchChange{}
+			runRebuild(change)
+
+		case key := <-keys:
+			switch key {
+			case 'r', 'R':
+				if timer != nil {
+					timer.Stop()
+					timerC = nil
+				}
+				pending = watchChange{}
+				runRebuild(watchChange{config: true})
+			case 'c', 'C':
+				fmt.Print("\033[H\033[2J")
+				fmt.Println("Watching for changes... (r: rebuild, c: clear, q: quit)")
+			case 'q', 'Q':
+				if timer != nil {
+					timer.Stop()
+				}
+				return nil
+			case 'h', 'H', '?':
+				fmt.Println("  r  Rebuild and relaunch now")
+				fmt.Println("  c  Clear the screen")
+				fmt.Println("  q  Quit")
 			}
-			fmt.Println()
-			fmt.Println("Watching for changes...")
 
 		case err, ok := <-watcher.Errors:
 			if !ok {
@@ -79,11 +142,120 @@ func watchAndRun(ctx context.Context, ws *workspace.Workspace, rebuild func() er
 	}
 }
 
+// watchFilter decides which file changes trigger a rebuild.
+type watchFilter struct {
+	root   string
+	ignore []string
+	// pkgDirs holds the directories of the packages the app builds, or nil
+	// if they could not be listed, in which case every Go change counts.
+	pkgDirs map[string]bool
+	// fonts holds the absolute paths of the fonts bundled by drift.yaml.
+	fonts map[string]bool
+}
+
+func newWatchFilter(ws *workspace.Workspace, goos string) *watchFilter {
+	root, _ := filepath.Abs(ws.Root)
+	fonts := make(map[string]bool)
+	for _, family := range ws.Config.Fonts {
+		for _, file := range family.Files {
+			fonts[filepath.Join(root, filepath.FromSlash(file.Path))] = true
+		}
+	}
+	return &watchFilter{
+		root:    root,
+		ignore:  ws.Config.WatchIgnore,
+		pkgDirs: appPackageDirs(ws, goos),
+		fonts:   fonts,
+	}
+}
+
+// check reports whether event should trigger a rebuild, and whether it
+// changed the drift config.
+func (f *watchFilter) check(event fsnotify.Event) (relevant, config bool) {
+	// Bundled fonts are embedded in the app binary.
+	if abs, _ := filepath.Abs(event.Name); f.fonts[abs] {
+		return event.Op&(fsnotify.Write|fsnotify.Create|fsnotify.Remove|fsnotify.Rename) != 0, false
+	}
+	if !isRelevantChange(event) || f.ignored(event.Name) {
+		return false, false
+	}
+	base := filepath.Base(event.Name)
+	if base == "drift.yaml" || base == "drift.yml" {
+		return true, true
+	}
+	if f.pkgDirs != nil {
+		dir, _ := filepath.Abs(filepath.Dir(event.Name))
+		if !f.pkgDirs[dir] {
+			return false, false
+		}
+	}
+	return true, false
+}
+
+// ignored reports whether name matches a watch.ignore pattern. Patterns
+// without a slash match any element of the path relative to the project
+// root; patterns with a slash match the relative path or one of its parent
+// directories.
+func (f *watchFilter) ignored(name string) bool {
+	if len(f.ignore) == 0 {
+		return false
+	}
+	abs, _ := filepath.Abs(name)
+	rel, err := filepath.Rel(f.root, abs)
+	if err != nil || rel == "." || strings.HasPrefix(rel, "..") {
+		return false
+	}
+	parts := strings.Split(filepath.ToSlash(rel), "/")
+	for _, pattern := range f.ignore {
+		if strings.Contains(pattern, "/") {
+			for i := len(parts); i > 0; i-- {
+				if ok, _ := path.Match(pattern, strings.Join(parts[:i], "/")); ok {
+					return true
+				}
+			}
+			continue
+		}
+		for _, part := range parts {
+			if ok, _ := path.Match(pattern, part); ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// appPackageDirs lists the directories of the non-standard packages the app
+// builds for goos. Returns nil if go list fails.
+func appPackageDirs(ws *workspace.Workspace, goos string) map[string]bool {
+	args := []string{"list", "-e", "-deps", "-f", "{{if not .Standard}}{{.Dir}}{{end}}"}
+	if ws.Overlay != "" {
+		args = append(args, "-overlay", ws.Overlay)
+	}
+	cmd := exec.Command("go", append(args, ".")...)
+	cmd.Dir = ws.Root
+	cmd.Env = os.Environ()
+	if goos != "" {
+		cmd.Env = append(cmd.Env, "GOOS="+goos, "GOARCH=arm64", "CGO_ENABLED=1")
+	}
+	out, err := cmd.Output()
+	if err != nil {
+		return nil
+	}
+	dirs := make(map[string]bool)
+	scanner := bufio.NewScanner(strings.NewReader(string(out)))
+	for scanner.Scan() {
+		if dir := strings.TrimSpace(scanner.Text()); dir != "" {
+			dirs[filepath.Clean(dir)] = true
+		}
+	}
+	return dirs
+}
+
 // addWatchDirs recursively adds project directories to the watcher,
-// skipping hidden dirs, vendor, platform scaffolds, third_party, and the
-// build directory (which may reside inside the project root for ejected
-// platforms).
-func addWatchDirs(watcher *fsnotify.Watcher, root, buildDir string) error {
+// skipping hidden dirs, vendor, platform scaffolds, third_party, ignored
+// directories, and the build directory (which may reside inside the project
+// root for ejected platforms).
+func addWatchDirs(watcher *fsnotify.Watcher, root, buildDir string, filter *watchFilter) error {
 	absBuildDir, _ := filepath.Abs(buildDir)
 	return filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
 		if err != nil {
@@ -104,16 +276,60 @@ func addWatchDirs(watcher *fsnotify.Watcher, root, buildDir string) error {
 		case "vendor", "platform", "third_party":
 			return filepath.SkipDir
 		}
+		if filter.ignored(path) {
+			return filepath.SkipDir
+		}
 		return watcher.Add(path)
 	})
 }
 
 // isRelevantChange returns true for write/create/remove/rename events on
-// .go files or the drift config file.
+// non-test .go files or the drift config file.
 func isRelevantChange(event fsnotify.Event) bool {
 	if event.Op&(fsnotify.Write|fsnotify.Create|fsnotify.Remove|fsnotify.Rename) == 0 {
 		return false
 	}
 	base := filepath.Base(event.Name)
+	if strings.HasSuffix(base, "_test.go") {
+		return false
+	}
 	return strings.HasSuffix(base, ".go") || base == "drift.yaml" || base == "drift.yml"
 }
+
+// readKeys returns a channel of keys typed on stdin and a function that
+// restores the terminal. On Unix terminals it switches off line buffering
+// with stty so each key is delivered as it is pressed; elsewhere keys
+// arrive when Enter is pressed.
+func readKeys() (<-chan byte, func()) {
+	restore := func() {}
+	if info, err := os.Stdin.Stat(); err == nil && info.Mode()&os.ModeCharDevice != 0 && runtime.GOOS != "windows" {
+		if state, err := stty("-g"); err == nil {
+			if _, err := stty("-icanon", "-echo", "min", "1"); err == nil {
+				restore = func() { stty(strings.TrimSpace(state)) }
+			}
+		}
+	}
+
+	keys := make(chan byte, 8)
+	go func() {
+		buf := make([]byte, 1)
+		for {
+			if _, err := os.Stdin.Read(buf); err != nil {
+				return
+			}
+			select {
+			case keys <- buf[0]:
+			default:
+			}
+		}
+	}()
+	return keys, restore
+}
+
+// stty runs stty on the controlling terminal.
+func stty(args ...string) (string, error) {
+	cmd := exec.Command("stty", args...)
+	cmd.Stdin = os.Stdin
+	out, err := cmd.Output()
+	return string(out), err
+}
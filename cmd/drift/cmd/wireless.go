@@ -0,0 +1,393 @@
+package cmd
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// defaultADBPort is the port adb tcpip mode listens on.
+const defaultADBPort = "5555"
+
+// androidWirelessOptions selects how drift run reaches an Android device
+// over the network instead of USB.
+type androidWirelessOptions struct {
+	// connect is the host[:port] to adb connect to.
+	connect string
+	// pair is the host:port shown under "Pair device with pairing code".
+	pair string
+	// pairCode is the six-digit pairing code; prompted for when empty.
+	pairCode string
+	// tcpip switches a USB-connected device to adb over TCP so the cable
+	// can be unplugged.
+	tcpip bool
+}
+
+func (o androidWirelessOptions) enabled() bool {
+	return o.connect != "" || o.pair != "" || o.tcpip
+}
+
+// parseAndroidWirelessArgs parses the wireless debugging flags of
+// drift run android.
+func parseAndroidWirelessArgs(args []string) (androidWirelessOptions, error) {
+	var opts androidWirelessOptions
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--connect", "--pair", "--pair-code":
+			if i+1 >= len(args) || strings.HasPrefix(args[i+1], "--") {
+				return opts, fmt.Errorf("%s requires a value", args[i])
+			}
+			switch args[i] {
+			case "--connect":
+				opts.connect = args[i+1]
+			case "--pair":
+				opts.pair = args[i+1]
+			case "--pair-code":
+				opts.pairCode = args[i+1]
+			}
+			i++
+		case "--tcpip":
+			opts.tcpip = true
+		}
+	}
+	if opts.pair != "" {
+		if _, _, err := net.SplitHostPort(opts.pair); err != nil {
+			return opts, fmt.Errorf("--pair needs the host:port shown under \"Pair device with pairing code\", got %q", opts.pair)
+		}
+	}
+	if opts.pairCode != "" && opts.pair == "" {
+		return opts, fmt.Errorf("--pair-code requires --pair")
+	}
+	return opts, nil
+}
+
+// connectAndroidWireless pairs with and connects to a device over the
+// network as requested by opts and returns its adb serial, which is
+// remembered in mem. deviceID picks the USB device to switch over when
+// opts.tcpip is set.
+func connectAndroidWireless(adb, deviceID string, opts androidWirelessOptions, mem deviceMemory) (string, error) {
+	connect := opts.connect
+
+	if opts.tcpip {
+		serial, err := resolveAndroidDevice(adb, deviceID, deviceMemory{})
+		if err != nil {
+			return "", err
+		}
+		ip, err := androidDeviceIP(adb, serial)
+		if err != nil {
+			return "", err
+		}
+		fmt.Printf("  Switching %s to adb over TCP on port %s...\n", serial, defaultADBPort)
+		if out, err := adbCommand(adb, serial, "tcpip", defaultADBPort).CombinedOutput(); err != nil {
+			return "", fmt.Errorf("adb tcpip failed: %w\n%s", err, out)
+		}
+		if connect == "" {
+			connect = ip
+		}
+		// adbd restarts in TCP mode; give it a moment before connecting.
+		time.Sleep(time.Second)
+	}
+
+	if opts.pair != "" {
+		code := opts.pairCode
+		if code == "" {
+			var err error
+			if code, err = promptLine("  Pairing code: "); err != nil {
+				return "", err
+			}
+		}
+		fmt.Printf("  Pairing with %s...\n", opts.pair)
+		out, err := exec.Command(adb, "pair", opts.pair, code).CombinedOutput()
+		if err != nil || !strings.Contains(string(out), "Successfully paired") {
+			return "", fmt.Errorf("adb pair failed: %s\nCheck the code and that the pairing dialog is still open on the device", strings.TrimSpace(string(out)))
+		}
+		if connect == "" {
+			host, _, _ := net.SplitHostPort(opts.pair)
+			addr, err := waitForADBConnectService(adb, host, 10*time.Second)
+			if err != nil {
+				return "", err
+			}
+			connect = addr
+		}
+	}
+
+	addr := adbAddress(connect)
+	if err := adbConnect(adb, addr); err != nil {
+		return "", err
+	}
+	fmt.Printf("  Connected over Wi-Fi: %s\n", addr)
+	mem.remember(addr)
+	return addr, nil
+}
+
+// adbAddress adds the default adb port to host when it has none.
+func adbAddress(host string) string {
+	if _, _, err := net.SplitHostPort(host); err == nil {
+		return host
+	}
+	return net.JoinHostPort(strings.Trim(host, "[]"), defaultADBPort)
+}
+
+// adbConnect runs adb connect, which exits zero even when it fails, so the
+// output is checked too.
+func adbConnect(adb, addr string) error {
+	out, err := exec.Command(adb, "connect", addr).CombinedOutput()
+	text := strings.TrimSpace(string(out))
+	if err != nil || !strings.Contains(text, "connected to") {
+		return fmt.Errorf("adb connect %s failed: %s\nMake sure the device is on the same network and wireless debugging is on", addr, text)
+	}
+	return nil
+}
+
+// routeSrcPattern matches the source address in `ip route` output.
+var routeSrcPattern = regexp.MustCompile(`\bsrc\s+(\d+\.\d+\.\d+\.\d+)`)
+
+// androidDeviceIP returns the Wi-Fi address of a USB-connected device.
+func androidDeviceIP(adb, serial string) (string, error) {
+	out, err := adbCommand(adb, serial, "shell", "ip", "route").Output()
+	if err != nil {
+		return "", fmt.Errorf("failed to read the device's IP address: %w", err)
+	}
+	ip := parseRouteSrc(string(out))
+	if ip == "" {
+		return "", fmt.Errorf("could not find the device's Wi-Fi address; connect it to Wi-Fi or pass --connect <ip>")
+	}
+	return ip, nil
+}
+
+// parseRouteSrc returns the first source address in `ip route` output,
+// preferring the wlan interface.
+func parseRouteSrc(output string) string {
+	first := ""
+	for _, line := range strings.Split(output, "\n") {
+		m := routeSrcPattern.FindStringSubmatch(line)
+		if m == nil {
+			continue
+		}
+		if strings.Contains(line, "wlan") {
+			return m[1]
+		}
+		if first == "" {
+			first = m[1]
+		}
+	}
+	return first
+}
+
+// waitForADBConnectService polls adb's mDNS discovery for the wireless
+// debugging connect service of host, which a device advertises after
+// pairing on a port that differs from the pairing port.
+func waitForADBConnectService(adb, host string, timeout time.Duration) (string, error) {
+	deadline := time.Now().Add(timeout)
+	for {
+		out, _ := exec.Command(adb, "mdns", "services").Output()
+		if addr := parseMDNSConnectService(string(out), host); addr != "" {
+			return addr, nil
+		}
+		if time.Now().After(deadline) {
+			return "", fmt.Errorf("paired, but the device did not advertise a connect address\n" +
+				"Pass the IP address and port shown under \"Wireless debugging\" with --connect")
+		}
+		time.Sleep(500 * time.Millisecond)
+	}
+}
+
+// parseMDNSConnectService returns the address of the _adb-tls-connect
+// service advertised by host in `adb mdns services` output.
+func parseMDNSConnectService(output, host string) string {
+	for _, line := range strings.Split(output, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 3 || !strings.HasPrefix(fields[1], "_adb-tls-connect.") {
+			continue
+		}
+		addr := fields[len(fields)-1]
+		if h, _, err := net.SplitHostPort(addr); err == nil && h == host {
+			return addr
+		}
+	}
+	return ""
+}
+
+// isNetworkSerial reports whether an adb serial is a device connected over
+// the network, either as host:port or through mDNS, rather than USB or an
+// emulator.
+func isNetworkSerial(serial string) bool {
+	if strings.Contains(serial, "._adb-tls-connect.") {
+		return true
+	}
+	_, _, err := net.SplitHostPort(serial)
+	return err == nil
+}
+
+// promptLine prints prompt and reads one line from standard input.
+func promptLine(prompt string) (string, error) {
+	fmt.Print(prompt)
+	line, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil && line == "" {
+		return "", fmt.Errorf("failed to read input: %w", err)
+	}
+	return strings.TrimSpace(line), nil
+}
+
+// coreDevice is an iOS device known to CoreDevice, as listed by
+// `xcrun devicectl list devices`.
+type coreDevice struct {
+	udid      string
+	name      string
+	transport string
+	paired    bool
+}
+
+// wireless reports whether the device is reached over the local network.
+func (d coreDevice) wireless() bool {
+	return d.transport == "localNetwork"
+}
+
+// listCoreDevices returns the iOS devices known to devicectl, including
+// devices paired for wireless debugging that are not plugged in.
+func listCoreDevices() ([]coreDevice, error) {
+	tmp, err := os.MkdirTemp("", "drift-devicectl")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(tmp)
+	outPath := filepath.Join(tmp, "devices.json")
+
+	cmd := exec.Command("xcrun", "devicectl", "list", "devices", "--quiet", "--json-output", outPath)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("devicectl list devices failed: %w\n%s", err, stderr.String())
+	}
+	data, err := os.ReadFile(outPath)
+	if err != nil {
+		return nil, err
+	}
+	return parseCoreDevices(data)
+}
+
+// parseCoreDevices parses the JSON output of devicectl list devices,
+// keeping iOS devices only.
+func parseCoreDevices(data []byte) ([]coreDevice, error) {
+	var out struct {
+		Result struct {
+			Devices []struct {
+				ConnectionProperties struct {
+					TransportType string `json:"transportType"`
+					PairingState  string `json:"pairingState"`
+				} `json:"connectionProperties"`
+				DeviceProperties struct {
+					Name string `json:"name"`
+				} `json:"deviceProperties"`
+				HardwareProperties struct {
+					UDID     string `json:"udid"`
+					Platform string `json:"platform"`
+				} `json:"hardwareProperties"`
+			} `json:"devices"`
+		} `json:"result"`
+	}
+	if err := json.Unmarshal(data, &out); err != nil {
+		return nil, fmt.Errorf("failed to parse devicectl output: %w", err)
+	}
+	var devices []coreDevice
+	for _, d := range out.Result.Devices {
+		if d.HardwareProperties.Platform != "iOS" || d.HardwareProperties.UDID == "" {
+			continue
+		}
+		devices = append(devices, coreDevice{
+			udid:      d.HardwareProperties.UDID,
+			name:      d.DeviceProperties.Name,
+			transport: d.ConnectionProperties.TransportType,
+			paired:    d.ConnectionProperties.PairingState == "paired",
+		})
+	}
+	return devices, nil
+}
+
+// resolveWirelessDevice resolves a device name or UDID, or empty for
+// auto-detect, among the paired iOS devices reachable over the network.
+func resolveWirelessDevice(id string) (coreDevice, error) {
+	all, err := listCoreDevices()
+	if err != nil {
+		return coreDevice{}, err
+	}
+	var devices []coreDevice
+	for _, d := range all {
+		if d.paired && d.wireless() {
+			devices = append(devices, d)
+		}
+	}
+	return matchWirelessDevice(devices, id)
+}
+
+func matchWirelessDevice(devices []coreDevice, id string) (coreDevice, error) {
+	if id == "" {
+		switch len(devices) {
+		case 0:
+			return coreDevice{}, fmt.Errorf("no iOS devices found on the network\n" +
+				"Pair the device over USB once in Xcode (Window > Devices and Simulators, \"Connect via network\"), then unplug it")
+		case 1:
+			fmt.Printf("  Auto-detected wireless device: %s (%s)\n", devices[0].name, devices[0].udid)
+			return devices[0], nil
+		default:
+			return coreDevice{}, fmt.Errorf("multiple wireless iOS devices found, specify one with --device <name-or-udid>:\n%s", formatCoreDevices(devices))
+		}
+	}
+	for _, d := range devices {
+		if d.udid == id {
+			return d, nil
+		}
+	}
+	for _, d := range devices {
+		if strings.EqualFold(d.name, id) {
+			return d, nil
+		}
+	}
+	names := make([]string, len(devices))
+	for i, d := range devices {
+		names[i] = d.name
+	}
+	i, err := uniqueMatch(id, fuzzyMatch(id, names), names, func(i, j int) bool { return false })
+	if err != nil {
+		return coreDevice{}, err
+	}
+	if i >= 0 {
+		return devices[i], nil
+	}
+	listing := formatCoreDevices(devices)
+	if len(devices) == 0 {
+		listing = "  (none)"
+	}
+	return coreDevice{}, fmt.Errorf("wireless device %q not found\nWireless devices:\n%s", id, listing)
+}
+
+func formatCoreDevices(devices []coreDevice) string {
+	var lines []string
+	for _, d := range devices {
+		lines = append(lines, fmt.Sprintf("  %s (%s)", d.name, d.udid))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// devicectlLaunchConsole launches the app and streams its standard output
+// and error until it exits or ctx is cancelled. It is the log stream for
+// wireless devices the syslog relay cannot reach. Intended to run as a
+// goroutine.
+func devicectlLaunchConsole(ctx context.Context, appID, deviceID string) {
+	cmd := exec.CommandContext(ctx, "xcrun", "devicectl", "device", "process", "launch",
+		"--console", "--terminate-existing", "--device", deviceID, appID)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil && ctx.Err() == nil {
+		fmt.Fprintf(os.Stderr, "Error: devicectl launch failed: %v\n", err)
+	}
+}
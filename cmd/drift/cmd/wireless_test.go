@@ -0,0 +1,120 @@
+package cmd
+
+import "testing"
+
+func TestParseAndroidWirelessArgs(t *testing.T) {
+	opts, err := parseAndroidWirelessArgs([]string{"--device", "Pixel", "--pair", "192.168.1.20:37099", "--pair-code", "123456", "--tcpip"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if opts.pair != "192.168.1.20:37099" || opts.pairCode != "123456" || !opts.tcpip || !opts.enabled() {
+		t.Errorf("unexpected options %+v", opts)
+	}
+
+	for _, args := range [][]string{
+		{"--connect"},
+		{"--connect", "--tcpip"},
+		{"--pair", "192.168.1.20"},
+		{"--pair-code", "123456"},
+	} {
+		if _, err := parseAndroidWirelessArgs(args); err == nil {
+			t.Errorf("expected an error for %v", args)
+		}
+	}
+
+	if opts, _ := parseAndroidWirelessArgs([]string{"--device", "emulator-5554"}); opts.enabled() {
+		t.Error("expected wireless to be off without wireless flags")
+	}
+}
+
+func TestADBAddress(t *testing.T) {
+	tests := map[string]string{
+		"192.168.1.20":       "192.168.1.20:5555",
+		"192.168.1.20:41235": "192.168.1.20:41235",
+		"fe80::1":            "[fe80::1]:5555",
+		"[fe80::1]:41235":    "[fe80::1]:41235",
+	}
+	for in, want := range tests {
+		if got := adbAddress(in); got != want {
+			t.Errorf("adbAddress(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestParseRouteSrc(t *testing.T) {
+	out := "10.0.2.0/24 dev eth0 proto kernel scope link src 10.0.2.15\n" +
+		"192.168.1.0/24 dev wlan0 proto kernel scope link src 192.168.1.20\n"
+	if got := parseRouteSrc(out); got != "192.168.1.20" {
+		t.Errorf("expected the wlan address, got %q", got)
+	}
+	if got := parseRouteSrc("10.0.2.0/24 dev eth0 src 10.0.2.15\n"); got != "10.0.2.15" {
+		t.Errorf("expected the only address, got %q", got)
+	}
+	if got := parseRouteSrc(""); got != "" {
+		t.Errorf("expected no address, got %q", got)
+	}
+}
+
+func TestParseMDNSConnectService(t *testing.T) {
+	out := "List of discovered mdns services\n" +
+		"adb-1A2B3C-xyz\t_adb-tls-pairing._tcp.\t192.168.1.20:37099\n" +
+		"adb-1A2B3C-xyz\t_adb-tls-connect._tcp.\t192.168.1.20:41235\n" +
+		"adb-9Z8Y7X-abc\t_adb-tls-connect._tcp.\t192.168.1.31:40001\n"
+	if got := parseMDNSConnectService(out, "192.168.1.20"); got != "192.168.1.20:41235" {
+		t.Errorf("expected the connect service of the paired host, got %q", got)
+	}
+	if got := parseMDNSConnectService(out, "192.168.1.99"); got != "" {
+		t.Errorf("expected no service for an unknown host, got %q", got)
+	}
+}
+
+func TestIsNetworkSerial(t *testing.T) {
+	tests := map[string]bool{
+		"192.168.1.20:5555":                     true,
+		"adb-1A2B3C-xyz._adb-tls-connect._tcp":  true,
+		"emulator-5554":                         false,
+		"R58M123ABC":                            false,
+		"adb-1A2B3C-xyz._adb-tls-connect._tcp.": true,
+		"[fe80::1]:5555":                        true,
+	}
+	for serial, want := range tests {
+		if got := isNetworkSerial(serial); got != want {
+			t.Errorf("isNetworkSerial(%q) = %v, want %v", serial, got, want)
+		}
+	}
+}
+
+func TestParseCoreDevices(t *testing.T) {
+	data := []byte(`{"result":{"devices":[
+		{"connectionProperties":{"transportType":"localNetwork","pairingState":"paired"},
+		 "deviceProperties":{"name":"Ada's iPhone"},
+		 "hardwareProperties":{"udid":"00008110-000A","platform":"iOS"}},
+		{"connectionProperties":{"transportType":"wired","pairingState":"paired"},
+		 "deviceProperties":{"name":"Test iPad"},
+		 "hardwareProperties":{"udid":"00008027-000B","platform":"iOS"}},
+		{"connectionProperties":{"transportType":"localNetwork","pairingState":"paired"},
+		 "deviceProperties":{"name":"Living Room"},
+		 "hardwareProperties":{"udid":"00008020-000C","platform":"tvOS"}}
+	]}}`)
+	devices, err := parseCoreDevices(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(devices) != 2 {
+		t.Fatalf("expected the two iOS devices, got %+v", devices)
+	}
+	if !devices[0].wireless() || !devices[0].paired || devices[1].wireless() {
+		t.Errorf("unexpected transports %+v", devices)
+	}
+
+	wireless := devices[:1]
+	if d, err := matchWirelessDevice(wireless, ""); err != nil || d.udid != "00008110-000A" {
+		t.Errorf("expected the single wireless device to be auto-detected, got %+v, %v", d, err)
+	}
+	if d, err := matchWirelessDevice(wireless, "ada's iphone"); err != nil || d.udid != "00008110-000A" {
+		t.Errorf("expected a case-insensitive name match, got %+v, %v", d, err)
+	}
+	if _, err := matchWirelessDevice(wireless, "Test iPad"); err == nil {
+		t.Error("expected an error for a device that is not wireless")
+	}
+}
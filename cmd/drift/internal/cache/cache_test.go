@@ -1,6 +1,11 @@
 package cache
 
-import "testing"
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
 
 // --- NormalizeVersion ---
 
@@ -147,3 +152,53 @@ func TestIntCompare(t *testing.T) {
 		}
 	}
 }
+
+// --- Object cache ---
+
+func TestObjects_StoreLookupPrune(t *testing.T) {
+	SetCacheDir(t.TempDir())
+	t.Cleanup(func() { SetCacheDir("") })
+
+	src := filepath.Join(t.TempDir(), "libdrift.so")
+	if err := os.WriteFile(src, []byte("lib"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := LookupObject("abc123", "libdrift.so"); ok {
+		t.Fatal("expected a miss before storing")
+	}
+	if _, ok := LookupObject("", "libdrift.so"); ok {
+		t.Fatal("expected an empty key to miss")
+	}
+
+	keys := []string{"aaa111", "bbb222", "ccc333"}
+	for i, key := range keys {
+		if err := StoreObject(key, "libdrift.so", src); err != nil {
+			t.Fatal(err)
+		}
+		// Age the entries so the first key is the least recently used.
+		path, _ := LookupObject(key, "libdrift.so")
+		used := time.Now().Add(time.Duration(i-len(keys)) * time.Hour)
+		os.Chtimes(filepath.Dir(path), used, used)
+	}
+
+	path, ok := LookupObject("bbb222", "libdrift.so")
+	if !ok {
+		t.Fatal("expected a hit after storing")
+	}
+	if data, _ := os.ReadFile(path); string(data) != "lib" {
+		t.Errorf("expected the stored contents, got %q", data)
+	}
+
+	if err := PruneObjects(2); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := LookupObject("aaa111", "libdrift.so"); ok {
+		t.Error("expected the least recently used entry to be pruned")
+	}
+	for _, key := range []string{"bbb222", "ccc333"} {
+		if _, ok := LookupObject(key, "libdrift.so"); !ok {
+			t.Errorf("expected %s to be kept", key)
+		}
+	}
+}
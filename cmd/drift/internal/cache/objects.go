@@ -0,0 +1,139 @@
+package cache
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+)
+
+// MaxObjects is the number of build outputs kept in the object cache. Older
+// entries are removed by PruneObjects.
+const MaxObjects = 12
+
+// ObjectsDir returns the content-addressed cache of build outputs.
+// Returns: <cache_root>/objects
+func ObjectsDir() (string, error) {
+	root, err := Root()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(root, "objects"), nil
+}
+
+// objectPath returns the path of the file name stored under key.
+func objectPath(key, name string) (string, error) {
+	dir, err := ObjectsDir()
+	if err != nil {
+		return "", err
+	}
+	if len(key) < 3 {
+		return "", fmt.Errorf("invalid object key %q", key)
+	}
+	return filepath.Join(dir, key[:2], key, name), nil
+}
+
+// LookupObject returns the cached file name stored under key, marking the
+// entry as recently used. The key must identify every input of the file,
+// typically as a hex digest.
+func LookupObject(key, name string) (string, bool) {
+	path, err := objectPath(key, name)
+	if err != nil {
+		return "", false
+	}
+	if _, err := os.Stat(path); err != nil {
+		return "", false
+	}
+	now := time.Now()
+	os.Chtimes(filepath.Dir(path), now, now)
+	return path, true
+}
+
+// StoreObject copies src into the cache under key and name. The copy is
+// written to a temporary file first so concurrent readers never see a
+// partial entry.
+func StoreObject(key, name, src string) error {
+	path, err := objectPath(key, name)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("failed to create object cache directory: %w", err)
+	}
+
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), name+".tmp*")
+	if err != nil {
+		return fmt.Errorf("failed to create cached object: %w", err)
+	}
+	if _, err := io.Copy(tmp, in); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return fmt.Errorf("failed to write cached object: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return fmt.Errorf("failed to write cached object: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		os.Remove(tmp.Name())
+		return fmt.Errorf("failed to store cached object: %w", err)
+	}
+	return nil
+}
+
+// PruneObjects removes the least recently used entries from the object
+// cache until at most keep remain.
+func PruneObjects(keep int) error {
+	dir, err := ObjectsDir()
+	if err != nil {
+		return err
+	}
+	shards, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+
+	type entry struct {
+		path string
+		used time.Time
+	}
+	var entries []entry
+	for _, shard := range shards {
+		if !shard.IsDir() {
+			continue
+		}
+		objects, err := os.ReadDir(filepath.Join(dir, shard.Name()))
+		if err != nil {
+			continue
+		}
+		for _, object := range objects {
+			info, err := object.Info()
+			if err != nil || !info.IsDir() {
+				continue
+			}
+			entries = append(entries, entry{filepath.Join(dir, shard.Name(), object.Name()), info.ModTime()})
+		}
+	}
+	if len(entries) <= keep {
+		return nil
+	}
+
+	slices.SortFunc(entries, func(a, b entry) int { return b.used.Compare(a.used) })
+	for _, e := range entries[keep:] {
+		if err := os.RemoveAll(e.path); err != nil {
+			return fmt.Errorf("failed to prune %s: %w", e.path, err)
+		}
+	}
+	return nil
+}
@@ -4,9 +4,11 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"path"
 	"path/filepath"
 	"strconv"
 	"strings"
+	"time"
 
 	"golang.org/x/mod/modfile"
 	"golang.org/x/mod/module"
@@ -17,20 +19,66 @@ const driftModulePath = "github.com/go-drift/drift"
 
 // Config represents the optional drift.yaml configuration.
 type Config struct {
-	App    AppConfig    `yaml:"app"`
-	Engine EngineConfig `yaml:"engine"`
+	App        AppConfig          `yaml:"app"`
+	Engine     EngineConfig       `yaml:"engine"`
+	Native     NativeConfig       `yaml:"native"`
+	Watch      WatchConfig        `yaml:"watch"`
+	Changelog  ChangelogConfig    `yaml:"changelog"`
+	Fonts      []FontFamilyConfig `yaml:"fonts,omitempty"`
+	Typography TypographyConfig   `yaml:"typography,omitempty"`
 }
 
 // AppConfig contains application metadata.
 type AppConfig struct {
-	Name           string `yaml:"name,omitempty"`
-	ID             string `yaml:"id,omitempty"`
-	Orientation    string `yaml:"orientation,omitempty"`
-	AllowHTTP      bool   `yaml:"allow_http,omitempty"`
-	Icon           string `yaml:"icon,omitempty"`
-	IconBackground string `yaml:"icon_background,omitempty"`
+	Name           string       `yaml:"name,omitempty"`
+	ID             string       `yaml:"id,omitempty"`
+	Orientation    string       `yaml:"orientation,omitempty"`
+	AllowHTTP      bool         `yaml:"allow_http,omitempty"`
+	Version        string       `yaml:"version,omitempty"` // e.g. "1.4.2"; versionName and CFBundleShortVersionString
+	Build          int          `yaml:"build,omitempty"`   // versionCode and CFBundleVersion
+	Icon           string       `yaml:"icon,omitempty"`
+	IconBackground string       `yaml:"icon_background,omitempty"` // hex color or image path
+	IconForeground string       `yaml:"icon_foreground,omitempty"`
+	IconMonochrome string       `yaml:"icon_monochrome,omitempty"`
+	IconDark       string       `yaml:"icon_dark,omitempty"`
+	IconTinted     string       `yaml:"icon_tinted,omitempty"`
+	Splash         SplashConfig `yaml:"splash,omitempty"`
+	// Localizations maps a locale code (e.g. "fr", "pt-BR") to the app
+	// metadata shown to users of that locale.
+	Localizations map[string]LocalizationConfig `yaml:"localizations,omitempty"`
 }
 
+// LocalizationConfig contains app metadata translated for one locale.
+type LocalizationConfig struct {
+	Name string `yaml:"name,omitempty"`
+	// UsageDescriptions maps Info.plist keys, such as
+	// NSCameraUsageDescription, to translated text. iOS only.
+	UsageDescriptions map[string]string `yaml:"usage_descriptions,omitempty"`
+}
+
+// SplashConfig contains launch screen settings.
+type SplashConfig struct {
+	Background        string `yaml:"background,omitempty"`
+	DarkBackground    string `yaml:"dark_background,omitempty"`
+	Image             string `yaml:"image,omitempty"`
+	AnimatedIcon      string `yaml:"animated_icon,omitempty"`      // Android 12+ only
+	AnimationDuration int    `yaml:"animation_duration,omitempty"` // milliseconds
+}
+
+// WatchConfig contains settings for drift run --watch.
+type WatchConfig struct {
+	// Ignore lists glob patterns for paths whose changes do not trigger a
+	// rebuild. Patterns without a slash match any file or directory name;
+	// patterns with a slash match paths relative to the project root.
+	Ignore []string `yaml:"ignore,omitempty"`
+	// Debounce is how long to wait after the last change before rebuilding,
+	// as a Go duration such as "300ms".
+	Debounce string `yaml:"debounce,omitempty"`
+}
+
+// DefaultWatchDebounce is the watch debounce used when drift.yaml sets none.
+const DefaultWatchDebounce = 500 * time.Millisecond
+
 // EngineConfig contains engine settings.
 type EngineConfig struct {
 	Version string `yaml:"version,omitempty"`
@@ -45,8 +93,22 @@ type Resolved struct {
 	Orientation    string
 	AllowHTTP      bool
 	EngineVersion  string
+	Version        string // "" when unset, leaving ejected projects' own version
+	BuildNumber    int    // 0 when unset
 	Icon           string
 	IconBackground string
+	IconForeground string
+	IconMonochrome string
+	IconDark       string
+	IconTinted     string
+	Splash         SplashConfig
+	Localizations  map[string]LocalizationConfig
+	Native         NativeConfig
+	WatchIgnore    []string
+	WatchDebounce  time.Duration
+	Changelog      ChangelogConfig
+	Fonts          []FontFamilyConfig
+	Typography     TypographyConfig
 }
 
 // LoadOptional reads drift.yaml if present.
@@ -107,6 +169,39 @@ func Resolve(dir string) (*Resolved, error) {
 		return nil, err
 	}
 
+	splash, err := normalizeSplash(cfg.App.Splash)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateLocalizations(cfg.App.Localizations); err != nil {
+		return nil, err
+	}
+
+	if err := cfg.Native.normalize(); err != nil {
+		return nil, err
+	}
+
+	watchIgnore, watchDebounce, err := normalizeWatch(cfg.Watch)
+	if err != nil {
+		return nil, err
+	}
+
+	version := strings.TrimSpace(cfg.App.Version)
+	if err := validateAppVersion(version, cfg.App.Build); err != nil {
+		return nil, err
+	}
+
+	changelog, err := normalizeChangelog(cfg.Changelog)
+	if err != nil {
+		return nil, err
+	}
+
+	fonts, typography, err := normalizeFonts(dir, cfg.Fonts, cfg.Typography)
+	if err != nil {
+		return nil, err
+	}
+
 	return &Resolved{
 		Root:           dir,
 		ModulePath:     modulePath,
@@ -115,11 +210,107 @@ func Resolve(dir string) (*Resolved, error) {
 		Orientation:    orientation,
 		AllowHTTP:      cfg.App.AllowHTTP,
 		EngineVersion:  engineVersion,
+		Version:        version,
+		BuildNumber:    cfg.App.Build,
 		Icon:           strings.TrimSpace(cfg.App.Icon),
 		IconBackground: strings.TrimSpace(cfg.App.IconBackground),
+		IconForeground: strings.TrimSpace(cfg.App.IconForeground),
+		IconMonochrome: strings.TrimSpace(cfg.App.IconMonochrome),
+		IconDark:       strings.TrimSpace(cfg.App.IconDark),
+		IconTinted:     strings.TrimSpace(cfg.App.IconTinted),
+		Splash:         splash,
+		Localizations:  cfg.App.Localizations,
+		Native:         cfg.Native,
+		WatchIgnore:    watchIgnore,
+		WatchDebounce:  watchDebounce,
+		Changelog:      changelog,
+		Fonts:          fonts,
+		Typography:     typography,
 	}, nil
 }
 
+// normalizeSplash trims the splash settings and validates them. Colors and
+// images are checked when the assets are generated.
+func normalizeSplash(splash SplashConfig) (SplashConfig, error) {
+	splash.Background = strings.TrimSpace(splash.Background)
+	splash.DarkBackground = strings.TrimSpace(splash.DarkBackground)
+	splash.Image = strings.TrimSpace(splash.Image)
+	splash.AnimatedIcon = strings.TrimSpace(splash.AnimatedIcon)
+	if splash.AnimatedIcon != "" && !strings.EqualFold(filepath.Ext(splash.AnimatedIcon), ".xml") {
+		return splash, fmt.Errorf("app.splash.animated_icon must be an AnimatedVectorDrawable .xml file (got %q)", splash.AnimatedIcon)
+	}
+	if splash.AnimationDuration < 0 {
+		return splash, fmt.Errorf("app.splash.animation_duration must not be negative (got %d)", splash.AnimationDuration)
+	}
+	return splash, nil
+}
+
+// normalizeWatch trims the ignore patterns, checks their syntax, and parses
+// the debounce interval.
+func normalizeWatch(watch WatchConfig) ([]string, time.Duration, error) {
+	var ignore []string
+	for _, pattern := range watch.Ignore {
+		pattern = strings.Trim(strings.TrimSpace(pattern), "/")
+		if pattern == "" {
+			continue
+		}
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, 0, fmt.Errorf("watch.ignore: invalid pattern %q: %w", pattern, err)
+		}
+		ignore = append(ignore, pattern)
+	}
+
+	debounce := DefaultWatchDebounce
+	if s := strings.TrimSpace(watch.Debounce); s != "" {
+		d, err := time.ParseDuration(s)
+		if err != nil || d < 0 {
+			return nil, 0, fmt.Errorf("watch.debounce must be a non-negative duration such as 300ms (got %q)", watch.Debounce)
+		}
+		debounce = d
+	}
+	return ignore, debounce, nil
+}
+
+// validateLocalizations checks locale codes and Info.plist keys.
+func validateLocalizations(localizations map[string]LocalizationConfig) error {
+	for locale, l := range localizations {
+		if !isLocaleCode(locale) {
+			return fmt.Errorf("app.localizations: invalid locale %q (use a language code with optional script or region, e.g. fr, pt-BR, zh-Hans)", locale)
+		}
+		for key := range l.UsageDescriptions {
+			if key == "" || strings.IndexFunc(key, func(r rune) bool { return !isASCIIAlnum(r) }) >= 0 {
+				return fmt.Errorf("app.localizations.%s.usage_descriptions: invalid Info.plist key %q", locale, key)
+			}
+		}
+	}
+	return nil
+}
+
+// isLocaleCode reports whether s is a BCP 47 style locale code: a 2 or 3
+// letter lowercase language, followed by hyphen-separated subtags.
+func isLocaleCode(s string) bool {
+	parts := strings.Split(s, "-")
+	lang := parts[0]
+	if len(lang) < 2 || len(lang) > 3 || strings.ToLower(lang) != lang {
+		return false
+	}
+	for _, r := range lang {
+		if r < 'a' || r > 'z' {
+			return false
+		}
+	}
+	for _, subtag := range parts[1:] {
+		if len(subtag) < 2 || len(subtag) > 8 || strings.IndexFunc(subtag, func(r rune) bool { return !isASCIIAlnum(r) }) >= 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func isASCIIAlnum(r rune) bool {
+	return r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9'
+}
+
 // FindProjectRoot walks up from the current directory to find go.mod.
 func FindProjectRoot() (string, error) {
 	dir, err := os.Getwd()
@@ -4,6 +4,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 )
 
 // --- defaultAppName ---
@@ -218,6 +219,177 @@ func TestValidateAppID_InvalidChars(t *testing.T) {
 	}
 }
 
+// --- normalizeSplash ---
+
+func TestNormalizeSplash(t *testing.T) {
+	splash, err := normalizeSplash(SplashConfig{Background: " #FFFFFF ", AnimatedIcon: "assets/splash.xml", AnimationDuration: 800})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if splash.Background != "#FFFFFF" {
+		t.Errorf("expected background trimmed, got %q", splash.Background)
+	}
+
+	if _, err := normalizeSplash(SplashConfig{AnimatedIcon: "assets/splash.gif"}); err == nil {
+		t.Error("expected error for a non-XML animated icon")
+	}
+	if _, err := normalizeSplash(SplashConfig{AnimationDuration: -1}); err == nil {
+		t.Error("expected error for a negative duration")
+	}
+}
+
+// --- validateLocalizations ---
+
+func TestValidateLocalizations(t *testing.T) {
+	valid := map[string]LocalizationConfig{
+		"fr":      {Name: "Démo", UsageDescriptions: map[string]string{"NSCameraUsageDescription": "Scanner"}},
+		"pt-BR":   {Name: "Demonstração"},
+		"zh-Hans": {Name: "演示"},
+	}
+	if err := validateLocalizations(valid); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	invalid := map[string]map[string]LocalizationConfig{
+		"uppercase language": {"FR": {Name: "x"}},
+		"underscore":         {"pt_BR": {Name: "x"}},
+		"empty subtag":       {"pt-": {Name: "x"}},
+		"invalid plist key":  {"fr": {UsageDescriptions: map[string]string{"NSCamera Usage": "x"}}},
+	}
+	for name, l := range invalid {
+		if err := validateLocalizations(l); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+}
+
+// --- normalizeWatch ---
+
+func TestNormalizeWatch(t *testing.T) {
+	ignore, debounce, err := normalizeWatch(WatchConfig{})
+	if err != nil || ignore != nil || debounce != DefaultWatchDebounce {
+		t.Errorf("expected defaults, got %v, %v, %v", ignore, debounce, err)
+	}
+
+	ignore, debounce, err = normalizeWatch(WatchConfig{Ignore: []string{" gen/ ", "", "*_gen.go"}, Debounce: "250ms"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(ignore) != 2 || ignore[0] != "gen" || ignore[1] != "*_gen.go" {
+		t.Errorf("expected trimmed patterns, got %q", ignore)
+	}
+	if debounce != 250*time.Millisecond {
+		t.Errorf("expected 250ms, got %v", debounce)
+	}
+
+	for _, bad := range []WatchConfig{{Ignore: []string{"[gen"}}, {Debounce: "soon"}, {Debounce: "-1s"}} {
+		if _, _, err := normalizeWatch(bad); err == nil {
+			t.Errorf("normalizeWatch(%+v): expected error", bad)
+		}
+	}
+}
+
+// --- normalizeFonts ---
+
+func TestNormalizeFonts(t *testing.T) {
+	root := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(root, "assets", "fonts"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"Inter-Regular.ttf", "Inter-BoldItalic.otf", "notes.txt"} {
+		if err := os.WriteFile(filepath.Join(root, "assets", "fonts", name), []byte("font"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	fonts, typography, err := normalizeFonts(root, []FontFamilyConfig{{
+		Family: " Inter ",
+		Files: []FontFileConfig{
+			{Path: "assets/fonts/Inter-Regular.ttf"},
+			{Path: "assets/fonts/Inter-BoldItalic.otf", Weight: 700, Style: "Italic"},
+		},
+	}}, TypographyConfig{Display: "Inter", Body: " Inter ", Fallbacks: []string{" Noto Sans CJK JP ", ""}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if fonts[0].Family != "Inter" || typography.Body != "Inter" {
+		t.Errorf("expected trimmed families, got %q and %q", fonts[0].Family, typography.Body)
+	}
+	if len(typography.Fallbacks) != 1 || typography.Fallbacks[0] != "Noto Sans CJK JP" {
+		t.Errorf("expected trimmed fallbacks, got %q", typography.Fallbacks)
+	}
+	if f := fonts[0].Files[0]; f.Weight != 400 || f.Style != "normal" {
+		t.Errorf("expected default weight and style, got %d %q", f.Weight, f.Style)
+	}
+	if f := fonts[0].Files[1]; f.Weight != 700 || f.Style != "italic" {
+		t.Errorf("expected 700 italic, got %d %q", f.Weight, f.Style)
+	}
+
+	regular := FontFileConfig{Path: "assets/fonts/Inter-Regular.ttf"}
+	for name, tc := range map[string]struct {
+		fonts      []FontFamilyConfig
+		typography TypographyConfig
+	}{
+		"missing family":    {fonts: []FontFamilyConfig{{Files: []FontFileConfig{regular}}}},
+		"duplicate family":  {fonts: []FontFamilyConfig{{Family: "A", Files: []FontFileConfig{regular}}, {Family: "A", Files: []FontFileConfig{regular}}}},
+		"no files":          {fonts: []FontFamilyConfig{{Family: "A"}}},
+		"missing file":      {fonts: []FontFamilyConfig{{Family: "A", Files: []FontFileConfig{{Path: "assets/fonts/Nope.ttf"}}}}},
+		"not a font":        {fonts: []FontFamilyConfig{{Family: "A", Files: []FontFileConfig{{Path: "assets/fonts/notes.txt"}}}}},
+		"outside project":   {fonts: []FontFamilyConfig{{Family: "A", Files: []FontFileConfig{{Path: "../Inter.ttf"}}}}},
+		"hidden directory":  {fonts: []FontFamilyConfig{{Family: "A", Files: []FontFileConfig{{Path: ".fonts/Inter.ttf"}}}}},
+		"bad weight":        {fonts: []FontFamilyConfig{{Family: "A", Files: []FontFileConfig{{Path: regular.Path, Weight: 450}}}}},
+		"bad style":         {fonts: []FontFamilyConfig{{Family: "A", Files: []FontFileConfig{{Path: regular.Path, Style: "oblique"}}}}},
+		"duplicate face":    {fonts: []FontFamilyConfig{{Family: "A", Files: []FontFileConfig{regular, {Path: regular.Path, Weight: 400}}}}},
+		"undeclared family": {fonts: []FontFamilyConfig{{Family: "A", Files: []FontFileConfig{regular}}}, typography: TypographyConfig{Body: "B"}},
+	} {
+		if _, _, err := normalizeFonts(root, tc.fonts, tc.typography); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+}
+
+// --- NativeConfig.normalize ---
+
+func TestNativeConfigNormalize_Valid(t *testing.T) {
+	n := NativeConfig{
+		Android: AndroidNativeConfig{
+			Dependencies: []string{" com.squareup.okhttp3:okhttp:4.12.0 "},
+			Permissions:  []string{"android.permission.NFC"},
+			Application:  []string{`<meta-data android:name="k" android:value="v" />`},
+		},
+		IOS: IOSNativeConfig{
+			Pods:     []Pod{{Name: "GoogleMaps", Version: "~> 8.4"}},
+			Packages: []SwiftPackage{{URL: "https://github.com/apple/swift-collections.git", Version: "1.1.0", Products: []string{"Collections"}}},
+			Plist:    map[string]any{"UIFileSharingEnabled": true},
+		},
+	}
+	if err := n.normalize(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := n.Android.Dependencies[0]; got != "com.squareup.okhttp3:okhttp:4.12.0" {
+		t.Errorf("expected dependency trimmed, got %q", got)
+	}
+	if n.IsEmpty() {
+		t.Error("expected IsEmpty to be false")
+	}
+}
+
+func TestNativeConfigNormalize_Invalid(t *testing.T) {
+	invalid := map[string]NativeConfig{
+		"dependency without version": {Android: AndroidNativeConfig{Dependencies: []string{"com.example:lib"}}},
+		"permission with quote":      {Android: AndroidNativeConfig{Permissions: []string{`bad"perm`}}},
+		"application not XML":        {Android: AndroidNativeConfig{Application: []string{"meta-data"}}},
+		"pod without name":           {IOS: IOSNativeConfig{Pods: []Pod{{Version: "1.0"}}}},
+		"package without products":   {IOS: IOSNativeConfig{Packages: []SwiftPackage{{URL: "https://example.com/pkg.git", Version: "1.0.0"}}}},
+		"empty plist key":            {IOS: IOSNativeConfig{Plist: map[string]any{" ": "x"}}},
+	}
+	for name, n := range invalid {
+		if err := n.normalize(); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+}
+
 // --- parseMajorMinor ---
 
 func TestParseMajorMinor(t *testing.T) {
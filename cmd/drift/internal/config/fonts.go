@@ -0,0 +1,142 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// FontFamilyConfig declares a font family bundled into the app.
+type FontFamilyConfig struct {
+	// Family is the name text styles use to select the font.
+	Family string `yaml:"family"`
+	// Files lists the family's font files, one per weight and style.
+	Files []FontFileConfig `yaml:"files"`
+}
+
+// FontFileConfig is one .ttf or .otf file of a bundled family.
+type FontFileConfig struct {
+	// Path is the font file, relative to the project root.
+	Path string `yaml:"path"`
+	// Weight is the file's weight, 100 to 900 in steps of 100. Default 400.
+	Weight int `yaml:"weight,omitempty"`
+	// Style is "normal" (the default) or "italic".
+	Style string `yaml:"style,omitempty"`
+}
+
+// TypographyConfig chooses the bundled families of the default text themes.
+type TypographyConfig struct {
+	// Display is the family of display, headline, and title styles. Empty
+	// means the system font.
+	Display string `yaml:"display,omitempty"`
+	// Body is the family of body and label styles. Empty means the system
+	// font.
+	Body string `yaml:"body,omitempty"`
+	// Fallbacks are families tried, in order, for characters the text's own
+	// family lacks, such as a bundled CJK font. They may be bundled or
+	// system families.
+	Fallbacks []string `yaml:"fallbacks,omitempty"`
+}
+
+// normalizeFonts trims the font and typography settings, fills in default
+// weights and styles, and checks that every file exists under root and can
+// be embedded, and that the typography families are declared.
+func normalizeFonts(root string, fonts []FontFamilyConfig, typography TypographyConfig) ([]FontFamilyConfig, TypographyConfig, error) {
+	families := make(map[string]bool, len(fonts))
+	for i := range fonts {
+		family := &fonts[i]
+		family.Family = strings.TrimSpace(family.Family)
+		if family.Family == "" {
+			return nil, typography, fmt.Errorf("fonts[%d].family is required", i)
+		}
+		if families[family.Family] {
+			return nil, typography, fmt.Errorf("fonts: family %q is declared twice", family.Family)
+		}
+		families[family.Family] = true
+		if len(family.Files) == 0 {
+			return nil, typography, fmt.Errorf("fonts: family %q has no files", family.Family)
+		}
+
+		faces := make(map[string]bool, len(family.Files))
+		for j := range family.Files {
+			file := &family.Files[j]
+			if err := normalizeFontFile(root, file); err != nil {
+				return nil, typography, fmt.Errorf("fonts: family %q: %w", family.Family, err)
+			}
+			face := fmt.Sprintf("%d %s", file.Weight, file.Style)
+			if faces[face] {
+				return nil, typography, fmt.Errorf("fonts: family %q has two %s files", family.Family, face)
+			}
+			faces[face] = true
+		}
+	}
+
+	typography.Display = strings.TrimSpace(typography.Display)
+	typography.Body = strings.TrimSpace(typography.Body)
+	var fallbacks []string
+	for _, family := range typography.Fallbacks {
+		if family = strings.TrimSpace(family); family != "" {
+			fallbacks = append(fallbacks, family)
+		}
+	}
+	typography.Fallbacks = fallbacks
+	for _, family := range []struct{ key, name string }{
+		{"typography.display", typography.Display},
+		{"typography.body", typography.Body},
+	} {
+		if family.name != "" && !families[family.name] {
+			return nil, typography, fmt.Errorf("%s: family %q is not declared under fonts", family.key, family.name)
+		}
+	}
+	return fonts, typography, nil
+}
+
+func normalizeFontFile(root string, file *FontFileConfig) error {
+	file.Path = filepath.ToSlash(strings.TrimSpace(file.Path))
+	if file.Path == "" {
+		return errors.New("file path is required")
+	}
+	// Font files are compiled in with go:embed, which only reads files
+	// inside the module and skips names starting with "." or "_".
+	if !filepath.IsLocal(file.Path) {
+		return fmt.Errorf("%s must be a relative path inside the project", file.Path)
+	}
+	for _, part := range strings.Split(file.Path, "/") {
+		if strings.HasPrefix(part, ".") || strings.HasPrefix(part, "_") {
+			return fmt.Errorf("%s: path elements must not start with \".\" or \"_\"", file.Path)
+		}
+	}
+	if strings.ContainsAny(file.Path, "*?[]\"`") {
+		return fmt.Errorf("%s: path must not contain glob characters or quotes", file.Path)
+	}
+	switch strings.ToLower(filepath.Ext(file.Path)) {
+	case ".ttf", ".otf":
+	default:
+		return fmt.Errorf("%s must be a .ttf or .otf file", file.Path)
+	}
+	info, err := os.Stat(filepath.Join(root, filepath.FromSlash(file.Path)))
+	if err != nil {
+		return fmt.Errorf("font file %s: %w", file.Path, err)
+	}
+	if info.IsDir() {
+		return fmt.Errorf("%s is a directory", file.Path)
+	}
+
+	if file.Weight == 0 {
+		file.Weight = 400
+	}
+	if file.Weight < 100 || file.Weight > 900 || file.Weight%100 != 0 {
+		return fmt.Errorf("%s: weight must be 100 to 900 in steps of 100 (got %d)", file.Path, file.Weight)
+	}
+	file.Style = strings.ToLower(strings.TrimSpace(file.Style))
+	switch file.Style {
+	case "":
+		file.Style = "normal"
+	case "normal", "italic":
+	default:
+		return fmt.Errorf("%s: style must be normal or italic (got %q)", file.Path, file.Style)
+	}
+	return nil
+}
@@ -0,0 +1,116 @@
+package config
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// NativeConfig declares native dependencies and project entries that the CLI
+// injects into the generated (or ejected) platform projects on every build.
+type NativeConfig struct {
+	Android AndroidNativeConfig `yaml:"android,omitempty"`
+	IOS     IOSNativeConfig     `yaml:"ios,omitempty"`
+}
+
+// AndroidNativeConfig lists additions to the Android project.
+type AndroidNativeConfig struct {
+	// Dependencies are Gradle coordinates ("group:artifact:version") added
+	// as implementation dependencies of the app module.
+	Dependencies []string `yaml:"dependencies,omitempty"`
+	// Permissions are permission names (e.g. "android.permission.NFC")
+	// added as <uses-permission> entries.
+	Permissions []string `yaml:"permissions,omitempty"`
+	// Application holds raw XML elements, such as <meta-data>, added inside
+	// the manifest's <application> element.
+	Application []string `yaml:"application,omitempty"`
+}
+
+// IOSNativeConfig lists additions to the iOS projects.
+type IOSNativeConfig struct {
+	// Pods are CocoaPods dependencies for Xcode builds.
+	Pods []Pod `yaml:"pods,omitempty"`
+	// Packages are Swift packages for xtool builds.
+	Packages []SwiftPackage `yaml:"packages,omitempty"`
+	// Plist holds Info.plist entries, such as usage descriptions. Values may
+	// be strings, numbers, booleans, lists or maps.
+	Plist map[string]any `yaml:"plist,omitempty"`
+}
+
+// Pod is a CocoaPods dependency.
+type Pod struct {
+	Name    string `yaml:"name"`
+	Version string `yaml:"version,omitempty"` // e.g. "~> 8.0"; empty for latest
+}
+
+// SwiftPackage is a Swift package dependency.
+type SwiftPackage struct {
+	URL      string   `yaml:"url"`
+	Version  string   `yaml:"version"`  // minimum version, "from:" semantics
+	Products []string `yaml:"products"` // products linked into the app target
+}
+
+// IsEmpty reports whether no native additions are declared.
+func (n NativeConfig) IsEmpty() bool {
+	a, i := n.Android, n.IOS
+	return len(a.Dependencies) == 0 && len(a.Permissions) == 0 && len(a.Application) == 0 &&
+		len(i.Pods) == 0 && len(i.Packages) == 0 && len(i.Plist) == 0
+}
+
+// normalize trims the entries of n and validates them.
+func (n *NativeConfig) normalize() error {
+	a := &n.Android
+	for i, dep := range a.Dependencies {
+		dep = strings.TrimSpace(dep)
+		if parts := strings.Split(dep, ":"); len(parts) < 3 || slices.ContainsFunc(parts, isBlank) {
+			return fmt.Errorf("native.android.dependencies: %q is not a Gradle coordinate (group:artifact:version)", dep)
+		}
+		a.Dependencies[i] = dep
+	}
+	for i, perm := range a.Permissions {
+		perm = strings.TrimSpace(perm)
+		if perm == "" || strings.ContainsAny(perm, " \"<>") {
+			return fmt.Errorf("native.android.permissions: invalid permission %q", perm)
+		}
+		a.Permissions[i] = perm
+	}
+	for i, element := range a.Application {
+		element = strings.TrimSpace(element)
+		if !strings.HasPrefix(element, "<") || !strings.HasSuffix(element, ">") {
+			return fmt.Errorf("native.android.application: entry must be an XML element (got %q)", element)
+		}
+		a.Application[i] = element
+	}
+
+	ios := &n.IOS
+	for i := range ios.Pods {
+		pod := &ios.Pods[i]
+		pod.Name, pod.Version = strings.TrimSpace(pod.Name), strings.TrimSpace(pod.Version)
+		if pod.Name == "" || strings.ContainsAny(pod.Name+pod.Version, "'\n") {
+			return fmt.Errorf("native.ios.pods: invalid pod %q", pod.Name)
+		}
+	}
+	for i := range ios.Packages {
+		pkg := &ios.Packages[i]
+		pkg.URL, pkg.Version = strings.TrimSpace(pkg.URL), strings.TrimSpace(pkg.Version)
+		if pkg.URL == "" || pkg.Version == "" {
+			return fmt.Errorf("native.ios.packages: url and version are required (got %q)", pkg.URL)
+		}
+		if len(pkg.Products) == 0 {
+			return fmt.Errorf("native.ios.packages: %s must list at least one product", pkg.URL)
+		}
+		if strings.ContainsAny(pkg.URL+pkg.Version+strings.Join(pkg.Products, ""), "\"\\\n") {
+			return fmt.Errorf("native.ios.packages: invalid characters in %q", pkg.URL)
+		}
+	}
+	for key := range ios.Plist {
+		if strings.TrimSpace(key) == "" {
+			return fmt.Errorf("native.ios.plist: keys must not be empty")
+		}
+	}
+	return nil
+}
+
+func isBlank(s string) bool {
+	return strings.TrimSpace(s) == ""
+}
@@ -0,0 +1,234 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+// MaxBuildNumber is the largest build number, the highest versionCode
+// Google Play accepts.
+const MaxBuildNumber = 2100000000
+
+// ChangelogConfig contains settings for the release notes written by
+// drift version bump.
+type ChangelogConfig struct {
+	// File is a Markdown changelog with an "Unreleased" section. Each bump
+	// moves its notes under a heading for the new version.
+	File string `yaml:"file,omitempty"`
+	// Heading is the template for the new version's heading. See
+	// DefaultChangelogHeading.
+	Heading string `yaml:"heading,omitempty"`
+	// StoreNotes lists release notes files to write for store submissions.
+	StoreNotes []StoreNotesConfig `yaml:"store_notes,omitempty"`
+}
+
+// StoreNotesConfig describes one release notes file, such as the
+// "What's new" text for Google Play or the App Store.
+type StoreNotesConfig struct {
+	// Path is the file to write, relative to the project root. It is a
+	// template, e.g. "fastlane/metadata/android/en-US/changelogs/{{.Build}}.txt".
+	Path string `yaml:"path"`
+	// Template renders the file content. Default "{{.Notes}}".
+	Template string `yaml:"template,omitempty"`
+	// MaxLength warns when the notes are longer, e.g. 500 for Google Play.
+	MaxLength int `yaml:"max_length,omitempty"`
+}
+
+// DefaultChangelogHeading is the changelog heading used when
+// changelog.heading is unset.
+const DefaultChangelogHeading = "## {{.Version}} ({{.Build}}) - {{.Date}}"
+
+// validateAppVersion checks that version is one to three dot-separated
+// non-negative integers, as both stores require, and that build is in
+// range.
+func validateAppVersion(version string, build int) error {
+	if version != "" {
+		if _, err := ParseAppVersion(version); err != nil {
+			return fmt.Errorf("app.version: %w", err)
+		}
+	}
+	if build < 0 || build > MaxBuildNumber {
+		return fmt.Errorf("app.build must be between 1 and %d (got %d)", MaxBuildNumber, build)
+	}
+	return nil
+}
+
+// ParseAppVersion parses a version such as "1.4" or "1.4.2" into its
+// numeric components.
+func ParseAppVersion(version string) ([]int, error) {
+	parts := strings.Split(version, ".")
+	if len(parts) > 3 {
+		return nil, fmt.Errorf("%q has more than three components", version)
+	}
+	nums := make([]int, len(parts))
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 || strings.HasPrefix(part, "+") {
+			return nil, fmt.Errorf("%q must be numbers separated by dots, such as 1.4.2", version)
+		}
+		nums[i] = n
+	}
+	return nums, nil
+}
+
+// normalizeChangelog trims the changelog settings and checks that the
+// templates parse.
+func normalizeChangelog(c ChangelogConfig) (ChangelogConfig, error) {
+	c.File = strings.TrimSpace(c.File)
+	if c.Heading == "" {
+		c.Heading = DefaultChangelogHeading
+	}
+	if _, err := template.New("").Parse(c.Heading); err != nil {
+		return c, fmt.Errorf("changelog.heading: %w", err)
+	}
+	if len(c.StoreNotes) > 0 && c.File == "" {
+		return c, errors.New("changelog.store_notes requires changelog.file")
+	}
+	for i := range c.StoreNotes {
+		notes := &c.StoreNotes[i]
+		notes.Path = strings.TrimSpace(notes.Path)
+		if notes.Path == "" {
+			return c, fmt.Errorf("changelog.store_notes[%d].path is required", i)
+		}
+		if notes.Template == "" {
+			notes.Template = "{{.Notes}}"
+		}
+		for _, text := range []string{notes.Path, notes.Template} {
+			if _, err := template.New("").Parse(text); err != nil {
+				return c, fmt.Errorf("changelog.store_notes[%d]: %w", i, err)
+			}
+		}
+	}
+	return c, nil
+}
+
+// SetAppVersion writes app.version and app.build to drift.yaml in dir,
+// creating the file if needed. The file is edited line by line so comments
+// and formatting are kept.
+func SetAppVersion(dir, version string, build int) error {
+	path := filepath.Join(dir, "drift.yaml")
+	data, err := os.ReadFile(path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to read drift.yaml: %w", err)
+	}
+	content, err := setAppVersion(string(data), version, build)
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		return fmt.Errorf("failed to write drift.yaml: %w", err)
+	}
+	return nil
+}
+
+func setAppVersion(content, version string, build int) (string, error) {
+	values := map[string]string{
+		"version": strconv.Quote(version),
+		"build":   strconv.Itoa(build),
+	}
+	lines := strings.Split(content, "\n")
+	if content == "" {
+		lines = nil
+	}
+
+	// Find the top-level app: key and the extent of its block.
+	appLine := -1
+	for i, line := range lines {
+		if key, rest, ok := yamlKey(line); ok && key == "app" && indentOf(line) == 0 {
+			if rest != "" {
+				return "", errors.New("drift.yaml: cannot update an inline app: value; set app.version and app.build by hand")
+			}
+			appLine = i
+			break
+		}
+	}
+	if appLine < 0 {
+		// Append an app block, after a blank line if the file has content.
+		for len(lines) > 0 && strings.TrimSpace(lines[len(lines)-1]) == "" {
+			lines = lines[:len(lines)-1]
+		}
+		if len(lines) > 0 {
+			lines = append(lines, "")
+		}
+		lines = append(lines, "app:", "  version: "+values["version"], "  build: "+values["build"], "")
+		return strings.Join(lines, "\n"), nil
+	}
+
+	end := len(lines)
+	childIndent := -1
+	for i := appLine + 1; i < len(lines); i++ {
+		trimmed := strings.TrimSpace(lines[i])
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") {
+			continue
+		}
+		indent := indentOf(lines[i])
+		if indent == 0 {
+			end = i
+			break
+		}
+		if childIndent < 0 {
+			childIndent = indent
+		}
+	}
+	if childIndent < 0 {
+		childIndent = 2
+	}
+	pad := strings.Repeat(" ", childIndent)
+
+	// Replace existing keys in place, keeping trailing comments.
+	found := map[string]bool{}
+	for i := appLine + 1; i < end; i++ {
+		if indentOf(lines[i]) != childIndent {
+			continue
+		}
+		key, rest, ok := yamlKey(lines[i])
+		value, known := values[key]
+		if !ok || !known {
+			continue
+		}
+		comment := ""
+		if j := strings.Index(rest, " #"); j >= 0 {
+			comment = rest[j:]
+		}
+		lines[i] = pad + key + ": " + value + comment
+		found[key] = true
+	}
+
+	// Insert missing keys directly under app:.
+	var missing []string
+	for _, key := range []string{"version", "build"} {
+		if !found[key] {
+			missing = append(missing, pad+key+": "+values[key])
+		}
+	}
+	lines = append(lines[:appLine+1], append(missing, lines[appLine+1:]...)...)
+	return strings.Join(lines, "\n"), nil
+}
+
+// yamlKey splits a block mapping line such as "  name: value # note" into
+// its key and the rest after the colon, trimmed. Comments and list items
+// are not keys.
+func yamlKey(line string) (key, rest string, ok bool) {
+	trimmed := strings.TrimSpace(line)
+	if trimmed == "" || strings.HasPrefix(trimmed, "#") || strings.HasPrefix(trimmed, "-") {
+		return "", "", false
+	}
+	key, rest, ok = strings.Cut(trimmed, ":")
+	if !ok || strings.ContainsAny(key, " \t\"'") {
+		return "", "", false
+	}
+	rest = strings.TrimSpace(rest)
+	if strings.HasPrefix(rest, "#") {
+		rest = ""
+	}
+	return key, rest, true
+}
+
+func indentOf(line string) int {
+	return len(line) - len(strings.TrimLeft(line, " "))
+}
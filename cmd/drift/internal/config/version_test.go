@@ -0,0 +1,93 @@
+package config
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseAppVersion(t *testing.T) {
+	for _, v := range []string{"1", "1.4", "1.4.2", "10.0.0"} {
+		if _, err := ParseAppVersion(v); err != nil {
+			t.Errorf("ParseAppVersion(%q) failed: %v", v, err)
+		}
+	}
+	for _, v := range []string{"", "1.4.2.1", "1.x", "v1.2", "1.-2", "1..2"} {
+		if _, err := ParseAppVersion(v); err == nil {
+			t.Errorf("ParseAppVersion(%q) succeeded, want error", v)
+		}
+	}
+}
+
+func TestSetAppVersion_UpdatesInPlace(t *testing.T) {
+	in := `# My app
+app:
+  name: demo   # shown on the home screen
+  version: "1.0.0" # keep in sync
+  build: 3
+
+engine:
+  version: latest
+`
+	got, err := setAppVersion(in, "1.1.0", 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `# My app
+app:
+  name: demo   # shown on the home screen
+  version: "1.1.0" # keep in sync
+  build: 4
+
+engine:
+  version: latest
+`
+	if got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestSetAppVersion_InsertsKeys(t *testing.T) {
+	got, err := setAppVersion("app:\n    name: demo\nengine:\n    version: latest\n", "1.0.1", 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "app:\n    version: \"1.0.1\"\n    build: 2\n    name: demo\nengine:\n    version: latest\n"
+	if got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+
+	got, err = setAppVersion("engine:\n  version: latest\n", "1.0.1", 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasSuffix(got, "\n\napp:\n  version: \"1.0.1\"\n  build: 2\n") {
+		t.Errorf("expected an appended app block, got:\n%s", got)
+	}
+
+	if _, err := setAppVersion("app: {name: demo}\n", "1.0.1", 2); err == nil {
+		t.Error("expected an error for an inline app value")
+	}
+}
+
+func TestNormalizeChangelog(t *testing.T) {
+	c, err := normalizeChangelog(ChangelogConfig{
+		File:       " CHANGELOG.md ",
+		StoreNotes: []StoreNotesConfig{{Path: "notes/{{.Build}}.txt"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.File != "CHANGELOG.md" || c.Heading != DefaultChangelogHeading || c.StoreNotes[0].Template != "{{.Notes}}" {
+		t.Errorf("unexpected defaults %+v", c)
+	}
+
+	for _, bad := range []ChangelogConfig{
+		{StoreNotes: []StoreNotesConfig{{Path: "notes.txt"}}},
+		{File: "CHANGELOG.md", Heading: "## {{.Version"},
+		{File: "CHANGELOG.md", StoreNotes: []StoreNotesConfig{{Path: ""}}},
+	} {
+		if _, err := normalizeChangelog(bad); err == nil {
+			t.Errorf("expected an error for %+v", bad)
+		}
+	}
+}
@@ -1,8 +1,10 @@
-// Package icongen generates app icons for Android and iOS from a single source image.
+// Package icongen generates app icons and launch screen assets for Android
+// and iOS from a single source image.
 package icongen
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"image"
 	"image/color"
@@ -10,6 +12,7 @@ import (
 	"image/png"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"golang.org/x/image/draw"
 )
@@ -17,32 +20,44 @@ import (
 // IconSource holds a decoded source image for icon generation.
 type IconSource struct {
 	img image.Image
+
+	// Optional variants, nil unless set by LoadVariants.
+	foreground, background, monochrome image.Image
+	dark, tinted                       image.Image
 }
 
 // LoadSource loads the icon source image. If iconPath is empty, the embedded
 // default icon is used. Otherwise the image is read from projectRoot/iconPath.
 // The source image must be at least 1024x1024.
 func LoadSource(projectRoot, iconPath string) (*IconSource, error) {
-	var img image.Image
-	var err error
-
 	if iconPath == "" {
-		img, err = png.Decode(bytes.NewReader(defaultIconPNG))
+		img, err := png.Decode(bytes.NewReader(defaultIconPNG))
 		if err != nil {
 			return nil, fmt.Errorf("failed to decode embedded default icon: %w", err)
 		}
-	} else {
-		path := filepath.Join(projectRoot, iconPath)
-		f, err := os.Open(path)
-		if err != nil {
-			return nil, fmt.Errorf("failed to open icon %s: %w", path, err)
-		}
-		defer f.Close()
+		return &IconSource{img: img}, nil
+	}
 
-		img, _, err = image.Decode(f)
-		if err != nil {
-			return nil, fmt.Errorf("failed to decode icon %s: %w", path, err)
-		}
+	img, err := loadImage(projectRoot, iconPath)
+	if err != nil {
+		return nil, err
+	}
+	return &IconSource{img: img}, nil
+}
+
+// loadImage reads the image at projectRoot/path and checks that it is a
+// square of at least 1024x1024.
+func loadImage(projectRoot, path string) (image.Image, error) {
+	path = filepath.Join(projectRoot, path)
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open icon %s: %w", path, err)
+	}
+	defer f.Close()
+
+	img, _, err := image.Decode(f)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode icon %s: %w", path, err)
 	}
 
 	bounds := img.Bounds()
@@ -53,8 +68,53 @@ func LoadSource(projectRoot, iconPath string) (*IconSource, error) {
 	if w != h {
 		return nil, fmt.Errorf("icon must be square (got %dx%d)", w, h)
 	}
+	return img, nil
+}
 
-	return &IconSource{img: img}, nil
+// Variants lists optional images used alongside the main icon. Paths are
+// relative to the project root and empty fields are skipped. Every image
+// must meet the same size requirements as the main icon.
+type Variants struct {
+	// Foreground is the Android adaptive icon foreground layer. It covers
+	// the full 108dp canvas, so keep content inside the centered 72dp safe
+	// zone. Without it, the main icon is scaled into the safe zone.
+	Foreground string
+	// Background is the Android adaptive icon background layer, used
+	// instead of a solid color.
+	Background string
+	// Monochrome is the Android 13+ themed icon layer. Like Foreground, it
+	// covers the full adaptive canvas; only its alpha channel is used.
+	Monochrome string
+	// Dark is the iOS 18+ dark appearance icon, usually drawn on a
+	// transparent background.
+	Dark string
+	// Tinted is the iOS 18+ tinted appearance icon, a grayscale image the
+	// system colors with the user's tint.
+	Tinted string
+}
+
+// LoadVariants loads the variant images listed in v into s.
+func (s *IconSource) LoadVariants(projectRoot string, v Variants) error {
+	for _, variant := range []struct {
+		path string
+		dst  *image.Image
+	}{
+		{v.Foreground, &s.foreground},
+		{v.Background, &s.background},
+		{v.Monochrome, &s.monochrome},
+		{v.Dark, &s.dark},
+		{v.Tinted, &s.tinted},
+	} {
+		if variant.path == "" {
+			continue
+		}
+		img, err := loadImage(projectRoot, variant.path)
+		if err != nil {
+			return err
+		}
+		*variant.dst = img
+	}
+	return nil
 }
 
 // androidDensity describes a single Android mipmap density bucket.
@@ -72,14 +132,26 @@ var androidDensities = []androidDensity{
 	{"mipmap-xxxhdpi", 192, 432},
 }
 
-const adaptiveIconXML = `<?xml version="1.0" encoding="utf-8"?>
+// adaptiveIconXML returns the adaptive icon definition, with a monochrome
+// layer for themed icons if one is generated.
+func adaptiveIconXML(monochrome bool) string {
+	var b strings.Builder
+	b.WriteString(`<?xml version="1.0" encoding="utf-8"?>
 <adaptive-icon xmlns:android="http://schemas.android.com/apk/res/android">
   <background android:drawable="@mipmap/ic_launcher_adaptive_back"/>
   <foreground android:drawable="@mipmap/ic_launcher_adaptive_fore"/>
-</adaptive-icon>`
+`)
+	if monochrome {
+		b.WriteString(`  <monochrome android:drawable="@mipmap/ic_launcher_adaptive_mono"/>
+`)
+	}
+	b.WriteString(`</adaptive-icon>`)
+	return b.String()
+}
 
 // GenerateAndroid generates all mipmap directories and the adaptive icon XML
-// into resDir. bgColor sets the adaptive icon background (default "#FFFFFF").
+// into resDir. bgColor sets the adaptive icon background (default "#FFFFFF")
+// unless a background layer was loaded with LoadVariants.
 func (s *IconSource) GenerateAndroid(resDir, bgColor string) error {
 	if bgColor == "" {
 		bgColor = "#FFFFFF"
@@ -101,18 +173,39 @@ func (s *IconSource) GenerateAndroid(resDir, bgColor string) error {
 			return err
 		}
 
-		// Adaptive foreground (icon centered in inner 2/3 of canvas for safe zone,
-		// canvas filled with bg color to avoid interpolation fringe artifacts)
-		fore := adaptiveForeground(s.img, d.adaptiveSize, bg)
+		// Adaptive foreground. A dedicated layer covers the whole canvas;
+		// otherwise the icon is centered in the inner 2/3 of the canvas for
+		// the safe zone, with the canvas filled with bg color to avoid
+		// interpolation fringe artifacts.
+		var fore *image.NRGBA
+		switch {
+		case s.foreground != nil:
+			fore = resizeImage(s.foreground, d.adaptiveSize)
+		case s.background != nil:
+			fore = adaptiveForeground(s.img, d.adaptiveSize, color.NRGBA{})
+		default:
+			fore = adaptiveForeground(s.img, d.adaptiveSize, bg)
+		}
 		if err := writePNG(filepath.Join(dir, "ic_launcher_adaptive_fore.png"), fore); err != nil {
 			return err
 		}
 
-		// Adaptive background (solid color)
+		// Adaptive background (image layer or solid color)
 		back := solidColorImage(d.adaptiveSize, bg)
+		if s.background != nil {
+			back = resizeImage(s.background, d.adaptiveSize)
+		}
 		if err := writePNG(filepath.Join(dir, "ic_launcher_adaptive_back.png"), back); err != nil {
 			return err
 		}
+
+		// Themed icon layer (Android 13+)
+		if s.monochrome != nil {
+			mono := resizeImage(s.monochrome, d.adaptiveSize)
+			if err := writePNG(filepath.Join(dir, "ic_launcher_adaptive_mono.png"), mono); err != nil {
+				return err
+			}
+		}
 	}
 
 	// Write adaptive-icon XML for API 26+
@@ -120,7 +213,7 @@ func (s *IconSource) GenerateAndroid(resDir, bgColor string) error {
 	if err := os.MkdirAll(anydpiDir, 0o755); err != nil {
 		return fmt.Errorf("failed to create %s: %w", anydpiDir, err)
 	}
-	if err := os.WriteFile(filepath.Join(anydpiDir, "ic_launcher.xml"), []byte(adaptiveIconXML), 0o644); err != nil {
+	if err := os.WriteFile(filepath.Join(anydpiDir, "ic_launcher.xml"), []byte(adaptiveIconXML(s.monochrome != nil)), 0o644); err != nil {
 		return fmt.Errorf("failed to write adaptive icon XML: %w", err)
 	}
 
@@ -131,17 +224,23 @@ const assetCatalogContentsJSON = `{
   "info": { "version": 1, "author": "xcode" }
 }`
 
-const appIconContentsJSON = `{
-  "images": [
-    {
-      "filename": "icon.png",
-      "idiom": "universal",
-      "platform": "ios",
-      "size": "1024x1024"
-    }
-  ],
-  "info": { "version": 1, "author": "xcode" }
-}`
+// appIconImage is an entry of an app icon set's Contents.json.
+type appIconImage struct {
+	Filename    string           `json:"filename"`
+	Idiom       string           `json:"idiom"`
+	Platform    string           `json:"platform"`
+	Size        string           `json:"size"`
+	Appearances []iconAppearance `json:"appearances,omitempty"`
+}
+
+// iconAppearance selects an image for a system appearance, such as dark mode.
+type iconAppearance struct {
+	Appearance string `json:"appearance"`
+	Value      string `json:"value"`
+}
+
+// assetInfo is the info block shared by asset catalog Contents.json files.
+var assetInfo = map[string]any{"version": 1, "author": "xcode"}
 
 const launchImageContentsJSON = `{
   "images": [
@@ -165,7 +264,8 @@ const launchImageContentsJSON = `{
 }`
 
 // GenerateIOS generates the Assets.xcassets structure into assetDir,
-// including both AppIcon.appiconset and LaunchImage.imageset.
+// including both AppIcon.appiconset and LaunchImage.imageset. Dark and
+// tinted variants loaded with LoadVariants are added to the icon set.
 func (s *IconSource) GenerateIOS(assetDir string) error {
 	// Contents.json for the asset catalog root
 	if err := os.MkdirAll(assetDir, 0o755); err != nil {
@@ -180,12 +280,35 @@ func (s *IconSource) GenerateIOS(assetDir string) error {
 	if err := os.MkdirAll(appIconDir, 0o755); err != nil {
 		return fmt.Errorf("failed to create %s: %w", appIconDir, err)
 	}
-	if err := os.WriteFile(filepath.Join(appIconDir, "Contents.json"), []byte(appIconContentsJSON+"\n"), 0o644); err != nil {
-		return fmt.Errorf("failed to write AppIcon Contents.json: %w", err)
-	}
+	images := []appIconImage{{Filename: "icon.png", Idiom: "universal", Platform: "ios", Size: "1024x1024"}}
 	if err := writePNG(filepath.Join(appIconDir, "icon.png"), resizeImage(s.img, 1024)); err != nil {
 		return err
 	}
+	for _, variant := range []struct {
+		img        image.Image
+		appearance string
+	}{
+		{s.dark, "dark"},
+		{s.tinted, "tinted"},
+	} {
+		if variant.img == nil {
+			continue
+		}
+		name := "icon_" + variant.appearance + ".png"
+		if err := writePNG(filepath.Join(appIconDir, name), resizeImage(variant.img, 1024)); err != nil {
+			return err
+		}
+		images = append(images, appIconImage{
+			Filename:    name,
+			Idiom:       "universal",
+			Platform:    "ios",
+			Size:        "1024x1024",
+			Appearances: []iconAppearance{{Appearance: "luminosity", Value: variant.appearance}},
+		})
+	}
+	if err := writeJSON(filepath.Join(appIconDir, "Contents.json"), map[string]any{"images": images, "info": assetInfo}); err != nil {
+		return err
+	}
 
 	// LaunchImage.imageset (used by LaunchScreen.storyboard)
 	if err := s.generateLaunchImageSet(assetDir); err != nil {
@@ -198,6 +321,11 @@ func (s *IconSource) GenerateIOS(assetDir string) error {
 // generateLaunchImageSet writes a LaunchImage.imageset into the given asset
 // catalog directory with 1x/2x/3x variants for the launch screen storyboard.
 func (s *IconSource) generateLaunchImageSet(assetDir string) error {
+	return writeLaunchImageSet(assetDir, s.img)
+}
+
+// writeLaunchImageSet writes img as the LaunchImage.imageset in assetDir.
+func writeLaunchImageSet(assetDir string, img image.Image) error {
 	launchDir := filepath.Join(assetDir, "LaunchImage.imageset")
 	if err := os.MkdirAll(launchDir, 0o755); err != nil {
 		return fmt.Errorf("failed to create %s: %w", launchDir, err)
@@ -215,7 +343,7 @@ func (s *IconSource) generateLaunchImageSet(assetDir string) error {
 		{"launch_icon@2x.png", 240},
 		{"launch_icon@3x.png", 360},
 	} {
-		if err := writePNG(filepath.Join(launchDir, s2.name), resizeImage(s.img, s2.size)); err != nil {
+		if err := writePNG(filepath.Join(launchDir, s2.name), resizeImage(img, s2.size)); err != nil {
 			return err
 		}
 	}
@@ -330,6 +458,18 @@ func hexVal(c byte) (uint8, bool) {
 	}
 }
 
+// writeJSON writes v as indented JSON, as Xcode formats asset catalogs.
+func writeJSON(path string, v any) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode %s: %w", path, err)
+	}
+	if err := os.WriteFile(path, append(data, '\n'), 0o644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	return nil
+}
+
 func writePNG(path string, img image.Image) (retErr error) {
 	f, err := os.Create(path)
 	if err != nil {
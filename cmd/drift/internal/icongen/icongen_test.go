@@ -1,11 +1,13 @@
 package icongen
 
 import (
+	"encoding/json"
 	"image"
 	"image/color"
 	"image/png"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 )
 
@@ -218,6 +220,215 @@ func TestGenerateIOS(t *testing.T) {
 	}
 }
 
+func TestGenerateAndroidVariants(t *testing.T) {
+	dir := t.TempDir()
+	createTestPNG(t, filepath.Join(dir, "fore.png"), 1024, 1024)
+	createTestPNG(t, filepath.Join(dir, "mono.png"), 1024, 1024)
+
+	src, err := LoadSource("", "")
+	if err != nil {
+		t.Fatalf("LoadSource: %v", err)
+	}
+	if err := src.LoadVariants(dir, Variants{Foreground: "fore.png", Monochrome: "mono.png"}); err != nil {
+		t.Fatalf("LoadVariants: %v", err)
+	}
+
+	resDir := filepath.Join(dir, "res")
+	if err := src.GenerateAndroid(resDir, ""); err != nil {
+		t.Fatalf("GenerateAndroid: %v", err)
+	}
+
+	// The foreground layer covers the whole canvas: the transparent test
+	// image must not be filled with the background color.
+	fore := decodePNG(t, filepath.Join(resDir, "mipmap-mdpi", "ic_launcher_adaptive_fore.png"))
+	if _, _, _, a := fore.At(0, 0).RGBA(); a != 0 {
+		t.Errorf("expected a transparent foreground corner, got alpha %d", a)
+	}
+	mono := decodePNG(t, filepath.Join(resDir, "mipmap-xxxhdpi", "ic_launcher_adaptive_mono.png"))
+	if b := mono.Bounds(); b.Dx() != 432 || b.Dy() != 432 {
+		t.Errorf("monochrome: expected 432x432, got %dx%d", b.Dx(), b.Dy())
+	}
+	xml, err := os.ReadFile(filepath.Join(resDir, "mipmap-anydpi-v26", "ic_launcher.xml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(xml), `<monochrome android:drawable="@mipmap/ic_launcher_adaptive_mono"/>`) {
+		t.Errorf("expected a monochrome layer in the adaptive icon, got:\n%s", xml)
+	}
+}
+
+func TestLoadVariantsTooSmall(t *testing.T) {
+	dir := t.TempDir()
+	createTestPNG(t, filepath.Join(dir, "dark.png"), 512, 512)
+
+	src, err := LoadSource("", "")
+	if err != nil {
+		t.Fatalf("LoadSource: %v", err)
+	}
+	if err := src.LoadVariants(dir, Variants{Dark: "dark.png"}); err == nil {
+		t.Fatal("expected error for small variant")
+	}
+}
+
+func TestGenerateIOSVariants(t *testing.T) {
+	dir := t.TempDir()
+	createTestPNG(t, filepath.Join(dir, "dark.png"), 1024, 1024)
+	createTestPNG(t, filepath.Join(dir, "tinted.png"), 1024, 1024)
+
+	src, err := LoadSource("", "")
+	if err != nil {
+		t.Fatalf("LoadSource: %v", err)
+	}
+	if err := src.LoadVariants(dir, Variants{Dark: "dark.png", Tinted: "tinted.png"}); err != nil {
+		t.Fatalf("LoadVariants: %v", err)
+	}
+	assetDir := filepath.Join(dir, "Assets.xcassets")
+	if err := src.GenerateIOS(assetDir); err != nil {
+		t.Fatalf("GenerateIOS: %v", err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(assetDir, "AppIcon.appiconset", "Contents.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var contents struct {
+		Images []appIconImage `json:"images"`
+	}
+	if err := json.Unmarshal(data, &contents); err != nil {
+		t.Fatalf("bad Contents.json: %v", err)
+	}
+	if len(contents.Images) != 3 {
+		t.Fatalf("expected 3 icon images, got %d", len(contents.Images))
+	}
+	for i, want := range []string{"", "dark", "tinted"} {
+		img := contents.Images[i]
+		if want == "" {
+			if len(img.Appearances) != 0 {
+				t.Errorf("expected the default icon without appearances, got %v", img.Appearances)
+			}
+			continue
+		}
+		if len(img.Appearances) != 1 || img.Appearances[0].Value != want {
+			t.Errorf("image %d: expected %s appearance, got %v", i, want, img.Appearances)
+		}
+		if _, err := os.Stat(filepath.Join(assetDir, "AppIcon.appiconset", img.Filename)); err != nil {
+			t.Errorf("missing %s: %v", img.Filename, err)
+		}
+	}
+}
+
+func TestGenerateAndroidSplash(t *testing.T) {
+	dir := t.TempDir()
+	createTestPNG(t, filepath.Join(dir, "splash.png"), 1024, 1024)
+	avd := `<animated-vector xmlns:android="http://schemas.android.com/apk/res/android" />`
+	if err := os.WriteFile(filepath.Join(dir, "splash.xml"), []byte(avd), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	resDir := filepath.Join(dir, "res")
+	err := GenerateAndroidSplash(dir, resDir, Splash{
+		Background:        "#1a73e8",
+		DarkBackground:    "#000",
+		Image:             "splash.png",
+		AnimatedIcon:      "splash.xml",
+		AnimationDuration: 800,
+	})
+	if err != nil {
+		t.Fatalf("GenerateAndroidSplash: %v", err)
+	}
+
+	for path, want := range map[string]string{
+		"values/splash_colors.xml":          `<color name="splash_background">#1A73E8</color>`,
+		"values-night/splash_colors.xml":    `<color name="splash_background">#000000</color>`,
+		"drawable/launch_background.xml":    `@drawable/splash_icon`,
+		"drawable/splash_animated_icon.xml": avd,
+		"values-v31/splash_styles.xml":      `<item name="android:windowSplashScreenAnimatedIcon">@drawable/splash_animated_icon</item>`,
+	} {
+		data, err := os.ReadFile(filepath.Join(resDir, path))
+		if err != nil {
+			t.Errorf("missing %s: %v", path, err)
+			continue
+		}
+		if !strings.Contains(string(data), want) {
+			t.Errorf("%s: expected %q, got:\n%s", path, want, data)
+		}
+	}
+	styles, _ := os.ReadFile(filepath.Join(resDir, "values-v31", "splash_styles.xml"))
+	if !strings.Contains(string(styles), `<item name="android:windowSplashScreenAnimationDuration">800</item>`) {
+		t.Errorf("expected the animation duration in the splash theme, got:\n%s", styles)
+	}
+	icon := decodePNG(t, filepath.Join(resDir, "drawable-xxxhdpi", "splash_icon.png"))
+	if b := icon.Bounds(); b.Dx() != 1152 || b.Dy() != 1152 {
+		t.Errorf("splash_icon: expected 1152x1152, got %dx%d", b.Dx(), b.Dy())
+	}
+}
+
+func TestGenerateAndroidSplashDefaults(t *testing.T) {
+	resDir := filepath.Join(t.TempDir(), "res")
+	if err := GenerateAndroidSplash("", resDir, Splash{}); err != nil {
+		t.Fatalf("GenerateAndroidSplash: %v", err)
+	}
+	night, err := os.ReadFile(filepath.Join(resDir, "values-night", "splash_colors.xml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(night), "#FFFFFF") {
+		t.Errorf("expected the dark color to default to the light one, got:\n%s", night)
+	}
+	styles, err := os.ReadFile(filepath.Join(resDir, "values-v31", "splash_styles.xml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(styles), "windowSplashScreenAnimatedIcon") {
+		t.Error("expected the launcher icon to be left to the system without a splash image")
+	}
+
+	if err := GenerateAndroidSplash("", resDir, Splash{Background: "blue"}); err == nil {
+		t.Error("expected error for an invalid color")
+	}
+}
+
+func TestGenerateIOSSplash(t *testing.T) {
+	dir := t.TempDir()
+	assetDir := filepath.Join(dir, "Assets.xcassets")
+	if err := GenerateIOSSplash(dir, assetDir, Splash{Background: "#FF0000", DarkBackground: "#000080"}); err != nil {
+		t.Fatalf("GenerateIOSSplash: %v", err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(assetDir, "LaunchBackground.colorset", "Contents.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var contents colorSet
+	if err := json.Unmarshal(data, &contents); err != nil {
+		t.Fatalf("bad Contents.json: %v", err)
+	}
+	if len(contents.Colors) != 2 {
+		t.Fatalf("expected light and dark colors, got %d", len(contents.Colors))
+	}
+	if got := contents.Colors[0].Color.Components["red"]; got != "1.000" {
+		t.Errorf("light red: expected 1.000, got %s", got)
+	}
+	dark := contents.Colors[1]
+	if len(dark.Appearances) != 1 || dark.Appearances[0].Value != "dark" || dark.Color.Components["blue"] != "0.502" {
+		t.Errorf("unexpected dark color %+v", dark)
+	}
+}
+
+func decodePNG(t *testing.T, path string) image.Image {
+	t.Helper()
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	img, err := png.Decode(f)
+	if err != nil {
+		t.Fatalf("bad PNG %s: %v", path, err)
+	}
+	return img
+}
+
 func createTestPNG(t *testing.T, path string, w, h int) {
 	t.Helper()
 	img := image.NewNRGBA(image.Rect(0, 0, w, h))
@@ -0,0 +1,236 @@
+package icongen
+
+import (
+	"fmt"
+	"image/color"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// Splash describes the launch screen shown while the app starts.
+type Splash struct {
+	// Background is the launch screen color (default "#FFFFFF").
+	Background string
+	// DarkBackground is the launch screen color in dark mode (default
+	// Background).
+	DarkBackground string
+	// Image is a square image, at least 1024x1024, centered on the launch
+	// screen. Keep its content inside the centered circle covering 2/3 of
+	// the image, which Android 12+ masks the icon to. Empty means no image
+	// on Android (Android 12+ shows the launcher icon) and the app icon on
+	// iOS.
+	Image string
+	// AnimatedIcon is an AnimatedVectorDrawable XML file used as the
+	// Android 12+ splash icon. It takes precedence over Image there.
+	AnimatedIcon string
+	// AnimationDuration is the length of the AnimatedIcon animation in
+	// milliseconds.
+	AnimationDuration int
+}
+
+// colors returns the parsed light and dark background colors.
+func (sp Splash) colors() (light, dark color.NRGBA, err error) {
+	bg := sp.Background
+	if bg == "" {
+		bg = "#FFFFFF"
+	}
+	light, err = parseHexColor(bg)
+	if err != nil {
+		return light, dark, fmt.Errorf("invalid splash background color %q: %w", bg, err)
+	}
+	if sp.DarkBackground == "" {
+		return light, light, nil
+	}
+	dark, err = parseHexColor(sp.DarkBackground)
+	if err != nil {
+		return light, dark, fmt.Errorf("invalid splash dark_background color %q: %w", sp.DarkBackground, err)
+	}
+	return light, dark, nil
+}
+
+// androidSplashSizes are the pixel sizes of the 288dp splash icon canvas
+// per drawable density.
+var androidSplashSizes = []struct {
+	dir  string
+	size int
+}{
+	{"drawable-mdpi", 288},
+	{"drawable-hdpi", 432},
+	{"drawable-xhdpi", 576},
+	{"drawable-xxhdpi", 864},
+	{"drawable-xxxhdpi", 1152},
+}
+
+// GenerateAndroidSplash writes the launch screen resources into resDir: the
+// splash colors for light and dark themes, the launch_background drawable
+// shown before the first frame, and a LaunchTheme for the Android 12+
+// SplashScreen API. Paths in splash are relative to projectRoot.
+func GenerateAndroidSplash(projectRoot, resDir string, splash Splash) error {
+	light, dark, err := splash.colors()
+	if err != nil {
+		return err
+	}
+	for dir, c := range map[string]color.NRGBA{"values": light, "values-night": dark} {
+		content := `<?xml version="1.0" encoding="utf-8"?>
+<resources>
+    <color name="splash_background">` + hexColor(c) + `</color>
+</resources>
+`
+		if err := writeResource(resDir, dir, "splash_colors.xml", content); err != nil {
+			return err
+		}
+	}
+
+	launchItems := `    <item android:drawable="@color/splash_background" />
+`
+	var splashIcon string
+	if splash.Image != "" {
+		img, err := loadImage(projectRoot, splash.Image)
+		if err != nil {
+			return err
+		}
+		for _, d := range androidSplashSizes {
+			dir := filepath.Join(resDir, d.dir)
+			if err := os.MkdirAll(dir, 0o755); err != nil {
+				return fmt.Errorf("failed to create %s: %w", dir, err)
+			}
+			icon := adaptiveForeground(img, d.size, color.NRGBA{})
+			if err := writePNG(filepath.Join(dir, "splash_icon.png"), icon); err != nil {
+				return err
+			}
+		}
+		launchItems += `    <item android:drawable="@drawable/splash_icon" android:gravity="center" android:width="288dp" android:height="288dp" />
+`
+		splashIcon = "@drawable/splash_icon"
+	}
+	if splash.AnimatedIcon != "" {
+		data, err := os.ReadFile(filepath.Join(projectRoot, splash.AnimatedIcon))
+		if err != nil {
+			return fmt.Errorf("failed to read splash animated icon: %w", err)
+		}
+		if err := writeResource(resDir, "drawable", "splash_animated_icon.xml", string(data)); err != nil {
+			return err
+		}
+		splashIcon = "@drawable/splash_animated_icon"
+	}
+
+	launchBackground := `<?xml version="1.0" encoding="utf-8"?>
+<layer-list xmlns:android="http://schemas.android.com/apk/res/android">
+` + launchItems + `</layer-list>
+`
+	if err := writeResource(resDir, "drawable", "launch_background.xml", launchBackground); err != nil {
+		return err
+	}
+
+	// Android 12+ replaces the launch window with the system splash screen,
+	// configured through these theme attributes.
+	var b strings.Builder
+	b.WriteString(`<?xml version="1.0" encoding="utf-8"?>
+<resources>
+    <style name="LaunchTheme" parent="Theme.AppCompat.NoActionBar">
+        <item name="android:windowBackground">@drawable/launch_background</item>
+        <item name="android:windowSplashScreenBackground">@color/splash_background</item>
+`)
+	if splashIcon != "" {
+		b.WriteString(`        <item name="android:windowSplashScreenAnimatedIcon">` + splashIcon + `</item>
+`)
+	}
+	if splash.AnimatedIcon != "" && splash.AnimationDuration > 0 {
+		b.WriteString(`        <item name="android:windowSplashScreenAnimationDuration">` + strconv.Itoa(splash.AnimationDuration) + `</item>
+`)
+	}
+	b.WriteString(`    </style>
+</resources>
+`)
+	return writeResource(resDir, "values-v31", "splash_styles.xml", b.String())
+}
+
+// colorSet is the Contents.json of an asset catalog color set.
+type colorSet struct {
+	Colors []colorSetColor `json:"colors"`
+	Info   map[string]any  `json:"info"`
+}
+
+type colorSetColor struct {
+	Appearances []iconAppearance `json:"appearances,omitempty"`
+	Color       colorSetValue    `json:"color"`
+	Idiom       string           `json:"idiom"`
+}
+
+type colorSetValue struct {
+	ColorSpace string            `json:"color-space"`
+	Components map[string]string `json:"components"`
+}
+
+// GenerateIOSSplash writes the launch screen assets into the asset catalog
+// at assetDir: the LaunchBackground color set, with a dark appearance, and
+// the splash image as LaunchImage when one is set. Call it after GenerateIOS,
+// whose launch image it replaces. Paths in splash are relative to
+// projectRoot. AnimatedIcon is Android only and ignored here.
+func GenerateIOSSplash(projectRoot, assetDir string, splash Splash) error {
+	light, dark, err := splash.colors()
+	if err != nil {
+		return err
+	}
+
+	if splash.Image != "" {
+		img, err := loadImage(projectRoot, splash.Image)
+		if err != nil {
+			return err
+		}
+		if err := writeLaunchImageSet(assetDir, img); err != nil {
+			return err
+		}
+	}
+
+	colorDir := filepath.Join(assetDir, "LaunchBackground.colorset")
+	if err := os.MkdirAll(colorDir, 0o755); err != nil {
+		return fmt.Errorf("failed to create %s: %w", colorDir, err)
+	}
+	return writeJSON(filepath.Join(colorDir, "Contents.json"), colorSet{
+		Colors: []colorSetColor{
+			{Color: colorSetValueOf(light), Idiom: "universal"},
+			{
+				Appearances: []iconAppearance{{Appearance: "luminosity", Value: "dark"}},
+				Color:       colorSetValueOf(dark),
+				Idiom:       "universal",
+			},
+		},
+		Info: assetInfo,
+	})
+}
+
+func colorSetValueOf(c color.NRGBA) colorSetValue {
+	component := func(v uint8) string {
+		return strconv.FormatFloat(float64(v)/255, 'f', 3, 64)
+	}
+	return colorSetValue{
+		ColorSpace: "srgb",
+		Components: map[string]string{
+			"red":   component(c.R),
+			"green": component(c.G),
+			"blue":  component(c.B),
+			"alpha": "1.000",
+		},
+	}
+}
+
+// hexColor formats c as an Android color resource value.
+func hexColor(c color.NRGBA) string {
+	return fmt.Sprintf("#%02X%02X%02X", c.R, c.G, c.B)
+}
+
+// writeResource writes an Android resource file to resDir/dir/name.
+func writeResource(resDir, dir, name, content string) error {
+	dir = filepath.Join(resDir, dir)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("failed to create %s: %w", dir, err)
+	}
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	return nil
+}
@@ -6,7 +6,6 @@ import (
 	"path/filepath"
 	"strings"
 
-	"github.com/go-drift/drift/cmd/drift/internal/icongen"
 	"github.com/go-drift/drift/cmd/drift/internal/templates"
 )
 
@@ -36,6 +35,8 @@ func WriteAndroid(root string, settings Settings) error {
 		IOSBundleID:    settings.Bundle,
 		Orientation:    settings.Orientation,
 		AllowHTTP:      settings.AllowHTTP,
+		Version:        settings.Version,
+		BuildNumber:    settings.BuildNumber,
 	})
 
 	writeTemplateFile := func(templatePath, destPath string, perm os.FileMode) error {
@@ -96,13 +97,14 @@ func WriteAndroid(root string, settings Settings) error {
 		return err
 	}
 
-	// Generate icon assets
-	iconSrc, err := icongen.LoadSource(settings.ProjectRoot, settings.Icon)
-	if err != nil {
-		return fmt.Errorf("failed to load icon: %w", err)
+	// Write the app name and its translations
+	if err := WriteAndroidStrings(resDir, settings); err != nil {
+		return err
 	}
-	if err := iconSrc.GenerateAndroid(resDir, settings.IconBackground); err != nil {
-		return fmt.Errorf("failed to generate android icons: %w", err)
+
+	// Generate icon and launch screen assets
+	if err := GenerateAndroidIcons(resDir, settings); err != nil {
+		return err
 	}
 
 	// Write Kotlin files from templates
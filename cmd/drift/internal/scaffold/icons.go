@@ -0,0 +1,74 @@
+package scaffold
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-drift/drift/cmd/drift/internal/icongen"
+)
+
+// GenerateAndroidIcons writes the launcher icons and launch screen resources
+// described by settings into the Android res directory.
+func GenerateAndroidIcons(resDir string, settings Settings) error {
+	// icon_background holds either a color or a background layer image.
+	bgColor, bgImage := settings.IconBackground, ""
+	if bgColor != "" && !strings.HasPrefix(bgColor, "#") {
+		bgColor, bgImage = "", bgColor
+	}
+
+	iconSrc, err := loadIcon(settings, icongen.Variants{
+		Foreground: settings.IconForeground,
+		Background: bgImage,
+		Monochrome: settings.IconMonochrome,
+	})
+	if err != nil {
+		return err
+	}
+	if err := iconSrc.GenerateAndroid(resDir, bgColor); err != nil {
+		return fmt.Errorf("failed to generate android icons: %w", err)
+	}
+	if err := icongen.GenerateAndroidSplash(settings.ProjectRoot, resDir, splashOf(settings)); err != nil {
+		return fmt.Errorf("failed to generate android splash: %w", err)
+	}
+	return nil
+}
+
+// GenerateIOSIcons writes the app icon and launch screen assets described by
+// settings into the asset catalog at assetDir.
+func GenerateIOSIcons(assetDir string, settings Settings) error {
+	iconSrc, err := loadIcon(settings, icongen.Variants{
+		Dark:   settings.IconDark,
+		Tinted: settings.IconTinted,
+	})
+	if err != nil {
+		return err
+	}
+	if err := iconSrc.GenerateIOS(assetDir); err != nil {
+		return fmt.Errorf("failed to generate iOS icons: %w", err)
+	}
+	if err := icongen.GenerateIOSSplash(settings.ProjectRoot, assetDir, splashOf(settings)); err != nil {
+		return fmt.Errorf("failed to generate iOS splash: %w", err)
+	}
+	return nil
+}
+
+func loadIcon(settings Settings, variants icongen.Variants) (*icongen.IconSource, error) {
+	iconSrc, err := icongen.LoadSource(settings.ProjectRoot, settings.Icon)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load icon: %w", err)
+	}
+	if err := iconSrc.LoadVariants(settings.ProjectRoot, variants); err != nil {
+		return nil, fmt.Errorf("failed to load icon variant: %w", err)
+	}
+	return iconSrc, nil
+}
+
+func splashOf(settings Settings) icongen.Splash {
+	return icongen.Splash{
+		Background:        settings.Splash.Background,
+		DarkBackground:    settings.Splash.DarkBackground,
+		Image:             settings.Splash.Image,
+		AnimatedIcon:      settings.Splash.AnimatedIcon,
+		AnimationDuration: settings.Splash.AnimationDuration,
+	}
+}
@@ -1,11 +1,9 @@
 package scaffold
 
 import (
-	"fmt"
 	"path/filepath"
 	"strings"
 
-	"github.com/go-drift/drift/cmd/drift/internal/icongen"
 	"github.com/go-drift/drift/cmd/drift/internal/templates"
 )
 
@@ -27,6 +25,9 @@ func WriteIOS(root string, settings Settings) error {
 		IOSBundleID:    settings.Bundle,
 		Orientation:    settings.Orientation,
 		AllowHTTP:      settings.AllowHTTP,
+		Version:        settings.Version,
+		BuildNumber:    settings.BuildNumber,
+		Locales:        IOSLocales(settings),
 	})
 
 	// Write iOS template files (Info.plist, Swift sources, LaunchScreen.storyboard)
@@ -40,14 +41,14 @@ func WriteIOS(root string, settings Settings) error {
 		return err
 	}
 
-	// Generate app icon assets
-	assetDir := filepath.Join(iosDir, "Assets.xcassets")
-	iconSrc, err := icongen.LoadSource(settings.ProjectRoot, settings.Icon)
-	if err != nil {
-		return fmt.Errorf("failed to load icon: %w", err)
+	// Write translated display names and usage descriptions
+	if err := WriteIOSLocalizations(iosDir, settings); err != nil {
+		return err
 	}
-	if err := iconSrc.GenerateIOS(assetDir); err != nil {
-		return fmt.Errorf("failed to generate iOS icons: %w", err)
+
+	// Generate app icon and launch screen assets
+	if err := GenerateIOSIcons(filepath.Join(iosDir, "Assets.xcassets"), settings); err != nil {
+		return err
 	}
 
 	// Write Xcode project files
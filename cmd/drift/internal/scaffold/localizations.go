@@ -0,0 +1,127 @@
+package scaffold
+
+import (
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// developmentRegion is the Xcode project's development language. Its
+// InfoPlist.strings is written whenever other locales are, so users of
+// untranslated languages see the default name rather than a translation.
+const developmentRegion = "en"
+
+// WriteAndroidStrings writes the app name as the app_name string resource
+// in resDir, along with a translated copy for each locale in
+// app.localizations that sets a name. The manifest labels the app with
+// @string/app_name.
+func WriteAndroidStrings(resDir string, settings Settings) error {
+	if err := writeAndroidAppName(filepath.Join(resDir, "values"), settings.AppName); err != nil {
+		return err
+	}
+	for locale, l := range settings.Localizations {
+		if l.Name == "" {
+			continue
+		}
+		dir := filepath.Join(resDir, "values-"+androidLocaleQualifier(locale))
+		if err := writeAndroidAppName(dir, l.Name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeAndroidAppName(dir, name string) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("failed to create %s: %w", dir, err)
+	}
+	content := `<?xml version="1.0" encoding="utf-8"?>
+<resources>
+    <string name="app_name">` + androidString(name) + `</string>
+</resources>
+`
+	path := filepath.Join(dir, "strings.xml")
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	return nil
+}
+
+// androidLocaleQualifier converts a locale code to an Android resource
+// qualifier: "fr" stays "fr", "pt-BR" becomes "pt-rBR", and codes with a
+// script or numeric region use the BCP 47 form, e.g. "b+zh+Hans".
+func androidLocaleQualifier(locale string) string {
+	parts := strings.Split(locale, "-")
+	switch {
+	case len(parts) == 1:
+		return locale
+	case len(parts) == 2 && len(parts[1]) == 2:
+		return parts[0] + "-r" + strings.ToUpper(parts[1])
+	default:
+		return "b+" + strings.Join(parts, "+")
+	}
+}
+
+// androidString escapes s for a string resource. Besides XML escaping,
+// quotes and apostrophes need a backslash, as does a leading @ or ?.
+func androidString(s string) string {
+	s = strings.NewReplacer(
+		"&", "&amp;", "<", "&lt;", ">", "&gt;",
+		`\`, `\\`, `"`, `\"`, `'`, `\'`, "\n", `\n`,
+	).Replace(s)
+	if strings.HasPrefix(s, "@") || strings.HasPrefix(s, "?") {
+		s = `\` + s
+	}
+	return s
+}
+
+// IOSLocales returns the locales that get an InfoPlist.strings file, in
+// order: those in app.localizations plus the development region.
+func IOSLocales(settings Settings) []string {
+	if len(settings.Localizations) == 0 {
+		return nil
+	}
+	locales := slices.Sorted(maps.Keys(settings.Localizations))
+	if !slices.Contains(locales, developmentRegion) {
+		locales = slices.Insert(locales, 0, developmentRegion)
+	}
+	return locales
+}
+
+// WriteIOSLocalizations writes <locale>.lproj/InfoPlist.strings into
+// runnerDir for each locale returned by IOSLocales, translating the display
+// name and usage descriptions. Keys without a translation fall back to
+// Info.plist.
+func WriteIOSLocalizations(runnerDir string, settings Settings) error {
+	for _, locale := range IOSLocales(settings) {
+		l := settings.Localizations[locale]
+		entries := map[string]string{"CFBundleDisplayName": settings.AppName}
+		if l.Name != "" {
+			entries["CFBundleDisplayName"] = l.Name
+		}
+		maps.Copy(entries, l.UsageDescriptions)
+
+		var b strings.Builder
+		for _, key := range slices.Sorted(maps.Keys(entries)) {
+			fmt.Fprintf(&b, "%s = %s;\n", stringsLiteral(key), stringsLiteral(entries[key]))
+		}
+
+		dir := filepath.Join(runnerDir, locale+".lproj")
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return fmt.Errorf("failed to create %s: %w", dir, err)
+		}
+		path := filepath.Join(dir, "InfoPlist.strings")
+		if err := os.WriteFile(path, []byte(b.String()), 0o644); err != nil {
+			return fmt.Errorf("failed to write %s: %w", path, err)
+		}
+	}
+	return nil
+}
+
+// stringsLiteral quotes s for a .strings file.
+func stringsLiteral(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(s) + `"`
+}
@@ -0,0 +1,105 @@
+package scaffold
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/go-drift/drift/cmd/drift/internal/config"
+)
+
+func TestAndroidLocaleQualifier(t *testing.T) {
+	tests := map[string]string{
+		"fr":         "fr",
+		"pt-BR":      "pt-rBR",
+		"pt-br":      "pt-rBR",
+		"zh-Hans":    "b+zh+Hans",
+		"es-419":     "b+es+419",
+		"zh-Hant-TW": "b+zh+Hant+TW",
+	}
+	for in, want := range tests {
+		if got := androidLocaleQualifier(in); got != want {
+			t.Errorf("androidLocaleQualifier(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestAndroidString(t *testing.T) {
+	tests := map[string]string{
+		"Demo":        "Demo",
+		`Tom's "App"`: `Tom\'s \"App\"`,
+		"A & B <C>":   "A &amp; B &lt;C&gt;",
+		"@home":       `\@home`,
+		"?why":        `\?why`,
+	}
+	for in, want := range tests {
+		if got := androidString(in); got != want {
+			t.Errorf("androidString(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestWriteAndroidStrings(t *testing.T) {
+	resDir := t.TempDir()
+	settings := Settings{
+		AppName: "Demo",
+		Localizations: map[string]config.LocalizationConfig{
+			"fr":    {Name: "Démo"},
+			"pt-BR": {Name: "Demonstração"},
+			"de":    {UsageDescriptions: map[string]string{"NSCameraUsageDescription": "Kamera"}},
+		},
+	}
+	if err := WriteAndroidStrings(resDir, settings); err != nil {
+		t.Fatal(err)
+	}
+
+	for dir, name := range map[string]string{"values": "Demo", "values-fr": "Démo", "values-pt-rBR": "Demonstração"} {
+		got := readFile(t, filepath.Join(resDir, dir, "strings.xml"))
+		if !strings.Contains(got, `<string name="app_name">`+name+`</string>`) {
+			t.Errorf("expected app_name %q in %s, got:\n%s", name, dir, got)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(resDir, "values-de")); !os.IsNotExist(err) {
+		t.Error("expected no resources for a locale without a name")
+	}
+}
+
+func TestIOSLocales(t *testing.T) {
+	if got := IOSLocales(Settings{}); got != nil {
+		t.Errorf("expected no locales without localizations, got %v", got)
+	}
+	settings := Settings{Localizations: map[string]config.LocalizationConfig{"fr": {}, "de": {}}}
+	if got, want := IOSLocales(settings), []string{"en", "de", "fr"}; !slices.Equal(got, want) {
+		t.Errorf("IOSLocales = %v, want %v", got, want)
+	}
+	settings.Localizations["en"] = config.LocalizationConfig{}
+	if got, want := IOSLocales(settings), []string{"de", "en", "fr"}; !slices.Equal(got, want) {
+		t.Errorf("IOSLocales = %v, want %v", got, want)
+	}
+}
+
+func TestWriteIOSLocalizations(t *testing.T) {
+	runnerDir := t.TempDir()
+	settings := Settings{
+		AppName: "Demo",
+		Localizations: map[string]config.LocalizationConfig{
+			"fr": {
+				Name:              `La "Démo"`,
+				UsageDescriptions: map[string]string{"NSCameraUsageDescription": "Scanner les reçus"},
+			},
+		},
+	}
+	if err := WriteIOSLocalizations(runnerDir, settings); err != nil {
+		t.Fatal(err)
+	}
+
+	want := "\"CFBundleDisplayName\" = \"La \\\"Démo\\\"\";\n\"NSCameraUsageDescription\" = \"Scanner les reçus\";\n"
+	if got := readFile(t, filepath.Join(runnerDir, "fr.lproj", "InfoPlist.strings")); got != want {
+		t.Errorf("fr.lproj/InfoPlist.strings = %q, want %q", got, want)
+	}
+	if got := readFile(t, filepath.Join(runnerDir, "en.lproj", "InfoPlist.strings")); got != "\"CFBundleDisplayName\" = \"Demo\";\n" {
+		t.Errorf("expected the development region to use the app name, got %q", got)
+	}
+}
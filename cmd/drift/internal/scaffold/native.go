@@ -0,0 +1,338 @@
+package scaffold
+
+import (
+	"errors"
+	"fmt"
+	"html"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/go-drift/drift/cmd/drift/internal/config"
+)
+
+// Native additions from drift.yaml are written between marker comments, such
+// as "// drift:dependencies:begin" and "// drift:dependencies:end", so every
+// build replaces the previous block in place. This works the same for managed
+// projects, which are regenerated from templates, and ejected ones, which
+// keep any edits outside the markers. Files are only rewritten when their
+// content changes, keeping Gradle and Xcode build caches warm.
+
+// commentStyle is how marker comments are written in a file type.
+type commentStyle struct {
+	prefix, suffix string
+}
+
+var (
+	slashComment = commentStyle{"// ", ""}
+	xmlComment   = commentStyle{"<!-- ", " -->"}
+	hashComment  = commentStyle{"# ", ""}
+)
+
+// podfileTemplate is written when pods are declared and the project has no
+// Podfile yet.
+const podfileTemplate = `# Created by drift. Pods listed under native.ios.pods in drift.yaml are
+# kept between the drift:pods markers on every build.
+platform :ios, '16.0'
+
+target 'Runner' do
+  use_frameworks!
+end
+`
+
+// InjectAndroid adds the native.android entries from drift.yaml to the
+// Android project in androidDir: Gradle dependencies to app/build.gradle,
+// and permissions and application entries to the manifest.
+func InjectAndroid(androidDir string, native config.AndroidNativeConfig) error {
+	gradle := filepath.Join(androidDir, "app", "build.gradle")
+	err := updateFile(gradle, func(content string) (string, error) {
+		var lines []string
+		if len(native.Dependencies) > 0 {
+			lines = append(lines, "dependencies {")
+			for _, dep := range native.Dependencies {
+				lines = append(lines, "    implementation "+strconv.Quote(dep))
+			}
+			lines = append(lines, "}")
+		}
+		return injectBlock(content, "dependencies", slashComment, "", lines, nil)
+	})
+	if err != nil {
+		return err
+	}
+
+	manifest := filepath.Join(androidDir, "app", "src", "main", "AndroidManifest.xml")
+	return updateFile(manifest, func(content string) (string, error) {
+		outside := withoutBlock(content, "permissions")
+		var permissions []string
+		for _, perm := range native.Permissions {
+			if strings.Contains(outside, `android:name="`+perm+`"`) {
+				continue // already declared by the project
+			}
+			permissions = append(permissions, `<uses-permission android:name="`+perm+`" />`)
+		}
+		content, err := injectBlock(content, "permissions", xmlComment, "    ", permissions, linePrefix("<application"))
+		if err != nil {
+			return "", err
+		}
+		return injectBlock(content, "application", xmlComment, "        ", native.Application, linePrefix("</application>"))
+	})
+}
+
+// InjectIOS adds the native.ios entries from drift.yaml to the Xcode project
+// in iosDir: plist entries to Runner/Info.plist, and pods to the Podfile,
+// which is created if needed. Swift packages apply to xtool builds only.
+func InjectIOS(iosDir string, native config.IOSNativeConfig) error {
+	if err := injectPlist(filepath.Join(iosDir, "Runner", "Info.plist"), native.Plist); err != nil {
+		return err
+	}
+
+	podfile := filepath.Join(iosDir, "Podfile")
+	if _, err := os.Stat(podfile); errors.Is(err, os.ErrNotExist) {
+		if len(native.Pods) == 0 {
+			return nil
+		}
+		if err := os.WriteFile(podfile, []byte(podfileTemplate), 0o644); err != nil {
+			return fmt.Errorf("failed to write Podfile: %w", err)
+		}
+	}
+	return updateFile(podfile, func(content string) (string, error) {
+		var lines []string
+		for _, pod := range native.Pods {
+			line := "pod '" + pod.Name + "'"
+			if pod.Version != "" {
+				line += ", '" + pod.Version + "'"
+			}
+			lines = append(lines, line)
+		}
+		return injectBlock(content, "pods", hashComment, "  ", lines, func(line string) bool {
+			return strings.TrimSpace(line) == "end"
+		})
+	})
+}
+
+// HasPods reports whether the Podfile in iosDir declares any pods, so the
+// build needs to run pod install and build the CocoaPods workspace.
+func HasPods(iosDir string) bool {
+	data, err := os.ReadFile(filepath.Join(iosDir, "Podfile"))
+	if err != nil {
+		return false
+	}
+	for line := range strings.SplitSeq(string(data), "\n") {
+		if strings.HasPrefix(strings.TrimSpace(line), "pod ") {
+			return true
+		}
+	}
+	return false
+}
+
+// InjectXtool adds the native.ios entries from drift.yaml to the xtool
+// project in xtoolDir: Swift packages to Package.swift and plist entries to
+// the app's Info.plist.
+func InjectXtool(xtoolDir string, native config.IOSNativeConfig) error {
+	packageSwift := filepath.Join(xtoolDir, "Package.swift")
+	err := updateFile(packageSwift, func(content string) (string, error) {
+		var packages, products []string
+		for _, pkg := range native.Packages {
+			packages = append(packages, fmt.Sprintf(".package(url: %q, from: %q),", pkg.URL, pkg.Version))
+			identity := strings.TrimSuffix(path.Base(pkg.URL), ".git")
+			for _, product := range pkg.Products {
+				products = append(products, fmt.Sprintf(".product(name: %q, package: %q),", product, identity))
+			}
+		}
+		content, err := injectBlock(content, "packages", slashComment, "        ", packages, nil)
+		if err != nil {
+			return "", err
+		}
+		return injectBlock(content, "products", slashComment, "                ", products, nil)
+	})
+	if err != nil {
+		return err
+	}
+	return injectPlist(filepath.Join(xtoolDir, "Sources", "Runner", "Resources", "Info.plist"), native.Plist)
+}
+
+// injectPlist adds entries to the top-level dictionary of an Info.plist,
+// replacing any existing values for the same keys.
+func injectPlist(plistPath string, entries map[string]any) error {
+	return updateFile(plistPath, func(content string) (string, error) {
+		keys := make([]string, 0, len(entries))
+		for key := range entries {
+			keys = append(keys, key)
+		}
+		slices.Sort(keys)
+
+		var lines []string
+		for _, key := range keys {
+			content = removePlistKey(content, key)
+			lines = append(lines, "<key>"+html.EscapeString(key)+"</key>")
+			lines = append(lines, plistValue(entries[key])...)
+		}
+		return injectBlock(content, "plist", xmlComment, "\t", lines, func(line string) bool {
+			return line == "</dict>"
+		})
+	})
+}
+
+// plistValue renders a drift.yaml value as plist XML lines.
+func plistValue(value any) []string {
+	switch v := value.(type) {
+	case bool:
+		if v {
+			return []string{"<true/>"}
+		}
+		return []string{"<false/>"}
+	case int:
+		return []string{"<integer>" + strconv.Itoa(v) + "</integer>"}
+	case float64:
+		return []string{"<real>" + strconv.FormatFloat(v, 'g', -1, 64) + "</real>"}
+	case []any:
+		lines := []string{"<array>"}
+		for _, item := range v {
+			for _, line := range plistValue(item) {
+				lines = append(lines, "\t"+line)
+			}
+		}
+		return append(lines, "</array>")
+	case map[string]any:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		slices.Sort(keys)
+		lines := []string{"<dict>"}
+		for _, key := range keys {
+			lines = append(lines, "\t<key>"+html.EscapeString(key)+"</key>")
+			for _, line := range plistValue(v[key]) {
+				lines = append(lines, "\t"+line)
+			}
+		}
+		return append(lines, "</dict>")
+	default:
+		return []string{"<string>" + html.EscapeString(fmt.Sprint(v)) + "</string>"}
+	}
+}
+
+// removePlistKey removes key and its value from the top-level dictionary of
+// a plist, outside the drift block, so injected values take precedence over
+// the project's own.
+func removePlistKey(content, key string) string {
+	lines := strings.Split(content, "\n")
+	want := "<key>" + html.EscapeString(key) + "</key>"
+	inBlock := false
+	for i := 0; i < len(lines); i++ {
+		trimmed := strings.TrimSpace(lines[i])
+		if isMarker(trimmed, "plist") {
+			inBlock = !inBlock
+			continue
+		}
+		if inBlock || trimmed != want {
+			continue
+		}
+		// The value runs from the next line to its matching close tag.
+		end, depth := i+1, 0
+		for ; end < len(lines); end++ {
+			value := strings.TrimSpace(lines[end])
+			switch {
+			case value == "<array>" || value == "<dict>":
+				depth++
+			case value == "</array>" || value == "</dict>":
+				depth--
+			}
+			if depth <= 0 {
+				break
+			}
+		}
+		if end >= len(lines) {
+			return content
+		}
+		return strings.Join(slices.Delete(lines, i, end+1), "\n")
+	}
+	return content
+}
+
+// injectBlock replaces the lines between the begin and end markers called
+// name with lines, indented by indent. Without markers, a non-empty block is
+// inserted before the last line matched by anchor, or appended to the file
+// if anchor is nil.
+func injectBlock(content, name string, style commentStyle, indent string, lines []string, anchor func(line string) bool) (string, error) {
+	block := []string{indent + style.prefix + "drift:" + name + ":begin" + style.suffix}
+	for _, line := range lines {
+		block = append(block, indent+line)
+	}
+	block = append(block, indent+style.prefix+"drift:"+name+":end"+style.suffix)
+
+	all := strings.Split(content, "\n")
+	begin, end := markerLines(all, name)
+	if begin >= 0 {
+		return strings.Join(slices.Replace(all, begin, end+1, block...), "\n"), nil
+	}
+	if len(lines) == 0 {
+		return content, nil
+	}
+	if anchor == nil {
+		return strings.TrimRight(content, "\n") + "\n\n" + strings.Join(block, "\n") + "\n", nil
+	}
+	for i := len(all) - 1; i >= 0; i-- {
+		if anchor(all[i]) {
+			return strings.Join(slices.Insert(all, i, block...), "\n"), nil
+		}
+	}
+	return "", fmt.Errorf("could not find where to add the drift:%s block", name)
+}
+
+// markerLines returns the line indexes of the begin and end markers called
+// name, or -1, -1 if either is missing.
+func markerLines(lines []string, name string) (begin, end int) {
+	begin, end = -1, -1
+	for i, line := range lines {
+		switch {
+		case strings.Contains(line, "drift:"+name+":begin"):
+			begin = i
+		case strings.Contains(line, "drift:"+name+":end") && begin >= 0:
+			return begin, i
+		}
+	}
+	return -1, -1
+}
+
+// withoutBlock returns content without the block called name.
+func withoutBlock(content, name string) string {
+	lines := strings.Split(content, "\n")
+	if begin, end := markerLines(lines, name); begin >= 0 {
+		lines = slices.Delete(lines, begin, end+1)
+	}
+	return strings.Join(lines, "\n")
+}
+
+func isMarker(line, name string) bool {
+	return strings.Contains(line, "drift:"+name+":begin") || strings.Contains(line, "drift:"+name+":end")
+}
+
+// linePrefix matches lines starting with prefix, ignoring indentation.
+func linePrefix(prefix string) func(string) bool {
+	return func(line string) bool {
+		return strings.HasPrefix(strings.TrimSpace(line), prefix)
+	}
+}
+
+// updateFile rewrites path with the result of update, if it changed.
+func updateFile(path string, update func(content string) (string, error)) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	updated, err := update(string(data))
+	if err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	if updated == string(data) {
+		return nil
+	}
+	if err := os.WriteFile(path, []byte(updated), 0o644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	return nil
+}
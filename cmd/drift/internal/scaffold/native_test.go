@@ -0,0 +1,177 @@
+package scaffold
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/go-drift/drift/cmd/drift/internal/config"
+	"github.com/go-drift/drift/cmd/drift/internal/templates"
+)
+
+// renderTemplate writes the rendered template at templatePath to dest.
+func renderTemplate(t *testing.T, templatePath, dest string) {
+	t.Helper()
+	content, err := templates.ReadFile(templatePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := templates.NewTemplateData(templates.TemplateInput{
+		AppName:        "demo",
+		AndroidPackage: "com.example.demo",
+		IOSBundleID:    "com.example.demo",
+		Orientation:    "portrait",
+	})
+	out, err := templates.ProcessTemplate(string(content), data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(filepath.Dir(dest), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(dest, []byte(out), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func readFile(t *testing.T, path string) string {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(data)
+}
+
+func TestInjectAndroid(t *testing.T) {
+	dir := t.TempDir()
+	gradle := filepath.Join(dir, "app", "build.gradle")
+	manifest := filepath.Join(dir, "app", "src", "main", "AndroidManifest.xml")
+	renderTemplate(t, "android/app.build.gradle.tmpl", gradle)
+	renderTemplate(t, "android/AndroidManifest.xml.tmpl", manifest)
+
+	native := config.AndroidNativeConfig{
+		Dependencies: []string{"com.squareup.okhttp3:okhttp:4.12.0"},
+		Permissions:  []string{"android.permission.CAMERA", "android.permission.NFC"},
+		Application:  []string{`<meta-data android:name="maps.key" android:value="abc" />`},
+	}
+	if err := InjectAndroid(dir, native); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := readFile(t, gradle); !strings.Contains(got, "dependencies {\n    implementation \"com.squareup.okhttp3:okhttp:4.12.0\"\n}\n// drift:dependencies:end") {
+		t.Errorf("expected the dependency block in build.gradle, got:\n%s", got)
+	}
+	got := readFile(t, manifest)
+	if n := strings.Count(got, `android.permission.CAMERA"`); n != 1 {
+		t.Errorf("expected an existing permission not to be repeated, found %d", n)
+	}
+	if !strings.Contains(got, `    <uses-permission android:name="android.permission.NFC" />`) {
+		t.Error("expected the new permission in the manifest")
+	}
+	meta := strings.Index(got, `<meta-data android:name="maps.key"`)
+	if meta < 0 || meta > strings.Index(got, "</application>") || meta < strings.Index(got, "<application") {
+		t.Error("expected the application entry inside <application>")
+	}
+
+	// Injecting again leaves the files untouched.
+	before := readFile(t, gradle) + readFile(t, manifest)
+	if err := InjectAndroid(dir, native); err != nil {
+		t.Fatal(err)
+	}
+	if after := readFile(t, gradle) + readFile(t, manifest); after != before {
+		t.Error("expected injection to be idempotent")
+	}
+
+	// Removing entries from drift.yaml empties the blocks.
+	if err := InjectAndroid(dir, config.AndroidNativeConfig{}); err != nil {
+		t.Fatal(err)
+	}
+	if got := readFile(t, gradle); strings.Contains(got, "okhttp") || !strings.Contains(got, "// drift:dependencies:begin\n// drift:dependencies:end") {
+		t.Errorf("expected an empty dependency block, got:\n%s", got)
+	}
+	if got := readFile(t, manifest); strings.Contains(got, "NFC") || strings.Contains(got, "maps.key") {
+		t.Error("expected the manifest entries removed")
+	}
+}
+
+func TestInjectIOS(t *testing.T) {
+	dir := t.TempDir()
+	plist := filepath.Join(dir, "Runner", "Info.plist")
+	renderTemplate(t, "ios/Info.plist.tmpl", plist)
+	if !strings.Contains(readFile(t, plist), "<key>NSCameraUsageDescription</key>") {
+		t.Fatal("expected the template to declare NSCameraUsageDescription")
+	}
+
+	native := config.IOSNativeConfig{
+		Pods: []config.Pod{{Name: "GoogleMaps", Version: "~> 8.4"}},
+		Plist: map[string]any{
+			"NSCameraUsageDescription":    "Scan receipts",
+			"LSApplicationQueriesSchemes": []any{"comgooglemaps"},
+			"UIFileSharingEnabled":        true,
+		},
+	}
+	if err := InjectIOS(dir, native); err != nil {
+		t.Fatal(err)
+	}
+
+	got := readFile(t, plist)
+	if n := strings.Count(got, "<key>NSCameraUsageDescription</key>"); n != 1 {
+		t.Errorf("expected the drift.yaml value to replace the template's, found the key %d times", n)
+	}
+	for _, want := range []string{
+		"\t<key>NSCameraUsageDescription</key>\n\t<string>Scan receipts</string>",
+		"\t<key>LSApplicationQueriesSchemes</key>\n\t<array>\n\t\t<string>comgooglemaps</string>\n\t</array>",
+		"\t<key>UIFileSharingEnabled</key>\n\t<true/>",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("expected %q in Info.plist", want)
+		}
+	}
+	if !strings.HasSuffix(strings.TrimSpace(got), "<!-- drift:plist:end -->\n</dict>\n</plist>") {
+		t.Error("expected the entries at the end of the top-level dictionary")
+	}
+
+	if !HasPods(dir) {
+		t.Fatal("expected a Podfile declaring pods")
+	}
+	if podfile := readFile(t, filepath.Join(dir, "Podfile")); !strings.Contains(podfile, "  pod 'GoogleMaps', '~> 8.4'\n  # drift:pods:end\nend") {
+		t.Errorf("expected the pod inside the Runner target, got:\n%s", podfile)
+	}
+
+	before := readFile(t, plist)
+	if err := InjectIOS(dir, native); err != nil {
+		t.Fatal(err)
+	}
+	if readFile(t, plist) != before {
+		t.Error("expected injection to be idempotent")
+	}
+}
+
+func TestInjectXtool(t *testing.T) {
+	dir := t.TempDir()
+	renderTemplate(t, "xtool/Package.swift.tmpl", filepath.Join(dir, "Package.swift"))
+	renderTemplate(t, "xtool/Info.plist.tmpl", filepath.Join(dir, "Sources", "Runner", "Resources", "Info.plist"))
+
+	native := config.IOSNativeConfig{
+		Packages: []config.SwiftPackage{{
+			URL:      "https://github.com/apple/swift-collections.git",
+			Version:  "1.1.0",
+			Products: []string{"Collections"},
+		}},
+	}
+	if err := InjectXtool(dir, native); err != nil {
+		t.Fatal(err)
+	}
+
+	got := readFile(t, filepath.Join(dir, "Package.swift"))
+	for _, want := range []string{
+		`        .package(url: "https://github.com/apple/swift-collections.git", from: "1.1.0"),`,
+		`                .product(name: "Collections", package: "swift-collections"),`,
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("expected %q in Package.swift, got:\n%s", want, got)
+		}
+	}
+}
@@ -1,5 +1,7 @@
 package scaffold
 
+import "github.com/go-drift/drift/cmd/drift/internal/config"
+
 // Settings describes the app metadata used for scaffolding.
 type Settings struct {
 	AppName        string
@@ -7,8 +9,40 @@ type Settings struct {
 	Bundle         string
 	Orientation    string
 	AllowHTTP      bool
+	Version        string
+	BuildNumber    int
 	Ejected        bool // If true, skip user-owned files (Swift/Kotlin, project files)
 	ProjectRoot    string
 	Icon           string
-	IconBackground string
+	IconBackground string // hex color or image path
+	IconForeground string
+	IconMonochrome string
+	IconDark       string
+	IconTinted     string
+	Splash         config.SplashConfig
+	Localizations  map[string]config.LocalizationConfig
+}
+
+// NewSettings returns the scaffold settings for the resolved configuration
+// of the project at root.
+func NewSettings(root string, cfg *config.Resolved, ejected bool) Settings {
+	return Settings{
+		AppName:        cfg.AppName,
+		AppID:          cfg.AppID,
+		Bundle:         cfg.AppID,
+		Orientation:    cfg.Orientation,
+		AllowHTTP:      cfg.AllowHTTP,
+		Version:        cfg.Version,
+		BuildNumber:    cfg.BuildNumber,
+		Ejected:        ejected,
+		ProjectRoot:    root,
+		Icon:           cfg.Icon,
+		IconBackground: cfg.IconBackground,
+		IconForeground: cfg.IconForeground,
+		IconMonochrome: cfg.IconMonochrome,
+		IconDark:       cfg.IconDark,
+		IconTinted:     cfg.IconTinted,
+		Splash:         cfg.Splash,
+		Localizations:  cfg.Localizations,
+	}
 }
@@ -0,0 +1,106 @@
+package scaffold
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// The app version from drift.yaml is rendered into managed projects by the
+// templates. Ejected projects own their build files, so the version is
+// rewritten in place, touching only the version values. An empty version or
+// zero build number leaves that value as it is.
+
+var (
+	gradleVersionCode = regexp.MustCompile(`(?m)^(\s*versionCode\s*=?\s*)\d+`)
+	gradleVersionName = regexp.MustCompile(`(?m)^(\s*versionName\s*=?\s*)"[^"]*"`)
+	pbxMarketing      = regexp.MustCompile(`(MARKETING_VERSION = )[^;]*;`)
+	pbxProjectVersion = regexp.MustCompile(`(CURRENT_PROJECT_VERSION = )[^;]*;`)
+)
+
+// ApplyAndroidVersion sets versionName and versionCode in the app's
+// build.gradle or build.gradle.kts under androidDir.
+func ApplyAndroidVersion(androidDir, version string, build int) error {
+	if version == "" && build == 0 {
+		return nil
+	}
+	gradle := filepath.Join(androidDir, "app", "build.gradle")
+	if _, err := os.Stat(gradle); errors.Is(err, os.ErrNotExist) {
+		gradle += ".kts"
+	}
+	return updateFile(gradle, func(content string) (string, error) {
+		if build > 0 {
+			content = gradleVersionCode.ReplaceAllString(content, "${1}"+strconv.Itoa(build))
+		}
+		if version != "" {
+			content = gradleVersionName.ReplaceAllString(content, "${1}"+strconv.Quote(version))
+		}
+		return content, nil
+	})
+}
+
+// ApplyIOSVersion sets the version in the Xcode project under iosDir:
+// MARKETING_VERSION and CURRENT_PROJECT_VERSION in the project file, and
+// CFBundleShortVersionString and CFBundleVersion in Runner/Info.plist unless
+// they refer to those build settings.
+func ApplyIOSVersion(iosDir, version string, build int) error {
+	if version == "" && build == 0 {
+		return nil
+	}
+	if err := applyPlistVersion(filepath.Join(iosDir, "Runner", "Info.plist"), version, build); err != nil {
+		return err
+	}
+	return updateFile(filepath.Join(iosDir, "Runner.xcodeproj", "project.pbxproj"), func(content string) (string, error) {
+		if version != "" {
+			content = pbxMarketing.ReplaceAllString(content, "${1}"+version+";")
+		}
+		if build > 0 {
+			content = pbxProjectVersion.ReplaceAllString(content, "${1}"+strconv.Itoa(build)+";")
+		}
+		return content, nil
+	})
+}
+
+// ApplyXtoolVersion sets CFBundleShortVersionString and CFBundleVersion in
+// the xtool project's Info.plist under xtoolDir.
+func ApplyXtoolVersion(xtoolDir, version string, build int) error {
+	if version == "" && build == 0 {
+		return nil
+	}
+	return applyPlistVersion(filepath.Join(xtoolDir, "Sources", "Runner", "Resources", "Info.plist"), version, build)
+}
+
+func applyPlistVersion(plistPath, version string, build int) error {
+	return updateFile(plistPath, func(content string) (string, error) {
+		if version != "" {
+			content = setPlistString(content, "CFBundleShortVersionString", version)
+		}
+		if build > 0 {
+			content = setPlistString(content, "CFBundleVersion", strconv.Itoa(build))
+		}
+		return content, nil
+	})
+}
+
+// setPlistString replaces the <string> value following <key>key</key>.
+// Values that reference a build setting, such as $(MARKETING_VERSION), are
+// left alone.
+func setPlistString(content, key, value string) string {
+	lines := strings.Split(content, "\n")
+	for i := 0; i+1 < len(lines); i++ {
+		if strings.TrimSpace(lines[i]) != "<key>"+key+"</key>" {
+			continue
+		}
+		next := lines[i+1]
+		trimmed := strings.TrimSpace(next)
+		if !strings.HasPrefix(trimmed, "<string>") || strings.Contains(trimmed, "$(") {
+			continue
+		}
+		indent := next[:len(next)-len(strings.TrimLeft(next, " \t"))]
+		lines[i+1] = indent + "<string>" + value + "</string>"
+	}
+	return strings.Join(lines, "\n")
+}
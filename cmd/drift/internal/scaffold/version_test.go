@@ -0,0 +1,61 @@
+package scaffold
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestApplyAndroidVersion(t *testing.T) {
+	dir := t.TempDir()
+	gradle := filepath.Join(dir, "app", "build.gradle")
+	renderTemplate(t, "android/app.build.gradle.tmpl", gradle)
+
+	if err := ApplyAndroidVersion(dir, "2.1.0", 42); err != nil {
+		t.Fatal(err)
+	}
+	content := readFile(t, gradle)
+	if !strings.Contains(content, "versionCode 42\n") || !strings.Contains(content, `versionName "2.1.0"`) {
+		t.Errorf("expected the version in build.gradle, got:\n%s", content)
+	}
+
+	// A zero build number keeps the project's own.
+	if err := ApplyAndroidVersion(dir, "2.2.0", 0); err != nil {
+		t.Fatal(err)
+	}
+	if content := readFile(t, gradle); !strings.Contains(content, "versionCode 42\n") || !strings.Contains(content, `versionName "2.2.0"`) {
+		t.Errorf("expected only versionName to change, got:\n%s", content)
+	}
+}
+
+func TestApplyIOSVersion(t *testing.T) {
+	dir := t.TempDir()
+	plist := filepath.Join(dir, "Runner", "Info.plist")
+	pbxproj := filepath.Join(dir, "Runner.xcodeproj", "project.pbxproj")
+	renderTemplate(t, "ios/Info.plist.tmpl", plist)
+	renderTemplate(t, "xcodeproj/project.pbxproj.tmpl", pbxproj)
+
+	if err := ApplyIOSVersion(dir, "2.1.0", 42); err != nil {
+		t.Fatal(err)
+	}
+	content := readFile(t, plist)
+	for _, want := range []string{
+		"<key>CFBundleShortVersionString</key>\n\t<string>2.1.0</string>",
+		"<key>CFBundleVersion</key>\n\t<string>42</string>",
+	} {
+		if !strings.Contains(content, want) {
+			t.Errorf("expected %q in Info.plist", want)
+		}
+	}
+	project := readFile(t, pbxproj)
+	if strings.Count(project, "MARKETING_VERSION = 2.1.0;") != 2 || strings.Count(project, "CURRENT_PROJECT_VERSION = 42;") != 2 {
+		t.Errorf("expected both build configurations to be updated")
+	}
+}
+
+func TestSetPlistString_KeepsBuildSettingReference(t *testing.T) {
+	in := "<key>CFBundleVersion</key>\n\t<string>$(CURRENT_PROJECT_VERSION)</string>"
+	if got := setPlistString(in, "CFBundleVersion", "7"); got != in {
+		t.Errorf("expected build setting reference to be kept, got %q", got)
+	}
+}
@@ -39,6 +39,9 @@ func WriteXtool(root string, settings Settings) error {
 		IOSBundleID:    settings.Bundle,
 		Orientation:    settings.Orientation,
 		AllowHTTP:      settings.AllowHTTP,
+		Version:        settings.Version,
+		BuildNumber:    settings.BuildNumber,
+		Locales:        IOSLocales(settings),
 	})
 
 	// Write Package.swift and xtool.yml
@@ -86,6 +89,13 @@ func WriteXtool(root string, settings Settings) error {
 		return err
 	}
 
+	// Write translated display names and usage descriptions. xtool.yml
+	// copies the .lproj directories into the app bundle, where iOS reads
+	// InfoPlist.strings.
+	if err := WriteIOSLocalizations(resourcesDir, settings); err != nil {
+		return err
+	}
+
 	// Write module maps for C libraries
 	if err := writeCDriftModuleMap(cdriftDir); err != nil {
 		return err
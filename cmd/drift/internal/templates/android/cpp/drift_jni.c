@@ -136,6 +136,17 @@ typedef void (*DriftSetScheduleFrameHandlerFn)(void (*handler)(void));
  */
 typedef int (*DriftHitTestPlatformViewFn)(int64_t viewID, double x, double y);
 
+/**
+ * Function pointer type for DriftMouseCursor.
+ * Matches the signature exported by Go:
+ *   func DriftMouseCursor(x C.double, y C.double) C.int
+ *
+ * @param x X coordinate in pixels
+ * @param y Y coordinate in pixels
+ * @return The layout.MouseCursor value to show (0 for the default pointer)
+ */
+typedef int (*DriftMouseCursorFn)(double x, double y);
+
 /* Cached function pointers. NULL until resolved. */
 static DriftPointerFn drift_pointer_event = NULL;
 static DriftSetScaleFn drift_set_scale = NULL;
@@ -150,6 +161,7 @@ static DriftBackButtonFn drift_back_button = NULL;
 static DriftRequestFrameFn drift_request_frame = NULL;
 static DriftNeedsFrameFn drift_needs_frame = NULL;
 static DriftHitTestPlatformViewFn drift_hit_test_platform_view = NULL;
+static DriftMouseCursorFn drift_mouse_cursor = NULL;
 static DriftSetScheduleFrameHandlerFn drift_set_schedule_frame_handler = NULL;
 
 /* Function pointer types for unified orchestrator */
@@ -161,9 +173,15 @@ static DriftStepAndSnapshotFn drift_step_and_snapshot = NULL;
 static DriftSkiaRenderVulkanSyncFn drift_skia_render_vulkan_sync = NULL;
 static DriftSkiaPurgeResourcesFn drift_skia_purge_resources = NULL;
 
+typedef int (*DriftSurfaceColorSpaceFn)(int wide_gamut);
+static DriftSurfaceColorSpaceFn drift_surface_color_space = NULL;
+
 typedef int (*DriftShouldWarmUpViewsFn)(void);
 static DriftShouldWarmUpViewsFn drift_should_warm_up_views = NULL;
 
+typedef int (*DriftFirstFrameRasterizedFn)(void);
+static DriftFirstFrameRasterizedFn drift_first_frame_rasterized = NULL;
+
 /* Handle to the loaded Go shared library. NULL until loaded. */
 static void *drift_handle = NULL;
 
@@ -759,6 +777,33 @@ Java_{{.JNIPackage}}_NativeBridge_hitTestPlatformView(
     return (jint)drift_hit_test_platform_view((int64_t)viewID, x, y);
 }
 
+/**
+ * JNI implementation for NativeBridge.mouseCursor().
+ *
+ * Queries the Go engine for the mouse cursor to show at the given pixel
+ * coordinates.
+ *
+ * @param x X coordinate in pixels
+ * @param y Y coordinate in pixels
+ * @return The layout.MouseCursor value (0 for the default pointer)
+ */
+JNIEXPORT jint JNICALL
+Java_{{.JNIPackage}}_NativeBridge_mouseCursor(
+    JNIEnv *env,
+    jclass clazz,
+    jdouble x,
+    jdouble y
+) {
+    (void)env;
+    (void)clazz;
+
+    if (resolve_symbol("DriftMouseCursor", (void **)&drift_mouse_cursor) != 0) {
+        return 0;
+    }
+
+    return (jint)drift_mouse_cursor(x, y);
+}
+
 /**
  * JNI_OnLoad is called when the native library is loaded.
  * We save the JavaVM reference for later use in callbacks.
@@ -1317,6 +1362,22 @@ Java_{{.JNIPackage}}_NativeBridge_renderFrameSync(JNIEnv *env, jclass clazz, jin
     return (jint)slot_idx;
 }
 
+/**
+ * JNI: NativeBridge.surfaceColorSpace(wideGamut)
+ * Reports whether the display supports Display P3 and returns the color space
+ * the engine renders the next frame in: 0 for sRGB, 1 for Display P3.
+ */
+JNIEXPORT jint JNICALL
+Java_{{.JNIPackage}}_NativeBridge_surfaceColorSpace(JNIEnv *env, jclass clazz, jint wide_gamut) {
+    (void)env; (void)clazz;
+
+    if (resolve_symbol("DriftSurfaceColorSpace", (void **)&drift_surface_color_space) != 0) {
+        return 0; /* Fail-safe: render in sRGB */
+    }
+
+    return (jint)drift_surface_color_space((int)wide_gamut);
+}
+
 /**
  * JNI: NativeBridge.purgeResources()
  * Releases all cached GPU resources.
@@ -1348,3 +1409,19 @@ Java_{{.JNIPackage}}_NativeBridge_shouldWarmUpViews(JNIEnv *env, jclass clazz) {
 
     return (jint)drift_should_warm_up_views();
 }
+
+/**
+ * JNI: NativeBridge.firstFrameRasterized()
+ * Returns 1 once the Go engine has drawn its first app frame with no
+ * engine.DeferFirstFrame() calls outstanding, 0 otherwise.
+ */
+JNIEXPORT jint JNICALL
+Java_{{.JNIPackage}}_NativeBridge_firstFrameRasterized(JNIEnv *env, jclass clazz) {
+    (void)env; (void)clazz;
+
+    if (resolve_symbol("DriftFirstFrameRasterized", (void **)&drift_first_frame_rasterized) != 0) {
+        return 1; /* Fail-safe: drop the launch screen if we can't check */
+    }
+
+    return (jint)drift_first_frame_rasterized();
+}
@@ -0,0 +1,274 @@
+/**
+ * CastHandler.kt
+ * Handles Google Cast discovery and sessions for the Drift platform channel.
+ */
+package {{.PackageName}}
+
+import android.content.Context
+import android.os.Handler
+import android.os.Looper
+import android.view.View
+import androidx.mediarouter.media.MediaRouteSelector
+import androidx.mediarouter.media.MediaRouter
+import com.google.android.gms.cast.CastMediaControlIntent
+import com.google.android.gms.cast.framework.CastContext
+import com.google.android.gms.cast.framework.CastOptions
+import com.google.android.gms.cast.framework.CastSession
+import com.google.android.gms.cast.framework.OptionsProvider
+import com.google.android.gms.cast.framework.SessionManagerListener
+import com.google.android.gms.cast.framework.SessionProvider
+import java.lang.ref.WeakReference
+import java.util.concurrent.CountDownLatch
+import java.util.concurrent.TimeUnit
+
+/**
+ * Configures the Cast framework with the default media receiver, which plays
+ * the formats Chromecast supports without a custom receiver app. Declared in
+ * AndroidManifest.xml.
+ */
+class DriftCastOptionsProvider : OptionsProvider {
+    override fun getCastOptions(context: Context): CastOptions {
+        return CastOptions.Builder()
+            .setReceiverApplicationId(CastMediaControlIntent.DEFAULT_MEDIA_RECEIVER_APPLICATION_ID)
+            .build()
+    }
+
+    override fun getAdditionalSessionProviders(context: Context): List<SessionProvider>? = null
+}
+
+/**
+ * Stands in for the AirPlay button, which has no Android equivalent. The view
+ * is empty so apps can build the same widget tree on both platforms.
+ */
+class NativeAirPlayButtonContainer(
+    context: Context,
+    override val viewId: Int
+) : PlatformViewContainer {
+    override val view: View = View(context)
+
+    override fun dispose() {}
+}
+
+/**
+ * Discovers Cast receivers through MediaRouter and tracks the Cast session.
+ *
+ * When a session connects, the video player that most recently started
+ * playing moves its media to the receiver; when the session ends, playback
+ * returns to that player.
+ */
+object CastHandler {
+    private const val KIND_CHROMECAST = 0
+
+    private val handler = Handler(Looper.getMainLooper())
+    private var castContext: CastContext? = null
+    private var router: MediaRouter? = null
+    private var discovering = false
+    private var activePlayer: WeakReference<NativeVideoPlayerContainer>? = null
+
+    private val selector: MediaRouteSelector = MediaRouteSelector.Builder()
+        .addControlCategory(
+            CastMediaControlIntent.categoryForCast(CastMediaControlIntent.DEFAULT_MEDIA_RECEIVER_APPLICATION_ID)
+        )
+        .build()
+
+    fun handle(context: Context, method: String, args: Any?): Pair<Any?, Exception?> {
+        val error = onMain { init(context) }
+        if (error != null) {
+            return Pair(null, error)
+        }
+        return when (method) {
+            "startDiscovery" -> {
+                handler.post { startDiscovery() }
+                Pair(null, null)
+            }
+            "stopDiscovery" -> {
+                handler.post { stopDiscovery() }
+                Pair(null, null)
+            }
+            "getSession" -> Pair(onMainResult { sessionMap(castContext?.sessionManager?.currentCastSession) }, null)
+            "connect" -> connect(args)
+            "disconnect" -> {
+                handler.post { castContext?.sessionManager?.endCurrentSession(true) }
+                Pair(null, null)
+            }
+            else -> Pair(null, IllegalArgumentException("Unknown method: $method"))
+        }
+    }
+
+    /**
+     * Initializes the Cast framework. Must be called on the main thread.
+     * Returns an error if Google Play services is unavailable, in which case
+     * casting is unsupported and players stay local.
+     */
+    fun init(context: Context): Exception? {
+        if (castContext != null) return null
+        return try {
+            val cast = CastContext.getSharedInstance(context.applicationContext)
+            cast.sessionManager.addSessionManagerListener(sessionListener, CastSession::class.java)
+            castContext = cast
+            router = MediaRouter.getInstance(context.applicationContext)
+            null
+        } catch (e: Exception) {
+            e
+        }
+    }
+
+    /**
+     * Records that a player started playing. If a session is connected, the
+     * player takes over the receiver from the previously cast player.
+     */
+    fun playerStarted(player: NativeVideoPlayerContainer) {
+        val previous = activePlayer?.get()
+        activePlayer = WeakReference(player)
+        val cast = castContext ?: return
+        val session = cast.sessionManager.currentCastSession ?: return
+        if (!session.isConnected || player.isCasting) return
+        if (previous != null && previous !== player) {
+            previous.stopCasting(resume = false)
+        }
+        player.startCasting(cast, deviceName(session))
+    }
+
+    /** Forgets a disposed player. */
+    fun playerDisposed(player: NativeVideoPlayerContainer) {
+        if (activePlayer?.get() === player) {
+            activePlayer = null
+        }
+    }
+
+    private fun startDiscovery() {
+        val r = router ?: return
+        if (!discovering) {
+            r.addCallback(selector, routeCallback, MediaRouter.CALLBACK_FLAG_REQUEST_DISCOVERY)
+            discovering = true
+        }
+        sendDevices()
+    }
+
+    private fun stopDiscovery() {
+        if (discovering) {
+            router?.removeCallback(routeCallback)
+            discovering = false
+        }
+    }
+
+    private fun connect(args: Any?): Pair<Any?, Exception?> {
+        val deviceId = (args as? Map<*, *>)?.get("deviceId") as? String
+            ?: return Pair(null, IllegalArgumentException("Missing deviceId"))
+        val found = onMainResult {
+            val r = router ?: return@onMainResult false
+            val route = r.routes.firstOrNull { it.id == deviceId && isCastRoute(it) }
+                ?: return@onMainResult false
+            r.selectRoute(route)
+            true
+        }
+        return if (found == true) {
+            Pair(null, null)
+        } else {
+            Pair(null, IllegalArgumentException("Unknown cast device: $deviceId"))
+        }
+    }
+
+    private fun isCastRoute(route: MediaRouter.RouteInfo): Boolean {
+        return !route.isDefault && route.isEnabled && route.matchesSelector(selector)
+    }
+
+    private fun sendDevices() {
+        val devices = router?.routes.orEmpty().filter { isCastRoute(it) }.map { route ->
+            mapOf(
+                "id" to route.id,
+                "name" to route.name,
+                "kind" to KIND_CHROMECAST
+            )
+        }
+        PlatformChannelManager.sendEvent(
+            "drift/cast/discovery",
+            mapOf(
+                "devices" to devices,
+                "routesAvailable" to devices.isNotEmpty()
+            )
+        )
+    }
+
+    private val routeCallback = object : MediaRouter.Callback() {
+        override fun onRouteAdded(router: MediaRouter, route: MediaRouter.RouteInfo) = sendDevices()
+        override fun onRouteRemoved(router: MediaRouter, route: MediaRouter.RouteInfo) = sendDevices()
+        override fun onRouteChanged(router: MediaRouter, route: MediaRouter.RouteInfo) = sendDevices()
+    }
+
+    private fun deviceName(session: CastSession): String {
+        return session.castDevice?.friendlyName ?: ""
+    }
+
+    private fun sessionMap(session: CastSession?): Map<String, Any?> {
+        val state = when {
+            session == null -> 0
+            session.isConnected -> 2
+            session.isConnecting || session.isResuming -> 1
+            else -> 0
+        }
+        val device = session?.castDevice
+        return mapOf(
+            "state" to state,
+            "device" to if (state != 0 && device != null) {
+                mapOf(
+                    "id" to device.deviceId,
+                    "name" to (device.friendlyName ?: ""),
+                    "kind" to KIND_CHROMECAST
+                )
+            } else {
+                null
+            }
+        )
+    }
+
+    private fun sendSession(session: CastSession?) {
+        PlatformChannelManager.sendEvent("drift/cast/session", sessionMap(session))
+    }
+
+    private fun sessionConnected(session: CastSession) {
+        sendSession(session)
+        val cast = castContext ?: return
+        val player = activePlayer?.get() ?: return
+        if (!player.isCasting) {
+            player.startCasting(cast, deviceName(session))
+        }
+    }
+
+    private fun sessionEnding() {
+        // Read the receiver's position before the session goes away.
+        activePlayer?.get()?.stopCasting(resume = true)
+    }
+
+    private val sessionListener = object : SessionManagerListener<CastSession> {
+        override fun onSessionStarting(session: CastSession) = sendSession(session)
+        override fun onSessionStarted(session: CastSession, sessionId: String) = sessionConnected(session)
+        override fun onSessionStartFailed(session: CastSession, error: Int) = sendSession(null)
+        override fun onSessionEnding(session: CastSession) = sessionEnding()
+        override fun onSessionEnded(session: CastSession, error: Int) = sendSession(null)
+        override fun onSessionResuming(session: CastSession, sessionId: String) = sendSession(session)
+        override fun onSessionResumed(session: CastSession, wasSuspended: Boolean) = sessionConnected(session)
+        override fun onSessionResumeFailed(session: CastSession, error: Int) = sendSession(null)
+        override fun onSessionSuspended(session: CastSession, reason: Int) {}
+    }
+
+    /** Runs block on the main thread and waits for its result. */
+    private fun <T> onMainResult(block: () -> T): T? {
+        if (Looper.myLooper() == Looper.getMainLooper()) {
+            return block()
+        }
+        var result: T? = null
+        val latch = CountDownLatch(1)
+        handler.post {
+            try {
+                result = block()
+            } finally {
+                latch.countDown()
+            }
+        }
+        latch.await(5, TimeUnit.SECONDS)
+        return result
+    }
+
+    private fun onMain(block: () -> Exception?): Exception? = onMainResult(block)
+}
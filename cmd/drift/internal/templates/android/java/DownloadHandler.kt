@@ -0,0 +1,459 @@
+/**
+ * DownloadHandler.kt
+ * Handles resumable background downloads using WorkManager for the Drift platform channel.
+ */
+package {{.PackageName}}
+
+import android.Manifest
+import android.app.NotificationChannel
+import android.app.NotificationManager
+import android.content.Context
+import android.content.pm.PackageManager
+import android.content.pm.ServiceInfo
+import android.net.Uri
+import android.util.Log
+import androidx.core.app.NotificationCompat
+import androidx.core.app.NotificationManagerCompat
+import androidx.core.content.ContextCompat
+import androidx.work.*
+import java.io.File
+import java.io.FileOutputStream
+import java.io.IOException
+import java.net.HttpURLConnection
+import java.net.URL
+import java.util.UUID
+import java.util.concurrent.TimeUnit
+
+/**
+ * Tracks downloads and runs each one as unique WorkManager work, so it
+ * survives process death and waits for its network constraint.
+ *
+ * Task state is persisted in SharedPreferences. Events are sent to Go only
+ * once Go has called into the download channel in this process; terminal
+ * events that happen before then (for example when WorkManager restarts the
+ * process in the background) are kept as pending completions.
+ */
+object DownloadHandler {
+    private const val PREFS = "drift_downloads"
+    private const val TASKS_KEY = "tasks"
+    private const val PENDING_KEY = "pending_completions"
+    private const val WORK_PREFIX = "drift_download_"
+    private const val CHANNEL_ID = "drift_downloads"
+
+    const val STATUS_QUEUED = 0
+    const val STATUS_RUNNING = 1
+    const val STATUS_PAUSED = 2
+    const val STATUS_COMPLETED = 3
+    const val STATUS_FAILED = 4
+    const val STATUS_CANCELED = 5
+
+    private val lock = Any()
+
+    @Volatile
+    private var goAttached = false
+
+    fun handle(context: Context, method: String, args: Any?): Pair<Any?, Exception?> {
+        goAttached = true
+        val id = (args as? Map<*, *>)?.get("id") as? String
+        return when (method) {
+            "enqueue" -> enqueue(context, args)
+            "pause" -> withTask(context, id) { pause(context, it) }
+            "resume" -> withTask(context, id) { resume(context, it) }
+            "cancel" -> withTask(context, id) { cancel(context, it) }
+            "remove" -> withTask(context, id) { remove(context, it) }
+            "getTask" -> Pair(id?.let { loadTask(context, it) }?.let { publicTask(it) }, null)
+            "getTasks" -> Pair(synchronized(lock) { loadTasks(context) }.values.map { publicTask(it) }, null)
+            "takePendingCompletions" -> Pair(takePending(context), null)
+            else -> Pair(null, IllegalArgumentException("Unknown method: $method"))
+        }
+    }
+
+    private fun withTask(
+        context: Context,
+        id: String?,
+        action: (MutableMap<String, Any?>) -> Unit
+    ): Pair<Any?, Exception?> {
+        if (id == null) return Pair(null, IllegalArgumentException("Missing id"))
+        val task = loadTask(context, id)
+            ?: return Pair(null, IllegalArgumentException("Unknown download: $id"))
+        action(task)
+        return Pair(null, null)
+    }
+
+    private fun enqueue(context: Context, args: Any?): Pair<Any?, Exception?> {
+        val argsMap = args as? Map<*, *>
+            ?: return Pair(null, IllegalArgumentException("Invalid arguments"))
+        val url = argsMap["url"] as? String
+            ?: return Pair(null, IllegalArgumentException("Missing url"))
+        val id = (argsMap["id"] as? String)?.takeIf { it.isNotEmpty() } ?: UUID.randomUUID().toString()
+        val fileName = (argsMap["fileName"] as? String)?.takeIf { it.isNotEmpty() }
+            ?: Uri.parse(url).lastPathSegment?.takeIf { it.isNotEmpty() }
+            ?: id
+
+        // Replace an existing download with the same ID.
+        loadTask(context, id)?.let { stopWork(context, id); deletePartial(it) }
+
+        val dir = File(context.filesDir, "downloads").apply { mkdirs() }
+        val task = mutableMapOf<String, Any?>(
+            "id" to id,
+            "url" to url,
+            "path" to File(dir, File(fileName).name).absolutePath,
+            "headers" to (argsMap["headers"] as? Map<*, *> ?: emptyMap<String, Any?>()),
+            "wifiOnly" to (argsMap["wifiOnly"] == true),
+            "notificationTitle" to (argsMap["notificationTitle"] as? String ?: ""),
+            "status" to STATUS_QUEUED,
+            "bytesDownloaded" to 0L,
+            "totalBytes" to -1L,
+            "error" to ""
+        )
+        saveTask(context, task)
+        startWork(context, task)
+        return Pair(publicTask(task), null)
+    }
+
+    private fun pause(context: Context, task: MutableMap<String, Any?>) {
+        if (isTerminal(task)) return
+        // Mark paused before stopping so the worker does not report a retry.
+        task["status"] = STATUS_PAUSED
+        saveTask(context, task)
+        stopWork(context, task["id"] as String)
+        notifyChanged(context, task)
+    }
+
+    private fun resume(context: Context, task: MutableMap<String, Any?>) {
+        val status = task["status"] as? Int
+        if (status != STATUS_PAUSED && status != STATUS_FAILED) return
+        task["status"] = STATUS_QUEUED
+        task["error"] = ""
+        saveTask(context, task)
+        startWork(context, task)
+        notifyChanged(context, task)
+    }
+
+    private fun cancel(context: Context, task: MutableMap<String, Any?>) {
+        if (isTerminal(task)) return
+        task["status"] = STATUS_CANCELED
+        saveTask(context, task)
+        stopWork(context, task["id"] as String)
+        deletePartial(task)
+        notifyChanged(context, task)
+    }
+
+    private fun remove(context: Context, task: MutableMap<String, Any?>) {
+        val id = task["id"] as String
+        stopWork(context, id)
+        deletePartial(task)
+        (task["path"] as? String)?.let { File(it).delete() }
+        synchronized(lock) {
+            val tasks = loadTasks(context)
+            tasks.remove(id)
+            writeTasks(context, tasks)
+        }
+        NotificationManagerCompat.from(context).cancel(notificationId(id))
+    }
+
+    private fun startWork(context: Context, task: Map<String, Any?>) {
+        val id = task["id"] as String
+        val constraints = Constraints.Builder()
+            .setRequiredNetworkType(
+                if (task["wifiOnly"] == true) NetworkType.UNMETERED else NetworkType.CONNECTED
+            )
+            .build()
+        val request = OneTimeWorkRequestBuilder<DriftDownloadWorker>()
+            .setConstraints(constraints)
+            .setBackoffCriteria(BackoffPolicy.EXPONENTIAL, 10, TimeUnit.SECONDS)
+            .setInputData(Data.Builder().putString("download_id", id).build())
+            .build()
+        WorkManager.getInstance(context).enqueueUniqueWork(WORK_PREFIX + id, ExistingWorkPolicy.REPLACE, request)
+    }
+
+    private fun stopWork(context: Context, id: String) {
+        WorkManager.getInstance(context).cancelUniqueWork(WORK_PREFIX + id)
+    }
+
+    fun partialFile(task: Map<String, Any?>): File = File(task["path"] as String + ".part")
+
+    private fun deletePartial(task: Map<String, Any?>) {
+        partialFile(task).delete()
+    }
+
+    private fun isTerminal(task: Map<String, Any?>): Boolean {
+        val status = task["status"] as? Int
+        return status == STATUS_COMPLETED || status == STATUS_FAILED || status == STATUS_CANCELED
+    }
+
+    // Persistence
+
+    @Suppress("UNCHECKED_CAST")
+    private fun loadTasks(context: Context): MutableMap<String, MutableMap<String, Any?>> {
+        val json = prefs(context).getString(TASKS_KEY, null) ?: return mutableMapOf()
+        val decoded = JsonCodec.decode(json.toByteArray(Charsets.UTF_8)) as? Map<String, Any?>
+            ?: return mutableMapOf()
+        val tasks = mutableMapOf<String, MutableMap<String, Any?>>()
+        for ((id, value) in decoded) {
+            val task = (value as? Map<String, Any?>)?.toMutableMap() ?: continue
+            // JSON numbers decode as Int or Long; normalize the fields compared.
+            task["status"] = (task["status"] as? Number)?.toInt() ?: STATUS_QUEUED
+            task["bytesDownloaded"] = (task["bytesDownloaded"] as? Number)?.toLong() ?: 0L
+            task["totalBytes"] = (task["totalBytes"] as? Number)?.toLong() ?: -1L
+            tasks[id] = task
+        }
+        return tasks
+    }
+
+    fun loadTask(context: Context, id: String): MutableMap<String, Any?>? {
+        return synchronized(lock) { loadTasks(context)[id] }
+    }
+
+    fun saveTask(context: Context, task: Map<String, Any?>) {
+        synchronized(lock) {
+            val tasks = loadTasks(context)
+            val id = task["id"] as String
+            // A task removed while its worker was stopping stays removed.
+            if (tasks.containsKey(id) || task["status"] == STATUS_QUEUED) {
+                tasks[id] = task.toMutableMap()
+                writeTasks(context, tasks)
+            }
+        }
+    }
+
+    private fun writeTasks(context: Context, tasks: Map<String, Any?>) {
+        prefs(context).edit()
+            .putString(TASKS_KEY, String(JsonCodec.encode(tasks), Charsets.UTF_8))
+            .apply()
+    }
+
+    private fun takePending(context: Context): List<Any?> {
+        synchronized(lock) {
+            val prefs = prefs(context)
+            val json = prefs.getString(PENDING_KEY, null) ?: return emptyList()
+            prefs.edit().remove(PENDING_KEY).apply()
+            return JsonCodec.decode(json.toByteArray(Charsets.UTF_8)) as? List<Any?> ?: emptyList()
+        }
+    }
+
+    private fun addPending(context: Context, task: Map<String, Any?>) {
+        synchronized(lock) {
+            val prefs = prefs(context)
+            val existing = prefs.getString(PENDING_KEY, null)?.let {
+                JsonCodec.decode(it.toByteArray(Charsets.UTF_8)) as? List<*>
+            }.orEmpty()
+            val pending = existing.filter { (it as? Map<*, *>)?.get("id") != task["id"] } + publicTask(task)
+            prefs.edit().putString(PENDING_KEY, String(JsonCodec.encode(pending), Charsets.UTF_8)).apply()
+        }
+    }
+
+    private fun prefs(context: Context) =
+        context.applicationContext.getSharedPreferences(PREFS, Context.MODE_PRIVATE)
+
+    /** Returns the fields of a task that are reported to Go. */
+    private fun publicTask(task: Map<String, Any?>): Map<String, Any?> = mapOf(
+        "id" to task["id"],
+        "url" to task["url"],
+        "path" to task["path"],
+        "status" to task["status"],
+        "bytesDownloaded" to task["bytesDownloaded"],
+        "totalBytes" to task["totalBytes"],
+        "error" to task["error"]
+    )
+
+    /**
+     * Reports a task change to Go. Terminal changes that Go cannot receive
+     * yet are kept for takePendingCompletions, and shown as a notification
+     * when the task has a notification title.
+     */
+    fun notifyChanged(context: Context, task: Map<String, Any?>) {
+        if (goAttached) {
+            PlatformChannelManager.sendEvent("drift/downloads/events", publicTask(task))
+        } else if (isTerminal(task)) {
+            addPending(context, task)
+        }
+        val status = task["status"] as? Int
+        if (status == STATUS_COMPLETED || status == STATUS_FAILED) {
+            showFinishedNotification(context, task)
+        }
+    }
+
+    // Notifications
+
+    fun notificationId(id: String): Int = (WORK_PREFIX + id).hashCode()
+
+    private fun ensureChannel(context: Context) {
+        val manager = context.getSystemService(Context.NOTIFICATION_SERVICE) as NotificationManager
+        if (manager.getNotificationChannel(CHANNEL_ID) == null) {
+            manager.createNotificationChannel(
+                NotificationChannel(CHANNEL_ID, "Downloads", NotificationManager.IMPORTANCE_LOW)
+            )
+        }
+    }
+
+    /** Builds the foreground notification showing a running download's progress. */
+    fun progressForegroundInfo(context: Context, task: Map<String, Any?>): ForegroundInfo? {
+        val title = task["notificationTitle"] as? String
+        if (title.isNullOrEmpty()) return null
+        ensureChannel(context)
+        val total = (task["totalBytes"] as? Long) ?: -1L
+        val done = (task["bytesDownloaded"] as? Long) ?: 0L
+        val notification = NotificationCompat.Builder(context, CHANNEL_ID)
+            .setSmallIcon(android.R.drawable.stat_sys_download)
+            .setContentTitle(title)
+            .setOngoing(true)
+            .setOnlyAlertOnce(true)
+            .setProgress(100, if (total > 0) (done * 100 / total).toInt() else 0, total <= 0)
+            .build()
+        return ForegroundInfo(
+            notificationId(task["id"] as String),
+            notification,
+            ServiceInfo.FOREGROUND_SERVICE_TYPE_DATA_SYNC
+        )
+    }
+
+    private fun showFinishedNotification(context: Context, task: Map<String, Any?>) {
+        val title = task["notificationTitle"] as? String
+        if (title.isNullOrEmpty()) return
+        if (ContextCompat.checkSelfPermission(context, Manifest.permission.POST_NOTIFICATIONS)
+            != PackageManager.PERMISSION_GRANTED
+        ) {
+            return
+        }
+        ensureChannel(context)
+        val completed = task["status"] == STATUS_COMPLETED
+        val notification = NotificationCompat.Builder(context, CHANNEL_ID)
+            .setSmallIcon(if (completed) android.R.drawable.stat_sys_download_done else android.R.drawable.stat_notify_error)
+            .setContentTitle(title)
+            .setContentText(if (completed) "Download complete" else "Download failed")
+            .setAutoCancel(true)
+            .build()
+        NotificationManagerCompat.from(context).notify(notificationId(task["id"] as String), notification)
+    }
+}
+
+/**
+ * Worker that downloads one file, resuming from its partial file with an
+ * HTTP range request. Failures are retried with backoff; a paused or
+ * canceled task leaves the worker stopped.
+ */
+class DriftDownloadWorker(
+    context: Context,
+    params: WorkerParameters
+) : Worker(context, params) {
+
+    companion object {
+        private const val MAX_ATTEMPTS = 5
+        private const val PROGRESS_INTERVAL_MS = 250L
+    }
+
+    override fun doWork(): Result {
+        val id = inputData.getString("download_id") ?: return Result.failure()
+        val task = DownloadHandler.loadTask(applicationContext, id) ?: return Result.failure()
+        if (task["status"] != DownloadHandler.STATUS_QUEUED && task["status"] != DownloadHandler.STATUS_RUNNING) {
+            return Result.success()
+        }
+
+        return try {
+            download(task)
+        } catch (e: IOException) {
+            if (isStopped) return Result.success()
+            Log.w("DriftDownloads", "Download $id failed (attempt $runAttemptCount)", e)
+            if (runAttemptCount + 1 < MAX_ATTEMPTS) {
+                update(task, DownloadHandler.STATUS_QUEUED)
+                Result.retry()
+            } else {
+                task["error"] = e.message ?: "Download failed"
+                update(task, DownloadHandler.STATUS_FAILED)
+                Result.failure()
+            }
+        }
+    }
+
+    private fun download(task: MutableMap<String, Any?>): Result {
+        val partial = DownloadHandler.partialFile(task)
+        val offset = if (partial.exists()) partial.length() else 0L
+
+        val connection = URL(task["url"] as String).openConnection() as HttpURLConnection
+        connection.connectTimeout = 30_000
+        connection.readTimeout = 30_000
+        (task["headers"] as? Map<*, *>)?.forEach { (key, value) ->
+            if (key is String && value is String) connection.setRequestProperty(key, value)
+        }
+        if (offset > 0) {
+            connection.setRequestProperty("Range", "bytes=$offset-")
+        }
+
+        try {
+            val code = connection.responseCode
+            if (code !in 200..299) {
+                if (code in 400..499 && code != 408 && code != 429) {
+                    task["error"] = "HTTP $code"
+                    update(task, DownloadHandler.STATUS_FAILED)
+                    return Result.failure()
+                }
+                throw IOException("HTTP $code")
+            }
+            // The server ignored the range request; start over.
+            val resumed = offset > 0 && code == HttpURLConnection.HTTP_PARTIAL
+            var downloaded = if (resumed) offset else 0L
+            val length = connection.contentLengthLong
+            task["totalBytes"] = if (length >= 0) downloaded + length else -1L
+            task["bytesDownloaded"] = downloaded
+            update(task, DownloadHandler.STATUS_RUNNING)
+            DownloadHandler.progressForegroundInfo(applicationContext, task)?.let { setForegroundAsync(it) }
+
+            var lastReport = System.currentTimeMillis()
+            connection.inputStream.use { input ->
+                FileOutputStream(partial, resumed).use { output ->
+                    val buffer = ByteArray(64 * 1024)
+                    while (true) {
+                        if (isStopped) return Result.success()
+                        val read = input.read(buffer)
+                        if (read < 0) break
+                        output.write(buffer, 0, read)
+                        downloaded += read
+                        val now = System.currentTimeMillis()
+                        if (now - lastReport >= PROGRESS_INTERVAL_MS) {
+                            lastReport = now
+                            task["bytesDownloaded"] = downloaded
+                            update(task, DownloadHandler.STATUS_RUNNING)
+                            DownloadHandler.progressForegroundInfo(applicationContext, task)?.let { setForegroundAsync(it) }
+                        }
+                    }
+                }
+            }
+
+            val target = File(task["path"] as String)
+            target.delete()
+            if (!partial.renameTo(target)) {
+                throw IOException("Could not move download to ${target.absolutePath}")
+            }
+            task["bytesDownloaded"] = downloaded
+            task["totalBytes"] = downloaded
+            update(task, DownloadHandler.STATUS_COMPLETED)
+            return Result.success()
+        } finally {
+            connection.disconnect()
+        }
+    }
+
+    override fun onStopped() {
+        // Stopped by WorkManager, e.g. because Wi-Fi was lost; the work is
+        // rescheduled once its constraint is met again.
+        val id = inputData.getString("download_id") ?: return
+        val task = DownloadHandler.loadTask(applicationContext, id) ?: return
+        update(task, DownloadHandler.STATUS_QUEUED)
+    }
+
+    /**
+     * Saves and reports the task's new status, unless it was paused,
+     * canceled, or removed while the worker ran.
+     */
+    private fun update(task: MutableMap<String, Any?>, status: Int) {
+        val current = DownloadHandler.loadTask(applicationContext, task["id"] as String) ?: return
+        val currentStatus = current["status"]
+        if (currentStatus != DownloadHandler.STATUS_QUEUED && currentStatus != DownloadHandler.STATUS_RUNNING) {
+            return
+        }
+        task["status"] = status
+        DownloadHandler.saveTask(applicationContext, task)
+        DownloadHandler.notifyChanged(applicationContext, task)
+    }
+}
@@ -6,11 +6,14 @@
  *   DriftContainer (FrameLayout)
  *     - skiaView (SkiaHostView, HardwareBuffer + HWUI onDraw rendering)
  *     - overlayLayout (transparent, on top, for native platform views)
+ *     - splashView (launch background, removed after the first Drift frame)
  */
 package {{.PackageName}}
 
 import android.content.Context
+import android.view.View
 import android.widget.FrameLayout
+import androidx.core.content.ContextCompat
 
 /**
  * Interface for the Skia rendering host, providing surface dimensions
@@ -31,8 +34,27 @@ class DriftContainer(context: Context) : FrameLayout(context) {
     val skiaView: SkiaHostView = SkiaHostView(context)
     val overlayLayout: InputOverlayLayout = InputOverlayLayout(context)
 
+    /**
+     * Copy of the launch window background kept on top of the Skia view so the
+     * splash stays visible until the engine has drawn its first frame.
+     */
+    private var splashView: View? = View(context).apply {
+        background = ContextCompat.getDrawable(context, R.drawable.launch_background)
+    }
+
     init {
         addView(skiaView, LayoutParams(LayoutParams.MATCH_PARENT, LayoutParams.MATCH_PARENT))
         addView(overlayLayout, LayoutParams(LayoutParams.MATCH_PARENT, LayoutParams.MATCH_PARENT))
+        addView(splashView, LayoutParams(LayoutParams.MATCH_PARENT, LayoutParams.MATCH_PARENT))
+    }
+
+    /**
+     * Removes the launch screen overlay. Called from the frame callback that
+     * rendered the first Drift frame, so the removal and the new content land
+     * in the same HWUI traversal.
+     */
+    fun removeSplash() {
+        splashView?.let { removeView(it) }
+        splashView = null
     }
 }
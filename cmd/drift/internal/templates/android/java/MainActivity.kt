@@ -8,8 +8,10 @@
  */
 package {{.PackageName}}
 
+import android.content.res.Configuration
 import android.os.Bundle
 import android.util.Log
+import android.view.KeyEvent
 import androidx.activity.OnBackPressedCallback
 import androidx.appcompat.app.AppCompatActivity
 import androidx.core.view.ViewCompat
@@ -24,6 +26,7 @@ class MainActivity : AppCompatActivity() {
         super.onCreate(savedInstanceState)
 
         PlatformChannelManager.init(applicationContext)
+        RestorationHandler.restore(savedInstanceState)
         Log.i("DriftDeepLink", "onCreate intent action=${intent?.action} data=${intent?.dataString}")
         NotificationHandler.handleNotificationOpen(intent)
         DeepLinkHandler.handleIntent(intent, "launch")
@@ -34,6 +37,7 @@ class MainActivity : AppCompatActivity() {
         val density = resources.displayMetrics.density
         val overlayController = InputOverlayController(container.overlayLayout, density)
         orchestrator = UnifiedFrameOrchestrator(container.skiaView, overlayController)
+        orchestrator.onFirstFrame = { container.removeSplash() }
 
         // Wire frame scheduling from SkiaHostView to orchestrator
         container.skiaView.onFrameNeeded = { orchestrator.scheduleFrame() }
@@ -44,10 +48,14 @@ class MainActivity : AppCompatActivity() {
         PlatformViewHandler.init(this, container.overlayLayout, container.skiaView, overlayController)
 
         AccessibilityHandler.initialize(this, container.skiaView)
+        PowerHandler.initialize(this)
+        AppearanceHandler.sendState(this, resources.configuration)
+        LocaleHandler.sendState(resources.configuration)
 
-        // Set up safe area insets listener
+        // Set up safe area and keyboard insets listener
         ViewCompat.setOnApplyWindowInsetsListener(container) { _, insets ->
             SafeAreaHandler.sendInsetsUpdate()
+            KeyboardHandler.sendInsetsUpdate(insets)
             insets
         }
         container.post { SafeAreaHandler.sendInsetsUpdate() }
@@ -101,4 +109,26 @@ class MainActivity : AppCompatActivity() {
         super.onPause()
         orchestrator.stop()
     }
+
+    // uiMode, fontScale and locale are in configChanges, so dark mode, text
+    // size and language changes arrive here instead of recreating the activity.
+    // System color changes on Android 12+ recreate it, and onCreate sends the
+    // new palette.
+    override fun onConfigurationChanged(newConfig: Configuration) {
+        super.onConfigurationChanged(newConfig)
+        AppearanceHandler.sendState(this, newConfig)
+        LocaleHandler.sendState(newConfig)
+    }
+
+    // Hardware keys are sent to the Go focus system but not consumed, so
+    // native text inputs and system shortcuts still receive them.
+    override fun dispatchKeyEvent(event: KeyEvent): Boolean {
+        HardwareKeyHandler.send(event)
+        return super.dispatchKeyEvent(event)
+    }
+
+    override fun onSaveInstanceState(outState: Bundle) {
+        super.onSaveInstanceState(outState)
+        RestorationHandler.save(outState)
+    }
 }
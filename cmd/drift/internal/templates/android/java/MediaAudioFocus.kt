@@ -0,0 +1,79 @@
+/**
+ * MediaAudioFocus.kt
+ * Shared audio focus handling for audio and video players.
+ *
+ * Players request focus when they start playing. Focus losses and gains are
+ * reported to Go as interruptions, which pause and resume playback according
+ * to the controller's interruption policy. Ducking is left to the system.
+ */
+package {{.PackageName}}
+
+import android.content.Context
+import android.media.AudioAttributes
+import android.media.AudioFocusRequest
+import android.media.AudioManager
+import android.os.Handler
+
+internal class MediaAudioFocus(
+    context: Context,
+    handler: Handler,
+    contentType: Int,
+    private val isPlaying: () -> Boolean,
+    private val send: (Map<String, Any>) -> Unit
+) {
+    private val audioManager = context.getSystemService(Context.AUDIO_SERVICE) as AudioManager
+    private var held = false
+
+    // A transient loss whose gain has not arrived yet.
+    private var interrupted = false
+
+    private val request: AudioFocusRequest = AudioFocusRequest.Builder(AudioManager.AUDIOFOCUS_GAIN)
+        .setAudioAttributes(
+            AudioAttributes.Builder()
+                .setUsage(AudioAttributes.USAGE_MEDIA)
+                .setContentType(contentType)
+                .build()
+        )
+        .setWillPauseWhenDucked(false)
+        .setOnAudioFocusChangeListener({ change -> onFocusChange(change) }, handler)
+        .build()
+
+    private fun onFocusChange(change: Int) {
+        when (change) {
+            AudioManager.AUDIOFOCUS_LOSS -> {
+                // Another app took over for good; no gain follows.
+                interrupted = false
+                sendBegan(transient = false)
+                abandon()
+            }
+            AudioManager.AUDIOFOCUS_LOSS_TRANSIENT -> {
+                interrupted = true
+                sendBegan(transient = true)
+            }
+            AudioManager.AUDIOFOCUS_GAIN -> {
+                if (interrupted) {
+                    interrupted = false
+                    send(mapOf("type" to "ended", "shouldResume" to true))
+                }
+            }
+        }
+    }
+
+    private fun sendBegan(transient: Boolean) {
+        send(mapOf("type" to "began", "wasPlaying" to isPlaying(), "transient" to transient))
+    }
+
+    /** Requests focus if not held. Returns false if it was denied, as during a call. */
+    fun request(): Boolean {
+        if (held) return true
+        held = audioManager.requestAudioFocus(request) == AudioManager.AUDIOFOCUS_REQUEST_GRANTED
+        return held
+    }
+
+    fun abandon() {
+        if (!held) return
+        held = false
+        interrupted = false
+        audioManager.abandonAudioFocusRequest(request)
+    }
+}
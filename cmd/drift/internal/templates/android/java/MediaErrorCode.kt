@@ -7,11 +7,13 @@ package {{.PackageName}}
 /**
  * Maps an ExoPlayer error code to a canonical Drift error code string.
  * Source/IO/parsing errors (2000-3999) become "source_error",
- * decoder/audio-track/DRM errors (4000-6999) become "decoder_error",
+ * decoder/audio-track errors (4000-5999) become "decoder_error",
+ * DRM errors (6000-6999) become "drm_error",
  * and everything else becomes "playback_failed".
  */
 internal fun mediaErrorCodeString(code: Int): String = when {
     code in 2000..3999 -> "source_error"
-    code in 4000..6999 -> "decoder_error"
+    code in 4000..5999 -> "decoder_error"
+    code in 6000..6999 -> "drm_error"
     else -> "playback_failed"
 }
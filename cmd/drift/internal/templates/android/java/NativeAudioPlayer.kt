@@ -2,38 +2,87 @@
  * NativeAudioPlayer.kt
  * Provides audio-only playback using ExoPlayer via a standalone platform channel.
  * Supports multiple concurrent player instances, each identified by a playerId.
+ * Each instance plays a queue of items, gaplessly or with a crossfade.
  */
 package {{.PackageName}}
 
 import android.content.Context
+import android.media.MediaCodec
+import android.media.MediaExtractor
+import android.media.MediaFormat
+import android.media.audiofx.Equalizer
+import android.net.Uri
 import android.os.Handler
 import android.os.Looper
+import android.os.SystemClock
 import androidx.media3.common.AudioAttributes
 import androidx.media3.common.C
 import androidx.media3.common.MediaItem
+import androidx.media3.common.MediaMetadata
 import androidx.media3.common.PlaybackException
+import androidx.media3.common.PlaybackParameters
 import androidx.media3.common.Player
 import androidx.media3.exoplayer.ExoPlayer
+import androidx.media3.exoplayer.source.ShuffleOrder
+import java.nio.ByteOrder
+import java.util.concurrent.CountDownLatch
+import java.util.concurrent.TimeUnit
+import kotlin.math.abs
+import kotlin.math.pow
 
 /**
  * Per-instance audio player state.
  */
 private class AudioPlayerInstance(
     val id: Long,
-    context: Context,
+    private val context: Context,
     private val handler: Handler
 ) {
-    val player: ExoPlayer = ExoPlayer.Builder(context).build().also {
-        it.setAudioAttributes(
-            AudioAttributes.Builder()
-                .setUsage(C.USAGE_MEDIA)
-                .setContentType(C.AUDIO_CONTENT_TYPE_MUSIC)
-                .build(),
-            /* handleAudioFocus= */ true
-        )
-    }
+    val player: ExoPlayer = buildPlayer()
     private var positionRunnable: Runnable? = null
 
+    // Focus changes are reported to Go, which pauses and resumes playback.
+    private val audioFocus = MediaAudioFocus(
+        context,
+        handler,
+        android.media.AudioAttributes.CONTENT_TYPE_MUSIC,
+        isPlaying = { player.isPlaying },
+        send = { event ->
+            PlatformChannelManager.sendEvent("drift/audio_player/interruptions", event + ("playerId" to id))
+        }
+    )
+
+    // Volume set by Go; crossfades ramp relative to it.
+    private var volume = 1f
+
+    // When set, each item plays at its gain (the MediaItem tag, in dB)
+    // relative to volume.
+    private var normalize = false
+
+    // Created on first use on the player's audio session, which the
+    // crossfade tail player shares.
+    private var equalizer: Equalizer? = null
+
+    // Crossfade state. The tail player plays out the end of the finishing
+    // item while the main player fades in the next one.
+    private var crossfadeMs = 0L
+    private var tail: ExoPlayer? = null
+    private var tailItemIndex = C.INDEX_UNSET
+    private var fadeRunnable: Runnable? = null
+
+    // Audio focus is handled by audioFocus, not ExoPlayer, so that Go
+    // decides how to react to interruptions.
+    private fun buildPlayer(): ExoPlayer =
+        ExoPlayer.Builder(context).build().also {
+            it.setAudioAttributes(
+                AudioAttributes.Builder()
+                    .setUsage(C.USAGE_MEDIA)
+                    .setContentType(C.AUDIO_CONTENT_TYPE_MUSIC)
+                    .build(),
+                /* handleAudioFocus= */ false
+            )
+        }
+
     init {
         player.addListener(object : Player.Listener {
             override fun onPlaybackStateChanged(playbackState: Int) {
@@ -51,6 +100,10 @@ private class AudioPlayerInstance(
             }
 
             override fun onIsPlayingChanged(isPlaying: Boolean) {
+                if (isPlaying && !audioFocus.request()) {
+                    player.pause()
+                    return
+                }
                 if (player.playbackState == Player.STATE_READY) {
                     val state = if (isPlaying) 2 else 4 // Playing or Paused
                     sendStateEvent(state)
@@ -58,6 +111,13 @@ private class AudioPlayerInstance(
                 }
             }
 
+            override fun onMediaItemTransition(mediaItem: MediaItem?, reason: Int) {
+                if (fadeRunnable == null) {
+                    player.volume = itemVolume(mediaItem)
+                }
+                sendStateEvent(currentState())
+            }
+
             override fun onPlayerError(error: PlaybackException) {
                 PlatformChannelManager.sendEvent(
                     "drift/audio_player/errors",
@@ -71,6 +131,14 @@ private class AudioPlayerInstance(
         })
     }
 
+    fun currentState(): Int = when (player.playbackState) {
+        Player.STATE_IDLE -> 0
+        Player.STATE_BUFFERING -> 1
+        Player.STATE_READY -> if (player.isPlaying) 2 else 4
+        Player.STATE_ENDED -> 3
+        else -> 0
+    }
+
     fun sendStateEvent(state: Int) {
         PlatformChannelManager.sendEvent(
             "drift/audio_player/events",
@@ -79,32 +147,22 @@ private class AudioPlayerInstance(
                 "playbackState" to state,
                 "positionMs" to player.currentPosition,
                 "durationMs" to player.duration.coerceAtLeast(0),
-                "bufferedMs" to player.bufferedPosition
+                "bufferedMs" to player.bufferedPosition,
+                "queueIndex" to queueIndex()
             )
         )
     }
 
+    private fun queueIndex(): Int =
+        if (player.mediaItemCount > 0) player.currentMediaItemIndex else -1
+
     fun startPositionUpdates() {
         stopPositionUpdates()
         positionRunnable = object : Runnable {
             override fun run() {
                 if (player.playbackState != Player.STATE_IDLE) {
-                    PlatformChannelManager.sendEvent(
-                        "drift/audio_player/events",
-                        mapOf(
-                            "playerId" to id,
-                            "playbackState" to when (player.playbackState) {
-                                Player.STATE_IDLE -> 0
-                                Player.STATE_BUFFERING -> 1
-                                Player.STATE_READY -> if (player.isPlaying) 2 else 4
-                                Player.STATE_ENDED -> 3
-                                else -> 0
-                            },
-                            "positionMs" to player.currentPosition,
-                            "durationMs" to player.duration.coerceAtLeast(0),
-                            "bufferedMs" to player.bufferedPosition
-                        )
-                    )
+                    sendStateEvent(currentState())
+                    checkCrossfade()
                 }
                 handler.postDelayed(this, 250)
             }
@@ -117,8 +175,171 @@ private class AudioPlayerInstance(
         positionRunnable = null
     }
 
+    fun setQueue(items: List<MediaItem>, startIndex: Int) {
+        cancelCrossfade()
+        if (items.isEmpty()) {
+            player.clearMediaItems()
+            player.stop()
+            return
+        }
+        player.setMediaItems(items, startIndex, 0)
+        player.prepare()
+        if (player.shuffleModeEnabled) {
+            shuffleFromCurrent()
+        }
+    }
+
+    fun skipTo(action: () -> Unit) {
+        cancelCrossfade()
+        action()
+    }
+
+    fun setVolume(volume: Float) {
+        this.volume = volume
+        if (fadeRunnable == null) {
+            player.volume = itemVolume(player.currentMediaItem)
+        }
+    }
+
+    /** The volume to play item at: volume, adjusted by its gain when normalizing. */
+    private fun itemVolume(item: MediaItem?): Float {
+        val gainDb = if (normalize) (item?.localConfiguration?.tag as? Float) ?: 0f else 0f
+        return (volume * 10f.pow(gainDb / 20f)).coerceIn(0f, 1f)
+    }
+
+    fun setLoudnessNormalization(enabled: Boolean) {
+        normalize = enabled
+        if (fadeRunnable == null) {
+            player.volume = itemVolume(player.currentMediaItem)
+        }
+    }
+
+    fun setPitch(pitch: Float) {
+        player.playbackParameters = PlaybackParameters(player.playbackParameters.speed, pitch)
+    }
+
+    private fun ensureEqualizer(): Equalizer? {
+        equalizer?.let { return it }
+        return try {
+            Equalizer(0, player.audioSessionId).also { equalizer = it }
+        } catch (e: RuntimeException) {
+            null // No equalizer on this device
+        }
+    }
+
+    fun equalizerBands(): List<Map<String, Any>> {
+        val eq = ensureEqualizer() ?: return emptyList()
+        val range = eq.bandLevelRange // millibels
+        return (0 until eq.numberOfBands).map { band ->
+            mapOf(
+                "centerFrequency" to eq.getCenterFreq(band.toShort()) / 1000.0, // milliHertz
+                "minGain" to range[0] / 100.0,
+                "maxGain" to range[1] / 100.0
+            )
+        }
+    }
+
+    fun setEqualizer(enabled: Boolean, preamp: Double, gains: List<Double>) {
+        val eq = ensureEqualizer() ?: return
+        val range = eq.bandLevelRange
+        for (band in 0 until eq.numberOfBands) {
+            val millibels = (((gains.getOrNull(band) ?: 0.0) + preamp) * 100).toInt()
+            eq.setBandLevel(band.toShort(), millibels.coerceIn(range[0].toInt(), range[1].toInt()).toShort())
+        }
+        eq.enabled = enabled
+    }
+
+    fun setShuffle(enabled: Boolean) {
+        if (enabled) {
+            shuffleFromCurrent()
+        }
+        player.shuffleModeEnabled = enabled
+    }
+
+    // Shuffles the queue so that the current item plays first and every
+    // other item plays once after it.
+    private fun shuffleFromCurrent() {
+        val count = player.mediaItemCount
+        if (count == 0) return
+        val current = player.currentMediaItemIndex
+        val order = (0 until count).filter { it != current }.shuffled()
+        player.setShuffleOrder(
+            ShuffleOrder.DefaultShuffleOrder((listOf(current) + order).toIntArray(), System.nanoTime())
+        )
+    }
+
+    fun setCrossfade(durationMs: Long) {
+        crossfadeMs = durationMs.coerceAtLeast(0)
+        if (crossfadeMs == 0L) {
+            cancelCrossfade()
+        }
+    }
+
+    // Called with each position update while playing. Prepares the tail
+    // player shortly before the crossfade window, then starts the fade once
+    // the finishing item enters it.
+    private fun checkCrossfade() {
+        if (crossfadeMs <= 0 || fadeRunnable != null || !player.isPlaying) return
+        if (player.repeatMode == Player.REPEAT_MODE_ONE || !player.hasNextMediaItem()) return
+        val duration = player.duration
+        if (duration == C.TIME_UNSET || duration <= crossfadeMs) return
+        val remaining = duration - player.currentPosition
+        val index = player.currentMediaItemIndex
+
+        if (remaining <= crossfadeMs + 2000 && tailItemIndex != index) {
+            tail?.release()
+            tail = buildPlayer().also {
+                it.setAudioSessionId(player.audioSessionId) // Keep the equalizer applied
+                it.setMediaItem(player.getMediaItemAt(index))
+                it.playbackParameters = player.playbackParameters
+                it.prepare()
+                it.seekTo(duration - crossfadeMs)
+            }
+            tailItemIndex = index
+        }
+        if (remaining > crossfadeMs) return
+
+        val tail = tail ?: return
+        val tailVolume = itemVolume(tail.currentMediaItem)
+        tail.volume = tailVolume
+        tail.play()
+        player.volume = 0f
+        player.seekToNextMediaItem()
+
+        val fadeMs = remaining.coerceAtLeast(1)
+        val start = SystemClock.uptimeMillis()
+        fadeRunnable = object : Runnable {
+            override fun run() {
+                val t = ((SystemClock.uptimeMillis() - start).toFloat() / fadeMs).coerceIn(0f, 1f)
+                tail.volume = tailVolume * (1 - t)
+                player.volume = itemVolume(player.currentMediaItem) * t
+                if (t < 1f) {
+                    handler.postDelayed(this, 50)
+                } else {
+                    cancelCrossfade()
+                }
+            }
+        }
+        handler.post(fadeRunnable!!)
+    }
+
+    // Stops any crossfade, releasing the tail player and restoring the
+    // main player's volume.
+    fun cancelCrossfade() {
+        fadeRunnable?.let { handler.removeCallbacks(it) }
+        fadeRunnable = null
+        tail?.release()
+        tail = null
+        tailItemIndex = C.INDEX_UNSET
+        player.volume = itemVolume(player.currentMediaItem)
+    }
+
     fun dispose() {
         stopPositionUpdates()
+        cancelCrossfade()
+        audioFocus.abandon()
+        equalizer?.release()
+        equalizer = null
         player.release()
     }
 }
@@ -148,6 +369,19 @@ object AudioPlayerHandler {
             "setVolume" -> setVolume(playerId, argsMap)
             "setLooping" -> setLooping(playerId, argsMap)
             "setPlaybackSpeed" -> setPlaybackSpeed(playerId, argsMap)
+            "setQueue" -> setQueue(playerId, argsMap)
+            "insertIntoQueue" -> insertIntoQueue(playerId, argsMap)
+            "removeFromQueue" -> removeFromQueue(playerId, argsMap)
+            "skipToNext" -> skipToNext(playerId)
+            "skipToPrevious" -> skipToPrevious(playerId)
+            "skipToIndex" -> skipToIndex(playerId, argsMap)
+            "setRepeatMode" -> setRepeatMode(playerId, argsMap)
+            "setShuffle" -> setShuffle(playerId, argsMap)
+            "setCrossfade" -> setCrossfade(playerId, argsMap)
+            "setPitch" -> setPitch(playerId, argsMap)
+            "getEqualizerBands" -> getEqualizerBands(playerId)
+            "setEqualizer" -> setEqualizer(playerId, argsMap)
+            "setLoudnessNormalization" -> setLoudnessNormalization(playerId, argsMap)
             "dispose" -> dispose(playerId)
             else -> Pair(null, IllegalArgumentException("Unknown method: $method"))
         }
@@ -166,6 +400,7 @@ object AudioPlayerHandler {
 
         handler.post {
             val instance = ensurePlayer(playerId)
+            instance.cancelCrossfade()
             val mediaItem = MediaItem.fromUri(url)
             instance.player.setMediaItem(mediaItem)
             instance.player.prepare()
@@ -194,6 +429,7 @@ object AudioPlayerHandler {
     private fun stop(playerId: Long): Pair<Any?, Exception?> {
         handler.post {
             val instance = players[playerId] ?: return@post
+            instance.cancelCrossfade()
             instance.player.stop()
             instance.player.seekTo(0)
             instance.sendStateEvent(0) // Idle with position reset to zero
@@ -204,7 +440,8 @@ object AudioPlayerHandler {
     private fun seekTo(playerId: Long, args: Map<*, *>?): Pair<Any?, Exception?> {
         val positionMs = (args?.get("positionMs") as? Number)?.toLong() ?: 0L
         handler.post {
-            players[playerId]?.player?.seekTo(positionMs)
+            val instance = players[playerId] ?: return@post
+            instance.skipTo { instance.player.seekTo(positionMs) }
         }
         return Pair(null, null)
     }
@@ -212,7 +449,7 @@ object AudioPlayerHandler {
     private fun setVolume(playerId: Long, args: Map<*, *>?): Pair<Any?, Exception?> {
         val volume = (args?.get("volume") as? Number)?.toFloat() ?: 1.0f
         handler.post {
-            players[playerId]?.player?.volume = volume
+            players[playerId]?.setVolume(volume)
         }
         return Pair(null, null)
     }
@@ -233,6 +470,166 @@ object AudioPlayerHandler {
         return Pair(null, null)
     }
 
+    private fun mediaItems(args: Map<*, *>?): List<MediaItem> {
+        val sources = args?.get("sources") as? List<*> ?: return emptyList()
+        return sources.mapNotNull { raw ->
+            val source = raw as? Map<*, *> ?: return@mapNotNull null
+            val url = source["url"] as? String ?: return@mapNotNull null
+            MediaItem.Builder()
+                .setUri(url)
+                .setTag((source["gain"] as? Number)?.toFloat() ?: 0f)
+                .setMediaMetadata(
+                    MediaMetadata.Builder()
+                        .setTitle((source["title"] as? String)?.ifEmpty { null })
+                        .setArtist((source["artist"] as? String)?.ifEmpty { null })
+                        .build()
+                )
+                .build()
+        }
+    }
+
+    private fun setQueue(playerId: Long, args: Map<*, *>?): Pair<Any?, Exception?> {
+        val items = mediaItems(args)
+        val startIndex = (args?.get("startIndex") as? Number)?.toInt() ?: 0
+        handler.post {
+            ensurePlayer(playerId).setQueue(items, startIndex.coerceIn(0, (items.size - 1).coerceAtLeast(0)))
+        }
+        return Pair(null, null)
+    }
+
+    private fun insertIntoQueue(playerId: Long, args: Map<*, *>?): Pair<Any?, Exception?> {
+        val items = mediaItems(args)
+        val index = (args?.get("index") as? Number)?.toInt() ?: 0
+        handler.post {
+            val instance = ensurePlayer(playerId)
+            val wasEmpty = instance.player.mediaItemCount == 0
+            instance.player.addMediaItems(index.coerceIn(0, instance.player.mediaItemCount), items)
+            if (wasEmpty) {
+                instance.player.prepare()
+            }
+        }
+        return Pair(null, null)
+    }
+
+    private fun removeFromQueue(playerId: Long, args: Map<*, *>?): Pair<Any?, Exception?> {
+        val index = (args?.get("index") as? Number)?.toInt() ?: 0
+        handler.post {
+            val instance = players[playerId] ?: return@post
+            if (index !in 0 until instance.player.mediaItemCount) return@post
+            if (index == instance.player.currentMediaItemIndex) {
+                instance.cancelCrossfade()
+            }
+            instance.player.removeMediaItem(index)
+            if (instance.player.mediaItemCount == 0) {
+                instance.sendStateEvent(0)
+            }
+        }
+        return Pair(null, null)
+    }
+
+    private fun skipToNext(playerId: Long): Pair<Any?, Exception?> {
+        handler.post {
+            val instance = players[playerId] ?: return@post
+            instance.skipTo { instance.player.seekToNextMediaItem() }
+        }
+        return Pair(null, null)
+    }
+
+    private fun skipToPrevious(playerId: Long): Pair<Any?, Exception?> {
+        handler.post {
+            val instance = players[playerId] ?: return@post
+            instance.skipTo { instance.player.seekToPrevious() }
+        }
+        return Pair(null, null)
+    }
+
+    private fun skipToIndex(playerId: Long, args: Map<*, *>?): Pair<Any?, Exception?> {
+        val index = (args?.get("index") as? Number)?.toInt() ?: 0
+        handler.post {
+            val instance = players[playerId] ?: return@post
+            if (index !in 0 until instance.player.mediaItemCount) return@post
+            instance.skipTo { instance.player.seekTo(index, 0) }
+        }
+        return Pair(null, null)
+    }
+
+    private fun setRepeatMode(playerId: Long, args: Map<*, *>?): Pair<Any?, Exception?> {
+        val mode = when ((args?.get("mode") as? Number)?.toInt() ?: 0) {
+            1 -> Player.REPEAT_MODE_ONE
+            2 -> Player.REPEAT_MODE_ALL
+            else -> Player.REPEAT_MODE_OFF
+        }
+        handler.post {
+            ensurePlayer(playerId).player.repeatMode = mode
+        }
+        return Pair(null, null)
+    }
+
+    private fun setShuffle(playerId: Long, args: Map<*, *>?): Pair<Any?, Exception?> {
+        val enabled = args?.get("enabled") as? Boolean ?: false
+        handler.post {
+            ensurePlayer(playerId).setShuffle(enabled)
+        }
+        return Pair(null, null)
+    }
+
+    private fun setCrossfade(playerId: Long, args: Map<*, *>?): Pair<Any?, Exception?> {
+        val durationMs = (args?.get("durationMs") as? Number)?.toLong() ?: 0L
+        handler.post {
+            ensurePlayer(playerId).setCrossfade(durationMs)
+        }
+        return Pair(null, null)
+    }
+
+    private fun setPitch(playerId: Long, args: Map<*, *>?): Pair<Any?, Exception?> {
+        val pitch = (args?.get("pitch") as? Number)?.toFloat() ?: 1.0f
+        handler.post {
+            ensurePlayer(playerId).setPitch(pitch)
+        }
+        return Pair(null, null)
+    }
+
+    private fun getEqualizerBands(playerId: Long): Pair<Any?, Exception?> {
+        val bands = onMainResult { ensurePlayer(playerId).equalizerBands() } ?: emptyList()
+        return Pair(mapOf("bands" to bands), null)
+    }
+
+    private fun setEqualizer(playerId: Long, args: Map<*, *>?): Pair<Any?, Exception?> {
+        val enabled = args?.get("enabled") as? Boolean ?: false
+        val preamp = (args?.get("preamp") as? Number)?.toDouble() ?: 0.0
+        val gains = (args?.get("gains") as? List<*>)?.map { (it as? Number)?.toDouble() ?: 0.0 } ?: emptyList()
+        handler.post {
+            ensurePlayer(playerId).setEqualizer(enabled, preamp, gains)
+        }
+        return Pair(null, null)
+    }
+
+    private fun setLoudnessNormalization(playerId: Long, args: Map<*, *>?): Pair<Any?, Exception?> {
+        val enabled = args?.get("enabled") as? Boolean ?: false
+        handler.post {
+            ensurePlayer(playerId).setLoudnessNormalization(enabled)
+        }
+        return Pair(null, null)
+    }
+
+    /** Runs block on the main thread and waits for its result. */
+    private fun <T> onMainResult(block: () -> T): T? {
+        if (Looper.myLooper() == Looper.getMainLooper()) {
+            return block()
+        }
+        var result: T? = null
+        val latch = CountDownLatch(1)
+        handler.post {
+            try {
+                result = block()
+            } finally {
+                latch.countDown()
+            }
+        }
+        latch.await(5, TimeUnit.SECONDS)
+        return result
+    }
+
     private fun dispose(playerId: Long): Pair<Any?, Exception?> {
         handler.post {
             players.remove(playerId)?.dispose()
@@ -240,3 +637,105 @@ object AudioPlayerHandler {
         return Pair(null, null)
     }
 }
+
+/**
+ * Handles audio processing requests from Go that are independent of any
+ * player instance.
+ */
+object AudioHandler {
+    fun handle(context: Context, method: String, args: Any?): Pair<Any?, Exception?> {
+        return when (method) {
+            "extractWaveform" -> extractWaveform(context, args as? Map<*, *>)
+            else -> Pair(null, IllegalArgumentException("Unknown method: $method"))
+        }
+    }
+
+    /**
+     * Decodes the first audio track of a file or remote URL to 16-bit PCM
+     * and returns the peak amplitude of each of `count` equal slices of it.
+     * Runs on the calling thread, which is never the main thread.
+     */
+    private fun extractWaveform(context: Context, args: Map<*, *>?): Pair<Any?, Exception?> {
+        val url = args?.get("url") as? String
+            ?: return Pair(null, IllegalArgumentException("Missing url"))
+        val count = (args["count"] as? Number)?.toInt() ?: 0
+        if (count <= 0) {
+            return Pair(null, IllegalArgumentException("count must be positive"))
+        }
+
+        val extractor = MediaExtractor()
+        var codec: MediaCodec? = null
+        try {
+            extractor.setDataSource(context, Uri.parse(url), null)
+            val track = (0 until extractor.trackCount).firstOrNull {
+                extractor.getTrackFormat(it).getString(MediaFormat.KEY_MIME)?.startsWith("audio/") == true
+            } ?: return Pair(null, IllegalArgumentException("No audio track in $url"))
+            extractor.selectTrack(track)
+            val format = extractor.getTrackFormat(track)
+            val durationUs = if (format.containsKey(MediaFormat.KEY_DURATION)) format.getLong(MediaFormat.KEY_DURATION) else 0L
+            if (durationUs <= 0) {
+                return Pair(null, IllegalArgumentException("Unknown duration for $url"))
+            }
+
+            val decoder = MediaCodec.createDecoderByType(format.getString(MediaFormat.KEY_MIME)!!)
+            codec = decoder
+            decoder.configure(format, null, null, 0)
+            decoder.start()
+
+            val peaks = DoubleArray(count)
+            var sampleRate = format.getInteger(MediaFormat.KEY_SAMPLE_RATE)
+            var channels = format.getInteger(MediaFormat.KEY_CHANNEL_COUNT)
+            val info = MediaCodec.BufferInfo()
+            var inputDone = false
+            while (true) {
+                if (!inputDone) {
+                    val inIndex = decoder.dequeueInputBuffer(10_000)
+                    if (inIndex >= 0) {
+                        val size = extractor.readSampleData(decoder.getInputBuffer(inIndex)!!, 0)
+                        if (size < 0) {
+                            decoder.queueInputBuffer(inIndex, 0, 0, 0, MediaCodec.BUFFER_FLAG_END_OF_STREAM)
+                            inputDone = true
+                        } else {
+                            decoder.queueInputBuffer(inIndex, 0, size, extractor.sampleTime, 0)
+                            extractor.advance()
+                        }
+                    }
+                }
+
+                val outIndex = decoder.dequeueOutputBuffer(info, 10_000)
+                if (outIndex == MediaCodec.INFO_OUTPUT_FORMAT_CHANGED) {
+                    sampleRate = decoder.outputFormat.getInteger(MediaFormat.KEY_SAMPLE_RATE)
+                    channels = decoder.outputFormat.getInteger(MediaFormat.KEY_CHANNEL_COUNT)
+                } else if (outIndex >= 0) {
+                    val samples = decoder.getOutputBuffer(outIndex)!!.order(ByteOrder.nativeOrder()).asShortBuffer()
+                    var frame = 0L
+                    while (samples.remaining() >= channels) {
+                        val timeUs = info.presentationTimeUs + frame * 1_000_000 / sampleRate
+                        val bucket = (timeUs * count / durationUs).toInt().coerceIn(0, count - 1)
+                        repeat(channels) {
+                            peaks[bucket] = maxOf(peaks[bucket], abs(samples.get().toInt()) / 32768.0)
+                        }
+                        frame++
+                    }
+                    decoder.releaseOutputBuffer(outIndex, false)
+                    if (info.flags and MediaCodec.BUFFER_FLAG_END_OF_STREAM != 0) {
+                        break
+                    }
+                }
+            }
+            return Pair(mapOf("peaks" to peaks.toList(), "durationMs" to durationUs / 1000), null)
+        } catch (e: Exception) {
+            return Pair(null, e)
+        } finally {
+            codec?.let {
+                try {
+                    it.stop()
+                } catch (_: IllegalStateException) {
+                    // Never started.
+                }
+                it.release()
+            }
+            extractor.release()
+        }
+    }
+}
@@ -199,6 +199,14 @@ object NativeBridge {
      */
     external fun hitTestPlatformView(viewID: Long, x: Double, y: Double): Int
 
+    /**
+     * Returns the mouse cursor the Go engine shows at the given pixel
+     * coordinates, as a layout.MouseCursor value (0 for the default pointer).
+     *
+     * Called from SkiaHostView.onResolvePointerIcon while a mouse hovers.
+     */
+    external fun mouseCursor(x: Double, y: Double): Int
+
     // ─── Unified Frame Orchestrator (Vulkan + HardwareBuffer + HWUI path) ───
 
     /** Initializes Vulkan instance, physical device, logical device, and graphics queue. */
@@ -216,6 +224,10 @@ object NativeBridge {
     /** Runs the engine pipeline and returns geometry snapshot as JSON bytes. */
     external fun stepAndSnapshot(width: Int, height: Int): ByteArray?
 
+    /** Reports whether the display supports Display P3 (1) or not (0) and returns the
+     *  color space to tag the rendered buffers with: 0 for sRGB, 1 for Display P3. */
+    external fun surfaceColorSpace(wideGamut: Int): Int
+
     /** Renders into the next double-buffer slot. Returns the slot index (0 or 1), or -1 on error. */
     external fun renderFrameSync(width: Int, height: Int): Int
 
@@ -225,4 +237,7 @@ object NativeBridge {
 
     /** Returns 1 if platform views should be pre-warmed at startup, 0 if disabled. */
     external fun shouldWarmUpViews(): Int
+
+    /** Returns 1 once the first app frame has been drawn and the launch screen can be removed. */
+    external fun firstFrameRasterized(): Int
 }
@@ -4,19 +4,41 @@
  */
 package {{.PackageName}}
 
+import android.content.ClipDescription
+import android.content.ClipboardManager
 import android.content.Context
 import android.graphics.Color
 import android.graphics.Typeface
+import android.net.Uri
+import android.os.Build
+import android.os.Handler
+import android.os.Looper
 import android.text.Editable
+import android.text.InputFilter
 import android.text.InputType
+import android.text.Spanned
 import android.text.TextWatcher
+import android.text.method.PasswordTransformationMethod
+import android.util.Base64
 import android.util.TypedValue
 import android.view.Gravity
 import android.view.View
+import android.view.inputmethod.BaseInputConnection
 import android.view.inputmethod.EditorInfo
+import android.view.inputmethod.InputConnection
 import android.view.inputmethod.InputMethodManager
+import android.view.textservice.SentenceSuggestionsInfo
+import android.view.textservice.SpellCheckerSession
+import android.view.textservice.SuggestionsInfo
+import android.view.textservice.TextInfo
+import android.view.textservice.TextServicesManager
 import android.widget.EditText
 import android.widget.FrameLayout
+import androidx.core.view.ContentInfoCompat
+import androidx.core.view.OnReceiveContentListener
+import androidx.core.view.ViewCompat
+import androidx.core.view.inputmethod.EditorInfoCompat
+import androidx.core.view.inputmethod.InputConnectionCompat
 
 /**
  * Platform view container for native text input.
@@ -28,14 +50,31 @@ class NativeTextInputContainer(
 ) : PlatformViewContainer {
 
     override val view: View
-    private val editText: EditText
+    private val editText: ContentEditText
     private var config: TextInputViewConfig
     private var suppressCallback: Boolean = false
+    private var spellCheckReporter: SpellCheckReporter? = null
+
+    /**
+     * Receives rich content from the keyboard, clipboard, and drag and drop.
+     * Items with a content URI are read and sent to Go; anything else (plain
+     * text) is returned for the EditText's default handling. Declared before
+     * init, which installs it.
+     */
+    private val contentListener = OnReceiveContentListener { _, payload ->
+        val split = payload.partition { item -> item.uri != null }
+        split.first?.clip?.let { clip ->
+            for (i in 0 until clip.itemCount) {
+                clip.getItemAt(i).uri?.let { readInsertedContent(it, clip.description) }
+            }
+        }
+        split.second
+    }
 
     init {
         config = TextInputViewConfig(params)
 
-        editText = EditText(context).apply {
+        editText = ContentEditText(context).apply {
             // Transparent background - Skia draws the chrome
             background = null
             setBackgroundColor(Color.TRANSPARENT)
@@ -48,14 +87,23 @@ class NativeTextInputContainer(
                 override fun beforeTextChanged(s: CharSequence?, start: Int, count: Int, after: Int) {}
                 override fun onTextChanged(s: CharSequence?, start: Int, before: Int, count: Int) {}
                 override fun afterTextChanged(s: Editable?) {
+                    // Composition may overflow the limit; trim once it commits.
+                    // The trim re-enters this watcher, which sends the update.
+                    if (s != null && !suppressCallback && truncateCommittedOverflow(s)) return
                     if (!suppressCallback) {
                         sendTextChanged()
                     }
+                    spellCheckReporter?.schedule(s?.toString() ?: "")
                 }
             })
 
             // IME action listener
             setOnEditorActionListener { _, actionId, _ ->
+                // Android can't disable the action key, so swallow the action
+                // instead while the field is empty.
+                if (config.enablesReturnKeyAutomatically && text.isNullOrEmpty()) {
+                    return@setOnEditorActionListener true
+                }
                 sendAction(actionIdToAction(actionId))
                 when (actionId) {
                     // Dismiss keyboard for completion actions
@@ -91,12 +139,16 @@ class NativeTextInputContainer(
         }
 
         view = editText
+        updateContentInsertion()
+        updateSpellCheckReporting()
 
         // Apply initial text if provided
         (params["text"] as? String)?.let { setText(it) }
     }
 
     override fun dispose() {
+        spellCheckReporter?.close()
+        spellCheckReporter = null
         hideKeyboard()
         editText.clearFocus()
     }
@@ -105,7 +157,11 @@ class NativeTextInputContainer(
 
     fun setText(text: String) {
         suppressCallback = true
+        // Programmatic text is not subject to the max length filter.
+        val savedFilters = editText.filters
+        editText.filters = arrayOf()
         editText.setText(text)
+        editText.filters = savedFilters
         suppressCallback = false
     }
 
@@ -133,6 +189,17 @@ class NativeTextInputContainer(
     fun updateConfig(params: Map<String, Any?>) {
         config = TextInputViewConfig(params)
         editText.applyConfig(config)
+        updateContentInsertion()
+        updateSpellCheckReporting()
+    }
+
+    private fun truncateCommittedOverflow(text: Editable): Boolean {
+        if (config.maxLength <= 0 || config.maxLengthEnforcement == MAX_LENGTH_ENFORCEMENT_NONE) return false
+        if (BaseInputConnection.getComposingSpanStart(text) != -1) return false
+        val count = Character.codePointCount(text, 0, text.length)
+        if (count <= config.maxLength) return false
+        text.delete(Character.offsetByCodePoints(text, 0, config.maxLength), text.length)
+        return true
     }
 
     // MARK: - Event Sending
@@ -154,6 +221,79 @@ class NativeTextInputContainer(
         )
     }
 
+    // MARK: - Spell Check
+
+    private fun updateSpellCheckReporting() {
+        if (!config.reportMisspellings) {
+            spellCheckReporter?.close()
+            spellCheckReporter = null
+            return
+        }
+        if (spellCheckReporter != null) return
+        val reporter = SpellCheckReporter(editText.context) { ranges ->
+            PlatformChannelManager.sendEvent(
+                "drift/platform_views",
+                mapOf(
+                    "method" to "onSpellCheckResults",
+                    "viewId" to viewId,
+                    "ranges" to ranges
+                )
+            )
+        }
+        spellCheckReporter = reporter
+        reporter.schedule(editText.text.toString())
+    }
+
+    // MARK: - Content Insertion
+
+    private fun updateContentInsertion() {
+        val mimeTypes = config.contentMimeTypes
+        val current = ViewCompat.getOnReceiveContentMimeTypes(editText)
+        if (mimeTypes.isEmpty()) {
+            if (current == null) return
+            ViewCompat.setOnReceiveContentListener(editText, null, null)
+        } else {
+            if (current != null && current.contentEquals(mimeTypes)) return
+            ViewCompat.setOnReceiveContentListener(editText, mimeTypes, contentListener)
+        }
+        // The keyboard reads accepted types from EditorInfo; refresh it.
+        if (editText.hasFocus()) {
+            val imm = editText.context.getSystemService(Context.INPUT_METHOD_SERVICE) as InputMethodManager
+            imm.restartInput(editText)
+        }
+    }
+
+    private fun readInsertedContent(uri: Uri, description: ClipDescription) {
+        val resolver = editText.context.contentResolver
+        val candidates = listOfNotNull(resolver.getType(uri)) +
+            (0 until description.mimeTypeCount).map { description.getMimeType(it) }
+        val mimeType = candidates.firstOrNull { type ->
+            !type.contains('*') && config.contentMimeTypes.any { ClipDescription.compareMimeTypes(type, it) }
+        } ?: return
+
+        // Keyboard GIFs and pasted photos can be large; read off the UI thread.
+        Thread {
+            val bytes = try {
+                resolver.openInputStream(uri)?.use { it.readBytes() }
+            } catch (e: Exception) {
+                null
+            } ?: return@Thread
+            val encoded = Base64.encodeToString(bytes, Base64.NO_WRAP)
+            editText.post {
+                PlatformChannelManager.sendEvent(
+                    "drift/platform_views",
+                    mapOf(
+                        "method" to "onContentInserted",
+                        "viewId" to viewId,
+                        "mimeType" to mimeType,
+                        "data" to encoded,
+                        "uri" to uri.toString()
+                    )
+                )
+            }
+        }.start()
+    }
+
     private fun sendAction(action: Int) {
         PlatformChannelManager.sendEvent(
             "drift/platform_views",
@@ -200,8 +340,31 @@ class NativeTextInputContainer(
         }
     }
 
-    private fun EditText.applyConfig(config: TextInputViewConfig) {
-        // Font
+    private fun ContentEditText.applyConfig(config: TextInputViewConfig) {
+        // Only set inputType when it changed. Redundant setInputType on password
+        // fields re-applies PasswordTransformationMethod (Android skips the
+        // short-circuit for password types), which disrupts cursor position.
+        // A real change (e.g. toggling password visibility) keeps the text
+        // and restores the selection.
+        if (inputType != config.inputType) {
+            val start = selectionStart
+            val end = selectionEnd
+            inputType = config.inputType
+            if (start >= 0 && end >= 0 && end <= length()) {
+                setSelection(start, end)
+            }
+        }
+
+        // Custom obscuring character. Password input types install the default
+        // transformation above, so this must follow the inputType update.
+        if (config.obscure && config.obscuringCharacter.isNotEmpty()) {
+            val current = transformationMethod
+            if (current !is ObscuringTransformationMethod || current.mask != config.obscuringCharacter) {
+                transformationMethod = ObscuringTransformationMethod(config.obscuringCharacter)
+            }
+        }
+
+        // Font. Set after inputType since password types switch to monospace.
         setTextSize(TypedValue.COMPLEX_UNIT_SP, config.fontSize)
         typeface = config.typeface
 
@@ -212,13 +375,6 @@ class NativeTextInputContainer(
         // Alignment
         gravity = config.gravity
 
-        // Only set inputType when it changed. Redundant setInputType on password
-        // fields re-applies PasswordTransformationMethod (Android skips the
-        // short-circuit for password types), which disrupts cursor position.
-        if (inputType != config.inputType) {
-            inputType = config.inputType
-        }
-
         // IME options
         imeOptions = config.imeOptions
 
@@ -234,6 +390,22 @@ class NativeTextInputContainer(
         // Placeholder
         hint = config.placeholder
 
+        // Caret and selection highlight
+        isCursorVisible = !config.hideCursor
+        highlightColor = if (config.hideCursor) Color.TRANSPARENT else defaultHighlightColor
+
+        // Autofill. No hints leaves the field to the service's heuristics.
+        if (!autofillHints.contentEquals(config.autofillHints)) {
+            setAutofillHints(*config.autofillHints)
+        }
+
+        // Length limit
+        filters = if (config.maxLength > 0 && config.maxLengthEnforcement != MAX_LENGTH_ENFORCEMENT_NONE) {
+            arrayOf(MaxLengthInputFilter(config.maxLength, config.maxLengthEnforcement == MAX_LENGTH_ENFORCEMENT_BLOCK))
+        } else {
+            arrayOf()
+        }
+
         // Multiline
         if (config.multiline) {
             setSingleLine(false)
@@ -246,6 +418,189 @@ class NativeTextInputContainer(
     }
 }
 
+/**
+ * Displays every character as [mask] instead of the platform bullet.
+ */
+private class ObscuringTransformationMethod(val mask: String) : PasswordTransformationMethod() {
+    override fun getTransformation(source: CharSequence, view: View): CharSequence =
+        MaskedCharSequence(source, mask[0])
+
+    private class MaskedCharSequence(
+        private val source: CharSequence,
+        private val mask: Char
+    ) : CharSequence {
+        override val length: Int get() = source.length
+        override fun get(index: Int): Char = mask
+        override fun subSequence(startIndex: Int, endIndex: Int): CharSequence =
+            MaskedCharSequence(source.subSequence(startIndex, endIndex), mask)
+        override fun toString(): String = mask.toString().repeat(length)
+    }
+}
+
+/**
+ * Runs the system spell checker over the text once typing pauses and reports
+ * the words it flags as typos. Results for text that changed while the check
+ * was running are dropped; the newer check reports instead.
+ */
+private class SpellCheckReporter(
+    private val context: Context,
+    private val onResults: (List<Map<String, Any>>) -> Unit
+) : SpellCheckerSession.SpellCheckerSessionListener {
+    private val handler = Handler(Looper.getMainLooper())
+    private val runCheck = Runnable { check() }
+    private var session: SpellCheckerSession? = null
+    private var pendingText = ""
+    private var checkedText: String? = null
+
+    fun schedule(text: String) {
+        pendingText = text
+        handler.removeCallbacks(runCheck)
+        handler.postDelayed(runCheck, SPELL_CHECK_DELAY_MS)
+    }
+
+    fun close() {
+        handler.removeCallbacks(runCheck)
+        session?.close()
+        session = null
+    }
+
+    private fun check() {
+        val text = pendingText
+        if (text.isBlank()) {
+            onResults(emptyList())
+            return
+        }
+        val active = session ?: openSession() ?: return
+        checkedText = text
+        active.getSentenceSuggestions(arrayOf(TextInfo(text)), SPELL_CHECK_MAX_SUGGESTIONS)
+    }
+
+    private fun openSession(): SpellCheckerSession? {
+        val manager = context.getSystemService(Context.TEXT_SERVICES_MANAGER_SERVICE) as? TextServicesManager
+        session = manager?.newSpellCheckerSession(null, null, this, true)
+        return session
+    }
+
+    override fun onGetSentenceSuggestions(results: Array<out SentenceSuggestionsInfo>?) {
+        val text = checkedText ?: return
+        if (text != pendingText) return
+        val ranges = mutableListOf<Map<String, Any>>()
+        results?.forEach { sentence ->
+            for (i in 0 until sentence.suggestionsCount) {
+                val info = sentence.getSuggestionsInfoAt(i)
+                if (info.suggestionsAttributes and SuggestionsInfo.RESULT_ATTR_LOOKS_LIKE_TYPO == 0) continue
+                val start = sentence.getOffsetAt(i)
+                val end = start + sentence.getLengthAt(i)
+                if (start < 0 || end > text.length) continue
+                val suggestions = (0 until maxOf(info.suggestionsCount, 0)).map { info.getSuggestionAt(it) }
+                ranges.add(
+                    mapOf(
+                        "start" to start,
+                        "end" to end,
+                        "word" to text.substring(start, end),
+                        "suggestions" to suggestions
+                    )
+                )
+            }
+        }
+        onResults(ranges)
+    }
+
+    override fun onGetSuggestions(results: Array<out SuggestionsInfo>?) {}
+}
+
+private const val SPELL_CHECK_DELAY_MS = 300L
+private const val SPELL_CHECK_MAX_SUGGESTIONS = 5
+
+/**
+ * EditText that routes rich content to its OnReceiveContentListener on every
+ * API level. Android 12+ does this natively; older versions need keyboard
+ * commitContent and clipboard paste forwarded explicitly.
+ */
+internal class ContentEditText(context: Context) : EditText(context) {
+    /** The theme's selection color, restored when the cursor is shown again. */
+    val defaultHighlightColor: Int = highlightColor
+
+    override fun onCreateInputConnection(outAttrs: EditorInfo): InputConnection? {
+        val ic = super.onCreateInputConnection(outAttrs) ?: return null
+        val mimeTypes = ViewCompat.getOnReceiveContentMimeTypes(this) ?: return ic
+        EditorInfoCompat.setContentMimeTypes(outAttrs, mimeTypes)
+        return InputConnectionCompat.createWrapper(this, ic, outAttrs)
+    }
+
+    override fun onTextContextMenuItem(id: Int): Boolean {
+        val isPaste = id == android.R.id.paste || id == android.R.id.pasteAsPlainText
+        if (Build.VERSION.SDK_INT >= Build.VERSION_CODES.S || !isPaste ||
+            ViewCompat.getOnReceiveContentMimeTypes(this) == null
+        ) {
+            return super.onTextContextMenuItem(id)
+        }
+        val clipboard = context.getSystemService(Context.CLIPBOARD_SERVICE) as ClipboardManager
+        val clip = clipboard.primaryClip ?: return true
+        val flags = if (id == android.R.id.paste) 0 else ContentInfoCompat.FLAG_CONVERT_TO_PLAIN_TEXT
+        val payload = ContentInfoCompat.Builder(clip, ContentInfoCompat.SOURCE_CLIPBOARD)
+            .setFlags(flags)
+            .build()
+        ViewCompat.performReceiveContent(this, payload)
+        return true
+    }
+}
+
+// Matches platform.SpellCheckMode in Go.
+private const val SPELL_CHECK_DEFAULT = 0
+private const val SPELL_CHECK_ENABLED = 1
+private const val SPELL_CHECK_DISABLED = 2
+
+// Matches platform.MaxLengthEnforcement in Go.
+private const val MAX_LENGTH_ENFORCEMENT_TRUNCATE = 0
+private const val MAX_LENGTH_ENFORCEMENT_BLOCK = 1
+private const val MAX_LENGTH_ENFORCEMENT_NONE = 2
+
+/**
+ * Maps a platform.AutofillHint name from Go to the Android autofill hint.
+ * The one-time code hint is androidx's HintConstants.AUTOFILL_HINT_SMS_OTP,
+ * which the SMS retriever and Google autofill fill from incoming messages.
+ */
+private fun androidAutofillHint(name: String): String? = when (name) {
+    "username" -> View.AUTOFILL_HINT_USERNAME
+    "password" -> View.AUTOFILL_HINT_PASSWORD
+    "newPassword" -> "newPassword"
+    "email" -> View.AUTOFILL_HINT_EMAIL_ADDRESS
+    "name" -> View.AUTOFILL_HINT_NAME
+    "phone" -> View.AUTOFILL_HINT_PHONE
+    "postalCode" -> View.AUTOFILL_HINT_POSTAL_CODE
+    "oneTimeCode" -> "smsOTPCode"
+    else -> null
+}
+
+/**
+ * Limits text to [maxLength] code points. Edits that carry an IME composing
+ * span pass through untouched so composition is never interrupted; the
+ * container trims any overflow once the composition commits.
+ */
+private class MaxLengthInputFilter(
+    private val maxLength: Int,
+    private val block: Boolean
+) : InputFilter {
+    override fun filter(
+        source: CharSequence,
+        start: Int,
+        end: Int,
+        dest: Spanned,
+        dstart: Int,
+        dend: Int
+    ): CharSequence? {
+        if (source is Spanned && BaseInputConnection.getComposingSpanStart(source) != -1) return null
+
+        val kept = Character.codePointCount(dest, 0, dest.length) - Character.codePointCount(dest, dstart, dend)
+        val available = maxLength - kept
+        val incoming = Character.codePointCount(source, start, end)
+        if (incoming <= available) return null
+        if (block || available <= 0) return ""
+        return source.subSequence(start, Character.offsetByCodePoints(source, start, available))
+    }
+}
+
 /**
  * Configuration for native text input view.
  */
@@ -259,15 +614,33 @@ class TextInputViewConfig(params: Map<String, Any?>) {
     val multiline: Boolean = params["multiline"] as? Boolean ?: false
     val maxLines: Int = (params["maxLines"] as? Number)?.toInt() ?: 0
     val obscure: Boolean = params["obscure"] as? Boolean ?: false
+    val obscuringCharacter: String = params["obscuringCharacter"] as? String ?: ""
     val autocorrect: Boolean = params["autocorrect"] as? Boolean ?: true
     val keyboardType: Int = (params["keyboardType"] as? Number)?.toInt() ?: 0
     val inputAction: Int = (params["inputAction"] as? Number)?.toInt() ?: 1
     val capitalization: Int = (params["capitalization"] as? Number)?.toInt() ?: 3
+    // keyboardAppearance, smartDashes, and smartQuotes are iOS-only; Android
+    // keyboards follow the system theme and have no punctuation substitution.
+    val spellCheck: Int = (params["spellCheck"] as? Number)?.toInt() ?: SPELL_CHECK_DEFAULT
+    val reportMisspellings: Boolean = params["reportMisspellings"] as? Boolean ?: false
+    val enablesReturnKeyAutomatically: Boolean = params["enablesReturnKeyAutomatically"] as? Boolean ?: false
+    val maxLength: Int = (params["maxLength"] as? Number)?.toInt() ?: 0
+    val maxLengthEnforcement: Int = (params["maxLengthEnforcement"] as? Number)?.toInt() ?: MAX_LENGTH_ENFORCEMENT_TRUNCATE
     val paddingLeft: Float = (params["paddingLeft"] as? Number)?.toFloat() ?: 0f
     val paddingTop: Float = (params["paddingTop"] as? Number)?.toFloat() ?: 0f
     val paddingRight: Float = (params["paddingRight"] as? Number)?.toFloat() ?: 0f
     val paddingBottom: Float = (params["paddingBottom"] as? Number)?.toFloat() ?: 0f
     val placeholder: String = params["placeholder"] as? String ?: ""
+    val contentMimeTypes: Array<String> = (params["contentMimeTypes"] as? String ?: "")
+        .split(',')
+        .map { it.trim() }
+        .filter { it.isNotEmpty() }
+        .toTypedArray()
+    val hideCursor: Boolean = params["hideCursor"] as? Boolean ?: false
+    val autofillHints: Array<String> = (params["autofillHints"] as? String ?: "")
+        .split(',')
+        .mapNotNull { androidAutofillHint(it.trim()) }
+        .toTypedArray()
 
     init {
         val textColorArg = params["textColor"]
@@ -324,10 +697,21 @@ class TextInputViewConfig(params: Map<String, Any?>) {
             }
 
             if (obscure) {
-                type = InputType.TYPE_CLASS_TEXT or InputType.TYPE_TEXT_VARIATION_PASSWORD
+                // Obscured number fields keep the number pad.
+                type = if (keyboardType == 1) {
+                    InputType.TYPE_CLASS_NUMBER or InputType.TYPE_NUMBER_VARIATION_PASSWORD
+                } else {
+                    InputType.TYPE_CLASS_TEXT or InputType.TYPE_TEXT_VARIATION_PASSWORD
+                }
             }
 
-            if (!autocorrect) {
+            // Spell check underlines and suggestions share one flag.
+            val noSuggestions = when (spellCheck) {
+                SPELL_CHECK_ENABLED -> false
+                SPELL_CHECK_DISABLED -> true
+                else -> !autocorrect
+            }
+            if (noSuggestions) {
                 type = type or InputType.TYPE_TEXT_FLAG_NO_SUGGESTIONS
             }
 
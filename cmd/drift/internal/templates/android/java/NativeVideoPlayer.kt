@@ -5,25 +5,55 @@
 package {{.PackageName}}
 
 import android.content.Context
+import android.graphics.Bitmap
+import android.hardware.display.DisplayManager
+import android.media.MediaMetadataRetriever
+import android.net.Uri
+import android.os.Handler
+import android.os.Looper
+import android.util.Base64
+import android.view.Display
+import android.view.SurfaceView
 import android.view.TextureView
 import android.view.View
 import android.view.ViewGroup
 import android.widget.FrameLayout
+import androidx.media3.cast.CastPlayer
 import androidx.media3.common.AudioAttributes
 import androidx.media3.common.C
+import androidx.media3.common.Format
 import androidx.media3.common.MediaItem
+import androidx.media3.common.MimeTypes
 import androidx.media3.common.PlaybackException
 import androidx.media3.common.Player
+import androidx.media3.common.TrackSelectionOverride
+import androidx.media3.common.Tracks
+import androidx.media3.common.text.CueGroup
+import androidx.media3.exoplayer.DecoderReuseEvaluation
 import androidx.media3.exoplayer.ExoPlayer
+import androidx.media3.exoplayer.analytics.AnalyticsListener
 import androidx.media3.ui.PlayerView
+import com.google.android.gms.cast.framework.CastContext
+import java.io.ByteArrayOutputStream
 
 /**
  * Platform view container for native video player using ExoPlayer.
  *
- * Wraps a PlayerView inside a FrameLayout and replaces the default SurfaceView
- * with a TextureView. TextureView integrates correctly with Drift's clipping
- * (View.clipBounds) and avoids z-ordering issues and black flashes on resize
- * that SurfaceView causes in a platform view context.
+ * Wraps a PlayerView inside a FrameLayout and, by default, replaces its
+ * SurfaceView with a TextureView. TextureView integrates correctly with
+ * Drift's clipping (View.clipBounds) and avoids z-ordering issues and black
+ * flashes on resize that SurfaceView causes in a platform view context.
+ *
+ * The SurfaceView is kept when the app asks for it with the "surface" mode,
+ * or in "auto" mode when it asks to preserve HDR and the display supports it,
+ * since TextureView tone maps HDR content to SDR. While Drift paints the view
+ * scaled, rotated or translucent, Go asks for a texture fallback and the
+ * TextureView takes the SurfaceView's place until the transform ends. The
+ * view opts out of region masking while it shows the SurfaceView.
+ *
+ * While a Cast session is connected, playback can move to a CastPlayer that
+ * controls the receiver (see CastHandler). Transport methods and events then
+ * go through the CastPlayer, so Go sees the receiver's state.
  */
 class NativeVideoPlayerContainer(
     context: Context,
@@ -32,21 +62,50 @@ class NativeVideoPlayerContainer(
 ) : PlatformViewContainer {
 
     override val view: View
-    override val supportsRegionMask: Boolean get() = true
+    override val supportsRegionMask: Boolean get() = !usesSurfaceView
     private val playerView: PlayerView
     private val player: ExoPlayer
+    /** PlayerView's own SurfaceView, kept to swap back in after a texture fallback. */
+    private val surfaceView: SurfaceView?
+    private var usesSurfaceView = false
+    /** Whether the SurfaceView is shown when the view is not transformed. */
+    private val prefersSurfaceView: Boolean
+    private var textureView: TextureView? = null
+    private var castPlayer: CastPlayer? = null
+    /** The video track pinned with selectQuality, or "" for adaptive selection. */
+    private var pinnedQuality = ""
+    private val audioFocus: MediaAudioFocus
+
+    /** The player that controls playback: the receiver while casting, else the local one. */
+    private val activePlayer: Player get() = castPlayer ?: player
+
+    val isCasting: Boolean get() = castPlayer != null
 
     init {
         player = ExoPlayer.Builder(context).build().also {
             it.setAudioAttributes(
                 AudioAttributes.Builder()
                     .setUsage(C.USAGE_MEDIA)
-                    .setContentType(C.AUDIO_CONTENT_TYPE_MUSIC)
+                    .setContentType(C.AUDIO_CONTENT_TYPE_MOVIE)
                     .build(),
-                /* handleAudioFocus= */ true
+                /* handleAudioFocus= */ false
             )
         }
 
+        // Focus changes are reported to Go, which pauses and resumes playback.
+        audioFocus = MediaAudioFocus(
+            context,
+            Handler(Looper.getMainLooper()),
+            android.media.AudioAttributes.CONTENT_TYPE_MOVIE,
+            isPlaying = { player.isPlaying },
+            send = { event ->
+                PlatformChannelManager.sendEvent(
+                    "drift/platform_views",
+                    event + mapOf("method" to "onInterruption", "viewId" to viewId)
+                )
+            }
+        )
+
         playerView = PlayerView(context).apply {
             layoutParams = FrameLayout.LayoutParams(
                 FrameLayout.LayoutParams.MATCH_PARENT,
@@ -54,15 +113,32 @@ class NativeVideoPlayerContainer(
             )
             this.player = this@NativeVideoPlayerContainer.player
             controllerAutoShow = true
+            // Captions are drawn by Drift from the cues reported to Go.
+            subtitleView?.visibility = View.GONE
         }
 
-        // Replace the default SurfaceView with a TextureView.
-        // PlayerView uses SurfaceView by default, which does not respect
-        // View.clipBounds and causes z-ordering issues in platform views.
-        replaceWithTextureView(playerView)
+        // Replace the default SurfaceView with a TextureView unless the app
+        // chose the SurfaceView. PlayerView uses SurfaceView by default, which
+        // does not respect View.clipBounds and causes z-ordering issues in
+        // platform views. HDR output is only possible through the SurfaceView.
+        val preserveHDR = params["preserveHDR"] as? Boolean ?: false
+        prefersSurfaceView = when (params["surfaceMode"] as? String) {
+            "surface" -> true
+            "texture" -> false
+            else -> preserveHDR && VideoHandler.hdrFormats(context).isNotEmpty()
+        }
+        surfaceView = findSurfaceView(playerView)
+        usesSurfaceView = surfaceView != null
+        if (!prefersSurfaceView) {
+            setRenderView(texture = true)
+        }
 
         view = playerView
 
+        // A session can connect before Go uses the cast channel, so the Cast
+        // framework is set up with the first player.
+        CastHandler.init(context)
+
         // Configure player from params
         val url = params["url"] as? String
         val autoPlay = params["autoPlay"] as? Boolean ?: false
@@ -73,99 +149,160 @@ class NativeVideoPlayerContainer(
         player.volume = volume
 
         // Add listener for state and position events
-        player.addListener(object : Player.Listener {
-            override fun onPlaybackStateChanged(playbackState: Int) {
-                val state = when (playbackState) {
-                    Player.STATE_IDLE -> 0
-                    Player.STATE_BUFFERING -> 1
-                    Player.STATE_READY -> if (player.isPlaying) 2 else 4 // Playing or Paused
-                    Player.STATE_ENDED -> 3
-                    else -> 0
-                }
-                if (playbackState == Player.STATE_IDLE || playbackState == Player.STATE_ENDED) {
-                    stopPositionUpdates()
-                }
-                PlatformChannelManager.sendEvent(
-                    "drift/platform_views",
-                    mapOf(
-                        "method" to "onPlaybackStateChanged",
-                        "viewId" to viewId,
-                        "state" to state
-                    )
-                )
+        player.addListener(listenerFor(player))
+
+        // Report adaptive rendition switches, with the new bitrate.
+        player.addAnalyticsListener(object : AnalyticsListener {
+            override fun onVideoInputFormatChanged(
+                eventTime: AnalyticsListener.EventTime,
+                format: Format,
+                decoderReuseEvaluation: DecoderReuseEvaluation?
+            ) {
+                sendQualityChanged(format)
             }
+        })
 
-            override fun onIsPlayingChanged(isPlaying: Boolean) {
-                if (player.playbackState == Player.STATE_READY) {
-                    val state = if (isPlaying) 2 else 4 // Playing or Paused
-                    PlatformChannelManager.sendEvent(
-                        "drift/platform_views",
-                        mapOf(
-                            "method" to "onPlaybackStateChanged",
-                            "viewId" to viewId,
-                            "state" to state
-                        )
-                    )
-                    if (isPlaying) startPositionUpdates() else stopPositionUpdates()
-                }
+        // Load media if URL provided
+        if (url != null && url.isNotEmpty()) {
+            val mediaItem = MediaItem.fromUri(url)
+            player.setMediaItem(mediaItem)
+            player.prepare()
+            if (autoPlay) {
+                player.playWhenReady = true
             }
+        }
+
+    }
 
-            override fun onPlayerError(error: PlaybackException) {
+    /**
+     * Creates a listener that reports source's events to Go. Events from a
+     * player that is not the active one, such as the local player pausing
+     * when playback moves to a receiver, are ignored.
+     */
+    private fun listenerFor(source: Player): Player.Listener = object : Player.Listener {
+        override fun onPlaybackStateChanged(playbackState: Int) {
+            if (source !== activePlayer) return
+            val state = when (playbackState) {
+                Player.STATE_IDLE -> 0
+                Player.STATE_BUFFERING -> 1
+                Player.STATE_READY -> if (source.isPlaying) 2 else 4 // Playing or Paused
+                Player.STATE_ENDED -> 3
+                else -> 0
+            }
+            if (playbackState == Player.STATE_IDLE || playbackState == Player.STATE_ENDED) {
+                stopPositionUpdates()
+            }
+            PlatformChannelManager.sendEvent(
+                "drift/platform_views",
+                mapOf(
+                    "method" to "onPlaybackStateChanged",
+                    "viewId" to viewId,
+                    "state" to state
+                )
+            )
+        }
+
+        override fun onIsPlayingChanged(isPlaying: Boolean) {
+            if (source !== activePlayer) return
+            // A receiver plays on its own device; only local playback needs focus.
+            if (isPlaying && source === player && !audioFocus.request()) {
+                player.pause()
+                return
+            }
+            if (source.playbackState == Player.STATE_READY) {
+                val state = if (isPlaying) 2 else 4 // Playing or Paused
                 PlatformChannelManager.sendEvent(
                     "drift/platform_views",
                     mapOf(
-                        "method" to "onVideoError",
+                        "method" to "onPlaybackStateChanged",
                         "viewId" to viewId,
-                        "code" to mediaErrorCodeString(error.errorCode),
-                        "message" to (error.message ?: "Unknown playback error")
+                        "state" to state
                     )
                 )
+                if (isPlaying) startPositionUpdates() else stopPositionUpdates()
             }
-        })
+        }
 
-        // Load media if URL provided
-        if (url != null && url.isNotEmpty()) {
-            val mediaItem = MediaItem.fromUri(url)
-            player.setMediaItem(mediaItem)
-            player.prepare()
-            if (autoPlay) {
-                player.playWhenReady = true
+        override fun onTracksChanged(tracks: Tracks) {
+            // Subtitles are selected and drawn locally, even while casting.
+            if (source !== player) return
+            sendSubtitleTracks(tracks)
+            sendQualities(tracks)
+        }
+
+        override fun onCues(cueGroup: CueGroup) {
+            if (source !== player) return
+            val startMs = cueGroup.presentationTimeUs / 1000
+            val cues = cueGroup.cues.mapNotNull { cue ->
+                cue.text?.toString()?.let { mapOf("text" to it, "startMs" to startMs) }
             }
+            PlatformChannelManager.sendEvent(
+                "drift/platform_views",
+                mapOf(
+                    "method" to "onCueChanged",
+                    "viewId" to viewId,
+                    "cues" to cues
+                )
+            )
         }
 
+        override fun onPlayerError(error: PlaybackException) {
+            if (source !== activePlayer) return
+            PlatformChannelManager.sendEvent(
+                "drift/platform_views",
+                mapOf(
+                    "method" to "onVideoError",
+                    "viewId" to viewId,
+                    "code" to mediaErrorCodeString(error.errorCode),
+                    "message" to (error.message ?: "Unknown playback error")
+                )
+            )
+        }
     }
 
     /**
-     * Replaces the default SurfaceView inside PlayerView with a TextureView.
-     * PlayerView renders video into the first SurfaceView/TextureView child it finds
-     * in its subtree. By swapping the SurfaceView for a TextureView at the same
-     * position and size, the player renders through TextureView instead.
-     *
-     * Searches recursively since some PlayerView versions nest the SurfaceView
-     * inside child ViewGroups.
+     * Swaps the view the player renders into. PlayerView renders video into
+     * the SurfaceView it creates; a TextureView takes its place at the same
+     * position and size when texture is true, and the SurfaceView is put
+     * back when it is false.
      */
-    private fun replaceWithTextureView(playerView: PlayerView) {
-        val surfaceView = findSurfaceView(playerView) ?: return
-        val parent = surfaceView.parent as? ViewGroup ?: return
-        val index = parent.indexOfChild(surfaceView)
-        val params = surfaceView.layoutParams
+    private fun setRenderView(texture: Boolean) {
+        val surface = surfaceView ?: return
+        if (texture != usesSurfaceView) return
+        val current: View = if (usesSurfaceView) surface else textureView ?: return
+        val parent = current.parent as? ViewGroup ?: return
+        val index = parent.indexOfChild(current)
+        val params = current.layoutParams
         parent.removeViewAt(index)
-        val textureView = TextureView(playerView.context)
-        textureView.layoutParams = params
-        parent.addView(textureView, index)
+        if (texture) {
+            val target = textureView ?: TextureView(playerView.context).also { textureView = it }
+            parent.addView(target, index, params)
+            player.setVideoTextureView(target)
+        } else {
+            parent.addView(surface, index, params)
+            player.setVideoSurfaceView(surface)
+        }
+        usesSurfaceView = !texture
+    }
 
-        // Connect the TextureView to the player via the PlayerView's
-        // video output mechanism
-        player.setVideoTextureView(textureView)
+    /**
+     * Shows the TextureView in place of a preferred SurfaceView while Drift
+     * paints the view scaled, rotated or translucent, which a SurfaceView
+     * composited outside the window cannot follow.
+     */
+    fun setTextureFallback(active: Boolean) {
+        if (!prefersSurfaceView) return
+        setRenderView(texture = active)
     }
 
     /**
      * Recursively searches a ViewGroup for the first SurfaceView child.
+     * Some PlayerView versions nest the SurfaceView inside child ViewGroups.
      */
-    private fun findSurfaceView(group: ViewGroup): android.view.SurfaceView? {
+    private fun findSurfaceView(group: ViewGroup): SurfaceView? {
         for (i in 0 until group.childCount) {
             val child = group.getChildAt(i)
-            if (child is android.view.SurfaceView) return child
+            if (child is SurfaceView) return child
             if (child is ViewGroup) {
                 val found = findSurfaceView(child)
                 if (found != null) return found
@@ -174,21 +311,106 @@ class NativeVideoPlayerContainer(
         return null
     }
 
+    /**
+     * Reports the media's text tracks to Go. Track IDs are "group:track"
+     * indices into the current tracks, which stay valid until the media
+     * item changes.
+     */
+    private fun sendSubtitleTracks(tracks: Tracks) {
+        val list = mutableListOf<Map<String, Any>>()
+        var selected = ""
+        tracks.groups.forEachIndexed { groupIndex, group ->
+            if (group.type != C.TRACK_TYPE_TEXT) return@forEachIndexed
+            for (i in 0 until group.length) {
+                if (!group.isTrackSupported(i)) continue
+                val format = group.getTrackFormat(i)
+                val id = "$groupIndex:$i"
+                list.add(
+                    mapOf(
+                        "id" to id,
+                        "label" to (format.label ?: ""),
+                        "language" to (format.language ?: "")
+                    )
+                )
+                if (group.isTrackSelected(i)) selected = id
+            }
+        }
+        PlatformChannelManager.sendEvent(
+            "drift/platform_views",
+            mapOf(
+                "method" to "onSubtitleTracksChanged",
+                "viewId" to viewId,
+                "tracks" to list,
+                "selected" to selected
+            )
+        )
+    }
+
+    /**
+     * Reports the media's video renditions to Go, lowest bitrate first.
+     * IDs are "group:track" indices, like subtitle track IDs. Progressive
+     * media has a single rendition, which is not reported.
+     */
+    private fun sendQualities(tracks: Tracks) {
+        val list = mutableListOf<Map<String, Any>>()
+        tracks.groups.forEachIndexed { groupIndex, group ->
+            if (group.type != C.TRACK_TYPE_VIDEO || !group.isAdaptiveSupported) return@forEachIndexed
+            for (i in 0 until group.length) {
+                if (!group.isTrackSupported(i)) continue
+                list.add(qualityMap("$groupIndex:$i", group.getTrackFormat(i)))
+            }
+        }
+        PlatformChannelManager.sendEvent(
+            "drift/platform_views",
+            mapOf(
+                "method" to "onQualitiesChanged",
+                "viewId" to viewId,
+                "qualities" to list.sortedBy { it["bitrate"] as Int },
+                "selected" to pinnedQuality
+            )
+        )
+    }
+
+    private fun sendQualityChanged(format: Format) {
+        var id = ""
+        player.currentTracks.groups.forEachIndexed { groupIndex, group ->
+            if (group.type != C.TRACK_TYPE_VIDEO) return@forEachIndexed
+            for (i in 0 until group.length) {
+                if (group.getTrackFormat(i).id == format.id) id = "$groupIndex:$i"
+            }
+        }
+        PlatformChannelManager.sendEvent(
+            "drift/platform_views",
+            qualityMap(id, format) + mapOf(
+                "method" to "onQualityChanged",
+                "viewId" to viewId
+            )
+        )
+    }
+
+    private fun qualityMap(id: String, format: Format): Map<String, Any> = mapOf(
+        "id" to id,
+        "width" to format.width.coerceAtLeast(0),
+        "height" to format.height.coerceAtLeast(0),
+        "bitrate" to (if (format.peakBitrate != Format.NO_VALUE) format.peakBitrate else format.bitrate).coerceAtLeast(0)
+    )
+
     private var positionRunnable: Runnable? = null
 
     private fun startPositionUpdates() {
         stopPositionUpdates()
         positionRunnable = object : Runnable {
             override fun run() {
-                if (player.playbackState != Player.STATE_IDLE) {
+                val current = activePlayer
+                if (current.playbackState != Player.STATE_IDLE) {
                     PlatformChannelManager.sendEvent(
                         "drift/platform_views",
                         mapOf(
                             "method" to "onPositionChanged",
                             "viewId" to viewId,
-                            "positionMs" to player.currentPosition,
-                            "durationMs" to player.duration.coerceAtLeast(0),
-                            "bufferedMs" to player.bufferedPosition
+                            "positionMs" to current.currentPosition,
+                            "durationMs" to current.duration.coerceAtLeast(0),
+                            "bufferedMs" to current.bufferedPosition
                         )
                     )
                 }
@@ -205,23 +427,33 @@ class NativeVideoPlayerContainer(
 
     override fun dispose() {
         stopPositionUpdates()
+        audioFocus.abandon()
+        castPlayer?.let {
+            it.stop()
+            it.release()
+        }
+        castPlayer = null
+        CastHandler.playerDisposed(this)
         player.release()
     }
 
     fun play() {
-        if (player.playbackState == Player.STATE_IDLE) {
-            player.prepare()
+        val current = activePlayer
+        if (current.playbackState == Player.STATE_IDLE) {
+            current.prepare()
         }
-        player.play()
+        current.play()
+        CastHandler.playerStarted(this)
     }
 
     fun pause() {
-        player.pause()
+        activePlayer.pause()
     }
 
     fun stop() {
-        player.stop()
-        player.seekTo(0)
+        val current = activePlayer
+        current.stop()
+        current.seekTo(0)
         PlatformChannelManager.sendEvent(
             "drift/platform_views",
             mapOf(
@@ -233,28 +465,308 @@ class NativeVideoPlayerContainer(
     }
 
     fun seekTo(positionMs: Long) {
-        player.seekTo(positionMs)
+        activePlayer.seekTo(positionMs)
     }
 
+    /** Sets the local volume. A receiver's volume is the receiver's own. */
     fun setVolume(volume: Float) {
         player.volume = volume
     }
 
     fun setLooping(looping: Boolean) {
-        player.repeatMode = if (looping) Player.REPEAT_MODE_ALL else Player.REPEAT_MODE_OFF
+        val mode = if (looping) Player.REPEAT_MODE_ALL else Player.REPEAT_MODE_OFF
+        player.repeatMode = mode
+        castPlayer?.repeatMode = mode
     }
 
     fun setPlaybackSpeed(rate: Float) {
         player.setPlaybackSpeed(rate)
+        castPlayer?.setPlaybackSpeed(rate)
     }
 
     fun setShowControls(show: Boolean) {
         playerView.useController = show
     }
 
-    fun load(url: String) {
-        val mediaItem = MediaItem.fromUri(url)
+    /**
+     * Loads a media URL. drm configures Widevine license acquisition; the
+     * license request is posted to its licenseUrl with its headers.
+     */
+    fun load(url: String, drm: Map<*, *>? = null) {
+        // Renditions belong to the media item; return to adaptive selection.
+        pinnedQuality = ""
+        player.trackSelectionParameters = player.trackSelectionParameters.buildUpon()
+            .clearOverridesOfType(C.TRACK_TYPE_VIDEO)
+            .build()
+        val builder = MediaItem.Builder().setUri(url)
+        if (drm != null) {
+            if (drm["scheme"] != "widevine") {
+                sendDrmError("${drm["scheme"]} DRM is not supported on Android")
+                return
+            }
+            val headers = (drm["headers"] as? Map<*, *>)
+                ?.entries
+                ?.associate { (key, value) -> key.toString() to value.toString() }
+                ?: emptyMap()
+            builder.setDrmConfiguration(
+                MediaItem.DrmConfiguration.Builder(C.WIDEVINE_UUID)
+                    .setLicenseUri(drm["licenseUrl"] as? String)
+                    .setLicenseRequestHeaders(headers)
+                    .build()
+            )
+        }
+        val mediaItem = builder.build()
         player.setMediaItem(mediaItem)
-        player.prepare()
+        val cast = castPlayer
+        if (cast != null) {
+            // The local player is prepared when playback returns to it.
+            cast.setMediaItem(castMediaItem(mediaItem))
+            cast.prepare()
+        } else {
+            player.prepare()
+        }
+    }
+
+    private fun sendDrmError(message: String) {
+        PlatformChannelManager.sendEvent(
+            "drift/platform_views",
+            mapOf(
+                "method" to "onVideoError",
+                "viewId" to viewId,
+                "code" to "drm_error",
+                "message" to message
+            )
+        )
+    }
+
+    /**
+     * Moves playback to the Cast receiver, continuing from the local
+     * position. Called by CastHandler on the main thread.
+     */
+    fun startCasting(castContext: CastContext, deviceName: String) {
+        if (castPlayer != null) return
+        val item = player.currentMediaItem ?: return
+        val positionMs = player.currentPosition
+        val playWhenReady = player.playWhenReady
+        player.pause()
+
+        val cast = CastPlayer(castContext)
+        cast.addListener(listenerFor(cast))
+        castPlayer = cast
+        cast.repeatMode = player.repeatMode
+        cast.setMediaItem(castMediaItem(item), positionMs)
+        cast.playWhenReady = playWhenReady
+        cast.prepare()
+        playerView.player = cast
+
+        sendCastingChanged(true, deviceName)
+        startPositionUpdates()
+    }
+
+    /**
+     * Returns playback to the local player at the receiver's position. The
+     * local player resumes only if resume is true and the receiver was
+     * playing. Called by CastHandler on the main thread.
+     */
+    fun stopCasting(resume: Boolean) {
+        val cast = castPlayer ?: return
+        val positionMs = cast.currentPosition
+        val playWhenReady = resume && cast.playWhenReady
+        castPlayer = null
+        cast.release()
+
+        // PlayerView attaches the player to its original SurfaceView, which
+        // may have been replaced by the TextureView.
+        playerView.player = player
+        if (!usesSurfaceView) {
+            textureView?.let { player.setVideoTextureView(it) }
+        }
+
+        if (player.playbackState == Player.STATE_IDLE) {
+            player.prepare()
+        }
+        player.seekTo(positionMs)
+        player.playWhenReady = playWhenReady
+
+        sendCastingChanged(false, "")
+        PlatformChannelManager.sendEvent(
+            "drift/platform_views",
+            mapOf(
+                "method" to "onPlaybackStateChanged",
+                "viewId" to viewId,
+                "state" to if (playWhenReady) 1 else 4 // Buffering or Paused
+            )
+        )
+    }
+
+    private fun sendCastingChanged(casting: Boolean, deviceName: String) {
+        PlatformChannelManager.sendEvent(
+            "drift/platform_views",
+            mapOf(
+                "method" to "onCastingChanged",
+                "viewId" to viewId,
+                "casting" to casting,
+                "deviceName" to deviceName
+            )
+        )
+    }
+
+    /**
+     * Returns the item with a MIME type, which the receiver needs to pick a
+     * media pipeline, inferring it from the URL if the item has none.
+     */
+    private fun castMediaItem(item: MediaItem): MediaItem {
+        val config = item.localConfiguration ?: return item
+        if (config.mimeType != null) return item
+        val path = config.uri.lastPathSegment?.lowercase() ?: ""
+        val mimeType = when {
+            path.endsWith(".m3u8") -> MimeTypes.APPLICATION_M3U8
+            path.endsWith(".mpd") -> MimeTypes.APPLICATION_MPD
+            path.endsWith(".webm") -> MimeTypes.VIDEO_WEBM
+            else -> MimeTypes.VIDEO_MP4
+        }
+        return item.buildUpon().setMimeType(mimeType).build()
+    }
+
+    /** Selects the text track with the given ID, or disables text tracks if the ID is empty. */
+    fun selectSubtitleTrack(id: String) {
+        val builder = player.trackSelectionParameters.buildUpon()
+        val groups = player.currentTracks.groups
+        val indices = id.split(":").mapNotNull { it.toIntOrNull() }
+        val group = if (indices.size == 2) groups.getOrNull(indices[0]) else null
+        if (group == null || group.type != C.TRACK_TYPE_TEXT || indices[1] !in 0 until group.length) {
+            builder.setTrackTypeDisabled(C.TRACK_TYPE_TEXT, true)
+        } else {
+            builder.setTrackTypeDisabled(C.TRACK_TYPE_TEXT, false)
+                .setOverrideForType(TrackSelectionOverride(group.mediaTrackGroup, indices[1]))
+        }
+        player.trackSelectionParameters = builder.build()
+    }
+
+    /** Pins the video track with the given ID, or returns to adaptive selection if the ID is empty or unknown. */
+    fun selectQuality(id: String) {
+        val builder = player.trackSelectionParameters.buildUpon().clearOverridesOfType(C.TRACK_TYPE_VIDEO)
+        val indices = id.split(":").mapNotNull { it.toIntOrNull() }
+        val group = if (indices.size == 2) player.currentTracks.groups.getOrNull(indices[0]) else null
+        pinnedQuality = ""
+        if (group != null && group.type == C.TRACK_TYPE_VIDEO && indices[1] in 0 until group.length) {
+            builder.setOverrideForType(TrackSelectionOverride(group.mediaTrackGroup, indices[1]))
+            pinnedQuality = id
+        }
+        player.trackSelectionParameters = builder.build()
+    }
+
+    /** Caps adaptive selection. Zero values are unlimited; overrides from selectQuality are not capped. */
+    fun setQualityLimit(maxHeight: Int, maxBitrate: Int) {
+        player.trackSelectionParameters = player.trackSelectionParameters.buildUpon()
+            .setMaxVideoSize(Int.MAX_VALUE, if (maxHeight > 0) maxHeight else Int.MAX_VALUE)
+            .setMaxVideoBitrate(if (maxBitrate > 0) maxBitrate else Int.MAX_VALUE)
+            .build()
+    }
+}
+
+/**
+ * Handles device video capability queries and frame extraction from Go.
+ */
+object VideoHandler {
+    fun handle(context: Context, method: String, args: Any?): Pair<Any?, Exception?> {
+        return when (method) {
+            "getHDRCapabilities" -> Pair(hdrCapabilities(context), null)
+            "frameAt" -> frameAt(context, args as? Map<*, *>)
+            else -> Pair(null, IllegalArgumentException("Unknown method: $method"))
+        }
+    }
+
+    /**
+     * Decodes the frame of a file or remote URL at a position and returns it
+     * as a base64 JPEG, scaled down to fit maxWidth x maxHeight. Positions
+     * past the end return the last frame. Runs on the calling thread, which
+     * is never the main thread.
+     */
+    private fun frameAt(context: Context, args: Map<*, *>?): Pair<Any?, Exception?> {
+        val url = args?.get("url") as? String
+            ?: return Pair(null, IllegalArgumentException("Missing url"))
+        val positionUs = ((args["positionMs"] as? Number)?.toLong() ?: 0L) * 1000
+        val maxWidth = (args["maxWidth"] as? Number)?.toInt() ?: 0
+        val maxHeight = (args["maxHeight"] as? Number)?.toInt() ?: 0
+        val option = if (args["exact"] == true) {
+            MediaMetadataRetriever.OPTION_CLOSEST
+        } else {
+            MediaMetadataRetriever.OPTION_CLOSEST_SYNC
+        }
+
+        val retriever = MediaMetadataRetriever()
+        try {
+            val uri = Uri.parse(url)
+            if (uri.scheme == "http" || uri.scheme == "https") {
+                retriever.setDataSource(url, HashMap())
+            } else {
+                retriever.setDataSource(context, uri)
+            }
+            val durationUs = (retriever.extractMetadata(MediaMetadataRetriever.METADATA_KEY_DURATION)
+                ?.toLongOrNull() ?: 0L) * 1000
+            val timeUs = if (durationUs > 0) positionUs.coerceAtMost(durationUs) else positionUs
+            val frame = retriever.getFrameAtTime(timeUs, option)
+                ?: retriever.getFrameAtTime(timeUs, MediaMetadataRetriever.OPTION_PREVIOUS_SYNC)
+                ?: return Pair(null, IllegalArgumentException("No video frame in $url"))
+
+            val scaled = scaleToFit(frame, maxWidth, maxHeight)
+            val out = ByteArrayOutputStream()
+            scaled.compress(Bitmap.CompressFormat.JPEG, 90, out)
+            if (scaled !== frame) {
+                scaled.recycle()
+            }
+            frame.recycle()
+            return Pair(mapOf("data" to Base64.encodeToString(out.toByteArray(), Base64.NO_WRAP)), null)
+        } catch (e: Exception) {
+            return Pair(null, e)
+        } finally {
+            retriever.release()
+        }
+    }
+
+    /** Scales bitmap down to fit the bounds, keeping its aspect ratio. Zero bounds are unlimited. */
+    private fun scaleToFit(bitmap: Bitmap, maxWidth: Int, maxHeight: Int): Bitmap {
+        var scale = 1.0
+        if (maxWidth > 0 && bitmap.width > maxWidth) {
+            scale = minOf(scale, maxWidth.toDouble() / bitmap.width)
+        }
+        if (maxHeight > 0 && bitmap.height > maxHeight) {
+            scale = minOf(scale, maxHeight.toDouble() / bitmap.height)
+        }
+        if (scale >= 1.0) {
+            return bitmap
+        }
+        val width = (bitmap.width * scale).toInt().coerceAtLeast(1)
+        val height = (bitmap.height * scale).toInt().coerceAtLeast(1)
+        return Bitmap.createScaledBitmap(bitmap, width, height, true)
+    }
+
+    private fun defaultDisplay(context: Context): Display? {
+        val displayManager = context.getSystemService(Context.DISPLAY_SERVICE) as? DisplayManager
+        return displayManager?.getDisplay(Display.DEFAULT_DISPLAY)
+    }
+
+    /** Returns the HDR formats the default display can present, by channel name. */
+    @Suppress("DEPRECATION") // HdrCapabilities.supportedHdrTypes moved to Display.Mode in API 34.
+    fun hdrFormats(context: Context): List<String> {
+        val types = defaultDisplay(context)?.hdrCapabilities?.supportedHdrTypes ?: return emptyList()
+        return types.mapNotNull { type ->
+            when (type) {
+                Display.HdrCapabilities.HDR_TYPE_HDR10 -> "hdr10"
+                Display.HdrCapabilities.HDR_TYPE_HDR10_PLUS -> "hdr10Plus"
+                Display.HdrCapabilities.HDR_TYPE_HLG -> "hlg"
+                Display.HdrCapabilities.HDR_TYPE_DOLBY_VISION -> "dolbyVision"
+                else -> null
+            }
+        }
+    }
+
+    private fun hdrCapabilities(context: Context): Map<String, Any> {
+        val maxLuminance = defaultDisplay(context)?.hdrCapabilities?.desiredMaxLuminance ?: 0f
+        return mapOf(
+            "formats" to hdrFormats(context),
+            "maxLuminance" to maxLuminance.toDouble().coerceAtLeast(0.0)
+        )
     }
 }
@@ -13,6 +13,8 @@ import android.content.ClipData
 import android.content.ClipboardManager
 import android.content.Context
 import android.content.Intent
+import android.content.pm.ActivityInfo
+import android.content.res.Configuration
 import android.graphics.Color
 import android.graphics.drawable.ColorDrawable
 import android.os.Build
@@ -22,7 +24,9 @@ import android.os.Vibrator
 import android.os.VibratorManager
 import android.util.Log
 import android.view.HapticFeedbackConstants
+import android.view.KeyEvent
 import android.view.View
+import android.view.WindowManager
 import androidx.appcompat.app.AppCompatActivity
 import androidx.core.content.FileProvider
 import androidx.core.view.ViewCompat
@@ -78,6 +82,10 @@ object PlatformChannelManager {
         return currentActivity
     }
 
+    fun hostView(): View? {
+        return view
+    }
+
     fun isAppForeground(): Boolean {
         return currentActivity != null
     }
@@ -207,6 +215,21 @@ object PlatformChannelManager {
             SystemUIHandler.handle(method, args)
         }
 
+        // Screen channel
+        register("drift/screen") { method, args ->
+            ScreenHandler.handle(method, args)
+        }
+
+        // System gestures channel
+        register("drift/system_gestures") { method, args ->
+            SystemGesturesHandler.handle(method, args)
+        }
+
+        // Restoration channel
+        register("drift/restoration") { method, args ->
+            RestorationHandler.handle(method, args)
+        }
+
         // Notifications channel
         register("drift/notifications") { method, args ->
             NotificationHandler.handle(context, method, args)
@@ -276,11 +299,27 @@ object PlatformChannelManager {
         register("drift/audio_player") { method, args ->
             AudioPlayerHandler.handle(context, method, args)
         }
+        register("drift/audio") { method, args ->
+            AudioHandler.handle(context, method, args)
+        }
 
         // URL Launcher channel
         register("drift/url_launcher") { method, args ->
             URLLauncherHandler.handle(context, method, args)
         }
+        register("drift/video") { method, args ->
+            VideoHandler.handle(context, method, args)
+        }
+
+        // Cast channel
+        register("drift/cast") { method, args ->
+            CastHandler.handle(context, method, args)
+        }
+
+        // Downloads channel
+        register("drift/downloads") { method, args ->
+            DownloadHandler.handle(context, method, args)
+        }
     }
 
     private fun setupLifecycleObserver() {
@@ -584,6 +623,10 @@ object LifecycleHandler {
 // MARK: - System UI Handler
 
 object SystemUIHandler {
+    // Whether the app's style hides the status bar; restored after immersive mode.
+    var statusBarHidden = false
+        private set
+
     fun handle(method: String, args: Any?): Pair<Any?, Exception?> {
         if (method != "setStyle") {
             return Pair(null, IllegalArgumentException("Unknown method: $method"))
@@ -596,6 +639,7 @@ object SystemUIHandler {
             ?: return Pair(null, IllegalArgumentException("Invalid arguments"))
 
         val statusBarHidden = argsMap["statusBarHidden"] as? Boolean ?: false
+        this.statusBarHidden = statusBarHidden
         val statusBarStyle = argsMap["statusBarStyle"] as? String ?: "default"
         val titleBarHidden = argsMap["titleBarHidden"] as? Boolean ?: false
         val transparent = argsMap["transparent"] as? Boolean ?: false
@@ -656,6 +700,193 @@ object SystemUIHandler {
     }
 }
 
+// MARK: - Screen Handler
+
+object ScreenHandler {
+    // Orientation declared in the manifest, restored when unlocked.
+    private var declaredOrientation: Int? = null
+
+    fun handle(method: String, args: Any?): Pair<Any?, Exception?> {
+        val activity = PlatformChannelManager.currentActivity()
+            ?: return Pair(null, IllegalStateException("No active activity"))
+        val argsMap = args as? Map<*, *>
+
+        return when (method) {
+            "getBrightness" -> {
+                val override = activity.window.attributes.screenBrightness
+                if (override >= 0f) {
+                    Pair(override.toDouble(), null)
+                } else {
+                    // No override: read the system setting (0-255).
+                    val system = android.provider.Settings.System.getInt(
+                        activity.contentResolver,
+                        android.provider.Settings.System.SCREEN_BRIGHTNESS,
+                        255
+                    )
+                    Pair(system / 255.0, null)
+                }
+            }
+            "setBrightness" -> {
+                val brightness = (argsMap?.get("brightness") as? Number)?.toFloat()
+                    ?: return Pair(null, IllegalArgumentException("Missing brightness"))
+                activity.runOnUiThread {
+                    val attributes = activity.window.attributes
+                    attributes.screenBrightness = brightness.coerceIn(0f, 1f)
+                    activity.window.attributes = attributes
+                }
+                Pair(null, null)
+            }
+            "resetBrightness" -> {
+                activity.runOnUiThread {
+                    val attributes = activity.window.attributes
+                    attributes.screenBrightness = WindowManager.LayoutParams.BRIGHTNESS_OVERRIDE_NONE
+                    activity.window.attributes = attributes
+                }
+                Pair(null, null)
+            }
+            "setKeepAwake" -> {
+                val enabled = argsMap?.get("enabled") as? Boolean ?: false
+                activity.runOnUiThread {
+                    if (enabled) {
+                        activity.window.addFlags(WindowManager.LayoutParams.FLAG_KEEP_SCREEN_ON)
+                    } else {
+                        activity.window.clearFlags(WindowManager.LayoutParams.FLAG_KEEP_SCREEN_ON)
+                    }
+                }
+                Pair(null, null)
+            }
+            "setOrientationLock" -> {
+                val orientation = argsMap?.get("orientation") as? String ?: "unlocked"
+                activity.runOnUiThread {
+                    val declared = declaredOrientation ?: activity.requestedOrientation.also {
+                        declaredOrientation = it
+                    }
+                    activity.requestedOrientation = when (orientation) {
+                        "portrait" -> ActivityInfo.SCREEN_ORIENTATION_SENSOR_PORTRAIT
+                        "landscape" -> ActivityInfo.SCREEN_ORIENTATION_SENSOR_LANDSCAPE
+                        else -> declared
+                    }
+                }
+                Pair(null, null)
+            }
+            "setImmersive" -> {
+                val enabled = argsMap?.get("enabled") as? Boolean ?: false
+                activity.runOnUiThread {
+                    val window = activity.window
+                    val controller = WindowInsetsControllerCompat(window, window.decorView)
+                    if (enabled) {
+                        controller.systemBarsBehavior =
+                            WindowInsetsControllerCompat.BEHAVIOR_SHOW_TRANSIENT_BARS_BY_SWIPE
+                        controller.hide(WindowInsetsCompat.Type.systemBars())
+                    } else {
+                        controller.show(WindowInsetsCompat.Type.navigationBars())
+                        if (!SystemUIHandler.statusBarHidden) {
+                            controller.show(WindowInsetsCompat.Type.statusBars())
+                        }
+                    }
+                    window.decorView.requestApplyInsets()
+                }
+                Pair(null, null)
+            }
+            else -> Pair(null, IllegalArgumentException("Unknown method: $method"))
+        }
+    }
+}
+
+// MARK: - System Gestures Handler
+
+/**
+ * Excludes areas of the Drift view from gesture navigation, so swipes that
+ * start there reach the app instead of going back.
+ */
+object SystemGesturesHandler {
+    fun handle(method: String, args: Any?): Pair<Any?, Exception?> {
+        val argsMap = args as? Map<*, *>
+
+        return when (method) {
+            "setExclusionRects" -> {
+                // Exclusion rects were added in Android 10.
+                if (Build.VERSION.SDK_INT < Build.VERSION_CODES.Q) {
+                    return Pair(null, null)
+                }
+                val view = PlatformChannelManager.hostView()
+                    ?: return Pair(null, IllegalStateException("No active view"))
+                val density = view.resources.displayMetrics.density
+                val rects = (argsMap?.get("rects") as? List<*>).orEmpty().mapNotNull { item ->
+                    val rect = item as? Map<*, *> ?: return@mapNotNull null
+                    fun edge(key: String): Float = (rect[key] as? Number)?.toFloat() ?: 0f
+                    android.graphics.Rect(
+                        (edge("left") * density).toInt(),
+                        (edge("top") * density).toInt(),
+                        Math.ceil((edge("right") * density).toDouble()).toInt(),
+                        Math.ceil((edge("bottom") * density).toDouble()).toInt()
+                    )
+                }
+                view.post {
+                    view.systemGestureExclusionRects = rects
+                }
+                Pair(null, null)
+            }
+            // Deferring edges is iOS only; Android excludes areas instead.
+            "setDeferredEdges" -> Pair(null, null)
+            else -> Pair(null, IllegalArgumentException("Unknown method: $method"))
+        }
+    }
+}
+
+// MARK: - Restoration Handler
+
+/**
+ * Keeps UI state saved by Go in the activity's instance state, so a process
+ * killed in the background can hand it to its replacement.
+ */
+object RestorationHandler {
+    private const val BUNDLE_KEY = "drift_restoration"
+
+    // Latest values saved by Go.
+    private val current = mutableMapOf<String, String>()
+
+    // Values saved by the previous process.
+    private var restored: Map<String, String> = emptyMap()
+
+    /** Loads the values saved by the previous process. Call from onCreate. */
+    @Synchronized
+    fun restore(savedInstanceState: Bundle?) {
+        val bundle = savedInstanceState?.getBundle(BUNDLE_KEY) ?: return
+        restored = bundle.keySet().associateWith { bundle.getString(it) ?: "" }
+        current.putAll(restored)
+    }
+
+    /** Writes the latest values. Call from onSaveInstanceState. */
+    @Synchronized
+    fun save(outState: Bundle) {
+        val bundle = Bundle()
+        current.forEach { (key, value) -> bundle.putString(key, value) }
+        outState.putBundle(BUNDLE_KEY, bundle)
+    }
+
+    @Synchronized
+    fun handle(method: String, args: Any?): Pair<Any?, Exception?> {
+        val argsMap = args as? Map<*, *>
+        return when (method) {
+            "getRestored" -> Pair(restored, null)
+            "save" -> {
+                val key = argsMap?.get("key") as? String
+                    ?: return Pair(null, IllegalArgumentException("Missing key"))
+                current[key] = argsMap?.get("value") as? String ?: ""
+                Pair(null, null)
+            }
+            "remove" -> {
+                val key = argsMap?.get("key") as? String
+                    ?: return Pair(null, IllegalArgumentException("Missing key"))
+                current.remove(key)
+                Pair(null, null)
+            }
+            else -> Pair(null, IllegalArgumentException("Unknown method: $method"))
+        }
+    }
+}
+
 // MARK: - Safe Area Handler
 
 object SafeAreaHandler {
@@ -674,6 +905,113 @@ object SafeAreaHandler {
     }
 }
 
+// MARK: - Keyboard Handler
+
+object KeyboardHandler {
+    private var lastBottom = -1.0
+
+    /** Sends the area covered by the on-screen keyboard. */
+    fun sendInsetsUpdate(insets: WindowInsetsCompat) {
+        val activity = PlatformChannelManager.currentActivity() ?: return
+        val density = activity.resources.displayMetrics.density
+        val ime = insets.getInsets(WindowInsetsCompat.Type.ime())
+        val bottom = (ime.bottom / density).toDouble()
+        if (bottom == lastBottom) return
+        lastBottom = bottom
+        PlatformChannelManager.sendEvent("drift/keyboard/events", mapOf(
+            "top" to 0.0,
+            "bottom" to bottom,
+            "left" to 0.0,
+            "right" to 0.0
+        ))
+    }
+}
+
+// MARK: - Hardware Key Handler
+
+object HardwareKeyHandler {
+    private val namedKeys = mapOf(
+        KeyEvent.KEYCODE_DPAD_UP to "ArrowUp",
+        KeyEvent.KEYCODE_DPAD_DOWN to "ArrowDown",
+        KeyEvent.KEYCODE_DPAD_LEFT to "ArrowLeft",
+        KeyEvent.KEYCODE_DPAD_RIGHT to "ArrowRight",
+        KeyEvent.KEYCODE_DPAD_CENTER to "Enter",
+        KeyEvent.KEYCODE_ENTER to "Enter",
+        KeyEvent.KEYCODE_NUMPAD_ENTER to "Enter",
+        KeyEvent.KEYCODE_SPACE to " ",
+        KeyEvent.KEYCODE_TAB to "Tab",
+        KeyEvent.KEYCODE_ESCAPE to "Escape",
+        KeyEvent.KEYCODE_DEL to "Backspace",
+        KeyEvent.KEYCODE_FORWARD_DEL to "Delete",
+        KeyEvent.KEYCODE_MOVE_HOME to "Home",
+        KeyEvent.KEYCODE_MOVE_END to "End",
+        KeyEvent.KEYCODE_PAGE_UP to "PageUp",
+        KeyEvent.KEYCODE_PAGE_DOWN to "PageDown"
+    )
+
+    /**
+     * Sends a hardware key event to the Go focus system. Keys without a name
+     * or a character, such as volume buttons, are not sent.
+     */
+    fun send(event: KeyEvent) {
+        val key = namedKeys[event.keyCode] ?: event.unicodeChar.takeIf { it > 0x20 }?.let {
+            String(Character.toChars(it))
+        } ?: return
+        val action = when {
+            event.action == KeyEvent.ACTION_UP -> "up"
+            event.repeatCount > 0 -> "repeat"
+            event.action == KeyEvent.ACTION_DOWN -> "down"
+            else -> return
+        }
+        PlatformChannelManager.sendEvent("drift/keys/events", mapOf(
+            "key" to key,
+            "action" to action,
+            "shift" to event.isShiftPressed,
+            "control" to event.isCtrlPressed,
+            "alt" to event.isAltPressed,
+            "meta" to event.isMetaPressed
+        ))
+    }
+}
+
+// MARK: - Appearance Handler
+
+object AppearanceHandler {
+    /**
+     * Sends the font scale and dark mode setting of the configuration, and on
+     * Android 12+ the middle tone of each system color palette (Material You).
+     */
+    fun sendState(context: Context, configuration: Configuration) {
+        val nightMode = configuration.uiMode and Configuration.UI_MODE_NIGHT_MASK
+        val state = mutableMapOf<String, Any>(
+            "textScale" to configuration.fontScale.toDouble(),
+            "dark" to (nightMode == Configuration.UI_MODE_NIGHT_YES)
+        )
+        if (Build.VERSION.SDK_INT >= Build.VERSION_CODES.S) {
+            state["dynamicColors"] = mapOf(
+                "accent1" to context.getColor(android.R.color.system_accent1_500),
+                "accent2" to context.getColor(android.R.color.system_accent2_500),
+                "accent3" to context.getColor(android.R.color.system_accent3_500),
+                "neutral1" to context.getColor(android.R.color.system_neutral1_500),
+                "neutral2" to context.getColor(android.R.color.system_neutral2_500)
+            )
+        }
+        PlatformChannelManager.sendEvent("drift/appearance/events", state)
+    }
+}
+
+// MARK: - Locale Handler
+
+object LocaleHandler {
+    /** Sends the preferred locales of the configuration, most preferred first. */
+    fun sendState(configuration: Configuration) {
+        val locales = configuration.locales
+        PlatformChannelManager.sendEvent("drift/locale/events", mapOf(
+            "locales" to (0 until locales.size()).map { locales[it].toLanguageTag() }
+        ))
+    }
+}
+
 // MARK: - URL Launcher Handler
 
 object URLLauncherHandler {
@@ -30,7 +30,7 @@ object PlatformViewHandler {
     private val textInputMethods = setOf("setText", "setSelection", "setValue", "focus", "blur", "updateConfig")
     private val switchMethods = setOf("setValue", "updateConfig")
     private val activityIndicatorMethods = setOf("setAnimating", "updateConfig")
-    private val videoPlayerMethods = setOf("play", "pause", "stop", "seekTo", "setVolume", "setLooping", "setPlaybackSpeed", "setShowControls", "load")
+    private val videoPlayerMethods = setOf("play", "pause", "stop", "seekTo", "setVolume", "setLooping", "setPlaybackSpeed", "setShowControls", "load", "selectSubtitleTrack", "selectQuality", "setQualityLimit", "setTextureFallback")
 
     fun init(context: Context, hostView: ViewGroup, surfaceView: View, overlayController: InputOverlayController) {
         this.context = context
@@ -195,7 +195,25 @@ object PlatformViewHandler {
                         "load" -> {
                             val url = args["url"] as? String
                             if (url != null) {
-                                container.load(url)
+                                container.load(url, args["drm"] as? Map<*, *>)
+                            }
+                        }
+                        "selectSubtitleTrack" -> {
+                            container.selectSubtitleTrack(args["id"] as? String ?: "")
+                        }
+                        "selectQuality" -> {
+                            container.selectQuality(args["id"] as? String ?: "")
+                        }
+                        "setQualityLimit" -> {
+                            val maxHeight = (args["maxHeight"] as? Number)?.toInt() ?: 0
+                            val maxBitrate = (args["maxBitrate"] as? Number)?.toInt() ?: 0
+                            container.setQualityLimit(maxHeight, maxBitrate)
+                        }
+                        "setTextureFallback" -> {
+                            container.setTextureFallback(args["active"] as? Boolean ?: false)
+                            interceptors[viewId]?.let {
+                                it.supportsRegionMask = container.supportsRegionMask
+                                if (!it.supportsRegionMask) it.clearRegionClip()
                             }
                         }
                     }
@@ -224,6 +242,7 @@ object PlatformViewHandler {
             "switch" -> { { NativeSwitchContainer(ctx, viewId, params) } }
             "activity_indicator" -> { { NativeActivityIndicatorContainer(ctx, viewId, params) } }
             "video_player" -> { { NativeVideoPlayerContainer(ctx, viewId, params) } }
+            "airplay_button" -> { { NativeAirPlayButtonContainer(ctx, viewId) } }
             else -> null
         }
 
@@ -317,7 +336,8 @@ interface PlatformViewContainer {
     val viewId: Int
     val view: View
     /** Whether this view supports region masking (Path-based clip for partial occlusion).
-     *  TextureView-backed views return true; SurfaceView-backed views return false. */
+     *  TextureView-backed views return true; SurfaceView-backed views return false.
+     *  Re-read after a video player's texture fallback changes. */
     val supportsRegionMask: Boolean get() = false
     fun dispose()
 }
@@ -0,0 +1,38 @@
+/**
+ * PowerHandler.kt
+ * Reports the system battery saver state to the Drift engine.
+ */
+package {{.PackageName}}
+
+import android.content.BroadcastReceiver
+import android.content.Context
+import android.content.Intent
+import android.content.IntentFilter
+import android.os.PowerManager
+
+object PowerHandler {
+    private var receiver: BroadcastReceiver? = null
+
+    /**
+     * Sends the current battery saver state and listens for changes.
+     */
+    fun initialize(context: Context) {
+        val appContext = context.applicationContext
+        val powerManager = appContext.getSystemService(Context.POWER_SERVICE) as? PowerManager ?: return
+
+        sendState(powerManager.isPowerSaveMode)
+
+        if (receiver != null) return
+        val newReceiver = object : BroadcastReceiver() {
+            override fun onReceive(context: Context, intent: Intent) {
+                sendState(powerManager.isPowerSaveMode)
+            }
+        }
+        appContext.registerReceiver(newReceiver, IntentFilter(PowerManager.ACTION_POWER_SAVE_MODE_CHANGED))
+        receiver = newReceiver
+    }
+
+    private fun sendState(lowPowerMode: Boolean) {
+        PlatformChannelManager.sendEvent("drift/power/events", mapOf("lowPowerMode" to lowPowerMode))
+    }
+}
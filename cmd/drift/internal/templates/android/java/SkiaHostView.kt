@@ -16,7 +16,9 @@
  */
 package {{.PackageName}}
 
+import android.app.Activity
 import android.content.Context
+import android.content.pm.ActivityInfo
 import android.graphics.Bitmap
 import android.graphics.Canvas
 import android.graphics.ColorSpace
@@ -24,6 +26,7 @@ import android.os.Handler
 import android.os.HandlerThread
 import android.util.Log
 import android.view.MotionEvent
+import android.view.PointerIcon
 import android.view.View
 
 class SkiaHostView(context: Context) : View(context), DriftSkiaHost {
@@ -37,6 +40,9 @@ class SkiaHostView(context: Context) : View(context), DriftSkiaHost {
     private var hwBitmaps: Array<Bitmap?> = arrayOfNulls(2)
     private var currentBitmapIndex = 0
 
+    // Color space the bitmaps are tagged with (0 = sRGB, 1 = Display P3)
+    private var surfaceColorSpace = 0
+
     @Volatile override var surfaceWidth = 0
         private set
     @Volatile override var surfaceHeight = 0
@@ -116,6 +122,8 @@ class SkiaHostView(context: Context) : View(context), DriftSkiaHost {
             NativeBridge.purgeResources()
         }
 
+        updateColorSpace()
+
         val slotIndex = NativeBridge.renderFrameSync(w, h)
         if (slotIndex < 0) {
             Log.e(TAG, "renderFrameSync failed: $slotIndex")
@@ -127,6 +135,21 @@ class SkiaHostView(context: Context) : View(context), DriftSkiaHost {
         invalidate()
     }
 
+    /**
+     * Renders in Display P3 when the app prefers it and the display supports
+     * it, otherwise sRGB. On a change, switches the window's color mode and
+     * re-tags the bitmaps so HWUI interprets the rendered pixels correctly.
+     */
+    private fun updateColorSpace() {
+        val wideGamut = if (display?.isWideColorGamut == true) 1 else 0
+        val space = NativeBridge.surfaceColorSpace(wideGamut)
+        if (space == surfaceColorSpace) return
+        surfaceColorSpace = space
+        (context as? Activity)?.window?.colorMode =
+            if (space == 1) ActivityInfo.COLOR_MODE_WIDE_COLOR_GAMUT else ActivityInfo.COLOR_MODE_DEFAULT
+        wrapBitmaps()
+    }
+
     override fun onDraw(canvas: Canvas) {
         hwBitmaps[currentBitmapIndex]?.let { bitmap ->
             canvas.drawBitmap(bitmap, 0f, 0f, null)
@@ -159,6 +182,15 @@ class SkiaHostView(context: Context) : View(context), DriftSkiaHost {
             return
         }
 
+        wrapBitmaps()
+        currentBitmapIndex = 0
+        Log.i(TAG, "HWB bitmaps created (double-buffered): ${w}x${h}")
+    }
+
+    private fun wrapBitmaps() {
+        val colorSpace = ColorSpace.get(
+            if (surfaceColorSpace == 1) ColorSpace.Named.DISPLAY_P3 else ColorSpace.Named.SRGB
+        )
         for (i in hwBitmaps.indices) {
             hwBitmaps[i]?.recycle()
             val hwb = NativeBridge.getHardwareBuffer(i)
@@ -167,11 +199,9 @@ class SkiaHostView(context: Context) : View(context), DriftSkiaHost {
                 hwBitmaps[i] = null
                 continue
             }
-            hwBitmaps[i] = Bitmap.wrapHardwareBuffer(hwb, ColorSpace.get(ColorSpace.Named.SRGB))
+            hwBitmaps[i] = Bitmap.wrapHardwareBuffer(hwb, colorSpace)
             hwb.close()
         }
-        currentBitmapIndex = 0
-        Log.i(TAG, "HWB bitmaps created (double-buffered): ${w}x${h}")
     }
 
     private fun initEngine(): Boolean {
@@ -263,6 +293,19 @@ class SkiaHostView(context: Context) : View(context), DriftSkiaHost {
         return super.dispatchHoverEvent(event)
     }
 
+    // Mouse cursor
+
+    override fun onResolvePointerIcon(event: MotionEvent, pointerIndex: Int): PointerIcon? {
+        // Values match layout.MouseCursor.
+        val type = when (NativeBridge.mouseCursor(event.getX(pointerIndex).toDouble(), event.getY(pointerIndex).toDouble())) {
+            1 -> PointerIcon.TYPE_HAND
+            2 -> PointerIcon.TYPE_HORIZONTAL_DOUBLE_ARROW
+            3 -> PointerIcon.TYPE_VERTICAL_DOUBLE_ARROW
+            else -> return super.onResolvePointerIcon(event, pointerIndex)
+        }
+        return PointerIcon.getSystemIcon(context, type)
+    }
+
     private fun updateDeviceScale() {
         val density = resources.displayMetrics.density.toDouble()
         NativeBridge.setDeviceScale(density)
@@ -31,6 +31,9 @@ class UnifiedFrameOrchestrator(
     private val frameScheduled = AtomicBoolean(false)
     private val mainHandler = Handler(Looper.getMainLooper())
 
+    /** Invoked once, on the UI thread, after the first Drift frame is rendered. */
+    var onFirstFrame: (() -> Unit)? = null
+
     private val postFrameRunnable = Runnable {
         if (active) {
             Choreographer.getInstance().postFrameCallback(this)
@@ -47,6 +50,10 @@ class UnifiedFrameOrchestrator(
         val h = skiaHost.surfaceHeight
         if (w <= 0 || h <= 0) return
 
+        // Skip frames the engine does not need (e.g. throttled by power saver).
+        // The engine schedules a new frame when work arrives.
+        if (NativeBridge.needsFrame() == 0) return
+
         // 1. Step engine pipeline, get geometry snapshot
         val snapshotBytes = NativeBridge.stepAndSnapshot(w, h)
 
@@ -61,6 +68,14 @@ class UnifiedFrameOrchestrator(
         // 3. Render Skia into HardwareBuffer + present
         skiaHost.renderFrame()
 
+        // Hand off from the launch screen in the same traversal as the frame
+        onFirstFrame?.let { callback ->
+            if (NativeBridge.firstFrameRasterized() != 0) {
+                onFirstFrame = null
+                callback()
+            }
+        }
+
         // 4. Continue animation if needed
         if (NativeBridge.needsFrame() != 0) {
             scheduleFrame()
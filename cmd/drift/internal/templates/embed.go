@@ -21,6 +21,27 @@ type TemplateInput struct {
 	IOSBundleID    string
 	Orientation    string
 	AllowHTTP      bool
+	Locales        []string // locales with an InfoPlist.strings file, in order
+	Version        string   // app version; defaults to "1.0"
+	BuildNumber    int      // build number; defaults to 1
+	Fonts          []TemplateFont
+	DisplayFamily  string // family of display, headline, and title styles
+	BodyFamily     string // family of body and label styles
+	FontFallbacks  []string
+}
+
+// TemplateFont is a font file embedded in the app binary.
+type TemplateFont struct {
+	Family string
+	Path   string // relative to the project root, slash-separated
+	Weight int    // 100 to 900
+	Italic bool
+}
+
+// TemplateLocale is a localization listed in the Xcode project.
+type TemplateLocale struct {
+	Code    string // e.g., "pt-BR"
+	FileRef string // object ID of the locale's InfoPlist.strings
 }
 
 // TemplateData contains the data for template substitution.
@@ -33,11 +54,27 @@ type TemplateData struct {
 	URLScheme   string // e.g., "my-app"
 	Orientation string // "portrait", "landscape", or "all"
 	AllowHTTP   bool   // allow cleartext HTTP traffic
+	Locales     []TemplateLocale
+	Version     string // e.g., "1.4.2"
+	BuildNumber int    // e.g., 42
+	// Fonts, DisplayFamily, BodyFamily, and FontFallbacks render the
+	// typography manifest bridge file.
+	Fonts         []TemplateFont
+	DisplayFamily string
+	BodyFamily    string
+	FontFallbacks []string
 }
 
 // NewTemplateData creates template data from the given input, deriving
 // JNI-safe names, package paths, and URL schemes automatically.
 func NewTemplateData(in TemplateInput) *TemplateData {
+	version, build := in.Version, in.BuildNumber
+	if version == "" {
+		version = "1.0"
+	}
+	if build == 0 {
+		build = 1
+	}
 	return &TemplateData{
 		AppName:     in.AppName,
 		PackageName: in.AndroidPackage,
@@ -47,7 +84,24 @@ func NewTemplateData(in TemplateInput) *TemplateData {
 		URLScheme:   sanitizeURLScheme(in.AppName),
 		Orientation: in.Orientation,
 		AllowHTTP:   in.AllowHTTP,
+		Locales:     templateLocales(in.Locales),
+		Version:     version,
+		BuildNumber: build,
+
+		Fonts:         in.Fonts,
+		DisplayFamily: in.DisplayFamily,
+		BodyFamily:    in.BodyFamily,
+		FontFallbacks: in.FontFallbacks,
+	}
+}
+
+// templateLocales assigns each locale a stable Xcode object ID.
+func templateLocales(codes []string) []TemplateLocale {
+	locales := make([]TemplateLocale, len(codes))
+	for i, code := range codes {
+		locales[i] = TemplateLocale{Code: code, FileRef: fmt.Sprintf("A11111111111111111117%03d", i)}
 	}
+	return locales
 }
 
 func sanitizeURLScheme(appName string) string {
@@ -1,6 +1,8 @@
 package templates
 
 import (
+	"go/parser"
+	"go/token"
 	"strings"
 	"testing"
 )
@@ -27,3 +29,131 @@ func TestIOSPlatformView_OnViewCreatedSentAfterInterceptorAttach(t *testing.T) {
 		t.Fatalf("onViewCreated appears before interceptor attachment (onViewCreated=%d, addSubview=%d)", onCreatedIdx, addSubviewIdx)
 	}
 }
+
+func TestXcodeProject_Localizations(t *testing.T) {
+	content, err := ReadFile("xcodeproj/project.pbxproj.tmpl")
+	if err != nil {
+		t.Fatalf("ReadFile(xcodeproj/project.pbxproj.tmpl) failed: %v", err)
+	}
+
+	plain, err := ProcessTemplate(string(content), NewTemplateData(TemplateInput{AppName: "demo"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(plain, "InfoPlist.strings") {
+		t.Error("expected no InfoPlist.strings without locales")
+	}
+
+	localized, err := ProcessTemplate(string(content), NewTemplateData(TemplateInput{AppName: "demo", Locales: []string{"en", "pt-BR"}}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		"/* Begin PBXVariantGroup section */",
+		`path = "pt-BR.lproj/InfoPlist.strings";`,
+		"InfoPlist.strings in Resources",
+		"\"pt-BR\",\n",
+	} {
+		if !strings.Contains(localized, want) {
+			t.Errorf("expected %q in the rendered project", want)
+		}
+	}
+}
+
+func TestXtoolProject_Localizations(t *testing.T) {
+	data := NewTemplateData(TemplateInput{AppName: "demo", Locales: []string{"en", "pt-BR"}})
+	for _, tc := range []struct {
+		path string
+		want []string
+	}{
+		{"xtool/Package.swift.tmpl", []string{`"Resources/AppIcon.png", "Resources/en.lproj", "Resources/pt-BR.lproj"]`}},
+		{"xtool/xtool.yml.tmpl", []string{"\nresources:\n  - Sources/Runner/Resources/en.lproj\n  - Sources/Runner/Resources/pt-BR.lproj\n"}},
+	} {
+		content, err := ReadFile(tc.path)
+		if err != nil {
+			t.Fatalf("ReadFile(%s) failed: %v", tc.path, err)
+		}
+		plain, err := ProcessTemplate(string(content), NewTemplateData(TemplateInput{AppName: "demo"}))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if strings.Contains(plain, ".lproj") {
+			t.Errorf("%s: expected no .lproj without locales", tc.path)
+		}
+		localized, err := ProcessTemplate(string(content), data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, want := range tc.want {
+			if !strings.Contains(localized, want) {
+				t.Errorf("%s: expected %q in\n%s", tc.path, want, localized)
+			}
+		}
+	}
+}
+
+func TestLaunchScreen_FallsBackToSystemBackground(t *testing.T) {
+	// xtool builds have no asset catalog, so the storyboard's own
+	// LaunchBackground value is used and must follow dark mode.
+	content, err := ReadFile("ios/LaunchScreen.storyboard")
+	if err != nil {
+		t.Fatalf("ReadFile(ios/LaunchScreen.storyboard) failed: %v", err)
+	}
+	want := "<namedColor name=\"LaunchBackground\">\n            <color systemColor=\"systemBackgroundColor\"/>"
+	if !strings.Contains(string(content), want) {
+		t.Errorf("expected the LaunchBackground fallback to be systemBackgroundColor")
+	}
+}
+
+func TestBridgeTypography(t *testing.T) {
+	content, err := ReadFile("bridge/typography.go.tmpl")
+	if err != nil {
+		t.Fatalf("ReadFile(bridge/typography.go.tmpl) failed: %v", err)
+	}
+
+	plain, err := ProcessTemplate(string(content), NewTemplateData(TemplateInput{AppName: "demo"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.TrimSpace(plain) != "package main" {
+		t.Errorf("expected an empty package without fonts, got:\n%s", plain)
+	}
+
+	bundled, err := ProcessTemplate(string(content), NewTemplateData(TemplateInput{
+		AppName: "demo",
+		Fonts: []TemplateFont{
+			{Family: "Inter", Path: "assets/fonts/Inter-Regular.ttf", Weight: 400},
+			{Family: "Inter", Path: "assets/fonts/Inter Bold Italic.ttf", Weight: 700, Italic: true},
+		},
+		BodyFamily: "Inter",
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := parser.ParseFile(token.NewFileSet(), "typography.go", bundled, parser.ParseComments); err != nil {
+		t.Fatalf("rendered file does not parse: %v\n%s", err, bundled)
+	}
+	fallbacks, err := ProcessTemplate(string(content), NewTemplateData(TemplateInput{
+		AppName:       "demo",
+		FontFallbacks: []string{"Noto Sans CJK JP"},
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := parser.ParseFile(token.NewFileSet(), "typography.go", fallbacks, parser.ParseComments); err != nil {
+		t.Fatalf("rendered file does not parse: %v\n%s", err, fallbacks)
+	}
+	if strings.Contains(fallbacks, "pkg/graphics") || !strings.Contains(fallbacks, `"Noto Sans CJK JP",`) {
+		t.Errorf("expected only the fallback chain without fonts:\n%s", fallbacks)
+	}
+
+	for _, want := range []string{
+		"//go:embed \"assets/fonts/Inter Bold Italic.ttf\"\nvar driftFont1 []byte",
+		`{Family: "Inter", Weight: 700, Style: graphics.FontStyleItalic, Data: driftFont1}`,
+		`BodyFamily:    "Inter"`,
+	} {
+		if !strings.Contains(bundled, want) {
+			t.Errorf("expected %q in the rendered file:\n%s", want, bundled)
+		}
+	}
+}
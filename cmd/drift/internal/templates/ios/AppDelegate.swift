@@ -90,6 +90,16 @@ class AppDelegate: UIResponder, UIApplicationDelegate {
         completionHandler(.newData)
     }
 
+    /// Called when iOS relaunches the app to deliver background download
+    /// events. The handler is called once the session has delivered them.
+    func application(
+        _ application: UIApplication,
+        handleEventsForBackgroundURLSession identifier: String,
+        completionHandler: @escaping () -> Void
+    ) {
+        DownloadHandler.handleBackgroundEvents(completionHandler: completionHandler)
+    }
+
     /// Provides the configuration for a new scene session.
     ///
     /// Called when the system is about to create a new scene (window). This method
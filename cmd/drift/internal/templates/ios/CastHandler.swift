@@ -0,0 +1,149 @@
+/// CastHandler.swift
+/// Provides AirPlay route discovery and session state for the Drift platform channel.
+
+import UIKit
+import AVKit
+import AVFoundation
+
+// MARK: - Cast Handler
+
+/// Reports AirPlay availability and sessions to Go. iOS does not let apps
+/// list or select AirPlay receivers, so discovery only reports whether any
+/// are available, and sessions are started by the user from the route
+/// picker or Control Center. AVPlayer moves video to the receiver itself.
+enum CastHandler {
+    private static let kindAirPlay = 1
+    private static var detector: AVRouteDetector?
+    private static var detectorObserver: NSObjectProtocol?
+    private static var routeObserver: NSObjectProtocol?
+    private static var lastRouteId: String?
+
+    static func handle(method: String, args: Any?) -> (Any?, Error?) {
+        DispatchQueue.main.async { observeRoutes() }
+        switch method {
+        case "startDiscovery":
+            DispatchQueue.main.async { startDiscovery() }
+            return (nil, nil)
+        case "stopDiscovery":
+            DispatchQueue.main.async { stopDiscovery() }
+            return (nil, nil)
+        case "getSession":
+            return (sessionMap(), nil)
+        case "connect", "disconnect":
+            return (nil, NSError(domain: "Cast", code: 400, userInfo: [NSLocalizedDescriptionKey: "AirPlay receivers are chosen by the user with the AirPlay button"]))
+        default:
+            return (nil, NSError(domain: "Cast", code: 404, userInfo: [NSLocalizedDescriptionKey: "Unknown method: \(method)"]))
+        }
+    }
+
+    /// Starts reporting AirPlay sessions on "drift/cast/session". Called when
+    /// the first video player is created, since the user can start a session
+    /// before Go uses the cast channel.
+    static func observeRoutes() {
+        guard routeObserver == nil else { return }
+        lastRouteId = airPlayOutput()?.uid
+        routeObserver = NotificationCenter.default.addObserver(
+            forName: AVAudioSession.routeChangeNotification,
+            object: nil,
+            queue: .main
+        ) { _ in
+            // Route changes also fire for headphones and speakers.
+            let routeId = airPlayOutput()?.uid
+            guard routeId != lastRouteId else { return }
+            lastRouteId = routeId
+            PlatformChannelManager.shared.sendEvent(channel: "drift/cast/session", data: sessionMap())
+        }
+    }
+
+    /// Returns the name of the AirPlay receiver audio is routed to, or an
+    /// empty string if there is none.
+    static func airPlayDeviceName() -> String {
+        return airPlayOutput()?.portName ?? ""
+    }
+
+    private static func airPlayOutput() -> AVAudioSessionPortDescription? {
+        return AVAudioSession.sharedInstance().currentRoute.outputs.first { $0.portType == .airPlay }
+    }
+
+    private static func sessionMap() -> [String: Any] {
+        guard let output = airPlayOutput() else {
+            return ["state": 0, "device": NSNull()]
+        }
+        return [
+            "state": 2,
+            "device": [
+                "id": output.uid,
+                "name": output.portName,
+                "kind": kindAirPlay
+            ]
+        ]
+    }
+
+    private static func startDiscovery() {
+        if detector == nil {
+            let routeDetector = AVRouteDetector()
+            detectorObserver = NotificationCenter.default.addObserver(
+                forName: .AVRouteDetectorMultipleRoutesDetectedDidChange,
+                object: routeDetector,
+                queue: .main
+            ) { _ in
+                sendAvailability()
+            }
+            detector = routeDetector
+        }
+        detector?.isRouteDetectionEnabled = true
+        sendAvailability()
+    }
+
+    private static func stopDiscovery() {
+        detector?.isRouteDetectionEnabled = false
+    }
+
+    private static func sendAvailability() {
+        PlatformChannelManager.shared.sendEvent(
+            channel: "drift/cast/discovery",
+            data: [
+                "devices": [[String: Any]](),
+                "routesAvailable": detector?.multipleRoutesDetected ?? false
+            ]
+        )
+    }
+}
+
+// MARK: - AirPlay Button
+
+/// Platform view container for the AirPlay route picker. Tapping it shows
+/// the system list of receivers.
+class NativeAirPlayButtonContainer: NSObject, PlatformViewContainer {
+    let viewId: Int
+    let view: UIView
+    private let picker: AVRoutePickerView
+
+    init(viewId: Int, params: [String: Any]) {
+        self.viewId = viewId
+        let picker = AVRoutePickerView()
+        picker.prioritizesVideoDevices = true
+        picker.backgroundColor = .clear
+        self.picker = picker
+        self.view = picker
+
+        super.init()
+
+        updateConfig(params)
+    }
+
+    func dispose() {
+        view.removeFromSuperview()
+    }
+
+    func updateConfig(_ params: [String: Any]) {
+        // Colors arrive as NSNumber from JSON/MessagePack; zero keeps the
+        // system default.
+        if let colorNumber = params["tintColor"] as? NSNumber, colorNumber.uint32Value != 0 {
+            picker.tintColor = UIColor(argb: colorNumber.uint32Value)
+        }
+        if let colorNumber = params["activeTintColor"] as? NSNumber, colorNumber.uint32Value != 0 {
+            picker.activeTintColor = UIColor(argb: colorNumber.uint32Value)
+        }
+    }
+}
@@ -0,0 +1,423 @@
+/// DownloadHandler.swift
+/// Handles resumable background downloads using a background URLSession for the Drift platform channel.
+
+import UIKit
+import UserNotifications
+
+// MARK: - Download Handler
+
+/// Runs downloads in a background URLSession, which keeps transferring
+/// while the app is suspended or terminated and relaunches the app in the
+/// background when they finish.
+///
+/// Task state is persisted in UserDefaults. Events are sent to Go only once
+/// Go has called into the download channel in this process; terminal events
+/// that happen before then (for example during a background relaunch) are
+/// kept as pending completions.
+final class DownloadHandler: NSObject {
+    static let shared = DownloadHandler()
+
+    private static let statusQueued = 0
+    private static let statusRunning = 1
+    private static let statusPaused = 2
+    private static let statusCompleted = 3
+    private static let statusFailed = 4
+    private static let statusCanceled = 5
+
+    private static let tasksKey = "drift_downloads_tasks"
+    private static let pendingKey = "drift_downloads_pending"
+    private static let resumeDataPrefix = "drift_downloads_resume_"
+    private static let progressInterval: TimeInterval = 0.25
+
+    private let lock = NSLock()
+    private var goAttached = false
+    private var lastProgress: [String: Date] = [:]
+    private var backgroundCompletionHandler: (() -> Void)?
+
+    private lazy var session: URLSession = {
+        let identifier = "\(Bundle.main.bundleIdentifier ?? "com.drift").downloads"
+        let config = URLSessionConfiguration.background(withIdentifier: identifier)
+        config.sessionSendsLaunchEvents = true
+        config.isDiscretionary = false
+        return URLSession(configuration: config, delegate: self, delegateQueue: nil)
+    }()
+
+    static func handle(method: String, args: Any?) -> (Any?, Error?) {
+        return shared.handle(method: method, args: args)
+    }
+
+    /// Reconnects to the background session so finished transfers are
+    /// delivered. Called by the AppDelegate when iOS relaunches the app for
+    /// download events.
+    static func handleBackgroundEvents(completionHandler: @escaping () -> Void) {
+        shared.backgroundCompletionHandler = completionHandler
+        _ = shared.session
+    }
+
+    private func handle(method: String, args: Any?) -> (Any?, Error?) {
+        lock.lock()
+        goAttached = true
+        lock.unlock()
+        _ = session
+
+        let id = (args as? [String: Any])?["id"] as? String
+        switch method {
+        case "enqueue":
+            return enqueue(args: args)
+        case "pause", "resume", "cancel", "remove":
+            guard let id = id, var task = loadTask(id) else {
+                return (nil, error(400, "Unknown download: \(id ?? "")"))
+            }
+            switch method {
+            case "pause": pause(&task)
+            case "resume": resume(&task)
+            case "cancel": cancel(&task)
+            default: remove(task)
+            }
+            return (nil, nil)
+        case "getTask":
+            return (id.flatMap { loadTask($0) }.map { publicTask($0) }, nil)
+        case "getTasks":
+            return (loadTasks().values.map { publicTask($0) }, nil)
+        case "takePendingCompletions":
+            return (takePending(), nil)
+        default:
+            return (nil, error(404, "Unknown method: \(method)"))
+        }
+    }
+
+    // MARK: - Operations
+
+    private func enqueue(args: Any?) -> (Any?, Error?) {
+        guard let dict = args as? [String: Any],
+              let urlString = dict["url"] as? String,
+              let url = URL(string: urlString) else {
+            return (nil, error(400, "Missing or invalid url"))
+        }
+        let id = (dict["id"] as? String).flatMap { $0.isEmpty ? nil : $0 } ?? UUID().uuidString
+        let requestedName = (dict["fileName"] as? String).flatMap { $0.isEmpty ? nil : $0 }
+        let fileName = (requestedName ?? (url.lastPathComponent.isEmpty ? id : url.lastPathComponent) as NSString).lastPathComponent
+
+        // Replace an existing download with the same ID.
+        if let existing = loadTask(id) {
+            stopTransfer(existing, keepResumeData: false)
+        }
+
+        let dir = Self.downloadsDirectory()
+        var task: [String: Any] = [
+            "id": id,
+            "url": urlString,
+            "path": dir.appendingPathComponent(fileName).path,
+            "headers": dict["headers"] as? [String: Any] ?? [:],
+            "wifiOnly": dict["wifiOnly"] as? Bool ?? false,
+            "notificationTitle": dict["notificationTitle"] as? String ?? "",
+            "status": Self.statusQueued,
+            "bytesDownloaded": 0,
+            "totalBytes": -1,
+            "error": ""
+        ]
+        start(&task, resumeData: nil)
+        return (publicTask(task), nil)
+    }
+
+    private func pause(_ task: inout [String: Any]) {
+        guard !isTerminal(task), task["status"] as? Int != Self.statusPaused else { return }
+        task["status"] = Self.statusPaused
+        saveTask(task)
+        stopTransfer(task, keepResumeData: true)
+        notifyChanged(task)
+    }
+
+    private func resume(_ task: inout [String: Any]) {
+        let status = task["status"] as? Int
+        guard status == Self.statusPaused || status == Self.statusFailed else { return }
+        let id = task["id"] as? String ?? ""
+        let resumeData = UserDefaults.standard.data(forKey: Self.resumeDataPrefix + id)
+        UserDefaults.standard.removeObject(forKey: Self.resumeDataPrefix + id)
+        task["error"] = ""
+        start(&task, resumeData: resumeData)
+        notifyChanged(task)
+    }
+
+    private func cancel(_ task: inout [String: Any]) {
+        guard !isTerminal(task) else { return }
+        task["status"] = Self.statusCanceled
+        saveTask(task)
+        stopTransfer(task, keepResumeData: false)
+        notifyChanged(task)
+    }
+
+    private func remove(_ task: [String: Any]) {
+        let id = task["id"] as? String ?? ""
+        lock.lock()
+        var tasks = loadTasksLocked()
+        tasks.removeValue(forKey: id)
+        writeTasksLocked(tasks)
+        lock.unlock()
+        stopTransfer(task, keepResumeData: false)
+        if let path = task["path"] as? String {
+            try? FileManager.default.removeItem(atPath: path)
+        }
+    }
+
+    private func start(_ task: inout [String: Any], resumeData: Data?) {
+        let id = task["id"] as? String ?? ""
+        let transfer: URLSessionDownloadTask
+        if let resumeData = resumeData {
+            transfer = session.downloadTask(withResumeData: resumeData)
+        } else {
+            var request = URLRequest(url: URL(string: task["url"] as? String ?? "")!)
+            for (key, value) in task["headers"] as? [String: Any] ?? [:] {
+                if let value = value as? String {
+                    request.setValue(value, forHTTPHeaderField: key)
+                }
+            }
+            if task["wifiOnly"] as? Bool == true {
+                // Waits for an unmetered network rather than failing.
+                request.allowsCellularAccess = false
+                request.allowsExpensiveNetworkAccess = false
+            }
+            transfer = session.downloadTask(with: request)
+        }
+        transfer.taskDescription = id
+        task["status"] = Self.statusQueued
+        saveTask(task)
+        transfer.resume()
+    }
+
+    private func stopTransfer(_ task: [String: Any], keepResumeData: Bool) {
+        guard let id = task["id"] as? String else { return }
+        if !keepResumeData {
+            UserDefaults.standard.removeObject(forKey: Self.resumeDataPrefix + id)
+        }
+        session.getAllTasks { transfers in
+            for transfer in transfers where transfer.taskDescription == id {
+                if keepResumeData, let download = transfer as? URLSessionDownloadTask {
+                    download.cancel { data in
+                        if let data = data {
+                            UserDefaults.standard.set(data, forKey: Self.resumeDataPrefix + id)
+                        }
+                    }
+                } else {
+                    transfer.cancel()
+                }
+            }
+        }
+    }
+
+    // MARK: - Persistence
+
+    private static func downloadsDirectory() -> URL {
+        let base = FileManager.default.urls(for: .applicationSupportDirectory, in: .userDomainMask)[0]
+        let dir = base.appendingPathComponent("downloads", isDirectory: true)
+        try? FileManager.default.createDirectory(at: dir, withIntermediateDirectories: true)
+        return dir
+    }
+
+    private func loadTasksLocked() -> [String: [String: Any]] {
+        guard let data = UserDefaults.standard.data(forKey: Self.tasksKey),
+              let tasks = try? JSONSerialization.jsonObject(with: data) as? [String: [String: Any]] else {
+            return [:]
+        }
+        return tasks
+    }
+
+    private func writeTasksLocked(_ tasks: [String: [String: Any]]) {
+        if let data = try? JSONSerialization.data(withJSONObject: tasks) {
+            UserDefaults.standard.set(data, forKey: Self.tasksKey)
+        }
+    }
+
+    private func loadTasks() -> [String: [String: Any]] {
+        lock.lock()
+        defer { lock.unlock() }
+        return loadTasksLocked()
+    }
+
+    private func loadTask(_ id: String) -> [String: Any]? {
+        return loadTasks()[id]
+    }
+
+    private func saveTask(_ task: [String: Any]) {
+        guard let id = task["id"] as? String else { return }
+        lock.lock()
+        var tasks = loadTasksLocked()
+        // A task removed while its transfer was stopping stays removed.
+        if tasks[id] != nil || task["status"] as? Int == Self.statusQueued {
+            tasks[id] = task
+            writeTasksLocked(tasks)
+        }
+        lock.unlock()
+    }
+
+    private func takePending() -> [[String: Any]] {
+        lock.lock()
+        defer { lock.unlock() }
+        guard let data = UserDefaults.standard.data(forKey: Self.pendingKey),
+              let pending = try? JSONSerialization.jsonObject(with: data) as? [[String: Any]] else {
+            return []
+        }
+        UserDefaults.standard.removeObject(forKey: Self.pendingKey)
+        return pending
+    }
+
+    private func addPending(_ task: [String: Any]) {
+        lock.lock()
+        defer { lock.unlock() }
+        var pending: [[String: Any]] = []
+        if let data = UserDefaults.standard.data(forKey: Self.pendingKey),
+           let existing = try? JSONSerialization.jsonObject(with: data) as? [[String: Any]] {
+            pending = existing.filter { $0["id"] as? String != task["id"] as? String }
+        }
+        pending.append(publicTask(task))
+        if let data = try? JSONSerialization.data(withJSONObject: pending) {
+            UserDefaults.standard.set(data, forKey: Self.pendingKey)
+        }
+    }
+
+    // MARK: - Reporting
+
+    private func isTerminal(_ task: [String: Any]) -> Bool {
+        let status = task["status"] as? Int
+        return status == Self.statusCompleted || status == Self.statusFailed || status == Self.statusCanceled
+    }
+
+    /// Returns the fields of a task that are reported to Go.
+    private func publicTask(_ task: [String: Any]) -> [String: Any] {
+        return [
+            "id": task["id"] ?? "",
+            "url": task["url"] ?? "",
+            "path": task["path"] ?? "",
+            "status": task["status"] ?? Self.statusQueued,
+            "bytesDownloaded": task["bytesDownloaded"] ?? 0,
+            "totalBytes": task["totalBytes"] ?? -1,
+            "error": task["error"] ?? ""
+        ]
+    }
+
+    /// Reports a task change to Go. Terminal changes that Go cannot receive
+    /// yet are kept for takePendingCompletions.
+    private func notifyChanged(_ task: [String: Any]) {
+        lock.lock()
+        let attached = goAttached
+        lock.unlock()
+        if attached {
+            PlatformChannelManager.shared.sendEvent(channel: "drift/downloads/events", data: publicTask(task))
+        } else if isTerminal(task) {
+            addPending(task)
+        }
+        let status = task["status"] as? Int
+        if status == Self.statusCompleted || status == Self.statusFailed {
+            showFinishedNotification(task)
+        }
+    }
+
+    /// Updates a task from its transfer, unless it was paused, canceled, or
+    /// removed in the meantime.
+    private func update(_ id: String, _ apply: (inout [String: Any]) -> Void) {
+        guard var task = loadTask(id) else { return }
+        let status = task["status"] as? Int
+        guard status == Self.statusQueued || status == Self.statusRunning else { return }
+        apply(&task)
+        saveTask(task)
+        notifyChanged(task)
+    }
+
+    private func showFinishedNotification(_ task: [String: Any]) {
+        guard let title = task["notificationTitle"] as? String, !title.isEmpty else { return }
+        let content = UNMutableNotificationContent()
+        content.title = title
+        content.body = task["status"] as? Int == Self.statusCompleted ? "Download complete" : "Download failed"
+        let request = UNNotificationRequest(
+            identifier: "drift_download_\(task["id"] as? String ?? "")",
+            content: content,
+            trigger: nil
+        )
+        UNUserNotificationCenter.current().add(request)
+    }
+
+    private func error(_ code: Int, _ message: String) -> NSError {
+        return NSError(domain: "Downloads", code: code, userInfo: [NSLocalizedDescriptionKey: message])
+    }
+}
+
+// MARK: - URLSessionDownloadDelegate
+
+extension DownloadHandler: URLSessionDownloadDelegate {
+    func urlSession(
+        _ session: URLSession,
+        downloadTask: URLSessionDownloadTask,
+        didWriteData bytesWritten: Int64,
+        totalBytesWritten: Int64,
+        totalBytesExpectedToWrite: Int64
+    ) {
+        guard let id = downloadTask.taskDescription else { return }
+        lock.lock()
+        let now = Date()
+        let due = now.timeIntervalSince(lastProgress[id] ?? .distantPast) >= Self.progressInterval
+        if due {
+            lastProgress[id] = now
+        }
+        lock.unlock()
+        guard due || totalBytesWritten == totalBytesExpectedToWrite else { return }
+        update(id) { task in
+            task["status"] = Self.statusRunning
+            task["bytesDownloaded"] = totalBytesWritten
+            task["totalBytes"] = totalBytesExpectedToWrite >= 0 ? totalBytesExpectedToWrite : -1
+        }
+    }
+
+    func urlSession(_ session: URLSession, downloadTask: URLSessionDownloadTask, didFinishDownloadingTo location: URL) {
+        guard let id = downloadTask.taskDescription, let task = loadTask(id),
+              let path = task["path"] as? String else { return }
+
+        if let response = downloadTask.response as? HTTPURLResponse, !(200...299).contains(response.statusCode) {
+            update(id) { task in
+                task["status"] = Self.statusFailed
+                task["error"] = "HTTP \(response.statusCode)"
+            }
+            return
+        }
+
+        // The temporary file is deleted when this method returns, so move it now.
+        let target = URL(fileURLWithPath: path)
+        do {
+            try? FileManager.default.removeItem(at: target)
+            try FileManager.default.moveItem(at: location, to: target)
+            let size = (try? FileManager.default.attributesOfItem(atPath: path)[.size] as? Int64) ?? 0
+            update(id) { task in
+                task["status"] = Self.statusCompleted
+                task["bytesDownloaded"] = size
+                task["totalBytes"] = size
+            }
+        } catch {
+            update(id) { task in
+                task["status"] = Self.statusFailed
+                task["error"] = error.localizedDescription
+            }
+        }
+    }
+
+    func urlSession(_ session: URLSession, task: URLSessionTask, didCompleteWithError error: Error?) {
+        guard let id = task.taskDescription, let error = error as NSError? else { return }
+        lock.lock()
+        lastProgress.removeValue(forKey: id)
+        lock.unlock()
+        // Cancellation is reported by pause, cancel, and remove themselves.
+        if error.code == NSURLErrorCancelled { return }
+        if let resumeData = error.userInfo[NSURLSessionDownloadTaskResumeData] as? Data {
+            UserDefaults.standard.set(resumeData, forKey: Self.resumeDataPrefix + id)
+        }
+        update(id) { task in
+            task["status"] = Self.statusFailed
+            task["error"] = error.localizedDescription
+        }
+    }
+
+    func urlSessionDidFinishEvents(forBackgroundURLSession session: URLSession) {
+        DispatchQueue.main.async {
+            self.backgroundCompletionHandler?()
+            self.backgroundCompletionHandler = nil
+        }
+    }
+}
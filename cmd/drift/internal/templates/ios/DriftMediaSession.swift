@@ -4,6 +4,11 @@
 /// Both NativeAudioPlayer and NativeVideoPlayer call activate() on init
 /// and deactivate() on dispose. The underlying AVAudioSession is only
 /// deactivated when no media players remain active.
+///
+/// Players observe session interruptions, such as phone calls, with
+/// observeInterruptions and report them to Go, which resumes playback
+/// according to the controller's interruption policy. The system pauses
+/// players itself when an interruption begins.
 
 import AVFoundation
 
@@ -23,6 +28,35 @@ enum DriftMediaSession {
         }
     }
 
+    /// Observes audio session interruptions. send receives the event to
+    /// report to Go; wasPlaying tells whether the player was playing when
+    /// the interruption began. Remove the returned observer on dispose.
+    static func observeInterruptions(
+        wasPlaying: @escaping () -> Bool,
+        send: @escaping ([String: Any]) -> Void
+    ) -> NSObjectProtocol {
+        NotificationCenter.default.addObserver(
+            forName: AVAudioSession.interruptionNotification,
+            object: AVAudioSession.sharedInstance(),
+            queue: .main
+        ) { notification in
+            guard let info = notification.userInfo,
+                  let rawType = info[AVAudioSessionInterruptionTypeKey] as? UInt,
+                  let type = AVAudioSession.InterruptionType(rawValue: rawType) else { return }
+            switch type {
+            case .began:
+                // Every interruption may end; whether to resume is decided then.
+                send(["type": "began", "wasPlaying": wasPlaying(), "transient": true])
+            case .ended:
+                let rawOptions = info[AVAudioSessionInterruptionOptionKey] as? UInt ?? 0
+                let options = AVAudioSession.InterruptionOptions(rawValue: rawOptions)
+                send(["type": "ended", "shouldResume": options.contains(.shouldResume)])
+            @unknown default:
+                break
+            }
+        }
+    }
+
     /// Decrements the active count and deactivates the audio session
     /// when no media players remain.
     static func deactivate() {
@@ -57,6 +57,13 @@ func DriftSetDeviceScale(_ scale: Double)
 @_silgen_name("DriftNeedsFrame")
 func DriftNeedsFrame() -> Int32
 
+/// FFI declaration for resolving the color space of the rendering surface.
+///
+/// - Parameter wideGamut: 1 if the display supports Display P3, 0 otherwise.
+/// - Returns: 0 to tag the layer as sRGB, 1 for Display P3.
+@_silgen_name("DriftSurfaceColorSpace")
+func DriftSurfaceColorSpace(_ wideGamut: Int32) -> Int32
+
 /// FFI declaration for requesting a new frame from the Go engine.
 @_silgen_name("DriftRequestFrame")
 func DriftRequestFrame()
@@ -66,6 +73,16 @@ func DriftRequestFrame()
 @_silgen_name("DriftShouldWarmUpViews")
 func DriftShouldWarmUpViews() -> Int32
 
+/// FFI declaration for checking whether the first app frame has been drawn.
+/// Returns 1 once the launch screen can be removed, 0 otherwise.
+@_silgen_name("DriftFirstFrameRasterized")
+func DriftFirstFrameRasterized() -> Int32
+
+/// FFI declaration for resolving the mouse cursor at a point.
+/// Returns a layout.MouseCursor value, 0 for the default pointer.
+@_silgen_name("DriftMouseCursor")
+func DriftMouseCursor(_ x: Double, _ y: Double) -> Int32
+
 /// FFI declaration for registering the schedule-frame callback with the Go engine.
 /// The Go engine calls this handler when it needs the platform to produce a frame.
 @_silgen_name("DriftSetScheduleFrameHandler")
@@ -105,6 +122,10 @@ final class DriftMetalView: UIView {
     var accessibilityElementsProvider: (() -> [Any]?)?
     private var lastPlatformGeometrySignature: Int?
 
+    /// Color space the Metal layer is tagged with (0 = sRGB, 1 = Display P3).
+    /// Starts unset so the first frame always tags the layer.
+    private var surfaceColorSpace: Int32 = -1
+
     // MARK: - Accessibility Container
 
     override var isAccessibilityElement: Bool {
@@ -174,6 +195,7 @@ final class DriftMetalView: UIView {
     override init(frame: CGRect) {
         super.init(frame: frame)
         configureLayer()
+        addInteraction(UIPointerInteraction(delegate: self))
     }
 
     /// Initializes the view from a storyboard or nib.
@@ -184,6 +206,7 @@ final class DriftMetalView: UIView {
     required init?(coder: NSCoder) {
         super.init(coder: coder)
         configureLayer()
+        addInteraction(UIPointerInteraction(delegate: self))
     }
 
     /// Configures the Metal layer for rendering.
@@ -291,7 +314,9 @@ final class DriftMetalView: UIView {
         let syncPresentation = syncPresentationForRotation || geometryChangedThisFrame
         metalLayer.presentsWithTransaction = syncPresentation
 
-        // Step 3: Acquire drawable and composite into it.
+        // Step 3: Tag the layer with the color space the engine renders in,
+        // then acquire a drawable and composite into it.
+        updateColorSpace()
         guard let drawable = metalLayer.nextDrawable() else { return false }
 
         renderer.renderSync(
@@ -303,6 +328,16 @@ final class DriftMetalView: UIView {
         return true
     }
 
+    /// Tags the Metal layer with Display P3 when the app prefers it and the
+    /// display supports it, otherwise sRGB.
+    private func updateColorSpace() {
+        let wideGamut: Int32 = traitCollection.displayGamut == .P3 ? 1 : 0
+        let space = DriftSurfaceColorSpace(wideGamut)
+        guard space != surfaceColorSpace else { return }
+        surfaceColorSpace = space
+        metalLayer.colorspace = CGColorSpace(name: space == 1 ? CGColorSpace.displayP3 : CGColorSpace.sRGB)
+    }
+
     private func didPlatformGeometryChange(_ views: [ViewSnapshot]) -> Bool {
         let signature = platformGeometrySignature(views)
         defer { lastPlatformGeometrySignature = signature }
@@ -469,3 +504,42 @@ final class DriftMetalView: UIView {
         DriftRequestFrame()
     }
 }
+
+// MARK: - Pointer Interaction
+
+/// Shows the mouse cursor the Go engine reports for the hovered point when an
+/// iPad is used with a trackpad or mouse. iPadOS has no resize cursors, so
+/// resize cursors are shown as beams along the draggable boundary.
+extension DriftMetalView: UIPointerInteractionDelegate {
+    func pointerInteraction(
+        _ interaction: UIPointerInteraction,
+        regionFor request: UIPointerRegionRequest,
+        defaultRegion: UIPointerRegion
+    ) -> UIPointerRegion? {
+        let point = request.location
+        let scale = Double(contentScaleFactor)
+        let cursor = DriftMouseCursor(Double(point.x) * scale, Double(point.y) * scale)
+        // Values match layout.MouseCursor; 2 and 3 are the resize cursors.
+        guard cursor == 2 || cursor == 3 else {
+            return nil
+        }
+        // A one-point region makes UIKit ask again as soon as the pointer
+        // moves, so the cursor follows the layout under it.
+        let rect = CGRect(x: point.x - 0.5, y: point.y - 0.5, width: 1, height: 1)
+        return UIPointerRegion(rect: rect, identifier: NSNumber(value: cursor))
+    }
+
+    func pointerInteraction(
+        _ interaction: UIPointerInteraction,
+        styleFor region: UIPointerRegion
+    ) -> UIPointerStyle? {
+        switch (region.identifier as? NSNumber)?.int32Value {
+        case 2:
+            return UIPointerStyle(shape: .verticalBeam(length: 24), constrainedAxes: [])
+        case 3:
+            return UIPointerStyle(shape: .horizontalBeam(length: 24), constrainedAxes: [])
+        default:
+            return nil
+        }
+    }
+}
@@ -32,6 +32,11 @@
 ///   is paused again. This avoids waking the CPU/GPU every vsync when the UI
 ///   is idle, matching the Android embedder's on-demand pattern.
 ///
+/// Launch Screen Handoff:
+///   A copy of LaunchScreen.storyboard is layered over the Metal view until the
+///   Go engine reports its first frame (see engine.DeferFirstFrame), then
+///   removed right after that frame is presented to avoid a blank flash.
+///
 /// Lifecycle:
 ///   - viewDidLoad: Creates the display link (paused) and registers callbacks
 ///   - viewWillAppear: Unpauses after reappearing (e.g. modal dismissal)
@@ -56,12 +61,27 @@ final class DriftViewController: UIViewController {
     /// Created immediately as a constant since it's used throughout the controller's lifetime.
     private let metalView = DriftMetalView()
 
+    /// Launch screen overlay shown until the first Drift frame is presented.
+    private var splashView: UIView?
+
     override var preferredStatusBarStyle: UIStatusBarStyle {
         SystemUIHandler.currentStyle.statusBarStyle
     }
 
     override var prefersStatusBarHidden: Bool {
-        SystemUIHandler.currentStyle.statusBarHidden
+        SystemUIHandler.currentStyle.statusBarHidden || ScreenHandler.immersive
+    }
+
+    override var prefersHomeIndicatorAutoHidden: Bool {
+        ScreenHandler.immersive
+    }
+
+    override var supportedInterfaceOrientations: UIInterfaceOrientationMask {
+        ScreenHandler.orientationMask
+    }
+
+    override var preferredScreenEdgesDeferringSystemGestures: UIRectEdge {
+        SystemGesturesHandler.deferredEdges
     }
 
     /// Provides the Metal view as this controller's main view.
@@ -85,7 +105,12 @@ final class DriftViewController: UIViewController {
         PlatformViewHandler.setHostView(view)
         // Initialize accessibility support
         AccessibilityHandler.shared.initialize(hostView: view)
+        PowerHandler.initialize()
+        KeyboardHandler.initialize()
+        AppearanceHandler.sendState(traitCollection)
+        LocaleHandler.initialize()
         applySystemUIStyle(SystemUIHandler.currentStyle)
+        installSplashView()
         // Register the schedule-frame callback so the Go engine can request frames
         driftScheduleFrameCallback = { [weak self] in self?.scheduleFrame() }
         DriftSetScheduleFrameHandler(nativeScheduleFrame)
@@ -137,6 +162,14 @@ final class DriftViewController: UIViewController {
         SafeAreaHandler.sendInsetsUpdate()
     }
 
+    override func traitCollectionDidChange(_ previousTraitCollection: UITraitCollection?) {
+        super.traitCollectionDidChange(previousTraitCollection)
+        if traitCollection.hasDifferentColorAppearance(comparedTo: previousTraitCollection)
+            || traitCollection.preferredContentSizeCategory != previousTraitCollection?.preferredContentSizeCategory {
+            AppearanceHandler.sendState(traitCollection)
+        }
+    }
+
     override func viewWillTransition(to size: CGSize, with coordinator: UIViewControllerTransitionCoordinator) {
         super.viewWillTransition(to: size, with: coordinator)
 
@@ -163,6 +196,25 @@ final class DriftViewController: UIViewController {
         stopDisplayLink()
     }
 
+    /// Sends hardware key presses to the Go focus system. They are passed on,
+    /// so native text inputs and system shortcuts still receive them.
+    override func pressesBegan(_ presses: Set<UIPress>, with event: UIPressesEvent?) {
+        HardwareKeyHandler.send(presses, action: "down")
+        super.pressesBegan(presses, with: event)
+    }
+
+    /// Sends hardware key releases to the Go focus system.
+    override func pressesEnded(_ presses: Set<UIPress>, with event: UIPressesEvent?) {
+        HardwareKeyHandler.send(presses, action: "up")
+        super.pressesEnded(presses, with: event)
+    }
+
+    /// Treats cancelled presses as releases.
+    override func pressesCancelled(_ presses: Set<UIPress>, with event: UIPressesEvent?) {
+        HardwareKeyHandler.send(presses, action: "up")
+        super.pressesCancelled(presses, with: event)
+    }
+
     /// Creates the display link for vsync-synchronized rendering.
     ///
     /// The link starts paused and is unpaused on demand by scheduleFrame().
@@ -217,8 +269,32 @@ final class DriftViewController: UIViewController {
     /// the Go engine calls the schedule-frame callback.
     @objc private func drawFrame() {
         metalView.renderFrame()
+        if splashView != nil && DriftFirstFrameRasterized() != 0 {
+            removeSplashView()
+        }
         if DriftNeedsFrame() == 0 {
             displayLink?.isPaused = true
         }
     }
+
+    /// Layers the launch screen over the Metal view so the system splash
+    /// appears to persist until the Go engine has drawn real content.
+    private func installSplashView() {
+        guard Bundle.main.path(forResource: "LaunchScreen", ofType: "storyboardc") != nil,
+              let launch = UIStoryboard(name: "LaunchScreen", bundle: nil).instantiateInitialViewController()
+        else { return }
+        let splash = launch.view!
+        splash.frame = view.bounds
+        splash.autoresizingMask = [.flexibleWidth, .flexibleHeight]
+        splash.isUserInteractionEnabled = false
+        view.addSubview(splash)
+        splashView = splash
+    }
+
+    /// Removes the launch screen overlay. Called right after the first frame
+    /// was presented, so Core Animation commits both in the same transaction.
+    private func removeSplashView() {
+        splashView?.removeFromSuperview()
+        splashView = nil
+    }
 }
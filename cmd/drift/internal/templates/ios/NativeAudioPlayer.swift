@@ -1,6 +1,7 @@
 /// NativeAudioPlayer.swift
 /// Provides audio-only playback using AVPlayer via a standalone platform channel.
 /// Supports multiple concurrent player instances, each identified by a playerId.
+/// Each instance plays a queue of items, gaplessly or with a crossfade.
 
 import AVFoundation
 
@@ -14,12 +15,34 @@ private class AudioPlayerInstance {
     private var timeControlObservation: NSKeyValueObservation?
     private var itemStatusObservation: NSKeyValueObservation?
     private var endOfItemObserver: NSObjectProtocol?
-    private var playerLooper: AVPlayerLooper?
+    private var interruptionObserver: NSObjectProtocol?
+    // Whether playback was asked for. The system pauses the player before
+    // reporting an interruption, so this tells whether it was playing.
+    private var playRequested = false
     private var playbackSpeed: Float = 1.0
-    private var isLooping: Bool = false
+    private var volume: Float = 1.0
     private var hasReachedEnd: Bool = false
     private var isStopped: Bool = false
 
+    // Queue state. The AVQueuePlayer holds the current item and, preloaded
+    // behind it, the next item in play order, so items play back to back
+    // without a gap. Looping is repeat-all, preloading the same URL again.
+    private var sources: [URL] = []
+    private var gains: [Float] = [] // Loudness normalization gain of each source, in dB
+    private var normalize = false
+    private var order: [Int] = [] // Queue indices in play order
+    private var orderPosition = 0
+    private var repeatMode = 0 // 0 off, 1 one, 2 all
+    private var shuffle = false
+    private var currentItem: AVPlayerItem?
+
+    // Crossfade state. The tail player plays out the end of the finishing
+    // item while the queue player fades in the next one.
+    private var crossfadeMs: Int64 = 0
+    private var tail: AVPlayer?
+    private var tailItem: AVPlayerItem?
+    private var fadeTimer: Timer?
+
     init(id: Int) {
         self.id = id
 
@@ -46,6 +69,7 @@ private class AudioPlayerInstance {
                 self.stopPositionUpdates()
             case .playing:
                 state = 2 // Playing
+                self.playRequested = true
                 self.startPositionUpdates()
             @unknown default:
                 state = 0 // Idle
@@ -53,37 +77,64 @@ private class AudioPlayerInstance {
             }
             self.sendStateEvent(state: state)
         }
+
+        interruptionObserver = DriftMediaSession.observeInterruptions(
+            wasPlaying: { [weak self] in
+                guard let self = self else { return false }
+                return self.playRequested && !self.hasReachedEnd && !self.isStopped
+            },
+            send: { [weak self] event in
+                guard let self = self else { return }
+                var data = event
+                data["playerId"] = self.id
+                PlatformChannelManager.shared.sendEvent(channel: "drift/audio_player/interruptions", data: data)
+            }
+        )
+
+        // Advance through the queue as items finish. Only the current item
+        // is of interest; others belong to other players.
+        endOfItemObserver = NotificationCenter.default.addObserver(
+            forName: .AVPlayerItemDidPlayToEndTime,
+            object: nil,
+            queue: .main
+        ) { [weak self] notification in
+            guard let self = self,
+                  let item = notification.object as? AVPlayerItem,
+                  item === self.currentItem else { return }
+            // Let the player move on to the preloaded item first.
+            DispatchQueue.main.async {
+                self.itemDidFinish(item)
+            }
+        }
+    }
+
+    /// The queue index of the current item, or -1 if the queue is empty.
+    private var queueIndex: Int {
+        order.indices.contains(orderPosition) ? order[orderPosition] : -1
+    }
+
+    private var positionMs: Int64 {
+        let currentTime = player.currentTime()
+        return currentTime.isNumeric ? Int64(CMTimeGetSeconds(currentTime) * 1000) : 0
+    }
+
+    private var durationMs: Int64 {
+        guard let item = player.currentItem, item.duration.isNumeric else { return 0 }
+        return max(Int64(CMTimeGetSeconds(item.duration) * 1000), 0)
+    }
+
+    private var bufferedMs: Int64 {
+        guard let timeRange = player.currentItem?.loadedTimeRanges.last?.timeRangeValue else { return 0 }
+        return Int64(CMTimeGetSeconds(CMTimeAdd(timeRange.start, timeRange.duration)) * 1000)
     }
 
     private func startPositionUpdates() {
         guard timeObserver == nil else { return }
         let interval = CMTime(seconds: 0.25, preferredTimescale: CMTimeScale(NSEC_PER_SEC))
-        timeObserver = player.addPeriodicTimeObserver(forInterval: interval, queue: .main) { [weak self] time in
+        timeObserver = player.addPeriodicTimeObserver(forInterval: interval, queue: .main) { [weak self] _ in
             guard let self = self else { return }
-            let positionMs = time.isNumeric ? Int64(CMTimeGetSeconds(time) * 1000) : 0
-            var durationMs: Int64 = 0
-            var bufferedMs: Int64 = 0
-
-            if let item = self.player.currentItem {
-                if item.duration.isNumeric {
-                    durationMs = Int64(CMTimeGetSeconds(item.duration) * 1000)
-                }
-                if let timeRange = item.loadedTimeRanges.last?.timeRangeValue {
-                    let bufferedEnd = CMTimeAdd(timeRange.start, timeRange.duration)
-                    bufferedMs = Int64(CMTimeGetSeconds(bufferedEnd) * 1000)
-                }
-            }
-
-            PlatformChannelManager.shared.sendEvent(
-                channel: "drift/audio_player/events",
-                data: [
-                    "playerId": self.id,
-                    "playbackState": 2, // Playing
-                    "positionMs": positionMs,
-                    "durationMs": max(durationMs, 0),
-                    "bufferedMs": bufferedMs
-                ]
-            )
+            self.sendStateEvent(state: 2) // Playing
+            self.checkCrossfade()
         }
     }
 
@@ -95,53 +146,227 @@ private class AudioPlayerInstance {
     }
 
     func sendStateEvent(state: Int) {
-        let currentTime = player.currentTime()
-        let positionMs = currentTime.isNumeric ? Int64(CMTimeGetSeconds(currentTime) * 1000) : 0
-        var durationMs: Int64 = 0
-        var bufferedMs: Int64 = 0
-
-        if let item = player.currentItem {
-            if item.duration.isNumeric {
-                durationMs = Int64(CMTimeGetSeconds(item.duration) * 1000)
-            }
-            if let timeRange = item.loadedTimeRanges.last?.timeRangeValue {
-                let bufferedEnd = CMTimeAdd(timeRange.start, timeRange.duration)
-                bufferedMs = Int64(CMTimeGetSeconds(bufferedEnd) * 1000)
-            }
-        }
-
         PlatformChannelManager.shared.sendEvent(
             channel: "drift/audio_player/events",
             data: [
                 "playerId": id,
                 "playbackState": state,
                 "positionMs": positionMs,
-                "durationMs": max(durationMs, 0),
-                "bufferedMs": bufferedMs
+                "durationMs": durationMs,
+                "bufferedMs": bufferedMs,
+                "queueIndex": queueIndex
             ]
         )
     }
 
+    private func currentState() -> Int {
+        switch player.timeControlStatus {
+        case .playing:
+            return 2
+        case .waitingToPlayAtSpecifiedRate:
+            return 1
+        default:
+            if player.currentItem == nil || isStopped {
+                return 0
+            }
+            return hasReachedEnd ? 3 : 4
+        }
+    }
+
+    // MARK: Queue
+
     func load(url: URL) {
+        setQueue([url], gains: [0], startIndex: 0)
+    }
+
+    func setQueue(_ urls: [URL], gains: [Float], startIndex: Int) {
         isStopped = false
+        sources = urls
+        self.gains = gains
+        let first = urls.indices.contains(startIndex) ? startIndex : 0
+        order = shuffle ? shuffledOrder(first: first) : Array(urls.indices)
+        start(at: order.firstIndex(of: first) ?? 0)
+    }
+
+    func insert(_ urls: [URL], gains: [Float], at index: Int) {
+        guard !urls.isEmpty else { return }
+        let index = min(max(index, 0), sources.count)
+        let wasEmpty = sources.isEmpty
+        let current = queueIndex
+        sources.insert(contentsOf: urls, at: index)
+        self.gains.insert(contentsOf: gains, at: index)
+        let inserted = Array(index..<(index + urls.count))
+
+        if wasEmpty {
+            order = shuffle ? shuffledOrder(first: 0) : Array(sources.indices)
+            start(at: 0)
+            return
+        }
+        if shuffle {
+            // Shift existing indices, then scatter the new items among those
+            // yet to play.
+            order = order.map { $0 >= index ? $0 + urls.count : $0 }
+            for item in inserted {
+                order.insert(item, at: Int.random(in: (orderPosition + 1)...order.count))
+            }
+        } else {
+            order = Array(sources.indices)
+            orderPosition = current >= index ? current + urls.count : current
+        }
+        preloadNext()
+    }
+
+    func remove(at index: Int) {
+        guard sources.indices.contains(index),
+              let position = order.firstIndex(of: index) else { return }
+        let wasCurrent = position == orderPosition
+        sources.remove(at: index)
+        gains.remove(at: index)
+        order.remove(at: position)
+        order = order.map { $0 > index ? $0 - 1 : $0 }
+        if position < orderPosition {
+            orderPosition -= 1
+        }
+
+        if sources.isEmpty {
+            cancelCrossfade()
+            player.removeAllItems()
+            currentItem = nil
+            orderPosition = 0
+            sendStateEvent(state: 0) // Idle
+            return
+        }
+        if wasCurrent {
+            // Play the item after the removed one; removing the last item
+            // stops on the one before it.
+            let pastEnd = orderPosition >= order.count
+            start(at: min(orderPosition, order.count - 1))
+            if pastEnd {
+                player.pause()
+            }
+        } else {
+            preloadNext()
+        }
+    }
+
+    func skipToNext() {
+        if orderPosition + 1 < order.count {
+            start(at: orderPosition + 1)
+        } else if repeatMode == 2 && !order.isEmpty {
+            start(at: 0)
+        }
+    }
+
+    func skipToPrevious() {
+        // Restart the current item unless it has only just begun.
+        if positionMs > 3000 {
+            seekTo(positionMs: 0)
+        } else if orderPosition > 0 {
+            start(at: orderPosition - 1)
+        } else if repeatMode == 2 && !order.isEmpty {
+            start(at: order.count - 1)
+        } else {
+            seekTo(positionMs: 0)
+        }
+    }
+
+    func skipToIndex(_ index: Int) {
+        guard let position = order.firstIndex(of: index) else { return }
+        start(at: position)
+    }
+
+    func setRepeatMode(_ mode: Int) {
+        repeatMode = mode
+        preloadNext()
+    }
+
+    func setShuffle(_ enabled: Bool) {
+        shuffle = enabled
+        guard !sources.isEmpty else { return }
+        let current = queueIndex
+        order = enabled ? shuffledOrder(first: current) : Array(sources.indices)
+        orderPosition = order.firstIndex(of: current) ?? 0
+        preloadNext()
+    }
+
+    /// Returns the queue indices in a random order that starts with first.
+    private func shuffledOrder(first: Int) -> [Int] {
+        [first] + sources.indices.filter { $0 != first }.shuffled()
+    }
+
+    /// The play-order position of the item after the current one when it
+    /// finishes, or nil if playback ends with it.
+    private func nextOrderPosition() -> Int? {
+        guard !order.isEmpty else { return nil }
+        if repeatMode == 1 {
+            return orderPosition
+        }
+        if orderPosition + 1 < order.count {
+            return orderPosition + 1
+        }
+        return repeatMode == 2 ? 0 : nil
+    }
+
+    /// Replaces the player's items with the item at position, keeping the
+    /// current rate so that playback continues if it was playing.
+    private func start(at position: Int) {
+        cancelCrossfade()
         hasReachedEnd = false
+        player.removeAllItems()
+        orderPosition = position
+        guard order.indices.contains(position) else {
+            currentItem = nil
+            return
+        }
+        let item = AVPlayerItem(url: sources[order[position]])
+        player.insert(item, after: nil)
+        becomeCurrent(item)
+        preloadNext()
+        sendStateEvent(state: currentState())
+    }
 
-        // Disable existing looper before replacing the item
-        playerLooper?.disableLooping()
-        playerLooper = nil
+    /// Queues the next item behind the current one, replacing any item
+    /// preloaded before the queue or modes changed.
+    private func preloadNext() {
+        for item in player.items() where item !== player.currentItem {
+            player.remove(item)
+        }
+        guard player.currentItem != nil, let next = nextOrderPosition() else {
+            // Stay on the last item when it finishes so it reports Completed.
+            player.actionAtItemEnd = .pause
+            return
+        }
+        player.actionAtItemEnd = .advance
+        player.insert(AVPlayerItem(url: sources[order[next]]), after: player.currentItem)
+    }
 
-        // Remove previous end-of-item observer
-        if let observer = endOfItemObserver {
-            NotificationCenter.default.removeObserver(observer)
-            endOfItemObserver = nil
+    private func itemDidFinish(_ item: AVPlayerItem) {
+        guard item === currentItem else { return }
+        guard let next = nextOrderPosition(), player.currentItem !== item else {
+            hasReachedEnd = true
+            sendStateEvent(state: 3) // Completed
+            return
         }
+        // The player has already moved on to the preloaded item.
+        didAdvance(to: next)
+    }
 
-        let item = AVPlayerItem(url: url)
-        player.replaceCurrentItem(with: item)
+    private func didAdvance(to position: Int) {
+        orderPosition = position
+        becomeCurrent(player.currentItem)
+        preloadNext()
+        sendStateEvent(state: currentState())
+    }
+
+    private func becomeCurrent(_ item: AVPlayerItem?) {
+        currentItem = item
+        if fadeTimer == nil {
+            player.volume = itemVolume(queueIndex)
+        }
 
         // Observe item status for errors
         itemStatusObservation?.invalidate()
-        itemStatusObservation = item.observe(\.status) { [weak self] item, _ in
+        itemStatusObservation = item?.observe(\.status) { [weak self] item, _ in
             guard let self = self else { return }
             if item.status == .failed {
                 PlatformChannelManager.shared.sendEvent(
@@ -154,29 +379,78 @@ private class AudioPlayerInstance {
                 )
             }
         }
+    }
 
-        // Register end-of-item observer for completion detection.
-        // When AVPlayerLooper is active it prevents this notification from firing,
-        // so the observer and looper are naturally mutually exclusive.
-        endOfItemObserver = NotificationCenter.default.addObserver(
-            forName: .AVPlayerItemDidPlayToEndTime,
-            object: item,
-            queue: .main
-        ) { [weak self] _ in
-            guard let self = self else { return }
-            self.hasReachedEnd = true
-            self.sendStateEvent(state: 3) // Completed
+    // MARK: Crossfade
+
+    func setCrossfade(durationMs: Int64) {
+        crossfadeMs = max(durationMs, 0)
+        if crossfadeMs == 0 {
+            cancelCrossfade()
         }
+    }
 
-        // Re-create looper if looping is active
-        if isLooping {
-            playerLooper = AVPlayerLooper(player: player, templateItem: item)
+    /// Called with each position update while playing. Prepares the tail
+    /// player shortly before the crossfade window, then starts the fade once
+    /// the finishing item enters it.
+    private func checkCrossfade() {
+        guard crossfadeMs > 0, fadeTimer == nil, repeatMode != 1,
+              let item = currentItem, item === player.currentItem,
+              let next = nextOrderPosition(), player.items().count > 1 else { return }
+        let duration = durationMs
+        guard duration > crossfadeMs else { return }
+        let remaining = duration - positionMs
+
+        if remaining <= crossfadeMs + 2000 && tailItem !== item {
+            tail?.pause()
+            let tail = AVPlayer(url: sources[queueIndex])
+            tail.seek(
+                to: CMTime(value: duration - crossfadeMs, timescale: 1000),
+                toleranceBefore: .zero,
+                toleranceAfter: .zero
+            )
+            self.tail = tail
+            tailItem = item
+        }
+        guard remaining <= crossfadeMs, let tail = tail else { return }
+
+        let tailVolume = itemVolume(queueIndex)
+        tail.volume = tailVolume
+        tail.playImmediately(atRate: playbackSpeed)
+        player.advanceToNextItem()
+        didAdvance(to: next)
+        player.volume = 0
+
+        let fadeDuration = Double(max(remaining, 1)) / 1000
+        let fadeStart = Date()
+        fadeTimer = Timer.scheduledTimer(withTimeInterval: 0.05, repeats: true) { [weak self] _ in
+            guard let self = self else { return }
+            let t = Float(min(1, Date().timeIntervalSince(fadeStart) / fadeDuration))
+            tail.volume = tailVolume * (1 - t)
+            self.player.volume = self.itemVolume(self.queueIndex) * t
+            if t >= 1 {
+                self.cancelCrossfade()
+            }
         }
     }
 
+    /// Stops any crossfade, releasing the tail player and restoring the
+    /// queue player's volume.
+    private func cancelCrossfade() {
+        fadeTimer?.invalidate()
+        fadeTimer = nil
+        tail?.pause()
+        tail = nil
+        tailItem = nil
+        player.volume = itemVolume(queueIndex)
+    }
+
+    // MARK: Transport
+
     func play() {
         isStopped = false
         hasReachedEnd = false
+        playRequested = true
         player.play()
         if playbackSpeed != 1.0 {
             player.rate = playbackSpeed
@@ -184,12 +458,15 @@ private class AudioPlayerInstance {
     }
 
     func pause() {
+        playRequested = false
         player.pause()
     }
 
     func stop() {
+        playRequested = false
         isStopped = true
         hasReachedEnd = false
+        cancelCrossfade()
         player.pause()
         player.seek(to: .zero) { [weak self] _ in
             guard let self = self else { return }
@@ -199,26 +476,36 @@ private class AudioPlayerInstance {
 
     func seekTo(positionMs: Int64) {
         hasReachedEnd = false
+        cancelCrossfade()
         let time = CMTime(seconds: Double(positionMs) / 1000.0, preferredTimescale: CMTimeScale(NSEC_PER_SEC))
         player.seek(to: time)
     }
 
     func setVolume(_ volume: Float) {
-        player.volume = volume
+        self.volume = volume
+        if fadeTimer == nil {
+            player.volume = itemVolume(queueIndex)
+        }
     }
 
-    func setLooping(_ looping: Bool) {
-        isLooping = looping
-
-        // Disable existing looper
-        playerLooper?.disableLooping()
-        playerLooper = nil
+    /// The volume to play the queue item at index at: volume, adjusted by
+    /// its gain when normalizing.
+    private func itemVolume(_ index: Int) -> Float {
+        let gainDb = normalize && gains.indices.contains(index) ? gains[index] : 0
+        return min(max(volume * powf(10, gainDb / 20), 0), 1)
+    }
 
-        if looping, let item = player.currentItem {
-            playerLooper = AVPlayerLooper(player: player, templateItem: item)
+    func setLoudnessNormalization(_ enabled: Bool) {
+        normalize = enabled
+        if fadeTimer == nil {
+            player.volume = itemVolume(queueIndex)
         }
     }
 
+    func setLooping(_ looping: Bool) {
+        setRepeatMode(looping ? 2 : 0)
+    }
+
     func setPlaybackSpeed(_ rate: Float) {
         playbackSpeed = rate
         if player.timeControlStatus == .playing {
@@ -228,6 +515,7 @@ private class AudioPlayerInstance {
 
     func dispose() {
         stopPositionUpdates()
+        cancelCrossfade()
         timeControlObservation?.invalidate()
         timeControlObservation = nil
         itemStatusObservation?.invalidate()
@@ -236,12 +524,18 @@ private class AudioPlayerInstance {
             NotificationCenter.default.removeObserver(observer)
             endOfItemObserver = nil
         }
-        playerLooper?.disableLooping()
-        playerLooper = nil
+        if let observer = interruptionObserver {
+            NotificationCenter.default.removeObserver(observer)
+            interruptionObserver = nil
+        }
         player.pause()
-        player.replaceCurrentItem(with: nil)
+        player.removeAllItems()
+        sources = []
+        gains = []
+        order = []
+        currentItem = nil
         playbackSpeed = 1.0
-        isLooping = false
+        playRequested = false
         hasReachedEnd = false
         isStopped = false
     }
@@ -275,6 +569,35 @@ enum AudioPlayerHandler {
             return setLooping(playerId: playerId, args: argsMap)
         case "setPlaybackSpeed":
             return setPlaybackSpeed(playerId: playerId, args: argsMap)
+        case "setQueue":
+            return setQueue(playerId: playerId, args: argsMap)
+        case "insertIntoQueue":
+            return insertIntoQueue(playerId: playerId, args: argsMap)
+        case "removeFromQueue":
+            return removeFromQueue(playerId: playerId, args: argsMap)
+        case "skipToNext":
+            players[playerId]?.skipToNext()
+            return (nil, nil)
+        case "skipToPrevious":
+            players[playerId]?.skipToPrevious()
+            return (nil, nil)
+        case "skipToIndex":
+            return skipToIndex(playerId: playerId, args: argsMap)
+        case "setRepeatMode":
+            return setRepeatMode(playerId: playerId, args: argsMap)
+        case "setShuffle":
+            return setShuffle(playerId: playerId, args: argsMap)
+        case "setCrossfade":
+            return setCrossfade(playerId: playerId, args: argsMap)
+        case "getEqualizerBands":
+            // AVPlayer has no equalizer.
+            return (["bands": [Any]()], nil)
+        case "setPitch", "setEqualizer":
+            return (nil, NSError(domain: "AudioPlayer", code: 501, userInfo: [NSLocalizedDescriptionKey: "\(method) is not supported on iOS"]))
+        case "setLoudnessNormalization":
+            let enabled = argsMap?["enabled"] as? Bool ?? false
+            ensurePlayer(playerId: playerId).setLoudnessNormalization(enabled)
+            return (nil, nil)
         case "dispose":
             return dispose(playerId: playerId)
         default:
@@ -340,9 +663,180 @@ enum AudioPlayerHandler {
         return (nil, nil)
     }
 
+    /// Returns the URLs of the sources and their loudness normalization gains.
+    private static func sources(_ args: [String: Any]?) -> (urls: [URL], gains: [Float]) {
+        let sources = args?["sources"] as? [[String: Any]] ?? []
+        var urls: [URL] = []
+        var gains: [Float] = []
+        for source in sources {
+            guard let url = (source["url"] as? String).flatMap({ URL(string: $0) }) else { continue }
+            urls.append(url)
+            gains.append((source["gain"] as? NSNumber)?.floatValue ?? 0)
+        }
+        return (urls, gains)
+    }
+
+    private static func setQueue(playerId: Int, args: [String: Any]?) -> (Any?, Error?) {
+        let startIndex = (args?["startIndex"] as? NSNumber)?.intValue ?? 0
+        let (urls, gains) = sources(args)
+        ensurePlayer(playerId: playerId).setQueue(urls, gains: gains, startIndex: startIndex)
+        return (nil, nil)
+    }
+
+    private static func insertIntoQueue(playerId: Int, args: [String: Any]?) -> (Any?, Error?) {
+        let index = (args?["index"] as? NSNumber)?.intValue ?? 0
+        let (urls, gains) = sources(args)
+        ensurePlayer(playerId: playerId).insert(urls, gains: gains, at: index)
+        return (nil, nil)
+    }
+
+    private static func removeFromQueue(playerId: Int, args: [String: Any]?) -> (Any?, Error?) {
+        let index = (args?["index"] as? NSNumber)?.intValue ?? 0
+        players[playerId]?.remove(at: index)
+        return (nil, nil)
+    }
+
+    private static func skipToIndex(playerId: Int, args: [String: Any]?) -> (Any?, Error?) {
+        let index = (args?["index"] as? NSNumber)?.intValue ?? 0
+        players[playerId]?.skipToIndex(index)
+        return (nil, nil)
+    }
+
+    private static func setRepeatMode(playerId: Int, args: [String: Any]?) -> (Any?, Error?) {
+        let mode = (args?["mode"] as? NSNumber)?.intValue ?? 0
+        ensurePlayer(playerId: playerId).setRepeatMode(mode)
+        return (nil, nil)
+    }
+
+    private static func setShuffle(playerId: Int, args: [String: Any]?) -> (Any?, Error?) {
+        let enabled = args?["enabled"] as? Bool ?? false
+        ensurePlayer(playerId: playerId).setShuffle(enabled)
+        return (nil, nil)
+    }
+
+    private static func setCrossfade(playerId: Int, args: [String: Any]?) -> (Any?, Error?) {
+        let durationMs = (args?["durationMs"] as? NSNumber)?.int64Value ?? 0
+        ensurePlayer(playerId: playerId).setCrossfade(durationMs: durationMs)
+        return (nil, nil)
+    }
+
     private static func dispose(playerId: Int) -> (Any?, Error?) {
         players.removeValue(forKey: playerId)?.dispose()
         DriftMediaSession.deactivate()
         return (nil, nil)
     }
 }
+
+// MARK: - Audio Handler
+
+/// Handles audio processing requests from Go that are independent of any
+/// player instance.
+enum AudioHandler {
+    static func handle(method: String, args: Any?) -> (Any?, Error?) {
+        switch method {
+        case "extractWaveform":
+            return extractWaveform(args: args as? [String: Any])
+        default:
+            return (nil, NSError(domain: "Audio", code: 404, userInfo: [NSLocalizedDescriptionKey: "Unknown method: \(method)"]))
+        }
+    }
+
+    /// Decodes the first audio track of a file or remote URL to 16-bit PCM
+    /// and returns the peak amplitude of each of `count` equal slices of it.
+    /// AVAssetReader reads only local files, so remote files are downloaded
+    /// first.
+    private static func extractWaveform(args: [String: Any]?) -> (Any?, Error?) {
+        guard let urlString = args?["url"] as? String,
+              let url = URL(string: urlString) else {
+            return (nil, NSError(domain: "Audio", code: 400, userInfo: [NSLocalizedDescriptionKey: "Missing url"]))
+        }
+        let count = (args?["count"] as? NSNumber)?.intValue ?? 0
+        guard count > 0 else {
+            return (nil, NSError(domain: "Audio", code: 400, userInfo: [NSLocalizedDescriptionKey: "count must be positive"]))
+        }
+
+        do {
+            let fileURL = try localFile(for: url)
+            defer {
+                if fileURL != url {
+                    try? FileManager.default.removeItem(at: fileURL)
+                }
+            }
+            let asset = AVURLAsset(url: fileURL)
+            guard let track = asset.tracks(withMediaType: .audio).first else {
+                return (nil, NSError(domain: "Audio", code: 400, userInfo: [NSLocalizedDescriptionKey: "No audio track in \(urlString)"]))
+            }
+            let duration = CMTimeGetSeconds(asset.duration)
+            guard duration > 0 else {
+                return (nil, NSError(domain: "Audio", code: 400, userInfo: [NSLocalizedDescriptionKey: "Unknown duration for \(urlString)"]))
+            }
+
+            let reader = try AVAssetReader(asset: asset)
+            let output = AVAssetReaderTrackOutput(track: track, outputSettings: [
+                AVFormatIDKey: kAudioFormatLinearPCM,
+                AVLinearPCMBitDepthKey: 16,
+                AVLinearPCMIsFloatKey: false,
+                AVLinearPCMIsBigEndianKey: false,
+                AVLinearPCMIsNonInterleaved: false
+            ])
+            reader.add(output)
+            reader.startReading()
+
+            var peaks = [Double](repeating: 0, count: count)
+            while let sampleBuffer = output.copyNextSampleBuffer() {
+                guard let blockBuffer = CMSampleBufferGetDataBuffer(sampleBuffer),
+                      let description = CMSampleBufferGetFormatDescription(sampleBuffer),
+                      let format = CMAudioFormatDescriptionGetStreamBasicDescription(description)?.pointee,
+                      format.mSampleRate > 0 else { continue }
+                let channels = max(Int(format.mChannelsPerFrame), 1)
+                let start = CMTimeGetSeconds(CMSampleBufferGetPresentationTimeStamp(sampleBuffer))
+
+                let length = CMBlockBufferGetDataLength(blockBuffer)
+                var samples = [Int16](repeating: 0, count: length / 2)
+                CMBlockBufferCopyDataBytes(blockBuffer, atOffset: 0, dataLength: samples.count * 2, destination: &samples)
+
+                for frame in 0..<(samples.count / channels) {
+                    let time = start + Double(frame) / format.mSampleRate
+                    let bucket = min(max(Int(time / duration * Double(count)), 0), count - 1)
+                    for channel in 0..<channels {
+                        let amplitude = abs(Double(samples[frame * channels + channel])) / 32768
+                        peaks[bucket] = max(peaks[bucket], amplitude)
+                    }
+                }
+            }
+            if reader.status == .failed {
+                return (nil, reader.error ?? NSError(domain: "Audio", code: 500, userInfo: [NSLocalizedDescriptionKey: "Decoding failed"]))
+            }
+            return (["peaks": peaks, "durationMs": Int64(duration * 1000)], nil)
+        } catch {
+            return (nil, error)
+        }
+    }
+
+    /// Returns url if it is a file URL, or downloads it to a temporary file.
+    private static func localFile(for url: URL) throws -> URL {
+        if url.isFileURL {
+            return url
+        }
+        let semaphore = DispatchSemaphore(value: 0)
+        var result: Result<URL, Error> = .failure(URLError(.unknown))
+        URLSession.shared.downloadTask(with: url) { location, _, error in
+            defer { semaphore.signal() }
+            guard let location = location else {
+                result = .failure(error ?? URLError(.unknown))
+                return
+            }
+            let destination = FileManager.default.temporaryDirectory
+                .appendingPathComponent(UUID().uuidString)
+                .appendingPathExtension(url.pathExtension)
+            do {
+                try FileManager.default.moveItem(at: location, to: destination)
+                result = .success(destination)
+            } catch {
+                result = .failure(error)
+            }
+        }.resume()
+        semaphore.wait()
+        return try result.get()
+    }
+}
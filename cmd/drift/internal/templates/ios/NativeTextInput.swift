@@ -2,6 +2,7 @@
 /// Provides native text input views embedded in Drift UI with Skia chrome.
 
 import UIKit
+import UniformTypeIdentifiers
 
 // MARK: - Padded Text Field
 
@@ -31,6 +32,17 @@ class TouchPointerIDManager {
 /// UITextField subclass with configurable padding.
 class PaddedTextField: UITextField {
     var padding: UIEdgeInsets = .zero
+    var contentInsertion = ContentPasteHandler()
+
+    override func canPerformAction(_ action: Selector, withSender sender: Any?) -> Bool {
+        if action == #selector(paste(_:)) && contentInsertion.canPaste() { return true }
+        return super.canPerformAction(action, withSender: sender)
+    }
+
+    override func paste(_ sender: Any?) {
+        if contentInsertion.paste() { return }
+        super.paste(sender)
+    }
 
     override func textRect(forBounds bounds: CGRect) -> CGRect {
         return bounds.inset(by: padding)
@@ -45,10 +57,51 @@ class PaddedTextField: UITextField {
     }
 }
 
+// MARK: - Content Paste
+
+/// Intercepts pastes of images (including GIFs and stickers copied from
+/// keyboards) whose type is accepted, handing them to `onContent` instead of
+/// the text view. Other pastes fall through to UIKit.
+struct ContentPasteHandler {
+    var acceptedTypes: [UTType] = []
+    var onContent: ((Data, String) -> Void)?
+
+    func canPaste() -> Bool {
+        guard !acceptedTypes.isEmpty else { return false }
+        return UIPasteboard.general.contains(pasteboardTypes: acceptedTypes.map(\.identifier))
+            || UIPasteboard.general.itemProviders.contains { matchingType(in: $0) != nil }
+    }
+
+    /// Returns true if the pasteboard held accepted content.
+    func paste() -> Bool {
+        guard let onContent = onContent, !acceptedTypes.isEmpty else { return false }
+        var handled = false
+        for provider in UIPasteboard.general.itemProviders {
+            guard let type = matchingType(in: provider) else { continue }
+            handled = true
+            let mimeType = type.preferredMIMEType ?? "application/octet-stream"
+            provider.loadDataRepresentation(forTypeIdentifier: type.identifier) { data, _ in
+                guard let data = data else { return }
+                DispatchQueue.main.async { onContent(data, mimeType) }
+            }
+        }
+        return handled
+    }
+
+    private func matchingType(in provider: NSItemProvider) -> UTType? {
+        for identifier in provider.registeredTypeIdentifiers {
+            guard let type = UTType(identifier) else { continue }
+            if acceptedTypes.contains(where: { type.conforms(to: $0) }) { return type }
+        }
+        return nil
+    }
+}
+
 // MARK: - Padded Text View
 
 /// UITextView subclass with configurable padding via textContainerInset.
 class PaddedTextView: UITextView {
+    var contentInsertion = ContentPasteHandler()
     var placeholderLabel: UILabel?
     var placeholderColor: UIColor = UIColor(white: 0.6, alpha: 1.0)
     var placeholderText: String = "" {
@@ -66,6 +119,16 @@ class PaddedTextView: UITextView {
         placeholderLabel?.isHidden = !text.isEmpty
     }
 
+    override func canPerformAction(_ action: Selector, withSender sender: Any?) -> Bool {
+        if action == #selector(paste(_:)) && contentInsertion.canPaste() { return true }
+        return super.canPerformAction(action, withSender: sender)
+    }
+
+    override func paste(_ sender: Any?) {
+        if contentInsertion.paste() { return }
+        super.paste(sender)
+    }
+
     func setupPlaceholder() {
         let label = UILabel()
         label.text = placeholderText
@@ -98,6 +161,7 @@ class NativeTextInputContainer: NSObject, PlatformViewContainer, UITextFieldDele
     private var isMultiline: Bool = false
     private var suppressCallback: Bool = false
     private var config: TextInputViewConfig
+    private var spellCheckWork: DispatchWorkItem?
 
     init(viewId: Int, params: [String: Any]) {
         self.viewId = viewId
@@ -113,7 +177,14 @@ class NativeTextInputContainer: NSObject, PlatformViewContainer, UITextFieldDele
             tv.keyboardType = config.keyboardType
             tv.returnKeyType = config.returnKeyType
             tv.autocorrectionType = config.autocorrect ? .yes : .no
+            tv.keyboardAppearance = config.keyboardAppearance
+            tv.enablesReturnKeyAutomatically = config.enablesReturnKeyAutomatically
+            tv.smartDashesType = config.smartDashes ? .yes : .no
+            tv.smartQuotesType = config.smartQuotes ? .yes : .no
+            tv.spellCheckingType = config.spellCheck
             tv.autocapitalizationType = config.capitalization
+            tv.textContentType = config.textContentType
+            tv.tintColor = config.hideCursor ? .clear : nil
             tv.isSecureTextEntry = config.obscure
             tv.textContainerInset = config.padding
             tv.placeholderText = config.placeholder
@@ -123,6 +194,7 @@ class NativeTextInputContainer: NSObject, PlatformViewContainer, UITextFieldDele
 
             super.init()
             tv.delegate = self
+            tv.contentInsertion = makeContentPasteHandler()
             tv.setupPlaceholder()
         } else {
             let tf = PaddedTextField()
@@ -134,7 +206,14 @@ class NativeTextInputContainer: NSObject, PlatformViewContainer, UITextFieldDele
             tf.keyboardType = config.keyboardType
             tf.returnKeyType = config.returnKeyType
             tf.autocorrectionType = config.autocorrect ? .yes : .no
+            tf.keyboardAppearance = config.keyboardAppearance
+            tf.enablesReturnKeyAutomatically = config.enablesReturnKeyAutomatically
+            tf.smartDashesType = config.smartDashes ? .yes : .no
+            tf.smartQuotesType = config.smartQuotes ? .yes : .no
+            tf.spellCheckingType = config.spellCheck
             tf.autocapitalizationType = config.capitalization
+            tf.textContentType = config.textContentType
+            tf.tintColor = config.hideCursor ? .clear : nil
             tf.isSecureTextEntry = config.obscure
             tf.padding = config.padding
             tf.placeholder = config.placeholder
@@ -147,6 +226,7 @@ class NativeTextInputContainer: NSObject, PlatformViewContainer, UITextFieldDele
 
             super.init()
             tf.delegate = self
+            tf.contentInsertion = makeContentPasteHandler()
             tf.addTarget(self, action: #selector(textDidChange), for: .editingChanged)
         }
 
@@ -157,6 +237,7 @@ class NativeTextInputContainer: NSObject, PlatformViewContainer, UITextFieldDele
     }
 
     func dispose() {
+        spellCheckWork?.cancel()
         textField?.resignFirstResponder()
         textView?.resignFirstResponder()
         view.removeFromSuperview()
@@ -196,6 +277,7 @@ class NativeTextInputContainer: NSObject, PlatformViewContainer, UITextFieldDele
             textField?.text = text
         }
         suppressCallback = false
+        scheduleSpellCheck()
     }
 
     func setSelection(base: Int, extent: Int) {
@@ -236,37 +318,180 @@ class NativeTextInputContainer: NSObject, PlatformViewContainer, UITextFieldDele
     }
 
     func updateConfig(_ params: [String: Any]) {
+        let wasReporting = config.reportMisspellings
         config = TextInputViewConfig(params: params)
+        if config.reportMisspellings != wasReporting {
+            scheduleSpellCheck()
+        }
 
         if isMultiline {
             guard let tv = textView else { return }
+            if tv.isSecureTextEntry != config.obscure {
+                setSecureTextEntry(config.obscure, on: tv)
+            }
             tv.font = config.font
             tv.textColor = config.textColor
             tv.textAlignment = config.textAlignment
             tv.keyboardType = config.keyboardType
             tv.returnKeyType = config.returnKeyType
             tv.autocorrectionType = config.autocorrect ? .yes : .no
+            if tv.keyboardAppearance != config.keyboardAppearance {
+                tv.keyboardAppearance = config.keyboardAppearance
+                // A visible keyboard only picks up the new appearance on reload.
+                if tv.isFirstResponder { tv.reloadInputViews() }
+            }
+            tv.enablesReturnKeyAutomatically = config.enablesReturnKeyAutomatically
+            tv.smartDashesType = config.smartDashes ? .yes : .no
+            tv.smartQuotesType = config.smartQuotes ? .yes : .no
+            tv.spellCheckingType = config.spellCheck
             tv.autocapitalizationType = config.capitalization
-            tv.isSecureTextEntry = config.obscure
+            tv.textContentType = config.textContentType
+            tv.tintColor = config.hideCursor ? .clear : nil
             tv.textContainerInset = config.padding
             tv.placeholderText = config.placeholder
             tv.placeholderColor = config.placeholderColor
             tv.placeholderLabel?.textColor = config.placeholderColor
+            tv.contentInsertion = makeContentPasteHandler()
         } else {
             guard let tf = textField else { return }
+            if tf.isSecureTextEntry != config.obscure {
+                setSecureTextEntry(config.obscure, on: tf)
+            }
             tf.font = config.font
             tf.textColor = config.textColor
             tf.textAlignment = config.textAlignment
             tf.keyboardType = config.keyboardType
             tf.returnKeyType = config.returnKeyType
             tf.autocorrectionType = config.autocorrect ? .yes : .no
+            if tf.keyboardAppearance != config.keyboardAppearance {
+                tf.keyboardAppearance = config.keyboardAppearance
+                // A visible keyboard only picks up the new appearance on reload.
+                if tf.isFirstResponder { tf.reloadInputViews() }
+            }
+            tf.enablesReturnKeyAutomatically = config.enablesReturnKeyAutomatically
+            tf.smartDashesType = config.smartDashes ? .yes : .no
+            tf.smartQuotesType = config.smartQuotes ? .yes : .no
+            tf.spellCheckingType = config.spellCheck
             tf.autocapitalizationType = config.capitalization
-            tf.isSecureTextEntry = config.obscure
+            tf.textContentType = config.textContentType
+            tf.tintColor = config.hideCursor ? .clear : nil
             tf.padding = config.padding
             tf.attributedPlaceholder = NSAttributedString(
                 string: config.placeholder,
                 attributes: [.foregroundColor: config.placeholderColor]
             )
+            tf.contentInsertion = makeContentPasteHandler()
+        }
+    }
+
+    // MARK: - Spell Check
+
+    private static let spellChecker = UITextChecker()
+
+    /// Checks the text once typing pauses and reports misspelled words.
+    private func scheduleSpellCheck() {
+        spellCheckWork?.cancel()
+        guard config.reportMisspellings else { return }
+        let work = DispatchWorkItem { [weak self] in self?.runSpellCheck() }
+        spellCheckWork = work
+        DispatchQueue.main.asyncAfter(deadline: .now() + 0.3, execute: work)
+    }
+
+    private func runSpellCheck() {
+        let text = (isMultiline ? textView?.text : textField?.text) ?? ""
+        let nsText = text as NSString
+        let language = spellCheckLanguage()
+        var ranges: [[String: Any]] = []
+        var offset = 0
+        while offset < nsText.length {
+            let range = NativeTextInputContainer.spellChecker.rangeOfMisspelledWord(
+                in: text,
+                range: NSRange(location: 0, length: nsText.length),
+                startingAt: offset,
+                wrap: false,
+                language: language
+            )
+            if range.location == NSNotFound { break }
+            let guesses = NativeTextInputContainer.spellChecker.guesses(
+                forWordRange: range,
+                in: text,
+                language: language
+            ) ?? []
+            ranges.append([
+                "start": range.location,
+                "end": range.location + range.length,
+                "word": nsText.substring(with: range),
+                "suggestions": Array(guesses.prefix(5))
+            ])
+            offset = range.location + range.length
+        }
+        PlatformChannelManager.shared.sendEvent(
+            channel: "drift/platform_views",
+            data: [
+                "method": "onSpellCheckResults",
+                "viewId": viewId,
+                "ranges": ranges
+            ]
+        )
+    }
+
+    /// Uses the active keyboard's language when the checker supports it.
+    private func spellCheckLanguage() -> String {
+        let available = UITextChecker.availableLanguages
+        if let primary = view.textInputMode?.primaryLanguage?.replacingOccurrences(of: "-", with: "_") {
+            if available.contains(primary) { return primary }
+            let base = String(primary.prefix(while: { $0 != "_" }))
+            if let match = available.first(where: { $0.hasPrefix(base) }) { return match }
+        }
+        return available.first(where: { $0.hasPrefix("en") }) ?? available.first ?? "en"
+    }
+
+    // MARK: - Content Insertion
+
+    private func makeContentPasteHandler() -> ContentPasteHandler {
+        var handler = ContentPasteHandler()
+        handler.acceptedTypes = config.contentTypes
+        handler.onContent = { [weak self] data, mimeType in
+            self?.sendContentInserted(data: data, mimeType: mimeType)
+        }
+        return handler
+    }
+
+    private func sendContentInserted(data: Data, mimeType: String) {
+        PlatformChannelManager.shared.sendEvent(
+            channel: "drift/platform_views",
+            data: [
+                "method": "onContentInserted",
+                "viewId": viewId,
+                "mimeType": mimeType,
+                "data": data.base64EncodedString()
+            ]
+        )
+    }
+
+    /// Toggles secure entry while keeping the text and selection. UIKit clears
+    /// a secure field on the next keystroke after secure entry is enabled, so
+    /// the text is re-inserted to make it the field's own edit.
+    private func setSecureTextEntry(_ secure: Bool, on input: UIView & UITextInput) {
+        var start = 0, end = 0
+        if let range = input.selectedTextRange {
+            start = input.offset(from: input.beginningOfDocument, to: range.start)
+            end = input.offset(from: input.beginningOfDocument, to: range.end)
+        }
+        if let tf = input as? UITextField {
+            tf.isSecureTextEntry = secure
+            if secure, let text = tf.text, !text.isEmpty {
+                suppressCallback = true
+                tf.text = ""
+                tf.insertText(text)
+                suppressCallback = false
+            }
+        } else if let tv = input as? UITextView {
+            tv.isSecureTextEntry = secure
+        }
+        if let from = input.position(from: input.beginningOfDocument, offset: start),
+           let to = input.position(from: input.beginningOfDocument, offset: end) {
+            input.selectedTextRange = input.textRange(from: from, to: to)
         }
     }
 
@@ -274,9 +499,61 @@ class NativeTextInputContainer: NSObject, PlatformViewContainer, UITextFieldDele
 
     @objc private func textDidChange() {
         guard !suppressCallback, let tf = textField else { return }
+        if truncateCommittedOverflow(tf) { return }
         sendTextChanged(text: tf.text ?? "", textInput: tf)
     }
 
+    // MARK: - Max Length
+
+    /// Decides whether a user edit may be applied under the max length limit.
+    /// Edits during IME composition (marked text) are always allowed; any
+    /// overflow is trimmed once the composition commits.
+    private func shouldApplyEdit(current: String, range: NSRange, replacement: String, textInput: UITextInput) -> Bool {
+        guard config.maxLength > 0, config.maxLengthEnforcement != .unlimited,
+              textInput.markedTextRange == nil,
+              let swiftRange = Range(range, in: current) else {
+            return true
+        }
+        let kept = current.unicodeScalars.count - current[swiftRange].unicodeScalars.count
+        let available = config.maxLength - kept
+        if replacement.unicodeScalars.count <= available {
+            return true
+        }
+        if config.maxLengthEnforcement == .block || available <= 0 {
+            return false
+        }
+
+        // Truncate: insert only the part of the replacement that fits.
+        let fitted = String(String.UnicodeScalarView(replacement.unicodeScalars.prefix(available)))
+        if let start = textInput.position(from: textInput.beginningOfDocument, offset: range.location),
+           let end = textInput.position(from: start, offset: range.length),
+           let textRange = textInput.textRange(from: start, to: end) {
+            textInput.replace(textRange, withText: fitted)
+            (textInput as? PaddedTextView)?.updatePlaceholder()
+            let text = (textInput as? UITextField)?.text ?? (textInput as? UITextView)?.text ?? ""
+            sendTextChanged(text: text, textInput: textInput)
+        }
+        return false
+    }
+
+    /// Trims committed text that exceeds the limit, e.g. after a composition
+    /// that ran past it. Returns true if the text was changed and reported.
+    private func truncateCommittedOverflow(_ textInput: UITextInput & UIView) -> Bool {
+        guard config.maxLength > 0, config.maxLengthEnforcement != .unlimited,
+              textInput.markedTextRange == nil else {
+            return false
+        }
+        let text = (textInput as? UITextField)?.text ?? (textInput as? UITextView)?.text ?? ""
+        guard text.unicodeScalars.count > config.maxLength else { return false }
+
+        let trimmed = String(String.UnicodeScalarView(text.unicodeScalars.prefix(config.maxLength)))
+        setText(trimmed)
+        let end = textInput.endOfDocument
+        textInput.selectedTextRange = textInput.textRange(from: end, to: end)
+        sendTextChanged(text: trimmed, textInput: textInput)
+        return true
+    }
+
     private func sendTextChanged(text: String, textInput: UITextInput) {
         var selBase = text.count
         var selExtent = text.count
@@ -286,6 +563,7 @@ class NativeTextInputContainer: NSObject, PlatformViewContainer, UITextFieldDele
             selExtent = textInput.offset(from: textInput.beginningOfDocument, to: range.end)
         }
 
+        scheduleSpellCheck()
         PlatformChannelManager.shared.sendEvent(
             channel: "drift/platform_views",
             data: [
@@ -330,6 +608,10 @@ class NativeTextInputContainer: NSObject, PlatformViewContainer, UITextFieldDele
         sendFocusChanged(false)
     }
 
+    func textField(_ textField: UITextField, shouldChangeCharactersIn range: NSRange, replacementString string: String) -> Bool {
+        return shouldApplyEdit(current: textField.text ?? "", range: range, replacement: string, textInput: textField)
+    }
+
     func textFieldShouldReturn(_ textField: UITextField) -> Bool {
         let action = actionFromReturnKeyType(config.returnKeyType)
         sendAction(action)
@@ -355,11 +637,16 @@ class NativeTextInputContainer: NSObject, PlatformViewContainer, UITextFieldDele
         sendFocusChanged(false)
     }
 
+    func textView(_ textView: UITextView, shouldChangeTextIn range: NSRange, replacementText text: String) -> Bool {
+        return shouldApplyEdit(current: textView.text, range: range, replacement: text, textInput: textView)
+    }
+
     func textViewDidChange(_ textView: UITextView) {
         guard !suppressCallback else { return }
         if let ptv = textView as? PaddedTextView {
             ptv.updatePlaceholder()
         }
+        if truncateCommittedOverflow(textView) { return }
         sendTextChanged(text: textView.text, textInput: textView)
     }
 
@@ -379,6 +666,13 @@ class NativeTextInputContainer: NSObject, PlatformViewContainer, UITextFieldDele
 
 // MARK: - Text Input View Config
 
+/// Matches platform.MaxLengthEnforcement in Go.
+enum MaxLengthEnforcement: Int {
+    case truncate = 0
+    case block = 1
+    case unlimited = 2
+}
+
 /// Configuration for native text input view.
 struct TextInputViewConfig {
     let fontFamily: String
@@ -394,8 +688,20 @@ struct TextInputViewConfig {
     let keyboardType: UIKeyboardType
     let returnKeyType: UIReturnKeyType
     let capitalization: UITextAutocapitalizationType
+    let keyboardAppearance: UIKeyboardAppearance
+    let enablesReturnKeyAutomatically: Bool
+    let smartDashes: Bool
+    let smartQuotes: Bool
+    let maxLength: Int
+    let maxLengthEnforcement: MaxLengthEnforcement
     let padding: UIEdgeInsets
     let placeholder: String
+    let contentTypes: [UTType]
+    let spellCheck: UITextSpellCheckingType
+    let reportMisspellings: Bool
+    let textContentType: UITextContentType?
+    /// Hides the caret and selection highlight, which both follow tintColor.
+    let hideCursor: Bool
 
     var font: UIFont {
         if fontFamily.isEmpty {
@@ -447,8 +753,18 @@ struct TextInputViewConfig {
         multiline = params["multiline"] as? Bool ?? false
         maxLines = params["maxLines"] as? Int ?? 0
         obscure = params["obscure"] as? Bool ?? false
+        // obscuringCharacter is Android-only; iOS always uses the system bullet.
         autocorrect = params["autocorrect"] as? Bool ?? true
 
+        switch params["keyboardAppearance"] as? Int ?? 0 {
+        case 1: keyboardAppearance = .light
+        case 2: keyboardAppearance = .dark
+        default: keyboardAppearance = .default
+        }
+        enablesReturnKeyAutomatically = params["enablesReturnKeyAutomatically"] as? Bool ?? false
+        smartDashes = params["smartDashes"] as? Bool ?? true
+        smartQuotes = params["smartQuotes"] as? Bool ?? true
+
         let kbType = params["keyboardType"] as? Int ?? 0
         switch kbType {
         case 1: keyboardType = .numberPad
@@ -481,6 +797,9 @@ struct TextInputViewConfig {
         default: capitalization = .sentences
         }
 
+        maxLength = params["maxLength"] as? Int ?? 0
+        maxLengthEnforcement = MaxLengthEnforcement(rawValue: params["maxLengthEnforcement"] as? Int ?? 0) ?? .truncate
+
         let paddingLeft = CGFloat(params["paddingLeft"] as? Double ?? 0)
         let paddingTop = CGFloat(params["paddingTop"] as? Double ?? 0)
         let paddingRight = CGFloat(params["paddingRight"] as? Double ?? 0)
@@ -488,6 +807,51 @@ struct TextInputViewConfig {
         padding = UIEdgeInsets(top: paddingTop, left: paddingLeft, bottom: paddingBottom, right: paddingRight)
 
         placeholder = params["placeholder"] as? String ?? ""
+
+        switch params["spellCheck"] as? Int ?? 0 {
+        case 1: spellCheck = .yes
+        case 2: spellCheck = .no
+        default: spellCheck = .default
+        }
+        reportMisspellings = params["reportMisspellings"] as? Bool ?? false
+        hideCursor = params["hideCursor"] as? Bool ?? false
+
+        let mimeTypes = (params["contentMimeTypes"] as? String ?? "")
+            .split(separator: ",")
+            .map { $0.trimmingCharacters(in: .whitespaces) }
+        contentTypes = mimeTypes.compactMap(TextInputViewConfig.contentType(forMimeType:))
+
+        // A text view takes a single content type; use the first hint it knows.
+        textContentType = (params["autofillHints"] as? String ?? "")
+            .split(separator: ",")
+            .lazy
+            .compactMap { TextInputViewConfig.textContentType(forAutofillHint: $0.trimmingCharacters(in: .whitespaces)) }
+            .first
+    }
+
+    /// Maps a platform.AutofillHint name from Go to a UITextContentType.
+    private static func textContentType(forAutofillHint hint: String) -> UITextContentType? {
+        switch hint {
+        case "username": return .username
+        case "password": return .password
+        case "newPassword": return .newPassword
+        case "email": return .emailAddress
+        case "name": return .name
+        case "phone": return .telephoneNumber
+        case "postalCode": return .postalCode
+        case "oneTimeCode": return .oneTimeCode
+        default: return nil
+        }
+    }
+
+    /// Maps a MIME type, including "image/*" style wildcards, to a UTType.
+    private static func contentType(forMimeType mimeType: String) -> UTType? {
+        switch mimeType {
+        case "image/*": return .image
+        case "video/*": return .movie
+        case "audio/*": return .audio
+        default: return UTType(mimeType: mimeType)
+        }
     }
 }
 
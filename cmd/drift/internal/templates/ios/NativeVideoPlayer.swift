@@ -17,14 +17,35 @@ class NativeVideoPlayerContainer: NSObject, PlatformViewContainer {
     private var timeControlObservation: NSKeyValueObservation?
     private var itemStatusObservation: NSKeyValueObservation?
     private var endOfItemObserver: NSObjectProtocol?
+    private var interruptionObserver: NSObjectProtocol?
+    // Whether playback was asked for. The system pauses the player before
+    // reporting an interruption, so this tells whether it was playing.
+    private var playRequested = false
+    private var externalPlaybackObservation: NSKeyValueObservation?
     private var playerLooper: AVPlayerLooper?
     private var playbackSpeed: Float = 1.0
     private var isLooping: Bool = false
     private var hasReachedEnd: Bool = false
     private var isStopped: Bool = false
+    private let preserveHDR: Bool
+    /// Renditions of the current HLS item, lowest bitrate first. Quality IDs
+    /// are indices into this list.
+    private var variants: [AVAssetVariant] = []
+    /// The rendition pinned with selectQuality, or "" for adaptive selection.
+    private var pinnedQuality = ""
+    private var maxQualityHeight = 0
+    private var maxQualityBitrate = 0
+    private var accessLogObserver: NSObjectProtocol?
+    /// Acquires FairPlay keys for the current item when it is protected.
+    private var contentKeySession: AVContentKeySession?
+    private var fairPlayDelegate: FairPlayKeyDelegate?
+    /// Receives caption text so Drift can draw it; the player's own caption
+    /// rendering is suppressed.
+    private let legibleOutput = AVPlayerItemLegibleOutput()
 
     init(viewId: Int, params: [String: Any]) {
         self.viewId = viewId
+        self.preserveHDR = params["preserveHDR"] as? Bool ?? false
 
         DriftMediaSession.activate()
 
@@ -42,6 +63,9 @@ class NativeVideoPlayerContainer: NSObject, PlatformViewContainer {
 
         super.init()
 
+        legibleOutput.suppressesPlayerRendering = true
+        legibleOutput.setDelegate(self, queue: .main)
+
         // Configure from params
         let looping = params["looping"] as? Bool ?? false
         let volume = (params["volume"] as? NSNumber)?.floatValue ?? 1.0
@@ -50,6 +74,25 @@ class NativeVideoPlayerContainer: NSObject, PlatformViewContainer {
         self.isLooping = looping
         player.volume = volume
 
+        // AirPlay moves video to the receiver while this player is playing.
+        // The user can pick a receiver before Go uses the cast channel, so
+        // sessions are observed from the first player.
+        player.allowsExternalPlayback = true
+        DispatchQueue.main.async { CastHandler.observeRoutes() }
+        externalPlaybackObservation = player.observe(\.isExternalPlaybackActive) { [weak self] player, _ in
+            guard let self = self else { return }
+            let casting = player.isExternalPlaybackActive
+            PlatformChannelManager.shared.sendEvent(
+                channel: "drift/platform_views",
+                data: [
+                    "method": "onCastingChanged",
+                    "viewId": self.viewId,
+                    "casting": casting,
+                    "deviceName": casting ? CastHandler.airPlayDeviceName() : ""
+                ]
+            )
+        }
+
         // Observe player time control status for playback state
         timeControlObservation = player.observe(\.timeControlStatus) { [weak self] player, _ in
             guard let self = self else { return }
@@ -69,6 +112,7 @@ class NativeVideoPlayerContainer: NSObject, PlatformViewContainer {
                 self.stopPositionUpdates()
             case .playing:
                 state = 2 // Playing
+                self.playRequested = true
                 self.startPositionUpdates()
             @unknown default:
                 state = 0 // Idle
@@ -84,6 +128,20 @@ class NativeVideoPlayerContainer: NSObject, PlatformViewContainer {
             )
         }
 
+        interruptionObserver = DriftMediaSession.observeInterruptions(
+            wasPlaying: { [weak self] in
+                guard let self = self else { return false }
+                return self.playRequested && !self.hasReachedEnd && !self.isStopped
+            },
+            send: { [weak self] event in
+                guard let self = self else { return }
+                var data = event
+                data["method"] = "onInterruption"
+                data["viewId"] = self.viewId
+                PlatformChannelManager.shared.sendEvent(channel: "drift/platform_views", data: data)
+            }
+        )
+
         // Load media if URL provided
         if let urlString = params["url"] as? String, let url = URL(string: urlString) {
             loadItem(url: url)
@@ -134,7 +192,8 @@ class NativeVideoPlayerContainer: NSObject, PlatformViewContainer {
     }
 
     /// Loads an AVPlayerItem from a URL, setting up observers and looping.
-    private func loadItem(url: URL) {
+    /// drm configures FairPlay key acquisition for protected HLS.
+    private func loadItem(url: URL, drm: [String: Any]? = nil) {
         isStopped = false
         hasReachedEnd = false
 
@@ -147,15 +206,62 @@ class NativeVideoPlayerContainer: NSObject, PlatformViewContainer {
             NotificationCenter.default.removeObserver(observer)
             endOfItemObserver = nil
         }
-
-        let item = AVPlayerItem(url: url)
+        if let observer = accessLogObserver {
+            NotificationCenter.default.removeObserver(observer)
+            accessLogObserver = nil
+        }
+        variants = []
+        pinnedQuality = ""
+
+        player.currentItem?.remove(legibleOutput)
+        contentKeySession?.invalidate()
+        contentKeySession = nil
+        fairPlayDelegate = nil
+        let asset = AVURLAsset(url: url)
+        if let drm = drm {
+            guard drm["scheme"] as? String == "fairplay" else {
+                sendDRMError("\(drm["scheme"] ?? "unknown") DRM is not supported on iOS")
+                return
+            }
+            guard let delegate = FairPlayKeyDelegate(config: drm, onError: { [weak self] message in
+                self?.sendDRMError(message)
+            }) else {
+                sendDRMError("Invalid FairPlay license URL")
+                return
+            }
+            let session = AVContentKeySession(keySystem: .fairPlayStreaming)
+            session.setDelegate(delegate, queue: DispatchQueue(label: "drift.video.fairplay"))
+            session.addContentKeyRecipient(asset)
+            contentKeySession = session
+            fairPlayDelegate = delegate
+        }
+        let item = AVPlayerItem(asset: asset)
+        item.add(legibleOutput)
+        // AVPlayer presents HDR whenever the device is eligible; per-frame
+        // brightness metadata (Dolby Vision, HDR10+) is only applied on request.
+        item.appliesPerFrameHDRDisplayMetadata = preserveHDR
+        applyQualityPreferences(to: item)
         player.replaceCurrentItem(with: item)
+        loadVariants(of: item)
+
+        // Each access log entry reports the rendition being played.
+        accessLogObserver = NotificationCenter.default.addObserver(
+            forName: .AVPlayerItemNewAccessLogEntry,
+            object: item,
+            queue: .main
+        ) { [weak self] _ in
+            self?.sendQualityChanged(for: item)
+        }
 
         // Observe item status for errors
         itemStatusObservation?.invalidate()
         itemStatusObservation = item.observe(\.status) { [weak self] item, _ in
             guard let self = self else { return }
-            if item.status == .failed {
+            if item.status == .readyToPlay {
+                DispatchQueue.main.async {
+                    self.sendSubtitleTracks(for: item)
+                }
+            } else if item.status == .failed {
                 let error = item.error
                 PlatformChannelManager.shared.sendEvent(
                     channel: "drift/platform_views",
@@ -199,16 +305,29 @@ class NativeVideoPlayerContainer: NSObject, PlatformViewContainer {
         stopPositionUpdates()
         timeControlObservation?.invalidate()
         timeControlObservation = nil
+        externalPlaybackObservation?.invalidate()
+        externalPlaybackObservation = nil
         itemStatusObservation?.invalidate()
         itemStatusObservation = nil
         if let observer = endOfItemObserver {
             NotificationCenter.default.removeObserver(observer)
             endOfItemObserver = nil
         }
+        if let observer = accessLogObserver {
+            NotificationCenter.default.removeObserver(observer)
+            accessLogObserver = nil
+        }
+        if let observer = interruptionObserver {
+            NotificationCenter.default.removeObserver(observer)
+            interruptionObserver = nil
+        }
         playerLooper?.disableLooping()
         playerLooper = nil
         player.pause()
+        player.currentItem?.remove(legibleOutput)
         player.replaceCurrentItem(with: nil)
+        contentKeySession?.invalidate()
+        contentKeySession = nil
         view.removeFromSuperview()
 
         DriftMediaSession.deactivate()
@@ -217,6 +336,7 @@ class NativeVideoPlayerContainer: NSObject, PlatformViewContainer {
     func play() {
         isStopped = false
         hasReachedEnd = false
+        playRequested = true
         player.play()
         if playbackSpeed != 1.0 {
             player.rate = playbackSpeed
@@ -224,10 +344,12 @@ class NativeVideoPlayerContainer: NSObject, PlatformViewContainer {
     }
 
     func pause() {
+        playRequested = false
         player.pause()
     }
 
     func stop() {
+        playRequested = false
         isStopped = true
         hasReachedEnd = false
         player.pause()
@@ -277,8 +399,361 @@ class NativeVideoPlayerContainer: NSObject, PlatformViewContainer {
         playerVC.showsPlaybackControls = show
     }
 
-    func load(_ urlString: String) {
+    func load(_ urlString: String, drm: [String: Any]? = nil) {
         guard let url = URL(string: urlString) else { return }
-        loadItem(url: url)
+        loadItem(url: url, drm: drm)
+    }
+
+    private func sendDRMError(_ message: String) {
+        PlatformChannelManager.shared.sendEvent(
+            channel: "drift/platform_views",
+            data: [
+                "method": "onVideoError",
+                "viewId": viewId,
+                "code": "drm_error",
+                "message": message
+            ]
+        )
+    }
+
+    /// Selects the legible option with the given index, or turns captions
+    /// off if the ID is empty or unknown.
+    func selectSubtitleTrack(_ id: String) {
+        guard let item = player.currentItem,
+              let group = item.asset.mediaSelectionGroup(forMediaCharacteristic: .legible) else { return }
+        if let index = Int(id), group.options.indices.contains(index) {
+            item.select(group.options[index], in: group)
+        } else {
+            item.select(nil, in: group)
+        }
+        sendSubtitleTracks(for: item)
+    }
+
+    /// Pins the rendition with the given index, or returns to adaptive
+    /// selection if the ID is empty or unknown. AVPlayer cannot force a
+    /// rendition, so a pinned one caps the bitrate and resolution instead.
+    func selectQuality(_ id: String) {
+        if let index = Int(id), variants.indices.contains(index) {
+            pinnedQuality = id
+        } else {
+            pinnedQuality = ""
+        }
+        if let item = player.currentItem {
+            applyQualityPreferences(to: item)
+        }
+    }
+
+    /// Caps adaptive selection. Zero values are unlimited.
+    func setQualityLimit(maxHeight: Int, maxBitrate: Int) {
+        maxQualityHeight = maxHeight
+        maxQualityBitrate = maxBitrate
+        if let item = player.currentItem {
+            applyQualityPreferences(to: item)
+        }
+    }
+
+    private func applyQualityPreferences(to item: AVPlayerItem) {
+        if let index = Int(pinnedQuality), variants.indices.contains(index) {
+            let variant = variants[index]
+            item.preferredPeakBitRate = variant.peakBitRate ?? 0
+            item.preferredMaximumResolution = variant.videoAttributes?.presentationSize ?? .zero
+        } else {
+            item.preferredPeakBitRate = Double(maxQualityBitrate)
+            item.preferredMaximumResolution = maxQualityHeight > 0
+                ? CGSize(width: CGFloat.greatestFiniteMagnitude, height: CGFloat(maxQualityHeight))
+                : .zero
+        }
+    }
+
+    /// Loads the HLS renditions of the item and reports them to Go.
+    /// Progressive media has none.
+    private func loadVariants(of item: AVPlayerItem) {
+        guard let asset = item.asset as? AVURLAsset else { return }
+        Task { @MainActor [weak self] in
+            guard let loaded = try? await asset.load(.variants),
+                  let self = self, self.player.currentItem === item else { return }
+            self.variants = loaded
+                .filter { $0.videoAttributes != nil }
+                .sorted { ($0.peakBitRate ?? 0) < ($1.peakBitRate ?? 0) }
+            if self.variants.isEmpty { return }
+            let qualities = self.variants.enumerated().map { index, variant in
+                self.qualityMap(id: String(index), variant: variant)
+            }
+            PlatformChannelManager.shared.sendEvent(
+                channel: "drift/platform_views",
+                data: [
+                    "method": "onQualitiesChanged",
+                    "viewId": self.viewId,
+                    "qualities": qualities,
+                    "selected": self.pinnedQuality
+                ]
+            )
+        }
+    }
+
+    /// Reports the rendition being played, matched to a variant by bitrate.
+    private func sendQualityChanged(for item: AVPlayerItem) {
+        guard let event = item.accessLog()?.events.last else { return }
+        let bitrate = event.indicatedBitrate
+        let size = item.presentationSize
+        var data: [String: Any] = [
+            "id": "",
+            "width": Int(size.width),
+            "height": Int(size.height),
+            "bitrate": bitrate > 0 ? Int(bitrate) : 0
+        ]
+        if let index = variants.firstIndex(where: { $0.peakBitRate == bitrate }) {
+            data = qualityMap(id: String(index), variant: variants[index])
+        }
+        data["method"] = "onQualityChanged"
+        data["viewId"] = viewId
+        PlatformChannelManager.shared.sendEvent(channel: "drift/platform_views", data: data)
+    }
+
+    private func qualityMap(id: String, variant: AVAssetVariant) -> [String: Any] {
+        let size = variant.videoAttributes?.presentationSize ?? .zero
+        return [
+            "id": id,
+            "width": Int(size.width),
+            "height": Int(size.height),
+            "bitrate": Int(variant.peakBitRate ?? 0)
+        ]
+    }
+
+    /// Reports the item's legible options to Go. Track IDs are indices into
+    /// the legible media selection group.
+    private func sendSubtitleTracks(for item: AVPlayerItem) {
+        var tracks: [[String: Any]] = []
+        var selected = ""
+        if let group = item.asset.mediaSelectionGroup(forMediaCharacteristic: .legible) {
+            let current = item.currentMediaSelection.selectedMediaOption(in: group)
+            for (index, option) in group.options.enumerated() {
+                tracks.append([
+                    "id": String(index),
+                    "label": option.displayName,
+                    "language": option.extendedLanguageTag ?? ""
+                ])
+                if option == current {
+                    selected = String(index)
+                }
+            }
+        }
+        PlatformChannelManager.shared.sendEvent(
+            channel: "drift/platform_views",
+            data: [
+                "method": "onSubtitleTracksChanged",
+                "viewId": viewId,
+                "tracks": tracks,
+                "selected": selected
+            ]
+        )
+    }
+}
+
+// MARK: - Captions
+
+extension NativeVideoPlayerContainer: AVPlayerItemLegibleOutputPushDelegate {
+    func legibleOutput(
+        _ output: AVPlayerItemLegibleOutput,
+        didOutputAttributedStrings strings: [NSAttributedString],
+        nativeSampleBuffers nativeSamples: [Any],
+        forItemTime itemTime: CMTime
+    ) {
+        let startMs = itemTime.isNumeric ? Int64(CMTimeGetSeconds(itemTime) * 1000) : 0
+        let cues: [[String: Any]] = strings.map { ["text": $0.string, "startMs": startMs] }
+        PlatformChannelManager.shared.sendEvent(
+            channel: "drift/platform_views",
+            data: [
+                "method": "onCueChanged",
+                "viewId": viewId,
+                "cues": cues
+            ]
+        )
+    }
+}
+
+// MARK: - FairPlay
+
+/// Acquires FairPlay Streaming keys: fetches the application certificate,
+/// creates the key request (SPC) for each skd:// key of the asset, posts it
+/// to the license server, and hands the response (CKC) back to AVFoundation.
+/// Failures are reported through onError on the main queue.
+final class FairPlayKeyDelegate: NSObject, AVContentKeySessionDelegate {
+    private let licenseURL: URL
+    private let certificateURL: URL?
+    private let headers: [String: String]
+    private var certificate: Data?
+    private let onError: (String) -> Void
+
+    init?(config: [String: Any], onError: @escaping (String) -> Void) {
+        guard let license = config["licenseUrl"] as? String, let licenseURL = URL(string: license) else {
+            return nil
+        }
+        self.licenseURL = licenseURL
+        self.certificateURL = (config["certificateUrl"] as? String).flatMap(URL.init(string:))
+        self.headers = (config["headers"] as? [String: Any])?.compactMapValues { $0 as? String } ?? [:]
+        if let encoded = config["certificate"] as? String {
+            self.certificate = Data(base64Encoded: encoded)
+        }
+        self.onError = onError
+        super.init()
+    }
+
+    func contentKeySession(_ session: AVContentKeySession, didProvide keyRequest: AVContentKeyRequest) {
+        handle(keyRequest)
+    }
+
+    func contentKeySession(_ session: AVContentKeySession, didProvideRenewingContentKeyRequest keyRequest: AVContentKeyRequest) {
+        handle(keyRequest)
+    }
+
+    private func handle(_ keyRequest: AVContentKeyRequest) {
+        guard let identifier = keyRequest.identifier as? String,
+              let contentId = identifier.replacingOccurrences(of: "skd://", with: "").data(using: .utf8) else {
+            fail(keyRequest, "FairPlay key request has no content identifier")
+            return
+        }
+        loadCertificate { certificate, message in
+            guard let certificate = certificate else {
+                self.fail(keyRequest, message)
+                return
+            }
+            keyRequest.makeStreamingContentKeyRequestData(
+                forApp: certificate,
+                contentIdentifier: contentId,
+                options: nil
+            ) { spc, error in
+                guard let spc = spc else {
+                    self.fail(keyRequest, "FairPlay key request failed: \(error?.localizedDescription ?? "no request data")")
+                    return
+                }
+                self.post(spc, to: self.licenseURL) { ckc, message in
+                    guard let ckc = ckc else {
+                        self.fail(keyRequest, "FairPlay license request failed: \(message)")
+                        return
+                    }
+                    keyRequest.processContentKeyResponse(AVContentKeyResponse(fairPlayStreamingKeyResponseData: ckc))
+                }
+            }
+        }
+    }
+
+    private func loadCertificate(completion: @escaping (Data?, String) -> Void) {
+        if let certificate = certificate {
+            completion(certificate, "")
+            return
+        }
+        guard let url = certificateURL else {
+            completion(nil, "FairPlay certificate is missing")
+            return
+        }
+        var request = URLRequest(url: url)
+        headers.forEach { request.setValue($1, forHTTPHeaderField: $0) }
+        send(request) { data, message in
+            self.certificate = data
+            completion(data, data == nil ? "FairPlay certificate request failed: \(message)" : "")
+        }
+    }
+
+    private func post(_ body: Data, to url: URL, completion: @escaping (Data?, String) -> Void) {
+        var request = URLRequest(url: url)
+        request.httpMethod = "POST"
+        request.httpBody = body
+        request.setValue("application/octet-stream", forHTTPHeaderField: "Content-Type")
+        headers.forEach { request.setValue($1, forHTTPHeaderField: $0) }
+        send(request, completion: completion)
+    }
+
+    /// Sends a request, treating non-2xx responses as failures.
+    private func send(_ request: URLRequest, completion: @escaping (Data?, String) -> Void) {
+        URLSession.shared.dataTask(with: request) { data, response, error in
+            if let error = error {
+                completion(nil, error.localizedDescription)
+                return
+            }
+            let status = (response as? HTTPURLResponse)?.statusCode ?? 0
+            guard (200..<300).contains(status), let data = data, !data.isEmpty else {
+                completion(nil, "HTTP \(status)")
+                return
+            }
+            completion(data, "")
+        }.resume()
+    }
+
+    private func fail(_ keyRequest: AVContentKeyRequest, _ message: String) {
+        keyRequest.processContentKeyResponseError(
+            NSError(domain: "DRM", code: 403, userInfo: [NSLocalizedDescriptionKey: message])
+        )
+        DispatchQueue.main.async { self.onError(message) }
+    }
+}
+
+// MARK: - Video Handler
+
+/// Handles device video capability queries and frame extraction from Go.
+enum VideoHandler {
+    static func handle(method: String, args: Any?) -> (Any?, Error?) {
+        switch method {
+        case "getHDRCapabilities":
+            return (hdrCapabilities(), nil)
+        case "frameAt":
+            return frameAt(args: args as? [String: Any])
+        default:
+            return (nil, NSError(domain: "Video", code: 404, userInfo: [NSLocalizedDescriptionKey: "Unknown method: \(method)"]))
+        }
+    }
+
+    /// Decodes the frame of a file or remote URL at a position and returns
+    /// it as a base64 JPEG, scaled down to fit maxWidth x maxHeight.
+    /// Positions past the end return the last frame.
+    private static func frameAt(args: [String: Any]?) -> (Any?, Error?) {
+        guard let urlString = args?["url"] as? String,
+              let url = URL(string: urlString) else {
+            return (nil, NSError(domain: "Video", code: 400, userInfo: [NSLocalizedDescriptionKey: "Missing url"]))
+        }
+        let positionMs = (args?["positionMs"] as? NSNumber)?.int64Value ?? 0
+        let maxWidth = (args?["maxWidth"] as? NSNumber)?.doubleValue ?? 0
+        let maxHeight = (args?["maxHeight"] as? NSNumber)?.doubleValue ?? 0
+        let exact = args?["exact"] as? Bool ?? false
+
+        let asset = AVURLAsset(url: url)
+        let generator = AVAssetImageGenerator(asset: asset)
+        generator.appliesPreferredTrackTransform = true
+        if maxWidth > 0 || maxHeight > 0 {
+            // Zero in maximumSize leaves that axis unbounded.
+            generator.maximumSize = CGSize(width: maxWidth, height: maxHeight)
+        }
+        if exact {
+            generator.requestedTimeToleranceBefore = .zero
+            generator.requestedTimeToleranceAfter = .zero
+        }
+
+        var time = CMTime(value: positionMs, timescale: 1000)
+        let duration = asset.duration
+        if duration.isNumeric && duration > .zero && time >= duration {
+            // The last frame starts before the end.
+            time = CMTimeSubtract(duration, CMTime(value: 1, timescale: 30))
+        }
+        do {
+            let image = try generator.copyCGImage(at: time, actualTime: nil)
+            guard let data = UIImage(cgImage: image).jpegData(compressionQuality: 0.9) else {
+                return (nil, NSError(domain: "Video", code: 500, userInfo: [NSLocalizedDescriptionKey: "Encoding the frame failed"]))
+            }
+            return (["data": data.base64EncodedString()], nil)
+        } catch {
+            return (nil, error)
+        }
+    }
+
+    private static func hdrCapabilities() -> [String: Any] {
+        var formats: [String] = []
+        if AVPlayer.eligibleForHDRPlayback {
+            let modes = AVPlayer.availableHDRModes
+            if modes.contains(.hdr10) { formats.append("hdr10") }
+            if modes.contains(.hlg) { formats.append("hlg") }
+            if modes.contains(.dolbyVision) { formats.append("dolbyVision") }
+        }
+        // iOS does not report the display's peak brightness.
+        return ["formats": formats, "maxLuminance": 0.0]
     }
 }
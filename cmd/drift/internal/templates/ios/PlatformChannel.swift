@@ -323,10 +323,45 @@ final class PlatformChannelManager {
             return AudioPlayerHandler.handle(method: method, args: args)
         }
 
+        // Audio processing channel
+        register(channel: "drift/audio") { method, args in
+            return AudioHandler.handle(method: method, args: args)
+        }
+
         // URL Launcher channel
         register(channel: "drift/url_launcher") { method, args in
             return URLLauncherHandler.handle(method: method, args: args)
         }
+
+        // Video capabilities channel
+        register(channel: "drift/video") { method, args in
+            return VideoHandler.handle(method: method, args: args)
+        }
+
+        // Cast channel
+        register(channel: "drift/cast") { method, args in
+            return CastHandler.handle(method: method, args: args)
+        }
+
+        // Downloads channel
+        register(channel: "drift/downloads") { method, args in
+            return DownloadHandler.handle(method: method, args: args)
+        }
+
+        // Screen channel
+        register(channel: "drift/screen") { method, args in
+            return ScreenHandler.handle(method: method, args: args)
+        }
+
+        // System gestures channel
+        register(channel: "drift/system_gestures") { method, args in
+            return SystemGesturesHandler.handle(method: method, args: args)
+        }
+
+        // Restoration channel
+        register(channel: "drift/restoration") { method, args in
+            return RestorationHandler.handle(method: method, args: args)
+        }
     }
 }
 
@@ -538,7 +573,7 @@ enum SystemUIHandler {
         }
     }
 
-    private static func activeDriftController() -> DriftViewController? {
+    static func activeDriftController() -> DriftViewController? {
         guard let window = activeWindow() else { return nil }
         return findDriftController(from: window.rootViewController)
     }
@@ -600,6 +635,176 @@ enum SystemUIHandler {
     }
 }
 
+// MARK: - Screen Handler
+
+enum ScreenHandler {
+    /// Orientations the app window may use; read by DriftViewController.
+    static var orientationMask: UIInterfaceOrientationMask = .all
+
+    /// Whether the status bar and home indicator are hidden; read by DriftViewController.
+    static var immersive = false
+
+    /// System brightness before the first override, restored on reset.
+    private static var originalBrightness: CGFloat?
+    private static var observers: [NSObjectProtocol] = []
+
+    static func handle(method: String, args: Any?) -> (Any?, Error?) {
+        let dict = args as? [String: Any] ?? [:]
+
+        switch method {
+        case "getBrightness":
+            let brightness = Thread.isMainThread
+                ? UIScreen.main.brightness
+                : DispatchQueue.main.sync { UIScreen.main.brightness }
+            return (Double(brightness), nil)
+        case "setBrightness":
+            guard let value = dict["brightness"] as? NSNumber else {
+                return (nil, NSError(domain: "Screen", code: 400, userInfo: [NSLocalizedDescriptionKey: "Missing brightness"]))
+            }
+            DispatchQueue.main.async {
+                setBrightness(CGFloat(truncating: value))
+            }
+            return (nil, nil)
+        case "resetBrightness":
+            DispatchQueue.main.async {
+                resetBrightness()
+            }
+            return (nil, nil)
+        case "setKeepAwake":
+            let enabled = dict["enabled"] as? Bool ?? false
+            DispatchQueue.main.async {
+                UIApplication.shared.isIdleTimerDisabled = enabled
+            }
+            return (nil, nil)
+        case "setOrientationLock":
+            let mask = parseOrientation(dict["orientation"] as? String)
+            DispatchQueue.main.async {
+                setOrientationMask(mask)
+            }
+            return (nil, nil)
+        case "setImmersive":
+            let enabled = dict["enabled"] as? Bool ?? false
+            DispatchQueue.main.async {
+                immersive = enabled
+                guard let controller = SystemUIHandler.activeDriftController() else { return }
+                controller.setNeedsStatusBarAppearanceUpdate()
+                controller.setNeedsUpdateOfHomeIndicatorAutoHidden()
+            }
+            return (nil, nil)
+        default:
+            return (nil, NSError(domain: "Screen", code: 404, userInfo: [NSLocalizedDescriptionKey: "Unknown method: \(method)"]))
+        }
+    }
+
+    private static func setBrightness(_ value: CGFloat) {
+        if originalBrightness == nil {
+            originalBrightness = UIScreen.main.brightness
+            observeLifecycle()
+        }
+        UIScreen.main.brightness = min(max(value, 0), 1)
+    }
+
+    private static func resetBrightness() {
+        guard let original = originalBrightness else { return }
+        UIScreen.main.brightness = original
+        originalBrightness = nil
+        observers.forEach { NotificationCenter.default.removeObserver($0) }
+        observers.removeAll()
+    }
+
+    /// iOS keeps the brightness system-wide, so the override is lifted while
+    /// the app is in the background and reapplied when it returns.
+    private static func observeLifecycle() {
+        var override: CGFloat?
+        observers.append(NotificationCenter.default.addObserver(
+            forName: UIApplication.willResignActiveNotification, object: nil, queue: .main
+        ) { _ in
+            guard let original = originalBrightness else { return }
+            override = UIScreen.main.brightness
+            UIScreen.main.brightness = original
+        })
+        observers.append(NotificationCenter.default.addObserver(
+            forName: UIApplication.didBecomeActiveNotification, object: nil, queue: .main
+        ) { _ in
+            guard originalBrightness != nil, let value = override else { return }
+            UIScreen.main.brightness = value
+            override = nil
+        })
+    }
+
+    private static func setOrientationMask(_ mask: UIInterfaceOrientationMask) {
+        orientationMask = mask
+        guard let controller = SystemUIHandler.activeDriftController() else { return }
+        controller.setNeedsUpdateOfSupportedInterfaceOrientations()
+        controller.view.window?.windowScene?.requestGeometryUpdate(.iOS(interfaceOrientations: mask)) { error in
+            DriftLog.platform.error("Orientation update failed: \(error.localizedDescription)")
+        }
+    }
+
+    private static func parseOrientation(_ value: String?) -> UIInterfaceOrientationMask {
+        switch value {
+        case "portrait":
+            return .portrait
+        case "landscape":
+            return .landscape
+        default:
+            return .all
+        }
+    }
+}
+
+// MARK: - System Gestures Handler
+
+enum SystemGesturesHandler {
+    /// Edges whose system gestures need a second swipe; read by DriftViewController.
+    static var deferredEdges: UIRectEdge = []
+
+    static func handle(method: String, args: Any?) -> (Any?, Error?) {
+        let dict = args as? [String: Any] ?? [:]
+
+        switch method {
+        case "setDeferredEdges":
+            let edges = parseEdges((dict["edges"] as? NSNumber)?.intValue ?? 0)
+            DispatchQueue.main.async {
+                deferredEdges = edges
+                SystemUIHandler.activeDriftController()?.setNeedsUpdateOfScreenEdgesDeferringSystemGestures()
+            }
+            return (nil, nil)
+        case "setExclusionRects":
+            // iOS has no back gesture to exclude areas from.
+            return (nil, nil)
+        default:
+            return (nil, NSError(domain: "SystemGestures", code: 404, userInfo: [NSLocalizedDescriptionKey: "Unknown method: \(method)"]))
+        }
+    }
+
+    private static func parseEdges(_ bits: Int) -> UIRectEdge {
+        var edges: UIRectEdge = []
+        if bits & 1 != 0 { edges.insert(.top) }
+        if bits & 2 != 0 { edges.insert(.left) }
+        if bits & 4 != 0 { edges.insert(.bottom) }
+        if bits & 8 != 0 { edges.insert(.right) }
+        return edges
+    }
+}
+
+// MARK: - Restoration Handler
+
+/// iOS does not relaunch apps killed in the background with their saved
+/// state, so nothing is ever restored. Saves are accepted and dropped.
+enum RestorationHandler {
+    static func handle(method: String, args: Any?) -> (Any?, Error?) {
+        switch method {
+        case "getRestored":
+            return ([String: String](), nil)
+        case "save", "remove":
+            return (nil, nil)
+        default:
+            return (nil, NSError(domain: "Restoration", code: 404, userInfo: [NSLocalizedDescriptionKey: "Unknown method: \(method)"]))
+        }
+    }
+}
+
 // MARK: - Notification Handler
 
 final class NotificationHandler: NSObject, UNUserNotificationCenterDelegate {
@@ -1046,6 +1251,154 @@ enum SafeAreaHandler {
     }
 }
 
+// MARK: - Keyboard Handler
+
+enum KeyboardHandler {
+    private static var observers: [NSObjectProtocol] = []
+
+    /// Observes the keyboard frame and sends the area it covers.
+    static func initialize() {
+        guard observers.isEmpty else { return }
+        let center = NotificationCenter.default
+        observers = [
+            center.addObserver(forName: UIResponder.keyboardWillChangeFrameNotification, object: nil, queue: .main) { note in
+                sendInsets(endFrame: note.userInfo?[UIResponder.keyboardFrameEndUserInfoKey] as? CGRect)
+            },
+            center.addObserver(forName: UIResponder.keyboardWillHideNotification, object: nil, queue: .main) { _ in
+                sendInsets(endFrame: nil)
+            },
+        ]
+    }
+
+    private static func sendInsets(endFrame: CGRect?) {
+        var bottom = 0.0
+        if let endFrame = endFrame,
+           let windowScene = UIApplication.shared.connectedScenes.first as? UIWindowScene,
+           let window = windowScene.windows.first {
+            // The end frame is in screen coordinates.
+            let frame = window.convert(endFrame, from: window.screen.coordinateSpace)
+            bottom = Double(max(0, window.bounds.maxY - frame.minY))
+        }
+        PlatformChannelManager.shared.sendEvent(
+            channel: "drift/keyboard/events",
+            data: ["top": 0.0, "bottom": bottom, "left": 0.0, "right": 0.0]
+        )
+    }
+}
+
+// MARK: - Hardware Key Handler
+
+enum HardwareKeyHandler {
+    private static let namedKeys: [UIKeyboardHIDUsage: String] = [
+        .keyboardUpArrow: "ArrowUp",
+        .keyboardDownArrow: "ArrowDown",
+        .keyboardLeftArrow: "ArrowLeft",
+        .keyboardRightArrow: "ArrowRight",
+        .keyboardReturnOrEnter: "Enter",
+        .keypadEnter: "Enter",
+        .keyboardSpacebar: " ",
+        .keyboardTab: "Tab",
+        .keyboardEscape: "Escape",
+        .keyboardDeleteOrBackspace: "Backspace",
+        .keyboardDeleteForward: "Delete",
+        .keyboardHome: "Home",
+        .keyboardEnd: "End",
+        .keyboardPageUp: "PageUp",
+        .keyboardPageDown: "PageDown",
+    ]
+
+    /// Sends hardware key presses or releases to the Go focus system. Keys
+    /// without a name or a character are not sent.
+    static func send(_ presses: Set<UIPress>, action: String) {
+        for press in presses {
+            guard let uiKey = press.key else { continue }
+            let key = namedKeys[uiKey.keyCode] ?? uiKey.characters
+            guard !key.isEmpty else { continue }
+            let flags = uiKey.modifierFlags
+            PlatformChannelManager.shared.sendEvent(
+                channel: "drift/keys/events",
+                data: [
+                    "key": key,
+                    "action": action,
+                    "shift": flags.contains(.shift),
+                    "control": flags.contains(.control),
+                    "alt": flags.contains(.alternate),
+                    "meta": flags.contains(.command),
+                ]
+            )
+        }
+    }
+}
+
+// MARK: - Appearance Handler
+
+enum AppearanceHandler {
+    /// Sends the Dynamic Type scale and dark mode setting of the traits.
+    static func sendState(_ traits: UITraitCollection) {
+        // Body text is 17pt at the default (Large) content size.
+        let textScale = UIFontMetrics(forTextStyle: .body).scaledValue(for: 17, compatibleWith: traits) / 17
+        PlatformChannelManager.shared.sendEvent(
+            channel: "drift/appearance/events",
+            data: [
+                "textScale": Double(textScale),
+                "dark": traits.userInterfaceStyle == .dark
+            ]
+        )
+    }
+}
+
+// MARK: - Locale Handler
+
+enum LocaleHandler {
+    private static var observer: NSObjectProtocol?
+
+    /// Sends the preferred languages and observes changes.
+    static func initialize() {
+        sendState()
+        guard observer == nil else { return }
+        observer = NotificationCenter.default.addObserver(
+            forName: NSLocale.currentLocaleDidChangeNotification,
+            object: nil,
+            queue: .main
+        ) { _ in
+            sendState()
+        }
+    }
+
+    private static func sendState() {
+        PlatformChannelManager.shared.sendEvent(
+            channel: "drift/locale/events",
+            data: ["locales": Locale.preferredLanguages]
+        )
+    }
+}
+
+// MARK: - Power Handler
+
+enum PowerHandler {
+    private static var observer: NSObjectProtocol?
+
+    /// Sends the current Low Power Mode state and observes changes.
+    static func initialize() {
+        sendState()
+        guard observer == nil else { return }
+        observer = NotificationCenter.default.addObserver(
+            forName: .NSProcessInfoPowerStateDidChange,
+            object: nil,
+            queue: .main
+        ) { _ in
+            sendState()
+        }
+    }
+
+    private static func sendState() {
+        PlatformChannelManager.shared.sendEvent(
+            channel: "drift/power/events",
+            data: ["lowPowerMode": ProcessInfo.processInfo.isLowPowerModeEnabled]
+        )
+    }
+}
+
 // MARK: - URL Launcher Handler
 
 enum URLLauncherHandler {
@@ -385,8 +385,10 @@ enum PlatformViewHandler {
             supportedMethods = ["setValue", "updateConfig"]
         } else if container is NativeActivityIndicatorContainer {
             supportedMethods = ["setAnimating", "updateConfig"]
+        } else if container is NativeAirPlayButtonContainer {
+            supportedMethods = ["updateConfig"]
         } else if container is NativeVideoPlayerContainer {
-            supportedMethods = ["play", "pause", "stop", "seekTo", "setVolume", "setLooping", "setPlaybackSpeed", "setShowControls", "load"]
+            supportedMethods = ["play", "pause", "stop", "seekTo", "setVolume", "setLooping", "setPlaybackSpeed", "setShowControls", "load", "selectSubtitleTrack", "selectQuality", "setQualityLimit"]
         } else {
             supportedMethods = []
         }
@@ -467,6 +469,12 @@ enum PlatformViewHandler {
                     break
                 }
             }
+        } else if let airPlayContainer = container as? NativeAirPlayButtonContainer {
+            DispatchQueue.main.async {
+                if method == "updateConfig" {
+                    airPlayContainer.updateConfig(args)
+                }
+            }
         } else if let videoContainer = container as? NativeVideoPlayerContainer {
             DispatchQueue.main.async {
                 switch method {
@@ -498,8 +506,16 @@ enum PlatformViewHandler {
                     }
                 case "load":
                     if let urlString = args["url"] as? String {
-                        videoContainer.load(urlString)
+                        videoContainer.load(urlString, drm: args["drm"] as? [String: Any])
                     }
+                case "selectSubtitleTrack":
+                    videoContainer.selectSubtitleTrack(args["id"] as? String ?? "")
+                case "selectQuality":
+                    videoContainer.selectQuality(args["id"] as? String ?? "")
+                case "setQualityLimit":
+                    let maxHeight = (args["maxHeight"] as? NSNumber)?.intValue ?? 0
+                    let maxBitrate = (args["maxBitrate"] as? NSNumber)?.intValue ?? 0
+                    videoContainer.setQualityLimit(maxHeight: maxHeight, maxBitrate: maxBitrate)
                 default:
                     break
                 }
@@ -531,6 +547,8 @@ enum PlatformViewHandler {
             container = NativeActivityIndicatorContainer(viewId: viewId, params: params)
         case "video_player":
             container = NativeVideoPlayerContainer(viewId: viewId, params: params)
+        case "airplay_button":
+            container = NativeAirPlayButtonContainer(viewId: viewId, params: params)
         default:
             return (nil, NSError(domain: "PlatformView", code: 400, userInfo: [NSLocalizedDescriptionKey: "Unknown view type: \(viewType)"]))
         }
@@ -50,4 +50,12 @@ class AppDelegate: NSObject, UIApplicationDelegate {
         NotificationHandler.handleRemoteNotification(userInfo, isForeground: application.applicationState == .active)
         completionHandler(.newData)
     }
+
+    func application(
+        _ application: UIApplication,
+        handleEventsForBackgroundURLSession identifier: String,
+        completionHandler: @escaping () -> Void
+    ) {
+        DownloadHandler.handleBackgroundEvents(completionHandler: completionHandler)
+    }
 }
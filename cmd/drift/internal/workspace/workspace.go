@@ -167,31 +167,48 @@ func Prepare(root string, cfg *config.Resolved, platform string) (*Workspace, er
 		return nil, fmt.Errorf("failed to create bridge directory: %w", err)
 	}
 
-	settings := scaffold.Settings{
-		AppName:        cfg.AppName,
-		AppID:          cfg.AppID,
-		Bundle:         cfg.AppID,
-		Orientation:    cfg.Orientation,
-		AllowHTTP:      cfg.AllowHTTP,
-		Ejected:        ejected,
-		ProjectRoot:    root,
-		Icon:           cfg.Icon,
-		IconBackground: cfg.IconBackground,
-	}
+	settings := scaffold.NewSettings(root, cfg, ejected)
 
+	// Native additions from drift.yaml are injected on every build, into
+	// ejected projects as well as freshly generated ones. The app version is
+	// rendered by the templates, so only ejected projects need it applied.
 	switch platform {
 	case "android":
 		if err := scaffold.WriteAndroid(buildDir, settings); err != nil {
 			return nil, err
 		}
+		if err := scaffold.InjectAndroid(ws.AndroidDir, cfg.Native.Android); err != nil {
+			return nil, err
+		}
+		if ejected {
+			if err := scaffold.ApplyAndroidVersion(ws.AndroidDir, cfg.Version, cfg.BuildNumber); err != nil {
+				return nil, err
+			}
+		}
 	case "ios":
 		if err := scaffold.WriteIOS(buildDir, settings); err != nil {
 			return nil, err
 		}
+		if err := scaffold.InjectIOS(ws.IOSDir, cfg.Native.IOS); err != nil {
+			return nil, err
+		}
+		if ejected {
+			if err := scaffold.ApplyIOSVersion(ws.IOSDir, cfg.Version, cfg.BuildNumber); err != nil {
+				return nil, err
+			}
+		}
 	case "xtool":
 		if err := scaffold.WriteXtool(buildDir, settings); err != nil {
 			return nil, err
 		}
+		if err := scaffold.InjectXtool(ws.XtoolDir, cfg.Native.IOS); err != nil {
+			return nil, err
+		}
+		if ejected {
+			if err := scaffold.ApplyXtoolVersion(ws.XtoolDir, cfg.Version, cfg.BuildNumber); err != nil {
+				return nil, err
+			}
+		}
 	default:
 		return nil, fmt.Errorf("unknown platform %q", platform)
 	}
@@ -232,10 +249,25 @@ func ManagedBuildDir(root string, cfg *config.Resolved, platform string) (string
 		return "", err
 	}
 
-	hash := sha1.Sum([]byte(root))
-	shortHash := hex.EncodeToString(hash[:6])
+	return filepath.Join(moduleRoot, platform, rootHash(root)), nil
+}
 
-	return filepath.Join(moduleRoot, platform, shortHash), nil
+// StateFile returns the path of the file where the CLI remembers per-project
+// choices, such as the last device used, e.g.
+// ~/.drift/build/<module>/state/<hash>.json. The file may not exist yet.
+func StateFile(root string, cfg *config.Resolved) (string, error) {
+	moduleRoot, err := moduleBuildRoot(cfg)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(moduleRoot, "state", rootHash(root)+".json"), nil
+}
+
+// rootHash returns a short hash identifying a project root, so checkouts of
+// the same module in different directories keep separate builds.
+func rootHash(root string) string {
+	hash := sha1.Sum([]byte(root))
+	return hex.EncodeToString(hash[:6])
 }
 
 // BuildRoot returns the cache root for the module.
@@ -259,6 +291,10 @@ func WriteBridgeFiles(dir string, cfg *config.Resolved) error {
 		IOSBundleID:    cfg.AppID,
 		Orientation:    cfg.Orientation,
 		AllowHTTP:      cfg.AllowHTTP,
+		Fonts:          templateFonts(cfg.Fonts),
+		DisplayFamily:  cfg.Typography.Display,
+		BodyFamily:     cfg.Typography.Body,
+		FontFallbacks:  cfg.Typography.Fallbacks,
 	})
 
 	for _, file := range bridgeFiles {
@@ -286,6 +322,23 @@ func WriteBridgeFiles(dir string, cfg *config.Resolved) error {
 	return nil
 }
 
+// templateFonts flattens the font families of drift.yaml into the files
+// the typography bridge file embeds.
+func templateFonts(families []config.FontFamilyConfig) []templates.TemplateFont {
+	var fonts []templates.TemplateFont
+	for _, family := range families {
+		for _, file := range family.Files {
+			fonts = append(fonts, templates.TemplateFont{
+				Family: family.Family,
+				Path:   file.Path,
+				Weight: file.Weight,
+				Italic: file.Style == "italic",
+			})
+		}
+	}
+	return fonts
+}
+
 func WriteOverlay(overlayPath, bridgeDir, projectRoot string) error {
 	bridgeFiles, err := templates.GetBridgeFiles()
 	if err != nil {
@@ -3,6 +3,8 @@ package animation
 import (
 	"fmt"
 	"time"
+
+	"github.com/go-drift/drift/pkg/core"
 )
 
 // AnimationStatus represents the current state of an animation.
@@ -77,7 +79,7 @@ type AnimationController struct {
 	target          float64
 	startValue      float64
 	scaledDuration  time.Duration
-	listeners       map[int]func()
+	listeners       core.Notifier
 	statusListeners map[int]func(AnimationStatus)
 	nextListenerID  int
 }
@@ -91,7 +93,6 @@ func NewAnimationController(duration time.Duration) *AnimationController {
 		UpperBound:      1,
 		Curve:           LinearCurve,
 		status:          AnimationDismissed,
-		listeners:       make(map[int]func()),
 		statusListeners: make(map[int]func(AnimationStatus)),
 	}
 }
@@ -191,6 +192,21 @@ func (c *AnimationController) stop() {
 	}
 }
 
+// SetValue stops any running animation and jumps to value, clamped to the
+// bounds, notifying listeners. Use it to drive the animation from a gesture.
+// The status becomes AnimationDismissed or AnimationCompleted at a bound
+// and is otherwise unchanged.
+func (c *AnimationController) SetValue(value float64) {
+	c.Stop()
+	c.Value = max(c.LowerBound, min(value, c.UpperBound))
+	if c.Value <= c.LowerBound {
+		c.setStatus(AnimationDismissed)
+	} else if c.Value >= c.UpperBound {
+		c.setStatus(AnimationCompleted)
+	}
+	c.notifyListeners()
+}
+
 // Reset immediately sets the value to the lower bound.
 func (c *AnimationController) Reset() {
 	c.Stop()
@@ -230,12 +246,7 @@ func (c *AnimationController) IsDismissed() bool {
 // AddListener adds a callback that fires whenever the value changes.
 // Returns an unsubscribe function.
 func (c *AnimationController) AddListener(fn func()) func() {
-	id := c.nextListenerID
-	c.nextListenerID++
-	c.listeners[id] = fn
-	return func() {
-		delete(c.listeners, id)
-	}
+	return c.listeners.AddListener(fn)
 }
 
 // AddStatusListener adds a callback that fires whenever the status changes.
@@ -260,14 +271,12 @@ func (c *AnimationController) setStatus(status AnimationStatus) {
 }
 
 func (c *AnimationController) notifyListeners() {
-	for _, listener := range c.listeners {
-		listener()
-	}
+	c.listeners.Notify()
 }
 
 // Dispose cleans up resources used by the controller.
 func (c *AnimationController) Dispose() {
 	c.Stop()
-	c.listeners = nil
+	c.listeners.Dispose()
 	c.statusListeners = nil
 }
@@ -154,6 +154,13 @@ func TestAnimationController_Dispose_ClearsListeners(t *testing.T) {
 	if callCount != 2 {
 		t.Errorf("Expected no more calls after dispose, got %d", callCount)
 	}
+
+	// Listeners added after dispose are ignored
+	c.AddListener(func() { callCount++ })
+	c.notifyListeners()
+	if callCount != 2 {
+		t.Errorf("Expected listeners added after dispose to be ignored, got %d calls", callCount)
+	}
 }
 
 func TestAnimationController_NewControllerInitialization(t *testing.T) {
@@ -175,14 +182,43 @@ func TestAnimationController_NewControllerInitialization(t *testing.T) {
 	if c.Status() != AnimationDismissed {
 		t.Errorf("Expected AnimationDismissed, got %v", c.Status())
 	}
-	if c.listeners == nil {
-		t.Error("listeners map should be initialized")
-	}
 	if c.statusListeners == nil {
 		t.Error("statusListeners map should be initialized")
 	}
 }
 
+func TestAnimationController_SetValue(t *testing.T) {
+	c := NewAnimationController(200 * time.Millisecond)
+	defer c.Dispose()
+
+	notified := 0
+	c.AddListener(func() { notified++ })
+	var statuses []AnimationStatus
+	c.AddStatusListener(func(s AnimationStatus) { statuses = append(statuses, s) })
+
+	c.SetValue(1.5)
+	if c.Value != 1 || c.Status() != AnimationCompleted {
+		t.Errorf("Expected value 1 and completed, got %f %v", c.Value, c.Status())
+	}
+
+	c.SetValue(0.4)
+	if c.Value != 0.4 || c.Status() != AnimationCompleted {
+		t.Errorf("Expected value 0.4 with status unchanged, got %f %v", c.Value, c.Status())
+	}
+
+	c.SetValue(-1)
+	if c.Value != 0 || c.Status() != AnimationDismissed {
+		t.Errorf("Expected value 0 and dismissed, got %f %v", c.Value, c.Status())
+	}
+
+	if notified != 3 {
+		t.Errorf("Expected 3 listener calls, got %d", notified)
+	}
+	if len(statuses) != 2 {
+		t.Errorf("Expected 2 status changes, got %v", statuses)
+	}
+}
+
 // Compile-time interface checks
 type listenable interface {
 	AddListener(listener func()) func()
@@ -8,7 +8,11 @@ import "math"
 // transformed value. Set an [AnimationController]'s Curve field to apply easing.
 //
 // Standard curves: [LinearCurve], [Ease], [EaseIn], [EaseOut], [EaseInOut].
-// Use [CubicBezier] to create custom curves matching CSS cubic-bezier().
+// Material curves: [FastOutSlowIn], [LinearOutSlowIn], [FastOutLinearIn],
+// [Decelerate]. Overshooting curves: [EaseInBack], [EaseOutBack], [BounceIn],
+// [BounceOut], [ElasticOut].
+// Use [CubicBezier] to create custom curves matching CSS cubic-bezier(), and
+// [Interval], [Threshold], and [FlippedCurve] to derive curves from others.
 //
 // See ExampleCubicBezier for custom curve usage.
 
@@ -37,6 +41,99 @@ var EaseOut = CubicBezier(0.0, 0.0, 0.2, 1.0)
 // Equivalent to CSS ease-in-out.
 var EaseInOut = CubicBezier(0.4, 0.0, 0.2, 1.0)
 
+// FastOutSlowIn accelerates quickly and settles slowly. It is the Material
+// standard curve for elements moving on screen.
+var FastOutSlowIn = CubicBezier(0.4, 0.0, 0.2, 1.0)
+
+// LinearOutSlowIn starts at full speed and decelerates. Use for elements
+// entering the screen.
+var LinearOutSlowIn = CubicBezier(0.0, 0.0, 0.2, 1.0)
+
+// FastOutLinearIn accelerates and leaves at full speed. Use for elements
+// leaving the screen.
+var FastOutLinearIn = CubicBezier(0.4, 0.0, 1.0, 1.0)
+
+// EaseInBack pulls back slightly before accelerating toward the end.
+var EaseInBack = CubicBezier(0.6, -0.28, 0.735, 0.045)
+
+// EaseOutBack overshoots the end slightly before settling.
+var EaseOutBack = CubicBezier(0.175, 0.885, 0.32, 1.275)
+
+// Decelerate starts quickly and slows to a stop, like a thrown object
+// meeting friction.
+func Decelerate(t float64) float64 {
+	inv := 1 - t
+	return 1 - inv*inv
+}
+
+// BounceOut bounces against the end value like a dropped ball.
+func BounceOut(t float64) float64 {
+	switch {
+	case t < 1/2.75:
+		return 7.5625 * t * t
+	case t < 2/2.75:
+		t -= 1.5 / 2.75
+		return 7.5625*t*t + 0.75
+	case t < 2.5/2.75:
+		t -= 2.25 / 2.75
+		return 7.5625*t*t + 0.9375
+	default:
+		t -= 2.625 / 2.75
+		return 7.5625*t*t + 0.984375
+	}
+}
+
+// BounceIn bounces against the start value before moving to the end.
+func BounceIn(t float64) float64 {
+	return 1 - BounceOut(1-t)
+}
+
+// ElasticOut overshoots and oscillates around the end value like a spring.
+func ElasticOut(t float64) float64 {
+	if t <= 0 || t >= 1 {
+		return clampUnit(t)
+	}
+	const period = 0.4
+	return math.Pow(2, -10*t)*math.Sin((t-period/4)*2*math.Pi/period) + 1
+}
+
+// Interval returns a curve that is 0 until begin, runs curve between begin
+// and end, and is 1 after end. Use it to stagger several animations driven
+// by one controller. A nil curve is linear.
+func Interval(begin, end float64, curve func(float64) float64) func(float64) float64 {
+	if curve == nil {
+		curve = LinearCurve
+	}
+	return func(t float64) float64 {
+		if t <= begin {
+			return 0
+		}
+		if t >= end || end <= begin {
+			return 1
+		}
+		return curve((t - begin) / (end - begin))
+	}
+}
+
+// Threshold returns a curve that jumps from 0 to 1 once t reaches threshold.
+func Threshold(threshold float64) func(float64) float64 {
+	return func(t float64) float64 {
+		if t < threshold {
+			return 0
+		}
+		return 1
+	}
+}
+
+// FlippedCurve returns curve mirrored in time and value, so an ease-in
+// becomes the matching ease-out. Useful for a reverse animation that should
+// retrace the forward motion.
+func FlippedCurve(curve func(float64) float64) func(float64) float64 {
+	return func(t float64) float64 {
+		return 1 - curve(1-t)
+	}
+}
+
 // CubicBezier returns a cubic-bezier easing function matching CSS cubic-bezier().
 // The parameters define the two control points (x1,y1) and (x2,y2) of the curve.
 // The curve starts at (0,0) and ends at (1,1).
@@ -0,0 +1,57 @@
+package animation
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCurves_Endpoints(t *testing.T) {
+	curves := map[string]func(float64) float64{
+		"FastOutSlowIn":   FastOutSlowIn,
+		"LinearOutSlowIn": LinearOutSlowIn,
+		"FastOutLinearIn": FastOutLinearIn,
+		"EaseInBack":      EaseInBack,
+		"EaseOutBack":     EaseOutBack,
+		"Decelerate":      Decelerate,
+		"BounceIn":        BounceIn,
+		"BounceOut":       BounceOut,
+		"ElasticOut":      ElasticOut,
+		"Interval":        Interval(0.25, 0.75, EaseIn),
+		"FlippedCurve":    FlippedCurve(EaseIn),
+	}
+	for name, curve := range curves {
+		if got := curve(0); math.Abs(got) > 1e-6 {
+			t.Errorf("%s(0) = %v, want 0", name, got)
+		}
+		if got := curve(1); math.Abs(got-1) > 1e-6 {
+			t.Errorf("%s(1) = %v, want 1", name, got)
+		}
+	}
+}
+
+func TestEaseOutBack_Overshoots(t *testing.T) {
+	peak := 0.0
+	for i := range 100 {
+		peak = max(peak, EaseOutBack(float64(i)/100))
+	}
+	if peak <= 1 {
+		t.Errorf("expected EaseOutBack to overshoot 1, peak %v", peak)
+	}
+}
+
+func TestInterval(t *testing.T) {
+	curve := Interval(0.2, 0.6, nil)
+	tests := map[float64]float64{0.1: 0, 0.2: 0, 0.4: 0.5, 0.6: 1, 0.9: 1}
+	for in, want := range tests {
+		if got := curve(in); math.Abs(got-want) > 1e-9 {
+			t.Errorf("Interval(0.2, 0.6)(%v) = %v, want %v", in, got, want)
+		}
+	}
+}
+
+func TestThreshold(t *testing.T) {
+	curve := Threshold(0.5)
+	if curve(0.49) != 0 || curve(0.5) != 1 {
+		t.Errorf("expected jump at 0.5, got %v and %v", curve(0.49), curve(0.5))
+	}
+}
@@ -52,6 +52,8 @@ var (
 	tickerMu      sync.Mutex
 	activeTickers = make(map[*Ticker]struct{})
 	lastTickTime  time.Time
+	tickersPaused bool
+	pausedAt      time.Time
 )
 
 // Ticker calls a callback on each frame while active.
@@ -102,12 +104,19 @@ func (t *Ticker) IsActive() bool {
 	return t.isActive
 }
 
-// Elapsed returns the time since the ticker started.
+// Elapsed returns the time since the ticker started, excluding time spent
+// paused via [SetTickersPaused].
 func (t *Ticker) Elapsed() time.Duration {
 	if !t.isActive {
 		return 0
 	}
-	return Now().Sub(t.start)
+	now := Now()
+	tickerMu.Lock()
+	if tickersPaused {
+		now = pausedAt
+	}
+	tickerMu.Unlock()
+	return max(now.Sub(t.start), 0)
 }
 
 // TickerProvider creates tickers.
@@ -115,11 +124,45 @@ type TickerProvider interface {
 	CreateTicker(callback func(time.Duration)) *Ticker
 }
 
+// SetTickersPaused freezes or resumes all active tickers. While paused,
+// [StepTickers] does nothing and [HasActiveTickers] reports false, so the
+// engine stops scheduling animation frames. On resume, each ticker's start
+// time is shifted by the paused duration so animations continue from where
+// they stopped instead of jumping ahead.
+func SetTickersPaused(paused bool) {
+	tickerMu.Lock()
+	defer tickerMu.Unlock()
+	if tickersPaused == paused {
+		return
+	}
+	tickersPaused = paused
+	now := Now()
+	if paused {
+		pausedAt = now
+		return
+	}
+	for ticker := range activeTickers {
+		// Tickers started during the pause begin counting from now.
+		from := pausedAt
+		if ticker.start.After(from) {
+			from = ticker.start
+		}
+		ticker.start = ticker.start.Add(now.Sub(from))
+	}
+}
+
+// TickersPaused reports whether tickers are paused via [SetTickersPaused].
+func TickersPaused() bool {
+	tickerMu.Lock()
+	defer tickerMu.Unlock()
+	return tickersPaused
+}
+
 // StepTickers advances all active tickers.
 // This should be called once per frame from the engine.
 func StepTickers() {
 	tickerMu.Lock()
-	if len(activeTickers) == 0 {
+	if tickersPaused || len(activeTickers) == 0 {
 		tickerMu.Unlock()
 		return
 	}
@@ -138,9 +181,9 @@ func StepTickers() {
 	}
 }
 
-// HasActiveTickers returns true if any tickers are active.
+// HasActiveTickers returns true if any tickers are active and not paused.
 func HasActiveTickers() bool {
 	tickerMu.Lock()
 	defer tickerMu.Unlock()
-	return len(activeTickers) > 0
+	return !tickersPaused && len(activeTickers) > 0
 }
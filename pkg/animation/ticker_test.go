@@ -0,0 +1,42 @@
+package animation
+
+import (
+	"testing"
+	"time"
+)
+
+type fakeClock struct{ now time.Time }
+
+func (c *fakeClock) Now() time.Time { return c.now }
+
+func TestSetTickersPaused_ResumesWhereStopped(t *testing.T) {
+	fc := &fakeClock{now: time.Unix(1000, 0)}
+	prev := SetClock(fc)
+	defer SetClock(prev)
+
+	ticker := NewTicker(func(time.Duration) {})
+	ticker.Start()
+	defer ticker.Stop()
+
+	fc.now = fc.now.Add(100 * time.Millisecond)
+	SetTickersPaused(true)
+	defer SetTickersPaused(false)
+
+	if HasActiveTickers() {
+		t.Error("expected no active tickers while paused")
+	}
+
+	fc.now = fc.now.Add(5 * time.Second)
+	if got := ticker.Elapsed(); got != 100*time.Millisecond {
+		t.Errorf("expected elapsed frozen at 100ms while paused, got %v", got)
+	}
+
+	SetTickersPaused(false)
+	if !HasActiveTickers() {
+		t.Error("expected tickers active after resume")
+	}
+	fc.now = fc.now.Add(50 * time.Millisecond)
+	if got := ticker.Elapsed(); got != 150*time.Millisecond {
+		t.Errorf("expected elapsed 150ms after resume, got %v", got)
+	}
+}
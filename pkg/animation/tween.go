@@ -121,6 +121,27 @@ func LerpAlignment(a, b layout.Alignment, t float64) layout.Alignment {
 	}
 }
 
+// LerpRect linearly interpolates between two Rect values.
+func LerpRect(a, b graphics.Rect, t float64) graphics.Rect {
+	return graphics.Rect{
+		Left:   LerpFloat64(a.Left, b.Left, t),
+		Top:    LerpFloat64(a.Top, b.Top, t),
+		Right:  LerpFloat64(a.Right, b.Right, t),
+		Bottom: LerpFloat64(a.Bottom, b.Bottom, t),
+	}
+}
+
+// LerpFontVariations linearly interpolates between two FontVariations
+// values. An axis left at zero interpolates from or to zero, so set it on
+// both ends to animate from the font's default.
+func LerpFontVariations(a, b graphics.FontVariations, t float64) graphics.FontVariations {
+	return graphics.FontVariations{
+		Weight: LerpFloat64(a.Weight, b.Weight, t),
+		Width:  LerpFloat64(a.Width, b.Width, t),
+		Slant:  LerpFloat64(a.Slant, b.Slant, t),
+	}
+}
+
 // TweenEdgeInsets creates a tween for EdgeInsets values.
 func TweenEdgeInsets(begin, end layout.EdgeInsets) *Tween[layout.EdgeInsets] {
 	return &Tween[layout.EdgeInsets]{
@@ -147,3 +168,21 @@ func TweenAlignment(begin, end layout.Alignment) *Tween[layout.Alignment] {
 		Lerp:  LerpAlignment,
 	}
 }
+
+// TweenRect creates a tween for Rect values.
+func TweenRect(begin, end graphics.Rect) *Tween[graphics.Rect] {
+	return &Tween[graphics.Rect]{
+		Begin: begin,
+		End:   end,
+		Lerp:  LerpRect,
+	}
+}
+
+// TweenFontVariations creates a tween for FontVariations values.
+func TweenFontVariations(begin, end graphics.FontVariations) *Tween[graphics.FontVariations] {
+	return &Tween[graphics.FontVariations]{
+		Begin: begin,
+		End:   end,
+		Lerp:  LerpFontVariations,
+	}
+}
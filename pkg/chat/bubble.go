@@ -0,0 +1,300 @@
+package chat
+
+import (
+	"math"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/layout"
+	"github.com/go-drift/drift/pkg/theme"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+const (
+	bubbleRadius      = 18
+	bubbleGroupRadius = 4
+	bubbleTailWidth   = 6
+	// bubbleMaxWidthFraction is how much of the list's width a bubble may
+	// take.
+	bubbleMaxWidthFraction = 0.75
+)
+
+// BubbleGroupPosition is where a bubble sits in a run of consecutive
+// messages from the same sender. Bubbles in a run share flatter corners on
+// the sender's side, and only the last has a tail.
+type BubbleGroupPosition int
+
+const (
+	// BubbleSingle is a message on its own.
+	BubbleSingle BubbleGroupPosition = iota
+	// BubbleFirst is the first (oldest) message of a run.
+	BubbleFirst
+	// BubbleMiddle is neither the first nor the last message of a run.
+	BubbleMiddle
+	// BubbleLast is the last (newest) message of a run.
+	BubbleLast
+)
+
+// BubbleGroupPositionOf returns the position of a message given whether the
+// message before it and the message after it are from the same sender.
+func BubbleGroupPositionOf(joinsPrevious, joinsNext bool) BubbleGroupPosition {
+	switch {
+	case joinsPrevious && joinsNext:
+		return BubbleMiddle
+	case joinsPrevious:
+		return BubbleLast
+	case joinsNext:
+		return BubbleFirst
+	default:
+		return BubbleSingle
+	}
+}
+
+func (p BubbleGroupPosition) joinsPrevious() bool {
+	return p == BubbleMiddle || p == BubbleLast
+}
+
+func (p BubbleGroupPosition) joinsNext() bool {
+	return p == BubbleFirst || p == BubbleMiddle
+}
+
+// Bubble shows a message in a speech bubble. Outgoing bubbles sit at the end
+// of the reading direction, incoming ones at the start.
+//
+//	chat.Bubble{
+//	    Text:     m.Text,
+//	    Outgoing: m.Mine,
+//	    Position: chat.BubbleGroupPositionOf(sameSender(i+1, i), sameSender(i, i-1)),
+//	}
+//
+// A bubble is at most three quarters of the list's width. Bubbles in a run
+// are spaced closer together than separate messages.
+type Bubble struct {
+	core.StatelessBase
+
+	// Text is the message. Ignored when Child is set.
+	Text string
+	// Child replaces the text, for images, links, or other content.
+	Child core.Widget
+	// Outgoing marks a message sent by the user.
+	Outgoing bool
+	// Position is the bubble's place in a run of messages from the same
+	// sender.
+	Position BubbleGroupPosition
+	// Color is the bubble's fill. Zero uses the theme's primary color for
+	// outgoing messages and its highest surface container color for
+	// incoming ones.
+	Color graphics.Color
+	// TextColor is the color of Text. Zero uses the color that contrasts
+	// with the theme's default fill.
+	TextColor graphics.Color
+}
+
+func (b Bubble) Build(ctx core.BuildContext) core.Widget {
+	_, colors, textTheme := theme.UseTheme(ctx)
+	color, textColor := b.Color, b.TextColor
+	if b.Outgoing {
+		color = orColor(color, colors.Primary)
+		textColor = orColor(textColor, colors.OnPrimary)
+	} else {
+		color = orColor(color, colors.SurfaceContainerHighest)
+		textColor = orColor(textColor, colors.OnSurface)
+	}
+
+	child := b.Child
+	if child == nil {
+		style := textTheme.BodyLarge
+		style.Color = textColor
+		child = widgets.Text{Content: b.Text, Style: style}
+	}
+
+	alignment := &layout.AlignmentCenterStart
+	if b.Outgoing {
+		alignment = &layout.AlignmentCenterEnd
+	}
+	// The tail points toward the sender: the end side for outgoing
+	// messages.
+	tailAtRight := b.Outgoing == (widgets.DirectionalityOf(ctx) != graphics.TextDirectionRTL)
+
+	// The gap above the bubble is smaller when the message above it is
+	// from the same sender.
+	spacing := 8.0
+	if b.Position.joinsPrevious() {
+		spacing = 2
+	}
+	return widgets.Padding{
+		Padding: layout.EdgeInsets{Left: 8, Right: 8, Top: spacing},
+		Child: widgets.Align{
+			Directional: alignment,
+			Child: bubbleShape{
+				color:       color,
+				position:    b.Position,
+				tailAtRight: tailAtRight,
+				child:       child,
+			},
+		},
+	}
+}
+
+func orColor(c, fallback graphics.Color) graphics.Color {
+	if c == 0 {
+		return fallback
+	}
+	return c
+}
+
+// bubbleShape paints the bubble behind its child.
+type bubbleShape struct {
+	core.RenderObjectBase
+	color       graphics.Color
+	position    BubbleGroupPosition
+	tailAtRight bool
+	child       core.Widget
+}
+
+func (b bubbleShape) ChildWidget() core.Widget {
+	return b.child
+}
+
+func (b bubbleShape) CreateRenderObject(ctx core.BuildContext) layout.RenderObject {
+	r := &renderBubble{color: b.color, position: b.position, tailAtRight: b.tailAtRight}
+	r.SetSelf(r)
+	return r
+}
+
+func (b bubbleShape) UpdateRenderObject(ctx core.BuildContext, renderObject layout.RenderObject) {
+	if r, ok := renderObject.(*renderBubble); ok {
+		needsLayout := r.tailAtRight != b.tailAtRight
+		r.color = b.color
+		r.position = b.position
+		r.tailAtRight = b.tailAtRight
+		if needsLayout {
+			r.MarkNeedsLayout()
+		}
+		r.MarkNeedsPaint()
+	}
+}
+
+type renderBubble struct {
+	layout.RenderBoxBase
+	child       layout.RenderBox
+	color       graphics.Color
+	position    BubbleGroupPosition
+	tailAtRight bool
+}
+
+var bubblePadding = layout.EdgeInsetsSymmetric(12, 8)
+
+func (r *renderBubble) SetChild(child layout.RenderObject) {
+	layout.SetParentOnChild(r.child, nil)
+	r.child = layout.AsRenderBox(child)
+	layout.SetParentOnChild(r.child, r)
+}
+
+func (r *renderBubble) VisitChildren(visitor func(layout.RenderObject)) {
+	if r.child != nil {
+		visitor(r.child)
+	}
+}
+
+func (r *renderBubble) PerformLayout() {
+	constraints := r.Constraints()
+	insets := bubblePadding.Horizontal() + bubbleTailWidth
+	maxWidth := constraints.MaxWidth
+	if maxWidth != math.MaxFloat64 {
+		maxWidth *= bubbleMaxWidthFraction
+	}
+
+	var childSize graphics.Size
+	if r.child != nil {
+		r.child.Layout(layout.Constraints{
+			MaxWidth:  max(0, maxWidth-insets),
+			MaxHeight: math.MaxFloat64,
+		}, true)
+		childSize = r.child.Size()
+		left := bubblePadding.Left
+		if !r.tailAtRight {
+			left += bubbleTailWidth
+		}
+		r.child.SetParentData(&layout.BoxParentData{Offset: graphics.Offset{X: left, Y: bubblePadding.Top}})
+	}
+	r.SetSize(constraints.Constrain(graphics.Size{
+		Width:  childSize.Width + insets,
+		Height: max(childSize.Height+bubblePadding.Vertical(), 2*bubbleRadius),
+	}))
+}
+
+func (r *renderBubble) Paint(ctx *layout.PaintContext) {
+	size := r.Size()
+	if r.color != 0 {
+		r.paintShape(ctx.Canvas, size)
+	}
+	if r.child != nil {
+		if data, ok := r.child.ParentData().(*layout.BoxParentData); ok {
+			ctx.PaintChildWithLayer(r.child, data.Offset)
+		}
+	}
+}
+
+// paintShape draws the body, flattening the sender-side corners that join
+// neighboring bubbles, and the tail below the last bubble of a run.
+func (r *renderBubble) paintShape(canvas graphics.Canvas, size graphics.Size) {
+	tail := r.position == BubbleSingle || r.position == BubbleLast
+	senderTop, senderBottom := graphics.CircularRadius(bubbleRadius), graphics.CircularRadius(bubbleRadius)
+	if r.position.joinsPrevious() {
+		senderTop = graphics.CircularRadius(bubbleGroupRadius)
+	}
+	if tail || r.position.joinsNext() {
+		senderBottom = graphics.CircularRadius(bubbleGroupRadius)
+	}
+	other := graphics.CircularRadius(bubbleRadius)
+
+	body := graphics.RRect{TopLeft: other, TopRight: other, BottomRight: other, BottomLeft: other}
+	if r.tailAtRight {
+		body.Rect = graphics.RectFromLTWH(0, 0, size.Width-bubbleTailWidth, size.Height)
+		body.TopRight, body.BottomRight = senderTop, senderBottom
+	} else {
+		body.Rect = graphics.RectFromLTWH(bubbleTailWidth, 0, size.Width-bubbleTailWidth, size.Height)
+		body.TopLeft, body.BottomLeft = senderTop, senderBottom
+	}
+
+	paint := graphics.DefaultPaint()
+	paint.Color = r.color
+	canvas.DrawRRect(body, paint)
+	if !tail {
+		return
+	}
+
+	// The tail curves out from the body's sender-side edge to a point at
+	// the bottom corner. Coordinates are for a right-side tail and mirrored
+	// otherwise.
+	x := func(v float64) float64 {
+		if r.tailAtRight {
+			return v
+		}
+		return size.Width - v
+	}
+	edge, bottom := size.Width-bubbleTailWidth, size.Height
+	path := graphics.NewPath()
+	path.MoveTo(x(edge), bottom-14)
+	path.QuadTo(x(edge), bottom, x(size.Width), bottom)
+	path.LineTo(x(edge-10), bottom)
+	path.Close()
+	canvas.DrawPath(path, paint)
+}
+
+func (r *renderBubble) HitTest(position graphics.Offset, result *layout.HitTestResult) bool {
+	if !layout.WithinBounds(position, r.Size()) {
+		return false
+	}
+	if r.child != nil {
+		if data, ok := r.child.ParentData().(*layout.BoxParentData); ok {
+			local := graphics.Offset{X: position.X - data.Offset.X, Y: position.Y - data.Offset.Y}
+			if r.child.HitTest(local, result) {
+				return true
+			}
+		}
+	}
+	result.Add(r)
+	return true
+}
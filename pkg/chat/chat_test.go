@@ -0,0 +1,150 @@
+package chat_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/go-drift/drift/pkg/chat"
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/platform"
+	drifttest "github.com/go-drift/drift/pkg/testing"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+func messages(n int) func(core.BuildContext, int) core.Widget {
+	return func(ctx core.BuildContext, i int) core.Widget {
+		return chat.Bubble{Text: fmt.Sprintf("message %d", i), Outgoing: i%2 == 0}
+	}
+}
+
+func TestMessageList_NewestAtBottomWithUnreadSeparator(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	tester.SetSize(graphics.Size{Width: 390, Height: 800})
+	tester.PumpWidget(chat.MessageList{Count: 3, ItemBuilder: messages(3), UnreadCount: 1})
+
+	newest := tester.Find(drifttest.ByText("message 0")).GlobalOffset().Y
+	older := tester.Find(drifttest.ByText("message 1")).GlobalOffset().Y
+	separator := tester.Find(drifttest.ByText("Unread messages")).GlobalOffset().Y
+	if !(older < separator && separator < newest) {
+		t.Errorf("message 1 at %v, separator at %v, message 0 at %v; want them top to bottom", older, separator, newest)
+	}
+	if newest < 700 {
+		t.Errorf("newest message at y %v, want it at the bottom", newest)
+	}
+}
+
+func TestMessageList_BuildsMoreThenLoadsOlder(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	tester.SetSize(graphics.Size{Width: 390, Height: 800})
+	controller := &widgets.ScrollController{}
+	loads := 0
+	tester.PumpWidget(chat.MessageList{
+		Count:       60,
+		ItemBuilder: messages(60),
+		BatchSize:   30,
+		Controller:  controller,
+		OnLoadOlder: func() { loads++ },
+	})
+
+	if tester.Find(drifttest.ByText("message 30")).Exists() {
+		t.Fatal("expected only the first batch to be built")
+	}
+	controller.JumpTo(controller.MaxScrollExtent())
+	tester.Pump()
+	if !tester.Find(drifttest.ByText("message 59")).Exists() {
+		t.Fatal("expected the next batch near the oldest message")
+	}
+	if loads != 0 {
+		t.Errorf("loads = %d before every message was built, want 0", loads)
+	}
+
+	controller.JumpTo(controller.MaxScrollExtent())
+	tester.Pump()
+	if loads == 0 {
+		t.Error("expected OnLoadOlder once every message was built")
+	}
+}
+
+func TestBubbleGroupPositionOf(t *testing.T) {
+	tests := []struct {
+		previous, next bool
+		want           chat.BubbleGroupPosition
+	}{
+		{false, false, chat.BubbleSingle},
+		{false, true, chat.BubbleFirst},
+		{true, true, chat.BubbleMiddle},
+		{true, false, chat.BubbleLast},
+	}
+	for _, tt := range tests {
+		if got := chat.BubbleGroupPositionOf(tt.previous, tt.next); got != tt.want {
+			t.Errorf("BubbleGroupPositionOf(%v, %v) = %v, want %v", tt.previous, tt.next, got, tt.want)
+		}
+	}
+}
+
+func TestBubble_AlignsBySender(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	tester.SetSize(graphics.Size{Width: 390, Height: 800})
+	tester.PumpWidget(widgets.Column{Children: []core.Widget{
+		chat.Bubble{Text: "hi", Outgoing: true},
+		chat.Bubble{Text: "hello"},
+	}})
+
+	outgoing := core.GlobalOffsetOf(tester.Find(drifttest.ByText("hi")).First()).X
+	incoming := core.GlobalOffsetOf(tester.Find(drifttest.ByText("hello")).First()).X
+	if outgoing < 195 || incoming > 195 {
+		t.Errorf("outgoing at x %v, incoming at x %v; want right and left", outgoing, incoming)
+	}
+}
+
+func TestInputBar_SendsAndClears(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	tester.SetSize(graphics.Size{Width: 390, Height: 800})
+	controller := platform.NewTextEditingController("")
+	var sent []string
+	tester.PumpWidget(widgets.Column{Children: []core.Widget{
+		chat.InputBar{Controller: controller, OnSend: func(text string) { sent = append(sent, text) }},
+	}})
+
+	tester.Tap(drifttest.ByText("➤"))
+	if len(sent) != 0 {
+		t.Fatalf("sent %v with an empty draft", sent)
+	}
+
+	controller.SetText("  see you soon ")
+	tester.Pump()
+	if err := tester.Tap(drifttest.ByText("➤")); err != nil {
+		t.Fatal(err)
+	}
+	if len(sent) != 1 || sent[0] != "see you soon" {
+		t.Errorf("sent = %q, want [see you soon]", sent)
+	}
+	if controller.Text() != "" {
+		t.Errorf("draft = %q after sending, want empty", controller.Text())
+	}
+}
+
+func TestTypingIndicator_Animates(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	tester.PumpWidget(chat.TypingIndicator{})
+
+	opacities := func() []float64 {
+		var values []float64
+		for _, e := range tester.Find(drifttest.ByType[widgets.Opacity]()).All() {
+			values = append(values, e.Widget().(widgets.Opacity).Opacity)
+		}
+		return values
+	}
+	before := opacities()
+	if len(before) != 3 {
+		t.Fatalf("dots = %d, want 3", len(before))
+	}
+	tester.Clock().Advance(300 * time.Millisecond)
+	tester.Pump()
+	after := opacities()
+	if before[0] == after[0] {
+		t.Error("expected the dots to pulse")
+	}
+}
@@ -0,0 +1,32 @@
+// Package chat provides the building blocks of messaging screens.
+//
+//   - [MessageList] shows a conversation newest-first from the bottom,
+//     building older messages as they scroll into view and marking where
+//     unread messages begin.
+//   - [Bubble] draws a message in a speech bubble, with a tail on the last
+//     bubble of a run of messages from the same sender.
+//   - [TypingIndicator] animates three dots while the other side types.
+//   - [InputBar] is the compose field and send button, kept above the
+//     on-screen keyboard.
+//
+// A chat screen stacks the list above the input bar:
+//
+//	widgets.Column{
+//	    MainAxisSize: widgets.MainAxisSizeMax,
+//	    Children: []core.Widget{
+//	        widgets.Expanded{Child: chat.MessageList{
+//	            Count:       len(s.messages),
+//	            ItemBuilder: s.buildMessage,
+//	            UnreadCount: s.unread,
+//	            OnLoadOlder: s.loadOlder,
+//	        }},
+//	        chat.InputBar{Controller: s.draft, OnSend: s.send},
+//	    },
+//	}
+//
+// # Styling
+//
+// Like the patterns package, the chat widgets take their colors and text styles from
+// the current theme. Where a widget has color fields, a zero value uses the
+// theme.
+package chat
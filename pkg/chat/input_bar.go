@@ -0,0 +1,181 @@
+package chat
+
+import (
+	"strings"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/layout"
+	"github.com/go-drift/drift/pkg/platform"
+	"github.com/go-drift/drift/pkg/semantics"
+	"github.com/go-drift/drift/pkg/theme"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+const sendButtonSize = 40
+
+// InputBar is the compose row at the bottom of a chat screen: optional
+// leading buttons, a text field, and a send button.
+//
+//	chat.InputBar{
+//	    Controller: s.draft,
+//	    Leading:    attachButton,
+//	    OnSend:     func(text string) { s.send(text) },
+//	}
+//
+// Tapping send, or the keyboard's send key, passes the trimmed text to OnSend
+// and clears the field. The send button is disabled while the field is
+// blank.
+//
+// The bar pads its bottom by the on-screen keyboard's height, or by the
+// bottom safe area when the keyboard is hidden, so it stays directly above
+// the keyboard. Place it at the bottom of a screen that fills the window,
+// below the [MessageList], rather than inside a scroll view.
+type InputBar struct {
+	core.StatefulBase
+
+	// Controller holds the draft. When nil, the bar keeps its own.
+	Controller *platform.TextEditingController
+	// Placeholder is shown while the field is empty. Zero means "Message".
+	Placeholder string
+	// OnSend is called with the trimmed text when the user sends it.
+	OnSend func(text string)
+	// OnContentInserted receives images and other content inserted from the
+	// keyboard, such as stickers. Optional.
+	OnContentInserted func(platform.InsertedContent)
+	// Leading is shown before the text field, such as an attachment or
+	// camera button. Optional.
+	Leading core.Widget
+	// Disabled disables the field and the send button.
+	Disabled bool
+	// Color is the bar's background. Zero uses the theme's surface color.
+	Color graphics.Color
+}
+
+func (b InputBar) CreateState() core.State {
+	return &inputBarState{}
+}
+
+type inputBarState struct {
+	core.StateBase
+	controller     *platform.TextEditingController
+	removeListener func()
+}
+
+func (s *inputBarState) InitState() {
+	s.attach(s.Element().Widget().(InputBar).Controller)
+}
+
+func (s *inputBarState) DidUpdateWidget(oldWidget core.StatefulWidget) {
+	old := oldWidget.(InputBar)
+	w := s.Element().Widget().(InputBar)
+	if old.Controller != w.Controller {
+		s.detach()
+		s.attach(w.Controller)
+	}
+}
+
+func (s *inputBarState) Dispose() {
+	s.detach()
+	s.StateBase.Dispose()
+}
+
+func (s *inputBarState) attach(controller *platform.TextEditingController) {
+	s.controller = controller
+	if s.controller == nil {
+		s.controller = platform.NewTextEditingController("")
+	}
+	// Rebuild as the text changes to enable and disable the send button.
+	s.removeListener = s.controller.AddListener(func() { s.SetState(nil) })
+}
+
+func (s *inputBarState) detach() {
+	if s.removeListener != nil {
+		s.removeListener()
+		s.removeListener = nil
+	}
+}
+
+func (s *inputBarState) send() {
+	w := s.Element().Widget().(InputBar)
+	text := strings.TrimSpace(s.controller.Text())
+	if w.Disabled || text == "" {
+		return
+	}
+	s.controller.Clear()
+	if w.OnSend != nil {
+		w.OnSend(text)
+	}
+}
+
+func (s *inputBarState) Build(ctx core.BuildContext) core.Widget {
+	w := s.Element().Widget().(InputBar)
+	colors := theme.ColorsOf(ctx)
+	placeholder := w.Placeholder
+	if placeholder == "" {
+		placeholder = "Message"
+	}
+
+	field := theme.TextFieldOf(ctx, s.controller)
+	field.Placeholder = placeholder
+	field.InputAction = platform.TextInputActionSend
+	field.EnablesReturnKeyAutomatically = true
+	field.OnSubmitted = func(string) { s.send() }
+	field.OnContentInserted = w.OnContentInserted
+	field.Disabled = w.Disabled
+
+	children := make([]core.Widget, 0, 5)
+	if w.Leading != nil {
+		children = append(children, w.Leading, widgets.HSpace(8))
+	}
+	children = append(children,
+		widgets.Expanded{Child: field},
+		widgets.HSpace(8),
+		s.sendButton(colors, !w.Disabled && strings.TrimSpace(s.controller.Text()) != ""),
+	)
+
+	// The keyboard covers the safe area, so only the larger of the two
+	// applies.
+	bottom := max(widgets.MediaQueryViewInsetsOf(ctx).Bottom, widgets.MediaQueryPaddingOf(ctx).Bottom)
+	return widgets.Container{
+		Color: orColor(w.Color, colors.Surface),
+		Padding: layout.EdgeInsets{
+			Left:   12,
+			Right:  12,
+			Top:    8,
+			Bottom: 8 + bottom,
+		},
+		Child: widgets.Row{
+			MainAxisSize:       widgets.MainAxisSizeMax,
+			CrossAxisAlignment: widgets.CrossAxisAlignmentCenter,
+			Children:           children,
+		},
+	}
+}
+
+func (s *inputBarState) sendButton(colors theme.ColorScheme, enabled bool) core.Widget {
+	fill, iconColor := colors.Primary, colors.OnPrimary
+	flags := semantics.SemanticsIsButton | semantics.SemanticsHasEnabledState
+	var onTap func()
+	if enabled {
+		flags |= semantics.SemanticsIsEnabled
+		onTap = s.send
+	} else {
+		fill, iconColor = colors.SurfaceContainerHighest, colors.OnSurfaceVariant
+	}
+	return widgets.Semantics{
+		Label:     "Send",
+		Role:      semantics.SemanticsRoleButton,
+		Flags:     flags,
+		Container: true,
+		OnTap:     onTap,
+		Child: widgets.Tap(onTap, widgets.Container{
+			Width:        sendButtonSize,
+			Height:       sendButtonSize,
+			Color:        fill,
+			BorderRadius: sendButtonSize / 2,
+			Alignment:    layout.AlignmentCenter,
+			Child:        widgets.Icon{Glyph: "➤", Size: 20, Color: iconColor},
+		}),
+	}
+}
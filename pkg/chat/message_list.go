@@ -0,0 +1,248 @@
+package chat
+
+import (
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/layout"
+	"github.com/go-drift/drift/pkg/semantics"
+	"github.com/go-drift/drift/pkg/theme"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+const (
+	// defaultMessageBatch is how many messages are built at first, and how
+	// many more as the user nears the oldest one built.
+	defaultMessageBatch = 50
+	// defaultLoadOlderThreshold is how close, in pixels, the oldest message
+	// must come to the top before more are built or loaded.
+	defaultLoadOlderThreshold = 400
+)
+
+// MessageList shows a conversation with the newest message at the bottom.
+// Index 0 is the newest message, so adding a message means inserting it at
+// the front of the app's slice:
+//
+//	chat.MessageList{
+//	    Count: len(s.messages),
+//	    ItemBuilder: func(ctx core.BuildContext, i int) core.Widget {
+//	        m := s.messages[i]
+//	        return chat.Bubble{Text: m.Text, Outgoing: m.Mine, Position: s.positionOf(i)}
+//	    },
+//	    UnreadCount: s.unread,
+//	    OnLoadOlder: s.loadOlder,
+//	}
+//
+// The list scrolls in reverse: it starts at the newest message and, while
+// it is there, keeps new messages in view as they arrive. Call JumpTo(0) on
+// Controller to return to the newest message.
+//
+// Messages vary in height, so rather than building only the visible ones,
+// the list builds the newest BatchSize messages and builds more as the user
+// scrolls toward the oldest. Once every message is built, OnLoadOlder is
+// called so the app can fetch earlier history.
+type MessageList struct {
+	core.StatefulBase
+
+	// Count is the number of messages.
+	Count int
+	// ItemBuilder builds the message at index; index 0 is the newest.
+	ItemBuilder func(ctx core.BuildContext, index int) core.Widget
+
+	// UnreadCount is how many of the newest messages are unread. A separator
+	// is shown above the oldest of them. Zero shows no separator.
+	UnreadCount int
+	// UnreadSeparator replaces the default [UnreadSeparator]. Optional.
+	UnreadSeparator core.Widget
+
+	// Header is shown above the oldest message, such as a progress
+	// indicator while earlier history loads. Optional.
+	Header core.Widget
+	// OnLoadOlder is called when the user scrolls near the oldest message
+	// and every message is built. Add earlier messages at the end of the
+	// app's slice. It may be called again before they arrive.
+	OnLoadOlder func()
+	// LoadOlderThreshold is how close, in pixels, the oldest message must
+	// come to the top of the list before more are built or loaded. Zero
+	// means 400.
+	LoadOlderThreshold float64
+	// BatchSize is how many messages are built at first and each time more
+	// are needed. Zero means 50.
+	BatchSize int
+
+	// Controller observes and moves the scroll position. Offset zero is the
+	// newest message. Optional.
+	Controller *widgets.ScrollController
+	// Physics determines how the list responds to the user.
+	Physics widgets.ScrollPhysics
+	// Padding is applied around the messages.
+	Padding layout.EdgeInsets
+}
+
+func (l MessageList) CreateState() core.State {
+	return &messageListState{}
+}
+
+type messageListState struct {
+	core.StateBase
+	controller     *widgets.ScrollController
+	removeListener func()
+	// built is how many messages, from the newest, to build; shown is how
+	// many the last build did.
+	built int
+	shown int
+}
+
+func (s *messageListState) InitState() {
+	w := s.Element().Widget().(MessageList)
+	s.built = w.batchSize()
+	s.attach(w.Controller)
+}
+
+func (s *messageListState) DidUpdateWidget(oldWidget core.StatefulWidget) {
+	old := oldWidget.(MessageList)
+	w := s.Element().Widget().(MessageList)
+	if old.Controller != w.Controller {
+		s.detach()
+		s.attach(w.Controller)
+	}
+}
+
+func (s *messageListState) Dispose() {
+	s.detach()
+	s.StateBase.Dispose()
+}
+
+func (s *messageListState) attach(controller *widgets.ScrollController) {
+	s.controller = controller
+	if s.controller == nil {
+		s.controller = &widgets.ScrollController{}
+	}
+	s.removeListener = s.controller.AddListener(s.onScroll)
+}
+
+func (s *messageListState) detach() {
+	if s.removeListener != nil {
+		s.removeListener()
+		s.removeListener = nil
+	}
+}
+
+// onScroll builds more messages, or asks for older ones, when the oldest
+// built message nears the top.
+func (s *messageListState) onScroll() {
+	if s.Element() == nil {
+		return
+	}
+	w := s.Element().Widget().(MessageList)
+	threshold := w.LoadOlderThreshold
+	if threshold <= 0 {
+		threshold = defaultLoadOlderThreshold
+	}
+	if s.controller.ViewportExtent() <= 0 || s.controller.MaxScrollExtent()-s.controller.Offset() > threshold {
+		return
+	}
+	if s.shown < w.Count {
+		s.SetState(func() { s.built = s.shown + w.batchSize() })
+		return
+	}
+	if w.OnLoadOlder != nil {
+		w.OnLoadOlder()
+	}
+}
+
+func (s *messageListState) Build(ctx core.BuildContext) core.Widget {
+	w := s.Element().Widget().(MessageList)
+	count := min(w.Count, s.built)
+	s.shown = count
+
+	// The children run from the newest message, with the unread separator
+	// after the newest UnreadCount messages and the header last.
+	children := make([]core.Widget, 0, count+2)
+	for i := range count {
+		if w.UnreadCount > 0 && i == w.UnreadCount {
+			children = append(children, w.unreadSeparator())
+		}
+		if w.ItemBuilder != nil {
+			if item := w.ItemBuilder(ctx, i); item != nil {
+				children = append(children, item)
+			}
+		}
+	}
+	if w.UnreadCount > 0 && w.UnreadCount >= count && count == w.Count {
+		// Every message is unread.
+		children = append(children, w.unreadSeparator())
+	}
+	if w.Header != nil {
+		children = append(children, w.Header)
+	}
+
+	return widgets.ListView{
+		Reverse:      true,
+		Controller:   s.controller,
+		Physics:      w.Physics,
+		Padding:      w.Padding,
+		MainAxisSize: widgets.MainAxisSizeMin,
+		Children:     children,
+	}
+}
+
+func (l MessageList) batchSize() int {
+	if l.BatchSize <= 0 {
+		return defaultMessageBatch
+	}
+	return l.BatchSize
+}
+
+func (l MessageList) unreadSeparator() core.Widget {
+	if l.UnreadSeparator != nil {
+		return l.UnreadSeparator
+	}
+	return UnreadSeparator{}
+}
+
+// UnreadSeparator marks where unread messages begin: a label between two
+// lines.
+type UnreadSeparator struct {
+	core.StatelessBase
+
+	// Label is the separator's text. Zero means "Unread messages".
+	Label string
+	// Color is the label and line color. Zero uses the theme's primary
+	// color.
+	Color graphics.Color
+}
+
+func (u UnreadSeparator) Build(ctx core.BuildContext) core.Widget {
+	_, colors, textTheme := theme.UseTheme(ctx)
+	color := u.Color
+	if color == 0 {
+		color = colors.Primary
+	}
+	label := u.Label
+	if label == "" {
+		label = "Unread messages"
+	}
+	style := textTheme.LabelMedium
+	style.Color = color
+	line := widgets.Expanded{Child: widgets.Divider{Height: 1, Thickness: 1, Color: color}}
+
+	return widgets.Semantics{
+		Role:             semantics.SemanticsRoleHeader,
+		Container:        true,
+		MergeDescendants: true,
+		Child: widgets.Padding{
+			Padding: layout.EdgeInsetsSymmetric(16, 12),
+			Child: widgets.Row{
+				MainAxisSize:       widgets.MainAxisSizeMax,
+				CrossAxisAlignment: widgets.CrossAxisAlignmentCenter,
+				Children: []core.Widget{
+					line,
+					widgets.HSpace(12),
+					widgets.Text{Content: label, Style: style, MaxLines: 1},
+					widgets.HSpace(12),
+					line,
+				},
+			},
+		},
+	}
+}
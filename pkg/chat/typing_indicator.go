@@ -0,0 +1,122 @@
+package chat
+
+import (
+	"math"
+	"time"
+
+	"github.com/go-drift/drift/pkg/animation"
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/semantics"
+	"github.com/go-drift/drift/pkg/theme"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+const (
+	typingDotSize    = 8
+	typingDotSpacing = 4
+	// typingDotStagger is how far behind the previous dot, as a fraction of
+	// the cycle, each dot pulses.
+	typingDotStagger = 0.2
+)
+
+// TypingIndicator shows three pulsing dots in an incoming bubble while the
+// other side is typing. Add it as the newest item of a [MessageList]:
+//
+//	ItemBuilder: func(ctx core.BuildContext, i int) core.Widget {
+//	    if s.peerTyping {
+//	        if i == 0 {
+//	            return chat.TypingIndicator{}
+//	        }
+//	        i--
+//	    }
+//	    return s.buildMessage(ctx, i)
+//	}
+//
+// The dots animate for as long as the indicator is shown. Screen readers
+// announce it as "Typing" when it appears.
+type TypingIndicator struct {
+	core.StatefulBase
+
+	// Label is announced by screen readers. Zero means "Typing".
+	Label string
+	// Color is the bubble's fill. Zero uses the theme, as for an incoming
+	// [Bubble].
+	Color graphics.Color
+	// DotColor is the dots' color. Zero uses the theme's on-surface variant
+	// color.
+	DotColor graphics.Color
+	// Position is the indicator's place in a run of bubbles from the same
+	// sender.
+	Position BubbleGroupPosition
+}
+
+func (t TypingIndicator) CreateState() core.State {
+	return &typingIndicatorState{}
+}
+
+type typingIndicatorState struct {
+	core.StateBase
+	controller *animation.AnimationController
+}
+
+func (s *typingIndicatorState) InitState() {
+	s.controller = animation.NewAnimationController(1200 * time.Millisecond)
+	s.controller.Curve = animation.LinearCurve
+	core.UseDisposable(s, s.controller)
+	core.UseListenable(s, s.controller)
+	s.controller.AddStatusListener(func(status animation.AnimationStatus) {
+		if status == animation.AnimationCompleted {
+			s.controller.Reset()
+			s.controller.Forward()
+		}
+	})
+	s.controller.Forward()
+}
+
+func (s *typingIndicatorState) Build(ctx core.BuildContext) core.Widget {
+	w := s.Element().Widget().(TypingIndicator)
+	colors := theme.ColorsOf(ctx)
+	dotColor := orColor(w.DotColor, colors.OnSurfaceVariant)
+	label := w.Label
+	if label == "" {
+		label = "Typing"
+	}
+
+	dots := make([]core.Widget, 0, 5)
+	for i := range 3 {
+		if i > 0 {
+			dots = append(dots, widgets.HSpace(typingDotSpacing))
+		}
+		// Each dot brightens once per cycle, a little after the one before.
+		phase := math.Mod(s.controller.Value-float64(i)*typingDotStagger+1, 1)
+		pulse := math.Sin(phase * math.Pi)
+		dots = append(dots, widgets.Opacity{
+			Opacity: 0.35 + 0.65*pulse,
+			Child: widgets.Container{
+				Width:        typingDotSize,
+				Height:       typingDotSize,
+				Color:        dotColor,
+				BorderRadius: typingDotSize / 2,
+			},
+		})
+	}
+
+	return widgets.Semantics{
+		Label:     label,
+		Flags:     semantics.SemanticsIsLiveRegion,
+		Container: true,
+		Child: Bubble{
+			Color:    w.Color,
+			Position: w.Position,
+			Child: widgets.SizedBox{
+				Height: 24,
+				Child: widgets.Row{
+					MainAxisSize:       widgets.MainAxisSizeMin,
+					CrossAxisAlignment: widgets.CrossAxisAlignmentCenter,
+					Children:           dots,
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,34 @@
+package core
+
+// AnimatedBuilder rebuilds whenever Animation notifies. It is the usual way
+// to drive a subtree from an animation controller, though any [Listenable]
+// works:
+//
+//	core.AnimatedBuilder{
+//	    Animation: s.controller,
+//	    Builder: func(ctx core.BuildContext, child core.Widget) core.Widget {
+//	        return widgets.Opacity{Opacity: s.controller.Value, Child: child}
+//	    },
+//	    Child: content, // built once, reused on every frame
+//	}
+//
+// Child is passed through to Builder unchanged, so the parts of the subtree
+// that do not depend on the animation are not rebuilt each frame.
+type AnimatedBuilder struct {
+	StatelessBase
+	Animation Listenable
+	Builder   func(ctx BuildContext, child Widget) Widget
+	Child     Widget
+}
+
+func (w AnimatedBuilder) Build(ctx BuildContext) Widget {
+	if w.Builder == nil {
+		panic("AnimatedBuilder: Builder must not be nil")
+	}
+	return &ListenableBuilder{
+		Listenable: w.Animation,
+		Builder: func(ctx BuildContext) Widget {
+			return w.Builder(ctx, w.Child)
+		},
+	}
+}
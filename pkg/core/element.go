@@ -34,6 +34,12 @@ type elementBase struct {
 	self         Element
 	mounted      bool
 	renderParent renderObjectHost // nearest ancestor that owns a render object
+
+	// Rebuild statistics for tree snapshots (see CaptureTreeSnapshot).
+	debugID        uint64
+	buildCount     int
+	lastRebuild    RebuildReason
+	pendingRebuild RebuildReason // why the element is currently dirty
 }
 
 func (e *elementBase) Widget() Widget {
@@ -87,10 +93,17 @@ func (e *elementBase) NeedsBuild() bool {
 }
 
 func (e *elementBase) MarkNeedsBuild() {
+	e.markNeedsBuild(RebuildReasonSetState)
+}
+
+// markNeedsBuild schedules a rebuild and records why the element became
+// dirty. If the element is already dirty, the original reason is kept.
+func (e *elementBase) markNeedsBuild(reason RebuildReason) {
 	if e.dirty {
 		return
 	}
 	e.dirty = true
+	e.pendingRebuild = reason
 	if e.buildOwner != nil && e.self != nil {
 		e.buildOwner.ScheduleBuild(e.self)
 	}
@@ -230,7 +243,7 @@ func (e *StatelessElement) Mount(parent Element, slot any) {
 
 func (e *StatelessElement) Update(newWidget Widget) {
 	e.widget = newWidget
-	e.MarkNeedsBuild()
+	e.markNeedsBuild(RebuildReasonParent)
 }
 
 func (e *StatelessElement) Unmount() {
@@ -247,6 +260,11 @@ func (e *StatelessElement) RebuildIfNeeded() {
 		return
 	}
 	e.dirty = false
+	e.recordRebuild()
+	if layout.ProfilingEnabled() {
+		layout.BeginProfile()
+		defer layout.EndProfile(e.self, layout.ProfilePhaseBuild)
+	}
 	widget := e.widget.(StatelessWidget)
 	built := e.safeBuild(func() Widget {
 		return widget.Build(e)
@@ -306,7 +324,7 @@ func (e *StatefulElement) Update(newWidget Widget) {
 	oldWidget := e.widget.(StatefulWidget)
 	e.widget = newWidget
 	e.state.DidUpdateWidget(oldWidget)
-	e.MarkNeedsBuild()
+	e.markNeedsBuild(RebuildReasonParent)
 }
 
 func (e *StatefulElement) Unmount() {
@@ -326,6 +344,11 @@ func (e *StatefulElement) RebuildIfNeeded() {
 		return
 	}
 	e.dirty = false
+	e.recordRebuild()
+	if layout.ProfilingEnabled() {
+		layout.BeginProfile()
+		defer layout.EndProfile(e.self, layout.ProfilePhaseBuild)
+	}
 	built := e.safeBuild(func() Widget {
 		return e.state.Build(e)
 	})
@@ -386,7 +409,7 @@ func (e *RenderObjectElement) Mount(parent Element, slot any) {
 
 func (e *RenderObjectElement) Update(newWidget Widget) {
 	e.widget = newWidget
-	e.MarkNeedsBuild()
+	e.markNeedsBuild(RebuildReasonParent)
 }
 
 func (e *RenderObjectElement) Unmount() {
@@ -408,6 +431,11 @@ func (e *RenderObjectElement) RebuildIfNeeded() {
 		return
 	}
 	e.dirty = false
+	e.recordRebuild()
+	if layout.ProfilingEnabled() {
+		layout.BeginProfile()
+		defer layout.EndProfile(e.self, layout.ProfilePhaseBuild)
+	}
 
 	widget := e.widget.(RenderObjectWidget)
 	widget.UpdateRenderObject(e, e.renderObject)
@@ -512,6 +540,9 @@ func (e *RenderObjectElement) insertRenderObjectChild(child layout.RenderObject,
 	// For single-child render objects, set the child directly
 	if single, ok := e.renderObject.(interface{ SetChild(layout.RenderObject) }); ok {
 		single.SetChild(child)
+		// The swap may come from a descendant's rebuild, in which case this
+		// element's UpdateRenderObject does not run to request layout.
+		e.renderObject.MarkNeedsLayout()
 		return
 	}
 	// For multi-child: parent reference is set above; the children list will be
@@ -529,6 +560,7 @@ func (e *RenderObjectElement) removeRenderObjectChild(child layout.RenderObject,
 	}
 	if single, ok := e.renderObject.(interface{ SetChild(layout.RenderObject) }); ok {
 		single.SetChild(nil)
+		e.renderObject.MarkNeedsLayout()
 		return
 	}
 	e.rebuildChildrenRenderList()
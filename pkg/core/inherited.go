@@ -70,7 +70,7 @@ func (e *InheritedElement) Update(newWidget Widget) {
 	// ShouldRebuildDependents acts as a coarse-grained gate. If it returns false,
 	// no dependents are notified.
 	if !newInherited.ShouldRebuildDependents(oldWidget) {
-		e.MarkNeedsBuild()
+		e.markNeedsBuild(RebuildReasonParent)
 		return
 	}
 
@@ -92,7 +92,7 @@ func (e *InheritedElement) Update(newWidget Widget) {
 		}
 	}
 
-	e.MarkNeedsBuild()
+	e.markNeedsBuild(RebuildReasonParent)
 }
 
 func (e *InheritedElement) Unmount() {
@@ -119,6 +119,11 @@ func (e *InheritedElement) rebuildWithSelf(self Element) {
 		return
 	}
 	e.dirty = false
+	e.recordRebuild()
+	if layout.ProfilingEnabled() {
+		layout.BeginProfile()
+		defer layout.EndProfile(self, layout.ProfilePhaseBuild)
+	}
 	inherited := e.widget.(InheritedWidget)
 	childWidget := inherited.ChildWidget()
 	e.child = updateChild(e.child, childWidget, self, e.buildOwner, nil)
@@ -178,10 +183,14 @@ func notifyDependent(element Element) {
 		if stateful.state != nil {
 			stateful.state.DidChangeDependencies()
 		}
-		stateful.MarkNeedsBuild()
+		stateful.markNeedsBuild(RebuildReasonDependencies)
 		return
 	}
 	// For other elements, just mark needs build
+	if marker, ok := element.(interface{ markNeedsBuild(RebuildReason) }); ok {
+		marker.markNeedsBuild(RebuildReasonDependencies)
+		return
+	}
 	element.MarkNeedsBuild()
 }
 
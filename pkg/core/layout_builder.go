@@ -117,6 +117,7 @@ func (e *LayoutBuilderElement) RebuildIfNeeded() {
 		return
 	}
 	e.dirty = false
+	e.recordRebuild()
 	e.childDirty = true
 	e.renderObject.MarkNeedsLayout()
 }
@@ -138,6 +139,11 @@ func (e *LayoutBuilderElement) layoutCallback(constraints layout.Constraints) {
 		return
 	}
 
+	if layout.ProfilingEnabled() {
+		layout.BeginProfile()
+		defer layout.EndProfile(e.self, layout.ProfilePhaseBuild)
+	}
+
 	lbw := e.widget.(LayoutBuilderWidget)
 	builder := lbw.LayoutBuilder()
 
@@ -168,3 +168,29 @@ func TestListenableBuilder_BuildDelegatesToBuilder(t *testing.T) {
 		t.Errorf("expected sentinel widget, got %v", got)
 	}
 }
+
+func TestAnimatedBuilder_PassesChildAndListenable(t *testing.T) {
+	n := &Notifier{}
+	child := sentinelWidget{}
+	var gotChild Widget
+	w := AnimatedBuilder{
+		Animation: n,
+		Builder: func(ctx BuildContext, c Widget) Widget {
+			gotChild = c
+			return nil
+		},
+		Child: child,
+	}
+
+	lb, ok := w.Build(nil).(*ListenableBuilder)
+	if !ok {
+		t.Fatalf("expected a ListenableBuilder, got %T", w.Build(nil))
+	}
+	if lb.Listenable != n {
+		t.Error("expected the animation to be the listenable")
+	}
+	lb.Builder(nil)
+	if gotChild != child {
+		t.Error("expected Child passed to Builder")
+	}
+}
@@ -118,3 +118,121 @@ func MustProvide[T any](ctx BuildContext) T {
 	}
 	return value
 }
+
+// Provider is a [StatefulWidget] that injects a service, such as an API
+// client or a store, into its subtree. Descendants read it with [Consumer],
+// [Provide] or [MustProvide], so the service is scoped to the part of the
+// tree that needs it instead of living in a package-level variable:
+//
+//	core.Provider[*api.Client]{
+//	    Create: func(ctx core.BuildContext) *api.Client {
+//	        return api.NewClient(core.MustProvide[*Config](ctx).BaseURL)
+//	    },
+//	    Dispose: func(c *api.Client) { c.Close() },
+//	    Child:   App{},
+//	}
+//
+// Create runs once, on the provider's first build, and its result is kept for
+// the provider's lifetime; later changes to Create are ignored. Dispose
+// releases the created value when the provider leaves the tree, after its
+// subtree has been unmounted.
+//
+// To provide an instance owned elsewhere, set Value and leave Create nil.
+// Dependents rebuild when Value changes. A nearer Provider of the same type
+// overrides an outer one, which is how tests swap in fakes:
+//
+//	core.Provider[api.Service]{Value: fakeService{}, Child: ScreenUnderTest{}}
+//
+// Lookups match T exactly: a value provided as Provider[api.Service] is read
+// with Consumer[api.Service], not with the concrete type behind it.
+type Provider[T any] struct {
+	StatefulBase
+
+	// Create builds the provided value. The context can read providers
+	// further up the tree.
+	Create func(ctx BuildContext) T
+
+	// Value is provided when Create is nil.
+	Value T
+
+	// Dispose, if set, is called with the value built by Create when the
+	// provider is unmounted. It is not called for Value.
+	Dispose func(T)
+
+	// ShouldRebuild customizes when dependents rebuild after Value changes,
+	// as for [InheritedProvider]. Required when T is not comparable.
+	ShouldRebuild func(old, new T) bool
+
+	// Child is the subtree that can read the value.
+	Child Widget
+}
+
+func (Provider[T]) CreateState() State {
+	return &providerState[T]{}
+}
+
+type providerState[T any] struct {
+	StateBase
+	value   T
+	created bool
+}
+
+func (s *providerState[T]) widget() Provider[T] {
+	return s.Element().Widget().(Provider[T])
+}
+
+func (s *providerState[T]) Build(ctx BuildContext) Widget {
+	w := s.widget()
+	value := w.Value
+	if w.Create != nil {
+		if !s.created {
+			s.value = w.Create(ctx)
+			s.created = true
+		}
+		value = s.value
+	}
+	return InheritedProvider[T]{
+		Value:         value,
+		Child:         w.Child,
+		ShouldRebuild: w.ShouldRebuild,
+	}
+}
+
+func (s *providerState[T]) Dispose() {
+	if s.created {
+		if dispose := s.widget().Dispose; dispose != nil {
+			dispose(s.value)
+		}
+	}
+	s.StateBase.Dispose()
+}
+
+// Consumer is a [StatelessWidget] that reads the value of the nearest
+// ancestor [Provider] of type T and passes it to Builder. It rebuilds when
+// the provided value changes, so wrapping only the widgets that use a
+// service keeps the rest of the screen from rebuilding:
+//
+//	core.Consumer[*CartStore]{
+//	    Builder: func(ctx core.BuildContext, cart *CartStore, child core.Widget) core.Widget {
+//	        return widgets.Text{Content: fmt.Sprint(cart.Count())}
+//	    },
+//	}
+//
+// Child is passed through to Builder unchanged, as for
+// [ValueListenableBuilder]. Consumer panics if no provider of type T is found.
+type Consumer[T any] struct {
+	StatelessBase
+	Builder func(ctx BuildContext, value T, child Widget) Widget
+	Child   Widget
+}
+
+func (c Consumer[T]) Build(ctx BuildContext) Widget {
+	if c.Builder == nil {
+		panic("Consumer: Builder must not be nil")
+	}
+	value, ok := Provide[T](ctx)
+	if !ok {
+		panic("Consumer: no Provider[" + reflect.TypeFor[T]().String() + "] found in ancestors")
+	}
+	return c.Builder(ctx, value, c.Child)
+}
@@ -363,3 +363,100 @@ func TestInheritedProvider_WrongOldWidgetType(t *testing.T) {
 		t.Error("expected ShouldRebuild to return true for different widget types")
 	}
 }
+
+func TestProvider_CreateOnceAndDispose(t *testing.T) {
+	owner := NewBuildOwner()
+
+	creates := 0
+	var disposed *testUser
+	var consumed *testUser
+	newWidget := func(name string) Provider[*testUser] {
+		return Provider[*testUser]{
+			Create: func(ctx BuildContext) *testUser {
+				creates++
+				return &testUser{ID: creates, Name: name}
+			},
+			Dispose: func(u *testUser) { disposed = u },
+			Child: Consumer[*testUser]{
+				Builder: func(ctx BuildContext, u *testUser, child Widget) Widget {
+					consumed = u
+					return nil
+				},
+			},
+		}
+	}
+
+	element := newTestStatefulElement(newWidget("first"), owner)
+	element.Mount(nil, nil)
+	if consumed == nil || consumed.Name != "first" {
+		t.Fatalf("expected Consumer to receive the created value, got %v", consumed)
+	}
+	created := consumed
+
+	element.Update(newWidget("second"))
+	element.RebuildIfNeeded()
+	if creates != 1 {
+		t.Errorf("expected Create to run once, ran %d times", creates)
+	}
+
+	element.Unmount()
+	if disposed != created {
+		t.Errorf("expected Dispose called with the created value, got %v", disposed)
+	}
+}
+
+func TestProvider_ValueOverride(t *testing.T) {
+	owner := NewBuildOwner()
+
+	real := &testUser{ID: 1, Name: "Real"}
+	fake := &testUser{ID: 2, Name: "Fake"}
+	disposeCalled := false
+	var consumed *testUser
+
+	widget := Provider[*testUser]{
+		Create:  func(ctx BuildContext) *testUser { return real },
+		Dispose: func(*testUser) { disposeCalled = true },
+		Child: Provider[*testUser]{
+			Value:   fake,
+			Dispose: func(*testUser) { t.Error("expected Dispose not to be called for Value") },
+			Child: Consumer[*testUser]{
+				Builder: func(ctx BuildContext, u *testUser, child Widget) Widget {
+					consumed = u
+					return nil
+				},
+			},
+		},
+	}
+
+	element := newTestStatefulElement(widget, owner)
+	element.Mount(nil, nil)
+	if consumed != fake {
+		t.Errorf("expected the nearer provider to win, got %v", consumed)
+	}
+
+	element.Unmount()
+	if !disposeCalled {
+		t.Error("expected the outer provider to dispose its created value")
+	}
+}
+
+func TestConsumer_PanicsWithoutProvider(t *testing.T) {
+	var panicValue any
+	widget := testStatelessWidget{
+		buildFn: func(ctx BuildContext) Widget {
+			defer func() {
+				panicValue = recover()
+			}()
+			return Consumer[*testUser]{
+				Builder: func(BuildContext, *testUser, Widget) Widget { return nil },
+			}.Build(ctx)
+		},
+	}
+
+	element := newTestStatelessElement(widget, NewBuildOwner())
+	element.Mount(nil, nil)
+
+	if msg, _ := panicValue.(string); !strings.Contains(msg, "Provider[*core.testUser]") {
+		t.Errorf("expected a panic naming the missing provider, got %v", panicValue)
+	}
+}
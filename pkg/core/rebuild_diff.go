@@ -0,0 +1,213 @@
+package core
+
+import (
+	"cmp"
+	"fmt"
+	"reflect"
+	"slices"
+	"sync/atomic"
+	"time"
+)
+
+// RebuildReason describes why an element was last built.
+type RebuildReason int
+
+const (
+	// RebuildReasonMount means the element has only been built once, when it
+	// was mounted.
+	RebuildReasonMount RebuildReason = iota
+	// RebuildReasonSetState means the element was marked dirty by
+	// [StateBase.SetState] or a direct [Element.MarkNeedsBuild] call, such as
+	// from a listenable or signal subscription.
+	RebuildReasonSetState
+	// RebuildReasonParent means the parent rebuilt and passed a new widget.
+	RebuildReasonParent
+	// RebuildReasonDependencies means an inherited widget the element depends
+	// on changed.
+	RebuildReasonDependencies
+)
+
+// String returns a human-readable representation of the rebuild reason.
+func (r RebuildReason) String() string {
+	switch r {
+	case RebuildReasonMount:
+		return "mount"
+	case RebuildReasonSetState:
+		return "setState"
+	case RebuildReasonParent:
+		return "parent"
+	case RebuildReasonDependencies:
+		return "dependencies"
+	default:
+		return fmt.Sprintf("RebuildReason(%d)", int(r))
+	}
+}
+
+// MarshalText encodes the reason as its string form so JSON output and map
+// keys are readable.
+func (r RebuildReason) MarshalText() ([]byte, error) {
+	return []byte(r.String()), nil
+}
+
+// ElementSnapshot is the captured state of one element in a [TreeSnapshot].
+type ElementSnapshot struct {
+	// ID identifies the element across snapshots. It is stable for the
+	// element's lifetime and never reused.
+	ID uint64 `json:"id"`
+	// ParentID is the ID of the nearest captured ancestor, or 0 for the root.
+	ParentID   uint64 `json:"parentId,omitempty"`
+	WidgetType string `json:"widgetType"`
+	Key        string `json:"key,omitempty"`
+	Depth      int    `json:"depth"`
+	// BuildCount is the number of times the element has been built,
+	// including the initial build on mount.
+	BuildCount int `json:"buildCount"`
+	// LastRebuild is the reason for the most recent build.
+	LastRebuild RebuildReason `json:"lastRebuild"`
+}
+
+// TreeSnapshot is a flat capture of the element tree at a point in time.
+// Capture two snapshots around an interaction and compare them with
+// [DiffTreeSnapshots] to see which elements rebuilt and why.
+type TreeSnapshot struct {
+	Time     time.Time         `json:"time"`
+	Elements []ElementSnapshot `json:"elements"`
+}
+
+// RebuiltElement is an element that was built at least once between two
+// snapshots.
+type RebuiltElement struct {
+	ElementSnapshot
+	// Rebuilds is the number of builds between the two snapshots.
+	Rebuilds int `json:"rebuilds"`
+}
+
+// TreeDiff describes how the element tree changed between two snapshots.
+type TreeDiff struct {
+	// Duration is the time between the two snapshots.
+	Duration time.Duration `json:"duration"`
+	// Rebuilt lists elements present in both snapshots that were rebuilt,
+	// ordered by rebuild count (most first).
+	Rebuilt []RebuiltElement `json:"rebuilt"`
+	// Mounted lists elements present only in the later snapshot.
+	Mounted []ElementSnapshot `json:"mounted"`
+	// Unmounted lists elements present only in the earlier snapshot.
+	Unmounted []ElementSnapshot `json:"unmounted"`
+	// Unchanged counts elements present in both snapshots that did not rebuild.
+	Unchanged int `json:"unchanged"`
+	// RebuildsByReason totals rebuilds by each element's most recent reason.
+	RebuildsByReason map[RebuildReason]int `json:"rebuildsByReason"`
+	// RebuildsByWidgetType totals rebuilds per widget type, useful for
+	// spotting a widget that rebuilds far more often than expected.
+	RebuildsByWidgetType map[string]int `json:"rebuildsByWidgetType"`
+}
+
+// TotalRebuilds returns the total number of element builds in the diff.
+func (d TreeDiff) TotalRebuilds() int {
+	total := 0
+	for _, r := range d.Rebuilt {
+		total += r.Rebuilds
+	}
+	return total
+}
+
+// CaptureTreeSnapshot walks the element tree rooted at root and records each
+// element's identity, widget type, and build statistics. It must be called
+// while the tree is not being built, e.g. between frames with the frame lock
+// held.
+func CaptureTreeSnapshot(root Element) TreeSnapshot {
+	snapshot := TreeSnapshot{Time: time.Now()}
+	if root != nil {
+		captureElement(root, 0, &snapshot.Elements)
+	}
+	return snapshot
+}
+
+func captureElement(element Element, parentID uint64, out *[]ElementSnapshot) {
+	id := parentID
+	if tracked, ok := element.(rebuildTracked); ok {
+		entry := tracked.rebuildSnapshot()
+		entry.ParentID = parentID
+		if widget := element.Widget(); widget != nil {
+			entry.WidgetType = reflect.TypeOf(widget).String()
+			if key := widget.Key(); key != nil {
+				entry.Key = fmt.Sprintf("%v", key)
+			}
+		}
+		*out = append(*out, entry)
+		id = entry.ID
+	}
+	element.VisitChildren(func(child Element) bool {
+		captureElement(child, id, out)
+		return true
+	})
+}
+
+// DiffTreeSnapshots compares two snapshots of the same tree, taken in order.
+func DiffTreeSnapshots(before, after TreeSnapshot) TreeDiff {
+	diff := TreeDiff{
+		Duration:             after.Time.Sub(before.Time),
+		RebuildsByReason:     make(map[RebuildReason]int),
+		RebuildsByWidgetType: make(map[string]int),
+	}
+
+	previous := make(map[uint64]ElementSnapshot, len(before.Elements))
+	for _, e := range before.Elements {
+		previous[e.ID] = e
+	}
+
+	for _, e := range after.Elements {
+		old, existed := previous[e.ID]
+		if !existed {
+			diff.Mounted = append(diff.Mounted, e)
+			continue
+		}
+		delete(previous, e.ID)
+		rebuilds := e.BuildCount - old.BuildCount
+		if rebuilds <= 0 {
+			diff.Unchanged++
+			continue
+		}
+		diff.Rebuilt = append(diff.Rebuilt, RebuiltElement{ElementSnapshot: e, Rebuilds: rebuilds})
+		diff.RebuildsByReason[e.LastRebuild] += rebuilds
+		diff.RebuildsByWidgetType[e.WidgetType] += rebuilds
+	}
+
+	// Preserve tree order for unmounted elements.
+	for _, e := range before.Elements {
+		if _, gone := previous[e.ID]; gone {
+			diff.Unmounted = append(diff.Unmounted, e)
+		}
+	}
+
+	slices.SortStableFunc(diff.Rebuilt, func(a, b RebuiltElement) int {
+		return cmp.Compare(b.Rebuilds, a.Rebuilds)
+	})
+	return diff
+}
+
+// rebuildTracked is implemented by elements embedding elementBase.
+type rebuildTracked interface {
+	rebuildSnapshot() ElementSnapshot
+}
+
+var nextElementDebugID atomic.Uint64
+
+func (e *elementBase) rebuildSnapshot() ElementSnapshot {
+	if e.debugID == 0 {
+		e.debugID = nextElementDebugID.Add(1)
+	}
+	return ElementSnapshot{
+		ID:          e.debugID,
+		Depth:       e.depth,
+		BuildCount:  e.buildCount,
+		LastRebuild: e.lastRebuild,
+	}
+}
+
+// recordRebuild is called by each element type's rebuild once it has
+// committed to building.
+func (e *elementBase) recordRebuild() {
+	e.buildCount++
+	e.lastRebuild = e.pendingRebuild
+}
@@ -0,0 +1,79 @@
+package core
+
+import "testing"
+
+type otherStatelessWidget struct {
+	StatelessBase
+}
+
+func (otherStatelessWidget) Build(ctx BuildContext) Widget { return nil }
+
+func TestTreeSnapshotDiff_RecordsRebuildReasons(t *testing.T) {
+	owner := NewBuildOwner()
+	swap := false
+	state := &testState{}
+	state.buildFn = func(ctx BuildContext) Widget {
+		if swap {
+			return otherStatelessWidget{}
+		}
+		return testStatelessWidget{}
+	}
+	root := inflateWidget(testStatefulWidget{createStateFn: func() State { return state }}, owner)
+	root.Mount(nil, nil)
+
+	before := CaptureTreeSnapshot(root)
+	if len(before.Elements) != 2 {
+		t.Fatalf("expected 2 elements, got %d", len(before.Elements))
+	}
+	if before.Elements[0].LastRebuild != RebuildReasonMount || before.Elements[0].BuildCount != 1 {
+		t.Errorf("expected root built once on mount, got %+v", before.Elements[0])
+	}
+	if before.Elements[1].ParentID != before.Elements[0].ID {
+		t.Error("expected child to reference root as parent")
+	}
+
+	state.SetState(nil)
+	owner.FlushBuild()
+	state.SetState(nil)
+	owner.FlushBuild()
+
+	diff := DiffTreeSnapshots(before, CaptureTreeSnapshot(root))
+	if len(diff.Rebuilt) != 2 {
+		t.Fatalf("expected 2 rebuilt elements, got %+v", diff.Rebuilt)
+	}
+	if got := diff.Rebuilt[0]; got.Rebuilds != 2 {
+		t.Errorf("expected 2 rebuilds, got %d", got.Rebuilds)
+	}
+	if diff.RebuildsByReason[RebuildReasonSetState] != 2 || diff.RebuildsByReason[RebuildReasonParent] != 2 {
+		t.Errorf("unexpected reasons %v", diff.RebuildsByReason)
+	}
+	if diff.TotalRebuilds() != 4 {
+		t.Errorf("expected 4 total rebuilds, got %d", diff.TotalRebuilds())
+	}
+
+	before = CaptureTreeSnapshot(root)
+	swap = true
+	state.SetState(nil)
+	owner.FlushBuild()
+
+	diff = DiffTreeSnapshots(before, CaptureTreeSnapshot(root))
+	if len(diff.Mounted) != 1 || len(diff.Unmounted) != 1 {
+		t.Fatalf("expected one mounted and one unmounted element, got %+v / %+v", diff.Mounted, diff.Unmounted)
+	}
+	if diff.Mounted[0].WidgetType != "core.otherStatelessWidget" {
+		t.Errorf("unexpected mounted widget type %q", diff.Mounted[0].WidgetType)
+	}
+}
+
+func TestRebuildReason_Dependencies(t *testing.T) {
+	e := &StatelessElement{}
+	e.self = e
+	e.mounted = true
+	e.widget = testStatelessWidget{}
+
+	notifyDependent(e)
+	e.RebuildIfNeeded()
+	if e.lastRebuild != RebuildReasonDependencies {
+		t.Errorf("expected dependencies reason, got %v", e.lastRebuild)
+	}
+}
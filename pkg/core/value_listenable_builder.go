@@ -0,0 +1,92 @@
+package core
+
+// ValueListenableBuilder is a [StatefulWidget] that rebuilds only its own
+// subtree whenever a [ValueListenable] notifies, passing the current value to
+// Builder. It is a lightweight alternative to a full StatefulWidget calling
+// SetState when a widget just displays a value:
+//
+//	count := core.NewValueNotifier(0)
+//
+//	core.ValueListenableBuilder[int]{
+//	    ValueListenable: count,
+//	    Builder: func(ctx core.BuildContext, value int, child core.Widget) core.Widget {
+//	        return widgets.Row{Children: []core.Widget{
+//	            widgets.Text{Content: fmt.Sprint(value)},
+//	            child,
+//	        }}
+//	    },
+//	    Child: expensiveIcon, // built once, reused on every rebuild
+//	}
+//
+// Child is passed through to Builder unchanged, so parts of the subtree that
+// do not depend on the value are not rebuilt when it changes.
+type ValueListenableBuilder[T any] struct {
+	StatefulBase
+	ValueListenable ValueListenable[T]
+	Builder         func(ctx BuildContext, value T, child Widget) Widget
+	Child           Widget
+}
+
+func (ValueListenableBuilder[T]) CreateState() State {
+	return &valueListenableBuilderState[T]{}
+}
+
+type valueListenableBuilderState[T any] struct {
+	StateBase
+	unsub func() // removes listener and unregisters disposer
+}
+
+func (s *valueListenableBuilderState[T]) widget() ValueListenableBuilder[T] {
+	return s.Element().Widget().(ValueListenableBuilder[T])
+}
+
+// subscribe registers a listener on l that triggers a rebuild, as
+// [ListenableBuilder] does.
+func (s *valueListenableBuilderState[T]) subscribe(l ValueListenable[T]) {
+	unsub := l.AddListener(func() {
+		s.SetState(nil)
+	})
+	unregister := s.OnDispose(unsub)
+	s.unsub = func() {
+		unsub()
+		unregister()
+	}
+}
+
+func (s *valueListenableBuilderState[T]) unsubscribe() {
+	if s.unsub != nil {
+		s.unsub()
+		s.unsub = nil
+	}
+}
+
+func (s *valueListenableBuilderState[T]) InitState() {
+	w := s.widget()
+	if w.ValueListenable == nil {
+		panic("ValueListenableBuilder: ValueListenable must not be nil")
+	}
+	if w.Builder == nil {
+		panic("ValueListenableBuilder: Builder must not be nil")
+	}
+	s.subscribe(w.ValueListenable)
+}
+
+func (s *valueListenableBuilderState[T]) DidUpdateWidget(old StatefulWidget) {
+	oldW := old.(ValueListenableBuilder[T])
+	newW := s.widget()
+	if newW.Builder == nil {
+		panic("ValueListenableBuilder: Builder must not be nil")
+	}
+	if oldW.ValueListenable != newW.ValueListenable {
+		s.unsubscribe()
+		if newW.ValueListenable == nil {
+			panic("ValueListenableBuilder: ValueListenable must not be nil")
+		}
+		s.subscribe(newW.ValueListenable)
+	}
+}
+
+func (s *valueListenableBuilderState[T]) Build(ctx BuildContext) Widget {
+	w := s.widget()
+	return w.Builder(ctx, w.ValueListenable.Value(), w.Child)
+}
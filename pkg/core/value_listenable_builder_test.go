@@ -0,0 +1,96 @@
+package core
+
+import "testing"
+
+// newValueListenableBuilderState wires up a valueListenableBuilderState with
+// the given widget, mimicking what StatefulElement.Mount does.
+func newValueListenableBuilderState[T any](w ValueListenableBuilder[T]) (*valueListenableBuilderState[T], *StatefulElement, *BuildOwner) {
+	s := &valueListenableBuilderState[T]{}
+	owner := NewBuildOwner()
+	elem := &StatefulElement{}
+	elem.buildOwner = owner
+	elem.self = elem
+	elem.widget = w
+	s.SetElement(elem)
+	return s, elem, owner
+}
+
+func TestValueNotifier_NotifiesOnChange(t *testing.T) {
+	n := NewValueNotifier(1)
+	calls := 0
+	n.AddListener(func() { calls++ })
+
+	n.Set(1)
+	n.Set(2)
+
+	if calls != 1 {
+		t.Errorf("expected 1 notification, got %d", calls)
+	}
+	if n.Value() != 2 {
+		t.Errorf("expected value 2, got %d", n.Value())
+	}
+}
+
+func TestValueListenableBuilder_BuildsWithValueAndChild(t *testing.T) {
+	n := NewValueNotifier("a")
+	child := sentinelWidget{}
+	var gotValue string
+	var gotChild Widget
+	w := ValueListenableBuilder[string]{
+		ValueListenable: n,
+		Builder: func(ctx BuildContext, value string, c Widget) Widget {
+			gotValue, gotChild = value, c
+			return nil
+		},
+		Child: child,
+	}
+	s, _, owner := newValueListenableBuilderState(w)
+	s.InitState()
+
+	n.Set("b")
+	if count := countDirty(owner); count != 1 {
+		t.Errorf("expected 1 dirty element, got %d", count)
+	}
+	s.Build(nil)
+	if gotValue != "b" || gotChild != child {
+		t.Errorf("expected Builder called with (b, child), got (%q, %v)", gotValue, gotChild)
+	}
+
+	s.Dispose()
+	if n.ListenerCount() != 0 {
+		t.Errorf("expected 0 listeners after dispose, got %d", n.ListenerCount())
+	}
+}
+
+func TestValueListenableBuilder_DidUpdateWidget_Resubscribes(t *testing.T) {
+	old := NewValueNotifier(0)
+	build := func(ctx BuildContext, value int, child Widget) Widget { return nil }
+	w := ValueListenableBuilder[int]{ValueListenable: old, Builder: build}
+	s, elem, _ := newValueListenableBuilderState(w)
+	s.InitState()
+
+	// Derived also satisfies ValueListenable.
+	doubled := NewDerived(func() int { return old.Value() * 2 }, old)
+	defer doubled.Dispose()
+	elem.widget = ValueListenableBuilder[int]{ValueListenable: doubled, Builder: build}
+	s.DidUpdateWidget(w)
+
+	if old.ListenerCount() != 1 {
+		t.Errorf("old listenable: expected only the derived listener, got %d", old.ListenerCount())
+	}
+	if doubled.ListenerCount() != 1 {
+		t.Errorf("new listenable: expected 1 listener, got %d", doubled.ListenerCount())
+	}
+}
+
+func TestValueListenableBuilder_PanicsOnNilValueListenable(t *testing.T) {
+	w := ValueListenableBuilder[int]{Builder: func(BuildContext, int, Widget) Widget { return nil }}
+	s, _, _ := newValueListenableBuilderState(w)
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("expected panic for nil ValueListenable")
+		}
+	}()
+	s.InitState()
+}
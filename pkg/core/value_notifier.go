@@ -0,0 +1,33 @@
+package core
+
+// ValueListenable is a [Listenable] that also exposes its current value.
+// [Signal], [ValueNotifier], and [Derived] all satisfy it, so any of them can
+// drive a [ValueListenableBuilder].
+type ValueListenable[T any] interface {
+	Listenable
+	Value() T
+}
+
+// ValueNotifier is an observable value with listeners. It is the same type as
+// [Signal], under the name used by [ValueListenableBuilder] and familiar from
+// other UI toolkits; the two can be used interchangeably.
+//
+// Example:
+//
+//	count := core.NewValueNotifier(0)
+//	count.Set(count.Value() + 1) // notifies listeners
+//	count.Set(1)                 // unchanged, no notification
+type ValueNotifier[T any] = Signal[T]
+
+// NewValueNotifier creates a [ValueNotifier] with the given initial value.
+// Set skips notification when the new value equals the old via ==. For
+// non-comparable types (slices, maps), use [NewValueNotifierWithEquality].
+func NewValueNotifier[T comparable](initial T) *ValueNotifier[T] {
+	return NewSignal(initial)
+}
+
+// NewValueNotifierWithEquality creates a [ValueNotifier] with a custom
+// equality function, for non-comparable types or semantic comparisons.
+func NewValueNotifierWithEquality[T any](initial T, equalityFunc func(a, b T) bool) *ValueNotifier[T] {
+	return NewSignalWithEquality(initial, equalityFunc)
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/go-drift/drift/pkg/core"
 	"github.com/go-drift/drift/pkg/engine"
+	"github.com/go-drift/drift/pkg/graphics"
 	"github.com/go-drift/drift/pkg/theme"
 )
 
@@ -20,6 +21,17 @@ type App struct {
 	// Diagnostics enables the performance diagnostics HUD overlay.
 	// Use engine.DefaultDiagnosticsConfig() for sensible defaults.
 	Diagnostics *engine.DiagnosticsConfig
+	// PowerSaver configures battery saving behavior. Defaults to
+	// engine.DefaultPowerSaverConfig(), which activates while the OS
+	// battery saver is on. Set Mode to engine.PowerSaverOff to opt out.
+	PowerSaver *engine.PowerSaverConfig
+	// RenderQuality sets image sampling, antialiasing, and dithering
+	// defaults. Defaults to graphics.DefaultRenderQuality() if nil.
+	RenderQuality *graphics.RenderQuality
+	// ColorSpace is the color space the app prefers to render in. Set
+	// graphics.ColorSpaceDisplayP3 to render wide gamut colors on capable
+	// displays; others fall back to sRGB. Defaults to sRGB.
+	ColorSpace graphics.ColorSpace
 	// OnInit is called once in a background goroutine before the root widget
 	// is mounted. Use it for one-time setup such as opening a database,
 	// loading configuration, or restoring authentication state.
@@ -75,6 +87,15 @@ func Run(app App) {
 	if app.Diagnostics != nil {
 		engine.SetDiagnostics(app.Diagnostics)
 	}
+	if app.PowerSaver != nil {
+		engine.SetPowerSaver(app.PowerSaver)
+	}
+	if app.RenderQuality != nil {
+		engine.SetRenderQuality(*app.RenderQuality)
+	}
+	if app.ColorSpace != graphics.ColorSpaceSRGB {
+		engine.SetColorSpace(app.ColorSpace)
+	}
 	if app.Root != nil {
 		// Wrap the root widget with the theme
 		themedRoot := theme.Theme{
@@ -0,0 +1,47 @@
+package engine
+
+import (
+	"sync/atomic"
+
+	"github.com/go-drift/drift/pkg/graphics"
+)
+
+var (
+	preferredColorSpace atomic.Int32 // graphics.ColorSpace
+	surfaceColorSpace   atomic.Int32 // graphics.ColorSpace
+)
+
+// SetColorSpace sets the color space the app prefers to render in. With
+// [graphics.ColorSpaceDisplayP3], frames are rendered in Display P3 on
+// devices whose display supports it, so Display P3 paint colors (see
+// [graphics.Paint.ColorSpace]) render exactly; other devices fall back to
+// sRGB. sRGB colors and images look the same in either space. The default
+// is [graphics.ColorSpaceSRGB]. Safe to call from any goroutine.
+func SetColorSpace(space graphics.ColorSpace) {
+	preferredColorSpace.Store(int32(space))
+	RequestFrame()
+}
+
+// ColorSpace returns the color space the app prefers to render in.
+func ColorSpace() graphics.ColorSpace {
+	return graphics.ColorSpace(preferredColorSpace.Load())
+}
+
+// SurfaceColorSpace returns the color space frames are rendered in: the
+// preferred color space if the display supports it, otherwise sRGB.
+func SurfaceColorSpace() graphics.ColorSpace {
+	return graphics.ColorSpace(surfaceColorSpace.Load())
+}
+
+// ResolveSurfaceColorSpace is called by the platform embedder before each
+// frame with whether the display supports the Display P3 gamut. It returns
+// the color space the native surface must be tagged with, which the engine
+// renders the frame in.
+func ResolveSurfaceColorSpace(wideGamutDisplay bool) graphics.ColorSpace {
+	space := graphics.ColorSpaceSRGB
+	if wideGamutDisplay && ColorSpace() == graphics.ColorSpaceDisplayP3 {
+		space = graphics.ColorSpaceDisplayP3
+	}
+	surfaceColorSpace.Store(int32(space))
+	return space
+}
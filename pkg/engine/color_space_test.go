@@ -0,0 +1,33 @@
+package engine
+
+import (
+	"testing"
+
+	"github.com/go-drift/drift/pkg/graphics"
+)
+
+func TestResolveSurfaceColorSpace(t *testing.T) {
+	t.Cleanup(func() {
+		SetColorSpace(graphics.ColorSpaceSRGB)
+		ResolveSurfaceColorSpace(false)
+	})
+
+	if got := ResolveSurfaceColorSpace(true); got != graphics.ColorSpaceSRGB {
+		t.Errorf("expected sRGB when the app has not opted in, got %v", got)
+	}
+
+	SetColorSpace(graphics.ColorSpaceDisplayP3)
+	if got := ResolveSurfaceColorSpace(false); got != graphics.ColorSpaceSRGB {
+		t.Errorf("expected sRGB fallback on an sRGB display, got %v", got)
+	}
+	if got := SurfaceColorSpace(); got != graphics.ColorSpaceSRGB {
+		t.Errorf("expected surface to use the resolved sRGB space, got %v", got)
+	}
+
+	if got := ResolveSurfaceColorSpace(true); got != graphics.ColorSpaceDisplayP3 {
+		t.Errorf("expected Display P3 on a wide gamut display, got %v", got)
+	}
+	if got := SurfaceColorSpace(); got != graphics.ColorSpaceDisplayP3 {
+		t.Errorf("expected surface to use Display P3, got %v", got)
+	}
+}
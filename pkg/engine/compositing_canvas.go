@@ -13,6 +13,15 @@ type PlatformViewSink interface {
 		clipBounds *graphics.Rect, visibleRect graphics.Rect, occlusionPaths []*graphics.Path) error
 }
 
+// PlatformViewTransformSink is implemented by sinks that need to know when a
+// platform view is painted scaled, rotated or translucent. Native views are
+// positioned by offset and clip only; views that render into an Android
+// SurfaceView, which is composited outside the window, use this to fall back
+// to a TextureView that stays in step with the widget tree.
+type PlatformViewTransformSink interface {
+	SetViewTransformed(viewID int64, transformed bool)
+}
+
 // CompositingCanvas wraps an inner canvas and tracks transform + clip state
 // so that EmbedPlatformView can resolve platform view geometry in global coordinates.
 // Used in tests; production geometry resolution uses GeometryCanvas in StepFrame.
@@ -40,7 +49,7 @@ func (c *CompositingCanvas) Save() {
 }
 
 func (c *CompositingCanvas) SaveLayerAlpha(bounds graphics.Rect, alpha float64) {
-	c.tracker.save()
+	c.tracker.saveLayerAlpha(alpha)
 	c.inner.SaveLayerAlpha(bounds, alpha)
 }
 
@@ -61,14 +70,18 @@ func (c *CompositingCanvas) Translate(dx, dy float64) {
 
 // Scale forwards to inner canvas but is NOT tracked for platform view geometry.
 // Platform views operate in logical coordinates; device scale is applied on the
-// raw Skia canvas before wrapping with CompositingCanvas.
+// raw Skia canvas before wrapping with CompositingCanvas. Views embedded under
+// a scale are reported as transformed.
 func (c *CompositingCanvas) Scale(sx, sy float64) {
+	c.tracker.scale(sx, sy)
 	c.inner.Scale(sx, sy)
 }
 
 // Rotate forwards to inner canvas but is NOT tracked for platform view geometry.
 // Native views cannot be rotated — they are always axis-aligned rectangles.
+// Views embedded under a rotation are reported as transformed.
 func (c *CompositingCanvas) Rotate(radians float64) {
+	c.tracker.rotate(radians)
 	c.inner.Rotate(radians)
 }
 
@@ -124,6 +137,10 @@ func (c *CompositingCanvas) DrawPath(path *graphics.Path, paint graphics.Paint)
 	c.inner.DrawPath(path, paint)
 }
 
+func (c *CompositingCanvas) DrawVertices(vertices *graphics.Vertices, img image.Image, paint graphics.Paint) {
+	c.inner.DrawVertices(vertices, img, paint)
+}
+
 func (c *CompositingCanvas) DrawRectShadow(rect graphics.Rect, shadow graphics.BoxShadow) {
 	c.inner.DrawRectShadow(rect, shadow)
 }
@@ -149,6 +166,10 @@ func (c *CompositingCanvas) DrawLottie(animPtr unsafe.Pointer, bounds graphics.R
 	c.inner.DrawLottie(animPtr, bounds, t)
 }
 
+func (c *CompositingCanvas) DrawRive(rivePtr unsafe.Pointer, bounds graphics.Rect) {
+	c.inner.DrawRive(rivePtr, bounds)
+}
+
 func (c *CompositingCanvas) EmbedPlatformView(viewID int64, size graphics.Size) {
 	c.tracker.embedPlatformView(c.sink, viewID, size)
 }
@@ -57,6 +57,8 @@ func (c *nullCanvas) DrawCircle(center graphics.Offset, radius float64, paint gr
 func (c *nullCanvas) DrawLine(start, end graphics.Offset, paint graphics.Paint)               {}
 func (c *nullCanvas) DrawText(layout *graphics.TextLayout, position graphics.Offset)          {}
 func (c *nullCanvas) DrawImage(img image.Image, position graphics.Offset)                     {}
+func (c *nullCanvas) DrawVertices(vertices *graphics.Vertices, img image.Image, paint graphics.Paint) {
+}
 func (c *nullCanvas) DrawImageRect(img image.Image, srcRect, dstRect graphics.Rect, quality graphics.FilterQuality, cacheKey uintptr) {
 }
 func (c *nullCanvas) DrawPath(path *graphics.Path, paint graphics.Paint)              {}
@@ -67,6 +69,7 @@ func (c *nullCanvas) DrawSVG(svgPtr unsafe.Pointer, bounds graphics.Rect)
 func (c *nullCanvas) DrawSVGTinted(svgPtr unsafe.Pointer, bounds graphics.Rect, tintColor graphics.Color) {
 }
 func (c *nullCanvas) DrawLottie(animPtr unsafe.Pointer, bounds graphics.Rect, t float64) {}
+func (c *nullCanvas) DrawRive(rivePtr unsafe.Pointer, bounds graphics.Rect)              {}
 func (c *nullCanvas) EmbedPlatformView(viewID int64, size graphics.Size)                 {}
 func (c *nullCanvas) Size() graphics.Size                                                { return c.size }
 
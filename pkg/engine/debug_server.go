@@ -14,6 +14,7 @@ import (
 
 	"github.com/go-drift/drift/pkg/core"
 	"github.com/go-drift/drift/pkg/layout"
+	"github.com/go-drift/drift/pkg/platform"
 )
 
 // debugServer manages the HTTP server for render tree inspection.
@@ -115,6 +116,10 @@ func startDebugServer(port int) (int, error) {
 	mux.HandleFunc("/frames", handleFrameTimeline)
 	mux.HandleFunc("/runtime", handleRuntime)
 	mux.HandleFunc("/jank", handleJankSnapshot)
+	mux.HandleFunc("/startup", handleStartup)
+	mux.HandleFunc("/rebuilds", handleRebuilds)
+	mux.HandleFunc("/channels", handleChannels)
+	mux.HandleFunc("/profile", handleProfile)
 	mux.HandleFunc("/health", handleHealth)
 	mux.HandleFunc("/debug", handleDebug)
 
@@ -463,6 +468,185 @@ func handleJankSnapshot(w http.ResponseWriter, r *http.Request) {
 	w.Write(data)
 }
 
+// handleStartup returns the recorded startup milestones as JSON, including
+// millisecond offsets from process start for each milestone reached.
+func handleStartup(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	timings := StartupTiming()
+	resp := struct {
+		StartupTimings
+		EngineInitMs  int64 `json:"engineInitMs,omitempty"`
+		FirstBuildMs  int64 `json:"firstBuildMs,omitempty"`
+		FirstLayoutMs int64 `json:"firstLayoutMs,omitempty"`
+		FirstFrameMs  int64 `json:"firstFrameMs,omitempty"`
+	}{
+		StartupTimings: timings,
+		EngineInitMs:   timings.Since(timings.EngineInit).Milliseconds(),
+		FirstBuildMs:   timings.Since(timings.FirstBuild).Milliseconds(),
+		FirstLayoutMs:  timings.Since(timings.FirstLayout).Milliseconds(),
+		FirstFrameMs:   timings.Since(timings.FirstFrame).Milliseconds(),
+	}
+
+	data, err := json.MarshalIndent(resp, "", "  ")
+	if err != nil {
+		http.Error(w, fmt.Sprintf("json encode error: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(data)
+}
+
+const (
+	defaultRebuildWindow = time.Second
+	maxRebuildWindow     = 30 * time.Second
+	defaultRebuildLimit  = 100
+)
+
+// handleRebuilds snapshots the widget tree, waits for the requested window
+// (?duration=2s, default 1s), snapshots again, and returns which elements
+// rebuilt and why. Lists are capped at ?limit= entries (default 100).
+//
+// Locking contract: frameLock is held only while each snapshot is captured,
+// so frames keep rendering during the window.
+func handleRebuilds(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	window := defaultRebuildWindow
+	if value := r.URL.Query().Get("duration"); value != "" {
+		parsed, err := time.ParseDuration(value)
+		if err != nil || parsed <= 0 {
+			http.Error(w, "invalid duration", http.StatusBadRequest)
+			return
+		}
+		window = min(parsed, maxRebuildWindow)
+	}
+	limit := defaultRebuildLimit
+	if value := r.URL.Query().Get("limit"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed <= 0 {
+			http.Error(w, "invalid limit", http.StatusBadRequest)
+			return
+		}
+		limit = parsed
+	}
+
+	defer func() {
+		if rec := recover(); rec != nil {
+			http.Error(w, fmt.Sprintf("panic: %v", rec), http.StatusInternalServerError)
+		}
+	}()
+
+	before, ok := captureTreeSnapshot()
+	if !ok {
+		http.Error(w, "no widget tree", http.StatusServiceUnavailable)
+		return
+	}
+	select {
+	case <-time.After(window):
+	case <-r.Context().Done():
+		return
+	}
+	after, ok := captureTreeSnapshot()
+	if !ok {
+		http.Error(w, "no widget tree", http.StatusServiceUnavailable)
+		return
+	}
+
+	diff := core.DiffTreeSnapshots(before, after)
+	resp := struct {
+		core.TreeDiff
+		DurationMs    int64 `json:"durationMs"`
+		TotalRebuilds int   `json:"totalRebuilds"`
+		Truncated     bool  `json:"truncated,omitempty"`
+	}{
+		DurationMs:    diff.Duration.Milliseconds(),
+		TotalRebuilds: diff.TotalRebuilds(),
+		Truncated:     len(diff.Rebuilt) > limit || len(diff.Mounted) > limit || len(diff.Unmounted) > limit,
+	}
+	diff.Rebuilt = diff.Rebuilt[:min(len(diff.Rebuilt), limit)]
+	diff.Mounted = diff.Mounted[:min(len(diff.Mounted), limit)]
+	diff.Unmounted = diff.Unmounted[:min(len(diff.Unmounted), limit)]
+	resp.TreeDiff = diff
+
+	data, err := json.MarshalIndent(resp, "", "  ")
+	if err != nil {
+		http.Error(w, fmt.Sprintf("json encode error: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(data)
+}
+
+const channelRecordsDefault = 500
+
+// handleChannels returns recorded platform channel traffic as JSON, oldest
+// first. Records can be narrowed with ?channel= (name or prefix), ?method=,
+// ?kind=, ?min_ms= (latency) and ?limit= (most recent N). DELETE clears
+// the recording.
+func handleChannels(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodDelete {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	recorder := platform.Default().Recorder()
+	if recorder == nil {
+		http.Error(w, "channel recording disabled", http.StatusServiceUnavailable)
+		return
+	}
+	if r.Method == http.MethodDelete {
+		recorder.Clear()
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	query := r.URL.Query()
+	filter := platform.ChannelRecordFilter{
+		Channel:    query.Get("channel"),
+		Method:     query.Get("method"),
+		Kind:       platform.ChannelRecordKind(query.Get("kind")),
+		MinLatency: time.Duration(parseFloatQuery(r, "min_ms") * float64(time.Millisecond)),
+	}
+	records := recorder.Records(filter)
+	if limit, err := strconv.Atoi(query.Get("limit")); err == nil && limit > 0 && len(records) > limit {
+		records = records[len(records)-limit:]
+	}
+
+	resp := struct {
+		Records []platform.ChannelRecord `json:"records"`
+	}{
+		Records: records,
+	}
+
+	data, err := json.MarshalIndent(resp, "", "  ")
+	if err != nil {
+		http.Error(w, fmt.Sprintf("json encode error: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(data)
+}
+
+// captureTreeSnapshot snapshots the current element tree under frameLock.
+func captureTreeSnapshot() (core.TreeSnapshot, bool) {
+	frameLock.Lock()
+	defer frameLock.Unlock()
+	if app.root == nil {
+		return core.TreeSnapshot{}, false
+	}
+	return core.CaptureTreeSnapshot(app.root), true
+}
+
 func parseFloatQuery(r *http.Request, key string) float64 {
 	value := r.URL.Query().Get(key)
 	if value == "" {
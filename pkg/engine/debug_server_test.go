@@ -7,6 +7,8 @@ import (
 	"net/http"
 	"testing"
 	"time"
+
+	"github.com/go-drift/drift/pkg/platform"
 )
 
 // waitForServer polls the health endpoint until ready or timeout.
@@ -298,3 +300,52 @@ func TestSetDiagnostics_SamePortNoRestart(t *testing.T) {
 		t.Error("server was restarted when port didn't change")
 	}
 }
+
+func TestDebugServer_ChannelsEndpoint(t *testing.T) {
+	port, err := startDebugServer(0)
+	if err != nil {
+		t.Fatalf("failed to start debug server: %v", err)
+	}
+	defer stopDebugServer()
+	if err := waitForServer(port, 2*time.Second); err != nil {
+		t.Fatalf("server not ready: %v", err)
+	}
+	url := fmt.Sprintf("http://localhost:%d/channels", port)
+
+	resp, err := http.Get(url)
+	if err != nil {
+		t.Fatalf("failed to reach channels endpoint: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("expected status 503 without recording, got %d", resp.StatusCode)
+	}
+
+	SetDiagnostics(&DiagnosticsConfig{RecordChannels: true})
+	defer SetDiagnostics(nil)
+	for _, name := range []string{"test/debug/a", "test/debug/b"} {
+		data, _ := platform.DefaultCodec.Encode(map[string]any{"n": 1})
+		platform.Default().NewEventChannel(name)
+		platform.HandleEvent(name, data)
+	}
+
+	resp, err = http.Get(url + "?channel=test/debug/b")
+	if err != nil {
+		t.Fatalf("failed to reach channels endpoint: %v", err)
+	}
+	defer resp.Body.Close()
+	var body struct {
+		Records []platform.ChannelRecord `json:"records"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		t.Fatalf("failed to decode channels response: %v", err)
+	}
+	if len(body.Records) != 1 || body.Records[0].Channel != "test/debug/b" || body.Records[0].Kind != platform.ChannelRecordEvent {
+		t.Errorf("expected the filtered event, got %+v", body.Records)
+	}
+
+	SetDiagnostics(nil)
+	if platform.Default().Recorder() != nil {
+		t.Error("expected recording to stop with diagnostics")
+	}
+}
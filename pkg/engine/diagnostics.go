@@ -47,6 +47,31 @@ type DiagnosticsConfig struct {
 	// RuntimeSampleWindow controls how much runtime history is kept.
 	// Defaults to 60s if zero.
 	RuntimeSampleWindow time.Duration
+	// RecordChannels records platform channel traffic (method calls,
+	// events, latency) for the debug server's /channels endpoint.
+	// Arguments and results are kept in memory, so leave it off in release
+	// builds.
+	RecordChannels bool
+	// CheckChannelThreads reports platform channel calls that block the UI
+	// thread for longer than ChannelBlockThreshold, and calls back to native
+	// code from native method handlers, which can deadlock. See
+	// platform.ThreadChecks.
+	CheckChannelThreads bool
+	// StrictChannelThreads also reports every synchronous channel call made
+	// on the UI thread, once per method. Requires CheckChannelThreads.
+	StrictChannelThreads bool
+	// ChannelBlockThreshold is how long a channel call may block the UI
+	// thread before CheckChannelThreads reports it. Defaults to 100ms if zero.
+	ChannelBlockThreshold time.Duration
+	// ProfileWidgets times the build, layout, and paint of every widget and
+	// reports the most expensive ones at the debug server's /profile
+	// endpoint. Timing every widget slows frames down, so use it to find
+	// which widgets are slow rather than to measure frame times.
+	ProfileWidgets bool
+	// ShowProfileHeatMap highlights the most expensive widgets of recent
+	// frames on screen, from green to red, labeled with their average cost
+	// per frame. Requires ProfileWidgets.
+	ShowProfileHeatMap bool
 }
 
 // DefaultDiagnosticsConfig returns a DiagnosticsConfig with sensible defaults.
@@ -11,6 +11,7 @@ import (
 	"github.com/go-drift/drift/pkg/animation"
 	"github.com/go-drift/drift/pkg/core"
 	"github.com/go-drift/drift/pkg/errors"
+	"github.com/go-drift/drift/pkg/focus"
 	"github.com/go-drift/drift/pkg/gestures"
 	"github.com/go-drift/drift/pkg/graphics"
 	"github.com/go-drift/drift/pkg/layout"
@@ -135,6 +136,18 @@ func (a *appRunner) needsFrameLocked() bool {
 	if a.root == nil {
 		return a.lifecycle.phase != initPhaseRunning
 	}
+	// Need frame if build/layout/paint is needed. Ticks rebuild within the
+	// frame that runs them, so work left between frames comes from input,
+	// timers or other state changes and is never throttled.
+	if a.buildOwner != nil && a.buildOwner.NeedsWork() {
+		return true
+	}
+	// Cap animation-driven frames while the power saver is active.
+	// Checked before pendingFrameRequest because animation listeners
+	// request a frame on every tick.
+	if (animation.HasActiveTickers() || widgets.HasActiveBallistics()) && a.throttleAnimationFrameLocked() {
+		return false
+	}
 	// Need frame if explicitly requested
 	if a.pendingFrameRequest.Load() {
 		return true
@@ -144,14 +157,7 @@ func (a *appRunner) needsFrameLocked() bool {
 		return true
 	}
 	// Need frame if ballistics are active
-	if widgets.HasActiveBallistics() {
-		return true
-	}
-	// Need frame if build/layout/paint is needed
-	if a.buildOwner != nil && a.buildOwner.NeedsWork() {
-		return true
-	}
-	return false
+	return widgets.HasActiveBallistics()
 }
 
 // Dispatch schedules a callback to run on the UI thread
@@ -247,6 +253,33 @@ func SetDiagnostics(config *DiagnosticsConfig) {
 		} else {
 			app.runtimeSamples = nil
 		}
+
+		if config.CheckChannelThreads {
+			platform.SetThreadChecks(&platform.ThreadChecks{
+				Strict:         config.StrictChannelThreads,
+				BlockThreshold: config.ChannelBlockThreshold,
+			})
+			app.threadChecks = true
+		} else {
+			stopThreadChecks()
+		}
+
+		if config.ProfileWidgets {
+			if app.widgetProfiler == nil {
+				app.widgetProfiler = newWidgetProfiler(profileFramesDefault)
+				layout.SetProfiler(app.widgetProfiler)
+			}
+			app.showProfileHeatMap = config.ShowProfileHeatMap
+		} else {
+			stopWidgetProfiling()
+		}
+
+		if config.RecordChannels && app.channelRecorder == nil {
+			app.channelRecorder = platform.NewChannelRecorder(channelRecordsDefault)
+			platform.Default().SetRecorder(app.channelRecorder)
+		} else if !config.RecordChannels {
+			stopChannelRecording()
+		}
 	} else {
 		// Clear state when diagnostics disabled
 		app.showLayoutBounds = false
@@ -254,6 +287,9 @@ func SetDiagnostics(config *DiagnosticsConfig) {
 		app.frameTraceEnabled = false
 		app.frameTrace = nil
 		app.runtimeSamples = nil
+		stopChannelRecording()
+		stopThreadChecks()
+		stopWidgetProfiling()
 	}
 	if app.root != nil {
 		app.root.MarkNeedsBuild()
@@ -272,6 +308,27 @@ func SetDiagnostics(config *DiagnosticsConfig) {
 	}
 }
 
+// stopThreadChecks disables the platform thread checks enabled for
+// CheckChannelThreads. Caller must hold frameLock.
+func stopThreadChecks() {
+	if app.threadChecks {
+		platform.SetThreadChecks(nil)
+		app.threadChecks = false
+	}
+}
+
+// stopChannelRecording detaches the recorder installed for RecordChannels,
+// leaving any recorder the app attached itself. Caller must hold frameLock.
+func stopChannelRecording() {
+	if app.channelRecorder == nil {
+		return
+	}
+	if platform.Default().Recorder() == app.channelRecorder {
+		platform.Default().SetRecorder(nil)
+	}
+	app.channelRecorder = nil
+}
+
 // diagnosticsDataSource implements widgets.DiagnosticsHUDDataSource
 type diagnosticsDataSource struct {
 	runner *appRunner
@@ -330,6 +387,7 @@ type appRunner struct {
 	root                core.Element
 	rootRender          layout.RenderObject
 	deviceScale         float64
+	logicalSize         graphics.Size
 	userApp             core.Widget
 	pointerHandlers     map[int64][]layout.PointerHandler
 	pointerPositions    map[int64]graphics.Offset
@@ -358,12 +416,24 @@ type appRunner struct {
 	frameTraceEnabled     bool
 	lastLifecycleState    platform.LifecycleState
 	runtimeSamples        *RuntimeSampleBuffer
+	channelRecorder       *platform.ChannelRecorder // installed for RecordChannels
+	threadChecks          bool                      // platform thread checks enabled for CheckChannelThreads
+	widgetProfiler        *widgetProfiler           // installed for ProfileWidgets
+	showProfileHeatMap    bool
+	profileHeat           []profileHeatSpot
 	treeCountFrame        int
 	cachedRenderNodeCount int
 	cachedWidgetNodeCount int
 
 	// App init/dispose lifecycle
 	lifecycle appInit
+
+	// Startup milestones (process start through first rasterized frame)
+	startup *startupTracker
+
+	// First-frame handoff from the native launch screen
+	firstFrameDeferrals  atomic.Int32
+	firstFrameRasterized atomic.Bool
 }
 
 func init() {
@@ -375,6 +445,14 @@ func init() {
 	widgets.RegisterRestartAppFn(RestartApp)
 	// Wire up frame scheduling so SetState triggers a render under on-demand scheduling
 	app.buildOwner.OnNeedsFrame = RequestFrame
+	// Lay out text again when a font family it named is registered at runtime
+	graphics.AddFontListener(func(family string) {
+		Dispatch(func() { app.fontRegistered(family) })
+	})
+	// Route hardware keys to the focused node
+	platform.HardwareKeyboard.AddHandler(func(event focus.KeyEvent) {
+		Dispatch(func() { focus.GetFocusManager().HandleKeyEvent(event) })
+	})
 	// Run OnDispose when the platform detaches
 	platform.Lifecycle.AddHandler(func(state platform.LifecycleState) {
 		if state == platform.LifecycleStateDetached {
@@ -391,6 +469,7 @@ func newAppRunner() *appRunner {
 		deviceScale:      1,
 		pointerHandlers:  make(map[int64][]layout.PointerHandler),
 		pointerPositions: make(map[int64]graphics.Offset),
+		startup:          newStartupTracker(processStart),
 	}
 }
 
@@ -418,6 +497,25 @@ func (a *appRunner) setUserApp(root core.Widget) {
 	}
 }
 
+// fontRegistered tells the render tree that fonts of family were registered.
+// Called on the UI thread.
+func (a *appRunner) fontRegistered(family string) {
+	if a.rootRender != nil {
+		notifyFontRegistered(a.rootRender, family)
+	}
+}
+
+func notifyFontRegistered(node layout.RenderObject, family string) {
+	if handler, ok := node.(layout.FontChangeHandler); ok {
+		handler.FontRegistered(family)
+	}
+	if visitor, ok := node.(layout.ChildVisitor); ok {
+		visitor.VisitChildren(func(child layout.RenderObject) {
+			notifyFontRegistered(child, family)
+		})
+	}
+}
+
 // SetOnInit registers a callback that runs in a background goroutine before
 // the root widget is mounted. While it executes, the platform's native splash
 // screen remains visible. A nil return mounts the root widget on the next
@@ -538,6 +636,13 @@ func (a *appRunner) runPipeline(size graphics.Size, traceSample *FrameSample) bo
 		Width:  size.Width / scale,
 		Height: size.Height / scale,
 	}
+	if logicalSize != a.logicalSize {
+		// Rebuild so MediaQuery reports the new size (e.g. after rotation)
+		a.logicalSize = logicalSize
+		if a.root != nil {
+			a.root.MarkNeedsBuild()
+		}
+	}
 
 	// Dispatch
 	var phaseStart time.Time
@@ -600,6 +705,7 @@ func (a *appRunner) runPipeline(size graphics.Size, traceSample *FrameSample) bo
 	if a.rootRender == nil {
 		return false
 	}
+	a.startup.markFirstBuild()
 
 	pipeline := a.buildOwner.Pipeline()
 
@@ -630,6 +736,7 @@ func (a *appRunner) runPipeline(size graphics.Size, traceSample *FrameSample) bo
 	if tracing {
 		traceSample.Phases.LayoutMs = durationToMillis(time.Since(phaseStart))
 	}
+	a.startup.markFirstLayout()
 
 	// Semantics
 	if tracing {
@@ -657,6 +764,15 @@ func (a *appRunner) runPipeline(size graphics.Size, traceSample *FrameSample) bo
 		traceSample.Counts.DirtyPaintBoundaries = len(dirtyBoundaries)
 	}
 
+	if a.widgetProfiler != nil {
+		a.widgetProfiler.endFrame()
+		if a.showProfileHeatMap {
+			a.profileHeat = a.widgetProfiler.heatSpots(a.root)
+		} else {
+			a.profileHeat = nil
+		}
+	}
+
 	return true
 }
 
@@ -687,6 +803,8 @@ func (a *appRunner) recoverFromFramePanic() func() {
 }
 
 func (a *appRunner) HandlePointer(event PointerEvent) {
+	defer platform.EnterUIThread()()
+
 	// In debug mode, recover panics and show error screen
 	// In prod mode, let panics crash the app (unless user adds ErrorBoundary)
 	if core.DebugMode {
@@ -845,10 +963,12 @@ type engineApp struct {
 
 func (e engineApp) Build(ctx core.BuildContext) core.Widget {
 	scale := 1.0
+	var size graphics.Size
 	var child core.Widget
 	var diagnosticsConfig *DiagnosticsConfig
 	if e.runner != nil {
 		scale = e.runner.deviceScale
+		size = e.runner.logicalSize
 		diagnosticsConfig = e.runner.diagnosticsConfig
 
 		// If we have a captured error (debug mode only), show error screen
@@ -901,7 +1021,13 @@ func (e engineApp) Build(ctx core.BuildContext) core.Widget {
 	return widgets.DeviceScale{
 		Scale: scale,
 		Child: widgets.SafeAreaProvider{
-			Child: child,
+			Child: widgets.MediaQueryProvider{
+				Size:             size,
+				DevicePixelRatio: scale,
+				Child: widgets.DirectionalityProvider{
+					Child: child,
+				},
+			},
 		},
 	}
 }
@@ -988,7 +1114,11 @@ func recordLayerContent(boundary layout.RenderObject, showLayoutBounds bool, str
 		DebugStrokeWidth: strokeWidth,
 		RecordingLayer:   layer,
 	}
-	boundary.Paint(ctx)
+	if layout.ProfilingEnabled() {
+		paintProfiled(boundary, ctx)
+	} else {
+		boundary.Paint(ctx)
+	}
 
 	layer.SetContent(recorder.EndRecording())
 	layer.Size = size
@@ -1068,6 +1198,7 @@ func compositeLayerTree(canvas graphics.Canvas, root layout.RenderObject) {
 func (a *appRunner) StepFrame(size graphics.Size) (*FrameSnapshot, error) {
 	frameLock.Lock()
 	defer frameLock.Unlock()
+	defer platform.EnterUIThread()()
 	// A frame callback is now running, so allow scheduling of a future callback.
 	platformFrameScheduled.Store(false)
 
@@ -1167,7 +1298,11 @@ func (a *appRunner) RenderFrame(canvas graphics.Canvas) error {
 
 	// Geometry was already captured in StepFrame; composite directly.
 	compositeLayerTree(canvas, a.rootRender)
+	if len(a.profileHeat) > 0 {
+		drawProfileHeat(canvas, a.profileHeat, 1.0/scale)
+	}
 
 	canvas.Restore()
+	a.markFirstFrameIfAllowed()
 	return nil
 }
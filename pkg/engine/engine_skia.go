@@ -54,6 +54,7 @@ func InitSkiaMetal(device, queue unsafe.Pointer) error {
 	if err := ctx.WarmupShaders("metal"); err != nil {
 		log.Printf("skia: shader warmup failed: %v", err)
 	}
+	app.startup.markEngineInit()
 
 	return nil
 }
@@ -83,6 +84,7 @@ func InitSkiaVulkan(instance, physDevice, device, queue uintptr, queueFamilyInde
 	if err := ctx.WarmupShaders("vulkan"); err != nil {
 		log.Printf("skia: shader warmup failed: %v", err)
 	}
+	app.startup.markEngineInit()
 
 	return nil
 }
@@ -98,7 +100,7 @@ func RenderSkiaVulkanSync(width, height int, vkImage uintptr, vkFormat uint32) e
 	if err != nil {
 		return skiaState.setError(err)
 	}
-	surface, err := ctx.MakeVulkanSurface(width, height, vkImage, vkFormat)
+	surface, err := ctx.MakeVulkanSurface(width, height, vkImage, vkFormat, int32(SurfaceColorSpace()))
 	if err != nil {
 		return skiaState.setError(err)
 	}
@@ -149,7 +151,7 @@ func RenderSkiaMetalSync(width, height int, texture unsafe.Pointer) error {
 	if err != nil {
 		return skiaState.setError(err)
 	}
-	surface, err := ctx.MakeMetalSurface(texture, width, height)
+	surface, err := ctx.MakeMetalSurface(texture, width, height, int32(SurfaceColorSpace()))
 	if err != nil {
 		return skiaState.setError(err)
 	}
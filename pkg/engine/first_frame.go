@@ -0,0 +1,42 @@
+package engine
+
+// DeferFirstFrame keeps the native launch screen visible until a matching
+// [AllowFirstFrame] call. Use it when the first meaningful frame depends on
+// work that finishes after the root widget mounts, such as loading cached
+// data or fonts, so users never see a partially built screen.
+//
+// Calls nest: each DeferFirstFrame must be balanced by one AllowFirstFrame.
+// Deferring has no effect once the first frame has been rasterized.
+// Safe to call from any goroutine.
+func DeferFirstFrame() {
+	app.firstFrameDeferrals.Add(1)
+}
+
+// AllowFirstFrame releases one deferral taken by [DeferFirstFrame]. When the
+// last deferral is released, the engine schedules a frame and the embedder
+// removes its launch screen once that frame is on screen.
+// Safe to call from any goroutine.
+func AllowFirstFrame() {
+	if app.firstFrameDeferrals.Add(-1) < 0 {
+		app.firstFrameDeferrals.Store(0)
+	}
+	RequestFrame()
+}
+
+// FirstFrameRasterized reports whether the engine has drawn a frame containing
+// the app with no outstanding [DeferFirstFrame] calls. Native embedders keep
+// the launch screen on top until this returns true, then remove it in the same
+// vsync as the frame so there is no blank gap during the handoff.
+func FirstFrameRasterized() bool {
+	return app.firstFrameRasterized.Load()
+}
+
+// markFirstFrameIfAllowed is called after the app's layer tree has been
+// composited. It records the first frame only when no deferral is active.
+func (a *appRunner) markFirstFrameIfAllowed() {
+	if a.firstFrameRasterized.Load() || a.firstFrameDeferrals.Load() > 0 {
+		return
+	}
+	a.firstFrameRasterized.Store(true)
+	a.startup.markFirstFrame()
+}
@@ -26,11 +26,12 @@ type GeometryCanvas struct {
 
 // pendingViewGeometry holds buffered platform view geometry awaiting occlusion.
 type pendingViewGeometry struct {
-	viewID     int64
-	offset     graphics.Offset
-	size       graphics.Size
-	parentClip *graphics.Rect // parent clip region at embed time
-	seqIndex   int
+	viewID      int64
+	offset      graphics.Offset
+	size        graphics.Size
+	parentClip  *graphics.Rect // parent clip region at embed time
+	seqIndex    int
+	transformed bool // painted scaled, rotated or translucent
 }
 
 // occlusionRegion is a path in global coordinates that occludes platform
@@ -49,8 +50,10 @@ func NewGeometryCanvas(size graphics.Size, sink PlatformViewSink) *GeometryCanva
 	}
 }
 
-func (c *GeometryCanvas) Save()                                        { c.tracker.save() }
-func (c *GeometryCanvas) SaveLayerAlpha(_ graphics.Rect, _ float64)    { c.tracker.save() }
+func (c *GeometryCanvas) Save() { c.tracker.save() }
+func (c *GeometryCanvas) SaveLayerAlpha(_ graphics.Rect, alpha float64) {
+	c.tracker.saveLayerAlpha(alpha)
+}
 func (c *GeometryCanvas) SaveLayer(_ graphics.Rect, _ *graphics.Paint) { c.tracker.save() }
 func (c *GeometryCanvas) Restore()                                     { c.tracker.restore() }
 func (c *GeometryCanvas) Translate(dx, dy float64)                     { c.tracker.translate(dx, dy) }
@@ -58,10 +61,11 @@ func (c *GeometryCanvas) ClipRect(rect graphics.Rect)                  { c.track
 func (c *GeometryCanvas) ClipRRect(rrect graphics.RRect)               { c.tracker.clipRRect(rrect) }
 func (c *GeometryCanvas) SaveLayerBlur(_ graphics.Rect, _, _ float64)  { c.tracker.save() }
 
-// Scale is a no-op. Platform view geometry is reported in logical coordinates;
-// the consumer (e.g. Android UI thread) applies device density scaling.
-func (c *GeometryCanvas) Scale(_, _ float64) {}
-func (c *GeometryCanvas) Rotate(_ float64)   {}
+// Scale does not affect geometry. Platform view geometry is reported in logical
+// coordinates; the consumer (e.g. Android UI thread) applies device density
+// scaling. Views embedded under a scale or rotation are reported as transformed.
+func (c *GeometryCanvas) Scale(sx, sy float64)   { c.tracker.scale(sx, sy) }
+func (c *GeometryCanvas) Rotate(radians float64) { c.tracker.rotate(radians) }
 
 func (c *GeometryCanvas) ClipPath(_ *graphics.Path, _ graphics.ClipOp, _ bool) {}
 
@@ -74,12 +78,14 @@ func (c *GeometryCanvas) DrawText(_ *graphics.TextLayout, _ graphics.Offset)
 func (c *GeometryCanvas) DrawImage(_ image.Image, _ graphics.Offset)                {}
 func (c *GeometryCanvas) DrawImageRect(_ image.Image, _, _ graphics.Rect, _ graphics.FilterQuality, _ uintptr) {
 }
-func (c *GeometryCanvas) DrawPath(_ *graphics.Path, _ graphics.Paint)                       {}
-func (c *GeometryCanvas) DrawRectShadow(_ graphics.Rect, _ graphics.BoxShadow)              {}
-func (c *GeometryCanvas) DrawRRectShadow(_ graphics.RRect, _ graphics.BoxShadow)            {}
-func (c *GeometryCanvas) DrawSVG(_ unsafe.Pointer, _ graphics.Rect)                         {}
-func (c *GeometryCanvas) DrawSVGTinted(_ unsafe.Pointer, _ graphics.Rect, _ graphics.Color) {}
-func (c *GeometryCanvas) DrawLottie(_ unsafe.Pointer, _ graphics.Rect, _ float64)           {}
+func (c *GeometryCanvas) DrawPath(_ *graphics.Path, _ graphics.Paint)                        {}
+func (c *GeometryCanvas) DrawVertices(_ *graphics.Vertices, _ image.Image, _ graphics.Paint) {}
+func (c *GeometryCanvas) DrawRectShadow(_ graphics.Rect, _ graphics.BoxShadow)               {}
+func (c *GeometryCanvas) DrawRRectShadow(_ graphics.RRect, _ graphics.BoxShadow)             {}
+func (c *GeometryCanvas) DrawSVG(_ unsafe.Pointer, _ graphics.Rect)                          {}
+func (c *GeometryCanvas) DrawSVGTinted(_ unsafe.Pointer, _ graphics.Rect, _ graphics.Color)  {}
+func (c *GeometryCanvas) DrawLottie(_ unsafe.Pointer, _ graphics.Rect, _ float64)            {}
+func (c *GeometryCanvas) DrawRive(_ unsafe.Pointer, _ graphics.Rect)                         {}
 
 // EmbedPlatformView resolves transform+clip and buffers the view geometry with
 // a z-order sequence index for later occlusion processing.
@@ -87,11 +93,12 @@ func (c *GeometryCanvas) EmbedPlatformView(viewID int64, size graphics.Size) {
 	offset := c.tracker.transform
 	parentClip := c.tracker.currentClip()
 	c.views = append(c.views, pendingViewGeometry{
-		viewID:     viewID,
-		offset:     offset,
-		size:       size,
-		parentClip: parentClip,
-		seqIndex:   c.seqCounter,
+		viewID:      viewID,
+		offset:      offset,
+		size:        size,
+		parentClip:  parentClip,
+		seqIndex:    c.seqCounter,
+		transformed: c.tracker.transformed,
 	})
 	c.seqCounter++
 }
@@ -115,6 +122,12 @@ func (c *GeometryCanvas) FlushToSink() {
 		return
 	}
 
+	if ts, ok := c.sink.(PlatformViewTransformSink); ok {
+		for _, v := range c.views {
+			ts.SetViewTransformed(v.viewID, v.transformed)
+		}
+	}
+
 	// Fast path: no occlusions recorded this frame.
 	if len(c.occlusions) == 0 {
 		for _, v := range c.views {
@@ -196,10 +209,12 @@ func (c *GeometryCanvas) Size() graphics.Size {
 }
 
 // mergeOverlappingPaths merges occlusion paths whose bounding rects overlap
-// into single rectangular paths. This prevents even-odd fill issues on iOS
-// where multiple overlapping subpaths in a CAShapeLayer mask cancel each
-// other out. Non-overlapping paths are preserved as-is, keeping precise
-// shapes (e.g. rounded rects for buttons).
+// into single paths. This prevents even-odd fill issues on iOS where
+// multiple overlapping subpaths in a CAShapeLayer mask cancel each other
+// out. Overlapping paths are combined with a path union, which keeps their
+// precise outline; where path ops are unavailable the merged path falls back
+// to the union of the bounding rects. Non-overlapping paths are preserved
+// as-is, keeping precise shapes (e.g. rounded rects for buttons).
 //
 // The merge is iterative: when two paths merge, the result may overlap with
 // additional paths, so the loop repeats until stable.
@@ -231,15 +246,19 @@ func mergeOverlappingPaths(paths []*graphics.Path) []*graphics.Path {
 				}
 				overlap := entries[i].bounds.Intersect(entries[j].bounds)
 				if !overlap.IsEmpty() {
-					// Merge: union of bounding rects.
+					// Merge. Overlap checks keep using the union of
+					// bounding rects, which is conservative.
 					union := graphics.Rect{
 						Left:   min(entries[i].bounds.Left, entries[j].bounds.Left),
 						Top:    min(entries[i].bounds.Top, entries[j].bounds.Top),
 						Right:  max(entries[i].bounds.Right, entries[j].bounds.Right),
 						Bottom: max(entries[i].bounds.Bottom, entries[j].bounds.Bottom),
 					}
-					newPath := graphics.NewPath()
-					newPath.AddRect(union)
+					newPath, err := graphics.CombinePaths(graphics.PathUnion, entries[i].path, entries[j].path)
+					if err != nil || newPath.IsEmpty() {
+						newPath = graphics.NewPath()
+						newPath.AddRect(union)
+					}
 					entries[i] = entry{path: newPath, bounds: union, alive: true}
 					entries[j].alive = false
 					merged = true
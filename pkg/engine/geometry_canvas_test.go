@@ -372,3 +372,47 @@ func TestCapOcclusionPaths_OverCap(t *testing.T) {
 		t.Errorf("collapsed path bounds = %v, want %v", result[0].Bounds(), want)
 	}
 }
+
+// transformSink records which views were reported as transformed.
+type transformSink struct {
+	mockSink
+	transformed map[int64]bool
+}
+
+func (s *transformSink) SetViewTransformed(viewID int64, transformed bool) {
+	s.transformed[viewID] = transformed
+}
+
+func TestGeometryCanvas_ReportsTransformedViews(t *testing.T) {
+	sink := &transformSink{transformed: map[int64]bool{}}
+	gc := NewGeometryCanvas(graphics.Size{Width: 800, Height: 600}, sink)
+	size := graphics.Size{Width: 100, Height: 80}
+
+	gc.Save()
+	gc.Translate(10, 20)
+	gc.EmbedPlatformView(1, size)
+	gc.Scale(1, 1)
+	gc.EmbedPlatformView(2, size)
+	gc.Scale(0.9, 0.9)
+	gc.EmbedPlatformView(3, size)
+	gc.Restore()
+
+	gc.SaveLayerAlpha(graphics.RectFromLTWH(0, 0, 800, 600), 0.5)
+	gc.EmbedPlatformView(4, size)
+	gc.Restore()
+
+	gc.Save()
+	gc.Rotate(0.1)
+	gc.EmbedPlatformView(5, size)
+	gc.Restore()
+	gc.EmbedPlatformView(6, size)
+
+	gc.FlushToSink()
+
+	want := map[int64]bool{1: false, 2: false, 3: true, 4: true, 5: true, 6: false}
+	for id, transformed := range want {
+		if got, ok := sink.transformed[id]; !ok || got != transformed {
+			t.Errorf("view %d: transformed = %v (reported %v), want %v", id, got, ok, transformed)
+		}
+	}
+}
@@ -51,3 +51,37 @@ func HitTestPlatformView(viewID int64, x, y float64) bool {
 
 	return false
 }
+
+// MouseCursorAt returns the mouse cursor to show at the given pixel
+// coordinates: the cursor of the deepest [layout.MouseCursorOwner] hit, or
+// [layout.MouseCursorBasic] if there is none or a platform view is on top,
+// since native views show their own cursors.
+//
+// Called synchronously from the native UI thread (via CGo) as a mouse or
+// trackpad pointer hovers over the app.
+func MouseCursorAt(x, y float64) layout.MouseCursor {
+	frameLock.Lock()
+	defer frameLock.Unlock()
+
+	rootRender := app.rootRender
+	if rootRender == nil {
+		return layout.MouseCursorBasic
+	}
+
+	scale := app.deviceScale
+	position := graphics.Offset{X: x / scale, Y: y / scale}
+
+	result := &layout.HitTestResult{}
+	if !rootRender.HitTest(position, result) {
+		return layout.MouseCursorBasic
+	}
+	for _, entry := range result.Entries {
+		if owner, ok := entry.(layout.MouseCursorOwner); ok {
+			return owner.MouseCursor()
+		}
+		if _, ok := entry.(layout.PlatformViewOwner); ok {
+			return layout.MouseCursorBasic
+		}
+	}
+	return layout.MouseCursorBasic
+}
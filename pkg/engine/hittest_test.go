@@ -57,6 +57,17 @@ func (v *platformViewWithPointerEntry) HitTest(pos graphics.Offset, r *layout.Hi
 func (v *platformViewWithPointerEntry) HandlePointer(event gestures.PointerEvent) {}
 func (v *platformViewWithPointerEntry) PlatformViewID() int64                     { return v.viewID }
 
+// cursorEntry implements MouseCursorOwner (like renderMouseRegion).
+type cursorEntry struct {
+	layout.RenderBoxBase
+	cursor layout.MouseCursor
+}
+
+func (c *cursorEntry) PerformLayout()                                            {}
+func (c *cursorEntry) Paint(ctx *layout.PaintContext)                            {}
+func (c *cursorEntry) HitTest(pos graphics.Offset, r *layout.HitTestResult) bool { return false }
+func (c *cursorEntry) MouseCursor() layout.MouseCursor                           { return c.cursor }
+
 // hitTestRoot is a mock root render object that returns a pre-configured hit test result.
 type hitTestRoot struct {
 	layout.RenderBoxBase
@@ -152,3 +163,36 @@ func TestHitTestPlatformView(t *testing.T) {
 		})
 	}
 }
+
+func TestMouseCursorAt(t *testing.T) {
+	column := &cursorEntry{cursor: layout.MouseCursorResizeColumn}
+	row := &cursorEntry{cursor: layout.MouseCursorResizeRow}
+	tests := []struct {
+		name    string
+		entries []layout.RenderObject
+		want    layout.MouseCursor
+	}{
+		{"no entries", nil, layout.MouseCursorBasic},
+		{"no owner", []layout.RenderObject{&pointerHandlerEntry{}, &decorativeEntry{}}, layout.MouseCursorBasic},
+		{"owner above pointer handler", []layout.RenderObject{&pointerHandlerEntry{}, column}, layout.MouseCursorResizeColumn},
+		{"deepest owner wins", []layout.RenderObject{row, column}, layout.MouseCursorResizeRow},
+		{"platform view on top", []layout.RenderObject{&platformViewEntry{viewID: 1}, column}, layout.MouseCursorBasic},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			saved := app
+			defer func() { app = saved }()
+
+			app = newAppRunner()
+			app.deviceScale = 2.0
+			root := &hitTestRoot{entries: tt.entries}
+			root.SetSelf(root)
+			app.rootRender = root
+
+			if got := MouseCursorAt(100, 100); got != tt.want {
+				t.Errorf("MouseCursorAt() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
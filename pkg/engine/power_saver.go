@@ -0,0 +1,177 @@
+package engine
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-drift/drift/pkg/animation"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/platform"
+)
+
+// PowerSaverMode selects when the engine's power saving mode is active.
+type PowerSaverMode int
+
+const (
+	// PowerSaverAuto enables power saving while the OS battery saver
+	// (Android) or Low Power Mode (iOS) is on.
+	PowerSaverAuto PowerSaverMode = iota
+	// PowerSaverOn keeps power saving enabled regardless of OS state.
+	PowerSaverOn
+	// PowerSaverOff disables power saving entirely.
+	PowerSaverOff
+)
+
+// PowerSaverConfig controls how the engine reduces work to save battery.
+type PowerSaverConfig struct {
+	// Mode controls when power saving is active.
+	Mode PowerSaverMode
+	// MaxFrameRate caps the frame rate of animation-driven frames while
+	// power saving. Input and state changes outside animations are not
+	// delayed. Defaults to 30 if zero.
+	MaxFrameRate int
+	// ReduceEffects skips box shadows and backdrop blur while power saving
+	// (see [graphics.EffectsQualityReduced]).
+	ReduceEffects bool
+	// PauseOffscreenTickers freezes animation tickers while power saving and
+	// the app is not visible, resuming them where they left off.
+	PauseOffscreenTickers bool
+}
+
+// DefaultPowerSaverConfig returns a PowerSaverConfig that follows the OS
+// battery saver, caps animations at 30fps, reduces effects, and pauses
+// offscreen tickers. This is the configuration the engine starts with.
+func DefaultPowerSaverConfig() *PowerSaverConfig {
+	return &PowerSaverConfig{
+		Mode:                  PowerSaverAuto,
+		MaxFrameRate:          30,
+		ReduceEffects:         true,
+		PauseOffscreenTickers: true,
+	}
+}
+
+const defaultPowerSaverFrameRate = 30
+
+// powerSaverState tracks the active power saver configuration and the
+// effects it has applied, so they can be undone when power saving ends.
+type powerSaverState struct {
+	mu             sync.Mutex
+	config         *PowerSaverConfig
+	active         bool
+	reducedEffects bool
+	pausedTickers  bool
+	frameInterval  atomic.Int64 // time.Duration; 0 when not throttling
+	wakePending    atomic.Bool
+}
+
+var powerSaver = powerSaverState{config: DefaultPowerSaverConfig()}
+
+// SetPowerSaver configures the engine's power saving mode. Pass nil to
+// disable it. Safe to call from any goroutine.
+func SetPowerSaver(config *PowerSaverConfig) {
+	powerSaver.mu.Lock()
+	powerSaver.config = config
+	powerSaver.mu.Unlock()
+	powerSaver.apply()
+}
+
+// IsPowerSaving reports whether power saving is currently active.
+func IsPowerSaving() bool {
+	powerSaver.mu.Lock()
+	defer powerSaver.mu.Unlock()
+	return powerSaver.active
+}
+
+// apply re-evaluates the power saver against the current config, OS battery
+// saver state, and lifecycle state, then updates frame throttling, effects
+// quality, and ticker pausing to match.
+func (p *powerSaverState) apply() {
+	p.mu.Lock()
+	config := p.config
+	active := false
+	if config != nil {
+		switch config.Mode {
+		case PowerSaverOn:
+			active = true
+		case PowerSaverAuto:
+			active = platform.Power.IsLowPowerMode()
+		}
+	}
+	p.active = active
+
+	interval := time.Duration(0)
+	if active {
+		rate := config.MaxFrameRate
+		if rate <= 0 {
+			rate = defaultPowerSaverFrameRate
+		}
+		interval = time.Second / time.Duration(rate)
+	}
+	p.frameInterval.Store(int64(interval))
+
+	reduceEffects := active && config.ReduceEffects
+	effectsChanged := reduceEffects != p.reducedEffects
+	if effectsChanged {
+		p.reducedEffects = reduceEffects
+		if reduceEffects {
+			graphics.SetEffectsQuality(graphics.EffectsQualityReduced)
+		} else {
+			graphics.SetEffectsQuality(graphics.EffectsQualityFull)
+		}
+	}
+
+	pauseTickers := active && config.PauseOffscreenTickers && !platform.Lifecycle.IsResumed()
+	if pauseTickers != p.pausedTickers {
+		p.pausedTickers = pauseTickers
+		animation.SetTickersPaused(pauseTickers)
+	}
+	p.mu.Unlock()
+
+	if effectsChanged {
+		// Effects quality is applied at composite time; one frame suffices.
+		RequestFrame()
+	}
+}
+
+// throttleDelay returns how long to wait before the next animation frame,
+// or zero if the frame may run now.
+func (p *powerSaverState) throttleDelay(lastFrameStart time.Time) time.Duration {
+	interval := time.Duration(p.frameInterval.Load())
+	if interval <= 0 || lastFrameStart.IsZero() {
+		return 0
+	}
+	return max(interval-time.Since(lastFrameStart), 0)
+}
+
+// scheduleWake asks the platform for a frame once the throttle interval has
+// elapsed. Only one wake is pending at a time.
+func (p *powerSaverState) scheduleWake(delay time.Duration) {
+	if p.wakePending.Swap(true) {
+		return
+	}
+	time.AfterFunc(delay, func() {
+		p.wakePending.Store(false)
+		// The platform may have polled NeedsFrame without running StepFrame,
+		// leaving the scheduled flag set; clear it so the wake goes through.
+		platformFrameScheduled.Store(false)
+		schedulePlatformFrame()
+	})
+}
+
+// throttleAnimationFrameLocked reports whether an animation-driven frame
+// should be skipped to honor the power saver frame rate cap, arming a wake
+// timer if so. Must be called with frameLock held.
+func (a *appRunner) throttleAnimationFrameLocked() bool {
+	delay := powerSaver.throttleDelay(a.lastFrameStart)
+	if delay <= 0 {
+		return false
+	}
+	powerSaver.scheduleWake(delay)
+	return true
+}
+
+func init() {
+	platform.Power.AddHandler(func(bool) { powerSaver.apply() })
+	platform.Lifecycle.AddHandler(func(platform.LifecycleState) { powerSaver.apply() })
+}
@@ -0,0 +1,123 @@
+package engine
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-drift/drift/pkg/animation"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/platform"
+)
+
+func resetPowerSaver(t *testing.T) {
+	t.Helper()
+	t.Cleanup(func() {
+		platform.Power.SetLowPowerModeForTest(false)
+		platform.Lifecycle.SetStateForTest(platform.LifecycleStateResumed)
+		SetPowerSaver(DefaultPowerSaverConfig())
+	})
+}
+
+func TestPowerSaver_FollowsBatterySaver(t *testing.T) {
+	swapApp(t)
+	resetPowerSaver(t)
+	SetPowerSaver(DefaultPowerSaverConfig())
+
+	if IsPowerSaving() {
+		t.Fatal("expected power saving off while battery saver is off")
+	}
+
+	platform.Power.SetLowPowerModeForTest(true)
+	powerSaver.apply()
+	if !IsPowerSaving() {
+		t.Fatal("expected power saving on while battery saver is on")
+	}
+	if got := graphics.GetEffectsQuality(); got != graphics.EffectsQualityReduced {
+		t.Errorf("expected reduced effects, got %v", got)
+	}
+
+	platform.Lifecycle.SetStateForTest(platform.LifecycleStatePaused)
+	powerSaver.apply()
+	if !animation.TickersPaused() {
+		t.Error("expected tickers paused while offscreen")
+	}
+
+	platform.Lifecycle.SetStateForTest(platform.LifecycleStateResumed)
+	platform.Power.SetLowPowerModeForTest(false)
+	powerSaver.apply()
+	if IsPowerSaving() || animation.TickersPaused() {
+		t.Error("expected power saving and ticker pause to end")
+	}
+	if got := graphics.GetEffectsQuality(); got != graphics.EffectsQualityFull {
+		t.Errorf("expected full effects restored, got %v", got)
+	}
+}
+
+func TestPowerSaver_ModeOverridesBatterySaver(t *testing.T) {
+	swapApp(t)
+	resetPowerSaver(t)
+
+	platform.Power.SetLowPowerModeForTest(true)
+	SetPowerSaver(&PowerSaverConfig{Mode: PowerSaverOff})
+	if IsPowerSaving() {
+		t.Error("expected PowerSaverOff to ignore battery saver")
+	}
+
+	platform.Power.SetLowPowerModeForTest(false)
+	SetPowerSaver(&PowerSaverConfig{Mode: PowerSaverOn})
+	if !IsPowerSaving() {
+		t.Error("expected PowerSaverOn to enable power saving")
+	}
+	if got := graphics.GetEffectsQuality(); got != graphics.EffectsQualityFull {
+		t.Errorf("expected effects untouched without ReduceEffects, got %v", got)
+	}
+}
+
+func TestPowerSaver_ThrottlesAnimationFrames(t *testing.T) {
+	a := swapApp(t)
+	resetPowerSaver(t)
+	SetPowerSaver(&PowerSaverConfig{Mode: PowerSaverOn, MaxFrameRate: 10})
+
+	a.lastFrameStart = time.Now()
+	if !a.throttleAnimationFrameLocked() {
+		t.Error("expected frame within the interval to be throttled")
+	}
+
+	a.lastFrameStart = time.Now().Add(-200 * time.Millisecond)
+	if a.throttleAnimationFrameLocked() {
+		t.Error("expected frame after the interval to run")
+	}
+
+	SetPowerSaver(nil)
+	a.lastFrameStart = time.Now()
+	if a.throttleAnimationFrameLocked() {
+		t.Error("expected no throttling with power saver disabled")
+	}
+}
+
+func TestPowerSaver_DoesNotThrottleStateChanges(t *testing.T) {
+	a := swapApp(t)
+	resetPowerSaver(t)
+	runPipelineLocked()
+	if a.root == nil {
+		t.Fatal("expected the root to mount")
+	}
+	SetPowerSaver(&PowerSaverConfig{Mode: PowerSaverOn, MaxFrameRate: 10})
+
+	ticker := animation.NewTicker(func(time.Duration) {})
+	ticker.Start()
+	defer ticker.Stop()
+
+	frameLock.Lock()
+	defer frameLock.Unlock()
+	a.lastFrameStart = time.Now()
+	a.pendingFrameRequest.Store(true)
+	if a.needsFrameLocked() {
+		t.Error("expected a tick-requested frame within the interval to be throttled")
+	}
+
+	a.root.MarkNeedsBuild()
+	if !a.needsFrameLocked() {
+		t.Error("expected a frame for a state change while a ticker runs")
+	}
+}
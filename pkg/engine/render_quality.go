@@ -0,0 +1,22 @@
+package engine
+
+import "github.com/go-drift/drift/pkg/graphics"
+
+// SetRenderQuality sets the global rendering quality defaults used for
+// image sampling, shape antialiasing, and gradient dithering, and schedules
+// a frame so the change is visible immediately. Use it to trade quality for
+// speed on low-end devices:
+//
+//	engine.SetRenderQuality(graphics.RenderQuality{
+//	    FilterQuality: graphics.FilterQualityNone,
+//	    Antialias:     false,
+//	})
+//
+// Individual paints can override the shape settings with
+// [graphics.Paint.Antialias] and [graphics.Paint.Dither]. Safe to call from
+// any goroutine.
+func SetRenderQuality(q graphics.RenderQuality) {
+	graphics.SetRenderQuality(q)
+	// Render quality is applied at composite time; one frame suffices.
+	RequestFrame()
+}
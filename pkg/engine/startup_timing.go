@@ -0,0 +1,124 @@
+package engine
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+)
+
+// processStart approximates process start as the moment the engine package is
+// initialized. Go package initialization runs before any embedder callback, so
+// this is the earliest point observable from Go code.
+var processStart = time.Now()
+
+// StartupTimings records when each startup milestone was reached. Zero
+// timestamps mean the milestone has not been reached yet.
+type StartupTimings struct {
+	// ProcessStart is when the Go runtime initialized the engine package.
+	ProcessStart time.Time `json:"processStart"`
+	// EngineInit is when the Skia GPU context finished initializing,
+	// including shader warmup.
+	EngineInit time.Time `json:"engineInit"`
+	// FirstBuild is when the root widget tree finished its first build.
+	FirstBuild time.Time `json:"firstBuild"`
+	// FirstLayout is when the render tree finished its first layout pass.
+	FirstLayout time.Time `json:"firstLayout"`
+	// FirstFrame is when the first frame containing the app was rasterized
+	// with no outstanding [DeferFirstFrame] calls.
+	FirstFrame time.Time `json:"firstFrame"`
+}
+
+// Complete reports whether the first frame has been rasterized.
+func (s StartupTimings) Complete() bool {
+	return !s.FirstFrame.IsZero()
+}
+
+// Since returns the elapsed time between ProcessStart and the given milestone,
+// or zero if either timestamp is unset.
+func (s StartupTimings) Since(milestone time.Time) time.Duration {
+	if s.ProcessStart.IsZero() || milestone.IsZero() {
+		return 0
+	}
+	return milestone.Sub(s.ProcessStart)
+}
+
+// String formats the milestones as offsets from process start, for example
+// "engine init 85ms, first build 120ms, first layout 124ms, first frame 161ms".
+// Milestones that have not been reached are omitted.
+func (s StartupTimings) String() string {
+	parts := make([]string, 0, 4)
+	add := func(label string, t time.Time) {
+		if t.IsZero() {
+			return
+		}
+		parts = append(parts, fmt.Sprintf("%s %dms", label, s.Since(t).Milliseconds()))
+	}
+	add("engine init", s.EngineInit)
+	add("first build", s.FirstBuild)
+	add("first layout", s.FirstLayout)
+	add("first frame", s.FirstFrame)
+	if len(parts) == 0 {
+		return "no milestones reached"
+	}
+	return strings.Join(parts, ", ")
+}
+
+// startupTracker records each startup milestone once. It has its own mutex
+// because Skia initialization runs outside frameLock.
+type startupTracker struct {
+	mu      sync.Mutex
+	timings StartupTimings
+}
+
+func newStartupTracker(start time.Time) *startupTracker {
+	return &startupTracker{timings: StartupTimings{ProcessStart: start}}
+}
+
+// mark stores now into the field selected by pick if it is still unset.
+// Returns true if this call recorded the milestone.
+func (t *startupTracker) mark(pick func(*StartupTimings) *time.Time) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	field := pick(&t.timings)
+	if !field.IsZero() {
+		return false
+	}
+	*field = time.Now()
+	return true
+}
+
+func (t *startupTracker) markEngineInit() {
+	t.mark(func(s *StartupTimings) *time.Time { return &s.EngineInit })
+}
+
+func (t *startupTracker) markFirstBuild() {
+	t.mark(func(s *StartupTimings) *time.Time { return &s.FirstBuild })
+}
+
+func (t *startupTracker) markFirstLayout() {
+	t.mark(func(s *StartupTimings) *time.Time { return &s.FirstLayout })
+}
+
+// markFirstFrame records the first rasterized frame and logs the startup
+// summary so it appears in `drift run` output.
+func (t *startupTracker) markFirstFrame() {
+	if !t.mark(func(s *StartupTimings) *time.Time { return &s.FirstFrame }) {
+		return
+	}
+	log.Printf("drift: startup: %s", t.snapshot())
+}
+
+func (t *startupTracker) snapshot() StartupTimings {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.timings
+}
+
+// StartupTiming returns the startup milestones recorded so far. Use it to
+// track cold-start regressions such as slow Skia initialization or font
+// loading. Safe to call from any goroutine, including Build.
+func StartupTiming() StartupTimings {
+	return app.startup.snapshot()
+}
@@ -0,0 +1,101 @@
+package engine
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestStartupTracker_MarksOnce(t *testing.T) {
+	tracker := newStartupTracker(time.Now())
+
+	tracker.markFirstBuild()
+	first := tracker.snapshot().FirstBuild
+	if first.IsZero() {
+		t.Fatal("expected FirstBuild to be recorded")
+	}
+
+	time.Sleep(time.Millisecond)
+	tracker.markFirstBuild()
+	if got := tracker.snapshot().FirstBuild; !got.Equal(first) {
+		t.Errorf("expected FirstBuild to stay %v, got %v", first, got)
+	}
+}
+
+func TestStartupTimings_String(t *testing.T) {
+	start := time.Now()
+	timings := StartupTimings{
+		ProcessStart: start,
+		EngineInit:   start.Add(80 * time.Millisecond),
+		FirstFrame:   start.Add(150 * time.Millisecond),
+	}
+
+	got := timings.String()
+	if got != "engine init 80ms, first frame 150ms" {
+		t.Errorf("unexpected summary %q", got)
+	}
+	if !timings.Complete() {
+		t.Error("expected timings with FirstFrame to be complete")
+	}
+
+	empty := StartupTimings{ProcessStart: start}
+	if !strings.Contains(empty.String(), "no milestones") {
+		t.Errorf("unexpected empty summary %q", empty.String())
+	}
+	if empty.Since(empty.FirstFrame) != 0 {
+		t.Error("expected zero duration for unreached milestone")
+	}
+}
+
+func TestRunPipeline_RecordsFirstBuildAndLayout(t *testing.T) {
+	swapApp(t)
+
+	if !runPipelineLocked() {
+		t.Fatal("expected runPipeline to return true")
+	}
+	timings := app.startup.snapshot()
+	if timings.FirstBuild.IsZero() || timings.FirstLayout.IsZero() {
+		t.Fatalf("expected first build and layout to be recorded, got %+v", timings)
+	}
+	if timings.FirstLayout.Before(timings.FirstBuild) {
+		t.Error("expected first layout to follow first build")
+	}
+	if timings.Complete() {
+		t.Error("expected first frame to remain unset until RenderFrame")
+	}
+}
+
+func TestFirstFrame_DeferredUntilAllowed(t *testing.T) {
+	swapApp(t)
+
+	DeferFirstFrame()
+	app.markFirstFrameIfAllowed()
+	if FirstFrameRasterized() {
+		t.Fatal("expected first frame to be deferred")
+	}
+	if app.startup.snapshot().Complete() {
+		t.Fatal("expected startup timing to exclude deferred frames")
+	}
+
+	AllowFirstFrame()
+	app.markFirstFrameIfAllowed()
+	if !FirstFrameRasterized() {
+		t.Fatal("expected first frame after AllowFirstFrame")
+	}
+	if !app.startup.snapshot().Complete() {
+		t.Error("expected startup timing to record the first frame")
+	}
+}
+
+func TestAllowFirstFrame_Unbalanced(t *testing.T) {
+	swapApp(t)
+
+	AllowFirstFrame()
+	if got := app.firstFrameDeferrals.Load(); got != 0 {
+		t.Fatalf("expected deferrals clamped to 0, got %d", got)
+	}
+	app.markFirstFrameIfAllowed()
+	if !FirstFrameRasterized() {
+		t.Error("expected first frame without outstanding deferrals")
+	}
+}
@@ -10,17 +10,23 @@ type transformTracker struct {
 	transform graphics.Offset
 	saveStack []trackerSaveState
 	clips     []graphics.Rect
+
+	// transformed is set under a scale, rotation or translucent layer,
+	// which native views are not drawn with.
+	transformed bool
 }
 
 type trackerSaveState struct {
-	transform graphics.Offset
-	clipDepth int
+	transform   graphics.Offset
+	clipDepth   int
+	transformed bool
 }
 
 func (t *transformTracker) save() {
 	t.saveStack = append(t.saveStack, trackerSaveState{
-		transform: t.transform,
-		clipDepth: len(t.clips),
+		transform:   t.transform,
+		clipDepth:   len(t.clips),
+		transformed: t.transformed,
 	})
 }
 
@@ -30,6 +36,26 @@ func (t *transformTracker) restore() {
 		t.saveStack = t.saveStack[:len(t.saveStack)-1]
 		t.transform = state.transform
 		t.clips = t.clips[:state.clipDepth]
+		t.transformed = state.transformed
+	}
+}
+
+func (t *transformTracker) scale(sx, sy float64) {
+	if sx != 1 || sy != 1 {
+		t.transformed = true
+	}
+}
+
+func (t *transformTracker) rotate(radians float64) {
+	if radians != 0 {
+		t.transformed = true
+	}
+}
+
+func (t *transformTracker) saveLayerAlpha(alpha float64) {
+	t.save()
+	if alpha < 1 {
+		t.transformed = true
 	}
 }
 
@@ -80,4 +106,7 @@ func (t *transformTracker) embedPlatformView(sink PlatformViewSink, viewID int64
 	}
 
 	sink.UpdateViewGeometry(viewID, offset, size, clipBounds, visibleRect, []*graphics.Path{})
+	if ts, ok := sink.(PlatformViewTransformSink); ok {
+		ts.SetViewTransformed(viewID, t.transformed)
+	}
 }
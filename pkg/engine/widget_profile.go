@@ -0,0 +1,431 @@
+package engine
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/layout"
+)
+
+const (
+	// profileFramesDefault is how many profiled frames the /profile report
+	// covers.
+	profileFramesDefault = 120
+	// profileHeatFrames is how many recent frames the heat map covers.
+	profileHeatFrames = 30
+	// profileHeatSpots is how many of the most expensive widgets the heat
+	// map highlights.
+	profileHeatSpots    = 10
+	defaultProfileLimit = 50
+)
+
+// profileCost is the time a target spent in each phase.
+type profileCost struct {
+	build, layout, paint time.Duration
+}
+
+func (c profileCost) total() time.Duration {
+	return c.build + c.layout + c.paint
+}
+
+// widgetProfiler keeps the per-target costs of recent frames for
+// ProfileWidgets. Targets are elements (build) and render objects (layout
+// and paint). Accessed only under frameLock.
+type widgetProfiler struct {
+	current map[any]*profileCost
+	frames  []map[any]*profileCost
+	next    int
+	count   int
+}
+
+func newWidgetProfiler(capacity int) *widgetProfiler {
+	return &widgetProfiler{
+		current: make(map[any]*profileCost),
+		frames:  make([]map[any]*profileCost, capacity),
+	}
+}
+
+// RecordProfile implements layout.Profiler.
+func (p *widgetProfiler) RecordProfile(target any, phase layout.ProfilePhase, self time.Duration) {
+	cost := p.current[target]
+	if cost == nil {
+		cost = &profileCost{}
+		p.current[target] = cost
+	}
+	switch phase {
+	case layout.ProfilePhaseBuild:
+		cost.build += self
+	case layout.ProfilePhaseLayout:
+		cost.layout += self
+	case layout.ProfilePhasePaint:
+		cost.paint += self
+	}
+}
+
+// endFrame stores the costs recorded since the last call as a frame. Frames
+// that did no work are skipped, so an idle app keeps its last report.
+func (p *widgetProfiler) endFrame() {
+	if len(p.current) == 0 {
+		return
+	}
+	p.frames[p.next] = p.current
+	p.next = (p.next + 1) % len(p.frames)
+	if p.count < len(p.frames) {
+		p.count++
+	}
+	p.current = make(map[any]*profileCost)
+}
+
+// recent returns up to n of the most recent frames, newest first.
+func (p *widgetProfiler) recent(n int) []map[any]*profileCost {
+	n = min(n, p.count)
+	frames := make([]map[any]*profileCost, n)
+	for i := range frames {
+		frames[i] = p.frames[(p.next-1-i+len(p.frames))%len(p.frames)]
+	}
+	return frames
+}
+
+// clear drops all recorded frames.
+func (p *widgetProfiler) clear() {
+	clear(p.frames)
+	p.next = 0
+	p.count = 0
+	p.current = make(map[any]*profileCost)
+}
+
+// WidgetProfile is the /profile report: the widgets that cost the most over
+// recent frames, most expensive first.
+type WidgetProfile struct {
+	// Frames is the number of profiled frames covered. Frames that did no
+	// build, layout or paint work are not counted.
+	Frames    int                  `json:"frames"`
+	Entries   []WidgetProfileEntry `json:"entries"`
+	Truncated bool                 `json:"truncated,omitempty"`
+}
+
+// WidgetProfileEntry is the cost of one widget over the profiled frames.
+// The layout and paint time of a render object is reported for the widget
+// that created it. Times are in microseconds and exclude the time spent in
+// descendants.
+type WidgetProfileEntry struct {
+	WidgetType string `json:"widgetType,omitempty"`
+	RenderType string `json:"renderType,omitempty"`
+	Key        any    `json:"key,omitempty"`
+	Depth      int    `json:"depth"`
+	// Mounted is false for widgets that have since left the tree.
+	Mounted bool `json:"mounted"`
+	// Offset and Size are the window bounds of the widget's render object.
+	Offset     *SafeOffset `json:"offset,omitempty"`
+	Size       *SafeSize   `json:"size,omitempty"`
+	BuildUs    float64     `json:"buildUs"`
+	LayoutUs   float64     `json:"layoutUs"`
+	PaintUs    float64     `json:"paintUs"`
+	TotalUs    float64     `json:"totalUs"`
+	MaxFrameUs float64     `json:"maxFrameUs"`
+	// Frames is the number of frames in which the widget did any work.
+	Frames int `json:"frames"`
+
+	bounds graphics.Rect
+}
+
+// buildWidgetProfile attributes the costs in frames to the widgets of the
+// tree at root and ranks them by total cost.
+func buildWidgetProfile(root core.Element, frames []map[any]*profileCost) []WidgetProfileEntry {
+	// Each render object belongs to the deepest element that reports it,
+	// which is the element of the widget that created it.
+	owners := make(map[layout.RenderObject]core.Element)
+	mounted := make(map[core.Element]bool)
+	var visit func(core.Element)
+	visit = func(elem core.Element) {
+		mounted[elem] = true
+		if ro := renderObjectOf(elem); ro != nil {
+			owners[ro] = elem
+		}
+		elem.VisitChildren(func(child core.Element) bool {
+			visit(child)
+			return true
+		})
+	}
+	if root != nil {
+		visit(root)
+	}
+
+	type row struct {
+		cost   profileCost
+		max    time.Duration
+		frames int
+	}
+	rows := make(map[any]*row)
+	frameCosts := make(map[any]*profileCost)
+	for _, frame := range frames {
+		clear(frameCosts)
+		for target, cost := range frame {
+			key := target
+			if ro, ok := target.(layout.RenderObject); ok {
+				if owner := owners[ro]; owner != nil {
+					key = owner
+				}
+			}
+			sum := frameCosts[key]
+			if sum == nil {
+				sum = &profileCost{}
+				frameCosts[key] = sum
+			}
+			sum.build += cost.build
+			sum.layout += cost.layout
+			sum.paint += cost.paint
+		}
+		for key, cost := range frameCosts {
+			r := rows[key]
+			if r == nil {
+				r = &row{}
+				rows[key] = r
+			}
+			r.cost.build += cost.build
+			r.cost.layout += cost.layout
+			r.cost.paint += cost.paint
+			r.max = max(r.max, cost.total())
+			r.frames++
+		}
+	}
+
+	entries := make([]WidgetProfileEntry, 0, len(rows))
+	for key, r := range rows {
+		entry := WidgetProfileEntry{
+			BuildUs:    durationToMicros(r.cost.build),
+			LayoutUs:   durationToMicros(r.cost.layout),
+			PaintUs:    durationToMicros(r.cost.paint),
+			TotalUs:    durationToMicros(r.cost.total()),
+			MaxFrameUs: durationToMicros(r.max),
+			Frames:     r.frames,
+		}
+		switch target := key.(type) {
+		case core.Element:
+			if widget := target.Widget(); widget != nil {
+				entry.WidgetType = reflect.TypeOf(widget).String()
+				entry.Key = safeKey(widget.Key())
+			}
+			entry.Depth = target.Depth()
+			entry.Mounted = mounted[target]
+			ro := renderObjectOf(target)
+			if ro != nil && owners[ro] == target {
+				entry.RenderType = reflect.TypeOf(ro).String()
+			}
+			if ro != nil && entry.Mounted {
+				offset := core.GlobalOffsetOf(target)
+				size := ro.Size()
+				entry.Offset = &SafeOffset{X: SafeFloat(offset.X), Y: SafeFloat(offset.Y)}
+				entry.Size = &SafeSize{Width: SafeFloat(size.Width), Height: SafeFloat(size.Height)}
+				entry.bounds = graphics.RectFromLTWH(offset.X, offset.Y, size.Width, size.Height)
+			}
+		default:
+			// A render object with no element, such as one created by
+			// another render object.
+			entry.RenderType = reflect.TypeOf(target).String()
+		}
+		entries = append(entries, entry)
+	}
+	slices.SortFunc(entries, func(a, b WidgetProfileEntry) int {
+		if c := cmp.Compare(b.TotalUs, a.TotalUs); c != 0 {
+			return c
+		}
+		if c := cmp.Compare(a.Depth, b.Depth); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.WidgetType+a.RenderType, b.WidgetType+b.RenderType)
+	})
+	return entries
+}
+
+// renderObjectOf returns the render object an element reports, if any.
+func renderObjectOf(elem core.Element) layout.RenderObject {
+	if renderElement, ok := elem.(interface{ RenderObject() layout.RenderObject }); ok {
+		return renderElement.RenderObject()
+	}
+	return nil
+}
+
+func durationToMicros(d time.Duration) float64 {
+	return float64(d) / float64(time.Microsecond)
+}
+
+// paintProfiled paints a repaint boundary's layer, reporting its paint time
+// to the profiler.
+func paintProfiled(boundary layout.RenderObject, ctx *layout.PaintContext) {
+	layout.BeginProfile()
+	defer layout.EndProfile(boundary, layout.ProfilePhasePaint)
+	boundary.Paint(ctx)
+}
+
+// stopWidgetProfiling removes the profiler installed for ProfileWidgets.
+// Caller must hold frameLock.
+func stopWidgetProfiling() {
+	if app.widgetProfiler != nil {
+		layout.SetProfiler(nil)
+		app.widgetProfiler = nil
+	}
+	app.showProfileHeatMap = false
+	app.profileHeat = nil
+}
+
+// profileHeatSpot is a widget highlighted by the heat map.
+type profileHeatSpot struct {
+	rect graphics.Rect
+	// heat is the widget's cost relative to the most expensive one, in (0, 1].
+	heat  float64
+	label string
+}
+
+// profileHeatSpots returns the heat map spots for the most expensive
+// widgets of recent frames.
+func (p *widgetProfiler) heatSpots(root core.Element) []profileHeatSpot {
+	var spots []profileHeatSpot
+	var hottest float64
+	for _, entry := range buildWidgetProfile(root, p.recent(profileHeatFrames)) {
+		if len(spots) == profileHeatSpots || entry.TotalUs <= 0 {
+			break
+		}
+		if entry.bounds.Width() <= 0 || entry.bounds.Height() <= 0 {
+			continue
+		}
+		if hottest == 0 {
+			hottest = entry.TotalUs
+		}
+		name := entry.WidgetType
+		if i := strings.LastIndex(name, "."); i >= 0 {
+			name = name[i+1:]
+		}
+		spots = append(spots, profileHeatSpot{
+			rect:  entry.bounds,
+			heat:  entry.TotalUs / hottest,
+			label: fmt.Sprintf("%s %.0fµs", name, entry.TotalUs/float64(entry.Frames)),
+		})
+	}
+	return spots
+}
+
+// drawProfileHeat draws the heat map over the frame, from green for the
+// cheapest highlighted widget to red for the most expensive, labeled with
+// each widget's average cost per frame.
+func drawProfileHeat(canvas graphics.Canvas, spots []profileHeatSpot, strokeWidth float64) {
+	manager, _ := graphics.DefaultFontManagerErr()
+	// Draw the hottest spot last so it stays on top.
+	for _, spot := range slices.Backward(spots) {
+		color := graphics.RGB(uint8(255*min(2*spot.heat, 1)), uint8(255*min(2*(1-spot.heat), 1)), 0)
+		fill := graphics.DefaultPaint()
+		fill.Color = color.WithAlpha(0.35)
+		canvas.DrawRect(spot.rect, fill)
+		canvas.DrawRect(spot.rect, graphics.Paint{
+			Color:       color,
+			Style:       graphics.PaintStyleStroke,
+			StrokeWidth: strokeWidth,
+			BlendMode:   graphics.BlendModeSrcOver,
+			Alpha:       1.0,
+		})
+		if manager == nil {
+			continue
+		}
+		label, err := graphics.LayoutText(spot.label, graphics.TextStyle{
+			Color:      graphics.RGB(255, 255, 255),
+			FontSize:   10,
+			FontWeight: graphics.FontWeightBold,
+		}, manager)
+		if err != nil {
+			continue
+		}
+		background := graphics.DefaultPaint()
+		background.Color = graphics.RGBA(0, 0, 0, 0.6)
+		canvas.DrawRect(graphics.RectFromLTWH(spot.rect.Left, spot.rect.Top, label.Size.Width+4, label.Size.Height), background)
+		canvas.DrawText(label, graphics.Offset{X: spot.rect.Left + 2, Y: spot.rect.Top})
+	}
+}
+
+// handleProfile returns the widgets that cost the most over the recent
+// profiled frames as JSON. ?frames= narrows the report to the most recent N
+// frames and ?limit= caps the number of entries. DELETE clears the recorded
+// frames.
+func handleProfile(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodDelete {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	query := r.URL.Query()
+	frameCount := profileFramesDefault
+	if value := query.Get("frames"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed <= 0 {
+			http.Error(w, "invalid frames", http.StatusBadRequest)
+			return
+		}
+		frameCount = parsed
+	}
+	limit := defaultProfileLimit
+	if value := query.Get("limit"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed <= 0 {
+			http.Error(w, "invalid limit", http.StatusBadRequest)
+			return
+		}
+		limit = parsed
+	}
+
+	defer func() {
+		if rec := recover(); rec != nil {
+			http.Error(w, fmt.Sprintf("panic: %v", rec), http.StatusInternalServerError)
+		}
+	}()
+
+	frames, entries, ok := widgetProfileReport(frameCount, r.Method == http.MethodDelete)
+	if !ok {
+		http.Error(w, "widget profiling disabled", http.StatusServiceUnavailable)
+		return
+	}
+	if r.Method == http.MethodDelete {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	resp := WidgetProfile{
+		Frames:    frames,
+		Entries:   entries[:min(len(entries), limit)],
+		Truncated: len(entries) > limit,
+	}
+
+	data, err := json.MarshalIndent(resp, "", "  ")
+	if err != nil {
+		http.Error(w, fmt.Sprintf("json encode error: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(data)
+}
+
+// widgetProfileReport builds the report for the last frameCount profiled
+// frames under frameLock, or clears the frames when reset is set. It returns
+// false when profiling is off.
+func widgetProfileReport(frameCount int, reset bool) (int, []WidgetProfileEntry, bool) {
+	frameLock.Lock()
+	defer frameLock.Unlock()
+	profiler := app.widgetProfiler
+	if profiler == nil {
+		return 0, nil, false
+	}
+	if reset {
+		profiler.clear()
+		return 0, nil, true
+	}
+	frames := profiler.recent(frameCount)
+	return len(frames), buildWidgetProfile(app.root, frames), true
+}
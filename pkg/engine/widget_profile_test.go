@@ -0,0 +1,68 @@
+package engine
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-drift/drift/pkg/layout"
+)
+
+type profiledBox struct {
+	layout.RenderBoxBase
+}
+
+func TestWidgetProfiler_SkipsIdleFrames(t *testing.T) {
+	p := newWidgetProfiler(2)
+	box := &profiledBox{}
+
+	p.RecordProfile(box, layout.ProfilePhaseLayout, time.Millisecond)
+	p.endFrame()
+	p.endFrame()
+	p.RecordProfile(box, layout.ProfilePhasePaint, 2*time.Millisecond)
+	p.endFrame()
+	p.RecordProfile(box, layout.ProfilePhasePaint, 3*time.Millisecond)
+	p.endFrame()
+
+	frames := p.recent(10)
+	if len(frames) != 2 {
+		t.Fatalf("expected 2 frames, got %d", len(frames))
+	}
+	if got := frames[0][box].paint; got != 3*time.Millisecond {
+		t.Errorf("newest frame paint = %v, want 3ms", got)
+	}
+	if got := frames[1][box].paint; got != 2*time.Millisecond {
+		t.Errorf("oldest frame paint = %v, want 2ms", got)
+	}
+}
+
+func TestBuildWidgetProfile_RanksByTotalCost(t *testing.T) {
+	cheap := &profiledBox{}
+	costly := &profiledBox{}
+	frames := []map[any]*profileCost{
+		{
+			cheap:  {paint: 100 * time.Microsecond},
+			costly: {layout: 300 * time.Microsecond, paint: 200 * time.Microsecond},
+		},
+		{
+			costly: {layout: 100 * time.Microsecond},
+		},
+	}
+
+	entries := buildWidgetProfile(nil, frames)
+	if len(entries) != 2 {
+		t.Fatalf("expected 2 entries, got %d", len(entries))
+	}
+	top := entries[0]
+	if top.TotalUs != 600 || top.LayoutUs != 400 || top.PaintUs != 200 {
+		t.Errorf("top entry = %+v, want 400us layout and 200us paint", top)
+	}
+	if top.Frames != 2 || top.MaxFrameUs != 500 {
+		t.Errorf("top entry frames = %d, max = %v; want 2 frames, 500us max", top.Frames, top.MaxFrameUs)
+	}
+	if top.RenderType != "*engine.profiledBox" {
+		t.Errorf("top entry render type = %q", top.RenderType)
+	}
+	if entries[1].TotalUs != 100 {
+		t.Errorf("second entry total = %v, want 100us", entries[1].TotalUs)
+	}
+}
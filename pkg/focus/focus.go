@@ -40,8 +40,58 @@ const (
 	TraversalDirectionRight
 )
 
-// KeyEvent represents a keyboard event placeholder.
-type KeyEvent struct{}
+// Key names a hardware key by its meaning rather than its position, such as
+// "ArrowDown" or "Enter". Keys that type a character are named by that
+// character, such as "a" or "1".
+type Key string
+
+const (
+	KeyArrowUp    Key = "ArrowUp"
+	KeyArrowDown  Key = "ArrowDown"
+	KeyArrowLeft  Key = "ArrowLeft"
+	KeyArrowRight Key = "ArrowRight"
+	KeyEnter      Key = "Enter"
+	KeySpace      Key = " "
+	KeyTab        Key = "Tab"
+	KeyEscape     Key = "Escape"
+	KeyBackspace  Key = "Backspace"
+	KeyDelete     Key = "Delete"
+	KeyHome       Key = "Home"
+	KeyEnd        Key = "End"
+	KeyPageUp     Key = "PageUp"
+	KeyPageDown   Key = "PageDown"
+)
+
+// KeyAction is the kind of a key event.
+type KeyAction int
+
+const (
+	// KeyActionDown is sent when a key is pressed.
+	KeyActionDown KeyAction = iota
+
+	// KeyActionRepeat is sent while a key is held down.
+	KeyActionRepeat
+
+	// KeyActionUp is sent when a key is released.
+	KeyActionUp
+)
+
+// KeyEvent is a hardware keyboard event, such as from a Bluetooth keyboard
+// or a TV remote. On-screen keyboards type into text inputs instead and do
+// not send key events.
+type KeyEvent struct {
+	Key    Key
+	Action KeyAction
+
+	// Modifier keys held during the event.
+	Shift, Control, Alt, Meta bool
+}
+
+// IsPress reports whether the event is a key press or a repeat of one, the
+// events that usually trigger an action.
+func (e KeyEvent) IsPress() bool {
+	return e.Action == KeyActionDown || e.Action == KeyActionRepeat
+}
 
 // KeyEventResult indicates how a key event was handled.
 type KeyEventResult int
@@ -284,6 +334,24 @@ func GetFocusManager() *FocusManager {
 	return focusManager
 }
 
+// HandleKeyEvent delivers a hardware key event to the primary focus. Tab and
+// Shift+Tab presses the focused node ignores move focus to the next or
+// previous node. It reports whether the event was handled.
+func (m *FocusManager) HandleKeyEvent(event KeyEvent) bool {
+	if node := m.PrimaryFocus; node != nil && node.OnKeyEvent != nil {
+		if node.OnKeyEvent(event) == KeyEventHandled {
+			return true
+		}
+	}
+	if event.Key == KeyTab && event.IsPress() {
+		if event.Shift {
+			return m.MoveFocus(-1)
+		}
+		return m.MoveFocus(1)
+	}
+	return false
+}
+
 // MoveFocus moves focus by delta positions within the root scope.
 func (m *FocusManager) MoveFocus(delta int) bool {
 	scope := m.RootScope
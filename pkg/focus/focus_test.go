@@ -331,6 +331,48 @@ func TestFocusManager_MoveFocus_NilScope(t *testing.T) {
 	}
 }
 
+// --- FocusManager.HandleKeyEvent ---
+
+func TestFocusManager_HandleKeyEvent(t *testing.T) {
+	resetFocusManager()
+
+	var got []Key
+	a := &FocusNode{
+		CanRequestFocus: true,
+		OnKeyEvent: func(event KeyEvent) KeyEventResult {
+			got = append(got, event.Key)
+			if event.Key == KeyEnter {
+				return KeyEventHandled
+			}
+			return KeyEventIgnored
+		},
+	}
+	b := &FocusNode{CanRequestFocus: true}
+
+	m := GetFocusManager()
+	m.RootScope.Children = []*FocusNode{a, b}
+	m.setPrimaryFocus(a)
+
+	if !m.HandleKeyEvent(KeyEvent{Key: KeyEnter}) {
+		t.Error("Enter should be handled by the focused node")
+	}
+	if m.HandleKeyEvent(KeyEvent{Key: KeyEscape}) {
+		t.Error("Escape should be ignored")
+	}
+	if m.HandleKeyEvent(KeyEvent{Key: KeyTab, Action: KeyActionUp}) || !a.HasPrimaryFocus() {
+		t.Error("releasing Tab should not move focus")
+	}
+	if !m.HandleKeyEvent(KeyEvent{Key: KeyTab}) || !b.HasPrimaryFocus() {
+		t.Error("an ignored Tab should move focus to the next node")
+	}
+	if !m.HandleKeyEvent(KeyEvent{Key: KeyTab, Shift: true}) || !a.HasPrimaryFocus() {
+		t.Error("Shift+Tab should move focus to the previous node")
+	}
+	if len(got) != 4 || got[0] != KeyEnter || got[1] != KeyEscape {
+		t.Errorf("focused node saw %q", got)
+	}
+}
+
 // --- FocusScopeNode.FocusInDirection ---
 
 type staticRect struct{ rect FocusRect }
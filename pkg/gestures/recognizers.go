@@ -120,6 +120,127 @@ func (t *TapGestureRecognizer) tryFire() {
 	}
 }
 
+// DefaultLongPressDuration is how long a pointer must be held for a
+// [LongPressGestureRecognizer] to win.
+var DefaultLongPressDuration = 500 * time.Millisecond
+
+// LongPressDetails describes a long press.
+type LongPressDetails struct {
+	// Position is the global position of the pointer when the press was
+	// recognized.
+	Position graphics.Offset
+}
+
+// Timer calls fire once after d, on the UI thread, unless the returned
+// cancel function is called first. Recognizers that wait for time to pass
+// use it; widgets supply one driven by the frame clock.
+type Timer func(d time.Duration, fire func()) (cancel func())
+
+// LongPressGestureRecognizer detects a pointer held within the touch slop
+// for Duration. It claims the pointer when the time is up, so taps and
+// drags competing for the same pointer lose.
+type LongPressGestureRecognizer struct {
+	Arena *GestureArena
+	// Duration is how long the pointer must be held. Zero uses
+	// [DefaultLongPressDuration].
+	Duration time.Duration
+	// StartTimer schedules the deadline. The recognizer does nothing
+	// without one.
+	StartTimer  Timer
+	OnLongPress func(LongPressDetails)
+	pointer     int64
+	start       graphics.Offset
+	position    graphics.Offset
+	cancelTimer func()
+	reject      bool
+}
+
+// NewLongPressGestureRecognizer creates a long press recognizer.
+func NewLongPressGestureRecognizer(arena *GestureArena, timer Timer) *LongPressGestureRecognizer {
+	return &LongPressGestureRecognizer{Arena: arena, StartTimer: timer}
+}
+
+// AddPointer registers a pointer down event and starts the deadline.
+func (l *LongPressGestureRecognizer) AddPointer(event PointerEvent) {
+	if l.Arena == nil || l.StartTimer == nil {
+		return
+	}
+	l.stopTimer()
+	l.pointer = event.PointerID
+	l.start = event.Position
+	l.position = event.Position
+	l.reject = false
+	l.Arena.Add(event.PointerID, l)
+	duration := l.Duration
+	if duration <= 0 {
+		duration = DefaultLongPressDuration
+	}
+	pointer := event.PointerID
+	l.cancelTimer = l.StartTimer(duration, func() {
+		l.cancelTimer = nil
+		if pointer == l.pointer && !l.reject {
+			l.fire()
+		}
+	})
+}
+
+// HandleEvent processes pointer events for long press detection.
+func (l *LongPressGestureRecognizer) HandleEvent(event PointerEvent) {
+	if event.PointerID != l.pointer || l.reject {
+		return
+	}
+	switch event.Phase {
+	case PointerPhaseMove:
+		l.position = event.Position
+		if distance(graphics.Offset{X: event.Position.X - l.start.X, Y: event.Position.Y - l.start.Y}) > DefaultTouchSlop {
+			l.giveUp()
+		}
+	case PointerPhaseUp, PointerPhaseCancel:
+		// Released before the deadline, or after winning: nothing to do.
+		if l.cancelTimer != nil {
+			l.giveUp()
+		}
+	}
+}
+
+// AcceptGesture is called by the arena when this recognizer wins. Winning
+// early, as the only member, still waits for the deadline.
+func (l *LongPressGestureRecognizer) AcceptGesture(pointerID int64) {}
+
+// RejectGesture is called by the arena when this recognizer loses.
+func (l *LongPressGestureRecognizer) RejectGesture(pointerID int64) {
+	if pointerID != l.pointer {
+		return
+	}
+	l.reject = true
+	l.stopTimer()
+}
+
+// Dispose releases resources for the recognizer.
+func (l *LongPressGestureRecognizer) Dispose() {
+	l.stopTimer()
+}
+
+func (l *LongPressGestureRecognizer) fire() {
+	l.Arena.Resolve(l.pointer, l)
+	if l.OnLongPress != nil {
+		l.OnLongPress(LongPressDetails{Position: l.position})
+	}
+}
+
+func (l *LongPressGestureRecognizer) giveUp() {
+	l.reject = true
+	l.stopTimer()
+	l.Arena.Reject(l.pointer, l)
+}
+
+func (l *LongPressGestureRecognizer) stopTimer() {
+	if l.cancelTimer != nil {
+		l.cancelTimer()
+		l.cancelTimer = nil
+	}
+}
+
 // PanGestureRecognizer detects pan gestures.
 type PanGestureRecognizer struct {
 	Arena    *GestureArena
@@ -133,6 +254,7 @@ type PanGestureRecognizer struct {
 	lastTime time.Time
 	velocity graphics.Offset
 	slop     float64
+	edge     float64 // edge zone width for edge drags, zero otherwise
 	accepted bool
 	reject   bool
 	started  bool
@@ -270,6 +392,7 @@ type axisDragRecognizer struct {
 	lastTime time.Time
 	velocity float64 // primary axis velocity
 	slop     float64
+	edge     float64 // edge zone width for edge drags, zero otherwise
 	accepted bool
 	reject   bool
 	started  bool
@@ -302,6 +425,13 @@ func (d *axisDragRecognizer) addPointer(event PointerEvent) {
 	d.accepted = false
 	d.reject = false
 	d.started = false
+	if d.edge > 0 {
+		if event.Position.X > d.edge {
+			d.reject = true
+			return
+		}
+		d.slop = DefaultTouchSlop / 2
+	}
 	d.Arena.Add(event.PointerID, d.self)
 	// Hold immediately to prevent auto-resolve on Close before we determine axis
 	d.Arena.Hold(event.PointerID, d.self)
@@ -327,6 +457,10 @@ func (d *axisDragRecognizer) handleMove(event PointerEvent) {
 
 	total := graphics.Offset{X: event.Position.X - d.start.X, Y: event.Position.Y - d.start.Y}
 	primary := math.Abs(d.primaryOffset(total))
+	if d.edge > 0 {
+		// Edge drags only claim movement away from the edge
+		primary = d.primaryOffset(total)
+	}
 	orthogonal := math.Abs(d.orthogonalOffset(total))
 
 	// Check if we should resolve or reject (we hold from addPointer)
@@ -334,8 +468,9 @@ func (d *axisDragRecognizer) handleMove(event PointerEvent) {
 		if primary > d.slop && primary >= orthogonal {
 			// Primary axis wins (>= handles ties in favor of primary)
 			d.Arena.Resolve(d.pointer, d.self)
-		} else if orthogonal > d.slop {
-			// Orthogonal axis exceeds slop first - reject
+		} else if orthogonal > d.slop || -primary > d.slop {
+			// Orthogonal axis (or, for edge drags, movement toward the
+			// edge) exceeds slop first - reject
 			d.reject = true
 			d.Arena.Reject(d.pointer, d.self)
 			return
@@ -507,3 +642,56 @@ func (v *VerticalDragGestureRecognizer) RejectGesture(pointerID int64) {
 
 // Dispose releases resources for the recognizer.
 func (v *VerticalDragGestureRecognizer) Dispose() {}
+
+// DefaultEdgeDragWidth is the width of the zone along the left edge of the
+// screen in which an [EdgeDragGestureRecognizer] starts.
+var DefaultEdgeDragWidth = 20.0
+
+// EdgeDragGestureRecognizer detects rightward drags that start at the left
+// edge of the screen, such as the iOS swipe-back gesture. It wins at half
+// the usual slop, so it beats horizontal scrollables beneath the edge, and
+// rejects drags that move left or vertically first.
+type EdgeDragGestureRecognizer struct {
+	axisDragRecognizer
+
+	// EdgeWidth is the width of the zone, measured from the left edge of
+	// the screen, in which a drag must start. Zero uses [DefaultEdgeDragWidth].
+	EdgeWidth float64
+}
+
+// NewEdgeDragGestureRecognizer creates an edge drag recognizer.
+func NewEdgeDragGestureRecognizer(arena *GestureArena) *EdgeDragGestureRecognizer {
+	e := &EdgeDragGestureRecognizer{}
+	e.Arena = arena
+	e.axis = DragAxisHorizontal
+	e.self = e // set self-reference for arena registration
+	return e
+}
+
+// AddPointer registers a pointer down event. Pointers outside the edge zone
+// are ignored.
+func (e *EdgeDragGestureRecognizer) AddPointer(event PointerEvent) {
+	e.edge = e.EdgeWidth
+	if e.edge <= 0 {
+		e.edge = DefaultEdgeDragWidth
+	}
+	e.addPointer(event)
+}
+
+// HandleEvent processes pointer events for drag detection.
+func (e *EdgeDragGestureRecognizer) HandleEvent(event PointerEvent) {
+	e.handleEvent(event)
+}
+
+// AcceptGesture is called by the arena when this recognizer wins.
+func (e *EdgeDragGestureRecognizer) AcceptGesture(pointerID int64) {
+	e.acceptGesture(pointerID)
+}
+
+// RejectGesture is called by the arena when this recognizer loses.
+func (e *EdgeDragGestureRecognizer) RejectGesture(pointerID int64) {
+	e.rejectGesture(pointerID)
+}
+
+// Dispose releases resources for the recognizer.
+func (e *EdgeDragGestureRecognizer) Dispose() {}
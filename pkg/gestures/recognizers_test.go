@@ -1,6 +1,7 @@
 package gestures
 
 import (
+	"math"
 	"testing"
 	"time"
 
@@ -273,6 +274,91 @@ func TestDrag_CompetesWithTap(t *testing.T) {
 	}
 }
 
+// manualTimer is a [Timer] fired by the test.
+type manualTimer struct {
+	fire func()
+}
+
+func (m *manualTimer) start(d time.Duration, fire func()) func() {
+	m.fire = fire
+	return func() { m.fire = nil }
+}
+
+func TestLongPress_BeatsTapAfterDeadline(t *testing.T) {
+	arena := NewGestureArena()
+	timer := &manualTimer{}
+	longPress := NewLongPressGestureRecognizer(arena, timer.start)
+	tap := NewTapGestureRecognizer(arena)
+
+	var pressed []LongPressDetails
+	var tapped bool
+	longPress.OnLongPress = func(d LongPressDetails) { pressed = append(pressed, d) }
+	tap.OnTap = func() { tapped = true }
+
+	down := PointerEvent{PointerID: 1, Position: graphics.Offset{X: 50, Y: 50}, Phase: PointerPhaseDown}
+	longPress.AddPointer(down)
+	tap.AddPointer(down)
+	arena.Close(1)
+
+	// A small move within the slop keeps the press alive.
+	move := PointerEvent{PointerID: 1, Position: graphics.Offset{X: 54, Y: 50}, Phase: PointerPhaseMove}
+	longPress.HandleEvent(move)
+	tap.HandleEvent(move)
+
+	timer.fire()
+	up := PointerEvent{PointerID: 1, Position: graphics.Offset{X: 54, Y: 50}, Phase: PointerPhaseUp}
+	longPress.HandleEvent(up)
+	tap.HandleEvent(up)
+
+	if len(pressed) != 1 || pressed[0].Position != (graphics.Offset{X: 54, Y: 50}) {
+		t.Errorf("OnLongPress calls = %+v", pressed)
+	}
+	if tapped {
+		t.Error("Tap should NOT fire after a long press")
+	}
+}
+
+func TestLongPress_TapBeforeDeadlineCancels(t *testing.T) {
+	arena := NewGestureArena()
+	timer := &manualTimer{}
+	longPress := NewLongPressGestureRecognizer(arena, timer.start)
+	tap := NewTapGestureRecognizer(arena)
+
+	var pressed, tapped bool
+	longPress.OnLongPress = func(LongPressDetails) { pressed = true }
+	tap.OnTap = func() { tapped = true }
+
+	down := PointerEvent{PointerID: 1, Position: graphics.Offset{X: 50, Y: 50}, Phase: PointerPhaseDown}
+	longPress.AddPointer(down)
+	tap.AddPointer(down)
+	arena.Close(1)
+	up := PointerEvent{PointerID: 1, Position: graphics.Offset{X: 50, Y: 50}, Phase: PointerPhaseUp}
+	tap.HandleEvent(up)
+	longPress.HandleEvent(up)
+
+	if !tapped || pressed || timer.fire != nil {
+		t.Errorf("tapped = %v, pressed = %v, timer pending = %v", tapped, pressed, timer.fire != nil)
+	}
+}
+
+func TestLongPress_MovePastSlopCancels(t *testing.T) {
+	arena := NewGestureArena()
+	timer := &manualTimer{}
+	longPress := NewLongPressGestureRecognizer(arena, timer.start)
+	longPress.OnLongPress = func(LongPressDetails) { t.Error("OnLongPress should not be called") }
+
+	longPress.AddPointer(PointerEvent{PointerID: 1, Position: graphics.Offset{X: 50, Y: 50}, Phase: PointerPhaseDown})
+	arena.Close(1)
+	longPress.HandleEvent(PointerEvent{
+		PointerID: 1,
+		Position:  graphics.Offset{X: 50, Y: 50 + DefaultTouchSlop + 1},
+		Phase:     PointerPhaseMove,
+	})
+	if timer.fire != nil {
+		t.Error("expected the deadline to be canceled")
+	}
+}
+
 func TestDrag_HorizontalVsVertical(t *testing.T) {
 	arena := NewGestureArena()
 	horizontal := NewHorizontalDragGestureRecognizer(arena)
@@ -409,3 +495,187 @@ func TestDrag_PointerUpWithoutAcceptance(t *testing.T) {
 		t.Error("OnEnd should NOT be called without acceptance")
 	}
 }
+
+func TestEdgeDrag_BeatsHorizontalDragAtEdge(t *testing.T) {
+	arena := NewGestureArena()
+	// The scrollable is deeper in the tree, so it sees each event first.
+	scroll := NewHorizontalDragGestureRecognizer(arena)
+	edge := NewEdgeDragGestureRecognizer(arena)
+
+	var scrollStarted, edgeStarted bool
+	scroll.OnStart = func(d DragStartDetails) { scrollStarted = true }
+	edge.OnStart = func(d DragStartDetails) { edgeStarted = true }
+
+	down := PointerEvent{
+		PointerID: 1,
+		Position:  graphics.Offset{X: 5, Y: 100},
+		Phase:     PointerPhaseDown,
+	}
+	scroll.AddPointer(down)
+	edge.AddPointer(down)
+	arena.Close(1)
+
+	for _, x := range []float64{5 + DefaultTouchSlop*0.75, 5 + DefaultTouchSlop*2} {
+		move := PointerEvent{
+			PointerID: 1,
+			Position:  graphics.Offset{X: x, Y: 100},
+			Phase:     PointerPhaseMove,
+		}
+		scroll.HandleEvent(move)
+		edge.HandleEvent(move)
+	}
+
+	if !edgeStarted {
+		t.Error("Edge drag should win a rightward drag from the edge")
+	}
+	if scrollStarted {
+		t.Error("Horizontal drag should lose to the edge drag")
+	}
+}
+
+func TestEdgeDrag_RejectsLeftwardDrag(t *testing.T) {
+	arena := NewGestureArena()
+	scroll := NewHorizontalDragGestureRecognizer(arena)
+	edge := NewEdgeDragGestureRecognizer(arena)
+
+	var scrollStarted, edgeStarted bool
+	scroll.OnStart = func(d DragStartDetails) { scrollStarted = true }
+	edge.OnStart = func(d DragStartDetails) { edgeStarted = true }
+
+	down := PointerEvent{
+		PointerID: 1,
+		Position:  graphics.Offset{X: 15, Y: 100},
+		Phase:     PointerPhaseDown,
+	}
+	scroll.AddPointer(down)
+	edge.AddPointer(down)
+	arena.Close(1)
+
+	for _, x := range []float64{15 - DefaultTouchSlop*0.75, 15 - DefaultTouchSlop*1.5} {
+		move := PointerEvent{
+			PointerID: 1,
+			Position:  graphics.Offset{X: x, Y: 100},
+			Phase:     PointerPhaseMove,
+		}
+		scroll.HandleEvent(move)
+		edge.HandleEvent(move)
+	}
+
+	if edgeStarted {
+		t.Error("Edge drag should not claim a drag toward the edge")
+	}
+	if !scrollStarted {
+		t.Error("Horizontal drag should win a leftward drag")
+	}
+}
+
+func TestEdgeDrag_IgnoresPointerOutsideEdge(t *testing.T) {
+	arena := NewGestureArena()
+	edge := NewEdgeDragGestureRecognizer(arena)
+	edge.EdgeWidth = 30
+
+	var started bool
+	edge.OnStart = func(d DragStartDetails) { started = true }
+
+	edge.AddPointer(PointerEvent{
+		PointerID: 1,
+		Position:  graphics.Offset{X: 40, Y: 100},
+		Phase:     PointerPhaseDown,
+	})
+	arena.Close(1)
+	edge.HandleEvent(PointerEvent{
+		PointerID: 1,
+		Position:  graphics.Offset{X: 100, Y: 100},
+		Phase:     PointerPhaseMove,
+	})
+
+	if started {
+		t.Error("Edge drag should ignore pointers that start outside the edge zone")
+	}
+}
+
+func TestScale_PinchAndRotate(t *testing.T) {
+	arena := NewGestureArena()
+	recognizer := NewScaleGestureRecognizer(arena)
+
+	var started, ended bool
+	var last ScaleUpdateDetails
+	recognizer.OnStart = func(d ScaleStartDetails) { started = true }
+	recognizer.OnUpdate = func(d ScaleUpdateDetails) { last = d }
+	recognizer.OnEnd = func(d ScaleEndDetails) { ended = true }
+
+	recognizer.AddPointer(PointerEvent{PointerID: 1, Position: graphics.Offset{X: 100, Y: 100}, Phase: PointerPhaseDown})
+	arena.Close(1)
+	if started {
+		t.Fatal("one resting pointer should not start a scale")
+	}
+	recognizer.AddPointer(PointerEvent{PointerID: 2, Position: graphics.Offset{X: 200, Y: 100}, Phase: PointerPhaseDown})
+	arena.Close(2)
+	if !started {
+		t.Fatal("a second pointer should win the arena and start the scale")
+	}
+
+	// Spread the fingers to twice the distance, turning them a quarter turn
+	// clockwise about the center.
+	recognizer.HandleEvent(PointerEvent{PointerID: 1, Position: graphics.Offset{X: 150, Y: 0}, Phase: PointerPhaseMove})
+	recognizer.HandleEvent(PointerEvent{PointerID: 2, Position: graphics.Offset{X: 150, Y: 200}, Phase: PointerPhaseMove})
+	if math.Abs(last.Scale-2) > 1e-9 {
+		t.Errorf("Scale = %v, want 2", last.Scale)
+	}
+	if math.Abs(last.Rotation-math.Pi/2) > 1e-9 {
+		t.Errorf("Rotation = %v, want π/2", last.Rotation)
+	}
+	if last.FocalPoint != (graphics.Offset{X: 150, Y: 100}) {
+		t.Errorf("FocalPoint = %v, want (150, 100)", last.FocalPoint)
+	}
+
+	// Lifting one finger keeps the scale, and the other keeps panning.
+	recognizer.HandleEvent(PointerEvent{PointerID: 2, Position: graphics.Offset{X: 150, Y: 200}, Phase: PointerPhaseUp})
+	recognizer.HandleEvent(PointerEvent{PointerID: 1, Position: graphics.Offset{X: 160, Y: 0}, Phase: PointerPhaseMove})
+	if math.Abs(last.Scale-2) > 1e-9 || last.PointerCount != 1 {
+		t.Errorf("Scale = %v with %d pointers, want 2 with 1", last.Scale, last.PointerCount)
+	}
+	if last.FocalPointDelta != (graphics.Offset{X: 10}) {
+		t.Errorf("FocalPointDelta = %v, want (10, 0)", last.FocalPointDelta)
+	}
+
+	recognizer.HandleEvent(PointerEvent{PointerID: 1, Position: graphics.Offset{X: 160, Y: 0}, Phase: PointerPhaseUp})
+	if !ended {
+		t.Error("OnEnd should be called when the last pointer lifts")
+	}
+}
+
+func TestScale_OnePointerPansAfterSlop(t *testing.T) {
+	arena := NewGestureArena()
+	recognizer := NewScaleGestureRecognizer(arena)
+	tap := NewTapGestureRecognizer(arena)
+
+	var tapped bool
+	var updates int
+	tap.OnTap = func() { tapped = true }
+	recognizer.OnUpdate = func(d ScaleUpdateDetails) {
+		updates++
+		if d.Scale != 1 {
+			t.Errorf("Scale = %v with one pointer, want 1", d.Scale)
+		}
+	}
+
+	down := PointerEvent{PointerID: 1, Position: graphics.Offset{X: 100, Y: 100}, Phase: PointerPhaseDown}
+	tap.AddPointer(down)
+	recognizer.AddPointer(down)
+	arena.Close(1)
+
+	move := PointerEvent{PointerID: 1, Position: graphics.Offset{X: 100 + DefaultTouchSlop + 5, Y: 100}, Phase: PointerPhaseMove}
+	tap.HandleEvent(move)
+	recognizer.HandleEvent(move)
+	up := PointerEvent{PointerID: 1, Position: move.Position, Phase: PointerPhaseUp}
+	tap.HandleEvent(up)
+	recognizer.HandleEvent(up)
+
+	if tapped {
+		t.Error("tap should lose to a pan past the slop")
+	}
+	if updates != 1 {
+		t.Errorf("updates = %d, want 1", updates)
+	}
+}
@@ -0,0 +1,258 @@
+package gestures
+
+import (
+	"math"
+	"slices"
+	"time"
+
+	"github.com/go-drift/drift/pkg/graphics"
+)
+
+// ScaleStartDetails describes the start of a scale gesture.
+type ScaleStartDetails struct {
+	// FocalPoint is the global center of the pointers in contact.
+	FocalPoint graphics.Offset
+	// PointerCount is the number of pointers in contact.
+	PointerCount int
+}
+
+// ScaleUpdateDetails describes a scale gesture update.
+type ScaleUpdateDetails struct {
+	// FocalPoint is the global center of the pointers in contact.
+	FocalPoint graphics.Offset
+	// FocalPointDelta is the change in FocalPoint since the last update.
+	FocalPointDelta graphics.Offset
+	// Scale is the scale factor since the gesture started. It stays 1 while
+	// only one pointer is down.
+	Scale float64
+	// Rotation is the clockwise rotation in radians since the gesture
+	// started, measured between the first two pointers.
+	Rotation float64
+	// PointerCount is the number of pointers in contact.
+	PointerCount int
+}
+
+// ScaleEndDetails describes the end of a scale gesture.
+type ScaleEndDetails struct {
+	// Velocity is the focal point's velocity at release in pixels per second.
+	Velocity graphics.Offset
+}
+
+// ScaleGestureRecognizer detects pinch-to-zoom and two-finger rotation, and
+// pans with one or more pointers. It reports a single gesture that follows
+// the center of all pointers in contact, so fingers can be added and lifted
+// without a jump.
+//
+// The recognizer wins the arena once the focal point moves past the touch
+// slop or the pointers spread or pinch past it. A second pointer landing
+// before then wins immediately, since two fingers can't be a tap or a
+// one-finger drag.
+type ScaleGestureRecognizer struct {
+	Arena    *GestureArena
+	OnStart  func(ScaleStartDetails)
+	OnUpdate func(ScaleUpdateDetails)
+	OnEnd    func(ScaleEndDetails)
+
+	pointers map[int64]graphics.Offset
+	// order holds the pointer IDs in the order they landed; rotation is
+	// measured between the first two.
+	order    []int64
+	accepted bool
+	started  bool
+
+	initialFocal graphics.Offset
+	lastFocal    graphics.Offset
+	lastTime     time.Time
+	velocity     graphics.Offset
+	// initialSpan and initialAngle are measured when the set of pointers
+	// changes; baseScale and baseRotation carry the gesture's values across
+	// that change.
+	initialSpan  float64
+	initialAngle float64
+	baseScale    float64
+	baseRotation float64
+	scale        float64
+	rotation     float64
+}
+
+// NewScaleGestureRecognizer creates a scale recognizer.
+func NewScaleGestureRecognizer(arena *GestureArena) *ScaleGestureRecognizer {
+	return &ScaleGestureRecognizer{Arena: arena}
+}
+
+// AddPointer registers a pointer down event. Pointers landing during a
+// gesture join it.
+func (s *ScaleGestureRecognizer) AddPointer(event PointerEvent) {
+	if s.Arena == nil {
+		return
+	}
+	if len(s.pointers) == 0 {
+		s.pointers = make(map[int64]graphics.Offset)
+		s.order = s.order[:0]
+		s.accepted = false
+		s.started = false
+		s.velocity = graphics.Offset{}
+		s.scale, s.rotation = 1, 0
+		s.baseScale, s.baseRotation = 1, 0
+	}
+	s.pointers[event.PointerID] = event.Position
+	s.order = append(s.order, event.PointerID)
+	s.Arena.Add(event.PointerID, s)
+	s.Arena.Hold(event.PointerID, s)
+	s.rebase()
+	if len(s.pointers) == 1 {
+		s.initialFocal = s.lastFocal
+	}
+	if s.accepted || len(s.pointers) > 1 {
+		s.Arena.Resolve(event.PointerID, s)
+		s.resolveAll()
+	}
+}
+
+// HandleEvent processes pointer events for scale detection.
+func (s *ScaleGestureRecognizer) HandleEvent(event PointerEvent) {
+	if _, ok := s.pointers[event.PointerID]; !ok {
+		return
+	}
+	switch event.Phase {
+	case PointerPhaseMove:
+		s.pointers[event.PointerID] = event.Position
+		focal, span, angle := s.measure()
+		now := time.Now()
+		delta := graphics.Offset{X: focal.X - s.lastFocal.X, Y: focal.Y - s.lastFocal.Y}
+		if dt := now.Sub(s.lastTime).Seconds(); dt > 0 {
+			s.velocity = graphics.Offset{
+				X: s.velocity.X*0.8 + delta.X/dt*0.2,
+				Y: s.velocity.Y*0.8 + delta.Y/dt*0.2,
+			}
+		}
+		s.lastFocal = focal
+		s.lastTime = now
+		if s.initialSpan > 0 {
+			s.scale = s.baseScale * span / s.initialSpan
+		}
+		if len(s.order) > 1 {
+			// Keep the change within ±π so crossing the negative x-axis
+			// doesn't flip the rotation.
+			turn := math.Remainder(angle-s.initialAngle, 2*math.Pi)
+			s.rotation = s.baseRotation + turn
+		}
+
+		if !s.accepted {
+			moved := distance(graphics.Offset{X: focal.X - s.initialFocal.X, Y: focal.Y - s.initialFocal.Y})
+			if moved > DefaultTouchSlop || math.Abs(span-s.initialSpan) > DefaultTouchSlop {
+				s.resolveAll()
+			}
+		}
+		if s.accepted {
+			s.ensureStarted()
+			if s.OnUpdate != nil {
+				s.OnUpdate(ScaleUpdateDetails{
+					FocalPoint:      focal,
+					FocalPointDelta: delta,
+					Scale:           s.scale,
+					Rotation:        s.rotation,
+					PointerCount:    len(s.pointers),
+				})
+			}
+		}
+	case PointerPhaseUp, PointerPhaseCancel:
+		s.removePointer(event.PointerID)
+		if !s.accepted {
+			s.Arena.Reject(event.PointerID, s)
+		}
+		if len(s.pointers) == 0 {
+			if s.started && s.OnEnd != nil {
+				velocity := s.velocity
+				if event.Phase == PointerPhaseCancel {
+					velocity = graphics.Offset{}
+				}
+				s.OnEnd(ScaleEndDetails{Velocity: velocity})
+			}
+			s.pointers = nil
+		}
+	}
+}
+
+// AcceptGesture is called by the arena when this recognizer wins.
+func (s *ScaleGestureRecognizer) AcceptGesture(pointerID int64) {
+	if _, ok := s.pointers[pointerID]; !ok || s.accepted {
+		return
+	}
+	s.accepted = true
+	s.ensureStarted()
+}
+
+// RejectGesture is called by the arena when this recognizer loses.
+func (s *ScaleGestureRecognizer) RejectGesture(pointerID int64) {
+	if _, ok := s.pointers[pointerID]; !ok {
+		return
+	}
+	s.removePointer(pointerID)
+	if len(s.pointers) == 0 {
+		s.pointers = nil
+	}
+}
+
+// Dispose releases resources for the recognizer.
+func (s *ScaleGestureRecognizer) Dispose() {}
+
+// resolveAll claims every pointer in contact.
+func (s *ScaleGestureRecognizer) resolveAll() {
+	for _, id := range slices.Clone(s.order) {
+		s.Arena.Resolve(id, s)
+	}
+}
+
+func (s *ScaleGestureRecognizer) removePointer(pointerID int64) {
+	delete(s.pointers, pointerID)
+	if i := slices.Index(s.order, pointerID); i >= 0 {
+		s.order = slices.Delete(s.order, i, i+1)
+	}
+	if len(s.pointers) > 0 {
+		s.rebase()
+	}
+}
+
+// rebase restarts the span and angle measurements from the current pointers
+// so a pointer landing or lifting doesn't change the scale or rotation.
+func (s *ScaleGestureRecognizer) rebase() {
+	focal, span, angle := s.measure()
+	s.lastFocal = focal
+	s.lastTime = time.Now()
+	s.initialSpan = span
+	s.initialAngle = angle
+	s.baseScale = s.scale
+	s.baseRotation = s.rotation
+}
+
+// measure returns the pointers' center, their average distance from it,
+// and the angle of the line from the first pointer to the second.
+func (s *ScaleGestureRecognizer) measure() (focal graphics.Offset, span, angle float64) {
+	n := float64(len(s.pointers))
+	if n == 0 {
+		return graphics.Offset{}, 0, 0
+	}
+	for _, p := range s.pointers {
+		focal.X += p.X / n
+		focal.Y += p.Y / n
+	}
+	for _, p := range s.pointers {
+		span += distance(graphics.Offset{X: p.X - focal.X, Y: p.Y - focal.Y}) / n
+	}
+	if len(s.order) > 1 {
+		a, b := s.pointers[s.order[0]], s.pointers[s.order[1]]
+		angle = math.Atan2(b.Y-a.Y, b.X-a.X)
+	}
+	return focal, span, angle
+}
+
+func (s *ScaleGestureRecognizer) ensureStarted() {
+	if s.started {
+		return
+	}
+	s.started = true
+	if s.OnStart != nil {
+		s.OnStart(ScaleStartDetails{FocalPoint: s.lastFocal, PointerCount: len(s.pointers)})
+	}
+}
@@ -10,6 +10,8 @@ import (
 type FilterQuality int
 
 const (
+	FilterQualityDefault FilterQuality = -1 // Global default from RenderQuality
+
 	FilterQualityNone   FilterQuality = iota // Nearest neighbor (pixelated)
 	FilterQualityLow                         // Bilinear
 	FilterQualityMedium                      // Bilinear + mipmaps
@@ -19,6 +21,8 @@ const (
 // String returns a human-readable representation of the filter quality.
 func (q FilterQuality) String() string {
 	switch q {
+	case FilterQualityDefault:
+		return "default"
 	case FilterQualityNone:
 		return "none"
 	case FilterQualityLow:
@@ -136,6 +140,16 @@ type Canvas interface {
 	// DrawPath draws a path with the provided paint.
 	DrawPath(path *Path, paint Paint)
 
+	// DrawVertices draws a triangle mesh.
+	//
+	// If image is non-nil, the mesh is textured with it, using the vertices'
+	// texture coordinates in image pixels; otherwise a paint Shader is
+	// sampled at the texture coordinates. Vertex colors multiply the texture,
+	// or color the mesh directly when there is none. Without colors or a
+	// texture the mesh is filled with the paint color. The paint's Alpha and
+	// BlendMode apply; its Style is ignored. Invalid meshes are not drawn.
+	DrawVertices(vertices *Vertices, image image.Image, paint Paint)
+
 	// DrawRectShadow draws a shadow behind a rectangle.
 	DrawRectShadow(rect Rect, shadow BoxShadow)
 
@@ -165,6 +179,11 @@ type Canvas interface {
 	// The animation is positioned at bounds.Left/Top and sized to bounds width/height.
 	DrawLottie(animPtr unsafe.Pointer, bounds Rect, t float64)
 
+	// DrawRive renders the current state of a Rive artboard within the given bounds.
+	// rivePtr must be the C handle from Rive.Ptr(), not a Go pointer.
+	// The artboard is scaled to fit the bounds and centered.
+	DrawRive(rivePtr unsafe.Pointer, bounds Rect)
+
 	// EmbedPlatformView records a platform view at the current canvas position.
 	// During compositing, the canvas resolves transform+clip and updates native geometry.
 	EmbedPlatformView(viewID int64, size Size)
@@ -0,0 +1,30 @@
+package graphics
+
+import "fmt"
+
+// ColorSpace identifies the RGB color space that color values are
+// specified in or that a rendering surface is tagged with.
+type ColorSpace int
+
+const (
+	// ColorSpaceSRGB is the standard web and UI color space, supported by
+	// every display.
+	ColorSpaceSRGB ColorSpace = iota
+	// ColorSpaceDisplayP3 is the wide gamut used by modern phone displays.
+	// It covers about 25% more colors than sRGB, mostly saturated reds and
+	// greens. P3 colors shown on an sRGB surface are mapped to the nearest
+	// sRGB color.
+	ColorSpaceDisplayP3
+)
+
+// String returns a human-readable representation of the color space.
+func (s ColorSpace) String() string {
+	switch s {
+	case ColorSpaceSRGB:
+		return "sRGB"
+	case ColorSpaceDisplayP3:
+		return "Display P3"
+	default:
+		return fmt.Sprintf("ColorSpace(%d)", int(s))
+	}
+}
@@ -182,7 +182,7 @@ func (b *commandBuffer) writeClear(color Color) {
 }
 
 // writePaint encodes paint parameters used by draw ops.
-// Format: color_bits, style, strokeWidth, cap, join, miter, blend, alpha,
+// Format: color_bits, style, strokeWidth, cap, join, miter, blend, alpha, flags,
 //
 //	dash_count, [dash_intervals..., dash_phase],
 //	has_gradient(0/1), [gradient_type, x1,y1,x2,y2, cx,cy,radius, stop_count, colors..., positions...]
@@ -197,6 +197,7 @@ func (b *commandBuffer) writePaint(paint Paint, shapeBounds Rect) {
 	b.write(miter)
 	b.write(float32(blend))
 	b.write(alpha)
+	b.write(float32(paintFlags(paint)))
 
 	// Dash encoding
 	b.write(float32(len(dash)))
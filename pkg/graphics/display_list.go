@@ -77,6 +77,26 @@ func (r *PictureRecorder) EndRecording() *DisplayList {
 	}
 }
 
+// RecordPicture records the drawing made by draw into a display list of the
+// given size. Replaying the result repeats the drawing without running draw
+// again, which suits expensive drawings that rarely change:
+//
+//	logo := graphics.RecordPicture(size, func(canvas graphics.Canvas) {
+//	    for _, p := range paths {
+//	        canvas.DrawPath(p.Path, p.Paint)
+//	    }
+//	})
+//	...
+//	logo.Paint(canvas)
+func RecordPicture(size Size, draw func(canvas Canvas)) *DisplayList {
+	var recorder PictureRecorder
+	canvas := recorder.BeginRecording(size)
+	if draw != nil {
+		draw(canvas)
+	}
+	return recorder.EndRecording()
+}
+
 func (r *PictureRecorder) append(op displayOp) {
 	if !r.recording {
 		return
@@ -120,6 +140,15 @@ func (c *recordingCanvas) SaveLayer(bounds Rect, paint *Paint) {
 	c.recorder.append(opSaveLayer{bounds: bounds, paint: paintCopy})
 }
 
+// recordedPaint copies the shader's uniforms so later changes to the shader
+// do not affect the recording.
+func recordedPaint(paint Paint) Paint {
+	if paint.Shader != nil {
+		paint.Shader = paint.Shader.Copy()
+	}
+	return paint
+}
+
 func (c *recordingCanvas) Restore() {
 	c.recorder.append(opRestore{})
 }
@@ -153,19 +182,19 @@ func (c *recordingCanvas) Clear(color Color) {
 }
 
 func (c *recordingCanvas) DrawRect(rect Rect, paint Paint) {
-	c.recorder.append(opRect{rect: rect, paint: paint})
+	c.recorder.append(opRect{rect: rect, paint: recordedPaint(paint)})
 }
 
 func (c *recordingCanvas) DrawRRect(rrect RRect, paint Paint) {
-	c.recorder.append(opRRect{rrect: rrect, paint: paint})
+	c.recorder.append(opRRect{rrect: rrect, paint: recordedPaint(paint)})
 }
 
 func (c *recordingCanvas) DrawCircle(center Offset, radius float64, paint Paint) {
-	c.recorder.append(opCircle{center: center, radius: radius, paint: paint})
+	c.recorder.append(opCircle{center: center, radius: radius, paint: recordedPaint(paint)})
 }
 
 func (c *recordingCanvas) DrawLine(start, end Offset, paint Paint) {
-	c.recorder.append(opLine{start: start, end: end, paint: paint})
+	c.recorder.append(opLine{start: start, end: end, paint: recordedPaint(paint)})
 }
 
 func (c *recordingCanvas) DrawText(layout *TextLayout, position Offset) {
@@ -181,7 +210,11 @@ func (c *recordingCanvas) DrawImageRect(img image.Image, srcRect, dstRect Rect,
 }
 
 func (c *recordingCanvas) DrawPath(path *Path, paint Paint) {
-	c.recorder.append(opPath{path: CopyPath(path), paint: paint})
+	c.recorder.append(opPath{path: CopyPath(path), paint: recordedPaint(paint)})
+}
+
+func (c *recordingCanvas) DrawVertices(vertices *Vertices, image image.Image, paint Paint) {
+	c.recorder.append(opVertices{vertices: copyVertices(vertices), image: image, paint: recordedPaint(paint)})
 }
 
 func (c *recordingCanvas) DrawRectShadow(rect Rect, shadow BoxShadow) {
@@ -214,6 +247,10 @@ func (c *recordingCanvas) DrawLottie(animPtr unsafe.Pointer, bounds Rect, t floa
 	c.recorder.append(opLottie{animPtr: animPtr, bounds: bounds, t: t})
 }
 
+func (c *recordingCanvas) DrawRive(rivePtr unsafe.Pointer, bounds Rect) {
+	c.recorder.append(opRive{rivePtr: rivePtr, bounds: bounds})
+}
+
 func (c *recordingCanvas) EmbedPlatformView(viewID int64, size Size) {
 	c.recorder.append(opEmbedPlatformView{viewID: viewID, size: size})
 }
@@ -437,6 +474,16 @@ func (op opPath) execute(canvas Canvas) {
 	canvas.DrawPath(op.path, op.paint)
 }
 
+type opVertices struct {
+	vertices *Vertices
+	image    image.Image
+	paint    Paint
+}
+
+func (op opVertices) execute(canvas Canvas) {
+	canvas.DrawVertices(op.vertices, op.image, op.paint)
+}
+
 type opRectShadow struct {
 	rect   Rect
 	shadow BoxShadow
@@ -493,3 +540,12 @@ type opLottie struct {
 func (op opLottie) execute(canvas Canvas) {
 	canvas.DrawLottie(op.animPtr, op.bounds, op.t)
 }
+
+type opRive struct {
+	rivePtr unsafe.Pointer
+	bounds  Rect
+}
+
+func (op opRive) execute(canvas Canvas) {
+	canvas.DrawRive(op.rivePtr, op.bounds)
+}
@@ -67,12 +67,32 @@ func (d *DisplayList) replayBatched(sc *SkiaCanvas) {
 		case opClear:
 			buf.writeClear(o.color)
 		case opRect:
+			if o.paint.Shader != nil {
+				flush()
+				sc.DrawRect(o.rect, o.paint)
+				continue
+			}
 			buf.writeDrawRect(o.rect, o.paint)
 		case opRRect:
+			if o.paint.Shader != nil {
+				flush()
+				sc.DrawRRect(o.rrect, o.paint)
+				continue
+			}
 			buf.writeDrawRRect(o.rrect, o.paint)
 		case opCircle:
+			if o.paint.Shader != nil {
+				flush()
+				sc.DrawCircle(o.center, o.radius, o.paint)
+				continue
+			}
 			buf.writeDrawCircle(o.center, o.radius, o.paint)
 		case opLine:
+			if o.paint.Shader != nil {
+				flush()
+				sc.DrawLine(o.start, o.end, o.paint)
+				continue
+			}
 			buf.writeDrawLine(o.start, o.end, o.paint)
 		case opRectShadow:
 			buf.writeDrawRectShadow(o.rect, o.shadow)
@@ -88,6 +108,9 @@ func (d *DisplayList) replayBatched(sc *SkiaCanvas) {
 			buf.writeLottie(o.animPtr, o.bounds, o.t)
 
 		// Non-batchable ops: flush buffer, execute directly, resume
+		case opRive:
+			flush()
+			sc.DrawRive(o.rivePtr, o.bounds)
 		case opClipPath:
 			flush()
 			sc.ClipPath(o.path, o.op, o.antialias)
@@ -106,6 +129,9 @@ func (d *DisplayList) replayBatched(sc *SkiaCanvas) {
 		case opPath:
 			flush()
 			sc.DrawPath(o.path, o.paint)
+		case opVertices:
+			flush()
+			sc.DrawVertices(o.vertices, o.image, o.paint)
 
 		// Platform view ops: no-op on SkiaCanvas, skip entirely
 		case opEmbedPlatformView:
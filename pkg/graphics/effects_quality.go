@@ -0,0 +1,48 @@
+package graphics
+
+import (
+	"fmt"
+	"sync/atomic"
+)
+
+// EffectsQuality controls whether expensive visual effects are rasterized.
+type EffectsQuality int
+
+const (
+	// EffectsQualityFull renders every effect as recorded.
+	EffectsQualityFull EffectsQuality = iota
+	// EffectsQualityReduced skips box shadows and replaces backdrop blur
+	// layers with plain saves. Used by the engine's power saving mode.
+	EffectsQualityReduced
+)
+
+// String returns a human-readable representation of the effects quality.
+func (q EffectsQuality) String() string {
+	switch q {
+	case EffectsQualityFull:
+		return "full"
+	case EffectsQualityReduced:
+		return "reduced"
+	default:
+		return fmt.Sprintf("EffectsQuality(%d)", int(q))
+	}
+}
+
+var effectsQuality atomic.Int32
+
+// SetEffectsQuality sets the global effects quality. The setting is applied
+// when recorded layers are composited, so it takes effect on the next frame
+// without re-recording any content.
+func SetEffectsQuality(q EffectsQuality) {
+	effectsQuality.Store(int32(q))
+}
+
+// GetEffectsQuality returns the current global effects quality.
+func GetEffectsQuality() EffectsQuality {
+	return EffectsQuality(effectsQuality.Load())
+}
+
+// effectsReduced reports whether shadows and blur should be skipped.
+func effectsReduced() bool {
+	return GetEffectsQuality() == EffectsQualityReduced
+}
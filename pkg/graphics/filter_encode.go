@@ -10,6 +10,7 @@ const (
 	imageFilterTypeBlur        float32 = 0
 	imageFilterTypeDropShadow  float32 = 1
 	imageFilterTypeColorFilter float32 = 2
+	imageFilterTypeShader      float32 = 3
 )
 
 // encodeColorFilter serializes a ColorFilter to a float32 slice for the C bridge.
@@ -63,6 +64,7 @@ func encodeColorFilter(cf *ColorFilter) []float32 {
 //	Type 0 (Blur): [0, sigma_x, sigma_y, tile_mode, input_len, ...input]
 //	Type 1 (DropShadow): [1, dx, dy, sigma_x, sigma_y, color_bits, shadow_only, input_len, ...input]
 //	Type 2 (ColorFilter): [2, cf_len, ...cf_encoding, input_len, ...input]
+//	Type 3 (Shader): [3, effect_ptr_lo, effect_ptr_hi, uniform_len, ...uniforms, input_len, ...input]
 func encodeImageFilter(imf *ImageFilter) []float32 {
 	if imf == nil {
 		return nil
@@ -96,6 +98,17 @@ func encodeImageFilter(imf *ImageFilter) []float32 {
 		result = append(result, float32(len(cfData)))
 		result = append(result, cfData...)
 
+	case ImageFilterShader:
+		effect := imf.Shader.effectPtr()
+		if effect == nil {
+			return nil
+		}
+		v := uintptr(effect)
+		result = append(result, imageFilterTypeShader)
+		result = append(result, math.Float32frombits(uint32(v)), math.Float32frombits(uint32(v>>32)))
+		result = append(result, float32(len(imf.Shader.uniforms)))
+		result = append(result, imf.Shader.uniforms...)
+
 	default:
 		return nil
 	}
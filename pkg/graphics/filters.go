@@ -71,6 +71,11 @@ const (
 	// ImageFilterColorFilter applies a ColorFilter as an image filter.
 	// Requires the ColorFilter field to be set.
 	ImageFilterColorFilter
+
+	// ImageFilterShader runs a FragmentShader over the content, which the
+	// shader samples through its single child shader.
+	// Requires the Shader field to be set.
+	ImageFilterShader
 )
 
 // TileMode specifies how an image filter handles pixels outside its bounds.
@@ -138,6 +143,9 @@ type ImageFilter struct {
 	// ColorFilter is the filter to apply for ImageFilterColorFilter.
 	ColorFilter *ColorFilter
 
+	// Shader is the fragment shader to run for ImageFilterShader.
+	Shader *FragmentShader
+
 	// Input is an optional filter to apply before this one.
 	// Used for filter composition chains.
 	Input *ImageFilter
@@ -332,6 +340,25 @@ func NewImageFilterFromColorFilter(cf ColorFilter) ImageFilter {
 	}
 }
 
+// NewShaderImageFilter creates an image filter that runs a fragment shader
+// over the content. The shader must declare exactly one child shader, through
+// which it samples the content:
+//
+//	uniform shader content;
+//
+//	half4 main(float2 coord) {
+//	    return content.eval(coord).bgra;
+//	}
+//
+// The shader's current uniform values are captured; later changes to the
+// shader do not affect the filter.
+func NewShaderImageFilter(shader *FragmentShader) ImageFilter {
+	return ImageFilter{
+		Type:   ImageFilterShader,
+		Shader: shader.Copy(),
+	}
+}
+
 // WithTileMode returns a copy of the filter with the specified tile mode.
 //
 // Tile mode only affects ImageFilterBlur. Other filter types ignore it.
@@ -362,7 +389,8 @@ func (cf *ColorFilter) clone() *ColorFilter {
 	return &c
 }
 
-// clone returns a deep copy of the ImageFilter, including Input chain and nested ColorFilter.
+// clone returns a deep copy of the ImageFilter, including Input chain, nested
+// ColorFilter, and shader uniforms.
 func (imf *ImageFilter) clone() *ImageFilter {
 	if imf == nil {
 		return nil
@@ -370,5 +398,6 @@ func (imf *ImageFilter) clone() *ImageFilter {
 	c := *imf
 	c.Input = imf.Input.clone()
 	c.ColorFilter = imf.ColorFilter.clone()
+	c.Shader = imf.Shader.Copy()
 	return &c
 }
@@ -0,0 +1,127 @@
+package graphics
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"sync"
+)
+
+// FontSource fetches the bytes of a TrueType or OpenType font file.
+type FontSource func(ctx context.Context) ([]byte, error)
+
+// FontBytes returns a source for font data already in memory.
+func FontBytes(data []byte) FontSource {
+	return func(context.Context) ([]byte, error) {
+		return data, nil
+	}
+}
+
+// FontFS returns a source that reads a font file from fsys, such as an
+// [embed.FS] holding the app's assets.
+func FontFS(fsys fs.FS, name string) FontSource {
+	return func(context.Context) ([]byte, error) {
+		return fs.ReadFile(fsys, name)
+	}
+}
+
+// FontURL returns a source that downloads a font file over HTTP(S). A nil
+// client uses [http.DefaultClient].
+func FontURL(client *http.Client, url string) FontSource {
+	return func(ctx context.Context) ([]byte, error) {
+		if client == nil {
+			client = http.DefaultClient
+		}
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+			return nil, fmt.Errorf("font: HTTP %d for %q", resp.StatusCode, url)
+		}
+		return io.ReadAll(resp.Body)
+	}
+}
+
+// FontLoader registers the fonts of one family at runtime, for fonts that
+// are downloaded or read from assets after the app starts.
+//
+// Text may name the family before it loads; it is drawn with the platform
+// font until then, and laid out again with the loaded font once Load
+// registers it.
+//
+//	err := graphics.NewFontLoader("Inter").
+//	    AddFont(graphics.FontFS(assets, "fonts/Inter-Regular.ttf"), graphics.FontWeightNormal, graphics.FontStyleNormal).
+//	    AddFont(graphics.FontURL(nil, boldURL), graphics.FontWeightBold, graphics.FontStyleNormal).
+//	    Load(ctx)
+type FontLoader struct {
+	family string
+	fonts  []loaderFont
+}
+
+type loaderFont struct {
+	source FontSource
+	weight FontWeight
+	style  FontStyle
+}
+
+// NewFontLoader creates a loader for the fonts of family.
+func NewFontLoader(family string) *FontLoader {
+	return &FontLoader{family: family}
+}
+
+// Family returns the family the loader registers fonts under.
+func (l *FontLoader) Family() string {
+	return l.family
+}
+
+// AddFont adds a font file providing weight and style of the family. It
+// returns the loader for chaining.
+func (l *FontLoader) AddFont(source FontSource, weight FontWeight, style FontStyle) *FontLoader {
+	l.fonts = append(l.fonts, loaderFont{source: source, weight: weight, style: style})
+	return l
+}
+
+// Load fetches the added fonts concurrently and registers them with
+// [DefaultFontManager]. Fonts that fail to fetch or register are skipped and
+// their errors joined in the result; the others are still registered. Load
+// blocks, so call it from a goroutine or an app init hook.
+func (l *FontLoader) Load(ctx context.Context) error {
+	if l.family == "" {
+		return stderrors.New("font: family required")
+	}
+	manager, err := DefaultFontManagerErr()
+	if err != nil {
+		return err
+	}
+
+	data := make([][]byte, len(l.fonts))
+	errs := make([]error, len(l.fonts))
+	var wg sync.WaitGroup
+	for i, font := range l.fonts {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			data[i], errs[i] = font.source(ctx)
+		}()
+	}
+	wg.Wait()
+
+	for i, font := range l.fonts {
+		if errs[i] == nil {
+			errs[i] = manager.RegisterFontStyle(l.family, data[i], font.weight, font.style)
+		}
+		if errs[i] != nil {
+			errs[i] = fmt.Errorf("font %s %d %s: %w", l.family, font.weight, font.style, errs[i])
+		}
+	}
+	return stderrors.Join(errs...)
+}
@@ -0,0 +1,103 @@
+package graphics
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+func TestFontSources(t *testing.T) {
+	ctx := context.Background()
+
+	if data, err := FontBytes([]byte("font"))(ctx); err != nil || string(data) != "font" {
+		t.Errorf("FontBytes = %q, %v", data, err)
+	}
+
+	fsys := fstest.MapFS{"fonts/Inter.ttf": {Data: []byte("inter")}}
+	if data, err := FontFS(fsys, "fonts/Inter.ttf")(ctx); err != nil || string(data) != "inter" {
+		t.Errorf("FontFS = %q, %v", data, err)
+	}
+	if _, err := FontFS(fsys, "fonts/Missing.ttf")(ctx); err == nil {
+		t.Error("FontFS: expected error for a missing file")
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/Inter.ttf" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte("remote"))
+	}))
+	defer server.Close()
+	if data, err := FontURL(nil, server.URL+"/Inter.ttf")(ctx); err != nil || string(data) != "remote" {
+		t.Errorf("FontURL = %q, %v", data, err)
+	}
+	if _, err := FontURL(nil, server.URL+"/Missing.ttf")(ctx); err == nil || !strings.Contains(err.Error(), "404") {
+		t.Errorf("FontURL: expected HTTP 404 error, got %v", err)
+	}
+}
+
+func TestFontLoader_JoinsErrors(t *testing.T) {
+	failed := errors.New("offline")
+	err := NewFontLoader("Inter").
+		AddFont(func(context.Context) ([]byte, error) { return nil, failed }, FontWeightBold, FontStyleItalic).
+		Load(context.Background())
+	if !errors.Is(err, failed) {
+		t.Fatalf("Load error = %v, want it to wrap %v", err, failed)
+	}
+	if !strings.Contains(err.Error(), "Inter 700 italic") {
+		t.Errorf("Load error %q does not name the font", err)
+	}
+
+	if err := NewFontLoader("").Load(context.Background()); err == nil {
+		t.Error("expected error without a family")
+	}
+}
+
+func TestFontManager_Listeners(t *testing.T) {
+	manager, _ := NewFontManager()
+	var got []string
+	remove := manager.AddListener(func(family string) { got = append(got, family) })
+
+	manager.fontRegistered("Inter")
+	remove()
+	manager.fontRegistered("Fraunces")
+
+	if len(got) != 1 || got[0] != "Inter" {
+		t.Errorf("listener saw %q, want [Inter]", got)
+	}
+}
+
+func TestAddFontListener(t *testing.T) {
+	manager, _ := NewFontManager()
+	var got []string
+	remove := AddFontListener(func(family string) { got = append(got, family) })
+
+	manager.fontRegistered("Inter")
+	remove()
+	manager.fontRegistered("Fraunces")
+
+	if len(got) != 1 || got[0] != "Inter" {
+		t.Errorf("listener saw %q, want [Inter]", got)
+	}
+}
+
+func TestTextLayout_UsesFamily(t *testing.T) {
+	plain := &TextLayout{Style: TextStyle{FontFamily: "Inter"}}
+	if !plain.UsesFamily("Inter") || plain.UsesFamily("Fraunces") {
+		t.Error("plain layout should use only its style's family")
+	}
+
+	rich := &TextLayout{families: spanFamilies([]flatSpan{
+		{text: "a", style: SpanStyle{FontFamily: "Inter"}},
+		{text: "b", style: SpanStyle{FontFamily: "Fraunces"}},
+		{text: "c", style: SpanStyle{FontFamily: "Inter"}},
+	})}
+	if len(rich.families) != 2 || !rich.UsesFamily("Fraunces") || rich.UsesFamily("Lora") {
+		t.Errorf("rich layout families = %q", rich.families)
+	}
+}
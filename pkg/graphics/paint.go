@@ -154,6 +154,11 @@ type Paint struct {
 	// but wanting the gradient to align to the original widget bounds.
 	GradientBounds *Rect
 
+	// Shader colors the shape with a custom fragment shader. If set, it
+	// overrides Color and Gradient; Alpha still applies. Shaders apply to
+	// rects, rounded rects, circles, lines, and paths, not to text or images.
+	Shader *FragmentShader
+
 	Style       PaintStyle // Fill, stroke, or both
 	StrokeWidth float64    // Width of stroke in pixels
 
@@ -178,6 +183,21 @@ type Paint struct {
 	// Use with SaveLayer to apply blur, drop shadow, or other effects to
 	// grouped content.
 	ImageFilter *ImageFilter
+
+	// Antialias overrides the global antialiasing default (see
+	// [RenderQuality]) for shapes drawn with this paint.
+	Antialias QualityOverride
+
+	// Dither overrides the global dithering default (see [RenderQuality]).
+	// QualityOn dithers any shape; the global default only dithers gradients.
+	Dither QualityOverride
+
+	// ColorSpace is the color space Color is specified in. The zero value is
+	// sRGB. Use [ColorSpaceDisplayP3] for colors outside the sRGB gamut, such
+	// as saturated brand colors; they render exactly on wide gamut surfaces
+	// and are mapped to the nearest sRGB color elsewhere. Gradient, shadow,
+	// and text colors are always sRGB.
+	ColorSpace ColorSpace
 }
 
 // DefaultPaint returns a basic opaque white fill paint with standard compositing.
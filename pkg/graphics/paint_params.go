@@ -37,3 +37,28 @@ func paintParams(paint Paint) (cap, join int32, miter float32, dash []float32, d
 	}
 	return
 }
+
+// Paint flags passed to the Skia bridge.
+const (
+	paintFlagAntialias = 1 << 0
+	paintFlagDither    = 1 << 1
+	paintFlagDisplayP3 = 1 << 2
+)
+
+// paintFlags resolves the paint's antialiasing and dithering against the
+// global defaults, and tags Display P3 colors. Default dithering applies
+// only to gradients, where banding is visible.
+func paintFlags(paint Paint) int32 {
+	q := GetRenderQuality()
+	var flags int32
+	if paint.Antialias == QualityOn || (paint.Antialias == QualityDefault && q.Antialias) {
+		flags |= paintFlagAntialias
+	}
+	if paint.Dither == QualityOn || (paint.Dither == QualityDefault && q.Dither && paint.Gradient != nil) {
+		flags |= paintFlagDither
+	}
+	if paint.ColorSpace == ColorSpaceDisplayP3 {
+		flags |= paintFlagDisplayP3
+	}
+	return flags
+}
@@ -0,0 +1,384 @@
+package graphics
+
+import (
+	"math"
+	"sort"
+)
+
+// Tangent is a position on a path and the direction of travel there.
+type Tangent struct {
+	// Position is the point on the path.
+	Position Offset
+	// Vector is the unit-length direction of the path at Position.
+	Vector Offset
+}
+
+// Angle returns the direction of the tangent in radians, measured clockwise
+// from the positive x axis.
+func (t Tangent) Angle() float64 {
+	return math.Atan2(t.Vector.Y, t.Vector.X)
+}
+
+// PathMetric measures one contour of a [Path]. Obtain metrics with
+// [Path.ComputeMetrics].
+//
+// Metrics are computed in Go and work on every platform. Curve lengths are
+// approximated by flattening, which is accurate to well under a pixel for
+// on-screen geometry.
+type PathMetric struct {
+	// Length is the total length of the contour.
+	Length float64
+	// Closed reports whether the contour ends with a close command. The
+	// closing line back to the start is included in Length.
+	Closed bool
+	// ContourIndex is the index of this contour among the path's non-empty
+	// contours.
+	ContourIndex int
+
+	segments []metricSegment
+}
+
+type metricSegmentKind int
+
+const (
+	metricLine metricSegmentKind = iota
+	metricQuad
+	metricCubic
+)
+
+// metricSegment is one line or curve in a contour. For curves, samples maps
+// parameter values to lengths from the start of the segment.
+type metricSegment struct {
+	kind    metricSegmentKind
+	points  [4]Offset
+	start   float64
+	length  float64
+	samples []metricSample
+}
+
+type metricSample struct {
+	t, length float64
+}
+
+// ComputeMetrics measures each contour of the path. Contours of zero length
+// are skipped.
+//
+//	metrics := path.ComputeMetrics()
+//	if len(metrics) > 0 {
+//	    m := metrics[0]
+//	    if tangent, ok := m.TangentForOffset(m.Length * progress); ok {
+//	        // place a marker at tangent.Position, rotated by tangent.Angle()
+//	    }
+//	}
+func (p *Path) ComputeMetrics() []*PathMetric {
+	if p == nil {
+		return nil
+	}
+	var metrics []*PathMetric
+	var current *PathMetric
+	var start, pen Offset
+
+	finish := func() {
+		if current != nil && current.Length > 0 {
+			current.ContourIndex = len(metrics)
+			metrics = append(metrics, current)
+		}
+		current = nil
+	}
+	add := func(seg metricSegment) {
+		if current == nil {
+			current = &PathMetric{}
+		}
+		seg.start = current.Length
+		seg.measure()
+		if seg.length > 0 {
+			current.segments = append(current.segments, seg)
+			current.Length += seg.length
+		}
+	}
+
+	for _, cmd := range p.Commands {
+		switch cmd.Op {
+		case PathOpMoveTo:
+			if len(cmd.Args) < 2 {
+				continue
+			}
+			finish()
+			start = Offset{X: cmd.Args[0], Y: cmd.Args[1]}
+			pen = start
+		case PathOpLineTo:
+			if len(cmd.Args) < 2 {
+				continue
+			}
+			end := Offset{X: cmd.Args[0], Y: cmd.Args[1]}
+			add(metricSegment{kind: metricLine, points: [4]Offset{pen, end}})
+			pen = end
+		case PathOpQuadTo:
+			if len(cmd.Args) < 4 {
+				continue
+			}
+			ctrl := Offset{X: cmd.Args[0], Y: cmd.Args[1]}
+			end := Offset{X: cmd.Args[2], Y: cmd.Args[3]}
+			add(metricSegment{kind: metricQuad, points: [4]Offset{pen, ctrl, end}})
+			pen = end
+		case PathOpCubicTo:
+			if len(cmd.Args) < 6 {
+				continue
+			}
+			c1 := Offset{X: cmd.Args[0], Y: cmd.Args[1]}
+			c2 := Offset{X: cmd.Args[2], Y: cmd.Args[3]}
+			end := Offset{X: cmd.Args[4], Y: cmd.Args[5]}
+			add(metricSegment{kind: metricCubic, points: [4]Offset{pen, c1, c2, end}})
+			pen = end
+		case PathOpClose:
+			add(metricSegment{kind: metricLine, points: [4]Offset{pen, start}})
+			if current != nil {
+				current.Closed = true
+			}
+			finish()
+			pen = start
+		}
+	}
+	finish()
+	return metrics
+}
+
+// TangentForOffset returns the position and direction at distance along the
+// contour. Distances outside [0, Length] are clamped. Returns false if the
+// contour is empty.
+func (m *PathMetric) TangentForOffset(distance float64) (Tangent, bool) {
+	if m == nil || len(m.segments) == 0 {
+		return Tangent{}, false
+	}
+	distance = clampFloat(distance, 0, m.Length)
+	seg := &m.segments[m.segmentAt(distance)]
+	t := seg.paramAt(distance - seg.start)
+	return Tangent{Position: seg.point(t), Vector: seg.direction(t)}, true
+}
+
+// ExtractPath returns the part of the contour between the start and end
+// distances as a new path beginning with a MoveTo. Distances are clamped to
+// [0, Length]; an empty path is returned if start >= end.
+func (m *PathMetric) ExtractPath(start, end float64) *Path {
+	out := NewPath()
+	if m == nil || len(m.segments) == 0 {
+		return out
+	}
+	start = clampFloat(start, 0, m.Length)
+	end = clampFloat(end, 0, m.Length)
+	if start >= end {
+		return out
+	}
+
+	first := m.segmentAt(start)
+	last := m.segmentAt(end)
+	for i := first; i <= last; i++ {
+		seg := &m.segments[i]
+		t0, t1 := 0.0, 1.0
+		if i == first {
+			t0 = seg.paramAt(start - seg.start)
+		}
+		if i == last {
+			t1 = seg.paramAt(end - seg.start)
+		}
+		if t1 <= t0 {
+			continue
+		}
+		pts := seg.subdivide(t0, t1)
+		if out.IsEmpty() {
+			out.MoveTo(pts[0].X, pts[0].Y)
+		}
+		switch seg.kind {
+		case metricLine:
+			out.LineTo(pts[1].X, pts[1].Y)
+		case metricQuad:
+			out.QuadTo(pts[1].X, pts[1].Y, pts[2].X, pts[2].Y)
+		case metricCubic:
+			out.CubicTo(pts[1].X, pts[1].Y, pts[2].X, pts[2].Y, pts[3].X, pts[3].Y)
+		}
+	}
+	return out
+}
+
+// segmentAt returns the index of the segment containing distance.
+func (m *PathMetric) segmentAt(distance float64) int {
+	i := sort.Search(len(m.segments), func(i int) bool {
+		seg := m.segments[i]
+		return seg.start+seg.length >= distance
+	})
+	if i >= len(m.segments) {
+		i = len(m.segments) - 1
+	}
+	return i
+}
+
+// measure computes the segment length and, for curves, the sample table.
+func (s *metricSegment) measure() {
+	if s.kind == metricLine {
+		s.length = distanceBetween(s.points[0], s.points[1])
+		return
+	}
+	// The control polygon bounds the curve length, so it gives a sample
+	// count that scales with the curve's size.
+	var polygon float64
+	for i := 0; i < s.pointCount()-1; i++ {
+		polygon += distanceBetween(s.points[i], s.points[i+1])
+	}
+	n := int(clampFloat(math.Ceil(polygon), 16, 1024))
+	s.samples = make([]metricSample, n+1)
+	prev := s.points[0]
+	var length float64
+	for i := 1; i <= n; i++ {
+		t := float64(i) / float64(n)
+		pt := s.point(t)
+		length += distanceBetween(prev, pt)
+		s.samples[i] = metricSample{t: t, length: length}
+		prev = pt
+	}
+	s.length = length
+}
+
+func (s *metricSegment) pointCount() int {
+	switch s.kind {
+	case metricQuad:
+		return 3
+	case metricCubic:
+		return 4
+	default:
+		return 2
+	}
+}
+
+// paramAt maps a length from the start of the segment to a parameter value.
+func (s *metricSegment) paramAt(distance float64) float64 {
+	if s.length <= 0 {
+		return 0
+	}
+	if s.kind == metricLine {
+		return clampFloat(distance/s.length, 0, 1)
+	}
+	i := sort.Search(len(s.samples), func(i int) bool {
+		return s.samples[i].length >= distance
+	})
+	if i <= 0 {
+		return 0
+	}
+	if i >= len(s.samples) {
+		return 1
+	}
+	a, b := s.samples[i-1], s.samples[i]
+	if b.length <= a.length {
+		return b.t
+	}
+	return a.t + (b.t-a.t)*(distance-a.length)/(b.length-a.length)
+}
+
+// point evaluates the segment at parameter t.
+func (s *metricSegment) point(t float64) Offset {
+	p := s.points
+	mt := 1 - t
+	switch s.kind {
+	case metricQuad:
+		return Offset{
+			X: mt*mt*p[0].X + 2*mt*t*p[1].X + t*t*p[2].X,
+			Y: mt*mt*p[0].Y + 2*mt*t*p[1].Y + t*t*p[2].Y,
+		}
+	case metricCubic:
+		a, b, c, d := mt*mt*mt, 3*mt*mt*t, 3*mt*t*t, t*t*t
+		return Offset{
+			X: a*p[0].X + b*p[1].X + c*p[2].X + d*p[3].X,
+			Y: a*p[0].Y + b*p[1].Y + c*p[2].Y + d*p[3].Y,
+		}
+	default:
+		return lerpOffset(p[0], p[1], t)
+	}
+}
+
+// direction returns the unit tangent at parameter t. Where the derivative
+// vanishes, such as at a control point coinciding with an end point, the
+// chord toward a nearby point is used instead.
+func (s *metricSegment) direction(t float64) Offset {
+	p := s.points
+	mt := 1 - t
+	var d Offset
+	switch s.kind {
+	case metricQuad:
+		d = Offset{
+			X: 2*mt*(p[1].X-p[0].X) + 2*t*(p[2].X-p[1].X),
+			Y: 2*mt*(p[1].Y-p[0].Y) + 2*t*(p[2].Y-p[1].Y),
+		}
+	case metricCubic:
+		d = Offset{
+			X: 3*mt*mt*(p[1].X-p[0].X) + 6*mt*t*(p[2].X-p[1].X) + 3*t*t*(p[3].X-p[2].X),
+			Y: 3*mt*mt*(p[1].Y-p[0].Y) + 6*mt*t*(p[2].Y-p[1].Y) + 3*t*t*(p[3].Y-p[2].Y),
+		}
+	default:
+		d = Offset{X: p[1].X - p[0].X, Y: p[1].Y - p[0].Y}
+	}
+	if math.Hypot(d.X, d.Y) < 1e-9 {
+		const h = 1e-3
+		a, b := s.point(math.Max(0, t-h)), s.point(math.Min(1, t+h))
+		d = Offset{X: b.X - a.X, Y: b.Y - a.Y}
+	}
+	n := math.Hypot(d.X, d.Y)
+	if n == 0 {
+		return Offset{X: 1}
+	}
+	return Offset{X: d.X / n, Y: d.Y / n}
+}
+
+// subdivide returns the control points of the part of the segment between
+// parameters t0 and t1.
+func (s *metricSegment) subdivide(t0, t1 float64) [4]Offset {
+	pts := s.points
+	n := s.pointCount()
+	if t1 < 1 {
+		pts = splitBezier(pts, n, t1)
+		// The curve now spans [0, t1]; rescale t0 into that range.
+		t0 /= t1
+	}
+	if t0 > 0 {
+		pts = splitBezierTail(pts, n, t0)
+	}
+	return pts
+}
+
+// splitBezier returns the control points of the curve over [0, t].
+func splitBezier(pts [4]Offset, n int, t float64) [4]Offset {
+	var out [4]Offset
+	work := pts
+	out[0] = work[0]
+	for level := 1; level < n; level++ {
+		for i := 0; i < n-level; i++ {
+			work[i] = lerpOffset(work[i], work[i+1], t)
+		}
+		out[level] = work[0]
+	}
+	return out
+}
+
+// splitBezierTail returns the control points of the curve over [t, 1].
+func splitBezierTail(pts [4]Offset, n int, t float64) [4]Offset {
+	var out [4]Offset
+	work := pts
+	out[n-1] = work[n-1]
+	for level := 1; level < n; level++ {
+		for i := 0; i < n-level; i++ {
+			work[i] = lerpOffset(work[i], work[i+1], t)
+		}
+		out[n-1-level] = work[n-1-level]
+	}
+	return out
+}
+
+func lerpOffset(a, b Offset, t float64) Offset {
+	return Offset{X: a.X + (b.X-a.X)*t, Y: a.Y + (b.Y-a.Y)*t}
+}
+
+func distanceBetween(a, b Offset) float64 {
+	return math.Hypot(b.X-a.X, b.Y-a.Y)
+}
+
+func clampFloat(v, lo, hi float64) float64 {
+	return math.Max(lo, math.Min(hi, v))
+}
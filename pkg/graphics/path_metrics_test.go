@@ -0,0 +1,145 @@
+package graphics
+
+import (
+	"math"
+	"testing"
+)
+
+func approxEqual(a, b, tol float64) bool {
+	return math.Abs(a-b) <= tol
+}
+
+func TestComputeMetrics_LineContours(t *testing.T) {
+	p := NewPath()
+	p.MoveTo(0, 0)
+	p.LineTo(30, 0)
+	p.LineTo(30, 40)
+	p.MoveTo(100, 100) // empty contour, skipped
+	p.MoveTo(0, 0)
+	p.AddRect(RectFromLTWH(0, 0, 10, 20))
+
+	metrics := p.ComputeMetrics()
+	if len(metrics) != 2 {
+		t.Fatalf("expected 2 metrics, got %d", len(metrics))
+	}
+	if metrics[0].Length != 70 || metrics[0].Closed {
+		t.Errorf("open contour: length %v closed %v", metrics[0].Length, metrics[0].Closed)
+	}
+	if metrics[1].Length != 60 || !metrics[1].Closed || metrics[1].ContourIndex != 1 {
+		t.Errorf("rect contour: length %v closed %v index %d", metrics[1].Length, metrics[1].Closed, metrics[1].ContourIndex)
+	}
+}
+
+func TestComputeMetrics_CurveLength(t *testing.T) {
+	// Quarter circle of radius 100 approximated by a cubic.
+	const k = 0.5522847498 * 100
+	p := NewPath()
+	p.MoveTo(100, 0)
+	p.CubicTo(100, k, k, 100, 0, 100)
+
+	metrics := p.ComputeMetrics()
+	if len(metrics) != 1 {
+		t.Fatalf("expected 1 metric, got %d", len(metrics))
+	}
+	want := math.Pi * 50
+	if !approxEqual(metrics[0].Length, want, 0.1) {
+		t.Errorf("length = %v, want ~%v", metrics[0].Length, want)
+	}
+}
+
+func TestPathMetric_TangentForOffset(t *testing.T) {
+	p := NewPath()
+	p.MoveTo(0, 0)
+	p.LineTo(10, 0)
+	p.LineTo(10, 10)
+	m := p.ComputeMetrics()[0]
+
+	tan, ok := m.TangentForOffset(15)
+	if !ok {
+		t.Fatal("expected tangent")
+	}
+	if tan.Position != (Offset{X: 10, Y: 5}) {
+		t.Errorf("position = %v", tan.Position)
+	}
+	if !approxEqual(tan.Angle(), math.Pi/2, 1e-9) {
+		t.Errorf("angle = %v, want pi/2", tan.Angle())
+	}
+
+	tan, _ = m.TangentForOffset(-5)
+	if tan.Position != (Offset{}) || tan.Vector != (Offset{X: 1}) {
+		t.Errorf("clamped start tangent = %+v", tan)
+	}
+}
+
+func TestPathMetric_TangentOnCurve(t *testing.T) {
+	p := NewPath()
+	p.MoveTo(0, 0)
+	p.QuadTo(50, 100, 100, 0)
+	m := p.ComputeMetrics()[0]
+
+	tan, _ := m.TangentForOffset(m.Length / 2)
+	if !approxEqual(tan.Position.X, 50, 0.01) || !approxEqual(tan.Position.Y, 50, 0.01) {
+		t.Errorf("midpoint = %v, want (50, 50)", tan.Position)
+	}
+	if !approxEqual(tan.Vector.X, 1, 1e-6) || !approxEqual(tan.Vector.Y, 0, 1e-6) {
+		t.Errorf("midpoint direction = %v, want (1, 0)", tan.Vector)
+	}
+}
+
+func TestPathMetric_ExtractPath(t *testing.T) {
+	p := NewPath()
+	p.MoveTo(0, 0)
+	p.LineTo(10, 0)
+	p.CubicTo(20, 0, 20, 10, 20, 20)
+	m := p.ComputeMetrics()[0]
+
+	sub := m.ExtractPath(5, m.Length-1)
+	if len(sub.Commands) != 3 {
+		t.Fatalf("expected MoveTo, LineTo, CubicTo; got %v", sub.Commands)
+	}
+	if sub.Commands[0].Op != PathOpMoveTo || sub.Commands[0].Args[0] != 5 {
+		t.Errorf("first command = %+v", sub.Commands[0])
+	}
+	subLen := sub.ComputeMetrics()[0].Length
+	if want := m.Length - 6; !approxEqual(subLen, want, 0.05) {
+		t.Errorf("extracted length = %v, want ~%v", subLen, want)
+	}
+
+	if got := m.ExtractPath(8, 8); !got.IsEmpty() {
+		t.Errorf("empty range should give empty path, got %v", got.Commands)
+	}
+}
+
+func TestCombinePaths_EmptyOperands(t *testing.T) {
+	rect := NewPath()
+	rect.AddRect(RectFromLTWH(0, 0, 10, 10))
+	empty := NewPath()
+
+	tests := []struct {
+		op        PathOperation
+		a, b      *Path
+		wantEmpty bool
+	}{
+		{PathUnion, empty, rect, false},
+		{PathUnion, rect, empty, false},
+		{PathIntersect, rect, empty, true},
+		{PathDifference, rect, empty, false},
+		{PathDifference, empty, rect, true},
+		{PathReverseDifference, empty, rect, false},
+		{PathReverseDifference, rect, empty, true},
+		{PathXor, empty, rect, false},
+	}
+	for _, tt := range tests {
+		got, err := CombinePaths(tt.op, tt.a, tt.b)
+		if err != nil {
+			t.Errorf("%v: unexpected error %v", tt.op, err)
+			continue
+		}
+		if got.IsEmpty() != tt.wantEmpty {
+			t.Errorf("%v: empty = %v, want %v", tt.op, got.IsEmpty(), tt.wantEmpty)
+		}
+		if got == rect {
+			t.Errorf("%v: result should be a copy", tt.op)
+		}
+	}
+}
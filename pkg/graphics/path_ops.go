@@ -0,0 +1,99 @@
+package graphics
+
+import (
+	"errors"
+	"fmt"
+)
+
+// PathOperation is a boolean operation that combines two paths into one.
+type PathOperation int
+
+const (
+	// PathDifference keeps the area of the first path outside the second.
+	PathDifference PathOperation = iota
+	// PathIntersect keeps the area covered by both paths.
+	PathIntersect
+	// PathUnion keeps the area covered by either path.
+	PathUnion
+	// PathXor keeps the area covered by exactly one of the paths.
+	PathXor
+	// PathReverseDifference keeps the area of the second path outside the first.
+	PathReverseDifference
+)
+
+// String returns a human-readable representation of the path operation.
+func (o PathOperation) String() string {
+	switch o {
+	case PathDifference:
+		return "difference"
+	case PathIntersect:
+		return "intersect"
+	case PathUnion:
+		return "union"
+	case PathXor:
+		return "xor"
+	case PathReverseDifference:
+		return "reverse_difference"
+	default:
+		return fmt.Sprintf("PathOperation(%d)", int(o))
+	}
+}
+
+// ErrPathOpsUnsupported is returned by [CombinePaths] on platforms without
+// Skia, such as when running tests on a desktop host.
+var ErrPathOpsUnsupported = errors.New("graphics: path operations require Skia")
+
+// ErrPathOpFailed is returned when Skia cannot compute a path operation,
+// which can happen with degenerate or non-finite geometry.
+var ErrPathOpFailed = errors.New("graphics: path operation failed")
+
+// CombinePaths combines a and b with a boolean operation, returning a new
+// path whose contours do not overlap. Curves in the result may be simplified
+// to lower-order curves or lines.
+//
+//	ring, err := graphics.CombinePaths(graphics.PathDifference, outer, inner)
+//
+// When either path is empty the result is computed directly. Otherwise the
+// operation runs in Skia and returns [ErrPathOpsUnsupported] where Skia is
+// not available.
+func CombinePaths(op PathOperation, a, b *Path) (*Path, error) {
+	aEmpty, bEmpty := a == nil || a.IsEmpty(), b == nil || b.IsEmpty()
+	if aEmpty || bEmpty {
+		switch {
+		case op == PathIntersect,
+			aEmpty && op == PathDifference,
+			bEmpty && op == PathReverseDifference:
+			return NewPath(), nil
+		case aEmpty:
+			return copyOrEmpty(b), nil
+		default:
+			return copyOrEmpty(a), nil
+		}
+	}
+	if op < PathDifference || op > PathReverseDifference {
+		return nil, fmt.Errorf("graphics: unknown path operation %v", op)
+	}
+	return combinePaths(op, a, b)
+}
+
+func copyOrEmpty(p *Path) *Path {
+	if p == nil {
+		return NewPath()
+	}
+	return CopyPath(p)
+}
+
+// Union returns the area covered by p or other. See [CombinePaths].
+func (p *Path) Union(other *Path) (*Path, error) {
+	return CombinePaths(PathUnion, p, other)
+}
+
+// Intersect returns the area covered by both p and other. See [CombinePaths].
+func (p *Path) Intersect(other *Path) (*Path, error) {
+	return CombinePaths(PathIntersect, p, other)
+}
+
+// Difference returns the area of p outside other. See [CombinePaths].
+func (p *Path) Difference(other *Path) (*Path, error) {
+	return CombinePaths(PathDifference, p, other)
+}
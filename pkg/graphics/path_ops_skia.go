@@ -0,0 +1,49 @@
+//go:build android || darwin || ios
+
+package graphics
+
+import "github.com/go-drift/drift/pkg/skia"
+
+func combinePaths(op PathOperation, a, b *Path) (*Path, error) {
+	skA := buildSkiaPath(a)
+	defer skA.Destroy()
+	skB := buildSkiaPath(b)
+	defer skB.Destroy()
+
+	result := skia.PathOp(skA, skB, int(op))
+	if result == nil {
+		return nil, ErrPathOpFailed
+	}
+	defer result.Destroy()
+	return pathFromSkia(result), nil
+}
+
+// pathFromSkia converts a skia.Path back to a graphics.Path.
+func pathFromSkia(skPath *skia.Path) *Path {
+	verbs, points, fillType := skPath.Read()
+	out := NewPath()
+	if fillType == skia.FillTypeEvenOdd {
+		out.FillRule = FillRuleEvenOdd
+	}
+	next := func(n int) []float64 {
+		args := make([]float64, n)
+		for i := range args {
+			args[i] = float64(points[i])
+		}
+		points = points[n:]
+		return args
+	}
+	for _, verb := range verbs {
+		switch PathOp(verb) {
+		case PathOpMoveTo, PathOpLineTo:
+			out.Commands = append(out.Commands, PathCommand{Op: PathOp(verb), Args: next(2)})
+		case PathOpQuadTo:
+			out.Commands = append(out.Commands, PathCommand{Op: PathOpQuadTo, Args: next(4)})
+		case PathOpCubicTo:
+			out.Commands = append(out.Commands, PathCommand{Op: PathOpCubicTo, Args: next(6)})
+		case PathOpClose:
+			out.Close()
+		}
+	}
+	return out
+}
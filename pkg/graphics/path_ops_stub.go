@@ -0,0 +1,7 @@
+//go:build !android && !darwin && !ios
+
+package graphics
+
+func combinePaths(op PathOperation, a, b *Path) (*Path, error) {
+	return nil, ErrPathOpsUnsupported
+}
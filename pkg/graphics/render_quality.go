@@ -0,0 +1,66 @@
+package graphics
+
+import "sync/atomic"
+
+// RenderQuality holds the global rendering quality defaults. Lower settings
+// trade visual quality for speed on low-end devices. Paints can override
+// the shape settings individually with [Paint.Antialias] and [Paint.Dither].
+type RenderQuality struct {
+	// FilterQuality is the image sampling used by draws that request
+	// [FilterQualityDefault], including the Image widget.
+	FilterQuality FilterQuality
+	// Antialias smooths the edges of rects, rounded rects, circles, lines,
+	// and paths.
+	Antialias bool
+	// Dither reduces color banding in gradients.
+	Dither bool
+}
+
+// DefaultRenderQuality returns the settings the renderer starts with:
+// bilinear image sampling, antialiased shapes, and no dithering.
+func DefaultRenderQuality() RenderQuality {
+	return RenderQuality{
+		FilterQuality: FilterQualityLow,
+		Antialias:     true,
+	}
+}
+
+var renderQuality atomic.Pointer[RenderQuality]
+
+func init() {
+	SetRenderQuality(DefaultRenderQuality())
+}
+
+// SetRenderQuality sets the global rendering quality defaults. Like
+// [SetEffectsQuality], the settings are applied when recorded layers are
+// composited, so they take effect on the next frame without re-recording
+// any content.
+func SetRenderQuality(q RenderQuality) {
+	renderQuality.Store(&q)
+}
+
+// GetRenderQuality returns the current global rendering quality defaults.
+func GetRenderQuality() RenderQuality {
+	return *renderQuality.Load()
+}
+
+// QualityOverride overrides a global rendering quality default for a
+// single paint.
+type QualityOverride int
+
+const (
+	// QualityDefault uses the global [RenderQuality] setting.
+	QualityDefault QualityOverride = iota
+	// QualityOn enables the setting for this paint.
+	QualityOn
+	// QualityOff disables the setting for this paint.
+	QualityOff
+)
+
+// resolveFilterQuality replaces FilterQualityDefault with the global default.
+func resolveFilterQuality(quality FilterQuality) FilterQuality {
+	if quality == FilterQualityDefault {
+		return GetRenderQuality().FilterQuality
+	}
+	return quality
+}
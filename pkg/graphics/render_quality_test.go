@@ -0,0 +1,82 @@
+package graphics
+
+import "testing"
+
+func useRenderQuality(t *testing.T, q RenderQuality) {
+	t.Helper()
+	prev := GetRenderQuality()
+	SetRenderQuality(q)
+	t.Cleanup(func() { SetRenderQuality(prev) })
+}
+
+func TestPaintFlags_Defaults(t *testing.T) {
+	useRenderQuality(t, DefaultRenderQuality())
+
+	if flags := paintFlags(DefaultPaint()); flags != paintFlagAntialias {
+		t.Errorf("expected antialias only by default, got %b", flags)
+	}
+
+	useRenderQuality(t, RenderQuality{Dither: true})
+	if flags := paintFlags(DefaultPaint()); flags != 0 {
+		t.Errorf("expected global dither to skip solid colors, got %b", flags)
+	}
+	gradient := DefaultPaint()
+	gradient.Gradient = NewLinearGradient(AlignTopLeft, AlignBottomRight, []GradientStop{
+		{Position: 0, Color: ColorRed},
+		{Position: 1, Color: ColorBlue},
+	})
+	if flags := paintFlags(gradient); flags != paintFlagDither {
+		t.Errorf("expected global dither to apply to gradients, got %b", flags)
+	}
+}
+
+func TestPaintFlags_Overrides(t *testing.T) {
+	useRenderQuality(t, RenderQuality{Antialias: true})
+
+	paint := DefaultPaint()
+	paint.Antialias = QualityOff
+	paint.Dither = QualityOn
+	if flags := paintFlags(paint); flags != paintFlagDither {
+		t.Errorf("expected overrides to disable antialias and enable dither, got %b", flags)
+	}
+
+	useRenderQuality(t, RenderQuality{})
+	paint.Antialias = QualityOn
+	paint.Dither = QualityOff
+	if flags := paintFlags(paint); flags != paintFlagAntialias {
+		t.Errorf("expected override to enable antialias, got %b", flags)
+	}
+}
+
+func TestResolveFilterQuality(t *testing.T) {
+	useRenderQuality(t, RenderQuality{FilterQuality: FilterQualityHigh})
+
+	if q := resolveFilterQuality(FilterQualityDefault); q != FilterQualityHigh {
+		t.Errorf("expected default to resolve to high, got %v", q)
+	}
+	if q := resolveFilterQuality(FilterQualityNone); q != FilterQualityNone {
+		t.Errorf("expected explicit quality to be kept, got %v", q)
+	}
+}
+
+func TestCommandBufferWritesPaintFlags(t *testing.T) {
+	useRenderQuality(t, RenderQuality{Antialias: false})
+	buf := getCommandBuffer()
+	defer putCommandBuffer(buf)
+
+	buf.writeDrawRect(RectFromLTWH(0, 0, 10, 10), DefaultPaint())
+	// opcode + 4 rect + color, style, strokeWidth, cap, join, miter, blend, alpha
+	if flags := buf.data[13]; flags != 0 {
+		t.Errorf("expected antialias disabled in encoded paint, got %v", flags)
+	}
+}
+
+func TestPaintFlags_DisplayP3(t *testing.T) {
+	useRenderQuality(t, RenderQuality{})
+
+	paint := DefaultPaint()
+	paint.ColorSpace = ColorSpaceDisplayP3
+	if flags := paintFlags(paint); flags != paintFlagDisplayP3 {
+		t.Errorf("expected Display P3 color flag, got %b", flags)
+	}
+}
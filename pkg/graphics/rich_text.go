@@ -4,6 +4,7 @@ import (
 	"errors"
 	"math"
 	"runtime"
+	"slices"
 	"strings"
 
 	"github.com/go-drift/drift/pkg/skia"
@@ -92,6 +93,7 @@ type SpanStyle struct {
 	FontSize        float64
 	FontWeight      FontWeight
 	FontStyle       FontStyle
+	FontVariations  FontVariations
 	LetterSpacing   float64
 	WordSpacing     float64
 	Height          float64
@@ -119,6 +121,9 @@ func (s SpanStyle) mergeFrom(parent SpanStyle) SpanStyle {
 	if s.FontStyle == 0 {
 		s.FontStyle = parent.FontStyle
 	}
+	if s.FontVariations.IsZero() {
+		s.FontVariations = parent.FontVariations
+	}
 	if s.LetterSpacing == 0 {
 		s.LetterSpacing = parent.LetterSpacing
 	}
@@ -150,15 +155,85 @@ type TextSpan struct {
 	Text     string
 	Style    SpanStyle
 	Children []TextSpan
+	// Placeholder, if set, reserves space in the line for an inline widget
+	// in place of Text. See [PlaceholderSpan].
+	Placeholder *Placeholder
 }
 
+// PlaceholderAlignment places an inline placeholder vertically within its
+// line.
+type PlaceholderAlignment int
+
+const (
+	// PlaceholderAlignmentBaseline rests the placeholder's baseline on the
+	// text baseline. Without a BaselineOffset, its bottom edge sits on the
+	// baseline, like an emoji.
+	PlaceholderAlignmentBaseline PlaceholderAlignment = iota
+	// PlaceholderAlignmentMiddle centers the placeholder on the middle of
+	// the text's lowercase letters.
+	PlaceholderAlignmentMiddle
+	// PlaceholderAlignmentTop aligns the placeholder's top with the top of
+	// the line.
+	PlaceholderAlignmentTop
+	// PlaceholderAlignmentBottom aligns the placeholder's bottom with the
+	// bottom of the line.
+	PlaceholderAlignmentBottom
+)
+
+// placeholderAlignmentToSkia maps PlaceholderAlignment values to Skia's
+// kBaseline=0, kTop=3, kBottom=4, kMiddle=5.
+var placeholderAlignmentToSkia = [4]int{0, 5, 3, 4}
+
+// Placeholder is space reserved in a paragraph for an inline widget, such as
+// an icon or a chip, that flows with the text around it. The widget is
+// drawn separately at the box reported by [TextLayout.PlaceholderRects].
+type Placeholder struct {
+	// Width and Height are the size of the reserved space.
+	Width  float64
+	Height float64
+	// Alignment places the space vertically within its line.
+	Alignment PlaceholderAlignment
+	// BaselineOffset is the distance from the top of the space to its
+	// baseline, used with PlaceholderAlignmentBaseline. Zero uses Height.
+	BaselineOffset float64
+}
+
+// PlaceholderSpan creates a span that reserves space for an inline widget.
+// widgets.RichText sizes the space to fit its next inline child; other
+// callers set the size with WithPlaceholderSize.
+func PlaceholderSpan(alignment PlaceholderAlignment) TextSpan {
+	return TextSpan{Placeholder: &Placeholder{Alignment: alignment}}
+}
+
+// WithPlaceholderSize returns a copy of a placeholder span with the given
+// size. Spans without a placeholder are returned unchanged.
+func (s TextSpan) WithPlaceholderSize(size Size) TextSpan {
+	if s.Placeholder == nil {
+		return s
+	}
+	placeholder := *s.Placeholder
+	placeholder.Width = size.Width
+	placeholder.Height = size.Height
+	s.Placeholder = &placeholder
+	return s
+}
+
+// placeholderText stands in for a placeholder in plain text, as it does in
+// the shaped paragraph.
+const placeholderText = "\uFFFC"
+
 // PlainText returns the concatenation of all text in the span tree.
+// Placeholders appear as U+FFFC OBJECT REPLACEMENT CHARACTER.
 func (s TextSpan) PlainText() string {
+	text := s.Text
+	if s.Placeholder != nil {
+		text = placeholderText
+	}
 	if len(s.Children) == 0 {
-		return s.Text
+		return text
 	}
 	var b strings.Builder
-	b.WriteString(s.Text)
+	b.WriteString(text)
 	for _, child := range s.Children {
 		b.WriteString(child.PlainText())
 	}
@@ -201,6 +276,12 @@ func (s TextSpan) Weight(w FontWeight) TextSpan {
 	return s
 }
 
+// Variations returns a copy with the specified variable font axes.
+func (s TextSpan) Variations(v FontVariations) TextSpan {
+	s.Style.FontVariations = v
+	return s
+}
+
 // Size returns a copy with the specified font size.
 func (s TextSpan) Size(size float64) TextSpan {
 	s.Style.FontSize = size
@@ -326,10 +407,12 @@ func (s TextSpan) NoBackground() TextSpan {
 	return s
 }
 
-// flatSpan is a resolved text + style pair produced by flattening a TextSpan tree.
+// flatSpan is a resolved text + style pair produced by flattening a TextSpan
+// tree. Placeholder spans have a placeholder instead of text.
 type flatSpan struct {
-	text  string
-	style SpanStyle
+	text        string
+	style       SpanStyle
+	placeholder *Placeholder
 }
 
 // flattenSpans walks a TextSpan tree depth-first, collecting leaf (text, style)
@@ -342,7 +425,9 @@ func flattenSpans(span TextSpan, baseStyle SpanStyle) []flatSpan {
 func flattenSpansInherited(span TextSpan, parentStyle SpanStyle) []flatSpan {
 	resolved := span.Style.mergeFrom(parentStyle)
 	var result []flatSpan
-	if span.Text != "" {
+	if span.Placeholder != nil {
+		result = append(result, flatSpan{style: resolved, placeholder: span.Placeholder})
+	} else if span.Text != "" {
 		result = append(result, flatSpan{text: span.Text, style: resolved})
 	}
 	for _, child := range span.Children {
@@ -390,8 +475,9 @@ func LayoutRichText(span TextSpan, baseStyle SpanStyle, manager *FontManager, op
 			Text:            f.text,
 			Family:          s.FontFamily,
 			Size:            float32(s.FontSize),
-			Weight:          int(s.FontWeight),
+			Weight:          resolveFontWeight(s.FontWeight, s.FontVariations),
 			Style:           fontStyleBridgeValue(s.FontStyle),
+			Variations:      s.FontVariations.bridgeValue(),
 			Color:           uint32(s.Color),
 			Decoration:      decoration,
 			DecorationColor: decorationColor,
@@ -402,6 +488,21 @@ func LayoutRichText(span TextSpan, baseStyle SpanStyle, manager *FontManager, op
 			HasBackground:   s.BackgroundColor != 0 && s.BackgroundColor != noBackgroundColor,
 			BackgroundColor: uint32(s.BackgroundColor),
 		}
+		if p := f.placeholder; p != nil {
+			baselineOffset := p.BaselineOffset
+			if baselineOffset == 0 {
+				baselineOffset = p.Height
+			}
+			alignment := 0
+			if int(p.Alignment) >= 0 && int(p.Alignment) < len(placeholderAlignmentToSkia) {
+				alignment = placeholderAlignmentToSkia[p.Alignment]
+			}
+			skiaSpans[i].Placeholder = true
+			skiaSpans[i].PlaceholderWidth = float32(max(p.Width, 0))
+			skiaSpans[i].PlaceholderHeight = float32(max(p.Height, 0))
+			skiaSpans[i].PlaceholderAlignment = alignment
+			skiaSpans[i].PlaceholderBaselineOffset = float32(baselineOffset)
+		}
 	}
 
 	maxWidth := opts.MaxWidth
@@ -409,7 +510,13 @@ func LayoutRichText(span TextSpan, baseStyle SpanStyle, manager *FontManager, op
 		maxWidth = 0
 	}
 
-	paragraph, err := skia.NewRichParagraph(skiaSpans, opts.MaxLines, int(opts.TextAlign))
+	// An unsized strut takes the root span's font size.
+	strutSize := span.Style.mergeFrom(baseStyle).FontSize
+	if strutSize <= 0 {
+		strutSize = defaultFontSize
+	}
+	strut := opts.Strut.bridgeValue(strutSize)
+	paragraph, err := skia.NewRichParagraph(skiaSpans, opts.MaxLines, int(opts.TextAlign), int(opts.TextDirection), strut, opts.Ellipsis)
 	if err != nil {
 		return nil, err
 	}
@@ -459,13 +566,15 @@ func LayoutRichText(span TextSpan, baseStyle SpanStyle, manager *FontManager, op
 	}
 
 	layout := &TextLayout{
-		Text:       span.PlainText(),
-		Size:       layoutSize,
-		Ascent:     ascent,
-		Descent:    descent,
-		LineHeight: lineHeight,
-		Lines:      lines,
-		paragraph:  paragraph,
+		Text:              span.PlainText(),
+		Size:              layoutSize,
+		Ascent:            ascent,
+		Descent:           descent,
+		LineHeight:        lineHeight,
+		Lines:             lines,
+		paragraph:         paragraph,
+		families:          append(spanFamilies(flat), manager.Fallbacks()...),
+		DidExceedMaxLines: metrics.DidExceedMaxLines,
 	}
 	runtime.SetFinalizer(layout, func(l *TextLayout) {
 		if l != nil && l.paragraph != nil {
@@ -475,3 +584,32 @@ func LayoutRichText(span TextSpan, baseStyle SpanStyle, manager *FontManager, op
 	})
 	return layout, nil
 }
+
+// PlaceholderRects returns the boxes of the layout's placeholder spans, in
+// order, relative to the layout's top-left corner. Placeholders cut off by
+// MaxLines are left out.
+func (l *TextLayout) PlaceholderRects() []Rect {
+	if l == nil || l.paragraph == nil {
+		return nil
+	}
+	boxes, err := l.paragraph.PlaceholderRects()
+	if err != nil {
+		return nil
+	}
+	rects := make([]Rect, len(boxes))
+	for i, box := range boxes {
+		rects[i] = Rect{Left: box.Left, Top: box.Top, Right: box.Right, Bottom: box.Bottom}
+	}
+	return rects
+}
+
+// spanFamilies returns the distinct font families named by spans.
+func spanFamilies(spans []flatSpan) []string {
+	var families []string
+	for _, span := range spans {
+		if family := span.style.FontFamily; family != "" && !slices.Contains(families, family) {
+			families = append(families, family)
+		}
+	}
+	return families
+}
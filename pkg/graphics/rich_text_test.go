@@ -515,3 +515,107 @@ func TestFlattenSpans_ContentStyleOverridesBaseStyle(t *testing.T) {
 		t.Errorf("expected base font size 18 (not overridden), got %v", flat[0].style.FontSize)
 	}
 }
+
+func TestFlattenSpans_InheritsFontVariations(t *testing.T) {
+	wide := FontVariations{Weight: 650, Width: 110}
+	span := Spans(
+		Span("inherits"),
+		Span("overrides").Variations(FontVariations{Slant: -8}),
+	).Variations(wide)
+	flat := flattenSpans(span, SpanStyle{})
+	if len(flat) != 2 {
+		t.Fatalf("expected 2 flat spans, got %d", len(flat))
+	}
+	if flat[0].style.FontVariations != wide {
+		t.Errorf("expected inherited variations %+v, got %+v", wide, flat[0].style.FontVariations)
+	}
+	if want := (FontVariations{Slant: -8}); flat[1].style.FontVariations != want {
+		t.Errorf("expected child variations %+v, got %+v", want, flat[1].style.FontVariations)
+	}
+}
+
+func TestResolveFontWeight(t *testing.T) {
+	tests := []struct {
+		weight     FontWeight
+		variations FontVariations
+		want       int
+	}{
+		{0, FontVariations{}, 0},
+		{FontWeightBold, FontVariations{Weight: 350}, 700},
+		{0, FontVariations{Weight: 640}, 600},
+		{0, FontVariations{Weight: 1000}, 900},
+		{0, FontVariations{Weight: 20}, 100},
+	}
+	for _, tt := range tests {
+		if got := resolveFontWeight(tt.weight, tt.variations); got != tt.want {
+			t.Errorf("resolveFontWeight(%d, %+v) = %d, want %d", tt.weight, tt.variations, got, tt.want)
+		}
+	}
+}
+
+func TestTextStyle_ShadowsInPaintOrder(t *testing.T) {
+	glow := TextShadow{Color: 0xFFFFFFFF, BlurRadius: 8}
+	drop := TextShadow{Color: 0x80000000, Offset: Offset{Y: 2}}
+	outline := TextShadow{Color: 0xFF000000, Offset: Offset{X: 1}}
+	style := TextStyle{Shadow: &glow, Shadows: &[]TextShadow{drop, outline}}
+
+	got := style.textShadows()
+	if len(got) != 3 || got[0] != glow || got[1] != drop || got[2] != outline {
+		t.Errorf("textShadows() = %+v", got)
+	}
+	if shadows := (TextStyle{}).textShadows(); len(shadows) != 0 {
+		t.Errorf("expected no shadows, got %+v", shadows)
+	}
+}
+
+func TestTextSpan_PlainText_Placeholder(t *testing.T) {
+	span := Spans(Span("a"), PlaceholderSpan(PlaceholderAlignmentMiddle), Span("b"))
+	if got := span.PlainText(); got != "a￼b" {
+		t.Errorf("expected placeholder as U+FFFC, got %q", got)
+	}
+}
+
+func TestTextSpan_WithPlaceholderSize_Copies(t *testing.T) {
+	span := PlaceholderSpan(PlaceholderAlignmentBaseline)
+	sized := span.WithPlaceholderSize(Size{Width: 10, Height: 20})
+	if span.Placeholder.Width != 0 {
+		t.Error("expected the original placeholder to be unchanged")
+	}
+	if sized.Placeholder.Width != 10 || sized.Placeholder.Height != 20 {
+		t.Errorf("expected 10x20 placeholder, got %+v", *sized.Placeholder)
+	}
+	if text := Span("x").WithPlaceholderSize(Size{Width: 10}); text.Placeholder != nil {
+		t.Error("expected text spans to be unchanged")
+	}
+}
+
+func TestFlattenSpans_Placeholder(t *testing.T) {
+	span := Spans(Span("a"), PlaceholderSpan(PlaceholderAlignmentTop).Size(20))
+	flat := flattenSpans(span, SpanStyle{FontSize: 12})
+	if len(flat) != 2 {
+		t.Fatalf("expected 2 flat spans, got %d", len(flat))
+	}
+	if flat[1].placeholder == nil || flat[1].text != "" {
+		t.Errorf("expected a placeholder span, got %+v", flat[1])
+	}
+	if flat[1].style.FontSize != 20 {
+		t.Errorf("expected placeholder to keep its style, got size %v", flat[1].style.FontSize)
+	}
+}
+
+func TestStrutStyle_BridgeValue(t *testing.T) {
+	if got := (StrutStyle{}).bridgeValue(14); got != nil {
+		t.Errorf("zero strut: got %+v, want nil", got)
+	}
+
+	// An unsized strut takes the text's font size.
+	got := StrutStyle{Height: 1.5, FontStyle: FontStyleItalic}.bridgeValue(14)
+	if got == nil || got.Size != 14 || got.Height != 1.5 || got.Style != 1 || got.ForceHeight {
+		t.Errorf("unsized strut: got %+v", got)
+	}
+
+	got = StrutStyle{FontSize: 20, ForceStrutHeight: true}.bridgeValue(14)
+	if got == nil || got.Size != 20 || !got.ForceHeight {
+		t.Errorf("sized strut: got %+v", got)
+	}
+}
@@ -0,0 +1,183 @@
+package graphics
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"runtime"
+	"unsafe"
+
+	"github.com/go-drift/drift/pkg/skia"
+)
+
+// ShaderUniform describes a uniform declared by a [FragmentShader]. Offset
+// and Count are measured in float slots, so a float2 has Count 2 and a
+// float4x4 has Count 16.
+type ShaderUniform struct {
+	Name   string
+	Offset int
+	Count  int
+	// IsInt reports an int, int2, int3, or int4 uniform. Values set on int
+	// uniforms are truncated toward zero.
+	IsInt bool
+}
+
+// FragmentShader is a custom fragment shader written in SkSL, Skia's
+// shading language. It is used as a [Paint] shader, which colors the shapes
+// it draws, or as an [ImageFilter] applied to a layer.
+//
+// The source must define a main function returning the color of each pixel:
+//
+//	uniform float2 resolution;
+//	uniform float time;
+//
+//	half4 main(float2 coord) {
+//	    float2 uv = coord / resolution;
+//	    return half4(uv.x, uv.y, 0.5 + 0.5 * sin(time), 1);
+//	}
+//
+// Compile the source once and set uniforms before each frame is drawn:
+//
+//	s.shader, err = graphics.NewFragmentShader(source)
+//	...
+//	s.shader.SetFloat("resolution", size.Width, size.Height)
+//	s.shader.SetFloat("time", elapsed.Seconds())
+//	canvas.DrawRect(rect, graphics.Paint{Shader: s.shader, Alpha: 1})
+//
+// Shaders used as an image filter must declare exactly one child shader,
+// which samples the layer's content:
+//
+//	uniform shader content;
+//	uniform float amount;
+//
+//	half4 main(float2 coord) {
+//	    half4 c = content.eval(coord);
+//	    return half4(c.rgb * amount, c.a);
+//	}
+//
+// Uniform values are captured when a shape or layer is recorded, so changes
+// made after a draw call only affect later draws. Use [FragmentShader.Copy]
+// to draw the same program with different uniforms in one frame.
+//
+// Runtime shaders require Skia; [NewFragmentShader] returns
+// [ErrRuntimeShadersUnsupported] elsewhere.
+type FragmentShader struct {
+	program  *shaderProgram
+	uniforms []float32
+}
+
+// shaderProgram is a compiled effect shared by copies of a FragmentShader.
+type shaderProgram struct {
+	effect   *skia.RuntimeEffect
+	uniforms []ShaderUniform
+	slots    int
+}
+
+// ErrRuntimeShadersUnsupported is returned by [NewFragmentShader] on
+// platforms without Skia.
+var ErrRuntimeShadersUnsupported = errors.New("graphics: runtime shaders require Skia")
+
+// NewFragmentShader compiles SkSL source into a shader. The returned error
+// includes the compiler's message when the source is invalid. All uniforms
+// start at zero.
+func NewFragmentShader(source string) (*FragmentShader, error) {
+	effect, err := compileRuntimeEffect(source)
+	if err != nil {
+		return nil, err
+	}
+	program := &shaderProgram{
+		effect:   effect,
+		uniforms: make([]ShaderUniform, 0),
+	}
+	for _, u := range effect.Uniforms() {
+		program.uniforms = append(program.uniforms, ShaderUniform{
+			Name:   u.Name,
+			Offset: u.Offset,
+			Count:  u.Count,
+			IsInt:  u.IsInt,
+		})
+		program.slots = max(program.slots, u.Offset+u.Count)
+	}
+	runtime.SetFinalizer(program, func(p *shaderProgram) {
+		p.effect.Destroy()
+	})
+	return &FragmentShader{
+		program:  program,
+		uniforms: make([]float32, program.slots),
+	}, nil
+}
+
+// Uniforms describes the uniforms declared by the shader, in declaration
+// order.
+func (s *FragmentShader) Uniforms() []ShaderUniform {
+	if s == nil || s.program == nil {
+		return nil
+	}
+	return s.program.uniforms
+}
+
+// SetFloat sets the named uniform. The number of values must match the
+// uniform's size: one for a float, two for a float2, four for a float2x2,
+// and so on. Matrices are given in column-major order.
+func (s *FragmentShader) SetFloat(name string, values ...float64) error {
+	if s == nil || s.program == nil {
+		return fmt.Errorf("graphics: uniform %q: shader not compiled", name)
+	}
+	for _, u := range s.program.uniforms {
+		if u.Name != name {
+			continue
+		}
+		if len(values) != u.Count {
+			return fmt.Errorf("graphics: uniform %q takes %d values, got %d", name, u.Count, len(values))
+		}
+		for i, v := range values {
+			s.uniforms[u.Offset+i] = uniformSlot(v, u.IsInt)
+		}
+		return nil
+	}
+	return fmt.Errorf("graphics: shader has no uniform %q", name)
+}
+
+// SetFloatAt sets the float slot at index, counting across all uniforms in
+// declaration order. Out-of-range indices are ignored.
+func (s *FragmentShader) SetFloatAt(index int, value float64) {
+	if s == nil || index < 0 || index >= len(s.uniforms) {
+		return
+	}
+	isInt := false
+	for _, u := range s.program.uniforms {
+		if index >= u.Offset && index < u.Offset+u.Count {
+			isInt = u.IsInt
+			break
+		}
+	}
+	s.uniforms[index] = uniformSlot(value, isInt)
+}
+
+// Copy returns a shader sharing the compiled program with its own copy of
+// the current uniform values.
+func (s *FragmentShader) Copy() *FragmentShader {
+	if s == nil {
+		return nil
+	}
+	return &FragmentShader{
+		program:  s.program,
+		uniforms: append([]float32(nil), s.uniforms...),
+	}
+}
+
+// effectPtr returns the compiled effect handle, or nil if unavailable.
+func (s *FragmentShader) effectPtr() unsafe.Pointer {
+	if s == nil || s.program == nil {
+		return nil
+	}
+	return s.program.effect.Ptr()
+}
+
+// uniformSlot encodes a uniform value, storing ints as their bit pattern.
+func uniformSlot(v float64, isInt bool) float32 {
+	if isInt {
+		return math.Float32frombits(uint32(int32(v)))
+	}
+	return float32(v)
+}
@@ -0,0 +1,9 @@
+//go:build android || darwin || ios
+
+package graphics
+
+import "github.com/go-drift/drift/pkg/skia"
+
+func compileRuntimeEffect(source string) (*skia.RuntimeEffect, error) {
+	return skia.NewRuntimeEffect(source)
+}
@@ -0,0 +1,109 @@
+package graphics
+
+import (
+	"math"
+	"testing"
+)
+
+// testShader returns a shader with the uniforms of
+//
+//	uniform float2 resolution;
+//	uniform float time;
+//	uniform int mode;
+//
+// without compiling, since Skia is not available in tests.
+func testShader() *FragmentShader {
+	program := &shaderProgram{
+		uniforms: []ShaderUniform{
+			{Name: "resolution", Offset: 0, Count: 2},
+			{Name: "time", Offset: 2, Count: 1},
+			{Name: "mode", Offset: 3, Count: 1, IsInt: true},
+		},
+		slots: 4,
+	}
+	return &FragmentShader{program: program, uniforms: make([]float32, program.slots)}
+}
+
+func TestFragmentShader_SetFloat(t *testing.T) {
+	s := testShader()
+	if err := s.SetFloat("resolution", 320, 240); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.SetFloat("time", 1.5); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.SetFloat("mode", 2.7); err != nil {
+		t.Fatal(err)
+	}
+	want := []float32{320, 240, 1.5, math.Float32frombits(2)}
+	for i, v := range want {
+		if math.Float32bits(s.uniforms[i]) != math.Float32bits(v) {
+			t.Errorf("slot %d = %v, want %v", i, s.uniforms[i], v)
+		}
+	}
+}
+
+func TestFragmentShader_SetFloatErrors(t *testing.T) {
+	s := testShader()
+	if err := s.SetFloat("resolution", 1); err == nil {
+		t.Error("expected error for wrong value count")
+	}
+	if err := s.SetFloat("missing", 1); err == nil {
+		t.Error("expected error for unknown uniform")
+	}
+	var nilShader *FragmentShader
+	if err := nilShader.SetFloat("time", 1); err == nil {
+		t.Error("expected error for nil shader")
+	}
+}
+
+func TestFragmentShader_SetFloatAt(t *testing.T) {
+	s := testShader()
+	s.SetFloatAt(1, 42)
+	s.SetFloatAt(3, -1)
+	s.SetFloatAt(99, 1) // ignored
+	if s.uniforms[1] != 42 {
+		t.Errorf("slot 1 = %v, want 42", s.uniforms[1])
+	}
+	if got := int32(math.Float32bits(s.uniforms[3])); got != -1 {
+		t.Errorf("int slot = %d, want -1", got)
+	}
+}
+
+func TestFragmentShader_RecordingCapturesUniforms(t *testing.T) {
+	s := testShader()
+	s.SetFloat("time", 1)
+
+	rec := &PictureRecorder{}
+	canvas := rec.BeginRecording(Size{Width: 10, Height: 10})
+	canvas.DrawRect(RectFromLTWH(0, 0, 10, 10), Paint{Shader: s, Alpha: 1})
+	list := rec.EndRecording()
+
+	s.SetFloat("time", 2)
+
+	op := list.ops[0].(opRect)
+	if op.paint.Shader == s {
+		t.Fatal("recorded paint should hold a copy of the shader")
+	}
+	if op.paint.Shader.uniforms[2] != 1 {
+		t.Errorf("recorded time = %v, want 1", op.paint.Shader.uniforms[2])
+	}
+}
+
+func TestNewShaderImageFilter_CopiesUniforms(t *testing.T) {
+	s := testShader()
+	s.SetFloat("time", 3)
+	filter := NewShaderImageFilter(s)
+	s.SetFloat("time", 4)
+
+	if filter.Type != ImageFilterShader {
+		t.Fatalf("type = %v", filter.Type)
+	}
+	if filter.Shader.uniforms[2] != 3 {
+		t.Errorf("filter time = %v, want 3", filter.Shader.uniforms[2])
+	}
+	// Without a compiled effect there is nothing to encode.
+	if data := encodeImageFilter(&filter); data != nil {
+		t.Errorf("expected nil encoding, got %v", data)
+	}
+}
@@ -165,6 +165,12 @@ func (c *SkiaCanvas) Clear(color Color) {
 }
 
 func (c *SkiaCanvas) DrawRect(rect Rect, paint Paint) {
+	if paint.Shader != nil {
+		path := NewPath()
+		path.AddRect(rect)
+		c.drawPathShader(path, paint)
+		return
+	}
 	cap, join, miter, dash, dashPhase, blend, alpha := paintParams(paint)
 	// Use GradientBounds if set, otherwise use the shape bounds
 	gradientBounds := rect
@@ -175,7 +181,7 @@ func (c *SkiaCanvas) DrawRect(rect Rect, paint Paint) {
 		skia.CanvasDrawRectGradient(
 			c.canvas,
 			float32(rect.Left), float32(rect.Top), float32(rect.Right), float32(rect.Bottom),
-			uint32(paint.Color), int32(paint.Style), float32(paint.StrokeWidth), true,
+			uint32(paint.Color), int32(paint.Style), float32(paint.StrokeWidth), paintFlags(paint),
 			cap, join, miter, dash, dashPhase, blend, alpha,
 			payload.gradientType,
 			float32(payload.start.X), float32(payload.start.Y),
@@ -188,12 +194,18 @@ func (c *SkiaCanvas) DrawRect(rect Rect, paint Paint) {
 	skia.CanvasDrawRect(
 		c.canvas,
 		float32(rect.Left), float32(rect.Top), float32(rect.Right), float32(rect.Bottom),
-		uint32(paint.Color), int32(paint.Style), float32(paint.StrokeWidth), true,
+		uint32(paint.Color), int32(paint.Style), float32(paint.StrokeWidth), paintFlags(paint),
 		cap, join, miter, dash, dashPhase, blend, alpha,
 	)
 }
 
 func (c *SkiaCanvas) DrawRRect(rrect RRect, paint Paint) {
+	if paint.Shader != nil {
+		path := NewPath()
+		path.AddRRect(rrect)
+		c.drawPathShader(path, paint)
+		return
+	}
 	cap, join, miter, dash, dashPhase, blend, alpha := paintParams(paint)
 	// Use GradientBounds if set, otherwise use the shape bounds
 	gradientBounds := rrect.Rect
@@ -209,7 +221,7 @@ func (c *SkiaCanvas) DrawRRect(rrect RRect, paint Paint) {
 			float32(rrect.TopRight.X), float32(rrect.TopRight.Y),
 			float32(rrect.BottomRight.X), float32(rrect.BottomRight.Y),
 			float32(rrect.BottomLeft.X), float32(rrect.BottomLeft.Y),
-			uint32(paint.Color), int32(paint.Style), float32(paint.StrokeWidth), true,
+			uint32(paint.Color), int32(paint.Style), float32(paint.StrokeWidth), paintFlags(paint),
 			cap, join, miter, dash, dashPhase, blend, alpha,
 			payload.gradientType,
 			float32(payload.start.X), float32(payload.start.Y),
@@ -227,12 +239,19 @@ func (c *SkiaCanvas) DrawRRect(rrect RRect, paint Paint) {
 		float32(rrect.TopRight.X), float32(rrect.TopRight.Y),
 		float32(rrect.BottomRight.X), float32(rrect.BottomRight.Y),
 		float32(rrect.BottomLeft.X), float32(rrect.BottomLeft.Y),
-		uint32(paint.Color), int32(paint.Style), float32(paint.StrokeWidth), true,
+		uint32(paint.Color), int32(paint.Style), float32(paint.StrokeWidth), paintFlags(paint),
 		cap, join, miter, dash, dashPhase, blend, alpha,
 	)
 }
 
 func (c *SkiaCanvas) DrawCircle(center Offset, radius float64, paint Paint) {
+	if paint.Shader != nil {
+		path := NewPath()
+		bounds := Rect{Left: center.X - radius, Top: center.Y - radius, Right: center.X + radius, Bottom: center.Y + radius}
+		path.AddRRect(RRectFromRectAndRadius(bounds, CircularRadius(radius)))
+		c.drawPathShader(path, paint)
+		return
+	}
 	cap, join, miter, dash, dashPhase, blend, alpha := paintParams(paint)
 	// Compute bounding rect for the circle
 	bounds := RectFromLTWH(center.X-radius, center.Y-radius, radius*2, radius*2)
@@ -245,7 +264,7 @@ func (c *SkiaCanvas) DrawCircle(center Offset, radius float64, paint Paint) {
 		skia.CanvasDrawCircleGradient(
 			c.canvas,
 			float32(center.X), float32(center.Y), float32(radius),
-			uint32(paint.Color), int32(paint.Style), float32(paint.StrokeWidth), true,
+			uint32(paint.Color), int32(paint.Style), float32(paint.StrokeWidth), paintFlags(paint),
 			cap, join, miter, dash, dashPhase, blend, alpha,
 			payload.gradientType,
 			float32(payload.start.X), float32(payload.start.Y),
@@ -258,12 +277,21 @@ func (c *SkiaCanvas) DrawCircle(center Offset, radius float64, paint Paint) {
 	skia.CanvasDrawCircle(
 		c.canvas,
 		float32(center.X), float32(center.Y), float32(radius),
-		uint32(paint.Color), int32(paint.Style), float32(paint.StrokeWidth), true,
+		uint32(paint.Color), int32(paint.Style), float32(paint.StrokeWidth), paintFlags(paint),
 		cap, join, miter, dash, dashPhase, blend, alpha,
 	)
 }
 
 func (c *SkiaCanvas) DrawLine(start, end Offset, paint Paint) {
+	if paint.Shader != nil {
+		// Lines are always stroked.
+		path := NewPath()
+		path.MoveTo(start.X, start.Y)
+		path.LineTo(end.X, end.Y)
+		paint.Style = PaintStyleStroke
+		c.drawPathShader(path, paint)
+		return
+	}
 	cap, join, miter, dash, dashPhase, blend, alpha := paintParams(paint)
 	// Compute bounding rect for the line
 	bounds := Rect{
@@ -281,7 +309,7 @@ func (c *SkiaCanvas) DrawLine(start, end Offset, paint Paint) {
 		skia.CanvasDrawLineGradient(
 			c.canvas,
 			float32(start.X), float32(start.Y), float32(end.X), float32(end.Y),
-			uint32(paint.Color), float32(paint.StrokeWidth), true,
+			uint32(paint.Color), float32(paint.StrokeWidth), paintFlags(paint),
 			cap, join, miter, dash, dashPhase, blend, alpha,
 			payload.gradientType,
 			float32(payload.start.X), float32(payload.start.Y),
@@ -294,7 +322,7 @@ func (c *SkiaCanvas) DrawLine(start, end Offset, paint Paint) {
 	skia.CanvasDrawLine(
 		c.canvas,
 		float32(start.X), float32(start.Y), float32(end.X), float32(end.Y),
-		uint32(paint.Color), float32(paint.StrokeWidth), true,
+		uint32(paint.Color), float32(paint.StrokeWidth), paintFlags(paint),
 		cap, join, miter, dash, dashPhase, blend, alpha,
 	)
 }
@@ -338,15 +366,15 @@ func (c *SkiaCanvas) DrawText(layout *TextLayout, position Offset) {
 		centerY = float32(payload.center.Y)
 		gradientRadius = float32(payload.radius)
 	}
-	shadow := layout.Style.Shadow
+	shadows := layout.Style.textShadows()
 	for i, line := range layout.Lines {
 		if line.Text == "" {
 			continue
 		}
 		baseline := position.Y + layout.Ascent + float64(i)*lineHeight
 
-		// Draw shadow first if present
-		if shadow != nil {
+		// Draw shadows first
+		for _, shadow := range shadows {
 			skia.CanvasDrawTextShadow(
 				c.canvas,
 				line.Text,
@@ -443,11 +471,15 @@ func (c *SkiaCanvas) DrawImageRect(img image.Image, srcRect, dstRect Rect, quali
 		c.canvas, rgba.Pix, w, h, rgba.Stride,
 		float32(srcRect.Left), float32(srcRect.Top), float32(srcRect.Right), float32(srcRect.Bottom),
 		float32(dstRect.Left), float32(dstRect.Top), float32(dstRect.Right), float32(dstRect.Bottom),
-		int(quality), cacheKey,
+		int(resolveFilterQuality(quality)), cacheKey,
 	)
 }
 
 func (c *SkiaCanvas) DrawPath(path *Path, paint Paint) {
+	if paint.Shader != nil {
+		c.drawPathShader(path, paint)
+		return
+	}
 	skPath := buildSkiaPath(path)
 	if skPath == nil {
 		return
@@ -473,7 +505,7 @@ func (c *SkiaCanvas) DrawPath(path *Path, paint Paint) {
 	if payload, ok := buildGradientPayload(paint.Gradient, gradientBounds); ok {
 		skia.CanvasDrawPathGradient(
 			c.canvas, skPath,
-			uint32(paint.Color), int32(paint.Style), float32(paint.StrokeWidth), true,
+			uint32(paint.Color), int32(paint.Style), float32(paint.StrokeWidth), paintFlags(paint),
 			cap, join, miter, dash, dashPhase, blend, alpha,
 			payload.gradientType,
 			float32(payload.start.X), float32(payload.start.Y),
@@ -486,12 +518,81 @@ func (c *SkiaCanvas) DrawPath(path *Path, paint Paint) {
 
 	skia.CanvasDrawPath(
 		c.canvas, skPath,
-		uint32(paint.Color), int32(paint.Style), float32(paint.StrokeWidth), true,
+		uint32(paint.Color), int32(paint.Style), float32(paint.StrokeWidth), paintFlags(paint),
+		cap, join, miter, dash, dashPhase, blend, alpha,
+	)
+}
+
+func (c *SkiaCanvas) DrawVertices(vertices *Vertices, img image.Image, paint Paint) {
+	if vertices.Validate() != nil || len(vertices.Positions) == 0 {
+		return
+	}
+	positions := make([]float32, 0, len(vertices.Positions)*2)
+	for _, p := range vertices.Positions {
+		positions = append(positions, float32(p.X), float32(p.Y))
+	}
+	var texCoords []float32
+	if vertices.TextureCoordinates != nil {
+		texCoords = make([]float32, 0, len(vertices.TextureCoordinates)*2)
+		for _, p := range vertices.TextureCoordinates {
+			texCoords = append(texCoords, float32(p.X), float32(p.Y))
+		}
+	}
+	var colors []uint32
+	if vertices.Colors != nil {
+		colors = make([]uint32, len(vertices.Colors))
+		for i, col := range vertices.Colors {
+			colors[i] = uint32(col)
+		}
+	}
+
+	var pixels []uint8
+	var w, h, stride int
+	if img != nil {
+		if rgba := toRGBA(img); rgba != nil {
+			bounds := rgba.Bounds()
+			pixels, w, h, stride = rgba.Pix, bounds.Dx(), bounds.Dy(), rgba.Stride
+		}
+	}
+	var effect *skia.RuntimeEffect
+	var uniforms []float32
+	if paint.Shader != nil && paint.Shader.program != nil {
+		effect, uniforms = paint.Shader.program.effect, paint.Shader.uniforms
+	}
+
+	_, _, _, _, _, blend, alpha := paintParams(paint)
+	skia.CanvasDrawVertices(
+		c.canvas, int32(vertices.Mode),
+		positions, texCoords, colors, vertices.Indices,
+		pixels, w, h, stride, int(resolveFilterQuality(FilterQualityDefault)),
+		effect, uniforms,
+		uint32(paint.Color), blend, alpha,
+	)
+}
+
+// drawPathShader draws a shape colored by paint.Shader.
+func (c *SkiaCanvas) drawPathShader(path *Path, paint Paint) {
+	if paint.Shader.program == nil {
+		return
+	}
+	skPath := buildSkiaPath(path)
+	if skPath == nil {
+		return
+	}
+	defer skPath.Destroy()
+	cap, join, miter, dash, dashPhase, blend, alpha := paintParams(paint)
+	skia.CanvasDrawPathShader(
+		c.canvas, skPath,
+		paint.Shader.program.effect, paint.Shader.uniforms,
+		int32(paint.Style), float32(paint.StrokeWidth), paintFlags(paint),
 		cap, join, miter, dash, dashPhase, blend, alpha,
 	)
 }
 
 func (c *SkiaCanvas) DrawRectShadow(rect Rect, shadow BoxShadow) {
+	if effectsReduced() {
+		return
+	}
 	skia.CanvasDrawRectShadow(
 		c.canvas,
 		float32(rect.Left),
@@ -508,6 +609,9 @@ func (c *SkiaCanvas) DrawRectShadow(rect Rect, shadow BoxShadow) {
 }
 
 func (c *SkiaCanvas) DrawRRectShadow(rrect RRect, shadow BoxShadow) {
+	if effectsReduced() {
+		return
+	}
 	skia.CanvasDrawRRectShadow(
 		c.canvas,
 		float32(rrect.Rect.Left),
@@ -532,6 +636,11 @@ func (c *SkiaCanvas) DrawRRectShadow(rrect RRect, shadow BoxShadow) {
 }
 
 func (c *SkiaCanvas) SaveLayerBlur(bounds Rect, sigmaX, sigmaY float64) {
+	if effectsReduced() {
+		// Keep the save stack balanced for the matching Restore.
+		skia.CanvasSave(c.canvas)
+		return
+	}
 	skia.CanvasSaveLayerBlur(
 		c.canvas,
 		float32(bounds.Left),
@@ -581,6 +690,23 @@ func (c *SkiaCanvas) DrawLottie(animPtr unsafe.Pointer, bounds Rect, t float64)
 	skia.CanvasRestore(c.canvas)
 }
 
+func (c *SkiaCanvas) DrawRive(rivePtr unsafe.Pointer, bounds Rect) {
+	if rivePtr == nil {
+		return
+	}
+	w, h := bounds.Width(), bounds.Height()
+	if w <= 0 || h <= 0 {
+		return
+	}
+	skia.CanvasSave(c.canvas)
+	skia.CanvasClipRect(c.canvas, float32(bounds.Left), float32(bounds.Top), float32(bounds.Right), float32(bounds.Bottom))
+	if bounds.Left != 0 || bounds.Top != 0 {
+		skia.CanvasTranslate(c.canvas, float32(bounds.Left), float32(bounds.Top))
+	}
+	skia.RiveRender(rivePtr, c.canvas, float32(w), float32(h))
+	skia.CanvasRestore(c.canvas)
+}
+
 func (c *SkiaCanvas) EmbedPlatformView(viewID int64, size Size) {
 	// No-op: platform view geometry is resolved by GeometryCanvas in StepFrame
 }
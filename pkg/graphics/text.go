@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math"
 	"runtime"
+	"slices"
 	"sync"
 
 	"github.com/go-drift/drift/pkg/errors"
@@ -118,6 +119,41 @@ func (w TextWrap) String() string {
 	}
 }
 
+// TextOverflow controls how text that does not fit its box is shown: text
+// cut off by a line limit, too wide to fit without wrapping, or too tall for
+// its box.
+type TextOverflow int
+
+const (
+	// TextOverflowVisible paints overflowing text outside the box (zero
+	// value).
+	TextOverflowVisible TextOverflow = iota
+	// TextOverflowClip clips overflowing text at the edges of the box.
+	TextOverflowClip
+	// TextOverflowEllipsis ends the last visible line with an ellipsis
+	// ("…") where the text is cut off.
+	TextOverflowEllipsis
+	// TextOverflowFade fades overflowing text out toward the edge of the
+	// box where it is cut off.
+	TextOverflowFade
+)
+
+// String returns a human-readable representation of the text overflow mode.
+func (o TextOverflow) String() string {
+	switch o {
+	case TextOverflowVisible:
+		return "visible"
+	case TextOverflowClip:
+		return "clip"
+	case TextOverflowEllipsis:
+		return "ellipsis"
+	case TextOverflowFade:
+		return "fade"
+	default:
+		return fmt.Sprintf("TextOverflow(%d)", int(o))
+	}
+}
+
 // TextAlign controls paragraph-level horizontal alignment for wrapped text.
 //
 // Alignment only has a visible effect when the text is laid out with a
@@ -138,11 +174,11 @@ const (
 	// TextAlignJustify stretches lines so both edges are flush with the
 	// paragraph bounds. The last line of a paragraph is left-aligned.
 	TextAlignJustify
-	// TextAlignStart aligns lines to the start edge based on text direction.
-	// Currently behaves like [TextAlignLeft] (LTR only).
+	// TextAlignStart aligns lines to the start edge for the paragraph's
+	// [TextDirection]: left in LTR text, right in RTL text.
 	TextAlignStart
-	// TextAlignEnd aligns lines to the end edge based on text direction.
-	// Currently behaves like [TextAlignRight] (LTR only).
+	// TextAlignEnd aligns lines to the end edge for the paragraph's
+	// [TextDirection]: right in LTR text, left in RTL text.
 	TextAlignEnd
 )
 
@@ -166,16 +202,146 @@ func (a TextAlign) String() string {
 	}
 }
 
+// Resolve returns the physical alignment for direction: [TextAlignStart]
+// and [TextAlignEnd] become [TextAlignLeft] or [TextAlignRight], and other
+// values are returned unchanged.
+func (a TextAlign) Resolve(direction TextDirection) TextAlign {
+	switch a {
+	case TextAlignStart:
+		if direction == TextDirectionRTL {
+			return TextAlignRight
+		}
+		return TextAlignLeft
+	case TextAlignEnd:
+		if direction == TextDirectionRTL {
+			return TextAlignLeft
+		}
+		return TextAlignRight
+	}
+	return a
+}
+
+// TextDirection is the direction in which text flows: left-to-right for
+// scripts such as Latin, right-to-left for scripts such as Arabic and
+// Hebrew.
+//
+// Besides shaping and aligning text, the direction decides which side is
+// the start of a layout; see widgets.Directionality.
+type TextDirection int
+
+const (
+	// TextDirectionLTR lays out text and start-aligned content from left to
+	// right.
+	TextDirectionLTR TextDirection = iota
+	// TextDirectionRTL lays out text and start-aligned content from right to
+	// left.
+	TextDirectionRTL
+)
+
+// String returns "ltr" or "rtl".
+func (d TextDirection) String() string {
+	switch d {
+	case TextDirectionLTR:
+		return "ltr"
+	case TextDirectionRTL:
+		return "rtl"
+	default:
+		return fmt.Sprintf("TextDirection(%d)", int(d))
+	}
+}
+
+// FontVariations sets the axes of a variable font, so one font file can
+// render any weight, width, or slant in its range. A zero field leaves its
+// axis at the font's default, and fonts without an axis ignore it.
+//
+// Unlike [FontWeight], the coordinates are continuous, so animating them
+// (see animation.LerpFontVariations) changes the glyphs smoothly.
+type FontVariations struct {
+	// Weight is the "wght" axis, usually 100 to 900 where 400 is regular.
+	Weight float64
+	// Width is the "wdth" axis, a percentage of the normal width: 75 is
+	// condensed and 125 expanded.
+	Width float64
+	// Slant is the "slnt" axis in degrees; negative values lean to the
+	// right.
+	Slant float64
+}
+
+// IsZero reports whether no axis is set.
+func (v FontVariations) IsZero() bool {
+	return v == FontVariations{}
+}
+
+func (v FontVariations) bridgeValue() skia.FontVariations {
+	return skia.FontVariations{
+		Weight: float32(v.Weight),
+		Width:  float32(v.Width),
+		Slant:  float32(v.Slant),
+	}
+}
+
+// resolveFontWeight returns the weight that selects a face: weight when set,
+// else the variable weight rounded to a standard weight, so a family of
+// static files picks its nearest face. Zero means the default.
+func resolveFontWeight(weight FontWeight, variations FontVariations) int {
+	if weight >= 100 {
+		return int(weight)
+	}
+	if variations.Weight > 0 {
+		return int(min(max(math.Round(variations.Weight/100)*100, 100), 900))
+	}
+	return 0
+}
+
 // TextStyle describes how text should be rendered.
 type TextStyle struct {
-	Color              Color
-	Gradient           *Gradient
-	FontFamily         string
-	FontSize           float64
-	FontWeight         FontWeight
-	FontStyle          FontStyle
+	Color      Color
+	Gradient   *Gradient
+	FontFamily string
+	FontSize   float64
+	FontWeight FontWeight
+	FontStyle  FontStyle
+	// FontVariations sets the axes of a variable font. When FontWeight is
+	// unset, the variable weight also picks the face.
+	FontVariations     FontVariations
 	PreserveWhitespace bool
 	Shadow             *TextShadow
+	// Shadows are drawn behind the text after Shadow, in order, so several
+	// can be layered, such as a glow under a drop shadow. It is a pointer so
+	// TextStyle stays comparable.
+	Shadows *[]TextShadow
+	// Decoration draws a line under, over, or through the text. Zero draws
+	// none.
+	Decoration TextDecoration
+	// DecorationColor is the color of the decoration line. Zero uses Color.
+	DecorationColor Color
+	// DecorationStyle is the style of the decoration line. Zero draws a
+	// solid line.
+	DecorationStyle TextDecorationStyle
+	// StrokeWidth, when positive, outlines the glyphs with a stroke of this
+	// width in Color or Gradient instead of filling them. For filled text
+	// with an outline, stack a stroked Text over a filled one.
+	StrokeWidth float64
+	// LetterSpacing is extra space added after each character, in logical
+	// pixels. Negative values tighten the text.
+	LetterSpacing float64
+	// WordSpacing is extra space added after each space, in logical pixels.
+	WordSpacing float64
+	// Height sets the height of each line as a multiple of FontSize, such
+	// as 1.5 for loose body text. Zero uses the font's own line spacing.
+	Height float64
+}
+
+// textShadows returns the style's shadows in paint order.
+func (s TextStyle) textShadows() []TextShadow {
+	var shadows []TextShadow
+	if s.Shadow != nil {
+		shadows = append(shadows, *s.Shadow)
+	}
+	if s.Shadows != nil {
+		shadows = append(shadows, *s.Shadows...)
+	}
+	return shadows
 }
 
 // WithColor returns a copy of the TextStyle with the specified color.
@@ -200,7 +366,21 @@ type TextLayout struct {
 	Face       font.Face
 	LineHeight float64
 	Lines      []TextLine
-	paragraph  *skia.Paragraph
+	// DidExceedMaxLines reports whether lines were cut off by
+	// [ParagraphOptions.MaxLines].
+	DidExceedMaxLines bool
+	paragraph         *skia.Paragraph
+	// families lists the span families of rich text layouts and the
+	// fallback chain at layout time.
+	families []string
+}
+
+// UsesFamily reports whether the layout shaped text in family, including
+// text that fell back to another font because family was not registered
+// yet, and the families of the fallback chain. Such layouts must be laid out
+// again once the family registers.
+func (l *TextLayout) UsesFamily(family string) bool {
+	return l.Style.FontFamily == family || slices.Contains(l.families, family)
 }
 
 // FontManager manages font registration for text graphics.
@@ -208,6 +388,55 @@ type FontManager struct {
 	mu          sync.RWMutex
 	fonts       map[string]struct{}
 	defaultName string
+	listeners   fontListeners
+	fallbacks   []string
+}
+
+// fontListeners is a set of functions called when a font is registered.
+type fontListeners struct {
+	mu     sync.Mutex
+	fns    map[int]func(family string)
+	nextID int
+}
+
+func (l *fontListeners) add(fn func(family string)) func() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.fns == nil {
+		l.fns = make(map[int]func(string))
+	}
+	id := l.nextID
+	l.nextID++
+	l.fns[id] = fn
+	return func() {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		delete(l.fns, id)
+	}
+}
+
+func (l *fontListeners) notify(family string) {
+	l.mu.Lock()
+	fns := make([]func(string), 0, len(l.fns))
+	for _, fn := range l.fns {
+		fns = append(fns, fn)
+	}
+	l.mu.Unlock()
+	for _, fn := range fns {
+		fn(family)
+	}
+}
+
+// globalFontListeners are notified when any font manager registers a font.
+var globalFontListeners fontListeners
+
+// AddFontListener registers fn to be called with the family name each time
+// any [FontManager] registers a font, on the registering goroutine. Unlike
+// [FontManager.AddListener], it does not create the default font manager,
+// so it is safe to call during package initialization. It returns a
+// function that removes the listener.
+func AddFontListener(fn func(family string)) func() {
+	return globalFontListeners.add(fn)
 }
 
 var (
@@ -259,12 +488,72 @@ func (m *FontManager) RegisterFont(name string, data []byte) error {
 	if err := skia.RegisterFont(name, data); err != nil {
 		return err
 	}
+	m.fontRegistered(name)
+	return nil
+}
+
+// RegisterFontStyle registers one weight and style of a font family from
+// TrueType or OpenType data. Register each file of a family under the same
+// name; text in the family then uses the face closest to its FontWeight and
+// FontStyle. Registering the same weight and style again replaces the face.
+func (m *FontManager) RegisterFontStyle(name string, data []byte, weight FontWeight, style FontStyle) error {
+	if name == "" {
+		return stderrors.New("font name required")
+	}
+	if weight == 0 {
+		weight = FontWeightNormal
+	}
+	if err := skia.RegisterFontStyle(name, data, int(weight), fontStyleBridgeValue(style)); err != nil {
+		return err
+	}
+	m.fontRegistered(name)
+	return nil
+}
+
+// SetFallbacks sets the font families tried, in order, for characters the
+// text's own family has no glyph for, such as CJK, Arabic, or emoji in a
+// Latin font. Characters the chain does not cover use a font the platform
+// picks for each character, so emoji and most scripts render without a
+// chain; list families, typically bundled ones, to choose the font yourself.
+//
+// Set the chain at startup, before text is laid out. Text laid out earlier
+// keeps its fonts until it is laid out again for another reason.
+func (m *FontManager) SetFallbacks(families ...string) error {
+	families = slices.DeleteFunc(slices.Clone(families), func(family string) bool { return family == "" })
+	if err := skia.SetFontFallbacks(families); err != nil {
+		return err
+	}
 	m.mu.Lock()
 	defer m.mu.Unlock()
-	m.fonts[name] = struct{}{}
+	m.fallbacks = families
 	return nil
 }
 
+// Fallbacks returns the fallback chain set by [FontManager.SetFallbacks].
+func (m *FontManager) Fallbacks() []string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return slices.Clone(m.fallbacks)
+}
+
+// AddListener registers fn to be called with the family name each time a
+// font is registered, on the registering goroutine. Text already laid out in
+// that family must be laid out again to use the new font. It returns a
+// function that removes the listener.
+func (m *FontManager) AddListener(fn func(family string)) func() {
+	return m.listeners.add(fn)
+}
+
+// fontRegistered records family and notifies the manager's listeners, then
+// those added with [AddFontListener].
+func (m *FontManager) fontRegistered(family string) {
+	m.mu.Lock()
+	m.fonts[family] = struct{}{}
+	m.mu.Unlock()
+	m.listeners.notify(family)
+	globalFontListeners.notify(family)
+}
+
 // Face resolves a font face for the given style.
 // Skia-backed builds do not expose font.Face instances.
 func (m *FontManager) Face(style TextStyle) (font.Face, error) {
@@ -284,6 +573,68 @@ type ParagraphOptions struct {
 	// TextAlign controls horizontal alignment of lines within the paragraph.
 	// The zero value ([TextAlignLeft]) aligns lines to the left edge.
 	TextAlign TextAlign
+	// TextDirection is the base direction of the paragraph, which orders
+	// runs of mixed-direction text and resolves [TextAlignStart] and
+	// [TextAlignEnd]. The zero value is [TextDirectionLTR].
+	TextDirection TextDirection
+	// Strut sets a minimum height for every line. The zero value sets
+	// none.
+	Strut StrutStyle
+	// Ellipsis, if set, ends the last line with this string where the text
+	// is cut off by MaxLines or does not fit MaxWidth. It has no effect
+	// without a MaxLines or MaxWidth to cut the text off.
+	Ellipsis string
+}
+
+// StrutStyle sets the minimum height of every line of a paragraph from a
+// font, so lines keep the same spacing whatever they contain, such as an
+// emoji, a larger span, or text in a fallback font with taller metrics.
+//
+//	graphics.StrutStyle{FontSize: 14, Height: 1.4, ForceStrutHeight: true}
+//
+// The zero value sets no strut.
+type StrutStyle struct {
+	// FontFamily is the font whose metrics size the lines. Empty uses the
+	// platform's default font.
+	FontFamily string
+	// FontSize is the font size of the strut. Zero uses the text's font
+	// size.
+	FontSize   float64
+	FontWeight FontWeight
+	FontStyle  FontStyle
+	// Height sets the line height as a multiple of FontSize. Zero uses the
+	// font's own line spacing.
+	Height float64
+	// Leading is extra space added to each line, as a multiple of FontSize.
+	Leading float64
+	// ForceStrutHeight makes every line exactly the strut's height, even
+	// lines whose text is taller, for text that must sit on a fixed grid.
+	ForceStrutHeight bool
+}
+
+// IsZero reports whether no strut is set.
+func (s StrutStyle) IsZero() bool {
+	return s == StrutStyle{}
+}
+
+// bridgeValue converts the strut for the bridge, using fontSize when its
+// own is unset. It returns nil for the zero strut.
+func (s StrutStyle) bridgeValue(fontSize float64) *skia.ParagraphStrut {
+	if s.IsZero() {
+		return nil
+	}
+	if s.FontSize > 0 {
+		fontSize = s.FontSize
+	}
+	return &skia.ParagraphStrut{
+		Family:      s.FontFamily,
+		Size:        float32(fontSize),
+		Weight:      int(s.FontWeight),
+		Style:       fontStyleBridgeValue(s.FontStyle),
+		Height:      float32(s.Height),
+		Leading:     float32(s.Leading),
+		ForceHeight: s.ForceStrutHeight,
+	}
 }
 
 // LayoutText measures and shapes text using the provided font manager.
@@ -309,14 +660,15 @@ func LayoutTextWithOptions(text string, style TextStyle, manager *FontManager, o
 	if size <= 0 {
 		size = defaultFontSize
 	}
-	weight := int(style.FontWeight)
-	if weight < 100 {
+	weight := resolveFontWeight(style.FontWeight, style.FontVariations)
+	if weight == 0 {
 		weight = int(FontWeightNormal)
 	}
 	layout, err := layoutParagraph(text, style, family, size, weight, opts)
 	if err != nil {
 		return nil, err
 	}
+	layout.families = manager.Fallbacks()
 	// Release the native Skia paragraph when the Go layout is garbage collected.
 	runtime.SetFinalizer(layout, func(layout *TextLayout) {
 		if layout != nil && layout.paragraph != nil {
@@ -337,16 +689,22 @@ func layoutParagraph(text string, style TextStyle, family string, size float64,
 	}
 	maxLines := opts.MaxLines
 	textAlign := opts.TextAlign
-
-	var shadow *skia.ParagraphShadow
-	if style.Shadow != nil {
-		shadow = &skia.ParagraphShadow{
-			Color:   uint32(style.Shadow.Color),
-			OffsetX: float32(style.Shadow.Offset.X),
-			OffsetY: float32(style.Shadow.Offset.Y),
-			Sigma:   float32(style.Shadow.Sigma()),
-		}
+	strut := opts.Strut.bridgeValue(size)
+
+	var shadows []skia.ParagraphShadow
+	for _, shadow := range style.textShadows() {
+		shadows = append(shadows, skia.ParagraphShadow{
+			Color:   uint32(shadow.Color),
+			OffsetX: float32(shadow.Offset.X),
+			OffsetY: float32(shadow.Offset.Y),
+			Sigma:   float32(shadow.Sigma()),
+		})
+	}
+	decoration := 0
+	if int(style.Decoration) >= 0 && int(style.Decoration) < len(decorationToSkia) {
+		decoration = decorationToSkia[style.Decoration]
 	}
+	decorationStyle := max(int(style.DecorationStyle)-1, 0)
 
 	// For gradients, we need actual layout dimensions to resolve relative coordinates.
 	// Do a two-pass approach: first layout without gradient to get size, then
@@ -365,13 +723,24 @@ func layoutParagraph(text string, style TextStyle, family string, size float64,
 		float32(size),
 		weight,
 		fontStyleBridgeValue(style.FontStyle),
+		style.FontVariations.bridgeValue(),
 		uint32(style.Color),
 		maxLines,
 		gradientType,
 		startX, startY, endX, endY, centerX, centerY, radius,
 		colors, positions,
-		shadow,
+		shadows,
+		decoration,
+		uint32(style.DecorationColor),
+		decorationStyle,
+		float32(style.StrokeWidth),
+		float32(style.LetterSpacing),
+		float32(style.WordSpacing),
+		float32(style.Height),
 		int(textAlign),
+		int(opts.TextDirection),
+		strut,
+		opts.Ellipsis,
 	)
 	if err != nil {
 		return nil, err
@@ -406,13 +775,24 @@ func layoutParagraph(text string, style TextStyle, family string, size float64,
 			float32(size),
 			weight,
 			fontStyleBridgeValue(style.FontStyle),
+			style.FontVariations.bridgeValue(),
 			uint32(style.Color),
 			maxLines,
 			gradientType,
 			startX, startY, endX, endY, centerX, centerY, radius,
 			colors, positions,
-			shadow,
+			shadows,
+			decoration,
+			uint32(style.DecorationColor),
+			decorationStyle,
+			float32(style.StrokeWidth),
+			float32(style.LetterSpacing),
+			float32(style.WordSpacing),
+			float32(style.Height),
 			int(textAlign),
+			int(opts.TextDirection),
+			strut,
+			opts.Ellipsis,
 		)
 		if err != nil {
 			return nil, err
@@ -478,14 +858,15 @@ func layoutParagraph(text string, style TextStyle, family string, size float64,
 		}
 	}
 	return &TextLayout{
-		Text:       text,
-		Style:      style,
-		Size:       layoutSize,
-		Ascent:     ascent,
-		Descent:    descent,
-		Face:       nil,
-		LineHeight: lineHeight,
-		Lines:      lines,
-		paragraph:  paragraph,
+		Text:              text,
+		Style:             style,
+		Size:              layoutSize,
+		Ascent:            ascent,
+		Descent:           descent,
+		Face:              nil,
+		LineHeight:        lineHeight,
+		Lines:             lines,
+		paragraph:         paragraph,
+		DidExceedMaxLines: metrics.DidExceedMaxLines,
 	}, nil
 }
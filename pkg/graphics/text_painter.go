@@ -0,0 +1,284 @@
+package graphics
+
+import (
+	"math"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// TextPainter lays out and paints a [TextSpan], for render objects and
+// drawing code that place text themselves, such as chart labels or editors.
+//
+//	painter := &graphics.TextPainter{
+//	    Text:  graphics.Span("42%"),
+//	    Style: graphics.SpanStyle{FontSize: 12, Color: graphics.ColorBlack},
+//	}
+//	painter.Layout(0, 100)
+//	size := painter.Size()
+//	painter.Paint(canvas, graphics.Offset{X: cx - size.Width/2, Y: cy - size.Height/2})
+//
+// Call Layout before the other methods, and again after changing a field.
+// Text offsets are byte offsets into the span's plain text (see
+// [TextSpan.PlainText]). Positions are relative to the painter's top-left
+// corner.
+type TextPainter struct {
+	// Text is the text to lay out.
+	Text TextSpan
+	// Style is the base style that spans inherit from.
+	Style SpanStyle
+	// TextAlign aligns lines within the laid-out width.
+	TextAlign TextAlign
+	// TextDirection is the base direction of the text.
+	TextDirection TextDirection
+	// MaxLines limits the number of lines. 0 means unlimited.
+	MaxLines int
+	// Strut sets a minimum height for every line. The zero value sets
+	// none.
+	Strut StrutStyle
+	// FontManager shapes the text. Nil uses [DefaultFontManager].
+	FontManager *FontManager
+
+	layout *TextLayout
+	text   string
+	size   Size
+	lines  []LineMetrics
+}
+
+// LineMetrics describes one line of laid-out text.
+type LineMetrics struct {
+	// StartOffset and EndOffset are the byte offsets of the line's text,
+	// with EndOffset including trailing whitespace and newlines.
+	StartOffset int
+	EndOffset   int
+	// Left is the x position of the line's start.
+	Left float64
+	// Baseline is the y position of the line's baseline.
+	Baseline float64
+	// Width is the width of the line's glyphs.
+	Width float64
+	// Height is the height of the line, including line spacing.
+	Height float64
+	// Ascent and Descent are the distances above and below the baseline
+	// taken up by the line's glyphs.
+	Ascent  float64
+	Descent float64
+}
+
+// TextBox is the bounding box of a run of laid-out text.
+type TextBox struct {
+	Rect      Rect
+	Direction TextDirection
+}
+
+// Layout lays the text out to a width between minWidth and maxWidth,
+// wrapping lines at maxWidth. An infinite maxWidth lays each line out at its
+// natural width.
+//
+// On error, the painter is empty and sized to minWidth.
+func (p *TextPainter) Layout(minWidth, maxWidth float64) error {
+	p.layout = nil
+	p.lines = nil
+	p.text = p.Text.PlainText()
+	p.size = Size{Width: max(minWidth, 0)}
+
+	manager := p.FontManager
+	if manager == nil {
+		var err error
+		if manager, err = DefaultFontManagerErr(); err != nil {
+			return err
+		}
+	}
+	wrapWidth := maxWidth
+	if math.IsInf(wrapWidth, 1) || wrapWidth < 0 {
+		wrapWidth = 0
+	}
+	layout, err := LayoutRichText(p.Text, p.Style, manager, ParagraphOptions{
+		MaxWidth:      wrapWidth,
+		MaxLines:      p.MaxLines,
+		TextAlign:     p.TextAlign,
+		TextDirection: p.TextDirection,
+		Strut:         p.Strut,
+	})
+	if err != nil {
+		return err
+	}
+	p.layout = layout
+
+	// Aligned lines are placed within the wrap width, so the painter must
+	// span all of it.
+	size := layout.Size
+	if p.TextAlign.Resolve(p.TextDirection) != TextAlignLeft && wrapWidth > 0 {
+		size.Width = wrapWidth
+	}
+	size.Width = max(min(size.Width, maxWidth), minWidth)
+	p.size = size
+
+	if metrics, err := layout.paragraph.LineMetrics(); err == nil {
+		p.lines = make([]LineMetrics, len(metrics.Widths))
+		for i := range p.lines {
+			p.lines[i] = LineMetrics{
+				StartOffset: metrics.Starts[i],
+				EndOffset:   metrics.Ends[i],
+				Left:        metrics.Lefts[i],
+				Baseline:    metrics.Baselines[i],
+				Width:       metrics.Widths[i],
+				Height:      metrics.Heights[i],
+				Ascent:      math.Abs(metrics.Ascents[i]),
+				Descent:     metrics.Descents[i],
+			}
+		}
+	}
+	return nil
+}
+
+// Size returns the size of the laid-out text.
+func (p *TextPainter) Size() Size {
+	return p.size
+}
+
+// LineMetrics returns the metrics of each laid-out line, in order.
+func (p *TextPainter) LineMetrics() []LineMetrics {
+	return p.lines
+}
+
+// PlaceholderRects returns the boxes of the text's placeholder spans, in
+// order. Placeholders cut off by MaxLines are left out.
+func (p *TextPainter) PlaceholderRects() []Rect {
+	return p.layout.PlaceholderRects()
+}
+
+// Paint draws the text with its top-left corner at offset.
+func (p *TextPainter) Paint(canvas Canvas, offset Offset) {
+	if p.layout == nil {
+		return
+	}
+	canvas.DrawText(p.layout, offset)
+}
+
+// GetPositionForOffset returns the text offset nearest to position, for
+// placing a caret where the user tapped.
+func (p *TextPainter) GetPositionForOffset(position Offset) int {
+	if p.layout == nil {
+		return 0
+	}
+	index, _, err := p.layout.paragraph.GlyphPosition(float32(position.X), float32(position.Y))
+	if err != nil {
+		return 0
+	}
+	return byteOffset(p.text, index)
+}
+
+// GetBoxesForRange returns the boxes around the text between the offsets
+// start and end, one per line and direction run, for painting a selection.
+func (p *TextPainter) GetBoxesForRange(start, end int) []TextBox {
+	if p.layout == nil {
+		return nil
+	}
+	start = max(min(start, len(p.text)), 0)
+	end = max(min(end, len(p.text)), start)
+	boxes, err := p.layout.paragraph.RectsForRange(utf16Index(p.text, start), utf16Index(p.text, end))
+	if err != nil {
+		return nil
+	}
+	result := make([]TextBox, len(boxes))
+	for i, box := range boxes {
+		result[i] = TextBox{Rect: Rect{Left: box.Left, Top: box.Top, Right: box.Right, Bottom: box.Bottom}}
+		if box.RTL {
+			result[i].Direction = TextDirectionRTL
+		}
+	}
+	return result
+}
+
+// GetOffsetForCaret returns the top of a caret placed at offset.
+func (p *TextPainter) GetOffsetForCaret(offset int) Offset {
+	box, trailing, ok := p.caretBox(offset)
+	if !ok {
+		line := p.lineAt(offset)
+		return Offset{X: line.Left, Y: line.Baseline - line.Ascent}
+	}
+	// The caret follows the previous character or leads the next one, on
+	// the side that depends on the character's direction.
+	if trailing != (box.Direction == TextDirectionRTL) {
+		return Offset{X: box.Rect.Right, Y: box.Rect.Top}
+	}
+	return Offset{X: box.Rect.Left, Y: box.Rect.Top}
+}
+
+// GetFullHeightForCaret returns the height of a caret placed at offset,
+// which is the height of its line.
+func (p *TextPainter) GetFullHeightForCaret(offset int) float64 {
+	if box, _, ok := p.caretBox(offset); ok {
+		return box.Rect.Height()
+	}
+	return p.lineAt(offset).Height
+}
+
+// caretBox returns the box of the character before offset, with trailing
+// set, or of the character after it at the start of a line.
+func (p *TextPainter) caretBox(offset int) (box TextBox, trailing, ok bool) {
+	offset = max(min(offset, len(p.text)), 0)
+	if offset > 0 && p.text[offset-1] != '\n' {
+		_, size := utf8.DecodeLastRuneInString(p.text[:offset])
+		if boxes := p.GetBoxesForRange(offset-size, offset); len(boxes) > 0 {
+			return boxes[len(boxes)-1], true, true
+		}
+	}
+	if offset < len(p.text) && p.text[offset] != '\n' {
+		_, size := utf8.DecodeRuneInString(p.text[offset:])
+		if boxes := p.GetBoxesForRange(offset, offset+size); len(boxes) > 0 {
+			return boxes[0], false, true
+		}
+	}
+	return TextBox{}, false, false
+}
+
+// lineAt returns the metrics of the line containing offset, or of an empty
+// line at the text's alignment when there are none.
+func (p *TextPainter) lineAt(offset int) LineMetrics {
+	for i := len(p.lines) - 1; i >= 0; i-- {
+		if p.lines[i].StartOffset <= offset {
+			return p.lines[i]
+		}
+	}
+	if len(p.lines) > 0 {
+		return p.lines[0]
+	}
+	var line LineMetrics
+	switch p.TextAlign.Resolve(p.TextDirection) {
+	case TextAlignRight:
+		line.Left = p.size.Width
+	case TextAlignCenter:
+		line.Left = p.size.Width / 2
+	}
+	if p.layout != nil {
+		line.Height = p.layout.LineHeight
+		line.Ascent = p.layout.Ascent
+		line.Baseline = p.layout.Ascent
+	}
+	return line
+}
+
+// utf16Index converts a byte offset in text to a UTF-16 index.
+func utf16Index(text string, offset int) int {
+	index := 0
+	for i, r := range text {
+		if i >= offset {
+			break
+		}
+		index += utf16.RuneLen(r)
+	}
+	return index
+}
+
+// byteOffset converts a UTF-16 index in text to a byte offset.
+func byteOffset(text string, index int) int {
+	units := 0
+	for i, r := range text {
+		if units >= index {
+			return i
+		}
+		units += utf16.RuneLen(r)
+	}
+	return len(text)
+}
@@ -0,0 +1,54 @@
+package graphics
+
+import (
+	"testing"
+)
+
+func TestTextOffsets_UTF16RoundTrip(t *testing.T) {
+	// "é" is two bytes and one UTF-16 unit; "😀" is four bytes and two units.
+	text := "aé😀b"
+	cases := []struct{ byteOffset, utf16 int }{
+		{0, 0},
+		{1, 1},
+		{3, 2},
+		{7, 4},
+		{8, 5},
+	}
+	for _, c := range cases {
+		if got := utf16Index(text, c.byteOffset); got != c.utf16 {
+			t.Errorf("utf16Index(%d) = %d, want %d", c.byteOffset, got, c.utf16)
+		}
+		if got := byteOffset(text, c.utf16); got != c.byteOffset {
+			t.Errorf("byteOffset(%d) = %d, want %d", c.utf16, got, c.byteOffset)
+		}
+	}
+	// An index inside a surrogate pair moves to the end of the character.
+	if got := byteOffset(text, 3); got != 7 {
+		t.Errorf("byteOffset(3) = %d, want 7", got)
+	}
+}
+
+func TestTextPainter_CaretWithoutLinesFollowsAlignment(t *testing.T) {
+	painter := &TextPainter{TextAlign: TextAlignEnd}
+	painter.size = Size{Width: 100}
+	if got := painter.GetOffsetForCaret(0); got.X != 100 {
+		t.Errorf("end-aligned caret at x = %v, want 100", got.X)
+	}
+	painter.TextDirection = TextDirectionRTL
+	if got := painter.GetOffsetForCaret(0); got.X != 0 {
+		t.Errorf("end-aligned RTL caret at x = %v, want 0", got.X)
+	}
+}
+
+func TestTextPainter_LineAt(t *testing.T) {
+	painter := &TextPainter{lines: []LineMetrics{
+		{StartOffset: 0, EndOffset: 6, Baseline: 10},
+		{StartOffset: 6, EndOffset: 11, Baseline: 30},
+	}}
+	if got := painter.lineAt(3).Baseline; got != 10 {
+		t.Errorf("lineAt(3) baseline = %v, want 10", got)
+	}
+	if got := painter.lineAt(6).Baseline; got != 30 {
+		t.Errorf("lineAt(6) baseline = %v, want 30", got)
+	}
+}
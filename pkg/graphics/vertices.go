@@ -0,0 +1,126 @@
+package graphics
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// VertexMode describes how the positions of a [Vertices] mesh form triangles.
+type VertexMode int
+
+const (
+	// VertexModeTriangles draws each group of three vertices as a triangle.
+	VertexModeTriangles VertexMode = iota
+	// VertexModeTriangleStrip draws a triangle for each vertex after the
+	// second, using it and the two before it.
+	VertexModeTriangleStrip
+	// VertexModeTriangleFan draws a triangle for each vertex after the
+	// second, using it, the one before it, and the first vertex.
+	VertexModeTriangleFan
+)
+
+// String returns a human-readable representation of the vertex mode.
+func (m VertexMode) String() string {
+	switch m {
+	case VertexModeTriangles:
+		return "triangles"
+	case VertexModeTriangleStrip:
+		return "triangle_strip"
+	case VertexModeTriangleFan:
+		return "triangle_fan"
+	default:
+		return fmt.Sprintf("VertexMode(%d)", int(m))
+	}
+}
+
+// Vertices is a triangle mesh drawn with [Canvas.DrawVertices].
+//
+// Each vertex has a position and, optionally, a color and a texture
+// coordinate. Colors are interpolated across each triangle, which makes
+// meshes suited to mesh gradients and smooth chart fills. Texture coordinates
+// map vertices to points in an image or shader, which warps the image over
+// the mesh:
+//
+//	// A quad whose top edge is pinched, drawn with an image.
+//	mesh := &graphics.Vertices{
+//	    Mode:               graphics.VertexModeTriangleStrip,
+//	    Positions:          []graphics.Offset{{X: 20, Y: 0}, {X: 80, Y: 0}, {X: 0, Y: 100}, {X: 100, Y: 100}},
+//	    TextureCoordinates: []graphics.Offset{{X: 0, Y: 0}, {X: w, Y: 0}, {X: 0, Y: h}, {X: w, Y: h}},
+//	}
+//	canvas.DrawVertices(mesh, img, graphics.DefaultPaint())
+type Vertices struct {
+	// Mode determines how vertices are grouped into triangles.
+	Mode VertexMode
+
+	// Positions are the vertex positions in canvas coordinates.
+	Positions []Offset
+
+	// Colors are optional per-vertex colors, one per position.
+	Colors []Color
+
+	// TextureCoordinates are optional per-vertex points in the texture, one
+	// per position. The texture is the image passed to DrawVertices, in
+	// pixels, or else the paint's shader. If nil, positions are used.
+	TextureCoordinates []Offset
+
+	// Indices optionally selects vertices by index, letting triangles share
+	// vertices. If nil, vertices are used in order.
+	Indices []uint16
+}
+
+// Validate reports whether the mesh can be drawn: colors and texture
+// coordinates, when present, must match the number of positions, and every
+// index must refer to a position.
+func (v *Vertices) Validate() error {
+	if v == nil {
+		return errors.New("graphics: nil vertices")
+	}
+	if v.Mode < VertexModeTriangles || v.Mode > VertexModeTriangleFan {
+		return fmt.Errorf("graphics: unknown vertex mode %v", v.Mode)
+	}
+	n := len(v.Positions)
+	if v.Colors != nil && len(v.Colors) != n {
+		return fmt.Errorf("graphics: %d colors for %d vertices", len(v.Colors), n)
+	}
+	if v.TextureCoordinates != nil && len(v.TextureCoordinates) != n {
+		return fmt.Errorf("graphics: %d texture coordinates for %d vertices", len(v.TextureCoordinates), n)
+	}
+	for _, i := range v.Indices {
+		if int(i) >= n {
+			return fmt.Errorf("graphics: index %d out of range for %d vertices", i, n)
+		}
+	}
+	return nil
+}
+
+// Bounds returns the smallest rectangle containing every position.
+func (v *Vertices) Bounds() Rect {
+	if v == nil || len(v.Positions) == 0 {
+		return Rect{}
+	}
+	first := v.Positions[0]
+	r := Rect{Left: first.X, Top: first.Y, Right: first.X, Bottom: first.Y}
+	for _, p := range v.Positions[1:] {
+		r.Left = math.Min(r.Left, p.X)
+		r.Top = math.Min(r.Top, p.Y)
+		r.Right = math.Max(r.Right, p.X)
+		r.Bottom = math.Max(r.Bottom, p.Y)
+	}
+	return r
+}
+
+// copyVertices returns a deep copy of v, so recordings are unaffected by
+// later changes to the caller's slices.
+func copyVertices(v *Vertices) *Vertices {
+	if v == nil {
+		return nil
+	}
+	return &Vertices{
+		Mode:               v.Mode,
+		Positions:          append([]Offset(nil), v.Positions...),
+		Colors:             append([]Color(nil), v.Colors...),
+		TextureCoordinates: append([]Offset(nil), v.TextureCoordinates...),
+		Indices:            append([]uint16(nil), v.Indices...),
+	}
+}
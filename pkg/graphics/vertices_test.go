@@ -0,0 +1,58 @@
+package graphics
+
+import "testing"
+
+func TestVertices_Validate(t *testing.T) {
+	tri := []Offset{{X: 0, Y: 0}, {X: 10, Y: 0}, {X: 0, Y: 10}}
+	tests := []struct {
+		name    string
+		v       *Vertices
+		wantErr bool
+	}{
+		{"positions only", &Vertices{Positions: tri}, false},
+		{"with colors and indices", &Vertices{Positions: tri, Colors: []Color{ColorWhite, ColorBlack, ColorWhite}, Indices: []uint16{0, 1, 2}}, false},
+		{"nil", nil, true},
+		{"color count mismatch", &Vertices{Positions: tri, Colors: []Color{ColorWhite}}, true},
+		{"texture count mismatch", &Vertices{Positions: tri, TextureCoordinates: tri[:2]}, true},
+		{"index out of range", &Vertices{Positions: tri, Indices: []uint16{0, 1, 3}}, true},
+		{"unknown mode", &Vertices{Mode: VertexMode(7), Positions: tri}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.v.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestVertices_Bounds(t *testing.T) {
+	v := &Vertices{Positions: []Offset{{X: 5, Y: 10}, {X: -3, Y: 20}, {X: 12, Y: 4}}}
+	want := Rect{Left: -3, Top: 4, Right: 12, Bottom: 20}
+	if got := v.Bounds(); got != want {
+		t.Errorf("Bounds() = %v, want %v", got, want)
+	}
+}
+
+func TestRecordingCanvas_DrawVerticesCopiesMesh(t *testing.T) {
+	v := &Vertices{
+		Mode:      VertexModeTriangleFan,
+		Positions: []Offset{{X: 0, Y: 0}, {X: 10, Y: 0}, {X: 10, Y: 10}, {X: 0, Y: 10}},
+		Colors:    []Color{ColorWhite, ColorWhite, ColorBlack, ColorBlack},
+	}
+	rec := &PictureRecorder{}
+	canvas := rec.BeginRecording(Size{Width: 10, Height: 10})
+	canvas.DrawVertices(v, nil, DefaultPaint())
+	list := rec.EndRecording()
+
+	v.Positions[0] = Offset{X: 99, Y: 99}
+	v.Colors[0] = ColorBlack
+
+	op := list.ops[0].(opVertices)
+	if op.vertices.Positions[0] != (Offset{}) || op.vertices.Colors[0] != ColorWhite {
+		t.Errorf("recorded mesh changed with the caller's slices: %+v", op.vertices)
+	}
+	if op.vertices.TextureCoordinates != nil || op.vertices.Indices != nil {
+		t.Error("absent attributes should stay nil")
+	}
+}
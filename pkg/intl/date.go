@@ -0,0 +1,385 @@
+package intl
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DateFormat formats and parses dates with a pattern. Pattern tokens:
+//
+//	yyyy  four-digit year      yy   two-digit year
+//	MMMM  month name           MMM  abbreviated month name
+//	MM    zero-padded month    M    month
+//	dd    zero-padded day      d    day
+//	EEEE  weekday name         EEE  abbreviated weekday name
+//	HH    24-hour, padded      H    24-hour
+//	hh    12-hour, padded      h    12-hour
+//	mm    minute, padded       ss   second, padded
+//	a     AM/PM marker
+//
+// Text in single quotes is copied literally; any other character is a
+// literal separator. Use [DateFormatOf] for a locale's own patterns:
+//
+//	intl.DateFormatOf(intl.ParseLocale("de"), intl.DateStyleLong).Format(t) // "7. März 2025"
+type DateFormat struct {
+	Pattern string
+	// Locale selects the month and weekday names and the AM/PM markers.
+	// Zero uses [DefaultLocale].
+	Locale Locale
+}
+
+// dateToken is one parsed element of a date pattern.
+type dateToken struct {
+	field   string // pattern letters such as "yyyy", or "" for a literal
+	literal string
+}
+
+var dateFields = []string{
+	"yyyy", "yy", "MMMM", "MMM", "MM", "M", "dd", "d", "EEEE", "EEE",
+	"HH", "H", "hh", "h", "mm", "ss", "a",
+}
+
+// tokens splits the pattern into fields and literals.
+func (f DateFormat) tokens() []dateToken {
+	var tokens []dateToken
+	addLiteral := func(s string) {
+		if n := len(tokens); n > 0 && tokens[n-1].field == "" {
+			tokens[n-1].literal += s
+			return
+		}
+		tokens = append(tokens, dateToken{literal: s})
+	}
+	p := f.Pattern
+	for p != "" {
+		if p[0] == '\'' {
+			end := strings.IndexByte(p[1:], '\'')
+			if end < 0 {
+				addLiteral(p[1:])
+				break
+			}
+			addLiteral(p[1 : end+1])
+			p = p[end+2:]
+			continue
+		}
+		matched := false
+		for _, field := range dateFields {
+			if strings.HasPrefix(p, field) {
+				tokens = append(tokens, dateToken{field: field})
+				p = p[len(field):]
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			r := []rune(p)[0]
+			addLiteral(string(r))
+			p = p[len(string(r)):]
+		}
+	}
+	return tokens
+}
+
+// Format returns t formatted with the pattern.
+func (f DateFormat) Format(t time.Time) string {
+	names := dateNamesOf(f.Locale.resolve())
+	var b strings.Builder
+	for _, tok := range f.tokens() {
+		switch tok.field {
+		case "":
+			b.WriteString(tok.literal)
+		case "yyyy":
+			b.WriteString(pad(t.Year(), 4))
+		case "yy":
+			b.WriteString(pad(t.Year()%100, 2))
+		case "MM":
+			b.WriteString(pad(int(t.Month()), 2))
+		case "M":
+			b.WriteString(strconv.Itoa(int(t.Month())))
+		case "MMMM":
+			b.WriteString(names.months[t.Month()-1])
+		case "MMM":
+			b.WriteString(names.shortMonths[t.Month()-1])
+		case "EEEE":
+			b.WriteString(names.weekdays[t.Weekday()])
+		case "EEE":
+			b.WriteString(names.shortWeekdays[t.Weekday()])
+		case "dd":
+			b.WriteString(pad(t.Day(), 2))
+		case "d":
+			b.WriteString(strconv.Itoa(t.Day()))
+		case "HH":
+			b.WriteString(pad(t.Hour(), 2))
+		case "H":
+			b.WriteString(strconv.Itoa(t.Hour()))
+		case "hh":
+			b.WriteString(pad(hour12(t.Hour()), 2))
+		case "h":
+			b.WriteString(strconv.Itoa(hour12(t.Hour())))
+		case "mm":
+			b.WriteString(pad(t.Minute(), 2))
+		case "ss":
+			b.WriteString(pad(t.Second(), 2))
+		case "a":
+			b.WriteString(names.dayPeriods[t.Hour()/12])
+		}
+	}
+	return b.String()
+}
+
+// ErrInvalidDate is returned when text does not match a date pattern.
+var ErrInvalidDate = errors.New("intl: invalid date")
+
+// Parse reads a date written with the pattern, in the given location.
+// Two-digit years are placed in 2000-2099. Fields missing from the pattern
+// default to January 1, midnight. Names match regardless of case, and
+// weekday names are checked for spelling but not against the date.
+func (f DateFormat) Parse(text string, loc *time.Location) (time.Time, error) {
+	names := dateNamesOf(f.Locale.resolve())
+	year, month, day, hour, minute, second := 2000, 1, 1, 0, 0, 0
+	pm, hasMarker := false, false
+	rest := text
+	for _, tok := range f.tokens() {
+		if tok.field == "" {
+			if !strings.HasPrefix(rest, tok.literal) {
+				return time.Time{}, ErrInvalidDate
+			}
+			rest = rest[len(tok.literal):]
+			continue
+		}
+		switch tok.field {
+		case "a":
+			i, n := matchName(rest, names.dayPeriods[:], defaultDateNames.dayPeriods[:])
+			if n == 0 {
+				return time.Time{}, ErrInvalidDate
+			}
+			pm, hasMarker = i == 1, true
+			rest = rest[n:]
+			continue
+		case "MMMM", "MMM":
+			i, n := matchName(rest, names.months[:], names.shortMonths[:])
+			if n == 0 {
+				return time.Time{}, ErrInvalidDate
+			}
+			month = i + 1
+			rest = rest[n:]
+			continue
+		case "EEEE", "EEE":
+			_, n := matchName(rest, names.weekdays[:], names.shortWeekdays[:])
+			if n == 0 {
+				return time.Time{}, ErrInvalidDate
+			}
+			rest = rest[n:]
+			continue
+		}
+		width := len(tok.field)
+		if width == 1 {
+			width = 2 // unpadded fields take one or two digits
+		}
+		n := 0
+		for n < width && n < len(rest) && isDigit(rune(rest[n])) {
+			n++
+		}
+		if n == 0 || (len(tok.field) > 1 && n != len(tok.field)) {
+			return time.Time{}, ErrInvalidDate
+		}
+		v, _ := strconv.Atoi(rest[:n])
+		rest = rest[n:]
+		switch tok.field {
+		case "yyyy":
+			year = v
+		case "yy":
+			year = 2000 + v
+		case "MM", "M":
+			month = v
+		case "dd", "d":
+			day = v
+		case "HH", "H", "hh", "h":
+			hour = v
+		case "mm":
+			minute = v
+		case "ss":
+			second = v
+		}
+	}
+	if rest != "" {
+		return time.Time{}, ErrInvalidDate
+	}
+	if hasMarker {
+		if hour < 1 || hour > 12 {
+			return time.Time{}, ErrInvalidDate
+		}
+		hour %= 12
+		if pm {
+			hour += 12
+		}
+	}
+	if loc == nil {
+		loc = time.Local
+	}
+	t := time.Date(year, time.Month(month), day, hour, minute, second, 0, loc)
+	// time.Date normalizes out-of-range values; reject them instead.
+	if t.Year() != year || int(t.Month()) != month || t.Day() != day ||
+		t.Hour() != hour || t.Minute() != minute || t.Second() != second {
+		return time.Time{}, ErrInvalidDate
+	}
+	return t, nil
+}
+
+// shortDatePatternsByLanguage lists languages that differ from dd/MM/yyyy.
+var shortDatePatternsByLanguage = map[string]string{
+	"cs": "dd.MM.yyyy", "da": "dd.MM.yyyy", "de": "dd.MM.yyyy", "fi": "dd.MM.yyyy",
+	"nb": "dd.MM.yyyy", "no": "dd.MM.yyyy", "pl": "dd.MM.yyyy", "ru": "dd.MM.yyyy",
+	"tr": "dd.MM.yyyy", "uk": "dd.MM.yyyy",
+	"hu": "yyyy.MM.dd", "ja": "yyyy/MM/dd", "ko": "yyyy.MM.dd", "zh": "yyyy/MM/dd",
+	"lt": "yyyy-MM-dd", "sv": "yyyy-MM-dd",
+	"nl": "dd-MM-yyyy",
+}
+
+var shortDatePatternsByLocale = map[string]string{
+	"en-CA": "yyyy-MM-dd",
+	"en-US": "MM/dd/yyyy",
+	"es-US": "MM/dd/yyyy",
+}
+
+// ShortDatePattern returns the numeric date pattern for l, such as
+// "MM/dd/yyyy" for en-US and "dd.MM.yyyy" for de. Patterns are zero-padded
+// so they also work as input masks with [DateMaskInputFormatter].
+func ShortDatePattern(l Locale) string {
+	l = l.resolve()
+	if p, ok := shortDatePatternsByLocale[l.String()]; ok {
+		return p
+	}
+	if p, ok := shortDatePatternsByLanguage[l.Language]; ok {
+		return p
+	}
+	if l.Language == "en" && l.Region == "" {
+		return "MM/dd/yyyy"
+	}
+	return "dd/MM/yyyy"
+}
+
+// DateStyle selects how much detail a locale's date pattern shows.
+type DateStyle int
+
+const (
+	// DateStyleShort is numeric, such as 03/07/2025.
+	DateStyleShort DateStyle = iota
+	// DateStyleMedium abbreviates the month, such as Mar 7, 2025.
+	DateStyleMedium
+	// DateStyleLong spells out the month, such as March 7, 2025.
+	DateStyleLong
+	// DateStyleFull adds the weekday, such as Friday, March 7, 2025.
+	DateStyleFull
+)
+
+// datePatterns holds the medium, long, and full patterns of a language.
+type datePatterns struct {
+	medium, long, full string
+}
+
+var (
+	datePatternsDefault = datePatterns{"d MMM yyyy", "d MMMM yyyy", "EEEE, d MMMM yyyy"}
+	datePatternsDayName = datePatterns{"d MMM yyyy", "d MMMM yyyy", "EEEE d MMMM yyyy"}
+	datePatternsUS      = datePatterns{"MMM d, yyyy", "MMMM d, yyyy", "EEEE, MMMM d, yyyy"}
+)
+
+// datePatternsByLanguage lists languages that differ from
+// datePatternsDefault.
+var datePatternsByLanguage = map[string]datePatterns{
+	"de": {"dd.MM.yyyy", "d. MMMM yyyy", "EEEE, d. MMMM yyyy"},
+	"es": {"d MMM yyyy", "d 'de' MMMM 'de' yyyy", "EEEE, d 'de' MMMM 'de' yyyy"},
+	"fr": datePatternsDayName,
+	"it": datePatternsDayName,
+	"ja": {"yyyy/MM/dd", "yyyy年M月d日", "yyyy年M月d日EEEE"},
+	"ko": {"yyyy. M. d.", "yyyy년 M월 d일", "yyyy년 M월 d일 EEEE"},
+	"nl": datePatternsDayName,
+	"pt": {"d 'de' MMM 'de' yyyy", "d 'de' MMMM 'de' yyyy", "EEEE, d 'de' MMMM 'de' yyyy"},
+	"ru": {"d MMM yyyy 'г.'", "d MMMM yyyy 'г.'", "EEEE, d MMMM yyyy 'г.'"},
+	"sv": datePatternsDayName,
+	"zh": {"yyyy年M月d日", "yyyy年M月d日", "yyyy年M月d日EEEE"},
+}
+
+// DatePattern returns the date pattern for l in the given style, such as
+// "MMMM d, yyyy" for long dates in en-US and "d. MMMM yyyy" in de. Short
+// patterns are those of [ShortDatePattern]. Languages without their own
+// patterns use "d MMM yyyy", "d MMMM yyyy", and "EEEE, d MMMM yyyy".
+func DatePattern(l Locale, style DateStyle) string {
+	l = l.resolve()
+	if style == DateStyleShort {
+		return ShortDatePattern(l)
+	}
+	p, ok := datePatternsByLanguage[l.Language]
+	if !ok {
+		p = datePatternsDefault
+	}
+	if l.Language == "en" && (l.Region == "" || l.Region == "US" || l.Region == "CA") {
+		p = datePatternsUS
+	}
+	switch style {
+	case DateStyleMedium:
+		return p.medium
+	case DateStyleLong:
+		return p.long
+	default:
+		return p.full
+	}
+}
+
+// TimePattern returns the hour and minute pattern for l: "h:mm a" where the
+// 12-hour clock is customary, such as en-US, and "HH:mm" elsewhere.
+func TimePattern(l Locale) string {
+	l = l.resolve()
+	switch l.Language {
+	case "en":
+		switch l.Region {
+		case "GB", "IE", "ZA":
+			return "HH:mm"
+		}
+		return "h:mm a"
+	case "hi":
+		return "h:mm a"
+	case "ko":
+		return "a h:mm"
+	case "ja":
+		return "H:mm"
+	}
+	return "HH:mm"
+}
+
+// DateFormatOf returns the format for dates in l in the given style.
+func DateFormatOf(l Locale, style DateStyle) DateFormat {
+	return DateFormat{Pattern: DatePattern(l, style), Locale: l}
+}
+
+// TimeFormatOf returns the format for times of day in l.
+func TimeFormatOf(l Locale) DateFormat {
+	return DateFormat{Pattern: TimePattern(l), Locale: l}
+}
+
+// FormatDate formats t with the default locale's short date pattern.
+func FormatDate(t time.Time) string {
+	return DateFormat{Pattern: ShortDatePattern(Locale{})}.Format(t)
+}
+
+// FormatTime formats the time of day of t with the default locale's
+// pattern, such as "3:04 PM" or "15:04".
+func FormatTime(t time.Time) string {
+	return TimeFormatOf(Locale{}).Format(t)
+}
+
+func pad(v, width int) string {
+	s := strconv.Itoa(v)
+	if len(s) < width {
+		s = strings.Repeat("0", width-len(s)) + s
+	}
+	return s
+}
+
+func hour12(h int) int {
+	if h%12 == 0 {
+		return 12
+	}
+	return h % 12
+}
@@ -0,0 +1,305 @@
+package intl
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/go-drift/drift/pkg/platform"
+)
+
+// NumberInputFormatter groups digits and normalizes the decimal separator as
+// the user types, such as "1234567" becoming "1,234,567". Characters other
+// than digits, the locale's decimal separator, and (when AllowNegative is
+// set) a leading minus sign are dropped. Edits that would exceed
+// MaxFractionDigits are rejected.
+type NumberInputFormatter struct {
+	// Locale selects the separators. Zero uses [DefaultLocale].
+	Locale Locale
+	// MaxFractionDigits limits digits after the decimal separator. Zero
+	// allows whole numbers only.
+	MaxFractionDigits int
+	// AllowNegative accepts a leading minus sign.
+	AllowNegative bool
+}
+
+// FormatEditUpdate implements [platform.TextInputFormatter].
+func (f NumberInputFormatter) FormatEditUpdate(oldValue, newValue platform.TextEditingValue) platform.TextEditingValue {
+	return formatNumberInput(oldValue, newValue, numberInput{
+		symbols:       SymbolsOf(f.Locale),
+		maxFraction:   f.MaxFractionDigits,
+		allowNegative: f.AllowNegative,
+	})
+}
+
+// CurrencyInputFormatter formats the text as an amount of money while the
+// user types, adding the currency symbol and digit grouping, such as "1234.5"
+// becoming "$1,234.5". Fraction digits are limited to the currency's minor
+// unit; the amount is not padded until it is displayed with [CurrencyFormat].
+// Read the value with [CurrencyFormat.Parse].
+type CurrencyInputFormatter struct {
+	// Locale selects the separators and symbol placement. Zero uses
+	// [DefaultLocale].
+	Locale Locale
+	// Currency is the ISO 4217 code, such as "USD". Empty uses the
+	// currency of the locale's region; see [CurrencyOf].
+	Currency string
+	// Symbol overrides the currency's display symbol when non-empty.
+	Symbol string
+}
+
+// FormatEditUpdate implements [platform.TextInputFormatter].
+func (f CurrencyInputFormatter) FormatEditUpdate(oldValue, newValue platform.TextEditingValue) platform.TextEditingValue {
+	format := CurrencyFormat{Locale: f.Locale, Currency: f.Currency, Symbol: f.Symbol}
+	prefix, suffix, _ := strings.Cut(format.affix("\x00"), "\x00")
+	return formatNumberInput(oldValue, newValue, numberInput{
+		symbols:     SymbolsOf(f.Locale),
+		maxFraction: CurrencyDecimals(format.currency()),
+		prefix:      prefix,
+		suffix:      suffix,
+	})
+}
+
+// numberInput configures formatNumberInput.
+type numberInput struct {
+	symbols        NumberSymbols
+	maxFraction    int
+	allowNegative  bool
+	prefix, suffix string
+}
+
+func formatNumberInput(oldValue, newValue platform.TextEditingValue, cfg numberInput) platform.TextEditingValue {
+	decimal := '.'
+	if r, _ := utf8.DecodeRuneInString(cfg.symbols.Decimal); r != utf8.RuneError {
+		decimal = r
+	}
+	significant := func(r rune) bool {
+		return isDigit(r) || r == decimal || r == '-'
+	}
+	newValue = backspaceOverLiteral(oldValue, newValue, significant)
+
+	text := newValue.Text
+	caret := clampCaret(newValue.Selection.ExtentOffset, text)
+	var intDigits, fraction []rune
+	negative, hasDecimal := false, false
+	before, intBefore := 0, 0
+	for i, r := range text {
+		atCaret := i < caret
+		switch {
+		case r == '-' || r == '−':
+			if !cfg.allowNegative || negative || len(intDigits) > 0 || hasDecimal {
+				continue
+			}
+			negative = true
+		case r == decimal:
+			if cfg.maxFraction == 0 || hasDecimal {
+				continue
+			}
+			hasDecimal = true
+		case isDigit(r):
+			if hasDecimal {
+				fraction = append(fraction, r)
+			} else {
+				intDigits = append(intDigits, r)
+				if atCaret {
+					intBefore++
+				}
+			}
+		default:
+			continue
+		}
+		if atCaret {
+			before++
+		}
+	}
+	if len(fraction) > cfg.maxFraction {
+		return oldValue
+	}
+
+	// Drop leading zeros, keeping one before the decimal separator.
+	zeros := 0
+	for zeros < len(intDigits)-1 && intDigits[zeros] == '0' {
+		zeros++
+	}
+	intDigits = intDigits[zeros:]
+	before -= min(zeros, intBefore)
+
+	if len(intDigits) == 0 && !hasDecimal && !negative {
+		return platform.TextEditingValueEmpty
+	}
+
+	b := caretBuilder{before: before}
+	b.literal(cfg.prefix)
+	if negative {
+		b.significant("-")
+	}
+	if len(intDigits) == 0 {
+		b.literal("0")
+	}
+	for _, r := range groupDigits(string(intDigits), cfg.symbols) {
+		if isDigit(r) {
+			b.significant(string(r))
+		} else {
+			b.literal(string(r))
+		}
+	}
+	if hasDecimal {
+		b.significant(string(decimal))
+		for _, r := range fraction {
+			b.significant(string(r))
+		}
+	}
+	caretAt := b.caretOffset()
+	b.literal(cfg.suffix)
+	return b.value(caretAt)
+}
+
+// DateMaskInputFormatter applies a numeric date pattern as a mask while the
+// user types, inserting separators automatically: typing "12312025" with the
+// pattern "MM/dd/yyyy" produces "12/31/2025". Only digits are kept, and
+// input stops when the mask is full. A separator is added only once a digit
+// follows it, so backspace behaves naturally.
+//
+// The mask does not validate the date; use [DateFormat.Parse] for that.
+type DateMaskInputFormatter struct {
+	// Pattern is a [DateFormat] pattern. Fields take their padded width, so
+	// "M" and "MM" both take two digits; "a" and names are not supported. Empty uses
+	// [ShortDatePattern] for Locale.
+	Pattern string
+	// Locale selects the pattern when Pattern is empty. Zero uses
+	// [DefaultLocale].
+	Locale Locale
+}
+
+// FormatEditUpdate implements [platform.TextInputFormatter].
+func (f DateMaskInputFormatter) FormatEditUpdate(oldValue, newValue platform.TextEditingValue) platform.TextEditingValue {
+	pattern := f.Pattern
+	if pattern == "" {
+		pattern = ShortDatePattern(f.Locale)
+	}
+	newValue = backspaceOverLiteral(oldValue, newValue, isDigit)
+
+	text := newValue.Text
+	caret := clampCaret(newValue.Selection.ExtentOffset, text)
+	var digits []rune
+	before := 0
+	for i, r := range text {
+		if isDigit(r) {
+			digits = append(digits, r)
+			if i < caret {
+				before++
+			}
+		}
+	}
+
+	b := caretBuilder{before: before}
+	next := 0
+tokens:
+	for _, tok := range (DateFormat{Pattern: pattern}).tokens() {
+		switch tok.field {
+		case "":
+			if next >= len(digits) {
+				break tokens
+			}
+			b.literal(tok.literal)
+		case "a":
+			break tokens
+		default:
+			width := max(len(tok.field), 2)
+			for range width {
+				if next >= len(digits) {
+					break tokens
+				}
+				b.significant(string(digits[next]))
+				next++
+			}
+		}
+	}
+	return b.value(b.caretOffset())
+}
+
+// caretBuilder builds formatted text while carrying the caret over from the
+// unformatted input: the caret lands after the same number of significant
+// characters (digits, signs, decimal separators) that preceded it before
+// formatting. Offsets are byte offsets, like the controller's selection.
+type caretBuilder struct {
+	b      strings.Builder
+	before int // significant characters still to write before the caret
+	caret  int
+	placed bool
+}
+
+func (c *caretBuilder) literal(s string) {
+	c.b.WriteString(s)
+}
+
+func (c *caretBuilder) significant(s string) {
+	if c.before == 0 && !c.placed {
+		// The caret preceded every significant character.
+		c.caret, c.placed = c.b.Len(), true
+	}
+	c.b.WriteString(s)
+	if c.before > 0 {
+		c.before--
+		if c.before == 0 {
+			c.caret, c.placed = c.b.Len(), true
+		}
+	}
+}
+
+// caretOffset returns the caret position, or the current end of the text if
+// the caret follows everything written so far.
+func (c *caretBuilder) caretOffset() int {
+	if c.placed {
+		return c.caret
+	}
+	return c.b.Len()
+}
+
+func (c *caretBuilder) value(caret int) platform.TextEditingValue {
+	return platform.TextEditingValue{
+		Text:           c.b.String(),
+		Selection:      platform.TextSelectionCollapsed(caret),
+		ComposingRange: platform.TextRangeEmpty,
+	}
+}
+
+// backspaceOverLiteral handles a backspace that removed only a single
+// formatting character, such as a group or date separator. Since the
+// formatter would put it straight back, the significant character before it
+// is removed instead so the edit makes progress.
+func backspaceOverLiteral(oldValue, newValue platform.TextEditingValue, significant func(rune) bool) platform.TextEditingValue {
+	oldText, newText := oldValue.Text, newValue.Text
+	caret := newValue.Selection.ExtentOffset
+	if !newValue.Selection.IsCollapsed() || len(newText) >= len(oldText) ||
+		caret < 0 || caret > len(newText) {
+		return newValue
+	}
+	removed := len(oldText) - len(newText)
+	if oldValue.Selection.ExtentOffset != caret+removed {
+		return newValue // not a backspace from the old caret
+	}
+	if oldText[:caret] != newText[:caret] || oldText[caret+removed:] != newText[caret:] {
+		return newValue
+	}
+	runes := []rune(oldText[caret : caret+removed])
+	if len(runes) != 1 || significant(runes[0]) {
+		return newValue
+	}
+	// Offsets are byte offsets into the text, so step back a whole rune
+	// at a time.
+	for i := caret; i > 0; {
+		r, size := utf8.DecodeLastRuneInString(newText[:i])
+		i -= size
+		if significant(r) {
+			newValue.Text = newText[:i] + newText[i+size:]
+			newValue.Selection = platform.TextSelectionCollapsed(i)
+			return newValue
+		}
+	}
+	return newValue
+}
+
+// clampCaret limits a selection offset to the bounds of text.
+func clampCaret(offset int, text string) int {
+	return max(0, min(offset, len(text)))
+}
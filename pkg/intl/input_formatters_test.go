@@ -0,0 +1,193 @@
+package intl
+
+import (
+	"testing"
+
+	"github.com/go-drift/drift/pkg/platform"
+)
+
+func caretValue(text string, caret int) platform.TextEditingValue {
+	return platform.TextEditingValue{
+		Text:           text,
+		Selection:      platform.TextSelectionCollapsed(caret),
+		ComposingRange: platform.TextRangeEmpty,
+	}
+}
+
+// typeAtEnd appends s to old one character at a time, formatting each edit.
+func typeAtEnd(f platform.TextInputFormatter, old platform.TextEditingValue, s string) platform.TextEditingValue {
+	for _, r := range s {
+		text := old.Text[:old.Selection.ExtentOffset] + string(r) + old.Text[old.Selection.ExtentOffset:]
+		old = f.FormatEditUpdate(old, caretValue(text, old.Selection.ExtentOffset+len(string(r))))
+	}
+	return old
+}
+
+func TestNumberInputFormatter_GroupsWhileTyping(t *testing.T) {
+	f := NumberInputFormatter{Locale: ParseLocale("en-US"), MaxFractionDigits: 2}
+	got := typeAtEnd(f, platform.TextEditingValueEmpty, "1234567.5")
+	if got.Text != "1,234,567.5" || got.Selection.ExtentOffset != len(got.Text) {
+		t.Errorf("got %q caret %d", got.Text, got.Selection.ExtentOffset)
+	}
+
+	// A third fraction digit is rejected.
+	if next := typeAtEnd(f, got, "55"); next.Text != "1,234,567.55" {
+		t.Errorf("expected fraction limited to two digits, got %q", next.Text)
+	}
+}
+
+func TestNumberInputFormatter_KeepsCaretInMiddle(t *testing.T) {
+	f := NumberInputFormatter{Locale: ParseLocale("de-DE")}
+	old := caretValue("1.234", 1)
+	// Insert "9" after the first digit: "19.234" before formatting.
+	got := f.FormatEditUpdate(old, caretValue("19.234", 2))
+	if got.Text != "19.234" || got.Selection.ExtentOffset != 2 {
+		t.Errorf("got %q caret %d", got.Text, got.Selection.ExtentOffset)
+	}
+	got = f.FormatEditUpdate(got, caretValue("199.234", 3))
+	if got.Text != "199.234" || got.Selection.ExtentOffset != 3 {
+		t.Errorf("got %q caret %d", got.Text, got.Selection.ExtentOffset)
+	}
+}
+
+func TestNumberInputFormatter_BackspaceOverSeparator(t *testing.T) {
+	f := NumberInputFormatter{Locale: ParseLocale("en-US")}
+	// Caret after the comma in "1,234"; backspace removes the comma.
+	got := f.FormatEditUpdate(caretValue("1,234", 2), caretValue("1234", 1))
+	if got.Text != "234" || got.Selection.ExtentOffset != 0 {
+		t.Errorf("expected the digit before the separator to be deleted, got %q caret %d",
+			got.Text, got.Selection.ExtentOffset)
+	}
+}
+
+func TestNumberInputFormatter_Filters(t *testing.T) {
+	f := NumberInputFormatter{Locale: ParseLocale("en-US")}
+	got := f.FormatEditUpdate(platform.TextEditingValueEmpty, caretValue("-00a12.5", 8))
+	if got.Text != "125" {
+		t.Errorf("expected letters, signs, and decimals dropped, got %q", got.Text)
+	}
+	f.AllowNegative = true
+	f.MaxFractionDigits = 1
+	got = f.FormatEditUpdate(platform.TextEditingValueEmpty, caretValue("-.5", 3))
+	if got.Text != "-0.5" || got.Selection.ExtentOffset != 4 {
+		t.Errorf("got %q caret %d", got.Text, got.Selection.ExtentOffset)
+	}
+}
+
+func TestCurrencyInputFormatter_Affixes(t *testing.T) {
+	usd := CurrencyInputFormatter{Locale: ParseLocale("en-US"), Currency: "USD"}
+	got := typeAtEnd(usd, platform.TextEditingValueEmpty, "1234.5")
+	if got.Text != "$1,234.5" || got.Selection.ExtentOffset != len(got.Text) {
+		t.Errorf("got %q caret %d", got.Text, got.Selection.ExtentOffset)
+	}
+
+	eur := CurrencyInputFormatter{Locale: ParseLocale("de-DE"), Currency: "EUR"}
+	got = typeAtEnd(eur, platform.TextEditingValueEmpty, "1234,5")
+	want := "1.234,5\u00a0€"
+	if got.Text != want || got.Selection.ExtentOffset != len("1.234,5") {
+		t.Errorf("got %q caret %d, want %q with caret before the symbol", got.Text, got.Selection.ExtentOffset, want)
+	}
+	if v, err := (CurrencyFormat{Locale: eur.Locale, Currency: "EUR"}).Parse(got.Text); err != nil || v != 1234.5 {
+		t.Errorf("Parse(%q) = %v, %v", got.Text, v, err)
+	}
+
+	// Clearing the digits clears the symbol too.
+	cleared := usd.FormatEditUpdate(caretValue("$5", 2), caretValue("$", 1))
+	if cleared.Text != "" {
+		t.Errorf("expected empty text, got %q", cleared.Text)
+	}
+}
+
+func TestCurrencyInputFormatter_Locales(t *testing.T) {
+	tests := []struct {
+		locale, currency, typed string
+		want, beforeSuffix      string
+	}{
+		{"fr-FR", "EUR", "1234567,5", "1\u00a0234\u00a0567,5\u00a0€", "1\u00a0234\u00a0567,5"},
+		{"de-DE", "EUR", "1234567,5", "1.234.567,5\u00a0€", "1.234.567,5"},
+		{"en-IN", "INR", "1234567.5", "₹12,34,567.5", "₹12,34,567.5"},
+	}
+	for _, tt := range tests {
+		f := CurrencyInputFormatter{Locale: ParseLocale(tt.locale), Currency: tt.currency}
+		got := typeAtEnd(f, platform.TextEditingValueEmpty, tt.typed)
+		if got.Text != tt.want || got.Selection.ExtentOffset != len(tt.beforeSuffix) {
+			t.Errorf("%s: got %q caret %d, want %q caret %d",
+				tt.locale, got.Text, got.Selection.ExtentOffset, tt.want, len(tt.beforeSuffix))
+		}
+	}
+}
+
+func TestCurrencyInputFormatter_KeepsCaretAroundNonASCII(t *testing.T) {
+	f := CurrencyInputFormatter{Locale: ParseLocale("fr-FR"), Currency: "EUR"}
+	// Type "9" after the "3" in "12 345 €", grouped with no-break spaces.
+	old := caretValue("12\u00a0345\u00a0€", len("12\u00a03"))
+	got := f.FormatEditUpdate(old, caretValue("12\u00a03945\u00a0€", len("12\u00a039")))
+	if got.Text != "123\u00a0945\u00a0€" || got.Selection.ExtentOffset != len("123\u00a09") {
+		t.Errorf("got %q caret %d, want caret after the typed digit", got.Text, got.Selection.ExtentOffset)
+	}
+
+	// The caret never lands inside the suffix.
+	got = f.FormatEditUpdate(old, caretValue("12\u00a0345\u00a0€", len("12\u00a0345\u00a0€")))
+	if got.Selection.ExtentOffset != len("12\u00a0345") {
+		t.Errorf("expected the caret before the suffix, got %d", got.Selection.ExtentOffset)
+	}
+}
+
+func TestCurrencyInputFormatter_BackspaceOverNonASCIISeparator(t *testing.T) {
+	f := CurrencyInputFormatter{Locale: ParseLocale("fr-FR"), Currency: "EUR"}
+	// Caret after the no-break space in "1 234,5 €"; backspace removes it.
+	old := caretValue("1\u00a0234,5\u00a0€", len("1\u00a0"))
+	got := f.FormatEditUpdate(old, caretValue("1234,5\u00a0€", 1))
+	if got.Text != "234,5\u00a0€" || got.Selection.ExtentOffset != 0 {
+		t.Errorf("expected the digit before the separator to be deleted, got %q caret %d",
+			got.Text, got.Selection.ExtentOffset)
+	}
+}
+
+func TestBackspaceOverLiteral_StepsOverRunes(t *testing.T) {
+	// An Arabic decimal separator is significant and two bytes long.
+	significant := func(r rune) bool { return isDigit(r) || r == '٫' }
+	old := caretValue("1٫5 x", len("1٫5 "))
+	got := backspaceOverLiteral(old, caretValue("1٫5x", len("1٫5")), significant)
+	if got.Text != "1٫x" || got.Selection.ExtentOffset != len("1٫") {
+		t.Errorf("got %q caret %d", got.Text, got.Selection.ExtentOffset)
+	}
+	old = caretValue("1٫ x", len("1٫ "))
+	got = backspaceOverLiteral(old, caretValue("1٫x", len("1٫")), significant)
+	if got.Text != "1x" || got.Selection.ExtentOffset != 1 {
+		t.Errorf("expected the whole separator deleted, got %q caret %d", got.Text, got.Selection.ExtentOffset)
+	}
+}
+
+func TestNumberInputFormatter_EmptyDecimalSymbol(t *testing.T) {
+	got := formatNumberInput(platform.TextEditingValueEmpty, caretValue("12.5", 4), numberInput{
+		symbols:     NumberSymbols{Group: ","},
+		maxFraction: 1,
+	})
+	if got.Text != "12.5" {
+		t.Errorf("expected the decimal separator to fall back to '.', got %q", got.Text)
+	}
+}
+
+func TestDateMaskInputFormatter(t *testing.T) {
+	f := DateMaskInputFormatter{Pattern: "MM/dd/yyyy"}
+	got := typeAtEnd(f, platform.TextEditingValueEmpty, "12")
+	if got.Text != "12" {
+		t.Errorf("expected no trailing separator, got %q", got.Text)
+	}
+	got = typeAtEnd(f, got, "3120259")
+	if got.Text != "12/31/2025" || got.Selection.ExtentOffset != len(got.Text) {
+		t.Errorf("got %q caret %d", got.Text, got.Selection.ExtentOffset)
+	}
+
+	// Backspace over a separator removes the digit before it.
+	got = f.FormatEditUpdate(caretValue("12/31", 3), caretValue("1231", 2))
+	if got.Text != "13/1" || got.Selection.ExtentOffset != 1 {
+		t.Errorf("got %q caret %d", got.Text, got.Selection.ExtentOffset)
+	}
+
+	de := DateMaskInputFormatter{Locale: ParseLocale("de-DE")}
+	if got := typeAtEnd(de, platform.TextEditingValueEmpty, "31122025"); got.Text != "31.12.2025" {
+		t.Errorf("expected locale pattern, got %q", got.Text)
+	}
+}
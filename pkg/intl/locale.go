@@ -0,0 +1,96 @@
+// Package intl provides locale-aware formatting for numbers, currencies,
+// percentages, dates, and relative times, both for display and as input
+// formatters for text fields.
+//
+// Display formatters turn values into strings for widgets such as Text:
+//
+//	widgets.Text{Content: intl.FormatCurrency(19.99, "EUR")}
+//	intl.NumberFormat{Locale: intl.ParseLocale("de-DE"), MaxFractionDigits: 2}.Format(1234.5) // "1.234,5"
+//	intl.DateFormatOf(intl.Locale{}, intl.DateStyleLong).Format(t)                        // "March 7, 2025"
+//	intl.FormatRelative(sent)                                                             // "5 minutes ago"
+//
+// Input formatters implement [platform.TextInputFormatter] and reformat text
+// as the user types, keeping the caret in place:
+//
+//	theme.TextFieldOf(ctx, amount).
+//	    WithKeyboardType(platform.KeyboardTypeNumber).
+//	    WithInputFormatters(intl.CurrencyInputFormatter{Currency: "USD"})
+//
+// Formatting data is built in, taken from CLDR for the most common locales,
+// so results are the same on every platform. Other locales fall back to
+// English names and common separators. A zero [Locale] means
+// [DefaultLocale].
+package intl
+
+import (
+	"strings"
+	"sync"
+)
+
+// Locale identifies a language and optional region, such as en-US or de.
+type Locale struct {
+	// Language is the lowercase ISO 639 language code, such as "en".
+	Language string
+	// Region is the uppercase ISO 3166 region code, such as "US". Optional.
+	Region string
+}
+
+// ParseLocale parses a tag such as "en-US", "en_US", or "de". Script and
+// variant subtags are ignored.
+func ParseLocale(tag string) Locale {
+	parts := strings.FieldsFunc(tag, func(r rune) bool { return r == '-' || r == '_' })
+	if len(parts) == 0 {
+		return Locale{}
+	}
+	locale := Locale{Language: strings.ToLower(parts[0])}
+	for _, part := range parts[1:] {
+		// Regions are two letters or three digits; scripts are four letters.
+		if len(part) == 2 || (len(part) == 3 && part[0] >= '0' && part[0] <= '9') {
+			locale.Region = strings.ToUpper(part)
+			break
+		}
+	}
+	return locale
+}
+
+// String returns the locale as a BCP 47 tag, such as "en-US".
+func (l Locale) String() string {
+	if l.Region == "" {
+		return l.Language
+	}
+	return l.Language + "-" + l.Region
+}
+
+// IsZero reports whether the locale is unset.
+func (l Locale) IsZero() bool {
+	return l.Language == ""
+}
+
+var (
+	defaultLocaleMu sync.RWMutex
+	defaultLocale   = Locale{Language: "en", Region: "US"}
+)
+
+// DefaultLocale returns the locale used when a formatter's Locale is zero.
+// It is en-US unless changed with [SetDefaultLocale].
+func DefaultLocale() Locale {
+	defaultLocaleMu.RLock()
+	defer defaultLocaleMu.RUnlock()
+	return defaultLocale
+}
+
+// SetDefaultLocale sets the locale used when a formatter's Locale is zero.
+// Safe to call from any goroutine.
+func SetDefaultLocale(l Locale) {
+	defaultLocaleMu.Lock()
+	defaultLocale = l
+	defaultLocaleMu.Unlock()
+}
+
+// resolve returns l, or the default locale if l is zero.
+func (l Locale) resolve() Locale {
+	if l.IsZero() {
+		return DefaultLocale()
+	}
+	return l
+}
@@ -0,0 +1,428 @@
+package intl
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// NumberSymbols describes how a locale writes numbers.
+type NumberSymbols struct {
+	// Decimal separates the integer and fraction parts.
+	Decimal string
+	// Group separates digit groups in the integer part.
+	Group string
+	// GroupSize is the size of the lowest digit group, usually 3.
+	GroupSize int
+	// SecondaryGroupSize is the size of higher digit groups when it differs
+	// from GroupSize, such as 2 for the Indian system (12,34,567). Zero means
+	// all groups use GroupSize.
+	SecondaryGroupSize int
+}
+
+var (
+	symbolsDotDecimal   = NumberSymbols{Decimal: ".", Group: ",", GroupSize: 3}
+	symbolsCommaDecimal = NumberSymbols{Decimal: ",", Group: ".", GroupSize: 3}
+	symbolsSpaceGroup   = NumberSymbols{Decimal: ",", Group: "\u00a0", GroupSize: 3}
+	symbolsIndian       = NumberSymbols{Decimal: ".", Group: ",", GroupSize: 3, SecondaryGroupSize: 2}
+	symbolsSwiss        = NumberSymbols{Decimal: ".", Group: "’", GroupSize: 3}
+)
+
+// numberSymbolsByLanguage lists languages that differ from symbolsDotDecimal.
+var numberSymbolsByLanguage = map[string]NumberSymbols{
+	"da": symbolsCommaDecimal, "de": symbolsCommaDecimal, "el": symbolsCommaDecimal,
+	"es": symbolsCommaDecimal, "hr": symbolsCommaDecimal, "id": symbolsCommaDecimal,
+	"it": symbolsCommaDecimal, "nl": symbolsCommaDecimal, "pt": symbolsCommaDecimal,
+	"ro": symbolsCommaDecimal, "sl": symbolsCommaDecimal, "sr": symbolsCommaDecimal,
+	"tr": symbolsCommaDecimal, "vi": symbolsCommaDecimal,
+
+	"bg": symbolsSpaceGroup, "cs": symbolsSpaceGroup, "et": symbolsSpaceGroup,
+	"fi": symbolsSpaceGroup, "fr": symbolsSpaceGroup, "hu": symbolsSpaceGroup,
+	"lt": symbolsSpaceGroup, "lv": symbolsSpaceGroup, "nb": symbolsSpaceGroup,
+	"no": symbolsSpaceGroup, "pl": symbolsSpaceGroup, "ru": symbolsSpaceGroup,
+	"sk": symbolsSpaceGroup, "sv": symbolsSpaceGroup, "uk": symbolsSpaceGroup,
+
+	"hi": symbolsIndian,
+}
+
+// numberSymbolsByLocale overrides the language defaults for specific regions.
+var numberSymbolsByLocale = map[string]NumberSymbols{
+	"de-CH": symbolsSwiss,
+	"de-LI": symbolsSwiss,
+	"en-IN": symbolsIndian,
+	"es-MX": symbolsDotDecimal,
+	"es-US": symbolsDotDecimal,
+}
+
+// SymbolsOf returns the number symbols for l. Unknown locales use a period
+// for decimals and commas for grouping.
+func SymbolsOf(l Locale) NumberSymbols {
+	l = l.resolve()
+	if s, ok := numberSymbolsByLocale[l.String()]; ok {
+		return s
+	}
+	if s, ok := numberSymbolsByLanguage[l.Language]; ok {
+		return s
+	}
+	return symbolsDotDecimal
+}
+
+// NumberFormat formats decimal numbers for a locale.
+//
+//	intl.NumberFormat{MaxFractionDigits: 2}.Format(1234.567) // "1,234.57"
+type NumberFormat struct {
+	// Locale selects the separators. Zero uses [DefaultLocale].
+	Locale Locale
+	// MinFractionDigits pads the fraction with zeros to at least this many
+	// digits.
+	MinFractionDigits int
+	// MaxFractionDigits rounds the fraction to at most this many digits.
+	// Zero formats whole numbers.
+	MaxFractionDigits int
+	// NoGrouping omits group separators.
+	NoGrouping bool
+}
+
+// Format returns v formatted with the locale's separators.
+func (f NumberFormat) Format(v float64) string {
+	if math.IsNaN(v) {
+		return "NaN"
+	}
+	if math.IsInf(v, 0) {
+		if v < 0 {
+			return "-∞"
+		}
+		return "∞"
+	}
+	symbols := SymbolsOf(f.Locale)
+	maxDigits := max(f.MaxFractionDigits, f.MinFractionDigits)
+
+	s := strconv.FormatFloat(math.Abs(v), 'f', maxDigits, 64)
+	intPart, frac, _ := strings.Cut(s, ".")
+	frac = strings.TrimRight(frac, "0")
+	if len(frac) < f.MinFractionDigits {
+		frac += strings.Repeat("0", f.MinFractionDigits-len(frac))
+	}
+
+	var b strings.Builder
+	if v < 0 && strings.Trim(intPart+frac, "0") != "" {
+		b.WriteByte('-')
+	}
+	if f.NoGrouping {
+		b.WriteString(intPart)
+	} else {
+		b.WriteString(groupDigits(intPart, symbols))
+	}
+	if frac != "" {
+		b.WriteString(symbols.Decimal)
+		b.WriteString(frac)
+	}
+	return b.String()
+}
+
+// ErrInvalidNumber is returned when text cannot be parsed as a number.
+var ErrInvalidNumber = errors.New("intl: invalid number")
+
+// Parse reads a number written with the locale's separators. Group
+// separators, spaces, and currency symbols are ignored.
+func (f NumberFormat) Parse(text string) (float64, error) {
+	return parseNumber(text, SymbolsOf(f.Locale))
+}
+
+func parseNumber(text string, symbols NumberSymbols) (float64, error) {
+	var b strings.Builder
+	rest := text
+	for rest != "" {
+		if strings.HasPrefix(rest, symbols.Decimal) {
+			b.WriteByte('.')
+			rest = rest[len(symbols.Decimal):]
+			continue
+		}
+		r := []rune(rest)[0]
+		switch {
+		case isDigit(r):
+			b.WriteRune(r)
+		case r == '-' || r == '−':
+			if b.Len() > 0 {
+				return 0, ErrInvalidNumber
+			}
+			b.WriteByte('-')
+		}
+		rest = rest[len(string(r)):]
+	}
+	v, err := strconv.ParseFloat(b.String(), 64)
+	if err != nil {
+		return 0, ErrInvalidNumber
+	}
+	return v, nil
+}
+
+// groupDigits inserts group separators into a string of ASCII digits.
+func groupDigits(digits string, symbols NumberSymbols) string {
+	size := symbols.GroupSize
+	if size <= 0 || len(digits) <= size {
+		return digits
+	}
+	secondary := symbols.SecondaryGroupSize
+	if secondary <= 0 {
+		secondary = size
+	}
+
+	var groups []string
+	end := len(digits)
+	groups = append(groups, digits[end-size:])
+	end -= size
+	for end > 0 {
+		start := max(end-secondary, 0)
+		groups = append(groups, digits[start:end])
+		end = start
+	}
+	for i, j := 0, len(groups)-1; i < j; i, j = i+1, j-1 {
+		groups[i], groups[j] = groups[j], groups[i]
+	}
+	return strings.Join(groups, symbols.Group)
+}
+
+// FormatNumber formats v with the default locale, rounded to at most
+// maxFractionDigits.
+func FormatNumber(v float64, maxFractionDigits int) string {
+	return NumberFormat{MaxFractionDigits: maxFractionDigits}.Format(v)
+}
+
+// currencyInfo holds a currency's display symbol and minor unit digits.
+type currencyInfo struct {
+	symbol   string
+	decimals int
+}
+
+var currencies = map[string]currencyInfo{
+	"AUD": {"A$", 2}, "BRL": {"R$", 2}, "CAD": {"CA$", 2}, "CHF": {"CHF", 2},
+	"CNY": {"¥", 2}, "CZK": {"Kč", 2}, "DKK": {"kr", 2}, "EUR": {"€", 2},
+	"GBP": {"£", 2}, "HKD": {"HK$", 2}, "HUF": {"Ft", 2}, "IDR": {"Rp", 2},
+	"ILS": {"₪", 2}, "INR": {"₹", 2}, "JPY": {"¥", 0}, "KRW": {"₩", 0},
+	"MXN": {"MX$", 2}, "NOK": {"kr", 2}, "NZD": {"NZ$", 2}, "PLN": {"zł", 2},
+	"RUB": {"₽", 2}, "SEK": {"kr", 2}, "SGD": {"S$", 2}, "THB": {"฿", 2},
+	"TRY": {"₺", 2}, "UAH": {"₴", 2}, "USD": {"$", 2}, "VND": {"₫", 0},
+	"ZAR": {"R", 2},
+}
+
+// regionCurrencies maps regions to the ISO 4217 code of their currency.
+var regionCurrencies = map[string]string{
+	"AT": "EUR", "AU": "AUD", "BE": "EUR", "BR": "BRL", "CA": "CAD", "CH": "CHF",
+	"CN": "CNY", "CZ": "CZK", "DE": "EUR", "DK": "DKK", "EE": "EUR", "ES": "EUR",
+	"FI": "EUR", "FR": "EUR", "GB": "GBP", "GR": "EUR", "HK": "HKD", "HR": "EUR",
+	"HU": "HUF", "ID": "IDR", "IE": "EUR", "IL": "ILS", "IN": "INR", "IT": "EUR",
+	"JP": "JPY", "KR": "KRW", "LI": "CHF", "LT": "EUR", "LU": "EUR", "LV": "EUR",
+	"MX": "MXN", "NL": "EUR", "NO": "NOK", "NZ": "NZD", "PL": "PLN", "PT": "EUR",
+	"RU": "RUB", "SE": "SEK", "SG": "SGD", "SI": "EUR", "SK": "EUR", "TH": "THB",
+	"TR": "TRY", "UA": "UAH", "US": "USD", "VN": "VND", "ZA": "ZAR",
+}
+
+// CurrencyOf returns the ISO 4217 code of the currency used in l's region,
+// such as "EUR" for de-DE. Locales without a known region use "USD".
+func CurrencyOf(l Locale) string {
+	if code, ok := regionCurrencies[l.resolve().Region]; ok {
+		return code
+	}
+	return "USD"
+}
+
+// localDollarCurrencies lists dollar and peso currencies written as a bare
+// "$" in their own region.
+var localDollarCurrencies = map[string]string{
+	"AUD": "AU", "CAD": "CA", "MXN": "MX", "NZD": "NZ", "SGD": "SG", "USD": "US",
+}
+
+// currencySymbolIn returns the symbol for code as written in l: a dollar
+// currency is a bare "$" in its own region, and US dollars are "US$" in
+// regions whose own currency is also a "$".
+func currencySymbolIn(code string, l Locale) string {
+	code = strings.ToUpper(code)
+	if region, ok := localDollarCurrencies[code]; ok {
+		if region == l.Region {
+			return "$"
+		}
+		if code == "USD" && localDollarCurrencies[CurrencyOf(l)] == l.Region {
+			return "US$"
+		}
+	}
+	return CurrencySymbol(code)
+}
+
+// CurrencySymbol returns the display symbol for an ISO 4217 currency code,
+// or the code itself if the currency is unknown.
+func CurrencySymbol(code string) string {
+	if info, ok := currencies[strings.ToUpper(code)]; ok {
+		return info.symbol
+	}
+	return strings.ToUpper(code)
+}
+
+// CurrencyDecimals returns the number of minor unit digits for an ISO 4217
+// currency code, such as 2 for USD and 0 for JPY. Unknown currencies use 2.
+func CurrencyDecimals(code string) int {
+	if info, ok := currencies[strings.ToUpper(code)]; ok {
+		return info.decimals
+	}
+	return 2
+}
+
+// currencyPattern describes where a locale places the currency symbol.
+type currencyPattern struct {
+	symbolAfter bool // "1,00 €" rather than "€1.00"
+	spaced      bool // a space separates symbol and number
+}
+
+// currencyPatternsByLanguage lists languages that differ from a prefixed,
+// unspaced symbol ("$1.00").
+var currencyPatternsByLanguage = map[string]currencyPattern{
+	"bg": {true, true}, "cs": {true, true}, "da": {true, true}, "de": {true, true},
+	"el": {true, true}, "es": {true, true}, "et": {true, true}, "fi": {true, true},
+	"fr": {true, true}, "hr": {true, true}, "hu": {true, true}, "it": {true, true},
+	"lt": {true, true}, "lv": {true, true}, "nb": {true, true}, "no": {true, true},
+	"pl": {true, true}, "pt": {true, true}, "ro": {true, true}, "ru": {true, true},
+	"sk": {true, true}, "sl": {true, true}, "sr": {true, true}, "sv": {true, true},
+	"tr": {false, false}, "uk": {true, true}, "vi": {true, true},
+
+	"id": {false, false}, "nl": {false, true},
+}
+
+var currencyPatternsByLocale = map[string]currencyPattern{
+	"de-CH": {false, true},
+	"es-MX": {false, false},
+	"es-US": {false, false},
+	"pt-BR": {false, true},
+}
+
+func currencyPatternOf(l Locale) currencyPattern {
+	if p, ok := currencyPatternsByLocale[l.String()]; ok {
+		return p
+	}
+	return currencyPatternsByLanguage[l.Language]
+}
+
+// CurrencyFormat formats amounts of money for a locale.
+//
+//	intl.CurrencyFormat{Currency: "USD"}.Format(1234.5)                              // "$1,234.50"
+//	intl.CurrencyFormat{Locale: intl.ParseLocale("de-DE"), Currency: "EUR"}.Format(1234.5) // "1.234,50 €"
+type CurrencyFormat struct {
+	// Locale selects the separators and symbol placement. Zero uses
+	// [DefaultLocale].
+	Locale Locale
+	// Currency is the ISO 4217 code, such as "USD". Empty uses the
+	// currency of the locale's region; see [CurrencyOf].
+	Currency string
+	// Symbol overrides the currency's display symbol when non-empty.
+	Symbol string
+}
+
+// Format returns v with the currency symbol, rounded to the currency's minor
+// unit. The symbol depends on the locale where needed: Canadian dollars are
+// "$" in en-CA and "CA$" elsewhere.
+func (f CurrencyFormat) Format(v float64) string {
+	decimals := CurrencyDecimals(f.currency())
+	number := NumberFormat{
+		Locale:            f.Locale,
+		MinFractionDigits: decimals,
+		MaxFractionDigits: decimals,
+	}.Format(math.Abs(v))
+	sign := ""
+	if v < 0 && strings.ContainsFunc(number, func(r rune) bool { return r >= '1' && r <= '9' }) {
+		sign = "-"
+	}
+	return sign + f.affix(number)
+}
+
+// Parse reads an amount formatted by Format or typed by the user.
+func (f CurrencyFormat) Parse(text string) (float64, error) {
+	return parseNumber(text, SymbolsOf(f.Locale))
+}
+
+// affix places the currency symbol around a formatted number.
+func (f CurrencyFormat) affix(number string) string {
+	symbol := f.symbol()
+	pattern := currencyPatternOf(f.Locale.resolve())
+	space := ""
+	if pattern.spaced {
+		space = "\u00a0"
+	}
+	if pattern.symbolAfter {
+		return number + space + symbol
+	}
+	return symbol + space + number
+}
+
+func (f CurrencyFormat) symbol() string {
+	if f.Symbol != "" {
+		return f.Symbol
+	}
+	return currencySymbolIn(f.currency(), f.Locale.resolve())
+}
+
+func (f CurrencyFormat) currency() string {
+	if f.Currency == "" {
+		return CurrencyOf(f.Locale)
+	}
+	return f.Currency
+}
+
+// FormatCurrency formats v as an amount of the given ISO 4217 currency with
+// the default locale.
+func FormatCurrency(v float64, currency string) string {
+	return CurrencyFormat{Currency: currency}.Format(v)
+}
+
+// percentSpacedLanguages lists languages that separate the number and the
+// percent sign with a no-break space, as in "12,5 %".
+var percentSpacedLanguages = map[string]bool{
+	"cs": true, "da": true, "de": true, "fi": true, "fr": true, "nb": true,
+	"no": true, "pl": true, "ru": true, "sk": true, "sv": true, "uk": true,
+}
+
+// PercentFormat formats ratios as percentages for a locale.
+//
+//	intl.PercentFormat{MaxFractionDigits: 1}.Format(0.125)                      // "12.5%"
+//	intl.PercentFormat{Locale: intl.ParseLocale("de")}.Format(0.5)              // "50 %"
+type PercentFormat struct {
+	// Locale selects the separators and sign placement. Zero uses
+	// [DefaultLocale].
+	Locale Locale
+	// MinFractionDigits pads the percentage to at least this many fraction
+	// digits.
+	MinFractionDigits int
+	// MaxFractionDigits rounds the percentage to at most this many fraction
+	// digits. Zero formats whole percentages.
+	MaxFractionDigits int
+}
+
+// Format returns v, a ratio where 1 is 100%, as a percentage.
+func (f PercentFormat) Format(v float64) string {
+	l := f.Locale.resolve()
+	number := NumberFormat{
+		Locale:            l,
+		MinFractionDigits: f.MinFractionDigits,
+		MaxFractionDigits: f.MaxFractionDigits,
+	}.Format(v * 100)
+	switch {
+	case l.Language == "tr":
+		if rest, ok := strings.CutPrefix(number, "-"); ok {
+			return "-%" + rest
+		}
+		return "%" + number
+	case percentSpacedLanguages[l.Language]:
+		return number + "\u00a0%"
+	}
+	return number + "%"
+}
+
+// FormatPercent formats v, a ratio where 1 is 100%, as a whole percentage
+// with the default locale.
+func FormatPercent(v float64) string {
+	return PercentFormat{}.Format(v)
+}
+
+// isDigit reports whether r is an ASCII digit.
+func isDigit(r rune) bool {
+	return r >= '0' && r <= '9'
+}
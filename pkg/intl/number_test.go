@@ -0,0 +1,288 @@
+package intl
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNumberFormat_Format(t *testing.T) {
+	tests := []struct {
+		format NumberFormat
+		value  float64
+		want   string
+	}{
+		{NumberFormat{Locale: ParseLocale("en-US"), MaxFractionDigits: 2}, 1234567.891, "1,234,567.89"},
+		{NumberFormat{Locale: ParseLocale("de-DE"), MaxFractionDigits: 2}, 1234.5, "1.234,5"},
+		{NumberFormat{Locale: ParseLocale("fr"), MinFractionDigits: 2}, 1234.5, "1\u00a0234,50"},
+		{NumberFormat{Locale: ParseLocale("en-IN")}, 1234567, "12,34,567"},
+		{NumberFormat{Locale: ParseLocale("en"), NoGrouping: true}, -1234, "-1234"},
+		{NumberFormat{Locale: ParseLocale("en")}, -0.2, "0"},
+	}
+	for _, tt := range tests {
+		if got := tt.format.Format(tt.value); got != tt.want {
+			t.Errorf("%+v.Format(%v) = %q, want %q", tt.format, tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestNumberFormat_ParseRoundTrips(t *testing.T) {
+	for _, tag := range []string{"en-US", "de-DE", "fr-FR", "en-IN", "de-CH"} {
+		f := NumberFormat{Locale: ParseLocale(tag), MaxFractionDigits: 2}
+		text := f.Format(-1234567.25)
+		got, err := f.Parse(text)
+		if err != nil || got != -1234567.25 {
+			t.Errorf("%s: Parse(%q) = %v, %v", tag, text, got, err)
+		}
+	}
+	if _, err := (NumberFormat{}).Parse("abc"); err != ErrInvalidNumber {
+		t.Errorf("expected ErrInvalidNumber, got %v", err)
+	}
+}
+
+func TestCurrencyFormat_Format(t *testing.T) {
+	tests := []struct {
+		format CurrencyFormat
+		value  float64
+		want   string
+	}{
+		{CurrencyFormat{Locale: ParseLocale("en-US"), Currency: "USD"}, 1234.5, "$1,234.50"},
+		{CurrencyFormat{Locale: ParseLocale("de-DE"), Currency: "EUR"}, 1234.5, "1.234,50\u00a0€"},
+		{CurrencyFormat{Locale: ParseLocale("ja-JP"), Currency: "JPY"}, 1234.5, "¥1,234"},
+		{CurrencyFormat{Locale: ParseLocale("en-US"), Currency: "USD"}, -5, "-$5.00"},
+		{CurrencyFormat{Locale: ParseLocale("en-US"), Currency: "XYZ"}, 1, "XYZ1.00"},
+	}
+	for _, tt := range tests {
+		if got := tt.format.Format(tt.value); got != tt.want {
+			t.Errorf("%+v.Format(%v) = %q, want %q", tt.format, tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestDateFormat_FormatAndParse(t *testing.T) {
+	date := time.Date(2025, time.March, 7, 15, 4, 5, 0, time.UTC)
+	tests := []struct {
+		pattern string
+		want    string
+	}{
+		{"MM/dd/yyyy", "03/07/2025"},
+		{"d.M.yy", "7.3.25"},
+		{"yyyy-MM-dd'T'HH:mm:ss", "2025-03-07T15:04:05"},
+		{"h:mm a", "3:04 PM"},
+	}
+	for _, tt := range tests {
+		f := DateFormat{Pattern: tt.pattern}
+		got := f.Format(date)
+		if got != tt.want {
+			t.Errorf("Format(%q) = %q, want %q", tt.pattern, got, tt.want)
+			continue
+		}
+		parsed, err := f.Parse(got, time.UTC)
+		if err != nil {
+			t.Errorf("Parse(%q, %q): %v", tt.pattern, got, err)
+			continue
+		}
+		if f.Format(parsed) != got {
+			t.Errorf("Parse(%q, %q) = %v, does not round-trip", tt.pattern, got, parsed)
+		}
+	}
+
+	if _, err := (DateFormat{Pattern: "MM/dd/yyyy"}).Parse("02/30/2025", time.UTC); err != ErrInvalidDate {
+		t.Errorf("expected ErrInvalidDate for February 30, got %v", err)
+	}
+}
+
+func TestShortDatePattern(t *testing.T) {
+	tests := map[string]string{
+		"en-US": "MM/dd/yyyy",
+		"en-GB": "dd/MM/yyyy",
+		"de-AT": "dd.MM.yyyy",
+		"ja":    "yyyy/MM/dd",
+		"xx":    "dd/MM/yyyy",
+	}
+	for tag, want := range tests {
+		if got := ShortDatePattern(ParseLocale(tag)); got != want {
+			t.Errorf("ShortDatePattern(%s) = %q, want %q", tag, got, want)
+		}
+	}
+}
+
+func TestPluralCategoryOf(t *testing.T) {
+	tests := []struct {
+		locale string
+		n      float64
+		want   PluralCategory
+	}{
+		{"en", 1, PluralOne},
+		{"en", 0, PluralOther},
+		{"en", 1.5, PluralOther},
+		{"fr", 0, PluralOne},
+		{"fr", 1.5, PluralOne},
+		{"ja", 1, PluralOther},
+		{"ru", 21, PluralOne},
+		{"ru", 3, PluralFew},
+		{"ru", 11, PluralMany},
+		{"pl", 22, PluralFew},
+		{"pl", 25, PluralMany},
+		{"ar", 0, PluralZero},
+		{"ar", 2, PluralTwo},
+		{"ar", 103, PluralFew},
+		{"ar", 111, PluralMany},
+		{"he", 2, PluralTwo},
+	}
+	for _, tt := range tests {
+		if got := PluralCategoryOf(ParseLocale(tt.locale), tt.n); got != tt.want {
+			t.Errorf("PluralCategoryOf(%s, %v) = %s, want %s", tt.locale, tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestCurrencyFormat_LocalSymbols(t *testing.T) {
+	tests := []struct {
+		format CurrencyFormat
+		want   string
+	}{
+		{CurrencyFormat{Locale: ParseLocale("en-CA"), Currency: "CAD"}, "$5.00"},
+		{CurrencyFormat{Locale: ParseLocale("en-CA"), Currency: "USD"}, "US$5.00"},
+		{CurrencyFormat{Locale: ParseLocale("en-US"), Currency: "CAD"}, "CA$5.00"},
+		{CurrencyFormat{Locale: ParseLocale("en-GB")}, "£5.00"},
+		{CurrencyFormat{Locale: ParseLocale("fr-FR")}, "5,00 €"},
+		{CurrencyFormat{Locale: ParseLocale("en")}, "$5.00"},
+	}
+	for _, tt := range tests {
+		if got := tt.format.Format(5); got != tt.want {
+			t.Errorf("%+v.Format(5) = %q, want %q", tt.format, got, tt.want)
+		}
+	}
+}
+
+func TestPercentFormat_Format(t *testing.T) {
+	tests := []struct {
+		format PercentFormat
+		value  float64
+		want   string
+	}{
+		{PercentFormat{Locale: ParseLocale("en-US")}, 0.5, "50%"},
+		{PercentFormat{Locale: ParseLocale("en-US"), MaxFractionDigits: 1}, 0.1256, "12.6%"},
+		{PercentFormat{Locale: ParseLocale("de-DE"), MaxFractionDigits: 1}, 0.125, "12,5 %"},
+		{PercentFormat{Locale: ParseLocale("tr")}, -0.25, "-%25"},
+	}
+	for _, tt := range tests {
+		if got := tt.format.Format(tt.value); got != tt.want {
+			t.Errorf("%+v.Format(%v) = %q, want %q", tt.format, tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestDateFormatOf(t *testing.T) {
+	date := time.Date(2025, time.March, 7, 15, 4, 0, 0, time.UTC)
+	tests := []struct {
+		locale string
+		style  DateStyle
+		want   string
+	}{
+		{"en-US", DateStyleMedium, "Mar 7, 2025"},
+		{"en-US", DateStyleFull, "Friday, March 7, 2025"},
+		{"en-GB", DateStyleLong, "7 March 2025"},
+		{"de", DateStyleLong, "7. März 2025"},
+		{"fr", DateStyleFull, "vendredi 7 mars 2025"},
+		{"es", DateStyleLong, "7 de marzo de 2025"},
+		{"ru", DateStyleLong, "7 марта 2025 г."},
+		{"ja", DateStyleFull, "2025年3月7日金曜日"},
+		{"xx", DateStyleMedium, "7 Mar 2025"},
+	}
+	for _, tt := range tests {
+		f := DateFormatOf(ParseLocale(tt.locale), tt.style)
+		got := f.Format(date)
+		if got != tt.want {
+			t.Errorf("%s style %d: Format = %q, want %q", tt.locale, tt.style, got, tt.want)
+			continue
+		}
+		parsed, err := f.Parse(got, time.UTC)
+		if err != nil || !parsed.Equal(time.Date(2025, time.March, 7, 0, 0, 0, 0, time.UTC)) {
+			t.Errorf("%s style %d: Parse(%q) = %v, %v", tt.locale, tt.style, got, parsed, err)
+		}
+	}
+}
+
+func TestTimeFormatOf(t *testing.T) {
+	date := time.Date(2025, time.March, 7, 15, 4, 0, 0, time.UTC)
+	tests := map[string]string{
+		"en-US": "3:04 PM",
+		"en-GB": "15:04",
+		"de":    "15:04",
+		"ko":    "오후 3:04",
+	}
+	for tag, want := range tests {
+		f := TimeFormatOf(ParseLocale(tag))
+		got := f.Format(date)
+		if got != want {
+			t.Errorf("%s: Format = %q, want %q", tag, got, want)
+			continue
+		}
+		if parsed, err := f.Parse(got, time.UTC); err != nil || parsed.Hour() != 15 || parsed.Minute() != 4 {
+			t.Errorf("%s: Parse(%q) = %v, %v", tag, got, parsed, err)
+		}
+	}
+}
+
+func TestDateFormat_ParseNamesIgnoresCase(t *testing.T) {
+	f := DateFormat{Pattern: "EEE, d MMM yyyy"}
+	got, err := f.Parse("fri, 7 MAR 2025", time.UTC)
+	if err != nil || got.Month() != time.March || got.Day() != 7 {
+		t.Errorf("Parse = %v, %v", got, err)
+	}
+	if _, err := f.Parse("Fri, 7 Mrz 2025", time.UTC); err != ErrInvalidDate {
+		t.Errorf("expected ErrInvalidDate for an unknown month, got %v", err)
+	}
+}
+
+func TestRelativeTimeFormat_Format(t *testing.T) {
+	tests := []struct {
+		format RelativeTimeFormat
+		value  int
+		unit   RelativeTimeUnit
+		want   string
+	}{
+		{RelativeTimeFormat{Locale: ParseLocale("en")}, 5, RelativeMinute, "in 5 minutes"},
+		{RelativeTimeFormat{Locale: ParseLocale("en")}, -1, RelativeHour, "1 hour ago"},
+		{RelativeTimeFormat{Locale: ParseLocale("en")}, -1, RelativeDay, "yesterday"},
+		{RelativeTimeFormat{Locale: ParseLocale("en"), NumericOnly: true}, -1, RelativeDay, "1 day ago"},
+		{RelativeTimeFormat{Locale: ParseLocale("en")}, 0, RelativeSecond, "now"},
+		{RelativeTimeFormat{Locale: ParseLocale("en")}, 1500, RelativeYear, "in 1,500 years"},
+		{RelativeTimeFormat{Locale: ParseLocale("de")}, 3, RelativeDay, "in 3 Tagen"},
+		{RelativeTimeFormat{Locale: ParseLocale("fr")}, -2, RelativeWeek, "il y a 2 semaines"},
+		{RelativeTimeFormat{Locale: ParseLocale("ru")}, -5, RelativeYear, "5 лет назад"},
+		{RelativeTimeFormat{Locale: ParseLocale("ru")}, 22, RelativeMinute, "через 22 минуты"},
+		{RelativeTimeFormat{Locale: ParseLocale("ja")}, 3, RelativeMonth, "3 か月後"},
+		{RelativeTimeFormat{Locale: ParseLocale("xx")}, 2, RelativeDay, "in 2 days"},
+	}
+	for _, tt := range tests {
+		if got := tt.format.Format(tt.value, tt.unit); got != tt.want {
+			t.Errorf("%+v.Format(%d, %d) = %q, want %q", tt.format, tt.value, tt.unit, got, tt.want)
+		}
+	}
+}
+
+func TestRelativeTimeFormat_FormatTime(t *testing.T) {
+	now := time.Date(2025, time.March, 7, 12, 0, 0, 0, time.UTC)
+	f := RelativeTimeFormat{Locale: ParseLocale("en")}
+	tests := []struct {
+		offset time.Duration
+		want   string
+	}{
+		{0, "now"},
+		{-30 * time.Second, "30 seconds ago"},
+		{59*time.Second + 600*time.Millisecond, "in 1 minute"},
+		{-90 * time.Minute, "2 hours ago"},
+		{-26 * time.Hour, "yesterday"},
+		{10 * 24 * time.Hour, "in 1 week"},
+		{-26 * 24 * time.Hour, "1 month ago"},
+		{200 * 24 * time.Hour, "in 7 months"},
+		{-360 * 24 * time.Hour, "1 year ago"},
+	}
+	for _, tt := range tests {
+		if got := f.FormatTime(now.Add(tt.offset), now); got != tt.want {
+			t.Errorf("FormatTime(now%+v) = %q, want %q", tt.offset, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,115 @@
+package intl
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// PluralCategory is a CLDR plural category, used to pick the grammatical
+// form of a word for a count, such as "1 file" or "2 files".
+type PluralCategory string
+
+const (
+	PluralZero  PluralCategory = "zero"
+	PluralOne   PluralCategory = "one"
+	PluralTwo   PluralCategory = "two"
+	PluralFew   PluralCategory = "few"
+	PluralMany  PluralCategory = "many"
+	PluralOther PluralCategory = "other"
+)
+
+// PluralCategoryOf returns the plural category of n in the locale's
+// language. Languages without plural forms, such as Japanese, always use
+// [PluralOther]; languages without rules here follow English, where only an
+// integer 1 is [PluralOne].
+func PluralCategoryOf(l Locale, n float64) PluralCategory {
+	l = l.resolve()
+	n = math.Abs(n)
+	i := int64(n)
+	// v is the number of visible fraction digits, as in CLDR.
+	v := 0
+	if n != math.Trunc(n) {
+		s := strconv.FormatFloat(n, 'f', -1, 64)
+		_, frac, _ := strings.Cut(s, ".")
+		v = len(frac)
+	}
+	integer := v == 0
+
+	switch l.Language {
+	case "ja", "zh", "ko", "th", "vi", "id", "ms", "lo", "my", "km":
+		return PluralOther
+	case "fr", "hy", "kab":
+		if i == 0 || i == 1 {
+			return PluralOne
+		}
+	case "pt":
+		if l.Region != "PT" && (i == 0 || i == 1) {
+			return PluralOne
+		}
+		if l.Region == "PT" && i == 1 && integer {
+			return PluralOne
+		}
+	case "ru", "uk", "be":
+		if !integer {
+			return PluralOther
+		}
+		switch {
+		case i%10 == 1 && i%100 != 11:
+			return PluralOne
+		case i%10 >= 2 && i%10 <= 4 && (i%100 < 12 || i%100 > 14):
+			return PluralFew
+		default:
+			return PluralMany
+		}
+	case "pl":
+		if !integer {
+			return PluralOther
+		}
+		switch {
+		case i == 1:
+			return PluralOne
+		case i%10 >= 2 && i%10 <= 4 && (i%100 < 12 || i%100 > 14):
+			return PluralFew
+		default:
+			return PluralMany
+		}
+	case "cs", "sk":
+		switch {
+		case !integer:
+			return PluralMany
+		case i == 1:
+			return PluralOne
+		case i >= 2 && i <= 4:
+			return PluralFew
+		}
+	case "ar":
+		if !integer {
+			return PluralOther
+		}
+		switch m := i % 100; {
+		case i == 0:
+			return PluralZero
+		case i == 1:
+			return PluralOne
+		case i == 2:
+			return PluralTwo
+		case m >= 3 && m <= 10:
+			return PluralFew
+		case m >= 11 && m <= 99:
+			return PluralMany
+		}
+	case "he", "iw":
+		if integer && i == 1 {
+			return PluralOne
+		}
+		if integer && i == 2 {
+			return PluralTwo
+		}
+	default:
+		if integer && i == 1 {
+			return PluralOne
+		}
+	}
+	return PluralOther
+}
@@ -0,0 +1,268 @@
+package intl
+
+import (
+	"math"
+	"strings"
+	"time"
+)
+
+// RelativeTimeUnit is the unit of a relative time such as "3 days ago".
+type RelativeTimeUnit int
+
+const (
+	RelativeSecond RelativeTimeUnit = iota
+	RelativeMinute
+	RelativeHour
+	RelativeDay
+	RelativeWeek
+	RelativeMonth
+	RelativeYear
+)
+
+// RelativeTimeFormat formats times relative to now, such as "in 5 minutes",
+// "3 days ago", or "yesterday".
+//
+//	intl.RelativeTimeFormat{}.Format(-1, intl.RelativeDay)                  // "yesterday"
+//	intl.RelativeTimeFormat{Locale: intl.ParseLocale("de")}.Format(3, intl.RelativeHour) // "in 3 Stunden"
+type RelativeTimeFormat struct {
+	// Locale selects the language. Zero uses [DefaultLocale]. Languages
+	// without relative time data use English.
+	Locale Locale
+	// NumericOnly always writes a number, so -1 days is "1 day ago" rather
+	// than "yesterday" and 0 seconds is "in 0 seconds" rather than "now".
+	NumericOnly bool
+}
+
+// Format returns value units from now: positive values are in the future
+// and negative values in the past.
+func (f RelativeTimeFormat) Format(value int, unit RelativeTimeUnit) string {
+	l := f.Locale.resolve()
+	data := relativeDataOf(l)
+	if !f.NumericOnly {
+		switch {
+		case unit == RelativeSecond && value == 0:
+			return data.now
+		case unit == RelativeDay && value >= -1 && value <= 1:
+			return data.days[value+1]
+		}
+	}
+	if unit < RelativeSecond || unit > RelativeYear {
+		unit = RelativeSecond
+	}
+	n := value
+	pattern := data.future
+	if value < 0 {
+		n, pattern = -value, data.past
+	}
+	count := NumberFormat{Locale: l}.Format(float64(n))
+	noun := data.units[unit].of(PluralCategoryOf(l, float64(n)))
+	return strings.NewReplacer("{0}", count, "{1}", noun).Replace(pattern)
+}
+
+// FormatTime describes t relative to now in the largest unit that fits,
+// rounded to the nearest whole unit: "in 2 hours", "yesterday", "3 weeks
+// ago". A unit is used until it would reach the next, so 59.6 seconds is
+// "in 1 minute" and 26 days is "in 1 month".
+func (f RelativeTimeFormat) FormatTime(t, now time.Time) string {
+	value, unit := relativeValue(t.Sub(now))
+	return f.Format(value, unit)
+}
+
+// FormatRelative describes t relative to the current time with the default
+// locale, such as "5 minutes ago".
+func FormatRelative(t time.Time) string {
+	return RelativeTimeFormat{}.FormatTime(t, time.Now())
+}
+
+func relativeValue(d time.Duration) (int, RelativeTimeUnit) {
+	const day = 24 * time.Hour
+	// Each unit is used while the rounded value stays below the next unit.
+	units := []struct {
+		unit  RelativeTimeUnit
+		size  time.Duration
+		limit int
+	}{
+		{RelativeSecond, time.Second, 60},
+		{RelativeMinute, time.Minute, 60},
+		{RelativeHour, time.Hour, 24},
+		{RelativeDay, day, 7},
+		{RelativeWeek, 7 * day, 4},
+		{RelativeMonth, 30 * day, 12},
+	}
+	for _, u := range units {
+		v := int(math.Round(float64(d) / float64(u.size)))
+		if v > -u.limit && v < u.limit {
+			return v, u.unit
+		}
+	}
+	return int(math.Round(float64(d) / float64(365*day))), RelativeYear
+}
+
+// relativeData holds a language's relative time phrases. future and past
+// are patterns in which {0} is the count and {1} the unit.
+type relativeData struct {
+	future, past string
+	units        [7]pluralNouns // indexed by RelativeTimeUnit
+	now          string
+	days         [3]string // yesterday, today, tomorrow
+}
+
+// pluralNouns holds a unit's forms by plural category. A missing category
+// uses PluralOther.
+type pluralNouns map[PluralCategory]string
+
+func (n pluralNouns) of(c PluralCategory) string {
+	if s, ok := n[c]; ok {
+		return s
+	}
+	return n[PluralOther]
+}
+
+// nouns returns the forms of a unit in a language with singular and plural.
+func nouns(one, other string) pluralNouns {
+	return pluralNouns{PluralOne: one, PluralOther: other}
+}
+
+// slavicNouns returns the forms of a unit in a language with one, few, and
+// many forms. Fractions take the few form.
+func slavicNouns(one, few, many string) pluralNouns {
+	return pluralNouns{PluralOne: one, PluralFew: few, PluralMany: many, PluralOther: few}
+}
+
+// invariant returns the form of a unit in a language without plurals.
+func invariant(s string) pluralNouns {
+	return pluralNouns{PluralOther: s}
+}
+
+var defaultRelativeData = relativeData{
+	future: "in {0} {1}", past: "{0} {1} ago",
+	units: [7]pluralNouns{
+		nouns("second", "seconds"), nouns("minute", "minutes"), nouns("hour", "hours"),
+		nouns("day", "days"), nouns("week", "weeks"), nouns("month", "months"), nouns("year", "years"),
+	},
+	now:  "now",
+	days: [3]string{"yesterday", "today", "tomorrow"},
+}
+
+// relativeDataByLanguage lists languages other than English.
+var relativeDataByLanguage = map[string]relativeData{
+	"de": {
+		future: "in {0} {1}", past: "vor {0} {1}",
+		units: [7]pluralNouns{
+			nouns("Sekunde", "Sekunden"), nouns("Minute", "Minuten"), nouns("Stunde", "Stunden"),
+			nouns("Tag", "Tagen"), nouns("Woche", "Wochen"), nouns("Monat", "Monaten"), nouns("Jahr", "Jahren"),
+		},
+		now:  "jetzt",
+		days: [3]string{"gestern", "heute", "morgen"},
+	},
+	"es": {
+		future: "dentro de {0} {1}", past: "hace {0} {1}",
+		units: [7]pluralNouns{
+			nouns("segundo", "segundos"), nouns("minuto", "minutos"), nouns("hora", "horas"),
+			nouns("día", "días"), nouns("semana", "semanas"), nouns("mes", "meses"), nouns("año", "años"),
+		},
+		now:  "ahora",
+		days: [3]string{"ayer", "hoy", "mañana"},
+	},
+	"fr": {
+		future: "dans {0} {1}", past: "il y a {0} {1}",
+		units: [7]pluralNouns{
+			nouns("seconde", "secondes"), nouns("minute", "minutes"), nouns("heure", "heures"),
+			nouns("jour", "jours"), nouns("semaine", "semaines"), nouns("mois", "mois"), nouns("an", "ans"),
+		},
+		now:  "maintenant",
+		days: [3]string{"hier", "aujourd’hui", "demain"},
+	},
+	"it": {
+		future: "tra {0} {1}", past: "{0} {1} fa",
+		units: [7]pluralNouns{
+			nouns("secondo", "secondi"), nouns("minuto", "minuti"), nouns("ora", "ore"),
+			nouns("giorno", "giorni"), nouns("settimana", "settimane"), nouns("mese", "mesi"), nouns("anno", "anni"),
+		},
+		now:  "ora",
+		days: [3]string{"ieri", "oggi", "domani"},
+	},
+	"ja": {
+		future: "{0} {1}後", past: "{0} {1}前",
+		units: [7]pluralNouns{
+			invariant("秒"), invariant("分"), invariant("時間"),
+			invariant("日"), invariant("週間"), invariant("か月"), invariant("年"),
+		},
+		now:  "今",
+		days: [3]string{"昨日", "今日", "明日"},
+	},
+	"ko": {
+		future: "{0}{1} 후", past: "{0}{1} 전",
+		units: [7]pluralNouns{
+			invariant("초"), invariant("분"), invariant("시간"),
+			invariant("일"), invariant("주"), invariant("개월"), invariant("년"),
+		},
+		now:  "지금",
+		days: [3]string{"어제", "오늘", "내일"},
+	},
+	"nl": {
+		future: "over {0} {1}", past: "{0} {1} geleden",
+		units: [7]pluralNouns{
+			nouns("seconde", "seconden"), nouns("minuut", "minuten"), nouns("uur", "uur"),
+			nouns("dag", "dagen"), nouns("week", "weken"), nouns("maand", "maanden"), nouns("jaar", "jaar"),
+		},
+		now:  "nu",
+		days: [3]string{"gisteren", "vandaag", "morgen"},
+	},
+	"pl": {
+		future: "za {0} {1}", past: "{0} {1} temu",
+		units: [7]pluralNouns{
+			slavicNouns("sekundę", "sekundy", "sekund"), slavicNouns("minutę", "minuty", "minut"),
+			slavicNouns("godzinę", "godziny", "godzin"), slavicNouns("dzień", "dni", "dni"),
+			slavicNouns("tydzień", "tygodnie", "tygodni"), slavicNouns("miesiąc", "miesiące", "miesięcy"),
+			slavicNouns("rok", "lata", "lat"),
+		},
+		now:  "teraz",
+		days: [3]string{"wczoraj", "dzisiaj", "jutro"},
+	},
+	"pt": {
+		future: "em {0} {1}", past: "há {0} {1}",
+		units: [7]pluralNouns{
+			nouns("segundo", "segundos"), nouns("minuto", "minutos"), nouns("hora", "horas"),
+			nouns("dia", "dias"), nouns("semana", "semanas"), nouns("mês", "meses"), nouns("ano", "anos"),
+		},
+		now:  "agora",
+		days: [3]string{"ontem", "hoje", "amanhã"},
+	},
+	"ru": {
+		future: "через {0} {1}", past: "{0} {1} назад",
+		units: [7]pluralNouns{
+			slavicNouns("секунду", "секунды", "секунд"), slavicNouns("минуту", "минуты", "минут"),
+			slavicNouns("час", "часа", "часов"), slavicNouns("день", "дня", "дней"),
+			slavicNouns("неделю", "недели", "недель"), slavicNouns("месяц", "месяца", "месяцев"),
+			slavicNouns("год", "года", "лет"),
+		},
+		now:  "сейчас",
+		days: [3]string{"вчера", "сегодня", "завтра"},
+	},
+	"sv": {
+		future: "om {0} {1}", past: "för {0} {1} sedan",
+		units: [7]pluralNouns{
+			nouns("sekund", "sekunder"), nouns("minut", "minuter"), nouns("timme", "timmar"),
+			nouns("dag", "dagar"), nouns("vecka", "veckor"), nouns("månad", "månader"), nouns("år", "år"),
+		},
+		now:  "nu",
+		days: [3]string{"i går", "i dag", "i morgon"},
+	},
+	"zh": {
+		future: "{0}{1}后", past: "{0}{1}前",
+		units: [7]pluralNouns{
+			invariant("秒钟"), invariant("分钟"), invariant("小时"),
+			invariant("天"), invariant("周"), invariant("个月"), invariant("年"),
+		},
+		now:  "现在",
+		days: [3]string{"昨天", "今天", "明天"},
+	},
+}
+
+func relativeDataOf(l Locale) relativeData {
+	if data, ok := relativeDataByLanguage[l.Language]; ok {
+		return data
+	}
+	return defaultRelativeData
+}
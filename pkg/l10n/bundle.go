@@ -0,0 +1,332 @@
+// Package l10n translates an app's text into the user's language.
+//
+// Messages are loaded into a [Bundle] from ARB files, as used by Flutter
+// and most translation services, or from plain JSON files, usually embedded
+// in the binary:
+//
+//	//go:embed l10n
+//	var messages embed.FS
+//
+//	bundle := l10n.NewBundle(intl.ParseLocale("en"))
+//	if err := bundle.LoadFS(messages, "l10n"); err != nil {
+//	    log.Fatal(err)
+//	}
+//
+// Messages use the ICU MessageFormat subset supported by ARB: {name}
+// placeholders, plurals, and selects:
+//
+//	{
+//	  "@@locale": "en",
+//	  "greeting": "Hello, {name}!",
+//	  "unread": "{count, plural, =0{No messages} one{# message} other{# messages}}"
+//	}
+//
+// [LocalizationsProvider] picks the best supported locale for the user's
+// system languages and provides a [Localizer] to the widget tree, switching
+// when the system language changes or when its Locale field is set:
+//
+//	l10n.LocalizationsProvider{Bundle: bundle, Child: app}
+//
+//	// In a descendant's Build:
+//	loc := l10n.Of(ctx)
+//	widgets.Text{Content: loc.Format("unread", l10n.Args{"count": n})}
+package l10n
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"path"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/go-drift/drift/pkg/intl"
+)
+
+// Bundle holds the translated messages of every supported locale. It is
+// safe for concurrent use.
+type Bundle struct {
+	fallback intl.Locale
+	mu       sync.RWMutex
+	catalogs map[intl.Locale]map[string]message
+}
+
+// NewBundle creates an empty bundle. Messages missing from the user's
+// locale are looked up in fallback, which should have every message.
+func NewBundle(fallback intl.Locale) *Bundle {
+	return &Bundle{
+		fallback: fallback,
+		catalogs: map[intl.Locale]map[string]message{},
+	}
+}
+
+// Fallback returns the locale used for messages missing from a
+// translation.
+func (b *Bundle) Fallback() intl.Locale {
+	return b.fallback
+}
+
+// AddMessages adds messages for locale, replacing existing messages with
+// the same key. Returns an error naming the first message that fails to
+// parse; the valid messages are still added.
+func (b *Bundle) AddMessages(locale intl.Locale, messages map[string]string) error {
+	parsed := make(map[string]message, len(messages))
+	var errs []string
+	for key, src := range messages {
+		m, err := parseMessage(src)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %v", key, err))
+			continue
+		}
+		parsed[key] = m
+	}
+
+	b.mu.Lock()
+	catalog := b.catalogs[locale]
+	if catalog == nil {
+		catalog = map[string]message{}
+		b.catalogs[locale] = catalog
+	}
+	for key, m := range parsed {
+		catalog[key] = m
+	}
+	b.mu.Unlock()
+
+	if len(errs) > 0 {
+		sort.Strings(errs)
+		return fmt.Errorf("l10n: invalid messages for %s: %s", locale, strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// LoadARB adds the messages of an ARB file. The locale comes from its
+// "@@locale" entry, or from locale when the file has none. Metadata entries
+// starting with "@" are ignored.
+func (b *Bundle) LoadARB(data []byte, locale intl.Locale) error {
+	var entries map[string]any
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return fmt.Errorf("l10n: invalid ARB file: %w", err)
+	}
+	if tag, ok := entries["@@locale"].(string); ok && tag != "" {
+		locale = intl.ParseLocale(tag)
+	}
+	if locale.IsZero() {
+		return fmt.Errorf("l10n: ARB file has no @@locale")
+	}
+	messages := map[string]string{}
+	for key, value := range entries {
+		if strings.HasPrefix(key, "@") {
+			continue
+		}
+		s, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("l10n: ARB message %q is not a string", key)
+		}
+		messages[key] = s
+	}
+	return b.AddMessages(locale, messages)
+}
+
+// LoadJSON adds the messages of a JSON file for locale. Nested objects are
+// flattened with dots, so {"settings": {"title": "..."}} defines the key
+// "settings.title".
+func (b *Bundle) LoadJSON(data []byte, locale intl.Locale) error {
+	var entries map[string]any
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return fmt.Errorf("l10n: invalid JSON messages: %w", err)
+	}
+	messages := map[string]string{}
+	if err := flattenMessages("", entries, messages); err != nil {
+		return err
+	}
+	return b.AddMessages(locale, messages)
+}
+
+func flattenMessages(prefix string, entries map[string]any, out map[string]string) error {
+	for key, value := range entries {
+		if prefix != "" {
+			key = prefix + "." + key
+		}
+		switch v := value.(type) {
+		case string:
+			out[key] = v
+		case map[string]any:
+			if err := flattenMessages(key, v, out); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("l10n: message %q is not a string", key)
+		}
+	}
+	return nil
+}
+
+// LoadFS adds every .arb and .json file in dir of fsys. The locale of a
+// file is its last "_"-separated name part, so app_en.arb, en.json and
+// messages_pt_BR.json are loaded as en, en and pt-BR; ARB files may
+// override it with "@@locale".
+func (b *Bundle) LoadFS(fsys fs.FS, dir string) error {
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return fmt.Errorf("l10n: %w", err)
+	}
+	for _, entry := range entries {
+		ext := path.Ext(entry.Name())
+		if entry.IsDir() || (ext != ".arb" && ext != ".json") {
+			continue
+		}
+		data, err := fs.ReadFile(fsys, path.Join(dir, entry.Name()))
+		if err != nil {
+			return fmt.Errorf("l10n: %w", err)
+		}
+		locale := localeFromFileName(strings.TrimSuffix(entry.Name(), ext))
+		if ext == ".arb" {
+			err = b.LoadARB(data, locale)
+		} else if locale.IsZero() {
+			err = fmt.Errorf("l10n: cannot tell the locale of %s", entry.Name())
+		} else {
+			err = b.LoadJSON(data, locale)
+		}
+		if err != nil {
+			return fmt.Errorf("%s: %w", entry.Name(), err)
+		}
+	}
+	return nil
+}
+
+// localeFromFileName reads the locale from names such as "en",
+// "app_en", "app_pt_BR" or "pt-BR".
+func localeFromFileName(name string) intl.Locale {
+	parts := strings.Split(name, "_")
+	last := parts[len(parts)-1]
+	// A trailing region, as in app_pt_BR, belongs to the previous part.
+	if len(parts) >= 2 && isRegion(last) && isLanguage(parts[len(parts)-2]) {
+		return intl.ParseLocale(parts[len(parts)-2] + "-" + last)
+	}
+	if language, _, _ := strings.Cut(last, "-"); isLanguage(language) {
+		return intl.ParseLocale(last)
+	}
+	return intl.Locale{}
+}
+
+func isLanguage(s string) bool {
+	return (len(s) == 2 || len(s) == 3) && strings.ToLower(s) == s && isLetters(s)
+}
+
+func isRegion(s string) bool {
+	return len(s) == 2 && strings.ToUpper(s) == s && isLetters(s)
+}
+
+func isLetters(s string) bool {
+	for _, r := range s {
+		if (r < 'a' || r > 'z') && (r < 'A' || r > 'Z') {
+			return false
+		}
+	}
+	return true
+}
+
+// Locales returns the locales with messages, sorted by tag.
+func (b *Bundle) Locales() []intl.Locale {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	locales := make([]intl.Locale, 0, len(b.catalogs))
+	for l := range b.catalogs {
+		locales = append(locales, l)
+	}
+	slices.SortFunc(locales, func(a, b intl.Locale) int { return strings.Compare(a.String(), b.String()) })
+	return locales
+}
+
+// Resolve returns the supported locale that best matches the user's
+// preferred locales, most preferred first: an exact match, then a match on
+// language alone, trying each preference in turn before falling back.
+func (b *Bundle) Resolve(preferred []intl.Locale) intl.Locale {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	for _, want := range preferred {
+		if _, ok := b.catalogs[want]; ok {
+			return want
+		}
+		if _, ok := b.catalogs[intl.Locale{Language: want.Language}]; ok {
+			return intl.Locale{Language: want.Language}
+		}
+		// Any region of the language, such as pt-BR for pt-PT.
+		var match intl.Locale
+		for l := range b.catalogs {
+			if l.Language == want.Language && (match.IsZero() || l.String() < match.String()) {
+				match = l
+			}
+		}
+		if !match.IsZero() {
+			return match
+		}
+	}
+	return b.fallback
+}
+
+// Localizer returns a localizer for locale. Messages are looked up in
+// locale, then its language without region, then the fallback locale.
+func (b *Bundle) Localizer(locale intl.Locale) *Localizer {
+	return &Localizer{bundle: b, locale: locale}
+}
+
+// lookup returns the message for key in the lookup chain of locale.
+func (b *Bundle) lookup(locale intl.Locale, key string) (message, bool) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	chain := []intl.Locale{locale, {Language: locale.Language}, b.fallback}
+	for _, l := range chain {
+		if m, ok := b.catalogs[l][key]; ok {
+			return m, true
+		}
+	}
+	return nil, false
+}
+
+// Localizer formats the messages of one locale.
+type Localizer struct {
+	bundle *Bundle
+	locale intl.Locale
+}
+
+// Locale returns the locale messages are formatted for.
+func (l *Localizer) Locale() intl.Locale {
+	if l == nil {
+		return intl.DefaultLocale()
+	}
+	return l.locale
+}
+
+// Text returns the message for key without arguments.
+func (l *Localizer) Text(key string) string {
+	return l.Format(key, nil)
+}
+
+// Format returns the message for key with args substituted. Numbers are
+// formatted for the locale. A missing key returns the key itself, so
+// untranslated text is visible rather than blank; a nil Localizer does the
+// same.
+func (l *Localizer) Format(key string, args Args) string {
+	if l == nil || l.bundle == nil {
+		return key
+	}
+	m, ok := l.bundle.lookup(l.locale, key)
+	if !ok {
+		return key
+	}
+	var b strings.Builder
+	m.formatTo(&b, &formatter{locale: l.locale, args: args})
+	return b.String()
+}
+
+// Has reports whether key has a message in the lookup chain.
+func (l *Localizer) Has(key string) bool {
+	if l == nil || l.bundle == nil {
+		return false
+	}
+	_, ok := l.bundle.lookup(l.locale, key)
+	return ok
+}
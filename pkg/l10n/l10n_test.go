@@ -0,0 +1,180 @@
+package l10n
+
+import (
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/go-drift/drift/pkg/intl"
+)
+
+func newTestBundle(t *testing.T) *Bundle {
+	t.Helper()
+	b := NewBundle(intl.ParseLocale("en"))
+	if err := b.AddMessages(intl.ParseLocale("en"), map[string]string{
+		"greeting": "Hello, {name}!",
+		"unread":   "{count, plural, =0{No messages} one{# message} other{# messages}}",
+		"invite":   "{gender, select, female{She} male{He} other{They}} invited you",
+		"only.en":  "English only",
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.AddMessages(intl.ParseLocale("de"), map[string]string{
+		"greeting": "Hallo, {name}!",
+		"unread":   "{count, plural, one{# Nachricht} other{# Nachrichten}}",
+	}); err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+func TestLocalizer_Format(t *testing.T) {
+	b := newTestBundle(t)
+	en := b.Localizer(intl.ParseLocale("en"))
+	de := b.Localizer(intl.ParseLocale("de-AT"))
+
+	tests := []struct {
+		loc  *Localizer
+		key  string
+		args Args
+		want string
+	}{
+		{en, "greeting", Args{"name": "Ada"}, "Hello, Ada!"},
+		{en, "greeting", nil, "Hello, {name}!"},
+		{en, "unread", Args{"count": 0}, "No messages"},
+		{en, "unread", Args{"count": 1}, "1 message"},
+		{en, "unread", Args{"count": 1200}, "1,200 messages"},
+		{en, "invite", Args{"gender": "female"}, "She invited you"},
+		{en, "invite", Args{"gender": "x"}, "They invited you"},
+		{de, "greeting", Args{"name": "Ada"}, "Hallo, Ada!"},
+		{de, "unread", Args{"count": 1200}, "1.200 Nachrichten"},
+		{de, "only.en", nil, "English only"},
+		{de, "missing", nil, "missing"},
+		{nil, "greeting", nil, "greeting"},
+	}
+	for _, tt := range tests {
+		if got := tt.loc.Format(tt.key, tt.args); got != tt.want {
+			t.Errorf("%s: Format(%q, %v) = %q, want %q", tt.loc.Locale(), tt.key, tt.args, got, tt.want)
+		}
+	}
+}
+
+func TestParseMessage_Quoting(t *testing.T) {
+	b := NewBundle(intl.ParseLocale("en"))
+	if err := b.AddMessages(intl.ParseLocale("en"), map[string]string{
+		"braces":     "Use '{name}' for placeholders",
+		"apostrophe": "It''s {name}'s turn",
+		"hash":       "{n, plural, other{'#'#}}",
+	}); err != nil {
+		t.Fatal(err)
+	}
+	loc := b.Localizer(intl.ParseLocale("en"))
+	args := Args{"name": "Bo", "n": 3}
+	for key, want := range map[string]string{
+		"braces":     "Use {name} for placeholders",
+		"apostrophe": "It's Bo's turn",
+		"hash":       "#3",
+	} {
+		if got := loc.Format(key, args); got != want {
+			t.Errorf("%s = %q, want %q", key, got, want)
+		}
+	}
+}
+
+func TestParseMessage_Errors(t *testing.T) {
+	for _, src := range []string{
+		"{name",
+		"oops}",
+		"{}",
+		"{n, plural, one{#}}",
+		"{n, number}",
+		"{n, plural, one{#} other{#}",
+	} {
+		if _, err := parseMessage(src); err == nil {
+			t.Errorf("parseMessage(%q) succeeded, want error", src)
+		}
+	}
+}
+
+func TestBundle_LoadFS(t *testing.T) {
+	fsys := fstest.MapFS{
+		"l10n/app_en.arb": {Data: []byte(`{
+			"@@locale": "en",
+			"title": "Inbox",
+			"@title": {"description": "Screen title"}
+		}`)},
+		"l10n/app_pt_BR.arb": {Data: []byte(`{"title": "Caixa de entrada"}`)},
+		"l10n/fr.json":       {Data: []byte(`{"settings": {"title": "Réglages"}}`)},
+		"l10n/README.md":     {Data: []byte("ignored")},
+	}
+	b := NewBundle(intl.ParseLocale("en"))
+	if err := b.LoadFS(fsys, "l10n"); err != nil {
+		t.Fatal(err)
+	}
+
+	var tags []string
+	for _, l := range b.Locales() {
+		tags = append(tags, l.String())
+	}
+	if got := strings.Join(tags, ","); got != "en,fr,pt-BR" {
+		t.Errorf("Locales() = %s", got)
+	}
+	if got := b.Localizer(intl.ParseLocale("pt-BR")).Text("title"); got != "Caixa de entrada" {
+		t.Errorf("pt-BR title = %q", got)
+	}
+	if got := b.Localizer(intl.ParseLocale("fr")).Text("settings.title"); got != "Réglages" {
+		t.Errorf("fr settings.title = %q", got)
+	}
+}
+
+func TestBundle_LoadARBInvalidMessage(t *testing.T) {
+	b := NewBundle(intl.ParseLocale("en"))
+	err := b.LoadARB([]byte(`{"@@locale": "en", "ok": "Fine", "bad": "{count, plural, one{#}}"}`), intl.Locale{})
+	if err == nil || !strings.Contains(err.Error(), "bad") {
+		t.Fatalf("expected an error naming the bad message, got %v", err)
+	}
+	if got := b.Localizer(intl.ParseLocale("en")).Text("ok"); got != "Fine" {
+		t.Errorf("expected valid messages to load, got %q", got)
+	}
+}
+
+func TestBundle_Resolve(t *testing.T) {
+	b := newTestBundle(t)
+	if err := b.AddMessages(intl.ParseLocale("pt-BR"), map[string]string{"greeting": "Olá, {name}!"}); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		preferred []string
+		want      string
+	}{
+		{[]string{"de-DE"}, "de"},
+		{[]string{"ja", "de"}, "de"},
+		{[]string{"pt-PT"}, "pt-BR"},
+		{[]string{"ja"}, "en"},
+		{nil, "en"},
+	}
+	for _, tt := range tests {
+		var preferred []intl.Locale
+		for _, tag := range tt.preferred {
+			preferred = append(preferred, intl.ParseLocale(tag))
+		}
+		if got := b.Resolve(preferred).String(); got != tt.want {
+			t.Errorf("Resolve(%v) = %s, want %s", tt.preferred, got, tt.want)
+		}
+	}
+}
+
+func TestLocaleFromFileName(t *testing.T) {
+	for name, want := range map[string]string{
+		"en":           "en",
+		"app_en":       "en",
+		"app_pt_BR":    "pt-BR",
+		"pt-BR":        "pt-BR",
+		"messages_zh":  "zh",
+		"translations": "",
+	} {
+		if got := localeFromFileName(name).String(); got != want {
+			t.Errorf("localeFromFileName(%q) = %q, want %q", name, got, want)
+		}
+	}
+}
@@ -0,0 +1,114 @@
+package l10n
+
+import (
+	"reflect"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/intl"
+	"github.com/go-drift/drift/pkg/platform"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+// Localizations provides a [Localizer] to descendants, which read it with
+// [Of]. Usually inserted by [LocalizationsProvider].
+type Localizations struct {
+	core.InheritedBase
+	Localizer *Localizer
+	Child     core.Widget
+}
+
+func (l Localizations) ChildWidget() core.Widget { return l.Child }
+
+func (l Localizations) ShouldRebuildDependents(oldWidget core.InheritedWidget) bool {
+	if old, ok := oldWidget.(Localizations); ok {
+		return l.Localizer != old.Localizer
+	}
+	return true
+}
+
+var localizationsType = reflect.TypeFor[Localizations]()
+
+// Of returns the [Localizer] from the nearest [Localizations]. Widgets
+// calling this rebuild when the locale changes. Returns nil if there is no
+// Localizations ancestor; a nil Localizer formats every message as its key.
+func Of(ctx core.BuildContext) *Localizer {
+	if l, ok := ctx.DependOnInherited(localizationsType, nil).(Localizations); ok {
+		return l.Localizer
+	}
+	return nil
+}
+
+// Text is shorthand for Of(ctx).Format(key, args).
+func Text(ctx core.BuildContext, key string, args Args) string {
+	return Of(ctx).Format(key, args)
+}
+
+// LocalizationsProvider is a StatefulWidget that provides [Localizations]
+// for the supported locale of Bundle that best matches the user's
+// preferred languages in [platform.Locales]. It rebuilds its dependents
+// when the system language changes, or when Locale is changed to switch
+// language from within the app.
+//
+// It also sets [intl.DefaultLocale] so numbers and dates format for the
+// same locale, and provides a [widgets.Directionality] for the locale's
+// reading direction.
+type LocalizationsProvider struct {
+	core.StatefulBase
+
+	// Bundle holds the app's messages. Required.
+	Bundle *Bundle
+	// Locale overrides the system languages when set, for an in-app
+	// language setting. It is still resolved against Bundle's locales.
+	Locale intl.Locale
+	Child  core.Widget
+}
+
+func (p LocalizationsProvider) CreateState() core.State {
+	return &localizationsProviderState{}
+}
+
+type localizationsProviderState struct {
+	core.StateBase
+	preferred []intl.Locale
+	localizer *Localizer
+}
+
+func (s *localizationsProviderState) InitState() {
+	s.preferred = toIntlLocales(platform.Locales.Preferred())
+	s.OnDispose(platform.Locales.AddHandler(func(locales []platform.Locale) {
+		preferred := toIntlLocales(locales)
+		platform.Dispatch(func() {
+			s.SetState(func() { s.preferred = preferred })
+		})
+	}))
+}
+
+func (s *localizationsProviderState) Build(ctx core.BuildContext) core.Widget {
+	w := s.Element().Widget().(LocalizationsProvider)
+	preferred := s.preferred
+	if !w.Locale.IsZero() {
+		preferred = []intl.Locale{w.Locale}
+	}
+	locale := w.Bundle.Resolve(preferred)
+	// Keep the same Localizer while the locale is unchanged so dependents
+	// are not rebuilt needlessly.
+	if s.localizer == nil || s.localizer.bundle != w.Bundle || s.localizer.locale != locale {
+		s.localizer = w.Bundle.Localizer(locale)
+		intl.SetDefaultLocale(locale)
+	}
+	return widgets.Directionality{
+		TextDirection: widgets.TextDirectionForLocale(platform.ParseLocale(locale.String())),
+		Child: Localizations{
+			Localizer: s.localizer,
+			Child:     w.Child,
+		},
+	}
+}
+
+func toIntlLocales(locales []platform.Locale) []intl.Locale {
+	out := make([]intl.Locale, len(locales))
+	for i, l := range locales {
+		out[i] = intl.ParseLocale(l.String())
+	}
+	return out
+}
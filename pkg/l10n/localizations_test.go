@@ -0,0 +1,108 @@
+package l10n_test
+
+import (
+	"testing"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/intl"
+	"github.com/go-drift/drift/pkg/l10n"
+	"github.com/go-drift/drift/pkg/platform"
+	drifttest "github.com/go-drift/drift/pkg/testing"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+// probe calls read on every build.
+type probe struct {
+	core.StatelessBase
+	read func(ctx core.BuildContext)
+}
+
+func (p probe) Build(ctx core.BuildContext) core.Widget {
+	p.read(ctx)
+	return widgets.SizedBox{}
+}
+
+func testBundle(t *testing.T) *l10n.Bundle {
+	t.Helper()
+	b := l10n.NewBundle(intl.ParseLocale("en"))
+	for tag, title := range map[string]string{"en": "Inbox", "fr": "Boîte de réception", "ar": "البريد الوارد"} {
+		if err := b.AddMessages(intl.ParseLocale(tag), map[string]string{"title": title}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return b
+}
+
+func TestLocalizationsProvider_FollowsPlatformLocale(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	t.Cleanup(platform.ResetForTest)
+	t.Cleanup(func() { intl.SetDefaultLocale(intl.ParseLocale("en-US")) })
+
+	var title string
+	var direction graphics.TextDirection
+	tester.PumpWidget(l10n.LocalizationsProvider{
+		Bundle: testBundle(t),
+		Child: probe{read: func(ctx core.BuildContext) {
+			title = l10n.Text(ctx, "title", nil)
+			direction = widgets.DirectionalityOf(ctx)
+		}},
+	})
+	if title != "Inbox" {
+		t.Fatalf("expected English title for the default locale, got %q", title)
+	}
+
+	platform.Locales.SetLocalesForTest(platform.ParseLocale("fr-CA"), platform.ParseLocale("en-US"))
+	tester.Pump()
+	if title != "Boîte de réception" {
+		t.Errorf("expected French title after switching the system language, got %q", title)
+	}
+	if got := intl.DefaultLocale(); got != intl.ParseLocale("fr") {
+		t.Errorf("expected intl default locale fr, got %s", got)
+	}
+
+	platform.Locales.SetLocalesForTest(platform.ParseLocale("ar-EG"))
+	tester.Pump()
+	if direction != graphics.TextDirectionRTL {
+		t.Errorf("expected RTL for Arabic, got %v", direction)
+	}
+}
+
+func TestLocalizationsProvider_LocaleOverride(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	t.Cleanup(platform.ResetForTest)
+	t.Cleanup(func() { intl.SetDefaultLocale(intl.ParseLocale("en-US")) })
+
+	bundle := testBundle(t)
+	var title string
+	app := func(locale intl.Locale) core.Widget {
+		return l10n.LocalizationsProvider{
+			Bundle: bundle,
+			Locale: locale,
+			Child: probe{read: func(ctx core.BuildContext) {
+				title = l10n.Of(ctx).Text("title")
+			}},
+		}
+	}
+
+	tester.PumpWidget(app(intl.ParseLocale("fr")))
+	if title != "Boîte de réception" {
+		t.Errorf("expected the Locale override to win, got %q", title)
+	}
+	tester.PumpWidget(app(intl.Locale{}))
+	if title != "Inbox" {
+		t.Errorf("expected the system locale after clearing the override, got %q", title)
+	}
+}
+
+func TestOf_WithoutProvider(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+
+	var title string
+	tester.PumpWidget(probe{read: func(ctx core.BuildContext) {
+		title = l10n.Text(ctx, "title", nil)
+	}})
+	if title != "title" {
+		t.Errorf("expected the key without a provider, got %q", title)
+	}
+}
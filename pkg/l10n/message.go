@@ -0,0 +1,348 @@
+package l10n
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/go-drift/drift/pkg/intl"
+)
+
+// Args are the values substituted into a message's placeholders, by name.
+type Args map[string]any
+
+// message is a parsed message: a sequence of literal text, placeholders,
+// and plural or select choices.
+type message []part
+
+type part interface {
+	format(b *strings.Builder, f *formatter)
+}
+
+// formatter carries the state needed to format one message.
+type formatter struct {
+	locale intl.Locale
+	args   Args
+	// count is the value '#' stands for inside a plural case.
+	count    any
+	hasCount bool
+}
+
+type textPart string
+
+func (p textPart) format(b *strings.Builder, f *formatter) {
+	b.WriteString(string(p))
+}
+
+// argPart is a {name} placeholder.
+type argPart struct {
+	name string
+}
+
+func (p argPart) format(b *strings.Builder, f *formatter) {
+	v, ok := f.args[p.name]
+	if !ok {
+		// Leave unfilled placeholders visible so they are noticed.
+		b.WriteString("{" + p.name + "}")
+		return
+	}
+	b.WriteString(f.formatValue(v))
+}
+
+// countPart is '#' inside a plural case.
+type countPart struct{}
+
+func (countPart) format(b *strings.Builder, f *formatter) {
+	if !f.hasCount {
+		b.WriteByte('#')
+		return
+	}
+	b.WriteString(f.formatValue(f.count))
+}
+
+// pluralPart is {name, plural, =0{...} one{...} other{...}}.
+type pluralPart struct {
+	name  string
+	exact map[float64]message
+	cases map[string]message
+}
+
+func (p pluralPart) format(b *strings.Builder, f *formatter) {
+	v := f.args[p.name]
+	n, _ := toFloat(v)
+	chosen, ok := p.exact[n]
+	if !ok {
+		if chosen, ok = p.cases[string(intl.PluralCategoryOf(f.locale, n))]; !ok {
+			chosen = p.cases["other"]
+		}
+	}
+	inner := *f
+	inner.count, inner.hasCount = v, true
+	chosen.formatTo(b, &inner)
+}
+
+// selectPart is {name, select, a{...} b{...} other{...}}.
+type selectPart struct {
+	name  string
+	cases map[string]message
+}
+
+func (p selectPart) format(b *strings.Builder, f *formatter) {
+	chosen, ok := p.cases[fmt.Sprint(f.args[p.name])]
+	if !ok {
+		chosen = p.cases["other"]
+	}
+	chosen.formatTo(b, f)
+}
+
+func (m message) formatTo(b *strings.Builder, f *formatter) {
+	for _, p := range m {
+		p.format(b, f)
+	}
+}
+
+// formatValue formats numbers with the locale's separators and anything
+// else with fmt.
+func (f *formatter) formatValue(v any) string {
+	if s, ok := v.(string); ok {
+		return s
+	}
+	if n, ok := toFloat(v); ok {
+		return intl.NumberFormat{Locale: f.locale, MaxFractionDigits: 3}.Format(n)
+	}
+	return fmt.Sprint(v)
+}
+
+// toFloat converts numeric values and numeric strings to float64.
+func toFloat(v any) (float64, bool) {
+	switch n := v.(type) {
+	case int:
+		return float64(n), true
+	case int8:
+		return float64(n), true
+	case int16:
+		return float64(n), true
+	case int32:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case uint:
+		return float64(n), true
+	case uint8:
+		return float64(n), true
+	case uint16:
+		return float64(n), true
+	case uint32:
+		return float64(n), true
+	case uint64:
+		return float64(n), true
+	case float32:
+		return float64(n), true
+	case float64:
+		return n, true
+	case string:
+		f, err := strconv.ParseFloat(n, 64)
+		return f, err == nil
+	}
+	return math.NaN(), false
+}
+
+// parseMessage parses a message in the ICU MessageFormat subset used by ARB
+// files: {name} placeholders, plural and select arguments, '#' for the
+// count inside plural cases, and apostrophe quoting ('{' is a literal
+// brace, and two apostrophes are a literal apostrophe).
+func parseMessage(src string) (message, error) {
+	p := &messageParser{src: []rune(src)}
+	m, err := p.parse(false, 0)
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.src) {
+		return nil, p.errorf("unexpected '}'")
+	}
+	return m, nil
+}
+
+type messageParser struct {
+	src []rune
+	pos int
+}
+
+func (p *messageParser) errorf(format string, args ...any) error {
+	return fmt.Errorf("offset %d: %s", p.pos, fmt.Sprintf(format, args...))
+}
+
+// parse reads parts until the end of input or, when depth > 0, the '}'
+// closing the current case, which is left unconsumed.
+func (p *messageParser) parse(inPlural bool, depth int) (message, error) {
+	var m message
+	var text strings.Builder
+	flush := func() {
+		if text.Len() > 0 {
+			m = append(m, textPart(text.String()))
+			text.Reset()
+		}
+	}
+	for p.pos < len(p.src) {
+		r := p.src[p.pos]
+		switch {
+		case r == '\'':
+			p.readQuoted(&text, inPlural)
+		case r == '{':
+			flush()
+			part, err := p.parseArgument(depth)
+			if err != nil {
+				return nil, err
+			}
+			m = append(m, part)
+		case r == '}':
+			if depth == 0 {
+				return nil, p.errorf("unexpected '}'")
+			}
+			flush()
+			return m, nil
+		case r == '#' && inPlural:
+			flush()
+			m = append(m, countPart{})
+			p.pos++
+		default:
+			text.WriteRune(r)
+			p.pos++
+		}
+	}
+	if depth > 0 {
+		return nil, p.errorf("missing '}'")
+	}
+	flush()
+	return m, nil
+}
+
+// readQuoted handles an apostrophe: a doubled apostrophe is literal, and an
+// apostrophe before a syntax character quotes text up to the next one.
+// Any other apostrophe is literal.
+func (p *messageParser) readQuoted(text *strings.Builder, inPlural bool) {
+	p.pos++
+	if p.pos >= len(p.src) {
+		text.WriteRune('\'')
+		return
+	}
+	next := p.src[p.pos]
+	if next == '\'' {
+		text.WriteRune('\'')
+		p.pos++
+		return
+	}
+	if next != '{' && next != '}' && (next != '#' || !inPlural) {
+		text.WriteRune('\'')
+		return
+	}
+	for p.pos < len(p.src) {
+		r := p.src[p.pos]
+		p.pos++
+		if r == '\'' {
+			if p.pos < len(p.src) && p.src[p.pos] == '\'' {
+				text.WriteRune('\'')
+				p.pos++
+				continue
+			}
+			return
+		}
+		text.WriteRune(r)
+	}
+}
+
+// parseArgument parses {name}, {name, plural, ...} or {name, select, ...}
+// starting at the opening brace.
+func (p *messageParser) parseArgument(depth int) (part, error) {
+	p.pos++ // '{'
+	name := p.readWord()
+	if name == "" {
+		return nil, p.errorf("missing placeholder name")
+	}
+	p.skipSpace()
+	if p.pos >= len(p.src) {
+		return nil, p.errorf("missing '}'")
+	}
+	if p.src[p.pos] == '}' {
+		p.pos++
+		return argPart{name: name}, nil
+	}
+	if p.src[p.pos] != ',' {
+		return nil, p.errorf("expected ',' or '}' after %q", name)
+	}
+	p.pos++
+	kind := p.readWord()
+	p.skipSpace()
+	if kind != "plural" && kind != "select" {
+		return nil, p.errorf("unsupported argument type %q for %q", kind, name)
+	}
+	if p.pos >= len(p.src) || p.src[p.pos] != ',' {
+		return nil, p.errorf("expected ',' after %q", kind)
+	}
+	p.pos++
+
+	cases := map[string]message{}
+	exact := map[float64]message{}
+	for {
+		p.skipSpace()
+		if p.pos >= len(p.src) {
+			return nil, p.errorf("missing '}'")
+		}
+		if p.src[p.pos] == '}' {
+			p.pos++
+			break
+		}
+		selector := p.readWord()
+		if selector == "" {
+			return nil, p.errorf("expected a case selector in %q", name)
+		}
+		p.skipSpace()
+		if p.pos >= len(p.src) || p.src[p.pos] != '{' {
+			return nil, p.errorf("expected '{' after %q", selector)
+		}
+		p.pos++
+		sub, err := p.parse(kind == "plural", depth+1)
+		if err != nil {
+			return nil, err
+		}
+		p.pos++ // '}'
+		if value, ok := strings.CutPrefix(selector, "="); ok && kind == "plural" {
+			n, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return nil, p.errorf("invalid exact selector %q", selector)
+			}
+			exact[n] = sub
+			continue
+		}
+		cases[selector] = sub
+	}
+	if _, ok := cases["other"]; !ok {
+		return nil, p.errorf("%s argument %q needs an \"other\" case", kind, name)
+	}
+	if kind == "plural" {
+		return pluralPart{name: name, exact: exact, cases: cases}, nil
+	}
+	return selectPart{name: name, cases: cases}, nil
+}
+
+// readWord reads an identifier or selector after optional whitespace.
+func (p *messageParser) readWord() string {
+	p.skipSpace()
+	start := p.pos
+	for p.pos < len(p.src) {
+		r := p.src[p.pos]
+		if unicode.IsSpace(r) || r == ',' || r == '{' || r == '}' {
+			break
+		}
+		p.pos++
+	}
+	return string(p.src[start:p.pos])
+}
+
+func (p *messageParser) skipSpace() {
+	for p.pos < len(p.src) && unicode.IsSpace(p.src[p.pos]) {
+		p.pos++
+	}
+}
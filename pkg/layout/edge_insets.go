@@ -1,5 +1,7 @@
 package layout
 
+import "github.com/go-drift/drift/pkg/graphics"
+
 // EdgeInsets represents padding/margin on four sides.
 type EdgeInsets struct {
 	Left   float64
@@ -132,3 +134,40 @@ func (e EdgeInsets) OnlyHorizontal() EdgeInsets {
 func (e EdgeInsets) OnlyVertical() EdgeInsets {
 	return EdgeInsets{Top: e.Top, Bottom: e.Bottom}
 }
+
+// EdgeInsetsDirectional is padding whose horizontal sides are given as the
+// start and end of the reading direction rather than left and right, so
+// the same value lays out correctly in right-to-left locales. Resolve it
+// with the ambient direction, or pass it to a widget that does, such as
+// widgets.Padding.
+type EdgeInsetsDirectional struct {
+	Start  float64
+	Top    float64
+	End    float64
+	Bottom float64
+}
+
+// EdgeInsetsDirectionalOnly creates directional padding with explicit values.
+func EdgeInsetsDirectionalOnly(start, top, end, bottom float64) EdgeInsetsDirectional {
+	return EdgeInsetsDirectional{Start: start, Top: top, End: end, Bottom: bottom}
+}
+
+// Resolve converts the insets to physical insets: start is left and end is
+// right in LTR, and the reverse in RTL.
+func (e EdgeInsetsDirectional) Resolve(direction graphics.TextDirection) EdgeInsets {
+	if direction == graphics.TextDirectionRTL {
+		return EdgeInsets{Left: e.End, Top: e.Top, Right: e.Start, Bottom: e.Bottom}
+	}
+	return EdgeInsets{Left: e.Start, Top: e.Top, Right: e.End, Bottom: e.Bottom}
+}
+
+// AddInsets returns a new EdgeInsets with each side of other added to the
+// matching side of e.
+func (e EdgeInsets) AddInsets(other EdgeInsets) EdgeInsets {
+	return EdgeInsets{
+		Left:   e.Left + other.Left,
+		Top:    e.Top + other.Top,
+		Right:  e.Right + other.Right,
+		Bottom: e.Bottom + other.Bottom,
+	}
+}
@@ -0,0 +1,44 @@
+package layout
+
+import "fmt"
+
+// MouseCursor is the pointer shape shown while a mouse or trackpad hovers
+// over a render object, on devices that have one.
+type MouseCursor int
+
+const (
+	// MouseCursorBasic is the platform's default pointer.
+	MouseCursorBasic MouseCursor = iota
+	// MouseCursorClick indicates something that can be tapped, such as a
+	// link.
+	MouseCursorClick
+	// MouseCursorResizeColumn indicates a boundary that can be dragged
+	// horizontally to resize the columns on either side.
+	MouseCursorResizeColumn
+	// MouseCursorResizeRow indicates a boundary that can be dragged
+	// vertically to resize the rows on either side.
+	MouseCursorResizeRow
+)
+
+// String returns the cursor name.
+func (c MouseCursor) String() string {
+	switch c {
+	case MouseCursorBasic:
+		return "basic"
+	case MouseCursorClick:
+		return "click"
+	case MouseCursorResizeColumn:
+		return "resize_column"
+	case MouseCursorResizeRow:
+		return "resize_row"
+	default:
+		return fmt.Sprintf("MouseCursor(%d)", int(c))
+	}
+}
+
+// MouseCursorOwner identifies a render object that shows a mouse cursor
+// while hovered. The engine resolves the cursor by hit testing the hovered
+// point: the deepest owner in the result decides.
+type MouseCursorOwner interface {
+	MouseCursor() MouseCursor
+}
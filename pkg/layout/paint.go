@@ -148,7 +148,11 @@ func (p *PaintContext) PaintChild(child RenderBox, offset graphics.Offset) {
 		p.debugDepth++
 	}
 
-	child.Paint(p)
+	if ProfilingEnabled() {
+		paintProfiled(child, p)
+	} else {
+		child.Paint(p)
+	}
 
 	// Draw bounds after child paints so overlay is visible on top
 	if p.ShowLayoutBounds {
@@ -187,7 +191,11 @@ func (p *PaintContext) PaintChildWithLayer(child RenderBox, offset graphics.Offs
 			if !p.drawChildLayer(childLayer) {
 				// Fallback: canvas doesn't support DrawChildLayer, paint child directly.
 				p.PushTranslation(offset.X, offset.Y)
-				child.Paint(p)
+				if ProfilingEnabled() {
+					paintProfiled(child, p)
+				} else {
+					child.Paint(p)
+				}
 				p.PopTranslation()
 			}
 			if p.ShowLayoutBounds {
@@ -225,7 +233,11 @@ func (p *PaintContext) PaintChildWithLayer(child RenderBox, offset graphics.Offs
 		p.debugDepth++
 	}
 
-	child.Paint(p)
+	if ProfilingEnabled() {
+		paintProfiled(child, p)
+	} else {
+		child.Paint(p)
+	}
 
 	// Draw bounds after child paints so overlay is visible on top
 	if p.ShowLayoutBounds {
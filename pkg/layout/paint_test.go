@@ -281,6 +281,8 @@ func (c *nullPaintCanvas) DrawCircle(center graphics.Offset, radius float64, pai
 func (c *nullPaintCanvas) DrawLine(start, end graphics.Offset, paint graphics.Paint)               {}
 func (c *nullPaintCanvas) DrawText(layout *graphics.TextLayout, position graphics.Offset)          {}
 func (c *nullPaintCanvas) DrawImage(img image.Image, position graphics.Offset)                     {}
+func (c *nullPaintCanvas) DrawVertices(vertices *graphics.Vertices, img image.Image, paint graphics.Paint) {
+}
 func (c *nullPaintCanvas) DrawImageRect(img image.Image, srcRect, dstRect graphics.Rect, quality graphics.FilterQuality, cacheKey uintptr) {
 }
 func (c *nullPaintCanvas) DrawPath(path *graphics.Path, paint graphics.Paint)              {}
@@ -291,6 +293,7 @@ func (c *nullPaintCanvas) DrawSVG(svgPtr unsafe.Pointer, bounds graphics.Rect)
 func (c *nullPaintCanvas) DrawSVGTinted(svgPtr unsafe.Pointer, bounds graphics.Rect, tintColor graphics.Color) {
 }
 func (c *nullPaintCanvas) DrawLottie(animPtr unsafe.Pointer, bounds graphics.Rect, t float64) {}
+func (c *nullPaintCanvas) DrawRive(rivePtr unsafe.Pointer, bounds graphics.Rect)              {}
 func (c *nullPaintCanvas) EmbedPlatformView(viewID int64, size graphics.Size)                 {}
 func (c *nullPaintCanvas) Size() graphics.Size                                                { return c.size }
 
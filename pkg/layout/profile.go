@@ -0,0 +1,96 @@
+package layout
+
+import "time"
+
+// ProfilePhase is the frame phase a profiled duration was spent in.
+type ProfilePhase int
+
+const (
+	// ProfilePhaseBuild is time spent rebuilding an element.
+	ProfilePhaseBuild ProfilePhase = iota
+	// ProfilePhaseLayout is time spent in a render object's PerformLayout.
+	ProfilePhaseLayout
+	// ProfilePhasePaint is time spent in a render object's Paint.
+	ProfilePhasePaint
+)
+
+// String returns "build", "layout", or "paint".
+func (p ProfilePhase) String() string {
+	switch p {
+	case ProfilePhaseBuild:
+		return "build"
+	case ProfilePhaseLayout:
+		return "layout"
+	case ProfilePhasePaint:
+		return "paint"
+	default:
+		return "unknown"
+	}
+}
+
+// Profiler receives the time individual elements and render objects spend
+// in each frame, so frame cost can be attributed to the widgets that caused
+// it. See [SetProfiler].
+type Profiler interface {
+	// RecordProfile reports that target, an element or a render object,
+	// spent self in phase. Time spent in nested targets, such as children
+	// laid out by a parent, is reported for them instead.
+	RecordProfile(target any, phase ProfilePhase, self time.Duration)
+}
+
+// profileFrame is an open BeginProfile call.
+type profileFrame struct {
+	start  time.Time
+	nested time.Duration
+}
+
+var (
+	profiler     Profiler
+	profileStack []profileFrame
+)
+
+// SetProfiler installs p to receive per-object frame timings, or removes the
+// profiler when p is nil. Profiling reads the clock around every build,
+// layout, and paint, so leave it off outside of performance debugging.
+// Call it on the UI thread between frames.
+func SetProfiler(p Profiler) {
+	profiler = p
+	profileStack = profileStack[:0]
+}
+
+// ProfilingEnabled reports whether a profiler is installed.
+func ProfilingEnabled() bool {
+	return profiler != nil
+}
+
+// BeginProfile starts timing work for a target. Each call must be matched by
+// an [EndProfile], innermost first; defer it so a panic does not leave the
+// timing open.
+func BeginProfile() {
+	profileStack = append(profileStack, profileFrame{start: time.Now()})
+}
+
+// EndProfile stops the timing started by the matching [BeginProfile] and
+// reports it for target, less the time of the timings nested in it.
+func EndProfile(target any, phase ProfilePhase) {
+	n := len(profileStack)
+	if n == 0 {
+		return
+	}
+	frame := profileStack[n-1]
+	profileStack = profileStack[:n-1]
+	total := time.Since(frame.start)
+	if n > 1 {
+		profileStack[n-2].nested += total
+	}
+	if profiler != nil {
+		profiler.RecordProfile(target, phase, max(total-frame.nested, 0))
+	}
+}
+
+// paintProfiled paints child, reporting its paint time to the profiler.
+func paintProfiled(child RenderBox, ctx *PaintContext) {
+	BeginProfile()
+	defer EndProfile(child, ProfilePhasePaint)
+	child.Paint(ctx)
+}
@@ -0,0 +1,44 @@
+package layout
+
+import (
+	"testing"
+	"time"
+)
+
+type recordingProfiler struct {
+	self map[any]time.Duration
+}
+
+func (p *recordingProfiler) RecordProfile(target any, phase ProfilePhase, self time.Duration) {
+	p.self[target] += self
+}
+
+func TestProfile_SelfTimeExcludesNested(t *testing.T) {
+	profiler := &recordingProfiler{self: make(map[any]time.Duration)}
+	SetProfiler(profiler)
+	t.Cleanup(func() { SetProfiler(nil) })
+
+	BeginProfile()
+	BeginProfile()
+	time.Sleep(10 * time.Millisecond)
+	EndProfile("child", ProfilePhaseLayout)
+	EndProfile("parent", ProfilePhaseLayout)
+
+	if got := profiler.self["child"]; got < 10*time.Millisecond {
+		t.Errorf("child self time = %v, want at least 10ms", got)
+	}
+	if got := profiler.self["parent"]; got >= 10*time.Millisecond {
+		t.Errorf("parent self time = %v, want the child's time excluded", got)
+	}
+}
+
+func TestProfile_EndWithoutBeginIsIgnored(t *testing.T) {
+	profiler := &recordingProfiler{self: make(map[any]time.Duration)}
+	SetProfiler(profiler)
+	t.Cleanup(func() { SetProfiler(nil) })
+
+	EndProfile("orphan", ProfilePhasePaint)
+	if len(profiler.self) != 0 {
+		t.Errorf("recorded %v, want nothing", profiler.self)
+	}
+}
@@ -45,6 +45,14 @@ type SemanticsChildVisitor interface {
 	VisitChildrenForSemantics(visitor func(RenderObject))
 }
 
+// FontChangeHandler is implemented by render objects that cache text
+// layouts. When a font family is registered at runtime, the engine calls
+// FontRegistered on every render object in the tree so layouts that used the
+// family are redone with the new font.
+type FontChangeHandler interface {
+	FontRegistered(family string)
+}
+
 // RepaintBoundaryNode is implemented by render objects that are repaint boundaries.
 type RepaintBoundaryNode interface {
 	IsRepaintBoundary() bool
@@ -460,6 +468,10 @@ func (r *RenderBoxBase) Layout(constraints Constraints, parentUsesSize bool) {
 
 	// Call the concrete implementation's PerformLayout
 	if performer, ok := r.self.(interface{ PerformLayout() }); ok {
+		if ProfilingEnabled() {
+			BeginProfile()
+			defer EndProfile(r.self, ProfilePhaseLayout)
+		}
 		performer.PerformLayout()
 	}
 }
@@ -0,0 +1,43 @@
+package lottie
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"net/http"
+)
+
+// LoadFS parses a Lottie animation from a file in fsys, such as an
+// [embed.FS] holding the app's assets.
+func LoadFS(fsys fs.FS, name string) (*Animation, error) {
+	data, err := fs.ReadFile(fsys, name)
+	if err != nil {
+		return nil, err
+	}
+	return LoadBytes(data)
+}
+
+// LoadURL downloads and parses a Lottie animation over HTTP(S). Headers are
+// added to the request, and a nil client uses [http.DefaultClient]. The
+// download is abandoned when ctx is canceled.
+func LoadURL(ctx context.Context, client *http.Client, url string, headers map[string]string) (*Animation, error) {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("lottie: HTTP %d for %q", resp.StatusCode, url)
+	}
+	return Load(resp.Body)
+}
@@ -6,6 +6,7 @@ package lottie
 import (
 	"errors"
 	"io"
+	"math"
 	"os"
 	"time"
 
@@ -25,9 +26,10 @@ import (
 //
 // Animations must only be rendered from the UI thread.
 type Animation struct {
-	skottie  *skia.Skottie
-	duration time.Duration
-	size     graphics.Size
+	skottie   *skia.Skottie
+	duration  time.Duration
+	frameRate float64
+	size      graphics.Size
 }
 
 // Load parses a Lottie animation from the provided reader.
@@ -48,9 +50,10 @@ func LoadBytes(data []byte) (*Animation, error) {
 	dur := s.Duration()
 	w, h := s.Size()
 	return &Animation{
-		skottie:  s,
-		duration: time.Duration(dur * float64(time.Second)),
-		size:     graphics.Size{Width: w, Height: h},
+		skottie:   s,
+		duration:  time.Duration(dur * float64(time.Second)),
+		frameRate: s.FrameRate(),
+		size:      graphics.Size{Width: w, Height: h},
 	}, nil
 }
 
@@ -71,6 +74,23 @@ func (a *Animation) Duration() time.Duration {
 	return a.duration
 }
 
+// FrameRate returns the frame rate the animation was authored at, in frames
+// per second.
+func (a *Animation) FrameRate() float64 {
+	if a == nil {
+		return 0
+	}
+	return a.frameRate
+}
+
+// FrameCount returns the number of frames in the animation.
+func (a *Animation) FrameCount() int {
+	if a == nil {
+		return 0
+	}
+	return int(math.Round(a.duration.Seconds() * a.frameRate))
+}
+
 // Size returns the intrinsic size of the animation.
 func (a *Animation) Size() graphics.Size {
 	if a == nil {
@@ -37,6 +37,17 @@ func (a *Animation) Duration() time.Duration {
 	return 0
 }
 
+// FrameRate returns the frame rate the animation was authored at, in frames
+// per second.
+func (a *Animation) FrameRate() float64 {
+	return 0
+}
+
+// FrameCount returns the number of frames in the animation.
+func (a *Animation) FrameCount() int {
+	return 0
+}
+
 // Size returns the intrinsic size of the animation.
 func (a *Animation) Size() graphics.Size {
 	return graphics.Size{}
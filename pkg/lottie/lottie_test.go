@@ -1,8 +1,14 @@
 package lottie
 
 import (
+	"context"
+	"errors"
+	"io/fs"
+	"net/http"
+	"net/http/httptest"
 	"strings"
 	"testing"
+	"testing/fstest"
 
 	"github.com/go-drift/drift/pkg/graphics"
 )
@@ -47,3 +53,25 @@ func TestNilAnimation_Destroy(t *testing.T) {
 	var a *Animation
 	a.Destroy() // should not panic
 }
+
+func TestLoadFS_MissingFile(t *testing.T) {
+	_, err := LoadFS(fstest.MapFS{}, "missing.json")
+	if !errors.Is(err, fs.ErrNotExist) {
+		t.Fatalf("expected fs.ErrNotExist, got %v", err)
+	}
+}
+
+func TestLoadURL_HTTPError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer tok" {
+			t.Errorf("expected Authorization header, got %q", r.Header.Get("Authorization"))
+		}
+		http.NotFound(w, r)
+	}))
+	defer server.Close()
+
+	_, err := LoadURL(context.Background(), server.Client(), server.URL, map[string]string{"Authorization": "Bearer tok"})
+	if err == nil || !strings.Contains(err.Error(), "404") {
+		t.Fatalf("expected HTTP 404 error, got %v", err)
+	}
+}
@@ -149,20 +149,22 @@ func (r *BottomSheetRoute) insertEntries() {
 			HandleBottomPadding: themeData.HandleBottomPadding,
 		}
 
-		return widgets.BottomSheet{
-			Builder:      r.builder,
-			Controller:   r.controller,
-			SnapPoints:   r.SnapPoints,
-			InitialSnap:  r.InitialSnapPoint,
-			EnableDrag:   r.EnableDrag,
-			DragMode:     r.DragMode,
-			ShowHandle:   r.ShowHandle,
-			UseSafeArea:  r.UseSafeArea,
-			Theme:        sheetTheme,
-			SnapBehavior: r.SnapBehavior,
-			// Called when dismiss animation completes
-			OnDismiss: r.onAnimationComplete,
-		}
+		return withRouteScope(r, func(core.BuildContext) core.Widget {
+			return widgets.BottomSheet{
+				Builder:      r.builder,
+				Controller:   r.controller,
+				SnapPoints:   r.SnapPoints,
+				InitialSnap:  r.InitialSnapPoint,
+				EnableDrag:   r.EnableDrag,
+				DragMode:     r.DragMode,
+				ShowHandle:   r.ShowHandle,
+				UseSafeArea:  r.UseSafeArea,
+				Theme:        sheetTheme,
+				SnapBehavior: r.SnapBehavior,
+				// Called when dismiss animation completes
+				OnDismiss: r.onAnimationComplete,
+			}
+		})
 	})
 	r.sheetEntry.Opaque = true // Block hit testing below when in sheet area
 
@@ -23,14 +23,17 @@ type fakeNavigator struct {
 	lastPop  any
 }
 
-func (f *fakeNavigator) Push(Route)                       {}
-func (f *fakeNavigator) PushNamed(string, any)            {}
-func (f *fakeNavigator) PushReplacementNamed(string, any) {}
-func (f *fakeNavigator) Pop(result any)                   { f.popCount++; f.lastPop = result }
-func (f *fakeNavigator) PopUntil(func(Route) bool)        {}
-func (f *fakeNavigator) PushReplacement(Route)            {}
-func (f *fakeNavigator) CanPop() bool                     { return true }
-func (f *fakeNavigator) MaybePop(result any) bool         { f.Pop(result); return true }
+func (f *fakeNavigator) Push(Route)                                            {}
+func (f *fakeNavigator) PushNamed(string, any)                                 {}
+func (f *fakeNavigator) PushReplacementNamed(string, any)                      {}
+func (f *fakeNavigator) Pop(result any)                                        { f.popCount++; f.lastPop = result }
+func (f *fakeNavigator) PopUntil(func(Route) bool)                             {}
+func (f *fakeNavigator) PushReplacement(Route)                                 {}
+func (f *fakeNavigator) PushAndRemoveUntil(Route, func(Route) bool)            {}
+func (f *fakeNavigator) PushNamedAndRemoveUntil(string, any, func(Route) bool) {}
+func (f *fakeNavigator) PopToRoot()                                            {}
+func (f *fakeNavigator) CanPop() bool                                          { return true }
+func (f *fakeNavigator) MaybePop(result any) bool                              { f.Pop(result); return true }
 
 func TestNewBottomSheetRoute_Defaults(t *testing.T) {
 	route := NewBottomSheetRoute(nil, RouteSettings{})
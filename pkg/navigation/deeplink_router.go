@@ -1,10 +1,12 @@
 package navigation
 
 import (
+	"net/url"
 	"sync"
 	"sync/atomic"
 
 	"github.com/go-drift/drift/pkg/drift"
+	"github.com/go-drift/drift/pkg/engine"
 	"github.com/go-drift/drift/pkg/platform"
 )
 
@@ -16,6 +18,10 @@ type DeepLinkRoute struct {
 
 // DeepLinkController listens for deep links and navigates to matching routes.
 //
+// Apps using a [Router] can set [Router.DeepLinks] instead, which matches
+// links against the router's routes and shows the launch link as the first
+// route.
+//
 // Deep links are dispatched via [RootNavigator], which requires a [Router] or
 // [Navigator] with IsRoot=true to be present in the widget tree. If your app
 // uses [TabNavigator] at the top level, wrap it in a Router or Navigator:
@@ -149,3 +155,84 @@ func (c *DeepLinkController) flushPending() {
 	}
 	c.mu.Unlock()
 }
+
+// PathFromDeepLink returns the route path for a deep link: the URL's path
+// followed by its query. For custom schemes the host is the first path
+// segment, so "myapp://products/42" and "https://example.com/products/42"
+// both map to "/products/42". Returns false if the URL cannot be parsed.
+func PathFromDeepLink(link platform.DeepLink) (string, bool) {
+	u, err := url.Parse(link.URL)
+	if err != nil {
+		return "", false
+	}
+	path := u.Path
+	if u.Scheme != "http" && u.Scheme != "https" && u.Host != "" {
+		path = "/" + u.Host + path
+	}
+	if path == "" {
+		path = "/"
+	}
+	if u.RawQuery != "" {
+		path += "?" + u.RawQuery
+	}
+	return path, true
+}
+
+// startDeepLinks resolves the launch deep link before the first frame and
+// routes later links through Go.
+func (s *routerState) startDeepLinks() {
+	s.resolvingLink = true
+	engine.DeferFirstFrame()
+
+	unsubscribe := platform.DeepLinks.Links().Listen(func(link platform.DeepLink) {
+		platform.Dispatch(func() {
+			s.openDeepLink(link)
+		})
+	})
+	s.OnDispose(unsubscribe)
+
+	go func() {
+		link, err := platform.DeepLinks.GetInitial()
+		resolved := func() {
+			defer engine.AllowFirstFrame()
+			if s.IsDisposed() || !s.resolvingLink {
+				return
+			}
+			if err == nil && link != nil {
+				if path, ok := s.deepLinkPath(*link); ok && s.launchPath == "" {
+					s.launchPath = path
+				}
+			}
+			s.SetState(func() {
+				s.resolvingLink = false
+			})
+		}
+		if !platform.Dispatch(resolved) {
+			engine.AllowFirstFrame()
+		}
+	}()
+}
+
+// openDeepLink navigates to the route for link. A link arriving before the
+// launch link is resolved becomes the first route instead.
+func (s *routerState) openDeepLink(link platform.DeepLink) {
+	if s.IsDisposed() {
+		return
+	}
+	path, ok := s.deepLinkPath(link)
+	if !ok {
+		return
+	}
+	if s.resolvingLink {
+		s.launchPath = path
+		return
+	}
+	s.Go(path, nil)
+}
+
+func (s *routerState) deepLinkPath(link platform.DeepLink) (string, bool) {
+	if s.router.DeepLinkPath != nil {
+		return s.router.DeepLinkPath(link)
+	}
+	return PathFromDeepLink(link)
+}
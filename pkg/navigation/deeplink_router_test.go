@@ -0,0 +1,108 @@
+package navigation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-drift/drift/pkg/platform"
+)
+
+// deepLinkBridge answers getInitial with a fixed launch link.
+type deepLinkBridge struct {
+	initial string
+}
+
+func (b *deepLinkBridge) InvokeMethod(ctx context.Context, channel, method string, args []byte) ([]byte, error) {
+	if channel == "drift/deeplinks" && method == "getInitial" && b.initial != "" {
+		return platform.DefaultCodec.Encode(map[string]any{"url": b.initial, "source": "launch"})
+	}
+	return platform.DefaultCodec.Encode(nil)
+}
+
+func (b *deepLinkBridge) StartEventStream(channel string) error { return nil }
+func (b *deepLinkBridge) StopEventStream(channel string) error  { return nil }
+
+// useDeepLinkBridge answers getInitial with initial, or no link when it
+// is empty.
+func useDeepLinkBridge(t *testing.T, initial string) {
+	t.Helper()
+	platform.SetNativeBridge(&deepLinkBridge{initial: initial})
+	t.Cleanup(platform.ResetForTest)
+}
+
+func sendDeepLink(t *testing.T, link string) {
+	t.Helper()
+	data, err := platform.DefaultCodec.Encode(map[string]any{"url": link, "source": "open_url"})
+	if err != nil {
+		t.Fatalf("encode link: %v", err)
+	}
+	if err := platform.HandleEvent("drift/deeplinks/events", data); err != nil {
+		t.Fatalf("HandleEvent: %v", err)
+	}
+}
+
+func TestRouter_DeepLinks_LaunchLink(t *testing.T) {
+	useDeepLinkBridge(t, "https://example.com/products/42?ref=mail")
+	_, nav := pumpRouter(t, Router{InitialPath: "/", DeepLinks: true, Routes: stubRoutes("/", "/login", "/products/:id")})
+
+	top := nav.top().Settings()
+	if top.Name != "/products/42?ref=mail" || top.Param("id") != "42" || top.QueryValue("ref") != "mail" {
+		t.Errorf("expected the launch link as the first route, got %+v", top)
+	}
+	if RootNavigator().CanPop() {
+		t.Error("expected the launch link to replace the initial path")
+	}
+}
+
+func TestRouter_DeepLinks_LaterLinks(t *testing.T) {
+	useDeepLinkBridge(t, "")
+	tester, nav := pumpRouter(t, Router{
+		InitialPath: "/",
+		DeepLinks:   true,
+		Redirect: func(ctx RedirectContext) RedirectResult {
+			if ctx.ToPath == "/products/secret" {
+				return RedirectTo("/login")
+			}
+			return NoRedirect()
+		},
+		Routes: stubRoutes("/", "/login", "/products/:id"),
+	})
+	if got := nav.top().Settings().Name; got != "/" {
+		t.Fatalf("expected InitialPath without a launch link, got %q", got)
+	}
+
+	sendDeepLink(t, "myapp://products/7")
+	tester.PumpAndSettle(time.Second)
+	if got := nav.top().Settings().Name; got != "/products/7" {
+		t.Errorf("expected the link to push /products/7, got %q", got)
+	}
+
+	sendDeepLink(t, "myapp://products/secret")
+	tester.PumpAndSettle(time.Second)
+	if got := nav.top().Settings().Name; got != "/login" {
+		t.Errorf("expected the redirect to apply to links, got %q", got)
+	}
+}
+
+func TestPathFromDeepLink(t *testing.T) {
+	tests := []struct {
+		url  string
+		want string
+	}{
+		{"https://example.com/products/42", "/products/42"},
+		{"https://example.com/search?q=shoes#top", "/search?q=shoes"},
+		{"https://example.com", "/"},
+		{"myapp://products/42", "/products/42"},
+		{"myapp:///settings", "/settings"},
+	}
+	for _, tt := range tests {
+		got, ok := PathFromDeepLink(platform.DeepLink{URL: tt.url})
+		if !ok || got != tt.want {
+			t.Errorf("PathFromDeepLink(%q) = %q, %v, want %q", tt.url, got, ok, tt.want)
+		}
+	}
+	if _, ok := PathFromDeepLink(platform.DeepLink{URL: "://bad"}); ok {
+		t.Error("expected an unparsable URL to be rejected")
+	}
+}
@@ -0,0 +1,92 @@
+package navigation
+
+import (
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/platform"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+// FullscreenOptions configures [EnterFullscreen].
+type FullscreenOptions struct {
+	// Orientation is the orientation lock while full screen. The zero value
+	// uses [platform.OrientationLandscape].
+	Orientation platform.OrientationLock
+
+	// HideControls hides the native transport controls while full screen.
+	HideControls bool
+
+	// Controls builds custom controls drawn over the video. exit leaves full
+	// screen. When nil, a close button is shown instead.
+	Controls func(ctx core.BuildContext, exit func()) core.Widget
+}
+
+// EnterFullscreen presents the controller's video in a full-screen route on
+// the root navigator, above tab bars and nested navigators. The route rotates
+// the window, hides the system bars, and keeps the screen awake (see
+// [widgets.FullScreenVideo]).
+//
+// The native video view moves from the inline [widgets.VideoPlayer] to the
+// route without reloading, so playback continues where it is. Popping the
+// route, with the back button or the returned exit function, moves the view
+// back and restores the orientation and system bars.
+//
+//	widgets.Tap(func() {
+//	    navigation.EnterFullscreen(ctx, s.video, navigation.FullscreenOptions{})
+//	}, fullscreenIcon)
+//
+// Returns a no-op exit function if there is no navigator.
+func EnterFullscreen(ctx core.BuildContext, controller *platform.VideoPlayerController, opts FullscreenOptions) (exit func()) {
+	nav := RootNavigator()
+	if nav == nil {
+		nav = NavigatorOf(ctx)
+	}
+	if nav == nil {
+		return func() {}
+	}
+
+	route := &fullscreenRoute{}
+	exit = func() {
+		if !route.onStack {
+			return
+		}
+		// Drop anything pushed over the player, then pop it with its
+		// transition.
+		nav.PopUntil(func(r Route) bool { return r == route })
+		nav.Pop(nil)
+	}
+	route.AnimatedPageRoute = *NewAnimatedPageRoute(func(ctx core.BuildContext) core.Widget {
+		video := widgets.FullScreenVideo{
+			Controller:   controller,
+			Orientation:  opts.Orientation,
+			HideControls: opts.HideControls,
+		}
+		if opts.Controls != nil {
+			video.Controls = opts.Controls(ctx, exit)
+		} else {
+			video.OnExit = exit
+		}
+		return video
+	}, RouteSettings{})
+	route.Transition = FadePageTransition()
+
+	nav.Push(route)
+	return exit
+}
+
+// fullscreenRoute is the route pushed by [EnterFullscreen].
+type fullscreenRoute struct {
+	AnimatedPageRoute
+	onStack bool
+}
+
+// DidPush records that the route is on the navigator's stack.
+func (r *fullscreenRoute) DidPush() {
+	r.onStack = true
+	r.AnimatedPageRoute.DidPush()
+}
+
+// DidPop records that the route has left the stack.
+func (r *fullscreenRoute) DidPop(result any) {
+	r.onStack = false
+	r.AnimatedPageRoute.DidPop(result)
+}
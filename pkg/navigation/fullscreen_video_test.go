@@ -0,0 +1,78 @@
+package navigation
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/platform"
+	drifttest "github.com/go-drift/drift/pkg/testing"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+// screenBridge records drift/screen methods.
+type screenBridge struct {
+	methods []string
+}
+
+func (b *screenBridge) InvokeMethod(ctx context.Context, channel, method string, args []byte) ([]byte, error) {
+	if channel == "drift/screen" {
+		b.methods = append(b.methods, method)
+	}
+	return platform.DefaultCodec.Encode(nil)
+}
+
+func (b *screenBridge) StartEventStream(channel string) error { return nil }
+func (b *screenBridge) StopEventStream(channel string) error  { return nil }
+
+func TestEnterFullscreen_PushesAndExits(t *testing.T) {
+	bridge := &screenBridge{}
+	platform.SetNativeBridge(bridge)
+	t.Cleanup(platform.ResetForTest)
+
+	video := platform.NewVideoPlayerController()
+	defer video.Dispose()
+
+	var pageCtx core.BuildContext
+	tester := drifttest.NewWidgetTesterWithT(t)
+	tester.PumpWidget(Navigator{
+		IsRoot:       true,
+		InitialRoute: "/",
+		OnGenerateRoute: func(settings RouteSettings) Route {
+			return NewAnimatedPageRoute(func(ctx core.BuildContext) core.Widget {
+				pageCtx = ctx
+				return widgets.VideoPlayer{Controller: video, Height: 200}
+			}, settings)
+		},
+	})
+	nav := RootNavigator().(*navigatorState)
+
+	exit := EnterFullscreen(pageCtx, video, FullscreenOptions{})
+	tester.PumpAndSettle(time.Second)
+	if _, ok := nav.top().(*fullscreenRoute); !ok {
+		t.Fatalf("expected the fullscreen route on top, got %T", nav.top())
+	}
+	for _, method := range []string{"setKeepAwake", "setOrientationLock", "setImmersive"} {
+		if !slices.Contains(bridge.methods, method) {
+			t.Errorf("expected %s on entering full screen, got %v", method, bridge.methods)
+		}
+	}
+
+	bridge.methods = nil
+	exit()
+	tester.PumpAndSettle(time.Second)
+	if got := nav.top().Settings().Name; got != "/" {
+		t.Errorf("expected / on top after exit, got %q", got)
+	}
+	if !slices.Contains(bridge.methods, "setImmersive") {
+		t.Errorf("expected immersive mode to be restored, got %v", bridge.methods)
+	}
+
+	// Exiting again is a no-op.
+	exit()
+	if len(nav.routes) != 1 {
+		t.Errorf("expected the root route to remain, got %d routes", len(nav.routes))
+	}
+}
@@ -0,0 +1,531 @@
+package navigation
+
+import (
+	"github.com/go-drift/drift/pkg/animation"
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/layout"
+	"github.com/go-drift/drift/pkg/overlay"
+)
+
+// Hero marks a widget that flies between routes during a navigation
+// transition. When a route is pushed or popped and both the outgoing and
+// incoming routes contain a Hero with the same Tag, the navigator hides both
+// heroes and animates a copy of the destination hero's child from the source
+// position and size to the destination, in the navigator's overlay, driven by
+// the route transition.
+//
+//	// On the list screen:
+//	navigation.Hero{Tag: "photo-" + id, Child: thumbnail}
+//
+//	// On the detail screen:
+//	navigation.Hero{Tag: "photo-" + id, Child: fullImage}
+//
+// Tags must be comparable and unique within a route. Flights run for
+// [AnimatedPageRoute] pushes and pops; replacements and PopUntil remove
+// routes without flights.
+type Hero struct {
+	core.StatefulBase
+
+	// Tag pairs this hero with the hero of the same tag on the other route.
+	Tag any
+
+	// Child is the widget that flies.
+	Child core.Widget
+
+	// FlightShuttleBuilder builds the widget shown in flight. It is rebuilt on
+	// every animation tick, so it can cross-fade or morph between the two
+	// children using [HeroFlight.Progress]. Nil flies the destination hero's
+	// child. When both heroes set a builder, the destination's is used.
+	FlightShuttleBuilder func(ctx core.BuildContext, flight HeroFlight) core.Widget
+
+	// RectTween computes the flight's bounds at progress t, from the source
+	// bounds begin to the destination bounds end. Nil interpolates linearly
+	// with [animation.LerpRect]. When both heroes set a tween, the
+	// destination's is used.
+	RectTween func(begin, end graphics.Rect, t float64) graphics.Rect
+}
+
+// CreateState creates the hero's state.
+func (h Hero) CreateState() core.State {
+	return &heroState{}
+}
+
+// HeroFlightDirection indicates which navigation started a hero flight.
+type HeroFlightDirection int
+
+const (
+	// HeroFlightPush is a flight to a newly pushed route.
+	HeroFlightPush HeroFlightDirection = iota
+	// HeroFlightPop is a flight back to the route below a popped route.
+	HeroFlightPop
+)
+
+// HeroFlight describes an in-progress flight, passed to
+// [Hero.FlightShuttleBuilder].
+type HeroFlight struct {
+	// Tag is the shared tag of the two heroes.
+	Tag any
+	// Direction is the navigation that started the flight.
+	Direction HeroFlightDirection
+	// Progress runs from 0 at the source hero to 1 at the destination hero,
+	// following the route transition's curve.
+	Progress float64
+	// FromChild and ToChild are the source and destination heroes' children.
+	FromChild core.Widget
+	ToChild   core.Widget
+}
+
+type heroState struct {
+	core.StateBase
+	nav    *navigatorState
+	route  Route
+	tag    any
+	hidden bool
+	render *renderHero
+}
+
+func (s *heroState) Build(ctx core.BuildContext) core.Widget {
+	w := s.Element().Widget().(Hero)
+	s.register(navigatorStateOf(ctx), routeOf(ctx), w.Tag)
+	return heroBox{state: s, child: w.Child}
+}
+
+// register records the hero with its navigator under (route, tag), moving
+// the registration if any of them changed since the last build.
+func (s *heroState) register(nav *navigatorState, route Route, tag any) {
+	if nav == s.nav && route == s.route && tag == s.tag {
+		return
+	}
+	s.unregister()
+	if nav == nil || route == nil || tag == nil {
+		return
+	}
+	s.nav, s.route, s.tag = nav, route, tag
+	nav.heroes.register(route, tag, s)
+}
+
+func (s *heroState) unregister() {
+	if s.nav != nil {
+		s.nav.heroes.unregister(s.route, s.tag, s)
+	}
+	s.nav, s.route, s.tag = nil, nil, nil
+}
+
+func (s *heroState) Dispose() {
+	if s.nav != nil {
+		s.nav.heroes.endFlightsFor(s)
+	}
+	s.unregister()
+	s.StateBase.Dispose()
+}
+
+// setHidden hides the hero's child while it is in flight. The child keeps its
+// layout so the destination bounds stay valid.
+func (s *heroState) setHidden(hidden bool) {
+	s.hidden = hidden
+	if s.render != nil {
+		s.render.MarkNeedsPaint()
+	}
+}
+
+func (s *heroState) widget() Hero {
+	if s.Element() == nil {
+		return Hero{}
+	}
+	return s.Element().Widget().(Hero)
+}
+
+// heroKey identifies a hero within a navigator.
+type heroKey struct {
+	route Route
+	tag   any
+}
+
+// heroController pairs heroes across routes and manages their flights for
+// one navigator.
+type heroController struct {
+	heroes  map[heroKey]*heroState
+	flights []*heroFlight
+}
+
+func (c *heroController) register(route Route, tag any, s *heroState) {
+	if c.heroes == nil {
+		c.heroes = make(map[heroKey]*heroState)
+	}
+	c.heroes[heroKey{route: route, tag: tag}] = s
+}
+
+func (c *heroController) unregister(route Route, tag any, s *heroState) {
+	key := heroKey{route: route, tag: tag}
+	if c.heroes[key] == s {
+		delete(c.heroes, key)
+	}
+}
+
+// heroPair is a source and destination hero sharing a tag.
+type heroPair struct {
+	from, to *heroState
+}
+
+// pairs returns the heroes in from that have a counterpart in to.
+func (c *heroController) pairs(from, to Route) []heroPair {
+	var pairs []heroPair
+	for key, fromHero := range c.heroes {
+		if key.route != from {
+			continue
+		}
+		toHero := c.heroes[heroKey{route: to, tag: key.tag}]
+		if toHero == nil || fromHero.render == nil || toHero.render == nil {
+			continue
+		}
+		pairs = append(pairs, heroPair{from: fromHero, to: toHero})
+	}
+	return pairs
+}
+
+// startFlights flies every hero shared by from and to, following controller
+// until the transition completes or is dismissed. Flights already in the air
+// are ended first.
+func (c *heroController) startFlights(ov OverlayState, from, to Route, controller *animation.AnimationController, direction HeroFlightDirection) {
+	c.endAllFlights()
+	if ov == nil || from == nil || to == nil || controller == nil {
+		return
+	}
+	for _, pair := range c.pairs(from, to) {
+		flight := &heroFlight{
+			from:      pair.from,
+			to:        pair.to,
+			tag:       pair.to.tag,
+			direction: direction,
+			animation: controller,
+		}
+		flight.entry = overlay.NewOverlayEntry(func(core.BuildContext) core.Widget {
+			return heroFlightWidget{flight: flight}
+		})
+		flight.unsubscribe = controller.AddStatusListener(func(status animation.AnimationStatus) {
+			if status == animation.AnimationCompleted || status == animation.AnimationDismissed {
+				c.endFlight(flight)
+			}
+		})
+		pair.from.setHidden(true)
+		pair.to.setHidden(true)
+		c.flights = append(c.flights, flight)
+		ov.Insert(flight.entry, nil, nil)
+	}
+}
+
+func (c *heroController) endFlight(flight *heroFlight) {
+	for i, f := range c.flights {
+		if f == flight {
+			c.flights = append(c.flights[:i], c.flights[i+1:]...)
+			break
+		}
+	}
+	flight.end()
+}
+
+// endFlightsFor ends flights involving a hero that is being disposed.
+func (c *heroController) endFlightsFor(s *heroState) {
+	for _, f := range append([]*heroFlight(nil), c.flights...) {
+		if f.from == s || f.to == s {
+			c.endFlight(f)
+		}
+	}
+}
+
+func (c *heroController) endAllFlights() {
+	flights := c.flights
+	c.flights = nil
+	for _, f := range flights {
+		f.end()
+	}
+}
+
+// heroFlight is one hero animating between two routes.
+type heroFlight struct {
+	from, to    *heroState
+	tag         any
+	direction   HeroFlightDirection
+	animation   *animation.AnimationController
+	entry       *overlay.OverlayEntry
+	unsubscribe func()
+	ended       bool
+}
+
+func (f *heroFlight) end() {
+	if f.ended {
+		return
+	}
+	f.ended = true
+	if f.unsubscribe != nil {
+		f.unsubscribe()
+		f.unsubscribe = nil
+	}
+	f.entry.Remove()
+	f.from.setHidden(false)
+	f.to.setHidden(false)
+}
+
+// progress returns 0 at the source hero and 1 at the destination. Pops run
+// the popped route's controller in reverse.
+func (f *heroFlight) progress() float64 {
+	if f.direction == HeroFlightPop {
+		return 1 - f.animation.Value
+	}
+	return f.animation.Value
+}
+
+// rectTween returns the tween to use, preferring the destination hero's.
+func (f *heroFlight) rectTween() func(begin, end graphics.Rect, t float64) graphics.Rect {
+	if tween := f.to.widget().RectTween; tween != nil {
+		return tween
+	}
+	if tween := f.from.widget().RectTween; tween != nil {
+		return tween
+	}
+	return animation.LerpRect
+}
+
+// heroFlightWidget rebuilds the shuttle on every tick of the route animation.
+type heroFlightWidget struct {
+	core.StatefulBase
+	flight *heroFlight
+}
+
+func (w heroFlightWidget) CreateState() core.State {
+	return &heroFlightState{}
+}
+
+type heroFlightState struct {
+	core.StateBase
+	unsubscribe func()
+}
+
+func (s *heroFlightState) InitState() {
+	flight := s.Element().Widget().(heroFlightWidget).flight
+	s.unsubscribe = flight.animation.AddListener(func() {
+		s.SetState(func() {})
+	})
+}
+
+func (s *heroFlightState) Dispose() {
+	if s.unsubscribe != nil {
+		s.unsubscribe()
+		s.unsubscribe = nil
+	}
+	s.StateBase.Dispose()
+}
+
+func (s *heroFlightState) Build(ctx core.BuildContext) core.Widget {
+	flight := s.Element().Widget().(heroFlightWidget).flight
+	from, to := flight.from.widget(), flight.to.widget()
+	progress := flight.progress()
+
+	shuttle := to.Child
+	builder := to.FlightShuttleBuilder
+	if builder == nil {
+		builder = from.FlightShuttleBuilder
+	}
+	if builder != nil {
+		shuttle = builder(ctx, HeroFlight{
+			Tag:       flight.tag,
+			Direction: flight.direction,
+			Progress:  progress,
+			FromChild: from.Child,
+			ToChild:   to.Child,
+		})
+	}
+	return heroFlightBox{flight: flight, progress: progress, child: shuttle}
+}
+
+// heroFlightBox positions the shuttle between the two heroes' bounds. Bounds
+// are read during layout, after the routes below have been laid out, so the
+// destination is correct even on the flight's first frame.
+type heroFlightBox struct {
+	core.RenderObjectBase
+	flight   *heroFlight
+	progress float64
+	child    core.Widget
+}
+
+func (b heroFlightBox) ChildWidget() core.Widget { return b.child }
+
+func (b heroFlightBox) CreateRenderObject(ctx core.BuildContext) layout.RenderObject {
+	r := &renderHeroFlight{flight: b.flight, progress: b.progress}
+	r.SetSelf(r)
+	return r
+}
+
+func (b heroFlightBox) UpdateRenderObject(ctx core.BuildContext, renderObject layout.RenderObject) {
+	if r, ok := renderObject.(*renderHeroFlight); ok {
+		r.flight = b.flight
+		r.progress = b.progress
+		r.MarkNeedsLayout()
+	}
+}
+
+type renderHeroFlight struct {
+	layout.RenderBoxBase
+	child    layout.RenderBox
+	flight   *heroFlight
+	progress float64
+}
+
+func (r *renderHeroFlight) SetChild(child layout.RenderObject) {
+	layout.SetParentOnChild(r.child, nil)
+	r.child = layout.AsRenderBox(child)
+	layout.SetParentOnChild(r.child, r)
+}
+
+func (r *renderHeroFlight) VisitChildren(visitor func(layout.RenderObject)) {
+	if r.child != nil {
+		visitor(r.child)
+	}
+}
+
+func (r *renderHeroFlight) PerformLayout() {
+	constraints := r.Constraints()
+	r.SetSize(graphics.Size{Width: constraints.MaxWidth, Height: constraints.MaxHeight})
+	if r.child == nil {
+		return
+	}
+
+	origin := renderOrigin(r)
+	begin := r.flight.from.bounds().Translate(-origin.X, -origin.Y)
+	end := r.flight.to.bounds().Translate(-origin.X, -origin.Y)
+	rect := r.flight.rectTween()(begin, end, r.progress)
+
+	size := graphics.Size{Width: max(rect.Width(), 0), Height: max(rect.Height(), 0)}
+	r.child.Layout(layout.Tight(size), false)
+	r.child.SetParentData(&layout.BoxParentData{Offset: graphics.Offset{X: rect.Left, Y: rect.Top}})
+}
+
+func (r *renderHeroFlight) Paint(ctx *layout.PaintContext) {
+	if r.child == nil {
+		return
+	}
+	var offset graphics.Offset
+	if data, ok := r.child.ParentData().(*layout.BoxParentData); ok && data != nil {
+		offset = data.Offset
+	}
+	ctx.PaintChildWithLayer(r.child, offset)
+}
+
+// HitTest ignores pointers; the routes below are not interactive during a
+// transition anyway.
+func (r *renderHeroFlight) HitTest(position graphics.Offset, result *layout.HitTestResult) bool {
+	return false
+}
+
+// heroBox is the hero's render object, which lets flights measure it and
+// hide its child while in flight.
+type heroBox struct {
+	core.RenderObjectBase
+	state *heroState
+	child core.Widget
+}
+
+func (b heroBox) ChildWidget() core.Widget { return b.child }
+
+func (b heroBox) CreateRenderObject(ctx core.BuildContext) layout.RenderObject {
+	r := &renderHero{state: b.state}
+	r.SetSelf(r)
+	b.state.render = r
+	return r
+}
+
+func (b heroBox) UpdateRenderObject(ctx core.BuildContext, renderObject layout.RenderObject) {
+	if r, ok := renderObject.(*renderHero); ok {
+		r.state = b.state
+		b.state.render = r
+		r.MarkNeedsPaint()
+	}
+}
+
+type renderHero struct {
+	layout.RenderBoxBase
+	child layout.RenderBox
+	state *heroState
+}
+
+func (r *renderHero) SetChild(child layout.RenderObject) {
+	layout.SetParentOnChild(r.child, nil)
+	r.child = layout.AsRenderBox(child)
+	layout.SetParentOnChild(r.child, r)
+}
+
+func (r *renderHero) VisitChildren(visitor func(layout.RenderObject)) {
+	if r.child != nil {
+		visitor(r.child)
+	}
+}
+
+func (r *renderHero) PerformLayout() {
+	constraints := r.Constraints()
+	if r.child != nil {
+		r.child.Layout(constraints, true)
+		r.child.SetParentData(&layout.BoxParentData{})
+		r.SetSize(r.child.Size())
+	} else {
+		r.SetSize(constraints.Constrain(graphics.Size{}))
+	}
+}
+
+func (r *renderHero) Paint(ctx *layout.PaintContext) {
+	if r.child == nil || r.state.hidden {
+		return
+	}
+	ctx.PaintChildWithLayer(r.child, graphics.Offset{})
+}
+
+func (r *renderHero) HitTest(position graphics.Offset, result *layout.HitTestResult) bool {
+	if r.child == nil || !layout.WithinBounds(position, r.Size()) {
+		return false
+	}
+	return r.child.HitTest(position, result)
+}
+
+// bounds returns the hero's bounds relative to the root render object.
+func (s *heroState) bounds() graphics.Rect {
+	if s.render == nil {
+		return graphics.Rect{}
+	}
+	origin := renderOrigin(s.render)
+	size := s.render.Size()
+	return graphics.RectFromLTWH(origin.X, origin.Y, size.Width, size.Height)
+}
+
+// renderOrigin returns the offset of r from the root render object,
+// accumulating layout offsets and scroll offsets like [core.GlobalOffsetOf].
+// Paint-time transforms such as route slide transitions are excluded, so
+// heroes are measured at their resting positions.
+func renderOrigin(r layout.RenderObject) graphics.Offset {
+	var offset graphics.Offset
+	for current := r; current != nil; {
+		if data, ok := current.ParentData().(*layout.BoxParentData); ok && data != nil {
+			offset.X += data.Offset.X
+			offset.Y += data.Offset.Y
+		}
+		if provider, ok := current.(core.ScrollOffsetProvider); ok {
+			scroll := provider.ScrollOffset()
+			offset.X += scroll.X
+			offset.Y += scroll.Y
+		}
+		parent, ok := current.(interface{ Parent() layout.RenderObject })
+		if !ok {
+			break
+		}
+		current = parent.Parent()
+	}
+	return offset
+}
+
+// navigatorStateOf returns the nearest navigator's state, or nil.
+func navigatorStateOf(ctx core.BuildContext) *navigatorState {
+	inherited, ok := ctx.DependOnInherited(navigatorInheritedType, nil).(navigatorInherited)
+	if !ok {
+		return nil
+	}
+	return inherited.state
+}
@@ -0,0 +1,54 @@
+package navigation
+
+import (
+	"testing"
+
+	"github.com/go-drift/drift/pkg/animation"
+	"github.com/go-drift/drift/pkg/graphics"
+)
+
+func TestHeroController_PairsHeroesByTag(t *testing.T) {
+	list := NewAnimatedPageRoute(nil, RouteSettings{Name: "/"})
+	detail := NewAnimatedPageRoute(nil, RouteSettings{Name: "/detail"})
+	newHero := func() *heroState { return &heroState{render: &renderHero{}} }
+
+	var c heroController
+	shared, sharedDetail := newHero(), newHero()
+	c.register(list, "photo", shared)
+	c.register(list, "title", newHero())
+	c.register(detail, "photo", sharedDetail)
+	c.register(detail, "caption", newHero())
+
+	pairs := c.pairs(list, detail)
+	if len(pairs) != 1 || pairs[0].from != shared || pairs[0].to != sharedDetail {
+		t.Fatalf("expected only the shared tag to pair, got %+v", pairs)
+	}
+
+	c.unregister(detail, "photo", newHero())
+	if len(c.pairs(list, detail)) != 1 {
+		t.Error("expected unregister by another hero to keep the registration")
+	}
+	c.unregister(detail, "photo", sharedDetail)
+	if len(c.pairs(list, detail)) != 0 {
+		t.Error("expected no pairs after the destination hero unregisters")
+	}
+}
+
+func TestHeroFlight_ProgressAndRectTween(t *testing.T) {
+	controller := animation.NewAnimationController(TransitionDuration)
+	controller.Value = 0.25
+	from := &heroState{}
+	to := &heroState{}
+
+	push := &heroFlight{from: from, to: to, direction: HeroFlightPush, animation: controller}
+	pop := &heroFlight{from: from, to: to, direction: HeroFlightPop, animation: controller}
+	if push.progress() != 0.25 || pop.progress() != 0.75 {
+		t.Errorf("unexpected progress push=%v pop=%v", push.progress(), pop.progress())
+	}
+
+	begin := graphics.RectFromLTWH(0, 0, 40, 40)
+	end := graphics.RectFromLTWH(100, 200, 200, 200)
+	if got := push.rectTween()(begin, end, 0.5); got != graphics.RectFromLTWH(50, 100, 120, 120) {
+		t.Errorf("unexpected default tween result %+v", got)
+	}
+}
@@ -0,0 +1,365 @@
+package navigation
+
+import (
+	"math"
+	"time"
+
+	"github.com/go-drift/drift/pkg/animation"
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/layout"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+// sharedAxisDistance is how far pages slide in a shared-axis transition on
+// the X and Y axes, in logical pixels.
+const sharedAxisDistance = 30.0
+
+// sharedAxisStartScale is the scale the incoming page of a Z-axis
+// shared-axis transition grows from; the outgoing page grows by
+// sharedAxisExitScale.
+const (
+	sharedAxisStartScale = 0.8
+	sharedAxisExitScale  = 0.1
+)
+
+// containerFillSplit is the point in a container transform where the
+// container has become opaque, covering the widget it grew from.
+const containerFillSplit = 0.2
+
+// containerScrimAlpha is the opacity of the scrim over the page beneath a
+// fully open container transform.
+const containerScrimAlpha = 0.32
+
+// SharedAxisPageTransition moves between pages along a shared axis, as in
+// Material motion: the page beneath fades out while moving along the axis,
+// then the new page fades in moving the same way. Popping plays it in
+// reverse. Use [widgets.SharedAxisX] for sibling pages, [widgets.SharedAxisY]
+// for vertically ordered content, and [widgets.SharedAxisZ] for parent and
+// child pages.
+func SharedAxisPageTransition(axis widgets.SharedAxis) PageTransition {
+	background := BackgroundTransition{FadeOut: true}
+	switch axis {
+	case widgets.SharedAxisX:
+		background.Translation = graphics.Offset{X: -sharedAxisDistance}
+	case widgets.SharedAxisY:
+		background.Translation = graphics.Offset{Y: -sharedAxisDistance}
+	case widgets.SharedAxisZ:
+		background.Scale = sharedAxisExitScale
+	}
+	return PageTransition{
+		Builder: func(animation *animation.AnimationController, child core.Widget) core.Widget {
+			return SharedAxisTransition{Animation: animation, Axis: axis, Child: child}
+		},
+		Background: background,
+	}
+}
+
+// ContainerTransformPageTransition grows the page out of source, the bounds
+// of the widget it opens from, as in a Material container transform. The
+// container starts with borderRadius corners and fills with color as it
+// leaves source, then the page fades in as it expands to full size. Popping
+// shrinks it back into source.
+//
+// Source is in the coordinates of the root render object; [OpenContainer]
+// measures it for you.
+func ContainerTransformPageTransition(source graphics.Rect, borderRadius float64, color graphics.Color) PageTransition {
+	return PageTransition{
+		Builder: func(animation *animation.AnimationController, child core.Widget) core.Widget {
+			return ContainerTransformTransition{
+				Animation:    animation,
+				Source:       source,
+				BorderRadius: borderRadius,
+				Color:        color,
+				Child:        child,
+			}
+		},
+	}
+}
+
+// SharedAxisTransition fades its child in during the last 70% of the
+// animation while moving it into place along Axis, the incoming half of a
+// shared-axis transition. On the X and Y axes the child slides 30 logical
+// pixels from the right or bottom; on the Z axis it grows from 80%.
+type SharedAxisTransition struct {
+	core.RenderObjectBase
+	Animation *animation.AnimationController
+	Axis      widgets.SharedAxis
+	Child     core.Widget
+}
+
+// ChildWidget returns the child widget.
+func (s SharedAxisTransition) ChildWidget() core.Widget {
+	return s.Child
+}
+
+// CreateRenderObject creates the renderSharedAxisTransition.
+func (s SharedAxisTransition) CreateRenderObject(ctx core.BuildContext) layout.RenderObject {
+	r := &renderSharedAxisTransition{
+		transitionRenderBase: transitionRenderBase{animation: s.Animation},
+		axis:                 s.Axis,
+	}
+	r.SetSelf(r)
+	r.subscribeAnimation()
+	return r
+}
+
+// UpdateRenderObject updates the renderSharedAxisTransition.
+func (s SharedAxisTransition) UpdateRenderObject(ctx core.BuildContext, renderObject layout.RenderObject) {
+	if r, ok := renderObject.(*renderSharedAxisTransition); ok {
+		if r.animation != s.Animation {
+			r.unsubscribeAnimation()
+			r.animation = s.Animation
+			r.subscribeAnimation()
+		}
+		r.axis = s.Axis
+		r.MarkNeedsPaint()
+	}
+}
+
+type renderSharedAxisTransition struct {
+	transitionRenderBase
+	axis widgets.SharedAxis
+}
+
+func (r *renderSharedAxisTransition) progress() float64 {
+	if r.animation == nil {
+		return 1
+	}
+	return r.animation.Value
+}
+
+func (r *renderSharedAxisTransition) slideOffset() graphics.Offset {
+	p := 1 - r.progress()
+	switch r.axis {
+	case widgets.SharedAxisX:
+		return graphics.Offset{X: sharedAxisDistance * p}
+	case widgets.SharedAxisY:
+		return graphics.Offset{Y: sharedAxisDistance * p}
+	default:
+		return graphics.Offset{}
+	}
+}
+
+func (r *renderSharedAxisTransition) ScrollOffset() graphics.Offset {
+	return r.slideOffset()
+}
+
+func (r *renderSharedAxisTransition) Paint(ctx *layout.PaintContext) {
+	if r.child == nil {
+		return
+	}
+	t := r.progress()
+	opacity := math.Max(0, (t-fadeThroughSplit)/(1-fadeThroughSplit))
+	if opacity <= 0 {
+		return
+	}
+	offset := r.slideOffset()
+	scale := 1.0
+	if r.axis == widgets.SharedAxisZ {
+		scale = sharedAxisStartScale + (1-sharedAxisStartScale)*t
+	}
+	if opacity >= 1 && scale == 1 {
+		ctx.PaintChildWithLayer(r.child, offset)
+		return
+	}
+	size := r.Size()
+	ctx.Canvas.SaveLayerAlpha(graphics.RectFromLTWH(offset.X, offset.Y, size.Width, size.Height), opacity)
+	if scale != 1 {
+		cx, cy := size.Width/2, size.Height/2
+		ctx.Canvas.Translate(cx, cy)
+		ctx.Canvas.Scale(scale, scale)
+		ctx.Canvas.Translate(-cx, -cy)
+	}
+	ctx.PaintChildWithLayer(r.child, offset)
+	ctx.Canvas.Restore()
+}
+
+// ContainerTransformTransition grows its child out of Source as the
+// animation runs, the incoming half of a container transform. A scrim
+// darkens everything beneath, the container fills with Color during the
+// first 20% of the animation, and the child, scaled to the container's
+// width, fades in during the last 70%.
+type ContainerTransformTransition struct {
+	core.RenderObjectBase
+	Animation *animation.AnimationController
+	// Source is the container's starting bounds, in the coordinates of the
+	// root render object.
+	Source graphics.Rect
+	// BorderRadius is the corner radius of the container at Source. The
+	// corners square off as it expands.
+	BorderRadius float64
+	// Color fills the container, typically the page's background color.
+	Color graphics.Color
+	Child core.Widget
+}
+
+// ChildWidget returns the child widget.
+func (c ContainerTransformTransition) ChildWidget() core.Widget {
+	return c.Child
+}
+
+// CreateRenderObject creates the renderContainerTransform.
+func (c ContainerTransformTransition) CreateRenderObject(ctx core.BuildContext) layout.RenderObject {
+	r := &renderContainerTransform{
+		transitionRenderBase: transitionRenderBase{animation: c.Animation},
+	}
+	c.apply(r)
+	r.SetSelf(r)
+	r.subscribeAnimation()
+	return r
+}
+
+// UpdateRenderObject updates the renderContainerTransform.
+func (c ContainerTransformTransition) UpdateRenderObject(ctx core.BuildContext, renderObject layout.RenderObject) {
+	if r, ok := renderObject.(*renderContainerTransform); ok {
+		if r.animation != c.Animation {
+			r.unsubscribeAnimation()
+			r.animation = c.Animation
+			r.subscribeAnimation()
+		}
+		c.apply(r)
+		r.MarkNeedsPaint()
+	}
+}
+
+func (c ContainerTransformTransition) apply(r *renderContainerTransform) {
+	r.source = c.Source
+	r.borderRadius = c.BorderRadius
+	r.color = c.Color
+}
+
+type renderContainerTransform struct {
+	transitionRenderBase
+	source       graphics.Rect
+	borderRadius float64
+	color        graphics.Color
+}
+
+func (r *renderContainerTransform) progress() float64 {
+	if r.animation == nil {
+		return 1
+	}
+	return r.animation.Value
+}
+
+func (r *renderContainerTransform) Paint(ctx *layout.PaintContext) {
+	if r.child == nil {
+		return
+	}
+	t := r.progress()
+	if t >= 1 {
+		ctx.PaintChildWithLayer(r.child, graphics.Offset{})
+		return
+	}
+	if t <= 0 {
+		return
+	}
+	size := r.Size()
+	full := graphics.RectFromLTWH(0, 0, size.Width, size.Height)
+
+	scrim := graphics.DefaultPaint()
+	scrim.Color = graphics.ColorBlack.WithAlpha(containerScrimAlpha * t)
+	ctx.Canvas.DrawRect(full, scrim)
+
+	// The source is measured from the root; bring it into this page.
+	origin := renderOrigin(r)
+	source := r.source.Translate(-origin.X, -origin.Y)
+	rect := animation.LerpRect(source, full, t)
+	radius := r.borderRadius * (1 - t)
+	container := graphics.RRectFromRectAndRadius(rect, graphics.CircularRadius(radius))
+
+	ctx.Canvas.Save()
+	ctx.Canvas.ClipRRect(container)
+	fill := graphics.DefaultPaint()
+	fill.Color = r.color.WithAlpha(r.color.Alpha() * math.Min(1, t/containerFillSplit))
+	ctx.Canvas.DrawRect(rect, fill)
+
+	opacity := math.Max(0, (t-fadeThroughSplit)/(1-fadeThroughSplit))
+	if opacity > 0 && size.Width > 0 {
+		scale := rect.Width() / size.Width
+		ctx.Canvas.SaveLayerAlpha(rect, opacity)
+		ctx.Canvas.Translate(rect.Left, rect.Top)
+		ctx.Canvas.Scale(scale, scale)
+		ctx.PaintChildWithLayer(r.child, graphics.Offset{})
+		ctx.Canvas.Restore()
+	}
+	ctx.Canvas.Restore()
+}
+
+// OpenContainer shows a closed widget, such as a card or list item, that
+// expands into a full page with a container transform when opened, and
+// shrinks back into place when the page is popped:
+//
+//	navigation.OpenContainer{
+//	    Closed: func(ctx core.BuildContext, open func()) core.Widget {
+//	        return widgets.Tap(open, albumCard(album))
+//	    },
+//	    Open: func(ctx core.BuildContext) core.Widget {
+//	        return albumPage(album)
+//	    },
+//	    Color:        colors.Surface,
+//	    BorderRadius: 12,
+//	}
+//
+// The page is pushed on the nearest navigator.
+type OpenContainer struct {
+	core.StatefulBase
+
+	// Closed builds the closed form. Call open to expand it into the page.
+	Closed func(ctx core.BuildContext, open func()) core.Widget
+
+	// Open builds the page the container expands into.
+	Open func(ctx core.BuildContext) core.Widget
+
+	// Color fills the container while it transforms, typically the page's
+	// background color.
+	Color graphics.Color
+
+	// BorderRadius is the corner radius of the closed form.
+	BorderRadius float64
+
+	// TransitionDuration is the length of the open and close animations.
+	// Zero uses [TransitionDuration].
+	TransitionDuration time.Duration
+
+	// Settings are the settings of the pushed route.
+	Settings RouteSettings
+}
+
+func (o OpenContainer) CreateState() core.State {
+	return &openContainerState{}
+}
+
+type openContainerState struct {
+	core.StateBase
+	nav NavigatorState
+}
+
+func (s *openContainerState) Build(ctx core.BuildContext) core.Widget {
+	w := s.Element().Widget().(OpenContainer)
+	s.nav = NavigatorOf(ctx)
+	if w.Closed == nil {
+		return nil
+	}
+	return w.Closed(ctx, s.open)
+}
+
+// open pushes the page, growing it out of the closed form's current bounds.
+func (s *openContainerState) open() {
+	w := s.Element().Widget().(OpenContainer)
+	box, ok := s.Element().RenderObject().(layout.RenderBox)
+	if s.nav == nil || w.Open == nil || !ok {
+		return
+	}
+	origin := renderOrigin(box)
+	size := box.Size()
+	route := NewAnimatedPageRoute(w.Open, w.Settings)
+	route.Transition = ContainerTransformPageTransition(
+		graphics.RectFromLTWH(origin.X, origin.Y, size.Width, size.Height),
+		w.BorderRadius,
+		w.Color,
+	)
+	route.TransitionDuration = w.TransitionDuration
+	s.nav.Push(route)
+}
@@ -0,0 +1,242 @@
+package navigation
+
+import "sync"
+
+// NavigationAction is the kind of stack change a [Navigation] makes.
+type NavigationAction int
+
+const (
+	// NavigationPush pushes a route, as Push, PushNamed and [RouterState.Go] do.
+	NavigationPush NavigationAction = iota
+
+	// NavigationReplace replaces the top route, as PushReplacement,
+	// PushReplacementNamed and [RouterState.Replace] do.
+	NavigationReplace
+
+	// NavigationPushAndRemove pushes a route and removes routes below it, as
+	// PushAndRemoveUntil and PushNamedAndRemoveUntil do.
+	NavigationPushAndRemove
+)
+
+// String returns a human-readable representation of the navigation action.
+func (a NavigationAction) String() string {
+	switch a {
+	case NavigationPush:
+		return "push"
+	case NavigationReplace:
+		return "replace"
+	case NavigationPushAndRemove:
+		return "push_and_remove"
+	default:
+		return "unknown"
+	}
+}
+
+// Navigation describes a navigation passing through a [Router]'s middleware.
+type Navigation struct {
+	// Settings is the destination, with Params and Query resolved against
+	// the router's routes. Params is nil for paths no route matches.
+	Settings RouteSettings
+
+	// FromPath is the path of the top route of the navigator being pushed
+	// onto. Empty when that route has no path.
+	FromPath string
+
+	// Action is how the destination enters the stack.
+	Action NavigationAction
+}
+
+// Middleware runs on every navigation of a [Router] to a path, in the order
+// given in [Router.Middleware], before redirects apply. It can let the
+// navigation continue, rewrite its destination, cancel it, or hold it until
+// some work finishes. Use it for cross-cutting concerns such as logging,
+// analytics, auth checks and feature flags.
+//
+//	func logNavigation(nav navigation.Navigation) navigation.MiddlewareResult {
+//	    log.Printf("%s %s -> %s", nav.Action, nav.FromPath, nav.Settings.Name)
+//	    return navigation.Proceed()
+//	}
+//
+// Navigations pushing routes without a path, such as dialogs, skip
+// middleware, as do the initial, restored and deep-linked launch routes.
+type Middleware func(nav Navigation) MiddlewareResult
+
+type middlewareKind int
+
+const (
+	middlewareProceed middlewareKind = iota
+	middlewareRewrite
+	middlewareCancel
+	middlewareDelay
+)
+
+// MiddlewareResult tells the router how to continue a navigation.
+//
+// Create using helper functions:
+//   - [Proceed] to pass the navigation on unchanged
+//   - [Rewrite] to change its destination
+//   - [Cancel] to drop it
+//   - [Delay] to decide later
+type MiddlewareResult struct {
+	kind middlewareKind
+	path string
+	args any
+	wait func(resume func(MiddlewareResult))
+}
+
+// Proceed returns a result that passes the navigation to the next middleware,
+// or performs it after the last one.
+func Proceed() MiddlewareResult {
+	return MiddlewareResult{}
+}
+
+// Rewrite returns a result that changes the destination of the navigation to
+// path with args. Later middleware see the new destination.
+//
+//	func flags(nav navigation.Navigation) navigation.MiddlewareResult {
+//	    if nav.Settings.Name == "/checkout" && features.NewCheckout() {
+//	        return navigation.Rewrite("/checkout/v2", nav.Settings.Arguments)
+//	    }
+//	    return navigation.Proceed()
+//	}
+func Rewrite(path string, args any) MiddlewareResult {
+	return MiddlewareResult{kind: middlewareRewrite, path: path, args: args}
+}
+
+// Cancel returns a result that drops the navigation. Later middleware do not
+// run, and a result channel returned by [Push] receives nil.
+func Cancel() MiddlewareResult {
+	return MiddlewareResult{kind: middlewareCancel}
+}
+
+// Delay returns a result that holds the navigation until wait calls resume
+// with the result to apply, such as after an auth check or a confirmation
+// dialog. Resume must be called once, on the UI thread; use
+// [platform.Dispatch] from other goroutines.
+//
+//	func confirm(nav navigation.Navigation) navigation.MiddlewareResult {
+//	    return navigation.Delay(func(resume func(navigation.MiddlewareResult)) {
+//	        go func() {
+//	            ok := session.Refresh()
+//	            platform.Dispatch(func() {
+//	                if ok {
+//	                    resume(navigation.Proceed())
+//	                } else {
+//	                    resume(navigation.Rewrite("/login", nil))
+//	                }
+//	            })
+//	        }()
+//	    })
+//	}
+//
+// Navigations started while one is held run independently.
+func Delay(wait func(resume func(MiddlewareResult))) MiddlewareResult {
+	return MiddlewareResult{kind: middlewareDelay, wait: wait}
+}
+
+// interceptNavigation runs nav through the middleware chain and resolves its
+// redirects, waiting for asynchronous ones, then calls perform with the final
+// destination and whether it was rewritten, or cancel.
+func (s *routerState) interceptNavigation(nav Navigation, perform func(RouteSettings, bool), cancel func()) {
+	// Navigations made while performing one, such as a shell opening a
+	// branch route, are part of it.
+	if s.navigating {
+		perform(nav.Settings, false)
+		return
+	}
+
+	middleware := s.router.Middleware
+	nav.Settings = s.resolveSettings(nav.Settings)
+	rewritten := false
+
+	var step func(i int)
+	var apply func(i int, result MiddlewareResult)
+	step = func(i int) {
+		if i == len(middleware) {
+			resolved := map[string]RedirectResult{}
+			s.resolveRedirects(resolved, nav.FromPath, nav.Settings, func(string) {
+				s.navigating, s.resolvedRedirects = true, resolved
+				defer func() { s.navigating, s.resolvedRedirects = false, nil }()
+				perform(nav.Settings, rewritten)
+			}, cancel)
+			return
+		}
+		apply(i, middleware[i](nav))
+	}
+	apply = func(i int, result MiddlewareResult) {
+		switch result.kind {
+		case middlewareRewrite:
+			nav.Settings = s.resolveSettings(RouteSettings{Name: result.path, Arguments: result.args})
+			rewritten = true
+		case middlewareCancel:
+			cancel()
+			return
+		case middlewareDelay:
+			var once sync.Once
+			result.wait(func(resumed MiddlewareResult) {
+				once.Do(func() {
+					if s.IsDisposed() {
+						cancel()
+						return
+					}
+					apply(i, resumed)
+				})
+			})
+			return
+		}
+		step(i + 1)
+	}
+	step(0)
+}
+
+// resolveSettings fills in the Params and Query of settings from the route
+// its path matches.
+func (s *routerState) resolveSettings(settings RouteSettings) RouteSettings {
+	if _, matched := s.findRoute(settings.Name); matched.Name != "" {
+		matched.Arguments = settings.Arguments
+		return matched
+	}
+	_, settings.Query = ParsePath(settings.Name)
+	return settings
+}
+
+// intercept runs a navigation to name through the navigator's middleware
+// hook, then calls perform with the final destination. The result receiver
+// pushed, if any, completes without a value once the navigation is
+// cancelled or performed without pushing a route.
+func (s *navigatorState) intercept(action NavigationAction, name string, args any, pushed *pushResult, perform func(name string, args any, rewritten bool)) {
+	if s.navigator.intercept == nil || name == "" {
+		perform(name, args, false)
+		pushed.finish()
+		return
+	}
+
+	nav := Navigation{
+		Settings: RouteSettings{Name: name, Arguments: args},
+		Action:   action,
+	}
+	if top := s.top(); top != nil {
+		nav.FromPath = top.Settings().Name
+	}
+
+	s.navigator.intercept(nav, func(settings RouteSettings, rewritten bool) {
+		if !s.IsDisposed() {
+			perform(settings.Name, settings.Arguments, rewritten)
+		}
+		pushed.finish()
+	}, pushed.finish)
+}
+
+// interceptRoute runs a push of route through the navigator's middleware
+// hook, regenerating the route if its destination was rewritten.
+func (s *navigatorState) interceptRoute(action NavigationAction, route Route, pushed *pushResult, perform func(Route)) {
+	settings := route.Settings()
+	s.intercept(action, settings.Name, settings.Arguments, pushed, func(name string, args any, rewritten bool) {
+		if rewritten {
+			if route = s.routeFromName(name, args); route == nil {
+				return
+			}
+		}
+		perform(route)
+	})
+}
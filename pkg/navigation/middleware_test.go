@@ -0,0 +1,174 @@
+package navigation
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+// middlewareRouter returns a Router with the given middleware that
+// redirects "/old" to "/login".
+func middlewareRouter(middleware ...Middleware) Router {
+	return Router{
+		InitialPath: "/",
+		Middleware:  middleware,
+		Redirect: func(ctx RedirectContext) RedirectResult {
+			if ctx.ToPath == "/old" {
+				return RedirectTo("/login")
+			}
+			return NoRedirect()
+		},
+		Routes: stubRoutes("/", "/products/:id", "/beta", "/login"),
+	}
+}
+
+func TestRouter_Middleware_RunsInOrderWithResolvedSettings(t *testing.T) {
+	var order []string
+	var seen Navigation
+	_, nav := pumpRouter(t, middlewareRouter(
+		func(n Navigation) MiddlewareResult {
+			order = append(order, "first")
+			seen = n
+			return Proceed()
+		},
+		func(n Navigation) MiddlewareResult {
+			order = append(order, "second")
+			return Proceed()
+		},
+	))
+	if len(order) != 0 {
+		t.Fatalf("expected the initial route to skip middleware, got %v", order)
+	}
+
+	RouterOf(nav.Element()).Go("/products/42?tab=reviews", "args")
+	if !slices.Equal(order, []string{"first", "second"}) {
+		t.Errorf("expected middleware in order, got %v", order)
+	}
+	if seen.Settings.Params["id"] != "42" || seen.Settings.Query["tab"][0] != "reviews" {
+		t.Errorf("expected resolved params and query, got %+v", seen.Settings)
+	}
+	if seen.Settings.Arguments != "args" || seen.FromPath != "/" || seen.Action != NavigationPush {
+		t.Errorf("unexpected navigation %+v", seen)
+	}
+	if got := nav.top().Settings().Name; got != "/products/42?tab=reviews" {
+		t.Errorf("expected the navigation to proceed, got %q", got)
+	}
+}
+
+func TestRouter_Middleware_RewritesBeforeRedirect(t *testing.T) {
+	var later Navigation
+	_, nav := pumpRouter(t, middlewareRouter(
+		func(n Navigation) MiddlewareResult {
+			if n.Settings.Name == "/products/1" {
+				return Rewrite("/beta", nil)
+			}
+			if n.Settings.Name == "/products/2" {
+				return Rewrite("/old", nil)
+			}
+			return Proceed()
+		},
+		func(n Navigation) MiddlewareResult {
+			later = n
+			return Proceed()
+		},
+	))
+
+	nav.PushReplacement(NewAnimatedPageRoute(nil, RouteSettings{Name: "/products/1"}))
+	if got := stackNames(nav); !slices.Equal(got, []string{"/beta"}) {
+		t.Fatalf("expected the rewritten route to replace the top, got %v", got)
+	}
+	if later.Settings.Name != "/beta" || later.Action != NavigationReplace {
+		t.Errorf("expected later middleware to see the rewrite, got %+v", later)
+	}
+
+	nav.PushNamed("/products/2", nil)
+	if got := nav.top().Settings().Name; got != "/login" {
+		t.Errorf("expected the redirect to apply to the rewritten path, got %q", got)
+	}
+}
+
+func TestRouter_Middleware_Cancels(t *testing.T) {
+	calls := 0
+	tester, nav := pumpRouter(t, middlewareRouter(
+		func(n Navigation) MiddlewareResult {
+			if n.Settings.Name == "/beta" {
+				return Cancel()
+			}
+			return Proceed()
+		},
+		func(n Navigation) MiddlewareResult {
+			calls++
+			return Proceed()
+		},
+	))
+
+	ch := Push[string](nav.Element(), "/beta", nil)
+	if _, ok := <-ch; ok {
+		t.Error("expected the result channel closed for a cancelled navigation")
+	}
+	nav.PushNamedAndRemoveUntil("/beta", nil, func(Route) bool { return false })
+	tester.PumpAndSettle(time.Second)
+	if got := stackNames(nav); !slices.Equal(got, []string{"/"}) {
+		t.Errorf("expected cancelled navigations to leave the stack, got %v", got)
+	}
+	if calls != 0 {
+		t.Errorf("expected later middleware to be skipped, got %d calls", calls)
+	}
+
+	// Routes without a path, such as dialogs, skip middleware.
+	nav.Push(NewAnimatedPageRoute(nil, RouteSettings{}))
+	if len(nav.routes) != 2 {
+		t.Errorf("expected an unnamed route to be pushed, got %d routes", len(nav.routes))
+	}
+}
+
+func TestRouter_Middleware_Delays(t *testing.T) {
+	var resume func(MiddlewareResult)
+	tester, nav := pumpRouter(t, middlewareRouter(func(n Navigation) MiddlewareResult {
+		if n.Settings.Name != "/beta" {
+			return Proceed()
+		}
+		return Delay(func(r func(MiddlewareResult)) {
+			resume = r
+		})
+	}))
+
+	ch := Push[string](nav.Element(), "/beta", nil)
+	if len(nav.routes) != 1 || resume == nil {
+		t.Fatalf("expected the navigation to be held, got %v", stackNames(nav))
+	}
+	select {
+	case <-ch:
+		t.Fatal("expected the result channel to stay open while held")
+	default:
+	}
+
+	resume(Proceed())
+	resume(Cancel()) // ignored
+	tester.PumpAndSettle(time.Second)
+	if got := nav.top().Settings().Name; got != "/beta" {
+		t.Fatalf("expected the navigation to proceed when resumed, got %q", got)
+	}
+
+	nav.Pop("done")
+	if got, ok := <-ch; !ok || got != "done" {
+		t.Errorf("expected the pushed route's result, got %q (ok %v)", got, ok)
+	}
+}
+
+func TestNavigationAction_String(t *testing.T) {
+	tests := []struct {
+		action NavigationAction
+		want   string
+	}{
+		{NavigationPush, "push"},
+		{NavigationReplace, "replace"},
+		{NavigationPushAndRemove, "push_and_remove"},
+		{NavigationAction(99), "unknown"},
+	}
+	for _, tt := range tests {
+		if got := tt.action.String(); got != tt.want {
+			t.Errorf("%d: got %q, want %q", tt.action, got, tt.want)
+		}
+	}
+}
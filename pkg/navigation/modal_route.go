@@ -1,6 +1,10 @@
 package navigation
 
 import (
+	"sync"
+	"time"
+
+	"github.com/go-drift/drift/pkg/animation"
 	"github.com/go-drift/drift/pkg/core"
 	"github.com/go-drift/drift/pkg/graphics"
 	"github.com/go-drift/drift/pkg/overlay"
@@ -10,9 +14,19 @@ import (
 // DefaultBarrierColor is the default semi-transparent black used for modal barriers.
 var DefaultBarrierColor = graphics.RGBA(0, 0, 0, 128) // 50% opacity black
 
+// ModalTransitionDuration is the default duration of modal route transitions.
+const ModalTransitionDuration = 200 * time.Millisecond
+
 // ModalRoute is a route that displays as a modal overlay with a barrier.
 // The modal content appears above a semi-transparent barrier that can
 // optionally dismiss the modal when tapped.
+//
+// The barrier and content live in overlay entries owned by the route: they
+// are inserted when the route is pushed and removed once it has animated
+// out. On push the barrier fades in and the content enters through
+// Transition; popping plays both in reverse. It is the base for dialogs
+// ([ShowDialog]) and other modal surfaces, such as menus, that should close
+// with the back button and return a result.
 type ModalRoute struct {
 	BaseRoute
 	builder func(ctx core.BuildContext) core.Widget
@@ -28,11 +42,21 @@ type ModalRoute struct {
 	// BarrierLabel is the accessibility label for the barrier.
 	BarrierLabel string
 
+	// Transition animates the content on push and pop. If nil, the content
+	// fades in and out.
+	Transition TransitionBuilder
+
+	// TransitionDuration is the length of the push and pop animations.
+	// Zero uses [ModalTransitionDuration].
+	TransitionDuration time.Duration
+
 	// internal
-	overlayState   OverlayState
-	barrierEntry   *overlay.OverlayEntry
-	contentEntry   *overlay.OverlayEntry
-	didPushPending bool // true if DidPush called before SetOverlay
+	foregroundController *animation.AnimationController
+	popping              bool // true once popped, while animating out
+	overlayState         OverlayState
+	barrierEntry         *overlay.OverlayEntry
+	contentEntry         *overlay.OverlayEntry
+	didPushPending       bool // true if DidPush called before SetOverlay
 }
 
 // NewModalRoute creates a new ModalRoute with the given builder and settings.
@@ -59,8 +83,29 @@ func (r *ModalRoute) SetOverlay(o OverlayState) {
 	}
 }
 
+// ForegroundController returns the controller driving the barrier and
+// content animations. Satisfies the AnimatedRoute interface, so the
+// navigator keeps the route until it has animated out.
+func (r *ModalRoute) ForegroundController() *animation.AnimationController {
+	return r.foregroundController
+}
+
+// BackgroundTransition leaves the page beneath in place while the modal
+// animates. Satisfies the BackgroundTransitionRoute interface.
+func (r *ModalRoute) BackgroundTransition() BackgroundTransition {
+	return BackgroundTransition{}
+}
+
 // DidPush is called when the route is pushed onto the navigator.
 func (r *ModalRoute) DidPush() {
+	duration := r.TransitionDuration
+	if duration <= 0 {
+		duration = ModalTransitionDuration
+	}
+	r.foregroundController = animation.NewAnimationController(duration)
+	r.foregroundController.Curve = animation.EaseOut
+	r.foregroundController.Forward()
+
 	if r.overlayState == nil {
 		// Overlay not ready yet - defer entry insertion
 		r.didPushPending = true
@@ -77,17 +122,28 @@ func (r *ModalRoute) insertEntries() {
 	}
 
 	r.barrierEntry = overlay.NewOverlayEntry(func(ctx core.BuildContext) core.Widget {
-		return overlay.ModalBarrier{
-			Color:         barrierColor,
-			Dismissible:   r.BarrierDismissible,
-			OnDismiss:     func() { NavigatorOf(ctx).Pop(nil) },
-			SemanticLabel: r.BarrierLabel,
+		// Fading the barrier scales its color's alpha with the animation
+		return FadeTransition{
+			Animation: r.foregroundController,
+			Child: overlay.ModalBarrier{
+				Color:         barrierColor,
+				Dismissible:   r.BarrierDismissible && !r.popping,
+				OnDismiss:     func() { NavigatorOf(ctx).Pop(nil) },
+				SemanticLabel: r.BarrierLabel,
+			},
 		}
 	})
 	r.barrierEntry.Opaque = false // Don't block hit testing everywhere
 
 	// Create content entry
-	r.contentEntry = overlay.NewOverlayEntry(r.builder)
+	r.contentEntry = overlay.NewOverlayEntry(func(ctx core.BuildContext) core.Widget {
+		var content core.Widget = withRouteScope(r, r.builder)
+		if r.foregroundController != nil {
+			content = r.transition()(r.foregroundController, content)
+		}
+		// Content animating out no longer takes input
+		return widgets.IgnorePointer{Ignoring: r.popping, Child: content}
+	})
 	r.contentEntry.Opaque = true // Block hit testing everywhere below
 	r.contentEntry.MaintainState = false
 
@@ -96,9 +152,33 @@ func (r *ModalRoute) insertEntries() {
 	r.overlayState.Insert(r.contentEntry, nil, nil)
 }
 
-// DidPop is called when the route is popped from the navigator.
+func (r *ModalRoute) transition() TransitionBuilder {
+	if r.Transition == nil {
+		return func(animation *animation.AnimationController, child core.Widget) core.Widget {
+			return FadeTransition{Animation: animation, Child: child}
+		}
+	}
+	return r.Transition
+}
+
+// DidPop is called when the route is popped from the navigator. The barrier
+// and content animate out and are removed when the navigator disposes the
+// route, or at once if the route never animated in.
 func (r *ModalRoute) DidPop(result any) {
 	r.didPushPending = false
+	r.popping = true
+	if r.foregroundController != nil && r.barrierEntry != nil {
+		r.foregroundController.Reverse()
+		r.barrierEntry.MarkNeedsBuild()
+		r.contentEntry.MarkNeedsBuild()
+		return
+	}
+	r.removeEntries()
+}
+
+// removeEntries removes the barrier and content from the overlay. The
+// navigator calls it when it disposes the route.
+func (r *ModalRoute) removeEntries() {
 	if r.barrierEntry != nil {
 		r.barrierEntry.Remove()
 		r.barrierEntry = nil
@@ -129,3 +209,88 @@ func (r *ModalRoute) Build(ctx core.BuildContext) core.Widget {
 	}
 	return r.builder(ctx)
 }
+
+// DialogOptions configures [ShowDialog].
+type DialogOptions struct {
+	// Builder creates the dialog content, which is centered over the
+	// barrier. Required.
+	Builder func(ctx core.BuildContext) core.Widget
+
+	// Persistent prevents a barrier tap from dismissing the dialog. The back
+	// button still pops it unless a [PopScope] blocks it.
+	Persistent bool
+
+	// BarrierColor is the color of the barrier behind the dialog. If nil,
+	// defaults to DefaultBarrierColor.
+	BarrierColor *graphics.Color
+
+	// BarrierLabel is the accessibility label for the barrier. Defaults to
+	// "Dismiss".
+	BarrierLabel string
+
+	// Transition animates the dialog on push and pop. If nil, the dialog
+	// fades in and out.
+	Transition TransitionBuilder
+}
+
+// ShowDialog pushes a [ModalRoute] that shows a centered dialog on the
+// nearest navigator. Unlike [overlay.ShowDialog], the dialog is part of the
+// navigation stack: the back button closes it, and it closes with a result.
+//
+// Returns a buffered channel (size 1) that receives the result the dialog
+// route is popped with, or nil if it was dismissed by the barrier or the
+// back button. The channel is closed after sending. To close the dialog
+// from its content, pop it:
+//
+//	navigation.NavigatorOf(ctx).Pop(result)
+//
+// Example:
+//
+//	ch := navigation.ShowDialog(ctx, navigation.DialogOptions{
+//	    Builder: func(ctx core.BuildContext) core.Widget {
+//	        return overlay.AlertDialog{
+//	            Title: widgets.Text{Content: "Delete draft?"},
+//	            Actions: []core.Widget{
+//	                theme.ButtonOf(ctx, "Delete", func() {
+//	                    navigation.NavigatorOf(ctx).Pop(true)
+//	                }),
+//	            },
+//	        }
+//	    },
+//	})
+//	go func() {
+//	    if confirmed, _ := (<-ch).(bool); confirmed {
+//	        drift.Dispatch(s.deleteDraft)
+//	    }
+//	}()
+func ShowDialog(ctx core.BuildContext, opts DialogOptions) <-chan any {
+	result := make(chan any, 1) // Buffered to prevent blocking
+	var once sync.Once
+	complete := func(value any) {
+		once.Do(func() {
+			result <- value
+			close(result)
+		})
+	}
+
+	nav := NavigatorOf(ctx)
+	if nav == nil || opts.Builder == nil {
+		complete(nil)
+		return result
+	}
+
+	route := NewModalRoute(func(ctx core.BuildContext) core.Widget {
+		return widgets.Center{Child: opts.Builder(ctx)}
+	}, RouteSettings{})
+	route.BarrierDismissible = !opts.Persistent
+	if opts.BarrierColor != nil {
+		route.BarrierColor = opts.BarrierColor
+	}
+	if opts.BarrierLabel != "" {
+		route.BarrierLabel = opts.BarrierLabel
+	}
+	route.Transition = opts.Transition
+
+	pushForResult(nav, route, &pushResult{complete: complete})
+	return result
+}
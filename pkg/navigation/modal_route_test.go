@@ -0,0 +1,68 @@
+package navigation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+func TestShowDialog_AnimatesAndReturnsResult(t *testing.T) {
+	tester, nav, ctx := pumpResultNavigator(t)
+
+	ch := ShowDialog(ctx, DialogOptions{
+		Builder: func(core.BuildContext) core.Widget { return widgets.SizedBox{Width: 100, Height: 100} },
+	})
+	route, ok := nav.top().(*ModalRoute)
+	if !ok {
+		t.Fatalf("expected a modal route on top, got %T", nav.top())
+	}
+	tester.PumpAndSettle(time.Second)
+	if route.barrierEntry == nil || route.contentEntry == nil {
+		t.Fatal("expected the barrier and content in the overlay")
+	}
+	if v := route.ForegroundController().Value; v != 1 {
+		t.Errorf("expected the modal fully shown, got %v", v)
+	}
+
+	nav.Pop("ok")
+	if route.barrierEntry == nil {
+		t.Error("expected the barrier to stay while the modal animates out")
+	}
+	select {
+	case result := <-ch:
+		if result != "ok" {
+			t.Errorf("expected result ok, got %v", result)
+		}
+	default:
+		t.Fatal("expected the result to be sent when the dialog is popped")
+	}
+
+	tester.PumpAndSettle(time.Second)
+	if route.barrierEntry != nil || route.contentEntry != nil {
+		t.Error("expected the entries removed once the modal animated out")
+	}
+	if nav.exitingRoute != nil || len(nav.routes) != 1 {
+		t.Errorf("expected only / to remain, got %d routes", len(nav.routes))
+	}
+}
+
+func TestModalRoute_RemovedWithoutAnimation(t *testing.T) {
+	tester, nav, ctx := pumpResultNavigator(t)
+
+	ch := ShowDialog(ctx, DialogOptions{
+		Builder: func(core.BuildContext) core.Widget { return widgets.SizedBox{} },
+	})
+	route := nav.top().(*ModalRoute)
+	tester.PumpAndSettle(time.Second)
+
+	// Routes removed beneath the top, or by PopUntil, do not animate out.
+	nav.PopUntil(func(r Route) bool { return r.Settings().Name == "/" })
+	if route.barrierEntry != nil || route.contentEntry != nil {
+		t.Error("expected the entries removed with the route")
+	}
+	if result, ok := <-ch; !ok || result != nil {
+		t.Errorf("expected a nil result, got %v (ok %v)", result, ok)
+	}
+}
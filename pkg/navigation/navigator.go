@@ -7,6 +7,7 @@ import (
 	"github.com/go-drift/drift/pkg/animation"
 	"github.com/go-drift/drift/pkg/core"
 	"github.com/go-drift/drift/pkg/overlay"
+	"github.com/go-drift/drift/pkg/platform"
 	"github.com/go-drift/drift/pkg/widgets"
 )
 
@@ -85,7 +86,8 @@ func (s *NavigationScope) ClearRootIf(nav NavigatorState) {
 // first (e.g., the current tab's navigator), then falls back to the root
 // navigator if the active one can't pop.
 //
-// Returns true if a route was popped, false if at root (app should exit).
+// Returns true if a route was popped or a [PopScope] intercepted the back
+// press, false if at root (app should exit).
 //
 //	// In platform back button handler:
 //	if !navigation.HandleBackButton() {
@@ -101,6 +103,12 @@ func HandleBackButton() bool {
 		return false
 	}
 
+	// A nested navigator whose host route is covered by another route is
+	// hidden, so the back press belongs to the root navigator.
+	if ns, ok := nav.(*navigatorState); ok && nav != root && root != nil && ns.isCovered() {
+		nav = root
+	}
+
 	// Try active navigator first
 	if nav.CanPop() {
 		return nav.MaybePop(nil)
@@ -198,6 +206,15 @@ type Navigator struct {
 	// RefreshListenable triggers redirect re-evaluation when notified.
 	// Use this when auth state changes to re-check if the current route is still accessible.
 	RefreshListenable core.Listenable
+
+	// initialStack replaces InitialRoute with a stack of routes, bottom
+	// first, when restoring a router's saved state.
+	initialStack []RouteSettings
+
+	// intercept runs navigations to a path through a router's middleware
+	// and asynchronous redirects. It calls perform with the final
+	// destination, or cancel.
+	intercept func(nav Navigation, perform func(settings RouteSettings, rewritten bool), cancel func())
 }
 
 // CreateState creates the NavigatorState.
@@ -229,13 +246,16 @@ type NavigatorState interface {
 	PushReplacementNamed(name string, args any)
 
 	// Pop removes the current route from the stack.
-	// The result is passed to the popped route's DidPop callback.
-	// Does nothing if only one route remains (can't pop the root).
+	// The result is passed to the popped route's DidPop callback, and to
+	// the [Push] call that pushed it.
+	// Does nothing if only one route remains (can't pop the root), or if a
+	// [PopScope] in the current route blocks the pop.
 	Pop(result any)
 
 	// PopUntil removes routes until the predicate returns true for the top route.
 	// Each route's WillPop is checked before removal; removal stops if WillPop
-	// returns false. Routes are removed without animation.
+	// returns false or a [PopScope] in the route blocks the pop. Routes are
+	// removed without animation.
 	PopUntil(predicate func(Route) bool)
 
 	// PushReplacement replaces the current route with a new route.
@@ -243,13 +263,29 @@ type NavigatorState interface {
 	// the redirect logic is applied before replacing.
 	PushReplacement(route Route)
 
+	// PushAndRemoveUntil pushes a route, then removes the routes below it
+	// until predicate returns true for one. A predicate that always returns
+	// false leaves the new route alone on the stack, as after logging in.
+	// Removed routes are not animated, and their WillPop and [PopScope]s are
+	// not consulted. Redirect logic is applied if the route has a name.
+	PushAndRemoveUntil(route Route, predicate func(Route) bool)
+
+	// PushNamedAndRemoveUntil creates a route by name and pushes it like
+	// PushAndRemoveUntil. Redirect logic is applied if configured.
+	PushNamedAndRemoveUntil(name string, args any, predicate func(Route) bool)
+
+	// PopToRoot removes every route above the first, like PopUntil with a
+	// predicate that never matches.
+	PopToRoot()
+
 	// CanPop returns true if there are routes that can be popped.
 	// Returns false if only the root route remains.
 	CanPop() bool
 
 	// MaybePop attempts to pop if possible.
 	// Checks CanPop and the top route's WillPop before popping.
-	// Returns true if a route was popped, false otherwise.
+	// Returns true if a route was popped or a [PopScope] blocked the pop
+	// and handled it, false otherwise.
 	MaybePop(result any) bool
 }
 
@@ -262,9 +298,17 @@ type navigatorState struct {
 	exitingRoute       Route  // route currently animating out
 	exitingUnsubscribe func() // cleanup for exit animation status listener
 	pushUnsubscribe    func() // cleanup for push animation status listener
+	swipeRoute         Route  // top route being dragged by an edge swipe
+
+	hostNavigator *navigatorState // enclosing navigator, for nested navigators
+	hostRoute     Route           // route of hostNavigator containing this navigator
 
 	isRefreshing       bool   // guard against re-entrant refresh
 	unsubscribeRefresh func() // cleanup for RefreshListenable
+
+	heroes heroController // pairs Hero widgets across routes during transitions
+
+	results map[Route]func(any) // receive the results of routes pushed by [Push]
 }
 
 func (s *navigatorState) InitState() {
@@ -280,54 +324,89 @@ func (s *navigatorState) InitState() {
 		s.unsubscribeRefresh = s.navigator.RefreshListenable.AddListener(s.onRefresh)
 	}
 
-	// Push the initial route (with redirect support)
-	if s.navigator.InitialRoute != "" && s.navigator.OnGenerateRoute != nil {
-		initialPath := s.navigator.InitialRoute
-		var initialArgs any
+	// Push the initial routes (with redirect support)
+	if s.navigator.OnGenerateRoute != nil {
+		stack := s.navigator.initialStack
+		if len(stack) == 0 && s.navigator.InitialRoute != "" {
+			stack = []RouteSettings{{Name: s.navigator.InitialRoute}}
+		}
+		s.pushInitialRoutes(stack)
+	}
+}
 
-		// Apply redirect to initial route
+// pushInitialRoutes shows the routes of stack, bottom first, without
+// animation. Each route is redirected as if navigated to from the one below;
+// a redirected route ends the stack.
+func (s *navigatorState) pushInitialRoutes(stack []RouteSettings) {
+	fromPath := ""
+	for _, settings := range stack {
+		path, args := settings.Name, settings.Arguments
 		if s.navigator.Redirect != nil {
-			finalPath, finalArgs, _, _ := s.applyRedirect("", initialPath, initialArgs)
-			initialPath = finalPath
-			initialArgs = finalArgs
+			path, args, _, _ = s.applyRedirect(fromPath, path, args)
 		}
-
-		settings := RouteSettings{Name: initialPath, Arguments: initialArgs}
-		route := s.navigator.OnGenerateRoute(settings)
-		if route == nil && s.navigator.OnUnknownRoute != nil {
-			route = s.navigator.OnUnknownRoute(settings)
+		route := s.routeFromName(path, args)
+		if route == nil {
+			break
 		}
-		if route != nil {
-			// Mark as initial route (no animation)
-			if mr, ok := route.(*AnimatedPageRoute); ok {
-				mr.SetInitialRoute()
-			}
-			s.routes = []Route{route}
-			route.DidPush()
+		// Mark as initial route (no animation)
+		if mr, ok := route.(*AnimatedPageRoute); ok {
+			mr.SetInitialRoute()
 		}
+		previous := s.top()
+		if previous != nil {
+			previous.DidChangeNext(route)
+		}
+		s.routes = append(s.routes, route)
+		route.DidChangePrevious(previous)
+		route.DidPush()
+		for _, observer := range s.navigator.Observers {
+			observer.DidPush(route, previous)
+		}
+		if path != settings.Name {
+			break
+		}
+		fromPath = path
 	}
+	s.notifyTopRoute(nil)
+}
+
+// backgroundTransitionOf returns the effect an animating route applies to
+// the route beneath it.
+func backgroundTransitionOf(route Route) BackgroundTransition {
+	if br, ok := route.(BackgroundTransitionRoute); ok {
+		return br.BackgroundTransition()
+	}
+	return BackgroundTransition{Offset: parallaxOffset}
 }
 
 func (s *navigatorState) Build(ctx core.BuildContext) core.Widget {
 	// Register with TabNavigator if we're inside one (for active navigator tracking)
 	tryRegisterTabNavigator(ctx, s)
 
-	// Check if top route is transparent (needs previous routes visible)
-	topIsTransparent := false
-	if len(s.routes) > 0 {
-		if tr, ok := s.routes[len(s.routes)-1].(TransparentRoute); ok {
-			topIsTransparent = tr.IsTransparent()
+	// Remember the enclosing navigator and route to detect when this one is covered
+	s.hostNavigator, _ = NavigatorOf(ctx).(*navigatorState)
+	s.hostRoute = routeOf(ctx)
+
+	// Find the lowest visible route: routes beneath transparent ones keep
+	// painting, down to the first opaque route.
+	firstVisible := len(s.routes) - 1
+	for firstVisible > 0 {
+		tr, ok := s.routes[firstVisible].(TransparentRoute)
+		if !ok || !tr.IsTransparent() {
+			break
 		}
+		firstVisible--
 	}
 
-	// Check if top route has an active foreground animation (push transition in progress).
-	// When animating, the route below must stay visible for the parallax effect.
+	// Check if top route has an active foreground animation (push transition in progress)
+	// or is being swiped back. When animating, the route below must stay visible for
+	// the parallax effect.
 	topIsAnimating := false
 	var topForegroundController *animation.AnimationController
 	if len(s.routes) > 0 {
 		if ar, ok := s.routes[len(s.routes)-1].(AnimatedRoute); ok {
 			fc := ar.ForegroundController()
-			if fc != nil && fc.IsAnimating() {
+			if fc != nil && (fc.IsAnimating() || ar == s.swipeRoute) {
 				topIsAnimating = true
 				topForegroundController = fc
 			}
@@ -353,26 +432,31 @@ func (s *navigatorState) Build(ctx core.BuildContext) core.Widget {
 
 		// Route is visible if:
 		// - It's the top route, OR
-		// - Top route is transparent and this is the route directly below it, OR
+		// - Only transparent routes are above it, OR
 		// - Top route is animating (push) and this is the route directly below it
-		isVisible := isTop || (topIsTransparent && isSecondFromTop) || (topIsAnimating && isSecondFromTop)
+		isVisible := i >= firstVisible || (topIsAnimating && isSecondFromTop)
 
 		// Determine background animation controller for this route.
 		// During push: the route below the top slides left, driven by the top's controller.
 		// During pop: the new top slides back from left, driven by the exiting route's controller.
+		// The animating route's BackgroundTransition decides the effect.
 		var bgAnimation *animation.AnimationController
+		var bgEffect BackgroundTransition
 		if isSecondFromTop && topForegroundController != nil {
 			bgAnimation = topForegroundController
+			bgEffect = backgroundTransitionOf(s.routes[len(s.routes)-1])
 		} else if isTop && exitingForegroundController != nil {
 			bgAnimation = exitingForegroundController
+			bgEffect = backgroundTransitionOf(s.exitingRoute)
 		}
 
-		// Always wrap in BackgroundSlideTransition to keep the widget tree stable.
+		// Always wrap in backgroundTransition to keep the widget tree stable.
 		// Passing nil animation makes it a no-op passthrough (no offset).
 		// This avoids element reconciliation destroying the subtree when
 		// the wrapper is conditionally added/removed.
-		child := BackgroundSlideTransition{
+		child := backgroundTransition{
 			Animation: bgAnimation,
+			Effect:    bgEffect,
 			Child: routeBuilder{
 				route: route,
 			},
@@ -396,7 +480,7 @@ func (s *navigatorState) Build(ctx core.BuildContext) core.Widget {
 
 	// Add exiting route on top (it's animating out).
 	// Use the same wrapper structure as active routes (ExcludeSemantics > Offstage >
-	// IgnorePointer > BackgroundSlideTransition > routeBuilder) so that element
+	// IgnorePointer > backgroundTransition > routeBuilder) so that element
 	// reconciliation reuses existing elements instead of destroying and recreating
 	// the entire render subtree. This prevents platform view lag during pop animations.
 	if s.exitingRoute != nil {
@@ -405,8 +489,8 @@ func (s *navigatorState) Build(ctx core.BuildContext) core.Widget {
 				Offstage: false, // visible during exit animation
 				Child: widgets.IgnorePointer{
 					Ignoring: true,
-					Child: BackgroundSlideTransition{
-						Animation: nil, // no background parallax for exiting route
+					Child: backgroundTransition{
+						Animation: nil, // no background effect for exiting route
 						Child: routeBuilder{
 							route: s.exitingRoute,
 						},
@@ -417,10 +501,13 @@ func (s *navigatorState) Build(ctx core.BuildContext) core.Widget {
 		})
 	}
 
-	// Build route stack
-	routeStack := widgets.Stack{
-		Children: children,
-		Fit:      widgets.StackFitExpand,
+	// Build route stack, poppable by an edge swipe
+	routeStack := swipeBackDetector{
+		state: s,
+		Child: widgets.Stack{
+			Children: children,
+			Fit:      widgets.StackFitExpand,
+		},
 	}
 
 	// Wrap in Overlay for modal routes support
@@ -451,12 +538,14 @@ func (s *navigatorState) Build(ctx core.BuildContext) core.Widget {
 
 func (s *navigatorState) Dispose() {
 	// Clean up animation listeners
+	s.heroes.endAllFlights()
 	s.clearPushListener()
 	s.clearExitingRoute()
 
-	// Dispose animation controllers for all remaining routes
+	// Dispose animation controllers and scopes for all remaining routes
 	for _, route := range s.routes {
-		disposeRouteController(route)
+		s.completeRoute(route, nil)
+		disposeRoute(route)
 	}
 
 	// Unsubscribe from RefreshListenable
@@ -490,7 +579,7 @@ func (s *navigatorState) clearExitingRoute() {
 		s.exitingUnsubscribe = nil
 	}
 	if s.exitingRoute != nil {
-		disposeRouteController(s.exitingRoute)
+		disposeRoute(s.exitingRoute)
 		s.exitingRoute = nil
 	}
 }
@@ -503,6 +592,19 @@ func (s *navigatorState) DidUpdateWidget(oldWidget core.StatefulWidget) {
 // If the route has a name and a Redirect callback is configured, the redirect
 // will be applied. Routes with empty Settings().Name skip redirect checks.
 func (s *navigatorState) Push(route Route) {
+	s.pushFor(route, nil)
+}
+
+// pushFor pushes route, handing its result to pushed.
+func (s *navigatorState) pushFor(route Route, pushed *pushResult) {
+	s.interceptRoute(NavigationPush, route, pushed, func(route Route) {
+		s.push(route, pushed)
+	})
+}
+
+// push applies redirects to route and pushes it, handing its result to
+// pushed.
+func (s *navigatorState) push(route Route, pushed *pushResult) {
 	fromPath := ""
 	if len(s.routes) > 0 {
 		fromPath = s.routes[len(s.routes)-1].Settings().Name
@@ -529,16 +631,16 @@ func (s *navigatorState) Push(route Route) {
 		}
 
 		if replace && len(s.routes) > 0 {
-			s.doPushReplacement(route)
+			s.doPushReplacement(route, pushed)
 			return
 		}
 	}
 
-	s.doPush(route)
+	s.doPush(route, pushed)
 }
 
 // doPush performs the actual push without redirect checks.
-func (s *navigatorState) doPush(route Route) {
+func (s *navigatorState) doPush(route Route, pushed *pushResult) {
 	s.SetState(func() {
 		var previousTop Route
 		if len(s.routes) > 0 {
@@ -546,6 +648,7 @@ func (s *navigatorState) doPush(route Route) {
 			previousTop.DidChangeNext(route)
 		}
 		s.routes = append(s.routes, route)
+		s.attachResult(route, pushed)
 
 		// Notify new route of its previous
 		route.DidChangePrevious(previousTop)
@@ -571,10 +674,36 @@ func (s *navigatorState) doPush(route Route) {
 			}
 		}
 
+		s.scheduleHeroFlights(previousTop, route)
+
 		// Notify observers
 		for _, observer := range s.navigator.Observers {
 			observer.DidPush(route, previousTop)
 		}
+		s.notifyTopRoute(previousTop)
+	})
+}
+
+// scheduleHeroFlights starts hero flights for a push once the new route has
+// been built and laid out, so its heroes are registered and measurable.
+func (s *navigatorState) scheduleHeroFlights(from, to Route) {
+	if from == nil || len(s.heroes.heroes) == 0 {
+		return
+	}
+	ar, ok := to.(AnimatedRoute)
+	if !ok {
+		return
+	}
+	fc := ar.ForegroundController()
+	if fc == nil || !fc.IsAnimating() {
+		return
+	}
+	platform.Dispatch(func() {
+		// Skip if the push was interrupted before the next frame.
+		if !fc.IsAnimating() || len(s.routes) == 0 || s.routes[len(s.routes)-1] != to {
+			return
+		}
+		s.heroes.startFlights(s.overlayState, from, to, fc, HeroFlightPush)
 	})
 }
 
@@ -592,6 +721,17 @@ func (s *navigatorState) routeFromName(name string, args any) Route {
 
 // PushNamed pushes a route by name, applying redirect if configured.
 func (s *navigatorState) PushNamed(name string, args any) {
+	s.pushNamedFor(name, args, nil)
+}
+
+// pushNamedFor pushes the route named name, handing its result to pushed.
+func (s *navigatorState) pushNamedFor(name string, args any, pushed *pushResult) {
+	s.intercept(NavigationPush, name, args, pushed, func(name string, args any, _ bool) {
+		s.pushNamed(name, args, pushed)
+	})
+}
+
+func (s *navigatorState) pushNamed(name string, args any, pushed *pushResult) {
 	fromPath := ""
 	if len(s.routes) > 0 {
 		fromPath = s.routes[len(s.routes)-1].Settings().Name
@@ -612,14 +752,20 @@ func (s *navigatorState) PushNamed(name string, args any) {
 	}
 
 	if replace && len(s.routes) > 0 {
-		s.doPushReplacement(route)
+		s.doPushReplacement(route, pushed)
 	} else {
-		s.doPush(route)
+		s.doPush(route, pushed)
 	}
 }
 
 // PushReplacementNamed replaces the current route, applying redirect if configured.
 func (s *navigatorState) PushReplacementNamed(name string, args any) {
+	s.intercept(NavigationReplace, name, args, nil, func(name string, args any, _ bool) {
+		s.pushReplacementNamed(name, args)
+	})
+}
+
+func (s *navigatorState) pushReplacementNamed(name string, args any) {
 	fromPath := ""
 	if len(s.routes) > 0 {
 		fromPath = s.routes[len(s.routes)-1].Settings().Name
@@ -635,7 +781,7 @@ func (s *navigatorState) PushReplacementNamed(name string, args any) {
 
 	route := s.routeFromName(finalPath, finalArgs)
 	if route != nil {
-		s.doPushReplacement(route)
+		s.doPushReplacement(route, nil)
 	}
 }
 
@@ -648,7 +794,30 @@ func (s *navigatorState) Pop(result any) {
 	if s.exitingRoute != nil {
 		return
 	}
+	if s.interceptPop(result) {
+		return
+	}
+	s.pop(result)
+}
 
+// interceptPop reports whether a PopScope in the top route blocks popping
+// it. The scope is given a function that pops the route anyway.
+func (s *navigatorState) interceptPop(result any) bool {
+	top := s.top()
+	scope := blockingPopScope(top)
+	if scope == nil {
+		return false
+	}
+	scope.popBlocked(func() {
+		if s.top() == top && len(s.routes) > 1 && s.exitingRoute == nil {
+			s.pop(result)
+		}
+	})
+	return true
+}
+
+// pop removes the current route without consulting PopScopes.
+func (s *navigatorState) pop(result any) {
 	s.SetState(func() {
 		s.clearPushListener()
 
@@ -660,10 +829,14 @@ func (s *navigatorState) Pop(result any) {
 
 		// Start the exit animation
 		popped.DidPop(result)
+		s.completeRoute(popped, result)
 
 		// Set up callback to remove route when animation completes
 		if ar, ok := popped.(AnimatedRoute); ok {
-			if fc := ar.ForegroundController(); fc != nil {
+			if fc := ar.ForegroundController(); fc != nil && fc.IsDismissed() {
+				// Already out of view, e.g. swiped fully off screen
+				s.clearExitingRoute()
+			} else if fc != nil {
 				s.exitingUnsubscribe = fc.AddStatusListener(func(status animation.AnimationStatus) {
 					if status == animation.AnimationDismissed {
 						s.SetState(func() {
@@ -684,6 +857,13 @@ func (s *navigatorState) Pop(result any) {
 			s.routes[len(s.routes)-1].DidChangeNext(nil)
 		}
 
+		// Fly shared heroes back while the popped route animates out
+		if s.exitingRoute != nil && len(s.routes) > 0 {
+			if fc := popped.(AnimatedRoute).ForegroundController(); fc.IsAnimating() {
+				s.heroes.startFlights(s.overlayState, popped, s.routes[len(s.routes)-1], fc, HeroFlightPop)
+			}
+		}
+
 		// Notify observers
 		var previousRoute Route
 		if len(s.routes) > 0 {
@@ -692,6 +872,7 @@ func (s *navigatorState) Pop(result any) {
 		for _, observer := range s.navigator.Observers {
 			observer.DidPop(popped, previousRoute)
 		}
+		s.notifyTopRoute(popped)
 	})
 }
 
@@ -700,7 +881,16 @@ func (s *navigatorState) Pop(result any) {
 // checked before removal - if WillPop returns false, the removal stops.
 // Observer DidRemove callbacks are fired for each removed route.
 func (s *navigatorState) PopUntil(predicate func(Route) bool) {
+	s.popUntil(predicate, nil)
+}
+
+// popUntil implements PopUntil. The PopScopes of allowed are not consulted,
+// so a blocking scope can resume the removal once it agrees to pop.
+func (s *navigatorState) popUntil(predicate func(Route) bool, allowed Route) {
+	var blocked *popScopeState
+	var blockedRoute Route
 	s.SetState(func() {
+		previousTop := s.top()
 		for len(s.routes) > 1 {
 			top := s.routes[len(s.routes)-1]
 			if predicate(top) {
@@ -710,6 +900,12 @@ func (s *navigatorState) PopUntil(predicate func(Route) bool) {
 			if !top.WillPop() {
 				break
 			}
+			if top != allowed {
+				if blocked = blockingPopScope(top); blocked != nil {
+					blockedRoute = top
+					break
+				}
+			}
 			s.routes = s.routes[:len(s.routes)-1]
 
 			// Fire lifecycle and observers
@@ -723,14 +919,91 @@ func (s *navigatorState) PopUntil(predicate func(Route) bool) {
 		if len(s.routes) > 0 {
 			s.routes[len(s.routes)-1].DidChangeNext(nil)
 		}
+		s.notifyTopRoute(previousTop)
 	})
+	if blocked != nil {
+		blocked.popBlocked(func() {
+			if s.top() == blockedRoute {
+				s.popUntil(predicate, blockedRoute)
+			}
+		})
+	}
+}
+
+// PopToRoot removes every route above the first.
+func (s *navigatorState) PopToRoot() {
+	s.PopUntil(func(Route) bool { return false })
+}
+
+// PushAndRemoveUntil pushes a route and removes the routes below it until
+// predicate returns true, applying redirect if configured.
+func (s *navigatorState) PushAndRemoveUntil(route Route, predicate func(Route) bool) {
+	s.interceptRoute(NavigationPushAndRemove, route, nil, func(route Route) {
+		s.pushAndRemoveUntil(route, predicate)
+	})
+}
+
+func (s *navigatorState) pushAndRemoveUntil(route Route, predicate func(Route) bool) {
+	if toPath := route.Settings().Name; toPath != "" && s.navigator.Redirect != nil {
+		fromPath := ""
+		if top := s.top(); top != nil {
+			fromPath = top.Settings().Name
+		}
+		finalPath, finalArgs, _, _ := s.applyRedirect(fromPath, toPath, route.Settings().Arguments)
+		if finalPath != toPath {
+			if route = s.routeFromName(finalPath, finalArgs); route == nil {
+				return
+			}
+		}
+	}
+	s.doPushAndRemoveUntil(route, predicate)
+}
+
+// PushNamedAndRemoveUntil pushes a route by name and removes the routes
+// below it until predicate returns true, applying redirect if configured.
+func (s *navigatorState) PushNamedAndRemoveUntil(name string, args any, predicate func(Route) bool) {
+	s.intercept(NavigationPushAndRemove, name, args, nil, func(name string, args any, _ bool) {
+		s.pushNamedAndRemoveUntil(name, args, predicate)
+	})
+}
+
+func (s *navigatorState) pushNamedAndRemoveUntil(name string, args any, predicate func(Route) bool) {
+	finalPath, finalArgs := name, args
+	if s.navigator.Redirect != nil {
+		fromPath := ""
+		if top := s.top(); top != nil {
+			fromPath = top.Settings().Name
+		}
+		finalPath, finalArgs, _, _ = s.applyRedirect(fromPath, name, args)
+	}
+	if route := s.routeFromName(finalPath, finalArgs); route != nil {
+		s.doPushAndRemoveUntil(route, predicate)
+	}
+}
+
+// doPushAndRemoveUntil removes routes from the top until predicate returns
+// true, then pushes route over those that remain.
+func (s *navigatorState) doPushAndRemoveUntil(route Route, predicate func(Route) bool) {
+	s.SetState(func() {
+		s.clearPushListener()
+		for len(s.routes) > 0 {
+			top := s.routes[len(s.routes)-1]
+			if predicate(top) {
+				break
+			}
+			s.routes = s.routes[:len(s.routes)-1]
+			s.removeRoute(top, s.top())
+		}
+	})
+	s.doPush(route, nil)
 }
 
 // removeRoute fires DidPop and observer callbacks for a removed route,
-// and disposes its animation controller.
+// and disposes its animation controller and scope.
 func (s *navigatorState) removeRoute(route Route, previousRoute Route) {
 	route.DidPop(nil)
-	disposeRouteController(route)
+	s.completeRoute(route, nil)
+	disposeRoute(route)
 	for _, observer := range s.navigator.Observers {
 		observer.DidRemove(route, previousRoute)
 	}
@@ -740,8 +1013,12 @@ func (s *navigatorState) removeRoute(route Route, previousRoute Route) {
 // If the route has a name and a Redirect callback is configured, the redirect
 // will be applied.
 func (s *navigatorState) PushReplacement(route Route) {
+	s.interceptRoute(NavigationReplace, route, nil, s.pushReplacement)
+}
+
+func (s *navigatorState) pushReplacement(route Route) {
 	if len(s.routes) == 0 {
-		s.Push(route)
+		s.push(route, nil)
 		return
 	}
 
@@ -766,13 +1043,13 @@ func (s *navigatorState) PushReplacement(route Route) {
 		}
 	}
 
-	s.doPushReplacement(route)
+	s.doPushReplacement(route, nil)
 }
 
 // doPushReplacement performs the actual replacement without redirect checks.
-func (s *navigatorState) doPushReplacement(route Route) {
+func (s *navigatorState) doPushReplacement(route Route, pushed *pushResult) {
 	if len(s.routes) == 0 {
-		s.doPush(route)
+		s.doPush(route, pushed)
 		return
 	}
 	s.SetState(func() {
@@ -785,8 +1062,10 @@ func (s *navigatorState) doPushReplacement(route Route) {
 		}
 
 		s.routes[len(s.routes)-1] = route
+		s.attachResult(route, pushed)
 		oldRoute.DidPop(nil)
-		disposeRouteController(oldRoute)
+		s.completeRoute(oldRoute, nil)
+		disposeRoute(oldRoute)
 
 		// Notify new route of previous
 		route.DidChangePrevious(previousOfOld)
@@ -802,9 +1081,43 @@ func (s *navigatorState) doPushReplacement(route Route) {
 		for _, observer := range s.navigator.Observers {
 			observer.DidReplace(route, oldRoute)
 		}
+		s.notifyTopRoute(oldRoute)
 	})
 }
 
+// isCovered reports whether a route has been pushed over the route hosting
+// this navigator in any enclosing navigator.
+func (s *navigatorState) isCovered() bool {
+	for nav := s; nav.hostNavigator != nil; nav = nav.hostNavigator {
+		if nav.hostRoute != nil && nav.hostNavigator.top() != nav.hostRoute {
+			return true
+		}
+	}
+	return false
+}
+
+// top returns the route on top of the stack, or nil if it is empty.
+func (s *navigatorState) top() Route {
+	if len(s.routes) == 0 {
+		return nil
+	}
+	return s.routes[len(s.routes)-1]
+}
+
+// notifyTopRoute tells observers implementing [TopRouteObserver] when the
+// top route is no longer previousTop.
+func (s *navigatorState) notifyTopRoute(previousTop Route) {
+	top := s.top()
+	if top == nil || top == previousTop {
+		return
+	}
+	for _, observer := range s.navigator.Observers {
+		if o, ok := observer.(TopRouteObserver); ok {
+			o.DidChangeTopRoute(top, previousTop)
+		}
+	}
+}
+
 // CanPop returns true if there are routes to pop.
 func (s *navigatorState) CanPop() bool {
 	return len(s.routes) > 1
@@ -819,10 +1132,23 @@ func (s *navigatorState) MaybePop(result any) bool {
 	if !top.WillPop() {
 		return false
 	}
-	s.Pop(result)
+	if s.exitingRoute == nil && !s.interceptPop(result) {
+		s.pop(result)
+	}
 	return true
 }
 
+// disposeRoute releases everything a removed route owns: its foreground
+// animation controller, its [RouteScope], and the overlay entries of a
+// [ModalRoute].
+func disposeRoute(route Route) {
+	disposeRouteController(route)
+	disposeRouteScope(route)
+	if mr, ok := route.(interface{ removeEntries() }); ok {
+		mr.removeEntries()
+	}
+}
+
 // disposeRouteController disposes the foreground animation controller of a
 // route if it implements AnimatedRoute.
 func disposeRouteController(route Route) {
@@ -844,7 +1170,7 @@ func (r routeBuilder) Key() any {
 }
 
 func (r routeBuilder) Build(ctx core.BuildContext) core.Widget {
-	return r.route.Build(ctx)
+	return withRouteScope(r.route, r.route.Build)
 }
 
 // navigatorInherited provides NavigatorState to descendants.
@@ -898,6 +1224,7 @@ type RedirectContext struct {
 //   - [NoRedirect] to allow navigation to proceed
 //   - [RedirectTo] to redirect to a different path
 //   - [RedirectWithArgs] to redirect with custom arguments
+//   - [RedirectAsync] to decide in the background
 type RedirectResult struct {
 	// Path is the redirect destination. Empty string means no redirect.
 	Path string
@@ -909,6 +1236,9 @@ type RedirectResult struct {
 	// Replace controls whether to replace the current route (true) or push (false).
 	// RedirectTo and RedirectWithArgs set this to true by default.
 	Replace bool
+
+	// pending delivers the result of an asynchronous redirect.
+	pending <-chan RedirectResult
 }
 
 // NoRedirect returns a result that allows navigation to proceed normally.
@@ -952,6 +1282,30 @@ func RedirectWithArgs(path string, args any) RedirectResult {
 	return RedirectResult{Path: path, Arguments: args, Replace: true}
 }
 
+// RedirectAsync creates a result that holds the navigation until a result
+// arrives on result, for redirects that do slow work such as validating a
+// session token. Closing result without sending allows the navigation.
+//
+// Only a [Router] waits for the result, showing [Router.PendingBuilder]
+// meanwhile; later redirects run once it arrives. Elsewhere, such as in a
+// [Navigator] or when RefreshListenable re-evaluates the current route, a
+// pending result is skipped as if it were [NoRedirect].
+//
+//	Redirect: func(ctx navigation.RedirectContext) navigation.RedirectResult {
+//	    result := make(chan navigation.RedirectResult, 1)
+//	    go func() {
+//	        if session.Validate() {
+//	            result <- navigation.NoRedirect()
+//	        } else {
+//	            result <- navigation.RedirectTo("/login")
+//	        }
+//	    }()
+//	    return navigation.RedirectAsync(result)
+//	}
+func RedirectAsync(result <-chan RedirectResult) RedirectResult {
+	return RedirectResult{pending: result}
+}
+
 const (
 	maxRedirects      = 10
 	redirectErrorPath = "/_redirect_error"
@@ -1032,6 +1386,6 @@ func (s *navigatorState) onRefresh() {
 	result := s.navigator.Redirect(ctx)
 
 	if result.Path != "" && result.Path != current.Settings().Name {
-		s.PushReplacementNamed(result.Path, result.Arguments)
+		s.pushReplacementNamed(result.Path, result.Arguments)
 	}
 }
@@ -1,9 +1,13 @@
 package navigation
 
 import (
+	"slices"
 	"testing"
+	"time"
 
 	"github.com/go-drift/drift/pkg/core"
+	drifttest "github.com/go-drift/drift/pkg/testing"
+	"github.com/go-drift/drift/pkg/widgets"
 )
 
 // mockNavigatorState implements NavigatorState for testing
@@ -13,13 +17,16 @@ type mockNavigatorState struct {
 	popResult    any
 }
 
-func (m *mockNavigatorState) Push(route Route)                           {}
-func (m *mockNavigatorState) PushNamed(name string, args any)            {}
-func (m *mockNavigatorState) PushReplacementNamed(name string, args any) {}
-func (m *mockNavigatorState) Pop(result any)                             { m.popCalled = true; m.popResult = result }
-func (m *mockNavigatorState) PopUntil(predicate func(Route) bool)        {}
-func (m *mockNavigatorState) PushReplacement(route Route)                {}
-func (m *mockNavigatorState) CanPop() bool                               { return m.canPopResult }
+func (m *mockNavigatorState) Push(route Route)                                      {}
+func (m *mockNavigatorState) PushNamed(name string, args any)                       {}
+func (m *mockNavigatorState) PushReplacementNamed(name string, args any)            {}
+func (m *mockNavigatorState) Pop(result any)                                        { m.popCalled = true; m.popResult = result }
+func (m *mockNavigatorState) PopUntil(predicate func(Route) bool)                   {}
+func (m *mockNavigatorState) PushReplacement(route Route)                           {}
+func (m *mockNavigatorState) PushAndRemoveUntil(Route, func(Route) bool)            {}
+func (m *mockNavigatorState) PushNamedAndRemoveUntil(string, any, func(Route) bool) {}
+func (m *mockNavigatorState) PopToRoot()                                            {}
+func (m *mockNavigatorState) CanPop() bool                                          { return m.canPopResult }
 func (m *mockNavigatorState) MaybePop(result any) bool {
 	if m.canPopResult {
 		m.popCalled = true
@@ -317,3 +324,64 @@ func TestBaseRoute_Defaults(t *testing.T) {
 	r.DidChangeNext(nil)
 	r.DidChangePrevious(nil)
 }
+
+func TestNavigator_PushAndRemoveUntil(t *testing.T) {
+	var events []string
+	record := func(kind string) func(a, b RouteSettings) {
+		return func(a, b RouteSettings) { events = append(events, kind+" "+a.Name) }
+	}
+	tester := drifttest.NewWidgetTesterWithT(t)
+	tester.PumpWidget(Navigator{
+		IsRoot:       true,
+		InitialRoute: "/",
+		OnGenerateRoute: func(settings RouteSettings) Route {
+			return NewPageRoute(func(core.BuildContext) core.Widget {
+				return widgets.SizedBox{}
+			}, settings)
+		},
+		Observers: []NavigatorObserver{&RouteObserver{OnPush: record("push"), OnRemove: record("remove")}},
+	})
+	nav := RootNavigator().(*navigatorState)
+	stack := func() []string {
+		var names []string
+		for _, r := range nav.routes {
+			names = append(names, r.Settings().Name)
+		}
+		return names
+	}
+
+	nav.PushNamed("/login", nil)
+	nav.PushNamed("/otp", nil)
+	tester.PumpAndSettle(time.Second)
+
+	// Keep the root, drop the login flow.
+	events = nil
+	nav.PushNamedAndRemoveUntil("/profile", nil, func(r Route) bool { return r.Settings().Name == "/" })
+	tester.PumpAndSettle(time.Second)
+	if got := stack(); !slices.Equal(got, []string{"/", "/profile"}) {
+		t.Errorf("stack: got %q, want [/ /profile]", got)
+	}
+	if want := []string{"remove /otp", "remove /login", "push /profile"}; !slices.Equal(events, want) {
+		t.Errorf("events: got %q, want %q", events, want)
+	}
+
+	// A predicate that never matches clears the history.
+	nav.PushAndRemoveUntil(NewPageRoute(func(core.BuildContext) core.Widget {
+		return widgets.SizedBox{}
+	}, RouteSettings{Name: "/home"}), func(Route) bool { return false })
+	tester.PumpAndSettle(time.Second)
+	if got := stack(); !slices.Equal(got, []string{"/home"}) {
+		t.Errorf("stack: got %q, want [/home]", got)
+	}
+	if nav.CanPop() {
+		t.Error("expected the new route to be the only route")
+	}
+
+	nav.PushNamed("/a", nil)
+	nav.PushNamed("/b", nil)
+	tester.PumpAndSettle(time.Second)
+	nav.PopToRoot()
+	if got := stack(); !slices.Equal(got, []string{"/home"}) {
+		t.Errorf("stack after PopToRoot: got %q, want [/home]", got)
+	}
+}
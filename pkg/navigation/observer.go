@@ -29,3 +29,93 @@ func (b *BaseNavigatorObserver) DidRemove(route, previousRoute Route) {}
 
 // DidReplace is a no-op.
 func (b *BaseNavigatorObserver) DidReplace(newRoute, oldRoute Route) {}
+
+// TopRouteObserver is an optional interface for a [NavigatorObserver] that
+// tracks which route is visible. The navigator calls DidChangeTopRoute once
+// the top of its stack settles on a different route: after the initial
+// route is pushed, and after each push, pop, replacement, or PopUntil.
+// previousTop is nil for the initial route.
+type TopRouteObserver interface {
+	DidChangeTopRoute(route, previousTop Route)
+}
+
+// RouteObserver is a [NavigatorObserver] built from callbacks that receive
+// route settings, for analytics and logging that identify screens by name.
+// Add it to a navigator or router once to track every screen:
+//
+//	navigation.Router{
+//	    Routes: routes,
+//	    Observers: []navigation.NavigatorObserver{
+//	        &navigation.RouteObserver{
+//	            OnScreenView: func(settings navigation.RouteSettings) {
+//	                analytics.LogScreenView(settings.Name)
+//	            },
+//	        },
+//	    },
+//	}
+//
+// Settings of a missing route, such as the route beneath the first one, are
+// zero. Nil callbacks are skipped.
+type RouteObserver struct {
+	// OnPush is called when a route is pushed over previous.
+	OnPush func(route, previous RouteSettings)
+
+	// OnPop is called when a route is popped, revealing previous.
+	OnPop func(route, previous RouteSettings)
+
+	// OnReplace is called when newRoute replaces oldRoute.
+	OnReplace func(newRoute, oldRoute RouteSettings)
+
+	// OnRemove is called when a route is removed without animation, such as
+	// by PopUntil.
+	OnRemove func(route, previous RouteSettings)
+
+	// OnScreenView is called when a route becomes the visible top route,
+	// including the initial route. It is called once per navigation, so a
+	// PopUntil that removes several routes reports only the route it stops
+	// at.
+	OnScreenView func(settings RouteSettings)
+}
+
+// DidPush calls OnPush.
+func (o *RouteObserver) DidPush(route, previousRoute Route) {
+	if o.OnPush != nil {
+		o.OnPush(settingsOf(route), settingsOf(previousRoute))
+	}
+}
+
+// DidPop calls OnPop.
+func (o *RouteObserver) DidPop(route, previousRoute Route) {
+	if o.OnPop != nil {
+		o.OnPop(settingsOf(route), settingsOf(previousRoute))
+	}
+}
+
+// DidRemove calls OnRemove.
+func (o *RouteObserver) DidRemove(route, previousRoute Route) {
+	if o.OnRemove != nil {
+		o.OnRemove(settingsOf(route), settingsOf(previousRoute))
+	}
+}
+
+// DidReplace calls OnReplace.
+func (o *RouteObserver) DidReplace(newRoute, oldRoute Route) {
+	if o.OnReplace != nil {
+		o.OnReplace(settingsOf(newRoute), settingsOf(oldRoute))
+	}
+}
+
+// DidChangeTopRoute calls OnScreenView.
+func (o *RouteObserver) DidChangeTopRoute(route, previousTop Route) {
+	if o.OnScreenView != nil {
+		o.OnScreenView(settingsOf(route))
+	}
+}
+
+// settingsOf returns the route's settings, or zero settings for nil.
+func settingsOf(route Route) RouteSettings {
+	if route == nil {
+		return RouteSettings{}
+	}
+	return route.Settings()
+}
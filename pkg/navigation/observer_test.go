@@ -0,0 +1,79 @@
+package navigation
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+	drifttest "github.com/go-drift/drift/pkg/testing"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+func TestRouteObserver_ReportsSettings(t *testing.T) {
+	var events []string
+	record := func(kind string) func(a, b RouteSettings) {
+		return func(a, b RouteSettings) { events = append(events, kind+" "+a.Name+" "+b.Name) }
+	}
+	o := &RouteObserver{
+		OnPush:    record("push"),
+		OnPop:     record("pop"),
+		OnRemove:  record("remove"),
+		OnReplace: record("replace"),
+	}
+	home := NewPageRoute(nil, RouteSettings{Name: "/"})
+	detail := NewPageRoute(nil, RouteSettings{Name: "/detail"})
+
+	o.DidPush(home, nil)
+	o.DidPush(detail, home)
+	o.DidReplace(home, detail)
+	o.DidRemove(detail, home)
+	o.DidPop(detail, home)
+
+	want := []string{"push / ", "push /detail /", "replace / /detail", "remove /detail /", "pop /detail /"}
+	if !slices.Equal(events, want) {
+		t.Errorf("got %q, want %q", events, want)
+	}
+
+	// Nil callbacks are skipped.
+	(&RouteObserver{}).DidPush(home, nil)
+}
+
+func TestNavigator_ScreenViews(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	tester.SetSize(graphics.Size{Width: 400, Height: 800})
+
+	var views []string
+	observer := &RouteObserver{
+		OnScreenView: func(settings RouteSettings) { views = append(views, settings.Name) },
+	}
+	tester.PumpWidget(Navigator{
+		IsRoot:       true,
+		InitialRoute: "/",
+		OnGenerateRoute: func(settings RouteSettings) Route {
+			return NewPageRoute(func(core.BuildContext) core.Widget {
+				return widgets.SizedBox{}
+			}, settings)
+		},
+		Observers: []NavigatorObserver{observer},
+	})
+	nav := RootNavigator()
+	if nav == nil {
+		t.Fatal("expected a root navigator")
+	}
+
+	nav.PushNamed("/a", nil)
+	nav.PushNamed("/b", nil)
+	nav.PushNamed("/c", nil)
+	tester.PumpAndSettle(time.Second)
+	nav.PopUntil(func(r Route) bool { return r.Settings().Name == "/a" })
+	nav.PushReplacementNamed("/d", nil)
+	nav.Pop(nil)
+	tester.PumpAndSettle(time.Second)
+
+	want := []string{"/", "/a", "/b", "/c", "/a", "/d", "/"}
+	if !slices.Equal(views, want) {
+		t.Errorf("got screen views %q, want %q", views, want)
+	}
+}
@@ -17,6 +17,27 @@ type AnimatedPageRoute struct {
 	// Builder creates the page content.
 	Builder func(ctx core.BuildContext) core.Widget
 
+	// Transition animates the page on push and pop. The zero value uses
+	// [CupertinoPageTransition].
+	Transition PageTransition
+
+	// TransitionDuration is the length of the push and pop animations.
+	// Zero uses [TransitionDuration].
+	TransitionDuration time.Duration
+
+	// ReverseTransitionDuration is the length of the pop animation. Zero
+	// uses TransitionDuration.
+	ReverseTransitionDuration time.Duration
+
+	// TransitionCurve eases the push and pop animations. Nil uses
+	// [animation.IOSNavigationCurve].
+	TransitionCurve func(float64) float64
+
+	// Transparent keeps the routes beneath visible while this route is on
+	// top, for pages that do not cover the whole screen or have see-through
+	// areas. The page must paint its own background where it is opaque.
+	Transparent bool
+
 	// foregroundController drives this route's own slide-in/slide-out animation.
 	foregroundController *animation.AnimationController
 
@@ -38,8 +59,20 @@ func (m *AnimatedPageRoute) ForegroundController() *animation.AnimationControlle
 	return m.foregroundController
 }
 
-// Build returns the page content wrapped in a foreground slide transition.
-// Background slide animation is handled by the navigator.
+// BackgroundTransition returns how the page beneath this route moves during
+// its transition. Satisfies the BackgroundTransitionRoute interface.
+func (m *AnimatedPageRoute) BackgroundTransition() BackgroundTransition {
+	return m.transition().Background
+}
+
+// CanSwipeBack reports whether the route's transition enables the edge
+// swipe. Satisfies the SwipeBackRoute interface.
+func (m *AnimatedPageRoute) CanSwipeBack() bool {
+	return m.foregroundController != nil && m.transition().SwipeBack
+}
+
+// Build returns the page content wrapped in the route's foreground
+// transition. The background transition is applied by the navigator.
 func (m *AnimatedPageRoute) Build(ctx core.BuildContext) core.Widget {
 	if m.Builder == nil {
 		return nil
@@ -47,24 +80,34 @@ func (m *AnimatedPageRoute) Build(ctx core.BuildContext) core.Widget {
 
 	content := m.Builder(ctx)
 
-	// Wrap in foreground slide transition if we have an animation
+	// Wrap in the foreground transition if we have an animation
 	if m.foregroundController != nil {
-		content = SlideTransition{
-			Animation: m.foregroundController,
-			Direction: SlideFromRight,
-			Child:     content,
-		}
+		content = m.transition().Builder(m.foregroundController, content)
 	}
 
 	return content
 }
 
+func (m *AnimatedPageRoute) transition() PageTransition {
+	if m.Transition.Builder == nil {
+		return CupertinoPageTransition()
+	}
+	return m.Transition
+}
+
 // DidPush is called when the route is pushed.
 func (m *AnimatedPageRoute) DidPush() {
 	// Only animate if not the initial route
 	if !m.isInitialRoute {
-		m.foregroundController = animation.NewAnimationController(TransitionDuration)
+		duration := m.TransitionDuration
+		if duration <= 0 {
+			duration = TransitionDuration
+		}
+		m.foregroundController = animation.NewAnimationController(duration)
 		m.foregroundController.Curve = animation.IOSNavigationCurve
+		if m.TransitionCurve != nil {
+			m.foregroundController.Curve = m.TransitionCurve
+		}
 		m.foregroundController.Forward()
 	}
 }
@@ -77,10 +120,19 @@ func (m *AnimatedPageRoute) SetInitialRoute() {
 // DidPop is called when the route is popped.
 func (m *AnimatedPageRoute) DidPop(result any) {
 	if m.foregroundController != nil {
+		if m.ReverseTransitionDuration > 0 {
+			m.foregroundController.Duration = m.ReverseTransitionDuration
+		}
 		m.foregroundController.Reverse()
 	}
 }
 
+// IsTransparent reports whether the routes beneath stay visible. Satisfies
+// the TransparentRoute interface.
+func (m *AnimatedPageRoute) IsTransparent() bool {
+	return m.Transparent
+}
+
 // PageRoute is a simpler route without transitions.
 type PageRoute struct {
 	BaseRoute
@@ -0,0 +1,79 @@
+package navigation
+
+import (
+	"github.com/go-drift/drift/pkg/animation"
+	"github.com/go-drift/drift/pkg/core"
+)
+
+// TransitionBuilder wraps a page in its entrance animation. The animation
+// runs from 0 to 1 as the page is pushed and back to 0 as it is popped.
+type TransitionBuilder func(animation *animation.AnimationController, child core.Widget) core.Widget
+
+// PageTransition describes how a route animates as it is pushed and popped:
+// how its own page enters and leaves, and how the page beneath it reacts.
+//
+// Use one of the built-in transitions, or supply a Builder for a custom one:
+//
+//	navigation.ScreenRoute{
+//	    Path:       "/settings",
+//	    Screen:     navigation.ScreenOnly(buildSettings),
+//	    Transition: navigation.FadePageTransition(),
+//	}
+//
+// The zero value uses [CupertinoPageTransition].
+type PageTransition struct {
+	// Builder wraps the route's page in its entrance animation.
+	Builder TransitionBuilder
+
+	// Background describes how the page beneath the route moves while the
+	// route animates. The zero value leaves it in place.
+	Background BackgroundTransition
+
+	// SwipeBack lets the user pop the route by dragging from the left edge
+	// of the screen, scrubbing the transition backward.
+	SwipeBack bool
+}
+
+// CupertinoPageTransition slides the page in from the right while the page
+// beneath shifts left by a third of its width, as on iOS. Dragging from the
+// left edge of the screen pops the page. This is the default transition.
+func CupertinoPageTransition() PageTransition {
+	return PageTransition{
+		Builder: func(animation *animation.AnimationController, child core.Widget) core.Widget {
+			return SlideTransition{Animation: animation, Direction: SlideFromRight, Child: child}
+		},
+		Background: BackgroundTransition{Offset: parallaxOffset},
+		SwipeBack:  true,
+	}
+}
+
+// SlidePageTransition slides the page in from direction over the page
+// beneath, which stays in place.
+func SlidePageTransition(direction SlideDirection) PageTransition {
+	return PageTransition{
+		Builder: func(animation *animation.AnimationController, child core.Widget) core.Widget {
+			return SlideTransition{Animation: animation, Direction: direction, Child: child}
+		},
+	}
+}
+
+// FadePageTransition fades the page in over the page beneath.
+func FadePageTransition() PageTransition {
+	return PageTransition{
+		Builder: func(animation *animation.AnimationController, child core.Widget) core.Widget {
+			return FadeTransition{Animation: animation, Child: child}
+		},
+	}
+}
+
+// FadeThroughPageTransition fades the page beneath out, then fades the new
+// page in while it scales up slightly. It suits switching between pages that
+// are not spatially related, such as top-level destinations.
+func FadeThroughPageTransition() PageTransition {
+	return PageTransition{
+		Builder: func(animation *animation.AnimationController, child core.Widget) core.Widget {
+			return FadeThroughTransition{Animation: animation, Child: child}
+		},
+		Background: BackgroundTransition{FadeOut: true},
+	}
+}
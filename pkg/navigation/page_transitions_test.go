@@ -0,0 +1,229 @@
+package navigation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-drift/drift/pkg/animation"
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+	drifttest "github.com/go-drift/drift/pkg/testing"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+func TestAnimatedPageRoute_DefaultTransition(t *testing.T) {
+	route := NewAnimatedPageRoute(stubBuilder, RouteSettings{Name: "/detail"})
+	route.DidPush()
+	defer route.ForegroundController().Dispose()
+
+	if d := route.ForegroundController().Duration; d != TransitionDuration {
+		t.Errorf("expected default duration %v, got %v", TransitionDuration, d)
+	}
+	if _, ok := route.Build(nil).(SlideTransition); !ok {
+		t.Errorf("expected default transition to slide, got %T", route.Build(nil))
+	}
+	if bg := route.BackgroundTransition(); bg.Offset != parallaxOffset {
+		t.Errorf("expected default background parallax, got %+v", bg)
+	}
+}
+
+func TestAnimatedPageRoute_CustomTransition(t *testing.T) {
+	route := NewAnimatedPageRoute(stubBuilder, RouteSettings{Name: "/detail"})
+	route.Transition = FadeThroughPageTransition()
+	route.TransitionDuration = 300 * time.Millisecond
+	route.DidPush()
+	defer route.ForegroundController().Dispose()
+
+	if d := route.ForegroundController().Duration; d != 300*time.Millisecond {
+		t.Errorf("expected custom duration, got %v", d)
+	}
+	if _, ok := route.Build(nil).(FadeThroughTransition); !ok {
+		t.Errorf("expected fade-through transition, got %T", route.Build(nil))
+	}
+	if bg := route.BackgroundTransition(); !bg.FadeOut || bg.Offset != (graphics.Offset{}) {
+		t.Errorf("expected fade-out background in place, got %+v", bg)
+	}
+}
+
+func TestBackgroundTransitionOf(t *testing.T) {
+	fade := NewAnimatedPageRoute(stubBuilder, RouteSettings{})
+	fade.Transition = FadePageTransition()
+	if bg := backgroundTransitionOf(fade); bg != (BackgroundTransition{}) {
+		t.Errorf("expected fade to leave the page beneath in place, got %+v", bg)
+	}
+	if bg := backgroundTransitionOf(NewPageRoute(stubBuilder, RouteSettings{})); bg.Offset != parallaxOffset {
+		t.Errorf("expected routes without a background transition to use parallax, got %+v", bg)
+	}
+}
+
+func TestRenderBackgroundTransition_Progress(t *testing.T) {
+	controller := animation.NewAnimationController(TransitionDuration)
+	defer controller.Dispose()
+	r := &renderBackgroundTransition{
+		transitionRenderBase: transitionRenderBase{animation: controller},
+		effect:               BackgroundTransition{Offset: graphics.Offset{X: -0.5, Y: 0.25}, FadeOut: true},
+	}
+	r.SetSelf(r)
+	r.SetSize(graphics.Size{Width: 200, Height: 400})
+
+	controller.Value = 0.15
+	if got := r.slideOffset(); got != (graphics.Offset{X: -15, Y: 15}) {
+		t.Errorf("unexpected offset %+v", got)
+	}
+	if got := r.opacity(); got < 0.49 || got > 0.51 {
+		t.Errorf("expected half faded at half the fade-out phase, got %v", got)
+	}
+	controller.Value = 0.5
+	if got := r.opacity(); got != 0 {
+		t.Errorf("expected fully faded after the fade-out phase, got %v", got)
+	}
+}
+
+func TestRenderBackgroundTransition_TranslationAndScale(t *testing.T) {
+	controller := animation.NewAnimationController(TransitionDuration)
+	defer controller.Dispose()
+	r := &renderBackgroundTransition{
+		transitionRenderBase: transitionRenderBase{animation: controller},
+		effect:               SharedAxisPageTransition(widgets.SharedAxisX).Background,
+	}
+	r.SetSelf(r)
+	r.SetSize(graphics.Size{Width: 200, Height: 400})
+
+	controller.Value = 0.5
+	if got := r.slideOffset(); got != (graphics.Offset{X: -15}) {
+		t.Errorf("expected the page beneath to move half of 30 pixels, got %+v", got)
+	}
+	r.effect = SharedAxisPageTransition(widgets.SharedAxisZ).Background
+	if got := r.scale(); got < 1.049 || got > 1.051 {
+		t.Errorf("expected the page beneath to grow by 5%%, got %v", got)
+	}
+}
+
+func TestSharedAxisPageTransition(t *testing.T) {
+	route := NewAnimatedPageRoute(stubBuilder, RouteSettings{})
+	route.Transition = SharedAxisPageTransition(widgets.SharedAxisY)
+	route.DidPush()
+	defer route.ForegroundController().Dispose()
+
+	built, ok := route.Build(nil).(SharedAxisTransition)
+	if !ok || built.Axis != widgets.SharedAxisY {
+		t.Fatalf("expected a Y-axis shared-axis transition, got %#v", route.Build(nil))
+	}
+	r := built.CreateRenderObject(nil).(*renderSharedAxisTransition)
+	defer r.Dispose()
+	route.ForegroundController().Value = 0.5
+	if got := r.slideOffset(); got != (graphics.Offset{Y: 15}) {
+		t.Errorf("expected the page to be halfway up, got %+v", got)
+	}
+	if bg := route.BackgroundTransition(); !bg.FadeOut || bg.Translation != (graphics.Offset{Y: -30}) {
+		t.Errorf("expected the page beneath to fade out moving up, got %+v", bg)
+	}
+}
+
+func TestContainerTransformPageTransition(t *testing.T) {
+	controller := animation.NewAnimationController(TransitionDuration)
+	defer controller.Dispose()
+	source := graphics.RectFromLTWH(20, 100, 160, 80)
+	transition := ContainerTransformPageTransition(source, 12, graphics.ColorWhite)
+
+	built, ok := transition.Builder(controller, nil).(ContainerTransformTransition)
+	if !ok || built.Source != source || built.BorderRadius != 12 || built.Color != graphics.ColorWhite {
+		t.Fatalf("expected a container transform from the source, got %#v", built)
+	}
+	if transition.Background != (BackgroundTransition{}) {
+		t.Errorf("expected the page beneath to stay in place, got %+v", transition.Background)
+	}
+}
+
+func TestRouter_GenerateRoute_Transition(t *testing.T) {
+	router := Router{
+		Routes: []ScreenRoute{
+			{Path: "/"},
+			{
+				Path:                      "/settings",
+				Screen:                    stubScreen,
+				Transition:                SlidePageTransition(SlideFromBottom),
+				TransitionDuration:        250 * time.Millisecond,
+				ReverseTransitionDuration: 100 * time.Millisecond,
+				TransitionCurve:           animation.EaseOut,
+				Transparent:               true,
+			},
+		},
+	}
+	state := &routerState{router: router}
+	state.routeIndex = state.buildRouteIndex()
+
+	route, ok := state.generateRoute(RouteSettings{Name: "/settings"}).(*AnimatedPageRoute)
+	if !ok {
+		t.Fatal("expected an AnimatedPageRoute")
+	}
+	if route.TransitionDuration != 250*time.Millisecond || route.Transition.Builder == nil {
+		t.Errorf("expected the screen route's transition, got %+v", route)
+	}
+	if route.ReverseTransitionDuration != 100*time.Millisecond || route.TransitionCurve == nil || !route.IsTransparent() {
+		t.Errorf("expected the screen route's reverse duration, curve and transparency, got %+v", route)
+	}
+}
+
+func TestAnimatedPageRoute_ReverseDurationAndCurve(t *testing.T) {
+	curveCalled := false
+	route := NewAnimatedPageRoute(stubBuilder, RouteSettings{Name: "/detail"})
+	route.TransitionDuration = 300 * time.Millisecond
+	route.ReverseTransitionDuration = 150 * time.Millisecond
+	route.TransitionCurve = func(t float64) float64 {
+		curveCalled = true
+		return t
+	}
+	route.DidPush()
+	fc := route.ForegroundController()
+	defer fc.Dispose()
+
+	if fc.Duration != 300*time.Millisecond {
+		t.Errorf("expected push duration 300ms, got %v", fc.Duration)
+	}
+	fc.Curve(0.5)
+	if !curveCalled {
+		t.Error("expected the route's curve on the controller")
+	}
+	route.DidPop(nil)
+	if fc.Duration != 150*time.Millisecond {
+		t.Errorf("expected pop duration 150ms, got %v", fc.Duration)
+	}
+}
+
+func TestNavigator_TransparentRoutesKeepRoutesBeneathVisible(t *testing.T) {
+	page := func(name string, transparent bool) Route {
+		route := NewAnimatedPageRoute(func(core.BuildContext) core.Widget {
+			return widgets.Text{Content: name}
+		}, RouteSettings{Name: "/" + name})
+		route.Transparent = transparent
+		return route
+	}
+	tester := drifttest.NewWidgetTesterWithT(t)
+	tester.PumpWidget(Navigator{
+		IsRoot:       true,
+		InitialRoute: "/base",
+		OnGenerateRoute: func(settings RouteSettings) Route {
+			return page("base", false)
+		},
+	})
+	nav := RootNavigator().(*navigatorState)
+	nav.Push(page("page", false))
+	nav.Push(page("card", true))
+	nav.Push(page("sheet", true))
+	tester.PumpAndSettle(time.Second)
+
+	visible := func(name string) bool {
+		offstage := tester.Find(drifttest.Ancestor(drifttest.ByText(name), drifttest.ByType[widgets.Offstage]())).All()
+		return !offstage[len(offstage)-1].Widget().(widgets.Offstage).Offstage
+	}
+	for name, want := range map[string]bool{"sheet": true, "card": true, "page": true, "base": false} {
+		if got := visible(name); got != want {
+			t.Errorf("%s visible: got %v, want %v", name, got, want)
+		}
+	}
+}
+
+func stubBuilder(core.BuildContext) core.Widget {
+	return nil
+}
@@ -0,0 +1,133 @@
+package navigation
+
+import (
+	"slices"
+
+	"github.com/go-drift/drift/pkg/core"
+)
+
+// PopScope intercepts attempts to pop the route it is built in: the Android
+// back button and back gesture, [NavigatorState.Pop],
+// [NavigatorState.MaybePop], and [NavigatorState.PopUntil]. While CanPop is
+// false, those pops are blocked and OnPopBlocked is called instead, which
+// makes it suited to asking before discarding unsaved changes. The edge
+// swipe of [SwipeBackRoute] routes is disabled instead, since the swipe
+// cannot be resumed later.
+//
+//	navigation.PopScope{
+//	    CanPop: !s.form.IsDirty(),
+//	    OnPopBlocked: func(pop func()) {
+//	        showDiscardDialog(ctx, func(discard bool) {
+//	            if discard {
+//	                pop()
+//	            }
+//	        })
+//	    },
+//	    Child: editor,
+//	}
+//
+// Replacing the route is not a pop and is never blocked. A PopScope in the
+// first route of a navigator has no effect, since that route cannot be
+// popped.
+type PopScope struct {
+	core.StatefulBase
+
+	// CanPop allows the route to pop. When false, pops are blocked.
+	CanPop bool
+
+	// OnPopBlocked is called when a pop is blocked because CanPop is false.
+	// Call pop to complete the pop anyway, for example once the user
+	// confirms; it may be called later, such as from a dialog's callback,
+	// and does nothing if the route is no longer on top by then.
+	OnPopBlocked func(pop func())
+
+	// Child is the content of the scope.
+	Child core.Widget
+}
+
+func (p PopScope) CreateState() core.State {
+	return &popScopeState{}
+}
+
+type popScopeState struct {
+	core.StateBase
+	route      popScopedRoute
+	unregister func()
+}
+
+func (s *popScopeState) InitState() {
+	s.OnDispose(func() {
+		s.register(nil)
+	})
+}
+
+func (s *popScopeState) Build(ctx core.BuildContext) core.Widget {
+	route, _ := routeOf(ctx).(popScopedRoute)
+	if route != s.route {
+		s.register(route)
+	}
+	return s.Element().Widget().(PopScope).Child
+}
+
+func (s *popScopeState) register(route popScopedRoute) {
+	if s.unregister != nil {
+		s.unregister()
+		s.unregister = nil
+	}
+	s.route = route
+	if route != nil {
+		s.unregister = route.addPopScope(s)
+	}
+}
+
+// blocks reports whether the scope's widget currently blocks pops.
+func (s *popScopeState) blocks() bool {
+	return !s.Element().Widget().(PopScope).CanPop
+}
+
+// popScopedRoute is implemented by routes that track the [PopScope] widgets
+// built in them. [BaseRoute] implements it, so all built-in routes do.
+type popScopedRoute interface {
+	Route
+	addPopScope(scope *popScopeState) func()
+	popScopeList() []*popScopeState
+}
+
+// addPopScope registers a PopScope built in the route. Returns a function
+// that unregisters it.
+func (r *BaseRoute) addPopScope(scope *popScopeState) func() {
+	r.popScopes = append(r.popScopes, scope)
+	return func() {
+		r.popScopes = slices.DeleteFunc(r.popScopes, func(s *popScopeState) bool {
+			return s == scope
+		})
+	}
+}
+
+func (r *BaseRoute) popScopeList() []*popScopeState {
+	return r.popScopes
+}
+
+// blockingPopScope returns the PopScope in route that blocks popping it,
+// or nil. Scopes register as they build, parents first, so the innermost
+// blocking scope is the last one registered.
+func blockingPopScope(route Route) *popScopeState {
+	scoped, ok := route.(popScopedRoute)
+	if !ok {
+		return nil
+	}
+	scopes := scoped.popScopeList()
+	for i := len(scopes) - 1; i >= 0; i-- {
+		if scopes[i].blocks() {
+			return scopes[i]
+		}
+	}
+	return nil
+}
+
+// popBlocked calls OnPopBlocked with pop, which completes the pop.
+func (s *popScopeState) popBlocked(pop func()) {
+	if onBlocked := s.Element().Widget().(PopScope).OnPopBlocked; onBlocked != nil {
+		onBlocked(pop)
+	}
+}
@@ -0,0 +1,102 @@
+package navigation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+	drifttest "github.com/go-drift/drift/pkg/testing"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+// pumpPopScopeNavigator shows a navigator whose "/edit" route holds a
+// PopScope that blocks pops and records the pop functions it is given.
+func pumpPopScopeNavigator(t *testing.T, pops *[]func()) (*drifttest.WidgetTester, NavigatorState) {
+	t.Helper()
+	tester := drifttest.NewWidgetTesterWithT(t)
+	tester.SetSize(graphics.Size{Width: 400, Height: 800})
+	tester.PumpWidget(Navigator{
+		IsRoot:       true,
+		InitialRoute: "/",
+		OnGenerateRoute: func(settings RouteSettings) Route {
+			return NewPageRoute(func(core.BuildContext) core.Widget {
+				if settings.Name != "/edit" {
+					return widgets.SizedBox{}
+				}
+				return PopScope{
+					OnPopBlocked: func(pop func()) { *pops = append(*pops, pop) },
+					Child:        widgets.SizedBox{},
+				}
+			}, settings)
+		},
+	})
+	nav := RootNavigator()
+	if nav == nil {
+		t.Fatal("expected a root navigator")
+	}
+	return tester, nav
+}
+
+func topName(nav NavigatorState) string {
+	return nav.(*navigatorState).top().Settings().Name
+}
+
+func TestPopScope_BlocksBackButtonAndPop(t *testing.T) {
+	var pops []func()
+	tester, nav := pumpPopScopeNavigator(t, &pops)
+
+	nav.PushNamed("/edit", nil)
+	tester.PumpAndSettle(time.Second)
+
+	if !HandleBackButton() {
+		t.Error("expected the back press to be handled by the PopScope")
+	}
+	nav.Pop(nil)
+	tester.PumpAndSettle(time.Second)
+	if got := topName(nav); got != "/edit" {
+		t.Fatalf("expected /edit to stay on top, got %q", got)
+	}
+	if len(pops) != 2 {
+		t.Fatalf("expected OnPopBlocked twice, got %d", len(pops))
+	}
+
+	// Confirming pops the route.
+	pops[0]()
+	tester.PumpAndSettle(time.Second)
+	if got := topName(nav); got != "/" {
+		t.Errorf("expected / on top after confirming, got %q", got)
+	}
+
+	// A stale pop function does nothing once the route is gone.
+	pops[1]()
+	tester.PumpAndSettle(time.Second)
+	if got := topName(nav); got != "/" {
+		t.Errorf("expected stale pop to do nothing, got %q on top", got)
+	}
+}
+
+func TestPopScope_PopUntilResumes(t *testing.T) {
+	var pops []func()
+	tester, nav := pumpPopScopeNavigator(t, &pops)
+
+	nav.PushNamed("/a", nil)
+	nav.PushNamed("/edit", nil)
+	nav.PushNamed("/b", nil)
+	tester.PumpAndSettle(time.Second)
+
+	nav.PopUntil(func(r Route) bool { return r.Settings().Name == "/" })
+	tester.PumpAndSettle(time.Second)
+	if got := topName(nav); got != "/edit" {
+		t.Fatalf("expected PopUntil to stop at /edit, got %q", got)
+	}
+	if len(pops) != 1 {
+		t.Fatalf("expected OnPopBlocked once, got %d", len(pops))
+	}
+
+	pops[0]()
+	tester.PumpAndSettle(time.Second)
+	if got := topName(nav); got != "/" {
+		t.Errorf("expected PopUntil to resume down to /, got %q", got)
+	}
+}
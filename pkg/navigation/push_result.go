@@ -0,0 +1,143 @@
+package navigation
+
+import (
+	"sync"
+
+	"github.com/go-drift/drift/pkg/core"
+)
+
+// Push navigates to path and returns a buffered channel (size 1) that
+// receives the result the pushed route is popped with. It navigates with
+// the nearest [Router] (see [RouterState.Go]), or the nearest [Navigator]
+// if there is no Router.
+//
+// The channel is closed once the route is gone. If the route was popped
+// with a result of type T, the result is sent first; a route dismissed with
+// the back button, popped with another result type, removed by PopUntil, or
+// replaced closes the channel without a value. If nothing was pushed, for
+// example because no route matches path, the channel is closed at once.
+//
+// Call Push on the UI thread and receive from the channel in a goroutine,
+// returning to the UI thread to use the result:
+//
+//	ch := navigation.Push[Color](ctx, "/color-picker", nil)
+//	go func() {
+//	    if color, ok := <-ch; ok {
+//	        drift.Dispatch(func() {
+//	            s.SetState(func() { s.color = color })
+//	        })
+//	    }
+//	}()
+//
+// The picker screen returns its selection by popping with it:
+//
+//	navigation.NavigatorOf(ctx).Pop(selected) // selected is a Color
+func Push[T any](ctx core.BuildContext, path string, args any) <-chan T {
+	result := make(chan T, 1)
+	var once sync.Once
+	complete := func(value any) {
+		once.Do(func() {
+			if v, ok := value.(T); ok {
+				result <- v
+			}
+			close(result)
+		})
+	}
+
+	pushed := &pushResult{complete: complete}
+	if router := RouterOf(ctx); router != nil {
+		goForResult(router, path, args, pushed)
+	} else if nav := NavigatorOf(ctx); nav != nil {
+		pushNamedForResult(nav, path, args, pushed)
+	} else {
+		pushed.finish()
+	}
+	return result
+}
+
+// pushResult carries the result receiver of a [Push] or [ShowDialog] along
+// the push, to the route that is finally pushed.
+type pushResult struct {
+	complete func(any)
+	attached bool
+}
+
+// finish completes the receiver without a value if no route took it, as
+// when the push was cancelled or no route matched. It is safe on nil.
+func (r *pushResult) finish() {
+	if r != nil && !r.attached {
+		r.attached = true
+		r.complete(nil)
+	}
+}
+
+// goForResult navigates router to path, handing the pushed route's result
+// to pushed.
+func goForResult(router RouterState, path string, args any, pushed *pushResult) {
+	if rs, ok := router.(*routerState); ok {
+		rs.goFor(path, args, pushed)
+		return
+	}
+	router.Go(path, args)
+	pushed.finish()
+}
+
+// pushNamedForResult pushes the route named name onto nav, handing its
+// result to pushed.
+func pushNamedForResult(nav NavigatorState, name string, args any, pushed *pushResult) {
+	switch nav := nav.(type) {
+	case *navigatorState:
+		nav.pushNamedFor(name, args, pushed)
+	case *routerState:
+		if root := RootNavigator(); root != NavigatorState(nav) {
+			pushNamedForResult(root, name, args, pushed)
+			return
+		}
+		pushed.finish()
+	case nil:
+		pushed.finish()
+	default:
+		nav.PushNamed(name, args)
+		pushed.finish()
+	}
+}
+
+// pushForResult pushes route onto nav, handing its result to pushed.
+func pushForResult(nav NavigatorState, route Route, pushed *pushResult) {
+	switch nav := nav.(type) {
+	case *navigatorState:
+		nav.pushFor(route, pushed)
+	case *routerState:
+		if root := RootNavigator(); root != NavigatorState(nav) {
+			pushForResult(root, route, pushed)
+			return
+		}
+		pushed.finish()
+	case nil:
+		pushed.finish()
+	default:
+		nav.Push(route)
+		pushed.finish()
+	}
+}
+
+// attachResult hands the [Push] result receiver to route.
+func (s *navigatorState) attachResult(route Route, pushed *pushResult) {
+	if pushed == nil || pushed.attached {
+		return
+	}
+	pushed.attached = true
+	if s.results == nil {
+		s.results = make(map[Route]func(any))
+	}
+	s.results[route] = pushed.complete
+}
+
+// completeRoute delivers the result of a route leaving the stack to the
+// [Push] call that pushed it, if any.
+func (s *navigatorState) completeRoute(route Route, result any) {
+	if complete, ok := s.results[route]; ok {
+		delete(s.results, route)
+		complete(result)
+	}
+}
@@ -0,0 +1,115 @@
+package navigation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-drift/drift/pkg/core"
+	drifttest "github.com/go-drift/drift/pkg/testing"
+)
+
+// pumpResultNavigator shows a navigator with "/" and "/picker" routes and
+// returns the tester, the navigator, and the context of the "/" page.
+func pumpResultNavigator(t *testing.T) (*drifttest.WidgetTester, *navigatorState, core.BuildContext) {
+	t.Helper()
+	var homeCtx core.BuildContext
+	tester := drifttest.NewWidgetTesterWithT(t)
+	tester.PumpWidget(Navigator{
+		IsRoot:       true,
+		InitialRoute: "/",
+		OnGenerateRoute: func(settings RouteSettings) Route {
+			switch settings.Name {
+			case "/":
+				return NewAnimatedPageRoute(func(ctx core.BuildContext) core.Widget {
+					homeCtx = ctx
+					return nil
+				}, settings)
+			case "/picker":
+				return NewAnimatedPageRoute(func(core.BuildContext) core.Widget { return nil }, settings)
+			}
+			return nil
+		},
+	})
+	return tester, RootNavigator().(*navigatorState), homeCtx
+}
+
+func TestPush_ReceivesTypedResult(t *testing.T) {
+	tester, nav, ctx := pumpResultNavigator(t)
+
+	ch := Push[string](ctx, "/picker", nil)
+	tester.PumpAndSettle(time.Second)
+	if got := nav.top().Settings().Name; got != "/picker" {
+		t.Fatalf("expected /picker on top, got %q", got)
+	}
+
+	nav.Pop("red")
+	select {
+	case color, ok := <-ch:
+		if !ok || color != "red" {
+			t.Errorf("expected result red, got %q (ok %v)", color, ok)
+		}
+	default:
+		t.Fatal("expected the result to be sent when the route is popped")
+	}
+	if _, ok := <-ch; ok {
+		t.Error("expected the channel to be closed after the result")
+	}
+}
+
+func TestPush_ClosesWithoutTypedResult(t *testing.T) {
+	tester, nav, ctx := pumpResultNavigator(t)
+
+	// Popped with a result of another type, such as the back button's nil.
+	ch := Push[int](ctx, "/picker", nil)
+	tester.PumpAndSettle(time.Second)
+	nav.Pop(nil)
+	if v, ok := <-ch; ok {
+		t.Errorf("expected the channel closed without a value, got %v", v)
+	}
+
+	// Removed by PopUntil.
+	ch = Push[int](ctx, "/picker", nil)
+	tester.PumpAndSettle(time.Second)
+	nav.PopUntil(func(r Route) bool { return r.Settings().Name == "/" })
+	if v, ok := <-ch; ok {
+		t.Errorf("expected the channel closed after PopUntil, got %v", v)
+	}
+
+	// Nothing matches the path.
+	ch = Push[int](ctx, "/missing", nil)
+	if v, ok := <-ch; ok {
+		t.Errorf("expected the channel closed when nothing is pushed, got %v", v)
+	}
+}
+
+func TestPush_HeldPushKeepsItsResult(t *testing.T) {
+	var resume func(MiddlewareResult)
+	tester, nav := pumpRouter(t, middlewareRouter(func(n Navigation) MiddlewareResult {
+		if n.Settings.Name != "/beta" {
+			return Proceed()
+		}
+		return Delay(func(r func(MiddlewareResult)) {
+			resume = r
+		})
+	}))
+
+	held := Push[string](nav.Element(), "/beta", nil)
+	other := Push[string](nav.Element(), "/products/1", nil)
+	tester.PumpAndSettle(time.Second)
+	nav.Pop("product")
+	if got, ok := <-other; !ok || got != "product" {
+		t.Errorf("expected the unheld push's result, got %q (ok %v)", got, ok)
+	}
+	select {
+	case got := <-held:
+		t.Fatalf("expected the held push to keep waiting, got %q", got)
+	default:
+	}
+
+	resume(Proceed())
+	tester.PumpAndSettle(time.Second)
+	nav.Pop("beta")
+	if got, ok := <-held; !ok || got != "beta" {
+		t.Errorf("expected the held push's result, got %q (ok %v)", got, ok)
+	}
+}
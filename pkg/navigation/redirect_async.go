@@ -0,0 +1,147 @@
+package navigation
+
+import (
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/platform"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+// resolveRedirects runs the redirects of a navigation from fromPath to
+// settings, hop by hop as the navigator will, waiting for asynchronous
+// results. Each hop's result is stored in resolved by path for the
+// navigator's redirect pass. It then calls done with the final path, or
+// cancel if the router is disposed while waiting.
+func (s *routerState) resolveRedirects(resolved map[string]RedirectResult, fromPath string, settings RouteSettings, done func(path string), cancel func()) {
+	seen := map[string]bool{}
+	var hop func(path string, args any)
+	hop = func(path string, args any) {
+		// The navigator reports loops and chains that are too long
+		if seen[path] || len(seen) == maxRedirects {
+			done(path)
+			return
+		}
+		seen[path] = true
+		ctx := RedirectContext{FromPath: fromPath, ToPath: path, Arguments: args}
+		s.resolveRedirect(ctx, func(result RedirectResult) {
+			resolved[path] = result
+			if result.Path == "" || result.Path == path {
+				done(path)
+				return
+			}
+			hop(result.Path, result.Arguments)
+		}, cancel)
+	}
+	hop(settings.Name, settings.Arguments)
+}
+
+// resolveRedirect runs the redirects for a single hop in order, waiting for
+// asynchronous results, and calls done with the first redirect or
+// [NoRedirect].
+func (s *routerState) resolveRedirect(ctx RedirectContext, done func(RedirectResult), cancel func()) {
+	redirects := s.redirectsFor(ctx.ToPath)
+
+	var step func(i int)
+	var apply func(i int, result RedirectResult)
+	step = func(i int) {
+		if i == len(redirects) {
+			done(NoRedirect())
+			return
+		}
+		apply(i, redirects[i](ctx))
+	}
+	apply = func(i int, result RedirectResult) {
+		switch {
+		case result.pending != nil:
+			s.awaitRedirect(ctx.ToPath, result.pending, func(resolved RedirectResult) {
+				apply(i, resolved)
+			}, cancel)
+		case result.Path != "":
+			done(result)
+		default:
+			step(i + 1)
+		}
+	}
+	step(0)
+}
+
+// awaitRedirect waits for an asynchronous redirect result on pending and
+// calls resolved with it on the UI thread. PendingBuilder is shown meanwhile.
+func (s *routerState) awaitRedirect(path string, pending <-chan RedirectResult, resolved func(RedirectResult), cancel func()) {
+	s.setPendingRedirects(s.pendingRedirects+1, path)
+	go func() {
+		// A closed channel yields NoRedirect
+		result := <-pending
+		platform.Dispatch(func() {
+			if s.IsDisposed() {
+				if cancel != nil {
+					cancel()
+				}
+				return
+			}
+			s.setPendingRedirects(s.pendingRedirects-1, path)
+			resolved(result)
+		})
+	}()
+}
+
+func (s *routerState) setPendingRedirects(count int, path string) {
+	if s.router.PendingBuilder == nil {
+		s.pendingRedirects = count
+		return
+	}
+	s.SetState(func() {
+		s.pendingRedirects = count
+		s.pendingSettings = s.resolveSettings(RouteSettings{Name: path})
+	})
+}
+
+// resolveInitialRedirects resolves the redirects of the initial routes as
+// the navigator will apply them: each from the route below, ending the stack
+// at the first redirected route. It reports whether they resolved without
+// waiting; otherwise the router rebuilds once they have.
+func (s *routerState) resolveInitialRedirects(stack []RouteSettings) bool {
+	if s.resolvingInitial {
+		return false
+	}
+	s.resolvingInitial = true
+	waited := false
+	resolved := map[string]RedirectResult{}
+
+	var entry func(i int, fromPath string)
+	entry = func(i int, fromPath string) {
+		if i < len(stack) {
+			s.resolveRedirects(resolved, fromPath, stack[i], func(path string) {
+				if path != stack[i].Name {
+					entry(len(stack), "")
+					return
+				}
+				entry(i+1, path)
+			}, nil)
+			return
+		}
+		s.resolvedRedirects = resolved
+		if waited {
+			s.SetState(func() {
+				s.resolvingInitial, s.initialResolved = false, true
+			})
+		} else {
+			s.resolvingInitial, s.initialResolved = false, true
+		}
+	}
+	entry(0, "")
+	waited = true
+	return s.initialResolved
+}
+
+// buildPending shows the PendingBuilder widget over child while an
+// asynchronous redirect resolves.
+func (s *routerState) buildPending(ctx core.BuildContext, child core.Widget) core.Widget {
+	if s.router.PendingBuilder == nil {
+		return child
+	}
+	children := []core.Widget{widgets.IgnorePointer{Ignoring: s.pendingRedirects > 0, Child: child}}
+	if s.pendingRedirects > 0 {
+		children = append(children, s.router.PendingBuilder(ctx, s.pendingSettings))
+	}
+	return widgets.Stack{Fit: widgets.StackFitExpand, Children: children}
+}
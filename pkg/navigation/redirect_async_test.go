@@ -0,0 +1,170 @@
+package navigation
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/go-drift/drift/pkg/core"
+	drifttest "github.com/go-drift/drift/pkg/testing"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+func pendingWidget(ctx core.BuildContext, settings RouteSettings) core.Widget {
+	return widgets.Text{Content: "checking " + settings.Name}
+}
+
+func TestRouter_RedirectAsync_HoldsNavigation(t *testing.T) {
+	results := make(chan RedirectResult, 1)
+	tester, nav := pumpRouter(t, Router{
+		InitialPath:    "/",
+		PendingBuilder: pendingWidget,
+		Routes: []ScreenRoute{
+			{Path: "/", Screen: stubScreen},
+			{Path: "/login", Screen: stubScreen},
+			{
+				Path:   "/account/:id",
+				Screen: stubScreen,
+				Redirect: func(ctx RedirectContext) RedirectResult {
+					return RedirectAsync(results)
+				},
+			},
+		},
+	})
+
+	ch := Push[string](nav.Element(), "/account/7", nil)
+	tester.Pump()
+	if got := stackNames(nav); !slices.Equal(got, []string{"/"}) {
+		t.Fatalf("expected the navigation to be held, got %v", got)
+	}
+	if !tester.Find(drifttest.ByText("checking /account/7")).Exists() {
+		t.Error("expected the pending widget while the redirect resolves")
+	}
+
+	results <- NoRedirect()
+	if err := tester.PumpUntil(func() bool { return len(nav.routes) == 2 }, time.Second); err != nil {
+		t.Fatal(err)
+	}
+	if got := nav.top().Settings(); got.Name != "/account/7" || got.Param("id") != "7" {
+		t.Errorf("expected the navigation to proceed, got %+v", got)
+	}
+	if tester.Find(drifttest.ByText("checking /account/7")).Exists() {
+		t.Error("expected the pending widget removed once resolved")
+	}
+	nav.Pop("done")
+	if got, ok := <-ch; !ok || got != "done" {
+		t.Errorf("expected the pushed route's result, got %q (ok %v)", got, ok)
+	}
+
+	tester.PumpAndSettle(time.Second)
+	results <- RedirectTo("/login")
+	nav.PushNamed("/account/8", nil)
+	if err := tester.PumpUntil(func() bool { return nav.top().Settings().Name != "/" }, time.Second); err != nil {
+		t.Fatal(err)
+	}
+	if got := stackNames(nav); !slices.Equal(got, []string{"/login"}) {
+		t.Errorf("expected the asynchronous redirect to apply, got %v", got)
+	}
+}
+
+func TestRouter_RedirectAsync_InitialRoute(t *testing.T) {
+	results := make(chan RedirectResult)
+	tester := drifttest.NewWidgetTesterWithT(t)
+	tester.PumpWidget(Router{
+		InitialPath:    "/home",
+		PendingBuilder: pendingWidget,
+		Redirect: func(ctx RedirectContext) RedirectResult {
+			if ctx.ToPath == "/login" {
+				return NoRedirect()
+			}
+			return RedirectAsync(results)
+		},
+		Routes: []ScreenRoute{
+			{Path: "/home", Screen: stubScreen},
+			{Path: "/login", Screen: stubScreen},
+		},
+	})
+	if RootNavigator() != nil {
+		t.Fatal("expected the navigator held back while the redirect resolves")
+	}
+	if !tester.Find(drifttest.ByText("checking /home")).Exists() {
+		t.Error("expected the pending widget before the first route")
+	}
+
+	go func() { results <- RedirectTo("/login") }()
+	if err := tester.PumpUntil(func() bool { return RootNavigator() != nil }, time.Second); err != nil {
+		t.Fatal(err)
+	}
+	if got := stackNames(RootNavigator().(*navigatorState)); !slices.Equal(got, []string{"/login"}) {
+		t.Errorf("expected the redirected initial route, got %v", got)
+	}
+}
+
+func TestRouter_RedirectAsync_RunsGuardsInOrder(t *testing.T) {
+	results := make(chan RedirectResult)
+	var order []string
+	tester, nav := pumpRouter(t, Router{
+		InitialPath: "/",
+		Redirect: func(ctx RedirectContext) RedirectResult {
+			order = append(order, "router "+ctx.ToPath)
+			if ctx.ToPath == "/admin" {
+				return RedirectAsync(results)
+			}
+			return NoRedirect()
+		},
+		Routes: []ScreenRoute{
+			{Path: "/", Screen: stubScreen},
+			{Path: "/denied", Screen: stubScreen},
+			{
+				Path:   "/admin",
+				Screen: stubScreen,
+				Redirect: func(ctx RedirectContext) RedirectResult {
+					order = append(order, "route "+ctx.ToPath)
+					return RedirectTo("/denied")
+				},
+			},
+		},
+	})
+	order = nil
+
+	nav.PushNamed("/admin", nil)
+	if !slices.Equal(order, []string{"router /admin"}) {
+		t.Fatalf("expected later redirects to wait, got %v", order)
+	}
+
+	// A closed channel lets the navigation continue to the next redirect
+	close(results)
+	if err := tester.PumpUntil(func() bool { return nav.top().Settings().Name != "/" }, time.Second); err != nil {
+		t.Fatal(err)
+	}
+	if got := nav.top().Settings().Name; got != "/denied" {
+		t.Errorf("expected the route redirect to apply, got %q", got)
+	}
+	want := []string{"router /admin", "route /admin", "router /denied"}
+	if !slices.Equal(order, want) {
+		t.Errorf("expected each redirect to run once, got %v, want %v", order, want)
+	}
+}
+
+func TestNavigator_RedirectAsync_Skipped(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	tester.PumpWidget(Navigator{
+		IsRoot:       true,
+		InitialRoute: "/",
+		OnGenerateRoute: func(settings RouteSettings) Route {
+			return NewPageRoute(func(core.BuildContext) core.Widget {
+				return widgets.SizedBox{}
+			}, settings)
+		},
+		Redirect: func(ctx RedirectContext) RedirectResult {
+			return RedirectAsync(make(chan RedirectResult))
+		},
+	})
+	tester.PumpAndSettle(time.Second)
+	nav := RootNavigator().(*navigatorState)
+
+	nav.PushNamed("/next", nil)
+	if got := nav.top().Settings().Name; got != "/next" {
+		t.Errorf("expected a pending redirect to be skipped, got %q", got)
+	}
+}
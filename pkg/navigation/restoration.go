@@ -0,0 +1,155 @@
+package navigation
+
+import (
+	"encoding/json"
+	"slices"
+
+	"github.com/go-drift/drift/pkg/platform"
+)
+
+// routerRestorationKey is the [platform.Restoration] key of the router's
+// saved navigation state.
+const routerRestorationKey = "drift.router"
+
+// savedState is the navigation state a [Router] saves for restoration.
+type savedState struct {
+	Routes []savedRoute        `json:"routes"`
+	Shells map[int]*savedShell `json:"shells,omitempty"` // by shellIndex.id
+}
+
+// savedRoute is a route in a saved navigation stack.
+type savedRoute struct {
+	Path string `json:"path"`
+	Args any    `json:"args,omitempty"`
+}
+
+// savedShell is the state of a mounted stateful shell.
+type savedShell struct {
+	Index    int            `json:"index"`
+	Branches [][]savedRoute `json:"branches"`
+}
+
+// restorationObserver saves the router's state after every navigation.
+type restorationObserver struct {
+	router *routerState
+}
+
+func (o *restorationObserver) DidPush(route, previousRoute Route)   { o.router.scheduleSave() }
+func (o *restorationObserver) DidPop(route, previousRoute Route)    { o.router.scheduleSave() }
+func (o *restorationObserver) DidRemove(route, previousRoute Route) { o.router.scheduleSave() }
+func (o *restorationObserver) DidReplace(newRoute, oldRoute Route)  { o.router.scheduleSave() }
+
+// loadRestoredState reads the state saved by the app's previous process.
+func (s *routerState) loadRestoredState() {
+	s.restoration = &restorationObserver{router: s}
+	data, err := platform.Restoration.Restored(routerRestorationKey)
+	if err != nil || data == "" {
+		return
+	}
+	var state savedState
+	if json.Unmarshal([]byte(data), &state) != nil || len(state.Routes) == 0 {
+		return
+	}
+	s.restored = &state
+	s.savedData = data
+}
+
+// restoredStack returns the saved root stack to show in place of the initial
+// path, or nil. It is handed out once.
+func (s *routerState) restoredStack() []RouteSettings {
+	if s.restored == nil || s.restored.Routes == nil {
+		return nil
+	}
+	stack := savedStack(s.restored.Routes)
+	s.restored.Routes = nil
+	return stack
+}
+
+// takeRestoredShell returns the saved state of the shell with the given id,
+// or nil. Each saved shell is handed to the first shell mounted with its id.
+func (s *routerState) takeRestoredShell(id int) *savedShell {
+	if s.restored == nil {
+		return nil
+	}
+	shell := s.restored.Shells[id]
+	delete(s.restored.Shells, id)
+	return shell
+}
+
+// scheduleSave saves the navigation state once the current change settles,
+// coalescing the many callbacks of a single navigation.
+func (s *routerState) scheduleSave() {
+	if s.savePending {
+		return
+	}
+	s.savePending = true
+	if !platform.Dispatch(s.saveState) {
+		s.saveState()
+	}
+}
+
+// saveState saves the root stack and the branch stacks of its shells.
+func (s *routerState) saveState() {
+	s.savePending = false
+	root, ok := RootNavigator().(*navigatorState)
+	if s.IsDisposed() || !ok {
+		return
+	}
+
+	state := savedState{Routes: savedRoutes(root.routes)}
+	for _, shell := range s.shells {
+		if !slices.Contains(root.routes, shell.route) {
+			continue
+		}
+		id := shell.widget.shell.id
+		if state.Shells == nil {
+			state.Shells = map[int]*savedShell{}
+		}
+		if _, ok := state.Shells[id]; ok {
+			continue
+		}
+		saved := &savedShell{Index: shell.index, Branches: make([][]savedRoute, len(shell.navigators))}
+		for i, nav := range shell.navigators {
+			if ns, ok := nav.(*navigatorState); ok {
+				saved.Branches[i] = savedRoutes(ns.routes)
+			}
+		}
+		state.Shells[id] = saved
+	}
+
+	data, err := json.Marshal(state)
+	if err != nil || string(data) == s.savedData {
+		return
+	}
+	s.savedData = string(data)
+	platform.Restoration.Save(routerRestorationKey, s.savedData)
+}
+
+// savedRoutes returns the restorable routes of a stack. Routes pushed without
+// a path are skipped, and arguments that do not encode as JSON are dropped.
+func savedRoutes(routes []Route) []savedRoute {
+	saved := make([]savedRoute, 0, len(routes))
+	for _, route := range routes {
+		settings := route.Settings()
+		if settings.Name == "" {
+			continue
+		}
+		entry := savedRoute{Path: settings.Name}
+		if settings.Arguments != nil {
+			if _, err := json.Marshal(settings.Arguments); err == nil {
+				entry.Args = settings.Arguments
+			}
+		}
+		saved = append(saved, entry)
+	}
+	return saved
+}
+
+// savedStack converts a saved stack to route settings.
+func savedStack(saved []savedRoute) []RouteSettings {
+	stack := make([]RouteSettings, len(saved))
+	for i, entry := range saved {
+		stack[i] = RouteSettings{Name: entry.Path, Arguments: entry.Args}
+	}
+	return stack
+}
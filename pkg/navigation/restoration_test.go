@@ -0,0 +1,106 @@
+package navigation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-drift/drift/pkg/platform"
+)
+
+// restorationBridge stands in for the native drift/restoration channel.
+type restorationBridge struct {
+	restored map[string]any // handed to the new process
+	saved    map[string]string
+}
+
+func (b *restorationBridge) InvokeMethod(ctx context.Context, channel, method string, argsData []byte) ([]byte, error) {
+	if channel != "drift/restoration" {
+		return platform.DefaultCodec.Encode(nil)
+	}
+	switch method {
+	case "getRestored":
+		return platform.DefaultCodec.Encode(b.restored)
+	case "save":
+		args, _ := platform.DefaultCodec.Decode(argsData)
+		m := args.(map[string]any)
+		b.saved[m["key"].(string)] = m["value"].(string)
+	}
+	return platform.DefaultCodec.Encode(nil)
+}
+
+func (b *restorationBridge) StartEventStream(channel string) error { return nil }
+func (b *restorationBridge) StopEventStream(channel string) error  { return nil }
+
+// useRestorationBridge starts a process with the given restored state.
+func useRestorationBridge(t *testing.T, restored map[string]any) *restorationBridge {
+	t.Helper()
+	platform.ResetForTest()
+	bridge := &restorationBridge{restored: restored, saved: map[string]string{}}
+	platform.SetNativeBridge(bridge)
+	t.Cleanup(platform.ResetForTest)
+	return bridge
+}
+
+func TestRouter_RestoresNavigationState(t *testing.T) {
+	var router RouterState
+	var shell ShellState
+	bridge := useRestorationBridge(t, nil)
+	tester, _ := pumpRouter(t, Router{InitialPath: "/feed", RestoreState: true, Routes: shellRoutes(&router, &shell)})
+	router.Go("/feed/42", nil)
+	tester.PumpAndSettle(time.Second)
+	router.Go("/profile", nil)
+	tester.PumpAndSettle(time.Second)
+	router.Go("/settings", map[string]any{"section": "privacy"})
+	tester.PumpAndSettle(time.Second)
+
+	saved := bridge.saved[routerRestorationKey]
+	if saved == "" {
+		t.Fatal("expected the navigation state to be saved")
+	}
+
+	// A new process restores the saved state.
+	bridge = useRestorationBridge(t, map[string]any{routerRestorationKey: saved})
+	_, root := pumpRouter(t, Router{InitialPath: "/feed", RestoreState: true, Routes: shellRoutes(&router, &shell)})
+
+	var names []string
+	for _, route := range root.routes {
+		names = append(names, route.Settings().Name)
+	}
+	if len(names) != 2 || names[0] != "/feed" || names[1] != "/settings" {
+		t.Fatalf("expected the root stack [/feed /settings], got %v", names)
+	}
+	args, _ := root.top().Settings().Arguments.(map[string]any)
+	if args["section"] != "privacy" {
+		t.Errorf("expected the route arguments to be restored, got %v", root.top().Settings().Arguments)
+	}
+	if shell.CurrentIndex() != 1 {
+		t.Errorf("expected the profile branch to be active, got %d", shell.CurrentIndex())
+	}
+	if got := branchTop(t, shell, 0); got != "/feed/42" {
+		t.Errorf("expected the feed branch stack to be restored, got %q", got)
+	}
+	if len(bridge.saved) != 0 {
+		t.Errorf("expected the unchanged restored state not to be saved again, got %v", bridge.saved)
+	}
+}
+
+func TestRouter_RestoredStackIsRedirected(t *testing.T) {
+	state := `{"routes":[{"path":"/feed"},{"path":"/settings"}]}`
+	useRestorationBridge(t, map[string]any{routerRestorationKey: state})
+	_, root := pumpRouter(t, Router{
+		InitialPath:  "/feed",
+		RestoreState: true,
+		Redirect: func(ctx RedirectContext) RedirectResult {
+			if ctx.ToPath == "/settings" {
+				return RedirectTo("/login")
+			}
+			return NoRedirect()
+		},
+		Routes: stubRoutes("/feed", "/settings", "/login"),
+	})
+
+	if len(root.routes) != 2 || root.top().Settings().Name != "/login" {
+		t.Errorf("expected the redirect to replace the restored /settings, got top %q", root.top().Settings().Name)
+	}
+}
@@ -163,6 +163,23 @@ type AnimatedRoute interface {
 	ForegroundController() *animation.AnimationController
 }
 
+// BackgroundTransitionRoute is implemented by animated routes that control
+// how the route beneath them moves during their transition. Animated routes
+// that don't implement it shift the route beneath left, as on iOS.
+type BackgroundTransitionRoute interface {
+	AnimatedRoute
+	BackgroundTransition() BackgroundTransition
+}
+
+// SwipeBackRoute is implemented by animated routes that the user can pop by
+// dragging from the left edge of the screen, as on iOS. While the drag lasts
+// it drives the route's foreground controller.
+type SwipeBackRoute interface {
+	AnimatedRoute
+	// CanSwipeBack reports whether the edge swipe is enabled for the route.
+	CanSwipeBack() bool
+}
+
 // TransparentRoute is implemented by routes that should keep previous routes visible.
 // Routes like bottom sheets and dialogs that have semi-transparent barriers
 // should implement this and return true from IsTransparent.
@@ -174,7 +191,9 @@ type TransparentRoute interface {
 
 // BaseRoute provides a default implementation of Route lifecycle methods.
 type BaseRoute struct {
-	settings RouteSettings
+	settings  RouteSettings
+	scope     *RouteScope
+	popScopes []*popScopeState
 }
 
 // NewBaseRoute creates a BaseRoute with the given settings.
@@ -0,0 +1,305 @@
+package navigation
+
+import (
+	"slices"
+
+	"github.com/go-drift/drift/pkg/platform"
+)
+
+// RouteInformation is a location in the app, as shown in a browser's
+// address bar.
+type RouteInformation struct {
+	// Location is the route path with its query, such as "/products/42?tab=reviews".
+	Location string
+
+	// State is data stored with the location, such as a browser history
+	// entry's state. The default parser passes it as the route's arguments.
+	State any
+}
+
+// RouteInformationProvider connects a [Router] to the platform's notion of
+// the current location, such as a browser's address bar and history.
+//
+// The router shows the provider's location on start, navigates whenever the
+// provider notifies its listeners, and reports every location it shows back
+// with Report. Providers must notify listeners on the UI thread.
+type RouteInformationProvider interface {
+	// Value returns the current location.
+	Value() RouteInformation
+
+	// AddListener registers a listener called when the platform changes the
+	// location, such as when the user edits the address bar or presses the
+	// browser's back button. It returns a function that removes the listener.
+	AddListener(listener func()) func()
+
+	// Report is called by the router when it shows a new location. Replace
+	// is true when the location replaces the current one, as after a pop or
+	// a redirect, rather than being pushed onto the history. Report must not
+	// notify listeners.
+	Report(info RouteInformation, replace bool)
+}
+
+// RouteInformationParser converts between [RouteInformation] and the
+// [RouteSettings] a [Router] navigates with.
+type RouteInformationParser interface {
+	// ParseRouteInformation returns the route to show for a location. Return
+	// false to ignore the location.
+	ParseRouteInformation(info RouteInformation) (RouteSettings, bool)
+
+	// RestoreRouteInformation returns the location to report for a route.
+	RestoreRouteInformation(settings RouteSettings) RouteInformation
+}
+
+// DefaultRouteInformationParser uses the location as the route path and the
+// state as the route's arguments.
+type DefaultRouteInformationParser struct{}
+
+// ParseRouteInformation returns the route for info, or false if its
+// location is empty.
+func (DefaultRouteInformationParser) ParseRouteInformation(info RouteInformation) (RouteSettings, bool) {
+	if info.Location == "" {
+		return RouteSettings{}, false
+	}
+	return RouteSettings{Name: info.Location, Arguments: info.State}, true
+}
+
+// RestoreRouteInformation returns the route's path and arguments.
+func (DefaultRouteInformationParser) RestoreRouteInformation(settings RouteSettings) RouteInformation {
+	return RouteInformation{Location: settings.Name, State: settings.Arguments}
+}
+
+// MemoryRouteInformationProvider is a [RouteInformationProvider] that keeps
+// a browser-style history in memory. It is useful for driving a [Router]
+// from strings in tests, and as a starting point for embedders:
+//
+//	history := navigation.NewMemoryRouteInformationProvider("/")
+//	tester.PumpWidget(navigation.Router{RouteInformationProvider: history, Routes: routes})
+//
+//	history.Go("/products/42") // as if typed into the address bar
+//	history.Back()             // as if the browser's back button was pressed
+//
+// Its methods must be called on the UI thread.
+type MemoryRouteInformationProvider struct {
+	history   []RouteInformation
+	index     int
+	listeners map[int]func()
+	nextID    int
+}
+
+// NewMemoryRouteInformationProvider creates a provider whose history holds
+// the given location.
+func NewMemoryRouteInformationProvider(location string) *MemoryRouteInformationProvider {
+	return &MemoryRouteInformationProvider{
+		history: []RouteInformation{{Location: location}},
+	}
+}
+
+// Value returns the current history entry.
+func (p *MemoryRouteInformationProvider) Value() RouteInformation {
+	return p.history[p.index]
+}
+
+// AddListener registers a listener called when Go, Back, or Forward changes
+// the location.
+func (p *MemoryRouteInformationProvider) AddListener(listener func()) func() {
+	if p.listeners == nil {
+		p.listeners = map[int]func(){}
+	}
+	id := p.nextID
+	p.nextID++
+	p.listeners[id] = listener
+	return func() {
+		delete(p.listeners, id)
+	}
+}
+
+// Report records a location shown by the router. A pushed location
+// discards the forward history. A replacing location that matches the
+// previous entry moves back to it, as when the app pops a route.
+func (p *MemoryRouteInformationProvider) Report(info RouteInformation, replace bool) {
+	switch {
+	case !replace:
+		p.history = append(p.history[:p.index+1], info)
+		p.index++
+	case p.index > 0 && p.history[p.index-1].Location == info.Location:
+		p.index--
+		p.history[p.index] = info
+	default:
+		p.history[p.index] = info
+	}
+}
+
+// Go pushes location onto the history and notifies listeners, as when the
+// user enters it in the address bar.
+func (p *MemoryRouteInformationProvider) Go(location string) {
+	p.history = append(p.history[:p.index+1], RouteInformation{Location: location})
+	p.index++
+	p.notify()
+}
+
+// CanGoBack reports whether there is an entry before the current one.
+func (p *MemoryRouteInformationProvider) CanGoBack() bool {
+	return p.index > 0
+}
+
+// CanGoForward reports whether there is an entry after the current one.
+func (p *MemoryRouteInformationProvider) CanGoForward() bool {
+	return p.index < len(p.history)-1
+}
+
+// Back moves to the previous entry and notifies listeners. It returns false
+// if there is no previous entry.
+func (p *MemoryRouteInformationProvider) Back() bool {
+	if !p.CanGoBack() {
+		return false
+	}
+	p.index--
+	p.notify()
+	return true
+}
+
+// Forward moves to the next entry and notifies listeners. It returns false
+// if there is no next entry.
+func (p *MemoryRouteInformationProvider) Forward() bool {
+	if !p.CanGoForward() {
+		return false
+	}
+	p.index++
+	p.notify()
+	return true
+}
+
+// notify calls the listeners.
+func (p *MemoryRouteInformationProvider) notify() {
+	for _, listener := range p.listeners {
+		listener()
+	}
+}
+
+// informationObserver reports the router's location after every navigation.
+type informationObserver struct {
+	router *routerState
+}
+
+func (o *informationObserver) DidPush(route, previousRoute Route) {
+	// The first route of a navigator is its initial location, not a push
+	o.router.scheduleReport(previousRoute != nil)
+}
+func (o *informationObserver) DidPop(route, previousRoute Route)    { o.router.scheduleReport(false) }
+func (o *informationObserver) DidRemove(route, previousRoute Route) { o.router.scheduleReport(false) }
+func (o *informationObserver) DidReplace(newRoute, oldRoute Route)  { o.router.scheduleReport(false) }
+
+// startRouteInformation reads the provider's location to show first and
+// navigates when the provider changes it.
+func (s *routerState) startRouteInformation() {
+	provider := s.router.RouteInformationProvider
+	s.information = &informationObserver{router: s}
+	info := provider.Value()
+	s.reported = info.Location
+	if settings, ok := s.informationParser().ParseRouteInformation(info); ok {
+		s.initialRoute = &settings
+	}
+	s.OnDispose(provider.AddListener(func() {
+		s.openRouteInformation(provider.Value())
+	}))
+}
+
+// informationParser returns the router's parser, or the default parser.
+func (s *routerState) informationParser() RouteInformationParser {
+	if s.router.RouteInformationParser != nil {
+		return s.router.RouteInformationParser
+	}
+	return DefaultRouteInformationParser{}
+}
+
+// openRouteInformation shows the location reported by the provider. A
+// location already in the visible stack is popped back to, as for the
+// browser's back button; any other location is navigated to with Go.
+func (s *routerState) openRouteInformation(info RouteInformation) {
+	if s.IsDisposed() {
+		return
+	}
+	settings, ok := s.informationParser().ParseRouteInformation(info)
+	if !ok {
+		return
+	}
+	s.reported = info.Location
+	if current := s.currentRoute(); current != nil && current.Settings().Name == settings.Name {
+		return
+	}
+
+	named := func(r Route) bool { return r.Settings().Name == settings.Name }
+	var navs []NavigatorState
+	if shell := s.visibleShell(nil); shell != nil {
+		navs = append(navs, shell.activeNavigator())
+	}
+	navs = append(navs, RootNavigator())
+	for _, nav := range navs {
+		ns, ok := nav.(*navigatorState)
+		if !ok {
+			continue
+		}
+		if i := slices.IndexFunc(ns.routes, named); i >= 0 && i < len(ns.routes)-1 {
+			ns.PopUntil(named)
+			s.reportPush = false
+			return
+		}
+	}
+	s.Go(settings.Name, settings.Arguments)
+	// The provider already holds the location; redirects replace it
+	s.reportPush = false
+}
+
+// scheduleReport reports the router's location once the current navigation
+// settles, coalescing the many callbacks of a single navigation. The report
+// replaces the current location unless any of the callbacks was a push.
+func (s *routerState) scheduleReport(push bool) {
+	s.reportPush = s.reportPush || push
+	if s.reportPending {
+		return
+	}
+	s.reportPending = true
+	if !platform.Dispatch(s.reportLocation) {
+		s.reportLocation()
+	}
+}
+
+// reportLocation reports the location of the visible route to the provider
+// if it changed.
+func (s *routerState) reportLocation() {
+	push := s.reportPush
+	s.reportPending, s.reportPush = false, false
+	route := s.currentRoute()
+	if s.IsDisposed() || route == nil {
+		return
+	}
+	info := s.informationParser().RestoreRouteInformation(route.Settings())
+	if info.Location == s.reported {
+		return
+	}
+	s.reported = info.Location
+	s.router.RouteInformationProvider.Report(info, !push)
+}
+
+// currentRoute returns the top route with a path in the active branch of
+// the visible shell, or in the root navigator. Routes pushed without a path,
+// such as dialogs, do not change the location.
+func (s *routerState) currentRoute() Route {
+	var navs []NavigatorState
+	if shell := s.visibleShell(nil); shell != nil {
+		navs = append(navs, shell.activeNavigator())
+	}
+	navs = append(navs, RootNavigator())
+	for _, nav := range navs {
+		ns, ok := nav.(*navigatorState)
+		if !ok {
+			continue
+		}
+		for i := len(ns.routes) - 1; i >= 0; i-- {
+			if ns.routes[i].Settings().Name != "" {
+				return ns.routes[i]
+			}
+		}
+	}
+	return nil
+}
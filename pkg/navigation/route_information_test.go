@@ -0,0 +1,142 @@
+package navigation
+
+import (
+	"testing"
+	"time"
+)
+
+func stackNames(nav *navigatorState) []string {
+	var names []string
+	for _, route := range nav.routes {
+		names = append(names, route.Settings().Name)
+	}
+	return names
+}
+
+func TestRouter_RouteInformation_DrivesNavigation(t *testing.T) {
+	history := NewMemoryRouteInformationProvider("/products/1")
+	tester, nav := pumpRouter(t, Router{
+		InitialPath:              "/",
+		RouteInformationProvider: history,
+		Routes:                   stubRoutes("/", "/products/:id", "/settings", "/login"),
+	})
+
+	if got := nav.top().Settings().Name; got != "/products/1" {
+		t.Fatalf("expected the provider's location to replace the initial path, got %q", got)
+	}
+
+	history.Go("/settings")
+	tester.PumpAndSettle(time.Second)
+	if got := stackNames(nav); len(got) != 2 || got[1] != "/settings" {
+		t.Fatalf("expected /settings to be pushed, got %v", got)
+	}
+
+	history.Back()
+	tester.PumpAndSettle(time.Second)
+	if got := stackNames(nav); len(got) != 1 || got[0] != "/products/1" {
+		t.Fatalf("expected back to pop /settings, got %v", got)
+	}
+	if !history.CanGoForward() {
+		t.Error("expected the forward entry to be kept")
+	}
+
+	history.Forward()
+	tester.PumpAndSettle(time.Second)
+	if got := nav.top().Settings().Name; got != "/settings" {
+		t.Errorf("expected forward to show /settings, got %q", got)
+	}
+}
+
+func TestRouter_RouteInformation_ReportsLocations(t *testing.T) {
+	history := NewMemoryRouteInformationProvider("/")
+	tester, nav := pumpRouter(t, Router{
+		InitialPath:              "/",
+		RouteInformationProvider: history,
+		Routes:                   stubRoutes("/", "/products/:id", "/settings", "/login"),
+	})
+	router := RouterOf(nav.Element())
+
+	router.Go("/products/7", nil)
+	tester.PumpAndSettle(time.Second)
+	if got := history.Value().Location; got != "/products/7" {
+		t.Fatalf("expected the pushed location to be reported, got %q", got)
+	}
+	if !history.CanGoBack() {
+		t.Fatal("expected the push to add a history entry")
+	}
+
+	router.Pop(nil)
+	tester.PumpAndSettle(time.Second)
+	if got := history.Value().Location; got != "/" {
+		t.Errorf("expected the pop to move back to /, got %q", got)
+	}
+	if history.CanGoBack() || !history.CanGoForward() {
+		t.Error("expected the pop to step back through the history")
+	}
+}
+
+func TestRouter_RouteInformation_RedirectReplacesLocation(t *testing.T) {
+	history := NewMemoryRouteInformationProvider("/settings")
+	_, nav := pumpRouter(t, Router{
+		InitialPath:              "/",
+		RouteInformationProvider: history,
+		Redirect: func(ctx RedirectContext) RedirectResult {
+			if ctx.ToPath == "/settings" {
+				return RedirectTo("/login")
+			}
+			return NoRedirect()
+		},
+		Routes: stubRoutes("/", "/products/:id", "/settings", "/login"),
+	})
+
+	if got := nav.top().Settings().Name; got != "/login" {
+		t.Fatalf("expected the redirect to apply, got %q", got)
+	}
+	if got := history.Value().Location; got != "/login" {
+		t.Errorf("expected the redirected location to be reported, got %q", got)
+	}
+	if history.CanGoBack() {
+		t.Error("expected the redirect to replace the location")
+	}
+}
+
+type prefixParser struct{}
+
+func (prefixParser) ParseRouteInformation(info RouteInformation) (RouteSettings, bool) {
+	if len(info.Location) < len("/app") || info.Location[:len("/app")] != "/app" {
+		return RouteSettings{}, false
+	}
+	path := info.Location[len("/app"):]
+	if path == "" {
+		path = "/"
+	}
+	return RouteSettings{Name: path}, true
+}
+
+func (prefixParser) RestoreRouteInformation(settings RouteSettings) RouteInformation {
+	return RouteInformation{Location: "/app" + settings.Name}
+}
+
+func TestRouter_RouteInformation_CustomParser(t *testing.T) {
+	history := NewMemoryRouteInformationProvider("/app/settings")
+	tester, nav := pumpRouter(t, Router{
+		RouteInformationProvider: history,
+		RouteInformationParser:   prefixParser{},
+		Routes:                   stubRoutes("/", "/settings", "/products/:id"),
+	})
+	if got := nav.top().Settings().Name; got != "/settings" {
+		t.Fatalf("expected the parsed route, got %q", got)
+	}
+
+	history.Go("/elsewhere")
+	tester.PumpAndSettle(time.Second)
+	if len(nav.routes) != 1 {
+		t.Errorf("expected an unparsed location to be ignored, got %v", stackNames(nav))
+	}
+
+	RouterOf(nav.Element()).Go("/products/3", nil)
+	tester.PumpAndSettle(time.Second)
+	if got := history.Value().Location; got != "/app/products/3" {
+		t.Errorf("expected the restored location, got %q", got)
+	}
+}
@@ -0,0 +1,228 @@
+package navigation
+
+import (
+	"reflect"
+	"sync"
+
+	"github.com/go-drift/drift/pkg/core"
+)
+
+// RouteScope holds objects whose lifetime is tied to a route, such as a
+// screen's view-model, its text editing controllers, or media controllers.
+// When the navigator removes the route (after its pop transition finishes, or
+// immediately for replacements and PopUntil), the scope is disposed and every
+// registered cleanup runs in reverse registration order.
+//
+// Every route embedding [BaseRoute] has a scope. Access it from the route's
+// widgets with [RouteScopeOf], or create scoped objects directly with
+// [Scoped]:
+//
+//	func buildProfile(ctx core.BuildContext, settings navigation.RouteSettings) core.Widget {
+//	    vm := navigation.Scoped(ctx, "profile", func() *ProfileViewModel {
+//	        return NewProfileViewModel(settings.Param("id"))
+//	    })
+//	    return ProfileScreen{ViewModel: vm}
+//	}
+//
+// RouteScope is safe for concurrent use, but cleanups always run on the UI
+// thread when the route is removed.
+type RouteScope struct {
+	mu        sync.Mutex
+	values    map[scopeKey]any
+	disposers []func()
+	disposed  bool
+}
+
+// scopeKey identifies a value in a RouteScope by its type and caller key, so
+// the same key may hold values of different types.
+type scopeKey struct {
+	typ reflect.Type
+	key any
+}
+
+// ScopedRoute is implemented by routes that own a [RouteScope].
+// [BaseRoute] implements it, so all built-in routes are scoped.
+type ScopedRoute interface {
+	Route
+	// Scope returns the route's scope, creating it on first use.
+	Scope() *RouteScope
+}
+
+// Scope returns the route's [RouteScope], creating it on first use.
+func (r *BaseRoute) Scope() *RouteScope {
+	if r.scope == nil {
+		r.scope = &RouteScope{}
+	}
+	return r.scope
+}
+
+// OnDispose registers a function to run when the scope is disposed.
+// If the scope is already disposed, fn runs immediately.
+func (s *RouteScope) OnDispose(fn func()) {
+	s.mu.Lock()
+	if s.disposed {
+		s.mu.Unlock()
+		fn()
+		return
+	}
+	s.disposers = append(s.disposers, fn)
+	s.mu.Unlock()
+}
+
+// Add registers d to be disposed with the scope.
+// If the scope is already disposed, d is disposed immediately.
+func (s *RouteScope) Add(d core.Disposable) {
+	s.OnDispose(d.Dispose)
+}
+
+// IsDisposed reports whether the scope has been disposed.
+func (s *RouteScope) IsDisposed() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.disposed
+}
+
+// Dispose runs every registered cleanup in reverse order and clears stored
+// values. Calling Dispose more than once has no effect. The navigator calls
+// this when the route is removed; call it yourself only for scopes you
+// manage outside a navigator.
+func (s *RouteScope) Dispose() {
+	s.mu.Lock()
+	if s.disposed {
+		s.mu.Unlock()
+		return
+	}
+	s.disposed = true
+	disposers := s.disposers
+	s.disposers = nil
+	s.values = nil
+	s.mu.Unlock()
+
+	for i := len(disposers) - 1; i >= 0; i-- {
+		disposers[i]()
+	}
+}
+
+// ScopedValue returns the value of type T stored under key in scope, calling
+// create and storing the result if there is none yet. Values implementing
+// [core.Disposable] are disposed with the scope.
+//
+// Once the scope is disposed, create is called on every use and its result is
+// disposed immediately if it is disposable, so a late build never leaks.
+func ScopedValue[T any](scope *RouteScope, key any, create func() T) T {
+	k := scopeKey{typ: reflect.TypeFor[T](), key: key}
+
+	scope.mu.Lock()
+	if v, ok := scope.values[k]; ok {
+		scope.mu.Unlock()
+		return v.(T)
+	}
+	scope.mu.Unlock()
+
+	// Create outside the lock so create may use the scope itself.
+	value := create()
+
+	scope.mu.Lock()
+	if v, ok := scope.values[k]; ok {
+		scope.mu.Unlock()
+		if d, ok := any(value).(core.Disposable); ok {
+			d.Dispose()
+		}
+		return v.(T)
+	}
+	if !scope.disposed {
+		if scope.values == nil {
+			scope.values = make(map[scopeKey]any)
+		}
+		scope.values[k] = value
+	}
+	scope.mu.Unlock()
+
+	if d, ok := any(value).(core.Disposable); ok {
+		scope.Add(d)
+	}
+	return value
+}
+
+// Scoped returns the value of type T stored under key in the scope of the
+// route enclosing ctx, creating it with create on first use. See
+// [ScopedValue] for disposal rules.
+//
+// Panics if ctx is not inside a route with a scope.
+func Scoped[T any](ctx core.BuildContext, key any, create func() T) T {
+	scope := RouteScopeOf(ctx)
+	if scope == nil {
+		panic("navigation.Scoped: no enclosing route scope for " + reflect.TypeFor[T]().String())
+	}
+	return ScopedValue(scope, key, create)
+}
+
+// RouteScopeOf returns the [RouteScope] of the route enclosing ctx, or nil if
+// ctx is not inside a scoped route.
+func RouteScopeOf(ctx core.BuildContext) *RouteScope {
+	inherited, ok := ctx.DependOnInherited(routeScopeInheritedType, nil).(routeScopeInherited)
+	if !ok {
+		return nil
+	}
+	return inherited.scope
+}
+
+// routeScopeInherited exposes a route's scope to the widgets it builds.
+type routeScopeInherited struct {
+	core.InheritedBase
+	route Route
+	scope *RouteScope
+	child core.Widget
+}
+
+func (r routeScopeInherited) ChildWidget() core.Widget { return r.child }
+
+func (r routeScopeInherited) ShouldRebuildDependents(oldWidget core.InheritedWidget) bool {
+	if old, ok := oldWidget.(routeScopeInherited); ok {
+		return r.scope != old.scope
+	}
+	return true
+}
+
+var routeScopeInheritedType = reflect.TypeFor[routeScopeInherited]()
+
+// withRouteScope builds content beneath route's scope so the context passed
+// to build can already find it with [RouteScopeOf]. Routes that build content
+// outside the navigator's stack, such as overlay entries, wrap it too.
+func withRouteScope(route Route, build func(core.BuildContext) core.Widget) core.Widget {
+	content := routeScopeContent{build: build}
+	sr, ok := route.(ScopedRoute)
+	if !ok {
+		return content
+	}
+	return routeScopeInherited{route: route, scope: sr.Scope(), child: content}
+}
+
+// routeOf returns the scoped route enclosing ctx, or nil.
+func routeOf(ctx core.BuildContext) Route {
+	inherited, ok := ctx.DependOnInherited(routeScopeInheritedType, nil).(routeScopeInherited)
+	if !ok {
+		return nil
+	}
+	return inherited.route
+}
+
+// routeScopeContent calls build with a context below the route scope.
+type routeScopeContent struct {
+	core.StatelessBase
+	build func(core.BuildContext) core.Widget
+}
+
+func (c routeScopeContent) Build(ctx core.BuildContext) core.Widget {
+	if c.build == nil {
+		return nil
+	}
+	return c.build(ctx)
+}
+
+// disposeRouteScope disposes the scope of a removed route.
+func disposeRouteScope(route Route) {
+	if sr, ok := route.(ScopedRoute); ok {
+		sr.Scope().Dispose()
+	}
+}
@@ -0,0 +1,81 @@
+package navigation
+
+import (
+	"slices"
+	"testing"
+)
+
+type disposeCounter struct {
+	disposed int
+}
+
+func (d *disposeCounter) Dispose() { d.disposed++ }
+
+func TestRouteScope_DisposesInReverseOrder(t *testing.T) {
+	scope := &RouteScope{}
+	var order []int
+	scope.OnDispose(func() { order = append(order, 1) })
+	scope.OnDispose(func() { order = append(order, 2) })
+
+	scope.Dispose()
+	scope.Dispose()
+
+	if !slices.Equal(order, []int{2, 1}) {
+		t.Errorf("expected cleanups to run once in reverse order, got %v", order)
+	}
+	if !scope.IsDisposed() {
+		t.Error("expected scope to report disposed")
+	}
+}
+
+func TestRouteScope_AddAfterDisposeDisposesImmediately(t *testing.T) {
+	scope := &RouteScope{}
+	scope.Dispose()
+
+	d := &disposeCounter{}
+	scope.Add(d)
+	if d.disposed != 1 {
+		t.Errorf("expected late registration to dispose immediately, got %d", d.disposed)
+	}
+}
+
+func TestScopedValue_CreatesOncePerKeyAndType(t *testing.T) {
+	scope := &RouteScope{}
+	calls := 0
+	create := func() *disposeCounter {
+		calls++
+		return &disposeCounter{}
+	}
+
+	a := ScopedValue(scope, "a", create)
+	if again := ScopedValue(scope, "a", create); again != a {
+		t.Error("expected the same value for the same key")
+	}
+	b := ScopedValue(scope, "b", create)
+	if b == a || calls != 2 {
+		t.Errorf("expected a distinct value per key, calls=%d", calls)
+	}
+	if s := ScopedValue(scope, "a", func() string { return "other" }); s != "other" {
+		t.Errorf("expected values of different types not to collide, got %q", s)
+	}
+
+	scope.Dispose()
+	if a.disposed != 1 || b.disposed != 1 {
+		t.Errorf("expected scoped disposables to be disposed, got %d and %d", a.disposed, b.disposed)
+	}
+}
+
+func TestRemoveRoute_DisposesScope(t *testing.T) {
+	s := &navigatorState{}
+	route := NewAnimatedPageRoute(nil, RouteSettings{Name: "/details"})
+	d := ScopedValue(route.Scope(), nil, func() *disposeCounter { return &disposeCounter{} })
+
+	s.removeRoute(route, nil)
+
+	if d.disposed != 1 {
+		t.Errorf("expected scoped controller to be disposed with the route, got %d", d.disposed)
+	}
+	if !route.Scope().IsDisposed() {
+		t.Error("expected route scope to be disposed")
+	}
+}
@@ -3,8 +3,11 @@ package navigation
 import (
 	"reflect"
 	"strings"
+	"time"
 
 	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/platform"
+	"github.com/go-drift/drift/pkg/widgets"
 )
 
 // ScreenRoute defines a route in the declarative [Router].
@@ -70,7 +73,8 @@ type ScreenRoute struct {
 	// Redirect defines redirect logic for this route and its descendants.
 	// Checked after the Router's global Redirect callback.
 	// Ancestor redirects are evaluated outermost-first before the
-	// matched route's own Redirect.
+	// matched route's own Redirect. Return [RedirectAsync] to check
+	// asynchronously, such as validating a session token.
 	Redirect func(ctx RedirectContext) RedirectResult
 
 	// Children defines nested child routes.
@@ -78,12 +82,32 @@ type ScreenRoute struct {
 	// If Wrap is set, all children are wrapped by it.
 	Children []ScreenRoute
 
-	// Future: StackKey string
-	// Per-subtree navigator isolation for stateful shells. When set,
-	// matched routes within this subtree would render in a dedicated
-	// nested Navigator identified by this key, preserving navigation
-	// history independently (e.g., tab branches). Not implemented in v1;
-	// use TabNavigator for stateful tab navigation.
+	// Transition animates this route's screen on push and pop. The zero
+	// value uses [CupertinoPageTransition]. Child routes do not inherit it.
+	Transition PageTransition
+
+	// TransitionDuration is the length of the push and pop animations.
+	// Zero uses [TransitionDuration].
+	TransitionDuration time.Duration
+
+	// ReverseTransitionDuration is the length of the pop animation. Zero
+	// uses TransitionDuration.
+	ReverseTransitionDuration time.Duration
+
+	// TransitionCurve eases the push and pop animations. Nil uses
+	// [animation.IOSNavigationCurve].
+	TransitionCurve func(float64) float64
+
+	// Transparent keeps the routes beneath painting while this route is on
+	// top, for screens such as overlays and cards that do not cover the
+	// whole screen. Routes are opaque by default, and only the top route and
+	// those shown through transparent routes above them are painted.
+	Transparent bool
+
+	// Shell makes this route a stateful shell whose branches keep their own
+	// navigation stacks. Branch route paths are prefixed with Path. Screen,
+	// Wrap, and Children are ignored when Shell is set.
+	Shell *StatefulShellRoute
 }
 
 // ScreenOnly adapts a plain widget builder to the [ScreenRoute.Screen]
@@ -124,8 +148,7 @@ func ScreenOnly(build func(core.BuildContext) core.Widget) func(core.BuildContex
 //
 // IMPORTANT: Router is designed to be used as a singleton at the root of your
 // app. Do not nest Routers or use Router inside [TabNavigator] tabs. For tabs
-// with their own navigation stacks, use [Navigator] with [Tab.OnGenerateRoute]
-// instead.
+// with their own navigation stacks, use a [StatefulShellRoute] instead.
 //
 // Basic usage:
 //
@@ -165,8 +188,21 @@ type Router struct {
 	// Redirect is the global redirect callback, checked before every navigation.
 	// Return [NoRedirect] to allow, or [RedirectTo]/[RedirectWithArgs] to redirect.
 	// Route-specific redirects in [ScreenRoute.Redirect] are checked after this.
+	// Return [RedirectAsync] to decide asynchronously; see [Router.PendingBuilder].
 	Redirect func(ctx RedirectContext) RedirectResult
 
+	// Middleware runs, in order, on every navigation to a path before
+	// Redirect. Each can let the navigation continue, rewrite it, cancel it,
+	// or delay it. See [Middleware].
+	Middleware []Middleware
+
+	// PendingBuilder creates the widget shown over the router while an
+	// asynchronous redirect (see [RedirectAsync]) resolves, such as a
+	// spinner. Settings is the destination being checked. The screens
+	// beneath do not receive input meanwhile. If nil, the current screen
+	// stays on its own, or nothing is shown before the first route.
+	PendingBuilder func(ctx core.BuildContext, settings RouteSettings) core.Widget
+
 	// ErrorBuilder creates a widget for unmatched routes (404 pages).
 	// If nil, navigation to unknown routes is silently ignored.
 	ErrorBuilder func(ctx core.BuildContext, settings RouteSettings) core.Widget
@@ -187,6 +223,45 @@ type Router struct {
 	// Connect this to auth state changes to automatically redirect users
 	// when they log in or out.
 	RefreshListenable core.Listenable
+
+	// Observers receive navigation events from the router's navigator.
+	Observers []NavigatorObserver
+
+	// DeepLinks routes platform deep links (Android intents, iOS universal
+	// links, custom URL schemes) through the router. The link that launched
+	// the app replaces InitialPath and is resolved before the first frame;
+	// later links navigate like [RouterState.Go]. Redirects apply to both.
+	DeepLinks bool
+
+	// DeepLinkPath maps a deep link to a route path. Return false to ignore
+	// the link. If nil, [PathFromDeepLink] is used.
+	DeepLinkPath func(link platform.DeepLink) (string, bool)
+
+	// RestoreState saves the navigation stack, and the stacks of stateful
+	// shell branches, with [platform.Restoration]. When Android kills the
+	// app in the background and the user returns to it, the saved stack is
+	// shown instead of InitialPath or the launch deep link. Restored routes
+	// pass through redirects; a redirected route ends the stack.
+	//
+	// Routes pushed without a path are not saved. Arguments are saved if
+	// they encode as JSON and are restored as decoded JSON values
+	// (map[string]any, []any, string, float64, bool), so screens that take
+	// arguments should accept those types or read path parameters instead.
+	RestoreState bool
+
+	// RouteInformationProvider syncs the router with a location outside the
+	// app, such as a browser's address bar. Its location replaces
+	// InitialPath, locations it reports later are navigated to, and every
+	// location the router shows is reported back to it. A launch deep link
+	// or a restored stack takes precedence over its initial location.
+	//
+	// [MemoryRouteInformationProvider] keeps the history in memory, which
+	// lets tests drive navigation from strings.
+	RouteInformationProvider RouteInformationProvider
+
+	// RouteInformationParser converts the provider's locations to routes and
+	// back. If nil, [DefaultRouteInformationParser] is used.
+	RouteInformationParser RouteInformationParser
 }
 
 // CreateState creates the RouterState.
@@ -209,17 +284,21 @@ type RouterState interface {
 
 	// Go navigates to the given path, pushing a new route onto the stack.
 	// Equivalent to PushNamed but with clearer intent for URL-based navigation.
+	// If the path belongs to a branch of the visible [StatefulShellRoute],
+	// the shell switches to that branch and pushes the route onto its stack.
 	Go(path string, args any)
 
 	// Replace replaces the current route with the given path.
 	// The current route is removed and the new route takes its place.
-	// Equivalent to PushReplacementNamed.
+	// Equivalent to PushReplacementNamed. Like Go, a path in a branch of the
+	// visible shell replaces the top route of that branch.
 	Replace(path string, args any)
 }
 
 // routeIndex stores compiled route patterns for efficient lookup.
 type routeIndex struct {
 	patterns []*indexedRoute
+	shells   int // number of stateful shells indexed
 }
 
 type indexedRoute struct {
@@ -228,6 +307,8 @@ type indexedRoute struct {
 	fullPath  string
 	wraps     []func(core.BuildContext, core.Widget) core.Widget
 	redirects []func(RedirectContext) RedirectResult // ancestor redirects, outermost first
+	shell     *shellIndex                            // enclosing stateful shell, or nil
+	branch    int                                    // branch index within shell
 }
 
 type routerState struct {
@@ -235,11 +316,43 @@ type routerState struct {
 	router      Router
 	internalNav *navigatorState
 	routeIndex  *routeIndex
+	shells      []*shellState // mounted stateful shells
+
+	resolvingLink bool   // waiting for the launch deep link
+	launchPath    string // launch deep link path, replacing InitialPath
+
+	restoration *restorationObserver // saves state when RestoreState is set
+	restored    *savedState          // state saved by the previous process
+	savedData   string               // last state saved
+	savePending bool                 // a save is scheduled
+
+	information   *informationObserver // reports locations when a provider is set
+	initialRoute  *RouteSettings       // provider's location, replacing InitialPath
+	reported      string               // last location reported to or by the provider
+	reportPending bool                 // a report is scheduled
+	reportPush    bool                 // the scheduled report is a push
+
+	navigating bool // performing a navigation that passed the middleware
+
+	resolvedRedirects map[string]RedirectResult // redirect results by path for the navigation being performed
+	resolvingInitial  bool                      // waiting for the initial routes' redirects
+	initialResolved   bool                      // the initial routes' redirects have resolved
+	pendingRedirects  int                       // asynchronous redirects being waited for
+	pendingSettings   RouteSettings             // destination of the last pending redirect
 }
 
 func (s *routerState) InitState() {
 	s.router = s.Element().Widget().(Router)
 	s.routeIndex = s.buildRouteIndex()
+	if s.router.RestoreState {
+		s.loadRestoredState()
+	}
+	if s.router.RouteInformationProvider != nil {
+		s.startRouteInformation()
+	}
+	if s.router.DeepLinks {
+		s.startDeepLinks()
+	}
 }
 
 func (s *routerState) buildRouteIndex() *routeIndex {
@@ -251,6 +364,8 @@ func (s *routerState) buildRouteIndex() *routeIndex {
 type indexContext struct {
 	wraps     []func(core.BuildContext, core.Widget) core.Widget
 	redirects []func(RedirectContext) RedirectResult
+	shell     *shellIndex
+	branch    int
 }
 
 func (s *routerState) indexRoutes(prefix string, ctx indexContext, routes []ScreenRoute, index *routeIndex) {
@@ -258,7 +373,7 @@ func (s *routerState) indexRoutes(prefix string, ctx indexContext, routes []Scre
 		fullPath := prefix + r.Path
 
 		// If this route has a Screen, index it as a matchable pattern
-		if r.Screen != nil {
+		if r.Screen != nil && r.Shell == nil {
 			pattern := NewPathPattern(
 				fullPath,
 				WithTrailingSlash(s.router.TrailingSlashBehavior),
@@ -270,11 +385,21 @@ func (s *routerState) indexRoutes(prefix string, ctx indexContext, routes []Scre
 				fullPath:  fullPath,
 				wraps:     ctx.wraps,
 				redirects: ctx.redirects,
+				shell:     ctx.shell,
+				branch:    ctx.branch,
 			})
 		}
 
 		// Build child context: accumulate Wrap and Redirect for children
 		childCtx := ctx
+		if r.Shell != nil {
+			if r.Redirect != nil {
+				childCtx.redirects = append([]func(RedirectContext) RedirectResult{}, ctx.redirects...)
+				childCtx.redirects = append(childCtx.redirects, r.Redirect)
+			}
+			s.indexShell(fullPath, childCtx, r, index)
+			continue
+		}
 		if r.Wrap != nil {
 			childCtx.wraps = append([]func(core.BuildContext, core.Widget) core.Widget{}, ctx.wraps...)
 			childCtx.wraps = append(childCtx.wraps, r.Wrap)
@@ -324,6 +449,15 @@ func (s *routerState) generateRoute(settings RouteSettings) Route {
 	// Merge arguments
 	matchedSettings.Arguments = settings.Arguments
 
+	// Branch routes open their shell, which shows them in the branch's navigator
+	if ir.shell != nil {
+		return s.shellRoute(ir, matchedSettings)
+	}
+	return s.screenRoute(ir, matchedSettings)
+}
+
+// screenRoute creates the page route for a matched screen.
+func (s *routerState) screenRoute(ir *indexedRoute, matchedSettings RouteSettings) Route {
 	// Capture for closure
 	screen := ir.route.Screen
 	wraps := ir.wraps
@@ -341,7 +475,13 @@ func (s *routerState) generateRoute(settings RouteSettings) Route {
 		return child
 	}
 
-	return NewAnimatedPageRoute(builder, matchedSettings)
+	route := NewAnimatedPageRoute(builder, matchedSettings)
+	route.Transition = ir.route.Transition
+	route.TransitionDuration = ir.route.TransitionDuration
+	route.ReverseTransitionDuration = ir.route.ReverseTransitionDuration
+	route.TransitionCurve = ir.route.TransitionCurve
+	route.Transparent = ir.route.Transparent
+	return route
 }
 
 func (s *routerState) unknownRoute(settings RouteSettings) Route {
@@ -357,37 +497,76 @@ func (s *routerState) unknownRoute(settings RouteSettings) Route {
 }
 
 func (s *routerState) applyRedirect(ctx RedirectContext) RedirectResult {
-	// First check router-level redirect
-	if s.router.Redirect != nil {
-		result := s.router.Redirect(ctx)
-		if result.Path != "" {
+	// Use the result resolved before the navigation, waiting for
+	// asynchronous redirects
+	if result, ok := s.resolvedRedirects[ctx.ToPath]; ok {
+		delete(s.resolvedRedirects, ctx.ToPath)
+		return result
+	}
+
+	// Results still pending cannot be waited for here and are skipped
+	for _, redirect := range s.redirectsFor(ctx.ToPath) {
+		if result := redirect(ctx); result.Path != "" {
 			return result
 		}
 	}
+	return NoRedirect()
+}
 
-	// Then check ancestor redirects (outermost first), then the route itself
-	ir, _ := s.findRoute(ctx.ToPath)
-	if ir != nil {
-		for _, redirect := range ir.redirects {
-			result := redirect(ctx)
-			if result.Path != "" {
-				return result
-			}
-		}
+// redirectsFor returns the redirects that apply to a navigation to path, in
+// the order they run: the router-level redirect, then ancestor redirects
+// (outermost first), then the route's own.
+func (s *routerState) redirectsFor(path string) []func(RedirectContext) RedirectResult {
+	var redirects []func(RedirectContext) RedirectResult
+	if s.router.Redirect != nil {
+		redirects = append(redirects, s.router.Redirect)
+	}
+	if ir, _ := s.findRoute(path); ir != nil {
+		redirects = append(redirects, ir.redirects...)
 		if ir.route.Redirect != nil {
-			return ir.route.Redirect(ctx)
+			redirects = append(redirects, ir.route.Redirect)
 		}
 	}
-
-	return NoRedirect()
+	return redirects
 }
 
 func (s *routerState) Build(ctx core.BuildContext) core.Widget {
+	// Hold the navigator back until the launch deep link is known, so the
+	// linked route is the first one shown
+	if s.resolvingLink {
+		return routerInherited{state: s, child: widgets.SizedBox{}}
+	}
+
 	initialPath := s.router.InitialPath
+	if s.launchPath != "" {
+		initialPath = s.launchPath
+	}
 	if initialPath == "" {
 		initialPath = "/"
 	}
 
+	// Hold the navigator back until asynchronous redirects of the initial
+	// routes resolve
+	initialStack := s.initialStack()
+	if !s.initialResolved {
+		stack := initialStack
+		if len(stack) == 0 {
+			stack = []RouteSettings{{Name: initialPath}}
+		}
+		if !s.resolveInitialRedirects(stack) {
+			return routerInherited{state: s, child: s.buildPending(ctx, widgets.SizedBox{})}
+		}
+	}
+	if s.resolvedRedirects != nil {
+		// The navigator uses the results as it pushes the initial routes;
+		// drop any it skips so later navigations ask again
+		platform.Dispatch(func() {
+			if !s.navigating {
+				s.resolvedRedirects = nil
+			}
+		})
+	}
+
 	// Build internal Navigator
 	nav := Navigator{
 		IsRoot:            true,
@@ -396,13 +575,44 @@ func (s *routerState) Build(ctx core.BuildContext) core.Widget {
 		OnUnknownRoute:    s.unknownRoute,
 		Redirect:          s.applyRedirect,
 		RefreshListenable: s.router.RefreshListenable,
+		Observers:         s.observers(s.router.Observers),
+		initialStack:      initialStack,
+		intercept:         s.interceptNavigation,
 	}
 
 	// Wrap in inherited widget for RouterOf access
 	return routerInherited{
 		state: s,
-		child: nav,
+		child: s.buildPending(ctx, nav),
+	}
+}
+
+// initialStack returns the restored stack, or the provider's location when
+// no deep link launched the app, to show in place of the initial path.
+func (s *routerState) initialStack() []RouteSettings {
+	if stack := s.restoredStack(); stack != nil {
+		return stack
+	}
+	if s.initialRoute != nil && s.launchPath == "" {
+		return []RouteSettings{*s.initialRoute}
+	}
+	return nil
+}
+
+// observers returns the observers for a navigator of the router, adding the
+// observers that save state and report locations.
+func (s *routerState) observers(observers []NavigatorObserver) []NavigatorObserver {
+	var own []NavigatorObserver
+	if s.router.RestoreState && s.restoration != nil {
+		own = append(own, s.restoration)
+	}
+	if s.information != nil {
+		own = append(own, s.information)
+	}
+	if len(own) == 0 {
+		return observers
 	}
+	return append(observers[:len(observers):len(observers)], own...)
 }
 
 func (s *routerState) DidUpdateWidget(oldWidget core.StatefulWidget) {
@@ -431,7 +641,7 @@ func (s *routerState) PushReplacementNamed(name string, args any) {
 }
 
 func (s *routerState) Pop(result any) {
-	if nav := RootNavigator(); nav != nil {
+	if nav := s.popTarget(); nav != nil {
 		nav.Pop(result)
 	}
 }
@@ -448,29 +658,73 @@ func (s *routerState) PushReplacement(route Route) {
 	}
 }
 
-func (s *routerState) CanPop() bool {
+func (s *routerState) PushAndRemoveUntil(route Route, predicate func(Route) bool) {
+	if nav := RootNavigator(); nav != nil {
+		nav.PushAndRemoveUntil(route, predicate)
+	}
+}
+
+func (s *routerState) PushNamedAndRemoveUntil(name string, args any, predicate func(Route) bool) {
 	if nav := RootNavigator(); nav != nil {
+		nav.PushNamedAndRemoveUntil(name, args, predicate)
+	}
+}
+
+// PopToRoot pops the active branch of the visible shell to its first route
+// if it can pop, otherwise the root navigator.
+func (s *routerState) PopToRoot() {
+	if nav := s.popTarget(); nav != nil {
+		nav.PopToRoot()
+	}
+}
+
+func (s *routerState) CanPop() bool {
+	if nav := s.popTarget(); nav != nil {
 		return nav.CanPop()
 	}
 	return false
 }
 
 func (s *routerState) MaybePop(result any) bool {
-	if nav := RootNavigator(); nav != nil {
+	if nav := s.popTarget(); nav != nil {
 		return nav.MaybePop(result)
 	}
 	return false
 }
 
+// popTarget returns the navigator that Pop acts on: the active branch of the
+// visible shell while it can pop, otherwise the root navigator.
+func (s *routerState) popTarget() NavigatorState {
+	if shell := s.visibleShell(nil); shell != nil {
+		if nav := shell.activeNavigator(); nav != nil && nav.CanPop() {
+			return nav
+		}
+	}
+	return RootNavigator()
+}
+
 // RouterState-specific methods
 
 // Go navigates to the given path.
 func (s *routerState) Go(path string, args any) {
-	s.PushNamed(path, args)
+	s.goFor(path, args, nil)
+}
+
+// goFor navigates to path, handing the pushed route's result to pushed.
+func (s *routerState) goFor(path string, args any, pushed *pushResult) {
+	if shell, branch := s.shellFor(path); shell != nil {
+		shell.goPath(branch, path, args, false, pushed)
+		return
+	}
+	pushNamedForResult(RootNavigator(), path, args, pushed)
 }
 
 // Replace replaces the current route with the given path.
 func (s *routerState) Replace(path string, args any) {
+	if shell, branch := s.shellFor(path); shell != nil {
+		shell.goPath(branch, path, args, true, nil)
+		return
+	}
 	s.PushReplacementNamed(path, args)
 }
 
@@ -0,0 +1,60 @@
+package navigation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-drift/drift/pkg/core"
+	drifttest "github.com/go-drift/drift/pkg/testing"
+)
+
+// pumpRouter shows router, waits for its root navigator, which may wait on
+// a launch deep link, and settles.
+func pumpRouter(t *testing.T, router Router) (*drifttest.WidgetTester, *navigatorState) {
+	t.Helper()
+	tester := drifttest.NewWidgetTesterWithT(t)
+	tester.PumpWidget(router)
+	deadline := time.Now().Add(time.Second)
+	for RootNavigator() == nil && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+		tester.Pump()
+	}
+	nav, ok := RootNavigator().(*navigatorState)
+	if !ok {
+		t.Fatal("the router did not show a navigator")
+	}
+	tester.PumpAndSettle(time.Second)
+	return tester, nav
+}
+
+// stubRoutes returns a route with a stub screen for each path.
+func stubRoutes(paths ...string) []ScreenRoute {
+	routes := make([]ScreenRoute, len(paths))
+	for i, path := range paths {
+		routes[i] = ScreenRoute{Path: path, Screen: stubScreen}
+	}
+	return routes
+}
+
+// shellRoutes returns a shell with a feed branch, whose "/feed/:id" shows
+// post details, and a profile branch, next to a full-screen "/settings".
+// Each build of the shell stores the router and shell in router and shell.
+func shellRoutes(router *RouterState, shell *ShellState) []ScreenRoute {
+	return []ScreenRoute{
+		{
+			Shell: &StatefulShellRoute{
+				Builder: func(ctx core.BuildContext, s ShellState, child core.Widget) core.Widget {
+					*router, *shell = RouterOf(ctx), s
+					return child
+				},
+				Branches: []ShellBranch{
+					{Routes: []ScreenRoute{
+						{Path: "/feed", Screen: stubScreen, Children: stubRoutes("/:id")},
+					}},
+					{Routes: stubRoutes("/profile")},
+				},
+			},
+		},
+		{Path: "/settings", Screen: stubScreen},
+	}
+}
@@ -0,0 +1,388 @@
+package navigation
+
+import (
+	"slices"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/layout"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+// StatefulShellRoute shows a persistent layout, such as a scaffold with a
+// bottom navigation bar, around branches that each own a nested navigation
+// stack. Set it as the Shell of a [ScreenRoute] in a [Router].
+//
+// Every branch keeps its navigator mounted while another branch is shown, so
+// pushed screens, scroll positions, and widget state survive switching
+// between branches. The platform back button and [RouterState.Pop] pop the
+// active branch first and fall back to the root navigator once the branch
+// is at its first route.
+//
+//	navigation.ScreenRoute{
+//	    Shell: &navigation.StatefulShellRoute{
+//	        Builder: func(ctx core.BuildContext, shell navigation.ShellState, child core.Widget) core.Widget {
+//	            return widgets.Column{
+//	                Children: []core.Widget{
+//	                    widgets.Expanded{Child: child},
+//	                    theme.TabBarOf(ctx, tabItems, shell.CurrentIndex(), shell.GoBranch),
+//	                },
+//	            }
+//	        },
+//	        Branches: []navigation.ShellBranch{
+//	            {Routes: []navigation.ScreenRoute{
+//	                {Path: "/feed", Screen: buildFeed, Children: []navigation.ScreenRoute{
+//	                    {Path: "/:id", Screen: buildPost},
+//	                }},
+//	            }},
+//	            {Routes: []navigation.ScreenRoute{
+//	                {Path: "/profile", Screen: navigation.ScreenOnly(buildProfile)},
+//	            }},
+//	        },
+//	    },
+//	}
+//
+// [RouterState.Go] with a path of a branch route switches to that branch and
+// pushes the route onto its stack. Within a branch, [NavigatorOf] returns the
+// branch's navigator; pushing a path outside the branch onto it navigates
+// like Go instead.
+type StatefulShellRoute struct {
+	// Branches are the independently navigable sections of the shell, in
+	// the order of CurrentIndex.
+	Branches []ShellBranch
+
+	// Builder wraps the branches in the shell's layout. The child shows the
+	// active branch's navigator. If nil, the child is shown on its own.
+	Builder func(ctx core.BuildContext, shell ShellState, child core.Widget) core.Widget
+}
+
+// ShellBranch is one section of a [StatefulShellRoute] with its own
+// navigation stack.
+type ShellBranch struct {
+	// Routes defines the branch's route tree. Paths are prefixed with the
+	// shell route's Path.
+	Routes []ScreenRoute
+
+	// InitialPath is the first route shown in the branch. Defaults to the
+	// path of the branch's first screen.
+	InitialPath string
+
+	// Observers receive navigation events from the branch's navigator.
+	Observers []NavigatorObserver
+}
+
+// ShellState controls a mounted [StatefulShellRoute]. It is passed to
+// [StatefulShellRoute.Builder].
+type ShellState interface {
+	// CurrentIndex returns the index of the active branch.
+	CurrentIndex() int
+
+	// GoBranch shows the branch at index, restoring its navigation stack.
+	// Selecting the active branch again pops it back to its first route.
+	GoBranch(index int)
+
+	// BranchNavigator returns the navigator of the branch at index, or nil
+	// if it is not built yet.
+	BranchNavigator(index int) NavigatorState
+}
+
+// shellIndex is the compiled form of a StatefulShellRoute.
+type shellIndex struct {
+	id           int // position among the router's shells, stable across rebuilds
+	route        ScreenRoute
+	wraps        []func(core.BuildContext, core.Widget) core.Widget
+	initialPaths []string
+}
+
+func (s *routerState) indexShell(fullPath string, ctx indexContext, r ScreenRoute, index *routeIndex) {
+	shell := &shellIndex{
+		id:           index.shells,
+		route:        r,
+		wraps:        ctx.wraps,
+		initialPaths: make([]string, len(r.Shell.Branches)),
+	}
+	index.shells++
+
+	for i, branch := range r.Shell.Branches {
+		first := len(index.patterns)
+		// Ancestor wraps apply around the whole shell, not inside branches.
+		s.indexRoutes(fullPath, indexContext{redirects: ctx.redirects, shell: shell, branch: i}, branch.Routes, index)
+
+		shell.initialPaths[i] = branch.InitialPath
+		if shell.initialPaths[i] == "" && len(index.patterns) > first {
+			shell.initialPaths[i] = index.patterns[first].fullPath
+		}
+	}
+}
+
+// shellRoute creates the root route showing a shell with the matched branch
+// route active.
+func (s *routerState) shellRoute(ir *indexedRoute, matchedSettings RouteSettings) Route {
+	shell := ir.shell
+	branch := ir.branch
+
+	builder := func(ctx core.BuildContext) core.Widget {
+		var child core.Widget = statefulShell{
+			router:   s,
+			shell:    shell,
+			branch:   branch,
+			settings: matchedSettings,
+		}
+		for i := len(shell.wraps) - 1; i >= 0; i-- {
+			child = shell.wraps[i](ctx, child)
+		}
+		return child
+	}
+
+	route := NewAnimatedPageRoute(builder, matchedSettings)
+	route.Transition = shell.route.Transition
+	route.TransitionDuration = shell.route.TransitionDuration
+	return route
+}
+
+// generateBranchRoute creates routes for a branch's navigator. Paths outside
+// the branch are unknown to it.
+func (s *routerState) generateBranchRoute(shell *shellIndex, branch int, settings RouteSettings) Route {
+	ir, matchedSettings := s.findRoute(settings.Name)
+	if ir == nil || ir.shell == nil || ir.shell.id != shell.id || ir.branch != branch {
+		return nil
+	}
+	matchedSettings.Arguments = settings.Arguments
+	return s.screenRoute(ir, matchedSettings)
+}
+
+// shellFor returns the visible shell containing path and the index of the
+// branch it belongs to, or nil if path is not in the visible shell.
+func (s *routerState) shellFor(path string) (*shellState, int) {
+	ir, _ := s.findRoute(path)
+	if ir == nil || ir.shell == nil {
+		return nil, 0
+	}
+	return s.visibleShell(ir.shell), ir.branch
+}
+
+// visibleShell returns the mounted shell whose route is on top of the root
+// navigator, or nil. If shell is non-nil, only that shell is considered.
+func (s *routerState) visibleShell(shell *shellIndex) *shellState {
+	root, ok := RootNavigator().(*navigatorState)
+	if !ok {
+		return nil
+	}
+	top := root.top()
+	for _, st := range s.shells {
+		if st.route != top || st.route == nil {
+			continue
+		}
+		if shell == nil || st.widget.shell.id == shell.id {
+			return st
+		}
+	}
+	return nil
+}
+
+// statefulShell builds a shell's branch navigators and layout.
+type statefulShell struct {
+	core.StatefulBase
+	router   *routerState
+	shell    *shellIndex
+	branch   int           // branch shown first
+	settings RouteSettings // route that opened the shell, shown first in branch
+}
+
+func (w statefulShell) CreateState() core.State {
+	return &shellState{}
+}
+
+type shellState struct {
+	core.StateBase
+	widget     statefulShell
+	index      int
+	navigators []NavigatorState // per-branch navigators
+	route      Route            // root route hosting the shell
+	restored   *savedShell      // branch stacks saved by the previous process
+}
+
+func (s *shellState) InitState() {
+	s.widget = s.Element().Widget().(statefulShell)
+	s.index = s.widget.branch
+	s.navigators = make([]NavigatorState, len(s.widget.shell.route.Shell.Branches))
+	if s.restored = s.widget.router.takeRestoredShell(s.widget.shell.id); s.restored != nil {
+		if s.restored.Index >= 0 && s.restored.Index < len(s.navigators) {
+			s.index = s.restored.Index
+		}
+	}
+	s.widget.router.shells = append(s.widget.router.shells, s)
+}
+
+func (s *shellState) DidUpdateWidget(oldWidget core.StatefulWidget) {
+	s.widget = s.Element().Widget().(statefulShell)
+}
+
+func (s *shellState) Dispose() {
+	router := s.widget.router
+	router.shells = slices.DeleteFunc(router.shells, func(st *shellState) bool { return st == s })
+	s.StateBase.Dispose()
+}
+
+func (s *shellState) Build(ctx core.BuildContext) core.Widget {
+	s.route = routeOf(ctx)
+	branches := s.widget.shell.route.Shell.Branches
+
+	bodies := make([]core.Widget, len(branches))
+	for i := range branches {
+		isActive := i == s.index
+		bodies[i] = widgets.ExcludeSemantics{
+			Excluding: !isActive,
+			Child: widgets.Offstage{
+				Offstage: !isActive,
+				Child: tabNavigatorScope{
+					state: s,
+					index: i,
+					child: s.buildNavigator(i),
+				},
+			},
+		}
+	}
+
+	var child core.Widget = widgets.IndexedStack{
+		Children:  bodies,
+		Alignment: layout.AlignmentTopLeft,
+		Fit:       widgets.StackFitExpand,
+		Index:     s.index,
+	}
+	if builder := s.widget.shell.route.Shell.Builder; builder != nil {
+		child = builder(ctx, s, child)
+	}
+	return child
+}
+
+// buildNavigator creates the Navigator for the branch at index. The branch
+// that opened the shell starts at the opening route, with its arguments.
+func (s *shellState) buildNavigator(index int) Navigator {
+	router := s.widget.router
+	shell := s.widget.shell
+	branch := shell.route.Shell.Branches[index]
+
+	initialRoute := shell.initialPaths[index]
+	if index == s.widget.branch {
+		initialRoute = s.widget.settings.Name
+	}
+	opening := s.widget.settings
+
+	var initialStack []RouteSettings
+	if s.restored != nil && index < len(s.restored.Branches) {
+		initialStack = savedStack(s.restored.Branches[index])
+	}
+
+	return Navigator{
+		InitialRoute: initialRoute,
+		initialStack: initialStack,
+		OnGenerateRoute: func(settings RouteSettings) Route {
+			if index == s.widget.branch && settings.Name == opening.Name && settings.Arguments == nil {
+				settings.Arguments = opening.Arguments
+			}
+			return router.generateBranchRoute(shell, index, settings)
+		},
+		OnUnknownRoute: func(settings RouteSettings) Route {
+			return s.unknownBranchRoute(index, settings)
+		},
+		Redirect:  router.applyRedirect,
+		Observers: router.observers(branch.Observers),
+		intercept: router.interceptNavigation,
+	}
+}
+
+// unknownBranchRoute handles paths a branch cannot show. Paths the router
+// knows are navigated to with Go, which may switch branches or push onto the
+// root navigator; the branch itself does not change.
+func (s *shellState) unknownBranchRoute(index int, settings RouteSettings) Route {
+	router := s.widget.router
+	// A branch still being created shows its initial route, known or not.
+	if s.BranchNavigator(index) == nil {
+		return router.unknownRoute(settings)
+	}
+	if ir, _ := router.findRoute(settings.Name); ir == nil {
+		return router.unknownRoute(settings)
+	}
+	router.Go(settings.Name, settings.Arguments)
+	return nil
+}
+
+// CurrentIndex returns the index of the active branch.
+func (s *shellState) CurrentIndex() int {
+	return s.index
+}
+
+// GoBranch shows the branch at index.
+func (s *shellState) GoBranch(index int) {
+	if index < 0 || index >= len(s.navigators) {
+		return
+	}
+	if index == s.index {
+		if nav := s.navigators[index]; nav != nil {
+			nav.PopUntil(func(r Route) bool { return false })
+		}
+		return
+	}
+	s.SetState(func() {
+		s.index = index
+	})
+	if nav := s.navigators[index]; nav != nil {
+		globalScope.SetActiveNavigator(nav)
+	}
+	if s.widget.router.router.RestoreState {
+		s.widget.router.scheduleSave()
+	}
+	if s.widget.router.information != nil {
+		s.widget.router.scheduleReport(true)
+	}
+}
+
+// BranchNavigator returns the navigator of the branch at index.
+func (s *shellState) BranchNavigator(index int) NavigatorState {
+	if index < 0 || index >= len(s.navigators) {
+		return nil
+	}
+	return s.navigators[index]
+}
+
+// activeNavigator returns the navigator of the active branch.
+func (s *shellState) activeNavigator() NavigatorState {
+	return s.BranchNavigator(s.index)
+}
+
+// goPath shows the branch at index and pushes path onto its stack, or
+// replaces its top route. Pushing the route already on top only switches
+// branches.
+func (s *shellState) goPath(index int, path string, args any, replace bool, pushed *pushResult) {
+	if index != s.index {
+		s.GoBranch(index)
+	}
+	nav := s.BranchNavigator(index)
+	if nav == nil {
+		pushed.finish()
+		return
+	}
+	if replace {
+		nav.PushReplacementNamed(path, args)
+		return
+	}
+	if ns, ok := nav.(*navigatorState); ok {
+		if top := ns.top(); top != nil && top.Settings().Name == path {
+			pushed.finish()
+			return
+		}
+	}
+	pushNamedForResult(nav, path, args, pushed)
+}
+
+// registerNavigator stores a branch's navigator and makes it active if its
+// branch is shown.
+func (s *shellState) registerNavigator(index int, nav NavigatorState) {
+	if index < 0 || index >= len(s.navigators) {
+		return
+	}
+	s.navigators[index] = nav
+	if index == s.index {
+		globalScope.SetActiveNavigator(nav)
+	}
+}
@@ -0,0 +1,148 @@
+package navigation
+
+import (
+	"testing"
+	"time"
+)
+
+// branchTop returns the name of the top route in a shell branch.
+func branchTop(t *testing.T, shell ShellState, index int) string {
+	t.Helper()
+	nav, ok := shell.BranchNavigator(index).(*navigatorState)
+	if !ok {
+		t.Fatalf("branch %d has no navigator", index)
+	}
+	return nav.top().Settings().Name
+}
+
+func TestStatefulShellRoute_PreservesBranchStacks(t *testing.T) {
+	var router RouterState
+	var shell ShellState
+	tester, _ := pumpRouter(t, Router{InitialPath: "/feed", Routes: shellRoutes(&router, &shell)})
+
+	router.Go("/feed/42", nil)
+	tester.PumpAndSettle(time.Second)
+	if got := branchTop(t, shell, 0); got != "/feed/42" {
+		t.Fatalf("expected /feed/42 pushed in the feed branch, got %q", got)
+	}
+
+	router.Go("/profile", nil)
+	tester.PumpAndSettle(time.Second)
+	if shell.CurrentIndex() != 1 {
+		t.Errorf("expected Go to switch to the profile branch, got index %d", shell.CurrentIndex())
+	}
+
+	shell.GoBranch(0)
+	tester.PumpAndSettle(time.Second)
+	if got := branchTop(t, shell, 0); got != "/feed/42" {
+		t.Errorf("expected the feed stack to survive switching branches, got %q", got)
+	}
+	if RootNavigator().CanPop() {
+		t.Error("expected branch navigation to leave the root stack alone")
+	}
+
+	shell.GoBranch(0)
+	tester.PumpAndSettle(time.Second)
+	if got := branchTop(t, shell, 0); got != "/feed" {
+		t.Errorf("expected reselecting the branch to pop to its first route, got %q", got)
+	}
+}
+
+func TestStatefulShellRoute_BackPopsActiveBranch(t *testing.T) {
+	var router RouterState
+	var shell ShellState
+	tester, _ := pumpRouter(t, Router{InitialPath: "/feed", Routes: shellRoutes(&router, &shell)})
+
+	router.Go("/feed/42", nil)
+	tester.PumpAndSettle(time.Second)
+
+	if !router.CanPop() {
+		t.Fatal("expected the router to pop the active branch")
+	}
+	if !HandleBackButton() {
+		t.Fatal("expected the back button to be handled")
+	}
+	tester.PumpAndSettle(time.Second)
+	if got := branchTop(t, shell, 0); got != "/feed" {
+		t.Errorf("expected back to pop the branch, got %q on top", got)
+	}
+	if HandleBackButton() {
+		t.Error("expected back at the first route of the branch to be unhandled")
+	}
+}
+
+func TestStatefulShellRoute_RoutesOutsideShell(t *testing.T) {
+	var router RouterState
+	var shell ShellState
+	tester, _ := pumpRouter(t, Router{InitialPath: "/feed", Routes: shellRoutes(&router, &shell)})
+
+	router.Go("/feed/42", nil)
+	tester.PumpAndSettle(time.Second)
+	router.Go("/settings", nil)
+	tester.PumpAndSettle(time.Second)
+
+	root := RootNavigator().(*navigatorState)
+	if got := root.top().Settings().Name; got != "/settings" {
+		t.Fatalf("expected /settings pushed over the shell, got %q", got)
+	}
+
+	// The covered branch must not take the back press.
+	HandleBackButton()
+	tester.PumpAndSettle(time.Second)
+	if got := root.top().Settings().Name; got == "/settings" {
+		t.Error("expected back to pop /settings from the root navigator")
+	}
+	if got := branchTop(t, shell, 0); got != "/feed/42" {
+		t.Errorf("expected the feed branch to be untouched, got %q", got)
+	}
+
+	// Pushing an outside path onto a branch navigator navigates like Go.
+	shell.BranchNavigator(0).PushNamed("/settings", nil)
+	tester.PumpAndSettle(time.Second)
+	if got := root.top().Settings().Name; got != "/settings" {
+		t.Errorf("expected /settings on the root navigator, got %q", got)
+	}
+	if got := branchTop(t, shell, 0); got != "/feed/42" {
+		t.Errorf("expected the branch stack unchanged, got %q", got)
+	}
+}
+
+func TestRouter_RouteIndex_Shell(t *testing.T) {
+	router := Router{
+		Routes: []ScreenRoute{
+			{Path: "/login", Screen: stubScreen},
+			{
+				Path: "/app",
+				Shell: &StatefulShellRoute{
+					Branches: []ShellBranch{
+						{Routes: []ScreenRoute{{Path: "/home", Screen: stubScreen}}},
+						{
+							InitialPath: "/app/search/recent",
+							Routes: []ScreenRoute{
+								{Path: "/search", Screen: stubScreen},
+								{Path: "/search/recent", Screen: stubScreen},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	state := &routerState{router: router}
+	state.routeIndex = state.buildRouteIndex()
+
+	ir, _ := state.findRoute("/app/search")
+	if ir == nil || ir.shell == nil || ir.branch != 1 {
+		t.Fatalf("expected /app/search in branch 1 of the shell, got %+v", ir)
+	}
+	if got := ir.shell.initialPaths; got[0] != "/app/home" || got[1] != "/app/search/recent" {
+		t.Errorf("unexpected initial paths %v", got)
+	}
+	if ir, _ := state.findRoute("/login"); ir == nil || ir.shell != nil {
+		t.Error("expected /login outside the shell")
+	}
+	if route := state.generateBranchRoute(ir.shell, 0, RouteSettings{Name: "/app/search"}); route != nil {
+		t.Error("expected a branch to reject routes of another branch")
+	}
+}
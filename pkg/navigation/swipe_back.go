@@ -0,0 +1,197 @@
+package navigation
+
+import (
+	"github.com/go-drift/drift/pkg/animation"
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/gestures"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/layout"
+)
+
+// swipeBackFlingVelocity is the release velocity, in page widths per second,
+// above which a swipe-back completes or cancels in the direction of the
+// fling regardless of how far the page was dragged.
+const swipeBackFlingVelocity = 1.0
+
+// swipeBackDetector recognizes the edge swipe that pops the navigator's top
+// route. It wraps the route stack, so routes built above the navigator's
+// overlay, such as dialogs, are not affected.
+type swipeBackDetector struct {
+	core.RenderObjectBase
+	state *navigatorState
+	Child core.Widget
+}
+
+func (d swipeBackDetector) ChildWidget() core.Widget {
+	return d.Child
+}
+
+func (d swipeBackDetector) CreateRenderObject(ctx core.BuildContext) layout.RenderObject {
+	r := &renderSwipeBackDetector{state: d.state}
+	r.SetSelf(r)
+	r.drag = gestures.NewEdgeDragGestureRecognizer(gestures.DefaultArena)
+	r.drag.OnStart = func(gestures.DragStartDetails) { r.state.startSwipeBack() }
+	r.drag.OnUpdate = func(d gestures.DragUpdateDetails) {
+		r.state.updateSwipeBack(d.PrimaryDelta / r.width())
+	}
+	r.drag.OnEnd = func(d gestures.DragEndDetails) {
+		r.state.endSwipeBack(d.PrimaryVelocity / r.width())
+	}
+	r.drag.OnCancel = func() { r.state.endSwipeBack(0) }
+	return r
+}
+
+func (d swipeBackDetector) UpdateRenderObject(ctx core.BuildContext, renderObject layout.RenderObject) {
+	if r, ok := renderObject.(*renderSwipeBackDetector); ok {
+		r.state = d.state
+	}
+}
+
+type renderSwipeBackDetector struct {
+	layout.RenderBoxBase
+	child layout.RenderBox
+	state *navigatorState
+	drag  *gestures.EdgeDragGestureRecognizer
+}
+
+func (r *renderSwipeBackDetector) SetChild(child layout.RenderObject) {
+	layout.SetParentOnChild(r.child, nil)
+	r.child = layout.AsRenderBox(child)
+	layout.SetParentOnChild(r.child, r)
+}
+
+func (r *renderSwipeBackDetector) VisitChildren(visitor func(layout.RenderObject)) {
+	if r.child != nil {
+		visitor(r.child)
+	}
+}
+
+func (r *renderSwipeBackDetector) PerformLayout() {
+	constraints := r.Constraints()
+	if r.child == nil {
+		r.SetSize(constraints.Constrain(graphics.Size{}))
+		return
+	}
+	r.child.Layout(constraints, true) // true: we read child.Size()
+	r.SetSize(r.child.Size())
+	r.child.SetParentData(&layout.BoxParentData{})
+}
+
+func (r *renderSwipeBackDetector) Paint(ctx *layout.PaintContext) {
+	if r.child != nil {
+		ctx.PaintChildWithLayer(r.child, graphics.Offset{})
+	}
+}
+
+func (r *renderSwipeBackDetector) HitTest(position graphics.Offset, result *layout.HitTestResult) bool {
+	if !layout.WithinBounds(position, r.Size()) {
+		return false
+	}
+	if r.child != nil {
+		r.child.HitTest(position, result)
+	}
+	result.Add(r)
+	return true
+}
+
+func (r *renderSwipeBackDetector) HandlePointer(event gestures.PointerEvent) {
+	if event.Phase == gestures.PointerPhaseDown {
+		// Only join the arena when the top route can be swiped away, so
+		// nested navigators and scrollables keep the drag otherwise.
+		if r.state.canSwipeBack() {
+			r.drag.AddPointer(event)
+		}
+		return
+	}
+	r.drag.HandleEvent(event)
+}
+
+// width returns the width the drag is measured against, guarding against
+// division by zero before layout.
+func (r *renderSwipeBackDetector) width() float64 {
+	return max(r.Size().Width, 1)
+}
+
+// canSwipeBack reports whether the top route can be popped by an edge
+// swipe: it opts in, is not animating, and no PopScope blocks popping it.
+func (s *navigatorState) canSwipeBack() bool {
+	if len(s.routes) <= 1 || s.exitingRoute != nil || s.swipeRoute != nil {
+		return false
+	}
+	top := s.top()
+	sr, ok := top.(SwipeBackRoute)
+	if !ok || !sr.CanSwipeBack() {
+		return false
+	}
+	fc := sr.ForegroundController()
+	if fc == nil || fc.IsAnimating() {
+		return false
+	}
+	return blockingPopScope(top) == nil
+}
+
+// startSwipeBack begins driving the top route's transition from a drag.
+// The navigator keeps the route beneath visible while the swipe lasts.
+func (s *navigatorState) startSwipeBack() {
+	if !s.canSwipeBack() {
+		return
+	}
+	s.SetState(func() {
+		s.clearPushListener()
+		s.swipeRoute = s.top()
+		s.swipeRoute.(AnimatedRoute).ForegroundController().Stop()
+	})
+}
+
+// updateSwipeBack moves the swiped route by delta, a fraction of the page
+// width.
+func (s *navigatorState) updateSwipeBack(delta float64) {
+	if s.swipeRoute == nil || s.swipeRoute != s.top() {
+		return
+	}
+	fc := s.swipeRoute.(AnimatedRoute).ForegroundController()
+	fc.SetValue(fc.Value - delta)
+}
+
+// endSwipeBack finishes a swipe released with velocity, in page widths per
+// second. A fling pops or restores the route in its direction; otherwise the
+// route pops if it was dragged past halfway.
+func (s *navigatorState) endSwipeBack(velocity float64) {
+	route := s.swipeRoute
+	if route == nil {
+		return
+	}
+	s.swipeRoute = nil
+	if route != s.top() {
+		s.SetState(func() {})
+		return
+	}
+	fc := route.(AnimatedRoute).ForegroundController()
+
+	var shouldPop bool
+	if velocity >= swipeBackFlingVelocity {
+		shouldPop = true
+	} else if velocity <= -swipeBackFlingVelocity {
+		shouldPop = false
+	} else {
+		shouldPop = fc.Value < 0.5
+	}
+	// A PopScope may have started blocking during the swipe.
+	if shouldPop && blockingPopScope(route) == nil {
+		s.pop(nil)
+		return
+	}
+
+	s.SetState(func() {
+		fc.Forward()
+		if fc.IsAnimating() {
+			s.pushUnsubscribe = fc.AddStatusListener(func(status animation.AnimationStatus) {
+				if status == animation.AnimationCompleted {
+					s.clearPushListener()
+					// Rebuild to hide the route beneath and unblock interaction
+					s.SetState(func() {})
+				}
+			})
+		}
+	})
+}
@@ -0,0 +1,138 @@
+package navigation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+	drifttest "github.com/go-drift/drift/pkg/testing"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+// pumpSwipeNavigator shows a 400-wide navigator of animated page routes with
+// "/details" pushed. The "/guarded" route holds a blocking PopScope.
+func pumpSwipeNavigator(t *testing.T, transition PageTransition) (*drifttest.WidgetTester, *navigatorState) {
+	t.Helper()
+	tester := drifttest.NewWidgetTesterWithT(t)
+	tester.SetSize(graphics.Size{Width: 400, Height: 800})
+	tester.PumpWidget(Navigator{
+		IsRoot:       true,
+		InitialRoute: "/",
+		OnGenerateRoute: func(settings RouteSettings) Route {
+			route := NewAnimatedPageRoute(func(core.BuildContext) core.Widget {
+				if settings.Name == "/guarded" {
+					return PopScope{OnPopBlocked: func(func()) {}, Child: widgets.SizedBox{}}
+				}
+				return widgets.SizedBox{}
+			}, settings)
+			route.Transition = transition
+			return route
+		},
+	})
+	nav := RootNavigator().(*navigatorState)
+	nav.PushNamed("/details", nil)
+	tester.PumpAndSettle(time.Second)
+	return tester, nav
+}
+
+// swipe drags from the left edge to x in small steps and releases.
+func swipe(t *testing.T, tester *drifttest.WidgetTester, x float64) {
+	t.Helper()
+	const pointer = 7
+	tester.SendPointerDown(graphics.Offset{X: 5, Y: 300}, pointer)
+	for pos := 10.0; pos < x; pos += 10 {
+		tester.SendPointerMove(graphics.Offset{X: pos, Y: 300}, pointer)
+	}
+	tester.SendPointerMove(graphics.Offset{X: x, Y: 300}, pointer)
+	tester.SendPointerUp(graphics.Offset{X: x, Y: 300}, pointer)
+}
+
+func TestSwipeBack_PopsPastHalfway(t *testing.T) {
+	tester, nav := pumpSwipeNavigator(t, PageTransition{})
+
+	swipe(t, tester, 300)
+	tester.PumpAndSettle(time.Second)
+
+	if got := nav.top().Settings().Name; got != "/" {
+		t.Errorf("expected / on top after swiping past halfway, got %q", got)
+	}
+	if nav.exitingRoute != nil {
+		t.Error("expected the swiped route to be removed once dismissed")
+	}
+}
+
+func TestSwipeBack_RestoresShortSwipe(t *testing.T) {
+	tester, nav := pumpSwipeNavigator(t, PageTransition{})
+	fc := nav.top().(AnimatedRoute).ForegroundController()
+
+	tester.SendPointerDown(graphics.Offset{X: 5, Y: 300}, 7)
+	for _, x := range []float64{15, 60, 105} {
+		tester.SendPointerMove(graphics.Offset{X: x, Y: 300}, 7)
+	}
+	if fc.Value >= 1 || fc.Value <= 0.5 {
+		t.Errorf("expected the drag to scrub the transition, got value %f", fc.Value)
+	}
+	tester.SendPointerUp(graphics.Offset{X: 105, Y: 300}, 7)
+	tester.PumpAndSettle(time.Second)
+
+	if got := nav.top().Settings().Name; got != "/details" {
+		t.Errorf("expected /details to stay on top, got %q", got)
+	}
+	if fc.Value != 1 || !fc.IsCompleted() {
+		t.Errorf("expected the route to settle back in place, got value %f", fc.Value)
+	}
+}
+
+func TestSwipeBack_Fling(t *testing.T) {
+	tester, nav := pumpSwipeNavigator(t, PageTransition{})
+
+	nav.startSwipeBack()
+	nav.updateSwipeBack(0.1)
+	nav.endSwipeBack(2)
+	tester.PumpAndSettle(time.Second)
+	if got := nav.top().Settings().Name; got != "/" {
+		t.Errorf("expected a fling to pop a short swipe, got %q on top", got)
+	}
+
+	nav.PushNamed("/details", nil)
+	tester.PumpAndSettle(time.Second)
+	nav.startSwipeBack()
+	nav.updateSwipeBack(0.8)
+	nav.endSwipeBack(-2)
+	tester.PumpAndSettle(time.Second)
+	if got := nav.top().Settings().Name; got != "/details" {
+		t.Errorf("expected a fling back to cancel a long swipe, got %q on top", got)
+	}
+}
+
+func TestSwipeBack_Disabled(t *testing.T) {
+	t.Run("transition without SwipeBack", func(t *testing.T) {
+		tester, nav := pumpSwipeNavigator(t, FadePageTransition())
+		swipe(t, tester, 300)
+		tester.PumpAndSettle(time.Second)
+		if got := nav.top().Settings().Name; got != "/details" {
+			t.Errorf("expected a fade route to ignore the swipe, got %q on top", got)
+		}
+	})
+
+	t.Run("blocking PopScope", func(t *testing.T) {
+		tester, nav := pumpSwipeNavigator(t, PageTransition{})
+		nav.PushNamed("/guarded", nil)
+		tester.PumpAndSettle(time.Second)
+		swipe(t, tester, 300)
+		tester.PumpAndSettle(time.Second)
+		if got := nav.top().Settings().Name; got != "/guarded" {
+			t.Errorf("expected a blocking PopScope to disable the swipe, got %q on top", got)
+		}
+	})
+
+	t.Run("away from the edge", func(t *testing.T) {
+		tester, nav := pumpSwipeNavigator(t, PageTransition{})
+		tester.DragFrom(graphics.Offset{X: 100, Y: 300}, graphics.Offset{X: 250})
+		tester.PumpAndSettle(time.Second)
+		if got := nav.top().Settings().Name; got != "/details" {
+			t.Errorf("expected a drag away from the edge to be ignored, got %q on top", got)
+		}
+	})
+}
@@ -282,10 +282,17 @@ func (s *tabNavigatorState) onTabChanged(index int) {
 	}
 }
 
-// tabNavigatorScope provides a way for child navigators to register with TabNavigator.
+// navigatorRegistry tracks the per-tab navigators of a TabNavigator or
+// StatefulShellRoute.
+type navigatorRegistry interface {
+	registerNavigator(index int, nav NavigatorState)
+}
+
+// tabNavigatorScope provides a way for child navigators to register with
+// TabNavigator or StatefulShellRoute.
 type tabNavigatorScope struct {
 	core.InheritedBase
-	state *tabNavigatorState
+	state navigatorRegistry
 	index int
 	child core.Widget
 }
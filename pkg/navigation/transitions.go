@@ -1,6 +1,8 @@
 package navigation
 
 import (
+	"math"
+
 	"github.com/go-drift/drift/pkg/animation"
 	"github.com/go-drift/drift/pkg/core"
 	"github.com/go-drift/drift/pkg/graphics"
@@ -12,6 +14,17 @@ import (
 // during a foreground push/pop transition (fraction of page width).
 const backgroundParallaxFactor = 0.33
 
+// parallaxOffset is the background offset of the default transition.
+var parallaxOffset = graphics.Offset{X: -backgroundParallaxFactor}
+
+// fadeThroughSplit is the point in a fade-through transition where the
+// outgoing page has faded out and the incoming page starts to fade in.
+const fadeThroughSplit = 0.3
+
+// fadeThroughStartScale is the scale the incoming page of a fade-through
+// transition grows from.
+const fadeThroughStartScale = 0.92
+
 // SlideDirection determines the direction of a slide transition.
 type SlideDirection int
 
@@ -174,6 +187,28 @@ func (r *renderSlideTransition) Paint(ctx *layout.PaintContext) {
 	ctx.PaintChildWithLayer(r.child, offset)
 }
 
+// BackgroundTransition describes how the page beneath a route reacts while
+// the route is pushed or popped. Its effects scale with the route's
+// animation, reaching full strength when the route covers the page.
+type BackgroundTransition struct {
+	// Offset is how far the page beneath moves when fully covered, as a
+	// fraction of its size. {X: -0.33} shifts it left by a third.
+	Offset graphics.Offset
+
+	// Translation is a further fixed distance the page beneath moves when
+	// fully covered, in logical pixels.
+	Translation graphics.Offset
+
+	// Scale is how much the page beneath grows about its center when fully
+	// covered, as a fraction of its size. 0.1 grows it by 10%, and negative
+	// values shrink it.
+	Scale float64
+
+	// FadeOut fades the page beneath out during the first 30% of the
+	// transition, as in a fade-through.
+	FadeOut bool
+}
+
 // BackgroundSlideTransition slides its child to the left as a foreground page
 // enters. At animation value 0 the child is at its normal position; at value 1
 // the child is shifted left by 33% of the width.
@@ -188,51 +223,127 @@ func (b BackgroundSlideTransition) ChildWidget() core.Widget {
 	return b.Child
 }
 
-// CreateRenderObject creates the renderBackgroundSlideTransition.
+// CreateRenderObject creates the renderBackgroundTransition.
 func (b BackgroundSlideTransition) CreateRenderObject(ctx core.BuildContext) layout.RenderObject {
-	r := &renderBackgroundSlideTransition{
+	return backgroundTransition{Animation: b.Animation, Effect: BackgroundTransition{Offset: parallaxOffset}}.CreateRenderObject(ctx)
+}
+
+// UpdateRenderObject updates the renderBackgroundTransition.
+func (b BackgroundSlideTransition) UpdateRenderObject(ctx core.BuildContext, renderObject layout.RenderObject) {
+	backgroundTransition{Animation: b.Animation, Effect: BackgroundTransition{Offset: parallaxOffset}}.UpdateRenderObject(ctx, renderObject)
+}
+
+// backgroundTransition applies the BackgroundTransition of the route above
+// its child. The navigator wraps every route in one, whatever the
+// transition, so the element tree stays stable as routes come and go.
+type backgroundTransition struct {
+	core.RenderObjectBase
+	Animation *animation.AnimationController
+	Effect    BackgroundTransition
+	Child     core.Widget
+}
+
+func (b backgroundTransition) ChildWidget() core.Widget {
+	return b.Child
+}
+
+func (b backgroundTransition) CreateRenderObject(ctx core.BuildContext) layout.RenderObject {
+	r := &renderBackgroundTransition{
 		transitionRenderBase: transitionRenderBase{animation: b.Animation},
+		effect:               b.Effect,
 	}
 	r.SetSelf(r)
 	r.subscribeAnimation()
 	return r
 }
 
-// UpdateRenderObject updates the renderBackgroundSlideTransition.
-func (b BackgroundSlideTransition) UpdateRenderObject(ctx core.BuildContext, renderObject layout.RenderObject) {
-	if r, ok := renderObject.(*renderBackgroundSlideTransition); ok {
+func (b backgroundTransition) UpdateRenderObject(ctx core.BuildContext, renderObject layout.RenderObject) {
+	if r, ok := renderObject.(*renderBackgroundTransition); ok {
 		if r.animation != b.Animation {
 			r.unsubscribeAnimation()
 			r.animation = b.Animation
 			r.subscribeAnimation()
 		}
+		r.effect = b.Effect
 		r.MarkNeedsPaint()
 	}
 }
 
-type renderBackgroundSlideTransition struct {
+type renderBackgroundTransition struct {
 	transitionRenderBase
+	effect BackgroundTransition
 }
 
-func (r *renderBackgroundSlideTransition) slideOffset() graphics.Offset {
+func (r *renderBackgroundTransition) progress() float64 {
 	if r.animation == nil {
-		return graphics.Offset{}
+		return 0
 	}
+	return r.animation.Value
+}
+
+func (r *renderBackgroundTransition) slideOffset() graphics.Offset {
+	t := r.progress()
+	size := r.Size()
 	return graphics.Offset{
-		X: -r.Size().Width * backgroundParallaxFactor * r.animation.Value,
+		X: (size.Width*r.effect.Offset.X + r.effect.Translation.X) * t,
+		Y: (size.Height*r.effect.Offset.Y + r.effect.Translation.Y) * t,
 	}
 }
 
-func (r *renderBackgroundSlideTransition) ScrollOffset() graphics.Offset {
+func (r *renderBackgroundTransition) scale() float64 {
+	return 1 + r.effect.Scale*r.progress()
+}
+
+func (r *renderBackgroundTransition) opacity() float64 {
+	if !r.effect.FadeOut {
+		return 1
+	}
+	return 1 - math.Min(1, r.progress()/fadeThroughSplit)
+}
+
+func (r *renderBackgroundTransition) ScrollOffset() graphics.Offset {
 	return r.slideOffset()
 }
 
-func (r *renderBackgroundSlideTransition) Paint(ctx *layout.PaintContext) {
+func (r *renderBackgroundTransition) Paint(ctx *layout.PaintContext) {
 	if r.child == nil {
 		return
 	}
 	offset := r.slideOffset()
+	opacity := r.opacity()
+	scale := r.scale()
+	if opacity <= 0 || scale <= 0 {
+		return
+	}
+	if opacity >= 1 && scale == 1 {
+		ctx.PaintChildWithLayer(r.child, offset)
+		return
+	}
+	size := r.Size()
+	if opacity < 1 {
+		bounds := graphics.RectFromLTWH(offset.X, offset.Y, size.Width, size.Height)
+		if scale > 1 {
+			bounds = scaledBounds(bounds, scale)
+		}
+		ctx.Canvas.SaveLayerAlpha(bounds, opacity)
+	} else {
+		ctx.Canvas.Save()
+	}
+	if scale != 1 {
+		cx, cy := offset.X+size.Width/2, offset.Y+size.Height/2
+		ctx.Canvas.Translate(cx, cy)
+		ctx.Canvas.Scale(scale, scale)
+		ctx.Canvas.Translate(-cx, -cy)
+	}
 	ctx.PaintChildWithLayer(r.child, offset)
+	ctx.Canvas.Restore()
+}
+
+// scaledBounds returns rect scaled by scale about its center.
+func scaledBounds(rect graphics.Rect, scale float64) graphics.Rect {
+	c := rect.Center()
+	w, h := rect.Width()*scale, rect.Height()*scale
+	return graphics.RectFromLTWH(c.X-w/2, c.Y-h/2, w, h)
 }
 
 // FadeTransition animates the opacity of its child.
@@ -294,3 +405,74 @@ func (r *renderFadeTransition) Paint(ctx *layout.PaintContext) {
 	ctx.PaintChildWithLayer(r.child, graphics.Offset{})
 	ctx.Canvas.Restore()
 }
+
+// FadeThroughTransition fades its child in during the last 70% of the
+// animation while scaling it up from 92% to full size, the incoming half of
+// a fade-through.
+type FadeThroughTransition struct {
+	core.RenderObjectBase
+	Animation *animation.AnimationController
+	Child     core.Widget
+}
+
+// ChildWidget returns the child widget.
+func (f FadeThroughTransition) ChildWidget() core.Widget {
+	return f.Child
+}
+
+// CreateRenderObject creates the renderFadeThroughTransition.
+func (f FadeThroughTransition) CreateRenderObject(ctx core.BuildContext) layout.RenderObject {
+	r := &renderFadeThroughTransition{
+		transitionRenderBase: transitionRenderBase{animation: f.Animation},
+	}
+	r.SetSelf(r)
+	r.subscribeAnimation()
+	return r
+}
+
+// UpdateRenderObject updates the renderFadeThroughTransition.
+func (f FadeThroughTransition) UpdateRenderObject(ctx core.BuildContext, renderObject layout.RenderObject) {
+	if r, ok := renderObject.(*renderFadeThroughTransition); ok {
+		if r.animation != f.Animation {
+			r.unsubscribeAnimation()
+			r.animation = f.Animation
+			r.subscribeAnimation()
+		}
+		r.MarkNeedsPaint()
+	}
+}
+
+type renderFadeThroughTransition struct {
+	transitionRenderBase
+}
+
+// phase returns the progress of the fade-in, from 0 to 1.
+func (r *renderFadeThroughTransition) phase() float64 {
+	if r.animation == nil {
+		return 1
+	}
+	return math.Max(0, (r.animation.Value-fadeThroughSplit)/(1-fadeThroughSplit))
+}
+
+func (r *renderFadeThroughTransition) Paint(ctx *layout.PaintContext) {
+	if r.child == nil {
+		return
+	}
+	t := r.phase()
+	if t <= 0 {
+		return
+	}
+	if t >= 1 {
+		ctx.PaintChildWithLayer(r.child, graphics.Offset{})
+		return
+	}
+	size := r.Size()
+	scale := fadeThroughStartScale + (1-fadeThroughStartScale)*t
+	cx, cy := size.Width/2, size.Height/2
+	ctx.Canvas.SaveLayerAlpha(graphics.RectFromLTWH(0, 0, size.Width, size.Height), t)
+	ctx.Canvas.Translate(cx, cy)
+	ctx.Canvas.Scale(scale, scale)
+	ctx.Canvas.Translate(-cx, -cy)
+	ctx.PaintChildWithLayer(r.child, graphics.Offset{})
+	ctx.Canvas.Restore()
+}
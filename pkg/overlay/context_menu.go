@@ -0,0 +1,470 @@
+package overlay
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"github.com/go-drift/drift/pkg/animation"
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/layout"
+	"github.com/go-drift/drift/pkg/platform"
+	"github.com/go-drift/drift/pkg/theme"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+// ContextMenuItem is an action in a [ContextMenu].
+type ContextMenuItem struct {
+	// Label is the action's title.
+	Label string
+	// Icon is an optional glyph shown with the label: before it in
+	// Material menus, after it in iOS menus.
+	Icon string
+	// Destructive shows the item in the error color, for actions such as
+	// deleting.
+	Destructive bool
+	// Disabled dims the item and ignores taps on it.
+	Disabled bool
+	// OnSelected is called after the menu closes.
+	OnSelected func()
+}
+
+// ContextMenu shows a menu of actions when its child is long-pressed, with
+// a haptic tick as it opens.
+//
+// The menu follows the platform of the current theme (see
+// [theme.PlatformOf]). On iOS the page is blurred, the child is lifted above
+// it, and the menu opens beside it, like UIMenu. On Android a popup menu
+// opens where the child was pressed. Tapping outside the menu closes it.
+//
+//	overlay.ContextMenu{
+//	    Items: []overlay.ContextMenuItem{
+//	        {Label: "Share", Icon: "↗", OnSelected: share},
+//	        {Label: "Delete", Icon: "🗑", Destructive: true, OnSelected: remove},
+//	    },
+//	    Child: photoTile,
+//	}
+//
+// Screen readers open the menu with their long-press action.
+type ContextMenu struct {
+	core.StatefulBase
+
+	// Child is the widget that opens the menu.
+	Child core.Widget
+	// Items are the menu's actions, in order. With none, long presses do
+	// nothing.
+	Items []ContextMenuItem
+	// Preview replaces Child in the lifted preview on iOS, for example to
+	// show a larger image. Nil lifts Child.
+	Preview core.Widget
+}
+
+func (m ContextMenu) CreateState() core.State {
+	return &contextMenuState{}
+}
+
+type contextMenuState struct {
+	core.StateBase
+	dismiss func()
+}
+
+func (s *contextMenuState) Dispose() {
+	if s.dismiss != nil {
+		s.dismiss()
+	}
+	s.StateBase.Dispose()
+}
+
+func (s *contextMenuState) Build(ctx core.BuildContext) core.Widget {
+	w := s.Element().Widget().(ContextMenu)
+	return widgets.Semantics{
+		OnLongPress: func() {
+			anchor := s.bounds()
+			s.open(ctx, graphics.Offset{X: anchor.Left + anchor.Width()/2, Y: anchor.Top + anchor.Height()/2})
+		},
+		Child: widgets.GestureDetector{
+			OnLongPress: func(d widgets.LongPressDetails) { s.open(ctx, d.Position) },
+			Child:       w.Child,
+		},
+	}
+}
+
+// bounds returns the child's rectangle in overlay coordinates.
+func (s *contextMenuState) bounds() graphics.Rect {
+	origin := core.GlobalOffsetOf(s.Element())
+	var size graphics.Size
+	if sizer, ok := s.Element().RenderObject().(interface{ Size() graphics.Size }); ok {
+		size = sizer.Size()
+	}
+	return graphics.RectFromLTWH(origin.X, origin.Y, size.Width, size.Height)
+}
+
+// open shows the menu for a press at position.
+func (s *contextMenuState) open(ctx core.BuildContext, position graphics.Offset) {
+	w := s.Element().Widget().(ContextMenu)
+	ov := OverlayOf(ctx)
+	if ov == nil || len(w.Items) == 0 || s.dismiss != nil {
+		return
+	}
+	platform.Haptics.MediumImpact()
+
+	cupertino := theme.PlatformOf(ctx) == theme.TargetPlatformCupertino
+	anchor := s.bounds()
+	var preview core.Widget
+	if cupertino {
+		preview = w.Preview
+		if preview == nil {
+			preview = w.Child
+		}
+	}
+
+	var once sync.Once
+	var barrierEntry, menuEntry *OverlayEntry
+	dismiss := func() {
+		once.Do(func() {
+			barrierEntry.Remove()
+			menuEntry.Remove()
+			s.dismiss = nil
+		})
+	}
+	s.dismiss = dismiss
+
+	barrierEntry = NewOverlayEntry(func(ctx core.BuildContext) core.Widget {
+		barrier := ModalBarrier{Dismissible: true, OnDismiss: dismiss, SemanticLabel: "Dismiss menu"}
+		if !cupertino {
+			return barrier
+		}
+		barrier.Color = graphics.RGBA(0, 0, 0, 0.2)
+		return widgets.NewBackdropFilter(12, barrier)
+	})
+	menuEntry = NewOverlayEntry(func(ctx core.BuildContext) core.Widget {
+		return contextMenuOverlay{
+			items:     w.Items,
+			preview:   preview,
+			anchor:    anchor,
+			position:  position,
+			cupertino: cupertino,
+			dismiss:   dismiss,
+		}
+	})
+	menuEntry.Opaque = true
+	ov.InsertAll([]*OverlayEntry{barrierEntry, menuEntry}, nil, nil)
+}
+
+// contextMenuOverlay is the open menu, with the lifted preview on iOS. It
+// animates in when inserted.
+type contextMenuOverlay struct {
+	core.StatefulBase
+	items     []ContextMenuItem
+	preview   core.Widget
+	anchor    graphics.Rect
+	position  graphics.Offset
+	cupertino bool
+	dismiss   func()
+}
+
+func (o contextMenuOverlay) CreateState() core.State {
+	return &contextMenuOverlayState{}
+}
+
+type contextMenuOverlayState struct {
+	core.StateBase
+	controller *animation.AnimationController
+}
+
+func (s *contextMenuOverlayState) InitState() {
+	s.controller = animation.NewAnimationController(200 * time.Millisecond)
+	s.controller.Curve = animation.EaseOut
+	core.UseDisposable(s, s.controller)
+	core.UseListenable(s, s.controller)
+	s.controller.Forward()
+}
+
+func (s *contextMenuOverlayState) Build(ctx core.BuildContext) core.Widget {
+	o := s.Element().Widget().(contextMenuOverlay)
+	var children []core.Widget
+	if o.preview != nil {
+		children = append(children, widgets.GestureDetector{
+			OnTap: o.dismiss,
+			Child: widgets.ClipRRect{Radius: 12, Child: o.preview},
+		})
+	}
+	children = append(children, buildContextMenu(ctx, o.items, o.cupertino, o.dismiss))
+	return contextMenuLayout{
+		anchor:     o.anchor,
+		position:   o.position,
+		hasPreview: o.preview != nil,
+		padding:    widgets.MediaQueryPaddingOf(ctx),
+		direction:  widgets.DirectionalityOf(ctx),
+		progress:   s.controller.Value,
+		children:   children,
+	}
+}
+
+// buildContextMenu builds the menu panel in the platform's style.
+func buildContextMenu(ctx core.BuildContext, items []ContextMenuItem, cupertino bool, dismiss func()) core.Widget {
+	rows := make([]core.Widget, 0, len(items)*2)
+	if cupertino {
+		colors := theme.CupertinoColorsOf(ctx)
+		style := theme.CupertinoTextThemeOf(ctx).TextStyle
+		for i, item := range items {
+			if i > 0 {
+				rows = append(rows, widgets.Container{Height: 0.5, Color: colors.Separator})
+			}
+			color := colors.Label
+			if item.Destructive {
+				color = colors.SystemRed
+			}
+			content := []core.Widget{
+				widgets.Expanded{Child: widgets.Text{Content: item.Label, Style: style.WithColor(color), MaxLines: 1}},
+			}
+			if item.Icon != "" {
+				content = append(content, widgets.HSpace(12), widgets.Icon{Glyph: item.Icon, Size: 20, Color: color})
+			}
+			rows = append(rows, contextMenuRow(item, dismiss, widgets.Container{
+				Height:  44,
+				Padding: layout.EdgeInsetsSymmetric(16, 0),
+				Child:   widgets.Row{CrossAxisAlignment: widgets.CrossAxisAlignmentCenter, Children: content},
+			}))
+		}
+		return widgets.Container{
+			Width:        250,
+			Color:        colors.SecondarySystemGroupedBackground,
+			BorderRadius: 13,
+			Child:        widgets.Column{MainAxisSize: widgets.MainAxisSizeMin, Children: rows},
+		}
+	}
+
+	_, colors, textTheme := theme.UseTheme(ctx)
+	for _, item := range items {
+		color := colors.OnSurface
+		iconColor := colors.OnSurfaceVariant
+		if item.Destructive {
+			color, iconColor = colors.Error, colors.Error
+		}
+		var content []core.Widget
+		if item.Icon != "" {
+			content = append(content, widgets.Icon{Glyph: item.Icon, Size: 24, Color: iconColor}, widgets.HSpace(12))
+		}
+		content = append(content, widgets.Expanded{
+			Child: widgets.Text{Content: item.Label, Style: textTheme.BodyLarge.WithColor(color), MaxLines: 1},
+		})
+		rows = append(rows, contextMenuRow(item, dismiss, widgets.Container{
+			Height:  48,
+			Padding: layout.EdgeInsetsSymmetric(12, 0),
+			Child:   widgets.Row{CrossAxisAlignment: widgets.CrossAxisAlignmentCenter, Children: content},
+		}))
+	}
+	return widgets.Container{
+		Width:        200,
+		Color:        colors.SurfaceContainer,
+		BorderRadius: 4,
+		Shadow:       graphics.BoxShadowElevation(2, colors.Shadow),
+		Padding:      layout.EdgeInsetsSymmetric(0, 8),
+		Child:        widgets.Column{MainAxisSize: widgets.MainAxisSizeMin, Children: rows},
+	}
+}
+
+// contextMenuRow makes row select item, or dims it when item is disabled.
+func contextMenuRow(item ContextMenuItem, dismiss func(), row core.Widget) core.Widget {
+	if item.Disabled {
+		return widgets.Opacity{Opacity: 0.38, Child: row}
+	}
+	return widgets.Tappable(item.Label, func() {
+		dismiss()
+		if item.OnSelected != nil {
+			item.OnSelected()
+		}
+	}, row)
+}
+
+// contextMenuGap is the space between the menu and the preview or the edges
+// of the screen.
+const contextMenuGap = 8
+
+// contextMenuLayout places the preview over the anchor and the menu beside
+// it, or the menu at the pressed position without a preview, keeping both
+// inside the safe area. Its last child is the menu.
+type contextMenuLayout struct {
+	core.RenderObjectBase
+	anchor     graphics.Rect
+	position   graphics.Offset
+	hasPreview bool
+	padding    layout.EdgeInsets
+	direction  graphics.TextDirection
+	progress   float64
+	children   []core.Widget
+}
+
+func (l contextMenuLayout) ChildrenWidgets() []core.Widget {
+	return l.children
+}
+
+func (l contextMenuLayout) CreateRenderObject(ctx core.BuildContext) layout.RenderObject {
+	r := &renderContextMenuLayout{}
+	r.SetSelf(r)
+	l.UpdateRenderObject(ctx, r)
+	return r
+}
+
+func (l contextMenuLayout) UpdateRenderObject(ctx core.BuildContext, renderObject layout.RenderObject) {
+	r := renderObject.(*renderContextMenuLayout)
+	r.anchor = l.anchor
+	r.position = l.position
+	r.hasPreview = l.hasPreview
+	r.padding = l.padding
+	r.direction = l.direction
+	if r.progress != l.progress {
+		r.progress = l.progress
+		r.MarkNeedsPaint()
+	}
+	r.MarkNeedsLayout()
+}
+
+type renderContextMenuLayout struct {
+	layout.RenderBoxBase
+	anchor     graphics.Rect
+	position   graphics.Offset
+	hasPreview bool
+	padding    layout.EdgeInsets
+	direction  graphics.TextDirection
+	progress   float64
+	children   []layout.RenderBox
+	// origin is the point of the menu nearest the anchor, which it grows
+	// from as it opens.
+	origin graphics.Offset
+}
+
+func (r *renderContextMenuLayout) SetChildren(children []layout.RenderObject) {
+	for _, child := range r.children {
+		layout.SetParentOnChild(child, nil)
+	}
+	r.children = r.children[:0]
+	for _, child := range children {
+		if box, ok := child.(layout.RenderBox); ok {
+			r.children = append(r.children, box)
+			layout.SetParentOnChild(box, r)
+		}
+	}
+}
+
+func (r *renderContextMenuLayout) VisitChildren(visitor func(layout.RenderObject)) {
+	for _, child := range r.children {
+		visitor(child)
+	}
+}
+
+func (r *renderContextMenuLayout) PerformLayout() {
+	constraints := r.Constraints()
+	size := graphics.Size{Width: constraints.MaxWidth, Height: constraints.MaxHeight}
+	r.SetSize(size)
+	if len(r.children) == 0 {
+		return
+	}
+	area := graphics.Rect{
+		Left:   r.padding.Left + contextMenuGap,
+		Top:    r.padding.Top + contextMenuGap,
+		Right:  size.Width - r.padding.Right - contextMenuGap,
+		Bottom: size.Height - r.padding.Bottom - contextMenuGap,
+	}
+	menu := r.children[len(r.children)-1]
+	menu.Layout(layout.Loose(graphics.Size{Width: max(area.Width(), 0), Height: max(area.Height(), 0)}), true)
+	menuSize := menu.Size()
+
+	var left, top float64
+	if r.hasPreview && len(r.children) > 1 {
+		preview := r.anchor
+		switch {
+		case preview.Bottom+contextMenuGap+menuSize.Height <= area.Bottom:
+			top = preview.Bottom + contextMenuGap
+			r.origin.Y = top
+		case preview.Top-contextMenuGap-menuSize.Height >= area.Top:
+			top = preview.Top - contextMenuGap - menuSize.Height
+			r.origin.Y = top + menuSize.Height
+		default:
+			// Neither side has room: move the preview up to make some.
+			shift := min(preview.Top-area.Top, preview.Bottom+contextMenuGap+menuSize.Height-area.Bottom)
+			preview = preview.Translate(0, -max(shift, 0))
+			top = min(preview.Bottom+contextMenuGap, area.Bottom-menuSize.Height)
+			r.origin.Y = top
+		}
+		r.children[0].Layout(layout.Tight(preview.Size()), false)
+		r.children[0].SetParentData(&layout.BoxParentData{Offset: graphics.Offset{X: preview.Left, Y: preview.Top}})
+
+		left = preview.Left
+		r.origin.X = left
+		if preview.Left+preview.Width()/2 > size.Width/2 {
+			left = preview.Right - menuSize.Width
+			r.origin.X = preview.Right
+		}
+	} else {
+		left = r.position.X
+		r.origin.X = left
+		if r.direction == graphics.TextDirectionRTL || left+menuSize.Width > area.Right {
+			left -= menuSize.Width
+			r.origin.X = r.position.X
+		}
+		top = r.position.Y
+		r.origin.Y = top
+		if top+menuSize.Height > area.Bottom {
+			top -= menuSize.Height
+		}
+	}
+	left = math.Max(math.Min(left, area.Right-menuSize.Width), area.Left)
+	top = math.Max(math.Min(top, area.Bottom-menuSize.Height), area.Top)
+	menu.SetParentData(&layout.BoxParentData{Offset: graphics.Offset{X: left, Y: top}})
+}
+
+func (r *renderContextMenuLayout) Paint(ctx *layout.PaintContext) {
+	for i, child := range r.children {
+		offset := childOffset(child)
+		if i < len(r.children)-1 {
+			// The preview lifts slightly as the menu opens.
+			r.paintScaled(ctx, child, offset, graphics.Offset{
+				X: offset.X + child.Size().Width/2,
+				Y: offset.Y + child.Size().Height/2,
+			}, 1+0.03*r.progress, 1)
+			continue
+		}
+		r.paintScaled(ctx, child, offset, r.origin, 0.8+0.2*r.progress, r.progress)
+	}
+}
+
+// paintScaled paints child at offset, scaled about origin and faded.
+func (r *renderContextMenuLayout) paintScaled(ctx *layout.PaintContext, child layout.RenderBox, offset, origin graphics.Offset, scale, opacity float64) {
+	if opacity <= 0 {
+		return
+	}
+	if opacity < 1 {
+		ctx.Canvas.SaveLayerAlpha(graphics.RectFromLTWH(0, 0, r.Size().Width, r.Size().Height), opacity)
+	} else {
+		ctx.Canvas.Save()
+	}
+	ctx.Canvas.Translate(origin.X, origin.Y)
+	ctx.Canvas.Scale(scale, scale)
+	ctx.Canvas.Translate(-origin.X, -origin.Y)
+	ctx.PaintChildWithLayer(child, offset)
+	ctx.Canvas.Restore()
+}
+
+// HitTest tests the menu, then the preview. Other positions fall through
+// to the barrier below.
+func (r *renderContextMenuLayout) HitTest(position graphics.Offset, result *layout.HitTestResult) bool {
+	for i := len(r.children) - 1; i >= 0; i-- {
+		child := r.children[i]
+		offset := childOffset(child)
+		if child.HitTest(graphics.Offset{X: position.X - offset.X, Y: position.Y - offset.Y}, result) {
+			return true
+		}
+	}
+	return false
+}
+
+// childOffset returns the offset from a child's parent data.
+func childOffset(child layout.RenderBox) graphics.Offset {
+	if data, ok := child.ParentData().(*layout.BoxParentData); ok {
+		return data.Offset
+	}
+	return graphics.Offset{}
+}
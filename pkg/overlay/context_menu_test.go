@@ -0,0 +1,124 @@
+package overlay
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/layout"
+	"github.com/go-drift/drift/pkg/theme"
+	"github.com/go-drift/drift/pkg/widgets"
+
+	dtesting "github.com/go-drift/drift/pkg/testing"
+)
+
+func contextMenuTestApp(items []ContextMenuItem) core.Widget {
+	return Overlay{
+		Child: widgets.Align{
+			Alignment: layout.AlignmentTopLeft,
+			Child: ContextMenu{
+				Items: items,
+				Child: widgets.SizedBox{Width: 100, Height: 100},
+			},
+		},
+	}
+}
+
+// longPressAt holds a pointer at pos past the long-press deadline.
+func longPressAt(t *testing.T, tester *dtesting.WidgetTester, pos graphics.Offset) {
+	t.Helper()
+	if err := tester.SendPointerDown(pos, 1); err != nil {
+		t.Fatal(err)
+	}
+	tester.Clock().Advance(600 * time.Millisecond)
+	if err := tester.Pump(); err != nil {
+		t.Fatal(err)
+	}
+	if err := tester.SendPointerUp(pos, 1); err != nil {
+		t.Fatal(err)
+	}
+	if err := tester.PumpAndSettle(time.Second); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestContextMenu_LongPressOpensAndItemSelects(t *testing.T) {
+	tester := dtesting.NewWidgetTesterWithT(t)
+	tester.SetSize(graphics.Size{Width: 400, Height: 800})
+
+	deleted := false
+	err := tester.PumpWidget(contextMenuTestApp([]ContextMenuItem{
+		{Label: "Share"},
+		{Label: "Delete", Destructive: true, OnSelected: func() { deleted = true }},
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tester.Find(dtesting.ByText("Delete")).Exists() {
+		t.Fatal("expected menu to be closed before a long press")
+	}
+
+	longPressAt(t, tester, graphics.Offset{X: 50, Y: 50})
+	if !tester.Find(dtesting.ByText("Delete")).Exists() {
+		t.Fatal("expected menu to open on long press")
+	}
+
+	if err := tester.Tap(dtesting.ByText("Delete")); err != nil {
+		t.Fatal(err)
+	}
+	if err := tester.PumpAndSettle(time.Second); err != nil {
+		t.Fatal(err)
+	}
+	if !deleted {
+		t.Error("expected OnSelected to be called")
+	}
+	if tester.Find(dtesting.ByText("Delete")).Exists() {
+		t.Error("expected menu to close after selecting an item")
+	}
+}
+
+func TestContextMenu_TapDoesNotOpen(t *testing.T) {
+	tester := dtesting.NewWidgetTesterWithT(t)
+	tester.SetSize(graphics.Size{Width: 400, Height: 800})
+
+	err := tester.PumpWidget(contextMenuTestApp([]ContextMenuItem{{Label: "Share"}}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := tester.TapAt(graphics.Offset{X: 50, Y: 50}); err != nil {
+		t.Fatal(err)
+	}
+	tester.Clock().Advance(time.Second)
+	if err := tester.PumpAndSettle(time.Second); err != nil {
+		t.Fatal(err)
+	}
+	if tester.Find(dtesting.ByText("Share")).Exists() {
+		t.Error("expected a tap not to open the menu")
+	}
+}
+
+func TestContextMenu_CupertinoBarrierDismisses(t *testing.T) {
+	tester := dtesting.NewWidgetTesterWithT(t)
+	tester.SetSize(graphics.Size{Width: 400, Height: 800})
+	tester.SetTheme(theme.NewAppThemeData(theme.TargetPlatformCupertino, theme.BrightnessLight))
+
+	err := tester.PumpWidget(contextMenuTestApp([]ContextMenuItem{{Label: "Share"}}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	longPressAt(t, tester, graphics.Offset{X: 50, Y: 50})
+	if !tester.Find(dtesting.ByType[widgets.BackdropFilter]()).Exists() {
+		t.Error("expected a blurred barrier on iOS")
+	}
+
+	if err := tester.TapAt(graphics.Offset{X: 350, Y: 750}); err != nil {
+		t.Fatal(err)
+	}
+	if err := tester.PumpAndSettle(time.Second); err != nil {
+		t.Fatal(err)
+	}
+	if tester.Find(dtesting.ByText("Share")).Exists() {
+		t.Error("expected tapping outside to close the menu")
+	}
+}
@@ -0,0 +1,37 @@
+// Package patterns provides ready-made layouts for common app screens,
+// composed from the widgets in [widgets] and [theme].
+//
+// [OnboardingPager] walks through a few introductory pages with skip, next,
+// and done buttons and a [PageIndicator]:
+//
+//	patterns.OnboardingPager{
+//	    Pages: []patterns.OnboardingPage{
+//	        {Image: welcomeArt, Title: "Welcome", Body: "Plan trips with friends."},
+//	        {Image: syncArt, Title: "Stay in sync", Body: "Changes show up everywhere."},
+//	    },
+//	    OnDone: s.finishOnboarding,
+//	    OnSkip: s.finishOnboarding,
+//	}
+//
+// [Paywall] lists what a subscription unlocks and the products to choose
+// from, and reports the purchase to the app, which hands it to its store:
+//
+//	patterns.Paywall{
+//	    Title:      "Go Pro",
+//	    Features:   features,
+//	    Products:   products,
+//	    OnPurchase: s.purchase,
+//	    OnRestore:  s.restore,
+//	    Busy:       s.purchasing,
+//	}
+//
+// # Styling
+//
+// Unlike the widgets in [widgets], patterns take their colors and text
+// styles from the current theme, since they are meant to drop into an app
+// as they are. Where a pattern has color fields, a zero value uses the
+// theme. For layouts the fields do not cover, copy a pattern's source
+// into the app and adapt it.
+//
+// The patterns package is optional: nothing else in drift imports it.
+package patterns
@@ -0,0 +1,313 @@
+package patterns
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/go-drift/drift/pkg/animation"
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/layout"
+	"github.com/go-drift/drift/pkg/semantics"
+	"github.com/go-drift/drift/pkg/theme"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+// Swipes shorter and slower than these do not change the page.
+const (
+	onboardingSwipeDistance = 48
+	onboardingSwipeVelocity = 300
+)
+
+// OnboardingPage is one page of an [OnboardingPager].
+type OnboardingPage struct {
+	// Image is shown above the title, such as an illustration. Optional.
+	Image core.Widget
+	// Title is the page's headline.
+	Title string
+	// Body is the text below the title.
+	Body string
+	// Child replaces the page's image, title, and body with custom content.
+	Child core.Widget
+}
+
+// OnboardingPager shows introductory pages one at a time. Users move
+// between pages by swiping or with the next button, which becomes a done
+// button on the last page. A skip button, shown while OnSkip is set, leaves
+// early.
+//
+//	patterns.OnboardingPager{
+//	    Pages: []patterns.OnboardingPage{
+//	        {Image: welcomeArt, Title: "Welcome", Body: "Plan trips with friends."},
+//	        {Image: syncArt, Title: "Stay in sync", Body: "Changes show up everywhere."},
+//	    },
+//	    OnDone: s.finishOnboarding,
+//	    OnSkip: s.finishOnboarding,
+//	}
+//
+// Pages change with a shared-axis transition. The pager fills the space it
+// is given and keeps its controls inside the safe area.
+type OnboardingPager struct {
+	core.StatefulBase
+
+	// Pages are the pages to show, in order.
+	Pages []OnboardingPage
+	// InitialPage is the index of the page shown first.
+	InitialPage int
+	// OnPageChanged is called with the new page's index when the page changes.
+	OnPageChanged func(index int)
+	// OnDone is called when the done button on the last page is tapped.
+	OnDone func()
+	// OnSkip is called when the skip button is tapped. The skip button is
+	// hidden when nil and on the last page.
+	OnSkip func()
+
+	// SkipLabel is the skip button's label. Zero means "Skip".
+	SkipLabel string
+	// NextLabel is the next button's label. Zero means "Next".
+	NextLabel string
+	// DoneLabel is the last page's button label. Zero means "Get started".
+	DoneLabel string
+}
+
+func (p OnboardingPager) CreateState() core.State {
+	return &onboardingPagerState{}
+}
+
+type onboardingPagerState struct {
+	core.StateBase
+	page    int
+	reverse bool
+	drag    float64
+}
+
+func (s *onboardingPagerState) InitState() {
+	w := s.Element().Widget().(OnboardingPager)
+	s.page = max(0, min(w.InitialPage, len(w.Pages)-1))
+}
+
+func (s *onboardingPagerState) DidUpdateWidget(oldWidget core.StatefulWidget) {
+	// Keep the page in range if pages were removed.
+	w := s.Element().Widget().(OnboardingPager)
+	s.page = max(0, min(s.page, len(w.Pages)-1))
+}
+
+// goTo shows the page at index, if there is one.
+func (s *onboardingPagerState) goTo(index int) {
+	w := s.Element().Widget().(OnboardingPager)
+	if index < 0 || index >= len(w.Pages) || index == s.page {
+		return
+	}
+	s.SetState(func() {
+		s.reverse = index < s.page
+		s.page = index
+	})
+	if w.OnPageChanged != nil {
+		w.OnPageChanged(index)
+	}
+}
+
+func (s *onboardingPagerState) next() {
+	w := s.Element().Widget().(OnboardingPager)
+	if s.page < len(w.Pages)-1 {
+		s.goTo(s.page + 1)
+	} else if w.OnDone != nil {
+		w.OnDone()
+	}
+}
+
+func (s *onboardingPagerState) onDragEnd(details widgets.DragEndDetails) {
+	distance := s.drag
+	s.drag = 0
+	switch {
+	case distance < -onboardingSwipeDistance || details.PrimaryVelocity < -onboardingSwipeVelocity:
+		s.goTo(s.page + 1)
+	case distance > onboardingSwipeDistance || details.PrimaryVelocity > onboardingSwipeVelocity:
+		s.goTo(s.page - 1)
+	}
+}
+
+func (s *onboardingPagerState) Build(ctx core.BuildContext) core.Widget {
+	w := s.Element().Widget().(OnboardingPager)
+	if len(w.Pages) == 0 {
+		return widgets.SizedBox{}
+	}
+	_, colors, textTheme := theme.UseTheme(ctx)
+	last := s.page == len(w.Pages)-1
+
+	var skip core.Widget = widgets.SizedBox{}
+	if w.OnSkip != nil && !last {
+		skip = theme.ButtonOf(ctx, labelOr(w.SkipLabel, "Skip"), w.OnSkip).
+			WithColor(graphics.ColorTransparent, colors.Primary)
+	}
+	nextLabel := labelOr(w.NextLabel, "Next")
+	if last {
+		nextLabel = labelOr(w.DoneLabel, "Get started")
+	}
+
+	return widgets.SafeArea{
+		Child: widgets.Column{
+			MainAxisSize:       widgets.MainAxisSizeMax,
+			CrossAxisAlignment: widgets.CrossAxisAlignmentStretch,
+			Children: []core.Widget{
+				widgets.Expanded{
+					Child: widgets.GestureDetector{
+						OnHorizontalDragStart: func(widgets.DragStartDetails) {
+							s.drag = 0
+						},
+						OnHorizontalDragUpdate: func(details widgets.DragUpdateDetails) {
+							s.drag += details.PrimaryDelta
+						},
+						OnHorizontalDragEnd: s.onDragEnd,
+						Child: widgets.SharedAxisSwitcher{
+							Axis:     widgets.SharedAxisX,
+							Reverse:  s.reverse,
+							Duration: 300 * time.Millisecond,
+							Curve:    animation.EaseInOut,
+							Child: onboardingPageView{
+								index:     s.page,
+								page:      w.Pages[s.page],
+								colors:    colors,
+								textTheme: textTheme,
+							},
+						},
+					},
+				},
+				widgets.Padding{
+					Padding: layout.EdgeInsetsSymmetric(16, 16),
+					Child: widgets.Row{
+						MainAxisSize:       widgets.MainAxisSizeMax,
+						CrossAxisAlignment: widgets.CrossAxisAlignmentCenter,
+						Children: []core.Widget{
+							widgets.Expanded{Child: widgets.Align{Directional: &layout.AlignmentCenterStart, Child: skip}},
+							PageIndicator{Count: len(w.Pages), Current: s.page, OnSelected: s.goTo},
+							widgets.Expanded{Child: widgets.Align{
+								Directional: &layout.AlignmentCenterEnd,
+								Child:       theme.ButtonOf(ctx, nextLabel, s.next),
+							}},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// onboardingPageView lays out a page. It is keyed by index so the switcher
+// transitions between pages.
+type onboardingPageView struct {
+	core.StatelessBase
+	index     int
+	page      OnboardingPage
+	colors    theme.ColorScheme
+	textTheme theme.TextTheme
+}
+
+func (v onboardingPageView) Key() any { return v.index }
+
+func (v onboardingPageView) Build(ctx core.BuildContext) core.Widget {
+	content := v.page.Child
+	if content == nil {
+		children := []core.Widget{}
+		if v.page.Image != nil {
+			children = append(children, widgets.Center{Child: v.page.Image}, widgets.VSpace(32))
+		}
+		title := v.textTheme.HeadlineMedium
+		title.Color = v.colors.OnSurface
+		body := v.textTheme.BodyLarge
+		body.Color = v.colors.OnSurfaceVariant
+		children = append(children,
+			widgets.Semantics{
+				Role:         semantics.SemanticsRoleHeader,
+				HeadingLevel: 1,
+				Child:        widgets.Text{Content: v.page.Title, Style: title, Align: graphics.TextAlignCenter},
+			},
+			widgets.VSpace(12),
+			widgets.Text{Content: v.page.Body, Style: body, Align: graphics.TextAlignCenter},
+		)
+		content = widgets.Column{
+			MainAxisAlignment:  widgets.MainAxisAlignmentCenter,
+			CrossAxisAlignment: widgets.CrossAxisAlignmentStretch,
+			MainAxisSize:       widgets.MainAxisSizeMax,
+			Children:           children,
+		}
+	}
+	return widgets.PaddingAll(24, content)
+}
+
+// PageIndicator shows a dot for each page, with the current page's dot
+// drawn wider in the active color.
+//
+//	patterns.PageIndicator{Count: 3, Current: s.page}
+type PageIndicator struct {
+	core.StatelessBase
+
+	// Count is the number of pages.
+	Count int
+	// Current is the index of the current page.
+	Current int
+	// OnSelected is called with a dot's index when it is tapped. Optional.
+	OnSelected func(index int)
+	// ActiveColor is the current page's dot color. Zero uses the theme's
+	// primary color.
+	ActiveColor graphics.Color
+	// InactiveColor is the other dots' color. Zero uses the theme's outline
+	// variant color.
+	InactiveColor graphics.Color
+}
+
+func (p PageIndicator) Build(ctx core.BuildContext) core.Widget {
+	colors := theme.ColorsOf(ctx)
+	active, inactive := p.ActiveColor, p.InactiveColor
+	if active == 0 {
+		active = colors.Primary
+	}
+	if inactive == 0 {
+		inactive = colors.OutlineVariant
+	}
+
+	dots := make([]core.Widget, 0, p.Count)
+	for i := range p.Count {
+		width, color := 8.0, inactive
+		if i == p.Current {
+			width, color = 24, active
+		}
+		var dot core.Widget = widgets.Padding{
+			Padding: layout.EdgeInsetsSymmetric(4, 8),
+			Child: widgets.ClipRRect{
+				Radius: 4,
+				Child: widgets.AnimatedContainer{
+					Duration: 200 * time.Millisecond,
+					Curve:    animation.EaseInOut,
+					Width:    width,
+					Height:   8,
+					Color:    color,
+				},
+			},
+		}
+		if p.OnSelected != nil {
+			dot = widgets.Tap(func() { p.OnSelected(i) }, dot)
+		}
+		dots = append(dots, dot)
+	}
+
+	current := min(p.Current+1, p.Count)
+	return widgets.Semantics{
+		Label:            fmt.Sprintf("Page %d of %d", current, p.Count),
+		Container:        true,
+		MergeDescendants: true,
+		Child: widgets.Row{
+			MainAxisSize:       widgets.MainAxisSizeMin,
+			CrossAxisAlignment: widgets.CrossAxisAlignmentCenter,
+			Children:           dots,
+		},
+	}
+}
+
+// labelOr returns label, or fallback if label is empty.
+func labelOr(label, fallback string) string {
+	if label == "" {
+		return fallback
+	}
+	return label
+}
@@ -0,0 +1,174 @@
+package patterns_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/patterns"
+	drifttest "github.com/go-drift/drift/pkg/testing"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+var onboardingPages = []patterns.OnboardingPage{
+	{Title: "Welcome", Body: "Plan trips with friends."},
+	{Title: "Stay in sync", Body: "Changes show up everywhere."},
+	{Title: "Go offline", Body: "Your plans are always with you."},
+}
+
+func TestOnboardingPager_NextAndDone(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	tester.SetSize(graphics.Size{Width: 390, Height: 800})
+
+	var pages []int
+	done, skipped := 0, 0
+	tester.PumpWidget(patterns.OnboardingPager{
+		Pages:         onboardingPages,
+		OnPageChanged: func(i int) { pages = append(pages, i) },
+		OnDone:        func() { done++ },
+		OnSkip:        func() { skipped++ },
+	})
+
+	if !tester.Find(drifttest.ByText("Welcome")).Exists() || !tester.Find(drifttest.ByText("Skip")).Exists() {
+		t.Fatal("expected the first page with a skip button")
+	}
+
+	for range 2 {
+		if err := tester.Tap(drifttest.ByText("Next")); err != nil {
+			t.Fatal(err)
+		}
+		tester.PumpAndSettle(time.Second)
+	}
+	if len(pages) != 2 || pages[1] != 2 {
+		t.Errorf("page changes = %v, want [1 2]", pages)
+	}
+	if !tester.Find(drifttest.ByText("Go offline")).Exists() || tester.Find(drifttest.ByText("Welcome")).Exists() {
+		t.Error("expected only the last page after the transition")
+	}
+	if tester.Find(drifttest.ByText("Skip")).Exists() {
+		t.Error("expected no skip button on the last page")
+	}
+
+	if err := tester.Tap(drifttest.ByText("Get started")); err != nil {
+		t.Fatal(err)
+	}
+	if done != 1 || skipped != 0 {
+		t.Errorf("done %d, skipped %d; want 1, 0", done, skipped)
+	}
+}
+
+func TestOnboardingPager_Swipe(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	tester.SetSize(graphics.Size{Width: 390, Height: 800})
+	tester.PumpWidget(patterns.OnboardingPager{Pages: onboardingPages, InitialPage: 1})
+
+	if err := tester.Drag(drifttest.ByText("Stay in sync"), graphics.Offset{X: -200}); err != nil {
+		t.Fatal(err)
+	}
+	tester.PumpAndSettle(time.Second)
+	if !tester.Find(drifttest.ByText("Go offline")).Exists() {
+		t.Fatal("expected a left swipe to show the next page")
+	}
+
+	// Swiping past the last page does nothing.
+	tester.Drag(drifttest.ByText("Go offline"), graphics.Offset{X: -200})
+	tester.PumpAndSettle(time.Second)
+	if !tester.Find(drifttest.ByText("Go offline")).Exists() {
+		t.Fatal("expected to stay on the last page")
+	}
+
+	tester.Drag(drifttest.ByText("Go offline"), graphics.Offset{X: 200})
+	tester.PumpAndSettle(time.Second)
+	if !tester.Find(drifttest.ByText("Stay in sync")).Exists() {
+		t.Error("expected a right swipe to show the previous page")
+	}
+}
+
+func TestPageIndicator_Tap(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	selected := -1
+	tester.PumpWidget(widgets.Center{Child: patterns.PageIndicator{
+		Count:      3,
+		Current:    0,
+		OnSelected: func(i int) { selected = i },
+	}})
+
+	dots := tester.Find(drifttest.ByType[widgets.AnimatedContainer]())
+	if dots.Count() != 3 {
+		t.Fatalf("dots = %d, want 3", dots.Count())
+	}
+	if err := tester.Tap(drifttest.ByPredicate(func(e core.Element) bool { return e == dots.At(2) })); err != nil {
+		t.Fatal(err)
+	}
+	if selected != 2 {
+		t.Errorf("selected = %d, want 2", selected)
+	}
+}
+
+var paywallProducts = []patterns.PaywallProduct{
+	{ID: "pro.monthly", Title: "Monthly", Price: "$4.99 / month"},
+	{ID: "pro.yearly", Title: "Yearly", Price: "$39.99 / year", Badge: "Save 33%"},
+}
+
+func TestPaywall_PurchasesSelectedProduct(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	tester.SetSize(graphics.Size{Width: 390, Height: 800})
+
+	var purchased []string
+	restored := 0
+	tester.PumpWidget(patterns.Paywall{
+		Title:           "Go Pro",
+		Features:        []patterns.PaywallFeature{{Title: "Unlimited projects"}},
+		Products:        paywallProducts,
+		SelectedProduct: "pro.yearly",
+		OnPurchase:      func(p patterns.PaywallProduct) { purchased = append(purchased, p.ID) },
+		OnRestore:       func() { restored++ },
+	})
+
+	if !tester.Find(drifttest.ByText("Unlimited projects")).Exists() || !tester.Find(drifttest.ByText("Save 33%")).Exists() {
+		t.Fatal("expected the features and products")
+	}
+
+	tester.Tap(drifttest.ByText("Continue"))
+	tester.Tap(drifttest.ByText("Monthly"))
+	tester.Pump()
+	tester.Tap(drifttest.ByText("Continue"))
+	tester.Tap(drifttest.ByText("Restore purchases"))
+
+	if len(purchased) != 2 || purchased[0] != "pro.yearly" || purchased[1] != "pro.monthly" {
+		t.Errorf("purchased = %v, want [pro.yearly pro.monthly]", purchased)
+	}
+	if restored != 1 {
+		t.Errorf("restored = %d, want 1", restored)
+	}
+}
+
+func TestPaywall_Busy(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	tester.SetSize(graphics.Size{Width: 390, Height: 800})
+
+	restored := 0
+	tester.PumpWidget(patterns.Paywall{
+		Title:      "Go Pro",
+		Products:   paywallProducts,
+		OnPurchase: func(patterns.PaywallProduct) {},
+		OnRestore:  func() { restored++ },
+		Busy:       true,
+		Error:      "The purchase was cancelled.",
+	})
+
+	if tester.Find(drifttest.ByText("Continue")).Exists() {
+		t.Error("expected no purchase button while busy")
+	}
+	if !tester.Find(drifttest.ByType[widgets.CircularProgressIndicator]()).Exists() {
+		t.Error("expected a progress indicator while busy")
+	}
+	if !tester.Find(drifttest.ByText("The purchase was cancelled.")).Exists() {
+		t.Error("expected the error to be shown")
+	}
+	tester.Tap(drifttest.ByText("Restore purchases"))
+	if restored != 0 {
+		t.Error("expected restore to be disabled while busy")
+	}
+}
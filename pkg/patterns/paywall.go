@@ -0,0 +1,387 @@
+package patterns
+
+import (
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/layout"
+	"github.com/go-drift/drift/pkg/semantics"
+	"github.com/go-drift/drift/pkg/theme"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+// PaywallFeature is a benefit listed on a [Paywall].
+type PaywallFeature struct {
+	// Icon is the glyph shown before the title. Zero means a check mark.
+	Icon string
+	// Title names the feature.
+	Title string
+	// Detail is a line below the title. Optional.
+	Detail string
+}
+
+// PaywallProduct is a purchase option on a [Paywall], such as a monthly or
+// yearly subscription. Its fields are display text; fill them from the
+// app's store, with prices formatted for the user's locale.
+type PaywallProduct struct {
+	// ID identifies the product in the app's store.
+	ID string
+	// Title names the product, such as "Yearly".
+	Title string
+	// Price is the formatted price, such as "$39.99 / year".
+	Price string
+	// Detail is a line below the title, such as "7-day free trial". Optional.
+	Detail string
+	// Badge is a short highlight beside the title, such as "Save 40%".
+	// Optional.
+	Badge string
+}
+
+// Paywall presents what a purchase unlocks and the products to choose
+// from. Tapping a product selects it, and the purchase button passes the
+// selected product to OnPurchase.
+//
+// The paywall does not talk to a store itself. The app starts the purchase
+// or restore with its store and reports progress back through Busy and
+// Error:
+//
+//	patterns.Paywall{
+//	    Title:    "Go Pro",
+//	    Subtitle: "Everything in Free, plus:",
+//	    Features: []patterns.PaywallFeature{
+//	        {Title: "Unlimited projects"},
+//	        {Title: "Offline sync", Detail: "Work without a connection."},
+//	    },
+//	    Products: []patterns.PaywallProduct{
+//	        {ID: "pro.monthly", Title: "Monthly", Price: "$4.99 / month"},
+//	        {ID: "pro.yearly", Title: "Yearly", Price: "$39.99 / year", Badge: "Save 33%"},
+//	    },
+//	    SelectedProduct: "pro.yearly",
+//	    OnPurchase: func(p patterns.PaywallProduct) {
+//	        s.SetState(func() { s.purchasing = true })
+//	        go s.buy(p.ID)
+//	    },
+//	    OnRestore: s.restore,
+//	    OnClose:   s.dismiss,
+//	    Busy:      s.purchasing,
+//	    Error:     s.purchaseError,
+//	}
+//
+// The features and products scroll, while the purchase and restore buttons
+// stay at the bottom inside the safe area.
+type Paywall struct {
+	core.StatefulBase
+
+	// Header is shown at the top of the scrolling content, such as artwork.
+	// Optional.
+	Header core.Widget
+	// Title is the paywall's headline.
+	Title string
+	// Subtitle is shown below the title. Optional.
+	Subtitle string
+	// Features are the benefits of purchasing.
+	Features []PaywallFeature
+	// Products are the purchase options.
+	Products []PaywallProduct
+	// SelectedProduct is the ID of the product selected at first. Zero
+	// selects the first product.
+	SelectedProduct string
+	// Footer is shown below the buttons, such as links to terms and
+	// privacy policy. Optional.
+	Footer core.Widget
+
+	// OnPurchase is called with the selected product when the purchase
+	// button is tapped.
+	OnPurchase func(product PaywallProduct)
+	// OnRestore is called when the restore button is tapped. The restore
+	// button is hidden when nil.
+	OnRestore func()
+	// OnClose is called when the close button is tapped. The close button is
+	// hidden when nil.
+	OnClose func()
+
+	// Busy shows progress in place of the purchase button and disables the
+	// paywall, while a purchase or restore is in progress.
+	Busy bool
+	// Error is shown above the purchase button, such as why a purchase
+	// failed. Optional.
+	Error string
+
+	// PurchaseLabel is the purchase button's label. Zero means "Continue".
+	PurchaseLabel string
+	// RestoreLabel is the restore button's label. Zero means
+	// "Restore purchases".
+	RestoreLabel string
+}
+
+func (p Paywall) CreateState() core.State {
+	return &paywallState{}
+}
+
+type paywallState struct {
+	core.StateBase
+	selected string
+}
+
+func (s *paywallState) InitState() {
+	s.selected = s.Element().Widget().(Paywall).SelectedProduct
+}
+
+// selectedProduct returns the selected product, falling back to the first
+// if the selection is not among the products.
+func (s *paywallState) selectedProduct(w Paywall) (PaywallProduct, bool) {
+	for _, p := range w.Products {
+		if p.ID == s.selected {
+			return p, true
+		}
+	}
+	if len(w.Products) > 0 {
+		return w.Products[0], true
+	}
+	return PaywallProduct{}, false
+}
+
+func (s *paywallState) Build(ctx core.BuildContext) core.Widget {
+	w := s.Element().Widget().(Paywall)
+	_, colors, textTheme := theme.UseTheme(ctx)
+	selected, hasSelection := s.selectedProduct(w)
+
+	content := []core.Widget{}
+	if w.Header != nil {
+		content = append(content, widgets.Center{Child: w.Header}, widgets.VSpace(24))
+	}
+	title := textTheme.HeadlineMedium
+	title.Color = colors.OnSurface
+	content = append(content, widgets.Semantics{
+		Role:         semantics.SemanticsRoleHeader,
+		HeadingLevel: 1,
+		Child:        widgets.Text{Content: w.Title, Style: title, Align: graphics.TextAlignCenter},
+	})
+	if w.Subtitle != "" {
+		subtitle := textTheme.BodyLarge
+		subtitle.Color = colors.OnSurfaceVariant
+		content = append(content,
+			widgets.VSpace(8),
+			widgets.Text{Content: w.Subtitle, Style: subtitle, Align: graphics.TextAlignCenter},
+		)
+	}
+	if len(w.Features) > 0 {
+		content = append(content, widgets.VSpace(24))
+		for _, f := range w.Features {
+			content = append(content, paywallFeatureRow(ctx, f, colors, textTheme))
+		}
+	}
+	if len(w.Products) > 0 {
+		content = append(content, widgets.VSpace(16))
+		for _, p := range w.Products {
+			isSelected := hasSelection && p.ID == selected.ID
+			content = append(content, s.productCard(w, p, isSelected, colors, textTheme))
+		}
+	}
+
+	return widgets.SafeArea{
+		Child: widgets.Column{
+			MainAxisSize:       widgets.MainAxisSizeMax,
+			CrossAxisAlignment: widgets.CrossAxisAlignmentStretch,
+			Children: []core.Widget{
+				s.closeBar(ctx, w, colors),
+				widgets.Expanded{
+					Child: widgets.ScrollView{
+						Padding: layout.EdgeInsetsSymmetric(24, 8),
+						Child: widgets.Column{
+							MainAxisSize:       widgets.MainAxisSizeMin,
+							CrossAxisAlignment: widgets.CrossAxisAlignmentStretch,
+							Children:           content,
+						},
+					},
+				},
+				s.actions(ctx, w, selected, hasSelection, colors, textTheme),
+			},
+		},
+	}
+}
+
+// closeBar returns the row holding the close button, or an empty box.
+func (s *paywallState) closeBar(ctx core.BuildContext, w Paywall, colors theme.ColorScheme) core.Widget {
+	if w.OnClose == nil {
+		return widgets.SizedBox{}
+	}
+	icon := theme.IconOf(ctx, "✕")
+	icon.Color = colors.OnSurfaceVariant
+	var onClose func()
+	if !w.Busy {
+		onClose = w.OnClose
+	}
+	return widgets.Align{
+		Directional: &layout.AlignmentCenterEnd,
+		Child: widgets.Semantics{
+			Label:     "Close",
+			Role:      semantics.SemanticsRoleButton,
+			Flags:     semantics.SemanticsIsButton,
+			Container: true,
+			OnTap:     onClose,
+			Child:     widgets.Tap(onClose, widgets.PaddingAll(12, icon)),
+		},
+	}
+}
+
+// actions returns the error, purchase and restore buttons, and footer.
+func (s *paywallState) actions(ctx core.BuildContext, w Paywall, selected PaywallProduct, hasSelection bool, colors theme.ColorScheme, textTheme theme.TextTheme) core.Widget {
+	children := []core.Widget{}
+	if w.Error != "" {
+		style := textTheme.BodyMedium
+		style.Color = colors.Error
+		children = append(children,
+			widgets.Semantics{
+				Flags: semantics.SemanticsIsLiveRegion,
+				Child: widgets.Text{Content: w.Error, Style: style, Align: graphics.TextAlignCenter},
+			},
+			widgets.VSpace(12),
+		)
+	}
+
+	if w.Busy {
+		children = append(children, widgets.Center{Child: theme.CircularProgressIndicatorOf(ctx, nil)})
+	} else {
+		purchase := func() {
+			if w.OnPurchase != nil {
+				w.OnPurchase(selected)
+			}
+		}
+		children = append(children, widgets.Center{
+			Child: theme.ButtonOf(ctx, labelOr(w.PurchaseLabel, "Continue"), purchase).
+				WithDisabled(!hasSelection),
+		})
+	}
+
+	if w.OnRestore != nil {
+		restore := theme.ButtonOf(ctx, labelOr(w.RestoreLabel, "Restore purchases"), w.OnRestore).
+			WithColor(graphics.ColorTransparent, colors.Primary).
+			WithDisabled(w.Busy)
+		children = append(children, widgets.VSpace(4), widgets.Center{Child: restore})
+	}
+	if w.Footer != nil {
+		children = append(children, widgets.VSpace(8), widgets.Center{Child: w.Footer})
+	}
+
+	return widgets.Padding{
+		Padding: layout.EdgeInsetsSymmetric(24, 16),
+		Child: widgets.Column{
+			MainAxisSize:       widgets.MainAxisSizeMin,
+			CrossAxisAlignment: widgets.CrossAxisAlignmentStretch,
+			Children:           children,
+		},
+	}
+}
+
+// productCard returns a selectable card for a product.
+func (s *paywallState) productCard(w Paywall, p PaywallProduct, selected bool, colors theme.ColorScheme, textTheme theme.TextTheme) core.Widget {
+	background, border, borderWidth := colors.Surface, colors.OutlineVariant, 1.0
+	if selected {
+		background, border, borderWidth = colors.PrimaryContainer, colors.Primary, 2
+	}
+	onColor := colors.OnSurface
+	if selected {
+		onColor = colors.OnPrimaryContainer
+	}
+
+	titleStyle := textTheme.TitleMedium
+	titleStyle.Color = onColor
+	heading := []core.Widget{widgets.Text{Content: p.Title, Style: titleStyle, MaxLines: 1}}
+	if p.Badge != "" {
+		badgeStyle := textTheme.LabelSmall
+		badgeStyle.Color = colors.OnPrimary
+		heading = append(heading, widgets.HSpace(8), widgets.Container{
+			Color:        colors.Primary,
+			BorderRadius: 8,
+			Padding:      layout.EdgeInsetsSymmetric(8, 2),
+			Child:        widgets.Text{Content: p.Badge, Style: badgeStyle, MaxLines: 1},
+		})
+	}
+	details := []core.Widget{widgets.Row{
+		MainAxisSize:       widgets.MainAxisSizeMin,
+		CrossAxisAlignment: widgets.CrossAxisAlignmentCenter,
+		Children:           heading,
+	}}
+	if p.Detail != "" {
+		detailStyle := textTheme.BodySmall
+		detailStyle.Color = onColor
+		details = append(details, widgets.VSpace(4), widgets.Text{Content: p.Detail, Style: detailStyle})
+	}
+	priceStyle := textTheme.TitleMedium
+	priceStyle.Color = onColor
+
+	flags := semantics.SemanticsHasCheckedState | semantics.SemanticsIsInMutuallyExclusiveGroup | semantics.SemanticsHasEnabledState
+	if selected {
+		flags = flags.Set(semantics.SemanticsIsChecked)
+	}
+	var onTap func()
+	if !w.Busy {
+		flags = flags.Set(semantics.SemanticsIsEnabled)
+		onTap = func() {
+			s.SetState(func() { s.selected = p.ID })
+		}
+	}
+
+	return widgets.Padding{
+		Padding: layout.EdgeInsetsSymmetric(0, 6),
+		Child: widgets.Semantics{
+			Role:             semantics.SemanticsRoleRadio,
+			Flags:            flags,
+			Container:        true,
+			MergeDescendants: true,
+			OnTap:            onTap,
+			Child: widgets.Tap(onTap, widgets.Container{
+				Color:        background,
+				BorderColor:  border,
+				BorderWidth:  borderWidth,
+				BorderRadius: 12,
+				Padding:      layout.EdgeInsetsAll(16),
+				Child: widgets.Row{
+					MainAxisSize:       widgets.MainAxisSizeMax,
+					CrossAxisAlignment: widgets.CrossAxisAlignmentCenter,
+					Children: []core.Widget{
+						widgets.Expanded{Child: widgets.Column{
+							MainAxisSize:       widgets.MainAxisSizeMin,
+							CrossAxisAlignment: widgets.CrossAxisAlignmentStart,
+							Children:           details,
+						}},
+						widgets.HSpace(12),
+						widgets.Text{Content: p.Price, Style: priceStyle, MaxLines: 1},
+					},
+				},
+			}),
+		},
+	}
+}
+
+// paywallFeatureRow returns a feature's icon beside its title and detail.
+func paywallFeatureRow(ctx core.BuildContext, f PaywallFeature, colors theme.ColorScheme, textTheme theme.TextTheme) core.Widget {
+	icon := theme.IconOf(ctx, labelOr(f.Icon, "✓"))
+	icon.Color = colors.Primary
+
+	titleStyle := textTheme.BodyLarge
+	titleStyle.Color = colors.OnSurface
+	text := []core.Widget{widgets.Text{Content: f.Title, Style: titleStyle}}
+	if f.Detail != "" {
+		detailStyle := textTheme.BodyMedium
+		detailStyle.Color = colors.OnSurfaceVariant
+		text = append(text, widgets.Text{Content: f.Detail, Style: detailStyle})
+	}
+
+	return widgets.Padding{
+		Padding: layout.EdgeInsetsSymmetric(0, 6),
+		Child: widgets.Row{
+			MainAxisSize:       widgets.MainAxisSizeMax,
+			CrossAxisAlignment: widgets.CrossAxisAlignmentStart,
+			Children: []core.Widget{
+				icon,
+				widgets.HSpace(12),
+				widgets.Expanded{Child: widgets.Column{
+					MainAxisSize:       widgets.MainAxisSizeMin,
+					CrossAxisAlignment: widgets.CrossAxisAlignmentStart,
+					Children:           text,
+				}},
+			},
+		},
+	}
+}
@@ -0,0 +1,84 @@
+package platform
+
+import (
+	"sync"
+)
+
+// AirPlayButtonViewConfig defines styling passed to the native AirPlay button.
+type AirPlayButtonViewConfig struct {
+	// TintColor is the icon color while not casting (ARGB).
+	TintColor uint32
+
+	// ActiveTintColor is the icon color while casting (ARGB).
+	ActiveTintColor uint32
+}
+
+// AirPlayButtonView is a platform view for the native AirPlay route picker
+// (AVRoutePickerView). Android has no AirPlay, so the view is empty there.
+type AirPlayButtonView struct {
+	basePlatformView
+	config AirPlayButtonViewConfig
+	mu     sync.RWMutex
+}
+
+// NewAirPlayButtonView creates a new AirPlay button platform view.
+func NewAirPlayButtonView(viewID int64, config AirPlayButtonViewConfig) *AirPlayButtonView {
+	return &AirPlayButtonView{
+		basePlatformView: basePlatformView{
+			viewID:   viewID,
+			viewType: "airplay_button",
+		},
+		config: config,
+	}
+}
+
+// Create initializes the native view.
+func (v *AirPlayButtonView) Create(params map[string]any) error {
+	return nil
+}
+
+// Dispose cleans up the native view.
+func (v *AirPlayButtonView) Dispose() {
+	// Cleanup handled by registry
+}
+
+// Config returns the current view configuration.
+func (v *AirPlayButtonView) Config() AirPlayButtonViewConfig {
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+	return v.config
+}
+
+// UpdateConfig updates the view configuration.
+func (v *AirPlayButtonView) UpdateConfig(config AirPlayButtonViewConfig) {
+	v.mu.Lock()
+	v.config = config
+	v.mu.Unlock()
+
+	GetPlatformViewRegistry().InvokeViewMethod(v.viewID, "updateConfig", map[string]any{
+		"tintColor":       config.TintColor,
+		"activeTintColor": config.ActiveTintColor,
+	})
+}
+
+// airPlayButtonViewFactory creates AirPlay button platform views.
+type airPlayButtonViewFactory struct{}
+
+func (f *airPlayButtonViewFactory) ViewType() string {
+	return "airplay_button"
+}
+
+func (f *airPlayButtonViewFactory) Create(viewID int64, params map[string]any) (PlatformView, error) {
+	var config AirPlayButtonViewConfig
+	if v, ok := toUint32(params["tintColor"]); ok {
+		config.TintColor = v
+	}
+	if v, ok := toUint32(params["activeTintColor"]); ok {
+		config.ActiveTintColor = v
+	}
+	return NewAirPlayButtonView(viewID, config), nil
+}
+
+func init() {
+	GetPlatformViewRegistry().RegisterFactory(&airPlayButtonViewFactory{})
+}
@@ -0,0 +1,170 @@
+package platform
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/go-drift/drift/pkg/graphics"
+)
+
+// Appearance provides the system display preferences apps adapt to: the
+// user's text size, whether dark mode is on, and the system colors.
+var Appearance = &AppearanceService{
+	events:   NewEventChannel("drift/appearance/events"),
+	settings: AppearanceSettings{TextScaleFactor: 1},
+}
+
+// Brightness is the system light or dark appearance.
+type Brightness int
+
+const (
+	// BrightnessLight is the default light appearance.
+	BrightnessLight Brightness = iota
+	// BrightnessDark is the dark appearance (dark mode).
+	BrightnessDark
+)
+
+// String returns the brightness name.
+func (b Brightness) String() string {
+	switch b {
+	case BrightnessLight:
+		return "light"
+	case BrightnessDark:
+		return "dark"
+	default:
+		return fmt.Sprintf("Brightness(%d)", int(b))
+	}
+}
+
+// AppearanceSettings holds the system display preferences.
+type AppearanceSettings struct {
+	// TextScaleFactor is the user's preferred text size relative to the
+	// default, e.g. 1.3 for 30% larger text. On Android this is the font
+	// scale; on iOS, the Dynamic Type size of body text relative to Large.
+	TextScaleFactor float64
+	// Brightness is the system light or dark appearance.
+	Brightness Brightness
+	// DynamicColors is the system color palette on Android 12 and later,
+	// and zero elsewhere.
+	DynamicColors DynamicColors
+}
+
+// DynamicColors is the system color palette of Android 12 and later
+// (Material You), derived from the wallpaper or from colors the user
+// picked. Each field is the middle tone of one of the system's tonal
+// palettes (system_accent1_500 and so on), which carries the palette's hue
+// and colorfulness; the theme package generates the other tones from it.
+//
+// The zero value means the system has no dynamic colors, as on iOS and
+// older Android versions.
+type DynamicColors struct {
+	// Accent1 is the primary accent palette.
+	Accent1 graphics.Color
+	// Accent2 is the secondary accent palette.
+	Accent2 graphics.Color
+	// Accent3 is the tertiary accent palette.
+	Accent3 graphics.Color
+	// Neutral1 is the palette of surfaces and backgrounds.
+	Neutral1 graphics.Color
+	// Neutral2 is the palette of surface variants and outlines.
+	Neutral2 graphics.Color
+}
+
+// IsZero reports whether the system has no dynamic colors.
+func (c DynamicColors) IsZero() bool {
+	return c == DynamicColors{}
+}
+
+// AppearanceService tracks the system text size, dark mode, and color
+// settings.
+type AppearanceService struct {
+	events   *EventChannel
+	settings AppearanceSettings
+	handlers []func(AppearanceSettings)
+	mu       sync.RWMutex
+}
+
+func init() {
+	initAppearanceListeners()
+	registerBuiltinInit(initAppearanceListeners)
+}
+
+func initAppearanceListeners() {
+	Appearance.events.Listen(EventHandler{
+		OnEvent: func(data any) {
+			m, ok := data.(map[string]any)
+			if !ok {
+				return
+			}
+			settings := AppearanceSettings{TextScaleFactor: 1}
+			if scale, ok := toFloat64(m["textScale"]); ok && scale > 0 {
+				settings.TextScaleFactor = scale
+			}
+			if dark, _ := m["dark"].(bool); dark {
+				settings.Brightness = BrightnessDark
+			}
+			if colors, ok := m["dynamicColors"].(map[string]any); ok {
+				settings.DynamicColors = parseDynamicColors(colors)
+			}
+			Appearance.updateSettings(settings)
+		},
+	})
+}
+
+// parseDynamicColors reads the palette colors of an appearance event, sent
+// as ARGB integers.
+func parseDynamicColors(m map[string]any) DynamicColors {
+	color := func(key string) graphics.Color {
+		v, _ := toInt64(m[key])
+		return graphics.Color(uint32(v))
+	}
+	return DynamicColors{
+		Accent1:  color("accent1"),
+		Accent2:  color("accent2"),
+		Accent3:  color("accent3"),
+		Neutral1: color("neutral1"),
+		Neutral2: color("neutral2"),
+	}
+}
+
+// Settings returns the current display preferences.
+func (a *AppearanceService) Settings() AppearanceSettings {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return a.settings
+}
+
+// AddHandler registers a handler to be called when the text size, dark
+// mode setting, or system colors change. Returns a function that can be called to remove the
+// handler.
+func (a *AppearanceService) AddHandler(handler func(AppearanceSettings)) func() {
+	a.mu.Lock()
+	a.handlers = append(a.handlers, handler)
+	index := len(a.handlers) - 1
+	a.mu.Unlock()
+
+	return func() {
+		a.mu.Lock()
+		if index < len(a.handlers) {
+			a.handlers = append(a.handlers[:index], a.handlers[index+1:]...)
+		}
+		a.mu.Unlock()
+	}
+}
+
+// updateSettings stores the new settings and notifies handlers on change.
+func (a *AppearanceService) updateSettings(settings AppearanceSettings) {
+	a.mu.Lock()
+	if a.settings == settings {
+		a.mu.Unlock()
+		return
+	}
+	a.settings = settings
+	handlers := make([]func(AppearanceSettings), len(a.handlers))
+	copy(handlers, a.handlers)
+	a.mu.Unlock()
+
+	for _, h := range handlers {
+		h(settings)
+	}
+}
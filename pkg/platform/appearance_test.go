@@ -0,0 +1,92 @@
+package platform
+
+import "testing"
+
+func sendEvent(t *testing.T, channel string, event map[string]any) {
+	t.Helper()
+	data, err := DefaultCodec.Encode(event)
+	if err != nil {
+		t.Fatalf("encode event: %v", err)
+	}
+	if err := HandleEvent(channel, data); err != nil {
+		t.Fatalf("HandleEvent: %v", err)
+	}
+}
+
+func TestAppearance_Events(t *testing.T) {
+	SetupTestBridge(t.Cleanup)
+
+	if got := Appearance.Settings(); got.TextScaleFactor != 1 || got.Brightness != BrightnessLight {
+		t.Fatalf("expected default settings, got %+v", got)
+	}
+
+	var notified []AppearanceSettings
+	Appearance.AddHandler(func(s AppearanceSettings) { notified = append(notified, s) })
+
+	sendEvent(t, "drift/appearance/events", map[string]any{"textScale": 1.3, "dark": true})
+	sendEvent(t, "drift/appearance/events", map[string]any{"textScale": 1.3, "dark": true})
+	want := AppearanceSettings{TextScaleFactor: 1.3, Brightness: BrightnessDark}
+	if got := Appearance.Settings(); got != want {
+		t.Errorf("Settings() = %+v, want %+v", got, want)
+	}
+	if len(notified) != 1 {
+		t.Errorf("expected one notification for a repeated event, got %d", len(notified))
+	}
+
+	// Missing or invalid values fall back to the defaults.
+	sendEvent(t, "drift/appearance/events", map[string]any{"textScale": 0})
+	if got := Appearance.Settings(); got.TextScaleFactor != 1 || got.Brightness != BrightnessLight {
+		t.Errorf("expected defaults for an empty event, got %+v", got)
+	}
+}
+
+func TestAppearance_DynamicColors(t *testing.T) {
+	SetupTestBridge(t.Cleanup)
+
+	// Android sends colors as signed 32-bit ARGB integers.
+	sendEvent(t, "drift/appearance/events", map[string]any{
+		"textScale": 1.0,
+		"dynamicColors": map[string]any{
+			"accent1":  int32(-10662726), // 0xFF5D4CBA
+			"accent2":  0xFF7A7489,
+			"accent3":  0xFF9A6A80,
+			"neutral1": 0xFF79767D,
+			"neutral2": 0xFF78757F,
+		},
+	})
+	want := DynamicColors{
+		Accent1:  0xFF5D4CBA,
+		Accent2:  0xFF7A7489,
+		Accent3:  0xFF9A6A80,
+		Neutral1: 0xFF79767D,
+		Neutral2: 0xFF78757F,
+	}
+	if got := Appearance.Settings().DynamicColors; got != want {
+		t.Errorf("DynamicColors = %+v, want %+v", got, want)
+	}
+
+	sendEvent(t, "drift/appearance/events", map[string]any{"textScale": 1.0})
+	if got := Appearance.Settings().DynamicColors; !got.IsZero() {
+		t.Errorf("expected no dynamic colors, got %+v", got)
+	}
+}
+
+func TestKeyboard_Events(t *testing.T) {
+	SetupTestBridge(t.Cleanup)
+
+	var notified EdgeInsets
+	Keyboard.AddHandler(func(insets EdgeInsets) { notified = insets })
+
+	sendEvent(t, "drift/keyboard/events", map[string]any{"bottom": 291.0})
+	if got := Keyboard.Insets(); got != (EdgeInsets{Bottom: 291}) || notified != got {
+		t.Errorf("expected bottom inset 291, got %+v (notified %+v)", got, notified)
+	}
+	if !Keyboard.IsVisible() {
+		t.Error("expected the keyboard to be visible")
+	}
+
+	sendEvent(t, "drift/keyboard/events", map[string]any{"bottom": 0.0})
+	if Keyboard.IsVisible() {
+		t.Error("expected the keyboard to be hidden")
+	}
+}
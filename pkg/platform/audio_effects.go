@@ -0,0 +1,122 @@
+package platform
+
+import (
+	"context"
+	"fmt"
+)
+
+// EqualizerBand is a frequency band of the device equalizer, as reported by
+// [AudioPlayerController.EqualizerBands].
+type EqualizerBand struct {
+	// CenterFrequency is the band's center frequency in hertz.
+	CenterFrequency float64
+
+	// MinGain and MaxGain are the range of gains the band accepts, in
+	// decibels.
+	MinGain float64
+	MaxGain float64
+}
+
+// Equalizer configures the equalizer of an [AudioPlayerController].
+//
+//	bands, _ := s.player.EqualizerBands()
+//	gains := make([]float64, len(bands))
+//	gains[0] = 6 // boost the lowest band by 6 dB
+//	s.player.SetEqualizer(platform.Equalizer{Enabled: true, Gains: gains})
+type Equalizer struct {
+	// Enabled turns the equalizer on. When false, audio plays unprocessed.
+	Enabled bool
+
+	// Preamp is a gain in decibels added to every band, typically negative
+	// to leave headroom for boosted bands.
+	Preamp float64
+
+	// Gains are the gains of the bands in decibels, in the order of
+	// [AudioPlayerController.EqualizerBands]. Missing bands are left flat.
+	// Gains, with the preamp, are clamped to each band's range.
+	Gains []float64
+}
+
+// SetPitch shifts the pitch of playback by a factor (1.0 = normal, 2.0 =
+// an octave up) without changing its speed. The pitch must be positive.
+//
+// Supported on Android. On iOS, AVPlayer cannot shift pitch independently
+// of speed and SetPitch returns an error.
+func (c *AudioPlayerController) SetPitch(pitch float64) error {
+	if pitch <= 0 {
+		return fmt.Errorf("audio player: pitch must be positive, got %v", pitch)
+	}
+	return c.invoke("setPitch", map[string]any{"pitch": pitch})
+}
+
+// EqualizerBands returns the bands of the device equalizer, lowest
+// frequency first. The bands depend on the device; build equalizer UI from
+// them rather than assuming a fixed layout. Returns no bands where the
+// platform has no equalizer, such as iOS.
+func (c *AudioPlayerController) EqualizerBands() ([]EqualizerBand, error) {
+	c.mu.RLock()
+	id := c.id
+	c.mu.RUnlock()
+	if id == 0 {
+		return nil, ErrDisposed
+	}
+	result, err := c.svc.channel.Invoke(context.Background(), "getEqualizerBands", map[string]any{
+		"playerId": id,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return parseEqualizerBands(result)
+}
+
+// SetEqualizer applies eq to the player's output, including the outgoing
+// item during a crossfade.
+//
+// Supported on Android, on devices with an equalizer (see
+// [AudioPlayerController.EqualizerBands]); elsewhere it has no effect. On
+// iOS, AVPlayer has no equalizer and SetEqualizer returns an error.
+func (c *AudioPlayerController) SetEqualizer(eq Equalizer) error {
+	gains := make([]any, len(eq.Gains))
+	for i, g := range eq.Gains {
+		gains[i] = g
+	}
+	return c.invoke("setEqualizer", map[string]any{
+		"enabled": eq.Enabled,
+		"preamp":  eq.Preamp,
+		"gains":   gains,
+	})
+}
+
+// SetLoudnessNormalization sets whether each queue item plays at its
+// [AudioSource.Gain], so that items mastered at different loudness play
+// at a similar level. It applies on both platforms, including across
+// crossfades.
+//
+// Gains are applied relative to the volume set with
+// [AudioPlayerController.SetVolume], and a positive gain cannot raise the
+// output above full volume. Set a volume below 1.0 to leave headroom for
+// quiet items.
+func (c *AudioPlayerController) SetLoudnessNormalization(enabled bool) error {
+	return c.invoke("setLoudnessNormalization", map[string]any{"enabled": enabled})
+}
+
+func parseEqualizerBands(result any) ([]EqualizerBand, error) {
+	m, ok := result.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("audio player: unexpected response from getEqualizerBands: %v", result)
+	}
+	raw, _ := m["bands"].([]any)
+	bands := make([]EqualizerBand, 0, len(raw))
+	for _, r := range raw {
+		b, ok := r.(map[string]any)
+		if !ok {
+			continue
+		}
+		var band EqualizerBand
+		band.CenterFrequency, _ = toFloat64(b["centerFrequency"])
+		band.MinGain, _ = toFloat64(b["minGain"])
+		band.MaxGain, _ = toFloat64(b["maxGain"])
+		bands = append(bands, band)
+	}
+	return bands, nil
+}
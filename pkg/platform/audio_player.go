@@ -27,6 +27,11 @@ var (
 // player instance. Call [AudioPlayerController.Dispose] to release resources
 // when a controller is no longer needed.
 //
+// To play several items in sequence, set a queue with
+// [AudioPlayerController.SetQueue] instead of calling Load; see also
+// [AudioPlayerController.SetRepeatMode], [AudioPlayerController.SetShuffle],
+// and [AudioPlayerController.SetCrossfade].
+//
 // Set callback fields (OnPlaybackStateChanged, OnPositionChanged, OnError)
 // before calling [AudioPlayerController.Load] or any other playback method
 // to ensure no events are missed.
@@ -45,6 +50,17 @@ type AudioPlayerController struct {
 	duration time.Duration
 	buffered time.Duration
 
+	// queue mirrors the native queue; index is the current item, or -1.
+	queue      []AudioSource
+	index      int
+	repeatMode RepeatMode
+	shuffle    bool
+
+	positionListeners map[int]func()
+	nextListenerID    int
+
+	interruptions interruptionHandler
+
 	// OnPlaybackStateChanged is called when the playback state changes.
 	// Called on the UI thread.
 	// Set this before calling [AudioPlayerController.Load] or any other
@@ -66,6 +82,25 @@ type AudioPlayerController struct {
 	// Set this before calling [AudioPlayerController.Load] or any other
 	// playback method to avoid missing events.
 	OnError func(code, message string)
+
+	// OnQueueChanged is called with a copy of the queue when it changes,
+	// including when [AudioPlayerController.Load] replaces it with a single
+	// item.
+	// Called on the UI thread.
+	OnQueueChanged func(queue []AudioSource)
+
+	// OnCurrentIndexChanged is called when playback moves to another item
+	// of the queue, whether by skipping or because an item finished. The
+	// index is -1 when the queue becomes empty.
+	// Called on the UI thread.
+	OnCurrentIndexChanged func(index int)
+
+	// OnInterruption is called when another audio source, such as a phone
+	// call, interrupts playback and when the interruption ends. It is
+	// called after the controller's [InterruptionPolicy] has paused or
+	// resumed playback; see [AudioPlayerController.SetInterruptionPolicy].
+	// Called on the UI thread.
+	OnInterruption func(interruption MediaInterruption)
 }
 
 // NewAudioPlayerController creates a new audio player controller.
@@ -75,8 +110,9 @@ func NewAudioPlayerController() *AudioPlayerController {
 	id := audioPlayerNextID.Add(1)
 
 	c := &AudioPlayerController{
-		id:  id,
-		svc: svc,
+		id:    id,
+		svc:   svc,
+		index: -1,
 	}
 
 	audioRegistryMu.Lock()
@@ -115,17 +151,19 @@ func (c *AudioPlayerController) Buffered() time.Duration {
 }
 
 type audioPlayerServiceState struct {
-	channel *MethodChannel
-	events  *EventChannel
-	errors  *EventChannel
+	channel       *MethodChannel
+	events        *EventChannel
+	errors        *EventChannel
+	interruptions *EventChannel
 }
 
 func ensureAudioService() *audioPlayerServiceState {
 	audioServiceOnce.Do(func() {
 		svc := &audioPlayerServiceState{
-			channel: NewMethodChannel("drift/audio_player"),
-			events:  NewEventChannel("drift/audio_player/events"),
-			errors:  NewEventChannel("drift/audio_player/errors"),
+			channel:       NewMethodChannel("drift/audio_player"),
+			events:        NewEventChannel("drift/audio_player/events"),
+			errors:        NewEventChannel("drift/audio_player/errors"),
+			interruptions: NewEventChannel("drift/audio_player/interruptions"),
 		}
 
 		// Shared event listener: routes events to the correct controller.
@@ -161,6 +199,11 @@ func ensureAudioService() *audioPlayerServiceState {
 				c.buffered = buf
 				c.mu.Unlock()
 
+				// Players with a queue report the index of the current item.
+				if queueIndex, ok := toInt(m["queueIndex"]); ok {
+					c.setCurrentIndex(queueIndex)
+				}
+
 				Dispatch(func() {
 					if stateChanged && c.OnPlaybackStateChanged != nil {
 						c.OnPlaybackStateChanged(state)
@@ -168,6 +211,7 @@ func ensureAudioService() *audioPlayerServiceState {
 					if c.OnPositionChanged != nil {
 						c.OnPositionChanged(pos, dur, buf)
 					}
+					c.notifyPositionListeners()
 				})
 			},
 			OnError: func(err error) {
@@ -214,13 +258,79 @@ func ensureAudioService() *audioPlayerServiceState {
 			},
 		})
 
+		// Shared interruption listener: applies each controller's policy.
+		svc.interruptions.Listen(EventHandler{
+			OnEvent: func(data any) {
+				m, ok := data.(map[string]any)
+				if !ok {
+					return
+				}
+				playerID, _ := toInt64(m["playerId"])
+				audioRegistryMu.RLock()
+				c := audioRegistry[playerID]
+				audioRegistryMu.RUnlock()
+				if c == nil {
+					return
+				}
+
+				interruption := parseMediaInterruption(m)
+				Dispatch(func() {
+					c.interruptions.handle(interruption, c.Pause, c.Play)
+					if c.OnInterruption != nil {
+						c.OnInterruption(interruption)
+					}
+				})
+			},
+			OnError: func(err error) {
+				errors.Report(&errors.DriftError{
+					Op:      "AudioPlayerController.interruptionStream",
+					Kind:    errors.KindPlatform,
+					Channel: "drift/audio_player/interruptions",
+					Err:     err,
+				})
+			},
+		})
+
 		audioService = svc
 	})
 	return audioService
 }
 
+// AddPositionListener adds a callback that fires on the UI thread with each
+// position update, alongside [AudioPlayerController.OnPositionChanged]. Read
+// the position with [AudioPlayerController.Position]. Returns an
+// unsubscribe function.
+func (c *AudioPlayerController) AddPositionListener(fn func()) func() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.positionListeners == nil {
+		c.positionListeners = make(map[int]func())
+	}
+	id := c.nextListenerID
+	c.nextListenerID++
+	c.positionListeners[id] = fn
+	return func() {
+		c.mu.Lock()
+		delete(c.positionListeners, id)
+		c.mu.Unlock()
+	}
+}
+
+func (c *AudioPlayerController) notifyPositionListeners() {
+	c.mu.RLock()
+	listeners := make([]func(), 0, len(c.positionListeners))
+	for _, fn := range c.positionListeners {
+		listeners = append(listeners, fn)
+	}
+	c.mu.RUnlock()
+	for _, fn := range listeners {
+		fn()
+	}
+}
+
 // Load prepares the given URL for playback. The native player begins buffering
 // the media source. Call [AudioPlayerController.Play] to start playback.
+// Load replaces the queue with the single item.
 func (c *AudioPlayerController) Load(url string) error {
 	c.mu.RLock()
 	id := c.id
@@ -232,7 +342,11 @@ func (c *AudioPlayerController) Load(url string) error {
 		"playerId": id,
 		"url":      url,
 	})
-	return err
+	if err != nil {
+		return err
+	}
+	c.replaceQueue([]AudioSource{{URL: url}}, 0)
+	return nil
 }
 
 // Play starts or resumes playback. Call [AudioPlayerController.Load] first
@@ -311,7 +425,8 @@ func (c *AudioPlayerController) SetVolume(volume float64) error {
 	return err
 }
 
-// SetLooping sets whether playback should loop.
+// SetLooping sets whether playback should loop. Looping repeats the whole
+// queue, the same as [RepeatModeAll]; turning it off sets [RepeatModeOff].
 func (c *AudioPlayerController) SetLooping(looping bool) error {
 	c.mu.RLock()
 	id := c.id
@@ -323,7 +438,17 @@ func (c *AudioPlayerController) SetLooping(looping bool) error {
 		"playerId": id,
 		"looping":  looping,
 	})
-	return err
+	if err != nil {
+		return err
+	}
+	c.mu.Lock()
+	if looping {
+		c.repeatMode = RepeatModeAll
+	} else {
+		c.repeatMode = RepeatModeOff
+	}
+	c.mu.Unlock()
+	return nil
 }
 
 // SetPlaybackSpeed sets the playback speed (1.0 = normal). The rate must be
@@ -342,6 +467,12 @@ func (c *AudioPlayerController) SetPlaybackSpeed(rate float64) error {
 	return err
 }
 
+// SetInterruptionPolicy sets what the player does when another audio
+// source interrupts it. The default is [InterruptionPauseAndResume].
+func (c *AudioPlayerController) SetInterruptionPolicy(policy InterruptionPolicy) {
+	c.interruptions.setPolicy(policy)
+}
+
 // Dispose releases the audio player and its native resources. After disposal,
 // this controller must not be reused. Dispose is idempotent; calling it more
 // than once is safe.
@@ -349,6 +480,7 @@ func (c *AudioPlayerController) Dispose() {
 	c.mu.Lock()
 	id := c.id
 	c.id = 0
+	c.positionListeners = nil
 	c.mu.Unlock()
 	if id == 0 {
 		return
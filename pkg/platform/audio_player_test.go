@@ -397,6 +397,16 @@ func TestAudioPlayerController_MethodsReturnErrDisposedAfterDispose(t *testing.T
 		{"SetVolume", func() error { return c.SetVolume(0.5) }},
 		{"SetLooping", func() error { return c.SetLooping(true) }},
 		{"SetPlaybackSpeed", func() error { return c.SetPlaybackSpeed(1.5) }},
+		{"SetQueue", func() error { return c.SetQueue([]AudioSource{{URL: "a"}}, 0) }},
+		{"SkipToNext", func() error { return c.SkipToNext() }},
+		{"SkipToPrevious", func() error { return c.SkipToPrevious() }},
+		{"SetRepeatMode", func() error { return c.SetRepeatMode(RepeatModeAll) }},
+		{"SetShuffle", func() error { return c.SetShuffle(true) }},
+		{"SetCrossfade", func() error { return c.SetCrossfade(time.Second) }},
+		{"SetPitch", func() error { return c.SetPitch(1.2) }},
+		{"SetEqualizer", func() error { return c.SetEqualizer(Equalizer{Enabled: true}) }},
+		{"EqualizerBands", func() error { _, err := c.EqualizerBands(); return err }},
+		{"SetLoudnessNormalization", func() error { return c.SetLoudnessNormalization(true) }},
 	} {
 		if err := tc.fn(); err != ErrDisposed {
 			t.Errorf("%s after Dispose: got %v, want ErrDisposed", tc.name, err)
@@ -487,3 +497,342 @@ func TestAudioPlayerController_EventRoutesToCorrectInstance(t *testing.T) {
 		t.Errorf("c2 should be Playing, got %v", c2State)
 	}
 }
+
+// sendAudioQueueEvent simulates a native playback event that reports the
+// current queue index.
+func sendAudioQueueEvent(t *testing.T, c *AudioPlayerController, state int, queueIndex int) {
+	t.Helper()
+	data, err := DefaultCodec.Encode(map[string]any{
+		"playerId":      c.id,
+		"playbackState": state,
+		"positionMs":    int64(0),
+		"durationMs":    int64(180000),
+		"bufferedMs":    int64(0),
+		"queueIndex":    queueIndex,
+	})
+	if err != nil {
+		t.Fatalf("encode event: %v", err)
+	}
+	if err := HandleEvent("drift/audio_player/events", data); err != nil {
+		t.Fatalf("HandleEvent: %v", err)
+	}
+}
+
+func TestAudioPlayerController_SetQueue(t *testing.T) {
+	bridge := setupTestBridge(t)
+
+	c := NewAudioPlayerController()
+	defer c.Dispose()
+
+	if c.CurrentIndex() != -1 {
+		t.Errorf("initial CurrentIndex(): got %d, want -1", c.CurrentIndex())
+	}
+
+	var queues [][]AudioSource
+	c.OnQueueChanged = func(queue []AudioSource) {
+		queues = append(queues, queue)
+	}
+	var indices []int
+	c.OnCurrentIndexChanged = func(index int) {
+		indices = append(indices, index)
+	}
+
+	sources := []AudioSource{
+		{URL: "https://example.com/1.mp3", Title: "One", Artist: "A"},
+		{URL: "https://example.com/2.mp3", Title: "Two"},
+		{URL: "https://example.com/3.mp3", Title: "Three"},
+	}
+	bridge.reset()
+	if err := c.SetQueue(sources, 1); err != nil {
+		t.Fatalf("SetQueue: %v", err)
+	}
+
+	if len(bridge.calls) != 1 || bridge.calls[0].method != "setQueue" {
+		t.Fatalf("calls: got %+v, want one setQueue", bridge.calls)
+	}
+	args := bridge.calls[0].args.(map[string]any)
+	if args["startIndex"] != float64(1) {
+		t.Errorf("startIndex: got %v, want 1", args["startIndex"])
+	}
+	encoded := args["sources"].([]any)
+	if len(encoded) != 3 || encoded[0].(map[string]any)["title"] != "One" {
+		t.Errorf("sources: got %v", encoded)
+	}
+
+	if got := c.Queue(); len(got) != 3 || got[2] != sources[2] {
+		t.Errorf("Queue(): got %v", got)
+	}
+	if c.CurrentIndex() != 1 {
+		t.Errorf("CurrentIndex(): got %d, want 1", c.CurrentIndex())
+	}
+	if len(queues) != 1 || len(queues[0]) != 3 {
+		t.Errorf("OnQueueChanged: got %v", queues)
+	}
+	if len(indices) != 1 || indices[0] != 1 {
+		t.Errorf("OnCurrentIndexChanged: got %v, want [1]", indices)
+	}
+
+	if err := c.SetQueue(sources, 3); err == nil {
+		t.Error("SetQueue with out-of-range start index: expected error")
+	}
+}
+
+func TestAudioPlayerController_QueueEdits(t *testing.T) {
+	setupTestBridge(t)
+
+	c := NewAudioPlayerController()
+	defer c.Dispose()
+
+	a := AudioSource{URL: "a"}
+	b := AudioSource{URL: "b"}
+	x := AudioSource{URL: "x"}
+	if err := c.SetQueue([]AudioSource{a, b}, 1); err != nil {
+		t.Fatalf("SetQueue: %v", err)
+	}
+
+	// Inserting before the current item shifts its index.
+	if err := c.InsertIntoQueue(0, x); err != nil {
+		t.Fatalf("InsertIntoQueue: %v", err)
+	}
+	if c.CurrentIndex() != 2 {
+		t.Errorf("after insert before current: got index %d, want 2", c.CurrentIndex())
+	}
+
+	// Appending leaves it alone.
+	if err := c.AddToQueue(x); err != nil {
+		t.Fatalf("AddToQueue: %v", err)
+	}
+	if got := c.Queue(); len(got) != 4 || got[3] != x {
+		t.Errorf("after append: got %v", got)
+	}
+	if c.CurrentIndex() != 2 {
+		t.Errorf("after append: got index %d, want 2", c.CurrentIndex())
+	}
+
+	// Removing an earlier item shifts it back.
+	if err := c.RemoveFromQueue(0); err != nil {
+		t.Fatalf("RemoveFromQueue: %v", err)
+	}
+	if c.CurrentIndex() != 1 {
+		t.Errorf("after remove before current: got index %d, want 1", c.CurrentIndex())
+	}
+
+	// Removing the current last item moves to the one before it.
+	if err := c.RemoveFromQueue(2); err != nil {
+		t.Fatalf("RemoveFromQueue: %v", err)
+	}
+	if err := c.RemoveFromQueue(1); err != nil {
+		t.Fatalf("RemoveFromQueue: %v", err)
+	}
+	if c.CurrentIndex() != 0 {
+		t.Errorf("after removing current last item: got index %d, want 0", c.CurrentIndex())
+	}
+	if err := c.RemoveFromQueue(0); err != nil {
+		t.Fatalf("RemoveFromQueue: %v", err)
+	}
+	if c.CurrentIndex() != -1 {
+		t.Errorf("after emptying queue: got index %d, want -1", c.CurrentIndex())
+	}
+
+	for _, tc := range []struct {
+		name string
+		fn   func() error
+	}{
+		{"InsertIntoQueue", func() error { return c.InsertIntoQueue(1, x) }},
+		{"RemoveFromQueue", func() error { return c.RemoveFromQueue(0) }},
+		{"SkipToIndex", func() error { return c.SkipToIndex(0) }},
+	} {
+		if err := tc.fn(); err == nil {
+			t.Errorf("%s out of range: expected error", tc.name)
+		}
+	}
+}
+
+func TestAudioPlayerController_QueueIndexFromEvents(t *testing.T) {
+	setupTestBridge(t)
+
+	c := NewAudioPlayerController()
+	defer c.Dispose()
+
+	if err := c.SetQueue([]AudioSource{{URL: "a"}, {URL: "b"}, {URL: "c"}}, 0); err != nil {
+		t.Fatalf("SetQueue: %v", err)
+	}
+	var indices []int
+	c.OnCurrentIndexChanged = func(index int) {
+		indices = append(indices, index)
+	}
+
+	sendAudioQueueEvent(t, c, 2, 0) // Unchanged
+	sendAudioQueueEvent(t, c, 2, 1) // Advanced to the next item
+	sendAudioQueueEvent(t, c, 2, 1) // Dedup
+	if err := c.SkipToIndex(2); err != nil {
+		t.Fatalf("SkipToIndex: %v", err)
+	}
+	sendAudioQueueEvent(t, c, 2, 2) // Native confirms the skip
+
+	want := []int{1, 2}
+	if len(indices) != len(want) || indices[0] != want[0] || indices[1] != want[1] {
+		t.Errorf("OnCurrentIndexChanged: got %v, want %v", indices, want)
+	}
+	if c.CurrentIndex() != 2 {
+		t.Errorf("CurrentIndex(): got %d, want 2", c.CurrentIndex())
+	}
+}
+
+func TestAudioPlayerController_PlaylistModes(t *testing.T) {
+	bridge := setupTestBridge(t)
+
+	c := NewAudioPlayerController()
+	defer c.Dispose()
+
+	if c.RepeatMode() != RepeatModeOff || c.Shuffle() {
+		t.Error("expected repeat off and shuffle off initially")
+	}
+
+	bridge.reset()
+	if err := c.SetRepeatMode(RepeatModeOne); err != nil {
+		t.Fatalf("SetRepeatMode: %v", err)
+	}
+	if err := c.SetShuffle(true); err != nil {
+		t.Fatalf("SetShuffle: %v", err)
+	}
+	if err := c.SetCrossfade(3 * time.Second); err != nil {
+		t.Fatalf("SetCrossfade: %v", err)
+	}
+	if c.RepeatMode() != RepeatModeOne || !c.Shuffle() {
+		t.Errorf("got repeat %v shuffle %v, want one and true", c.RepeatMode(), c.Shuffle())
+	}
+
+	want := []struct {
+		method string
+		key    string
+		value  any
+	}{
+		{"setRepeatMode", "mode", float64(RepeatModeOne)},
+		{"setShuffle", "enabled", true},
+		{"setCrossfade", "durationMs", float64(3000)},
+	}
+	if len(bridge.calls) != len(want) {
+		t.Fatalf("calls: got %d, want %d", len(bridge.calls), len(want))
+	}
+	for i, w := range want {
+		call := bridge.calls[i]
+		args := call.args.(map[string]any)
+		if call.method != w.method || args[w.key] != w.value || args["playerId"] != float64(c.id) {
+			t.Errorf("call[%d]: got %s %v, want %s %s=%v", i, call.method, args, w.method, w.key, w.value)
+		}
+	}
+
+	// SetLooping is shorthand for repeating the whole queue.
+	if err := c.SetLooping(true); err != nil {
+		t.Fatalf("SetLooping: %v", err)
+	}
+	if c.RepeatMode() != RepeatModeAll {
+		t.Errorf("after SetLooping(true): got %v, want all", c.RepeatMode())
+	}
+}
+
+func TestAudioPlayerController_LoadReplacesQueue(t *testing.T) {
+	setupTestBridge(t)
+
+	c := NewAudioPlayerController()
+	defer c.Dispose()
+
+	if err := c.SetQueue([]AudioSource{{URL: "a"}, {URL: "b"}}, 1); err != nil {
+		t.Fatalf("SetQueue: %v", err)
+	}
+	if err := c.Load("https://example.com/song.mp3"); err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if got := c.Queue(); len(got) != 1 || got[0].URL != "https://example.com/song.mp3" {
+		t.Errorf("Queue(): got %v", got)
+	}
+	if c.CurrentIndex() != 0 {
+		t.Errorf("CurrentIndex(): got %d, want 0", c.CurrentIndex())
+	}
+}
+
+func TestAudioPlayerController_PositionListeners(t *testing.T) {
+	setupTestBridge(t)
+
+	c := NewAudioPlayerController()
+	defer c.Dispose()
+
+	var a, b int
+	removeA := c.AddPositionListener(func() { a++ })
+	c.AddPositionListener(func() { b++ })
+
+	sendAudioEvent(t, c, 2, 250, 180000, 0)
+	removeA()
+	sendAudioEvent(t, c, 2, 500, 180000, 0)
+
+	if a != 1 || b != 2 {
+		t.Errorf("listener calls: got a=%d b=%d, want a=1 b=2", a, b)
+	}
+}
+
+func TestAudioPlayerController_Effects(t *testing.T) {
+	bridge := setupTestBridge(t)
+
+	c := NewAudioPlayerController()
+	defer c.Dispose()
+
+	if err := c.SetPitch(0); err == nil {
+		t.Error("SetPitch(0): expected error")
+	}
+	bridge.reset()
+	if err := c.SetPitch(1.5); err != nil {
+		t.Fatalf("SetPitch: %v", err)
+	}
+	if err := c.SetEqualizer(Equalizer{Enabled: true, Preamp: -3, Gains: []float64{6, 0, -2}}); err != nil {
+		t.Fatalf("SetEqualizer: %v", err)
+	}
+	if err := c.SetLoudnessNormalization(true); err != nil {
+		t.Fatalf("SetLoudnessNormalization: %v", err)
+	}
+	if len(bridge.calls) != 3 {
+		t.Fatalf("calls: got %+v, want 3", bridge.calls)
+	}
+	if args := bridge.calls[0].args.(map[string]any); bridge.calls[0].method != "setPitch" || args["pitch"] != 1.5 {
+		t.Errorf("setPitch: got %s %v", bridge.calls[0].method, args)
+	}
+	args := bridge.calls[1].args.(map[string]any)
+	gains, _ := args["gains"].([]any)
+	if bridge.calls[1].method != "setEqualizer" || args["enabled"] != true || args["preamp"] != float64(-3) || len(gains) != 3 || gains[0] != float64(6) {
+		t.Errorf("setEqualizer: got %s %v", bridge.calls[1].method, args)
+	}
+	if args := bridge.calls[2].args.(map[string]any); args["enabled"] != true || args["playerId"] != float64(c.id) {
+		t.Errorf("setLoudnessNormalization: got %v", args)
+	}
+
+	bridge.reset()
+	if err := c.SetQueue([]AudioSource{{URL: "a", Gain: -4.5}}, 0); err != nil {
+		t.Fatalf("SetQueue: %v", err)
+	}
+	source := bridge.calls[0].args.(map[string]any)["sources"].([]any)[0].(map[string]any)
+	if source["gain"] != -4.5 {
+		t.Errorf("source gain: got %v, want -4.5", source["gain"])
+	}
+}
+
+func TestParseEqualizerBands(t *testing.T) {
+	bands, err := parseEqualizerBands(map[string]any{"bands": []any{
+		map[string]any{"centerFrequency": 60.0, "minGain": -15.0, "maxGain": 15.0},
+		map[string]any{"centerFrequency": 14000.0, "minGain": -15.0, "maxGain": 15.0},
+	}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []EqualizerBand{{60, -15, 15}, {14000, -15, 15}}
+	if len(bands) != 2 || bands[0] != want[0] || bands[1] != want[1] {
+		t.Errorf("bands: got %v, want %v", bands, want)
+	}
+
+	// Platforms without an equalizer report no bands.
+	if bands, err := parseEqualizerBands(map[string]any{"bands": []any{}}); err != nil || len(bands) != 0 {
+		t.Errorf("empty bands: got %v, %v", bands, err)
+	}
+	if _, err := parseEqualizerBands(nil); err == nil {
+		t.Error("expected error for a nil response")
+	}
+}
@@ -0,0 +1,314 @@
+package platform
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// AudioSource is an item in an [AudioPlayerController] queue.
+type AudioSource struct {
+	// URL is the media to play.
+	URL string
+
+	// Title and Artist describe the item for the app's own UI. They are
+	// passed to the native player but not otherwise used by Drift.
+	Title  string
+	Artist string
+
+	// Gain is the item's loudness normalization gain in decibels, such as
+	// its ReplayGain track gain. It applies while loudness normalization is
+	// on; see [AudioPlayerController.SetLoudnessNormalization].
+	Gain float64
+}
+
+// RepeatMode controls what an [AudioPlayerController] plays after an item
+// finishes.
+type RepeatMode int
+
+const (
+	// RepeatModeOff plays the queue once and stops after the last item.
+	RepeatModeOff RepeatMode = iota
+
+	// RepeatModeOne repeats the current item.
+	RepeatModeOne
+
+	// RepeatModeAll returns to the first item after the last one.
+	RepeatModeAll
+)
+
+// String returns a human-readable label for the repeat mode.
+func (m RepeatMode) String() string {
+	switch m {
+	case RepeatModeOff:
+		return "off"
+	case RepeatModeOne:
+		return "one"
+	case RepeatModeAll:
+		return "all"
+	default:
+		return fmt.Sprintf("RepeatMode(%d)", int(m))
+	}
+}
+
+// SetQueue replaces the queue with sources and prepares the item at
+// startIndex for playback. Call [AudioPlayerController.Play] to start.
+// Items play back to back without gaps unless a crossfade is set with
+// [AudioPlayerController.SetCrossfade]. An empty queue stops playback.
+func (c *AudioPlayerController) SetQueue(sources []AudioSource, startIndex int) error {
+	if len(sources) > 0 && (startIndex < 0 || startIndex >= len(sources)) {
+		return fmt.Errorf("audio player: start index %d out of range [0, %d)", startIndex, len(sources))
+	}
+	if err := c.invoke("setQueue", map[string]any{
+		"sources":    encodeAudioSources(sources),
+		"startIndex": startIndex,
+	}); err != nil {
+		return err
+	}
+	if len(sources) == 0 {
+		startIndex = -1
+	}
+	c.replaceQueue(slices.Clone(sources), startIndex)
+	return nil
+}
+
+// AddToQueue appends sources to the end of the queue.
+func (c *AudioPlayerController) AddToQueue(sources ...AudioSource) error {
+	c.mu.RLock()
+	n := len(c.queue)
+	c.mu.RUnlock()
+	return c.InsertIntoQueue(n, sources...)
+}
+
+// InsertIntoQueue inserts sources before the item at index. An index equal
+// to the queue length appends them. The current item keeps playing.
+func (c *AudioPlayerController) InsertIntoQueue(index int, sources ...AudioSource) error {
+	c.mu.RLock()
+	n := len(c.queue)
+	c.mu.RUnlock()
+	if index < 0 || index > n {
+		return fmt.Errorf("audio player: insert index %d out of range [0, %d]", index, n)
+	}
+	if len(sources) == 0 {
+		return nil
+	}
+	if err := c.invoke("insertIntoQueue", map[string]any{
+		"index":   index,
+		"sources": encodeAudioSources(sources),
+	}); err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	index = min(index, len(c.queue))
+	queue := slices.Insert(slices.Clone(c.queue), index, sources...)
+	current := c.index
+	if current < 0 {
+		// The first items of an empty queue become current.
+		current = 0
+	} else if index <= current {
+		current += len(sources)
+	}
+	c.mu.Unlock()
+	c.replaceQueue(queue, current)
+	return nil
+}
+
+// RemoveFromQueue removes the item at index. Removing the current item
+// moves playback to the item after it, or stops if it was the last one.
+func (c *AudioPlayerController) RemoveFromQueue(index int) error {
+	c.mu.RLock()
+	n := len(c.queue)
+	c.mu.RUnlock()
+	if index < 0 || index >= n {
+		return fmt.Errorf("audio player: remove index %d out of range [0, %d)", index, n)
+	}
+	if err := c.invoke("removeFromQueue", map[string]any{
+		"index": index,
+	}); err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	if index >= len(c.queue) {
+		c.mu.Unlock()
+		return nil
+	}
+	queue := slices.Delete(slices.Clone(c.queue), index, index+1)
+	current := c.index
+	if index < current || current >= len(queue) {
+		current--
+	}
+	c.mu.Unlock()
+	c.replaceQueue(queue, current)
+	return nil
+}
+
+// Queue returns a copy of the queue, in the order it was set. Shuffling
+// changes the play order but not the queue.
+func (c *AudioPlayerController) Queue() []AudioSource {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return slices.Clone(c.queue)
+}
+
+// CurrentIndex returns the queue index of the current item, or -1 if the
+// queue is empty.
+func (c *AudioPlayerController) CurrentIndex() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.index
+}
+
+// SkipToNext moves to the next item in play order. At the end of the queue
+// it wraps to the start when the repeat mode is [RepeatModeAll], and does
+// nothing otherwise.
+func (c *AudioPlayerController) SkipToNext() error {
+	return c.invoke("skipToNext", nil)
+}
+
+// SkipToPrevious moves to the previous item in play order. If more than
+// three seconds of the current item have played, it restarts the current
+// item instead, as music players conventionally do.
+func (c *AudioPlayerController) SkipToPrevious() error {
+	return c.invoke("skipToPrevious", nil)
+}
+
+// SkipToIndex moves to the item at index in the queue and plays it from
+// the start.
+func (c *AudioPlayerController) SkipToIndex(index int) error {
+	c.mu.RLock()
+	n := len(c.queue)
+	c.mu.RUnlock()
+	if index < 0 || index >= n {
+		return fmt.Errorf("audio player: skip index %d out of range [0, %d)", index, n)
+	}
+	if err := c.invoke("skipToIndex", map[string]any{
+		"index": index,
+	}); err != nil {
+		return err
+	}
+	c.setCurrentIndex(index)
+	return nil
+}
+
+// SetRepeatMode sets what plays after an item finishes.
+// [AudioPlayerController.SetLooping] is shorthand for [RepeatModeAll] and
+// [RepeatModeOff].
+func (c *AudioPlayerController) SetRepeatMode(mode RepeatMode) error {
+	if err := c.invoke("setRepeatMode", map[string]any{
+		"mode": int(mode),
+	}); err != nil {
+		return err
+	}
+	c.mu.Lock()
+	c.repeatMode = mode
+	c.mu.Unlock()
+	return nil
+}
+
+// RepeatMode returns the current repeat mode.
+func (c *AudioPlayerController) RepeatMode() RepeatMode {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.repeatMode
+}
+
+// SetShuffle sets whether the queue plays in a random order. The current
+// item keeps playing, and the rest of the queue is shuffled after it.
+func (c *AudioPlayerController) SetShuffle(enabled bool) error {
+	if err := c.invoke("setShuffle", map[string]any{
+		"enabled": enabled,
+	}); err != nil {
+		return err
+	}
+	c.mu.Lock()
+	c.shuffle = enabled
+	c.mu.Unlock()
+	return nil
+}
+
+// Shuffle reports whether shuffle is enabled.
+func (c *AudioPlayerController) Shuffle() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.shuffle
+}
+
+// SetCrossfade sets how long consecutive items overlap, with the finishing
+// item fading out while the next fades in. Zero, the default, plays items
+// back to back without a gap. Crossfades apply when an item finishes on its
+// own, not when skipping.
+func (c *AudioPlayerController) SetCrossfade(duration time.Duration) error {
+	return c.invoke("setCrossfade", map[string]any{
+		"durationMs": max(duration, 0).Milliseconds(),
+	})
+}
+
+// invoke calls a native method for this player, adding the player ID to
+// args. Returns [ErrDisposed] after Dispose.
+func (c *AudioPlayerController) invoke(method string, args map[string]any) error {
+	c.mu.RLock()
+	id := c.id
+	c.mu.RUnlock()
+	if id == 0 {
+		return ErrDisposed
+	}
+	if args == nil {
+		args = map[string]any{}
+	}
+	args["playerId"] = id
+	_, err := c.svc.channel.Invoke(context.Background(), method, args)
+	return err
+}
+
+// replaceQueue caches the queue and current index and notifies the
+// callbacks.
+func (c *AudioPlayerController) replaceQueue(queue []AudioSource, index int) {
+	c.mu.Lock()
+	c.queue = queue
+	indexChanged := index != c.index
+	c.index = index
+	c.mu.Unlock()
+
+	Dispatch(func() {
+		if c.OnQueueChanged != nil {
+			c.OnQueueChanged(slices.Clone(queue))
+		}
+		if indexChanged && c.OnCurrentIndexChanged != nil {
+			c.OnCurrentIndexChanged(index)
+		}
+	})
+}
+
+// setCurrentIndex caches the current index, notifying
+// OnCurrentIndexChanged if it changed.
+func (c *AudioPlayerController) setCurrentIndex(index int) {
+	c.mu.Lock()
+	if index == c.index || index >= len(c.queue) {
+		c.mu.Unlock()
+		return
+	}
+	c.index = index
+	c.mu.Unlock()
+
+	Dispatch(func() {
+		if c.OnCurrentIndexChanged != nil {
+			c.OnCurrentIndexChanged(index)
+		}
+	})
+}
+
+func encodeAudioSources(sources []AudioSource) []any {
+	encoded := make([]any, len(sources))
+	for i, s := range sources {
+		encoded[i] = map[string]any{
+			"url":    s.URL,
+			"title":  s.Title,
+			"artist": s.Artist,
+			"gain":   s.Gain,
+		}
+	}
+	return encoded
+}
@@ -0,0 +1,122 @@
+package platform
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Waveform is the amplitude envelope of audio: the loudest sample in each
+// of a number of equal slices of its duration.
+type Waveform struct {
+	// Peaks are the peak amplitudes of consecutive slices, from 0 (silence)
+	// to 1 (full scale).
+	Peaks []float64
+
+	// Duration is the length of the audio the peaks cover.
+	Duration time.Duration
+}
+
+// Resample returns the waveform with count peaks, each the largest of the
+// peaks it covers, so that short transients survive downsampling. Use it
+// to fit a waveform to the number of bars on screen. Upsampling repeats
+// peaks.
+func (w Waveform) Resample(count int) Waveform {
+	if count <= 0 || len(w.Peaks) == 0 {
+		return Waveform{Duration: w.Duration}
+	}
+	peaks := make([]float64, count)
+	n := len(w.Peaks)
+	for i := range peaks {
+		start := i * n / count
+		end := max((i+1)*n/count, start+1)
+		for _, p := range w.Peaks[start:end] {
+			peaks[i] = max(peaks[i], p)
+		}
+	}
+	return Waveform{Peaks: peaks, Duration: w.Duration}
+}
+
+// WaveformFromPCM computes a waveform with count peaks from 16-bit PCM
+// samples, interleaved if there are several channels. Use it for audio the
+// app records or decodes itself; pass the samples recorded so far to draw a
+// live waveform while recording.
+func WaveformFromPCM(samples []int16, sampleRate, channels, count int) Waveform {
+	if sampleRate <= 0 || channels <= 0 || count <= 0 {
+		return Waveform{}
+	}
+	frames := len(samples) / channels
+	w := Waveform{
+		Peaks:    make([]float64, count),
+		Duration: time.Duration(frames) * time.Second / time.Duration(sampleRate),
+	}
+	if frames == 0 {
+		return w
+	}
+	for i := range frames * channels {
+		bucket := (i / channels) * count / frames
+		s := int(samples[i])
+		if s < 0 {
+			s = -s
+		}
+		w.Peaks[bucket] = max(w.Peaks[bucket], float64(s)/32768)
+	}
+	return w
+}
+
+// Audio provides audio processing that runs on the device, independent of
+// any [AudioPlayerController].
+var Audio = &AudioService{
+	channel: NewMethodChannel("drift/audio"),
+}
+
+// AudioService decodes audio on the device.
+type AudioService struct {
+	channel *MethodChannel
+}
+
+// ExtractWaveform decodes the audio at url, which may be a local file or a
+// remote URL, and returns its waveform with count peaks. Decoding reads the
+// whole source and blocks until it finishes, so call it from a goroutine:
+//
+//	go func() {
+//	    waveform, err := platform.Audio.ExtractWaveform(url, 200)
+//	    if err != nil {
+//	        return
+//	    }
+//	    platform.Dispatch(func() {
+//	        s.waveform.Set(waveform)
+//	    })
+//	}()
+//
+// Extract a generous number of peaks once and fit them to the screen with
+// [Waveform.Resample]. On iOS, remote files are downloaded before decoding.
+func (a *AudioService) ExtractWaveform(url string, count int) (Waveform, error) {
+	if count <= 0 {
+		return Waveform{}, fmt.Errorf("audio: waveform peak count must be positive, got %d", count)
+	}
+	result, err := a.channel.Invoke(context.Background(), "extractWaveform", map[string]any{
+		"url":   url,
+		"count": count,
+	})
+	if err != nil {
+		return Waveform{}, err
+	}
+	return parseWaveform(result)
+}
+
+func parseWaveform(result any) (Waveform, error) {
+	m, ok := result.(map[string]any)
+	if !ok {
+		return Waveform{}, fmt.Errorf("audio: unexpected response from extractWaveform: %v", result)
+	}
+	raw, _ := m["peaks"].([]any)
+	w := Waveform{Peaks: make([]float64, len(raw))}
+	for i, v := range raw {
+		p, _ := toFloat64(v)
+		w.Peaks[i] = min(max(p, 0), 1)
+	}
+	durationMs, _ := toInt64(m["durationMs"])
+	w.Duration = time.Duration(durationMs) * time.Millisecond
+	return w, nil
+}
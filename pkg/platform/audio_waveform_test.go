@@ -0,0 +1,90 @@
+package platform
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestWaveform_Resample(t *testing.T) {
+	w := Waveform{Peaks: []float64{0.1, 0.9, 0.2, 0.3, 0.5, 0.4}, Duration: time.Second}
+
+	down := w.Resample(3)
+	if want := []float64{0.9, 0.3, 0.5}; !reflect.DeepEqual(down.Peaks, want) {
+		t.Errorf("Resample(3): got %v, want %v", down.Peaks, want)
+	}
+	if down.Duration != time.Second {
+		t.Errorf("Resample kept duration %v, want 1s", down.Duration)
+	}
+
+	up := Waveform{Peaks: []float64{0.2, 0.8}}.Resample(4)
+	if want := []float64{0.2, 0.2, 0.8, 0.8}; !reflect.DeepEqual(up.Peaks, want) {
+		t.Errorf("Resample(4): got %v, want %v", up.Peaks, want)
+	}
+
+	if got := w.Resample(0); len(got.Peaks) != 0 {
+		t.Errorf("Resample(0): got %v, want no peaks", got.Peaks)
+	}
+}
+
+func TestWaveformFromPCM(t *testing.T) {
+	// Four stereo frames at 4 Hz: one second.
+	samples := []int16{
+		100, -16384, // frame 0
+		0, 0, // frame 1
+		-32768, 0, // frame 2
+		8192, 0, // frame 3
+	}
+	w := WaveformFromPCM(samples, 4, 2, 2)
+	if w.Duration != time.Second {
+		t.Errorf("Duration: got %v, want 1s", w.Duration)
+	}
+	if want := []float64{0.5, 1}; !reflect.DeepEqual(w.Peaks, want) {
+		t.Errorf("Peaks: got %v, want %v", w.Peaks, want)
+	}
+
+	if w := WaveformFromPCM(nil, 44100, 1, 3); len(w.Peaks) != 3 || w.Duration != 0 {
+		t.Errorf("empty PCM: got %+v, want three silent peaks", w)
+	}
+}
+
+func TestAudioService_ExtractWaveform(t *testing.T) {
+	bridge := setupTestBridge(t)
+
+	if _, err := Audio.ExtractWaveform("file:///song.mp3", 0); err == nil {
+		t.Error("expected an error for a zero peak count")
+	}
+	if len(bridge.calls) != 0 {
+		t.Errorf("expected no native call for an invalid count, got %d", len(bridge.calls))
+	}
+
+	// The test bridge returns nil, which is not a valid waveform.
+	if _, err := Audio.ExtractWaveform("file:///song.mp3", 100); err == nil {
+		t.Error("expected an error for an empty response")
+	}
+	if len(bridge.calls) != 1 {
+		t.Fatalf("calls: got %d, want 1", len(bridge.calls))
+	}
+	call := bridge.calls[0]
+	args := call.args.(map[string]any)
+	if call.channel != "drift/audio" || call.method != "extractWaveform" ||
+		args["url"] != "file:///song.mp3" || args["count"] != float64(100) {
+		t.Errorf("call: got %s %s %v", call.channel, call.method, args)
+	}
+}
+
+func TestParseWaveform(t *testing.T) {
+	w, err := parseWaveform(map[string]any{
+		"peaks":      []any{0.25, int64(1), 1.5, -0.5},
+		"durationMs": int64(2500),
+	})
+	if err != nil {
+		t.Fatalf("parseWaveform: %v", err)
+	}
+	if want := []float64{0.25, 1, 1, 0}; !reflect.DeepEqual(w.Peaks, want) {
+		t.Errorf("Peaks: got %v, want %v", w.Peaks, want)
+	}
+	if w.Duration != 2500*time.Millisecond {
+		t.Errorf("Duration: got %v, want 2.5s", w.Duration)
+	}
+}
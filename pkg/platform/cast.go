@@ -0,0 +1,245 @@
+package platform
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-drift/drift/pkg/errors"
+)
+
+// CastDeviceKind identifies the protocol a [CastDevice] is reached with.
+type CastDeviceKind int
+
+const (
+	// CastDeviceChromecast is a Google Cast receiver, such as a Chromecast
+	// or a TV with Cast built in. Available on Android.
+	CastDeviceChromecast CastDeviceKind = iota
+
+	// CastDeviceAirPlay is an AirPlay receiver, such as an Apple TV.
+	// Available on iOS.
+	CastDeviceAirPlay
+)
+
+// String returns a human-readable label for the device kind.
+func (k CastDeviceKind) String() string {
+	switch k {
+	case CastDeviceChromecast:
+		return "chromecast"
+	case CastDeviceAirPlay:
+		return "airplay"
+	default:
+		return fmt.Sprintf("CastDeviceKind(%d)", int(k))
+	}
+}
+
+// CastDevice is a receiver that media can be cast to.
+type CastDevice struct {
+	// ID identifies the device for [CastService.Connect].
+	ID string
+
+	// Name is the user-visible device name, such as "Living Room TV".
+	Name string
+
+	// Kind is the protocol used to reach the device.
+	Kind CastDeviceKind
+}
+
+// CastDiscovery reports the cast receivers found on the local network.
+type CastDiscovery struct {
+	// Devices are the receivers available to connect to. iOS does not
+	// expose AirPlay receivers to apps, so Devices is always empty there;
+	// use RoutesAvailable to decide whether to show a [widgets.AirPlayButton].
+	Devices []CastDevice
+
+	// RoutesAvailable reports whether any receiver is available.
+	RoutesAvailable bool
+}
+
+// CastSessionState is the connection state of a cast session.
+type CastSessionState int
+
+const (
+	// CastSessionDisconnected means media plays on this device.
+	CastSessionDisconnected CastSessionState = iota
+
+	// CastSessionConnecting means a receiver was selected and the session
+	// is starting.
+	CastSessionConnecting
+
+	// CastSessionConnected means media plays on the receiver.
+	CastSessionConnected
+)
+
+// String returns a human-readable label for the session state.
+func (s CastSessionState) String() string {
+	switch s {
+	case CastSessionDisconnected:
+		return "disconnected"
+	case CastSessionConnecting:
+		return "connecting"
+	case CastSessionConnected:
+		return "connected"
+	default:
+		return fmt.Sprintf("CastSessionState(%d)", int(s))
+	}
+}
+
+// CastSession describes the current cast session.
+type CastSession struct {
+	// State is the connection state.
+	State CastSessionState
+
+	// Device is the receiver of the session. It is zero while disconnected.
+	Device CastDevice
+}
+
+// CastService discovers cast receivers and manages the cast session.
+//
+// While a session is connected, the [VideoPlayerController] that most
+// recently started playing moves its media to the receiver, continuing from
+// the same position. Its transport methods then control the receiver, and
+// the receiver's state is reported through the controller's callbacks as
+// usual; [VideoPlayerController.OnCastingChanged] reports the switch. When
+// the session ends, playback returns to the device at the receiver's
+// position.
+//
+// On Android, casting uses Google Cast with the default media receiver,
+// which plays the formats Chromecast supports (MP4, WebM, HLS, DASH). On
+// iOS, casting uses AirPlay, which the user starts from an
+// [widgets.AirPlayButton] or Control Center; apps cannot connect to an
+// AirPlay receiver themselves.
+type CastService struct {
+	channel   *MethodChannel
+	discovery *Stream[CastDiscovery]
+	sessions  *Stream[CastSession]
+}
+
+// Cast is the singleton cast service.
+var Cast *CastService
+
+func init() {
+	Cast = &CastService{
+		channel:   NewMethodChannel("drift/cast"),
+		discovery: NewStream("drift/cast/discovery", NewEventChannel("drift/cast/discovery"), parseCastDiscovery),
+		sessions:  NewStream("drift/cast/session", NewEventChannel("drift/cast/session"), parseCastSession),
+	}
+}
+
+// StartDiscovery starts scanning for receivers. Results are reported on
+// [CastService.Discovery]. Scanning uses power and network, so stop it with
+// [CastService.StopDiscovery] when the device list is no longer shown.
+func (c *CastService) StartDiscovery() error {
+	_, err := c.channel.Invoke(context.Background(), "startDiscovery", nil)
+	return err
+}
+
+// StopDiscovery stops scanning for receivers.
+func (c *CastService) StopDiscovery() error {
+	_, err := c.channel.Invoke(context.Background(), "stopDiscovery", nil)
+	return err
+}
+
+// Discovery returns a stream of the receivers found while discovery runs.
+// Each event replaces the previous list.
+func (c *CastService) Discovery() *Stream[CastDiscovery] {
+	return c.discovery
+}
+
+// Sessions returns a stream of cast session changes.
+func (c *CastService) Sessions() *Stream[CastSession] {
+	return c.sessions
+}
+
+// Session returns the current cast session.
+func (c *CastService) Session() (CastSession, error) {
+	result, err := c.channel.Invoke(context.Background(), "getSession", nil)
+	if err != nil {
+		return CastSession{}, err
+	}
+	return parseCastSession(result)
+}
+
+// Connect starts a session with the receiver with the given ID, found with
+// discovery. The session's progress is reported on [CastService.Sessions].
+// On iOS, Connect returns an error; AirPlay sessions are started by the
+// user.
+func (c *CastService) Connect(deviceID string) error {
+	_, err := c.channel.Invoke(context.Background(), "connect", map[string]any{
+		"deviceId": deviceID,
+	})
+	return err
+}
+
+// Disconnect ends the current session, returning playback to the device.
+// On iOS, Disconnect returns an error; the user ends AirPlay sessions from
+// the AirPlay button or Control Center.
+func (c *CastService) Disconnect() error {
+	_, err := c.channel.Invoke(context.Background(), "disconnect", nil)
+	return err
+}
+
+func parseCastDiscovery(data any) (CastDiscovery, error) {
+	m, ok := data.(map[string]any)
+	if !ok {
+		return CastDiscovery{}, &errors.ParseError{
+			Channel:  "drift/cast/discovery",
+			DataType: "CastDiscovery",
+			Got:      data,
+		}
+	}
+	raw, _ := m["devices"].([]any)
+	discovery := CastDiscovery{RoutesAvailable: parseBool(m["routesAvailable"])}
+	for _, item := range raw {
+		device, ok := parseCastDevice(item)
+		if !ok {
+			return CastDiscovery{}, &errors.ParseError{
+				Channel:  "drift/cast/discovery",
+				DataType: "CastDevice",
+				Got:      item,
+			}
+		}
+		discovery.Devices = append(discovery.Devices, device)
+	}
+	if len(discovery.Devices) > 0 {
+		discovery.RoutesAvailable = true
+	}
+	return discovery, nil
+}
+
+func parseCastSession(data any) (CastSession, error) {
+	m, ok := data.(map[string]any)
+	if !ok {
+		return CastSession{}, &errors.ParseError{
+			Channel:  "drift/cast/session",
+			DataType: "CastSession",
+			Got:      data,
+		}
+	}
+	state, _ := toInt(m["state"])
+	session := CastSession{State: CastSessionState(state)}
+	if raw, ok := m["device"]; ok && raw != nil {
+		device, ok := parseCastDevice(raw)
+		if !ok {
+			return CastSession{}, &errors.ParseError{
+				Channel:  "drift/cast/session",
+				DataType: "CastDevice",
+				Got:      raw,
+			}
+		}
+		session.Device = device
+	}
+	return session, nil
+}
+
+func parseCastDevice(data any) (CastDevice, bool) {
+	m, ok := data.(map[string]any)
+	if !ok {
+		return CastDevice{}, false
+	}
+	kind, _ := toInt(m["kind"])
+	return CastDevice{
+		ID:   parseString(m["id"]),
+		Name: parseString(m["name"]),
+		Kind: CastDeviceKind(kind),
+	}, true
+}
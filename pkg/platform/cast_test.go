@@ -0,0 +1,109 @@
+package platform
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCastService_Invokes(t *testing.T) {
+	bridge := setupTestBridge(t)
+
+	if err := Cast.StartDiscovery(); err != nil {
+		t.Fatalf("StartDiscovery: %v", err)
+	}
+	if err := Cast.Connect("device-1"); err != nil {
+		t.Fatalf("Connect: %v", err)
+	}
+	if err := Cast.Disconnect(); err != nil {
+		t.Fatalf("Disconnect: %v", err)
+	}
+	if err := Cast.StopDiscovery(); err != nil {
+		t.Fatalf("StopDiscovery: %v", err)
+	}
+
+	want := []string{"startDiscovery", "connect", "disconnect", "stopDiscovery"}
+	if len(bridge.calls) != len(want) {
+		t.Fatalf("calls: got %d, want %d", len(bridge.calls), len(want))
+	}
+	for i, call := range bridge.calls {
+		if call.channel != "drift/cast" || call.method != want[i] {
+			t.Errorf("call %d: got %s %s, want drift/cast %s", i, call.channel, call.method, want[i])
+		}
+	}
+	if args := bridge.calls[1].args.(map[string]any); args["deviceId"] != "device-1" {
+		t.Errorf("connect args: got %v", args)
+	}
+}
+
+func TestCastService_DiscoveryStream(t *testing.T) {
+	setupTestBridge(t)
+
+	var got []CastDiscovery
+	unsub := Cast.Discovery().Listen(func(d CastDiscovery) {
+		got = append(got, d)
+	})
+	defer unsub()
+
+	data, err := DefaultCodec.Encode(map[string]any{
+		"devices": []any{
+			map[string]any{"id": "a", "name": "Living Room", "kind": 0},
+		},
+	})
+	if err != nil {
+		t.Fatalf("encode event: %v", err)
+	}
+	if err := HandleEvent("drift/cast/discovery", data); err != nil {
+		t.Fatalf("HandleEvent: %v", err)
+	}
+
+	want := CastDiscovery{
+		Devices:         []CastDevice{{ID: "a", Name: "Living Room", Kind: CastDeviceChromecast}},
+		RoutesAvailable: true,
+	}
+	if len(got) != 1 || !reflect.DeepEqual(got[0], want) {
+		t.Errorf("discovery: got %+v, want %+v", got, want)
+	}
+}
+
+func TestParseCastDiscovery(t *testing.T) {
+	// iOS reports availability without devices.
+	d, err := parseCastDiscovery(map[string]any{"routesAvailable": true})
+	if err != nil {
+		t.Fatalf("parseCastDiscovery: %v", err)
+	}
+	if len(d.Devices) != 0 || !d.RoutesAvailable {
+		t.Errorf("got %+v, want routes available without devices", d)
+	}
+
+	if _, err := parseCastDiscovery("bad"); err == nil {
+		t.Error("expected an error for a non-map event")
+	}
+	if _, err := parseCastDiscovery(map[string]any{"devices": []any{"bad"}}); err == nil {
+		t.Error("expected an error for a malformed device")
+	}
+}
+
+func TestParseCastSession(t *testing.T) {
+	s, err := parseCastSession(map[string]any{
+		"state":  int64(2),
+		"device": map[string]any{"id": "tv", "name": "Apple TV", "kind": int64(1)},
+	})
+	if err != nil {
+		t.Fatalf("parseCastSession: %v", err)
+	}
+	want := CastSession{
+		State:  CastSessionConnected,
+		Device: CastDevice{ID: "tv", Name: "Apple TV", Kind: CastDeviceAirPlay},
+	}
+	if s != want {
+		t.Errorf("got %+v, want %+v", s, want)
+	}
+
+	s, err = parseCastSession(map[string]any{"state": int64(0), "device": nil})
+	if err != nil {
+		t.Fatalf("parseCastSession: %v", err)
+	}
+	if s != (CastSession{}) {
+		t.Errorf("got %+v, want a zero disconnected session", s)
+	}
+}
@@ -12,18 +12,26 @@ type MethodHandler func(method string, args any) (any, error)
 
 // MethodChannel provides bidirectional method-call communication with native code.
 type MethodChannel struct {
-	name    string
-	codec   MessageCodec
-	handler MethodHandler
+	name     string
+	codec    MessageCodec
+	handler  MethodHandler
+	platform *Platform
 }
 
-// NewMethodChannel creates a new method channel with the given name.
+// NewMethodChannel creates a new method channel with the given name on the
+// default platform.
 func NewMethodChannel(name string) *MethodChannel {
+	return defaultPlatform.NewMethodChannel(name)
+}
+
+// NewMethodChannel creates a new method channel with the given name on p.
+func (p *Platform) NewMethodChannel(name string) *MethodChannel {
 	ch := &MethodChannel{
-		name:  name,
-		codec: DefaultCodec,
+		name:     name,
+		codec:    DefaultCodec,
+		platform: p,
 	}
-	registry.registerMethod(name, ch)
+	p.channels.registerMethod(name, ch)
 	return ch
 }
 
@@ -39,9 +47,36 @@ func (c *MethodChannel) SetHandler(handler MethodHandler) {
 
 // Invoke calls a method on the native side and returns the result.
 // Blocks until the native side responds, an error occurs, or ctx is canceled.
-// See [invokeNative] for the ctx cancellation contract.
+// See [invokeNative] for the ctx cancellation contract. Prefer
+// [MethodChannel.InvokeAsync] for slow calls made from the UI thread.
 func (c *MethodChannel) Invoke(ctx context.Context, method string, args any) (any, error) {
-	return invokeNative(ctx, c.name, method, args)
+	return c.platform.invokeNative(ctx, c.name, method, args)
+}
+
+// InvokeResult is the outcome of a method call made with
+// [MethodChannel.InvokeAsync].
+type InvokeResult struct {
+	Value any
+	Err   error
+}
+
+// InvokeAsync calls a method on the native side without blocking the caller,
+// such as the UI thread. The returned channel receives exactly one result
+// and is buffered, so the result may be ignored. Apply the result to the UI
+// with [Dispatch]:
+//
+//	result := ch.InvokeAsync(ctx, "load", nil)
+//	go func() {
+//	    r := <-result
+//	    platform.Dispatch(func() { s.SetState(func() { s.data, s.err = r.Value, r.Err }) })
+//	}()
+func (c *MethodChannel) InvokeAsync(ctx context.Context, method string, args any) <-chan InvokeResult {
+	result := make(chan InvokeResult, 1)
+	go func() {
+		value, err := c.Invoke(ctx, method, args)
+		result <- InvokeResult{Value: value, Err: err}
+	}()
+	return result
 }
 
 // handleCall processes an incoming method call from native code.
@@ -82,18 +117,26 @@ func (s *Subscription) IsCanceled() bool {
 type EventChannel struct {
 	name          string
 	codec         MessageCodec
+	platform      *Platform
 	subscriptions []*Subscription
 	started       bool // whether native event stream is active
 	mu            sync.Mutex
 }
 
-// NewEventChannel creates a new event channel with the given name.
+// NewEventChannel creates a new event channel with the given name on the
+// default platform.
 func NewEventChannel(name string) *EventChannel {
+	return defaultPlatform.NewEventChannel(name)
+}
+
+// NewEventChannel creates a new event channel with the given name on p.
+func (p *Platform) NewEventChannel(name string) *EventChannel {
 	ch := &EventChannel{
-		name:  name,
-		codec: DefaultCodec,
+		name:     name,
+		codec:    DefaultCodec,
+		platform: p,
 	}
-	registry.registerEvent(name, ch)
+	p.channels.registerEvent(name, ch)
 	return ch
 }
 
@@ -115,7 +158,7 @@ func (c *EventChannel) Listen(handler EventHandler) *Subscription {
 
 	c.mu.Lock()
 	c.subscriptions = append(c.subscriptions, sub)
-	shouldStart := nativeBridge != nil && !c.started
+	shouldStart := c.platform.nativeBridge() != nil && !c.started
 	if shouldStart {
 		c.started = true
 	}
@@ -125,7 +168,7 @@ func (c *EventChannel) Listen(handler EventHandler) *Subscription {
 	// - bridge not yet set (SetNativeBridge will start pending streams), or
 	// - stream already started by a prior subscriber or SetNativeBridge.
 	if shouldStart {
-		if err := startEventStream(c.name); err != nil {
+		if err := c.platform.startEventStream(c.name); err != nil {
 			c.mu.Lock()
 			c.started = false
 			c.mu.Unlock()
@@ -156,7 +199,7 @@ func (c *EventChannel) removeSubscription(sub *Subscription) {
 	// Notify native if no more listeners.
 	// ErrClosed is expected during normal shutdown and not reported.
 	if !hasListeners {
-		if err := stopEventStream(c.name); err != nil && !errors.Is(err, ErrClosed) {
+		if err := c.platform.stopEventStream(c.name); err != nil && !errors.Is(err, ErrClosed) {
 			// Unexpected teardown error - already reported by stopEventStream
 		}
 	}
@@ -0,0 +1,262 @@
+package platform
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ChannelRecordKind identifies the kind of platform channel traffic in a
+// [ChannelRecord].
+type ChannelRecordKind string
+
+const (
+	// ChannelRecordInvoke is a method call from Go to native code.
+	ChannelRecordInvoke ChannelRecordKind = "invoke"
+
+	// ChannelRecordCall is a method call from native code to Go.
+	ChannelRecordCall ChannelRecordKind = "call"
+
+	// ChannelRecordEvent is an event from native code.
+	ChannelRecordEvent ChannelRecordKind = "event"
+
+	// ChannelRecordError is an error on a native event stream.
+	ChannelRecordError ChannelRecordKind = "error"
+
+	// ChannelRecordDone is the end of a native event stream.
+	ChannelRecordDone ChannelRecordKind = "done"
+)
+
+// ChannelRecord is one message recorded by a [ChannelRecorder].
+type ChannelRecord struct {
+	Time    time.Time         `json:"time"`
+	Kind    ChannelRecordKind `json:"kind"`
+	Channel string            `json:"channel"`
+	Method  string            `json:"method,omitempty"`
+
+	// Args holds the method arguments, or the data of an event.
+	Args any `json:"args,omitempty"`
+
+	// Result holds the result of a method call.
+	Result any `json:"result,omitempty"`
+
+	// Code and Error describe a failed method call or an event stream error.
+	Code  string `json:"code,omitempty"`
+	Error string `json:"error,omitempty"`
+
+	// LatencyMs is how long a method call took to return.
+	LatencyMs float64 `json:"latencyMs,omitempty"`
+}
+
+// ChannelRecordFilter selects channel records. Zero fields match everything.
+type ChannelRecordFilter struct {
+	// Channel matches channels with this name or prefix, such as
+	// "drift/audio_player".
+	Channel string
+
+	// Method matches records of this method.
+	Method string
+
+	// Kind matches records of this kind.
+	Kind ChannelRecordKind
+
+	// MinLatency matches method calls that took at least this long.
+	MinLatency time.Duration
+}
+
+// Matches reports whether record passes the filter.
+func (f ChannelRecordFilter) Matches(record ChannelRecord) bool {
+	if f.Channel != "" && !strings.HasPrefix(record.Channel, f.Channel) {
+		return false
+	}
+	if f.Method != "" && record.Method != f.Method {
+		return false
+	}
+	if f.Kind != "" && record.Kind != f.Kind {
+		return false
+	}
+	if f.MinLatency > 0 && record.LatencyMs < float64(f.MinLatency)/float64(time.Millisecond) {
+		return false
+	}
+	return true
+}
+
+// ChannelRecorder keeps the most recent traffic on the channels of a
+// [Platform], for debugging native integrations. Attach it with
+// [Platform.SetRecorder]; the debug server exposes the default platform's
+// recorder at /channels when DiagnosticsConfig.RecordChannels is set.
+//
+// Recorded traffic can be fed back into headless tests with [Replay].
+// All methods are safe for concurrent use.
+type ChannelRecorder struct {
+	mu       sync.Mutex
+	records  []ChannelRecord
+	next     int
+	full     bool
+	capacity int
+}
+
+// NewChannelRecorder creates a recorder that keeps the last capacity
+// records. A capacity of zero or less keeps 500.
+func NewChannelRecorder(capacity int) *ChannelRecorder {
+	if capacity <= 0 {
+		capacity = 500
+	}
+	return &ChannelRecorder{
+		records:  make([]ChannelRecord, capacity),
+		capacity: capacity,
+	}
+}
+
+// record stores a record, replacing the oldest when full.
+func (r *ChannelRecorder) record(record ChannelRecord) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.records[r.next] = record
+	r.next = (r.next + 1) % r.capacity
+	if r.next == 0 {
+		r.full = true
+	}
+}
+
+// Records returns the recorded traffic passing filter, oldest first.
+func (r *ChannelRecorder) Records(filter ChannelRecordFilter) []ChannelRecord {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	ordered := r.records[:r.next]
+	if r.full {
+		ordered = append(r.records[r.next:r.capacity:r.capacity], ordered...)
+	}
+	out := []ChannelRecord{}
+	for _, record := range ordered {
+		if filter.Matches(record) {
+			out = append(out, record)
+		}
+	}
+	return out
+}
+
+// Clear removes all records.
+func (r *ChannelRecorder) Clear() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	clear(r.records)
+	r.next = 0
+	r.full = false
+}
+
+// SetRecorder starts recording the channel traffic of p into recorder.
+// Pass nil to stop recording.
+func (p *Platform) SetRecorder(recorder *ChannelRecorder) {
+	p.recorder.Store(recorder)
+}
+
+// Recorder returns the recorder attached to p, or nil.
+func (p *Platform) Recorder() *ChannelRecorder {
+	return p.recorder.Load()
+}
+
+// recordCall records a method call that started at start.
+func (p *Platform) recordCall(kind ChannelRecordKind, channel, method string, args, result any, err error, start time.Time) {
+	recorder := p.recorder.Load()
+	if recorder == nil {
+		return
+	}
+	now := time.Now()
+	record := ChannelRecord{
+		Time:      start,
+		Kind:      kind,
+		Channel:   channel,
+		Method:    method,
+		Args:      args,
+		Result:    result,
+		LatencyMs: float64(now.Sub(start)) / float64(time.Millisecond),
+	}
+	if err != nil {
+		record.Error = err.Error()
+		var channelErr *ChannelError
+		if errors.As(err, &channelErr) {
+			record.Code = channelErr.Code
+		}
+	}
+	recorder.record(record)
+}
+
+// recordEvent records traffic on an event stream.
+func (p *Platform) recordEvent(record ChannelRecord) {
+	if recorder := p.recorder.Load(); recorder != nil {
+		record.Time = time.Now()
+		recorder.record(record)
+	}
+}
+
+// ParseChannelRecords decodes records saved from the debug server's
+// /channels endpoint, or a JSON array of records.
+func ParseChannelRecords(data []byte) ([]ChannelRecord, error) {
+	var response struct {
+		Records []ChannelRecord `json:"records"`
+	}
+	if err := json.Unmarshal(data, &response); err == nil {
+		return response.Records, nil
+	}
+	var records []ChannelRecord
+	if err := json.Unmarshal(data, &records); err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+// Replay feeds the native-to-Go traffic in records to the default platform's
+// channels, in order and without delay, as if native code sent it. See
+// [Platform.Replay].
+func Replay(records []ChannelRecord) error {
+	return defaultPlatform.Replay(records)
+}
+
+// Replay feeds the native-to-Go traffic in records (method calls from native
+// code, events, event stream errors and ends) to the channels of p, in order
+// and without delay, as if native code sent it. Calls from Go to native are
+// skipped, since their results were already returned.
+//
+// Use it to reproduce a recorded session in a headless test:
+//
+//	data, _ := os.ReadFile("testdata/playback.json") // saved from /channels
+//	records, _ := platform.ParseChannelRecords(data)
+//	if err := platform.Replay(records); err != nil {
+//	    t.Fatal(err)
+//	}
+//
+// Replay returns the errors from delivering records, joined. A method call
+// that failed when recorded may fail again without error.
+func (p *Platform) Replay(records []ChannelRecord) error {
+	var errs []error
+	for _, record := range records {
+		var err error
+		switch record.Kind {
+		case ChannelRecordCall:
+			var args []byte
+			if args, err = DefaultCodec.Encode(record.Args); err == nil {
+				_, err = p.HandleMethodCall(record.Channel, record.Method, args)
+			}
+			if record.Error != "" {
+				// The recorded call failed too
+				err = nil
+			}
+		case ChannelRecordEvent:
+			var data []byte
+			if data, err = DefaultCodec.Encode(record.Args); err == nil {
+				err = p.HandleEvent(record.Channel, data)
+			}
+		case ChannelRecordError:
+			err = p.HandleEventError(record.Channel, record.Code, record.Error)
+		case ChannelRecordDone:
+			err = p.HandleEventDone(record.Channel)
+		}
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,131 @@
+package platform
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestChannelRecorder_RecordsTraffic(t *testing.T) {
+	p := New(&countingBridge{response: map[string]any{"ok": true}})
+	recorder := NewChannelRecorder(0)
+	p.SetRecorder(recorder)
+
+	ch := p.NewMethodChannel("test/recorded")
+	if _, err := ch.Invoke(context.Background(), "load", map[string]any{"id": 1}); err != nil {
+		t.Fatalf("Invoke: %v", err)
+	}
+	ch.SetHandler(func(method string, args any) (any, error) {
+		return nil, NewChannelError("busy", "try later")
+	})
+	args, _ := DefaultCodec.Encode(nil)
+	p.HandleMethodCall("test/recorded", "ping", args)
+
+	p.NewEventChannel("test/recorded/events").Listen(EventHandler{})
+	data, _ := DefaultCodec.Encode(map[string]any{"state": "ready"})
+	p.HandleEvent("test/recorded/events", data)
+	p.HandleEventError("test/recorded/events", "lost", "stream lost")
+	p.HandleEventDone("test/recorded/events")
+
+	records := recorder.Records(ChannelRecordFilter{})
+	kinds := make([]ChannelRecordKind, len(records))
+	for i, record := range records {
+		kinds[i] = record.Kind
+	}
+	want := []ChannelRecordKind{ChannelRecordInvoke, ChannelRecordCall, ChannelRecordEvent, ChannelRecordError, ChannelRecordDone}
+	if !slices.Equal(kinds, want) {
+		t.Fatalf("kinds: got %v, want %v", kinds, want)
+	}
+
+	invoke := records[0]
+	if invoke.Method != "load" || invoke.Result.(map[string]any)["ok"] != true || invoke.Time.IsZero() {
+		t.Errorf("invoke: got %+v", invoke)
+	}
+	if call := records[1]; call.Code != "busy" || call.Error == "" {
+		t.Errorf("call: got %+v, want the channel error", call)
+	}
+	if event := records[2]; event.Args.(map[string]any)["state"] != "ready" {
+		t.Errorf("event: got %+v", event)
+	}
+	if streamErr := records[3]; streamErr.Code != "lost" || streamErr.Error != "stream lost" {
+		t.Errorf("error: got %+v", streamErr)
+	}
+
+	filtered := recorder.Records(ChannelRecordFilter{Channel: "test/recorded/events", Kind: ChannelRecordEvent})
+	if len(filtered) != 1 {
+		t.Errorf("filtered: got %d records, want 1", len(filtered))
+	}
+
+	p.SetRecorder(nil)
+	ch.Invoke(context.Background(), "load", nil)
+	if got := len(recorder.Records(ChannelRecordFilter{})); got != len(want) {
+		t.Errorf("expected no records after detaching, got %d", got)
+	}
+}
+
+func TestChannelRecorder_KeepsMostRecent(t *testing.T) {
+	recorder := NewChannelRecorder(3)
+	for _, method := range []string{"a", "b", "c", "d", "e"} {
+		recorder.record(ChannelRecord{Kind: ChannelRecordInvoke, Method: method})
+	}
+	records := recorder.Records(ChannelRecordFilter{})
+	if len(records) != 3 || records[0].Method != "c" || records[2].Method != "e" {
+		t.Errorf("records: got %+v, want c, d, e", records)
+	}
+
+	recorder.Clear()
+	if got := recorder.Records(ChannelRecordFilter{}); len(got) != 0 {
+		t.Errorf("expected no records after Clear, got %d", len(got))
+	}
+}
+
+func TestReplay_FeedsRecordedTraffic(t *testing.T) {
+	recorded := New(&countingBridge{})
+	recorder := NewChannelRecorder(0)
+	recorded.SetRecorder(recorder)
+	recorded.NewMethodChannel("test/replay").SetHandler(func(string, any) (any, error) { return nil, nil })
+	recorded.NewEventChannel("test/replay/events")
+
+	first, _ := DefaultCodec.Encode(map[string]any{"position": 1.5})
+	second, _ := DefaultCodec.Encode(map[string]any{"position": 3.0})
+	args, _ := DefaultCodec.Encode(map[string]any{"reason": "user"})
+	recorded.HandleEvent("test/replay/events", first)
+	recorded.HandleMethodCall("test/replay", "didPause", args)
+	recorded.HandleEvent("test/replay/events", second)
+	recorded.NewMethodChannel("test/replay/out").Invoke(context.Background(), "play", nil)
+
+	// Round-trip through JSON, as when saved from the debug server
+	data, err := json.Marshal(map[string]any{"records": recorder.Records(ChannelRecordFilter{})})
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	records, err := ParseChannelRecords(data)
+	if err != nil {
+		t.Fatalf("ParseChannelRecords: %v", err)
+	}
+
+	replayed := New(nil)
+	var traffic []string
+	replayed.NewMethodChannel("test/replay").SetHandler(func(method string, args any) (any, error) {
+		traffic = append(traffic, method+" "+args.(map[string]any)["reason"].(string))
+		return nil, nil
+	})
+	replayed.NewEventChannel("test/replay/events").Listen(EventHandler{
+		OnEvent: func(data any) {
+			traffic = append(traffic, "event")
+		},
+	})
+	if err := replayed.Replay(records); err != nil {
+		t.Fatalf("Replay: %v", err)
+	}
+	want := []string{"event", "didPause user", "event"}
+	if !slices.Equal(traffic, want) {
+		t.Errorf("traffic: got %v, want %v", traffic, want)
+	}
+
+	if err := New(nil).Replay(records); !errors.Is(err, ErrChannelNotRegistered) {
+		t.Errorf("Replay without channels: got %v, want ErrChannelNotRegistered", err)
+	}
+}
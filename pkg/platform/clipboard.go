@@ -12,6 +12,15 @@ type ClipboardService struct {
 	channel *MethodChannel
 }
 
+// ClipboardProvider is the clipboard behavior [ClipboardService] provides.
+// Implement it to replace the clipboard in tests; see [SetForTesting].
+type ClipboardProvider interface {
+	GetText() (string, error)
+	SetText(text string) error
+	HasText() (bool, error)
+	Clear() error
+}
+
 // ClipboardData represents data on the clipboard.
 type ClipboardData struct {
 	Text string `json:"text,omitempty"`
@@ -20,6 +29,9 @@ type ClipboardData struct {
 // GetText retrieves text from the clipboard.
 // Returns empty string if clipboard is empty or contains non-text data.
 func (c *ClipboardService) GetText() (string, error) {
+	if double := testServices().Clipboard; double != nil {
+		return double.GetText()
+	}
 	result, err := c.channel.Invoke(context.Background(), "getText", nil)
 	if err != nil {
 		return "", err
@@ -46,6 +58,9 @@ func (c *ClipboardService) GetText() (string, error) {
 
 // SetText copies text to the clipboard.
 func (c *ClipboardService) SetText(text string) error {
+	if double := testServices().Clipboard; double != nil {
+		return double.SetText(text)
+	}
 	_, err := c.channel.Invoke(context.Background(), "setText", map[string]any{
 		"text": text,
 	})
@@ -54,6 +69,9 @@ func (c *ClipboardService) SetText(text string) error {
 
 // HasText returns true if the clipboard contains text.
 func (c *ClipboardService) HasText() (bool, error) {
+	if double := testServices().Clipboard; double != nil {
+		return double.HasText()
+	}
 	result, err := c.channel.Invoke(context.Background(), "hasText", nil)
 	if err != nil {
 		return false, err
@@ -68,6 +86,9 @@ func (c *ClipboardService) HasText() (bool, error) {
 
 // Clear removes all data from the clipboard.
 func (c *ClipboardService) Clear() error {
+	if double := testServices().Clipboard; double != nil {
+		return double.Clear()
+	}
 	_, err := c.channel.Invoke(context.Background(), "clear", nil)
 	return err
 }
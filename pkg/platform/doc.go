@@ -1,8 +1,14 @@
 // Package platform provides global singletons for platform services.
 //
 // It assumes a single application per process. Platform services are
-// initialized during package init and activated when [SetNativeBridge]
-// is called by the bridge package.
+// initialized during package init and activated when the bridge package
+// calls [Initialize], which refuses a second bridge.
+//
+// # Isolated Platforms
+//
+// [New] creates a [Platform] with its own channels and bridge, separate from
+// the default one behind the global services. Tests use it to exercise
+// channels against a fake bridge without touching global state.
 //
 // # Global Services
 //
@@ -0,0 +1,299 @@
+package platform
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-drift/drift/pkg/errors"
+)
+
+// DownloadStatus is the state of a download.
+type DownloadStatus int
+
+const (
+	// DownloadQueued means the download is waiting to start, for example
+	// for a network connection or for Wi-Fi when [DownloadRequest.WifiOnly]
+	// is set.
+	DownloadQueued DownloadStatus = iota
+
+	// DownloadRunning means bytes are being transferred.
+	DownloadRunning
+
+	// DownloadPaused means the download was paused with
+	// [DownloadService.Pause] and keeps its partial data until resumed.
+	DownloadPaused
+
+	// DownloadCompleted means the file was saved to [DownloadTask.Path].
+	DownloadCompleted
+
+	// DownloadFailed means the download stopped with an error, reported in
+	// [DownloadTask.Error].
+	DownloadFailed
+
+	// DownloadCanceled means the download was canceled and its partial
+	// data deleted.
+	DownloadCanceled
+)
+
+// String returns a human-readable label for the status.
+func (s DownloadStatus) String() string {
+	switch s {
+	case DownloadQueued:
+		return "queued"
+	case DownloadRunning:
+		return "running"
+	case DownloadPaused:
+		return "paused"
+	case DownloadCompleted:
+		return "completed"
+	case DownloadFailed:
+		return "failed"
+	case DownloadCanceled:
+		return "canceled"
+	default:
+		return fmt.Sprintf("DownloadStatus(%d)", int(s))
+	}
+}
+
+// IsTerminal reports whether the download has finished, successfully or not.
+func (s DownloadStatus) IsTerminal() bool {
+	return s == DownloadCompleted || s == DownloadFailed || s == DownloadCanceled
+}
+
+// DownloadRequest describes a file to download.
+type DownloadRequest struct {
+	// ID identifies the download. Zero generates a unique ID, returned in
+	// the enqueued [DownloadTask].
+	ID string
+
+	// URL is the HTTP or HTTPS address of the file.
+	URL string
+
+	// Headers are added to the request, for example for authorization.
+	Headers map[string]string
+
+	// FileName is the name of the saved file within the app's downloads
+	// directory. Zero uses the last segment of the URL path.
+	FileName string
+
+	// WifiOnly defers the download until the device is on an unmetered
+	// network, pausing it when the device moves to cellular.
+	WifiOnly bool
+
+	// NotificationTitle, when set, shows a system notification when the
+	// download completes or fails. Android also shows one with the
+	// download's progress while it runs.
+	NotificationTitle string
+}
+
+// DownloadTask reports the state of a download.
+type DownloadTask struct {
+	// ID identifies the download.
+	ID string
+
+	// URL is the address being downloaded.
+	URL string
+
+	// Path is the absolute path of the saved file. The file is complete
+	// only once Status is [DownloadCompleted].
+	Path string
+
+	// Status is the state of the download.
+	Status DownloadStatus
+
+	// BytesDownloaded is the number of bytes saved so far.
+	BytesDownloaded int64
+
+	// TotalBytes is the size of the file, or -1 if the server did not
+	// report it.
+	TotalBytes int64
+
+	// Error describes why the download failed. It is empty unless Status
+	// is [DownloadFailed].
+	Error string
+}
+
+// Progress returns the fraction of the file downloaded, from 0 to 1, or -1
+// if the size is unknown.
+func (t DownloadTask) Progress() float64 {
+	if t.Status == DownloadCompleted {
+		return 1
+	}
+	if t.TotalBytes <= 0 {
+		return -1
+	}
+	return min(float64(t.BytesDownloaded)/float64(t.TotalBytes), 1)
+}
+
+// DownloadService downloads files in the background. Downloads continue
+// while the app is suspended or terminated, and resume from where they
+// stopped after a network failure, using WorkManager on Android and a
+// background URLSession on iOS.
+//
+// While the app runs, every change of a download is reported on
+// [DownloadService.Updates]. Downloads that finish while the app is not
+// running are recorded instead; call [DownloadService.PendingCompletions]
+// at startup to receive them:
+//
+//	platform.Downloads.Updates().Listen(s.onDownload)
+//	done, _ := platform.Downloads.PendingCompletions()
+//	for _, task := range done {
+//	    s.onDownload(task)
+//	}
+type DownloadService struct {
+	channel *MethodChannel
+	updates *Stream[DownloadTask]
+}
+
+// Downloads is the singleton download service.
+var Downloads *DownloadService
+
+func init() {
+	Downloads = &DownloadService{
+		channel: NewMethodChannel("drift/downloads"),
+		updates: NewStream("drift/downloads/events", NewEventChannel("drift/downloads/events"), parseDownloadTask),
+	}
+}
+
+// Enqueue starts a download. An existing download with the same ID is
+// replaced.
+func (d *DownloadService) Enqueue(request DownloadRequest) (DownloadTask, error) {
+	if request.URL == "" {
+		return DownloadTask{}, fmt.Errorf("downloads: URL is empty")
+	}
+	headers := make(map[string]any, len(request.Headers))
+	for k, v := range request.Headers {
+		headers[k] = v
+	}
+	result, err := d.channel.Invoke(context.Background(), "enqueue", map[string]any{
+		"id":                request.ID,
+		"url":               request.URL,
+		"headers":           headers,
+		"fileName":          request.FileName,
+		"wifiOnly":          request.WifiOnly,
+		"notificationTitle": request.NotificationTitle,
+	})
+	if err != nil {
+		return DownloadTask{}, err
+	}
+	return parseDownloadTask(result)
+}
+
+// Pause stops a download, keeping its partial data so that
+// [DownloadService.Resume] continues from the same point.
+func (d *DownloadService) Pause(id string) error {
+	return d.invokeWithID("pause", id)
+}
+
+// Resume continues a paused or failed download.
+func (d *DownloadService) Resume(id string) error {
+	return d.invokeWithID("resume", id)
+}
+
+// Cancel stops a download and deletes its partial data.
+func (d *DownloadService) Cancel(id string) error {
+	return d.invokeWithID("cancel", id)
+}
+
+// Remove forgets a finished download and deletes its file. A download that
+// is still in progress is canceled first.
+func (d *DownloadService) Remove(id string) error {
+	return d.invokeWithID("remove", id)
+}
+
+// Task returns the download with the given ID, or nil if there is none.
+func (d *DownloadService) Task(id string) (*DownloadTask, error) {
+	result, err := d.channel.Invoke(context.Background(), "getTask", map[string]any{
+		"id": id,
+	})
+	if err != nil || result == nil {
+		return nil, err
+	}
+	task, err := parseDownloadTask(result)
+	if err != nil {
+		return nil, err
+	}
+	return &task, nil
+}
+
+// Tasks returns all downloads that have not been removed, including
+// finished ones.
+func (d *DownloadService) Tasks() ([]DownloadTask, error) {
+	result, err := d.channel.Invoke(context.Background(), "getTasks", nil)
+	if err != nil {
+		return nil, err
+	}
+	return parseDownloadTasks(result)
+}
+
+// PendingCompletions returns the downloads that finished while the app was
+// not running, and clears them so each is returned once.
+func (d *DownloadService) PendingCompletions() ([]DownloadTask, error) {
+	result, err := d.channel.Invoke(context.Background(), "takePendingCompletions", nil)
+	if err != nil {
+		return nil, err
+	}
+	return parseDownloadTasks(result)
+}
+
+// Updates returns a stream of download changes: status changes, progress,
+// and completion.
+func (d *DownloadService) Updates() *Stream[DownloadTask] {
+	return d.updates
+}
+
+func (d *DownloadService) invokeWithID(method, id string) error {
+	_, err := d.channel.Invoke(context.Background(), method, map[string]any{
+		"id": id,
+	})
+	return err
+}
+
+func parseDownloadTasks(data any) ([]DownloadTask, error) {
+	if data == nil {
+		return nil, nil
+	}
+	items, ok := data.([]any)
+	if !ok {
+		return nil, &errors.ParseError{
+			Channel:  "drift/downloads",
+			DataType: "[]DownloadTask",
+			Got:      data,
+		}
+	}
+	tasks := make([]DownloadTask, 0, len(items))
+	for _, item := range items {
+		task, err := parseDownloadTask(item)
+		if err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, task)
+	}
+	return tasks, nil
+}
+
+func parseDownloadTask(data any) (DownloadTask, error) {
+	m, ok := data.(map[string]any)
+	if !ok {
+		return DownloadTask{}, &errors.ParseError{
+			Channel:  "drift/downloads/events",
+			DataType: "DownloadTask",
+			Got:      data,
+		}
+	}
+	status, _ := toInt(m["status"])
+	downloaded, _ := toInt64(m["bytesDownloaded"])
+	total, ok := toInt64(m["totalBytes"])
+	if !ok {
+		total = -1
+	}
+	return DownloadTask{
+		ID:              parseString(m["id"]),
+		URL:             parseString(m["url"]),
+		Path:            parseString(m["path"]),
+		Status:          DownloadStatus(status),
+		BytesDownloaded: downloaded,
+		TotalBytes:      total,
+		Error:           parseString(m["error"]),
+	}, nil
+}
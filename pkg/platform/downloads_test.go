@@ -0,0 +1,108 @@
+package platform
+
+import (
+	"testing"
+)
+
+func TestDownloadService_Invokes(t *testing.T) {
+	bridge := setupTestBridge(t)
+
+	if _, err := Downloads.Enqueue(DownloadRequest{}); err == nil {
+		t.Error("expected an error for an empty URL")
+	}
+	// The test bridge returns nil, which is not a task.
+	Downloads.Enqueue(DownloadRequest{
+		ID:       "episode-1",
+		URL:      "https://example.com/episode.mp3",
+		Headers:  map[string]string{"Authorization": "Bearer token"},
+		WifiOnly: true,
+	})
+	if err := Downloads.Pause("episode-1"); err != nil {
+		t.Fatalf("Pause: %v", err)
+	}
+	if err := Downloads.Resume("episode-1"); err != nil {
+		t.Fatalf("Resume: %v", err)
+	}
+	if err := Downloads.Cancel("episode-1"); err != nil {
+		t.Fatalf("Cancel: %v", err)
+	}
+	if task, err := Downloads.Task("episode-1"); err != nil || task != nil {
+		t.Fatalf("Task: got %v, %v, want nil task", task, err)
+	}
+
+	want := []string{"enqueue", "pause", "resume", "cancel", "getTask"}
+	if len(bridge.calls) != len(want) {
+		t.Fatalf("calls: got %d, want %d", len(bridge.calls), len(want))
+	}
+	for i, call := range bridge.calls {
+		if call.channel != "drift/downloads" || call.method != want[i] {
+			t.Errorf("call %d: got %s %s, want drift/downloads %s", i, call.channel, call.method, want[i])
+		}
+	}
+	args := bridge.calls[0].args.(map[string]any)
+	if args["url"] != "https://example.com/episode.mp3" || args["wifiOnly"] != true {
+		t.Errorf("enqueue args: got %v", args)
+	}
+	if headers := args["headers"].(map[string]any); headers["Authorization"] != "Bearer token" {
+		t.Errorf("enqueue headers: got %v", headers)
+	}
+}
+
+func TestDownloadService_UpdatesStream(t *testing.T) {
+	setupTestBridge(t)
+
+	var got []DownloadTask
+	unsub := Downloads.Updates().Listen(func(task DownloadTask) {
+		got = append(got, task)
+	})
+	defer unsub()
+
+	data, err := DefaultCodec.Encode(map[string]any{
+		"id":              "episode-1",
+		"status":          1,
+		"bytesDownloaded": 250,
+		"totalBytes":      1000,
+	})
+	if err != nil {
+		t.Fatalf("encode event: %v", err)
+	}
+	if err := HandleEvent("drift/downloads/events", data); err != nil {
+		t.Fatalf("HandleEvent: %v", err)
+	}
+
+	if len(got) != 1 {
+		t.Fatalf("got %d updates, want 1", len(got))
+	}
+	if got[0].Status != DownloadRunning || got[0].Progress() != 0.25 {
+		t.Errorf("got %+v, want running at 25%%", got[0])
+	}
+}
+
+func TestParseDownloadTasks(t *testing.T) {
+	tasks, err := parseDownloadTasks([]any{
+		map[string]any{"id": "a", "status": 3, "path": "/data/a.mp4", "bytesDownloaded": 10, "totalBytes": 10},
+		map[string]any{"id": "b", "status": 4, "error": "timed out"},
+	})
+	if err != nil {
+		t.Fatalf("parseDownloadTasks: %v", err)
+	}
+	if len(tasks) != 2 {
+		t.Fatalf("got %d tasks, want 2", len(tasks))
+	}
+	if tasks[0].Status != DownloadCompleted || tasks[0].Path != "/data/a.mp4" || tasks[0].Progress() != 1 {
+		t.Errorf("task a: got %+v", tasks[0])
+	}
+	if tasks[1].Status != DownloadFailed || tasks[1].Error != "timed out" || tasks[1].TotalBytes != -1 {
+		t.Errorf("task b: got %+v", tasks[1])
+	}
+	if tasks[1].Progress() != -1 {
+		t.Errorf("task b progress: got %f, want -1 for unknown size", tasks[1].Progress())
+	}
+	if !tasks[1].Status.IsTerminal() || DownloadPaused.IsTerminal() {
+		t.Error("IsTerminal: unexpected result")
+	}
+
+	if _, err := parseDownloadTasks("bad"); err == nil {
+		t.Error("expected an error for a non-list result")
+	}
+}
@@ -12,6 +12,13 @@ type HapticsService struct {
 	channel *MethodChannel
 }
 
+// HapticsProvider is the haptic feedback behavior [HapticsService] provides.
+// Implement it to replace haptics in tests; see [SetForTesting].
+type HapticsProvider interface {
+	Impact(style HapticFeedbackType) error
+	Vibrate(durationMs int) error
+}
+
 // HapticFeedbackType defines the type of haptic feedback.
 type HapticFeedbackType string
 
@@ -40,6 +47,9 @@ const (
 
 // Impact triggers an impact haptic feedback.
 func (h *HapticsService) Impact(style HapticFeedbackType) error {
+	if double := testServices().Haptics; double != nil {
+		return double.Impact(style)
+	}
 	_, err := h.channel.Invoke(context.Background(), "impact", map[string]any{
 		"style": string(style),
 	})
@@ -69,6 +79,9 @@ func (h *HapticsService) SelectionClick() error {
 
 // Vibrate triggers a vibration for the specified duration in milliseconds.
 func (h *HapticsService) Vibrate(durationMs int) error {
+	if double := testServices().Haptics; double != nil {
+		return double.Vibrate(durationMs)
+	}
 	_, err := h.channel.Invoke(context.Background(), "vibrate", map[string]any{
 		"duration": durationMs,
 	})
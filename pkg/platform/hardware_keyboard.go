@@ -0,0 +1,94 @@
+package platform
+
+import (
+	"sync"
+
+	"github.com/go-drift/drift/pkg/focus"
+)
+
+// HardwareKeyboard delivers key events from hardware keyboards and remotes.
+var HardwareKeyboard = &HardwareKeyboardService{
+	events: NewEventChannel("drift/keys/events"),
+}
+
+// HardwareKeyboardService reports hardware key presses and releases. The
+// engine routes every event to the focused [focus.FocusNode]; add a handler
+// only to observe keys regardless of focus.
+type HardwareKeyboardService struct {
+	events   *EventChannel
+	handlers []func(focus.KeyEvent)
+	mu       sync.RWMutex
+}
+
+func init() {
+	initHardwareKeyboardListeners()
+	registerBuiltinInit(initHardwareKeyboardListeners)
+}
+
+func initHardwareKeyboardListeners() {
+	HardwareKeyboard.events.Listen(EventHandler{
+		OnEvent: func(data any) {
+			if m, ok := data.(map[string]any); ok {
+				if event, ok := parseKeyEvent(m); ok {
+					HardwareKeyboard.dispatch(event)
+				}
+			}
+		},
+	})
+}
+
+// AddHandler registers a handler to be called for each key event. Handlers
+// run on the platform thread; use [Dispatch] to touch widget state. Returns
+// a function that can be called to remove the handler.
+func (k *HardwareKeyboardService) AddHandler(handler func(focus.KeyEvent)) func() {
+	k.mu.Lock()
+	k.handlers = append(k.handlers, handler)
+	index := len(k.handlers) - 1
+	k.mu.Unlock()
+
+	return func() {
+		k.mu.Lock()
+		if index < len(k.handlers) {
+			k.handlers = append(k.handlers[:index], k.handlers[index+1:]...)
+		}
+		k.mu.Unlock()
+	}
+}
+
+// dispatch notifies handlers of a key event.
+func (k *HardwareKeyboardService) dispatch(event focus.KeyEvent) {
+	k.mu.RLock()
+	handlers := make([]func(focus.KeyEvent), len(k.handlers))
+	copy(handlers, k.handlers)
+	k.mu.RUnlock()
+
+	for _, h := range handlers {
+		h(event)
+	}
+}
+
+// parseKeyEvent decodes a key event sent by the embedder.
+func parseKeyEvent(m map[string]any) (focus.KeyEvent, bool) {
+	key := parseString(m["key"])
+	if key == "" {
+		return focus.KeyEvent{}, false
+	}
+	event := focus.KeyEvent{
+		Key:     focus.Key(key),
+		Shift:   parseBool(m["shift"]),
+		Control: parseBool(m["control"]),
+		Alt:     parseBool(m["alt"]),
+		Meta:    parseBool(m["meta"]),
+	}
+	switch parseString(m["action"]) {
+	case "down":
+		event.Action = focus.KeyActionDown
+	case "repeat":
+		event.Action = focus.KeyActionRepeat
+	case "up":
+		event.Action = focus.KeyActionUp
+	default:
+		return focus.KeyEvent{}, false
+	}
+	return event, true
+}
@@ -0,0 +1,35 @@
+package platform
+
+import (
+	"testing"
+
+	"github.com/go-drift/drift/pkg/focus"
+)
+
+func TestHardwareKeyboard_Events(t *testing.T) {
+	SetupTestBridge(t.Cleanup)
+
+	var got []focus.KeyEvent
+	HardwareKeyboard.AddHandler(func(e focus.KeyEvent) { got = append(got, e) })
+
+	sendEvent(t, "drift/keys/events", map[string]any{"key": "ArrowDown", "action": "down"})
+	sendEvent(t, "drift/keys/events", map[string]any{"key": "Tab", "action": "repeat", "shift": true})
+	sendEvent(t, "drift/keys/events", map[string]any{"key": "a", "action": "up", "meta": true})
+	// Events without a key or with an unknown action are dropped.
+	sendEvent(t, "drift/keys/events", map[string]any{"action": "down"})
+	sendEvent(t, "drift/keys/events", map[string]any{"key": "a", "action": "click"})
+
+	want := []focus.KeyEvent{
+		{Key: focus.KeyArrowDown, Action: focus.KeyActionDown},
+		{Key: focus.KeyTab, Action: focus.KeyActionRepeat, Shift: true},
+		{Key: "a", Action: focus.KeyActionUp, Meta: true},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got %d events, want %d: %+v", len(got), len(want), got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("event %d = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package platform
+
+import "sync"
+
+// Keyboard reports how much of the window the on-screen keyboard covers.
+var Keyboard = &KeyboardService{
+	events: NewEventChannel("drift/keyboard/events"),
+}
+
+// KeyboardService tracks the on-screen keyboard insets, in logical pixels.
+// While the keyboard is hidden all insets are zero. On Android this reflects
+// the IME window insets; on iOS, the keyboard frame's overlap with the
+// window.
+type KeyboardService struct {
+	events   *EventChannel
+	insets   EdgeInsets
+	handlers []func(EdgeInsets)
+	mu       sync.RWMutex
+}
+
+func init() {
+	initKeyboardListeners()
+	registerBuiltinInit(initKeyboardListeners)
+}
+
+func initKeyboardListeners() {
+	Keyboard.events.Listen(EventHandler{
+		OnEvent: func(data any) {
+			if m, ok := data.(map[string]any); ok {
+				Keyboard.updateInsets(parseEdgeInsets(m))
+			}
+		},
+	})
+}
+
+// Insets returns the area covered by the keyboard.
+func (k *KeyboardService) Insets() EdgeInsets {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+	return k.insets
+}
+
+// IsVisible reports whether the keyboard covers any part of the window.
+func (k *KeyboardService) IsVisible() bool {
+	return k.Insets() != EdgeInsets{}
+}
+
+// AddHandler registers a handler to be called when the keyboard insets
+// change, such as when the keyboard is shown, hidden or resized.
+// Returns a function that can be called to remove the handler.
+func (k *KeyboardService) AddHandler(handler func(EdgeInsets)) func() {
+	k.mu.Lock()
+	k.handlers = append(k.handlers, handler)
+	index := len(k.handlers) - 1
+	k.mu.Unlock()
+
+	return func() {
+		k.mu.Lock()
+		if index < len(k.handlers) {
+			k.handlers = append(k.handlers[:index], k.handlers[index+1:]...)
+		}
+		k.mu.Unlock()
+	}
+}
+
+// updateInsets stores the new insets and notifies handlers on change.
+func (k *KeyboardService) updateInsets(insets EdgeInsets) {
+	k.mu.Lock()
+	if k.insets == insets {
+		k.mu.Unlock()
+		return
+	}
+	k.insets = insets
+	handlers := make([]func(EdgeInsets), len(k.handlers))
+	copy(handlers, k.handlers)
+	k.mu.Unlock()
+
+	for _, h := range handlers {
+		h(insets)
+	}
+}
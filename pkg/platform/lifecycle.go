@@ -44,6 +44,13 @@ const (
 // LifecycleHandler is called when lifecycle state changes.
 type LifecycleHandler func(state LifecycleState)
 
+// LifecycleProvider is the lifecycle behavior [LifecycleService] provides.
+// Implement it to replace the lifecycle in tests; see [SetForTesting].
+type LifecycleProvider interface {
+	State() LifecycleState
+	AddHandler(handler LifecycleHandler) func()
+}
+
 // disposable is satisfied by *core.StateBase via structural typing. Defined
 // here because platform cannot import core without creating a cycle. If more
 // platform hooks need this, consider extracting it into a shared leaf package.
@@ -140,6 +147,9 @@ func initLifecycleListeners() {
 
 // State returns the current lifecycle state.
 func (l *LifecycleService) State() LifecycleState {
+	if double := testServices().Lifecycle; double != nil {
+		return double.State()
+	}
 	l.mu.RLock()
 	defer l.mu.RUnlock()
 	return l.state
@@ -148,6 +158,9 @@ func (l *LifecycleService) State() LifecycleState {
 // AddHandler registers a handler to be called on lifecycle changes.
 // Returns a function that can be called to remove the handler.
 func (l *LifecycleService) AddHandler(handler LifecycleHandler) func() {
+	if double := testServices().Lifecycle; double != nil {
+		return double.AddHandler(handler)
+	}
 	l.mu.Lock()
 	l.handlers = append(l.handlers, handler)
 	index := len(l.handlers) - 1
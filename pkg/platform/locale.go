@@ -0,0 +1,189 @@
+package platform
+
+import (
+	"slices"
+	"strings"
+	"sync"
+)
+
+// Locales reports the languages the user prefers, in order, as set in the
+// system settings.
+var Locales = &LocaleService{
+	events:  NewEventChannel("drift/locale/events"),
+	locales: []Locale{DefaultLocale},
+}
+
+// Locale identifies a language with an optional script and region, such
+// as en-US, ar-EG or zh-Hans-CN.
+type Locale struct {
+	// Language is the lowercase ISO 639 language code, such as "en".
+	Language string
+	// Script is the title-case ISO 15924 script code, such as "Hans", or
+	// empty.
+	Script string
+	// Region is the uppercase ISO 3166 country code or UN M.49 area code,
+	// such as "US", or empty.
+	Region string
+}
+
+// DefaultLocale is reported until the platform sends the user's locales.
+var DefaultLocale = Locale{Language: "en", Region: "US"}
+
+// ParseLocale parses a BCP 47 language tag such as "pt-BR", also accepting
+// the underscore form used by Android and POSIX ("pt_BR"). Variants and
+// extensions are ignored.
+func ParseLocale(tag string) Locale {
+	parts := strings.FieldsFunc(tag, func(r rune) bool { return r == '-' || r == '_' })
+	if len(parts) == 0 {
+		return Locale{}
+	}
+	l := Locale{Language: strings.ToLower(parts[0])}
+	for _, part := range parts[1:] {
+		switch {
+		case len(part) == 4 && l.Script == "" && l.Region == "" && isLetters(part):
+			l.Script = strings.ToUpper(part[:1]) + strings.ToLower(part[1:])
+		case (len(part) == 2 && isLetters(part)) || (len(part) == 3 && isDigits(part)):
+			if l.Region == "" {
+				l.Region = strings.ToUpper(part)
+			}
+		default:
+			return l
+		}
+	}
+	return l
+}
+
+// String returns the locale as a BCP 47 tag, such as "zh-Hans-CN".
+func (l Locale) String() string {
+	tag := l.Language
+	if l.Script != "" {
+		tag += "-" + l.Script
+	}
+	if l.Region != "" {
+		tag += "-" + l.Region
+	}
+	return tag
+}
+
+// IsRTL reports whether the locale's language is written right to left.
+func (l Locale) IsRTL() bool {
+	switch l.Script {
+	case "Arab", "Hebr", "Thaa", "Syrc", "Nkoo", "Adlm", "Rohg":
+		return true
+	case "":
+	default:
+		return false
+	}
+	return slices.Contains(rtlLanguages, l.Language)
+}
+
+// rtlLanguages are the languages whose default script is written right to
+// left.
+var rtlLanguages = []string{
+	"ar", "ckb", "dv", "fa", "he", "iw", "ks", "ps", "sd", "ug", "ur", "yi",
+}
+
+func isLetters(s string) bool {
+	for _, r := range s {
+		if (r < 'a' || r > 'z') && (r < 'A' || r > 'Z') {
+			return false
+		}
+	}
+	return true
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// LocaleService tracks the user's preferred locales.
+type LocaleService struct {
+	events   *EventChannel
+	locales  []Locale
+	handlers []func([]Locale)
+	mu       sync.RWMutex
+}
+
+func init() {
+	initLocaleListeners()
+	registerBuiltinInit(initLocaleListeners)
+}
+
+func initLocaleListeners() {
+	Locales.events.Listen(EventHandler{
+		OnEvent: func(data any) {
+			m, ok := data.(map[string]any)
+			if !ok {
+				return
+			}
+			tags, _ := m["locales"].([]any)
+			var locales []Locale
+			for _, tag := range tags {
+				if s, ok := tag.(string); ok {
+					if l := ParseLocale(s); l.Language != "" {
+						locales = append(locales, l)
+					}
+				}
+			}
+			Locales.updateLocales(locales)
+		},
+	})
+}
+
+// Preferred returns the user's locales, most preferred first. It is never
+// empty.
+func (s *LocaleService) Preferred() []Locale {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return slices.Clone(s.locales)
+}
+
+// Current returns the user's most preferred locale.
+func (s *LocaleService) Current() Locale {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.locales[0]
+}
+
+// AddHandler registers a handler to be called when the preferred locales
+// change. Returns a function that can be called to remove the handler.
+func (s *LocaleService) AddHandler(handler func([]Locale)) func() {
+	s.mu.Lock()
+	s.handlers = append(s.handlers, handler)
+	index := len(s.handlers) - 1
+	s.mu.Unlock()
+
+	return func() {
+		s.mu.Lock()
+		if index < len(s.handlers) {
+			s.handlers = append(s.handlers[:index], s.handlers[index+1:]...)
+		}
+		s.mu.Unlock()
+	}
+}
+
+// updateLocales stores the new locales and notifies handlers on change. An
+// empty list resets to [DefaultLocale].
+func (s *LocaleService) updateLocales(locales []Locale) {
+	if len(locales) == 0 {
+		locales = []Locale{DefaultLocale}
+	}
+	s.mu.Lock()
+	if slices.Equal(s.locales, locales) {
+		s.mu.Unlock()
+		return
+	}
+	s.locales = locales
+	handlers := make([]func([]Locale), len(s.handlers))
+	copy(handlers, s.handlers)
+	s.mu.Unlock()
+
+	for _, h := range handlers {
+		h(slices.Clone(locales))
+	}
+}
@@ -0,0 +1,72 @@
+package platform
+
+import "testing"
+
+func TestParseLocale(t *testing.T) {
+	tests := []struct {
+		tag  string
+		want Locale
+	}{
+		{"en", Locale{Language: "en"}},
+		{"pt-BR", Locale{Language: "pt", Region: "BR"}},
+		{"pt_br", Locale{Language: "pt", Region: "BR"}},
+		{"zh-Hans-CN", Locale{Language: "zh", Script: "Hans", Region: "CN"}},
+		{"sr_LATN", Locale{Language: "sr", Script: "Latn"}},
+		{"es-419", Locale{Language: "es", Region: "419"}},
+		{"de-DE-u-co-phonebk", Locale{Language: "de", Region: "DE"}},
+		{"", Locale{}},
+	}
+	for _, tt := range tests {
+		got := ParseLocale(tt.tag)
+		if got != tt.want {
+			t.Errorf("ParseLocale(%q) = %+v, want %+v", tt.tag, got, tt.want)
+		}
+	}
+	if got := ParseLocale("zh_hans_cn").String(); got != "zh-Hans-CN" {
+		t.Errorf("String() = %q, want zh-Hans-CN", got)
+	}
+}
+
+func TestLocale_IsRTL(t *testing.T) {
+	tests := []struct {
+		tag  string
+		want bool
+	}{
+		{"ar-EG", true},
+		{"he", true},
+		{"fa-IR", true},
+		{"en-US", false},
+		{"az-Arab", true},
+		{"ks-Deva", false},
+		{"ja", false},
+	}
+	for _, tt := range tests {
+		if got := ParseLocale(tt.tag).IsRTL(); got != tt.want {
+			t.Errorf("%s: IsRTL() = %v, want %v", tt.tag, got, tt.want)
+		}
+	}
+}
+
+func TestLocales_Events(t *testing.T) {
+	SetupTestBridge(t.Cleanup)
+
+	if got := Locales.Current(); got != DefaultLocale {
+		t.Fatalf("expected the default locale, got %v", got)
+	}
+
+	var notified []Locale
+	Locales.AddHandler(func(l []Locale) { notified = l })
+
+	sendEvent(t, "drift/locale/events", map[string]any{"locales": []any{"ar-EG", "en-GB"}})
+	if got := Locales.Current(); got != (Locale{Language: "ar", Region: "EG"}) {
+		t.Errorf("Current() = %v, want ar-EG", got)
+	}
+	if len(notified) != 2 || notified[1] != (Locale{Language: "en", Region: "GB"}) {
+		t.Errorf("expected both locales notified, got %v", notified)
+	}
+
+	sendEvent(t, "drift/locale/events", map[string]any{"locales": []any{}})
+	if got := Locales.Preferred(); len(got) != 1 || got[0] != DefaultLocale {
+		t.Errorf("expected an empty list to reset to the default, got %v", got)
+	}
+}
@@ -11,10 +11,14 @@ const (
 	ErrCodeSourceError = "source_error"
 
 	// ErrCodeDecoderError indicates the media could not be decoded or
-	// rendered. Covers codec failures, audio track initialization errors,
-	// and DRM-related errors.
+	// rendered. Covers codec failures and audio track initialization errors.
 	ErrCodeDecoderError = "decoder_error"
 
+	// ErrCodeDRMError indicates protected media could not be played.
+	// Covers failed license or certificate requests, licenses the server
+	// denied or that expired, and schemes the device does not support.
+	ErrCodeDRMError = "drm_error"
+
 	// ErrCodePlaybackFailed indicates a general playback failure that
 	// does not fit a more specific category.
 	ErrCodePlaybackFailed = "playback_failed"
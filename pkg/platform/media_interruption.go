@@ -0,0 +1,134 @@
+package platform
+
+import "sync"
+
+// InterruptionType is the phase of a [MediaInterruption].
+type InterruptionType int
+
+const (
+	// InterruptionBegan means another audio source took over, such as a
+	// phone call, an alarm, or another app starting playback.
+	InterruptionBegan InterruptionType = iota
+
+	// InterruptionEnded means the interrupting audio finished.
+	InterruptionEnded
+)
+
+// String returns a human-readable representation of the interruption type.
+func (t InterruptionType) String() string {
+	switch t {
+	case InterruptionBegan:
+		return "began"
+	case InterruptionEnded:
+		return "ended"
+	default:
+		return "unknown"
+	}
+}
+
+// MediaInterruption describes an audio interruption of a media player,
+// reported by OnInterruption on [AudioPlayerController] and
+// [VideoPlayerController].
+type MediaInterruption struct {
+	// Type is whether the interruption began or ended.
+	Type InterruptionType
+
+	// WasPlaying reports, when the interruption began, whether the player
+	// was playing.
+	WasPlaying bool
+
+	// Transient reports, when the interruption began, whether it is expected
+	// to end, as for a phone call. When another app starts playing media on
+	// Android, the interruption is not transient and never ends. iOS reports
+	// every interruption as transient and decides with ShouldResume.
+	Transient bool
+
+	// ShouldResume reports, when the interruption ended, whether the system
+	// suggests resuming playback.
+	ShouldResume bool
+}
+
+// InterruptionPolicy controls what a media player does when an
+// interruption begins and ends.
+type InterruptionPolicy int
+
+const (
+	// InterruptionPauseAndResume pauses playback when an interruption
+	// begins and resumes it when the interruption ends, if the player was
+	// playing and the system suggests resuming. This is the default.
+	InterruptionPauseAndResume InterruptionPolicy = iota
+
+	// InterruptionPause pauses playback when an interruption begins and
+	// leaves resuming to the app.
+	InterruptionPause
+
+	// InterruptionIgnore leaves playback to the app, which handles
+	// interruptions in OnInterruption. On iOS the system still pauses
+	// playback when an interruption begins.
+	InterruptionIgnore
+)
+
+// String returns a human-readable representation of the interruption policy.
+func (p InterruptionPolicy) String() string {
+	switch p {
+	case InterruptionPauseAndResume:
+		return "pause_and_resume"
+	case InterruptionPause:
+		return "pause"
+	case InterruptionIgnore:
+		return "ignore"
+	default:
+		return "unknown"
+	}
+}
+
+// interruptionHandler applies a player's interruption policy.
+type interruptionHandler struct {
+	mu     sync.Mutex
+	policy InterruptionPolicy
+	resume bool // playback was paused by a transient interruption
+}
+
+func (h *interruptionHandler) setPolicy(policy InterruptionPolicy) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.policy = policy
+	if policy != InterruptionPauseAndResume {
+		h.resume = false
+	}
+}
+
+// handle pauses or resumes playback for interruption as the policy says.
+// Called on the UI thread.
+func (h *interruptionHandler) handle(interruption MediaInterruption, pause, play func() error) {
+	h.mu.Lock()
+	var pauseNow, resumeNow bool
+	switch interruption.Type {
+	case InterruptionBegan:
+		pauseNow = interruption.WasPlaying && h.policy != InterruptionIgnore
+		h.resume = pauseNow && interruption.Transient && h.policy == InterruptionPauseAndResume
+	case InterruptionEnded:
+		resumeNow = h.resume && interruption.ShouldResume
+		h.resume = false
+	}
+	h.mu.Unlock()
+
+	if pauseNow {
+		pause()
+	}
+	if resumeNow {
+		play()
+	}
+}
+
+// parseMediaInterruption decodes an interruption reported by native.
+func parseMediaInterruption(m map[string]any) MediaInterruption {
+	interruption := MediaInterruption{Type: InterruptionBegan}
+	if parseString(m["type"]) == "ended" {
+		interruption.Type = InterruptionEnded
+	}
+	interruption.WasPlaying, _ = m["wasPlaying"].(bool)
+	interruption.Transient, _ = m["transient"].(bool)
+	interruption.ShouldResume, _ = m["shouldResume"].(bool)
+	return interruption
+}
@@ -0,0 +1,123 @@
+package platform
+
+import (
+	"maps"
+	"slices"
+	"testing"
+)
+
+// sendAudioInterruption simulates a native interruption arriving for the given controller.
+func sendAudioInterruption(t *testing.T, c *AudioPlayerController, event map[string]any) {
+	t.Helper()
+	event["playerId"] = c.id
+	data, err := DefaultCodec.Encode(event)
+	if err != nil {
+		t.Fatalf("encode interruption: %v", err)
+	}
+	if err := HandleEvent("drift/audio_player/interruptions", data); err != nil {
+		t.Fatalf("HandleEvent: %v", err)
+	}
+}
+
+// audioMethods returns the drift/audio_player methods invoked since the last reset.
+func audioMethods(bridge *testBridge) []string {
+	bridge.mu.Lock()
+	defer bridge.mu.Unlock()
+	var methods []string
+	for _, call := range bridge.calls {
+		if call.channel == "drift/audio_player" {
+			methods = append(methods, call.method)
+		}
+	}
+	return methods
+}
+
+func TestAudioPlayerController_InterruptionPolicies(t *testing.T) {
+	began := map[string]any{"type": "began", "wasPlaying": true, "transient": true}
+	ended := map[string]any{"type": "ended", "shouldResume": true}
+
+	tests := []struct {
+		name   string
+		policy InterruptionPolicy
+		began  map[string]any
+		ended  map[string]any
+		want   []string
+	}{
+		{"pause and resume", InterruptionPauseAndResume, began, ended, []string{"pause", "play"}},
+		{"pause only", InterruptionPause, began, ended, []string{"pause"}},
+		{"ignore", InterruptionIgnore, began, ended, nil},
+		{"not playing", InterruptionPauseAndResume,
+			map[string]any{"type": "began", "wasPlaying": false, "transient": true}, ended, nil},
+		{"permanent", InterruptionPauseAndResume,
+			map[string]any{"type": "began", "wasPlaying": true, "transient": false}, ended, []string{"pause"}},
+		{"no resume suggested", InterruptionPauseAndResume,
+			began, map[string]any{"type": "ended", "shouldResume": false}, []string{"pause"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bridge := setupTestBridge(t)
+			c := NewAudioPlayerController()
+			defer c.Dispose()
+			c.SetInterruptionPolicy(tt.policy)
+
+			var got []MediaInterruption
+			c.OnInterruption = func(interruption MediaInterruption) {
+				got = append(got, interruption)
+			}
+			bridge.reset()
+			sendAudioInterruption(t, c, maps.Clone(tt.began))
+			sendAudioInterruption(t, c, maps.Clone(tt.ended))
+
+			if methods := audioMethods(bridge); !slices.Equal(methods, tt.want) {
+				t.Errorf("methods: got %v, want %v", methods, tt.want)
+			}
+			if len(got) != 2 || got[0].Type != InterruptionBegan || got[1].Type != InterruptionEnded {
+				t.Errorf("callbacks: got %+v", got)
+			}
+		})
+	}
+}
+
+func TestVideoPlayerController_Interruption(t *testing.T) {
+	bridge := setupTestBridge(t)
+
+	c := NewVideoPlayerController()
+	defer c.Dispose()
+
+	var got []MediaInterruption
+	c.OnInterruption = func(interruption MediaInterruption) {
+		got = append(got, interruption)
+	}
+	bridge.reset()
+
+	sendVideoViewEvent(t, "onInterruption", map[string]any{
+		"viewId":     c.ViewID(),
+		"type":       "began",
+		"wasPlaying": true,
+		"transient":  true,
+	})
+	sendVideoViewEvent(t, "onInterruption", map[string]any{
+		"viewId":       c.ViewID(),
+		"type":         "ended",
+		"shouldResume": true,
+	})
+
+	var methods []string
+	bridge.mu.Lock()
+	for _, call := range bridge.calls {
+		if args, ok := call.args.(map[string]any); ok && call.method == "invokeViewMethod" {
+			methods = append(methods, args["method"].(string))
+		}
+	}
+	bridge.mu.Unlock()
+	if !slices.Equal(methods, []string{"pause", "play"}) {
+		t.Errorf("methods: got %v, want [pause play]", methods)
+	}
+	want := []MediaInterruption{
+		{Type: InterruptionBegan, WasPlaying: true, Transient: true},
+		{Type: InterruptionEnded, ShouldResume: true},
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("callbacks: got %+v, want %+v", got, want)
+	}
+}
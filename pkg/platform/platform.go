@@ -0,0 +1,75 @@
+package platform
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+)
+
+// ErrAlreadyInitialized is returned by [Initialize] when the default platform
+// already has a native bridge, such as when a second engine instance starts
+// in the same process.
+var ErrAlreadyInitialized = errors.New("platform: already initialized")
+
+// Platform connects a set of channels to one native bridge. Method calls on
+// its channels go to its bridge, and events from its bridge reach only its
+// channels.
+//
+// The package-level functions and services ([Lifecycle], [SafeArea],
+// [Haptics], etc.) use the default platform returned by [Default] and bound
+// by [Initialize]. Additional platforms created with [New] are isolated from
+// it, so tests can drive their own channels without touching global state.
+type Platform struct {
+	channels *channelRegistry
+	bridge   NativeBridge
+	bridgeMu sync.RWMutex
+	initMu   sync.Mutex
+	recorder atomic.Pointer[ChannelRecorder]
+}
+
+var defaultPlatform = &Platform{channels: newChannelRegistry()}
+
+// Initialize binds bridge to the default platform and returns it. The bridge
+// package calls it once during startup; event streams that package init
+// subscribed to start then.
+//
+// Initialize returns [ErrAlreadyInitialized] if the default platform already
+// has a bridge, since the package-level services assume a single engine per
+// process. Use [New] for additional, isolated platforms.
+func Initialize(bridge NativeBridge) (*Platform, error) {
+	if bridge == nil {
+		return nil, ErrInvalidArguments
+	}
+	defaultPlatform.initMu.Lock()
+	defer defaultPlatform.initMu.Unlock()
+	if defaultPlatform.nativeBridge() != nil {
+		return nil, ErrAlreadyInitialized
+	}
+	defaultPlatform.setBridge(bridge)
+	return defaultPlatform, nil
+}
+
+// Default returns the platform used by the package-level functions and
+// services.
+func Default() *Platform {
+	return defaultPlatform
+}
+
+// New creates a platform bound to bridge, with its own channels. Create
+// channels on it with [Platform.NewMethodChannel] and
+// [Platform.NewEventChannel], and deliver native calls and events with
+// [Platform.HandleMethodCall] and [Platform.HandleEvent].
+//
+//	p := platform.New(fakeBridge)
+//	ch := p.NewMethodChannel("app/greeter")
+//	result, err := ch.Invoke(ctx, "greet", nil) // handled by fakeBridge
+func New(bridge NativeBridge) *Platform {
+	return &Platform{channels: newChannelRegistry(), bridge: bridge}
+}
+
+// nativeBridge returns the bridge of p, or nil if none is set.
+func (p *Platform) nativeBridge() NativeBridge {
+	p.bridgeMu.RLock()
+	defer p.bridgeMu.RUnlock()
+	return p.bridge
+}
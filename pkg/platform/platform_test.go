@@ -0,0 +1,74 @@
+package platform
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestInitialize_RefusesSecondBridge(t *testing.T) {
+	ResetForTest()
+	t.Cleanup(ResetForTest)
+
+	p, err := Initialize(&countingBridge{})
+	if err != nil || p != Default() {
+		t.Fatalf("Initialize: got (%p, %v), want the default platform", p, err)
+	}
+	if _, err := Initialize(&countingBridge{}); !errors.Is(err, ErrAlreadyInitialized) {
+		t.Errorf("second Initialize: got %v, want ErrAlreadyInitialized", err)
+	}
+	if _, err := Initialize(nil); !errors.Is(err, ErrInvalidArguments) {
+		t.Errorf("Initialize(nil): got %v, want ErrInvalidArguments", err)
+	}
+
+	ResetForTest()
+	if _, err := Initialize(&countingBridge{}); err != nil {
+		t.Errorf("Initialize after reset: %v", err)
+	}
+}
+
+func TestNew_IsolatesChannels(t *testing.T) {
+	ResetForTest()
+	t.Cleanup(ResetForTest)
+	global := &countingBridge{}
+	SetNativeBridge(global)
+
+	bridge := &countingBridge{response: "hello"}
+	p := New(bridge)
+	ch := p.NewMethodChannel("test/isolated")
+	result, err := ch.Invoke(context.Background(), "greet", nil)
+	if err != nil || result != "hello" {
+		t.Fatalf("Invoke: got (%v, %v), want hello", result, err)
+	}
+	if bridge.callCount() != 1 || global.callCount() != 0 {
+		t.Errorf("calls: got %d on the platform, %d on the default", bridge.callCount(), global.callCount())
+	}
+
+	var events []any
+	p.NewEventChannel("test/isolated/events").Listen(EventHandler{
+		OnEvent: func(data any) { events = append(events, data) },
+	})
+	data, _ := DefaultCodec.Encode("ping")
+	if err := HandleEvent("test/isolated/events", data); !errors.Is(err, ErrChannelNotRegistered) {
+		t.Errorf("default HandleEvent: got %v, want ErrChannelNotRegistered", err)
+	}
+	if err := p.HandleEvent("test/isolated/events", data); err != nil {
+		t.Fatalf("HandleEvent: %v", err)
+	}
+	if len(events) != 1 || events[0] != "ping" {
+		t.Errorf("events: got %v, want [ping]", events)
+	}
+
+	ch.SetHandler(func(method string, args any) (any, error) { return method, nil })
+	if _, err := HandleMethodCall("test/isolated", "echo", nil); !errors.Is(err, ErrChannelNotFound) {
+		t.Errorf("default HandleMethodCall: got %v, want ErrChannelNotFound", err)
+	}
+	args, _ := DefaultCodec.Encode(nil)
+	out, err := p.HandleMethodCall("test/isolated", "echo", args)
+	if err != nil {
+		t.Fatalf("HandleMethodCall: %v", err)
+	}
+	if got, _ := DefaultCodec.Decode(out); got != "echo" {
+		t.Errorf("HandleMethodCall: got %v, want echo", got)
+	}
+}
@@ -2,6 +2,7 @@ package platform
 
 import (
 	"context"
+	"encoding/base64"
 	"errors"
 	"maps"
 	"sync"
@@ -66,6 +67,9 @@ type PlatformViewRegistry struct {
 	// Views NOT seen get empty clip bounds in FlushGeometryBatch, signaling hidden.
 	viewsSeenThisFrame map[int64]struct{}
 	capturedViews      []CapturedViewGeometry
+	// transformed holds the views last painted scaled, rotated or
+	// translucent (see SetViewTransformed).
+	transformed map[int64]bool
 }
 
 var platformViewRegistry *PlatformViewRegistry
@@ -85,6 +89,7 @@ func newPlatformViewRegistry() *PlatformViewRegistry {
 		channel:            NewMethodChannel("drift/platform_views"),
 		geometryCache:      make(map[int64]CapturedViewGeometry),
 		viewsSeenThisFrame: make(map[int64]struct{}),
+		transformed:        make(map[int64]bool),
 	}
 
 	// Handle incoming calls from native
@@ -131,6 +136,10 @@ func (r *PlatformViewRegistry) handleEvent(data any) {
 		r.handleAction(args)
 	case "onFocusChanged":
 		r.handleFocusChanged(args)
+	case "onContentInserted":
+		r.handleContentInserted(args)
+	case "onSpellCheckResults":
+		r.handleSpellCheckResults(args)
 	case "onSwitchChanged":
 		r.handleSwitchChanged(args)
 	case "onPlaybackStateChanged":
@@ -139,6 +148,18 @@ func (r *PlatformViewRegistry) handleEvent(data any) {
 		r.handleVideoPositionChanged(args)
 	case "onVideoError":
 		r.handleVideoError(args)
+	case "onSubtitleTracksChanged":
+		r.handleVideoSubtitleTracksChanged(args)
+	case "onCueChanged":
+		r.handleVideoCueChanged(args)
+	case "onCastingChanged":
+		r.handleVideoCastingChanged(args)
+	case "onQualitiesChanged":
+		r.handleVideoQualitiesChanged(args)
+	case "onQualityChanged":
+		r.handleVideoQualityChanged(args)
+	case "onInterruption":
+		r.handleVideoInterruption(args)
 	case "onPageStarted":
 		r.handleWebViewPageStarted(args)
 	case "onPageFinished":
@@ -355,6 +376,7 @@ func (r *PlatformViewRegistry) resendGeometry(viewID int64) {
 func (r *PlatformViewRegistry) ClearGeometryCache(viewID int64) {
 	r.batchMu.Lock()
 	delete(r.geometryCache, viewID)
+	delete(r.transformed, viewID)
 	r.batchMu.Unlock()
 }
 
@@ -405,6 +427,10 @@ func (r *PlatformViewRegistry) handleMethodCall(method string, args any) (any, e
 		return r.handleAction(args)
 	case "onFocusChanged":
 		return r.handleFocusChanged(args)
+	case "onContentInserted":
+		return r.handleContentInserted(args)
+	case "onSpellCheckResults":
+		return r.handleSpellCheckResults(args)
 	case "onSwitchChanged":
 		return r.handleSwitchChanged(args)
 	case "onPlaybackStateChanged":
@@ -413,6 +439,18 @@ func (r *PlatformViewRegistry) handleMethodCall(method string, args any) (any, e
 		return r.handleVideoPositionChanged(args)
 	case "onVideoError":
 		return r.handleVideoError(args)
+	case "onSubtitleTracksChanged":
+		return r.handleVideoSubtitleTracksChanged(args)
+	case "onCueChanged":
+		return r.handleVideoCueChanged(args)
+	case "onCastingChanged":
+		return r.handleVideoCastingChanged(args)
+	case "onQualitiesChanged":
+		return r.handleVideoQualitiesChanged(args)
+	case "onQualityChanged":
+		return r.handleVideoQualityChanged(args)
+	case "onInterruption":
+		return r.handleVideoInterruption(args)
 	case "onPageStarted":
 		return r.handleWebViewPageStarted(args)
 	case "onPageFinished":
@@ -528,6 +566,96 @@ func (r *PlatformViewRegistry) handleFocusChanged(raw any) (any, error) {
 	return nil, nil
 }
 
+func (r *PlatformViewRegistry) handleContentInserted(raw any) (any, error) {
+	const op = "handleContentInserted"
+	args, err := requireMap(op, raw)
+	if err != nil {
+		return nil, reportPlatformViewArg(op, err)
+	}
+	viewID, err := requireInt64(op, args, "viewId")
+	if err != nil {
+		return nil, reportPlatformViewArg(op, err)
+	}
+	mimeType, err := requireString(op, args, "mimeType")
+	if err != nil {
+		return nil, reportPlatformViewArg(op, err)
+	}
+	encoded, err := requireString(op, args, "data")
+	if err != nil {
+		return nil, reportPlatformViewArg(op, err)
+	}
+	data, decodeErr := base64.StdEncoding.DecodeString(encoded)
+	if decodeErr != nil {
+		return nil, reportPlatformViewArg(op, &argError{
+			Op: op, Key: "data", Want: "base64 string", Got: encoded,
+		})
+	}
+	uri, _ := args["uri"].(string)
+
+	view, err := lookupView[*TextInputView](r, viewID)
+	if err != nil {
+		if errors.Is(err, errViewNotFound) {
+			return nil, nil
+		}
+		return nil, reportPlatformViewArg(op, err)
+	}
+	view.handleContentInserted(InsertedContent{MimeType: mimeType, Data: data, URI: uri})
+	return nil, nil
+}
+
+func (r *PlatformViewRegistry) handleSpellCheckResults(raw any) (any, error) {
+	const op = "handleSpellCheckResults"
+	args, err := requireMap(op, raw)
+	if err != nil {
+		return nil, reportPlatformViewArg(op, err)
+	}
+	viewID, err := requireInt64(op, args, "viewId")
+	if err != nil {
+		return nil, reportPlatformViewArg(op, err)
+	}
+	rawRanges, ok := args["ranges"].([]any)
+	if !ok {
+		return nil, reportPlatformViewArg(op, &argError{
+			Op: op, Key: "ranges", Want: "array", Got: args["ranges"],
+		})
+	}
+	ranges := make([]MisspelledRange, 0, len(rawRanges))
+	for _, item := range rawRanges {
+		m, err := requireMap(op, item)
+		if err != nil {
+			return nil, reportPlatformViewArg(op, err)
+		}
+		start, err := requireInt(op, m, "start")
+		if err != nil {
+			return nil, reportPlatformViewArg(op, err)
+		}
+		end, err := requireInt(op, m, "end")
+		if err != nil {
+			return nil, reportPlatformViewArg(op, err)
+		}
+		word, _ := m["word"].(string)
+		var suggestions []string
+		if rawSuggestions, ok := m["suggestions"].([]any); ok {
+			for _, s := range rawSuggestions {
+				if str, ok := s.(string); ok {
+					suggestions = append(suggestions, str)
+				}
+			}
+		}
+		ranges = append(ranges, MisspelledRange{Start: start, End: end, Word: word, Suggestions: suggestions})
+	}
+
+	view, err := lookupView[*TextInputView](r, viewID)
+	if err != nil {
+		if errors.Is(err, errViewNotFound) {
+			return nil, nil
+		}
+		return nil, reportPlatformViewArg(op, err)
+	}
+	view.handleMisspelledRanges(ranges)
+	return nil, nil
+}
+
 func (r *PlatformViewRegistry) handleSwitchChanged(raw any) (any, error) {
 	const op = "handleSwitchChanged"
 	args, err := requireMap(op, raw)
@@ -648,6 +776,202 @@ func (r *PlatformViewRegistry) handleVideoError(raw any) (any, error) {
 	return nil, nil
 }
 
+func (r *PlatformViewRegistry) handleVideoSubtitleTracksChanged(raw any) (any, error) {
+	const op = "handleVideoSubtitleTracksChanged"
+	args, err := requireMap(op, raw)
+	if err != nil {
+		return nil, reportPlatformViewArg(op, err)
+	}
+	viewID, err := requireInt64(op, args, "viewId")
+	if err != nil {
+		return nil, reportPlatformViewArg(op, err)
+	}
+	rawTracks, ok := args["tracks"].([]any)
+	if !ok {
+		return nil, reportPlatformViewArg(op, &argError{
+			Op: op, Key: "tracks", Want: "array", Got: args["tracks"],
+		})
+	}
+	tracks := make([]SubtitleTrack, 0, len(rawTracks))
+	for _, item := range rawTracks {
+		m, err := requireMap(op, item)
+		if err != nil {
+			return nil, reportPlatformViewArg(op, err)
+		}
+		id, err := requireString(op, m, "id")
+		if err != nil {
+			return nil, reportPlatformViewArg(op, err)
+		}
+		label, _ := m["label"].(string)
+		language, _ := m["language"].(string)
+		tracks = append(tracks, SubtitleTrack{ID: id, Label: label, Language: language})
+	}
+	selected, _ := args["selected"].(string)
+
+	view, err := lookupView[*videoPlayerView](r, viewID)
+	if err != nil {
+		if errors.Is(err, errViewNotFound) {
+			return nil, nil
+		}
+		return nil, reportPlatformViewArg(op, err)
+	}
+	view.handleSubtitleTracksChanged(tracks, selected)
+	return nil, nil
+}
+
+func (r *PlatformViewRegistry) handleVideoCueChanged(raw any) (any, error) {
+	const op = "handleVideoCueChanged"
+	args, err := requireMap(op, raw)
+	if err != nil {
+		return nil, reportPlatformViewArg(op, err)
+	}
+	viewID, err := requireInt64(op, args, "viewId")
+	if err != nil {
+		return nil, reportPlatformViewArg(op, err)
+	}
+	rawCues, ok := args["cues"].([]any)
+	if !ok {
+		return nil, reportPlatformViewArg(op, &argError{
+			Op: op, Key: "cues", Want: "array", Got: args["cues"],
+		})
+	}
+	var cues []SubtitleCue
+	for _, item := range rawCues {
+		m, err := requireMap(op, item)
+		if err != nil {
+			return nil, reportPlatformViewArg(op, err)
+		}
+		text, err := requireString(op, m, "text")
+		if err != nil {
+			return nil, reportPlatformViewArg(op, err)
+		}
+		startMs, _ := toInt64(m["startMs"])
+		cues = append(cues, SubtitleCue{Start: time.Duration(startMs) * time.Millisecond, Text: text})
+	}
+
+	view, err := lookupView[*videoPlayerView](r, viewID)
+	if err != nil {
+		if errors.Is(err, errViewNotFound) {
+			return nil, nil
+		}
+		return nil, reportPlatformViewArg(op, err)
+	}
+	view.handleCuesChanged(cues)
+	return nil, nil
+}
+
+func (r *PlatformViewRegistry) handleVideoCastingChanged(raw any) (any, error) {
+	const op = "handleVideoCastingChanged"
+	args, err := requireMap(op, raw)
+	if err != nil {
+		return nil, reportPlatformViewArg(op, err)
+	}
+	viewID, err := requireInt64(op, args, "viewId")
+	if err != nil {
+		return nil, reportPlatformViewArg(op, err)
+	}
+	casting, err := requireBool(op, args, "casting")
+	if err != nil {
+		return nil, reportPlatformViewArg(op, err)
+	}
+	deviceName, _ := args["deviceName"].(string)
+
+	view, err := lookupView[*videoPlayerView](r, viewID)
+	if err != nil {
+		if errors.Is(err, errViewNotFound) {
+			return nil, nil
+		}
+		return nil, reportPlatformViewArg(op, err)
+	}
+	view.handleCastingChanged(casting, deviceName)
+	return nil, nil
+}
+
+func (r *PlatformViewRegistry) handleVideoQualitiesChanged(raw any) (any, error) {
+	const op = "handleVideoQualitiesChanged"
+	args, err := requireMap(op, raw)
+	if err != nil {
+		return nil, reportPlatformViewArg(op, err)
+	}
+	viewID, err := requireInt64(op, args, "viewId")
+	if err != nil {
+		return nil, reportPlatformViewArg(op, err)
+	}
+	rawQualities, ok := args["qualities"].([]any)
+	if !ok {
+		return nil, reportPlatformViewArg(op, &argError{
+			Op: op, Key: "qualities", Want: "array", Got: args["qualities"],
+		})
+	}
+	qualities := make([]VideoQuality, 0, len(rawQualities))
+	for _, item := range rawQualities {
+		quality, err := parseVideoQuality(op, item)
+		if err != nil {
+			return nil, reportPlatformViewArg(op, err)
+		}
+		qualities = append(qualities, quality)
+	}
+	selected, _ := args["selected"].(string)
+
+	view, err := lookupView[*videoPlayerView](r, viewID)
+	if err != nil {
+		if errors.Is(err, errViewNotFound) {
+			return nil, nil
+		}
+		return nil, reportPlatformViewArg(op, err)
+	}
+	view.handleQualitiesChanged(qualities, selected)
+	return nil, nil
+}
+
+func (r *PlatformViewRegistry) handleVideoQualityChanged(raw any) (any, error) {
+	const op = "handleVideoQualityChanged"
+	args, err := requireMap(op, raw)
+	if err != nil {
+		return nil, reportPlatformViewArg(op, err)
+	}
+	viewID, err := requireInt64(op, args, "viewId")
+	if err != nil {
+		return nil, reportPlatformViewArg(op, err)
+	}
+	quality, err := parseVideoQuality(op, args)
+	if err != nil {
+		return nil, reportPlatformViewArg(op, err)
+	}
+
+	view, err := lookupView[*videoPlayerView](r, viewID)
+	if err != nil {
+		if errors.Is(err, errViewNotFound) {
+			return nil, nil
+		}
+		return nil, reportPlatformViewArg(op, err)
+	}
+	view.handleQualityChanged(quality)
+	return nil, nil
+}
+
+func (r *PlatformViewRegistry) handleVideoInterruption(raw any) (any, error) {
+	const op = "handleVideoInterruption"
+	args, err := requireMap(op, raw)
+	if err != nil {
+		return nil, reportPlatformViewArg(op, err)
+	}
+	viewID, err := requireInt64(op, args, "viewId")
+	if err != nil {
+		return nil, reportPlatformViewArg(op, err)
+	}
+
+	view, err := lookupView[*videoPlayerView](r, viewID)
+	if err != nil {
+		if errors.Is(err, errViewNotFound) {
+			return nil, nil
+		}
+		return nil, reportPlatformViewArg(op, err)
+	}
+	view.handleInterruption(parseMediaInterruption(args))
+	return nil, nil
+}
+
 func (r *PlatformViewRegistry) handleWebViewPageStarted(raw any) (any, error) {
 	const op = "handleWebViewPageStarted"
 	args, err := requireMap(op, raw)
@@ -0,0 +1,78 @@
+package platform
+
+// SurfaceMode selects how an Android platform view that renders into a
+// surface, such as a video player, is composited with the widget tree.
+// iOS composites every platform view as a layer and ignores the mode.
+//
+// A SurfaceView is composited by the system in its own layer behind a hole
+// punched in the window. It is the most efficient path and the only one with
+// HDR output, but it cannot be blended, clipped to a non-rectangular shape,
+// or kept perfectly in step with widgets as it moves. A TextureView is drawn like any other view, so it
+// follows scrolls, transitions, partial occlusion and opacity, at the cost of
+// an extra GPU copy per frame and SDR-only output.
+type SurfaceMode int
+
+const (
+	// SurfaceModeAuto uses a TextureView, or a SurfaceView when the content
+	// needs one, as for HDR output (see [VideoPlayerOptions.PreserveHDR]).
+	// A SurfaceView falls back to a TextureView while the view is painted
+	// scaled, rotated or translucent.
+	SurfaceModeAuto SurfaceMode = iota
+
+	// SurfaceModeTexture always uses a TextureView.
+	SurfaceModeTexture
+
+	// SurfaceModeSurface uses a SurfaceView, falling back to a TextureView
+	// while the view is painted scaled, rotated or translucent, as during
+	// zoom and fade page transitions.
+	SurfaceModeSurface
+)
+
+// String returns a human-readable representation of the surface mode.
+func (m SurfaceMode) String() string {
+	switch m {
+	case SurfaceModeAuto:
+		return "auto"
+	case SurfaceModeTexture:
+		return "texture"
+	case SurfaceModeSurface:
+		return "surface"
+	default:
+		return "unknown"
+	}
+}
+
+// transformListener is implemented by platform views that change how they
+// render while painted scaled, rotated or translucent.
+type transformListener interface {
+	setTransformed(transformed bool)
+}
+
+// SetViewTransformed records whether a platform view was painted scaled,
+// rotated or translucent this frame. Views that render into a SurfaceView
+// are told when this changes so they can fall back to a TextureView.
+// Gracefully ignores disposed or unknown viewIDs.
+func (r *PlatformViewRegistry) SetViewTransformed(viewID int64, transformed bool) {
+	r.mu.RLock()
+	view, exists := r.views[viewID]
+	r.mu.RUnlock()
+	if !exists {
+		return
+	}
+
+	r.batchMu.Lock()
+	changed := r.transformed[viewID] != transformed
+	if transformed {
+		r.transformed[viewID] = true
+	} else {
+		delete(r.transformed, viewID)
+	}
+	r.batchMu.Unlock()
+
+	if listener, ok := view.(transformListener); ok && changed {
+		// Geometry is resolved while the frame lock is held.
+		Dispatch(func() {
+			listener.setTransformed(transformed)
+		})
+	}
+}
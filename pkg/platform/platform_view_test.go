@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"reflect"
 	"sync"
 	"testing"
 
@@ -69,6 +70,7 @@ func newTestRegistry(viewIDs ...int64) *PlatformViewRegistry {
 		channel:            NewMethodChannel("test/platform_views"),
 		geometryCache:      make(map[int64]CapturedViewGeometry),
 		viewsSeenThisFrame: make(map[int64]struct{}),
+		transformed:        make(map[int64]bool),
 	}
 	for _, id := range viewIDs {
 		r.views[id] = &stubView{id: id}
@@ -114,6 +116,8 @@ type fakeTextInputClient struct {
 	textCalls   []textCall
 	actionCalls []TextInputAction
 	focusCalls  []bool
+	content     []InsertedContent
+	misspelled  [][]MisspelledRange
 }
 
 type textCall struct {
@@ -136,6 +140,16 @@ func (c *fakeTextInputClient) OnFocusChanged(focused bool) {
 	c.focusCalls = append(c.focusCalls, focused)
 	c.mu.Unlock()
 }
+func (c *fakeTextInputClient) OnMisspelledRanges(ranges []MisspelledRange) {
+	c.mu.Lock()
+	c.misspelled = append(c.misspelled, ranges)
+	c.mu.Unlock()
+}
+func (c *fakeTextInputClient) OnContentInserted(content InsertedContent) {
+	c.mu.Lock()
+	c.content = append(c.content, content)
+	c.mu.Unlock()
+}
 
 type fakeSwitchClient struct {
 	mu     sync.Mutex
@@ -288,6 +302,92 @@ func TestHandleTextChanged(t *testing.T) {
 	})
 }
 
+func TestHandleContentInserted(t *testing.T) {
+	defer installImmediateDispatch(t)()
+
+	const viewID int64 = 7
+
+	t.Run("happy", func(t *testing.T) {
+		reg := newTestRegistry()
+		client := &fakeTextInputClient{}
+		registerView(reg, NewTextInputView(viewID, TextInputViewConfig{}, client))
+
+		_, err := reg.handleContentInserted(map[string]any{
+			"viewId":   float64(viewID),
+			"mimeType": "image/gif",
+			"data":     "R0lGODlh",
+			"uri":      "content://keyboard/1",
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(client.content) != 1 {
+			t.Fatalf("expected one insertion, got %d", len(client.content))
+		}
+		got := client.content[0]
+		if got.MimeType != "image/gif" || string(got.Data) != "GIF89a" || got.URI != "content://keyboard/1" {
+			t.Errorf("unexpected content %+v", got)
+		}
+	})
+
+	t.Run("invalid base64", func(t *testing.T) {
+		reg := newTestRegistry()
+		h := captureErrorReports(t)
+		client := &fakeTextInputClient{}
+		registerView(reg, NewTextInputView(viewID, TextInputViewConfig{}, client))
+
+		_, err := reg.handleContentInserted(map[string]any{
+			"viewId":   float64(viewID),
+			"mimeType": "image/png",
+			"data":     "not base64!",
+		})
+		if err == nil {
+			t.Fatal("expected error")
+		}
+		assertArgErrorReport(t, h, "platform_view.handleContentInserted", platformViewsChannel)
+		if len(client.content) != 0 {
+			t.Error("expected no insertion for malformed data")
+		}
+	})
+}
+
+func TestHandleSpellCheckResults(t *testing.T) {
+	defer installImmediateDispatch(t)()
+
+	const viewID int64 = 7
+	reg := newTestRegistry()
+	client := &fakeTextInputClient{}
+	view := NewTextInputView(viewID, TextInputViewConfig{}, client)
+	registerView(reg, view)
+	// Native offsets count UTF-16 code units; each "é" is one unit but two bytes.
+	view.handleTextChanged("héé wrold", 9, 9)
+
+	_, err := reg.handleSpellCheckResults(map[string]any{
+		"viewId": float64(viewID),
+		"ranges": []any{
+			map[string]any{
+				"start":       float64(4),
+				"end":         float64(9),
+				"word":        "wrold",
+				"suggestions": []any{"world", "wold"},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []MisspelledRange{{Start: 6, End: 11, Word: "wrold", Suggestions: []string{"world", "wold"}}}
+	if len(client.misspelled) != 1 || !reflect.DeepEqual(client.misspelled[0], want) {
+		t.Fatalf("unexpected results %+v", client.misspelled)
+	}
+
+	h := captureErrorReports(t)
+	if _, err := reg.handleSpellCheckResults(map[string]any{"viewId": float64(viewID)}); err == nil {
+		t.Fatal("expected error for missing ranges")
+	}
+	assertArgErrorReport(t, h, "platform_view.handleSpellCheckResults", platformViewsChannel)
+}
+
 func TestHandleSwitchChanged(t *testing.T) {
 	defer installImmediateDispatch(t)()
 
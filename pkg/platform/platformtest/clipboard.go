@@ -0,0 +1,56 @@
+package platformtest
+
+import "sync"
+
+// Clipboard is an in-memory [platform.ClipboardProvider].
+// All methods are safe for concurrent use.
+type Clipboard struct {
+	mu   sync.Mutex
+	text string
+	err  error
+}
+
+// GetText returns the clipboard text.
+func (c *Clipboard) GetText() (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.text, c.err
+}
+
+// SetText stores text on the clipboard.
+func (c *Clipboard) SetText(text string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.err != nil {
+		return c.err
+	}
+	c.text = text
+	return nil
+}
+
+// HasText reports whether the clipboard holds text.
+func (c *Clipboard) HasText() (bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.text != "", c.err
+}
+
+// Clear empties the clipboard.
+func (c *Clipboard) Clear() error {
+	return c.SetText("")
+}
+
+// Text returns the clipboard text, for assertions.
+func (c *Clipboard) Text() string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.text
+}
+
+// SetError makes every clipboard operation fail with err, as when the
+// platform denies access. Pass nil to succeed again.
+func (c *Clipboard) SetError(err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.err = err
+}
@@ -0,0 +1,19 @@
+// Package platformtest provides test doubles for Drift platform services, so
+// widget and app logic can be tested without a device or native bridge.
+//
+// [Install] replaces the global services with fakes for the duration of a
+// test:
+//
+//	func TestCopyButton(t *testing.T) {
+//	    services := platformtest.Install(t)
+//	    tester := drifttest.NewWidgetTesterWithT(t)
+//	    tester.PumpWidget(CopyButton{Text: "hello"})
+//
+//	    tester.Tap(drifttest.ByText("Copy"))
+//	    if services.Clipboard.Text() != "hello" {
+//	        t.Error("expected the text on the clipboard")
+//	    }
+//	}
+//
+// Each fake can also be installed on its own with [platform.SetForTesting].
+package platformtest
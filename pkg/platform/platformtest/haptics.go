@@ -0,0 +1,54 @@
+package platformtest
+
+import (
+	"sync"
+
+	"github.com/go-drift/drift/pkg/platform"
+)
+
+// Haptics is a [platform.HapticsProvider] that records feedback instead of
+// playing it. All methods are safe for concurrent use.
+type Haptics struct {
+	mu         sync.Mutex
+	impacts    []platform.HapticFeedbackType
+	vibrations []int
+}
+
+// Impact records an impact feedback.
+func (h *Haptics) Impact(style platform.HapticFeedbackType) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.impacts = append(h.impacts, style)
+	return nil
+}
+
+// Vibrate records a vibration.
+func (h *Haptics) Vibrate(durationMs int) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.vibrations = append(h.vibrations, durationMs)
+	return nil
+}
+
+// Impacts returns the impact feedback styles played so far, oldest first.
+func (h *Haptics) Impacts() []platform.HapticFeedbackType {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return append([]platform.HapticFeedbackType(nil), h.impacts...)
+}
+
+// Vibrations returns the durations in milliseconds of the vibrations played
+// so far, oldest first.
+func (h *Haptics) Vibrations() []int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return append([]int(nil), h.vibrations...)
+}
+
+// Reset forgets the recorded feedback.
+func (h *Haptics) Reset() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.impacts = nil
+	h.vibrations = nil
+}
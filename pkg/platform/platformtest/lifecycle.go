@@ -0,0 +1,57 @@
+package platformtest
+
+import (
+	"sync"
+
+	"github.com/go-drift/drift/pkg/platform"
+)
+
+// Lifecycle is a [platform.LifecycleProvider] whose state the test sets.
+// All methods are safe for concurrent use.
+type Lifecycle struct {
+	mu       sync.Mutex
+	state    platform.LifecycleState
+	handlers handlers[platform.LifecycleState]
+}
+
+// NewLifecycle returns a lifecycle in the resumed state.
+func NewLifecycle() *Lifecycle {
+	return &Lifecycle{state: platform.LifecycleStateResumed}
+}
+
+// State returns the current lifecycle state.
+func (l *Lifecycle) State() platform.LifecycleState {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.state
+}
+
+// AddHandler registers a handler called when SetState changes the state.
+// Returns a function that removes it.
+func (l *Lifecycle) AddHandler(handler platform.LifecycleHandler) func() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	id := l.handlers.add(handler)
+	return func() {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		l.handlers.remove(id)
+	}
+}
+
+// SetState changes the lifecycle state and notifies handlers, as when the
+// app moves to the background. Setting the current state does nothing.
+func (l *Lifecycle) SetState(state platform.LifecycleState) {
+	l.mu.Lock()
+	if l.state == state {
+		l.mu.Unlock()
+		return
+	}
+	l.state = state
+	handlers := l.handlers.snapshot()
+	l.mu.Unlock()
+
+	for _, h := range handlers {
+		h(state)
+	}
+}
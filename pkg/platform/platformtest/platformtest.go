@@ -0,0 +1,69 @@
+package platformtest
+
+import (
+	"testing"
+
+	"github.com/go-drift/drift/pkg/platform"
+)
+
+// Services holds the fakes installed by [Install].
+type Services struct {
+	Clipboard *Clipboard
+	Haptics   *Haptics
+	Lifecycle *Lifecycle
+	SafeArea  *SafeArea
+}
+
+// Install replaces [platform.Clipboard], [platform.Haptics],
+// [platform.Lifecycle] and [platform.SafeArea] with new fakes and restores
+// the real services when the test ends.
+func Install(t testing.TB) *Services {
+	t.Helper()
+	services := &Services{
+		Clipboard: &Clipboard{},
+		Haptics:   &Haptics{},
+		Lifecycle: NewLifecycle(),
+		SafeArea:  &SafeArea{},
+	}
+	restore := platform.SetForTesting(platform.TestServices{
+		Clipboard: services.Clipboard,
+		Haptics:   services.Haptics,
+		Lifecycle: services.Lifecycle,
+		SafeArea:  services.SafeArea,
+	})
+	t.Cleanup(restore)
+	return services
+}
+
+// handlers is a list of change handlers that can each be removed.
+type handlers[T any] struct {
+	nextID int
+	funcs  map[int]func(T)
+	order  []int
+}
+
+func (h *handlers[T]) add(fn func(T)) int {
+	if h.funcs == nil {
+		h.funcs = make(map[int]func(T))
+	}
+	id := h.nextID
+	h.nextID++
+	h.funcs[id] = fn
+	h.order = append(h.order, id)
+	return id
+}
+
+func (h *handlers[T]) remove(id int) {
+	delete(h.funcs, id)
+}
+
+// snapshot returns the current handlers in the order they were added.
+func (h *handlers[T]) snapshot() []func(T) {
+	var out []func(T)
+	for _, id := range h.order {
+		if fn, ok := h.funcs[id]; ok {
+			out = append(out, fn)
+		}
+	}
+	return out
+}
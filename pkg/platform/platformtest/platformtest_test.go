@@ -0,0 +1,87 @@
+package platformtest_test
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/layout"
+	"github.com/go-drift/drift/pkg/platform"
+	"github.com/go-drift/drift/pkg/platform/platformtest"
+	drifttest "github.com/go-drift/drift/pkg/testing"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+func TestInstall_ReplacesServices(t *testing.T) {
+	services := platformtest.Install(t)
+
+	if err := platform.Clipboard.SetText("hello"); err != nil {
+		t.Fatalf("SetText: %v", err)
+	}
+	if text, err := platform.Clipboard.GetText(); text != "hello" || err != nil {
+		t.Errorf("GetText: got (%q, %v), want hello", text, err)
+	}
+	denied := errors.New("denied")
+	services.Clipboard.SetError(denied)
+	if _, err := platform.Clipboard.HasText(); !errors.Is(err, denied) {
+		t.Errorf("HasText: got %v, want the injected error", err)
+	}
+
+	platform.Haptics.LightImpact()
+	platform.Haptics.SelectionClick()
+	platform.Haptics.Vibrate(50)
+	want := []platform.HapticFeedbackType{platform.HapticLight, platform.HapticSelection}
+	if got := services.Haptics.Impacts(); !slices.Equal(got, want) {
+		t.Errorf("Impacts: got %v, want %v", got, want)
+	}
+	if got := services.Haptics.Vibrations(); !slices.Equal(got, []int{50}) {
+		t.Errorf("Vibrations: got %v, want [50]", got)
+	}
+
+	var states []platform.LifecycleState
+	remove := platform.Lifecycle.AddHandler(func(state platform.LifecycleState) {
+		states = append(states, state)
+	})
+	services.Lifecycle.SetState(platform.LifecycleStatePaused)
+	remove()
+	services.Lifecycle.SetState(platform.LifecycleStateResumed)
+	if !slices.Equal(states, []platform.LifecycleState{platform.LifecycleStatePaused}) {
+		t.Errorf("lifecycle handler: got %v, want [paused]", states)
+	}
+	if !platform.Lifecycle.IsResumed() {
+		t.Error("expected the fake's state through the service")
+	}
+}
+
+// insetsProbe records the safe area insets it builds with.
+type insetsProbe struct {
+	core.StatelessBase
+	insets *layout.EdgeInsets
+}
+
+func (p insetsProbe) Build(ctx core.BuildContext) core.Widget {
+	*p.insets = widgets.SafeAreaOf(ctx)
+	return widgets.SizedBox{}
+}
+
+func TestSafeArea_DrivesWidgets(t *testing.T) {
+	services := platformtest.Install(t)
+	services.SafeArea.SetInsets(platform.EdgeInsets{Top: 44})
+
+	var insets layout.EdgeInsets
+	tester := drifttest.NewWidgetTesterWithT(t)
+	tester.PumpWidget(widgets.SafeAreaProvider{
+		Child: insetsProbe{insets: &insets},
+	})
+	if insets.Top != 44 {
+		t.Fatalf("initial insets: got %+v, want top 44", insets)
+	}
+
+	services.SafeArea.SetInsets(platform.EdgeInsets{Top: 44, Bottom: 34})
+	tester.PumpAndSettle(time.Second)
+	if insets.Bottom != 34 {
+		t.Errorf("updated insets: got %+v, want bottom 34", insets)
+	}
+}
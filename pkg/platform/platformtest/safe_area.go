@@ -0,0 +1,52 @@
+package platformtest
+
+import (
+	"sync"
+
+	"github.com/go-drift/drift/pkg/platform"
+)
+
+// SafeArea is a [platform.SafeAreaProvider] whose insets the test sets.
+// All methods are safe for concurrent use.
+type SafeArea struct {
+	mu       sync.Mutex
+	insets   platform.EdgeInsets
+	handlers handlers[platform.EdgeInsets]
+}
+
+// Insets returns the current safe area insets.
+func (s *SafeArea) Insets() platform.EdgeInsets {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.insets
+}
+
+// AddHandler registers a handler called when SetInsets changes the insets.
+// Returns a function that removes it.
+func (s *SafeArea) AddHandler(handler func(platform.EdgeInsets)) func() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	id := s.handlers.add(handler)
+	return func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.handlers.remove(id)
+	}
+}
+
+// SetInsets changes the insets and notifies handlers, as when a notch or
+// system bar appears. Setting the current insets does nothing.
+func (s *SafeArea) SetInsets(insets platform.EdgeInsets) {
+	s.mu.Lock()
+	if s.insets == insets {
+		s.mu.Unlock()
+		return
+	}
+	s.insets = insets
+	handlers := s.handlers.snapshot()
+	s.mu.Unlock()
+
+	for _, h := range handlers {
+		h(insets)
+	}
+}
@@ -0,0 +1,91 @@
+package platform
+
+import (
+	"sync"
+
+	"github.com/go-drift/drift/pkg/errors"
+)
+
+// Power provides the OS battery saver (low power mode) state.
+var Power = &PowerService{
+	events: NewEventChannel("drift/power/events"),
+}
+
+// PowerService tracks whether the OS battery saver is enabled.
+// On Android this reflects PowerManager.isPowerSaveMode; on iOS it reflects
+// ProcessInfo.isLowPowerModeEnabled.
+type PowerService struct {
+	events   *EventChannel
+	lowPower bool
+	handlers []func(bool)
+	mu       sync.RWMutex
+}
+
+func init() {
+	initPowerListeners()
+	registerBuiltinInit(initPowerListeners)
+}
+
+func initPowerListeners() {
+	Power.events.Listen(EventHandler{
+		OnEvent: func(data any) {
+			m, ok := data.(map[string]any)
+			if !ok {
+				errors.Report(&errors.DriftError{
+					Op:      "power.parseEvent",
+					Kind:    errors.KindParsing,
+					Channel: "drift/power/events",
+					Err: &errors.ParseError{
+						Channel:  "drift/power/events",
+						DataType: "PowerState",
+						Got:      data,
+					},
+				})
+				return
+			}
+			lowPower, _ := m["lowPowerMode"].(bool)
+			Power.updateLowPowerMode(lowPower)
+		},
+	})
+}
+
+// IsLowPowerMode reports whether the OS battery saver is currently enabled.
+func (p *PowerService) IsLowPowerMode() bool {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.lowPower
+}
+
+// AddHandler registers a handler to be called when battery saver is toggled.
+// Returns a function that can be called to remove the handler.
+func (p *PowerService) AddHandler(handler func(lowPower bool)) func() {
+	p.mu.Lock()
+	p.handlers = append(p.handlers, handler)
+	index := len(p.handlers) - 1
+	p.mu.Unlock()
+
+	return func() {
+		p.mu.Lock()
+		if index < len(p.handlers) {
+			p.handlers = append(p.handlers[:index], p.handlers[index+1:]...)
+		}
+		p.mu.Unlock()
+	}
+}
+
+// updateLowPowerMode stores the new state and notifies handlers on change.
+func (p *PowerService) updateLowPowerMode(lowPower bool) {
+	p.mu.Lock()
+	if p.lowPower == lowPower {
+		p.mu.Unlock()
+		return
+	}
+	p.lowPower = lowPower
+	handlers := make([]func(bool), len(p.handlers))
+	copy(handlers, p.handlers)
+	p.mu.Unlock()
+
+	for _, h := range handlers {
+		h(lowPower)
+	}
+}
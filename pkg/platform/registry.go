@@ -5,20 +5,23 @@ import (
 	"fmt"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/go-drift/drift/pkg/errors"
 )
 
-// channelRegistry manages all registered platform channels.
+// channelRegistry manages the channels registered with a [Platform].
 type channelRegistry struct {
 	methodChannels map[string]*MethodChannel
 	eventChannels  map[string]*EventChannel
 	mu             sync.RWMutex
 }
 
-var registry = &channelRegistry{
-	methodChannels: make(map[string]*MethodChannel),
-	eventChannels:  make(map[string]*EventChannel),
+func newChannelRegistry() *channelRegistry {
+	return &channelRegistry{
+		methodChannels: make(map[string]*MethodChannel),
+		eventChannels:  make(map[string]*EventChannel),
+	}
 }
 
 func (r *channelRegistry) registerMethod(name string, ch *MethodChannel) {
@@ -60,10 +63,6 @@ var (
 	nextCallID     atomic.Int64
 )
 
-// nativeBridge is the interface to native platform code.
-// This is set by the bridge package during initialization.
-var nativeBridge NativeBridge
-
 // builtinInits holds functions that re-register the built-in event listeners
 // set up during package init (lifecycle, safe area, accessibility, etc.).
 // Each init() function appends its listener setup here so that ResetForTest
@@ -94,8 +93,9 @@ type NativeBridge interface {
 	StopEventStream(channel string) error
 }
 
-// SetNativeBridge sets the native bridge implementation.
-// Called by the bridge package during initialization.
+// SetNativeBridge sets the native bridge of the default platform, replacing
+// any bridge already set. Prefer [Initialize], which refuses to replace a
+// bridge another engine instance is using.
 //
 // After setting the bridge, SetNativeBridge starts event streams for any
 // event channels that acquired subscriptions before the bridge was available
@@ -103,15 +103,21 @@ type NativeBridge interface {
 // for Lifecycle, SafeArea, Accessibility, etc. are not silently lost.
 // Startup errors are dispatched to subscribers' error handlers.
 func SetNativeBridge(bridge NativeBridge) {
-	nativeBridge = bridge
+	defaultPlatform.setBridge(bridge)
+}
+
+func (p *Platform) setBridge(bridge NativeBridge) {
+	p.bridgeMu.Lock()
+	p.bridge = bridge
+	p.bridgeMu.Unlock()
 
 	// Start event streams for channels that subscribed before the bridge was set.
-	registry.mu.RLock()
-	channels := make([]*EventChannel, 0, len(registry.eventChannels))
-	for _, ch := range registry.eventChannels {
+	p.channels.mu.RLock()
+	channels := make([]*EventChannel, 0, len(p.channels.eventChannels))
+	for _, ch := range p.channels.eventChannels {
 		channels = append(channels, ch)
 	}
-	registry.mu.RUnlock()
+	p.channels.mu.RUnlock()
 
 	for _, ch := range channels {
 		ch.mu.Lock()
@@ -122,7 +128,7 @@ func SetNativeBridge(bridge NativeBridge) {
 		ch.mu.Unlock()
 
 		if shouldStart {
-			if err := startEventStream(ch.name); err != nil {
+			if err := p.startEventStream(ch.name); err != nil {
 				ch.mu.Lock()
 				ch.started = false
 				ch.mu.Unlock()
@@ -140,10 +146,24 @@ func SetNativeBridge(bridge NativeBridge) {
 // what "cancellation" means at this boundary. Native-side resources held by
 // the in-flight call (UI dialogs, file handles, etc.) are released only when
 // native finishes.
-func invokeNative(ctx context.Context, channel, method string, args any) (any, error) {
+func (p *Platform) invokeNative(ctx context.Context, channel, method string, args any) (any, error) {
+	if checks := threadChecks.Load(); checks != nil {
+		defer checkInvoke(checks, channel, method)()
+	}
+	if p.Recorder() == nil {
+		return p.invokeBridge(ctx, channel, method, args)
+	}
+	start := time.Now()
+	result, err := p.invokeBridge(ctx, channel, method, args)
+	p.recordCall(ChannelRecordInvoke, channel, method, args, result, err, start)
+	return result, err
+}
+
+// invokeBridge performs a call for invokeNative.
+func (p *Platform) invokeBridge(ctx context.Context, channel, method string, args any) (any, error) {
 	// Snapshot the bridge so a concurrent ResetForTest cannot swap it out
 	// while the goroutine is still in flight on a canceled call.
-	bridge := nativeBridge
+	bridge := p.nativeBridge()
 	if bridge == nil {
 		return nil, ErrPlatformUnavailable
 	}
@@ -189,8 +209,9 @@ func invokeNative(ctx context.Context, channel, method string, args any) (any, e
 }
 
 // startEventStream notifies native to start sending events.
-func startEventStream(channel string) error {
-	if nativeBridge == nil {
+func (p *Platform) startEventStream(channel string) error {
+	bridge := p.nativeBridge()
+	if bridge == nil {
 		errors.Report(&errors.DriftError{
 			Op:      "platform.startEventStream",
 			Kind:    errors.KindPlatform,
@@ -199,7 +220,7 @@ func startEventStream(channel string) error {
 		})
 		return ErrPlatformUnavailable
 	}
-	if err := nativeBridge.StartEventStream(channel); err != nil {
+	if err := bridge.StartEventStream(channel); err != nil {
 		errors.Report(&errors.DriftError{
 			Op:      "platform.startEventStream",
 			Kind:    errors.KindPlatform,
@@ -212,8 +233,9 @@ func startEventStream(channel string) error {
 }
 
 // stopEventStream notifies native to stop sending events.
-func stopEventStream(channel string) error {
-	if nativeBridge == nil {
+func (p *Platform) stopEventStream(channel string) error {
+	bridge := p.nativeBridge()
+	if bridge == nil {
 		errors.Report(&errors.DriftError{
 			Op:      "platform.stopEventStream",
 			Kind:    errors.KindPlatform,
@@ -222,7 +244,7 @@ func stopEventStream(channel string) error {
 		})
 		return ErrPlatformUnavailable
 	}
-	if err := nativeBridge.StopEventStream(channel); err != nil {
+	if err := bridge.StopEventStream(channel); err != nil {
 		errors.Report(&errors.DriftError{
 			Op:      "platform.stopEventStream",
 			Kind:    errors.KindPlatform,
@@ -236,7 +258,12 @@ func stopEventStream(channel string) error {
 
 // HandleMethodCall is called from the bridge when native invokes a Go method.
 func HandleMethodCall(channel, method string, argsData []byte) ([]byte, error) {
-	ch := registry.getMethodChannel(channel)
+	return defaultPlatform.HandleMethodCall(channel, method, argsData)
+}
+
+// HandleMethodCall delivers a method call from native code to a channel of p.
+func (p *Platform) HandleMethodCall(channel, method string, argsData []byte) ([]byte, error) {
+	ch := p.channels.getMethodChannel(channel)
 	if ch == nil {
 		return nil, ErrChannelNotFound
 	}
@@ -248,7 +275,11 @@ func HandleMethodCall(channel, method string, argsData []byte) ([]byte, error) {
 	}
 
 	// Handle the call
+	start := time.Now()
+	done := trackHandler()
 	result, err := ch.handleCall(method, args)
+	done()
+	p.recordCall(ChannelRecordCall, channel, method, args, result, err, start)
 	if err != nil {
 		return nil, err
 	}
@@ -262,7 +293,12 @@ var ErrChannelNotRegistered = fmt.Errorf("event channel not registered")
 
 // HandleEvent is called from the bridge when native sends an event.
 func HandleEvent(channel string, eventData []byte) error {
-	ch := registry.getEventChannel(channel)
+	return defaultPlatform.HandleEvent(channel, eventData)
+}
+
+// HandleEvent delivers an event from native code to a channel of p.
+func (p *Platform) HandleEvent(channel string, eventData []byte) error {
+	ch := p.channels.getEventChannel(channel)
 	if ch == nil {
 		err := fmt.Errorf("%w: %s", ErrChannelNotRegistered, channel)
 		errors.Report(&errors.DriftError{
@@ -276,9 +312,11 @@ func HandleEvent(channel string, eventData []byte) error {
 
 	data, err := DefaultCodec.Decode(eventData)
 	if err != nil {
+		p.recordEvent(ChannelRecord{Kind: ChannelRecordError, Channel: channel, Error: err.Error()})
 		ch.dispatchError(err)
 		return err
 	}
+	p.recordEvent(ChannelRecord{Kind: ChannelRecordEvent, Channel: channel, Args: data})
 
 	ch.dispatchEvent(data)
 	return nil
@@ -286,7 +324,12 @@ func HandleEvent(channel string, eventData []byte) error {
 
 // HandleEventError is called from the bridge when an event stream errors.
 func HandleEventError(channel string, code, message string) error {
-	ch := registry.getEventChannel(channel)
+	return defaultPlatform.HandleEventError(channel, code, message)
+}
+
+// HandleEventError delivers an event stream error to a channel of p.
+func (p *Platform) HandleEventError(channel string, code, message string) error {
+	ch := p.channels.getEventChannel(channel)
 	if ch == nil {
 		err := fmt.Errorf("%w: %s", ErrChannelNotRegistered, channel)
 		errors.Report(&errors.DriftError{
@@ -298,13 +341,19 @@ func HandleEventError(channel string, code, message string) error {
 		return err
 	}
 
+	p.recordEvent(ChannelRecord{Kind: ChannelRecordError, Channel: channel, Code: code, Error: message})
 	ch.dispatchError(NewChannelError(code, message))
 	return nil
 }
 
 // HandleEventDone is called from the bridge when an event stream ends.
 func HandleEventDone(channel string) error {
-	ch := registry.getEventChannel(channel)
+	return defaultPlatform.HandleEventDone(channel)
+}
+
+// HandleEventDone tells a channel of p that its event stream ended.
+func (p *Platform) HandleEventDone(channel string) error {
+	ch := p.channels.getEventChannel(channel)
 	if ch == nil {
 		err := fmt.Errorf("%w: %s", ErrChannelNotRegistered, channel)
 		errors.Report(&errors.DriftError{
@@ -316,6 +365,7 @@ func HandleEventDone(channel string) error {
 		return err
 	}
 
+	p.recordEvent(ChannelRecord{Kind: ChannelRecordDone, Channel: channel})
 	ch.dispatchDone()
 	return nil
 }
@@ -326,7 +376,14 @@ func HandleEventDone(channel string) error {
 // listeners (lifecycle, safe area, accessibility) so that the package
 // behaves as if freshly initialized. This should only be called from tests.
 func ResetForTest() {
-	nativeBridge = nil
+	testDoubles.Store(nil)
+	defaultPlatform.SetRecorder(nil)
+	threadChecks.Store(nil)
+	uiGoroutine.Store(0)
+
+	defaultPlatform.bridgeMu.Lock()
+	defaultPlatform.bridge = nil
+	defaultPlatform.bridgeMu.Unlock()
 
 	// Reset lifecycle
 	Lifecycle.mu.Lock()
@@ -340,13 +397,47 @@ func ResetForTest() {
 	SafeArea.handlers = SafeArea.handlers[:0]
 	SafeArea.mu.Unlock()
 
+	// Reset keyboard insets
+	Keyboard.mu.Lock()
+	Keyboard.insets = EdgeInsets{}
+	Keyboard.handlers = Keyboard.handlers[:0]
+	Keyboard.mu.Unlock()
+
+	// Reset hardware key handlers
+	HardwareKeyboard.mu.Lock()
+	HardwareKeyboard.handlers = HardwareKeyboard.handlers[:0]
+	HardwareKeyboard.mu.Unlock()
+
+	// Reset appearance
+	Appearance.mu.Lock()
+	Appearance.settings = AppearanceSettings{TextScaleFactor: 1}
+	Appearance.handlers = Appearance.handlers[:0]
+	Appearance.mu.Unlock()
+
+	// Reset locales
+	Locales.mu.Lock()
+	Locales.locales = []Locale{DefaultLocale}
+	Locales.handlers = Locales.handlers[:0]
+	Locales.mu.Unlock()
+
+	// Reset power state
+	Power.mu.Lock()
+	Power.lowPower = false
+	Power.handlers = Power.handlers[:0]
+	Power.mu.Unlock()
+
+	// Reset restored state
+	Restoration.mu.Lock()
+	Restoration.restored = nil
+	Restoration.mu.Unlock()
+
 	// Clear all event channel subscriptions and started flags
-	registry.mu.RLock()
-	channels := make([]*EventChannel, 0, len(registry.eventChannels))
-	for _, ch := range registry.eventChannels {
+	defaultPlatform.channels.mu.RLock()
+	channels := make([]*EventChannel, 0, len(defaultPlatform.channels.eventChannels))
+	for _, ch := range defaultPlatform.channels.eventChannels {
 		channels = append(channels, ch)
 	}
-	registry.mu.RUnlock()
+	defaultPlatform.channels.mu.RUnlock()
 
 	for _, ch := range channels {
 		ch.mu.Lock()
@@ -376,6 +467,7 @@ func ResetForTest() {
 		platformViewRegistry.batchMu.Lock()
 		platformViewRegistry.geometryCache = make(map[int64]CapturedViewGeometry)
 		platformViewRegistry.viewsSeenThisFrame = make(map[int64]struct{})
+		platformViewRegistry.transformed = make(map[int64]bool)
 		platformViewRegistry.batchUpdates = nil
 		platformViewRegistry.batchMu.Unlock()
 	}
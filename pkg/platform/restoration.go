@@ -0,0 +1,67 @@
+package platform
+
+import (
+	"context"
+	"sync"
+)
+
+// Restoration keeps small pieces of UI state across process death.
+//
+// Android may kill a backgrounded app to reclaim memory and relaunch it when
+// the user returns, expecting it to look as it did. Values saved here are
+// stored in the activity's saved instance state and handed back to the new
+// process by [RestorationService.Restored]. They are discarded when the user
+// closes the app or it starts fresh. iOS does not relaunch apps this way, so
+// Restored always returns an empty value there.
+//
+// The saved state is limited in size by Android; keep values small, such as
+// route paths and identifiers, not content.
+var Restoration = &RestorationService{
+	channel: NewMethodChannel("drift/restoration"),
+}
+
+// RestorationService saves and restores UI state across process death.
+type RestorationService struct {
+	channel *MethodChannel
+
+	mu       sync.Mutex
+	restored map[string]string // values from the previous process, loaded once
+}
+
+// Restored returns the value saved under key by the previous process, or ""
+// if the app started fresh or nothing was saved.
+func (s *RestorationService) Restored(key string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.restored == nil {
+		result, err := s.channel.Invoke(context.Background(), "getRestored", nil)
+		if err != nil {
+			return "", err
+		}
+		s.restored = map[string]string{}
+		if m, ok := result.(map[string]any); ok {
+			for k, v := range m {
+				s.restored[k] = parseString(v)
+			}
+		}
+	}
+	return s.restored[key], nil
+}
+
+// Save stores value under key, replacing the previous value. The latest
+// values are kept when Android saves the app's state.
+func (s *RestorationService) Save(key, value string) error {
+	_, err := s.channel.Invoke(context.Background(), "save", map[string]any{
+		"key":   key,
+		"value": value,
+	})
+	return err
+}
+
+// Remove deletes the value saved under key.
+func (s *RestorationService) Remove(key string) error {
+	_, err := s.channel.Invoke(context.Background(), "remove", map[string]any{
+		"key": key,
+	})
+	return err
+}
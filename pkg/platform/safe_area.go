@@ -13,6 +13,13 @@ type EdgeInsets struct {
 	Top, Bottom, Left, Right float64
 }
 
+// SafeAreaProvider is the safe area behavior [SafeAreaService] provides.
+// Implement it to replace the safe area in tests; see [SetForTesting].
+type SafeAreaProvider interface {
+	Insets() EdgeInsets
+	AddHandler(handler func(EdgeInsets)) func()
+}
+
 // SafeAreaService manages safe area inset events.
 type SafeAreaService struct {
 	events   *EventChannel
@@ -30,27 +37,36 @@ func initSafeAreaListeners() {
 	SafeArea.events.Listen(EventHandler{
 		OnEvent: func(data any) {
 			if m, ok := data.(map[string]any); ok {
-				insets := EdgeInsets{}
-				if top, ok := m["top"].(float64); ok {
-					insets.Top = top
-				}
-				if bottom, ok := m["bottom"].(float64); ok {
-					insets.Bottom = bottom
-				}
-				if left, ok := m["left"].(float64); ok {
-					insets.Left = left
-				}
-				if right, ok := m["right"].(float64); ok {
-					insets.Right = right
-				}
-				SafeArea.updateInsets(insets)
+				SafeArea.updateInsets(parseEdgeInsets(m))
 			}
 		},
 	})
 }
 
+// parseEdgeInsets reads the top, bottom, left and right insets of an event,
+// treating missing edges as zero.
+func parseEdgeInsets(m map[string]any) EdgeInsets {
+	insets := EdgeInsets{}
+	if top, ok := m["top"].(float64); ok {
+		insets.Top = top
+	}
+	if bottom, ok := m["bottom"].(float64); ok {
+		insets.Bottom = bottom
+	}
+	if left, ok := m["left"].(float64); ok {
+		insets.Left = left
+	}
+	if right, ok := m["right"].(float64); ok {
+		insets.Right = right
+	}
+	return insets
+}
+
 // Insets returns the current safe area insets.
 func (s *SafeAreaService) Insets() EdgeInsets {
+	if double := testServices().SafeArea; double != nil {
+		return double.Insets()
+	}
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 	return s.insets
@@ -59,6 +75,9 @@ func (s *SafeAreaService) Insets() EdgeInsets {
 // AddHandler registers a handler to be called on inset changes.
 // Returns a function that can be called to remove the handler.
 func (s *SafeAreaService) AddHandler(handler func(EdgeInsets)) func() {
+	if double := testServices().SafeArea; double != nil {
+		return double.AddHandler(handler)
+	}
 	s.mu.Lock()
 	s.handlers = append(s.handlers, handler)
 	index := len(s.handlers) - 1
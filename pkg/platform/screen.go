@@ -0,0 +1,116 @@
+package platform
+
+import (
+	"context"
+	"fmt"
+)
+
+// OrientationLock restricts the orientations the app's window may rotate to.
+type OrientationLock int
+
+const (
+	// OrientationUnlocked follows the device rotation, limited to the
+	// orientations the app declares.
+	OrientationUnlocked OrientationLock = iota
+
+	// OrientationPortrait keeps the window upright in portrait.
+	OrientationPortrait
+
+	// OrientationLandscape rotates the window to landscape, following the
+	// device between the two landscape sides.
+	OrientationLandscape
+)
+
+// String returns a human-readable label for the lock.
+func (o OrientationLock) String() string {
+	switch o {
+	case OrientationUnlocked:
+		return "unlocked"
+	case OrientationPortrait:
+		return "portrait"
+	case OrientationLandscape:
+		return "landscape"
+	default:
+		return fmt.Sprintf("OrientationLock(%d)", int(o))
+	}
+}
+
+// ScreenService controls the display while the app is in the foreground:
+// brightness, the idle timer, the window orientation, and the system bars.
+//
+// Settings apply to the app's window only and are released by the system
+// when the app leaves the foreground. To tie settings to a screen of the
+// app and restore them when it closes, use widgets.ScreenSettings.
+type ScreenService struct {
+	channel *MethodChannel
+}
+
+// Screen is the singleton screen service.
+var Screen *ScreenService
+
+func init() {
+	Screen = &ScreenService{
+		channel: NewMethodChannel("drift/screen"),
+	}
+}
+
+// Brightness returns the window's brightness, from 0 to 1. Without an
+// override set by [ScreenService.SetBrightness], this is the system
+// brightness.
+func (s *ScreenService) Brightness() (float64, error) {
+	result, err := s.channel.Invoke(context.Background(), "getBrightness", nil)
+	if err != nil {
+		return 0, err
+	}
+	brightness, ok := toFloat64(result)
+	if !ok {
+		return 0, fmt.Errorf("screen: unexpected brightness %v", result)
+	}
+	return brightness, nil
+}
+
+// SetBrightness overrides the window's brightness, from 0 to 1, until
+// [ScreenService.ResetBrightness] is called. Values outside the range are
+// clamped.
+func (s *ScreenService) SetBrightness(brightness float64) error {
+	_, err := s.channel.Invoke(context.Background(), "setBrightness", map[string]any{
+		"brightness": min(max(brightness, 0), 1),
+	})
+	return err
+}
+
+// ResetBrightness removes the override set by [ScreenService.SetBrightness]
+// and returns to the system brightness.
+func (s *ScreenService) ResetBrightness() error {
+	_, err := s.channel.Invoke(context.Background(), "resetBrightness", nil)
+	return err
+}
+
+// SetKeepAwake keeps the screen from dimming and locking while enabled, for
+// example during video playback.
+func (s *ScreenService) SetKeepAwake(enabled bool) error {
+	_, err := s.channel.Invoke(context.Background(), "setKeepAwake", map[string]any{
+		"enabled": enabled,
+	})
+	return err
+}
+
+// SetOrientationLock restricts the window to the given orientations and
+// rotates it if needed. [OrientationUnlocked] returns to following the
+// device.
+func (s *ScreenService) SetOrientationLock(lock OrientationLock) error {
+	_, err := s.channel.Invoke(context.Background(), "setOrientationLock", map[string]any{
+		"orientation": lock.String(),
+	})
+	return err
+}
+
+// SetImmersive hides the status bar, the navigation bar on Android, and the
+// home indicator on iOS while enabled, giving the app the whole screen. The
+// user can reveal the bars temporarily with an edge swipe.
+func (s *ScreenService) SetImmersive(enabled bool) error {
+	_, err := s.channel.Invoke(context.Background(), "setImmersive", map[string]any{
+		"enabled": enabled,
+	})
+	return err
+}
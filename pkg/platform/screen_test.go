@@ -0,0 +1,51 @@
+package platform
+
+import (
+	"testing"
+)
+
+func TestScreenService_Invokes(t *testing.T) {
+	bridge := setupTestBridge(t)
+
+	if err := Screen.SetBrightness(1.5); err != nil {
+		t.Fatalf("SetBrightness: %v", err)
+	}
+	if err := Screen.ResetBrightness(); err != nil {
+		t.Fatalf("ResetBrightness: %v", err)
+	}
+	if err := Screen.SetKeepAwake(true); err != nil {
+		t.Fatalf("SetKeepAwake: %v", err)
+	}
+	if err := Screen.SetOrientationLock(OrientationLandscape); err != nil {
+		t.Fatalf("SetOrientationLock: %v", err)
+	}
+	if err := Screen.SetImmersive(true); err != nil {
+		t.Fatalf("SetImmersive: %v", err)
+	}
+	// The test bridge returns nil, which is not a brightness.
+	if _, err := Screen.Brightness(); err == nil {
+		t.Error("expected an error for a missing brightness")
+	}
+
+	want := []string{"setBrightness", "resetBrightness", "setKeepAwake", "setOrientationLock", "setImmersive", "getBrightness"}
+	if len(bridge.calls) != len(want) {
+		t.Fatalf("calls: got %d, want %d", len(bridge.calls), len(want))
+	}
+	for i, call := range bridge.calls {
+		if call.channel != "drift/screen" || call.method != want[i] {
+			t.Errorf("call %d: got %s %s, want drift/screen %s", i, call.channel, call.method, want[i])
+		}
+	}
+	if args := bridge.calls[0].args.(map[string]any); args["brightness"] != 1.0 {
+		t.Errorf("expected brightness clamped to 1, got %v", args["brightness"])
+	}
+	if args := bridge.calls[2].args.(map[string]any); args["enabled"] != true {
+		t.Errorf("setKeepAwake args: got %v", args)
+	}
+	if args := bridge.calls[3].args.(map[string]any); args["orientation"] != "landscape" {
+		t.Errorf("setOrientationLock args: got %v", args)
+	}
+	if args := bridge.calls[4].args.(map[string]any); args["enabled"] != true {
+		t.Errorf("setImmersive args: got %v", args)
+	}
+}
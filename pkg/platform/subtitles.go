@@ -0,0 +1,154 @@
+package platform
+
+import (
+	"bytes"
+	"cmp"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SubtitleTrack describes a subtitle or closed caption track of a
+// [VideoPlayerController], either embedded in the media or added with
+// [VideoPlayerController.AddSubtitleTrack].
+type SubtitleTrack struct {
+	// ID identifies the track for [VideoPlayerController.SelectSubtitleTrack].
+	ID string
+
+	// Label is the track's display name, such as "English (CC)". May be
+	// empty for embedded tracks without one.
+	Label string
+
+	// Language is the track's BCP 47 language tag, such as "en" or "pt-BR".
+	// May be empty if the media does not declare it.
+	Language string
+
+	// External reports whether the track was added by the app rather than
+	// embedded in the media.
+	External bool
+}
+
+// SubtitleCue is a caption shown during playback.
+type SubtitleCue struct {
+	// Start is when the cue appears.
+	Start time.Duration
+
+	// End is when the cue disappears. Zero for cues of embedded tracks,
+	// whose end the platforms do not report; they remain until the next
+	// cue change.
+	End time.Duration
+
+	// Text is the caption text, with formatting tags removed. Lines are
+	// separated by "\n".
+	Text string
+}
+
+// externalSubtitleTrack is a track added by the app, with its parsed cues.
+type externalSubtitleTrack struct {
+	track SubtitleTrack
+	cues  []SubtitleCue
+}
+
+// ParseSubtitles parses WebVTT or SubRip (SRT) subtitles. Files beginning
+// with a "WEBVTT" header are parsed as WebVTT, anything else as SRT. Cue
+// settings, styles, and regions are ignored, and formatting tags such as
+// <i> are removed from the text. Cues are returned sorted by start time.
+func ParseSubtitles(data []byte) ([]SubtitleCue, error) {
+	text := string(bytes.TrimPrefix(data, []byte("\ufeff")))
+	text = strings.ReplaceAll(text, "\r\n", "\n")
+	text = strings.ReplaceAll(text, "\r", "\n")
+	webVTT := strings.HasPrefix(text, "WEBVTT")
+
+	var cues []SubtitleCue
+	for block := range strings.SplitSeq(text, "\n\n") {
+		lines := strings.Split(strings.Trim(block, "\n"), "\n")
+		// The timing line follows an optional identifier or SRT index.
+		timing := slices.IndexFunc(lines, func(line string) bool {
+			return strings.Contains(line, "-->")
+		})
+		if timing < 0 || timing > 1 {
+			// Headers, NOTE, STYLE, and REGION blocks.
+			continue
+		}
+		start, end, err := parseCueTiming(lines[timing], webVTT)
+		if err != nil {
+			return nil, err
+		}
+		cues = append(cues, SubtitleCue{
+			Start: start,
+			End:   end,
+			Text:  cueTagPattern.ReplaceAllString(strings.Join(lines[timing+1:], "\n"), ""),
+		})
+	}
+	slices.SortStableFunc(cues, func(a, b SubtitleCue) int {
+		return cmp.Compare(a.Start, b.Start)
+	})
+	return cues, nil
+}
+
+// cueTagPattern matches formatting tags such as <i>, </b>, <c.yellow>,
+// <v Speaker>, and WebVTT timestamp tags.
+var cueTagPattern = regexp.MustCompile(`</?[^<>]*>`)
+
+// parseCueTiming parses a "start --> end" line, ignoring any WebVTT cue
+// settings after the end time.
+func parseCueTiming(line string, webVTT bool) (start, end time.Duration, err error) {
+	from, to, _ := strings.Cut(line, "-->")
+	fields := strings.Fields(to)
+	if len(fields) == 0 {
+		return 0, 0, fmt.Errorf("subtitles: missing end time in %q", line)
+	}
+	if start, err = parseCueTimestamp(strings.TrimSpace(from), webVTT); err != nil {
+		return 0, 0, err
+	}
+	if end, err = parseCueTimestamp(fields[0], webVTT); err != nil {
+		return 0, 0, err
+	}
+	return start, end, nil
+}
+
+// parseCueTimestamp parses "hh:mm:ss.ttt" or, in WebVTT, "mm:ss.ttt". SRT
+// separates milliseconds with a comma, though a period is also accepted.
+func parseCueTimestamp(s string, webVTT bool) (time.Duration, error) {
+	invalid := fmt.Errorf("subtitles: invalid timestamp %q", s)
+	clock, millis, ok := strings.Cut(strings.Replace(s, ",", ".", 1), ".")
+	if !ok {
+		return 0, invalid
+	}
+	parts := strings.Split(clock, ":")
+	if len(parts) == 2 && webVTT {
+		parts = append([]string{"0"}, parts...)
+	}
+	if len(parts) != 3 {
+		return 0, invalid
+	}
+	var values [4]int
+	for i, part := range append(parts, millis) {
+		v, err := strconv.Atoi(part)
+		if err != nil || v < 0 {
+			return 0, invalid
+		}
+		values[i] = v
+	}
+	return time.Duration(values[0])*time.Hour +
+		time.Duration(values[1])*time.Minute +
+		time.Duration(values[2])*time.Second +
+		time.Duration(values[3])*time.Millisecond, nil
+}
+
+// activeCues returns the cues showing at position.
+func activeCues(cues []SubtitleCue, position time.Duration) []SubtitleCue {
+	var active []SubtitleCue
+	for _, cue := range cues {
+		if cue.Start > position {
+			break
+		}
+		if position < cue.End {
+			active = append(active, cue)
+		}
+	}
+	return active
+}
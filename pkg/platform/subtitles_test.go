@@ -0,0 +1,160 @@
+package platform
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestParseSubtitles_WebVTT(t *testing.T) {
+	data := []byte("\ufeffWEBVTT - Episode 1\r\n\r\n" +
+		"NOTE timings are approximate\r\n\r\n" +
+		"intro\r\n00:01.000 --> 00:04.500 align:start line:90%\r\n<v Narrator>Long ago,</v>\r\n<i>far away</i>\r\n\r\n" +
+		"01:00:00.000 --> 01:00:02.000\r\nThe end\r\n")
+
+	cues, err := ParseSubtitles(data)
+	if err != nil {
+		t.Fatalf("ParseSubtitles: %v", err)
+	}
+	want := []SubtitleCue{
+		{Start: time.Second, End: 4500 * time.Millisecond, Text: "Long ago,\nfar away"},
+		{Start: time.Hour, End: time.Hour + 2*time.Second, Text: "The end"},
+	}
+	if !slices.Equal(cues, want) {
+		t.Errorf("got %+v, want %+v", cues, want)
+	}
+}
+
+func TestParseSubtitles_SRT(t *testing.T) {
+	data := []byte("2\n00:00:05,000 --> 00:00:06,000\nSecond\n\n" +
+		"1\n00:00:01,250 --> 00:00:03,000\n<b>First</b>\nline two\n")
+
+	cues, err := ParseSubtitles(data)
+	if err != nil {
+		t.Fatalf("ParseSubtitles: %v", err)
+	}
+	want := []SubtitleCue{
+		{Start: 1250 * time.Millisecond, End: 3 * time.Second, Text: "First\nline two"},
+		{Start: 5 * time.Second, End: 6 * time.Second, Text: "Second"},
+	}
+	if !slices.Equal(cues, want) {
+		t.Errorf("got %+v, want %+v", cues, want)
+	}
+}
+
+func TestParseSubtitles_InvalidTimestamp(t *testing.T) {
+	// SRT requires hours.
+	if _, err := ParseSubtitles([]byte("1\n00:01,000 --> 00:02,000\nHi\n")); err == nil {
+		t.Error("expected error for timestamp without hours")
+	}
+	if _, err := ParseSubtitles([]byte("WEBVTT\n\n00:01.000 -->\nHi\n")); err == nil {
+		t.Error("expected error for missing end time")
+	}
+}
+
+func TestVideoPlayerController_ExternalSubtitles(t *testing.T) {
+	bridge := setupTestBridge(t)
+
+	c := NewVideoPlayerController()
+	defer c.Dispose()
+
+	var tracks []SubtitleTrack
+	c.OnSubtitleTracksChanged = func(t []SubtitleTrack) { tracks = t }
+	var cueTexts []string
+	c.OnCueChanged = func(cues []SubtitleCue) {
+		text := ""
+		for _, cue := range cues {
+			text += cue.Text
+		}
+		cueTexts = append(cueTexts, text)
+	}
+	notified := 0
+	c.AddCueListener(func() { notified++ })
+
+	track, err := c.AddSubtitleTrack("English", "en", []byte("WEBVTT\n\n00:01.000 --> 00:02.000\nHello\n"))
+	if err != nil {
+		t.Fatalf("AddSubtitleTrack: %v", err)
+	}
+	if !track.External || track.Language != "en" || len(tracks) != 1 || tracks[0] != track {
+		t.Fatalf("unexpected track %+v, reported %+v", track, tracks)
+	}
+
+	bridge.reset()
+	if err := c.SelectSubtitleTrack(track.ID); err != nil {
+		t.Fatalf("SelectSubtitleTrack: %v", err)
+	}
+	// Native stops showing embedded tracks while an external one is selected.
+	if len(bridge.calls) != 1 || bridge.calls[0].method != "invokeViewMethod" {
+		t.Fatalf("unexpected native calls %+v", bridge.calls)
+	}
+	if args, _ := bridge.calls[0].args.(map[string]any); args["method"] != "selectSubtitleTrack" || args["id"] != "" {
+		t.Errorf("native call args: got %+v, want selectSubtitleTrack with empty id", args)
+	}
+
+	for _, ms := range []int64{500, 1200, 1700, 2000} {
+		sendVideoViewEvent(t, "onPositionChanged", map[string]any{
+			"viewId": c.ViewID(), "positionMs": ms, "durationMs": int64(10000), "bufferedMs": int64(0),
+		})
+	}
+	if !slices.Equal(cueTexts, []string{"Hello", ""}) {
+		t.Errorf("cue changes: got %q, want [Hello, \"\"]", cueTexts)
+	}
+	if notified != 2 {
+		t.Errorf("cue listener: got %d calls, want 2", notified)
+	}
+
+	// Seeking while paused shows the target's cues.
+	if err := c.SeekTo(1500 * time.Millisecond); err != nil {
+		t.Fatalf("SeekTo: %v", err)
+	}
+	if cues := c.Cues(); len(cues) != 1 || cues[0].Text != "Hello" {
+		t.Errorf("cues after seek: got %+v", cues)
+	}
+
+	if err := c.SelectSubtitleTrack("missing"); err == nil {
+		t.Error("expected error selecting unknown track")
+	}
+}
+
+func TestVideoPlayerController_EmbeddedSubtitles(t *testing.T) {
+	setupTestBridge(t)
+
+	c := NewVideoPlayerController()
+	defer c.Dispose()
+
+	var cues []SubtitleCue
+	c.OnCueChanged = func(c []SubtitleCue) { cues = c }
+
+	sendVideoViewEvent(t, "onSubtitleTracksChanged", map[string]any{
+		"viewId": c.ViewID(),
+		"tracks": []any{
+			map[string]any{"id": "0:0", "label": "English", "language": "en"},
+			map[string]any{"id": "1:0", "language": "fr"},
+		},
+		"selected": "1:0",
+	})
+	tracks := c.SubtitleTracks()
+	want := []SubtitleTrack{{ID: "0:0", Label: "English", Language: "en"}, {ID: "1:0", Language: "fr"}}
+	if !slices.Equal(tracks, want) {
+		t.Errorf("tracks: got %+v, want %+v", tracks, want)
+	}
+	if got := c.SelectedSubtitleTrack(); got != "1:0" {
+		t.Errorf("selected: got %q, want 1:0", got)
+	}
+
+	sendVideoViewEvent(t, "onCueChanged", map[string]any{
+		"viewId": c.ViewID(),
+		"cues":   []any{map[string]any{"text": "Bonjour", "startMs": int64(3000)}},
+	})
+	if len(cues) != 1 || cues[0] != (SubtitleCue{Start: 3 * time.Second, Text: "Bonjour"}) {
+		t.Errorf("cues: got %+v", cues)
+	}
+
+	// Loading new media clears the tracks and the showing cues.
+	if err := c.Load("https://example.com/next.mp4"); err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if len(c.SubtitleTracks()) != 0 || c.SelectedSubtitleTrack() != "" || len(cues) != 0 {
+		t.Errorf("after Load: tracks %+v, selected %q, cues %+v", c.SubtitleTracks(), c.SelectedSubtitleTrack(), cues)
+	}
+}
@@ -0,0 +1,81 @@
+package platform
+
+import (
+	"context"
+
+	"github.com/go-drift/drift/pkg/graphics"
+)
+
+// ScreenEdges is a set of screen edges.
+type ScreenEdges int
+
+const (
+	// ScreenEdgeTop is the top edge of the screen.
+	ScreenEdgeTop ScreenEdges = 1 << iota
+	// ScreenEdgeLeft is the left edge of the screen.
+	ScreenEdgeLeft
+	// ScreenEdgeBottom is the bottom edge of the screen.
+	ScreenEdgeBottom
+	// ScreenEdgeRight is the right edge of the screen.
+	ScreenEdgeRight
+
+	// ScreenEdgesAll contains every edge.
+	ScreenEdgesAll = ScreenEdgeTop | ScreenEdgeLeft | ScreenEdgeBottom | ScreenEdgeRight
+)
+
+// SystemGesturesService keeps the system's edge gestures, such as Android's
+// back and home swipes or the iOS home indicator, from taking swipes that
+// the app handles itself, such as opening a drawer or dragging a slider at
+// the edge of the screen.
+//
+// To exclude the area of a widget, wrap it in widgets.SystemGestureExclusion,
+// which keeps the exclusions up to date as widgets move.
+type SystemGesturesService struct {
+	channel *MethodChannel
+}
+
+// SystemGestures is the singleton system gestures service.
+var SystemGestures *SystemGesturesService
+
+func init() {
+	SystemGestures = &SystemGesturesService{
+		channel: NewMethodChannel("drift/system_gestures"),
+	}
+}
+
+// SetExclusionRects asks Android's gesture navigation not to treat swipes
+// that start inside rects, in window coordinates, as back or home gestures.
+// Each call replaces the previous rects; pass nil to clear them.
+//
+// Android limits exclusions to 200dp along each side edge, and ignores them
+// along the bottom edge and before Android 10. iOS ignores them; use
+// [SystemGesturesService.SetDeferredEdges] there.
+func (s *SystemGesturesService) SetExclusionRects(rects []graphics.Rect) error {
+	list := make([]map[string]any, len(rects))
+	for i, rect := range rects {
+		list[i] = map[string]any{
+			"left":   rect.Left,
+			"top":    rect.Top,
+			"right":  rect.Right,
+			"bottom": rect.Bottom,
+		}
+	}
+	_, err := s.channel.Invoke(context.Background(), "setExclusionRects", map[string]any{
+		"rects": list,
+	})
+	return err
+}
+
+// SetDeferredEdges asks iOS to let the app handle swipes from edges first.
+// A swipe from a deferred edge goes to the app, and the system gesture,
+// such as going home or opening Control Center, needs a second swipe.
+// Pass 0 to restore the system behavior.
+//
+// Android ignores deferred edges; use
+// [SystemGesturesService.SetExclusionRects] there.
+func (s *SystemGesturesService) SetDeferredEdges(edges ScreenEdges) error {
+	_, err := s.channel.Invoke(context.Background(), "setDeferredEdges", map[string]any{
+		"edges": int(edges & ScreenEdgesAll),
+	})
+	return err
+}
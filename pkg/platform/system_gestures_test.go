@@ -0,0 +1,40 @@
+package platform
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/go-drift/drift/pkg/graphics"
+)
+
+func TestSystemGesturesService_Invokes(t *testing.T) {
+	bridge := setupTestBridge(t)
+
+	rect := graphics.RectFromLTWH(0, 100, 24, 300)
+	if err := SystemGestures.SetExclusionRects([]graphics.Rect{rect}); err != nil {
+		t.Fatalf("SetExclusionRects: %v", err)
+	}
+	if err := SystemGestures.SetDeferredEdges(ScreenEdgeBottom | ScreenEdgeLeft); err != nil {
+		t.Fatalf("SetDeferredEdges: %v", err)
+	}
+
+	want := []string{"setExclusionRects", "setDeferredEdges"}
+	if len(bridge.calls) != len(want) {
+		t.Fatalf("calls: got %d, want %d", len(bridge.calls), len(want))
+	}
+	for i, call := range bridge.calls {
+		if call.channel != "drift/system_gestures" || call.method != want[i] {
+			t.Errorf("call %d: got %s %s, want drift/system_gestures %s", i, call.channel, call.method, want[i])
+		}
+	}
+	rects, _ := bridge.calls[0].args.(map[string]any)["rects"].([]any)
+	if len(rects) != 1 {
+		t.Fatalf("setExclusionRects: got %d rects, want 1", len(rects))
+	}
+	if got := rects[0].(map[string]any); got["top"] != 100.0 || got["right"] != 24.0 {
+		t.Errorf("setExclusionRects args: got %v", got)
+	}
+	if args := bridge.calls[1].args.(map[string]any); fmt.Sprint(args["edges"]) != "6" {
+		t.Errorf("setDeferredEdges args: got %v", args)
+	}
+}
@@ -1,6 +1,11 @@
 package platform
 
-import "context"
+import (
+	"context"
+	"sync/atomic"
+
+	"github.com/go-drift/drift/pkg/focus"
+)
 
 // noopBridge is a NativeBridge that accepts all calls without side effects.
 type noopBridge struct{}
@@ -22,8 +27,78 @@ func SetupTestBridge(cleanup func(func())) {
 	cleanup(ResetForTest)
 }
 
+// TestServices holds test doubles that replace platform services. Nil fields
+// leave the service in place.
+type TestServices struct {
+	Clipboard ClipboardProvider
+	Haptics   HapticsProvider
+	Lifecycle LifecycleProvider
+	SafeArea  SafeAreaProvider
+}
+
+var (
+	testDoubles atomic.Pointer[TestServices]
+	noDoubles   = &TestServices{}
+)
+
+// testServices returns the installed test doubles.
+func testServices() *TestServices {
+	if doubles := testDoubles.Load(); doubles != nil {
+		return doubles
+	}
+	return noDoubles
+}
+
+// SetForTesting routes [Clipboard], [Haptics], [Lifecycle] and [SafeArea]
+// to the doubles in services, so widget and app logic can be tested without
+// a device or native bridge. It returns a function that restores the real
+// services; [ResetForTest] restores them too. The platformtest package
+// provides ready-made doubles.
+//
+// Handlers added to Lifecycle or SafeArea before the call stay with the real
+// service.
+//
+//	restore := platform.SetForTesting(platform.TestServices{Clipboard: fake})
+//	defer restore()
+func SetForTesting(services TestServices) (restore func()) {
+	previous := testDoubles.Swap(&services)
+	return func() {
+		testDoubles.Store(previous)
+	}
+}
+
 // SetStateForTest updates the lifecycle state and notifies handlers.
 // Use only in tests.
 func (l *LifecycleService) SetStateForTest(state LifecycleState) {
 	l.updateState(state)
 }
+
+// SetLowPowerModeForTest updates the battery saver state and notifies handlers.
+// Use only in tests.
+func (p *PowerService) SetLowPowerModeForTest(lowPower bool) {
+	p.updateLowPowerMode(lowPower)
+}
+
+// SetSettingsForTest updates the display preferences and notifies handlers.
+// Use only in tests.
+func (a *AppearanceService) SetSettingsForTest(settings AppearanceSettings) {
+	a.updateSettings(settings)
+}
+
+// SetInsetsForTest updates the keyboard insets and notifies handlers.
+// Use only in tests.
+func (k *KeyboardService) SetInsetsForTest(insets EdgeInsets) {
+	k.updateInsets(insets)
+}
+
+// SendKeyEventForTest notifies handlers of a hardware key event. Use only in
+// tests.
+func (k *HardwareKeyboardService) SendKeyEventForTest(event focus.KeyEvent) {
+	k.dispatch(event)
+}
+
+// SetLocalesForTest updates the preferred locales and notifies handlers.
+// Use only in tests.
+func (s *LocaleService) SetLocalesForTest(locales ...Locale) {
+	s.updateLocales(locales)
+}
@@ -2,6 +2,8 @@ package platform
 
 import (
 	"sync"
+
+	"github.com/go-drift/drift/pkg/core"
 )
 
 // TextAffinity describes which side of a position the caret prefers.
@@ -38,7 +40,8 @@ func (r TextRange) IsNormalized() bool {
 // TextRangeEmpty is an invalid/empty text range.
 var TextRangeEmpty = TextRange{Start: -1, End: -1}
 
-// TextSelection represents the current text selection.
+// TextSelection represents the current text selection. Offsets are UTF-8
+// byte offsets into the text.
 type TextSelection struct {
 	// BaseOffset is the position where the selection started.
 	BaseOffset int
@@ -85,7 +88,10 @@ func TextSelectionCollapsed(offset int) TextSelection {
 	}
 }
 
-// TextEditingValue represents the current text editing state.
+// TextEditingValue represents the current text editing state. Selection and
+// ComposingRange hold UTF-8 byte offsets into Text, so they can slice it
+// directly. [TextInputView] converts them to and from the UTF-16 offsets the
+// native text views use.
 type TextEditingValue struct {
 	// Text is the current text content.
 	Text string
@@ -106,6 +112,23 @@ func (v TextEditingValue) IsComposing() bool {
 	return v.ComposingRange.IsValid() && !v.ComposingRange.IsEmpty()
 }
 
+// TextInputFormatter rewrites user edits before they reach a text field's
+// controller, for masks, digit grouping, or filtering. FormatEditUpdate
+// receives the value before and after the edit and returns the value to keep,
+// including where the caret should go. Returning oldValue rejects the edit.
+// Selection offsets in both values are byte offsets; see [TextEditingValue].
+type TextInputFormatter interface {
+	FormatEditUpdate(oldValue, newValue TextEditingValue) TextEditingValue
+}
+
+// TextInputFormatterFunc adapts a function to [TextInputFormatter].
+type TextInputFormatterFunc func(oldValue, newValue TextEditingValue) TextEditingValue
+
+// FormatEditUpdate calls f.
+func (f TextInputFormatterFunc) FormatEditUpdate(oldValue, newValue TextEditingValue) TextEditingValue {
+	return f(oldValue, newValue)
+}
+
 // KeyboardType specifies the type of keyboard to show.
 type KeyboardType int
 
@@ -143,6 +166,106 @@ const (
 	TextCapitalizationSentences
 )
 
+// MaxLengthEnforcement specifies how a text input applies its maximum length.
+// Lengths are counted in Unicode code points. Edits made while an IME
+// composition is active are never altered; the limit is applied once the
+// composition is committed so multi-step input (e.g. CJK, Hangul) keeps working.
+type MaxLengthEnforcement int
+
+const (
+	// MaxLengthEnforcementTruncate inserts as much of an edit as fits and
+	// discards the rest, so pasting long text fills the remaining space.
+	MaxLengthEnforcementTruncate MaxLengthEnforcement = iota
+	// MaxLengthEnforcementBlock rejects any edit that would exceed the limit.
+	MaxLengthEnforcementBlock
+	// MaxLengthEnforcementNone does not limit input. The length is only
+	// reported (e.g. by a character counter) and may exceed the maximum.
+	MaxLengthEnforcementNone
+)
+
+// KeyboardAppearance selects the light or dark style of the system keyboard.
+type KeyboardAppearance int
+
+const (
+	// KeyboardAppearanceDefault uses the platform default, which follows the
+	// system appearance.
+	KeyboardAppearanceDefault KeyboardAppearance = iota
+	// KeyboardAppearanceLight requests a light keyboard.
+	KeyboardAppearanceLight
+	// KeyboardAppearanceDark requests a dark keyboard.
+	KeyboardAppearanceDark
+)
+
+// SpellCheckMode selects whether a text input shows native spell check
+// underlines.
+type SpellCheckMode int
+
+const (
+	// SpellCheckDefault uses the platform default, which follows Autocorrect.
+	SpellCheckDefault SpellCheckMode = iota
+	// SpellCheckEnabled always shows spell check underlines.
+	SpellCheckEnabled
+	// SpellCheckDisabled never shows spell check underlines.
+	SpellCheckDisabled
+)
+
+// MisspelledRange is a word the platform spell checker flagged.
+type MisspelledRange struct {
+	// Start and End delimit the word, in the same units as selection offsets.
+	Start int
+	End   int
+	// Word is the flagged text.
+	Word string
+	// Suggestions holds the spell checker's replacements, best first.
+	// It may be empty when the checker has no suggestions.
+	Suggestions []string
+}
+
+// FindMisspelledRange returns the range in ranges containing offset, such as
+// the caret position, inclusive of both ends.
+func FindMisspelledRange(ranges []MisspelledRange, offset int) (MisspelledRange, bool) {
+	for _, r := range ranges {
+		if offset >= r.Start && offset <= r.End {
+			return r, true
+		}
+	}
+	return MisspelledRange{}, false
+}
+
+// InsertedContent is rich content, such as an image or GIF, inserted into a
+// text input from the keyboard (e.g. a GIF or sticker picker), a paste, or a
+// drag and drop.
+type InsertedContent struct {
+	// MimeType is the content type, e.g. "image/gif".
+	MimeType string
+	// Data holds the content bytes.
+	Data []byte
+	// URI is the content URI the platform delivered, if any (Android only).
+	URI string
+}
+
+// DefaultContentMimeTypes are the content types a text input accepts when it
+// handles inserted content without specifying its own list.
+var DefaultContentMimeTypes = []string{"image/png", "image/gif", "image/jpeg", "image/webp"}
+
+// AutofillHint tells the platform's autofill service what a text input
+// holds, so it can offer saved credentials, contact details, or a one-time
+// code read from an incoming SMS.
+type AutofillHint string
+
+const (
+	AutofillHintUsername    AutofillHint = "username"
+	AutofillHintPassword    AutofillHint = "password"
+	AutofillHintNewPassword AutofillHint = "newPassword"
+	AutofillHintEmail       AutofillHint = "email"
+	AutofillHintName        AutofillHint = "name"
+	AutofillHintPhone       AutofillHint = "phone"
+	AutofillHintPostalCode  AutofillHint = "postalCode"
+	// AutofillHintOneTimeCode offers a verification code from a recent SMS
+	// (or, on iOS, email) above the keyboard.
+	AutofillHintOneTimeCode AutofillHint = "oneTimeCode"
+)
+
 var (
 	focusedTarget   any   // The render object that currently has focus
 	focusedViewID   int64 // The view ID of the currently focused text input
@@ -210,10 +333,9 @@ func UnfocusAll() {
 
 // TextEditingController manages text input state.
 type TextEditingController struct {
-	value          TextEditingValue
-	listeners      map[int]func()
-	nextListenerID int
-	mu             sync.RWMutex
+	value     TextEditingValue
+	listeners core.Notifier
+	mu        sync.RWMutex
 }
 
 // NewTextEditingController creates a new text editing controller with the given initial text.
@@ -224,7 +346,6 @@ func NewTextEditingController(text string) *TextEditingController {
 			Selection:      TextSelectionCollapsed(len(text)),
 			ComposingRange: TextRangeEmpty,
 		},
-		listeners: make(map[int]func()),
 	}
 }
 
@@ -280,6 +401,20 @@ func (c *TextEditingController) SetValue(value TextEditingValue) {
 	c.notifyListeners()
 }
 
+// ReplaceRange replaces the text between r.Start and r.End with replacement
+// and places the caret after it. The range is clamped to the current text.
+func (c *TextEditingController) ReplaceRange(r TextRange, replacement string) {
+	c.mu.Lock()
+	text := c.value.Text
+	start := min(max(min(r.Start, r.End), 0), len(text))
+	end := min(max(max(r.Start, r.End), 0), len(text))
+	c.value.Text = text[:start] + replacement + text[end:]
+	c.value.Selection = TextSelectionCollapsed(start + len(replacement))
+	c.value.ComposingRange = TextRangeEmpty
+	c.mu.Unlock()
+	c.notifyListeners()
+}
+
 // Clear clears the text.
 func (c *TextEditingController) Clear() {
 	c.SetText("")
@@ -288,29 +423,10 @@ func (c *TextEditingController) Clear() {
 // AddListener adds a callback that is called when the value changes.
 // Returns an unsubscribe function.
 func (c *TextEditingController) AddListener(fn func()) func() {
-	c.mu.Lock()
-	id := c.nextListenerID
-	c.nextListenerID++
-	c.listeners[id] = fn
-	c.mu.Unlock()
-
-	return func() {
-		c.mu.Lock()
-		delete(c.listeners, id)
-		c.mu.Unlock()
-	}
+	return c.listeners.AddListener(fn)
 }
 
 // notifyListeners calls all registered listeners.
 func (c *TextEditingController) notifyListeners() {
-	c.mu.RLock()
-	listeners := make([]func(), 0, len(c.listeners))
-	for _, fn := range c.listeners {
-		listeners = append(listeners, fn)
-	}
-	c.mu.RUnlock()
-
-	for _, fn := range listeners {
-		fn()
-	}
+	c.listeners.Notify()
 }
@@ -2,6 +2,7 @@ package platform
 
 import (
 	"sync"
+	"unicode/utf8"
 )
 
 // TextInputViewConfig defines styling passed to native text view.
@@ -15,13 +16,32 @@ type TextInputViewConfig struct {
 	TextAlignment    int    // 0=left, 1=center, 2=right
 
 	// Behavior
-	Multiline      bool
-	MaxLines       int
-	Obscure        bool
-	Autocorrect    bool
-	KeyboardType   KeyboardType
-	InputAction    TextInputAction
-	Capitalization TextCapitalization
+	Multiline bool
+	MaxLines  int
+	Obscure   bool
+	// ObscuringCharacter replaces each character while Obscure is set.
+	// Empty uses the platform default bullet.
+	ObscuringCharacter string
+	Autocorrect        bool
+	KeyboardType       KeyboardType
+	InputAction        TextInputAction
+	Capitalization     TextCapitalization
+
+	// Keyboard appearance and punctuation (iOS; see TextInput for per-field
+	// platform notes)
+	KeyboardAppearance            KeyboardAppearance
+	EnablesReturnKeyAutomatically bool
+	SmartDashes                   bool
+	SmartQuotes                   bool
+
+	// Spell checking. ReportMisspellings makes the native view run its spell
+	// checker as text changes and report flagged words.
+	SpellCheck         SpellCheckMode
+	ReportMisspellings bool
+
+	// Length limit (0 = unlimited)
+	MaxLength            int
+	MaxLengthEnforcement MaxLengthEnforcement
 
 	// Padding inside native view
 	PaddingLeft   float64
@@ -31,6 +51,19 @@ type TextInputViewConfig struct {
 
 	// Placeholder text
 	Placeholder string
+
+	// ContentMimeTypes is a comma-separated list of MIME types accepted as
+	// inserted content (wildcards like "image/*" are allowed). Empty disables
+	// content insertion. A string keeps the config comparable.
+	ContentMimeTypes string
+
+	// AutofillHints is a comma-separated list of [AutofillHint] values.
+	// Empty leaves autofill to the platform's heuristics.
+	AutofillHints string
+
+	// HideCursor hides the caret and selection highlight, for widgets that
+	// draw their own.
+	HideCursor bool
 }
 
 // TextInputViewClient receives callbacks from native text input view.
@@ -45,6 +78,23 @@ type TextInputViewClient interface {
 	OnFocusChanged(focused bool)
 }
 
+// TextInputSpellCheckClient is implemented by a [TextInputViewClient] that
+// receives spell check results. See [TextInputViewConfig.ReportMisspellings].
+type TextInputSpellCheckClient interface {
+	// OnMisspelledRanges is called with every flagged word in the current
+	// text, or an empty slice once none remain.
+	OnMisspelledRanges(ranges []MisspelledRange)
+}
+
+// TextInputContentClient is implemented by a [TextInputViewClient] that
+// accepts rich content inserted into the view. See
+// [TextInputViewConfig.ContentMimeTypes].
+type TextInputContentClient interface {
+	// OnContentInserted is called when the user inserts content of an
+	// accepted type.
+	OnContentInserted(content InsertedContent)
+}
+
 // TextInputView is a platform view for text input.
 type TextInputView struct {
 	basePlatformView
@@ -100,16 +150,18 @@ func (v *TextInputView) SetText(text string) {
 	})
 }
 
-// SetSelection updates the cursor/selection position.
+// SetSelection updates the cursor/selection position, given as byte offsets
+// into the current text.
 func (v *TextInputView) SetSelection(base, extent int) {
 	v.mu.Lock()
 	v.selBase = base
 	v.selExt = extent
+	text := v.text
 	v.mu.Unlock()
 
 	GetPlatformViewRegistry().InvokeViewMethod(v.viewID, "setSelection", map[string]any{
-		"selectionBase":   base,
-		"selectionExtent": extent,
+		"selectionBase":   byteToUTF16Offset(text, base),
+		"selectionExtent": byteToUTF16Offset(text, extent),
 	})
 }
 
@@ -123,8 +175,8 @@ func (v *TextInputView) SetValue(value TextEditingValue) {
 
 	GetPlatformViewRegistry().InvokeViewMethod(v.viewID, "setValue", map[string]any{
 		"text":            value.Text,
-		"selectionBase":   value.Selection.BaseOffset,
-		"selectionExtent": value.Selection.ExtentOffset,
+		"selectionBase":   byteToUTF16Offset(value.Text, value.Selection.BaseOffset),
+		"selectionExtent": byteToUTF16Offset(value.Text, value.Selection.ExtentOffset),
 	})
 }
 
@@ -174,29 +226,44 @@ func (v *TextInputView) UpdateConfig(config TextInputViewConfig) {
 	v.mu.Unlock()
 
 	GetPlatformViewRegistry().InvokeViewMethod(v.viewID, "updateConfig", map[string]any{
-		"fontFamily":       config.FontFamily,
-		"fontSize":         config.FontSize,
-		"fontWeight":       config.FontWeight,
-		"textColor":        config.TextColor,
-		"placeholderColor": config.PlaceholderColor,
-		"textAlignment":    config.TextAlignment,
-		"multiline":        config.Multiline,
-		"maxLines":         config.MaxLines,
-		"obscure":          config.Obscure,
-		"autocorrect":      config.Autocorrect,
-		"keyboardType":     int(config.KeyboardType),
-		"inputAction":      int(config.InputAction),
-		"capitalization":   int(config.Capitalization),
-		"paddingLeft":      config.PaddingLeft,
-		"paddingTop":       config.PaddingTop,
-		"paddingRight":     config.PaddingRight,
-		"paddingBottom":    config.PaddingBottom,
-		"placeholder":      config.Placeholder,
+		"fontFamily":                    config.FontFamily,
+		"fontSize":                      config.FontSize,
+		"fontWeight":                    config.FontWeight,
+		"textColor":                     config.TextColor,
+		"placeholderColor":              config.PlaceholderColor,
+		"textAlignment":                 config.TextAlignment,
+		"multiline":                     config.Multiline,
+		"maxLines":                      config.MaxLines,
+		"obscure":                       config.Obscure,
+		"obscuringCharacter":            config.ObscuringCharacter,
+		"autocorrect":                   config.Autocorrect,
+		"keyboardType":                  int(config.KeyboardType),
+		"inputAction":                   int(config.InputAction),
+		"capitalization":                int(config.Capitalization),
+		"keyboardAppearance":            int(config.KeyboardAppearance),
+		"enablesReturnKeyAutomatically": config.EnablesReturnKeyAutomatically,
+		"smartDashes":                   config.SmartDashes,
+		"smartQuotes":                   config.SmartQuotes,
+		"spellCheck":                    int(config.SpellCheck),
+		"reportMisspellings":            config.ReportMisspellings,
+		"maxLength":                     config.MaxLength,
+		"maxLengthEnforcement":          int(config.MaxLengthEnforcement),
+		"paddingLeft":                   config.PaddingLeft,
+		"paddingTop":                    config.PaddingTop,
+		"paddingRight":                  config.PaddingRight,
+		"paddingBottom":                 config.PaddingBottom,
+		"placeholder":                   config.Placeholder,
+		"contentMimeTypes":              config.ContentMimeTypes,
+		"autofillHints":                 config.AutofillHints,
+		"hideCursor":                    config.HideCursor,
 	})
 }
 
-// handleTextChanged processes text change events from native.
+// handleTextChanged processes text change events from native. The native
+// views report the selection in UTF-16 code units.
 func (v *TextInputView) handleTextChanged(text string, selBase, selExt int) {
+	selBase = utf16ToByteOffset(text, selBase)
+	selExt = utf16ToByteOffset(text, selExt)
 	v.mu.Lock()
 	v.text = text
 	v.selBase = selBase
@@ -215,6 +282,69 @@ func (v *TextInputView) handleAction(action TextInputAction) {
 	}
 }
 
+// handleMisspelledRanges processes spell check results from native.
+func (v *TextInputView) handleMisspelledRanges(ranges []MisspelledRange) {
+	c, ok := v.client.(TextInputSpellCheckClient)
+	if !ok {
+		return
+	}
+	text := v.Text()
+	for i := range ranges {
+		ranges[i].Start = utf16ToByteOffset(text, ranges[i].Start)
+		ranges[i].End = utf16ToByteOffset(text, ranges[i].End)
+	}
+	c.OnMisspelledRanges(ranges)
+}
+
+// utf16ToByteOffset converts an offset into text counted in UTF-16 code
+// units, as native text views count, to a byte offset. Negative offsets,
+// meaning no selection, are returned unchanged; offsets past the end are
+// clamped to it.
+func utf16ToByteOffset(text string, offset int) int {
+	if offset < 0 {
+		return offset
+	}
+	units := 0
+	for i, r := range text {
+		if units >= offset {
+			return i
+		}
+		units += utf16Len(r)
+	}
+	return len(text)
+}
+
+// byteToUTF16Offset converts a byte offset into text to UTF-16 code units.
+// Negative offsets are returned unchanged.
+func byteToUTF16Offset(text string, offset int) int {
+	if offset < 0 {
+		return offset
+	}
+	units := 0
+	for i, r := range text {
+		if i >= offset {
+			return units
+		}
+		units += utf16Len(r)
+	}
+	return units
+}
+
+// utf16Len returns the number of UTF-16 code units that encode r.
+func utf16Len(r rune) int {
+	if r >= 0x10000 && r <= utf8.MaxRune {
+		return 2
+	}
+	return 1
+}
+
+// handleContentInserted processes content insertion events from native.
+func (v *TextInputView) handleContentInserted(content InsertedContent) {
+	if c, ok := v.client.(TextInputContentClient); ok {
+		c.OnContentInserted(content)
+	}
+}
+
 // handleFocusChanged processes focus change events from native.
 func (v *TextInputView) handleFocusChanged(focused bool) {
 	v.mu.Lock()
@@ -264,6 +394,9 @@ func (f *textInputViewFactory) Create(viewID int64, params map[string]any) (Plat
 	if v, ok := params["obscure"].(bool); ok {
 		config.Obscure = v
 	}
+	if v, ok := params["obscuringCharacter"].(string); ok {
+		config.ObscuringCharacter = v
+	}
 	if v, ok := params["autocorrect"].(bool); ok {
 		config.Autocorrect = v
 	}
@@ -276,6 +409,24 @@ func (f *textInputViewFactory) Create(viewID int64, params map[string]any) (Plat
 	if v, ok := toInt(params["capitalization"]); ok {
 		config.Capitalization = TextCapitalization(v)
 	}
+	if v, ok := toInt(params["keyboardAppearance"]); ok {
+		config.KeyboardAppearance = KeyboardAppearance(v)
+	}
+	if v, ok := params["enablesReturnKeyAutomatically"].(bool); ok {
+		config.EnablesReturnKeyAutomatically = v
+	}
+	if v, ok := params["smartDashes"].(bool); ok {
+		config.SmartDashes = v
+	}
+	if v, ok := params["smartQuotes"].(bool); ok {
+		config.SmartQuotes = v
+	}
+	if v, ok := toInt(params["spellCheck"]); ok {
+		config.SpellCheck = SpellCheckMode(v)
+	}
+	if v, ok := params["reportMisspellings"].(bool); ok {
+		config.ReportMisspellings = v
+	}
 	if v, ok := toFloat64(params["paddingLeft"]); ok {
 		config.PaddingLeft = v
 	}
@@ -291,6 +442,15 @@ func (f *textInputViewFactory) Create(viewID int64, params map[string]any) (Plat
 	if v, ok := params["placeholder"].(string); ok {
 		config.Placeholder = v
 	}
+	if v, ok := params["contentMimeTypes"].(string); ok {
+		config.ContentMimeTypes = v
+	}
+	if v, ok := params["autofillHints"].(string); ok {
+		config.AutofillHints = v
+	}
+	if v, ok := params["hideCursor"].(bool); ok {
+		config.HideCursor = v
+	}
 
 	// The client will be set later by the widget
 	view := NewTextInputView(viewID, config, nil)
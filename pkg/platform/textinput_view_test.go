@@ -251,3 +251,67 @@ func (c *testTextInputClient) OnFocusChanged(focused bool) {
 		c.onFocusChanged(focused)
 	}
 }
+
+func TestTextEditingController_ReplaceRange(t *testing.T) {
+	c := NewTextEditingController("hello wrold!")
+	ranges := []MisspelledRange{{Start: 6, End: 11, Word: "wrold", Suggestions: []string{"world"}}}
+
+	r, ok := FindMisspelledRange(ranges, 8)
+	if !ok {
+		t.Fatal("expected caret inside the word to find its range")
+	}
+	if _, ok := FindMisspelledRange(ranges, 2); ok {
+		t.Fatal("expected no range outside misspelled words")
+	}
+
+	c.ReplaceRange(TextRange{Start: r.Start, End: r.End}, r.Suggestions[0])
+	if got := c.Text(); got != "hello world!" {
+		t.Fatalf("unexpected text %q", got)
+	}
+	if sel := c.Selection(); sel != TextSelectionCollapsed(11) {
+		t.Fatalf("expected caret after replacement, got %+v", sel)
+	}
+
+	c.ReplaceRange(TextRange{Start: 20, End: 40}, "?")
+	if got := c.Text(); got != "hello world!?" {
+		t.Fatalf("expected out-of-range replacement to append, got %q", got)
+	}
+}
+
+func TestTextInputView_HandleTextChangedConvertsUTF16Offsets(t *testing.T) {
+	setupTestBridge(t)
+
+	view := NewTextInputView(1, TextInputViewConfig{}, nil)
+
+	// Native views count UTF-16 code units. The no-break spaces are one
+	// unit but two bytes, so the caret before the euro sign at unit 6 is at
+	// byte 8.
+	text := "1\u00a0234\u00a0€"
+	view.handleTextChanged(text, 6, 7)
+	base, ext := view.Selection()
+	if base != 8 || ext != len(text) {
+		t.Errorf("Selection() = (%d, %d), want byte offsets (8, %d)", base, ext, len(text))
+	}
+}
+
+func TestUTF16ByteOffsets(t *testing.T) {
+	// "a", U+00A0 (2 bytes, 1 unit), "€" (3 bytes, 1 unit), U+1F600 (4 bytes, 2 units).
+	text := "a\u00a0€\U0001F600b"
+	tests := []struct{ units, bytes int }{
+		{0, 0}, {1, 1}, {2, 3}, {3, 6}, {5, 10}, {6, 11},
+	}
+	for _, tt := range tests {
+		if got := utf16ToByteOffset(text, tt.units); got != tt.bytes {
+			t.Errorf("utf16ToByteOffset(%d) = %d, want %d", tt.units, got, tt.bytes)
+		}
+		if got := byteToUTF16Offset(text, tt.bytes); got != tt.units {
+			t.Errorf("byteToUTF16Offset(%d) = %d, want %d", tt.bytes, got, tt.units)
+		}
+	}
+	if got := utf16ToByteOffset(text, -1); got != -1 {
+		t.Errorf("expected no selection kept, got %d", got)
+	}
+	if got := utf16ToByteOffset(text, 99); got != len(text) {
+		t.Errorf("expected offsets past the end clamped, got %d", got)
+	}
+}
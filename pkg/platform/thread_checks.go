@@ -0,0 +1,142 @@
+package platform
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"runtime"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	drifterrors "github.com/go-drift/drift/pkg/errors"
+)
+
+// Errors reported by the thread checks enabled with [SetThreadChecks].
+var (
+	// ErrUIThreadCall is reported in strict mode for every synchronous
+	// method call made on the UI thread.
+	ErrUIThreadCall = errors.New("platform: synchronous call on the UI thread")
+
+	// ErrUIThreadBlocked is reported when a synchronous method call has
+	// blocked the UI thread for longer than the threshold. It is reported
+	// while the call is still blocked, so a deadlocked call is reported too.
+	ErrUIThreadBlocked = errors.New("platform: UI thread blocked by a synchronous call")
+
+	// ErrReentrantCall is reported when a handler for a call from native code
+	// makes a synchronous call back to native code, which deadlocks on
+	// platforms that serve both calls on the same native thread.
+	ErrReentrantCall = errors.New("platform: synchronous call from a native method handler may deadlock")
+)
+
+// ThreadChecks configures debug checks on how platform channels are used
+// from the UI thread, to catch the calls behind UI freezes. Enable them with
+// [SetThreadChecks], or with DiagnosticsConfig.CheckChannelThreads in the
+// engine. Problems are reported through the errors package.
+type ThreadChecks struct {
+	// Strict reports every synchronous method call made on the UI thread,
+	// not only slow ones. Use it to audit an app for calls to move to
+	// [MethodChannel.InvokeAsync] or a goroutine.
+	Strict bool
+
+	// BlockThreshold is how long a synchronous call may block the UI thread
+	// before it is reported. Defaults to 100ms if zero.
+	BlockThreshold time.Duration
+}
+
+const defaultBlockThreshold = 100 * time.Millisecond
+
+var (
+	threadChecks      atomic.Pointer[ThreadChecks]
+	uiGoroutine       atomic.Int64
+	handlerGoroutines sync.Map // goroutine ID -> struct{}, while handling a native call
+	reportedCalls     sync.Map // channel + method -> struct{}, reported in strict mode
+)
+
+// SetThreadChecks enables the thread checks, replacing any earlier
+// configuration. Pass nil to disable them. The checks cost a stack read per
+// method call, so leave them off in release builds.
+func SetThreadChecks(checks *ThreadChecks) {
+	if checks == nil {
+		threadChecks.Store(nil)
+		return
+	}
+	c := *checks
+	if c.BlockThreshold <= 0 {
+		c.BlockThreshold = defaultBlockThreshold
+	}
+	reportedCalls.Clear()
+	threadChecks.Store(&c)
+}
+
+// EnterUIThread marks the calling goroutine as the UI thread until the
+// returned function is called. The engine calls it around frames and input
+// handling; it does nothing unless thread checks are enabled.
+//
+//	defer platform.EnterUIThread()()
+func EnterUIThread() (exit func()) {
+	if threadChecks.Load() == nil {
+		return func() {}
+	}
+	prev := uiGoroutine.Swap(goroutineID())
+	return func() { uiGoroutine.Store(prev) }
+}
+
+// checkInvoke runs the thread checks for a synchronous call to native code
+// and returns a function to call once the call returns.
+func checkInvoke(checks *ThreadChecks, channel, method string) (done func()) {
+	id := goroutineID()
+	if _, ok := handlerGoroutines.Load(id); ok {
+		reportThreadCheck(channel, fmt.Errorf("%w: %s", ErrReentrantCall, method), true)
+	}
+	if ui := uiGoroutine.Load(); ui == 0 || ui != id {
+		return func() {}
+	}
+	if checks.Strict {
+		if _, seen := reportedCalls.LoadOrStore(channel+"\x00"+method, struct{}{}); !seen {
+			reportThreadCheck(channel, fmt.Errorf("%w: %s", ErrUIThreadCall, method), true)
+		}
+	}
+	threshold := checks.BlockThreshold
+	timer := time.AfterFunc(threshold, func() {
+		reportThreadCheck(channel, fmt.Errorf("%w: %s still running after %v", ErrUIThreadBlocked, method, threshold), false)
+	})
+	return func() { timer.Stop() }
+}
+
+// trackHandler records that the calling goroutine is handling a call from
+// native code until the returned function is called.
+func trackHandler() (done func()) {
+	if threadChecks.Load() == nil {
+		return func() {}
+	}
+	id := goroutineID()
+	handlerGoroutines.Store(id, struct{}{})
+	return func() { handlerGoroutines.Delete(id) }
+}
+
+func reportThreadCheck(channel string, err error, withStack bool) {
+	report := &drifterrors.DriftError{
+		Op:      "platform.Invoke",
+		Kind:    drifterrors.KindPlatform,
+		Channel: channel,
+		Err:     err,
+	}
+	if withStack {
+		report.StackTrace = drifterrors.CaptureStack()
+	}
+	drifterrors.Report(report)
+}
+
+// goroutineID returns the ID of the calling goroutine, parsed from the
+// header of its stack trace ("goroutine 42 [running]:").
+func goroutineID() int64 {
+	var buf [64]byte
+	header := bytes.TrimPrefix(buf[:runtime.Stack(buf[:], false)], []byte("goroutine "))
+	if i := bytes.IndexByte(header, ' '); i > 0 {
+		id, _ := strconv.ParseInt(string(header[:i]), 10, 64)
+		return id
+	}
+	return 0
+}
@@ -0,0 +1,103 @@
+package platform
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	drifterrors "github.com/go-drift/drift/pkg/errors"
+)
+
+// delayBridge answers method calls after a delay.
+type delayBridge struct {
+	countingBridge
+	delay time.Duration
+}
+
+func (b *delayBridge) InvokeMethod(ctx context.Context, channel, method string, args []byte) ([]byte, error) {
+	time.Sleep(b.delay)
+	return b.countingBridge.InvokeMethod(ctx, channel, method, args)
+}
+
+func captureThreadChecks(t *testing.T, checks *ThreadChecks) *capturingHandler {
+	t.Helper()
+	ResetForTest()
+	t.Cleanup(ResetForTest)
+	handler := &capturingHandler{}
+	prev := drifterrors.DefaultHandler
+	drifterrors.SetHandler(handler)
+	t.Cleanup(func() { drifterrors.SetHandler(prev) })
+	SetThreadChecks(checks)
+	return handler
+}
+
+func reportedErrors(h *capturingHandler, target error) int {
+	n := 0
+	for _, err := range h.snapshot() {
+		if errors.Is(err, target) {
+			n++
+		}
+	}
+	return n
+}
+
+func TestThreadChecks_ReportsBlockedUIThread(t *testing.T) {
+	handler := captureThreadChecks(t, &ThreadChecks{Strict: true, BlockThreshold: 5 * time.Millisecond})
+	SetNativeBridge(&delayBridge{delay: 30 * time.Millisecond})
+	ch := NewMethodChannel("test/threads")
+
+	// Off the UI thread, slow calls are fine.
+	if _, err := ch.Invoke(context.Background(), "slow", nil); err != nil {
+		t.Fatalf("Invoke: %v", err)
+	}
+	if got := len(handler.snapshot()); got != 0 {
+		t.Fatalf("expected no reports off the UI thread, got %d", got)
+	}
+
+	exit := EnterUIThread()
+	ch.Invoke(context.Background(), "slow", nil)
+	ch.Invoke(context.Background(), "slow", nil)
+	exit()
+
+	if got := reportedErrors(handler, ErrUIThreadBlocked); got != 2 {
+		t.Errorf("expected each blocked call reported, got %d", got)
+	}
+	if got := reportedErrors(handler, ErrUIThreadCall); got != 1 {
+		t.Errorf("expected strict mode to report the method once, got %d", got)
+	}
+
+	// InvokeAsync does not block the caller.
+	exit = EnterUIThread()
+	result := ch.InvokeAsync(context.Background(), "slow", nil)
+	exit()
+	if r := <-result; r.Err != nil {
+		t.Errorf("InvokeAsync: %v", r.Err)
+	}
+	if got := reportedErrors(handler, ErrUIThreadBlocked); got != 2 {
+		t.Errorf("expected InvokeAsync not reported, got %d reports", got)
+	}
+}
+
+func TestThreadChecks_ReportsReentrantCall(t *testing.T) {
+	handler := captureThreadChecks(t, &ThreadChecks{})
+	SetNativeBridge(&countingBridge{})
+	ch := NewMethodChannel("test/threads/reentrant")
+	ch.SetHandler(func(method string, args any) (any, error) {
+		return ch.Invoke(context.Background(), "back", nil)
+	})
+
+	args, _ := DefaultCodec.Encode(nil)
+	if _, err := HandleMethodCall("test/threads/reentrant", "call", args); err != nil {
+		t.Fatalf("HandleMethodCall: %v", err)
+	}
+	if got := reportedErrors(handler, ErrReentrantCall); got != 1 {
+		t.Errorf("expected the call back to native reported, got %d", got)
+	}
+
+	SetThreadChecks(nil)
+	HandleMethodCall("test/threads/reentrant", "call", args)
+	if got := len(handler.snapshot()); got != 1 {
+		t.Errorf("expected no reports once disabled, got %d", got)
+	}
+}
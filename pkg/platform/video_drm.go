@@ -0,0 +1,108 @@
+package platform
+
+import (
+	"encoding/base64"
+	"errors"
+	"maps"
+)
+
+// DRMScheme identifies a content protection system.
+type DRMScheme int
+
+const (
+	// DRMWidevine is Google Widevine, supported on Android.
+	DRMWidevine DRMScheme = iota
+
+	// DRMFairPlay is Apple FairPlay Streaming, supported on iOS for HLS.
+	DRMFairPlay
+)
+
+// String returns a human-readable label for the scheme.
+func (s DRMScheme) String() string {
+	switch s {
+	case DRMWidevine:
+		return "Widevine"
+	case DRMFairPlay:
+		return "FairPlay"
+	default:
+		return "Unknown"
+	}
+}
+
+// channelName returns the scheme's name on the platform channel.
+func (s DRMScheme) channelName() string {
+	switch s {
+	case DRMWidevine:
+		return "widevine"
+	case DRMFairPlay:
+		return "fairplay"
+	default:
+		return ""
+	}
+}
+
+// DRMConfiguration describes how to acquire licenses for protected media
+// loaded with [VideoPlayerController.LoadWithOptions].
+//
+// The player posts the platform's license request to LicenseURL and expects
+// the license in the response body. Servers that wrap requests or responses
+// in their own format need a proxy that unwraps them.
+type DRMConfiguration struct {
+	// Scheme is the protection system of the media. Use the scheme the
+	// platform supports: Widevine on Android, FairPlay on iOS.
+	Scheme DRMScheme
+
+	// LicenseURL is the license server URL. Required.
+	LicenseURL string
+
+	// Headers are sent with every license request, for example an
+	// authorization token.
+	Headers map[string]string
+
+	// Certificate is the FairPlay application certificate (DER). FairPlay
+	// requires either Certificate or CertificateURL.
+	Certificate []byte
+
+	// CertificateURL is fetched, with Headers, to obtain the FairPlay
+	// application certificate when Certificate is empty.
+	CertificateURL string
+}
+
+// validate reports configuration errors that would make every license
+// request fail.
+func (c *DRMConfiguration) validate() error {
+	if c.Scheme.channelName() == "" {
+		return errors.New("video: unknown DRM scheme")
+	}
+	if c.LicenseURL == "" {
+		return errors.New("video: DRM configuration has no license URL")
+	}
+	if c.Scheme == DRMFairPlay && len(c.Certificate) == 0 && c.CertificateURL == "" {
+		return errors.New("video: FairPlay requires a certificate or certificate URL")
+	}
+	return nil
+}
+
+// args encodes the configuration for the platform channel.
+func (c *DRMConfiguration) args() map[string]any {
+	args := map[string]any{
+		"scheme":     c.Scheme.channelName(),
+		"licenseUrl": c.LicenseURL,
+		"headers":    maps.Clone(c.Headers),
+	}
+	if len(c.Certificate) > 0 {
+		args["certificate"] = base64.StdEncoding.EncodeToString(c.Certificate)
+	}
+	if c.CertificateURL != "" {
+		args["certificateUrl"] = c.CertificateURL
+	}
+	return args
+}
+
+// VideoLoadOptions configures how [VideoPlayerController.LoadWithOptions]
+// loads media.
+type VideoLoadOptions struct {
+	// DRM configures license acquisition for protected media. Nil for
+	// unprotected media.
+	DRM *DRMConfiguration
+}
@@ -0,0 +1,74 @@
+package platform
+
+import (
+	"encoding/base64"
+	"testing"
+)
+
+func TestVideoPlayerController_LoadWithDRM(t *testing.T) {
+	bridge := setupTestBridge(t)
+
+	c := NewVideoPlayerController()
+	defer c.Dispose()
+
+	bridge.reset()
+	err := c.LoadWithOptions("https://example.com/master.m3u8", VideoLoadOptions{
+		DRM: &DRMConfiguration{
+			Scheme:      DRMFairPlay,
+			LicenseURL:  "https://license.example.com/fps",
+			Headers:     map[string]string{"Authorization": "Bearer token"},
+			Certificate: []byte{0x30, 0x82},
+		},
+	})
+	if err != nil {
+		t.Fatalf("LoadWithOptions: %v", err)
+	}
+	if len(bridge.calls) != 1 {
+		t.Fatalf("unexpected native calls %+v", bridge.calls)
+	}
+	args, _ := bridge.calls[0].args.(map[string]any)
+	drm, _ := args["drm"].(map[string]any)
+	if args["method"] != "load" || drm == nil {
+		t.Fatalf("native call args: got %+v, want load with drm", args)
+	}
+	headers, _ := drm["headers"].(map[string]any)
+	if drm["scheme"] != "fairplay" || drm["licenseUrl"] != "https://license.example.com/fps" || headers["Authorization"] != "Bearer token" {
+		t.Errorf("drm args: got %+v", drm)
+	}
+	if drm["certificate"] != base64.StdEncoding.EncodeToString([]byte{0x30, 0x82}) {
+		t.Errorf("certificate: got %v", drm["certificate"])
+	}
+
+	// Plain Load sends no DRM configuration.
+	bridge.reset()
+	if err := c.Load("https://example.com/clear.mp4"); err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if args, _ := bridge.calls[0].args.(map[string]any); args["drm"] != nil {
+		t.Errorf("expected no drm args, got %+v", args["drm"])
+	}
+}
+
+func TestVideoPlayerController_LoadWithInvalidDRM(t *testing.T) {
+	bridge := setupTestBridge(t)
+
+	c := NewVideoPlayerController()
+	defer c.Dispose()
+
+	for _, tc := range []struct {
+		name string
+		drm  DRMConfiguration
+	}{
+		{"no license URL", DRMConfiguration{Scheme: DRMWidevine}},
+		{"FairPlay without certificate", DRMConfiguration{Scheme: DRMFairPlay, LicenseURL: "https://license.example.com"}},
+		{"unknown scheme", DRMConfiguration{Scheme: DRMScheme(99), LicenseURL: "https://license.example.com"}},
+	} {
+		bridge.reset()
+		if err := c.LoadWithOptions("https://example.com/video.mpd", VideoLoadOptions{DRM: &tc.drm}); err == nil {
+			t.Errorf("%s: expected an error", tc.name)
+		}
+		if len(bridge.calls) != 0 {
+			t.Errorf("%s: expected nothing loaded, got %+v", tc.name, bridge.calls)
+		}
+	}
+}
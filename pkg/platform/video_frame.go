@@ -0,0 +1,104 @@
+package platform
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"image"
+	_ "image/jpeg" // frames are encoded as JPEG by native
+	"time"
+)
+
+// FrameOptions configures frame extraction with [VideoService.FrameAt].
+type FrameOptions struct {
+	// MaxWidth and MaxHeight bound the frame's size in pixels. The frame is
+	// scaled down to fit, keeping its aspect ratio. Zero leaves that axis
+	// unbounded. Small frames decode and transfer much faster, so set them
+	// to the size the frame is shown at.
+	MaxWidth  int
+	MaxHeight int
+
+	// Exact extracts the frame at exactly the requested position. By default
+	// the nearest keyframe is used, which is much faster and close enough
+	// for scrubbing previews and list thumbnails.
+	Exact bool
+}
+
+// validate reports an error for negative bounds.
+func (o FrameOptions) validate() error {
+	if o.MaxWidth < 0 || o.MaxHeight < 0 {
+		return fmt.Errorf("video: negative frame size %dx%d", o.MaxWidth, o.MaxHeight)
+	}
+	return nil
+}
+
+// FrameAt decodes the frame of the video at url, which may be a local file
+// or a remote URL, at position, without playing it. Use it for video list
+// thumbnails and scrubbing previews. Pass the result to [widgets.Image].
+//
+// Decoding reads from the source and blocks until it finishes, so call it
+// from a goroutine:
+//
+//	go func() {
+//	    frame, err := platform.Video.FrameAt(url, 10*time.Second, platform.FrameOptions{MaxWidth: 320})
+//	    if err != nil {
+//	        return
+//	    }
+//	    platform.Dispatch(func() {
+//	        s.SetState(func() { s.thumbnail = frame })
+//	    })
+//	}()
+//
+// Positions past the end return the last frame. HLS and DASH streams and
+// DRM-protected media cannot be decoded this way; extract frames from a
+// progressive rendition of the video instead.
+func (v *VideoService) FrameAt(url string, position time.Duration, opts FrameOptions) (image.Image, error) {
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	result, err := v.channel.Invoke(context.Background(), "frameAt", map[string]any{
+		"url":        url,
+		"positionMs": max(position.Milliseconds(), 0),
+		"maxWidth":   opts.MaxWidth,
+		"maxHeight":  opts.MaxHeight,
+		"exact":      opts.Exact,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return parseFrame(result)
+}
+
+// FrameAt decodes the frame at position of the media last loaded into the
+// controller, like [VideoService.FrameAt]. The frame is decoded separately,
+// so playback is not affected. Call it from a goroutine.
+func (c *VideoPlayerController) FrameAt(position time.Duration, opts FrameOptions) (image.Image, error) {
+	c.mu.RLock()
+	v, url := c.view, c.url
+	c.mu.RUnlock()
+	if v == nil {
+		return nil, ErrDisposed
+	}
+	if url == "" {
+		return nil, fmt.Errorf("video: FrameAt called before Load")
+	}
+	return Video.FrameAt(url, position, opts)
+}
+
+func parseFrame(result any) (image.Image, error) {
+	m, ok := result.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("video: unexpected response from frameAt: %v", result)
+	}
+	encoded, _ := m["data"].(string)
+	data, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil || len(data) == 0 {
+		return nil, fmt.Errorf("video: frameAt returned no image data")
+	}
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("video: decode frame: %w", err)
+	}
+	return img, nil
+}
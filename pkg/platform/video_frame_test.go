@@ -0,0 +1,62 @@
+package platform
+
+import (
+	"bytes"
+	"encoding/base64"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"testing"
+	"time"
+)
+
+func TestVideoFrameAt(t *testing.T) {
+	src := image.NewRGBA(image.Rect(0, 0, 16, 9))
+	for i := range src.Pix {
+		src.Pix[i] = 0xff
+	}
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, src, nil); err != nil {
+		t.Fatal(err)
+	}
+	SetNativeBridge(&urlLauncherBridge{response: map[string]any{
+		"data": base64.StdEncoding.EncodeToString(buf.Bytes()),
+	}})
+	RegisterDispatch(func(cb func()) { cb() })
+	t.Cleanup(ResetForTest)
+
+	frame, err := Video.FrameAt("https://example.com/video.mp4", 10*time.Second, FrameOptions{MaxWidth: 16})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := frame.Bounds().Size(); got != image.Pt(16, 9) {
+		t.Errorf("frame size: got %v, want 16x9", got)
+	}
+	if r, _, _, _ := color.GrayModel.Convert(frame.At(8, 4)).RGBA(); r < 0xf000 {
+		t.Errorf("expected a white frame, got %v", frame.At(8, 4))
+	}
+}
+
+func TestVideoFrameAt_Errors(t *testing.T) {
+	RegisterDispatch(func(cb func()) { cb() })
+	t.Cleanup(ResetForTest)
+
+	SetNativeBridge(&urlLauncherBridge{response: map[string]any{"data": "bm90IGFuIGltYWdl"}})
+	if _, err := Video.FrameAt("video.mp4", 0, FrameOptions{}); err == nil {
+		t.Error("expected an error for undecodable image data")
+	}
+	SetNativeBridge(&urlLauncherBridge{response: map[string]any{}})
+	if _, err := Video.FrameAt("video.mp4", 0, FrameOptions{}); err == nil {
+		t.Error("expected an error for a missing image")
+	}
+	if _, err := Video.FrameAt("video.mp4", 0, FrameOptions{MaxWidth: -1}); err == nil {
+		t.Error("expected an error for a negative size")
+	}
+
+	setupTestBridge(t)
+	c := NewVideoPlayerController()
+	defer c.Dispose()
+	if _, err := c.FrameAt(time.Second, FrameOptions{}); err == nil {
+		t.Error("expected an error before Load")
+	}
+}
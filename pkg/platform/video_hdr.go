@@ -0,0 +1,124 @@
+package platform
+
+import (
+	"context"
+	"fmt"
+)
+
+// HDRFormat identifies a high dynamic range video format.
+type HDRFormat int
+
+const (
+	// HDRFormatHDR10 is HDR10, using the PQ transfer function with static
+	// brightness metadata.
+	HDRFormatHDR10 HDRFormat = iota
+
+	// HDRFormatHDR10Plus is HDR10+, which adds per-scene brightness metadata
+	// to HDR10.
+	HDRFormatHDR10Plus
+
+	// HDRFormatHLG is Hybrid Log-Gamma, the broadcast HDR format.
+	HDRFormatHLG
+
+	// HDRFormatDolbyVision is Dolby Vision, with per-frame brightness metadata.
+	HDRFormatDolbyVision
+)
+
+// String returns a human-readable label for the format.
+func (f HDRFormat) String() string {
+	switch f {
+	case HDRFormatHDR10:
+		return "HDR10"
+	case HDRFormatHDR10Plus:
+		return "HDR10+"
+	case HDRFormatHLG:
+		return "HLG"
+	case HDRFormatDolbyVision:
+		return "Dolby Vision"
+	default:
+		return "Unknown"
+	}
+}
+
+// hdrFormatNames maps the names used on the platform channel to formats.
+var hdrFormatNames = map[string]HDRFormat{
+	"hdr10":       HDRFormatHDR10,
+	"hdr10Plus":   HDRFormatHDR10Plus,
+	"hlg":         HDRFormatHLG,
+	"dolbyVision": HDRFormatDolbyVision,
+}
+
+// HDRCapabilities describes the HDR video formats the device display can
+// present without tone mapping to SDR.
+type HDRCapabilities struct {
+	// Formats lists the supported formats. Empty if the display is SDR only.
+	Formats []HDRFormat
+
+	// MaxLuminance is the display's peak brightness in nits, or 0 if the
+	// platform does not report it (iOS).
+	MaxLuminance float64
+}
+
+// Supports reports whether the display can present format.
+func (c HDRCapabilities) Supports(format HDRFormat) bool {
+	for _, f := range c.Formats {
+		if f == format {
+			return true
+		}
+	}
+	return false
+}
+
+// IsHDR reports whether the display supports any HDR format.
+func (c HDRCapabilities) IsHDR() bool {
+	return len(c.Formats) > 0
+}
+
+// Video provides device video capabilities.
+var Video = &VideoService{
+	channel: NewMethodChannel("drift/video"),
+}
+
+// VideoService queries what the device can play, so apps can pick
+// appropriate assets before loading them into a [VideoPlayerController].
+type VideoService struct {
+	channel *MethodChannel
+}
+
+// HDRCapabilities returns the HDR formats the display supports. Apps can
+// use it to choose between HDR and SDR renditions of a video:
+//
+//	caps, err := platform.Video.HDRCapabilities()
+//	if err == nil && caps.Supports(platform.HDRFormatDolbyVision) {
+//	    url = dolbyVisionURL
+//	}
+//
+// On Android this reflects Display.getHdrCapabilities; on iOS it reflects
+// AVPlayer.availableHDRModes, which is empty when the device is not eligible
+// for HDR playback.
+func (v *VideoService) HDRCapabilities() (HDRCapabilities, error) {
+	result, err := v.channel.Invoke(context.Background(), "getHDRCapabilities", nil)
+	if err != nil {
+		return HDRCapabilities{}, err
+	}
+	return parseHDRCapabilities(result)
+}
+
+func parseHDRCapabilities(result any) (HDRCapabilities, error) {
+	m, ok := result.(map[string]any)
+	if !ok {
+		return HDRCapabilities{}, fmt.Errorf("video: unexpected response from getHDRCapabilities: %v", result)
+	}
+	var caps HDRCapabilities
+	if names, ok := m["formats"].([]any); ok {
+		for _, name := range names {
+			s, _ := name.(string)
+			// Skip formats added by newer platform versions.
+			if f, ok := hdrFormatNames[s]; ok && !caps.Supports(f) {
+				caps.Formats = append(caps.Formats, f)
+			}
+		}
+	}
+	caps.MaxLuminance, _ = toFloat64(m["maxLuminance"])
+	return caps, nil
+}
@@ -0,0 +1,82 @@
+package platform
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+)
+
+func TestVideoHDRCapabilities(t *testing.T) {
+	SetNativeBridge(&urlLauncherBridge{response: map[string]any{
+		"formats":      []any{"hdr10", "dolbyVision", "hdr10", "futureFormat"},
+		"maxLuminance": 1000.0,
+	}})
+	RegisterDispatch(func(cb func()) { cb() })
+	t.Cleanup(ResetForTest)
+
+	caps, err := Video.HDRCapabilities()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []HDRFormat{HDRFormatHDR10, HDRFormatDolbyVision}
+	if !slices.Equal(caps.Formats, want) {
+		t.Errorf("Formats: got %v, want %v", caps.Formats, want)
+	}
+	if caps.MaxLuminance != 1000 {
+		t.Errorf("MaxLuminance: got %v, want 1000", caps.MaxLuminance)
+	}
+	if !caps.IsHDR() || !caps.Supports(HDRFormatDolbyVision) || caps.Supports(HDRFormatHLG) {
+		t.Errorf("unexpected support for %v", caps.Formats)
+	}
+}
+
+func TestVideoHDRCapabilities_SDRDisplay(t *testing.T) {
+	SetNativeBridge(&urlLauncherBridge{response: map[string]any{"formats": []any{}}})
+	RegisterDispatch(func(cb func()) { cb() })
+	t.Cleanup(ResetForTest)
+
+	caps, err := Video.HDRCapabilities()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if caps.IsHDR() {
+		t.Errorf("expected SDR display, got %v", caps.Formats)
+	}
+}
+
+func TestVideoHDRCapabilities_Errors(t *testing.T) {
+	for _, bridge := range []*urlLauncherBridge{
+		{err: fmt.Errorf("no handler")},
+		{response: nil},
+	} {
+		SetNativeBridge(bridge)
+		RegisterDispatch(func(cb func()) { cb() })
+
+		if _, err := Video.HDRCapabilities(); err == nil {
+			t.Errorf("expected error for bridge %+v", bridge)
+		}
+		ResetForTest()
+	}
+}
+
+func TestVideoPlayerController_PreserveHDROption(t *testing.T) {
+	bridge := setupTestBridge(t)
+
+	c := NewVideoPlayerControllerWithOptions(VideoPlayerOptions{PreserveHDR: true})
+	defer c.Dispose()
+
+	bridge.mu.Lock()
+	defer bridge.mu.Unlock()
+	for _, call := range bridge.calls {
+		args, _ := call.args.(map[string]any)
+		if call.method != "create" || args["viewType"] != "video_player" {
+			continue
+		}
+		params, _ := args["params"].(map[string]any)
+		if params["preserveHDR"] != true {
+			t.Errorf("expected preserveHDR param, got %v", params)
+		}
+		return
+	}
+	t.Fatal("expected create call for video_player")
+}
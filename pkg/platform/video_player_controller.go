@@ -36,6 +36,12 @@ type VideoPlayerController struct {
 	view   *videoPlayerView // guarded by mu
 	viewID int64            // guarded by mu
 
+	url            string         // last loaded media, guarded by mu
+	cueListeners   map[int]func() // guarded by mu
+	nextListenerID int            // guarded by mu
+
+	interruptions interruptionHandler
+
 	// OnPlaybackStateChanged is called when the playback state changes.
 	// Called on the UI thread.
 	// Set this before calling [VideoPlayerController.Load] or any other
@@ -52,20 +58,90 @@ type VideoPlayerController struct {
 
 	// OnError is called when a playback error occurs.
 	// The code parameter is one of [ErrCodeSourceError],
-	// [ErrCodeDecoderError], or [ErrCodePlaybackFailed].
+	// [ErrCodeDecoderError], [ErrCodeDRMError], or [ErrCodePlaybackFailed].
 	// Called on the UI thread.
 	// Set this before calling [VideoPlayerController.Load] or any other
 	// playback method to avoid missing events.
 	OnError func(code, message string)
+
+	// OnSubtitleTracksChanged is called with the available subtitle tracks
+	// when they change: once the media's embedded tracks are known, when a
+	// track is added with [VideoPlayerController.AddSubtitleTrack], and when
+	// Load clears them.
+	// Called on the UI thread.
+	OnSubtitleTracksChanged func(tracks []SubtitleTrack)
+
+	// OnCueChanged is called with the cues of the selected subtitle track
+	// whenever the showing cues change, and with none when a cue ends. Use
+	// it to draw captions with a custom UI; [widgets.VideoCaptions] draws
+	// them for you.
+	// Called on the UI thread.
+	OnCueChanged func(cues []SubtitleCue)
+
+	// OnCastingChanged is called when playback moves to a cast receiver,
+	// with the receiver's name, and when it returns to the device. See
+	// [CastService] for when a player is cast.
+	// Called on the UI thread.
+	OnCastingChanged func(casting bool, deviceName string)
+
+	// OnQualitiesChanged is called with the available renditions of
+	// adaptive (HLS or DASH) media when they change: once they are known
+	// after Load, and with none when Load clears them.
+	// Called on the UI thread.
+	OnQualitiesChanged func(qualities []VideoQuality)
+
+	// OnQualityChanged is called when the player switches to another
+	// rendition, with its size and bitrate. In auto mode this follows the
+	// available bandwidth.
+	// Called on the UI thread.
+	OnQualityChanged func(quality VideoQuality)
+
+	// OnInterruption is called when another audio source, such as a phone
+	// call, interrupts playback and when the interruption ends. It is
+	// called after the controller's [InterruptionPolicy] has paused or
+	// resumed playback; see [VideoPlayerController.SetInterruptionPolicy].
+	// Called on the UI thread.
+	OnInterruption func(interruption MediaInterruption)
+}
+
+// VideoPlayerOptions configures the native surface of a
+// [VideoPlayerController]. They are fixed when the controller is created.
+type VideoPlayerOptions struct {
+	// PreserveHDR presents HDR10, HLG, and Dolby Vision content in high
+	// dynamic range, with its brightness metadata, when the display supports
+	// it (see [VideoService.HDRCapabilities]). Otherwise HDR content is tone
+	// mapped to SDR.
+	//
+	// On Android, HDR output requires a SurfaceView instead of the default
+	// TextureView. A SurfaceView is composited by the system outside the
+	// widget tree, so the video cannot be partially clipped by overlapping
+	// widgets. On iOS, HDR is presented whenever the device is eligible, and
+	// this option controls whether per-frame brightness metadata (Dolby
+	// Vision, HDR10+) is applied.
+	PreserveHDR bool
+
+	// SurfaceMode selects between a SurfaceView and a TextureView on
+	// Android. The default, [SurfaceModeAuto], uses a TextureView unless
+	// PreserveHDR needs a SurfaceView. See [SurfaceMode] for the trade-offs.
+	SurfaceMode SurfaceMode
 }
 
 // NewVideoPlayerController creates a new video player controller.
 // The underlying platform view is created eagerly so methods and callbacks
 // work immediately.
 func NewVideoPlayerController() *VideoPlayerController {
-	c := &VideoPlayerController{}
+	return NewVideoPlayerControllerWithOptions(VideoPlayerOptions{})
+}
 
-	view, err := GetPlatformViewRegistry().Create("video_player", map[string]any{})
+// NewVideoPlayerControllerWithOptions creates a new video player controller
+// with the given surface options.
+func NewVideoPlayerControllerWithOptions(opts VideoPlayerOptions) *VideoPlayerController {
+	c := &VideoPlayerController{cueListeners: make(map[int]func())}
+
+	view, err := GetPlatformViewRegistry().Create("video_player", map[string]any{
+		"preserveHDR": opts.PreserveHDR,
+		"surfaceMode": opts.SurfaceMode.String(),
+	})
 	if err != nil {
 		errors.Report(&errors.DriftError{
 			Op:  "NewVideoPlayerController",
@@ -102,6 +178,46 @@ func NewVideoPlayerController() *VideoPlayerController {
 			c.OnError(code, message)
 		}
 	}
+	videoView.OnSubtitleTracksChanged = func(tracks []SubtitleTrack) {
+		if c.OnSubtitleTracksChanged != nil {
+			c.OnSubtitleTracksChanged(tracks)
+		}
+	}
+	videoView.OnCueChanged = func(cues []SubtitleCue) {
+		if c.OnCueChanged != nil {
+			c.OnCueChanged(cues)
+		}
+		c.mu.RLock()
+		listeners := make([]func(), 0, len(c.cueListeners))
+		for _, listener := range c.cueListeners {
+			listeners = append(listeners, listener)
+		}
+		c.mu.RUnlock()
+		for _, listener := range listeners {
+			listener()
+		}
+	}
+	videoView.OnCastingChanged = func(casting bool, deviceName string) {
+		if c.OnCastingChanged != nil {
+			c.OnCastingChanged(casting, deviceName)
+		}
+	}
+	videoView.OnQualitiesChanged = func(qualities []VideoQuality) {
+		if c.OnQualitiesChanged != nil {
+			c.OnQualitiesChanged(qualities)
+		}
+	}
+	videoView.OnQualityChanged = func(quality VideoQuality) {
+		if c.OnQualityChanged != nil {
+			c.OnQualityChanged(quality)
+		}
+	}
+	videoView.OnInterruption = func(interruption MediaInterruption) {
+		c.interruptions.handle(interruption, c.Pause, c.Play)
+		if c.OnInterruption != nil {
+			c.OnInterruption(interruption)
+		}
+	}
 
 	return c
 }
@@ -161,13 +277,37 @@ func (c *VideoPlayerController) Buffered() time.Duration {
 // Load loads a new media URL, replacing the current media item.
 // Call [VideoPlayerController.Play] to start playback.
 func (c *VideoPlayerController) Load(url string) error {
+	return c.LoadWithOptions(url, VideoLoadOptions{})
+}
+
+// LoadWithOptions loads a new media URL like [VideoPlayerController.Load],
+// with options such as DRM license acquisition for protected media:
+//
+//	err := s.video.LoadWithOptions(manifestURL, platform.VideoLoadOptions{
+//	    DRM: &platform.DRMConfiguration{
+//	        Scheme:     platform.DRMWidevine,
+//	        LicenseURL: "https://license.example.com/widevine",
+//	        Headers:    map[string]string{"Authorization": "Bearer " + token},
+//	    },
+//	})
+//
+// An invalid DRM configuration is returned as an error. License failures
+// during playback are reported to [VideoPlayerController.OnError] with
+// [ErrCodeDRMError], including a scheme the platform does not support.
+func (c *VideoPlayerController) LoadWithOptions(url string, opts VideoLoadOptions) error {
 	c.mu.RLock()
 	v := c.view
 	c.mu.RUnlock()
 	if v == nil {
 		return ErrDisposed
 	}
-	return v.Load(url)
+	if err := v.Load(url, opts); err != nil {
+		return err
+	}
+	c.mu.Lock()
+	c.url = url
+	c.mu.Unlock()
+	return nil
 }
 
 // Play starts or resumes playback. Call [VideoPlayerController.Load] first
@@ -263,6 +403,204 @@ func (c *VideoPlayerController) SetShowControls(show bool) error {
 	return v.SetShowControls(show)
 }
 
+// SubtitleTracks returns the available subtitle tracks: those embedded in
+// the loaded media, followed by those added with
+// [VideoPlayerController.AddSubtitleTrack]. Embedded tracks are known
+// shortly after Load; [VideoPlayerController.OnSubtitleTracksChanged]
+// reports them.
+func (c *VideoPlayerController) SubtitleTracks() []SubtitleTrack {
+	c.mu.RLock()
+	v := c.view
+	c.mu.RUnlock()
+	if v != nil {
+		return v.SubtitleTracks()
+	}
+	return nil
+}
+
+// AddSubtitleTrack adds an external WebVTT or SRT subtitle track to the
+// loaded media, parsed with [ParseSubtitles]. Label and language describe
+// the track for track pickers. Call it after Load, which clears external
+// tracks; the track is not shown until selected:
+//
+//	track, err := s.video.AddSubtitleTrack("English", "en", vtt)
+//	if err == nil {
+//	    s.video.SelectSubtitleTrack(track.ID)
+//	}
+//
+// External cues are timed against the position updates the player sends
+// while playing, so they may appear up to a quarter of a second late.
+func (c *VideoPlayerController) AddSubtitleTrack(label, language string, data []byte) (SubtitleTrack, error) {
+	c.mu.RLock()
+	v := c.view
+	c.mu.RUnlock()
+	if v == nil {
+		return SubtitleTrack{}, ErrDisposed
+	}
+	cues, err := ParseSubtitles(data)
+	if err != nil {
+		return SubtitleTrack{}, err
+	}
+	return v.AddSubtitleTrack(label, language, cues), nil
+}
+
+// SelectSubtitleTrack shows the subtitle track with the given ID, or turns
+// subtitles off if id is empty. Captions are not drawn by the native
+// player; show them with [widgets.VideoCaptions] or
+// [VideoPlayerController.OnCueChanged].
+func (c *VideoPlayerController) SelectSubtitleTrack(id string) error {
+	c.mu.RLock()
+	v := c.view
+	c.mu.RUnlock()
+	if v == nil {
+		return ErrDisposed
+	}
+	return v.SelectSubtitleTrack(id)
+}
+
+// SelectedSubtitleTrack returns the ID of the selected subtitle track, or
+// "" if subtitles are off.
+func (c *VideoPlayerController) SelectedSubtitleTrack() string {
+	c.mu.RLock()
+	v := c.view
+	c.mu.RUnlock()
+	if v != nil {
+		return v.SelectedSubtitleTrack()
+	}
+	return ""
+}
+
+// Cues returns the subtitle cues currently showing.
+func (c *VideoPlayerController) Cues() []SubtitleCue {
+	c.mu.RLock()
+	v := c.view
+	c.mu.RUnlock()
+	if v != nil {
+		return v.Cues()
+	}
+	return nil
+}
+
+// AddCueListener adds a callback that fires on the UI thread when the
+// showing subtitle cues change, alongside
+// [VideoPlayerController.OnCueChanged]. Read them with
+// [VideoPlayerController.Cues]. Returns an unsubscribe function.
+func (c *VideoPlayerController) AddCueListener(fn func()) func() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	id := c.nextListenerID
+	c.nextListenerID++
+	c.cueListeners[id] = fn
+	return func() {
+		c.mu.Lock()
+		delete(c.cueListeners, id)
+		c.mu.Unlock()
+	}
+}
+
+// Qualities returns the renditions of the loaded adaptive (HLS or DASH)
+// media, ordered from lowest to highest bitrate. Empty for progressive
+// media such as MP4 files. Renditions are known shortly after Load;
+// [VideoPlayerController.OnQualitiesChanged] reports them.
+func (c *VideoPlayerController) Qualities() []VideoQuality {
+	c.mu.RLock()
+	v := c.view
+	c.mu.RUnlock()
+	if v != nil {
+		return v.Qualities()
+	}
+	return nil
+}
+
+// SelectQuality pins playback to the rendition with the given ID, or
+// returns to automatic selection if id is empty. Load returns to automatic
+// selection.
+//
+// AVPlayer cannot force a rendition, so on iOS a pinned rendition caps the
+// bitrate and resolution instead, and a lower one may play when bandwidth
+// is short.
+func (c *VideoPlayerController) SelectQuality(id string) error {
+	c.mu.RLock()
+	v := c.view
+	c.mu.RUnlock()
+	if v == nil {
+		return ErrDisposed
+	}
+	return v.SelectQuality(id)
+}
+
+// SelectedQuality returns the ID of the pinned rendition, or "" if quality
+// is selected automatically.
+func (c *VideoPlayerController) SelectedQuality() string {
+	c.mu.RLock()
+	v := c.view
+	c.mu.RUnlock()
+	if v != nil {
+		return v.SelectedQuality()
+	}
+	return ""
+}
+
+// CurrentQuality returns the rendition being played, including its
+// bitrate, or the zero value before playback starts.
+func (c *VideoPlayerController) CurrentQuality() VideoQuality {
+	c.mu.RLock()
+	v := c.view
+	c.mu.RUnlock()
+	if v != nil {
+		return v.CurrentQuality()
+	}
+	return VideoQuality{}
+}
+
+// SetQualityLimit caps the renditions picked while quality is selected
+// automatically; the zero value removes the cap. The limit applies to
+// media loaded later too. It does not restrict [VideoPlayerController.SelectQuality].
+//
+// On iOS, AVPlayer treats the limit as a preference and may briefly exceed
+// it, for example at startup.
+func (c *VideoPlayerController) SetQualityLimit(limit VideoQualityLimit) error {
+	c.mu.RLock()
+	v := c.view
+	c.mu.RUnlock()
+	if v == nil {
+		return ErrDisposed
+	}
+	return v.SetQualityLimit(limit)
+}
+
+// IsCasting reports whether playback is on a cast receiver rather than
+// the device.
+func (c *VideoPlayerController) IsCasting() bool {
+	c.mu.RLock()
+	v := c.view
+	c.mu.RUnlock()
+	if v != nil {
+		casting, _ := v.Casting()
+		return casting
+	}
+	return false
+}
+
+// CastDeviceName returns the name of the receiver playback is cast to, or
+// "" if it is not cast.
+func (c *VideoPlayerController) CastDeviceName() string {
+	c.mu.RLock()
+	v := c.view
+	c.mu.RUnlock()
+	if v != nil {
+		_, name := v.Casting()
+		return name
+	}
+	return ""
+}
+
+// SetInterruptionPolicy sets what the player does when another audio
+// source interrupts it. The default is [InterruptionPauseAndResume].
+func (c *VideoPlayerController) SetInterruptionPolicy(policy InterruptionPolicy) {
+	c.interruptions.setPolicy(policy)
+}
+
 // Dispose releases the video player and its native resources. After disposal,
 // this controller must not be reused. Dispose is idempotent; calling it more
 // than once is safe.
@@ -271,6 +609,7 @@ func (c *VideoPlayerController) Dispose() {
 	id := c.viewID
 	c.view = nil
 	c.viewID = 0
+	clear(c.cueListeners)
 	c.mu.Unlock()
 	if id != 0 {
 		GetPlatformViewRegistry().Dispose(id)
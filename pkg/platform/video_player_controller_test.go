@@ -163,6 +163,55 @@ func TestVideoPlayerController_ErrorCallback(t *testing.T) {
 	}
 }
 
+func TestVideoPlayerController_CastingCallback(t *testing.T) {
+	setupTestBridge(t)
+
+	c := NewVideoPlayerController()
+	defer c.Dispose()
+
+	type change struct {
+		casting bool
+		device  string
+	}
+	var got []change
+	c.OnCastingChanged = func(casting bool, deviceName string) {
+		got = append(got, change{casting, deviceName})
+	}
+
+	sendVideoViewEvent(t, "onCastingChanged", map[string]any{
+		"viewId":     c.ViewID(),
+		"casting":    true,
+		"deviceName": "Living Room",
+	})
+	if !c.IsCasting() || c.CastDeviceName() != "Living Room" {
+		t.Errorf("after cast: IsCasting %v, CastDeviceName %q", c.IsCasting(), c.CastDeviceName())
+	}
+
+	// A repeated event does not call back again.
+	sendVideoViewEvent(t, "onCastingChanged", map[string]any{
+		"viewId":     c.ViewID(),
+		"casting":    true,
+		"deviceName": "Living Room",
+	})
+	sendVideoViewEvent(t, "onCastingChanged", map[string]any{
+		"viewId":  c.ViewID(),
+		"casting": false,
+	})
+	if c.IsCasting() || c.CastDeviceName() != "" {
+		t.Errorf("after disconnect: IsCasting %v, CastDeviceName %q", c.IsCasting(), c.CastDeviceName())
+	}
+
+	want := []change{{true, "Living Room"}, {false, ""}}
+	if len(got) != len(want) {
+		t.Fatalf("callbacks: got %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("callback %d: got %v, want %v", i, got[i], want[i])
+		}
+	}
+}
+
 func TestVideoPlayerController_NilCallbacksDoNotPanic(t *testing.T) {
 	setupTestBridge(t)
 
@@ -237,6 +286,8 @@ func TestVideoPlayerController_TransportMethods(t *testing.T) {
 		{"SetLooping", func() error { return c.SetLooping(true) }},
 		{"SetPlaybackSpeed", func() error { return c.SetPlaybackSpeed(1.5) }},
 		{"SetShowControls", func() error { return c.SetShowControls(false) }},
+		{"SelectQuality", func() error { return c.SelectQuality("") }},
+		{"SetQualityLimit", func() error { return c.SetQualityLimit(VideoQualityLimit{}) }},
 		{"Stop", func() error { return c.Stop() }},
 	} {
 		if err := tc.fn(); err != nil {
@@ -413,6 +464,7 @@ func TestVideoPlayerController_MethodsReturnErrDisposedAfterDispose(t *testing.T
 		{"SetLooping", func() error { return c.SetLooping(true) }},
 		{"SetPlaybackSpeed", func() error { return c.SetPlaybackSpeed(1.5) }},
 		{"SetShowControls", func() error { return c.SetShowControls(false) }},
+		{"FrameAt", func() error { _, err := c.FrameAt(time.Second, FrameOptions{}); return err }},
 	} {
 		if err := tc.fn(); err != ErrDisposed {
 			t.Errorf("%s after Dispose: got %v, want ErrDisposed", tc.name, err)
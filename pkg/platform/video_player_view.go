@@ -1,6 +1,9 @@
 package platform
 
 import (
+	"fmt"
+	"slices"
+	"strconv"
 	"sync"
 	"time"
 )
@@ -23,6 +26,28 @@ type videoPlayerView struct {
 	duration time.Duration
 	buffered time.Duration
 
+	// Cached subtitle state. Embedded tracks are reported by native;
+	// external tracks and their cues are kept here.
+	embeddedTracks   []SubtitleTrack
+	externalTracks   []externalSubtitleTrack
+	nextExternalID   int
+	selectedSubtitle string
+	cues             []SubtitleCue
+
+	// Cached cast state, reported by native when playback moves to or
+	// from a cast receiver.
+	casting    bool
+	castDevice string
+
+	// Cached adaptive quality state. selectedQuality is "" in auto mode.
+	qualities       []VideoQuality
+	selectedQuality string
+	currentQuality  VideoQuality
+
+	// textureFallback is set when native may render into a SurfaceView,
+	// which falls back to a TextureView while the view is transformed.
+	textureFallback bool
+
 	// OnPlaybackStateChanged is called when the playback state changes.
 	// Called on the UI thread via [Dispatch].
 	// Set this before calling any playback method to avoid missing events.
@@ -39,6 +64,30 @@ type videoPlayerView struct {
 	// Called on the UI thread via [Dispatch].
 	// Set this before calling any playback method to avoid missing events.
 	OnError func(code, message string)
+
+	// OnSubtitleTracksChanged is called when the available subtitle tracks
+	// change. Called on the UI thread via [Dispatch].
+	OnSubtitleTracksChanged func([]SubtitleTrack)
+
+	// OnCueChanged is called when the showing subtitle cues change.
+	// Called on the UI thread via [Dispatch].
+	OnCueChanged func([]SubtitleCue)
+
+	// OnCastingChanged is called when playback moves to or from a cast
+	// receiver. Called on the UI thread via [Dispatch].
+	OnCastingChanged func(casting bool, deviceName string)
+
+	// OnQualitiesChanged is called when the available renditions change.
+	// Called on the UI thread via [Dispatch].
+	OnQualitiesChanged func([]VideoQuality)
+
+	// OnQualityChanged is called when the player switches rendition.
+	// Called on the UI thread via [Dispatch].
+	OnQualityChanged func(VideoQuality)
+
+	// OnInterruption is called when an audio interruption begins or ends.
+	// Called on the UI thread via [Dispatch].
+	OnInterruption func(MediaInterruption)
 }
 
 // newVideoPlayerView creates a new video player platform view with the given
@@ -87,6 +136,10 @@ func (v *videoPlayerView) SeekTo(position time.Duration) error {
 	_, err := GetPlatformViewRegistry().InvokeViewMethod(v.viewID, "seekTo", map[string]any{
 		"positionMs": position.Milliseconds(),
 	})
+	if err == nil {
+		// Position updates stop while paused, so show the target's cues now.
+		v.updateExternalCues(position)
+	}
 	return err
 }
 
@@ -122,12 +175,235 @@ func (v *videoPlayerView) SetShowControls(show bool) error {
 	return err
 }
 
-// Load loads a new media URL, replacing the current media item.
-// The native player prepares the new URL immediately. If looping was
+// Load loads a new media URL, replacing the current media item, with the
+// given options. The native player prepares the new URL immediately. If looping was
 // enabled, it remains active for the new item.
-func (v *videoPlayerView) Load(url string) error {
-	_, err := GetPlatformViewRegistry().InvokeViewMethod(v.viewID, "load", map[string]any{
+func (v *videoPlayerView) Load(url string, opts VideoLoadOptions) error {
+	args := map[string]any{
 		"url": url,
+	}
+	if opts.DRM != nil {
+		if err := opts.DRM.validate(); err != nil {
+			return err
+		}
+		args["drm"] = opts.DRM.args()
+	}
+	_, err := GetPlatformViewRegistry().InvokeViewMethod(v.viewID, "load", args)
+	if err != nil {
+		return err
+	}
+	// Subtitle tracks belong to the media item. Native reports the new
+	// item's embedded tracks once they are known.
+	v.mu.Lock()
+	hadTracks := len(v.embeddedTracks) > 0 || len(v.externalTracks) > 0
+	v.embeddedTracks = nil
+	v.externalTracks = nil
+	v.selectedSubtitle = ""
+	tracksCB := v.OnSubtitleTracksChanged
+	// So do renditions; a quality limit applies to every item.
+	hadQualities := len(v.qualities) > 0
+	v.qualities = nil
+	v.selectedQuality = ""
+	v.currentQuality = VideoQuality{}
+	qualitiesCB := v.OnQualitiesChanged
+	v.mu.Unlock()
+	if hadTracks && tracksCB != nil {
+		Dispatch(func() {
+			tracksCB(nil)
+		})
+	}
+	if hadQualities && qualitiesCB != nil {
+		Dispatch(func() {
+			qualitiesCB(nil)
+		})
+	}
+	v.setCues(nil)
+	return nil
+}
+
+// SubtitleTracks returns the embedded tracks followed by the external ones.
+func (v *videoPlayerView) SubtitleTracks() []SubtitleTrack {
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+	return v.subtitleTracksLocked()
+}
+
+func (v *videoPlayerView) subtitleTracksLocked() []SubtitleTrack {
+	tracks := slices.Clone(v.embeddedTracks)
+	for _, t := range v.externalTracks {
+		tracks = append(tracks, t.track)
+	}
+	return tracks
+}
+
+// SelectedSubtitleTrack returns the ID of the showing track, or "" if
+// subtitles are off.
+func (v *videoPlayerView) SelectedSubtitleTrack() string {
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+	return v.selectedSubtitle
+}
+
+// Cues returns the showing subtitle cues.
+func (v *videoPlayerView) Cues() []SubtitleCue {
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+	return slices.Clone(v.cues)
+}
+
+// AddSubtitleTrack adds an external track with the given cues.
+func (v *videoPlayerView) AddSubtitleTrack(label, language string, cues []SubtitleCue) SubtitleTrack {
+	v.mu.Lock()
+	v.nextExternalID++
+	track := SubtitleTrack{
+		ID:       "external:" + strconv.Itoa(v.nextExternalID),
+		Label:    label,
+		Language: language,
+		External: true,
+	}
+	v.externalTracks = append(v.externalTracks, externalSubtitleTrack{track: track, cues: cues})
+	tracks := v.subtitleTracksLocked()
+	cb := v.OnSubtitleTracksChanged
+	v.mu.Unlock()
+
+	if cb != nil {
+		Dispatch(func() {
+			cb(tracks)
+		})
+	}
+	return track
+}
+
+// SelectSubtitleTrack shows the track with the given ID, or turns
+// subtitles off if id is empty.
+func (v *videoPlayerView) SelectSubtitleTrack(id string) error {
+	v.mu.RLock()
+	external := v.externalTrackLocked(id) != nil
+	known := id == "" || external || slices.ContainsFunc(v.embeddedTracks, func(t SubtitleTrack) bool {
+		return t.ID == id
+	})
+	v.mu.RUnlock()
+	if !known {
+		return fmt.Errorf("video: unknown subtitle track %q", id)
+	}
+
+	// External cues are timed here, so native renders no embedded track.
+	nativeID := id
+	if external {
+		nativeID = ""
+	}
+	if _, err := GetPlatformViewRegistry().InvokeViewMethod(v.viewID, "selectSubtitleTrack", map[string]any{
+		"id": nativeID,
+	}); err != nil {
+		return err
+	}
+
+	v.mu.Lock()
+	v.selectedSubtitle = id
+	position := v.position
+	v.mu.Unlock()
+	if external {
+		v.updateExternalCues(position)
+	} else {
+		// Native reports the embedded track's cues as they appear.
+		v.setCues(nil)
+	}
+	return nil
+}
+
+func (v *videoPlayerView) externalTrackLocked(id string) *externalSubtitleTrack {
+	for i := range v.externalTracks {
+		if v.externalTracks[i].track.ID == id {
+			return &v.externalTracks[i]
+		}
+	}
+	return nil
+}
+
+// updateExternalCues shows the cues of the selected external track at
+// position. It does nothing if an embedded track or no track is selected.
+func (v *videoPlayerView) updateExternalCues(position time.Duration) {
+	v.mu.RLock()
+	track := v.externalTrackLocked(v.selectedSubtitle)
+	var cues []SubtitleCue
+	if track != nil {
+		cues = activeCues(track.cues, position)
+	}
+	v.mu.RUnlock()
+	if track != nil {
+		v.setCues(cues)
+	}
+}
+
+// setCues caches the showing cues and notifies OnCueChanged if they changed.
+func (v *videoPlayerView) setCues(cues []SubtitleCue) {
+	v.mu.Lock()
+	if slices.Equal(v.cues, cues) {
+		v.mu.Unlock()
+		return
+	}
+	v.cues = cues
+	cb := v.OnCueChanged
+	v.mu.Unlock()
+
+	if cb != nil {
+		Dispatch(func() {
+			cb(slices.Clone(cues))
+		})
+	}
+}
+
+// Qualities returns the available renditions.
+func (v *videoPlayerView) Qualities() []VideoQuality {
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+	return slices.Clone(v.qualities)
+}
+
+// SelectedQuality returns the ID of the pinned rendition, or "" in auto mode.
+func (v *videoPlayerView) SelectedQuality() string {
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+	return v.selectedQuality
+}
+
+// CurrentQuality returns the rendition being played.
+func (v *videoPlayerView) CurrentQuality() VideoQuality {
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+	return v.currentQuality
+}
+
+// SelectQuality pins the rendition with the given ID, or returns to
+// automatic selection if id is empty.
+func (v *videoPlayerView) SelectQuality(id string) error {
+	v.mu.RLock()
+	known := id == "" || slices.ContainsFunc(v.qualities, func(q VideoQuality) bool {
+		return q.ID == id
+	})
+	v.mu.RUnlock()
+	if !known {
+		return fmt.Errorf("video: unknown quality %q", id)
+	}
+	if _, err := GetPlatformViewRegistry().InvokeViewMethod(v.viewID, "selectQuality", map[string]any{
+		"id": id,
+	}); err != nil {
+		return err
+	}
+	v.mu.Lock()
+	v.selectedQuality = id
+	v.mu.Unlock()
+	return nil
+}
+
+// SetQualityLimit caps automatic rendition selection.
+func (v *videoPlayerView) SetQualityLimit(limit VideoQualityLimit) error {
+	if err := limit.validate(); err != nil {
+		return err
+	}
+	_, err := GetPlatformViewRegistry().InvokeViewMethod(v.viewID, "setQualityLimit", map[string]any{
+		"maxHeight":  limit.MaxHeight,
+		"maxBitrate": limit.MaxBitrate,
 	})
 	return err
 }
@@ -160,6 +436,13 @@ func (v *videoPlayerView) Buffered() time.Duration {
 	return v.buffered
 }
 
+// Casting reports whether playback is on a cast receiver, and its name.
+func (v *videoPlayerView) Casting() (bool, string) {
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+	return v.casting, v.castDevice
+}
+
 // handlePlaybackStateChanged processes state change events from native.
 func (v *videoPlayerView) handlePlaybackStateChanged(state PlaybackState) {
 	v.mu.Lock()
@@ -189,6 +472,7 @@ func (v *videoPlayerView) handlePositionChanged(position, duration, buffered tim
 			cb(position, duration, buffered)
 		})
 	}
+	v.updateExternalCues(position)
 }
 
 // handleError processes error events from native.
@@ -204,6 +488,109 @@ func (v *videoPlayerView) handleError(code string, message string) {
 	}
 }
 
+// handleSubtitleTracksChanged processes embedded track updates from native.
+// selected is the embedded track native is showing, or "" if none.
+func (v *videoPlayerView) handleSubtitleTracksChanged(tracks []SubtitleTrack, selected string) {
+	v.mu.Lock()
+	v.embeddedTracks = tracks
+	if v.externalTrackLocked(v.selectedSubtitle) == nil {
+		v.selectedSubtitle = selected
+	}
+	all := v.subtitleTracksLocked()
+	cb := v.OnSubtitleTracksChanged
+	v.mu.Unlock()
+
+	if cb != nil {
+		Dispatch(func() {
+			cb(all)
+		})
+	}
+}
+
+// handleCuesChanged processes embedded track cue updates from native.
+func (v *videoPlayerView) handleCuesChanged(cues []SubtitleCue) {
+	v.mu.RLock()
+	external := v.externalTrackLocked(v.selectedSubtitle) != nil
+	v.mu.RUnlock()
+	if !external {
+		v.setCues(cues)
+	}
+}
+
+// handleCastingChanged processes cast routing changes from native.
+func (v *videoPlayerView) handleCastingChanged(casting bool, deviceName string) {
+	if !casting {
+		deviceName = ""
+	}
+	v.mu.Lock()
+	changed := casting != v.casting || deviceName != v.castDevice
+	v.casting = casting
+	v.castDevice = deviceName
+	cb := v.OnCastingChanged
+	v.mu.Unlock()
+
+	if changed && cb != nil {
+		Dispatch(func() {
+			cb(casting, deviceName)
+		})
+	}
+}
+
+// handleQualitiesChanged processes rendition updates from native.
+// selected is the pinned rendition, or "" in auto mode.
+func (v *videoPlayerView) handleQualitiesChanged(qualities []VideoQuality, selected string) {
+	v.mu.Lock()
+	v.qualities = qualities
+	v.selectedQuality = selected
+	cb := v.OnQualitiesChanged
+	v.mu.Unlock()
+
+	if cb != nil {
+		Dispatch(func() {
+			cb(slices.Clone(qualities))
+		})
+	}
+}
+
+// handleQualityChanged processes rendition switches from native.
+func (v *videoPlayerView) handleQualityChanged(quality VideoQuality) {
+	v.mu.Lock()
+	changed := quality != v.currentQuality
+	v.currentQuality = quality
+	cb := v.OnQualityChanged
+	v.mu.Unlock()
+
+	if changed && cb != nil {
+		Dispatch(func() {
+			cb(quality)
+		})
+	}
+}
+
+// handleInterruption processes audio interruptions from native.
+func (v *videoPlayerView) handleInterruption(interruption MediaInterruption) {
+	v.mu.RLock()
+	cb := v.OnInterruption
+	v.mu.RUnlock()
+
+	if cb != nil {
+		Dispatch(func() {
+			cb(interruption)
+		})
+	}
+}
+
+// setTransformed switches native rendering to a TextureView while the view
+// is painted scaled, rotated or translucent, if it uses a SurfaceView.
+func (v *videoPlayerView) setTransformed(transformed bool) {
+	if !v.textureFallback {
+		return
+	}
+	GetPlatformViewRegistry().InvokeViewMethod(v.viewID, "setTextureFallback", map[string]any{
+		"active": transformed,
+	})
+}
+
 // videoPlayerViewFactory creates video player platform views.
 type videoPlayerViewFactory struct{}
 
@@ -212,7 +599,14 @@ func (f *videoPlayerViewFactory) ViewType() string {
 }
 
 func (f *videoPlayerViewFactory) Create(viewID int64, params map[string]any) (PlatformView, error) {
-	return newVideoPlayerView(viewID), nil
+	v := newVideoPlayerView(viewID)
+	switch params["surfaceMode"] {
+	case SurfaceModeSurface.String():
+		v.textureFallback = true
+	case SurfaceModeAuto.String():
+		v.textureFallback, _ = params["preserveHDR"].(bool)
+	}
+	return v, nil
 }
 
 func init() {
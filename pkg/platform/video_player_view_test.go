@@ -1,6 +1,7 @@
 package platform
 
 import (
+	"slices"
 	"testing"
 	"time"
 )
@@ -114,7 +115,7 @@ func TestVideoPlayerView_TransportMethods(t *testing.T) {
 		name string
 		fn   func() error
 	}{
-		{"Load", func() error { return v.Load("https://example.com/video.mp4") }},
+		{"Load", func() error { return v.Load("https://example.com/video.mp4", VideoLoadOptions{}) }},
 		{"Play", func() error { return v.Play() }},
 		{"Pause", func() error { return v.Pause() }},
 		{"SeekTo", func() error { return v.SeekTo(30 * time.Second) }},
@@ -148,3 +149,58 @@ func TestVideoPlayerView_StateGetters_DefaultValues(t *testing.T) {
 		t.Error("initial Buffered() should be 0")
 	}
 }
+
+func TestVideoPlayerController_SurfaceModeTextureFallback(t *testing.T) {
+	tests := []struct {
+		name string
+		opts VideoPlayerOptions
+		want []any
+	}{
+		{"auto", VideoPlayerOptions{}, nil},
+		{"auto with HDR", VideoPlayerOptions{PreserveHDR: true}, []any{true, false}},
+		{"texture", VideoPlayerOptions{SurfaceMode: SurfaceModeTexture, PreserveHDR: true}, nil},
+		{"surface", VideoPlayerOptions{SurfaceMode: SurfaceModeSurface}, []any{true, false}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bridge := setupTestBridge(t)
+			c := NewVideoPlayerControllerWithOptions(tt.opts)
+			defer c.Dispose()
+			bridge.reset()
+
+			registry := GetPlatformViewRegistry()
+			for _, transformed := range []bool{true, true, false, false} {
+				registry.SetViewTransformed(c.ViewID(), transformed)
+			}
+
+			var got []any
+			bridge.mu.Lock()
+			for _, call := range bridge.calls {
+				if args, ok := call.args.(map[string]any); ok && args["method"] == "setTextureFallback" {
+					got = append(got, args["active"])
+				}
+			}
+			bridge.mu.Unlock()
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("setTextureFallback calls: got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSurfaceMode_String(t *testing.T) {
+	tests := []struct {
+		mode SurfaceMode
+		want string
+	}{
+		{SurfaceModeAuto, "auto"},
+		{SurfaceModeTexture, "texture"},
+		{SurfaceModeSurface, "surface"},
+		{SurfaceMode(99), "unknown"},
+	}
+	for _, tt := range tests {
+		if got := tt.mode.String(); got != tt.want {
+			t.Errorf("%d: got %q, want %q", tt.mode, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package platform
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// VideoQuality describes a rendition of adaptive (HLS or DASH) media, as
+// reported by [VideoPlayerController.Qualities].
+type VideoQuality struct {
+	// ID identifies the rendition for [VideoPlayerController.SelectQuality].
+	ID string
+
+	// Width and Height are the rendition's frame size in pixels. Zero if the
+	// media does not declare them, as for audio-only renditions.
+	Width  int
+	Height int
+
+	// Bitrate is the rendition's peak bitrate in bits per second, or 0 if
+	// the media does not declare it.
+	Bitrate int
+}
+
+// Label returns a short display label for the rendition, such as "720p",
+// falling back to its bitrate, such as "1.5 Mbps".
+func (q VideoQuality) Label() string {
+	switch {
+	case q.Height > 0:
+		return strconv.Itoa(q.Height) + "p"
+	case q.Bitrate >= 1_000_000:
+		return strconv.FormatFloat(float64(q.Bitrate)/1_000_000, 'f', 1, 64) + " Mbps"
+	case q.Bitrate > 0:
+		return strconv.Itoa(q.Bitrate/1000) + " kbps"
+	default:
+		return "Unknown"
+	}
+}
+
+// VideoQualityLimit caps the renditions the player picks while selecting
+// quality automatically. Zero fields are unlimited.
+//
+// A typical use is limiting resolution on metered networks:
+//
+//	s.video.SetQualityLimit(platform.VideoQualityLimit{MaxHeight: 720})
+type VideoQualityLimit struct {
+	// MaxHeight is the largest frame height, in pixels, to pick.
+	MaxHeight int
+
+	// MaxBitrate is the largest peak bitrate, in bits per second, to pick.
+	MaxBitrate int
+}
+
+// parseVideoQuality decodes a rendition reported by native.
+func parseVideoQuality(op string, raw any) (VideoQuality, error) {
+	m, err := requireMap(op, raw)
+	if err != nil {
+		return VideoQuality{}, err
+	}
+	id, _ := m["id"].(string)
+	width, _ := toInt64(m["width"])
+	height, _ := toInt64(m["height"])
+	bitrate, _ := toInt64(m["bitrate"])
+	return VideoQuality{ID: id, Width: int(width), Height: int(height), Bitrate: int(bitrate)}, nil
+}
+
+// validate reports an error for negative limits.
+func (l VideoQualityLimit) validate() error {
+	if l.MaxHeight < 0 || l.MaxBitrate < 0 {
+		return fmt.Errorf("video: negative quality limit %+v", l)
+	}
+	return nil
+}
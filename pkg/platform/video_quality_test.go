@@ -0,0 +1,93 @@
+package platform
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestVideoQuality_Label(t *testing.T) {
+	for _, tc := range []struct {
+		quality VideoQuality
+		want    string
+	}{
+		{VideoQuality{Width: 1280, Height: 720, Bitrate: 2_500_000}, "720p"},
+		{VideoQuality{Bitrate: 1_500_000}, "1.5 Mbps"},
+		{VideoQuality{Bitrate: 96_000}, "96 kbps"},
+		{VideoQuality{}, "Unknown"},
+	} {
+		if got := tc.quality.Label(); got != tc.want {
+			t.Errorf("Label(%+v): got %q, want %q", tc.quality, got, tc.want)
+		}
+	}
+}
+
+func TestVideoPlayerController_Qualities(t *testing.T) {
+	bridge := setupTestBridge(t)
+
+	c := NewVideoPlayerController()
+	defer c.Dispose()
+
+	var reported []VideoQuality
+	c.OnQualitiesChanged = func(q []VideoQuality) { reported = q }
+	var switches []VideoQuality
+	c.OnQualityChanged = func(q VideoQuality) { switches = append(switches, q) }
+
+	sendVideoViewEvent(t, "onQualitiesChanged", map[string]any{
+		"viewId": c.ViewID(),
+		"qualities": []any{
+			map[string]any{"id": "0:0", "width": 640, "height": 360, "bitrate": 800_000},
+			map[string]any{"id": "0:1", "width": 1280, "height": 720, "bitrate": 2_500_000},
+		},
+		"selected": "",
+	})
+	want := []VideoQuality{
+		{ID: "0:0", Width: 640, Height: 360, Bitrate: 800_000},
+		{ID: "0:1", Width: 1280, Height: 720, Bitrate: 2_500_000},
+	}
+	if !slices.Equal(c.Qualities(), want) || !slices.Equal(reported, want) {
+		t.Fatalf("qualities: got %+v, reported %+v, want %+v", c.Qualities(), reported, want)
+	}
+
+	// A repeated switch event does not call back again.
+	for range 2 {
+		sendVideoViewEvent(t, "onQualityChanged", map[string]any{
+			"viewId": c.ViewID(), "id": "0:1", "width": 1280, "height": 720, "bitrate": 2_500_000,
+		})
+	}
+	if len(switches) != 1 || switches[0] != want[1] || c.CurrentQuality() != want[1] {
+		t.Errorf("switches: got %+v, current %+v", switches, c.CurrentQuality())
+	}
+
+	bridge.reset()
+	if err := c.SelectQuality("0:0"); err != nil {
+		t.Fatalf("SelectQuality: %v", err)
+	}
+	if args, _ := bridge.calls[0].args.(map[string]any); args["method"] != "selectQuality" || args["id"] != "0:0" {
+		t.Errorf("native call args: got %+v, want selectQuality 0:0", args)
+	}
+	if got := c.SelectedQuality(); got != "0:0" {
+		t.Errorf("selected: got %q, want 0:0", got)
+	}
+	if err := c.SelectQuality("9:9"); err == nil {
+		t.Error("expected error selecting unknown quality")
+	}
+
+	bridge.reset()
+	if err := c.SetQualityLimit(VideoQualityLimit{MaxHeight: 720}); err != nil {
+		t.Fatalf("SetQualityLimit: %v", err)
+	}
+	if args, _ := bridge.calls[0].args.(map[string]any); args["method"] != "setQualityLimit" {
+		t.Errorf("native call args: got %+v, want setQualityLimit", args)
+	}
+	if err := c.SetQualityLimit(VideoQualityLimit{MaxBitrate: -1}); err == nil {
+		t.Error("expected error for a negative limit")
+	}
+
+	// Loading new media clears the renditions and returns to auto mode.
+	if err := c.Load("https://example.com/next.m3u8"); err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if len(c.Qualities()) != 0 || c.SelectedQuality() != "" || reported != nil || c.CurrentQuality() != (VideoQuality{}) {
+		t.Errorf("after Load: qualities %+v, selected %q, current %+v", c.Qualities(), c.SelectedQuality(), c.CurrentQuality())
+	}
+}
@@ -0,0 +1,263 @@
+package responsive
+
+import (
+	"fmt"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/layout"
+	"github.com/go-drift/drift/pkg/semantics"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+// AdaptiveScaffold lays out top-level navigation to suit the available
+// width:
+//
+//   - compact: the body above a bottom navigation bar
+//   - medium: a navigation rail beside the body
+//   - expanded and wider: a permanent navigation drawer beside the body
+//
+// Move the switch points with Breakpoints. The scaffold also acts as a
+// [WindowSizeClassProvider], so the body can read [WindowSizeClassOf].
+//
+// # Styling Model
+//
+// AdaptiveScaffold is explicit — zero visual values mean zero. Use
+// [theme.AdaptiveScaffoldOf] for theme-styled navigation:
+//
+//	theme.AdaptiveScaffoldOf(ctx, []widgets.TabItem{
+//	    {Label: "Inbox", Icon: inboxIcon},
+//	    {Label: "Settings", Icon: settingsIcon},
+//	}, s.selected, func(i int) {
+//	    s.SetState(func() { s.selected = i })
+//	}, body)
+type AdaptiveScaffold struct {
+	core.StatelessBase
+
+	// Destinations are the top-level navigation entries.
+	Destinations []widgets.TabItem
+	// SelectedIndex is the index of the current destination.
+	SelectedIndex int
+	// OnSelected is called when a destination is tapped.
+	OnSelected func(index int)
+	// Body is the content for the current destination.
+	Body core.Widget
+	// Header is shown above the destinations in the rail and drawer, such as
+	// a logo or a compose button. Optional; not shown in the bottom bar.
+	Header core.Widget
+
+	// Breakpoints select the layout. Zero uses [DefaultBreakpoints].
+	Breakpoints Breakpoints
+
+	// BackgroundColor is the navigation surface color. Zero means transparent.
+	BackgroundColor graphics.Color
+	// ActiveColor is the selected destination's icon and label color.
+	ActiveColor graphics.Color
+	// InactiveColor is the color of other destinations.
+	InactiveColor graphics.Color
+	// IndicatorColor is the bottom bar's selection indicator color.
+	IndicatorColor graphics.Color
+	// IndicatorHeight is the bottom bar's indicator height. Zero means none.
+	IndicatorHeight float64
+	// SelectedBackgroundColor fills the pill behind the selected destination
+	// in the rail and drawer. Zero means transparent.
+	SelectedBackgroundColor graphics.Color
+	// DividerColor is the line between the rail or drawer and the body.
+	// Zero means no line.
+	DividerColor graphics.Color
+	// LabelStyle is the text style for destination labels.
+	LabelStyle graphics.TextStyle
+	// Padding is the padding around each destination.
+	Padding layout.EdgeInsets
+	// BottomBarHeight is the height of the bottom navigation bar.
+	BottomBarHeight float64
+	// RailWidth is the width of the navigation rail.
+	RailWidth float64
+	// DrawerWidth is the width of the permanent drawer.
+	DrawerWidth float64
+}
+
+func (s AdaptiveScaffold) Build(ctx core.BuildContext) core.Widget {
+	return widgets.LayoutBuilder{
+		Builder: func(ctx core.BuildContext, constraints layout.Constraints) core.Widget {
+			size := measure(constraints, s.Breakpoints)
+			return windowSizeData{size: size, child: s.buildLayout(size.Class)}
+		},
+	}
+}
+
+// buildLayout returns the navigation layout for a size class.
+func (s AdaptiveScaffold) buildLayout(class WindowSizeClass) core.Widget {
+	body := widgets.Expanded{Flex: 1, Child: s.Body}
+	switch class {
+	case WindowSizeCompact:
+		return widgets.Column{
+			MainAxisSize:       widgets.MainAxisSizeMax,
+			CrossAxisAlignment: widgets.CrossAxisAlignmentStretch,
+			Children: []core.Widget{
+				body,
+				widgets.TabBar{
+					Items:           s.Destinations,
+					CurrentIndex:    s.SelectedIndex,
+					OnTap:           s.OnSelected,
+					BackgroundColor: s.BackgroundColor,
+					ActiveColor:     s.ActiveColor,
+					InactiveColor:   s.InactiveColor,
+					IndicatorColor:  s.IndicatorColor,
+					IndicatorHeight: s.IndicatorHeight,
+					Padding:         s.Padding,
+					Height:          s.BottomBarHeight,
+					LabelStyle:      s.LabelStyle,
+				},
+			},
+		}
+	case WindowSizeMedium:
+		return s.side(s.RailWidth, s.railItem, body)
+	default:
+		return s.side(s.DrawerWidth, s.drawerItem, body)
+	}
+}
+
+// side places a vertical navigation pane of the given width beside body.
+func (s AdaptiveScaffold) side(width float64, item func(int, widgets.TabItem) core.Widget, body core.Widget) core.Widget {
+	items := make([]core.Widget, 0, len(s.Destinations)+1)
+	if s.Header != nil {
+		items = append(items, s.Header)
+	}
+	for i, d := range s.Destinations {
+		items = append(items, item(i, d))
+	}
+
+	children := []core.Widget{
+		widgets.Container{
+			Width: width,
+			Color: s.BackgroundColor,
+			Child: widgets.Column{
+				MainAxisAlignment:  widgets.MainAxisAlignmentStart,
+				CrossAxisAlignment: widgets.CrossAxisAlignmentStretch,
+				MainAxisSize:       widgets.MainAxisSizeMax,
+				Children:           items,
+			},
+		},
+	}
+	if s.DividerColor != 0 {
+		children = append(children, widgets.VerticalDivider{Width: 1, Thickness: 1, Color: s.DividerColor})
+	}
+	children = append(children, body)
+
+	return widgets.Row{
+		MainAxisSize:       widgets.MainAxisSizeMax,
+		CrossAxisAlignment: widgets.CrossAxisAlignmentStretch,
+		Children:           children,
+	}
+}
+
+// railItem builds a rail destination: the icon in a pill above its label.
+func (s AdaptiveScaffold) railItem(index int, d widgets.TabItem) core.Widget {
+	selected := index == s.SelectedIndex
+	color := s.colorFor(selected)
+
+	content := []core.Widget{}
+	if d.Icon != nil {
+		pill := graphics.ColorTransparent
+		if selected {
+			pill = s.SelectedBackgroundColor
+		}
+		content = append(content,
+			widgets.Container{
+				Color:        pill,
+				BorderRadius: 16,
+				Padding:      layout.EdgeInsetsSymmetric(16, 4),
+				Child:        tintIcon(d.Icon, color),
+			},
+			widgets.VSpace(4),
+		)
+	}
+	content = append(content, s.label(d.Label, color))
+
+	return s.destination(index, widgets.Container{
+		Padding:   s.Padding,
+		Alignment: layout.AlignmentCenter,
+		Child: widgets.Column{
+			MainAxisAlignment:  widgets.MainAxisAlignmentCenter,
+			CrossAxisAlignment: widgets.CrossAxisAlignmentCenter,
+			MainAxisSize:       widgets.MainAxisSizeMin,
+			Children:           content,
+		},
+	})
+}
+
+// drawerItem builds a drawer destination: a full-width row in a pill.
+func (s AdaptiveScaffold) drawerItem(index int, d widgets.TabItem) core.Widget {
+	selected := index == s.SelectedIndex
+	color := s.colorFor(selected)
+	background := graphics.ColorTransparent
+	if selected {
+		background = s.SelectedBackgroundColor
+	}
+
+	content := []core.Widget{}
+	if d.Icon != nil {
+		content = append(content, tintIcon(d.Icon, color), widgets.HSpace(12))
+	}
+	content = append(content, widgets.Expanded{Flex: 1, Child: s.label(d.Label, color)})
+
+	return widgets.Padding{
+		Padding: layout.EdgeInsetsSymmetric(12, 2),
+		Child: s.destination(index, widgets.Container{
+			Color:        background,
+			BorderRadius: 28,
+			Padding:      s.Padding,
+			Child: widgets.Row{
+				MainAxisAlignment:  widgets.MainAxisAlignmentStart,
+				CrossAxisAlignment: widgets.CrossAxisAlignmentCenter,
+				MainAxisSize:       widgets.MainAxisSizeMax,
+				Children:           content,
+			},
+		}),
+	}
+}
+
+// destination makes child a tappable, accessible navigation destination.
+func (s AdaptiveScaffold) destination(index int, child core.Widget) core.Widget {
+	var flags semantics.SemanticsFlag = semantics.SemanticsHasSelectedState
+	if index == s.SelectedIndex {
+		flags = flags.Set(semantics.SemanticsIsSelected)
+	}
+	onTap := func() {
+		if s.OnSelected != nil {
+			s.OnSelected(index)
+		}
+	}
+	return widgets.Semantics{
+		Hint:             fmt.Sprintf("Tab %d of %d", index+1, len(s.Destinations)),
+		Role:             semantics.SemanticsRoleTab,
+		Flags:            flags,
+		Container:        true,
+		MergeDescendants: true,
+		OnTap:            onTap,
+		Child:            widgets.GestureDetector{OnTap: onTap, Child: child},
+	}
+}
+
+func (s AdaptiveScaffold) label(text string, color graphics.Color) core.Widget {
+	style := s.LabelStyle
+	style.Color = color
+	return widgets.Text{Content: text, Style: style, MaxLines: 1}
+}
+
+func (s AdaptiveScaffold) colorFor(selected bool) graphics.Color {
+	if selected {
+		return s.ActiveColor
+	}
+	return s.InactiveColor
+}
+
+// tintIcon applies color to [widgets.Icon] destinations, matching TabBar.
+func tintIcon(icon core.Widget, color graphics.Color) core.Widget {
+	if i, ok := icon.(widgets.Icon); ok {
+		i.Color = color
+		return i
+	}
+	return icon
+}
@@ -0,0 +1,210 @@
+// Package responsive adapts layouts to the space available to them.
+//
+// Widths are grouped into window size classes using [Breakpoints]. Wrap a
+// subtree in [WindowSizeClassProvider] (or use [AdaptiveScaffold], which
+// provides one) and read the class with [WindowSizeClassOf]:
+//
+//	switch responsive.WindowSizeClassOf(ctx) {
+//	case responsive.WindowSizeCompact:
+//	    return singlePane()
+//	default:
+//	    return listDetail()
+//	}
+//
+// [AdaptiveScaffold] switches between a bottom navigation bar, a navigation
+// rail, and a permanent drawer as the width grows.
+//
+// To follow the whole screen rather than a measured region, use
+// [BreakpointBuilder], [ScreenSizeClassOf], or [OrientationBuilder], which
+// read the size from [widgets.MediaQuery].
+package responsive
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/layout"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+// WindowSizeClass groups widths into layout categories. The default
+// breakpoints follow the Material 3 window size classes.
+type WindowSizeClass int
+
+const (
+	// WindowSizeCompact is a phone in portrait, narrower than 600.
+	WindowSizeCompact WindowSizeClass = iota
+	// WindowSizeMedium is a tablet in portrait or a foldable, 600 to 840.
+	WindowSizeMedium
+	// WindowSizeExpanded is a tablet in landscape, 840 to 1200.
+	WindowSizeExpanded
+	// WindowSizeLarge is a desktop window, 1200 to 1600.
+	WindowSizeLarge
+	// WindowSizeExtraLarge is a wide desktop window, 1600 and wider.
+	WindowSizeExtraLarge
+)
+
+// String returns the size class name.
+func (c WindowSizeClass) String() string {
+	switch c {
+	case WindowSizeCompact:
+		return "compact"
+	case WindowSizeMedium:
+		return "medium"
+	case WindowSizeExpanded:
+		return "expanded"
+	case WindowSizeLarge:
+		return "large"
+	case WindowSizeExtraLarge:
+		return "extra-large"
+	default:
+		return fmt.Sprintf("WindowSizeClass(%d)", int(c))
+	}
+}
+
+// Breakpoints holds the minimum width, in logical pixels, of each size class
+// above compact. A zero Breakpoints uses [DefaultBreakpoints].
+type Breakpoints struct {
+	Medium     float64
+	Expanded   float64
+	Large      float64
+	ExtraLarge float64
+}
+
+// DefaultBreakpoints returns the Material 3 breakpoints: 600, 840, 1200, and
+// 1600.
+func DefaultBreakpoints() Breakpoints {
+	return Breakpoints{Medium: 600, Expanded: 840, Large: 1200, ExtraLarge: 1600}
+}
+
+// ClassOf returns the size class for a width.
+func (b Breakpoints) ClassOf(width float64) WindowSizeClass {
+	if b == (Breakpoints{}) {
+		b = DefaultBreakpoints()
+	}
+	switch {
+	case width >= b.ExtraLarge:
+		return WindowSizeExtraLarge
+	case width >= b.Large:
+		return WindowSizeLarge
+	case width >= b.Expanded:
+		return WindowSizeExpanded
+	case width >= b.Medium:
+		return WindowSizeMedium
+	default:
+		return WindowSizeCompact
+	}
+}
+
+// WindowSize describes the space measured by the nearest
+// [WindowSizeClassProvider].
+type WindowSize struct {
+	Width  float64
+	Height float64
+	Class  WindowSizeClass
+}
+
+// windowSizeAspect selects what a dependent reads from windowSizeData.
+type windowSizeAspect int
+
+const (
+	windowSizeAspectClass windowSizeAspect = iota
+	windowSizeAspectSize
+)
+
+// windowSizeData provides the measured size to descendants. It is aspect
+// aware so widgets that only read the class rebuild when the class changes,
+// not on every resize.
+type windowSizeData struct {
+	core.InheritedBase
+	size  WindowSize
+	child core.Widget
+}
+
+func (d windowSizeData) ChildWidget() core.Widget { return d.child }
+
+func (d windowSizeData) ShouldRebuildDependents(oldWidget core.InheritedWidget) bool {
+	if old, ok := oldWidget.(windowSizeData); ok {
+		return d.size != old.size
+	}
+	return true
+}
+
+func (d windowSizeData) ShouldRebuildDependent(oldWidget core.InheritedWidget, aspects map[any]struct{}) bool {
+	old, ok := oldWidget.(windowSizeData)
+	if !ok {
+		return true
+	}
+	for aspect := range aspects {
+		switch aspect.(windowSizeAspect) {
+		case windowSizeAspectClass:
+			if d.size.Class != old.size.Class {
+				return true
+			}
+		case windowSizeAspectSize:
+			if d.size != old.size {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+var _ core.AspectAwareInheritedWidget = windowSizeData{}
+
+var windowSizeDataType = reflect.TypeFor[windowSizeData]()
+
+// WindowSizeClassProvider measures the width available to it and provides
+// the matching [WindowSizeClass] to descendants. Place it near the root, or
+// around any region that should adapt to its own width rather than the
+// window's.
+type WindowSizeClassProvider struct {
+	core.StatelessBase
+
+	// Breakpoints for the size classes. Zero uses [DefaultBreakpoints].
+	Breakpoints Breakpoints
+
+	Child core.Widget
+}
+
+func (p WindowSizeClassProvider) Build(ctx core.BuildContext) core.Widget {
+	return widgets.LayoutBuilder{
+		Builder: func(ctx core.BuildContext, constraints layout.Constraints) core.Widget {
+			return windowSizeData{
+				size:  measure(constraints, p.Breakpoints),
+				child: p.Child,
+			}
+		},
+	}
+}
+
+// measure returns the window size for constraints. Unbounded widths count
+// as the widest class.
+func measure(constraints layout.Constraints, breakpoints Breakpoints) WindowSize {
+	return WindowSize{
+		Width:  constraints.MaxWidth,
+		Height: constraints.MaxHeight,
+		Class:  breakpoints.ClassOf(constraints.MaxWidth),
+	}
+}
+
+// WindowSizeClassOf returns the size class from the nearest
+// [WindowSizeClassProvider], or [WindowSizeCompact] if there is none.
+// Widgets calling this rebuild only when the class changes.
+func WindowSizeClassOf(ctx core.BuildContext) WindowSizeClass {
+	if d, ok := ctx.DependOnInherited(windowSizeDataType, windowSizeAspectClass).(windowSizeData); ok {
+		return d.size.Class
+	}
+	return WindowSizeCompact
+}
+
+// WindowSizeOf returns the size measured by the nearest
+// [WindowSizeClassProvider], or a zero WindowSize if there is none.
+// Widgets calling this rebuild whenever the size changes.
+func WindowSizeOf(ctx core.BuildContext) WindowSize {
+	if d, ok := ctx.DependOnInherited(windowSizeDataType, windowSizeAspectSize).(windowSizeData); ok {
+		return d.size
+	}
+	return WindowSize{}
+}
@@ -0,0 +1,149 @@
+package responsive_test
+
+import (
+	"testing"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/responsive"
+	drifttest "github.com/go-drift/drift/pkg/testing"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+func TestBreakpoints_ClassOf(t *testing.T) {
+	tests := []struct {
+		width float64
+		want  responsive.WindowSizeClass
+	}{
+		{0, responsive.WindowSizeCompact},
+		{599, responsive.WindowSizeCompact},
+		{600, responsive.WindowSizeMedium},
+		{840, responsive.WindowSizeExpanded},
+		{1200, responsive.WindowSizeLarge},
+		{1600, responsive.WindowSizeExtraLarge},
+	}
+	for _, tt := range tests {
+		if got := (responsive.Breakpoints{}).ClassOf(tt.width); got != tt.want {
+			t.Errorf("ClassOf(%v) = %v, want %v", tt.width, got, tt.want)
+		}
+	}
+
+	custom := responsive.Breakpoints{Medium: 400, Expanded: 700, Large: 1000, ExtraLarge: 2000}
+	if got := custom.ClassOf(500); got != responsive.WindowSizeMedium {
+		t.Errorf("expected custom breakpoints to apply, got %v", got)
+	}
+}
+
+type classProbe struct {
+	core.StatelessBase
+	got *responsive.WindowSizeClass
+}
+
+func (p classProbe) Build(ctx core.BuildContext) core.Widget {
+	*p.got = responsive.WindowSizeClassOf(ctx)
+	return widgets.SizedBox{}
+}
+
+func TestWindowSizeClassProvider_MeasuresWidth(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	var got responsive.WindowSizeClass
+
+	tester.SetSize(graphics.Size{Width: 900, Height: 600})
+	tester.PumpWidget(responsive.WindowSizeClassProvider{Child: classProbe{got: &got}})
+	if got != responsive.WindowSizeExpanded {
+		t.Errorf("expected expanded at 900 wide, got %v", got)
+	}
+
+	tester.SetSize(graphics.Size{Width: 390, Height: 800})
+	tester.PumpWidget(responsive.WindowSizeClassProvider{Child: classProbe{got: &got}})
+	if got != responsive.WindowSizeCompact {
+		t.Errorf("expected compact at 390 wide, got %v", got)
+	}
+}
+
+func TestAdaptiveScaffold_SwitchesNavigation(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	scaffold := responsive.AdaptiveScaffold{
+		Destinations:    []widgets.TabItem{{Label: "Inbox"}, {Label: "Settings"}},
+		Body:            widgets.Text{Content: "body"},
+		BottomBarHeight: 56,
+		RailWidth:       80,
+		DrawerWidth:     300,
+	}
+
+	tester.SetSize(graphics.Size{Width: 390, Height: 800})
+	tester.PumpWidget(scaffold)
+	if !tester.Find(drifttest.ByType[widgets.TabBar]()).Exists() {
+		t.Error("expected a bottom bar when compact")
+	}
+
+	for _, width := range []float64{700, 1000} {
+		tester.SetSize(graphics.Size{Width: width, Height: 800})
+		tester.PumpWidget(scaffold)
+		if tester.Find(drifttest.ByType[widgets.TabBar]()).Exists() {
+			t.Errorf("expected no bottom bar at %v wide", width)
+		}
+		if !tester.Find(drifttest.ByText("Settings")).Exists() {
+			t.Errorf("expected destinations to be shown at %v wide", width)
+		}
+	}
+}
+
+func TestOrientationBuilder(t *testing.T) {
+	tests := []struct {
+		size graphics.Size
+		want responsive.Orientation
+	}{
+		{graphics.Size{Width: 390, Height: 844}, responsive.OrientationPortrait},
+		{graphics.Size{Width: 400, Height: 400}, responsive.OrientationPortrait},
+		{graphics.Size{Width: 844, Height: 390}, responsive.OrientationLandscape},
+	}
+	for _, tt := range tests {
+		tester := drifttest.NewWidgetTesterWithT(t)
+		tester.SetSize(tt.size)
+
+		var got responsive.Orientation
+		tester.PumpWidget(responsive.OrientationBuilder{
+			Builder: func(ctx core.BuildContext, o responsive.Orientation) core.Widget {
+				got = o
+				return widgets.SizedBox{}
+			},
+		})
+		if got != tt.want {
+			t.Errorf("size %v: got %v, want %v", tt.size, got, tt.want)
+		}
+	}
+}
+
+func TestBreakpointBuilder_FallsBack(t *testing.T) {
+	tests := []struct {
+		width float64
+		want  string
+	}{
+		{390, "compact"},
+		{700, "medium"},
+		{1024, "medium"},
+		{1800, "medium"},
+	}
+	for _, tt := range tests {
+		tester := drifttest.NewWidgetTesterWithT(t)
+		tester.SetSize(graphics.Size{Width: tt.width, Height: 800})
+		tester.PumpWidget(responsive.BreakpointBuilder{
+			Compact: widgets.Text{Content: "compact"},
+			Medium:  widgets.Text{Content: "medium"},
+		})
+		if !tester.Find(drifttest.ByText(tt.want)).Exists() {
+			t.Errorf("width %v: expected the %s layout", tt.width, tt.want)
+		}
+	}
+
+	tester := drifttest.NewWidgetTesterWithT(t)
+	tester.SetSize(graphics.Size{Width: 1000, Height: 800})
+	tester.PumpWidget(responsive.BreakpointBuilder{
+		Compact:  widgets.Text{Content: "compact"},
+		Expanded: widgets.Text{Content: "expanded"},
+	})
+	if !tester.Find(drifttest.ByText("expanded")).Exists() {
+		t.Error("expected the expanded layout at 1000 wide")
+	}
+}
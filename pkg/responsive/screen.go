@@ -0,0 +1,109 @@
+package responsive
+
+import (
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+// Orientation is whether the screen is taller than it is wide.
+type Orientation int
+
+const (
+	// OrientationPortrait is a screen at least as tall as it is wide.
+	OrientationPortrait Orientation = iota
+	// OrientationLandscape is a screen wider than it is tall.
+	OrientationLandscape
+)
+
+// String returns "portrait" or "landscape".
+func (o Orientation) String() string {
+	if o == OrientationLandscape {
+		return "landscape"
+	}
+	return "portrait"
+}
+
+// OrientationForSize returns the orientation of a screen of the given size.
+func OrientationForSize(size graphics.Size) Orientation {
+	if size.Width > size.Height {
+		return OrientationLandscape
+	}
+	return OrientationPortrait
+}
+
+// OrientationOf returns the screen orientation from the nearest
+// [widgets.MediaQuery]. Widgets calling this rebuild when the screen size
+// changes.
+func OrientationOf(ctx core.BuildContext) Orientation {
+	return OrientationForSize(widgets.MediaQuerySizeOf(ctx))
+}
+
+// OrientationBuilder builds its child from the screen orientation, so a
+// layout can switch arrangements when the device rotates:
+//
+//	responsive.OrientationBuilder{
+//	    Builder: func(ctx core.BuildContext, o responsive.Orientation) core.Widget {
+//	        if o == responsive.OrientationLandscape {
+//	            return widgets.Row{Children: panes}
+//	        }
+//	        return widgets.Column{Children: panes}
+//	    },
+//	}
+type OrientationBuilder struct {
+	core.StatelessBase
+
+	Builder func(ctx core.BuildContext, orientation Orientation) core.Widget
+}
+
+func (o OrientationBuilder) Build(ctx core.BuildContext) core.Widget {
+	return o.Builder(ctx, OrientationOf(ctx))
+}
+
+// ScreenSizeClassOf returns the size class of the screen width from the
+// nearest [widgets.MediaQuery], using [DefaultBreakpoints]. Unlike
+// [WindowSizeClassOf] it needs no provider and ignores the space given to
+// the caller. Widgets calling this rebuild when the screen size changes.
+func ScreenSizeClassOf(ctx core.BuildContext) WindowSizeClass {
+	return DefaultBreakpoints().ClassOf(widgets.MediaQuerySizeOf(ctx).Width)
+}
+
+// BreakpointBuilder shows one of its widgets depending on the size class of
+// the screen width. Expanded is used for expanded and wider screens, and a
+// nil Medium or Expanded falls back to the next smaller breakpoint, so only
+// the layouts that differ need to be set:
+//
+//	responsive.BreakpointBuilder{
+//	    Compact: phoneLayout(),
+//	    Medium:  tabletLayout(), // also used when expanded
+//	}
+//
+// The width comes from [widgets.MediaQuery]; to adapt to the space of a
+// region instead, switch on [WindowSizeClassOf] below a
+// [WindowSizeClassProvider].
+type BreakpointBuilder struct {
+	core.StatelessBase
+
+	// Breakpoints for the size classes. Zero uses [DefaultBreakpoints].
+	Breakpoints Breakpoints
+
+	Compact  core.Widget
+	Medium   core.Widget
+	Expanded core.Widget
+}
+
+func (b BreakpointBuilder) Build(ctx core.BuildContext) core.Widget {
+	switch b.Breakpoints.ClassOf(widgets.MediaQuerySizeOf(ctx).Width) {
+	case WindowSizeCompact:
+		return b.Compact
+	case WindowSizeMedium:
+	default:
+		if b.Expanded != nil {
+			return b.Expanded
+		}
+	}
+	if b.Medium != nil {
+		return b.Medium
+	}
+	return b.Compact
+}
@@ -0,0 +1,52 @@
+package rive
+
+import (
+	"errors"
+	"io/fs"
+)
+
+// ErrUnsupported is returned when loading a Rive file in a build without
+// the Rive runtime.
+var ErrUnsupported = errors.New("rive: not supported in this build")
+
+// InputKind is the type of a state machine input.
+type InputKind int
+
+const (
+	// InputBool is a boolean input, set with SetBool.
+	InputBool InputKind = iota
+	// InputNumber is a number input, set with SetNumber.
+	InputNumber
+	// InputTrigger is a momentary input, fired with Fire.
+	InputTrigger
+)
+
+// String returns the input kind name.
+func (k InputKind) String() string {
+	switch k {
+	case InputBool:
+		return "bool"
+	case InputNumber:
+		return "number"
+	case InputTrigger:
+		return "trigger"
+	default:
+		return "unknown"
+	}
+}
+
+// Input describes a state machine input.
+type Input struct {
+	Name string
+	Kind InputKind
+}
+
+// LoadFS imports a Rive file from a file in fsys, such as an [embed.FS]
+// holding the app's assets.
+func LoadFS(fsys fs.FS, name string) (*File, error) {
+	data, err := fs.ReadFile(fsys, name)
+	if err != nil {
+		return nil, err
+	}
+	return LoadBytes(data)
+}
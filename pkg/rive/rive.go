@@ -0,0 +1,198 @@
+//go:build android || ios
+
+// Package rive provides Rive animation loading, state machine control, and
+// rendering using the Rive runtime's Skia renderer.
+//
+// Rive support is optional: the Skia bridge must be built with the Rive
+// runtime (see the Skia build guide). [Supported] reports whether it was.
+package rive
+
+import (
+	"errors"
+	"io"
+	"os"
+	"time"
+
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/skia"
+)
+
+// Supported reports whether the Rive runtime is linked into this build.
+func Supported() bool {
+	return skia.RiveSupported()
+}
+
+// File is an imported Rive file. Create artboards from it with
+// [File.Instantiate]; each artboard has its own animation state.
+//
+// # Thread Safety
+//
+// Files and artboards must only be used from the UI thread.
+type File struct {
+	file *skia.RiveFile
+}
+
+// Load imports a Rive file from the provided reader.
+func Load(r io.Reader) (*File, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	return LoadBytes(data)
+}
+
+// LoadBytes imports a Rive file from its binary (.riv) data.
+func LoadBytes(data []byte) (*File, error) {
+	if !Supported() {
+		return nil, ErrUnsupported
+	}
+	f, err := skia.NewRiveFile(data)
+	if err != nil {
+		return nil, err
+	}
+	return &File{file: f}, nil
+}
+
+// LoadFile imports a Rive file from a file path.
+func LoadFile(path string) (*File, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return LoadBytes(data)
+}
+
+// Instantiate creates an artboard running the named state machine. An empty
+// artboard name selects the file's default artboard, and an empty state
+// machine name selects the artboard's default state machine, if it has one.
+func (f *File) Instantiate(artboard, stateMachine string) (*Artboard, error) {
+	if f == nil || f.file == nil {
+		return nil, errors.New("rive: file is nil or destroyed")
+	}
+	r, err := skia.NewRive(f.file, artboard, stateMachine)
+	if err != nil {
+		return nil, err
+	}
+	w, h := r.Size()
+	return &Artboard{rive: r, size: graphics.Size{Width: w, Height: h}}, nil
+}
+
+// Destroy releases the file. Artboards already created from it stay valid.
+func (f *File) Destroy() {
+	if f != nil && f.file != nil {
+		f.file.Destroy()
+		f.file = nil
+	}
+}
+
+// Artboard is an instance of a Rive artboard and its state machine.
+//
+// # Lifetime Rules
+//
+// Artboards must not be destroyed while any display list that references
+// them might still be replayed. In practice, keep artboards alive for the
+// widget's lifetime.
+type Artboard struct {
+	rive *skia.Rive
+	size graphics.Size
+}
+
+// Size returns the intrinsic size of the artboard.
+func (a *Artboard) Size() graphics.Size {
+	if a == nil {
+		return graphics.Size{}
+	}
+	return a.size
+}
+
+// Inputs lists the state machine's inputs.
+func (a *Artboard) Inputs() []Input {
+	if a == nil || a.rive == nil {
+		return nil
+	}
+	n := a.rive.InputCount()
+	inputs := make([]Input, 0, n)
+	for i := range n {
+		name, kind, ok := a.rive.Input(i)
+		if !ok {
+			continue
+		}
+		inputs = append(inputs, Input{Name: name, Kind: InputKind(kind)})
+	}
+	return inputs
+}
+
+// SetBool sets a boolean input. Returns false if there is no such input.
+func (a *Artboard) SetBool(name string, value bool) bool {
+	return a != nil && a.rive.SetBool(name, value)
+}
+
+// SetNumber sets a number input. Returns false if there is no such input.
+func (a *Artboard) SetNumber(name string, value float64) bool {
+	return a != nil && a.rive.SetNumber(name, value)
+}
+
+// Fire fires a trigger input. Returns false if there is no such input.
+func (a *Artboard) Fire(name string) bool {
+	return a != nil && a.rive.FireTrigger(name)
+}
+
+// Value returns the current value of a boolean (0 or 1) or number input.
+func (a *Artboard) Value(name string) (float64, bool) {
+	if a == nil {
+		return 0, false
+	}
+	return a.rive.Value(name)
+}
+
+// Advance moves the state machine forward by dt and applies the result to
+// the artboard. Returns true while there is more to play; once it returns
+// false, the artboard is idle until an input changes or a pointer event
+// arrives.
+func (a *Artboard) Advance(dt time.Duration) bool {
+	return a != nil && a.rive.Advance(dt.Seconds())
+}
+
+// PointerDown delivers a pointer press at position, in the coordinates of a
+// box of the given size that the artboard is drawn into. Returns true if it
+// hit one of the state machine's listeners.
+func (a *Artboard) PointerDown(position graphics.Offset, size graphics.Size) bool {
+	return a.pointer(0, position, size)
+}
+
+// PointerMove delivers a pointer move. See [Artboard.PointerDown].
+func (a *Artboard) PointerMove(position graphics.Offset, size graphics.Size) bool {
+	return a.pointer(1, position, size)
+}
+
+// PointerUp delivers a pointer release. See [Artboard.PointerDown].
+func (a *Artboard) PointerUp(position graphics.Offset, size graphics.Size) bool {
+	return a.pointer(2, position, size)
+}
+
+func (a *Artboard) pointer(kind int, position graphics.Offset, size graphics.Size) bool {
+	if a == nil {
+		return false
+	}
+	return a.rive.Pointer(kind, position.X, position.Y, size.Width, size.Height)
+}
+
+// Draw renders the artboard's current state within bounds, scaled to fit
+// and centered.
+func (a *Artboard) Draw(canvas graphics.Canvas, bounds graphics.Rect) {
+	if a == nil || a.rive == nil {
+		return
+	}
+	if bounds.Width() <= 0 || bounds.Height() <= 0 {
+		return
+	}
+	canvas.DrawRive(a.rive.Ptr(), bounds)
+}
+
+// Destroy releases the artboard resources.
+func (a *Artboard) Destroy() {
+	if a != nil && a.rive != nil {
+		a.rive.Destroy()
+		a.rive = nil
+	}
+}
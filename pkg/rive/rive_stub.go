@@ -0,0 +1,105 @@
+//go:build !android && !ios
+
+// Package rive provides Rive animation loading, state machine control, and
+// rendering using the Rive runtime's Skia renderer.
+//
+// This is a stub implementation for unsupported platforms. All loading
+// functions return [ErrUnsupported].
+package rive
+
+import (
+	"io"
+	"time"
+
+	"github.com/go-drift/drift/pkg/graphics"
+)
+
+// Supported reports whether the Rive runtime is linked into this build.
+func Supported() bool {
+	return false
+}
+
+// File is an imported Rive file.
+type File struct{}
+
+// Load imports a Rive file from the provided reader.
+func Load(r io.Reader) (*File, error) {
+	return nil, ErrUnsupported
+}
+
+// LoadBytes imports a Rive file from its binary (.riv) data.
+func LoadBytes(data []byte) (*File, error) {
+	return nil, ErrUnsupported
+}
+
+// LoadFile imports a Rive file from a file path.
+func LoadFile(path string) (*File, error) {
+	return nil, ErrUnsupported
+}
+
+// Instantiate creates an artboard running the named state machine.
+func (f *File) Instantiate(artboard, stateMachine string) (*Artboard, error) {
+	return nil, ErrUnsupported
+}
+
+// Destroy releases the file.
+func (f *File) Destroy() {}
+
+// Artboard is an instance of a Rive artboard and its state machine.
+type Artboard struct{}
+
+// Size returns the intrinsic size of the artboard.
+func (a *Artboard) Size() graphics.Size {
+	return graphics.Size{}
+}
+
+// Inputs lists the state machine's inputs.
+func (a *Artboard) Inputs() []Input {
+	return nil
+}
+
+// SetBool sets a boolean input.
+func (a *Artboard) SetBool(name string, value bool) bool {
+	return false
+}
+
+// SetNumber sets a number input.
+func (a *Artboard) SetNumber(name string, value float64) bool {
+	return false
+}
+
+// Fire fires a trigger input.
+func (a *Artboard) Fire(name string) bool {
+	return false
+}
+
+// Value returns the current value of a boolean or number input.
+func (a *Artboard) Value(name string) (float64, bool) {
+	return 0, false
+}
+
+// Advance moves the state machine forward by dt.
+func (a *Artboard) Advance(dt time.Duration) bool {
+	return false
+}
+
+// PointerDown delivers a pointer press.
+func (a *Artboard) PointerDown(position graphics.Offset, size graphics.Size) bool {
+	return false
+}
+
+// PointerMove delivers a pointer move.
+func (a *Artboard) PointerMove(position graphics.Offset, size graphics.Size) bool {
+	return false
+}
+
+// PointerUp delivers a pointer release.
+func (a *Artboard) PointerUp(position graphics.Offset, size graphics.Size) bool {
+	return false
+}
+
+// Draw renders the artboard's current state within bounds.
+func (a *Artboard) Draw(canvas graphics.Canvas, bounds graphics.Rect) {}
+
+// Destroy releases the artboard resources.
+func (a *Artboard) Destroy() {}
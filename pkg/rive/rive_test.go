@@ -0,0 +1,52 @@
+package rive
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/go-drift/drift/pkg/graphics"
+)
+
+func TestLoad_Unsupported(t *testing.T) {
+	if Supported() {
+		t.Fatal("expected Rive to be unsupported in host builds")
+	}
+	if _, err := LoadBytes([]byte("RIVE")); !errors.Is(err, ErrUnsupported) {
+		t.Fatalf("expected ErrUnsupported from LoadBytes, got %v", err)
+	}
+	if _, err := Load(strings.NewReader("RIVE")); !errors.Is(err, ErrUnsupported) {
+		t.Fatalf("expected ErrUnsupported from Load, got %v", err)
+	}
+}
+
+func TestLoadFS(t *testing.T) {
+	fsys := fstest.MapFS{"assets/button.riv": {Data: []byte("RIVE")}}
+	if _, err := LoadFS(fsys, "assets/missing.riv"); err == nil || errors.Is(err, ErrUnsupported) {
+		t.Fatalf("expected a file error for a missing asset, got %v", err)
+	}
+	if _, err := LoadFS(fsys, "assets/button.riv"); !errors.Is(err, ErrUnsupported) {
+		t.Fatalf("expected ErrUnsupported after reading the asset, got %v", err)
+	}
+}
+
+func TestNilArtboard(t *testing.T) {
+	var a *Artboard
+	if s := a.Size(); s != (graphics.Size{}) {
+		t.Fatalf("expected zero size, got %v", s)
+	}
+	if a.SetBool("hover", true) || a.Fire("press") || a.Advance(time.Second) {
+		t.Fatal("expected nil artboard calls to report false")
+	}
+	a.Destroy()
+}
+
+func TestInputKind_String(t *testing.T) {
+	for kind, want := range map[InputKind]string{InputBool: "bool", InputNumber: "number", InputTrigger: "trigger"} {
+		if got := kind.String(); got != want {
+			t.Fatalf("expected %q, got %q", want, got)
+		}
+	}
+}
@@ -4,7 +4,9 @@
 // and image implementations that are identical across GPU backends.
 
 #include <algorithm>
+#include <atomic>
 #include <cstddef>
+#include <cstdlib>
 #include <cstring>
 #include <limits>
 #include <mutex>
@@ -18,6 +20,7 @@
 #include "core/SkColorSpace.h"
 #include "core/SkData.h"
 #include "core/SkFont.h"
+#include "core/SkFontArguments.h"
 #include "core/SkFontMetrics.h"
 #include "core/SkImage.h"
 #include "core/SkImageInfo.h"
@@ -30,6 +33,7 @@
 #include "core/SkSurface.h"
 #include "core/SkSurfaceProps.h"
 #include "core/SkTypeface.h"
+#include "core/SkVertices.h"
 #include "core/SkFontMgr.h"
 #include "core/SkString.h"
 #include "effects/SkGradient.h"
@@ -42,10 +46,13 @@
 #include "modules/skparagraph/include/ParagraphBuilder.h"
 #include "modules/skparagraph/include/ParagraphStyle.h"
 #include "modules/skparagraph/include/TextStyle.h"
+#include "modules/skparagraph/include/TypefaceFontProvider.h"
 #include "modules/skunicode/include/SkUnicode_libgrapheme.h"
 
 #include "skia_common_internal.h"
 #include "skia_path_impl.h"
+#include "skia_rive_impl.h"
+#include "skia_runtime_effect_impl.h"
 #include "skia_svg_impl.h"
 #include "skia_skottie_impl.h"
 
@@ -86,14 +93,30 @@ SkColor to_sk_color(uint32_t argb) {
     );
 }
 
+// Maps Go's graphics.TextDirection (0 = LTR, 1 = RTL) to Skia's enum,
+// whose values are in the opposite order.
+skia::textlayout::TextDirection to_text_direction(int text_direction) {
+    return text_direction == 1 ? skia::textlayout::TextDirection::kRtl : skia::textlayout::TextDirection::kLtr;
+}
+
+sk_sp<SkColorSpace> drift_color_space(int color_space) {
+    if (color_space == kDriftColorSpaceDisplayP3) {
+        static sk_sp<SkColorSpace> p3 = SkColorSpace::MakeRGB(SkNamedTransferFn::kSRGB, SkNamedGamut::kDisplayP3);
+        return p3;
+    }
+    return SkColorSpace::MakeSRGB();
+}
+
 SkPaint make_paint_ext(
-    uint32_t argb, int style, float stroke_width, int aa,
+    uint32_t argb, int style, float stroke_width, int flags,
     int stroke_cap, int stroke_join, float miter_limit,
     const float* dash_intervals, int dash_count, float dash_phase,
     int blend_mode, float alpha
 ) {
     SkPaint paint;
-    paint.setAntiAlias(aa != 0);
+    // Flags: bit 0 = anti-alias, bit 1 = dither, bit 2 = Display P3 color.
+    paint.setAntiAlias((flags & 1) != 0);
+    paint.setDither((flags & 2) != 0);
 
     // Apply alpha to color (clamp to valid range)
     SkColor color = to_sk_color(argb);
@@ -102,7 +125,11 @@ SkPaint make_paint_ext(
         int a = static_cast<int>(SkColorGetA(color) * clamped_alpha);
         color = SkColorSetA(color, a);
     }
-    paint.setColor(color);
+    if ((flags & 4) != 0) {
+        paint.setColor(SkColor4f::FromColor(color), drift_color_space(kDriftColorSpaceDisplayP3).get());
+    } else {
+        paint.setColor(color);
+    }
 
     // Style
     switch (style) {
@@ -212,14 +239,28 @@ sk_sp<SkShader> make_gradient_shader(int gradient_type, float x1, float y1, floa
     }
 }
 
+// CustomTypeface is one weight and slant of a registered family.
+struct CustomTypeface {
+    SkFontStyle style;
+    sk_sp<SkTypeface> typeface;
+};
+
 struct FontRegistry {
     std::mutex mu;
-    std::unordered_map<std::string, sk_sp<SkTypeface>> custom;
+    std::unordered_map<std::string, std::vector<CustomTypeface>> custom;
+    // fallbacks are the families paragraphs try, in order, for characters
+    // their own family lacks, before the platform picks a font per character.
+    std::vector<SkString> fallbacks;
+    // generation changes on every registration so cached lookups that
+    // predate a font are discarded.
+    std::atomic<uint64_t> generation{0};
 };
 
 struct ParagraphRegistry {
     std::mutex mu;
     sk_sp<skia::textlayout::FontCollection> collection;
+    // provider makes registered fonts visible to paragraphs by family name.
+    sk_sp<skia::textlayout::TypefaceFontProvider> provider;
 };
 
 FontRegistry& font_registry() {
@@ -236,23 +277,125 @@ sk_sp<skia::textlayout::FontCollection> get_paragraph_collection() {
     auto& registry = paragraph_registry();
     std::lock_guard<std::mutex> lock(registry.mu);
     if (!registry.collection) {
+        registry.provider = sk_make_sp<skia::textlayout::TypefaceFontProvider>();
         registry.collection = sk_make_sp<skia::textlayout::FontCollection>();
-        registry.collection->setDefaultFontManager(drift_get_font_manager());
+        registry.collection->setAssetFontManager(registry.provider);
+        registry.collection->setDefaultFontManager(drift_get_font_manager(), drift_platform_fallback_font());
+        registry.collection->enableFontFallback();
     }
     return registry.collection;
 }
 
-sk_sp<SkTypeface> lookup_custom_typeface(const char* family) {
+// Adds a registered typeface to the fonts paragraphs resolve by family name.
+void register_paragraph_typeface(const sk_sp<SkTypeface>& typeface, const char* name) {
+    auto collection = get_paragraph_collection();
+    auto& registry = paragraph_registry();
+    std::lock_guard<std::mutex> lock(registry.mu);
+    registry.provider->registerTypeface(typeface, SkString(name));
+    collection->clearCaches();
+}
+
+// Returns the families a paragraph span in family tries, in order: family
+// (or the platform default when empty), then the fallback chain. Characters
+// none of them cover fall back to a font the platform picks per character,
+// such as its emoji font.
+std::vector<SkString> paragraph_font_families(const char* family) {
+    std::vector<SkString> families;
+    families.emplace_back((family && family[0] != '\0') ? family : drift_platform_fallback_font());
+    auto& registry = font_registry();
+    std::lock_guard<std::mutex> lock(registry.mu);
+    for (const auto& fallback : registry.fallbacks) {
+        if (!fallback.equals(families[0])) {
+            families.push_back(fallback);
+        }
+    }
+    return families;
+}
+
+// Sets the variable font axis coordinates of text_style. Zero coordinates
+// are left out so those axes keep the font's default.
+void apply_font_variations(skia::textlayout::TextStyle& text_style, float weight, float width, float slant) {
+    SkFontArguments::VariationPosition::Coordinate coordinates[3];
+    int count = 0;
+    if (weight != 0) {
+        coordinates[count++] = {SkSetFourByteTag('w', 'g', 'h', 't'), weight};
+    }
+    if (width != 0) {
+        coordinates[count++] = {SkSetFourByteTag('w', 'd', 't', 'h'), width};
+    }
+    if (slant != 0) {
+        coordinates[count++] = {SkSetFourByteTag('s', 'l', 'n', 't'), slant};
+    }
+    if (count == 0) {
+        return;
+    }
+    SkFontArguments args;
+    args.setVariationDesignPosition({coordinates, count});
+    text_style.setFontArguments(args);
+}
+
+// Enables the strut of paragraph_style when strut is set.
+void apply_strut_style(skia::textlayout::ParagraphStyle& paragraph_style, const DriftStrutStyle* strut) {
+    if (!strut) {
+        return;
+    }
+    skia::textlayout::StrutStyle strut_style;
+    strut_style.setStrutEnabled(true);
+    strut_style.setFontFamilies(paragraph_font_families(strut->family));
+    SkFontStyle::Slant slant = (strut->style == 1) ? SkFontStyle::kItalic_Slant : SkFontStyle::kUpright_Slant;
+    int weight = std::clamp(strut->weight > 0 ? strut->weight : 400, 100, 900);
+    strut_style.setFontStyle(SkFontStyle(weight, SkFontStyle::kNormal_Width, slant));
+    if (strut->size > 0) {
+        strut_style.setFontSize(strut->size);
+    }
+    if (strut->height > 0) {
+        strut_style.setHeight(strut->height);
+        strut_style.setHeightOverride(true);
+    }
+    if (strut->leading > 0) {
+        strut_style.setLeading(strut->leading);
+    }
+    strut_style.setForceStrutHeight(strut->force_height != 0);
+    paragraph_style.setStrutStyle(strut_style);
+}
+
+void set_font_fallbacks(const char** families, int count) {
+    std::vector<SkString> fallbacks;
+    for (int i = 0; i < count; i++) {
+        if (families[i] && families[i][0] != '\0') {
+            fallbacks.emplace_back(families[i]);
+        }
+    }
+    auto& registry = font_registry();
+    std::lock_guard<std::mutex> lock(registry.mu);
+    registry.fallbacks = std::move(fallbacks);
+}
+
+// Returns the registered face of family closest to style: the same slant if
+// there is one, then the nearest weight.
+sk_sp<SkTypeface> lookup_custom_typeface(const char* family, SkFontStyle style) {
     if (!family || family[0] == '\0') {
         return nullptr;
     }
     auto& registry = font_registry();
     std::lock_guard<std::mutex> lock(registry.mu);
     auto it = registry.custom.find(family);
-    if (it != registry.custom.end()) {
-        return it->second;
+    if (it == registry.custom.end()) {
+        return nullptr;
+    }
+    sk_sp<SkTypeface> best;
+    int best_score = 0;
+    for (const auto& face : it->second) {
+        int score = std::abs(face.style.weight() - style.weight());
+        if (face.style.slant() != style.slant()) {
+            score += 1000;
+        }
+        if (!best || score < best_score) {
+            best = face.typeface;
+            best_score = score;
+        }
     }
-    return nullptr;
+    return best;
 }
 
 // Resolve a typeface by family name, weight, and style.
@@ -263,20 +406,23 @@ sk_sp<SkTypeface> resolve_typeface(const char* family, int weight, int style) {
         std::string family;
         int weight = -1;
         int style = -1;
+        uint64_t generation = 0;
         sk_sp<SkTypeface> typeface;
     };
     static Cache cache;
 
     weight = std::clamp(weight, 100, 900);
     std::string family_name = (family && family[0] != '\0') ? family : "";
-    if (cache.typeface && cache.weight == weight && cache.style == style && cache.family == family_name) {
+    uint64_t generation = font_registry().generation.load();
+    if (cache.typeface && cache.weight == weight && cache.style == style &&
+        cache.generation == generation && cache.family == family_name) {
         return cache.typeface;
     }
 
     SkFontStyle::Slant slant = (style == 1) ? SkFontStyle::kItalic_Slant : SkFontStyle::kUpright_Slant;
     SkFontStyle font_style(weight, SkFontStyle::kNormal_Width, slant);
     auto manager = drift_get_font_manager();
-    sk_sp<SkTypeface> typeface = lookup_custom_typeface(family);
+    sk_sp<SkTypeface> typeface = lookup_custom_typeface(family, font_style);
     if (!typeface && manager && !family_name.empty()) {
         typeface = manager->matchFamilyStyle(family_name.c_str(), font_style);
     }
@@ -301,11 +447,15 @@ sk_sp<SkTypeface> resolve_typeface(const char* family, int weight, int style) {
     cache.family = family_name;
     cache.weight = weight;
     cache.style = style;
+    cache.generation = generation;
     cache.typeface = typeface;
     return typeface;
 }
 
-bool register_font(const char* name, const uint8_t* data, int length) {
+// Registers a face of the family name. A weight of zero or less takes the
+// weight and slant from the font file; otherwise they are used as given and
+// style 1 is italic. A face with the same weight and slant is replaced.
+bool register_font(const char* name, const uint8_t* data, int length, int weight, int style) {
     if (!name || name[0] == '\0' || !data || length <= 0) {
         return false;
     }
@@ -318,9 +468,26 @@ bool register_font(const char* name, const uint8_t* data, int length) {
     if (!typeface) {
         return false;
     }
-    auto& registry = font_registry();
-    std::lock_guard<std::mutex> lock(registry.mu);
-    registry.custom[name] = typeface;
+    SkFontStyle face_style = typeface->fontStyle();
+    if (weight > 0) {
+        SkFontStyle::Slant slant = (style == 1) ? SkFontStyle::kItalic_Slant : SkFontStyle::kUpright_Slant;
+        face_style = SkFontStyle(std::clamp(weight, 100, 900), SkFontStyle::kNormal_Width, slant);
+    }
+    {
+        auto& registry = font_registry();
+        std::lock_guard<std::mutex> lock(registry.mu);
+        auto& faces = registry.custom[name];
+        auto existing = std::find_if(faces.begin(), faces.end(), [&](const CustomTypeface& face) {
+            return face.style.weight() == face_style.weight() && face.style.slant() == face_style.slant();
+        });
+        if (existing != faces.end()) {
+            existing->typeface = typeface;
+        } else {
+            faces.push_back({face_style, typeface});
+        }
+        registry.generation++;
+    }
+    register_paragraph_typeface(typeface, name);
     return true;
 }
 
@@ -333,7 +500,8 @@ SkFont make_font(const char* family, float size, int weight, int style) {
     font.setSize(size);
     font.setEdging(SkFont::Edging::kSubpixelAntiAlias);
     font.setHinting(SkFontHinting::kNormal);
-    if (style == 1) {
+    // Synthesize italics only when the family has no italic face.
+    if (style == 1 && !(typeface && typeface->isItalic())) {
         font.setSkewX(-0.25f);
     }
     return font;
@@ -363,6 +531,7 @@ constexpr float kColorFilterMatrix = 1;
 constexpr float kImageFilterBlur = 0;
 constexpr float kImageFilterDropShadow = 1;
 constexpr float kImageFilterColorFilter = 2;
+constexpr float kImageFilterRuntimeShader = 3;
 
 // Parse serialized ColorFilter data and create SkColorFilter
 // Returns nullptr if data is invalid or empty
@@ -513,6 +682,32 @@ sk_sp<SkImageFilter> parse_image_filter(const float* data, int len, int& consume
         }
         consumed = base_consumed;
 
+    } else if (type == kImageFilterRuntimeShader) {
+        // Format: [3, effect_lo, effect_hi, uniform_len, ...uniforms, input_len, ...input]
+        if (len < 5) return nullptr;
+        uint32_t lo, hi;
+        std::memcpy(&lo, &data[1], sizeof(float));
+        std::memcpy(&hi, &data[2], sizeof(float));
+        void* effect = reinterpret_cast<void*>(static_cast<uintptr_t>(lo) | (static_cast<uintptr_t>(hi) << 32));
+        int uniform_len = static_cast<int>(data[3]);
+        base_consumed = 4;
+        if (uniform_len < 0 || base_consumed + uniform_len >= len) return nullptr;
+        const float* uniforms = data + base_consumed;
+        base_consumed += uniform_len;
+
+        // Parse input filter
+        int input_len = static_cast<int>(data[base_consumed]);
+        base_consumed += 1;
+        sk_sp<SkImageFilter> input;
+        if (input_len > 0 && base_consumed + input_len <= len) {
+            int input_consumed = 0;
+            input = parse_image_filter(data + base_consumed, input_len, input_consumed);
+            base_consumed += input_len;
+        }
+
+        filter = drift_skia_runtime_image_filter(effect, uniforms, uniform_len, input);
+        consumed = base_consumed;
+
     } else {
         return nullptr;
     }
@@ -699,7 +894,7 @@ void drift_skia_canvas_clear(DriftSkiaCanvas canvas, uint32_t argb) {
 
 void drift_skia_canvas_draw_rect(
     DriftSkiaCanvas canvas, float l, float t, float r, float b,
-    uint32_t argb, int style, float stroke_width, int aa,
+    uint32_t argb, int style, float stroke_width, int flags,
     int stroke_cap, int stroke_join, float miter_limit,
     const float* dash_intervals, int dash_count, float dash_phase,
     int blend_mode, float alpha
@@ -708,7 +903,7 @@ void drift_skia_canvas_draw_rect(
         return;
     }
     SkRect rect = SkRect::MakeLTRB(l, t, r, b);
-    SkPaint paint = make_paint_ext(argb, style, stroke_width, aa,
+    SkPaint paint = make_paint_ext(argb, style, stroke_width, flags,
         stroke_cap, stroke_join, miter_limit,
         dash_intervals, dash_count, dash_phase,
         blend_mode, alpha);
@@ -720,7 +915,7 @@ void drift_skia_canvas_draw_rrect(
     float l, float t, float r, float b,
     float rx1, float ry1, float rx2, float ry2,
     float rx3, float ry3, float rx4, float ry4,
-    uint32_t argb, int style, float stroke_width, int aa,
+    uint32_t argb, int style, float stroke_width, int flags,
     int stroke_cap, int stroke_join, float miter_limit,
     const float* dash_intervals, int dash_count, float dash_phase,
     int blend_mode, float alpha
@@ -737,7 +932,7 @@ void drift_skia_canvas_draw_rrect(
     };
     SkRRect rrect;
     rrect.setRectRadii(rect, radii);
-    SkPaint paint = make_paint_ext(argb, style, stroke_width, aa,
+    SkPaint paint = make_paint_ext(argb, style, stroke_width, flags,
         stroke_cap, stroke_join, miter_limit,
         dash_intervals, dash_count, dash_phase,
         blend_mode, alpha);
@@ -746,7 +941,7 @@ void drift_skia_canvas_draw_rrect(
 
 void drift_skia_canvas_draw_circle(
     DriftSkiaCanvas canvas, float cx, float cy, float radius,
-    uint32_t argb, int style, float stroke_width, int aa,
+    uint32_t argb, int style, float stroke_width, int flags,
     int stroke_cap, int stroke_join, float miter_limit,
     const float* dash_intervals, int dash_count, float dash_phase,
     int blend_mode, float alpha
@@ -754,7 +949,7 @@ void drift_skia_canvas_draw_circle(
     if (!canvas) {
         return;
     }
-    SkPaint paint = make_paint_ext(argb, style, stroke_width, aa,
+    SkPaint paint = make_paint_ext(argb, style, stroke_width, flags,
         stroke_cap, stroke_join, miter_limit,
         dash_intervals, dash_count, dash_phase,
         blend_mode, alpha);
@@ -763,7 +958,7 @@ void drift_skia_canvas_draw_circle(
 
 void drift_skia_canvas_draw_line(
     DriftSkiaCanvas canvas, float x1, float y1, float x2, float y2,
-    uint32_t argb, float stroke_width, int aa,
+    uint32_t argb, float stroke_width, int flags,
     int stroke_cap, int stroke_join, float miter_limit,
     const float* dash_intervals, int dash_count, float dash_phase,
     int blend_mode, float alpha
@@ -771,7 +966,7 @@ void drift_skia_canvas_draw_line(
     if (!canvas) {
         return;
     }
-    SkPaint paint = make_paint_ext(argb, 1, stroke_width, aa,
+    SkPaint paint = make_paint_ext(argb, 1, stroke_width, flags,
         stroke_cap, stroke_join, miter_limit,
         dash_intervals, dash_count, dash_phase,
         blend_mode, alpha);
@@ -781,7 +976,7 @@ void drift_skia_canvas_draw_line(
 void drift_skia_canvas_draw_rect_gradient(
     DriftSkiaCanvas canvas,
     float l, float t, float r, float b,
-    uint32_t argb, int style, float stroke_width, int aa,
+    uint32_t argb, int style, float stroke_width, int flags,
     int stroke_cap, int stroke_join, float miter_limit,
     const float* dash_intervals, int dash_count, float dash_phase,
     int blend_mode, float alpha,
@@ -794,7 +989,7 @@ void drift_skia_canvas_draw_rect_gradient(
         return;
     }
     SkRect rect = SkRect::MakeLTRB(l, t, r, b);
-    SkPaint paint = make_paint_ext(argb, style, stroke_width, aa,
+    SkPaint paint = make_paint_ext(argb, style, stroke_width, flags,
         stroke_cap, stroke_join, miter_limit,
         dash_intervals, dash_count, dash_phase,
         blend_mode, alpha);
@@ -810,7 +1005,7 @@ void drift_skia_canvas_draw_rrect_gradient(
     float l, float t, float r, float b,
     float rx1, float ry1, float rx2, float ry2,
     float rx3, float ry3, float rx4, float ry4,
-    uint32_t argb, int style, float stroke_width, int aa,
+    uint32_t argb, int style, float stroke_width, int flags,
     int stroke_cap, int stroke_join, float miter_limit,
     const float* dash_intervals, int dash_count, float dash_phase,
     int blend_mode, float alpha,
@@ -831,7 +1026,7 @@ void drift_skia_canvas_draw_rrect_gradient(
     };
     SkRRect rrect;
     rrect.setRectRadii(rect, radii);
-    SkPaint paint = make_paint_ext(argb, style, stroke_width, aa,
+    SkPaint paint = make_paint_ext(argb, style, stroke_width, flags,
         stroke_cap, stroke_join, miter_limit,
         dash_intervals, dash_count, dash_phase,
         blend_mode, alpha);
@@ -845,7 +1040,7 @@ void drift_skia_canvas_draw_rrect_gradient(
 void drift_skia_canvas_draw_circle_gradient(
     DriftSkiaCanvas canvas,
     float cx, float cy, float radius,
-    uint32_t argb, int style, float stroke_width, int aa,
+    uint32_t argb, int style, float stroke_width, int flags,
     int stroke_cap, int stroke_join, float miter_limit,
     const float* dash_intervals, int dash_count, float dash_phase,
     int blend_mode, float alpha,
@@ -857,7 +1052,7 @@ void drift_skia_canvas_draw_circle_gradient(
     if (!canvas) {
         return;
     }
-    SkPaint paint = make_paint_ext(argb, style, stroke_width, aa,
+    SkPaint paint = make_paint_ext(argb, style, stroke_width, flags,
         stroke_cap, stroke_join, miter_limit,
         dash_intervals, dash_count, dash_phase,
         blend_mode, alpha);
@@ -871,7 +1066,7 @@ void drift_skia_canvas_draw_circle_gradient(
 void drift_skia_canvas_draw_line_gradient(
     DriftSkiaCanvas canvas,
     float x1, float y1, float x2, float y2,
-    uint32_t argb, float stroke_width, int aa,
+    uint32_t argb, float stroke_width, int flags,
     int stroke_cap, int stroke_join, float miter_limit,
     const float* dash_intervals, int dash_count, float dash_phase,
     int blend_mode, float alpha,
@@ -883,7 +1078,7 @@ void drift_skia_canvas_draw_line_gradient(
     if (!canvas) {
         return;
     }
-    SkPaint paint = make_paint_ext(argb, 1, stroke_width, aa,
+    SkPaint paint = make_paint_ext(argb, 1, stroke_width, flags,
         stroke_cap, stroke_join, miter_limit,
         dash_intervals, dash_count, dash_phase,
         blend_mode, alpha);
@@ -896,7 +1091,7 @@ void drift_skia_canvas_draw_line_gradient(
 
 void drift_skia_canvas_draw_path_gradient(
     DriftSkiaCanvas canvas, DriftSkiaPath path,
-    uint32_t argb, int style, float stroke_width, int aa,
+    uint32_t argb, int style, float stroke_width, int flags,
     int stroke_cap, int stroke_join, float miter_limit,
     const float* dash_intervals, int dash_count, float dash_phase,
     int blend_mode, float alpha,
@@ -908,7 +1103,7 @@ void drift_skia_canvas_draw_path_gradient(
     if (!canvas || !path) {
         return;
     }
-    SkPaint paint = make_paint_ext(argb, style, stroke_width, aa,
+    SkPaint paint = make_paint_ext(argb, style, stroke_width, flags,
         stroke_cap, stroke_join, miter_limit,
         dash_intervals, dash_count, dash_phase,
         blend_mode, alpha);
@@ -998,7 +1193,15 @@ void drift_skia_canvas_draw_text_shadow(DriftSkiaCanvas canvas, const char* text
 }
 
 int drift_skia_register_font(const char* name, const uint8_t* data, int length) {
-    return register_font(name, data, length) ? 1 : 0;
+    return register_font(name, data, length, 0, 0) ? 1 : 0;
+}
+
+void drift_skia_set_font_fallbacks(const char** families, int count) {
+    set_font_fallbacks(families, count);
+}
+
+int drift_skia_register_font_style(const char* name, const uint8_t* data, int length, int weight, int style) {
+    return register_font(name, data, length, weight, style) ? 1 : 0;
 }
 
 int drift_skia_measure_text(const char* text, const char* family, float size, int weight, int style, float* width) {
@@ -1087,6 +1290,9 @@ DriftSkiaParagraph drift_skia_paragraph_create(
     float size,
     int weight,
     int style,
+    float variation_weight,
+    float variation_width,
+    float variation_slant,
     uint32_t argb,
     int max_lines,
     int gradient_type,
@@ -1097,12 +1303,19 @@ DriftSkiaParagraph drift_skia_paragraph_create(
     const uint32_t* colors,
     const float* positions,
     int count,
-    int shadow_enabled,
-    uint32_t shadow_argb,
-    float shadow_dx,
-    float shadow_dy,
-    float shadow_sigma,
-    int text_align
+    const DriftTextShadow* shadows,
+    int shadow_count,
+    int decoration,
+    uint32_t decoration_argb,
+    int decoration_style,
+    float stroke_width,
+    float letter_spacing,
+    float word_spacing,
+    float height,
+    int text_align,
+    int text_direction,
+    const DriftStrutStyle* strut,
+    const char* ellipsis
 ) {
     auto collection = get_paragraph_collection();
     if (!collection) {
@@ -1113,35 +1326,57 @@ DriftSkiaParagraph drift_skia_paragraph_create(
         paragraph_style.setMaxLines(static_cast<size_t>(max_lines));
     }
     paragraph_style.setTextAlign(static_cast<skia::textlayout::TextAlign>(text_align));
+    paragraph_style.setTextDirection(to_text_direction(text_direction));
+    apply_strut_style(paragraph_style, strut);
+    if (ellipsis && ellipsis[0] != '\0') {
+        paragraph_style.setEllipsis(SkString(ellipsis));
+    }
     skia::textlayout::TextStyle text_style;
     text_style.setFontSize(size);
     SkFontStyle::Slant slant = (style == 1) ? SkFontStyle::kItalic_Slant : SkFontStyle::kUpright_Slant;
     text_style.setFontStyle(SkFontStyle(std::clamp(weight, 100, 900), SkFontStyle::kNormal_Width, slant));
-    if (family && family[0] != '\0') {
-        std::vector<SkString> families;
-        families.emplace_back(family);
-        text_style.setFontFamilies(families);
+    text_style.setFontFamilies(paragraph_font_families(family));
+    apply_font_variations(text_style, variation_weight, variation_width, variation_slant);
+    text_style.setColor(to_sk_color(argb));
+    if (letter_spacing != 0) {
+        text_style.setLetterSpacing(letter_spacing);
     }
-    auto typeface = resolve_typeface(family, weight, style);
-    if (typeface) {
-        text_style.setTypeface(typeface);
+    if (word_spacing != 0) {
+        text_style.setWordSpacing(word_spacing);
+    }
+    if (height > 0) {
+        text_style.setHeight(height);
+        text_style.setHeightOverride(true);
     }
-    text_style.setColor(to_sk_color(argb));
     auto shader = make_gradient_shader(gradient_type, x1, y1, x2, y2, cx, cy, radius, colors, positions, count);
-    if (shader) {
+    if (shader || stroke_width > 0) {
         SkPaint paint;
         paint.setAntiAlias(true);
         paint.setColor(to_sk_color(argb));
-        paint.setShader(shader);
+        if (shader) {
+            paint.setShader(shader);
+        }
+        if (stroke_width > 0) {
+            paint.setStyle(SkPaint::kStroke_Style);
+            paint.setStrokeWidth(stroke_width);
+            paint.setStrokeJoin(SkPaint::kRound_Join);
+        }
         text_style.setForegroundPaint(paint);
     }
-    if (shadow_enabled != 0) {
+    for (int i = 0; shadows && i < shadow_count; ++i) {
         skia::textlayout::TextShadow shadow;
-        shadow.fColor = to_sk_color(shadow_argb);
-        shadow.fOffset = SkPoint::Make(shadow_dx, shadow_dy);
-        shadow.fBlurSigma = shadow_sigma;
+        shadow.fColor = to_sk_color(shadows[i].color);
+        shadow.fOffset = SkPoint::Make(shadows[i].dx, shadows[i].dy);
+        shadow.fBlurSigma = shadows[i].sigma;
         text_style.addShadow(shadow);
     }
+    if (decoration != 0) {
+        text_style.setDecoration(static_cast<skia::textlayout::TextDecoration>(decoration));
+        if (decoration_argb != 0) {
+            text_style.setDecorationColor(to_sk_color(decoration_argb));
+        }
+        text_style.setDecorationStyle(static_cast<skia::textlayout::TextDecorationStyle>(decoration_style));
+    }
     auto unicode = SkUnicodes::Libgrapheme::Make();
     auto builder = skia::textlayout::ParagraphBuilder::make(paragraph_style, collection, unicode);
     builder->pushStyle(text_style);
@@ -1163,22 +1398,23 @@ void drift_skia_paragraph_layout(DriftSkiaParagraph paragraph, float width) {
     reinterpret_cast<skia::textlayout::Paragraph*>(paragraph)->layout(width);
 }
 
-int drift_skia_paragraph_get_metrics(DriftSkiaParagraph paragraph, float* height, float* longest_line, float* max_intrinsic_width, int* line_count) {
-    if (!paragraph || !height || !longest_line || !max_intrinsic_width || !line_count) {
+int drift_skia_paragraph_get_metrics(DriftSkiaParagraph paragraph, float* height, float* longest_line, float* max_intrinsic_width, int* line_count, int* did_exceed_max_lines) {
+    if (!paragraph || !height || !longest_line || !max_intrinsic_width || !line_count || !did_exceed_max_lines) {
         return 0;
     }
     auto sk_paragraph = reinterpret_cast<skia::textlayout::Paragraph*>(paragraph);
     *height = sk_paragraph->getHeight();
     *longest_line = sk_paragraph->getLongestLine();
     *max_intrinsic_width = sk_paragraph->getMaxIntrinsicWidth();
+    *did_exceed_max_lines = sk_paragraph->didExceedMaxLines() ? 1 : 0;
     std::vector<skia::textlayout::LineMetrics> metrics;
     sk_paragraph->getLineMetrics(metrics);
     *line_count = static_cast<int>(metrics.size());
     return 1;
 }
 
-int drift_skia_paragraph_get_line_metrics(DriftSkiaParagraph paragraph, float* widths, float* ascents, float* descents, float* heights, int count) {
-    if (!paragraph || !widths || !ascents || !descents || !heights || count <= 0) {
+int drift_skia_paragraph_get_line_metrics(DriftSkiaParagraph paragraph, float* widths, float* ascents, float* descents, float* heights, float* lefts, float* baselines, int* starts, int* ends, int count) {
+    if (!paragraph || !widths || !ascents || !descents || !heights || !lefts || !baselines || !starts || !ends || count <= 0) {
         return 0;
     }
     auto sk_paragraph = reinterpret_cast<skia::textlayout::Paragraph*>(paragraph);
@@ -1190,10 +1426,70 @@ int drift_skia_paragraph_get_line_metrics(DriftSkiaParagraph paragraph, float* w
         ascents[i] = metrics[i].fAscent;
         descents[i] = metrics[i].fDescent;
         heights[i] = metrics[i].fHeight;
+        lefts[i] = metrics[i].fLeft;
+        baselines[i] = metrics[i].fBaseline;
+        // Line indices are UTF-8 offsets into the paragraph text.
+        starts[i] = static_cast<int>(metrics[i].fStartIndex);
+        ends[i] = static_cast<int>(metrics[i].fEndIncludingNewline);
     }
     return 1;
 }
 
+int drift_skia_paragraph_get_glyph_position(DriftSkiaParagraph paragraph, float x, float y, int* index, int* upstream) {
+    if (!paragraph || !index || !upstream) {
+        return 0;
+    }
+    auto sk_paragraph = reinterpret_cast<skia::textlayout::Paragraph*>(paragraph);
+    // The position is a UTF-16 index.
+    auto position = sk_paragraph->getGlyphPositionAtCoordinate(x, y);
+    *index = position.position;
+    *upstream = position.affinity == skia::textlayout::Affinity::kUpstream ? 1 : 0;
+    return 1;
+}
+
+int drift_skia_paragraph_get_rects_for_range(DriftSkiaParagraph paragraph, int start, int end, float* rects, int* rtl, int max_rects) {
+    if (!paragraph || !rects || !rtl || max_rects <= 0 || start < 0 || end < start) {
+        return 0;
+    }
+    auto sk_paragraph = reinterpret_cast<skia::textlayout::Paragraph*>(paragraph);
+    // start and end are UTF-16 indices.
+    auto boxes = sk_paragraph->getRectsForRange(
+        static_cast<unsigned>(start),
+        static_cast<unsigned>(end),
+        skia::textlayout::RectHeightStyle::kMax,
+        skia::textlayout::RectWidthStyle::kTight
+    );
+    int count = std::min(max_rects, static_cast<int>(boxes.size()));
+    for (int i = 0; i < count; ++i) {
+        rects[i * 4] = boxes[i].rect.left();
+        rects[i * 4 + 1] = boxes[i].rect.top();
+        rects[i * 4 + 2] = boxes[i].rect.right();
+        rects[i * 4 + 3] = boxes[i].rect.bottom();
+        rtl[i] = boxes[i].direction == skia::textlayout::TextDirection::kRtl ? 1 : 0;
+    }
+    return count;
+}
+
+int drift_skia_paragraph_get_placeholder_rects(DriftSkiaParagraph paragraph, float* rects, int max_rects) {
+    if (!paragraph) {
+        return 0;
+    }
+    auto sk_paragraph = reinterpret_cast<skia::textlayout::Paragraph*>(paragraph);
+    auto boxes = sk_paragraph->getRectsForPlaceholders();
+    // Without a buffer, report how many placeholders were laid out.
+    if (!rects || max_rects <= 0) {
+        return static_cast<int>(boxes.size());
+    }
+    int count = std::min(max_rects, static_cast<int>(boxes.size()));
+    for (int i = 0; i < count; ++i) {
+        rects[i * 4] = boxes[i].rect.left();
+        rects[i * 4 + 1] = boxes[i].rect.top();
+        rects[i * 4 + 2] = boxes[i].rect.right();
+        rects[i * 4 + 3] = boxes[i].rect.bottom();
+    }
+    return count;
+}
+
 void drift_skia_paragraph_paint(DriftSkiaParagraph paragraph, DriftSkiaCanvas canvas, float x, float y) {
     if (!paragraph || !canvas) {
         return;
@@ -1212,9 +1508,12 @@ DriftSkiaParagraph drift_skia_rich_paragraph_create(
     const DriftTextSpan* spans,
     int span_count,
     int max_lines,
-    int text_align
+    int text_align,
+    int text_direction,
+    const DriftStrutStyle* strut,
+    const char* ellipsis
 ) {
-    return drift_skia_rich_paragraph_create_impl(spans, span_count, max_lines, text_align);
+    return drift_skia_rich_paragraph_create_impl(spans, span_count, max_lines, text_align, text_direction, strut, ellipsis);
 }
 
 DriftSkiaPath drift_skia_path_create(int fill_type) {
@@ -1245,9 +1544,138 @@ void drift_skia_path_close(DriftSkiaPath path) {
     drift_skia_path_close_impl(path);
 }
 
+void drift_skia_canvas_draw_path_shader(
+    DriftSkiaCanvas canvas, DriftSkiaPath path,
+    DriftSkiaRuntimeEffect effect, const float* uniforms, int uniform_count,
+    int style, float stroke_width, int flags,
+    int stroke_cap, int stroke_join, float miter_limit,
+    const float* dash_intervals, int dash_count, float dash_phase,
+    int blend_mode, float alpha
+) {
+    if (!canvas || !path) {
+        return;
+    }
+    auto shader = drift_skia_runtime_shader(effect, uniforms, uniform_count);
+    if (!shader) {
+        return;
+    }
+    // The shader supplies the color; opaque black keeps alpha untouched.
+    SkPaint paint = make_paint_ext(0xFF000000, style, stroke_width, flags,
+        stroke_cap, stroke_join, miter_limit,
+        dash_intervals, dash_count, dash_phase,
+        blend_mode, alpha);
+    paint.setShader(shader);
+    reinterpret_cast<SkCanvas*>(canvas)->drawPath(drift_skia_path_snapshot(path), paint);
+}
+
+void drift_skia_canvas_draw_vertices(
+    DriftSkiaCanvas canvas, int mode,
+    const float* positions, int vertex_count,
+    const float* tex_coords, const uint32_t* colors,
+    const uint16_t* indices, int index_count,
+    const uint8_t* pixels, int width, int height, int stride, int filter_quality,
+    DriftSkiaRuntimeEffect effect, const float* uniforms, int uniform_count,
+    uint32_t argb, int blend_mode, float alpha
+) {
+    if (!canvas || !positions || vertex_count <= 0 || mode < 0 || mode > 2) {
+        return;
+    }
+    std::vector<SkPoint> points(vertex_count);
+    for (int i = 0; i < vertex_count; i++) {
+        points[i] = SkPoint::Make(positions[i * 2], positions[i * 2 + 1]);
+    }
+    std::vector<SkPoint> texs;
+    if (tex_coords) {
+        texs.resize(vertex_count);
+        for (int i = 0; i < vertex_count; i++) {
+            texs[i] = SkPoint::Make(tex_coords[i * 2], tex_coords[i * 2 + 1]);
+        }
+    }
+    std::vector<SkColor> cols;
+    if (colors) {
+        cols.resize(vertex_count);
+        for (int i = 0; i < vertex_count; i++) {
+            cols[i] = to_sk_color(colors[i]);
+        }
+    }
+    auto vertices = SkVertices::MakeCopy(
+        static_cast<SkVertices::VertexMode>(mode), vertex_count, points.data(),
+        texs.empty() ? nullptr : texs.data(),
+        cols.empty() ? nullptr : cols.data(),
+        indices ? index_count : 0, indices);
+    if (!vertices) {
+        return;
+    }
+
+    SkPaint paint;
+    paint.setAntiAlias(true);
+    SkColor color = to_sk_color(argb);
+    float clamped_alpha = std::clamp(alpha, 0.0f, 1.0f);
+    paint.setColor(SkColorSetA(color, static_cast<int>(SkColorGetA(color) * clamped_alpha)));
+    paint.setBlendMode(static_cast<SkBlendMode>(blend_mode));
+
+    if (pixels && width > 0 && height > 0 && stride > 0) {
+        SkImageInfo info = SkImageInfo::Make(width, height, kRGBA_8888_SkColorType, kPremul_SkAlphaType);
+        auto data = SkData::MakeWithCopy(pixels, static_cast<size_t>(stride) * height);
+        auto image = data ? SkImages::RasterFromData(info, data, stride) : nullptr;
+        if (!image) {
+            return;
+        }
+        paint.setShader(image->makeShader(SkTileMode::kClamp, SkTileMode::kClamp, make_sampling_options(filter_quality)));
+        // The texture supplies color; keep only the paint's alpha.
+        paint.setColor(SkColorSetA(SK_ColorBLACK, static_cast<int>(255 * clamped_alpha)));
+    } else if (effect) {
+        auto shader = drift_skia_runtime_shader(effect, uniforms, uniform_count);
+        if (!shader) {
+            return;
+        }
+        paint.setShader(shader);
+        paint.setColor(SkColorSetA(SK_ColorBLACK, static_cast<int>(255 * clamped_alpha)));
+    }
+
+    reinterpret_cast<SkCanvas*>(canvas)->drawVertices(vertices, SkBlendMode::kModulate, paint);
+}
+
+DriftSkiaRuntimeEffect drift_skia_runtime_effect_make(const char* sksl, char* error, int error_capacity) {
+    return drift_skia_runtime_effect_make_impl(sksl, error, error_capacity);
+}
+
+void drift_skia_runtime_effect_destroy(DriftSkiaRuntimeEffect effect) {
+    drift_skia_runtime_effect_destroy_impl(effect);
+}
+
+int drift_skia_runtime_effect_uniform_count(DriftSkiaRuntimeEffect effect) {
+    return drift_skia_runtime_effect_uniform_count_impl(effect);
+}
+
+int drift_skia_runtime_effect_uniform_info(
+    DriftSkiaRuntimeEffect effect, int index,
+    char* name, int name_capacity,
+    int* offset, int* count, int* is_int
+) {
+    return drift_skia_runtime_effect_uniform_info_impl(effect, index, name, name_capacity, offset, count, is_int);
+}
+
+int drift_skia_runtime_effect_child_count(DriftSkiaRuntimeEffect effect) {
+    return drift_skia_runtime_effect_child_count_impl(effect);
+}
+
+int drift_skia_path_op(DriftSkiaPath one, DriftSkiaPath two, int op, DriftSkiaPath result) {
+    return drift_skia_path_op_impl(one, two, op, result);
+}
+
+int drift_skia_path_read(
+    DriftSkiaPath path,
+    uint8_t* verbs, int verb_capacity,
+    float* points, int point_capacity,
+    int* verb_count, int* point_count, int* fill_type
+) {
+    return drift_skia_path_read_impl(path, verbs, verb_capacity, points, point_capacity, verb_count, point_count, fill_type);
+}
+
 void drift_skia_canvas_draw_path(
     DriftSkiaCanvas canvas, DriftSkiaPath path,
-    uint32_t argb, int style, float stroke_width, int aa,
+    uint32_t argb, int style, float stroke_width, int flags,
     int stroke_cap, int stroke_join, float miter_limit,
     const float* dash_intervals, int dash_count, float dash_phase,
     int blend_mode, float alpha
@@ -1255,7 +1683,7 @@ void drift_skia_canvas_draw_path(
     if (!canvas || !path) {
         return;
     }
-    SkPaint paint = make_paint_ext(argb, style, stroke_width, aa,
+    SkPaint paint = make_paint_ext(argb, style, stroke_width, flags,
         stroke_cap, stroke_join, miter_limit,
         dash_intervals, dash_count, dash_phase,
         blend_mode, alpha);
@@ -1530,6 +1958,10 @@ int drift_skia_skottie_get_duration(DriftSkiaSkottie anim, float* duration) {
     return drift_skia_skottie_get_duration_impl(anim, duration);
 }
 
+int drift_skia_skottie_get_fps(DriftSkiaSkottie anim, float* fps) {
+    return drift_skia_skottie_get_fps_impl(anim, fps);
+}
+
 int drift_skia_skottie_get_size(DriftSkiaSkottie anim, float* width, float* height) {
     return drift_skia_skottie_get_size_impl(anim, width, height);
 }
@@ -1542,6 +1974,67 @@ void drift_skia_skottie_render(DriftSkiaSkottie anim, DriftSkiaCanvas canvas, fl
     drift_skia_skottie_render_impl(anim, canvas, width, height);
 }
 
+int drift_skia_rive_supported(void) {
+    return drift_skia_rive_supported_impl();
+}
+
+DriftSkiaRiveFile drift_skia_rive_file_create(const uint8_t* data, int length, char* error, int error_capacity) {
+    return drift_skia_rive_file_create_impl(data, length, error, error_capacity);
+}
+
+void drift_skia_rive_file_destroy(DriftSkiaRiveFile file) {
+    drift_skia_rive_file_destroy_impl(file);
+}
+
+DriftSkiaRive drift_skia_rive_create(DriftSkiaRiveFile file, const char* artboard, const char* state_machine,
+    char* error, int error_capacity) {
+    return drift_skia_rive_create_impl(file, artboard, state_machine, error, error_capacity);
+}
+
+void drift_skia_rive_destroy(DriftSkiaRive rive) {
+    drift_skia_rive_destroy_impl(rive);
+}
+
+int drift_skia_rive_get_size(DriftSkiaRive rive, float* width, float* height) {
+    return drift_skia_rive_get_size_impl(rive, width, height);
+}
+
+int drift_skia_rive_input_count(DriftSkiaRive rive) {
+    return drift_skia_rive_input_count_impl(rive);
+}
+
+int drift_skia_rive_input_info(DriftSkiaRive rive, int index, char* name, int name_capacity, int* type) {
+    return drift_skia_rive_input_info_impl(rive, index, name, name_capacity, type);
+}
+
+int drift_skia_rive_set_bool(DriftSkiaRive rive, const char* name, int value) {
+    return drift_skia_rive_set_bool_impl(rive, name, value);
+}
+
+int drift_skia_rive_set_number(DriftSkiaRive rive, const char* name, float value) {
+    return drift_skia_rive_set_number_impl(rive, name, value);
+}
+
+int drift_skia_rive_fire_trigger(DriftSkiaRive rive, const char* name) {
+    return drift_skia_rive_fire_trigger_impl(rive, name);
+}
+
+int drift_skia_rive_get_value(DriftSkiaRive rive, const char* name, float* value) {
+    return drift_skia_rive_get_value_impl(rive, name, value);
+}
+
+int drift_skia_rive_advance(DriftSkiaRive rive, float seconds) {
+    return drift_skia_rive_advance_impl(rive, seconds);
+}
+
+int drift_skia_rive_pointer(DriftSkiaRive rive, int kind, float x, float y, float width, float height) {
+    return drift_skia_rive_pointer_impl(rive, kind, x, y, width, height);
+}
+
+void drift_skia_rive_render(DriftSkiaRive rive, DriftSkiaCanvas canvas, float width, float height) {
+    drift_skia_rive_render_impl(rive, canvas, width, height);
+}
+
 void drift_skia_context_flush_and_submit(DriftSkiaContext ctx, int sync_cpu) {
     if (!ctx) {
         return;
@@ -1610,6 +2103,7 @@ static SkPaint read_paint(const float* data, int& i) {
     float miter = rf(data, i);
     int blend = static_cast<int>(rf(data, i));
     float alpha = rf(data, i);
+    int flags = static_cast<int>(rf(data, i));
 
     // Dash
     int dash_count = static_cast<int>(rf(data, i));
@@ -1621,7 +2115,7 @@ static SkPaint read_paint(const float* data, int& i) {
         dash_phase = rf(data, i);
     }
 
-    SkPaint paint = make_paint_ext(argb, style, stroke_width, 1,
+    SkPaint paint = make_paint_ext(argb, style, stroke_width, flags,
         cap, join, miter,
         dash_ptr, dash_count, dash_phase,
         blend, alpha);
@@ -5,6 +5,7 @@
 #ifndef DRIFT_SKIA_COMMON_INTERNAL_H
 #define DRIFT_SKIA_COMMON_INTERNAL_H
 
+#include "core/SkColorSpace.h"
 #include "core/SkFontMgr.h"
 
 // Returns the platform font manager (Core Text on Apple, Android NDK on Android).
@@ -13,4 +14,14 @@ sk_sp<SkFontMgr> drift_get_font_manager();
 // Returns the platform fallback font name ("SF Pro Text" on Apple, "sans-serif" on Android).
 const char* drift_platform_fallback_font();
 
+// Color space values shared with the Go side (graphics.ColorSpace).
+enum {
+    kDriftColorSpaceSRGB = 0,
+    kDriftColorSpaceDisplayP3 = 1,
+};
+
+// Returns the Skia color space for a graphics.ColorSpace value, defaulting to
+// sRGB. Defined in skia_common.cc for use by every backend.
+sk_sp<SkColorSpace> drift_color_space(int color_space);
+
 #endif  // DRIFT_SKIA_COMMON_INTERNAL_H
@@ -77,7 +77,7 @@ void drift_skia_context_destroy(DriftSkiaContext ctx) {
     reinterpret_cast<GrDirectContext*>(ctx)->unref();
 }
 
-DriftSkiaSurface drift_skia_surface_create_metal(DriftSkiaContext ctx, void* texture, int width, int height) {
+DriftSkiaSurface drift_skia_surface_create_metal(DriftSkiaContext ctx, void* texture, int width, int height, int color_space) {
     if (!ctx || !texture || width <= 0 || height <= 0) {
         return nullptr;
     }
@@ -97,7 +97,7 @@ DriftSkiaSurface drift_skia_surface_create_metal(DriftSkiaContext ctx, void* tex
         backend_target,
         kTopLeft_GrSurfaceOrigin,
         kRGBA_8888_SkColorType,
-        SkColorSpace::MakeSRGB(),
+        drift_color_space(color_space),
         &props
     );
 
@@ -128,9 +128,9 @@ DriftSkiaContext drift_skia_context_create_vulkan(
 }
 
 DriftSkiaSurface drift_skia_surface_create_vulkan(
-    DriftSkiaContext ctx, int width, int height, uintptr_t vk_image, uint32_t vk_format
+    DriftSkiaContext ctx, int width, int height, uintptr_t vk_image, uint32_t vk_format, int color_space
 ) {
-    (void)ctx; (void)width; (void)height; (void)vk_image; (void)vk_format;
+    (void)ctx; (void)width; (void)height; (void)vk_image; (void)vk_format; (void)color_space;
     return nullptr;
 }
 
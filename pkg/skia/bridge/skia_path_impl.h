@@ -7,6 +7,7 @@
 #include "../skia_bridge.h"
 #include "core/SkPath.h"
 #include "core/SkPathBuilder.h"
+#include "pathops/SkPathOps.h"
 
 inline DriftSkiaPath drift_skia_path_create_impl(int fill_type) {
     SkPathFillType ft = (fill_type == 1) ? SkPathFillType::kEvenOdd : SkPathFillType::kWinding;
@@ -59,4 +60,89 @@ inline SkPath drift_skia_path_snapshot(DriftSkiaPath path) {
     return reinterpret_cast<SkPathBuilder*>(path)->snapshot();
 }
 
+inline int drift_skia_path_op_impl(DriftSkiaPath one, DriftSkiaPath two, int op, DriftSkiaPath result) {
+    if (!one || !two || !result || op < kDifference_SkPathOp || op > kReverseDifference_SkPathOp) {
+        return 0;
+    }
+    SkPath out;
+    if (!Op(drift_skia_path_snapshot(one), drift_skia_path_snapshot(two), static_cast<SkPathOp>(op), &out)) {
+        return 0;
+    }
+    *reinterpret_cast<SkPathBuilder*>(result) = SkPathBuilder(out);
+    return 1;
+}
+
+inline int drift_skia_path_read_impl(
+    DriftSkiaPath path,
+    uint8_t* verbs, int verb_capacity,
+    float* points, int point_capacity,
+    int* verb_count, int* point_count, int* fill_type
+) {
+    if (!path || !verb_count || !point_count || !fill_type) {
+        return 0;
+    }
+    SkPath p = drift_skia_path_snapshot(path);
+    int nv = 0;
+    int np = 0;
+    auto verb = [&](uint8_t v) {
+        if (verbs && nv < verb_capacity) {
+            verbs[nv] = v;
+        }
+        nv++;
+    };
+    auto point = [&](const SkPoint& pt) {
+        if (points && np + 1 < point_capacity) {
+            points[np] = pt.fX;
+            points[np + 1] = pt.fY;
+        }
+        np += 2;
+    };
+
+    SkPath::Iter iter(p, false);
+    SkPoint pts[4];
+    for (SkPath::Verb v = iter.next(pts); v != SkPath::kDone_Verb; v = iter.next(pts)) {
+        switch (v) {
+            case SkPath::kMove_Verb:
+                verb(0);
+                point(pts[0]);
+                break;
+            case SkPath::kLine_Verb:
+                verb(1);
+                point(pts[1]);
+                break;
+            case SkPath::kQuad_Verb:
+                verb(2);
+                point(pts[1]);
+                point(pts[2]);
+                break;
+            case SkPath::kConic_Verb: {
+                constexpr int kPow2 = 2;
+                SkPoint quads[1 + 2 * (1 << kPow2)];
+                int count = SkPath::ConvertConicToQuads(pts[0], pts[1], pts[2], iter.conicWeight(), quads, kPow2);
+                for (int i = 0; i < count; i++) {
+                    verb(2);
+                    point(quads[1 + 2 * i]);
+                    point(quads[2 + 2 * i]);
+                }
+                break;
+            }
+            case SkPath::kCubic_Verb:
+                verb(3);
+                point(pts[1]);
+                point(pts[2]);
+                point(pts[3]);
+                break;
+            case SkPath::kClose_Verb:
+                verb(4);
+                break;
+            default:
+                break;
+        }
+    }
+    *verb_count = nv;
+    *point_count = np;
+    *fill_type = p.getFillType() == SkPathFillType::kEvenOdd ? 1 : 0;
+    return 1;
+}
+
 #endif  // DRIFT_SKIA_PATH_IMPL_H
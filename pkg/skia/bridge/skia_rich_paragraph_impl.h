@@ -18,15 +18,8 @@ inline skia::textlayout::TextStyle span_to_text_style_impl(const DriftTextSpan&
     SkFontStyle::Slant slant = (span.style == 1) ? SkFontStyle::kItalic_Slant : SkFontStyle::kUpright_Slant;
     int weight = std::clamp(span.weight > 0 ? span.weight : 400, 100, 900);
     text_style.setFontStyle(SkFontStyle(weight, SkFontStyle::kNormal_Width, slant));
-    if (span.family && span.family[0] != '\0') {
-        std::vector<SkString> families;
-        families.emplace_back(span.family);
-        text_style.setFontFamilies(families);
-    }
-    auto typeface = resolve_typeface(span.family, weight, span.style);
-    if (typeface) {
-        text_style.setTypeface(typeface);
-    }
+    text_style.setFontFamilies(paragraph_font_families(span.family));
+    apply_font_variations(text_style, span.variation_weight, span.variation_width, span.variation_slant);
     text_style.setColor(to_sk_color(span.color));
     if (span.letter_spacing != 0) {
         text_style.setLetterSpacing(span.letter_spacing);
@@ -57,7 +50,10 @@ inline DriftSkiaParagraph drift_skia_rich_paragraph_create_impl(
     const DriftTextSpan* spans,
     int span_count,
     int max_lines,
-    int text_align
+    int text_align,
+    int text_direction,
+    const DriftStrutStyle* strut,
+    const char* ellipsis
 ) {
     if (!spans || span_count <= 0) {
         return nullptr;
@@ -71,12 +67,25 @@ inline DriftSkiaParagraph drift_skia_rich_paragraph_create_impl(
         paragraph_style.setMaxLines(static_cast<size_t>(max_lines));
     }
     paragraph_style.setTextAlign(static_cast<skia::textlayout::TextAlign>(text_align));
+    paragraph_style.setTextDirection(to_text_direction(text_direction));
+    apply_strut_style(paragraph_style, strut);
+    if (ellipsis && ellipsis[0] != '\0') {
+        paragraph_style.setEllipsis(SkString(ellipsis));
+    }
     auto unicode = SkUnicodes::Libgrapheme::Make();
     auto builder = skia::textlayout::ParagraphBuilder::make(paragraph_style, collection, unicode);
     for (int i = 0; i < span_count; ++i) {
         const auto& span = spans[i];
         builder->pushStyle(span_to_text_style_impl(span));
-        if (span.text) {
+        if (span.is_placeholder != 0) {
+            builder->addPlaceholder(skia::textlayout::PlaceholderStyle(
+                span.placeholder_width,
+                span.placeholder_height,
+                static_cast<skia::textlayout::PlaceholderAlignment>(span.placeholder_alignment),
+                skia::textlayout::TextBaseline::kAlphabetic,
+                span.placeholder_baseline_offset
+            ));
+        } else if (span.text) {
             builder->addText(span.text);
         }
         builder->pop();
@@ -0,0 +1,291 @@
+#ifndef DRIFT_SKIA_RIVE_IMPL_H
+#define DRIFT_SKIA_RIVE_IMPL_H
+
+// Rive support requires the Rive C++ runtime and its Skia renderer, which are
+// not part of Skia. Build the bridge with -DDRIFT_SKIA_ENABLE_RIVE and link
+// the runtime libraries to enable it; otherwise every function reports that
+// Rive is unavailable.
+
+#include <algorithm>
+#include <cstring>
+#include <string>
+
+#include "../skia_bridge.h"
+
+namespace drift_skia_rive_impl {
+
+inline void copy_string(const std::string& src, char* dst, int capacity) {
+    if (!dst || capacity <= 0) {
+        return;
+    }
+    size_t n = std::min(src.size(), static_cast<size_t>(capacity - 1));
+    std::memcpy(dst, src.data(), n);
+    dst[n] = '\0';
+}
+
+}  // namespace drift_skia_rive_impl
+
+#ifdef DRIFT_SKIA_ENABLE_RIVE
+
+#include <memory>
+
+#include "core/SkCanvas.h"
+#include "rive/animation/state_machine_bool.hpp"
+#include "rive/animation/state_machine_input_instance.hpp"
+#include "rive/animation/state_machine_instance.hpp"
+#include "rive/animation/state_machine_number.hpp"
+#include "rive/animation/state_machine_trigger.hpp"
+#include "rive/artboard.hpp"
+#include "rive/file.hpp"
+#include "rive/layout.hpp"
+#include "skia_factory.hpp"
+#include "skia_renderer.hpp"
+
+namespace drift_skia_rive_impl {
+
+using FilePtr = decltype(rive::File::import(rive::Span<const uint8_t>(), nullptr));
+
+// RiveFile owns an imported file. Instances share it so the file outlives
+// every artboard created from it, whichever is destroyed first.
+struct RiveFile {
+    std::shared_ptr<FilePtr> file;
+};
+
+struct RiveInstance {
+    std::shared_ptr<FilePtr> file;
+    std::unique_ptr<rive::ArtboardInstance> artboard;
+    std::unique_ptr<rive::StateMachineInstance> machine;
+};
+
+inline rive::SkiaFactory* factory() {
+    static rive::SkiaFactory instance;
+    return &instance;
+}
+
+inline RiveInstance* as_instance(DriftSkiaRive rive) {
+    return reinterpret_cast<RiveInstance*>(rive);
+}
+
+// Maps the artboard into a width x height box, scaled to fit and centered.
+inline rive::Mat2D artboard_transform(RiveInstance* inst, float width, float height) {
+    return rive::computeAlignment(
+        rive::Fit::contain, rive::Alignment::center,
+        rive::AABB(0, 0, width, height), inst->artboard->bounds());
+}
+
+}  // namespace drift_skia_rive_impl
+
+inline int drift_skia_rive_supported_impl() {
+    return 1;
+}
+
+inline DriftSkiaRiveFile drift_skia_rive_file_create_impl(const uint8_t* data, int length, char* error, int error_capacity) {
+    using namespace drift_skia_rive_impl;
+    if (!data || length <= 0) {
+        copy_string("empty Rive data", error, error_capacity);
+        return nullptr;
+    }
+    rive::ImportResult result;
+    auto file = rive::File::import(rive::Span<const uint8_t>(data, static_cast<size_t>(length)), factory(), &result);
+    if (!file || result != rive::ImportResult::success) {
+        copy_string(result == rive::ImportResult::unsupportedVersion ? "unsupported Rive file version" : "malformed Rive file",
+                    error, error_capacity);
+        return nullptr;
+    }
+    return new RiveFile{std::make_shared<FilePtr>(std::move(file))};
+}
+
+inline void drift_skia_rive_file_destroy_impl(DriftSkiaRiveFile file) {
+    delete reinterpret_cast<drift_skia_rive_impl::RiveFile*>(file);
+}
+
+inline DriftSkiaRive drift_skia_rive_create_impl(
+    DriftSkiaRiveFile file, const char* artboard, const char* state_machine,
+    char* error, int error_capacity
+) {
+    using namespace drift_skia_rive_impl;
+    if (!file) {
+        copy_string("nil Rive file", error, error_capacity);
+        return nullptr;
+    }
+    auto shared = reinterpret_cast<RiveFile*>(file)->file;
+    auto inst = std::make_unique<RiveInstance>();
+    inst->file = shared;
+    inst->artboard = (artboard && artboard[0]) ? (*shared)->artboardNamed(artboard) : (*shared)->artboardDefault();
+    if (!inst->artboard) {
+        copy_string(std::string("no artboard named \"") + (artboard ? artboard : "") + "\"", error, error_capacity);
+        return nullptr;
+    }
+    if (state_machine && state_machine[0]) {
+        inst->machine = inst->artboard->stateMachineNamed(state_machine);
+        if (!inst->machine) {
+            copy_string(std::string("no state machine named \"") + state_machine + "\"", error, error_capacity);
+            return nullptr;
+        }
+    } else {
+        inst->machine = inst->artboard->defaultStateMachine();
+        if (!inst->machine && inst->artboard->stateMachineCount() > 0) {
+            inst->machine = inst->artboard->stateMachineAt(0);
+        }
+    }
+    inst->artboard->advance(0);
+    if (inst->machine) {
+        inst->machine->advanceAndApply(0);
+    }
+    return inst.release();
+}
+
+inline void drift_skia_rive_destroy_impl(DriftSkiaRive rive) {
+    delete drift_skia_rive_impl::as_instance(rive);
+}
+
+inline int drift_skia_rive_get_size_impl(DriftSkiaRive rive, float* width, float* height) {
+    if (!rive || !width || !height) return 0;
+    auto bounds = drift_skia_rive_impl::as_instance(rive)->artboard->bounds();
+    *width = bounds.width();
+    *height = bounds.height();
+    return 1;
+}
+
+inline int drift_skia_rive_input_count_impl(DriftSkiaRive rive) {
+    auto inst = drift_skia_rive_impl::as_instance(rive);
+    if (!inst || !inst->machine) return 0;
+    return static_cast<int>(inst->machine->inputCount());
+}
+
+inline int drift_skia_rive_input_info_impl(DriftSkiaRive rive, int index, char* name, int name_capacity, int* type) {
+    using namespace drift_skia_rive_impl;
+    auto inst = as_instance(rive);
+    if (!inst || !inst->machine || index < 0 || static_cast<size_t>(index) >= inst->machine->inputCount()) {
+        return 0;
+    }
+    const rive::SMIInput* input = inst->machine->input(static_cast<size_t>(index));
+    copy_string(input->name(), name, name_capacity);
+    if (type) {
+        if (input->input()->is<rive::StateMachineBool>()) {
+            *type = 0;
+        } else if (input->input()->is<rive::StateMachineNumber>()) {
+            *type = 1;
+        } else {
+            *type = 2;
+        }
+    }
+    return 1;
+}
+
+inline int drift_skia_rive_set_bool_impl(DriftSkiaRive rive, const char* name, int value) {
+    auto inst = drift_skia_rive_impl::as_instance(rive);
+    if (!inst || !inst->machine || !name) return 0;
+    auto input = inst->machine->getBool(name);
+    if (!input) return 0;
+    input->value(value != 0);
+    return 1;
+}
+
+inline int drift_skia_rive_set_number_impl(DriftSkiaRive rive, const char* name, float value) {
+    auto inst = drift_skia_rive_impl::as_instance(rive);
+    if (!inst || !inst->machine || !name) return 0;
+    auto input = inst->machine->getNumber(name);
+    if (!input) return 0;
+    input->value(value);
+    return 1;
+}
+
+inline int drift_skia_rive_fire_trigger_impl(DriftSkiaRive rive, const char* name) {
+    auto inst = drift_skia_rive_impl::as_instance(rive);
+    if (!inst || !inst->machine || !name) return 0;
+    auto input = inst->machine->getTrigger(name);
+    if (!input) return 0;
+    input->fire();
+    return 1;
+}
+
+inline int drift_skia_rive_get_value_impl(DriftSkiaRive rive, const char* name, float* value) {
+    auto inst = drift_skia_rive_impl::as_instance(rive);
+    if (!inst || !inst->machine || !name || !value) return 0;
+    if (auto input = inst->machine->getBool(name)) {
+        *value = input->value() ? 1.0f : 0.0f;
+        return 1;
+    }
+    if (auto input = inst->machine->getNumber(name)) {
+        *value = input->value();
+        return 1;
+    }
+    return 0;
+}
+
+inline int drift_skia_rive_advance_impl(DriftSkiaRive rive, float seconds) {
+    auto inst = drift_skia_rive_impl::as_instance(rive);
+    if (!inst) return 0;
+    if (inst->machine) {
+        return inst->machine->advanceAndApply(seconds) ? 1 : 0;
+    }
+    return inst->artboard->advance(seconds) ? 1 : 0;
+}
+
+inline int drift_skia_rive_pointer_impl(DriftSkiaRive rive, int kind, float x, float y, float width, float height) {
+    using namespace drift_skia_rive_impl;
+    auto inst = as_instance(rive);
+    if (!inst || !inst->machine || width <= 0 || height <= 0) return 0;
+    rive::Mat2D inverse;
+    if (!artboard_transform(inst, width, height).invert(&inverse)) return 0;
+    rive::Vec2D local = inverse * rive::Vec2D(x, y);
+    rive::HitResult hit;
+    switch (kind) {
+        case 0:
+            hit = inst->machine->pointerDown(local);
+            break;
+        case 1:
+            hit = inst->machine->pointerMove(local);
+            break;
+        default:
+            hit = inst->machine->pointerUp(local);
+            break;
+    }
+    return hit != rive::HitResult::none ? 1 : 0;
+}
+
+inline void drift_skia_rive_render_impl(DriftSkiaRive rive, DriftSkiaCanvas canvas, float width, float height) {
+    using namespace drift_skia_rive_impl;
+    auto inst = as_instance(rive);
+    if (!inst || !canvas || width <= 0 || height <= 0) return;
+    rive::SkiaRenderer renderer(reinterpret_cast<SkCanvas*>(canvas));
+    renderer.save();
+    renderer.transform(artboard_transform(inst, width, height));
+    inst->artboard->draw(&renderer);
+    renderer.restore();
+}
+
+#else  // DRIFT_SKIA_ENABLE_RIVE
+
+inline int drift_skia_rive_supported_impl() {
+    return 0;
+}
+
+inline DriftSkiaRiveFile drift_skia_rive_file_create_impl(const uint8_t*, int, char* error, int error_capacity) {
+    drift_skia_rive_impl::copy_string("Rive runtime not linked", error, error_capacity);
+    return nullptr;
+}
+
+inline void drift_skia_rive_file_destroy_impl(DriftSkiaRiveFile) {}
+
+inline DriftSkiaRive drift_skia_rive_create_impl(DriftSkiaRiveFile, const char*, const char*, char* error, int error_capacity) {
+    drift_skia_rive_impl::copy_string("Rive runtime not linked", error, error_capacity);
+    return nullptr;
+}
+
+inline void drift_skia_rive_destroy_impl(DriftSkiaRive) {}
+inline int drift_skia_rive_get_size_impl(DriftSkiaRive, float*, float*) { return 0; }
+inline int drift_skia_rive_input_count_impl(DriftSkiaRive) { return 0; }
+inline int drift_skia_rive_input_info_impl(DriftSkiaRive, int, char*, int, int*) { return 0; }
+inline int drift_skia_rive_set_bool_impl(DriftSkiaRive, const char*, int) { return 0; }
+inline int drift_skia_rive_set_number_impl(DriftSkiaRive, const char*, float) { return 0; }
+inline int drift_skia_rive_fire_trigger_impl(DriftSkiaRive, const char*) { return 0; }
+inline int drift_skia_rive_get_value_impl(DriftSkiaRive, const char*, float*) { return 0; }
+inline int drift_skia_rive_advance_impl(DriftSkiaRive, float) { return 0; }
+inline int drift_skia_rive_pointer_impl(DriftSkiaRive, int, float, float, float, float) { return 0; }
+inline void drift_skia_rive_render_impl(DriftSkiaRive, DriftSkiaCanvas, float, float) {}
+
+#endif  // DRIFT_SKIA_ENABLE_RIVE
+
+#endif
@@ -0,0 +1,135 @@
+#ifndef DRIFT_SKIA_RUNTIME_EFFECT_IMPL_H
+#define DRIFT_SKIA_RUNTIME_EFFECT_IMPL_H
+
+#include <algorithm>
+#include <cstring>
+#include <string>
+
+#include "../skia_bridge.h"
+#include "core/SkData.h"
+#include "core/SkImageFilter.h"
+#include "core/SkShader.h"
+#include "core/SkString.h"
+#include "effects/SkImageFilters.h"
+#include "effects/SkRuntimeEffect.h"
+
+namespace drift_skia_runtime_effect_impl {
+
+inline SkRuntimeEffect* as_effect(DriftSkiaRuntimeEffect effect) {
+    return reinterpret_cast<SkRuntimeEffect*>(effect);
+}
+
+inline bool is_int_uniform(SkRuntimeEffect::Uniform::Type type) {
+    using Type = SkRuntimeEffect::Uniform::Type;
+    return type == Type::kInt || type == Type::kInt2 || type == Type::kInt3 || type == Type::kInt4;
+}
+
+inline void copy_string(const std::string& src, char* dst, int capacity) {
+    if (!dst || capacity <= 0) {
+        return;
+    }
+    size_t n = std::min(src.size(), static_cast<size_t>(capacity - 1));
+    std::memcpy(dst, src.data(), n);
+    dst[n] = '\0';
+}
+
+}  // namespace drift_skia_runtime_effect_impl
+
+inline DriftSkiaRuntimeEffect drift_skia_runtime_effect_make_impl(const char* sksl, char* error, int error_capacity) {
+    using namespace drift_skia_runtime_effect_impl;
+    if (!sksl) {
+        copy_string("empty shader source", error, error_capacity);
+        return nullptr;
+    }
+    auto result = SkRuntimeEffect::MakeForShader(SkString(sksl));
+    if (!result.effect) {
+        copy_string(std::string(result.errorText.c_str()), error, error_capacity);
+        return nullptr;
+    }
+    return result.effect.release();
+}
+
+inline void drift_skia_runtime_effect_destroy_impl(DriftSkiaRuntimeEffect effect) {
+    SkSafeUnref(drift_skia_runtime_effect_impl::as_effect(effect));
+}
+
+inline int drift_skia_runtime_effect_uniform_count_impl(DriftSkiaRuntimeEffect effect) {
+    if (!effect) {
+        return 0;
+    }
+    return static_cast<int>(drift_skia_runtime_effect_impl::as_effect(effect)->uniforms().size());
+}
+
+inline int drift_skia_runtime_effect_uniform_info_impl(
+    DriftSkiaRuntimeEffect effect, int index,
+    char* name, int name_capacity,
+    int* offset, int* count, int* is_int
+) {
+    using namespace drift_skia_runtime_effect_impl;
+    if (!effect || index < 0) {
+        return 0;
+    }
+    auto uniforms = as_effect(effect)->uniforms();
+    if (static_cast<size_t>(index) >= uniforms.size()) {
+        return 0;
+    }
+    const auto& u = uniforms[index];
+    copy_string(std::string(u.name), name, name_capacity);
+    if (offset) *offset = static_cast<int>(u.offset / sizeof(float));
+    if (count) *count = static_cast<int>(u.sizeInBytes() / sizeof(float));
+    if (is_int) *is_int = is_int_uniform(u.type) ? 1 : 0;
+    return 1;
+}
+
+inline int drift_skia_runtime_effect_child_count_impl(DriftSkiaRuntimeEffect effect) {
+    if (!effect) {
+        return 0;
+    }
+    return static_cast<int>(drift_skia_runtime_effect_impl::as_effect(effect)->children().size());
+}
+
+// Creates a shader from an effect without children. Returns nullptr if the
+// uniform data is too short or the effect expects child shaders.
+inline sk_sp<SkShader> drift_skia_runtime_shader(DriftSkiaRuntimeEffect effect, const float* uniforms, int uniform_count) {
+    using namespace drift_skia_runtime_effect_impl;
+    if (!effect) {
+        return nullptr;
+    }
+    SkRuntimeEffect* e = as_effect(effect);
+    size_t size = e->uniformSize();
+    if (static_cast<size_t>(uniform_count) * sizeof(float) < size || (size > 0 && !uniforms)) {
+        return nullptr;
+    }
+    auto data = SkData::MakeWithCopy(uniforms, size);
+    return e->makeShader(std::move(data), {});
+}
+
+// Creates an image filter that runs the effect with the filtered content
+// bound to its single child shader.
+inline sk_sp<SkImageFilter> drift_skia_runtime_image_filter(
+    DriftSkiaRuntimeEffect effect,
+    const float* uniforms, int uniform_count,
+    sk_sp<SkImageFilter> input
+) {
+    using namespace drift_skia_runtime_effect_impl;
+    if (!effect) {
+        return nullptr;
+    }
+    SkRuntimeEffect* e = as_effect(effect);
+    if (static_cast<size_t>(uniform_count) * sizeof(float) < e->uniformSize() || e->children().size() != 1) {
+        return nullptr;
+    }
+    SkRuntimeShaderBuilder builder(sk_ref_sp(e));
+    for (const auto& u : e->uniforms()) {
+        const float* src = uniforms + u.offset / sizeof(float);
+        int n = static_cast<int>(u.sizeInBytes() / sizeof(float));
+        if (is_int_uniform(u.type)) {
+            builder.uniform(u.name).set(reinterpret_cast<const int*>(src), n);
+        } else {
+            builder.uniform(u.name).set(src, n);
+        }
+    }
+    return SkImageFilters::RuntimeShader(builder, std::string_view(), std::move(input));
+}
+
+#endif
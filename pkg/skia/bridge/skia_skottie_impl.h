@@ -30,6 +30,12 @@ inline int drift_skia_skottie_get_duration_impl(DriftSkiaSkottie anim, float* du
     return 1;
 }
 
+inline int drift_skia_skottie_get_fps_impl(DriftSkiaSkottie anim, float* fps) {
+    if (!anim || !fps) return 0;
+    *fps = static_cast<float>(reinterpret_cast<skottie::Animation*>(anim)->fps());
+    return 1;
+}
+
 inline int drift_skia_skottie_get_size_impl(DriftSkiaSkottie anim, float* width, float* height) {
     if (!anim || !width || !height) return 0;
     auto size = reinterpret_cast<skottie::Animation*>(anim)->size();
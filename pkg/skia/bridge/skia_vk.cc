@@ -153,11 +153,12 @@ void drift_skia_context_destroy(DriftSkiaContext ctx) {
     reinterpret_cast<GrDirectContext*>(ctx)->unref();
 }
 
-DriftSkiaSurface drift_skia_surface_create_metal(DriftSkiaContext ctx, void* texture, int width, int height) {
+DriftSkiaSurface drift_skia_surface_create_metal(DriftSkiaContext ctx, void* texture, int width, int height, int color_space) {
     (void)ctx;
     (void)texture;
     (void)width;
     (void)height;
+    (void)color_space;
     return nullptr;
 }
 
@@ -165,7 +166,8 @@ DriftSkiaSurface drift_skia_surface_create_vulkan(
     DriftSkiaContext ctx,
     int width, int height,
     uintptr_t vk_image,
-    uint32_t vk_format
+    uint32_t vk_format,
+    int color_space
 ) {
     if (!ctx || width <= 0 || height <= 0 || !vk_image) {
         return nullptr;
@@ -201,7 +203,7 @@ DriftSkiaSurface drift_skia_surface_create_vulkan(
         backend_target,
         kTopLeft_GrSurfaceOrigin,
         kRGBA_8888_SkColorType,
-        SkColorSpace::MakeSRGB(),
+        drift_color_space(color_space),
         &props
     );
 
@@ -73,14 +73,29 @@ type ParagraphMetrics struct {
 	LongestLine       float64
 	MaxIntrinsicWidth float64
 	LineCount         int
+	// DidExceedMaxLines reports whether lines were cut off by the line
+	// limit.
+	DidExceedMaxLines bool
 }
 
 // ParagraphLineMetrics reports per-line layout metrics.
 type ParagraphLineMetrics struct {
-	Widths   []float64
-	Ascents  []float64
-	Descents []float64
-	Heights  []float64
+	Widths    []float64
+	Ascents   []float64
+	Descents  []float64
+	Heights   []float64
+	Lefts     []float64
+	Baselines []float64
+	// Starts and Ends are the UTF-8 offsets of each line's text, with Ends
+	// including trailing whitespace and newlines.
+	Starts []int
+	Ends   []int
+}
+
+// ParagraphBox is the bounding box of a run of glyphs.
+type ParagraphBox struct {
+	Left, Top, Right, Bottom float64
+	RTL                      bool
 }
 
 // NewMetalContext creates a Skia GPU context using the provided Metal device/queue.
@@ -152,11 +167,12 @@ func (c *Context) MakeOffscreenSurfaceMetal(width, height int) (*Surface, error)
 }
 
 // MakeVulkanSurface creates a Skia surface wrapping the provided VkImage.
-func (c *Context) MakeVulkanSurface(width, height int, vkImage uintptr, vkFormat uint32) (*Surface, error) {
+// colorSpace tags the surface: 0 for sRGB, 1 for Display P3.
+func (c *Context) MakeVulkanSurface(width, height int, vkImage uintptr, vkFormat uint32, colorSpace int32) (*Surface, error) {
 	if c == nil || c.ptr == nil {
 		return nil, errors.New("skia: nil context")
 	}
-	surface := C.drift_skia_surface_create_vulkan(c.ptr, C.int(width), C.int(height), C.uintptr_t(vkImage), C.uint32_t(vkFormat))
+	surface := C.drift_skia_surface_create_vulkan(c.ptr, C.int(width), C.int(height), C.uintptr_t(vkImage), C.uint32_t(vkFormat), C.int(colorSpace))
 	if surface == nil {
 		return nil, errors.New("skia: failed to create Vulkan surface")
 	}
@@ -176,14 +192,15 @@ func (c *Context) MakeOffscreenSurfaceVulkan(width, height int) (*Surface, error
 }
 
 // MakeMetalSurface creates a Skia surface targeting the provided Metal texture.
-func (c *Context) MakeMetalSurface(texture unsafe.Pointer, width, height int) (*Surface, error) {
+// colorSpace tags the surface: 0 for sRGB, 1 for Display P3.
+func (c *Context) MakeMetalSurface(texture unsafe.Pointer, width, height int, colorSpace int32) (*Surface, error) {
 	if c == nil || c.ptr == nil {
 		return nil, errors.New("skia: nil context")
 	}
 	if texture == nil {
 		return nil, errors.New("skia: nil texture")
 	}
-	surface := C.drift_skia_surface_create_metal(c.ptr, texture, C.int(width), C.int(height))
+	surface := C.drift_skia_surface_create_metal(c.ptr, texture, C.int(width), C.int(height), C.int(colorSpace))
 	if surface == nil {
 		return nil, errors.New("skia: failed to create Metal surface")
 	}
@@ -328,7 +345,7 @@ func CanvasClear(canvas unsafe.Pointer, argb uint32) {
 func CanvasDrawRect(
 	canvas unsafe.Pointer,
 	left, top, right, bottom float32,
-	argb uint32, style int32, strokeWidth float32, aa bool,
+	argb uint32, style int32, strokeWidth float32, flags int32,
 	strokeCap, strokeJoin int32, miterLimit float32,
 	dashIntervals []float32, dashPhase float32,
 	blendMode int32, alpha float32,
@@ -337,7 +354,7 @@ func CanvasDrawRect(
 	C.drift_skia_canvas_draw_rect(
 		C.DriftSkiaCanvas(canvas),
 		C.float(left), C.float(top), C.float(right), C.float(bottom),
-		C.uint(argb), C.int(style), C.float(strokeWidth), boolToInt(aa),
+		C.uint(argb), C.int(style), C.float(strokeWidth), C.int(flags),
 		C.int(strokeCap), C.int(strokeJoin), C.float(miterLimit),
 		dashPtr, dashCount, C.float(dashPhase),
 		C.int(blendMode), C.float(alpha),
@@ -349,7 +366,7 @@ func CanvasDrawRRect(
 	canvas unsafe.Pointer,
 	left, top, right, bottom float32,
 	rx1, ry1, rx2, ry2, rx3, ry3, rx4, ry4 float32,
-	argb uint32, style int32, strokeWidth float32, aa bool,
+	argb uint32, style int32, strokeWidth float32, flags int32,
 	strokeCap, strokeJoin int32, miterLimit float32,
 	dashIntervals []float32, dashPhase float32,
 	blendMode int32, alpha float32,
@@ -362,7 +379,7 @@ func CanvasDrawRRect(
 		C.float(rx2), C.float(ry2),
 		C.float(rx3), C.float(ry3),
 		C.float(rx4), C.float(ry4),
-		C.uint(argb), C.int(style), C.float(strokeWidth), boolToInt(aa),
+		C.uint(argb), C.int(style), C.float(strokeWidth), C.int(flags),
 		C.int(strokeCap), C.int(strokeJoin), C.float(miterLimit),
 		dashPtr, dashCount, C.float(dashPhase),
 		C.int(blendMode), C.float(alpha),
@@ -373,7 +390,7 @@ func CanvasDrawRRect(
 func CanvasDrawCircle(
 	canvas unsafe.Pointer,
 	cx, cy, radius float32,
-	argb uint32, style int32, strokeWidth float32, aa bool,
+	argb uint32, style int32, strokeWidth float32, flags int32,
 	strokeCap, strokeJoin int32, miterLimit float32,
 	dashIntervals []float32, dashPhase float32,
 	blendMode int32, alpha float32,
@@ -382,7 +399,7 @@ func CanvasDrawCircle(
 	C.drift_skia_canvas_draw_circle(
 		C.DriftSkiaCanvas(canvas),
 		C.float(cx), C.float(cy), C.float(radius),
-		C.uint(argb), C.int(style), C.float(strokeWidth), boolToInt(aa),
+		C.uint(argb), C.int(style), C.float(strokeWidth), C.int(flags),
 		C.int(strokeCap), C.int(strokeJoin), C.float(miterLimit),
 		dashPtr, dashCount, C.float(dashPhase),
 		C.int(blendMode), C.float(alpha),
@@ -393,7 +410,7 @@ func CanvasDrawCircle(
 func CanvasDrawLine(
 	canvas unsafe.Pointer,
 	x1, y1, x2, y2 float32,
-	argb uint32, strokeWidth float32, aa bool,
+	argb uint32, strokeWidth float32, flags int32,
 	strokeCap, strokeJoin int32, miterLimit float32,
 	dashIntervals []float32, dashPhase float32,
 	blendMode int32, alpha float32,
@@ -402,7 +419,7 @@ func CanvasDrawLine(
 	C.drift_skia_canvas_draw_line(
 		C.DriftSkiaCanvas(canvas),
 		C.float(x1), C.float(y1), C.float(x2), C.float(y2),
-		C.uint(argb), C.float(strokeWidth), boolToInt(aa),
+		C.uint(argb), C.float(strokeWidth), C.int(flags),
 		C.int(strokeCap), C.int(strokeJoin), C.float(miterLimit),
 		dashPtr, dashCount, C.float(dashPhase),
 		C.int(blendMode), C.float(alpha),
@@ -413,7 +430,7 @@ func CanvasDrawLine(
 func CanvasDrawRectGradient(
 	canvas unsafe.Pointer,
 	left, top, right, bottom float32,
-	argb uint32, style int32, strokeWidth float32, aa bool,
+	argb uint32, style int32, strokeWidth float32, flags int32,
 	strokeCap, strokeJoin int32, miterLimit float32,
 	dashIntervals []float32, dashPhase float32,
 	blendMode int32, alpha float32,
@@ -427,7 +444,7 @@ func CanvasDrawRectGradient(
 	C.drift_skia_canvas_draw_rect_gradient(
 		C.DriftSkiaCanvas(canvas),
 		C.float(left), C.float(top), C.float(right), C.float(bottom),
-		C.uint(argb), C.int(style), C.float(strokeWidth), boolToInt(aa),
+		C.uint(argb), C.int(style), C.float(strokeWidth), C.int(flags),
 		C.int(strokeCap), C.int(strokeJoin), C.float(miterLimit),
 		dashPtr, dashCount, C.float(dashPhase),
 		C.int(blendMode), C.float(alpha),
@@ -443,7 +460,7 @@ func CanvasDrawRRectGradient(
 	canvas unsafe.Pointer,
 	left, top, right, bottom float32,
 	rx1, ry1, rx2, ry2, rx3, ry3, rx4, ry4 float32,
-	argb uint32, style int32, strokeWidth float32, aa bool,
+	argb uint32, style int32, strokeWidth float32, flags int32,
 	strokeCap, strokeJoin int32, miterLimit float32,
 	dashIntervals []float32, dashPhase float32,
 	blendMode int32, alpha float32,
@@ -459,7 +476,7 @@ func CanvasDrawRRectGradient(
 		C.float(left), C.float(top), C.float(right), C.float(bottom),
 		C.float(rx1), C.float(ry1), C.float(rx2), C.float(ry2),
 		C.float(rx3), C.float(ry3), C.float(rx4), C.float(ry4),
-		C.uint(argb), C.int(style), C.float(strokeWidth), boolToInt(aa),
+		C.uint(argb), C.int(style), C.float(strokeWidth), C.int(flags),
 		C.int(strokeCap), C.int(strokeJoin), C.float(miterLimit),
 		dashPtr, dashCount, C.float(dashPhase),
 		C.int(blendMode), C.float(alpha),
@@ -474,7 +491,7 @@ func CanvasDrawRRectGradient(
 func CanvasDrawCircleGradient(
 	canvas unsafe.Pointer,
 	cx, cy, radius float32,
-	argb uint32, style int32, strokeWidth float32, aa bool,
+	argb uint32, style int32, strokeWidth float32, flags int32,
 	strokeCap, strokeJoin int32, miterLimit float32,
 	dashIntervals []float32, dashPhase float32,
 	blendMode int32, alpha float32,
@@ -488,7 +505,7 @@ func CanvasDrawCircleGradient(
 	C.drift_skia_canvas_draw_circle_gradient(
 		C.DriftSkiaCanvas(canvas),
 		C.float(cx), C.float(cy), C.float(radius),
-		C.uint(argb), C.int(style), C.float(strokeWidth), boolToInt(aa),
+		C.uint(argb), C.int(style), C.float(strokeWidth), C.int(flags),
 		C.int(strokeCap), C.int(strokeJoin), C.float(miterLimit),
 		dashPtr, dashCount, C.float(dashPhase),
 		C.int(blendMode), C.float(alpha),
@@ -503,7 +520,7 @@ func CanvasDrawCircleGradient(
 func CanvasDrawLineGradient(
 	canvas unsafe.Pointer,
 	x1, y1, x2, y2 float32,
-	argb uint32, strokeWidth float32, aa bool,
+	argb uint32, strokeWidth float32, flags int32,
 	strokeCap, strokeJoin int32, miterLimit float32,
 	dashIntervals []float32, dashPhase float32,
 	blendMode int32, alpha float32,
@@ -517,7 +534,7 @@ func CanvasDrawLineGradient(
 	C.drift_skia_canvas_draw_line_gradient(
 		C.DriftSkiaCanvas(canvas),
 		C.float(x1), C.float(y1), C.float(x2), C.float(y2),
-		C.uint(argb), C.float(strokeWidth), boolToInt(aa),
+		C.uint(argb), C.float(strokeWidth), C.int(flags),
 		C.int(strokeCap), C.int(strokeJoin), C.float(miterLimit),
 		dashPtr, dashCount, C.float(dashPhase),
 		C.int(blendMode), C.float(alpha),
@@ -532,7 +549,7 @@ func CanvasDrawLineGradient(
 func CanvasDrawPathGradient(
 	canvas unsafe.Pointer,
 	path *Path,
-	argb uint32, style int32, strokeWidth float32, aa bool,
+	argb uint32, style int32, strokeWidth float32, flags int32,
 	strokeCap, strokeJoin int32, miterLimit float32,
 	dashIntervals []float32, dashPhase float32,
 	blendMode int32, alpha float32,
@@ -549,7 +566,7 @@ func CanvasDrawPathGradient(
 	C.drift_skia_canvas_draw_path_gradient(
 		C.DriftSkiaCanvas(canvas),
 		path.ptr,
-		C.uint(argb), C.int(style), C.float(strokeWidth), boolToInt(aa),
+		C.uint(argb), C.int(style), C.float(strokeWidth), C.int(flags),
 		C.int(strokeCap), C.int(strokeJoin), C.float(miterLimit),
 		dashPtr, dashCount, C.float(dashPhase),
 		C.int(blendMode), C.float(alpha),
@@ -664,6 +681,7 @@ func NewParagraph(
 	size float32,
 	weight int,
 	style int,
+	variations FontVariations,
 	color uint32,
 	maxLines int,
 	gradientType int32,
@@ -671,8 +689,16 @@ func NewParagraph(
 	centerX, centerY, radius float32,
 	colors []uint32,
 	positions []float32,
-	shadow *ParagraphShadow,
+	shadows []ParagraphShadow,
+	decoration int,
+	decorationColor uint32,
+	decorationStyle int,
+	strokeWidth float32,
+	letterSpacing, wordSpacing, height float32,
 	textAlign int,
+	textDirection int,
+	strut *ParagraphStrut,
+	ellipsis string,
 ) (*Paragraph, error) {
 	cstr := C.CString(text)
 	defer C.free(unsafe.Pointer(cstr))
@@ -681,25 +707,34 @@ func NewParagraph(
 		cfamily = C.CString(family)
 		defer C.free(unsafe.Pointer(cfamily))
 	}
-	var shadowEnabled C.int
-	var shadowColor C.uint
-	var shadowDx C.float
-	var shadowDy C.float
-	var shadowSigma C.float
-	if shadow != nil {
-		shadowEnabled = 1
-		shadowColor = C.uint(shadow.Color)
-		shadowDx = C.float(shadow.OffsetX)
-		shadowDy = C.float(shadow.OffsetY)
-		shadowSigma = C.float(shadow.Sigma)
+	var cShadows *C.DriftTextShadow
+	if len(shadows) > 0 {
+		list := make([]C.DriftTextShadow, len(shadows))
+		for i, shadow := range shadows {
+			list[i].color = C.uint32_t(shadow.Color)
+			list[i].dx = C.float(shadow.OffsetX)
+			list[i].dy = C.float(shadow.OffsetY)
+			list[i].sigma = C.float(shadow.Sigma)
+		}
+		cShadows = &list[0]
 	}
 	cColors, cPositions, count := gradientData(colors, positions)
+	cStrut, freeStrut := strutData(strut)
+	defer freeStrut()
+	var cEllipsis *C.char
+	if ellipsis != "" {
+		cEllipsis = C.CString(ellipsis)
+		defer C.free(unsafe.Pointer(cEllipsis))
+	}
 	paragraph := C.drift_skia_paragraph_create(
 		cstr,
 		cfamily,
 		C.float(size),
 		C.int(weight),
 		C.int(style),
+		C.float(variations.Weight),
+		C.float(variations.Width),
+		C.float(variations.Slant),
 		C.uint(color),
 		C.int(maxLines),
 		C.int(gradientType),
@@ -713,12 +748,19 @@ func NewParagraph(
 		cColors,
 		cPositions,
 		count,
-		shadowEnabled,
-		shadowColor,
-		shadowDx,
-		shadowDy,
-		shadowSigma,
+		cShadows,
+		C.int(len(shadows)),
+		C.int(decoration),
+		C.uint(decorationColor),
+		C.int(decorationStyle),
+		C.float(strokeWidth),
+		C.float(letterSpacing),
+		C.float(wordSpacing),
+		C.float(height),
 		C.int(textAlign),
+		C.int(textDirection),
+		cStrut,
+		cEllipsis,
 	)
 	if paragraph == nil {
 		return nil, errors.New("skia: failed to create paragraph")
@@ -727,7 +769,7 @@ func NewParagraph(
 }
 
 // NewRichParagraph creates a paragraph with multiple styled spans.
-func NewRichParagraph(spans []TextSpanData, maxLines int, textAlign int) (*Paragraph, error) {
+func NewRichParagraph(spans []TextSpanData, maxLines int, textAlign int, textDirection int, strut *ParagraphStrut, ellipsis string) (*Paragraph, error) {
 	if len(spans) == 0 {
 		return nil, errors.New("skia: no spans provided")
 	}
@@ -745,6 +787,9 @@ func NewRichParagraph(spans []TextSpanData, maxLines int, textAlign int) (*Parag
 		cSpans[i].size = C.float(s.Size)
 		cSpans[i].weight = C.int(s.Weight)
 		cSpans[i].style = C.int(s.Style)
+		cSpans[i].variation_weight = C.float(s.Variations.Weight)
+		cSpans[i].variation_width = C.float(s.Variations.Width)
+		cSpans[i].variation_slant = C.float(s.Variations.Slant)
 		cSpans[i].color = C.uint32_t(s.Color)
 		cSpans[i].decoration = C.int(s.Decoration)
 		cSpans[i].decoration_color = C.uint32_t(s.DecorationColor)
@@ -756,17 +801,34 @@ func NewRichParagraph(spans []TextSpanData, maxLines int, textAlign int) (*Parag
 			cSpans[i].has_background = 1
 		}
 		cSpans[i].background_color = C.uint32_t(s.BackgroundColor)
+		if s.Placeholder {
+			cSpans[i].is_placeholder = 1
+		}
+		cSpans[i].placeholder_width = C.float(s.PlaceholderWidth)
+		cSpans[i].placeholder_height = C.float(s.PlaceholderHeight)
+		cSpans[i].placeholder_alignment = C.int(s.PlaceholderAlignment)
+		cSpans[i].placeholder_baseline_offset = C.float(s.PlaceholderBaselineOffset)
 	}
 	defer func() {
 		for _, cs := range cStrings {
 			C.free(unsafe.Pointer(cs))
 		}
 	}()
+	cStrut, freeStrut := strutData(strut)
+	defer freeStrut()
+	var cEllipsis *C.char
+	if ellipsis != "" {
+		cEllipsis = C.CString(ellipsis)
+		defer C.free(unsafe.Pointer(cEllipsis))
+	}
 	paragraph := C.drift_skia_rich_paragraph_create(
 		&cSpans[0],
 		C.int(len(spans)),
 		C.int(maxLines),
 		C.int(textAlign),
+		C.int(textDirection),
+		cStrut,
+		cEllipsis,
 	)
 	if paragraph == nil {
 		return nil, errors.New("skia: failed to create rich paragraph")
@@ -791,7 +853,8 @@ func (p *Paragraph) Metrics() (ParagraphMetrics, error) {
 	var longestLine C.float
 	var maxIntrinsic C.float
 	var lineCount C.int
-	result := C.drift_skia_paragraph_get_metrics(p.ptr, &height, &longestLine, &maxIntrinsic, &lineCount)
+	var didExceedMaxLines C.int
+	result := C.drift_skia_paragraph_get_metrics(p.ptr, &height, &longestLine, &maxIntrinsic, &lineCount, &didExceedMaxLines)
 	if result == 0 {
 		return ParagraphMetrics{}, errors.New("skia: failed to get paragraph metrics")
 	}
@@ -800,6 +863,7 @@ func (p *Paragraph) Metrics() (ParagraphMetrics, error) {
 		LongestLine:       float64(longestLine),
 		MaxIntrinsicWidth: float64(maxIntrinsic),
 		LineCount:         int(lineCount),
+		DidExceedMaxLines: didExceedMaxLines != 0,
 	}, nil
 }
 
@@ -812,36 +876,125 @@ func (p *Paragraph) LineMetrics() (ParagraphLineMetrics, error) {
 	if metrics.LineCount == 0 {
 		return ParagraphLineMetrics{}, nil
 	}
-	widths := make([]float32, metrics.LineCount)
-	ascents := make([]float32, metrics.LineCount)
-	descents := make([]float32, metrics.LineCount)
-	heights := make([]float32, metrics.LineCount)
+	n := metrics.LineCount
+	widths := make([]float32, n)
+	ascents := make([]float32, n)
+	descents := make([]float32, n)
+	heights := make([]float32, n)
+	lefts := make([]float32, n)
+	baselines := make([]float32, n)
+	starts := make([]C.int, n)
+	ends := make([]C.int, n)
 	result := C.drift_skia_paragraph_get_line_metrics(
 		p.ptr,
 		(*C.float)(unsafe.Pointer(&widths[0])),
 		(*C.float)(unsafe.Pointer(&ascents[0])),
 		(*C.float)(unsafe.Pointer(&descents[0])),
 		(*C.float)(unsafe.Pointer(&heights[0])),
-		C.int(metrics.LineCount),
+		(*C.float)(unsafe.Pointer(&lefts[0])),
+		(*C.float)(unsafe.Pointer(&baselines[0])),
+		&starts[0],
+		&ends[0],
+		C.int(n),
 	)
 	if result == 0 {
 		return ParagraphLineMetrics{}, errors.New("skia: failed to get paragraph line metrics")
 	}
 	out := ParagraphLineMetrics{
-		Widths:   make([]float64, metrics.LineCount),
-		Ascents:  make([]float64, metrics.LineCount),
-		Descents: make([]float64, metrics.LineCount),
-		Heights:  make([]float64, metrics.LineCount),
-	}
-	for i := 0; i < metrics.LineCount; i++ {
+		Widths:    make([]float64, n),
+		Ascents:   make([]float64, n),
+		Descents:  make([]float64, n),
+		Heights:   make([]float64, n),
+		Lefts:     make([]float64, n),
+		Baselines: make([]float64, n),
+		Starts:    make([]int, n),
+		Ends:      make([]int, n),
+	}
+	for i := 0; i < n; i++ {
 		out.Widths[i] = float64(widths[i])
 		out.Ascents[i] = float64(ascents[i])
 		out.Descents[i] = float64(descents[i])
 		out.Heights[i] = float64(heights[i])
+		out.Lefts[i] = float64(lefts[i])
+		out.Baselines[i] = float64(baselines[i])
+		out.Starts[i] = int(starts[i])
+		out.Ends[i] = int(ends[i])
 	}
 	return out, nil
 }
 
+// GlyphPosition returns the UTF-16 index of the text position nearest to
+// (x, y), and whether the position belongs to the preceding character.
+func (p *Paragraph) GlyphPosition(x, y float32) (int, bool, error) {
+	if p == nil || p.ptr == nil {
+		return 0, false, errors.New("skia: nil paragraph")
+	}
+	var index, upstream C.int
+	if C.drift_skia_paragraph_get_glyph_position(p.ptr, C.float(x), C.float(y), &index, &upstream) == 0 {
+		return 0, false, errors.New("skia: failed to get glyph position")
+	}
+	return int(index), upstream != 0, nil
+}
+
+// RectsForRange returns the boxes of the glyphs between the UTF-16 indices
+// start and end.
+func (p *Paragraph) RectsForRange(start, end int) ([]ParagraphBox, error) {
+	if p == nil || p.ptr == nil {
+		return nil, errors.New("skia: nil paragraph")
+	}
+	if end <= start {
+		return nil, nil
+	}
+	// A range yields at most one box per character plus one per line, so
+	// twice its length is always enough.
+	maxBoxes := 2*(end-start) + 1
+	rects := make([]float32, 4*maxBoxes)
+	rtl := make([]C.int, maxBoxes)
+	count := int(C.drift_skia_paragraph_get_rects_for_range(
+		p.ptr,
+		C.int(start),
+		C.int(end),
+		(*C.float)(unsafe.Pointer(&rects[0])),
+		&rtl[0],
+		C.int(maxBoxes),
+	))
+	boxes := make([]ParagraphBox, count)
+	for i := range boxes {
+		boxes[i] = ParagraphBox{
+			Left:   float64(rects[i*4]),
+			Top:    float64(rects[i*4+1]),
+			Right:  float64(rects[i*4+2]),
+			Bottom: float64(rects[i*4+3]),
+			RTL:    rtl[i] != 0,
+		}
+	}
+	return boxes, nil
+}
+
+// PlaceholderRects returns the boxes of the paragraph's placeholders, in
+// order. Placeholders cut off by the line limit are left out.
+func (p *Paragraph) PlaceholderRects() ([]ParagraphBox, error) {
+	if p == nil || p.ptr == nil {
+		return nil, errors.New("skia: nil paragraph")
+	}
+	count := int(C.drift_skia_paragraph_get_placeholder_rects(p.ptr, nil, 0))
+	if count == 0 {
+		return nil, nil
+	}
+	rects := make([]float32, 4*count)
+	count = int(C.drift_skia_paragraph_get_placeholder_rects(p.ptr, (*C.float)(unsafe.Pointer(&rects[0])), C.int(count)))
+	boxes := make([]ParagraphBox, count)
+	for i := range boxes {
+		boxes[i] = ParagraphBox{
+			Left:   float64(rects[i*4]),
+			Top:    float64(rects[i*4+1]),
+			Right:  float64(rects[i*4+2]),
+			Bottom: float64(rects[i*4+3]),
+		}
+	}
+	return boxes, nil
+}
+
 // Paint renders the paragraph to the canvas at the given position.
 func (p *Paragraph) Paint(canvas unsafe.Pointer, x, y float32) {
 	if p == nil || p.ptr == nil || canvas == nil {
@@ -883,6 +1036,41 @@ func RegisterFont(name string, data []byte) error {
 	return nil
 }
 
+// RegisterFontStyle registers one weight and style of a font family with the
+// Skia backend. Style 1 is italic. Text in the family uses the registered
+// face closest to the requested weight and style.
+func RegisterFontStyle(name string, data []byte, weight int, style int) error {
+	if name == "" {
+		return errors.New("font name required")
+	}
+	if len(data) == 0 {
+		return errors.New("font data required")
+	}
+	cname := C.CString(name)
+	defer C.free(unsafe.Pointer(cname))
+	result := C.drift_skia_register_font_style(cname, (*C.uchar)(unsafe.Pointer(&data[0])), C.int(len(data)), C.int(weight), C.int(style))
+	if result == 0 {
+		return errors.New("skia: failed to register font")
+	}
+	return nil
+}
+
+// SetFontFallbacks sets the families paragraphs try, in order, for
+// characters missing from their own family.
+func SetFontFallbacks(families []string) error {
+	if len(families) == 0 {
+		C.drift_skia_set_font_fallbacks(nil, 0)
+		return nil
+	}
+	cfamilies := make([]*C.char, len(families))
+	for i, family := range families {
+		cfamilies[i] = C.CString(family)
+		defer C.free(unsafe.Pointer(cfamilies[i]))
+	}
+	C.drift_skia_set_font_fallbacks(&cfamilies[0], C.int(len(families)))
+	return nil
+}
+
 // MeasureTextWidth returns the advance width for the text.
 func MeasureTextWidth(text, family string, size float64, weight int, style int) (float64, error) {
 	var width C.float
@@ -931,6 +1119,29 @@ func gradientData(colors []uint32, positions []float32) (*C.uint, *C.float, C.in
 	return (*C.uint)(unsafe.Pointer(&colors[0])), (*C.float)(unsafe.Pointer(&positions[0])), C.int(len(colors))
 }
 
+// strutData converts strut for the C bridge. Call free once the bridge is
+// done with it.
+func strutData(strut *ParagraphStrut) (cStrut *C.DriftStrutStyle, free func()) {
+	if strut == nil {
+		return nil, func() {}
+	}
+	cStrut = &C.DriftStrutStyle{
+		size:    C.float(strut.Size),
+		weight:  C.int(strut.Weight),
+		style:   C.int(strut.Style),
+		height:  C.float(strut.Height),
+		leading: C.float(strut.Leading),
+	}
+	if strut.ForceHeight {
+		cStrut.force_height = 1
+	}
+	if strut.Family == "" {
+		return cStrut, func() {}
+	}
+	cStrut.family = C.CString(strut.Family)
+	return cStrut, func() { C.free(unsafe.Pointer(cStrut.family)) }
+}
+
 // Skia dash patterns require at least one on/off pair.
 func dashIntervalData(intervals []float32) (*C.float, C.int) {
 	if len(intervals) < 2 {
@@ -1007,11 +1218,56 @@ func (p *Path) Close() {
 	C.drift_skia_path_close(p.ptr)
 }
 
+// Path operations accepted by PathOp, matching SkPathOp.
+const (
+	PathOpDifference        = 0
+	PathOpIntersect         = 1
+	PathOpUnion             = 2
+	PathOpXor               = 3
+	PathOpReverseDifference = 4
+)
+
+// PathOp combines two paths with a boolean operation. Returns nil if Skia
+// cannot compute the result. Caller must call Destroy() on non-nil result.
+func PathOp(one, two *Path, op int) *Path {
+	if one == nil || one.ptr == nil || two == nil || two.ptr == nil {
+		return nil
+	}
+	result := NewPath(FillTypeWinding)
+	if C.drift_skia_path_op(one.ptr, two.ptr, C.int(op), result.ptr) == 0 {
+		result.Destroy()
+		return nil
+	}
+	return result
+}
+
+// Read returns the path's verbs (0 move, 1 line, 2 quad, 3 cubic, 4 close),
+// the x/y coordinates of their points, and its fill type. Conics are
+// converted to quads.
+func (p *Path) Read() (verbs []uint8, points []float32, fillType int) {
+	if p == nil || p.ptr == nil {
+		return nil, nil, FillTypeWinding
+	}
+	var verbCount, pointCount, fill C.int
+	if C.drift_skia_path_read(p.ptr, nil, 0, nil, 0, &verbCount, &pointCount, &fill) == 0 || verbCount == 0 {
+		return nil, nil, int(fill)
+	}
+	verbs = make([]uint8, int(verbCount))
+	points = make([]float32, max(int(pointCount), 1))
+	C.drift_skia_path_read(
+		p.ptr,
+		(*C.uint8_t)(unsafe.Pointer(&verbs[0])), verbCount,
+		(*C.float)(unsafe.Pointer(&points[0])), pointCount,
+		&verbCount, &pointCount, &fill,
+	)
+	return verbs, points[:int(pointCount)], int(fill)
+}
+
 // CanvasDrawPath draws a path with the provided paint settings.
 func CanvasDrawPath(
 	canvas unsafe.Pointer,
 	path *Path,
-	argb uint32, style int32, strokeWidth float32, aa bool,
+	argb uint32, style int32, strokeWidth float32, flags int32,
 	strokeCap, strokeJoin int32, miterLimit float32,
 	dashIntervals []float32, dashPhase float32,
 	blendMode int32, alpha float32,
@@ -1023,13 +1279,170 @@ func CanvasDrawPath(
 	C.drift_skia_canvas_draw_path(
 		C.DriftSkiaCanvas(canvas),
 		path.ptr,
-		C.uint(argb), C.int(style), C.float(strokeWidth), boolToInt(aa),
+		C.uint(argb), C.int(style), C.float(strokeWidth), C.int(flags),
 		C.int(strokeCap), C.int(strokeJoin), C.float(miterLimit),
 		dashPtr, dashCount, C.float(dashPhase),
 		C.int(blendMode), C.float(alpha),
 	)
 }
 
+// CanvasDrawPathShader draws a path with a runtime effect shader. uniforms
+// holds the effect's uniform data in 32-bit slots.
+func CanvasDrawPathShader(
+	canvas unsafe.Pointer,
+	path *Path,
+	effect *RuntimeEffect, uniforms []float32,
+	style int32, strokeWidth float32, flags int32,
+	strokeCap, strokeJoin int32, miterLimit float32,
+	dashIntervals []float32, dashPhase float32,
+	blendMode int32, alpha float32,
+) {
+	if path == nil || path.ptr == nil || effect == nil || effect.ptr == nil {
+		return
+	}
+	dashPtr, dashCount := dashIntervalData(dashIntervals)
+	uniformPtr, uniformCount := floatSliceData(uniforms)
+	C.drift_skia_canvas_draw_path_shader(
+		C.DriftSkiaCanvas(canvas),
+		path.ptr,
+		effect.ptr, uniformPtr, uniformCount,
+		C.int(style), C.float(strokeWidth), C.int(flags),
+		C.int(strokeCap), C.int(strokeJoin), C.float(miterLimit),
+		dashPtr, dashCount, C.float(dashPhase),
+		C.int(blendMode), C.float(alpha),
+	)
+}
+
+// CanvasDrawVertices draws a triangle mesh. positions and texCoords hold x/y
+// pairs; texCoords, colors, indices, and pixels may be nil. The mesh is
+// textured with the RGBA pixels when given, otherwise with effect when
+// non-nil.
+func CanvasDrawVertices(
+	canvas unsafe.Pointer,
+	mode int32,
+	positions, texCoords []float32,
+	colors []uint32,
+	indices []uint16,
+	pixels []uint8, width, height, stride int, filterQuality int,
+	effect *RuntimeEffect, uniforms []float32,
+	argb uint32, blendMode int32, alpha float32,
+) {
+	vertexCount := len(positions) / 2
+	if vertexCount == 0 {
+		return
+	}
+	var texPtr *C.float
+	if len(texCoords) >= vertexCount*2 {
+		texPtr = (*C.float)(unsafe.Pointer(&texCoords[0]))
+	}
+	var colorPtr *C.uint32_t
+	if len(colors) >= vertexCount {
+		colorPtr = (*C.uint32_t)(unsafe.Pointer(&colors[0]))
+	}
+	var indexPtr *C.uint16_t
+	if len(indices) > 0 {
+		indexPtr = (*C.uint16_t)(unsafe.Pointer(&indices[0]))
+	}
+	var pixelPtr *C.uint8_t
+	if len(pixels) > 0 {
+		pixelPtr = (*C.uint8_t)(unsafe.Pointer(&pixels[0]))
+	}
+	var effectPtr C.DriftSkiaRuntimeEffect
+	if effect != nil {
+		effectPtr = effect.ptr
+	}
+	uniformPtr, uniformCount := floatSliceData(uniforms)
+	C.drift_skia_canvas_draw_vertices(
+		C.DriftSkiaCanvas(canvas), C.int(mode),
+		(*C.float)(unsafe.Pointer(&positions[0])), C.int(vertexCount),
+		texPtr, colorPtr,
+		indexPtr, C.int(len(indices)),
+		pixelPtr, C.int(width), C.int(height), C.int(stride), C.int(filterQuality),
+		effectPtr, uniformPtr, uniformCount,
+		C.uint32_t(argb), C.int(blendMode), C.float(alpha),
+	)
+}
+
+// RuntimeEffect wraps a compiled SkSL shader.
+type RuntimeEffect struct {
+	ptr C.DriftSkiaRuntimeEffect
+}
+
+// RuntimeEffectUniform describes one uniform of a RuntimeEffect. Offset and
+// Count are measured in 32-bit slots.
+type RuntimeEffectUniform struct {
+	Name   string
+	Offset int
+	Count  int
+	IsInt  bool
+}
+
+// NewRuntimeEffect compiles SkSL shader source. The error carries the
+// compiler message when compilation fails.
+func NewRuntimeEffect(sksl string) (*RuntimeEffect, error) {
+	cSource := C.CString(sksl)
+	defer C.free(unsafe.Pointer(cSource))
+	var errBuf [1024]C.char
+	ptr := C.drift_skia_runtime_effect_make(cSource, &errBuf[0], C.int(len(errBuf)))
+	if ptr == nil {
+		msg := C.GoString(&errBuf[0])
+		if msg == "" {
+			msg = "compile failed"
+		}
+		return nil, errors.New("skia: runtime effect: " + msg)
+	}
+	return &RuntimeEffect{ptr: ptr}, nil
+}
+
+// Destroy releases the runtime effect.
+func (e *RuntimeEffect) Destroy() {
+	if e == nil || e.ptr == nil {
+		return
+	}
+	C.drift_skia_runtime_effect_destroy(e.ptr)
+	e.ptr = nil
+}
+
+// Ptr returns the underlying C handle for encoding into filter data.
+// Returns nil if the effect is nil or has been destroyed.
+func (e *RuntimeEffect) Ptr() unsafe.Pointer {
+	if e == nil || e.ptr == nil {
+		return nil
+	}
+	return unsafe.Pointer(e.ptr)
+}
+
+// Uniforms describes the effect's uniforms in declaration order.
+func (e *RuntimeEffect) Uniforms() []RuntimeEffectUniform {
+	if e == nil || e.ptr == nil {
+		return nil
+	}
+	n := int(C.drift_skia_runtime_effect_uniform_count(e.ptr))
+	uniforms := make([]RuntimeEffectUniform, 0, n)
+	var name [256]C.char
+	for i := range n {
+		var offset, count, isInt C.int
+		if C.drift_skia_runtime_effect_uniform_info(e.ptr, C.int(i), &name[0], C.int(len(name)), &offset, &count, &isInt) == 0 {
+			continue
+		}
+		uniforms = append(uniforms, RuntimeEffectUniform{
+			Name:   C.GoString(&name[0]),
+			Offset: int(offset),
+			Count:  int(count),
+			IsInt:  isInt != 0,
+		})
+	}
+	return uniforms
+}
+
+// ChildCount returns the number of child shaders the effect samples.
+func (e *RuntimeEffect) ChildCount() int {
+	if e == nil || e.ptr == nil {
+		return 0
+	}
+	return int(C.drift_skia_runtime_effect_child_count(e.ptr))
+}
+
 // CanvasDrawRectShadow draws a shadow behind a rectangle.
 func CanvasDrawRectShadow(
 	canvas unsafe.Pointer,
@@ -1262,6 +1675,18 @@ func (s *Skottie) Duration() float64 {
 	return float64(dur)
 }
 
+// FrameRate returns the animation's frame rate in frames per second.
+func (s *Skottie) FrameRate() float64 {
+	if s == nil || s.ptr == nil {
+		return 0
+	}
+	var fps C.float
+	if C.drift_skia_skottie_get_fps(s.ptr, &fps) == 0 {
+		return 0
+	}
+	return float64(fps)
+}
+
 // Size returns the intrinsic size of the animation.
 func (s *Skottie) Size() (width, height float64) {
 	if s == nil || s.ptr == nil {
@@ -1296,3 +1721,194 @@ func SkottieSeekAndRender(animPtr, canvasPtr unsafe.Pointer, t, width, height fl
 		C.float(height),
 	)
 }
+
+// RiveSupported reports whether the bridge was built with the Rive runtime.
+func RiveSupported() bool {
+	return C.drift_skia_rive_supported() != 0
+}
+
+// RiveFile wraps an imported Rive file.
+type RiveFile struct {
+	ptr C.DriftSkiaRiveFile
+}
+
+// NewRiveFile imports a Rive file from its binary data.
+func NewRiveFile(data []byte) (*RiveFile, error) {
+	if len(data) == 0 {
+		return nil, errors.New("skia: rive: empty data")
+	}
+	var errBuf [256]C.char
+	ptr := C.drift_skia_rive_file_create(
+		(*C.uint8_t)(unsafe.Pointer(&data[0])),
+		C.int(len(data)),
+		&errBuf[0], C.int(len(errBuf)),
+	)
+	if ptr == nil {
+		return nil, errors.New("skia: rive: " + C.GoString(&errBuf[0]))
+	}
+	return &RiveFile{ptr: ptr}, nil
+}
+
+// Destroy releases the file. Instances created from it remain valid.
+func (f *RiveFile) Destroy() {
+	if f == nil || f.ptr == nil {
+		return
+	}
+	C.drift_skia_rive_file_destroy(f.ptr)
+	f.ptr = nil
+}
+
+// RiveInput kinds reported by [Rive.Input].
+const (
+	RiveInputBool    = 0
+	RiveInputNumber  = 1
+	RiveInputTrigger = 2
+)
+
+// Rive wraps an artboard instance and its state machine.
+type Rive struct {
+	ptr C.DriftSkiaRive
+}
+
+// NewRive instantiates an artboard from file with the named state machine.
+// Empty names select the file's default artboard and state machine.
+func NewRive(file *RiveFile, artboard, stateMachine string) (*Rive, error) {
+	if file == nil || file.ptr == nil {
+		return nil, errors.New("skia: rive: nil file")
+	}
+	cArtboard := C.CString(artboard)
+	defer C.free(unsafe.Pointer(cArtboard))
+	cMachine := C.CString(stateMachine)
+	defer C.free(unsafe.Pointer(cMachine))
+	var errBuf [256]C.char
+	ptr := C.drift_skia_rive_create(file.ptr, cArtboard, cMachine, &errBuf[0], C.int(len(errBuf)))
+	if ptr == nil {
+		return nil, errors.New("skia: rive: " + C.GoString(&errBuf[0]))
+	}
+	return &Rive{ptr: ptr}, nil
+}
+
+// Destroy releases the instance.
+func (r *Rive) Destroy() {
+	if r == nil || r.ptr == nil {
+		return
+	}
+	C.drift_skia_rive_destroy(r.ptr)
+	r.ptr = nil
+}
+
+// Ptr returns the underlying C handle for use in DrawRive.
+func (r *Rive) Ptr() unsafe.Pointer {
+	if r == nil || r.ptr == nil {
+		return nil
+	}
+	return unsafe.Pointer(r.ptr)
+}
+
+// Size returns the artboard size.
+func (r *Rive) Size() (width, height float64) {
+	if r == nil || r.ptr == nil {
+		return 0, 0
+	}
+	var w, h C.float
+	if C.drift_skia_rive_get_size(r.ptr, &w, &h) == 0 {
+		return 0, 0
+	}
+	return float64(w), float64(h)
+}
+
+// InputCount returns the number of state machine inputs.
+func (r *Rive) InputCount() int {
+	if r == nil || r.ptr == nil {
+		return 0
+	}
+	return int(C.drift_skia_rive_input_count(r.ptr))
+}
+
+// Input returns the name and kind of the input at index.
+func (r *Rive) Input(index int) (name string, kind int, ok bool) {
+	if r == nil || r.ptr == nil {
+		return "", 0, false
+	}
+	var nameBuf [256]C.char
+	var cKind C.int
+	if C.drift_skia_rive_input_info(r.ptr, C.int(index), &nameBuf[0], C.int(len(nameBuf)), &cKind) == 0 {
+		return "", 0, false
+	}
+	return C.GoString(&nameBuf[0]), int(cKind), true
+}
+
+// SetBool sets a boolean input. Returns false if there is no such input.
+func (r *Rive) SetBool(name string, value bool) bool {
+	if r == nil || r.ptr == nil {
+		return false
+	}
+	cName := C.CString(name)
+	defer C.free(unsafe.Pointer(cName))
+	v := C.int(0)
+	if value {
+		v = 1
+	}
+	return C.drift_skia_rive_set_bool(r.ptr, cName, v) != 0
+}
+
+// SetNumber sets a number input. Returns false if there is no such input.
+func (r *Rive) SetNumber(name string, value float64) bool {
+	if r == nil || r.ptr == nil {
+		return false
+	}
+	cName := C.CString(name)
+	defer C.free(unsafe.Pointer(cName))
+	return C.drift_skia_rive_set_number(r.ptr, cName, C.float(value)) != 0
+}
+
+// FireTrigger fires a trigger input. Returns false if there is no such input.
+func (r *Rive) FireTrigger(name string) bool {
+	if r == nil || r.ptr == nil {
+		return false
+	}
+	cName := C.CString(name)
+	defer C.free(unsafe.Pointer(cName))
+	return C.drift_skia_rive_fire_trigger(r.ptr, cName) != 0
+}
+
+// Value returns the value of a bool (0 or 1) or number input.
+func (r *Rive) Value(name string) (float64, bool) {
+	if r == nil || r.ptr == nil {
+		return 0, false
+	}
+	cName := C.CString(name)
+	defer C.free(unsafe.Pointer(cName))
+	var v C.float
+	if C.drift_skia_rive_get_value(r.ptr, cName, &v) == 0 {
+		return 0, false
+	}
+	return float64(v), true
+}
+
+// Advance moves the state machine forward by seconds. Returns true while
+// the animation has more to play.
+func (r *Rive) Advance(seconds float64) bool {
+	if r == nil || r.ptr == nil {
+		return false
+	}
+	return C.drift_skia_rive_advance(r.ptr, C.float(seconds)) != 0
+}
+
+// Pointer delivers a pointer event (0=down, 1=move, 2=up) at x, y within a
+// width x height box. Returns true if it hit a listener.
+func (r *Rive) Pointer(kind int, x, y, width, height float64) bool {
+	if r == nil || r.ptr == nil {
+		return false
+	}
+	return C.drift_skia_rive_pointer(r.ptr, C.int(kind), C.float(x), C.float(y), C.float(width), C.float(height)) != 0
+}
+
+// RiveRender renders an artboard instance scaled to fit width x height.
+// Used internally by display list playback.
+func RiveRender(rivePtr, canvasPtr unsafe.Pointer, width, height float32) {
+	if rivePtr == nil || canvasPtr == nil {
+		return
+	}
+	C.drift_skia_rive_render(C.DriftSkiaRive(rivePtr), C.DriftSkiaCanvas(canvasPtr), C.float(width), C.float(height))
+}
@@ -13,6 +13,7 @@ typedef void* DriftSkiaCanvas;
 typedef void* DriftSkiaPath;
 typedef void* DriftSkiaSVGDOM;
 typedef void* DriftSkiaParagraph;
+typedef void* DriftSkiaRuntimeEffect;
 
 DriftSkiaContext drift_skia_context_create_metal(void* device, void* queue);
 DriftSkiaContext drift_skia_context_create_vulkan(
@@ -25,12 +26,14 @@ DriftSkiaContext drift_skia_context_create_vulkan(
 );
 void drift_skia_context_destroy(DriftSkiaContext ctx);
 
-DriftSkiaSurface drift_skia_surface_create_metal(DriftSkiaContext ctx, void* texture, int width, int height);
+// color_space: 0 = sRGB, 1 = Display P3 (graphics.ColorSpace).
+DriftSkiaSurface drift_skia_surface_create_metal(DriftSkiaContext ctx, void* texture, int width, int height, int color_space);
 DriftSkiaSurface drift_skia_surface_create_vulkan(
     DriftSkiaContext ctx,
     int width, int height,
     uintptr_t vk_image,
-    uint32_t vk_format
+    uint32_t vk_format,
+    int color_space
 );
 DriftSkiaCanvas drift_skia_surface_get_canvas(DriftSkiaSurface surface);
 void drift_skia_surface_flush(DriftSkiaContext ctx, DriftSkiaSurface surface);
@@ -81,7 +84,7 @@ void drift_skia_canvas_save_layer_filtered(
 void drift_skia_canvas_clear(DriftSkiaCanvas canvas, uint32_t argb);
 void drift_skia_canvas_draw_rect(
     DriftSkiaCanvas canvas, float l, float t, float r, float b,
-    uint32_t argb, int style, float stroke_width, int aa,
+    uint32_t argb, int style, float stroke_width, int flags,
     int stroke_cap, int stroke_join, float miter_limit,
     const float* dash_intervals, int dash_count, float dash_phase,
     int blend_mode, float alpha
@@ -91,21 +94,21 @@ void drift_skia_canvas_draw_rrect(
     float l, float t, float r, float b,
     float rx1, float ry1, float rx2, float ry2,
     float rx3, float ry3, float rx4, float ry4,
-    uint32_t argb, int style, float stroke_width, int aa,
+    uint32_t argb, int style, float stroke_width, int flags,
     int stroke_cap, int stroke_join, float miter_limit,
     const float* dash_intervals, int dash_count, float dash_phase,
     int blend_mode, float alpha
 );
 void drift_skia_canvas_draw_circle(
     DriftSkiaCanvas canvas, float cx, float cy, float radius,
-    uint32_t argb, int style, float stroke_width, int aa,
+    uint32_t argb, int style, float stroke_width, int flags,
     int stroke_cap, int stroke_join, float miter_limit,
     const float* dash_intervals, int dash_count, float dash_phase,
     int blend_mode, float alpha
 );
 void drift_skia_canvas_draw_line(
     DriftSkiaCanvas canvas, float x1, float y1, float x2, float y2,
-    uint32_t argb, float stroke_width, int aa,
+    uint32_t argb, float stroke_width, int flags,
     int stroke_cap, int stroke_join, float miter_limit,
     const float* dash_intervals, int dash_count, float dash_phase,
     int blend_mode, float alpha
@@ -113,7 +116,7 @@ void drift_skia_canvas_draw_line(
 void drift_skia_canvas_draw_rect_gradient(
     DriftSkiaCanvas canvas,
     float l, float t, float r, float b,
-    uint32_t argb, int style, float stroke_width, int aa,
+    uint32_t argb, int style, float stroke_width, int flags,
     int stroke_cap, int stroke_join, float miter_limit,
     const float* dash_intervals, int dash_count, float dash_phase,
     int blend_mode, float alpha,
@@ -127,7 +130,7 @@ void drift_skia_canvas_draw_rrect_gradient(
     float l, float t, float r, float b,
     float rx1, float ry1, float rx2, float ry2,
     float rx3, float ry3, float rx4, float ry4,
-    uint32_t argb, int style, float stroke_width, int aa,
+    uint32_t argb, int style, float stroke_width, int flags,
     int stroke_cap, int stroke_join, float miter_limit,
     const float* dash_intervals, int dash_count, float dash_phase,
     int blend_mode, float alpha,
@@ -139,7 +142,7 @@ void drift_skia_canvas_draw_rrect_gradient(
 void drift_skia_canvas_draw_circle_gradient(
     DriftSkiaCanvas canvas,
     float cx, float cy, float radius,
-    uint32_t argb, int style, float stroke_width, int aa,
+    uint32_t argb, int style, float stroke_width, int flags,
     int stroke_cap, int stroke_join, float miter_limit,
     const float* dash_intervals, int dash_count, float dash_phase,
     int blend_mode, float alpha,
@@ -151,7 +154,7 @@ void drift_skia_canvas_draw_circle_gradient(
 void drift_skia_canvas_draw_line_gradient(
     DriftSkiaCanvas canvas,
     float x1, float y1, float x2, float y2,
-    uint32_t argb, float stroke_width, int aa,
+    uint32_t argb, float stroke_width, int flags,
     int stroke_cap, int stroke_join, float miter_limit,
     const float* dash_intervals, int dash_count, float dash_phase,
     int blend_mode, float alpha,
@@ -162,7 +165,7 @@ void drift_skia_canvas_draw_line_gradient(
 );
 void drift_skia_canvas_draw_path_gradient(
     DriftSkiaCanvas canvas, DriftSkiaPath path,
-    uint32_t argb, int style, float stroke_width, int aa,
+    uint32_t argb, int style, float stroke_width, int flags,
     int stroke_cap, int stroke_join, float miter_limit,
     const float* dash_intervals, int dash_count, float dash_phase,
     int blend_mode, float alpha,
@@ -204,12 +207,35 @@ void drift_skia_canvas_draw_image_rect(
     int filter_quality,
     uintptr_t cache_key
 );
+typedef struct {
+    uint32_t color;
+    float dx;
+    float dy;
+    float sigma;
+} DriftTextShadow;
+
+// A strut sets the minimum height of every line of a paragraph from a font,
+// so lines keep the same spacing whatever their text. A zero size or height
+// leaves it at the paragraph's default.
+typedef struct {
+    const char* family;
+    float size;
+    int weight;
+    int style;
+    float height;
+    float leading;
+    int force_height;
+} DriftStrutStyle;
+
 DriftSkiaParagraph drift_skia_paragraph_create(
     const char* text,
     const char* family,
     float size,
     int weight,
     int style,
+    float variation_weight,
+    float variation_width,
+    float variation_slant,
     uint32_t argb,
     int max_lines,
     int gradient_type,
@@ -223,16 +249,26 @@ DriftSkiaParagraph drift_skia_paragraph_create(
     const uint32_t* colors,
     const float* positions,
     int count,
-    int shadow_enabled,
-    uint32_t shadow_argb,
-    float shadow_dx,
-    float shadow_dy,
-    float shadow_sigma,
-    int text_align
+    const DriftTextShadow* shadows,
+    int shadow_count,
+    int decoration,
+    uint32_t decoration_argb,
+    int decoration_style,
+    float stroke_width,
+    float letter_spacing,
+    float word_spacing,
+    float height,
+    int text_align,
+    int text_direction,
+    const DriftStrutStyle* strut,
+    const char* ellipsis
 );
 void drift_skia_paragraph_layout(DriftSkiaParagraph paragraph, float width);
-int drift_skia_paragraph_get_metrics(DriftSkiaParagraph paragraph, float* height, float* longest_line, float* max_intrinsic_width, int* line_count);
-int drift_skia_paragraph_get_line_metrics(DriftSkiaParagraph paragraph, float* widths, float* ascents, float* descents, float* heights, int count);
+int drift_skia_paragraph_get_metrics(DriftSkiaParagraph paragraph, float* height, float* longest_line, float* max_intrinsic_width, int* line_count, int* did_exceed_max_lines);
+int drift_skia_paragraph_get_line_metrics(DriftSkiaParagraph paragraph, float* widths, float* ascents, float* descents, float* heights, float* lefts, float* baselines, int* starts, int* ends, int count);
+int drift_skia_paragraph_get_glyph_position(DriftSkiaParagraph paragraph, float x, float y, int* index, int* upstream);
+int drift_skia_paragraph_get_rects_for_range(DriftSkiaParagraph paragraph, int start, int end, float* rects, int* rtl, int max_rects);
+int drift_skia_paragraph_get_placeholder_rects(DriftSkiaParagraph paragraph, float* rects, int max_rects);
 void drift_skia_paragraph_paint(DriftSkiaParagraph paragraph, DriftSkiaCanvas canvas, float x, float y);
 void drift_skia_paragraph_destroy(DriftSkiaParagraph paragraph);
 
@@ -242,6 +278,10 @@ typedef struct {
     float size;
     int weight;
     int style;
+    // Variable font axis coordinates; zero leaves the axis at its default.
+    float variation_weight;
+    float variation_width;
+    float variation_slant;
     uint32_t color;
     int decoration;
     uint32_t decoration_color;
@@ -251,16 +291,29 @@ typedef struct {
     float height;
     int has_background;
     uint32_t background_color;
+    // A placeholder span reserves space for an inline widget instead of
+    // drawing text, aligned to the line by placeholder_alignment (Skia's
+    // PlaceholderAlignment).
+    int is_placeholder;
+    float placeholder_width;
+    float placeholder_height;
+    int placeholder_alignment;
+    float placeholder_baseline_offset;
 } DriftTextSpan;
 
 DriftSkiaParagraph drift_skia_rich_paragraph_create(
     const DriftTextSpan* spans,
     int span_count,
     int max_lines,
-    int text_align
+    int text_align,
+    int text_direction,
+    const DriftStrutStyle* strut,
+    const char* ellipsis
 );
 
 int drift_skia_register_font(const char* name, const uint8_t* data, int length);
+int drift_skia_register_font_style(const char* name, const uint8_t* data, int length, int weight, int style);
+void drift_skia_set_font_fallbacks(const char** families, int count);
 int drift_skia_measure_text(const char* text, const char* family, float size, int weight, int style, float* width);
 int drift_skia_font_metrics(const char* family, float size, int weight, int style, float* ascent, float* descent, float* leading);
 
@@ -271,14 +324,66 @@ void drift_skia_path_line_to(DriftSkiaPath path, float x, float y);
 void drift_skia_path_quad_to(DriftSkiaPath path, float x1, float y1, float x2, float y2);
 void drift_skia_path_cubic_to(DriftSkiaPath path, float x1, float y1, float x2, float y2, float x3, float y3);
 void drift_skia_path_close(DriftSkiaPath path);
+// Combines one and two with a boolean operation (0 difference, 1 intersect,
+// 2 union, 3 xor, 4 reverse difference) and stores the result in result.
+// Returns 1 on success, 0 if the operation failed.
+int drift_skia_path_op(DriftSkiaPath one, DriftSkiaPath two, int op, DriftSkiaPath result);
+// Reads the path back as verbs (0 move, 1 line, 2 quad, 3 cubic, 4 close) and
+// x/y point pairs. Conics are converted to quads. Writes at most the given
+// capacities and reports the full counts, so callers can size buffers with a
+// first call passing null arrays. Returns 1 on success.
+int drift_skia_path_read(
+    DriftSkiaPath path,
+    uint8_t* verbs, int verb_capacity,
+    float* points, int point_capacity,
+    int* verb_count, int* point_count, int* fill_type
+);
 void drift_skia_canvas_draw_path(
     DriftSkiaCanvas canvas, DriftSkiaPath path,
-    uint32_t argb, int style, float stroke_width, int aa,
+    uint32_t argb, int style, float stroke_width, int flags,
     int stroke_cap, int stroke_join, float miter_limit,
     const float* dash_intervals, int dash_count, float dash_phase,
     int blend_mode, float alpha
 );
 
+// Draws a path filled or stroked with a runtime effect shader. uniforms
+// holds the effect's uniform data as 32-bit slots.
+void drift_skia_canvas_draw_path_shader(
+    DriftSkiaCanvas canvas, DriftSkiaPath path,
+    DriftSkiaRuntimeEffect effect, const float* uniforms, int uniform_count,
+    int style, float stroke_width, int flags,
+    int stroke_cap, int stroke_join, float miter_limit,
+    const float* dash_intervals, int dash_count, float dash_phase,
+    int blend_mode, float alpha
+);
+
+// Draws a triangle mesh. positions and tex_coords hold x/y pairs; tex_coords,
+// colors, and indices may be null. The mesh is textured with pixels when
+// given, else with effect when given. Colors multiply the texture.
+void drift_skia_canvas_draw_vertices(
+    DriftSkiaCanvas canvas, int mode,
+    const float* positions, int vertex_count,
+    const float* tex_coords, const uint32_t* colors,
+    const uint16_t* indices, int index_count,
+    const uint8_t* pixels, int width, int height, int stride, int filter_quality,
+    DriftSkiaRuntimeEffect effect, const float* uniforms, int uniform_count,
+    uint32_t argb, int blend_mode, float alpha
+);
+
+// Compiles SkSL shader source. On failure returns null and writes the
+// compiler error, truncated to error_capacity, into error.
+DriftSkiaRuntimeEffect drift_skia_runtime_effect_make(const char* sksl, char* error, int error_capacity);
+void drift_skia_runtime_effect_destroy(DriftSkiaRuntimeEffect effect);
+int drift_skia_runtime_effect_uniform_count(DriftSkiaRuntimeEffect effect);
+// Describes uniform index: its name, offset and size in 32-bit slots, and
+// whether it is an int type. Returns 1 on success.
+int drift_skia_runtime_effect_uniform_info(
+    DriftSkiaRuntimeEffect effect, int index,
+    char* name, int name_capacity,
+    int* offset, int* count, int* is_int
+);
+int drift_skia_runtime_effect_child_count(DriftSkiaRuntimeEffect effect);
+
 void drift_skia_canvas_draw_rect_shadow(
     DriftSkiaCanvas canvas,
     float l, float t, float r, float b,
@@ -314,10 +419,37 @@ typedef void* DriftSkiaSkottie;
 DriftSkiaSkottie drift_skia_skottie_create(const uint8_t* data, int length);
 void drift_skia_skottie_destroy(DriftSkiaSkottie anim);
 int drift_skia_skottie_get_duration(DriftSkiaSkottie anim, float* duration);
+int drift_skia_skottie_get_fps(DriftSkiaSkottie anim, float* fps);
 int drift_skia_skottie_get_size(DriftSkiaSkottie anim, float* width, float* height);
 void drift_skia_skottie_seek(DriftSkiaSkottie anim, float t);
 void drift_skia_skottie_render(DriftSkiaSkottie anim, DriftSkiaCanvas canvas, float width, float height);
 
+typedef void* DriftSkiaRiveFile;
+typedef void* DriftSkiaRive;
+
+// Returns 1 when the bridge was built with the Rive runtime.
+int drift_skia_rive_supported(void);
+DriftSkiaRiveFile drift_skia_rive_file_create(const uint8_t* data, int length, char* error, int error_capacity);
+void drift_skia_rive_file_destroy(DriftSkiaRiveFile file);
+// Empty artboard or state machine names select the defaults.
+DriftSkiaRive drift_skia_rive_create(DriftSkiaRiveFile file, const char* artboard, const char* state_machine,
+    char* error, int error_capacity);
+void drift_skia_rive_destroy(DriftSkiaRive rive);
+int drift_skia_rive_get_size(DriftSkiaRive rive, float* width, float* height);
+int drift_skia_rive_input_count(DriftSkiaRive rive);
+// type: 0=bool, 1=number, 2=trigger
+int drift_skia_rive_input_info(DriftSkiaRive rive, int index, char* name, int name_capacity, int* type);
+int drift_skia_rive_set_bool(DriftSkiaRive rive, const char* name, int value);
+int drift_skia_rive_set_number(DriftSkiaRive rive, const char* name, float value);
+int drift_skia_rive_fire_trigger(DriftSkiaRive rive, const char* name);
+int drift_skia_rive_get_value(DriftSkiaRive rive, const char* name, float* value);
+// Returns 1 while the animation has more to play.
+int drift_skia_rive_advance(DriftSkiaRive rive, float seconds);
+// kind: 0=down, 1=move, 2=up. x and y are in the width x height box the
+// artboard is drawn in. Returns 1 if the pointer hit a listener.
+int drift_skia_rive_pointer(DriftSkiaRive rive, int kind, float x, float y, float width, float height);
+void drift_skia_rive_render(DriftSkiaRive rive, DriftSkiaCanvas canvas, float width, float height);
+
 DriftSkiaSurface drift_skia_surface_create_offscreen_metal(DriftSkiaContext ctx, int width, int height);
 DriftSkiaSurface drift_skia_surface_create_offscreen_vulkan(DriftSkiaContext ctx, int width, int height);
 void drift_skia_context_flush_and_submit(DriftSkiaContext ctx, int sync_cpu);
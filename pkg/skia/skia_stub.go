@@ -38,14 +38,29 @@ type ParagraphMetrics struct {
 	LongestLine       float64
 	MaxIntrinsicWidth float64
 	LineCount         int
+	// DidExceedMaxLines reports whether lines were cut off by the line
+	// limit.
+	DidExceedMaxLines bool
 }
 
 // ParagraphLineMetrics reports per-line layout metrics.
 type ParagraphLineMetrics struct {
-	Widths   []float64
-	Ascents  []float64
-	Descents []float64
-	Heights  []float64
+	Widths    []float64
+	Ascents   []float64
+	Descents  []float64
+	Heights   []float64
+	Lefts     []float64
+	Baselines []float64
+	// Starts and Ends are the UTF-8 offsets of each line's text, with Ends
+	// including trailing whitespace and newlines.
+	Starts []int
+	Ends   []int
+}
+
+// ParagraphBox is the bounding box of a run of glyphs.
+type ParagraphBox struct {
+	Left, Top, Right, Bottom float64
+	RTL                      bool
 }
 
 // NewMetalContext creates a Skia GPU context using the provided Metal device/queue.
@@ -71,12 +86,12 @@ func (c *Context) PurgeGpuResources() {}
 func (c *Context) WarmupShaders(backend string) error { return errStubNotSupported }
 
 // MakeMetalSurface creates a Skia surface targeting the provided Metal texture.
-func (c *Context) MakeMetalSurface(texture unsafe.Pointer, width, height int) (*Surface, error) {
+func (c *Context) MakeMetalSurface(texture unsafe.Pointer, width, height int, colorSpace int32) (*Surface, error) {
 	return nil, errStubNotSupported
 }
 
 // MakeVulkanSurface creates a Skia surface wrapping the provided VkImage.
-func (c *Context) MakeVulkanSurface(width, height int, vkImage uintptr, vkFormat uint32) (*Surface, error) {
+func (c *Context) MakeVulkanSurface(width, height int, vkImage uintptr, vkFormat uint32, colorSpace int32) (*Surface, error) {
 	return nil, errStubNotSupported
 }
 
@@ -159,7 +174,7 @@ func CanvasClear(canvas unsafe.Pointer, argb uint32) {}
 func CanvasDrawRect(
 	canvas unsafe.Pointer,
 	left, top, right, bottom float32,
-	argb uint32, style int32, strokeWidth float32, aa bool,
+	argb uint32, style int32, strokeWidth float32, flags int32,
 	strokeCap, strokeJoin int32, miterLimit float32,
 	dashIntervals []float32, dashPhase float32,
 	blendMode int32, alpha float32,
@@ -171,7 +186,7 @@ func CanvasDrawRRect(
 	canvas unsafe.Pointer,
 	left, top, right, bottom float32,
 	rx1, ry1, rx2, ry2, rx3, ry3, rx4, ry4 float32,
-	argb uint32, style int32, strokeWidth float32, aa bool,
+	argb uint32, style int32, strokeWidth float32, flags int32,
 	strokeCap, strokeJoin int32, miterLimit float32,
 	dashIntervals []float32, dashPhase float32,
 	blendMode int32, alpha float32,
@@ -182,7 +197,7 @@ func CanvasDrawRRect(
 func CanvasDrawCircle(
 	canvas unsafe.Pointer,
 	cx, cy, radius float32,
-	argb uint32, style int32, strokeWidth float32, aa bool,
+	argb uint32, style int32, strokeWidth float32, flags int32,
 	strokeCap, strokeJoin int32, miterLimit float32,
 	dashIntervals []float32, dashPhase float32,
 	blendMode int32, alpha float32,
@@ -193,7 +208,7 @@ func CanvasDrawCircle(
 func CanvasDrawLine(
 	canvas unsafe.Pointer,
 	x1, y1, x2, y2 float32,
-	argb uint32, strokeWidth float32, aa bool,
+	argb uint32, strokeWidth float32, flags int32,
 	strokeCap, strokeJoin int32, miterLimit float32,
 	dashIntervals []float32, dashPhase float32,
 	blendMode int32, alpha float32,
@@ -204,7 +219,7 @@ func CanvasDrawLine(
 func CanvasDrawRectGradient(
 	canvas unsafe.Pointer,
 	left, top, right, bottom float32,
-	argb uint32, style int32, strokeWidth float32, aa bool,
+	argb uint32, style int32, strokeWidth float32, flags int32,
 	strokeCap, strokeJoin int32, miterLimit float32,
 	dashIntervals []float32, dashPhase float32,
 	blendMode int32, alpha float32,
@@ -220,7 +235,7 @@ func CanvasDrawRRectGradient(
 	canvas unsafe.Pointer,
 	left, top, right, bottom float32,
 	rx1, ry1, rx2, ry2, rx3, ry3, rx4, ry4 float32,
-	argb uint32, style int32, strokeWidth float32, aa bool,
+	argb uint32, style int32, strokeWidth float32, flags int32,
 	strokeCap, strokeJoin int32, miterLimit float32,
 	dashIntervals []float32, dashPhase float32,
 	blendMode int32, alpha float32,
@@ -235,7 +250,7 @@ func CanvasDrawRRectGradient(
 func CanvasDrawCircleGradient(
 	canvas unsafe.Pointer,
 	cx, cy, radius float32,
-	argb uint32, style int32, strokeWidth float32, aa bool,
+	argb uint32, style int32, strokeWidth float32, flags int32,
 	strokeCap, strokeJoin int32, miterLimit float32,
 	dashIntervals []float32, dashPhase float32,
 	blendMode int32, alpha float32,
@@ -250,7 +265,7 @@ func CanvasDrawCircleGradient(
 func CanvasDrawLineGradient(
 	canvas unsafe.Pointer,
 	x1, y1, x2, y2 float32,
-	argb uint32, strokeWidth float32, aa bool,
+	argb uint32, strokeWidth float32, flags int32,
 	strokeCap, strokeJoin int32, miterLimit float32,
 	dashIntervals []float32, dashPhase float32,
 	blendMode int32, alpha float32,
@@ -265,7 +280,7 @@ func CanvasDrawLineGradient(
 func CanvasDrawPathGradient(
 	canvas unsafe.Pointer,
 	path *Path,
-	argb uint32, style int32, strokeWidth float32, aa bool,
+	argb uint32, style int32, strokeWidth float32, flags int32,
 	strokeCap, strokeJoin int32, miterLimit float32,
 	dashIntervals []float32, dashPhase float32,
 	blendMode int32, alpha float32,
@@ -321,6 +336,7 @@ func NewParagraph(
 	size float32,
 	weight int,
 	style int,
+	variations FontVariations,
 	color uint32,
 	maxLines int,
 	gradientType int32,
@@ -328,14 +344,22 @@ func NewParagraph(
 	centerX, centerY, radius float32,
 	colors []uint32,
 	positions []float32,
-	shadow *ParagraphShadow,
+	shadows []ParagraphShadow,
+	decoration int,
+	decorationColor uint32,
+	decorationStyle int,
+	strokeWidth float32,
+	letterSpacing, wordSpacing, height float32,
 	textAlign int,
+	textDirection int,
+	strut *ParagraphStrut,
+	ellipsis string,
 ) (*Paragraph, error) {
 	return nil, errStubNotSupported
 }
 
 // NewRichParagraph creates a paragraph with multiple styled spans.
-func NewRichParagraph(spans []TextSpanData, maxLines int, textAlign int) (*Paragraph, error) {
+func NewRichParagraph(spans []TextSpanData, maxLines int, textAlign int, textDirection int, strut *ParagraphStrut, ellipsis string) (*Paragraph, error) {
 	return nil, errStubNotSupported
 }
 
@@ -352,6 +376,24 @@ func (p *Paragraph) LineMetrics() (ParagraphLineMetrics, error) {
 	return ParagraphLineMetrics{}, errStubNotSupported
 }
 
+// GlyphPosition returns the UTF-16 index of the text position nearest to
+// (x, y), and whether the position belongs to the preceding character.
+func (p *Paragraph) GlyphPosition(x, y float32) (int, bool, error) {
+	return 0, false, errStubNotSupported
+}
+
+// RectsForRange returns the boxes of the glyphs between the UTF-16 indices
+// start and end.
+func (p *Paragraph) RectsForRange(start, end int) ([]ParagraphBox, error) {
+	return nil, errStubNotSupported
+}
+
+// PlaceholderRects returns the boxes of the paragraph's placeholders, in
+// order. Placeholders cut off by the line limit are left out.
+func (p *Paragraph) PlaceholderRects() ([]ParagraphBox, error) {
+	return nil, errStubNotSupported
+}
+
 // Paint renders the paragraph to the canvas at the given position.
 func (p *Paragraph) Paint(canvas unsafe.Pointer, x, y float32) {}
 
@@ -370,6 +412,18 @@ func RegisterFont(name string, data []byte) error {
 	return errStubNotSupported
 }
 
+// RegisterFontStyle registers one weight and style of a font family with the
+// Skia backend.
+func RegisterFontStyle(name string, data []byte, weight int, style int) error {
+	return errStubNotSupported
+}
+
+// SetFontFallbacks sets the families paragraphs try for characters missing
+// from their own family.
+func SetFontFallbacks(families []string) error {
+	return errStubNotSupported
+}
+
 // MeasureTextWidth returns the advance width for the text.
 func MeasureTextWidth(text, family string, size float64, weight int, style int) (float64, error) {
 	return 0, errStubNotSupported
@@ -409,17 +463,96 @@ func (p *Path) CubicTo(x1, y1, x2, y2, x3, y3 float32) {}
 // Close closes the current subpath.
 func (p *Path) Close() {}
 
+// Path operations accepted by PathOp, matching SkPathOp.
+const (
+	PathOpDifference        = 0
+	PathOpIntersect         = 1
+	PathOpUnion             = 2
+	PathOpXor               = 3
+	PathOpReverseDifference = 4
+)
+
+// PathOp combines two paths with a boolean operation.
+func PathOp(one, two *Path, op int) *Path {
+	return nil
+}
+
+// Read returns the path's verbs, point coordinates, and fill type.
+func (p *Path) Read() (verbs []uint8, points []float32, fillType int) {
+	return nil, nil, FillTypeWinding
+}
+
 // CanvasDrawPath draws a path with the provided paint settings.
 func CanvasDrawPath(
 	canvas unsafe.Pointer,
 	path *Path,
-	argb uint32, style int32, strokeWidth float32, aa bool,
+	argb uint32, style int32, strokeWidth float32, flags int32,
 	strokeCap, strokeJoin int32, miterLimit float32,
 	dashIntervals []float32, dashPhase float32,
 	blendMode int32, alpha float32,
 ) {
 }
 
+// CanvasDrawPathShader draws a path with a runtime effect shader.
+func CanvasDrawPathShader(
+	canvas unsafe.Pointer,
+	path *Path,
+	effect *RuntimeEffect, uniforms []float32,
+	style int32, strokeWidth float32, flags int32,
+	strokeCap, strokeJoin int32, miterLimit float32,
+	dashIntervals []float32, dashPhase float32,
+	blendMode int32, alpha float32,
+) {
+}
+
+// CanvasDrawVertices draws a triangle mesh.
+func CanvasDrawVertices(
+	canvas unsafe.Pointer,
+	mode int32,
+	positions, texCoords []float32,
+	colors []uint32,
+	indices []uint16,
+	pixels []uint8, width, height, stride int, filterQuality int,
+	effect *RuntimeEffect, uniforms []float32,
+	argb uint32, blendMode int32, alpha float32,
+) {
+}
+
+// RuntimeEffect wraps a compiled SkSL shader.
+type RuntimeEffect struct{}
+
+// RuntimeEffectUniform describes one uniform of a RuntimeEffect. Offset and
+// Count are measured in 32-bit slots.
+type RuntimeEffectUniform struct {
+	Name   string
+	Offset int
+	Count  int
+	IsInt  bool
+}
+
+// NewRuntimeEffect compiles SkSL shader source.
+func NewRuntimeEffect(sksl string) (*RuntimeEffect, error) {
+	return nil, errStubNotSupported
+}
+
+// Destroy releases the runtime effect.
+func (e *RuntimeEffect) Destroy() {}
+
+// Ptr returns the underlying C handle for encoding into filter data.
+func (e *RuntimeEffect) Ptr() unsafe.Pointer {
+	return nil
+}
+
+// Uniforms describes the effect's uniforms in declaration order.
+func (e *RuntimeEffect) Uniforms() []RuntimeEffectUniform {
+	return nil
+}
+
+// ChildCount returns the number of child shaders the effect samples.
+func (e *RuntimeEffect) ChildCount() int {
+	return 0
+}
+
 // CanvasDrawRectShadow draws a shadow behind a rectangle.
 func CanvasDrawRectShadow(
 	canvas unsafe.Pointer,
@@ -513,6 +646,11 @@ func (s *Skottie) Duration() float64 {
 	return 0
 }
 
+// FrameRate returns the animation's frame rate in frames per second.
+func (s *Skottie) FrameRate() float64 {
+	return 0
+}
+
 // Size returns the intrinsic size of the animation.
 func (s *Skottie) Size() (width, height float64) {
 	return 0, 0
@@ -523,3 +661,90 @@ func (s *Skottie) Seek(t float64) {}
 
 // SkottieSeekAndRender seeks to normalized time t and renders the current frame.
 func SkottieSeekAndRender(animPtr, canvasPtr unsafe.Pointer, t, width, height float32) {}
+
+// RiveSupported reports whether the bridge was built with the Rive runtime.
+func RiveSupported() bool {
+	return false
+}
+
+// RiveFile wraps an imported Rive file.
+type RiveFile struct{}
+
+// NewRiveFile imports a Rive file from its binary data.
+func NewRiveFile(data []byte) (*RiveFile, error) {
+	return nil, errors.New("skia: rive: not supported on this platform")
+}
+
+// Destroy releases the file. Instances created from it remain valid.
+func (f *RiveFile) Destroy() {}
+
+// RiveInput kinds reported by [Rive.Input].
+const (
+	RiveInputBool    = 0
+	RiveInputNumber  = 1
+	RiveInputTrigger = 2
+)
+
+// Rive wraps an artboard instance and its state machine.
+type Rive struct{}
+
+// NewRive instantiates an artboard from file with the named state machine.
+func NewRive(file *RiveFile, artboard, stateMachine string) (*Rive, error) {
+	return nil, errors.New("skia: rive: not supported on this platform")
+}
+
+// Destroy releases the instance.
+func (r *Rive) Destroy() {}
+
+// Ptr returns the underlying C handle for use in DrawRive.
+func (r *Rive) Ptr() unsafe.Pointer {
+	return nil
+}
+
+// Size returns the artboard size.
+func (r *Rive) Size() (width, height float64) {
+	return 0, 0
+}
+
+// InputCount returns the number of state machine inputs.
+func (r *Rive) InputCount() int {
+	return 0
+}
+
+// Input returns the name and kind of the input at index.
+func (r *Rive) Input(index int) (name string, kind int, ok bool) {
+	return "", 0, false
+}
+
+// SetBool sets a boolean input.
+func (r *Rive) SetBool(name string, value bool) bool {
+	return false
+}
+
+// SetNumber sets a number input.
+func (r *Rive) SetNumber(name string, value float64) bool {
+	return false
+}
+
+// FireTrigger fires a trigger input.
+func (r *Rive) FireTrigger(name string) bool {
+	return false
+}
+
+// Value returns the value of a bool or number input.
+func (r *Rive) Value(name string) (float64, bool) {
+	return 0, false
+}
+
+// Advance moves the state machine forward by seconds.
+func (r *Rive) Advance(seconds float64) bool {
+	return false
+}
+
+// Pointer delivers a pointer event within a width x height box.
+func (r *Rive) Pointer(kind int, x, y, width, height float64) bool {
+	return false
+}
+
+// RiveRender renders an artboard instance scaled to fit width x height.
+func RiveRender(rivePtr, canvasPtr unsafe.Pointer, width, height float32) {}
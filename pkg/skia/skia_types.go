@@ -7,6 +7,7 @@ type TextSpanData struct {
 	Size            float32
 	Weight          int
 	Style           int
+	Variations      FontVariations
 	Color           uint32
 	Decoration      int
 	DecorationColor uint32
@@ -16,4 +17,32 @@ type TextSpanData struct {
 	Height          float32
 	HasBackground   bool
 	BackgroundColor uint32
+	// Placeholder reserves PlaceholderWidth x PlaceholderHeight for an
+	// inline widget instead of drawing Text. PlaceholderAlignment is Skia's
+	// PlaceholderAlignment.
+	Placeholder               bool
+	PlaceholderWidth          float32
+	PlaceholderHeight         float32
+	PlaceholderAlignment      int
+	PlaceholderBaselineOffset float32
+}
+
+// ParagraphStrut sets the minimum height of every line of a paragraph from
+// a font. A zero Size or Height leaves it at the paragraph's default.
+type ParagraphStrut struct {
+	Family      string
+	Size        float32
+	Weight      int
+	Style       int
+	Height      float32
+	Leading     float32
+	ForceHeight bool
+}
+
+// FontVariations holds variable font axis coordinates. A zero coordinate
+// leaves its axis at the font's default; fonts without an axis ignore it.
+type FontVariations struct {
+	Weight float32 // wght
+	Width  float32 // wdth
+	Slant  float32 // slnt
 }
@@ -81,6 +81,11 @@ func warmupPrimitives(canvas unsafe.Pointer) {
 		blendSrcOver int32 = 3
 	)
 
+	// Paint flags
+	const (
+		flagAntialias int32 = 1
+	)
+
 	// Gradient colors and positions
 	gradientColors := []uint32{red, blue}
 	gradientPositions := []float32{0.0, 1.0}
@@ -89,25 +94,25 @@ func warmupPrimitives(canvas unsafe.Pointer) {
 	CanvasClear(canvas, white)
 
 	// 2. DrawRect (solid) - solid color shader
-	CanvasDrawRect(canvas, 0, 0, 8, 8, black, styleFill, 0, true,
+	CanvasDrawRect(canvas, 0, 0, 8, 8, black, styleFill, 0, flagAntialias,
 		capButt, joinMiter, 4, nil, 0, blendSrcOver, 1.0)
 
 	// 3. DrawRRect - rounded rect shader
 	CanvasDrawRRect(canvas, 0, 0, 8, 8, 2, 2, 2, 2, 2, 2, 2, 2,
-		black, styleFill, 0, true, capButt, joinMiter, 4, nil, 0, blendSrcOver, 1.0)
+		black, styleFill, 0, flagAntialias, capButt, joinMiter, 4, nil, 0, blendSrcOver, 1.0)
 
 	// 4. DrawCircle - circle shader
-	CanvasDrawCircle(canvas, 8, 8, 4, black, styleFill, 0, true,
+	CanvasDrawCircle(canvas, 8, 8, 4, black, styleFill, 0, flagAntialias,
 		capButt, joinMiter, 4, nil, 0, blendSrcOver, 1.0)
 
 	// 5. DrawRectGradient (linear) - linear gradient shader
-	CanvasDrawRectGradient(canvas, 0, 0, 8, 8, white, styleFill, 0, true,
+	CanvasDrawRectGradient(canvas, 0, 0, 8, 8, white, styleFill, 0, flagAntialias,
 		capButt, joinMiter, 4, nil, 0, blendSrcOver, 1.0,
 		gradientLinear, 0, 0, 8, 8, 0, 0, 0,
 		gradientColors, gradientPositions)
 
 	// 6. DrawCircleGradient (radial) - radial gradient shader
-	CanvasDrawCircleGradient(canvas, 8, 8, 4, white, styleFill, 0, true,
+	CanvasDrawCircleGradient(canvas, 8, 8, 4, white, styleFill, 0, flagAntialias,
 		capButt, joinMiter, 4, nil, 0, blendSrcOver, 1.0,
 		gradientRadial, 0, 0, 0, 0, 8, 8, 4,
 		gradientColors, gradientPositions)
@@ -134,19 +139,19 @@ func warmupPrimitives(canvas unsafe.Pointer) {
 
 	// 11. SaveLayerBlur + draw - image filter blur shader
 	CanvasSaveLayerBlur(canvas, 0, 0, 16, 16, 2, 2)
-	CanvasDrawRect(canvas, 0, 0, 8, 8, red, styleFill, 0, true,
+	CanvasDrawRect(canvas, 0, 0, 8, 8, red, styleFill, 0, flagAntialias,
 		capButt, joinMiter, 4, nil, 0, blendSrcOver, 1.0)
 	CanvasRestore(canvas)
 
 	// 12. SaveLayerAlpha + draw - alpha blend shader
 	CanvasSaveLayerAlpha(canvas, 0, 0, 16, 16, 128)
-	CanvasDrawRect(canvas, 0, 0, 8, 8, blue, styleFill, 0, true,
+	CanvasDrawRect(canvas, 0, 0, 8, 8, blue, styleFill, 0, flagAntialias,
 		capButt, joinMiter, 4, nil, 0, blendSrcOver, 1.0)
 	CanvasRestore(canvas)
 
 	// 13. DrawRect (stroked + dash) - dash path effect shader
 	dashIntervals := []float32{2, 2}
-	CanvasDrawRect(canvas, 1, 1, 14, 14, black, styleStroke, 1, true,
+	CanvasDrawRect(canvas, 1, 1, 14, 14, black, styleStroke, 1, flagAntialias,
 		capButt, joinMiter, 4, dashIntervals, 0, blendSrcOver, 1.0)
 
 	// 14. DrawPath (curves) - path shader
@@ -155,7 +160,7 @@ func warmupPrimitives(canvas unsafe.Pointer) {
 	path.QuadTo(8, 0, 16, 8)
 	path.CubicTo(12, 12, 4, 12, 0, 8)
 	path.Close()
-	CanvasDrawPath(canvas, path, black, styleFill, 0, true,
+	CanvasDrawPath(canvas, path, black, styleFill, 0, flagAntialias,
 		capButt, joinMiter, 4, nil, 0, blendSrcOver, 1.0)
 	path.Destroy()
 
@@ -167,16 +172,16 @@ func warmupPrimitives(canvas unsafe.Pointer) {
 	clipPath.Close()
 	CanvasSave(canvas)
 	CanvasClipPath(canvas, clipPath, 0, true)
-	CanvasDrawRect(canvas, 0, 0, 16, 16, red, styleFill, 0, true,
+	CanvasDrawRect(canvas, 0, 0, 16, 16, red, styleFill, 0, flagAntialias,
 		capButt, joinMiter, 4, nil, 0, blendSrcOver, 1.0)
 	CanvasRestore(canvas)
 	clipPath.Destroy()
 
 	// 16. DrawLine (round cap/join) - stroke cap variant shader
-	CanvasDrawLine(canvas, 0, 0, 16, 16, black, 2, true,
+	CanvasDrawLine(canvas, 0, 0, 16, 16, black, 2, flagAntialias,
 		capRound, joinRound, 4, nil, 0, blendSrcOver, 1.0)
 
 	// 17. DrawLine (square cap/miter join) - stroke join variant shader
-	CanvasDrawLine(canvas, 0, 16, 16, 0, black, 2, true,
+	CanvasDrawLine(canvas, 0, 16, 16, 0, black, 2, flagAntialias,
 		capSquare, joinMiter, 4, nil, 0, blendSrcOver, 1.0)
 }
@@ -159,6 +159,21 @@ func (c *serializingCanvas) DrawImageRect(_ image.Image, _, dstRect graphics.Rec
 	})
 }
 
+func (c *serializingCanvas) DrawVertices(vertices *graphics.Vertices, img image.Image, _ graphics.Paint) {
+	if vertices == nil {
+		return
+	}
+	c.ops = append(c.ops, DisplayOp{
+		Op: "drawVertices",
+		Params: sortedMap(
+			"mode", vertices.Mode.String(),
+			"count", len(vertices.Positions),
+			"bounds", serializeRect(vertices.Bounds()),
+			"textured", img != nil,
+		),
+	})
+}
+
 func (c *serializingCanvas) DrawRectShadow(rect graphics.Rect, shadow graphics.BoxShadow) {
 	c.ops = append(c.ops, DisplayOp{
 		Op: "drawRectShadow",
@@ -219,6 +234,13 @@ func (c *serializingCanvas) DrawLottie(_ unsafe.Pointer, bounds graphics.Rect, t
 	})
 }
 
+func (c *serializingCanvas) DrawRive(_ unsafe.Pointer, bounds graphics.Rect) {
+	c.ops = append(c.ops, DisplayOp{
+		Op:     "drawRive",
+		Params: sortedMap("bounds", serializeRect(bounds)),
+	})
+}
+
 func (c *serializingCanvas) OccludePlatformViews(mask *graphics.Path) {
 	c.ops = append(c.ops, DisplayOp{
 		Op:     "occludePlatformViews",
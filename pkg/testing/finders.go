@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
 	"github.com/go-drift/drift/pkg/layout"
 	"github.com/go-drift/drift/pkg/widgets"
 )
@@ -82,6 +83,12 @@ func (r FinderResult) RenderObject() layout.RenderObject {
 	return extractRenderObject(r.First())
 }
 
+// GlobalOffset returns the global position of the first match's render
+// object. Panics if no matches.
+func (r FinderResult) GlobalOffset() graphics.Offset {
+	return core.GlobalOffsetOf(r.First())
+}
+
 // --- Concrete finders ---
 
 // typeFinder matches elements whose widget is of the specified type.
@@ -101,6 +101,20 @@ func TestFinderResult_First_PanicsOnEmpty(t *testing.T) {
 	tester.Find(ByText("missing")).First()
 }
 
+func TestFinderResult_GlobalOffset(t *testing.T) {
+	tester := NewWidgetTesterWithT(t)
+	tester.PumpWidget(widgets.Column{
+		Children: []core.Widget{
+			widgets.SizedBox{Height: 50},
+			widgets.Text{Content: "below"},
+		},
+	})
+
+	if got := tester.Find(ByText("below")).GlobalOffset().Y; got != 50 {
+		t.Errorf("expected the text at y=50, got %v", got)
+	}
+}
+
 func TestByPredicate(t *testing.T) {
 	tester := NewWidgetTesterWithT(t)
 	tester.PumpWidget(testbed.Counter{Initial: 7})
@@ -3,6 +3,7 @@ package testing
 import (
 	"fmt"
 
+	"github.com/go-drift/drift/pkg/core"
 	"github.com/go-drift/drift/pkg/gestures"
 	"github.com/go-drift/drift/pkg/graphics"
 	"github.com/go-drift/drift/pkg/layout"
@@ -254,7 +255,8 @@ func renderCenter(ro layout.RenderObject) graphics.Offset {
 }
 
 // absoluteOffset walks up the parent chain accumulating offsets from
-// BoxParentData to compute the root-relative position of a render object.
+// BoxParentData, and the scroll offsets of scrolling ancestors, to compute
+// the root-relative position of a render object.
 func absoluteOffset(ro layout.RenderObject) graphics.Offset {
 	offset := graphics.Offset{}
 	cur := ro
@@ -263,11 +265,16 @@ func absoluteOffset(ro layout.RenderObject) graphics.Offset {
 			offset.X += pd.Offset.X
 			offset.Y += pd.Offset.Y
 		}
-		if parent, ok := cur.(interface{ Parent() layout.RenderObject }); ok {
-			cur = parent.Parent()
-		} else {
+		parent, ok := cur.(interface{ Parent() layout.RenderObject })
+		if !ok {
 			break
 		}
+		cur = parent.Parent()
+		if scroll, ok := cur.(core.ScrollOffsetProvider); ok {
+			shift := scroll.ScrollOffset()
+			offset.X += shift.X
+			offset.Y += shift.Y
+		}
 	}
 	return offset
 }
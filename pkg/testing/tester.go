@@ -2,6 +2,7 @@ package testing
 
 import (
 	"errors"
+	"sync"
 	"testing"
 	"time"
 
@@ -26,6 +27,9 @@ const (
 // ErrSettleTimeout is returned when PumpAndSettle exceeds its timeout.
 var ErrSettleTimeout = errors.New("PumpAndSettle timed out: framework did not settle")
 
+// ErrPumpUntilTimeout is returned when PumpUntil exceeds its timeout.
+var ErrPumpUntilTimeout = errors.New("PumpUntil timed out: condition never held")
+
 // WidgetTester provides isolated widget testing without real rendering.
 // It drives the same build, layout, and paint phases as the engine but
 // uses a fake clock and recording canvas instead of the platform layer.
@@ -38,8 +42,10 @@ type WidgetTester struct {
 	size       graphics.Size
 	scale      float64
 	theme      *theme.AppThemeData
-	dispatches []func()
 	pointers   map[int]*pointerState
+
+	dispatchMu sync.Mutex
+	dispatches []func()
 }
 
 // NewWidgetTester creates a tester with default test environment.
@@ -109,12 +115,20 @@ func (t *WidgetTester) PumpWidget(widget core.Widget) error {
 		t.rootRender = nil
 	}
 
-	// Wrap in test scaffold: DeviceScale → AppTheme → user widget
+	// Wrap in test scaffold: DeviceScale → MediaQuery → AppTheme → user widget
+	mediaQuery := widgets.DefaultMediaQueryData()
+	mediaQuery.Size = t.size
+	if t.scale > 0 {
+		mediaQuery.DevicePixelRatio = t.scale
+	}
 	wrapped := widgets.DeviceScale{
 		Scale: t.scale,
-		Child: theme.AppTheme{
-			Data:  t.theme,
-			Child: widget,
+		Child: widgets.MediaQuery{
+			Data: mediaQuery,
+			Child: theme.AppTheme{
+				Data:  t.theme,
+				Child: widget,
+			},
 		},
 	}
 
@@ -137,8 +151,10 @@ func (t *WidgetTester) PumpWidget(widget core.Widget) error {
 // Pump runs a single frame cycle: dispatches, tickers, build, layout, paint.
 func (t *WidgetTester) Pump() error {
 	// 1. Drain dispatch queue
+	t.dispatchMu.Lock()
 	dispatches := t.dispatches
 	t.dispatches = nil
+	t.dispatchMu.Unlock()
 	for _, fn := range dispatches {
 		fn()
 	}
@@ -182,16 +198,44 @@ func (t *WidgetTester) PumpAndSettle(timeout time.Duration) error {
 	return ErrSettleTimeout
 }
 
+// PumpUntil pumps frames until done reports true or timeout of real time
+// passes, sleeping a millisecond between frames. Use it to wait for work on
+// other goroutines, such as a fetch that dispatches its result, which
+// PumpAndSettle does not see. The fake clock does not advance.
+// Returns ErrPumpUntilTimeout if done is still false at the timeout.
+func (t *WidgetTester) PumpUntil(done func() bool, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	for !done() {
+		if time.Now().After(deadline) {
+			return ErrPumpUntilTimeout
+		}
+		time.Sleep(time.Millisecond)
+		if err := t.Pump(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // needsWork returns true if the framework has pending work.
 func (t *WidgetTester) needsWork() bool {
 	return t.buildOwner.NeedsWork() ||
 		animation.HasActiveTickers() ||
 		widgets.HasActiveBallistics() ||
-		len(t.dispatches) > 0
+		t.hasDispatches()
+}
+
+func (t *WidgetTester) hasDispatches() bool {
+	t.dispatchMu.Lock()
+	defer t.dispatchMu.Unlock()
+	return len(t.dispatches) > 0
 }
 
 // Dispatch queues a callback for the next frame, mirroring engine.Dispatch.
+// It is safe to call from any goroutine.
 func (t *WidgetTester) Dispatch(fn func()) {
+	t.dispatchMu.Lock()
+	defer t.dispatchMu.Unlock()
 	t.dispatches = append(t.dispatches, fn)
 }
 
@@ -78,6 +78,26 @@ func TestPumpAndSettle_IdleWidget(t *testing.T) {
 	}
 }
 
+func TestPumpUntil(t *testing.T) {
+	tester := NewWidgetTesterWithT(t)
+	tester.PumpWidget(widgets.Text{Content: "test"})
+
+	done := make(chan struct{})
+	loaded := false
+	go func() {
+		tester.Dispatch(func() { loaded = true })
+		close(done)
+	}()
+	if err := tester.PumpUntil(func() bool { return loaded }, time.Second); err != nil {
+		t.Fatalf("expected the dispatched result, got: %v", err)
+	}
+	<-done
+
+	if err := tester.PumpUntil(func() bool { return false }, 10*time.Millisecond); err != ErrPumpUntilTimeout {
+		t.Errorf("expected ErrPumpUntilTimeout, got: %v", err)
+	}
+}
+
 func TestDispatch(t *testing.T) {
 	tester := NewWidgetTesterWithT(t)
 	tester.PumpWidget(widgets.Text{Content: "test"})
@@ -0,0 +1,120 @@
+package theme
+
+import (
+	"sync"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/platform"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+// ColorSchemeFromDynamicColors generates a Material 3 color scheme from the
+// system color palette of Android 12 and later (Material You). Each system
+// palette takes the place of one that [ColorSchemeFromSeed] derives from a
+// seed, so the scheme has the system's accent colors with the usual roles
+// and contrast. Returns false if colors is zero.
+func ColorSchemeFromDynamicColors(colors platform.DynamicColors, brightness Brightness) (ColorScheme, bool) {
+	if colors.IsZero() {
+		return ColorScheme{}, false
+	}
+	palette := func(c graphics.Color) tonalPalette {
+		hue, chroma := graphics.HueChroma(c.WithAlpha(1))
+		return tonalPalette{hue: hue, chroma: chroma}
+	}
+	p := seedPalettes{
+		primary:        palette(colors.Accent1),
+		secondary:      palette(colors.Accent2),
+		tertiary:       palette(colors.Accent3),
+		neutral:        palette(colors.Neutral1),
+		neutralVariant: palette(colors.Neutral2),
+		error:          tonalPalette{hue: 25, chroma: 84},
+	}
+	if brightness == BrightnessDark {
+		return darkSeedColorScheme(p, 4.5), true
+	}
+	return lightSeedColorScheme(p, 4.5), true
+}
+
+// DynamicColorSchemeOf returns the color scheme from the system palette in
+// the nearest [widgets.MediaQuery]. Where the system has none, it is
+// generated from seed, or is the default scheme if seed is zero. Widgets
+// calling this rebuild when the system palette changes.
+func DynamicColorSchemeOf(ctx core.BuildContext, brightness Brightness, seed graphics.Color) ColorScheme {
+	colors := themeColors{seed: seed}
+	if dynamic := widgets.MediaQueryDynamicColorsOf(ctx); !dynamic.IsZero() {
+		colors = themeColors{dynamic: dynamic}
+	}
+	if scheme, ok := colors.scheme(brightness); ok {
+		return scheme
+	}
+	if brightness == BrightnessDark {
+		return DarkColorScheme()
+	}
+	return LightColorScheme()
+}
+
+// themeColors is the source of the default themes' colors: the system
+// palette, a seed, or neither for the built-in colors.
+type themeColors struct {
+	dynamic platform.DynamicColors
+	seed    graphics.Color
+}
+
+// themeColorsOf returns the colors source for the adaptive theme widgets.
+func themeColorsOf(ctx core.BuildContext, dynamicColor bool, seed graphics.Color) themeColors {
+	if dynamicColor {
+		if dynamic := widgets.MediaQueryDynamicColorsOf(ctx); !dynamic.IsZero() {
+			return themeColors{dynamic: dynamic}
+		}
+	}
+	return themeColors{seed: seed}
+}
+
+// scheme returns the color scheme, or false for the built-in colors.
+func (c themeColors) scheme(brightness Brightness) (ColorScheme, bool) {
+	if !c.dynamic.IsZero() {
+		return ColorSchemeFromDynamicColors(c.dynamic, brightness)
+	}
+	if c.seed == 0 {
+		return ColorScheme{}, false
+	}
+	scheme, err := ColorSchemeFromSeed(ColorSchemeSeedOptions{Seed: c.seed, Brightness: brightness})
+	return scheme, err == nil
+}
+
+// defaultAppThemes caches the default theme data by platform, brightness,
+// and colors, so rebuilding an adaptive theme does not restart its
+// transition.
+var (
+	defaultAppThemesMu sync.Mutex
+	defaultAppThemes   = map[defaultAppThemeKey]*AppThemeData{}
+)
+
+type defaultAppThemeKey struct {
+	platform   TargetPlatform
+	brightness Brightness
+	colors     themeColors
+}
+
+func defaultAppTheme(platform TargetPlatform, brightness Brightness, colors themeColors) *AppThemeData {
+	defaultAppThemesMu.Lock()
+	defer defaultAppThemesMu.Unlock()
+	key := defaultAppThemeKey{platform, brightness, colors}
+	data, ok := defaultAppThemes[key]
+	if !ok {
+		data = NewAppThemeData(platform, brightness)
+		if scheme, ok := colors.scheme(brightness); ok {
+			data.Material = &ThemeData{
+				ColorScheme: scheme,
+				TextTheme:   DefaultTextTheme(scheme.OnBackground),
+				Brightness:  brightness,
+			}
+			// Cupertino widgets take their tint from the primary color.
+			data.Cupertino.PrimaryColor = scheme.Primary
+			data.Cupertino.PrimaryContrastingColor = scheme.OnPrimary
+		}
+		defaultAppThemes[key] = data
+	}
+	return data
+}
@@ -1,11 +1,15 @@
 package theme
 
 import (
+	"image"
 	"time"
 
+	"github.com/go-drift/drift/pkg/animation"
 	"github.com/go-drift/drift/pkg/core"
 	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/layout"
 	"github.com/go-drift/drift/pkg/platform"
+	"github.com/go-drift/drift/pkg/responsive"
 	"github.com/go-drift/drift/pkg/widgets"
 )
 
@@ -176,7 +180,8 @@ func DropdownOf[T comparable](ctx core.BuildContext, value T, items []widgets.Dr
 //
 // This is the recommended way to create text fields that follow the app's theme.
 // The returned text field has all visual properties pre-filled from the theme,
-// including colors, dimensions, and typography styles.
+// including colors, dimensions, and typography styles. The keyboard appearance
+// matches the theme's brightness, and smart dashes and quotes are enabled.
 //
 // To override specific properties, chain WithX methods on the returned text field.
 //
@@ -189,8 +194,13 @@ func DropdownOf[T comparable](ctx core.BuildContext, value T, items []widgets.Dr
 //	    WithPlaceholder("Enter email").
 //	    WithLabel("Email")
 func TextFieldOf(ctx core.BuildContext, controller *platform.TextEditingController) widgets.TextField {
-	th := ThemeOf(ctx).TextFieldThemeOf()
+	themeData := ThemeOf(ctx)
+	th := themeData.TextFieldThemeOf()
 	_, _, textTheme := UseTheme(ctx)
+	keyboardAppearance := platform.KeyboardAppearanceLight
+	if themeData.Brightness == BrightnessDark {
+		keyboardAppearance = platform.KeyboardAppearanceDark
+	}
 	return widgets.TextField{
 		Controller:       controller,
 		BackgroundColor:  th.BackgroundColor,
@@ -205,6 +215,46 @@ func TextFieldOf(ctx core.BuildContext, controller *platform.TextEditingControll
 		LabelStyle:       graphics.TextStyle{FontSize: textTheme.LabelMedium.FontSize, Color: th.LabelColor},
 		HelperStyle:      graphics.TextStyle{FontSize: textTheme.BodySmall.FontSize, Color: th.LabelColor},
 		ErrorColor:       th.ErrorColor,
+		ObscureToggleStyle: graphics.TextStyle{
+			FontSize:   textTheme.LabelLarge.FontSize,
+			FontWeight: textTheme.LabelLarge.FontWeight,
+			Color:      th.FocusColor,
+		},
+		KeyboardAppearance: keyboardAppearance,
+		SmartDashes:        true,
+		SmartQuotes:        true,
+	}
+}
+
+// SpellCheckSuggestionsToolbarOf creates a [widgets.SpellCheckSuggestionsToolbar]
+// for r with visual properties filled from the current theme's colors.
+//
+// The returned toolbar has:
+//   - Color set to ColorScheme.SurfaceContainerHigh
+//   - Style from TextTheme.LabelLarge in ColorScheme.OnSurface
+//   - Padding of 12 horizontal and 10 vertical
+//   - BorderRadius set to 8
+//   - MaxSuggestions set to 3
+//
+// Example:
+//
+//	if r, ok := platform.FindMisspelledRange(s.misspelled, controller.Selection().Start()); ok {
+//	    toolbar := theme.SpellCheckSuggestionsToolbarOf(ctx, controller, r)
+//	}
+func SpellCheckSuggestionsToolbarOf(ctx core.BuildContext, controller *platform.TextEditingController, r platform.MisspelledRange) widgets.SpellCheckSuggestionsToolbar {
+	_, colors, textTheme := UseTheme(ctx)
+	return widgets.SpellCheckSuggestionsToolbar{
+		Controller:     controller,
+		Range:          r,
+		MaxSuggestions: 3,
+		Color:          colors.SurfaceContainerHigh,
+		Style: graphics.TextStyle{
+			FontSize:   textTheme.LabelLarge.FontSize,
+			FontWeight: textTheme.LabelLarge.FontWeight,
+			Color:      colors.OnSurface,
+		},
+		Padding:      layout.EdgeInsetsSymmetric(12, 10),
+		BorderRadius: 8,
 	}
 }
 
@@ -349,6 +399,38 @@ func TabBarOf(ctx core.BuildContext, items []widgets.TabItem, currentIndex int,
 	}
 }
 
+// AdaptiveScaffoldOf creates a [responsive.AdaptiveScaffold] with its bottom
+// bar styled from the current theme's [TabBarThemeData] and its rail and
+// drawer styled from the color scheme.
+//
+// Example:
+//
+//	theme.AdaptiveScaffoldOf(ctx, destinations, s.selected, func(i int) {
+//	    s.SetState(func() { s.selected = i })
+//	}, pages[s.selected])
+func AdaptiveScaffoldOf(ctx core.BuildContext, destinations []widgets.TabItem, selectedIndex int, onSelected func(int), body core.Widget) responsive.AdaptiveScaffold {
+	th := ThemeOf(ctx).TabBarThemeOf()
+	_, colors, textTheme := UseTheme(ctx)
+	return responsive.AdaptiveScaffold{
+		Destinations:            destinations,
+		SelectedIndex:           selectedIndex,
+		OnSelected:              onSelected,
+		Body:                    body,
+		BackgroundColor:         th.BackgroundColor,
+		ActiveColor:             th.ActiveColor,
+		InactiveColor:           th.InactiveColor,
+		IndicatorColor:          th.IndicatorColor,
+		IndicatorHeight:         th.IndicatorHeight,
+		SelectedBackgroundColor: colors.SecondaryContainer,
+		DividerColor:            ThemeOf(ctx).DividerThemeOf().Color,
+		LabelStyle:              textTheme.LabelMedium,
+		Padding:                 th.Padding,
+		BottomBarHeight:         th.Height,
+		RailWidth:               80,
+		DrawerWidth:             300,
+	}
+}
+
 // DatePickerOf creates a [widgets.DatePicker] with visual properties filled from
 // the current theme's colors.
 //
@@ -584,3 +666,222 @@ func LinearProgressIndicatorOf(ctx core.BuildContext, value *float64) widgets.Li
 		BorderRadius: 2,
 	}
 }
+
+// PagedListViewOf creates a [widgets.PagedListView] with its indicators
+// built from the current theme.
+//
+// The returned list has:
+//   - a centered [CircularProgressIndicatorOf] while the first page loads
+//   - a smaller progress indicator after the last item while the next page loads
+//   - the error message and a "Retry" [ButtonOf] when a page fails to load
+//   - FetchThreshold set to 200
+//
+// NoItemsBuilder is left nil; set it to show an empty state. Override any
+// builder on the returned value.
+//
+// Example:
+//
+//	theme.PagedListViewOf(ctx, s.paging, func(ctx core.BuildContext, post Post, index int) core.Widget {
+//	    return postTile(post)
+//	})
+func PagedListViewOf[K, T any](ctx core.BuildContext, controller *widgets.PagingController[K, T], itemBuilder func(ctx core.BuildContext, item T, index int) core.Widget) widgets.PagedListView[K, T] {
+	errorView := func(ctx core.BuildContext, err error, retry func()) core.Widget {
+		_, colors, textTheme := UseTheme(ctx)
+		style := textTheme.BodyMedium
+		style.Color = colors.OnSurfaceVariant
+		message := "Something went wrong"
+		if err != nil {
+			message = err.Error()
+		}
+		return widgets.Padding{
+			Padding: layout.EdgeInsetsAll(16),
+			Child: widgets.Column{
+				MainAxisSize:       widgets.MainAxisSizeMin,
+				CrossAxisAlignment: widgets.CrossAxisAlignmentCenter,
+				Children: []core.Widget{
+					widgets.Text{Content: message, Style: style, Align: graphics.TextAlignCenter},
+					widgets.VSpace(12),
+					ButtonOf(ctx, "Retry", retry),
+				},
+			},
+		}
+	}
+	return widgets.PagedListView[K, T]{
+		Controller:  controller,
+		ItemBuilder: itemBuilder,
+		FirstPageLoadingBuilder: func(ctx core.BuildContext) core.Widget {
+			return widgets.Center{Child: CircularProgressIndicatorOf(ctx, nil)}
+		},
+		FirstPageErrorBuilder: func(ctx core.BuildContext, err error, retry func()) core.Widget {
+			return widgets.Center{Child: errorView(ctx, err, retry)}
+		},
+		NextPageLoadingBuilder: func(ctx core.BuildContext) core.Widget {
+			indicator := CircularProgressIndicatorOf(ctx, nil)
+			indicator.Size = 24
+			indicator.StrokeWidth = 3
+			return widgets.Padding{
+				Padding: layout.EdgeInsetsAll(16),
+				Child:   widgets.Center{Child: indicator},
+			}
+		},
+		NextPageErrorBuilder: errorView,
+		FetchThreshold:       200,
+	}
+}
+
+// ImageCropperOf creates a [widgets.ImageCropper] for source with visual
+// properties filled from the current theme's colors.
+//
+// The returned cropper has:
+//   - OverlayColor set to ColorScheme.Scrim at 60% opacity
+//   - BorderColor set to white, so the frame shows on dark and light photos
+//   - BorderWidth set to 2
+//   - Padding set to 24
+//   - MaxScale set to 8
+//
+// For fully explicit croppers without theme styling, use
+// [widgets.ImageCropper] struct literals.
+//
+// Example:
+//
+//	cropper := theme.ImageCropperOf(ctx, photo, s.crop)
+//	cropper.AspectRatio = widgets.CropAspectSquare
+//	cropper.Shape = widgets.CropShapeOval
+func ImageCropperOf(ctx core.BuildContext, source image.Image, controller *widgets.ImageCropController) widgets.ImageCropper {
+	_, colors, _ := UseTheme(ctx)
+	return widgets.ImageCropper{
+		Source:       source,
+		Controller:   controller,
+		OverlayColor: colors.Scrim.WithAlpha(0.6),
+		BorderColor:  graphics.ColorWhite,
+		BorderWidth:  2,
+		Padding:      24,
+		MaxScale:     8,
+	}
+}
+
+// PinCodeFieldOf creates a [widgets.PinCodeField] with visual properties
+// filled from the current theme's [TextFieldThemeData], so the boxes match
+// the app's text fields.
+//
+// The returned field has:
+//   - Six 48x56 boxes spaced 8 apart
+//   - BackgroundColor, BorderColor, FocusColor, ErrorColor, BorderRadius, and
+//     BorderWidth from the text field theme
+//   - Style from TextTheme.HeadlineSmall in the text field's TextColor
+//   - ErrorStyle from TextTheme.BodySmall in the text field's ErrorColor
+//
+// For fully explicit fields without theme styling, use [widgets.PinCodeField]
+// struct literals.
+//
+// Example:
+//
+//	theme.PinCodeFieldOf(ctx, s.code).
+//	    WithOneTimeCode().
+//	    WithOnCompleted(s.verify)
+func PinCodeFieldOf(ctx core.BuildContext, controller *platform.TextEditingController) widgets.PinCodeField {
+	th := ThemeOf(ctx).TextFieldThemeOf()
+	_, _, textTheme := UseTheme(ctx)
+	return widgets.PinCodeField{
+		Controller:      controller,
+		BoxWidth:        48,
+		BoxHeight:       56,
+		Spacing:         8,
+		BorderRadius:    th.BorderRadius,
+		BorderWidth:     th.BorderWidth,
+		BackgroundColor: th.BackgroundColor,
+		BorderColor:     th.BorderColor,
+		FocusColor:      th.FocusColor,
+		ErrorColor:      th.ErrorColor,
+		Style: graphics.TextStyle{
+			FontSize:   textTheme.HeadlineSmall.FontSize,
+			FontWeight: textTheme.HeadlineSmall.FontWeight,
+			Color:      th.TextColor,
+		},
+		ErrorStyle: graphics.TextStyle{FontSize: textTheme.BodySmall.FontSize, Color: th.ErrorColor},
+	}
+}
+
+// TreeViewOf creates a [widgets.TreeView] for controller with rows styled
+// from the current theme. label gives the text shown for each node.
+//
+// The returned tree has:
+//   - 40-high rows indented 20 per level, with a 24-wide expander column
+//   - labels in TextTheme.BodyLarge and ColorScheme.OnSurface, or
+//     ColorScheme.Error after a node's children fail to load
+//   - expander chevrons in ColorScheme.OnSurfaceVariant
+//   - 1-wide indentation guides in ColorScheme.OutlineVariant
+//   - the selected row on ColorScheme.SecondaryContainer
+//   - a small [CircularProgressIndicatorOf] while children load
+//   - 200ms expand and collapse animations
+//
+// Override NodeBuilder on the returned value for richer rows, such as icons
+// or trailing counts.
+//
+// Example:
+//
+//	theme.TreeViewOf(ctx, s.tree, filepath.Base).
+//	    WithOnSelect(s.showFile)
+func TreeViewOf[T comparable](ctx core.BuildContext, controller *widgets.TreeController[T], label func(node T) string) widgets.TreeView[T] {
+	_, colors, textTheme := UseTheme(ctx)
+	return widgets.TreeView[T]{
+		Controller: controller,
+		NodeBuilder: func(ctx core.BuildContext, row widgets.TreeRow[T]) core.Widget {
+			style := textTheme.BodyLarge
+			style.Color = colors.OnSurface
+			if row.Selected {
+				style.Color = colors.OnSecondaryContainer
+			}
+			if row.Err != nil {
+				style.Color = colors.Error
+			}
+			text := ""
+			if label != nil {
+				text = label(row.Node)
+			}
+			return widgets.Padding{
+				Directional: layout.EdgeInsetsDirectionalOnly(4, 0, 8, 0),
+				Child:       widgets.Text{Content: text, Style: style, MaxLines: 1},
+			}
+		},
+		LoadingBuilder: func(ctx core.BuildContext) core.Widget {
+			indicator := CircularProgressIndicatorOf(ctx, nil)
+			indicator.Size = 14
+			indicator.StrokeWidth = 2
+			return indicator
+		},
+		RowHeight:     40,
+		Indent:        20,
+		ExpanderSize:  24,
+		ExpanderColor: colors.OnSurfaceVariant,
+		GuideColor:    colors.OutlineVariant,
+		GuideWidth:    1,
+		SelectedColor: colors.SecondaryContainer,
+		Duration:      200 * time.Millisecond,
+		Curve:         animation.EaseInOut,
+	}
+}
+
+// SplitViewOf creates a [widgets.SplitView] of panes along direction, with
+// dividers styled from the current theme.
+//
+// The returned view has:
+//   - 1-wide dividers in ColorScheme.OutlineVariant
+//   - a 16-wide drag area centered on each divider
+//
+// Example:
+//
+//	theme.SplitViewOf(ctx, widgets.AxisHorizontal,
+//	    widgets.SplitPane{Child: folders, Weight: 1, MinSize: 200, CollapseThreshold: 120},
+//	    widgets.SplitPane{Child: messages, Weight: 2, MinSize: 320},
+//	).WithStorageKey("mail")
+func SplitViewOf(ctx core.BuildContext, direction widgets.Axis, panes ...widgets.SplitPane) widgets.SplitView {
+	_, colors, _ := UseTheme(ctx)
+	return widgets.SplitView{
+		Direction:        direction,
+		Panes:            panes,
+		DividerThickness: 1,
+		DividerHitExtent: 16,
+		DividerColor:     colors.OutlineVariant,
+	}
+}
@@ -61,83 +61,104 @@ type CupertinoTextThemeData struct {
 }
 
 // DefaultCupertinoTextTheme creates a text theme with iOS-style defaults.
+// Titles and headlines use the display family of the loaded
+// [TypographyManifest] and the rest its body family.
 func DefaultCupertinoTextTheme(textColor graphics.Color) CupertinoTextThemeData {
+	display, body := typographyFamilies()
 	return CupertinoTextThemeData{
 		TextStyle: graphics.TextStyle{
-			Color:    textColor,
-			FontSize: 17,
+			Color:      textColor,
+			FontFamily: body,
+			FontSize:   17,
 		},
 		ActionTextStyle: graphics.TextStyle{
-			Color:    textColor,
-			FontSize: 17,
+			Color:      textColor,
+			FontFamily: body,
+			FontSize:   17,
 		},
 		NavTitleTextStyle: graphics.TextStyle{
 			Color:      textColor,
+			FontFamily: display,
 			FontSize:   17,
 			FontWeight: graphics.FontWeightSemibold,
 		},
 		NavLargeTitleTextStyle: graphics.TextStyle{
 			Color:      textColor,
+			FontFamily: display,
 			FontSize:   34,
 			FontWeight: graphics.FontWeightBold,
 		},
 		TabLabelTextStyle: graphics.TextStyle{
-			Color:    textColor,
-			FontSize: 10,
+			Color:      textColor,
+			FontFamily: body,
+			FontSize:   10,
 		},
 		PickerTextStyle: graphics.TextStyle{
-			Color:    textColor,
-			FontSize: 21,
+			Color:      textColor,
+			FontFamily: body,
+			FontSize:   21,
 		},
 		DateTimePickerTextStyle: graphics.TextStyle{
-			Color:    textColor,
-			FontSize: 21,
+			Color:      textColor,
+			FontFamily: body,
+			FontSize:   21,
 		},
 		LargeTitleTextStyle: graphics.TextStyle{
 			Color:      textColor,
+			FontFamily: display,
 			FontSize:   34,
 			FontWeight: graphics.FontWeightBold,
 		},
 		Title1TextStyle: graphics.TextStyle{
-			Color:    textColor,
-			FontSize: 28,
+			Color:      textColor,
+			FontFamily: display,
+			FontSize:   28,
 		},
 		Title2TextStyle: graphics.TextStyle{
-			Color:    textColor,
-			FontSize: 22,
+			Color:      textColor,
+			FontFamily: display,
+			FontSize:   22,
 		},
 		Title3TextStyle: graphics.TextStyle{
-			Color:    textColor,
-			FontSize: 20,
+			Color:      textColor,
+			FontFamily: display,
+			FontSize:   20,
 		},
 		HeadlineTextStyle: graphics.TextStyle{
 			Color:      textColor,
+			FontFamily: display,
 			FontSize:   17,
 			FontWeight: graphics.FontWeightSemibold,
 		},
 		SubheadlineTextStyle: graphics.TextStyle{
-			Color:    textColor,
-			FontSize: 15,
+			Color:      textColor,
+			FontFamily: body,
+			FontSize:   15,
 		},
 		BodyTextStyle: graphics.TextStyle{
-			Color:    textColor,
-			FontSize: 17,
+			Color:      textColor,
+			FontFamily: body,
+			FontSize:   17,
 		},
 		CalloutTextStyle: graphics.TextStyle{
-			Color:    textColor,
-			FontSize: 16,
+			Color:      textColor,
+			FontFamily: body,
+			FontSize:   16,
 		},
 		FootnoteTextStyle: graphics.TextStyle{
-			Color:    textColor,
-			FontSize: 13,
+			Color:      textColor,
+			FontFamily: body,
+			FontSize:   13,
 		},
 		Caption1TextStyle: graphics.TextStyle{
-			Color:    textColor,
-			FontSize: 12,
+			Color:      textColor,
+			FontFamily: body,
+			FontSize:   12,
 		},
 		Caption2TextStyle: graphics.TextStyle{
-			Color:    textColor,
-			FontSize: 11,
+			Color:      textColor,
+			FontFamily: body,
+			FontSize:   11,
 		},
 	}
 }
@@ -32,67 +32,85 @@ type TextTheme struct {
 }
 
 // DefaultTextTheme creates a TextTheme with default sizes and the given text color.
+// Display, headline, and title styles use the display family of the loaded
+// [TypographyManifest] and the rest its body family.
 func DefaultTextTheme(textColor graphics.Color) TextTheme {
+	display, body := typographyFamilies()
 	return TextTheme{
 		DisplayLarge: graphics.TextStyle{
-			Color:    textColor,
-			FontSize: 57,
+			Color:      textColor,
+			FontFamily: display,
+			FontSize:   57,
 		},
 		DisplayMedium: graphics.TextStyle{
-			Color:    textColor,
-			FontSize: 45,
+			Color:      textColor,
+			FontFamily: display,
+			FontSize:   45,
 		},
 		DisplaySmall: graphics.TextStyle{
-			Color:    textColor,
-			FontSize: 36,
+			Color:      textColor,
+			FontFamily: display,
+			FontSize:   36,
 		},
 		HeadlineLarge: graphics.TextStyle{
-			Color:    textColor,
-			FontSize: 32,
+			Color:      textColor,
+			FontFamily: display,
+			FontSize:   32,
 		},
 		HeadlineMedium: graphics.TextStyle{
-			Color:    textColor,
-			FontSize: 28,
+			Color:      textColor,
+			FontFamily: display,
+			FontSize:   28,
 		},
 		HeadlineSmall: graphics.TextStyle{
-			Color:    textColor,
-			FontSize: 24,
+			Color:      textColor,
+			FontFamily: display,
+			FontSize:   24,
 		},
 		TitleLarge: graphics.TextStyle{
-			Color:    textColor,
-			FontSize: 22,
+			Color:      textColor,
+			FontFamily: display,
+			FontSize:   22,
 		},
 		TitleMedium: graphics.TextStyle{
-			Color:    textColor,
-			FontSize: 16,
+			Color:      textColor,
+			FontFamily: display,
+			FontSize:   16,
 		},
 		TitleSmall: graphics.TextStyle{
-			Color:    textColor,
-			FontSize: 14,
+			Color:      textColor,
+			FontFamily: display,
+			FontSize:   14,
 		},
 		BodyLarge: graphics.TextStyle{
-			Color:    textColor,
-			FontSize: 16,
+			Color:      textColor,
+			FontFamily: body,
+			FontSize:   16,
 		},
 		BodyMedium: graphics.TextStyle{
-			Color:    textColor,
-			FontSize: 14,
+			Color:      textColor,
+			FontFamily: body,
+			FontSize:   14,
 		},
 		BodySmall: graphics.TextStyle{
-			Color:    textColor,
-			FontSize: 12,
+			Color:      textColor,
+			FontFamily: body,
+			FontSize:   12,
 		},
 		LabelLarge: graphics.TextStyle{
-			Color:    textColor,
-			FontSize: 14,
+			Color:      textColor,
+			FontFamily: body,
+			FontSize:   14,
 		},
 		LabelMedium: graphics.TextStyle{
-			Color:    textColor,
-			FontSize: 12,
+			Color:      textColor,
+			FontFamily: body,
+			FontSize:   12,
 		},
 		LabelSmall: graphics.TextStyle{
-			Color:    textColor,
-			FontSize: 11,
+			Color:      textColor,
+			FontFamily: body,
+			FontSize:   11,
 		},
 	}
 }
@@ -0,0 +1,102 @@
+package theme
+
+import (
+	"reflect"
+
+	"github.com/go-drift/drift/pkg/animation"
+	"github.com/go-drift/drift/pkg/graphics"
+)
+
+// LerpThemeData interpolates between two themes for animated transitions.
+// Colors and sizes, including those in text styles and component themes,
+// blend smoothly; other values such as Brightness and font families switch
+// from a to b halfway through. Returns a when t <= 0 and b when t >= 1, so
+// a finished transition yields the target theme itself.
+func LerpThemeData(a, b *ThemeData, t float64) *ThemeData {
+	return lerpTheme(a, b, t)
+}
+
+// LerpCupertinoThemeData interpolates between two Cupertino themes like
+// [LerpThemeData].
+func LerpCupertinoThemeData(a, b *CupertinoThemeData, t float64) *CupertinoThemeData {
+	return lerpTheme(a, b, t)
+}
+
+// LerpAppThemeData interpolates both the Material and Cupertino themes like
+// [LerpThemeData]. Platform switches halfway through.
+func LerpAppThemeData(a, b *AppThemeData, t float64) *AppThemeData {
+	if a == nil || b == nil || t <= 0 || t >= 1 {
+		return lerpTheme(a, b, t)
+	}
+	result := *b
+	if t < 0.5 {
+		result.Platform = a.Platform
+	}
+	result.Material = LerpThemeData(a.Material, b.Material, t)
+	result.Cupertino = LerpCupertinoThemeData(a.Cupertino, b.Cupertino, t)
+	return &result
+}
+
+func lerpTheme[T any](a, b *T, t float64) *T {
+	switch {
+	case a == nil || b == nil:
+		if t < 0.5 {
+			return a
+		}
+		return b
+	case t <= 0:
+		return a
+	case t >= 1:
+		return b
+	}
+	result := new(T)
+	lerpValue(reflect.ValueOf(result).Elem(), reflect.ValueOf(a).Elem(), reflect.ValueOf(b).Elem(), t)
+	return result
+}
+
+var colorType = reflect.TypeFor[graphics.Color]()
+
+// lerpValue sets dst between a and b. Theme data is plain structs of colors,
+// sizes, and nested styles, so it is walked field by field rather than
+// listing every color of every scheme and component theme here.
+func lerpValue(dst, a, b reflect.Value, t float64) {
+	switch {
+	case dst.Type() == colorType:
+		dst.Set(reflect.ValueOf(animation.LerpColor(graphics.Color(a.Uint()), graphics.Color(b.Uint()), t)))
+		return
+	case dst.Kind() == reflect.Float64:
+		dst.SetFloat(animation.LerpFloat64(a.Float(), b.Float(), t))
+		return
+	case dst.Kind() == reflect.Struct && exportedFields(dst.Type()):
+		for i := range dst.NumField() {
+			lerpValue(dst.Field(i), a.Field(i), b.Field(i), t)
+		}
+		return
+	case dst.Kind() == reflect.Pointer && !a.IsNil() && !b.IsNil() &&
+		dst.Type().Elem().Kind() == reflect.Struct && exportedFields(dst.Type().Elem()):
+		if a.Pointer() == b.Pointer() {
+			dst.Set(b)
+			return
+		}
+		p := reflect.New(dst.Type().Elem())
+		lerpValue(p.Elem(), a.Elem(), b.Elem(), t)
+		dst.Set(p)
+		return
+	}
+	if t < 0.5 {
+		dst.Set(a)
+	} else {
+		dst.Set(b)
+	}
+}
+
+// exportedFields reports whether every field of a struct type is exported,
+// and so can be set field by field.
+func exportedFields(typ reflect.Type) bool {
+	for i := range typ.NumField() {
+		if !typ.Field(i).IsExported() {
+			return false
+		}
+	}
+	return true
+}
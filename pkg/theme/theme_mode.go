@@ -0,0 +1,286 @@
+package theme
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/go-drift/drift/pkg/animation"
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/platform"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+// ThemeMode selects between an app's light and dark themes.
+type ThemeMode int
+
+const (
+	// ThemeModeSystem follows the system dark mode setting.
+	ThemeModeSystem ThemeMode = iota
+	// ThemeModeLight always uses the light theme.
+	ThemeModeLight
+	// ThemeModeDark always uses the dark theme.
+	ThemeModeDark
+)
+
+// String returns the mode name.
+func (m ThemeMode) String() string {
+	switch m {
+	case ThemeModeSystem:
+		return "system"
+	case ThemeModeLight:
+		return "light"
+	case ThemeModeDark:
+		return "dark"
+	default:
+		return fmt.Sprintf("ThemeMode(%d)", int(m))
+	}
+}
+
+// Resolve returns the theme brightness for the mode, given the system
+// appearance.
+func (m ThemeMode) Resolve(system platform.Brightness) Brightness {
+	switch m {
+	case ThemeModeLight:
+		return BrightnessLight
+	case ThemeModeDark:
+		return BrightnessDark
+	}
+	if system == platform.BrightnessDark {
+		return BrightnessDark
+	}
+	return BrightnessLight
+}
+
+// brightnessOf resolves mode against the system appearance from
+// [widgets.MediaQuery]. Only ThemeModeSystem depends on it, so fixed modes
+// do not rebuild when the system setting changes.
+func brightnessOf(ctx core.BuildContext, mode ThemeMode) Brightness {
+	if mode != ThemeModeSystem {
+		return mode.Resolve(platform.BrightnessLight)
+	}
+	return mode.Resolve(widgets.MediaQueryPlatformBrightnessOf(ctx))
+}
+
+// DefaultThemeAnimationDuration is the length of theme transitions when a
+// widget's Duration is zero.
+const DefaultThemeAnimationDuration = 200 * time.Millisecond
+
+// themeAnimationDuration returns d, DefaultThemeAnimationDuration for zero,
+// and zero (no animation) for negative values.
+func themeAnimationDuration(d time.Duration) time.Duration {
+	switch {
+	case d == 0:
+		return DefaultThemeAnimationDuration
+	case d < 0:
+		return 0
+	}
+	return d
+}
+
+// AdaptiveAppTheme provides the light or dark [AppThemeData] chosen by Mode,
+// and animates between them when the choice or the system setting changes,
+// so every themed widget fades to the new colors.
+//
+//	theme.AdaptiveAppTheme{
+//	    Platform:     theme.TargetPlatformMaterial,
+//	    Mode:         s.mode, // ThemeModeSystem follows dark mode
+//	    DynamicColor: true,   // Material You colors on Android 12+
+//	    Seed:         brand,  // and brand colors elsewhere
+//	    Child:        app,
+//	}
+//
+// Create custom Light and Dark themes once, not in Build: a new pointer is a
+// new theme and starts another transition.
+type AdaptiveAppTheme struct {
+	core.StatelessBase
+	// Platform selects Material or Cupertino styling for the default themes.
+	Platform TargetPlatform
+	// Light is the light theme. Nil uses NewAppThemeData(Platform,
+	// BrightnessLight) with the colors chosen by DynamicColor and Seed.
+	Light *AppThemeData
+	// Dark is the dark theme. Nil uses NewAppThemeData(Platform,
+	// BrightnessDark) with the colors chosen by DynamicColor and Seed.
+	Dark *AppThemeData
+	// DynamicColor colors the default themes from the system palette on
+	// Android 12 and later (Material You), following wallpaper changes.
+	// Elsewhere Seed is used.
+	DynamicColor bool
+	// Seed generates the default themes' colors with [ColorSchemeFromSeed]
+	// when non-zero.
+	Seed graphics.Color
+	// Mode selects the theme. The zero value follows the system.
+	Mode ThemeMode
+	// Duration is the length of the transition. Zero uses
+	// [DefaultThemeAnimationDuration]; negative switches immediately.
+	Duration time.Duration
+	// Curve eases the transition. Nil uses [animation.EaseInOut].
+	Curve func(float64) float64
+	// Child is the themed subtree.
+	Child core.Widget
+}
+
+// Build resolves the mode and returns an [AnimatedAppTheme].
+func (a AdaptiveAppTheme) Build(ctx core.BuildContext) core.Widget {
+	brightness := brightnessOf(ctx, a.Mode)
+	data := a.Light
+	if brightness == BrightnessDark {
+		data = a.Dark
+	}
+	if data == nil {
+		data = defaultAppTheme(a.Platform, brightness, themeColorsOf(ctx, a.DynamicColor, a.Seed))
+	}
+	return AnimatedAppTheme{Data: data, Duration: a.Duration, Curve: a.Curve, Child: a.Child}
+}
+
+// AdaptiveTheme is [AdaptiveAppTheme] for apps that provide a Material
+// [ThemeData] with [Theme].
+type AdaptiveTheme struct {
+	core.StatelessBase
+	// Light is the light theme. Nil uses [DefaultLightTheme] with the
+	// colors chosen by DynamicColor and Seed.
+	Light *ThemeData
+	// Dark is the dark theme. Nil uses [DefaultDarkTheme] with the colors
+	// chosen by DynamicColor and Seed.
+	Dark *ThemeData
+	// DynamicColor colors the default themes from the system palette on
+	// Android 12 and later (Material You), following wallpaper changes.
+	// Elsewhere Seed is used.
+	DynamicColor bool
+	// Seed generates the default themes' colors with [ColorSchemeFromSeed]
+	// when non-zero.
+	Seed graphics.Color
+	// Mode selects the theme. The zero value follows the system.
+	Mode ThemeMode
+	// Duration is the length of the transition. Zero uses
+	// [DefaultThemeAnimationDuration]; negative switches immediately.
+	Duration time.Duration
+	// Curve eases the transition. Nil uses [animation.EaseInOut].
+	Curve func(float64) float64
+	// Child is the themed subtree.
+	Child core.Widget
+}
+
+// Build resolves the mode and returns an [AnimatedTheme].
+func (a AdaptiveTheme) Build(ctx core.BuildContext) core.Widget {
+	brightness := brightnessOf(ctx, a.Mode)
+	data := a.Light
+	if brightness == BrightnessDark {
+		data = a.Dark
+	}
+	if data == nil {
+		data = defaultAppTheme(TargetPlatformMaterial, brightness, themeColorsOf(ctx, a.DynamicColor, a.Seed)).Material
+	}
+	return AnimatedTheme{Data: data, Duration: a.Duration, Curve: a.Curve, Child: a.Child}
+}
+
+// AnimatedAppTheme is an [AppTheme] that animates changes to Data, blending
+// colors with [LerpAppThemeData] instead of switching at once.
+type AnimatedAppTheme struct {
+	core.StatefulBase
+	// Data is the target theme. A different pointer starts a transition.
+	Data *AppThemeData
+	// Duration is the length of the transition. Zero uses
+	// [DefaultThemeAnimationDuration]; negative switches immediately.
+	Duration time.Duration
+	// Curve eases the transition. Nil uses [animation.EaseInOut].
+	Curve func(float64) float64
+	// Child is the themed subtree.
+	Child core.Widget
+}
+
+func (a AnimatedAppTheme) CreateState() core.State {
+	return &animatedThemeState[*AppThemeData]{
+		lerp: LerpAppThemeData,
+		widget: func(w core.Widget) animatedThemeConfig[*AppThemeData] {
+			a := w.(AnimatedAppTheme)
+			return animatedThemeConfig[*AppThemeData]{a.Data, a.Duration, a.Curve}
+		},
+		build: func(w core.Widget, data *AppThemeData) core.Widget {
+			return AppTheme{Data: data, Child: w.(AnimatedAppTheme).Child}
+		},
+	}
+}
+
+// AnimatedTheme is a [Theme] that animates changes to Data, blending colors
+// with [LerpThemeData] instead of switching at once.
+type AnimatedTheme struct {
+	core.StatefulBase
+	// Data is the target theme. A different pointer starts a transition.
+	Data *ThemeData
+	// Duration is the length of the transition. Zero uses
+	// [DefaultThemeAnimationDuration]; negative switches immediately.
+	Duration time.Duration
+	// Curve eases the transition. Nil uses [animation.EaseInOut].
+	Curve func(float64) float64
+	// Child is the themed subtree.
+	Child core.Widget
+}
+
+func (a AnimatedTheme) CreateState() core.State {
+	return &animatedThemeState[*ThemeData]{
+		lerp: LerpThemeData,
+		widget: func(w core.Widget) animatedThemeConfig[*ThemeData] {
+			a := w.(AnimatedTheme)
+			return animatedThemeConfig[*ThemeData]{a.Data, a.Duration, a.Curve}
+		},
+		build: func(w core.Widget, data *ThemeData) core.Widget {
+			return Theme{Data: data, Child: w.(AnimatedTheme).Child}
+		},
+	}
+}
+
+// animatedThemeConfig holds the fields shared by the animated theme widgets.
+type animatedThemeConfig[T comparable] struct {
+	data     T
+	duration time.Duration
+	curve    func(float64) float64
+}
+
+// animatedThemeState animates AnimatedTheme and AnimatedAppTheme. A change
+// of target mid-transition starts from the colors currently shown.
+type animatedThemeState[T comparable] struct {
+	core.StateBase
+	lerp   func(a, b T, t float64) T
+	widget func(core.Widget) animatedThemeConfig[T]
+	build  func(core.Widget, T) core.Widget
+
+	controller *animation.AnimationController
+	from       T
+	to         T
+	current    T
+}
+
+func (s *animatedThemeState[T]) InitState() {
+	cfg := s.widget(s.Element().Widget())
+	s.controller = animation.NewAnimationController(0)
+	s.controller.Value = 1
+	core.UseDisposable(s, s.controller)
+	core.UseListenable(s, s.controller)
+	s.configure(cfg)
+	s.to, s.current = cfg.data, cfg.data
+}
+
+func (s *animatedThemeState[T]) configure(cfg animatedThemeConfig[T]) {
+	s.controller.Duration = themeAnimationDuration(cfg.duration)
+	s.controller.Curve = cfg.curve
+	if s.controller.Curve == nil {
+		s.controller.Curve = animation.EaseInOut
+	}
+}
+
+func (s *animatedThemeState[T]) DidUpdateWidget(core.StatefulWidget) {
+	cfg := s.widget(s.Element().Widget())
+	s.configure(cfg)
+	if cfg.data == s.to {
+		return
+	}
+	s.from, s.to = s.current, cfg.data
+	s.controller.Reset()
+	s.controller.Forward()
+}
+
+func (s *animatedThemeState[T]) Build(ctx core.BuildContext) core.Widget {
+	s.current = s.lerp(s.from, s.to, s.controller.Value)
+	return s.build(s.Element().Widget(), s.current)
+}
@@ -0,0 +1,196 @@
+package theme_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/go-drift/drift/pkg/animation"
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/platform"
+	drifttest "github.com/go-drift/drift/pkg/testing"
+	"github.com/go-drift/drift/pkg/theme"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+func TestThemeMode_Resolve(t *testing.T) {
+	tests := []struct {
+		mode   theme.ThemeMode
+		system platform.Brightness
+		want   theme.Brightness
+	}{
+		{theme.ThemeModeSystem, platform.BrightnessLight, theme.BrightnessLight},
+		{theme.ThemeModeSystem, platform.BrightnessDark, theme.BrightnessDark},
+		{theme.ThemeModeLight, platform.BrightnessDark, theme.BrightnessLight},
+		{theme.ThemeModeDark, platform.BrightnessLight, theme.BrightnessDark},
+	}
+	for _, tt := range tests {
+		if got := tt.mode.Resolve(tt.system); got != tt.want {
+			t.Errorf("%s.Resolve(%s) = %v, want %v", tt.mode, tt.system, got, tt.want)
+		}
+	}
+}
+
+func TestLerpThemeData(t *testing.T) {
+	light, dark := theme.DefaultLightTheme(), theme.DefaultDarkTheme()
+	button := &theme.ButtonThemeData{BackgroundColor: graphics.ColorWhite, BorderRadius: 10}
+	light.ButtonTheme = button
+	dark.ButtonTheme = &theme.ButtonThemeData{BackgroundColor: graphics.ColorBlack, BorderRadius: 20}
+
+	if theme.LerpThemeData(light, dark, 0) != light || theme.LerpThemeData(light, dark, 1) != dark {
+		t.Error("expected the end points to return the themes themselves")
+	}
+
+	mid := theme.LerpThemeData(light, dark, 0.5)
+	want := animation.LerpColor(light.ColorScheme.Surface, dark.ColorScheme.Surface, 0.5)
+	if mid.ColorScheme.Surface != want {
+		t.Errorf("expected surface %v, got %v", want, mid.ColorScheme.Surface)
+	}
+	if got := mid.TextTheme.BodyLarge.Color; got != animation.LerpColor(light.TextTheme.BodyLarge.Color, dark.TextTheme.BodyLarge.Color, 0.5) {
+		t.Errorf("expected text colors to blend, got %v", got)
+	}
+	if mid.ButtonTheme.BorderRadius != 15 || mid.ButtonTheme == button {
+		t.Errorf("expected a blended copy of the button theme, got %+v", mid.ButtonTheme)
+	}
+	if button.BorderRadius != 10 {
+		t.Error("expected the original theme to be unchanged")
+	}
+	if theme.LerpThemeData(light, dark, 0.4).Brightness != theme.BrightnessLight || mid.Brightness != theme.BrightnessDark {
+		t.Error("expected brightness to switch halfway")
+	}
+}
+
+// colorsProbe records the color scheme on every build.
+type colorsProbe struct {
+	core.StatelessBase
+	colors *theme.ColorScheme
+}
+
+func (p colorsProbe) Build(ctx core.BuildContext) core.Widget {
+	*p.colors = theme.ColorsOf(ctx)
+	return widgets.SizedBox{}
+}
+
+func TestAdaptiveAppTheme_FollowsSystemWithTransition(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	t.Cleanup(platform.ResetForTest)
+
+	light := theme.NewAppThemeData(theme.TargetPlatformMaterial, theme.BrightnessLight)
+	dark := theme.NewAppThemeData(theme.TargetPlatformMaterial, theme.BrightnessDark)
+	var colors theme.ColorScheme
+	tester.PumpWidget(widgets.MediaQueryProvider{
+		Child: theme.AdaptiveAppTheme{
+			Light:    light,
+			Dark:     dark,
+			Duration: 100 * time.Millisecond,
+			Curve:    animation.LinearCurve,
+			Child:    colorsProbe{colors: &colors},
+		},
+	})
+	if colors.Surface != light.Material.ColorScheme.Surface {
+		t.Fatalf("expected the light theme, got surface %v", colors.Surface)
+	}
+
+	platform.Appearance.SetSettingsForTest(platform.AppearanceSettings{TextScaleFactor: 1, Brightness: platform.BrightnessDark})
+	tester.Pump()
+	tester.Clock().Advance(50 * time.Millisecond)
+	tester.Pump()
+	if want := animation.LerpColor(light.Material.ColorScheme.Surface, dark.Material.ColorScheme.Surface, 0.5); colors.Surface != want {
+		t.Errorf("expected surface %v halfway through, got %v", want, colors.Surface)
+	}
+
+	if err := tester.PumpAndSettle(time.Second); err != nil {
+		t.Fatal(err)
+	}
+	if colors.Surface != dark.Material.ColorScheme.Surface {
+		t.Errorf("expected the dark theme, got surface %v", colors.Surface)
+	}
+}
+
+func TestAdaptiveTheme_FixedModeIgnoresSystem(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	t.Cleanup(platform.ResetForTest)
+	platform.Appearance.SetSettingsForTest(platform.AppearanceSettings{TextScaleFactor: 1, Brightness: platform.BrightnessDark})
+	// Without the tester's AppTheme, ThemeOf reads the Theme widget.
+	tester.SetTheme(nil)
+
+	var colors theme.ColorScheme
+	tester.PumpWidget(widgets.MediaQueryProvider{
+		Child: theme.AdaptiveTheme{
+			Mode:     theme.ThemeModeLight,
+			Duration: -1,
+			Child:    colorsProbe{colors: &colors},
+		},
+	})
+	if colors.Brightness != theme.BrightnessLight {
+		t.Errorf("expected the light theme, got %v", colors.Brightness)
+	}
+}
+
+func TestColorSchemeFromDynamicColors(t *testing.T) {
+	if _, ok := theme.ColorSchemeFromDynamicColors(platform.DynamicColors{}, theme.BrightnessLight); ok {
+		t.Error("expected no scheme without dynamic colors")
+	}
+
+	colors := platform.DynamicColors{
+		Accent1:  0xFF2E7D32, // green
+		Accent2:  0xFF6A7A68,
+		Accent3:  0xFF3B6F7D, // teal
+		Neutral1: 0xFF77786F,
+		Neutral2: 0xFF727970,
+	}
+	light, ok := theme.ColorSchemeFromDynamicColors(colors, theme.BrightnessLight)
+	if !ok {
+		t.Fatal("expected a scheme")
+	}
+	dark, _ := theme.ColorSchemeFromDynamicColors(colors, theme.BrightnessDark)
+	if light.Brightness != theme.BrightnessLight || dark.Brightness != theme.BrightnessDark {
+		t.Errorf("brightness = %v and %v", light.Brightness, dark.Brightness)
+	}
+
+	// Each role keeps the hue of its system palette.
+	hueNear := func(name string, got, palette graphics.Color) {
+		t.Helper()
+		h1, _ := graphics.HueChroma(got)
+		h2, _ := graphics.HueChroma(palette)
+		if d := math.Abs(h1 - h2); min(d, 360-d) > 5 {
+			t.Errorf("%s hue %.1f, want near %.1f", name, h1, h2)
+		}
+	}
+	hueNear("primary", light.Primary, colors.Accent1)
+	hueNear("dark primary", dark.Primary, colors.Accent1)
+	hueNear("tertiary", light.Tertiary, colors.Accent3)
+	if graphics.ContrastRatio(light.Primary, light.OnPrimary) < 4.5 {
+		t.Error("expected readable text on primary")
+	}
+}
+
+func TestAdaptiveTheme_DynamicColorFallsBackToSeed(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	t.Cleanup(platform.ResetForTest)
+	tester.SetTheme(nil)
+
+	seed := graphics.Color(0xFFB3261E)
+	var colors theme.ColorScheme
+	tester.PumpWidget(widgets.MediaQueryProvider{
+		Child: theme.AdaptiveTheme{
+			DynamicColor: true,
+			Seed:         seed,
+			Duration:     -1,
+			Child:        colorsProbe{colors: &colors},
+		},
+	})
+	fromSeed, _ := theme.ColorSchemeFromSeed(theme.ColorSchemeSeedOptions{Seed: seed})
+	if colors.Primary != fromSeed.Primary {
+		t.Errorf("expected the seed's primary %v without dynamic colors, got %v", fromSeed.Primary, colors.Primary)
+	}
+
+	dynamic := platform.DynamicColors{Accent1: 0xFF2E7D32, Accent2: 0xFF6A7A68, Accent3: 0xFF3B6F7D, Neutral1: 0xFF77786F, Neutral2: 0xFF727970}
+	platform.Appearance.SetSettingsForTest(platform.AppearanceSettings{TextScaleFactor: 1, DynamicColors: dynamic})
+	tester.Pump()
+	want, _ := theme.ColorSchemeFromDynamicColors(dynamic, theme.BrightnessLight)
+	if colors.Primary != want.Primary {
+		t.Errorf("expected the system primary %v, got %v", want.Primary, colors.Primary)
+	}
+}
@@ -379,3 +379,34 @@ func TestDefaultBottomSheetTheme_UsesColorScheme(t *testing.T) {
 		t.Error("HandleColor should be OnSurfaceVariant")
 	}
 }
+
+// --- LoadTypographyManifest ---
+
+func TestLoadTypographyManifest_SetsDefaultFamilies(t *testing.T) {
+	LoadTypographyManifest(TypographyManifest{DisplayFamily: "Fraunces", BodyFamily: "Inter"})
+	t.Cleanup(func() { LoadTypographyManifest(TypographyManifest{}) })
+
+	text := DefaultTextTheme(graphics.ColorBlack)
+	if got := text.HeadlineLarge.FontFamily; got != "Fraunces" {
+		t.Errorf("HeadlineLarge.FontFamily = %q, want Fraunces", got)
+	}
+	if got := text.BodyMedium.FontFamily; got != "Inter" {
+		t.Errorf("BodyMedium.FontFamily = %q, want Inter", got)
+	}
+	if got := text.LabelSmall.FontFamily; got != "Inter" {
+		t.Errorf("LabelSmall.FontFamily = %q, want Inter", got)
+	}
+
+	cupertino := DefaultCupertinoTextTheme(graphics.ColorBlack)
+	if got := cupertino.NavLargeTitleTextStyle.FontFamily; got != "Fraunces" {
+		t.Errorf("NavLargeTitleTextStyle.FontFamily = %q, want Fraunces", got)
+	}
+	if got := cupertino.BodyTextStyle.FontFamily; got != "Inter" {
+		t.Errorf("BodyTextStyle.FontFamily = %q, want Inter", got)
+	}
+
+	LoadTypographyManifest(TypographyManifest{})
+	if got := DefaultTextTheme(graphics.ColorBlack).TitleLarge.FontFamily; got != "" {
+		t.Errorf("after reset TitleLarge.FontFamily = %q, want system font", got)
+	}
+}
@@ -0,0 +1,99 @@
+package theme
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/go-drift/drift/pkg/errors"
+	"github.com/go-drift/drift/pkg/graphics"
+)
+
+// BundledFont is one font file packaged with the app.
+type BundledFont struct {
+	// Family is the name text styles use to select the font.
+	Family string
+	// Weight is the weight the file provides. Zero means normal.
+	Weight graphics.FontWeight
+	// Style is the style the file provides. Zero means normal.
+	Style graphics.FontStyle
+	// Data is the TrueType or OpenType file content.
+	Data []byte
+}
+
+// TypographyManifest lists the fonts bundled with the app and the families
+// the default text themes use.
+//
+// The drift CLI generates a manifest from the fonts and typography sections
+// of drift.yaml and loads it before main runs. Apps built without the CLI
+// can call [LoadTypographyManifest] themselves.
+type TypographyManifest struct {
+	Fonts []BundledFont
+	// DisplayFamily is the family of the display, headline, and title styles.
+	// Empty means the system font.
+	DisplayFamily string
+	// BodyFamily is the family of the body and label styles. Empty means the
+	// system font.
+	BodyFamily string
+	// FallbackFamilies are tried, in order, for characters the styles'
+	// families lack. See [graphics.FontManager.SetFallbacks].
+	FallbackFamilies []string
+}
+
+var (
+	typographyMu sync.RWMutex
+	typography   TypographyManifest
+)
+
+// LoadTypographyManifest registers the manifest's fonts and fallback chain
+// with [graphics.DefaultFontManager] and makes its families the defaults of
+// [DefaultTextTheme] and [DefaultCupertinoTextTheme]. A font that fails to
+// register is reported through the errors package and the rest still load.
+//
+// Themes built before the manifest loads keep the system font, so with the
+// CLI build themes in functions rather than package-level variables of
+// package main. Loading a second manifest replaces the default families.
+func LoadTypographyManifest(manifest TypographyManifest) {
+	manager, managerErr := graphics.DefaultFontManagerErr()
+	for _, font := range manifest.Fonts {
+		err := managerErr
+		if err == nil {
+			err = manager.RegisterFontStyle(font.Family, font.Data, font.Weight, font.Style)
+		}
+		if err != nil {
+			errors.Report(&errors.DriftError{
+				Op:   "theme.LoadTypographyManifest",
+				Kind: errors.KindInit,
+				Err:  fmt.Errorf("font %s %d %s: %w", font.Family, font.Weight, font.Style, err),
+			})
+		}
+	}
+
+	if len(manifest.FallbackFamilies) > 0 {
+		err := managerErr
+		if err == nil {
+			err = manager.SetFallbacks(manifest.FallbackFamilies...)
+		}
+		if err != nil {
+			errors.Report(&errors.DriftError{
+				Op:   "theme.LoadTypographyManifest",
+				Kind: errors.KindInit,
+				Err:  fmt.Errorf("font fallbacks: %w", err),
+			})
+		}
+	}
+
+	typographyMu.Lock()
+	defer typographyMu.Unlock()
+	typography = TypographyManifest{
+		DisplayFamily: manifest.DisplayFamily,
+		BodyFamily:    manifest.BodyFamily,
+	}
+}
+
+// typographyFamilies returns the display and body families of the loaded
+// manifest.
+func typographyFamilies() (display, body string) {
+	typographyMu.RLock()
+	defer typographyMu.RUnlock()
+	return typography.DisplayFamily, typography.BodyFamily
+}
@@ -0,0 +1,184 @@
+package widgets
+
+import (
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/gestures"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/layout"
+	"github.com/go-drift/drift/pkg/platform"
+	"github.com/go-drift/drift/pkg/semantics"
+)
+
+// AirPlayButton displays the native AirPlay route picker (AVRoutePickerView)
+// on iOS. Tapping it shows the system list of AirPlay receivers; choosing
+// one casts the playing [platform.VideoPlayerController] to it, and
+// [platform.VideoPlayerController.OnCastingChanged] reports the switch.
+//
+// Android has no AirPlay, so the button is empty there; use
+// [platform.CastService] to list Chromecast receivers instead. Show the
+// button only when receivers are available:
+//
+//	unsub := platform.Cast.Discovery().Listen(func(d platform.CastDiscovery) {
+//	    s.canCast.Set(d.RoutesAvailable)
+//	})
+type AirPlayButton struct {
+	core.StatefulBase
+
+	// TintColor is the icon color while not casting. Zero uses the system
+	// default.
+	TintColor graphics.Color
+
+	// ActiveTintColor is the icon color while casting. Zero uses the
+	// system default.
+	ActiveTintColor graphics.Color
+
+	// Size is the width and height of the button. Zero uses 44, the
+	// minimum recommended touch target.
+	Size float64
+}
+
+func (a AirPlayButton) CreateState() core.State {
+	return &airPlayButtonState{}
+}
+
+type airPlayButtonState struct {
+	core.StateBase
+	platformView *platform.AirPlayButtonView
+}
+
+func (s *airPlayButtonState) Dispose() {
+	if s.platformView != nil {
+		platform.GetPlatformViewRegistry().Dispose(s.platformView.ViewID())
+		s.platformView = nil
+	}
+	s.StateBase.Dispose()
+}
+
+func (s *airPlayButtonState) DidUpdateWidget(oldWidget core.StatefulWidget) {
+	if s.platformView == nil {
+		return
+	}
+
+	w := s.Element().Widget().(AirPlayButton)
+	old := oldWidget.(AirPlayButton)
+
+	if w.TintColor != old.TintColor || w.ActiveTintColor != old.ActiveTintColor {
+		s.platformView.UpdateConfig(platform.AirPlayButtonViewConfig{
+			TintColor:       uint32(w.TintColor),
+			ActiveTintColor: uint32(w.ActiveTintColor),
+		})
+	}
+}
+
+func (s *airPlayButtonState) Build(ctx core.BuildContext) core.Widget {
+	w := s.Element().Widget().(AirPlayButton)
+	size := w.Size
+	if size == 0 {
+		size = 44
+	}
+	return airPlayButtonRender{state: s, size: size}
+}
+
+func (s *airPlayButtonState) ensurePlatformView() {
+	if s.platformView != nil {
+		return
+	}
+
+	w := s.Element().Widget().(AirPlayButton)
+
+	params := map[string]any{}
+	if w.TintColor != 0 {
+		params["tintColor"] = uint32(w.TintColor)
+	}
+	if w.ActiveTintColor != 0 {
+		params["activeTintColor"] = uint32(w.ActiveTintColor)
+	}
+
+	view, err := platform.GetPlatformViewRegistry().Create("airplay_button", params)
+	if err != nil {
+		return
+	}
+
+	buttonView, ok := view.(*platform.AirPlayButtonView)
+	if !ok {
+		return
+	}
+
+	s.platformView = buttonView
+}
+
+type airPlayButtonRender struct {
+	core.RenderObjectBase
+	state *airPlayButtonState
+	size  float64
+}
+
+func (a airPlayButtonRender) CreateRenderObject(ctx core.BuildContext) layout.RenderObject {
+	r := &renderAirPlayButton{state: a.state, size: a.size}
+	r.SetSelf(r)
+	return r
+}
+
+func (a airPlayButtonRender) UpdateRenderObject(ctx core.BuildContext, renderObject layout.RenderObject) {
+	if r, ok := renderObject.(*renderAirPlayButton); ok {
+		r.state = a.state
+		if r.size != a.size {
+			r.size = a.size
+			r.MarkNeedsLayout()
+		}
+		r.MarkNeedsPaint()
+	}
+}
+
+var _ layout.PlatformViewOwner = (*renderAirPlayButton)(nil)
+
+type renderAirPlayButton struct {
+	layout.RenderBoxBase
+	state *airPlayButtonState
+	size  float64
+}
+
+func (r *renderAirPlayButton) PerformLayout() {
+	constraints := r.Constraints()
+	width := min(max(r.size, constraints.MinWidth), constraints.MaxWidth)
+	height := min(max(r.size, constraints.MinHeight), constraints.MaxHeight)
+	r.SetSize(graphics.Size{Width: width, Height: height})
+}
+
+func (r *renderAirPlayButton) Paint(ctx *layout.PaintContext) {
+	// Ensure platform view exists and record its embedding
+	r.state.ensurePlatformView()
+	if r.state.platformView != nil {
+		ctx.EmbedPlatformView(r.state.platformView.ViewID(), r.Size())
+	}
+}
+
+func (r *renderAirPlayButton) HitTest(position graphics.Offset, result *layout.HitTestResult) bool {
+	if !layout.WithinBounds(position, r.Size()) {
+		return false
+	}
+	result.Add(r)
+	return true
+}
+
+// PlatformViewID implements PlatformViewOwner.
+func (r *renderAirPlayButton) PlatformViewID() int64 {
+	if r.state != nil && r.state.platformView != nil {
+		if id := r.state.platformView.ViewID(); id != 0 {
+			return id
+		}
+	}
+	return -1
+}
+
+func (r *renderAirPlayButton) HandlePointer(event gestures.PointerEvent) {
+	// Taps are handled by the native picker
+}
+
+// DescribeSemanticsConfiguration implements SemanticsDescriber for accessibility.
+func (r *renderAirPlayButton) DescribeSemanticsConfiguration(config *semantics.SemanticsConfiguration) bool {
+	config.IsSemanticBoundary = true
+	config.Properties.Role = semantics.SemanticsRoleButton
+	config.Properties.Label = "AirPlay"
+	return true
+}
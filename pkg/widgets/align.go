@@ -21,6 +21,14 @@ import (
 //	    Child:     Text{Content: "Bottom right"},
 //	}
 //
+// To align to the start or end of the reading direction, set Directional
+// instead; it overrides Alignment:
+//
+//	Align{
+//	    Directional: &layout.AlignmentCenterEnd,
+//	    Child:       chevron,
+//	}
+//
 // See also:
 //   - [Center] for centering (equivalent to Align with AlignmentCenter)
 //   - [Container] for combined alignment, padding, and decoration
@@ -28,6 +36,9 @@ type Align struct {
 	core.RenderObjectBase
 	Child     core.Widget
 	Alignment layout.Alignment
+	// Directional, when set, is resolved against the ambient
+	// [Directionality] and used instead of Alignment.
+	Directional *layout.AlignmentDirectional
 }
 
 func (a Align) ChildWidget() core.Widget {
@@ -35,18 +46,25 @@ func (a Align) ChildWidget() core.Widget {
 }
 
 func (a Align) CreateRenderObject(ctx core.BuildContext) layout.RenderObject {
-	r := &renderAlign{alignment: a.Alignment}
+	r := &renderAlign{alignment: a.resolve(ctx)}
 	r.SetSelf(r)
 	return r
 }
 
 func (a Align) UpdateRenderObject(ctx core.BuildContext, renderObject layout.RenderObject) {
 	if r, ok := renderObject.(*renderAlign); ok {
-		r.alignment = a.Alignment
+		r.alignment = a.resolve(ctx)
 		r.MarkNeedsLayout()
 	}
 }
 
+func (a Align) resolve(ctx core.BuildContext) layout.Alignment {
+	if a.Directional == nil {
+		return a.Alignment
+	}
+	return a.Directional.Resolve(DirectionalityOf(ctx))
+}
+
 type renderAlign struct {
 	layout.RenderBoxBase
 	child     layout.RenderBox
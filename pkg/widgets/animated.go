@@ -275,3 +275,326 @@ func (s *animatedOpacityState) Build(ctx core.BuildContext) core.Widget {
 		Child:   w.Child,
 	}
 }
+
+// AnimatedPadding animates changes to its padding over a duration.
+//
+// Example:
+//
+//	widgets.AnimatedPadding{
+//	    Duration: 200 * time.Millisecond,
+//	    Curve:    animation.EaseOut,
+//	    Padding:  layout.EdgeInsetsAll(s.expanded ? 24 : 8),
+//	    Child:    child,
+//	}
+type AnimatedPadding struct {
+	core.StatefulBase
+
+	// Duration is the length of the animation.
+	Duration time.Duration
+	// Curve transforms the animation progress. If nil, uses linear interpolation.
+	Curve func(float64) float64
+	// OnEnd is called when the animation completes.
+	OnEnd func()
+
+	// Padding is the target padding.
+	Padding layout.EdgeInsets
+	// Child is the padded widget.
+	Child core.Widget
+}
+
+func (a AnimatedPadding) CreateState() core.State {
+	return &animatedPaddingState{}
+}
+
+type animatedPaddingState struct {
+	core.StateBase
+	controller     *animation.AnimationController
+	paddingTween   *animation.Tween[layout.EdgeInsets]
+	currentPadding layout.EdgeInsets
+}
+
+func (s *animatedPaddingState) InitState() {
+	w := s.Element().Widget().(AnimatedPadding)
+	s.controller = animation.NewAnimationController(w.Duration)
+	if w.Curve != nil {
+		s.controller.Curve = w.Curve
+	}
+	core.UseDisposable(s, s.controller)
+	core.UseListenable(s, s.controller)
+
+	s.controller.AddStatusListener(func(status animation.AnimationStatus) {
+		if status == animation.AnimationCompleted {
+			w := s.Element().Widget().(AnimatedPadding)
+			if w.OnEnd != nil {
+				w.OnEnd()
+			}
+		}
+	})
+
+	s.currentPadding = w.Padding
+}
+
+func (s *animatedPaddingState) DidUpdateWidget(oldWidget core.StatefulWidget) {
+	old := oldWidget.(AnimatedPadding)
+	w := s.Element().Widget().(AnimatedPadding)
+
+	s.controller.Duration = w.Duration
+	if w.Curve != nil {
+		s.controller.Curve = w.Curve
+	} else {
+		s.controller.Curve = animation.LinearCurve
+	}
+
+	if old.Padding != w.Padding {
+		s.paddingTween = animation.TweenEdgeInsets(s.currentPadding, w.Padding)
+		s.controller.Reset()
+		s.controller.Forward()
+	}
+}
+
+func (s *animatedPaddingState) Build(ctx core.BuildContext) core.Widget {
+	w := s.Element().Widget().(AnimatedPadding)
+
+	if s.paddingTween != nil {
+		s.currentPadding = s.paddingTween.Evaluate(s.controller.Value)
+	} else {
+		s.currentPadding = w.Padding
+	}
+
+	return Padding{
+		Padding: s.currentPadding,
+		Child:   w.Child,
+	}
+}
+
+// AnimatedAlign animates changes to its child's alignment over a duration.
+//
+// Example:
+//
+//	widgets.AnimatedAlign{
+//	    Duration:  300 * time.Millisecond,
+//	    Curve:     animation.EaseInOut,
+//	    Alignment: s.on ? layout.AlignmentCenterRight : layout.AlignmentCenterLeft,
+//	    Child:     knob,
+//	}
+type AnimatedAlign struct {
+	core.StatefulBase
+
+	// Duration is the length of the animation.
+	Duration time.Duration
+	// Curve transforms the animation progress. If nil, uses linear interpolation.
+	Curve func(float64) float64
+	// OnEnd is called when the animation completes.
+	OnEnd func()
+
+	// Alignment is the target alignment of the child.
+	Alignment layout.Alignment
+	// Child is the aligned widget.
+	Child core.Widget
+}
+
+func (a AnimatedAlign) CreateState() core.State {
+	return &animatedAlignState{}
+}
+
+type animatedAlignState struct {
+	core.StateBase
+	controller       *animation.AnimationController
+	alignmentTween   *animation.Tween[layout.Alignment]
+	currentAlignment layout.Alignment
+}
+
+func (s *animatedAlignState) InitState() {
+	w := s.Element().Widget().(AnimatedAlign)
+	s.controller = animation.NewAnimationController(w.Duration)
+	if w.Curve != nil {
+		s.controller.Curve = w.Curve
+	}
+	core.UseDisposable(s, s.controller)
+	core.UseListenable(s, s.controller)
+
+	s.controller.AddStatusListener(func(status animation.AnimationStatus) {
+		if status == animation.AnimationCompleted {
+			w := s.Element().Widget().(AnimatedAlign)
+			if w.OnEnd != nil {
+				w.OnEnd()
+			}
+		}
+	})
+
+	s.currentAlignment = w.Alignment
+}
+
+func (s *animatedAlignState) DidUpdateWidget(oldWidget core.StatefulWidget) {
+	old := oldWidget.(AnimatedAlign)
+	w := s.Element().Widget().(AnimatedAlign)
+
+	s.controller.Duration = w.Duration
+	if w.Curve != nil {
+		s.controller.Curve = w.Curve
+	} else {
+		s.controller.Curve = animation.LinearCurve
+	}
+
+	if old.Alignment != w.Alignment {
+		s.alignmentTween = animation.TweenAlignment(s.currentAlignment, w.Alignment)
+		s.controller.Reset()
+		s.controller.Forward()
+	}
+}
+
+func (s *animatedAlignState) Build(ctx core.BuildContext) core.Widget {
+	w := s.Element().Widget().(AnimatedAlign)
+
+	if s.alignmentTween != nil {
+		s.currentAlignment = s.alignmentTween.Evaluate(s.controller.Value)
+	} else {
+		s.currentAlignment = w.Alignment
+	}
+
+	return Align{
+		Alignment: s.currentAlignment,
+		Child:     w.Child,
+	}
+}
+
+// AnimatedPositioned is a [Positioned] child of a [Stack] that animates
+// changes to its position and size over a duration.
+//
+// Each edge and dimension is optional, as with Positioned: nil leaves it
+// unset. A value animates only when it is set both before and after a
+// change; setting or clearing it applies immediately.
+//
+// Example:
+//
+//	top := 16.0
+//	if s.lowered {
+//	    top = 200
+//	}
+//	widgets.Stack{Children: []core.Widget{
+//	    widgets.AnimatedPositioned{
+//	        Duration: 250 * time.Millisecond,
+//	        Curve:    animation.EaseOut,
+//	        Top:      &top,
+//	        Left:     &left,
+//	        Child:    card,
+//	    },
+//	}}
+type AnimatedPositioned struct {
+	core.StatefulBase
+
+	// Duration is the length of the animation.
+	Duration time.Duration
+	// Curve transforms the animation progress. If nil, uses linear interpolation.
+	Curve func(float64) float64
+	// OnEnd is called when the animation completes.
+	OnEnd func()
+
+	// Left, Top, Right, and Bottom are distances from the Stack's edges.
+	Left   *float64
+	Top    *float64
+	Right  *float64
+	Bottom *float64
+	// Width and Height override the child's size.
+	Width  *float64
+	Height *float64
+
+	// Child is the positioned widget.
+	Child core.Widget
+}
+
+func (a AnimatedPositioned) CreateState() core.State {
+	return &animatedPositionedState{}
+}
+
+// positionedValues holds the current values of an AnimatedPositioned's
+// optional edges and dimensions, in field order.
+type positionedValues [6]*float64
+
+func (a AnimatedPositioned) values() positionedValues {
+	return positionedValues{a.Left, a.Top, a.Right, a.Bottom, a.Width, a.Height}
+}
+
+type animatedPositionedState struct {
+	core.StateBase
+	controller *animation.AnimationController
+	begin      [6]float64 // start values for fields set before and after a change
+	current    positionedValues
+}
+
+func (s *animatedPositionedState) InitState() {
+	w := s.Element().Widget().(AnimatedPositioned)
+	s.controller = animation.NewAnimationController(w.Duration)
+	if w.Curve != nil {
+		s.controller.Curve = w.Curve
+	}
+	core.UseDisposable(s, s.controller)
+	core.UseListenable(s, s.controller)
+
+	s.controller.AddStatusListener(func(status animation.AnimationStatus) {
+		if status == animation.AnimationCompleted {
+			w := s.Element().Widget().(AnimatedPositioned)
+			if w.OnEnd != nil {
+				w.OnEnd()
+			}
+		}
+	})
+
+	s.current = w.values()
+}
+
+func (s *animatedPositionedState) DidUpdateWidget(oldWidget core.StatefulWidget) {
+	old := oldWidget.(AnimatedPositioned)
+	w := s.Element().Widget().(AnimatedPositioned)
+
+	s.controller.Duration = w.Duration
+	if w.Curve != nil {
+		s.controller.Curve = w.Curve
+	} else {
+		s.controller.Curve = animation.LinearCurve
+	}
+
+	oldValues, newValues := old.values(), w.values()
+	changed := false
+	for i := range newValues {
+		if !equalFloatPtr(oldValues[i], newValues[i]) {
+			changed = true
+		}
+		// Start from the value currently shown so a change mid-animation
+		// continues smoothly.
+		if s.current[i] != nil {
+			s.begin[i] = *s.current[i]
+		}
+	}
+	if changed {
+		s.controller.Reset()
+		s.controller.Forward()
+	}
+}
+
+func (s *animatedPositionedState) Build(ctx core.BuildContext) core.Widget {
+	w := s.Element().Widget().(AnimatedPositioned)
+	t := s.controller.Value
+
+	target := w.values()
+	for i, end := range target {
+		if end == nil || s.current[i] == nil || !s.controller.IsAnimating() {
+			s.current[i] = end
+			continue
+		}
+		v := animation.LerpFloat64(s.begin[i], *end, t)
+		s.current[i] = &v
+	}
+
+	p := Positioned(w.Child)
+	p.left, p.top, p.right, p.bottom, p.width, p.height =
+		s.current[0], s.current[1], s.current[2], s.current[3], s.current[4], s.current[5]
+	return p
+}
+
+func equalFloatPtr(a, b *float64) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
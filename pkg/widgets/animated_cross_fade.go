@@ -0,0 +1,239 @@
+package widgets
+
+import (
+	"math"
+	"time"
+
+	"github.com/go-drift/drift/pkg/animation"
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/layout"
+)
+
+// CrossFadeState selects which child an [AnimatedCrossFade] shows.
+type CrossFadeState int
+
+const (
+	// CrossFadeShowFirst shows the First child.
+	CrossFadeShowFirst CrossFadeState = iota
+	// CrossFadeShowSecond shows the Second child.
+	CrossFadeShowSecond
+)
+
+// AnimatedCrossFade cross-fades between two children and animates its size
+// from one child's size to the other's.
+//
+// Both children stay mounted, so their state is kept while hidden. The
+// hidden child ignores pointer events. During the transition the widget
+// clips children to its animating size.
+//
+// Example:
+//
+//	widgets.AnimatedCrossFade{
+//	    Duration:       200 * time.Millisecond,
+//	    Curve:          animation.EaseInOut,
+//	    First:          summary,
+//	    Second:         details,
+//	    CrossFadeState: s.expanded ? widgets.CrossFadeShowSecond : widgets.CrossFadeShowFirst,
+//	}
+type AnimatedCrossFade struct {
+	core.StatefulBase
+
+	// Duration is the length of the animation.
+	Duration time.Duration
+	// Curve transforms the animation progress. If nil, uses linear interpolation.
+	Curve func(float64) float64
+	// OnEnd is called when the animation completes in either direction.
+	OnEnd func()
+
+	// First is shown for CrossFadeShowFirst.
+	First core.Widget
+	// Second is shown for CrossFadeShowSecond.
+	Second core.Widget
+	// CrossFadeState selects the child to show.
+	CrossFadeState CrossFadeState
+	// Alignment positions the children within the animating size. The zero
+	// value centers them.
+	Alignment layout.Alignment
+}
+
+func (a AnimatedCrossFade) CreateState() core.State {
+	return &animatedCrossFadeState{}
+}
+
+type animatedCrossFadeState struct {
+	core.StateBase
+	controller *animation.AnimationController
+}
+
+func (s *animatedCrossFadeState) InitState() {
+	w := s.Element().Widget().(AnimatedCrossFade)
+	s.controller = animation.NewAnimationController(w.Duration)
+	if w.Curve != nil {
+		s.controller.Curve = w.Curve
+	}
+	core.UseDisposable(s, s.controller)
+	core.UseListenable(s, s.controller)
+
+	s.controller.AddStatusListener(func(status animation.AnimationStatus) {
+		if status == animation.AnimationCompleted || status == animation.AnimationDismissed {
+			w := s.Element().Widget().(AnimatedCrossFade)
+			if w.OnEnd != nil {
+				w.OnEnd()
+			}
+		}
+	})
+
+	// Show the selected child without an initial animation.
+	if w.CrossFadeState == CrossFadeShowSecond {
+		s.controller.Value = 1
+	}
+}
+
+func (s *animatedCrossFadeState) DidUpdateWidget(oldWidget core.StatefulWidget) {
+	old := oldWidget.(AnimatedCrossFade)
+	w := s.Element().Widget().(AnimatedCrossFade)
+
+	s.controller.Duration = w.Duration
+	if w.Curve != nil {
+		s.controller.Curve = w.Curve
+	} else {
+		s.controller.Curve = animation.LinearCurve
+	}
+
+	if old.CrossFadeState != w.CrossFadeState {
+		if w.CrossFadeState == CrossFadeShowSecond {
+			s.controller.Forward()
+		} else {
+			s.controller.Reverse()
+		}
+	}
+}
+
+func (s *animatedCrossFadeState) Build(ctx core.BuildContext) core.Widget {
+	w := s.Element().Widget().(AnimatedCrossFade)
+	t := math.Max(0, math.Min(1, s.controller.Value))
+	showSecond := w.CrossFadeState == CrossFadeShowSecond
+	return crossFadeLayout{
+		t:         t,
+		alignment: w.Alignment,
+		first: IgnorePointer{
+			Ignoring: showSecond,
+			Child:    Opacity{Opacity: 1 - t, Child: w.First},
+		},
+		second: IgnorePointer{
+			Ignoring: !showSecond,
+			Child:    Opacity{Opacity: t, Child: w.Second},
+		},
+	}
+}
+
+// crossFadeLayout sizes itself between its two children's sizes at t, from
+// 0 (first child's size) to 1 (second child's size).
+type crossFadeLayout struct {
+	core.RenderObjectBase
+	t         float64
+	alignment layout.Alignment
+	first     core.Widget
+	second    core.Widget
+}
+
+func (c crossFadeLayout) ChildrenWidgets() []core.Widget {
+	return []core.Widget{c.first, c.second}
+}
+
+func (c crossFadeLayout) CreateRenderObject(ctx core.BuildContext) layout.RenderObject {
+	r := &renderCrossFade{t: c.t, alignment: c.alignment}
+	r.SetSelf(r)
+	return r
+}
+
+func (c crossFadeLayout) UpdateRenderObject(ctx core.BuildContext, renderObject layout.RenderObject) {
+	if r, ok := renderObject.(*renderCrossFade); ok {
+		r.t = c.t
+		r.alignment = c.alignment
+		r.MarkNeedsLayout()
+	}
+}
+
+type renderCrossFade struct {
+	layout.RenderBoxBase
+	children  []layout.RenderBox
+	t         float64
+	alignment layout.Alignment
+}
+
+func (r *renderCrossFade) SetChildren(children []layout.RenderObject) {
+	for _, child := range r.children {
+		layout.SetParentOnChild(child, nil)
+	}
+	r.children = make([]layout.RenderBox, 0, len(children))
+	for _, child := range children {
+		if box, ok := child.(layout.RenderBox); ok {
+			r.children = append(r.children, box)
+			layout.SetParentOnChild(box, r)
+		}
+	}
+}
+
+func (r *renderCrossFade) VisitChildren(visitor func(layout.RenderObject)) {
+	for _, child := range r.children {
+		visitor(child)
+	}
+}
+
+func (r *renderCrossFade) PerformLayout() {
+	constraints := r.Constraints()
+	loose := constraints
+	loose.MinWidth = 0
+	loose.MinHeight = 0
+
+	sizes := make([]graphics.Size, len(r.children))
+	for i, child := range r.children {
+		child.Layout(loose, true)
+		sizes[i] = child.Size()
+	}
+	var size graphics.Size
+	if len(sizes) == 2 {
+		size = graphics.Size{
+			Width:  sizes[0].Width + (sizes[1].Width-sizes[0].Width)*r.t,
+			Height: sizes[0].Height + (sizes[1].Height-sizes[0].Height)*r.t,
+		}
+	}
+	size = constraints.Constrain(size)
+	r.SetSize(size)
+
+	bounds := graphics.RectFromLTWH(0, 0, size.Width, size.Height)
+	for i, child := range r.children {
+		child.SetParentData(&layout.BoxParentData{Offset: r.alignment.WithinRect(bounds, sizes[i])})
+	}
+}
+
+func (r *renderCrossFade) Paint(ctx *layout.PaintContext) {
+	size := r.Size()
+	animating := r.t > 0 && r.t < 1
+	if animating {
+		rect := graphics.RectFromLTWH(0, 0, size.Width, size.Height)
+		ctx.Canvas.Save()
+		ctx.Canvas.ClipRect(rect)
+		ctx.PushClipRect(rect)
+	}
+	for i, child := range r.children {
+		// Skip the fully hidden child.
+		if (i == 0 && r.t >= 1) || (i == 1 && r.t <= 0) {
+			continue
+		}
+		ctx.PaintChildWithLayer(child, getChildOffset(child))
+	}
+	if animating {
+		ctx.PopClipRect()
+		ctx.Canvas.Restore()
+	}
+}
+
+func (r *renderCrossFade) HitTest(position graphics.Offset, result *layout.HitTestResult) bool {
+	if !layout.WithinBounds(position, r.Size()) {
+		return false
+	}
+	return hitTestChildrenReverse(r.children, position, result)
+}
@@ -0,0 +1,503 @@
+package widgets
+
+import (
+	"math"
+	"time"
+
+	"github.com/go-drift/drift/pkg/animation"
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/layout"
+)
+
+// AnimatedListController tracks the items of an [AnimatedList] and animates
+// them in and out. Call InsertItem and RemoveItem alongside the matching
+// change to your data so the list grows or shrinks smoothly instead of
+// jumping.
+//
+//	s.messages = append(s.messages, msg)
+//	s.list.InsertItem(len(s.messages) - 1)
+//
+//	removed := s.messages[i]
+//	s.messages = slices.Delete(s.messages, i, i+1)
+//	s.list.RemoveItem(i, func(ctx core.BuildContext) core.Widget {
+//	    return messageTile(removed)
+//	})
+//
+// Indices always refer to live items; items that are animating out are not
+// counted. Call Dispose when done to stop running animations.
+type AnimatedListController struct {
+	entries   []*animatedListEntry
+	nextID    int
+	duration  time.Duration
+	curve     func(float64) float64
+	listeners core.Notifier
+}
+
+// animatedListEntry is one item slot, live or being removed.
+type animatedListEntry struct {
+	id int // stable identity, used as the item widget key
+	// anim drives the size and fade transition. Nil when the item is settled.
+	anim *animation.AnimationController
+	// removed builds the item while it animates out. Nil for live items.
+	removed func(ctx core.BuildContext) core.Widget
+}
+
+// NewAnimatedListController creates a controller for a list that starts with
+// initialCount items, shown without animation.
+func NewAnimatedListController(initialCount int) *AnimatedListController {
+	c := &AnimatedListController{}
+	for range initialCount {
+		c.entries = append(c.entries, c.newEntry())
+	}
+	return c
+}
+
+func (c *AnimatedListController) newEntry() *animatedListEntry {
+	e := &animatedListEntry{id: c.nextID}
+	c.nextID++
+	return e
+}
+
+// ItemCount returns the number of live items.
+func (c *AnimatedListController) ItemCount() int {
+	n := 0
+	for _, e := range c.entries {
+		if e.removed == nil {
+			n++
+		}
+	}
+	return n
+}
+
+// InsertItem adds an item at index and animates it in. The item is built
+// with the list's ItemBuilder, so update your data first.
+func (c *AnimatedListController) InsertItem(index int) {
+	e := c.newEntry()
+	pos := c.entryPosition(index)
+	c.entries = append(c.entries[:pos], append([]*animatedListEntry{e}, c.entries[pos:]...)...)
+	c.animate(e, 0, true)
+	c.notifyListeners()
+}
+
+// RemoveItem animates the item at index out. The builder draws the item
+// while it shrinks, since it is no longer in your data. The index is removed
+// immediately: later indices shift down at once.
+func (c *AnimatedListController) RemoveItem(index int, builder func(ctx core.BuildContext) core.Widget) {
+	pos := c.entryPosition(index)
+	if pos >= len(c.entries) {
+		return
+	}
+	e := c.entries[pos]
+	if builder == nil {
+		builder = func(core.BuildContext) core.Widget { return SizedBox{} }
+	}
+	e.removed = builder
+	c.notifyListeners()
+	c.animate(e, 1, false)
+}
+
+// ApplyOperations animates the operations from [ListDiffer.Diff]. removed
+// returns the builder for the item at an old index as it animates out.
+// Moves are applied immediately; changes need no animation, since the item
+// is rebuilt from the new data.
+func (c *AnimatedListController) ApplyOperations(ops []ListOperation, removed func(oldIndex int) func(ctx core.BuildContext) core.Widget) {
+	for _, op := range ops {
+		switch op.Kind {
+		case ListOperationRemove:
+			var builder func(ctx core.BuildContext) core.Widget
+			if removed != nil {
+				// Removals run from the end, so Index is still the old index.
+				builder = removed(op.Index)
+			}
+			c.RemoveItem(op.Index, builder)
+		case ListOperationMove:
+			from := c.entryPosition(op.From)
+			e := c.entries[from]
+			c.entries = append(c.entries[:from], c.entries[from+1:]...)
+			to := c.entryPosition(op.Index)
+			c.entries = append(c.entries[:to], append([]*animatedListEntry{e}, c.entries[to:]...)...)
+			c.notifyListeners()
+		case ListOperationInsert:
+			c.InsertItem(op.Index)
+		}
+	}
+}
+
+// AddListener registers a callback for structural changes. Returns an
+// unsubscribe function.
+func (c *AnimatedListController) AddListener(listener func()) func() {
+	return c.listeners.AddListener(listener)
+}
+
+// Dispose stops all running item animations.
+func (c *AnimatedListController) Dispose() {
+	for _, e := range c.entries {
+		if e.anim != nil {
+			e.anim.Dispose()
+			e.anim = nil
+		}
+	}
+	c.listeners.Dispose()
+}
+
+// entryPosition converts a live item index into a position in entries.
+// Indices past the last live item map to the end.
+func (c *AnimatedListController) entryPosition(index int) int {
+	live := 0
+	for pos, e := range c.entries {
+		if e.removed != nil {
+			continue
+		}
+		if live == index {
+			return pos
+		}
+		live++
+	}
+	return len(c.entries)
+}
+
+// animate runs the entry's transition towards 1 when entering and 0 when
+// leaving, starting from from unless the entry is already animating.
+func (c *AnimatedListController) animate(e *animatedListEntry, from float64, entering bool) {
+	if e.anim == nil {
+		e.anim = animation.NewAnimationController(c.duration)
+		if c.curve != nil {
+			e.anim.Curve = c.curve
+		}
+		e.anim.Value = from
+		anim := e.anim
+		anim.AddStatusListener(func(status animation.AnimationStatus) {
+			switch {
+			case status == animation.AnimationCompleted && e.removed == nil:
+				c.settle(e, anim)
+			case status == animation.AnimationDismissed && e.removed != nil:
+				c.drop(e, anim)
+			}
+		})
+		c.notifyListeners()
+	}
+	if entering {
+		e.anim.Forward()
+	} else {
+		e.anim.Reverse()
+	}
+}
+
+// settle finishes an insertion.
+func (c *AnimatedListController) settle(e *animatedListEntry, anim *animation.AnimationController) {
+	if e.anim != anim {
+		return
+	}
+	e.anim = nil
+	anim.Dispose()
+	c.notifyListeners()
+}
+
+// drop finishes a removal.
+func (c *AnimatedListController) drop(e *animatedListEntry, anim *animation.AnimationController) {
+	for pos, entry := range c.entries {
+		if entry == e {
+			c.entries = append(c.entries[:pos], c.entries[pos+1:]...)
+			break
+		}
+	}
+	e.anim = nil
+	anim.Dispose()
+	c.notifyListeners()
+}
+
+func (c *AnimatedListController) notifyListeners() {
+	c.listeners.Notify()
+}
+
+// AnimatedList is a scrollable list that animates items in and out as they
+// are inserted and removed through its [AnimatedListController]. Inserted
+// items grow from zero extent while fading in; removed items fade out and
+// shrink away, so the items around them slide into place.
+//
+// Like [ListView], all items are built, so it suits lists of modest size such
+// as chats and feeds.
+//
+//	widgets.AnimatedList{
+//	    Controller: s.list,
+//	    Duration:   250 * time.Millisecond,
+//	    Curve:      animation.EaseInOut,
+//	    ItemBuilder: func(ctx core.BuildContext, index int) core.Widget {
+//	        return messageTile(s.messages[index])
+//	    },
+//	}
+type AnimatedList struct {
+	core.StatefulBase
+
+	// Controller tracks the items and their animations. Required.
+	Controller *AnimatedListController
+	// ItemBuilder builds the live item at index.
+	ItemBuilder func(ctx core.BuildContext, index int) core.Widget
+	// Duration is the length of insert and remove animations. Zero means
+	// items appear and disappear immediately.
+	Duration time.Duration
+	// Curve transforms the animation progress. If nil, uses linear interpolation.
+	Curve func(float64) float64
+	// ScrollDirection is the axis along which the list scrolls. Defaults to vertical.
+	ScrollDirection Axis
+	// ScrollController manages scroll position and provides scroll notifications.
+	ScrollController *ScrollController
+	// Physics determines how the scroll view responds to user input.
+	Physics ScrollPhysics
+	// Padding is applied around the list content.
+	Padding layout.EdgeInsets
+}
+
+func (a AnimatedList) CreateState() core.State {
+	return &animatedListState{}
+}
+
+type animatedListState struct {
+	core.StateBase
+	unsubscribe func()
+}
+
+func (s *animatedListState) InitState() {
+	s.attach(s.Element().Widget().(AnimatedList))
+}
+
+func (s *animatedListState) DidUpdateWidget(oldWidget core.StatefulWidget) {
+	old := oldWidget.(AnimatedList)
+	w := s.Element().Widget().(AnimatedList)
+	if old.Controller != w.Controller {
+		s.detach()
+		s.attach(w)
+	} else if w.Controller != nil {
+		w.Controller.duration = w.Duration
+		w.Controller.curve = w.Curve
+	}
+}
+
+func (s *animatedListState) Dispose() {
+	s.detach()
+	s.StateBase.Dispose()
+}
+
+func (s *animatedListState) attach(w AnimatedList) {
+	if w.Controller == nil {
+		return
+	}
+	w.Controller.duration = w.Duration
+	w.Controller.curve = w.Curve
+	s.unsubscribe = w.Controller.AddListener(func() {
+		s.SetState(nil)
+	})
+}
+
+func (s *animatedListState) detach() {
+	if s.unsubscribe != nil {
+		s.unsubscribe()
+		s.unsubscribe = nil
+	}
+}
+
+func (s *animatedListState) Build(ctx core.BuildContext) core.Widget {
+	w := s.Element().Widget().(AnimatedList)
+	var children []core.Widget
+	if w.Controller != nil {
+		children = make([]core.Widget, 0, len(w.Controller.entries))
+		index := 0
+		for _, e := range w.Controller.entries {
+			var child core.Widget
+			if e.removed != nil {
+				child = e.removed(ctx)
+			} else {
+				if w.ItemBuilder != nil {
+					child = w.ItemBuilder(ctx, index)
+				}
+				index++
+			}
+			children = append(children, animatedListItem{
+				id:    e.id,
+				anim:  e.anim,
+				axis:  w.ScrollDirection,
+				child: child,
+			})
+		}
+	}
+	return ListView{
+		Children:        children,
+		ScrollDirection: w.ScrollDirection,
+		Controller:      w.ScrollController,
+		Physics:         w.Physics,
+		Padding:         w.Padding,
+		MainAxisSize:    MainAxisSizeMin,
+	}
+}
+
+// animatedListItem wraps one item in its size and fade transition. It is
+// keyed by the entry id so item state survives inserts and removals around
+// it, and it listens to its own animation so only moving items rebuild.
+type animatedListItem struct {
+	core.StatefulBase
+	id    int
+	anim  *animation.AnimationController
+	axis  Axis
+	child core.Widget
+}
+
+func (i animatedListItem) Key() any { return i.id }
+
+func (i animatedListItem) CreateState() core.State {
+	return &animatedListItemState{}
+}
+
+type animatedListItemState struct {
+	core.StateBase
+	unsubscribe func()
+}
+
+func (s *animatedListItemState) InitState() {
+	s.listen(s.Element().Widget().(animatedListItem).anim)
+}
+
+func (s *animatedListItemState) DidUpdateWidget(oldWidget core.StatefulWidget) {
+	old := oldWidget.(animatedListItem)
+	w := s.Element().Widget().(animatedListItem)
+	if old.anim != w.anim {
+		s.listen(w.anim)
+	}
+}
+
+func (s *animatedListItemState) Dispose() {
+	s.listen(nil)
+	s.StateBase.Dispose()
+}
+
+func (s *animatedListItemState) listen(anim *animation.AnimationController) {
+	if s.unsubscribe != nil {
+		s.unsubscribe()
+		s.unsubscribe = nil
+	}
+	if anim != nil {
+		s.unsubscribe = anim.AddListener(func() {
+			s.SetState(nil)
+		})
+	}
+}
+
+func (s *animatedListItemState) Build(ctx core.BuildContext) core.Widget {
+	w := s.Element().Widget().(animatedListItem)
+	// Settled items keep the same wrappers so their subtree is not rebuilt
+	// from scratch when the animation ends.
+	t := 1.0
+	if w.anim != nil {
+		t = math.Max(0, math.Min(1, w.anim.Value))
+	}
+	return sizeFactor{
+		axis:   w.axis,
+		factor: t,
+		child:  Opacity{Opacity: t, Child: w.child},
+	}
+}
+
+// sizeFactor sizes itself to a fraction of its child's extent along axis,
+// clipping the rest. The child is laid out at its natural extent.
+type sizeFactor struct {
+	core.RenderObjectBase
+	axis   Axis
+	factor float64
+	child  core.Widget
+}
+
+func (s sizeFactor) ChildWidget() core.Widget {
+	return s.child
+}
+
+func (s sizeFactor) CreateRenderObject(ctx core.BuildContext) layout.RenderObject {
+	box := &renderSizeFactor{axis: s.axis, factor: s.factor}
+	box.SetSelf(box)
+	return box
+}
+
+func (s sizeFactor) UpdateRenderObject(ctx core.BuildContext, renderObject layout.RenderObject) {
+	if box, ok := renderObject.(*renderSizeFactor); ok {
+		box.axis = s.axis
+		box.factor = s.factor
+		box.MarkNeedsLayout()
+	}
+}
+
+type renderSizeFactor struct {
+	layout.RenderBoxBase
+	child  layout.RenderBox
+	axis   Axis
+	factor float64
+}
+
+func (r *renderSizeFactor) SetChild(child layout.RenderObject) {
+	layout.SetParentOnChild(r.child, nil)
+	r.child = layout.AsRenderBox(child)
+	layout.SetParentOnChild(r.child, r)
+}
+
+func (r *renderSizeFactor) VisitChildren(visitor func(layout.RenderObject)) {
+	if r.child != nil {
+		visitor(r.child)
+	}
+}
+
+func (r *renderSizeFactor) PerformLayout() {
+	constraints := r.Constraints()
+	if r.child == nil {
+		r.SetSize(constraints.Constrain(graphics.Size{}))
+		return
+	}
+	childConstraints := constraints
+	if r.axis == AxisHorizontal {
+		childConstraints.MinWidth = 0
+		childConstraints.MaxWidth = math.MaxFloat64
+	} else {
+		childConstraints.MinHeight = 0
+		childConstraints.MaxHeight = math.MaxFloat64
+	}
+	r.child.Layout(childConstraints, true)
+	size := r.child.Size()
+	if r.axis == AxisHorizontal {
+		size.Width *= r.factor
+	} else {
+		size.Height *= r.factor
+	}
+	r.SetSize(constraints.Constrain(size))
+}
+
+func (r *renderSizeFactor) Paint(ctx *layout.PaintContext) {
+	if r.child == nil {
+		return
+	}
+	size := r.Size()
+	if size.Width <= 0 || size.Height <= 0 {
+		return
+	}
+	if r.factor >= 1 {
+		ctx.PaintChildWithLayer(r.child, getChildOffset(r.child))
+		return
+	}
+	rect := graphics.RectFromLTWH(0, 0, size.Width, size.Height)
+	ctx.Canvas.Save()
+	ctx.Canvas.ClipRect(rect)
+	ctx.PushClipRect(rect)
+	ctx.PaintChildWithLayer(r.child, getChildOffset(r.child))
+	ctx.PopClipRect()
+	ctx.Canvas.Restore()
+}
+
+func (r *renderSizeFactor) HitTest(position graphics.Offset, result *layout.HitTestResult) bool {
+	if !layout.WithinBounds(position, r.Size()) {
+		return false
+	}
+	if r.child != nil {
+		offset := getChildOffset(r.child)
+		local := graphics.Offset{X: position.X - offset.X, Y: position.Y - offset.Y}
+		if r.child.HitTest(local, result) {
+			return true
+		}
+	}
+	return false
+}
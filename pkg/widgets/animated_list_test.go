@@ -0,0 +1,83 @@
+package widgets_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+	drifttest "github.com/go-drift/drift/pkg/testing"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+func TestAnimatedList_InsertAndRemove(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	tester.SetSize(graphics.Size{Width: 400, Height: 600})
+
+	items := []string{"a", "probe"}
+	list := widgets.NewAnimatedListController(len(items))
+	defer list.Dispose()
+	item := func(name string) core.Widget {
+		if name == "probe" {
+			return probeBox{widgets.SizedBox{Width: 10, Height: 50}}
+		}
+		return widgets.SizedBox{Width: 10, Height: 50}
+	}
+	tester.PumpWidget(widgets.AnimatedList{
+		Controller: list,
+		Duration:   100 * time.Millisecond,
+		ItemBuilder: func(ctx core.BuildContext, index int) core.Widget {
+			return item(items[index])
+		},
+	})
+	if y := probeOffset(tester).Y; y != 50 {
+		t.Fatalf("expected probe at y=50, got %v", y)
+	}
+
+	items = append([]string{"new"}, items...)
+	list.InsertItem(0)
+	if n := list.ItemCount(); n != 3 {
+		t.Errorf("expected 3 items after insert, got %d", n)
+	}
+	tester.Pump()
+	tester.Clock().Advance(50 * time.Millisecond)
+	tester.Pump()
+	if y := probeOffset(tester).Y; math.Abs(y-75) > 1 {
+		t.Errorf("expected probe halfway at y=75, got %v", y)
+	}
+	if err := tester.PumpAndSettle(time.Second); err != nil {
+		t.Fatal(err)
+	}
+	if y := probeOffset(tester).Y; y != 100 {
+		t.Errorf("expected probe to settle at y=100, got %v", y)
+	}
+
+	removed := items[1]
+	items = append(items[:1], items[2:]...)
+	list.RemoveItem(1, func(ctx core.BuildContext) core.Widget { return item(removed) })
+	if n := list.ItemCount(); n != 2 {
+		t.Errorf("expected 2 items after remove, got %d", n)
+	}
+	tester.Pump()
+	tester.Clock().Advance(50 * time.Millisecond)
+	tester.Pump()
+	if y := probeOffset(tester).Y; math.Abs(y-75) > 1 {
+		t.Errorf("expected probe halfway at y=75, got %v", y)
+	}
+	if err := tester.PumpAndSettle(time.Second); err != nil {
+		t.Fatal(err)
+	}
+	if y := probeOffset(tester).Y; y != 50 {
+		t.Errorf("expected probe to settle at y=50, got %v", y)
+	}
+}
+
+func TestAnimatedList_ZeroDurationIsImmediate(t *testing.T) {
+	list := widgets.NewAnimatedListController(1)
+	list.InsertItem(1)
+	list.RemoveItem(0, nil)
+	if n := list.ItemCount(); n != 1 {
+		t.Errorf("expected 1 item, got %d", n)
+	}
+}
@@ -0,0 +1,330 @@
+package widgets
+
+import (
+	"math"
+	"reflect"
+	"time"
+
+	"github.com/go-drift/drift/pkg/animation"
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/layout"
+)
+
+// SwitcherTransition wraps a child of an [AnimatedSwitcher] in its
+// transition at progress t, from 0 (hidden) to 1 (fully shown). Incoming
+// children run from 0 to 1 and outgoing children from 1 back to 0.
+type SwitcherTransition func(child core.Widget, t float64) core.Widget
+
+// FadeSwitcherTransition fades children in and out.
+func FadeSwitcherTransition(child core.Widget, t float64) core.Widget {
+	return Opacity{Opacity: t, Child: child}
+}
+
+// ScaleSwitcherTransition grows children from their center as they enter
+// and shrinks them as they leave.
+func ScaleSwitcherTransition(child core.Widget, t float64) core.Widget {
+	return scaleBox{scale: t, child: child}
+}
+
+// FadeScaleSwitcherTransition fades children while scaling them from 80%
+// to full size, a subtler variant of [ScaleSwitcherTransition].
+func FadeScaleSwitcherTransition(child core.Widget, t float64) core.Widget {
+	return Opacity{Opacity: t, Child: scaleBox{scale: 0.8 + 0.2*t, child: child}}
+}
+
+// AnimatedSwitcher transitions from its old child to its new child whenever
+// Child changes identity: the old child animates out while the new one
+// animates in, overlapping in a [Stack].
+//
+// Children are the same when they have the same type and key, so rebuilding
+// with an updated widget of the same type simply updates it in place. Give
+// children distinct keys to switch between widgets of the same type:
+//
+//	widgets.AnimatedSwitcher{
+//	    Duration: 250 * time.Millisecond,
+//	    Child:    countLabel{key: s.count, Content: strconv.Itoa(s.count)},
+//	}
+//
+// Transition controls the effect, defaulting to [FadeSwitcherTransition].
+// Outgoing children ignore pointer events. If Child changes again before a
+// transition finishes, the current child reverses from where it is.
+type AnimatedSwitcher struct {
+	core.StatefulBase
+
+	// Duration is the length of each transition.
+	Duration time.Duration
+	// Curve transforms the animation progress. If nil, uses linear interpolation.
+	Curve func(float64) float64
+	// Transition builds the effect. If nil, uses FadeSwitcherTransition.
+	Transition SwitcherTransition
+	// LeavingTransition builds the effect for outgoing children, for
+	// transitions that move differently on the way out. If nil, uses
+	// Transition.
+	LeavingTransition SwitcherTransition
+	// Alignment positions children of different sizes within the switcher.
+	// The zero value centers them.
+	Alignment layout.Alignment
+	// Child is the current child. A nil Child fades out to nothing.
+	Child core.Widget
+}
+
+func (a AnimatedSwitcher) CreateState() core.State {
+	return &animatedSwitcherState{}
+}
+
+// switcherEntry is one child of the switcher, current or leaving.
+type switcherEntry struct {
+	id    int
+	child core.Widget
+	anim  *animation.AnimationController
+}
+
+type animatedSwitcherState struct {
+	core.StateBase
+	current  *switcherEntry
+	outgoing []*switcherEntry
+	nextID   int
+}
+
+func (s *animatedSwitcherState) InitState() {
+	w := s.Element().Widget().(AnimatedSwitcher)
+	if w.Child != nil {
+		// The first child is shown without a transition.
+		s.current = s.newEntry(w, w.Child)
+		s.current.anim.Value = 1
+	}
+	s.OnDispose(func() {
+		for _, e := range s.entries() {
+			e.anim.Dispose()
+		}
+	})
+}
+
+func (s *animatedSwitcherState) DidUpdateWidget(oldWidget core.StatefulWidget) {
+	w := s.Element().Widget().(AnimatedSwitcher)
+	for _, e := range s.entries() {
+		e.anim.Duration = w.Duration
+		e.anim.Curve = switcherCurve(w)
+	}
+
+	if s.current != nil && sameSwitcherChild(s.current.child, w.Child) {
+		s.current.child = w.Child
+		return
+	}
+	if s.current == nil && w.Child == nil {
+		return
+	}
+
+	if s.current != nil {
+		leaving := s.current
+		s.outgoing = append(s.outgoing, leaving)
+		leaving.anim.Reverse()
+	}
+	s.current = nil
+	if w.Child != nil {
+		s.current = s.newEntry(w, w.Child)
+		s.current.anim.Forward()
+	}
+}
+
+func (s *animatedSwitcherState) newEntry(w AnimatedSwitcher, child core.Widget) *switcherEntry {
+	e := &switcherEntry{id: s.nextID, child: child}
+	s.nextID++
+	e.anim = animation.NewAnimationController(w.Duration)
+	e.anim.Curve = switcherCurve(w)
+	e.anim.AddStatusListener(func(status animation.AnimationStatus) {
+		if status == animation.AnimationDismissed && e != s.current {
+			s.remove(e)
+		}
+	})
+	return e
+}
+
+// remove drops an outgoing entry once it has animated out.
+func (s *animatedSwitcherState) remove(e *switcherEntry) {
+	for i, out := range s.outgoing {
+		if out == e {
+			s.SetState(func() {
+				s.outgoing = append(s.outgoing[:i], s.outgoing[i+1:]...)
+			})
+			e.anim.Dispose()
+			return
+		}
+	}
+}
+
+// entries returns every entry, oldest first.
+func (s *animatedSwitcherState) entries() []*switcherEntry {
+	all := append([]*switcherEntry(nil), s.outgoing...)
+	if s.current != nil {
+		all = append(all, s.current)
+	}
+	return all
+}
+
+func (s *animatedSwitcherState) Build(ctx core.BuildContext) core.Widget {
+	w := s.Element().Widget().(AnimatedSwitcher)
+	transition := w.Transition
+	if transition == nil {
+		transition = FadeSwitcherTransition
+	}
+	leavingTransition := w.LeavingTransition
+	if leavingTransition == nil {
+		leavingTransition = transition
+	}
+	entries := s.entries()
+	children := make([]core.Widget, 0, len(entries))
+	for _, e := range entries {
+		item := switcherItem{
+			id:         e.id,
+			anim:       e.anim,
+			leaving:    e != s.current,
+			transition: transition,
+			child:      e.child,
+		}
+		if item.leaving {
+			item.transition = leavingTransition
+		}
+		children = append(children, item)
+	}
+	return Stack{Alignment: w.Alignment, Children: children}
+}
+
+func switcherCurve(w AnimatedSwitcher) func(float64) float64 {
+	if w.Curve != nil {
+		return w.Curve
+	}
+	return animation.LinearCurve
+}
+
+// sameSwitcherChild reports whether next updates the existing child rather
+// than replacing it, using the same type and key rule as the element tree.
+func sameSwitcherChild(existing, next core.Widget) bool {
+	if existing == nil || next == nil {
+		return false
+	}
+	if reflect.TypeOf(existing) != reflect.TypeOf(next) {
+		return false
+	}
+	return reflect.DeepEqual(existing.Key(), next.Key())
+}
+
+// switcherItem wraps one child in its transition. It is keyed by the entry
+// id so child state survives while other children come and go, and it
+// listens to its own animation so only moving children rebuild.
+type switcherItem struct {
+	core.StatefulBase
+	id         int
+	anim       *animation.AnimationController
+	leaving    bool
+	transition SwitcherTransition
+	child      core.Widget
+}
+
+func (i switcherItem) Key() any { return i.id }
+
+func (i switcherItem) CreateState() core.State {
+	return &switcherItemState{}
+}
+
+type switcherItemState struct {
+	core.StateBase
+}
+
+func (s *switcherItemState) InitState() {
+	core.UseListenable(s, s.Element().Widget().(switcherItem).anim)
+}
+
+func (s *switcherItemState) Build(ctx core.BuildContext) core.Widget {
+	w := s.Element().Widget().(switcherItem)
+	t := math.Max(0, math.Min(1, w.anim.Value))
+	return IgnorePointer{
+		Ignoring: w.leaving,
+		Child:    w.transition(w.child, t),
+	}
+}
+
+// scaleBox paints its child scaled about its center. The box keeps its
+// child's unscaled size, while hit testing follows the scaled drawing.
+type scaleBox struct {
+	core.RenderObjectBase
+	scale float64
+	child core.Widget
+}
+
+func (s scaleBox) ChildWidget() core.Widget {
+	return s.child
+}
+
+func (s scaleBox) CreateRenderObject(ctx core.BuildContext) layout.RenderObject {
+	box := &renderScaleBox{scale: s.scale}
+	box.SetSelf(box)
+	return box
+}
+
+func (s scaleBox) UpdateRenderObject(ctx core.BuildContext, renderObject layout.RenderObject) {
+	if box, ok := renderObject.(*renderScaleBox); ok && box.scale != s.scale {
+		box.scale = s.scale
+		box.MarkNeedsPaint()
+	}
+}
+
+type renderScaleBox struct {
+	layout.RenderBoxBase
+	child layout.RenderBox
+	scale float64
+}
+
+func (r *renderScaleBox) SetChild(child layout.RenderObject) {
+	layout.SetParentOnChild(r.child, nil)
+	r.child = layout.AsRenderBox(child)
+	layout.SetParentOnChild(r.child, r)
+}
+
+func (r *renderScaleBox) VisitChildren(visitor func(layout.RenderObject)) {
+	if r.child != nil {
+		visitor(r.child)
+	}
+}
+
+func (r *renderScaleBox) PerformLayout() {
+	constraints := r.Constraints()
+	if r.child == nil {
+		r.SetSize(constraints.Constrain(graphics.Size{}))
+		return
+	}
+	r.child.Layout(constraints, true)
+	r.child.SetParentData(&layout.BoxParentData{})
+	r.SetSize(r.child.Size())
+}
+
+func (r *renderScaleBox) Paint(ctx *layout.PaintContext) {
+	if r.child == nil || r.scale <= 0 {
+		return
+	}
+	if r.scale == 1 {
+		ctx.PaintChildWithLayer(r.child, graphics.Offset{})
+		return
+	}
+	cx, cy := r.Size().Width/2, r.Size().Height/2
+	ctx.Canvas.Save()
+	ctx.Canvas.Translate(cx, cy)
+	ctx.Canvas.Scale(r.scale, r.scale)
+	ctx.Canvas.Translate(-cx, -cy)
+	ctx.PaintChildWithLayer(r.child, graphics.Offset{})
+	ctx.Canvas.Restore()
+}
+
+func (r *renderScaleBox) HitTest(position graphics.Offset, result *layout.HitTestResult) bool {
+	if r.child == nil || r.scale <= 0 {
+		return false
+	}
+	// Map the position back through the scale to the child's coordinates.
+	cx, cy := r.Size().Width/2, r.Size().Height/2
+	local := graphics.Offset{X: cx + (position.X-cx)/r.scale, Y: cy + (position.Y-cy)/r.scale}
+	if !layout.WithinBounds(local, r.Size()) {
+		return false
+	}
+	return r.child.HitTest(local, result)
+}
@@ -0,0 +1,157 @@
+package widgets_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+	drifttest "github.com/go-drift/drift/pkg/testing"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+// keyedBox is a SizedBox with a key, so switchers see a new child when the
+// key changes.
+type keyedBox struct {
+	widgets.SizedBox
+	key int
+}
+
+func (k keyedBox) Key() any { return k.key }
+
+// opacities returns the opacity of every Opacity widget, in tree order.
+func opacities(tester *drifttest.WidgetTester) []float64 {
+	var values []float64
+	for _, e := range tester.Find(drifttest.ByType[widgets.Opacity]()).All() {
+		values = append(values, e.Widget().(widgets.Opacity).Opacity)
+	}
+	return values
+}
+
+func TestAnimatedSwitcher_CrossFadesKeyedChildren(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	tester.SetSize(graphics.Size{Width: 400, Height: 300})
+
+	var set func(float64)
+	tester.PumpWidget(valueHost{set: &set, build: func(v float64) core.Widget {
+		return widgets.AnimatedSwitcher{
+			Duration: 100 * time.Millisecond,
+			Child:    keyedBox{key: int(v), SizedBox: widgets.SizedBox{Width: 10, Height: 10}},
+		}
+	}})
+	if got := opacities(tester); len(got) != 1 || got[0] != 1 {
+		t.Fatalf("expected initial child fully shown without a transition, got %v", got)
+	}
+
+	set(1)
+	tester.Pump()
+	tester.Clock().Advance(50 * time.Millisecond)
+	tester.Pump()
+	got := opacities(tester)
+	if len(got) != 2 || math.Abs(got[0]-0.5) > 0.01 || math.Abs(got[1]-0.5) > 0.01 {
+		t.Fatalf("expected both children half faded, got %v", got)
+	}
+
+	if err := tester.PumpAndSettle(time.Second); err != nil {
+		t.Fatal(err)
+	}
+	if n := tester.Find(drifttest.ByType[keyedBox]()).Count(); n != 1 {
+		t.Fatalf("expected the old child removed after the transition, got %d children", n)
+	}
+	if key := tester.Find(drifttest.ByType[keyedBox]()).Widget().Key(); key != 1 {
+		t.Fatalf("expected new child with key 1, got %v", key)
+	}
+}
+
+func TestAnimatedSwitcher_SameKeyUpdatesInPlace(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	tester.SetSize(graphics.Size{Width: 400, Height: 300})
+
+	var set func(float64)
+	tester.PumpWidget(valueHost{set: &set, build: func(v float64) core.Widget {
+		return widgets.AnimatedSwitcher{
+			Duration: 100 * time.Millisecond,
+			Child:    keyedBox{SizedBox: widgets.SizedBox{Width: 10 + v, Height: 10}},
+		}
+	}})
+
+	set(20)
+	tester.Pump()
+	if n := tester.Find(drifttest.ByType[keyedBox]()).Count(); n != 1 {
+		t.Fatalf("expected no transition for the same key, got %d children", n)
+	}
+	if w := tester.Find(drifttest.ByType[keyedBox]()).Widget().(keyedBox).Width; w != 30 {
+		t.Fatalf("expected child updated to width 30, got %v", w)
+	}
+}
+
+func TestAnimatedCrossFade_AnimatesSize(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	tester.SetSize(graphics.Size{Width: 400, Height: 300})
+
+	var set func(float64)
+	ended := 0
+	tester.PumpWidget(valueHost{set: &set, build: func(v float64) core.Widget {
+		state := widgets.CrossFadeShowFirst
+		if v > 0 {
+			state = widgets.CrossFadeShowSecond
+		}
+		return widgets.Column{
+			MainAxisSize: widgets.MainAxisSizeMin,
+			Children: []core.Widget{
+				widgets.AnimatedCrossFade{
+					Duration:       100 * time.Millisecond,
+					First:          widgets.SizedBox{Width: 40, Height: 20},
+					Second:         widgets.SizedBox{Width: 40, Height: 60},
+					CrossFadeState: state,
+					OnEnd:          func() { ended++ },
+				},
+				probeBox{widgets.SizedBox{Width: 10, Height: 10}},
+			},
+		}
+	}})
+	if y := probeOffset(tester).Y; y != 20 {
+		t.Fatalf("expected first child's height 20, got probe at y=%v", y)
+	}
+
+	set(1)
+	tester.Pump()
+	tester.Clock().Advance(50 * time.Millisecond)
+	tester.Pump()
+	if y := probeOffset(tester).Y; math.Abs(y-40) > 1 {
+		t.Fatalf("expected height halfway at 40, got probe at y=%v", y)
+	}
+
+	if err := tester.PumpAndSettle(time.Second); err != nil {
+		t.Fatal(err)
+	}
+	if y := probeOffset(tester).Y; y != 60 {
+		t.Fatalf("expected second child's height 60, got probe at y=%v", y)
+	}
+	if ended != 1 {
+		t.Fatalf("expected OnEnd once, got %d", ended)
+	}
+}
+
+func TestAnimatedSwitcher_LeavingTransition(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	tester.SetSize(graphics.Size{Width: 400, Height: 300})
+
+	var set func(float64)
+	tester.PumpWidget(valueHost{set: &set, build: func(v float64) core.Widget {
+		return widgets.AnimatedSwitcher{
+			Duration:          100 * time.Millisecond,
+			LeavingTransition: widgets.ScaleSwitcherTransition,
+			Child:             keyedBox{key: int(v), SizedBox: widgets.SizedBox{Width: 10, Height: 10}},
+		}
+	}})
+
+	set(1)
+	tester.Pump()
+	tester.Clock().Advance(50 * time.Millisecond)
+	tester.Pump()
+	if got := opacities(tester); len(got) != 1 || math.Abs(got[0]-0.5) > 0.01 {
+		t.Fatalf("expected only the incoming child to fade, got %v", got)
+	}
+}
@@ -0,0 +1,102 @@
+package widgets_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/layout"
+	drifttest "github.com/go-drift/drift/pkg/testing"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+// probeBox marks the widget whose position the tests read.
+type probeBox struct{ widgets.SizedBox }
+
+// valueHost rebuilds its child with a new value when set is called.
+type valueHost struct {
+	core.StatefulBase
+	initial float64
+	build   func(v float64) core.Widget
+	set     *func(float64)
+}
+
+func (h valueHost) CreateState() core.State { return &valueHostState{} }
+
+type valueHostState struct {
+	core.StateBase
+	value float64
+}
+
+func (s *valueHostState) InitState() {
+	w := s.Element().Widget().(valueHost)
+	s.value = w.initial
+	*w.set = func(v float64) { s.SetState(func() { s.value = v }) }
+}
+
+func (s *valueHostState) Build(ctx core.BuildContext) core.Widget {
+	return s.Element().Widget().(valueHost).build(s.value)
+}
+
+func probeOffset(tester *drifttest.WidgetTester) graphics.Offset {
+	return core.GlobalOffsetOf(tester.Find(drifttest.ByType[probeBox]()).First())
+}
+
+func TestAnimatedPositioned_TweensLeft(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	tester.SetSize(graphics.Size{Width: 400, Height: 300})
+
+	var set func(float64)
+	tester.PumpWidget(valueHost{set: &set, build: func(left float64) core.Widget {
+		return widgets.Stack{Children: []core.Widget{
+			widgets.AnimatedPositioned{
+				Duration: 100 * time.Millisecond,
+				Left:     &left,
+				Top:      new(float64),
+				Child:    probeBox{widgets.SizedBox{Width: 10, Height: 10}},
+			},
+		}}
+	}})
+
+	set(100)
+	tester.Pump()
+	tester.Clock().Advance(50 * time.Millisecond)
+	tester.Pump()
+	if x := probeOffset(tester).X; math.Abs(x-50) > 1 {
+		t.Errorf("expected box halfway at x=50, got %v", x)
+	}
+
+	if err := tester.PumpAndSettle(time.Second); err != nil {
+		t.Fatal(err)
+	}
+	if x := probeOffset(tester).X; x != 100 {
+		t.Errorf("expected box to settle at x=100, got %v", x)
+	}
+}
+
+func TestAnimatedPadding_TweensPadding(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	tester.SetSize(graphics.Size{Width: 400, Height: 300})
+
+	var set func(float64)
+	tester.PumpWidget(valueHost{set: &set, build: func(inset float64) core.Widget {
+		return widgets.Align{
+			Alignment: layout.AlignmentTopLeft,
+			Child: widgets.AnimatedPadding{
+				Duration: 100 * time.Millisecond,
+				Padding:  layout.EdgeInsetsAll(inset),
+				Child:    probeBox{widgets.SizedBox{Width: 10, Height: 10}},
+			},
+		}
+	}})
+
+	set(40)
+	tester.Pump()
+	tester.Clock().Advance(25 * time.Millisecond)
+	tester.Pump()
+	if offset := probeOffset(tester); math.Abs(offset.X-10) > 1 || math.Abs(offset.Y-10) > 1 {
+		t.Errorf("expected padding a quarter of the way at 10, got %v", offset)
+	}
+}
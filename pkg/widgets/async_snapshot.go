@@ -0,0 +1,59 @@
+package widgets
+
+// ConnectionState describes the state of the asynchronous source behind an
+// [AsyncSnapshot].
+type ConnectionState int
+
+const (
+	// ConnectionNone means there is no source, such as a nil stream.
+	ConnectionNone ConnectionState = iota
+
+	// ConnectionWaiting means the source is connected but has not yet
+	// produced a value.
+	ConnectionWaiting
+
+	// ConnectionActive means the source has produced at least one value or
+	// error and may produce more.
+	ConnectionActive
+
+	// ConnectionDone means the source has finished.
+	ConnectionDone
+)
+
+// String returns a human-readable representation of the connection state.
+func (s ConnectionState) String() string {
+	switch s {
+	case ConnectionNone:
+		return "none"
+	case ConnectionWaiting:
+		return "waiting"
+	case ConnectionActive:
+		return "active"
+	case ConnectionDone:
+		return "done"
+	default:
+		return "unknown"
+	}
+}
+
+// AsyncSnapshot is the latest state of an asynchronous source, as passed to
+// the builder of a [StreamBuilder] or [FutureBuilder].
+type AsyncSnapshot[T any] struct {
+	// State is the connection state of the source.
+	State ConnectionState
+
+	// Data is the most recent value, valid when HasData is true. It is kept
+	// after an error or once the source is done.
+	Data T
+
+	// HasData reports whether the source has produced a value.
+	HasData bool
+
+	// Err is the most recent error, or nil. A later value clears it.
+	Err error
+}
+
+// HasError reports whether the snapshot holds an error.
+func (s AsyncSnapshot[T]) HasError() bool {
+	return s.Err != nil
+}
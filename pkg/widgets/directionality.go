@@ -0,0 +1,147 @@
+package widgets
+
+import (
+	"reflect"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/layout"
+	"github.com/go-drift/drift/pkg/platform"
+)
+
+// Directionality provides the reading direction to descendants. Widgets
+// that lay out content from the start edge, such as [Row], [Text],
+// [Padding] with Directional insets and [Align] with a Directional
+// alignment, read it with [DirectionalityOf] and mirror horizontally when
+// it is [graphics.TextDirectionRTL].
+//
+// The engine inserts one above the app, kept in sync with the system
+// locale by [DirectionalityProvider]. Insert another to force a direction
+// for a subtree, for example to keep a phone number or media controls
+// left-to-right in an Arabic UI:
+//
+//	widgets.Directionality{
+//	    TextDirection: graphics.TextDirectionLTR,
+//	    Child:         controls,
+//	}
+type Directionality struct {
+	core.InheritedBase
+	TextDirection graphics.TextDirection
+	Child         core.Widget
+}
+
+func (d Directionality) ChildWidget() core.Widget { return d.Child }
+
+func (d Directionality) ShouldRebuildDependents(oldWidget core.InheritedWidget) bool {
+	if old, ok := oldWidget.(Directionality); ok {
+		return d.TextDirection != old.TextDirection
+	}
+	return true
+}
+
+var directionalityType = reflect.TypeFor[Directionality]()
+
+// DirectionalityOf returns the reading direction from the nearest
+// [Directionality], or [graphics.TextDirectionLTR] if there is none.
+// Widgets calling this rebuild when the direction changes.
+func DirectionalityOf(ctx core.BuildContext) graphics.TextDirection {
+	if d, ok := ctx.DependOnInherited(directionalityType, nil).(Directionality); ok {
+		return d.TextDirection
+	}
+	return graphics.TextDirectionLTR
+}
+
+// TextDirectionForLocale returns the reading direction of locale's script.
+func TextDirectionForLocale(locale platform.Locale) graphics.TextDirection {
+	if locale.IsRTL() {
+		return graphics.TextDirectionRTL
+	}
+	return graphics.TextDirectionLTR
+}
+
+// DirectionalityProvider is a StatefulWidget that provides a
+// [Directionality] derived from the user's preferred locale in
+// [platform.Locales], rebuilding when the system language changes.
+type DirectionalityProvider struct {
+	core.StatefulBase
+
+	Child core.Widget
+}
+
+func (d DirectionalityProvider) CreateState() core.State {
+	return &directionalityProviderState{}
+}
+
+type directionalityProviderState struct {
+	core.StateBase
+	direction graphics.TextDirection
+}
+
+func (s *directionalityProviderState) InitState() {
+	s.direction = TextDirectionForLocale(platform.Locales.Current())
+	s.OnDispose(platform.Locales.AddHandler(func(locales []platform.Locale) {
+		direction := TextDirectionForLocale(locales[0])
+		platform.Dispatch(func() {
+			if direction != s.direction {
+				s.SetState(func() { s.direction = direction })
+			}
+		})
+	}))
+}
+
+func (s *directionalityProviderState) Build(ctx core.BuildContext) core.Widget {
+	w := s.Element().Widget().(DirectionalityProvider)
+	return Directionality{
+		TextDirection: s.direction,
+		Child:         w.Child,
+	}
+}
+
+// mirrorBox flips its child horizontally about its center when mirror is
+// set, for glyphs such as arrows that point the other way in RTL.
+type mirrorBox struct {
+	core.RenderObjectBase
+	mirror bool
+	child  core.Widget
+}
+
+func (m mirrorBox) ChildWidget() core.Widget {
+	return m.child
+}
+
+func (m mirrorBox) CreateRenderObject(ctx core.BuildContext) layout.RenderObject {
+	box := &renderMirrorBox{mirror: m.mirror}
+	box.SetSelf(box)
+	return box
+}
+
+func (m mirrorBox) UpdateRenderObject(ctx core.BuildContext, renderObject layout.RenderObject) {
+	if box, ok := renderObject.(*renderMirrorBox); ok && box.mirror != m.mirror {
+		box.mirror = m.mirror
+		box.MarkNeedsPaint()
+	}
+}
+
+type renderMirrorBox struct {
+	renderPassthrough
+	mirror bool
+}
+
+func (r *renderMirrorBox) Paint(ctx *layout.PaintContext) {
+	if !r.mirror || r.child == nil {
+		r.renderPassthrough.Paint(ctx)
+		return
+	}
+	ctx.Canvas.Save()
+	ctx.Canvas.Translate(r.Size().Width, 0)
+	ctx.Canvas.Scale(-1, 1)
+	ctx.PaintChildWithLayer(r.child.(layout.RenderBox), graphics.Offset{})
+	ctx.Canvas.Restore()
+}
+
+func (r *renderMirrorBox) HitTest(position graphics.Offset, result *layout.HitTestResult) bool {
+	if r.mirror {
+		position.X = r.Size().Width - position.X
+	}
+	return r.renderPassthrough.HitTest(position, result)
+}
@@ -0,0 +1,150 @@
+package widgets_test
+
+import (
+	"testing"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/layout"
+	"github.com/go-drift/drift/pkg/platform"
+	drifttest "github.com/go-drift/drift/pkg/testing"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+// sizedBoxOffsets returns the parent offsets of every SizedBox, in tree
+// order.
+func sizedBoxOffsets(t *testing.T, tester *drifttest.WidgetTester) []graphics.Offset {
+	t.Helper()
+	var offsets []graphics.Offset
+	for _, e := range tester.Find(drifttest.ByType[widgets.SizedBox]()).All() {
+		ro := e.(interface{ RenderObject() layout.RenderObject }).RenderObject()
+		pd, ok := ro.ParentData().(*layout.BoxParentData)
+		if !ok {
+			t.Fatal("expected BoxParentData on SizedBox")
+		}
+		offsets = append(offsets, pd.Offset)
+	}
+	return offsets
+}
+
+func rtl(child core.Widget) core.Widget {
+	return widgets.Directionality{TextDirection: graphics.TextDirectionRTL, Child: child}
+}
+
+func TestDirectionality_RowRTL(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	tester.SetSize(graphics.Size{Width: 200, Height: 100})
+
+	tester.PumpWidget(rtl(widgets.Row{
+		Children: []core.Widget{
+			widgets.SizedBox{Width: 30, Height: 10},
+			widgets.SizedBox{Width: 50, Height: 10},
+		},
+	}))
+
+	offsets := sizedBoxOffsets(t, tester)
+	if offsets[0].X != 170 || offsets[1].X != 120 {
+		t.Errorf("expected children laid out from the right at 170 and 120, got %v and %v", offsets[0].X, offsets[1].X)
+	}
+}
+
+func TestDirectionality_ColumnRTLCrossAxis(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	tester.SetSize(graphics.Size{Width: 200, Height: 100})
+
+	tester.PumpWidget(rtl(widgets.Column{
+		CrossAxisAlignment: widgets.CrossAxisAlignmentStart,
+		Children: []core.Widget{
+			widgets.SizedBox{Width: 200, Height: 10},
+			widgets.SizedBox{Width: 40, Height: 10},
+		},
+	}))
+
+	if got := sizedBoxOffsets(t, tester)[1].X; got != 160 {
+		t.Errorf("expected start-aligned child against the right edge at 160, got %v", got)
+	}
+}
+
+func TestDirectionality_PaddingDirectional(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	tester.SetSize(graphics.Size{Width: 200, Height: 200})
+
+	tester.PumpWidget(widgets.Center{
+		Child: rtl(widgets.Padding{
+			Padding:     layout.EdgeInsetsOnly(0, 4, 0, 0),
+			Directional: layout.EdgeInsetsDirectionalOnly(16, 0, 8, 0),
+			Child:       widgets.SizedBox{Width: 50, Height: 50},
+		}),
+	})
+
+	if got := sizedBoxOffsets(t, tester)[0]; got != (graphics.Offset{X: 8, Y: 4}) {
+		t.Errorf("expected the end inset on the left in RTL, got %v", got)
+	}
+	size := tester.Find(drifttest.ByType[widgets.Padding]()).RenderObject().Size()
+	if size.Width != 74 {
+		t.Errorf("expected width 50+16+8, got %v", size.Width)
+	}
+}
+
+func TestDirectionality_AlignDirectional(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	tester.SetSize(graphics.Size{Width: 200, Height: 100})
+
+	align := widgets.Align{
+		Directional: &layout.AlignmentTopStart,
+		Child:       widgets.SizedBox{Width: 20, Height: 20},
+	}
+	tester.PumpWidget(align)
+	if got := sizedBoxOffsets(t, tester)[0].X; got != 0 {
+		t.Errorf("expected top start on the left in LTR, got %v", got)
+	}
+
+	tester.PumpWidget(rtl(align))
+	if got := sizedBoxOffsets(t, tester)[0].X; got != 180 {
+		t.Errorf("expected top start on the right in RTL, got %v", got)
+	}
+}
+
+func TestDirectionality_PositionedStart(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	tester.SetSize(graphics.Size{Width: 200, Height: 100})
+
+	tester.PumpWidget(widgets.Center{
+		Child: rtl(widgets.Stack{
+			Children: []core.Widget{
+				widgets.SizedBox{Width: 200, Height: 100},
+				widgets.Positioned(widgets.SizedBox{Width: 20, Height: 20}).Start(8).Top(8),
+			},
+		}),
+	})
+
+	// The SizedBox's parent is the positioned wrapper placed by the stack.
+	box := tester.Find(drifttest.ByType[widgets.SizedBox]()).At(1)
+	ro := box.(interface{ RenderObject() layout.RenderObject }).RenderObject()
+	pos := ro.(interface{ Parent() layout.RenderObject }).Parent()
+	x := pos.ParentData().(*layout.BoxParentData).Offset.X
+	if x != 172 {
+		t.Errorf("expected start 8 measured from the right edge, got x=%v", x)
+	}
+}
+
+func TestDirectionalityProvider_FollowsLocale(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	t.Cleanup(platform.ResetForTest)
+
+	var direction graphics.TextDirection
+	tester.PumpWidget(widgets.DirectionalityProvider{
+		Child: mediaQueryProbe{read: func(ctx core.BuildContext) {
+			direction = widgets.DirectionalityOf(ctx)
+		}},
+	})
+	if direction != graphics.TextDirectionLTR {
+		t.Fatalf("expected LTR for the default locale, got %v", direction)
+	}
+
+	platform.Locales.SetLocalesForTest(platform.ParseLocale("he-IL"))
+	tester.Pump()
+	if direction != graphics.TextDirectionRTL {
+		t.Errorf("expected RTL after switching to Hebrew, got %v", direction)
+	}
+}
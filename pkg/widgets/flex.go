@@ -36,9 +36,11 @@ func (a Axis) String() string {
 type MainAxisAlignment int
 
 const (
-	// MainAxisAlignmentStart places children at the start (left for Row, top for Column).
+	// MainAxisAlignmentStart places children at the start (left for Row, or
+	// right in RTL; top for Column).
 	MainAxisAlignmentStart MainAxisAlignment = iota
-	// MainAxisAlignmentEnd places children at the end (right for Row, bottom for Column).
+	// MainAxisAlignmentEnd places children at the end (right for Row, or left
+	// in RTL; bottom for Column).
 	MainAxisAlignmentEnd
 	// MainAxisAlignmentCenter centers children along the main axis.
 	MainAxisAlignmentCenter
@@ -78,9 +80,11 @@ func (a MainAxisAlignment) String() string {
 type CrossAxisAlignment int
 
 const (
-	// CrossAxisAlignmentStart places children at the start of the cross axis.
+	// CrossAxisAlignmentStart places children at the start of the cross axis
+	// (top for Row; left for Column, or right in RTL).
 	CrossAxisAlignmentStart CrossAxisAlignment = iota
-	// CrossAxisAlignmentEnd places children at the end of the cross axis.
+	// CrossAxisAlignmentEnd places children at the end of the cross axis
+	// (bottom for Row; right for Column, or left in RTL).
 	CrossAxisAlignmentEnd
 	// CrossAxisAlignmentCenter centers children along the cross axis.
 	CrossAxisAlignmentCenter
@@ -173,7 +177,9 @@ type FlexFitProvider interface {
 // Row lays out children horizontally from left to right.
 //
 // Row is a flex container where the main axis is horizontal. Children are
-// laid out in a single horizontal run and do not wrap.
+// laid out in a single horizontal run and do not wrap. When the ambient
+// [Directionality] is right-to-left, the first child is on the right and
+// MainAxisAlignmentStart packs children against the right edge.
 //
 // # Sizing Behavior
 //
@@ -217,6 +223,7 @@ func (r Row) CreateRenderObject(ctx core.BuildContext) layout.RenderObject {
 		alignment:      r.MainAxisAlignment,
 		crossAlignment: r.CrossAxisAlignment,
 		axisSize:       r.MainAxisSize,
+		textDirection:  DirectionalityOf(ctx),
 	}
 	flex.SetSelf(flex)
 	return flex
@@ -228,6 +235,7 @@ func (r Row) UpdateRenderObject(ctx core.BuildContext, renderObject layout.Rende
 		flex.alignment = r.MainAxisAlignment
 		flex.crossAlignment = r.CrossAxisAlignment
 		flex.axisSize = r.MainAxisSize
+		flex.textDirection = DirectionalityOf(ctx)
 		flex.MarkNeedsLayout()
 		flex.MarkNeedsPaint()
 	}
@@ -261,6 +269,9 @@ func (r Row) UpdateRenderObject(ctx core.BuildContext, renderObject layout.Rende
 //	    },
 //	}
 //
+// In a right-to-left [Directionality], CrossAxisAlignmentStart and
+// CrossAxisAlignmentEnd align children to the right and left edges.
+//
 // For horizontal layout, use [Row].
 type Column struct {
 	core.RenderObjectBase
@@ -280,6 +291,7 @@ func (c Column) CreateRenderObject(ctx core.BuildContext) layout.RenderObject {
 		alignment:      c.MainAxisAlignment,
 		crossAlignment: c.CrossAxisAlignment,
 		axisSize:       c.MainAxisSize,
+		textDirection:  DirectionalityOf(ctx),
 	}
 	flex.SetSelf(flex)
 	return flex
@@ -291,6 +303,7 @@ func (c Column) UpdateRenderObject(ctx core.BuildContext, renderObject layout.Re
 		flex.alignment = c.MainAxisAlignment
 		flex.crossAlignment = c.CrossAxisAlignment
 		flex.axisSize = c.MainAxisSize
+		flex.textDirection = DirectionalityOf(ctx)
 		flex.MarkNeedsLayout()
 		flex.MarkNeedsPaint()
 	}
@@ -303,6 +316,7 @@ type renderFlex struct {
 	alignment      MainAxisAlignment
 	crossAlignment CrossAxisAlignment
 	axisSize       MainAxisSize
+	textDirection  graphics.TextDirection
 }
 
 func (r *renderFlex) SetChildren(children []layout.RenderObject) {
@@ -461,11 +475,23 @@ func (r *renderFlex) PerformLayout() {
 	freeSpace := math.Max(0, r.mainAxis(size)-mainSize)
 	spacing, startOffset := r.computeSpacing(freeSpace)
 
+	// In RTL the horizontal axis runs from the right edge, so mirror the
+	// main offset of a Row and the cross offset of a Column.
+	rtl := r.textDirection == graphics.TextDirectionRTL
 	cursor := startOffset
 	for _, child := range r.children {
-		crossOffset := r.crossAxisOffset(child.Size())
-		child.SetParentData(&layout.BoxParentData{Offset: r.makeOffset(cursor, crossOffset)})
-		cursor += r.mainAxis(child.Size()) + spacing
+		childSize := child.Size()
+		mainOffset := cursor
+		crossOffset := r.crossAxisOffset(childSize)
+		if rtl {
+			if r.direction == AxisHorizontal {
+				mainOffset = size.Width - cursor - childSize.Width
+			} else {
+				crossOffset = size.Width - crossOffset - childSize.Width
+			}
+		}
+		child.SetParentData(&layout.BoxParentData{Offset: r.makeOffset(mainOffset, crossOffset)})
+		cursor += r.mainAxis(childSize) + spacing
 	}
 }
 
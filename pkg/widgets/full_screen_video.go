@@ -0,0 +1,88 @@
+package widgets
+
+import (
+	"math"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/layout"
+	"github.com/go-drift/drift/pkg/platform"
+)
+
+// FullScreenVideo fills its space with a video on a black background,
+// rotates the window to landscape, hides the system bars, and keeps the
+// screen awake. Show it as the page of its own route; popping the route
+// restores the previous orientation, system bars, and idle timer through
+// [ScreenSettings]. navigation.EnterFullscreen pushes such a route.
+//
+//	nav.Push(navigation.NewAnimatedPageRoute(func(ctx core.BuildContext) core.Widget {
+//	    return widgets.FullScreenVideo{Controller: s.video, OnExit: func() { nav.Pop(nil) }}
+//	}, navigation.RouteSettings{Name: "/player"}))
+//
+// If the controller is also shown by a [VideoPlayer] on the page beneath,
+// the video moves here without interrupting playback and moves back when
+// this widget is removed.
+type FullScreenVideo struct {
+	core.StatelessBase
+
+	// Controller provides the native video player surface and playback control.
+	Controller *platform.VideoPlayerController
+
+	// Orientation is the orientation lock while the video is shown. The zero
+	// value [platform.OrientationUnlocked] uses [platform.OrientationLandscape].
+	Orientation platform.OrientationLock
+
+	// HideControls hides the native transport controls.
+	HideControls bool
+
+	// Controls is drawn over the video and fills it. Combine it with
+	// HideControls for custom playback controls.
+	Controls core.Widget
+
+	// OnExit, if set, shows a close button in the top-left corner that
+	// calls it.
+	OnExit func()
+}
+
+// Build builds the full-screen video.
+func (v FullScreenVideo) Build(ctx core.BuildContext) core.Widget {
+	orientation := v.Orientation
+	if orientation == platform.OrientationUnlocked {
+		orientation = platform.OrientationLandscape
+	}
+
+	children := []core.Widget{
+		Container{
+			Color:     graphics.ColorBlack,
+			Alignment: layout.AlignmentCenter,
+			Child: VideoPlayer{
+				Controller: v.Controller,
+				// The player clamps to the space available.
+				Width:        math.Inf(1),
+				Height:       math.Inf(1),
+				HideControls: v.HideControls,
+			},
+		},
+	}
+	if v.Controls != nil {
+		children = append(children, v.Controls)
+	}
+	if v.OnExit != nil {
+		insets := SafeAreaOf(ctx)
+		children = append(children, Positioned(Tappable("Exit full screen", v.OnExit, Container{
+			Width:        40,
+			Height:       40,
+			Color:        graphics.ColorBlack.WithAlpha(0.5),
+			BorderRadius: 20,
+			Alignment:    layout.AlignmentCenter,
+			Child:        Icon{Glyph: "✕", Size: 20, Color: graphics.ColorWhite},
+		})).Top(insets.Top+12).Left(insets.Left+12))
+	}
+
+	return ScreenSettings{
+		KeepAwake:   true,
+		Immersive:   true,
+		Orientation: orientation,
+		Child:       Stack{Fit: StackFitExpand, Children: children},
+	}
+}
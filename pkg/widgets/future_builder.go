@@ -0,0 +1,167 @@
+package widgets
+
+import (
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/platform"
+)
+
+// Future is the eventual result of an asynchronous computation, observed by
+// a [FutureBuilder]. A Future completes once; its result never changes.
+type Future[T any] struct {
+	done  chan struct{}
+	value T
+	err   error
+}
+
+// NewFuture runs fn on a new goroutine and returns a [Future] for its result.
+//
+//	s.profile = widgets.NewFuture(func() (*Profile, error) {
+//	    return api.FetchProfile(ctx, userID)
+//	})
+func NewFuture[T any](fn func() (T, error)) *Future[T] {
+	f := &Future[T]{done: make(chan struct{})}
+	go func() {
+		defer close(f.done)
+		f.value, f.err = fn()
+	}()
+	return f
+}
+
+// CompletedFuture returns a [Future] that has already completed with value
+// and err, such as a cached result.
+func CompletedFuture[T any](value T, err error) *Future[T] {
+	f := &Future[T]{done: make(chan struct{}), value: value, err: err}
+	close(f.done)
+	return f
+}
+
+// Done returns a channel that is closed when the future completes.
+func (f *Future[T]) Done() <-chan struct{} {
+	return f.done
+}
+
+// Result blocks until the future completes and returns its result. Do not
+// call it on the UI thread before [Future.Done] is closed.
+func (f *Future[T]) Result() (T, error) {
+	<-f.done
+	return f.value, f.err
+}
+
+// completed reports whether the future has completed.
+func (f *Future[T]) completed() bool {
+	select {
+	case <-f.done:
+		return true
+	default:
+		return false
+	}
+}
+
+// FutureBuilder builds itself from the latest state of a [Future], passing an
+// [AsyncSnapshot] to Builder: [ConnectionWaiting] while the computation runs,
+// then [ConnectionDone] with its data or error. Completion is applied on the
+// UI thread through [platform.Dispatch], so the computation may run on any
+// goroutine.
+//
+// Example:
+//
+//	func (s *profileState) InitState() {
+//	    s.profile = widgets.NewFuture(loadProfile)
+//	}
+//
+//	func (s *profileState) Build(ctx core.BuildContext) core.Widget {
+//	    return widgets.FutureBuilder[*Profile]{
+//	        Future: s.profile,
+//	        Builder: func(ctx core.BuildContext, snap widgets.AsyncSnapshot[*Profile]) core.Widget {
+//	            switch {
+//	            case snap.State != widgets.ConnectionDone:
+//	                return widgets.CircularProgressIndicator{}
+//	            case snap.HasError():
+//	                return widgets.Text{Content: "Error: " + snap.Err.Error()}
+//	            }
+//	            return profileCard(snap.Data)
+//	        },
+//	    }
+//	}
+//
+// Create the future once, such as in InitState, rather than in a parent's
+// Build: a new future on every build restarts the computation each time.
+// When Future changes, the snapshot keeps the previous data and returns to
+// [ConnectionWaiting]; the result of the old future is ignored.
+type FutureBuilder[T any] struct {
+	core.StatefulBase
+
+	// Future is the computation to observe. A nil future gives a snapshot in
+	// [ConnectionNone].
+	Future *Future[T]
+
+	// Builder builds the widget for the latest snapshot. Required.
+	Builder func(ctx core.BuildContext, snapshot AsyncSnapshot[T]) core.Widget
+}
+
+func (FutureBuilder[T]) CreateState() core.State {
+	return &futureBuilderState[T]{}
+}
+
+type futureBuilderState[T any] struct {
+	core.StateBase
+	snapshot   AsyncSnapshot[T]
+	generation int
+}
+
+func (s *futureBuilderState[T]) widget() FutureBuilder[T] {
+	return s.Element().Widget().(FutureBuilder[T])
+}
+
+func (s *futureBuilderState[T]) InitState() {
+	if s.widget().Builder == nil {
+		panic("FutureBuilder: Builder must not be nil")
+	}
+	s.observe(s.widget().Future)
+}
+
+func (s *futureBuilderState[T]) DidUpdateWidget(old core.StatefulWidget) {
+	if s.widget().Builder == nil {
+		panic("FutureBuilder: Builder must not be nil")
+	}
+	if old.(FutureBuilder[T]).Future != s.widget().Future {
+		s.generation++
+		s.observe(s.widget().Future)
+	}
+}
+
+// observe waits for future to complete and applies its result on the UI
+// thread. A future that has already completed is applied immediately.
+func (s *futureBuilderState[T]) observe(future *Future[T]) {
+	if future == nil {
+		s.snapshot.State = ConnectionNone
+		return
+	}
+	if future.completed() {
+		s.complete(future)
+		return
+	}
+	s.snapshot.State = ConnectionWaiting
+	generation := s.generation
+	go func() {
+		<-future.done
+		platform.Dispatch(func() {
+			if generation != s.generation || s.IsDisposed() {
+				return
+			}
+			s.SetState(func() { s.complete(future) })
+		})
+	}()
+}
+
+func (s *futureBuilderState[T]) complete(future *Future[T]) {
+	s.snapshot.State = ConnectionDone
+	s.snapshot.Err = future.err
+	if future.err == nil {
+		s.snapshot.Data, s.snapshot.HasData = future.value, true
+	}
+}
+
+func (s *futureBuilderState[T]) Build(ctx core.BuildContext) core.Widget {
+	return s.widget().Builder(ctx, s.snapshot)
+}
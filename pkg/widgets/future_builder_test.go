@@ -0,0 +1,68 @@
+package widgets_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-drift/drift/pkg/core"
+	drifttest "github.com/go-drift/drift/pkg/testing"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+func TestFutureBuilder_Completes(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	release := make(chan struct{})
+	future := widgets.NewFuture(func() (int, error) {
+		<-release
+		return 7, nil
+	})
+	tester.PumpWidget(widgets.FutureBuilder[int]{Future: future, Builder: snapshotText})
+	if !tester.Find(drifttest.ByText("waiting")).Exists() {
+		t.Fatal("expected a waiting snapshot while the future runs")
+	}
+
+	close(release)
+	if err := tester.PumpUntil(func() bool { return tester.Find(drifttest.ByText("done 7")).Exists() }, 2*time.Second); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestFutureBuilder_ErrorAndSwap(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	current := core.NewValueNotifier(widgets.CompletedFuture(3, nil))
+	tester.PumpWidget(core.ValueListenableBuilder[*widgets.Future[int]]{
+		ValueListenable: current,
+		Builder: func(ctx core.BuildContext, f *widgets.Future[int], _ core.Widget) core.Widget {
+			return widgets.FutureBuilder[int]{Future: f, Builder: snapshotText}
+		},
+	})
+	if !tester.Find(drifttest.ByText("done 3")).Exists() {
+		t.Fatal("expected a completed future to build done without waiting")
+	}
+
+	// A stale future's result is ignored once the widget moves on.
+	release := make(chan struct{})
+	stale := widgets.NewFuture(func() (int, error) {
+		<-release
+		return 5, nil
+	})
+	current.Set(stale)
+	tester.Pump()
+	if !tester.Find(drifttest.ByText("waiting 3")).Exists() {
+		t.Error("expected the new future to start waiting with the last data")
+	}
+	current.Set(widgets.NewFuture(func() (int, error) { return 0, errors.New("failed") }))
+	tester.Pump()
+	close(release)
+	stale.Result()
+	if err := tester.PumpUntil(func() bool { return tester.Find(drifttest.ByText("done 3 failed")).Exists() }, 2*time.Second); err != nil {
+		t.Fatal(err)
+	}
+
+	current.Set(nil)
+	tester.Pump()
+	if !tester.Find(drifttest.ByText("none 3 failed")).Exists() {
+		t.Error("expected a nil future to give ConnectionNone")
+	}
+}
@@ -1,6 +1,9 @@
 package widgets
 
 import (
+	"time"
+
+	"github.com/go-drift/drift/pkg/animation"
 	"github.com/go-drift/drift/pkg/core"
 	"github.com/go-drift/drift/pkg/gestures"
 	"github.com/go-drift/drift/pkg/graphics"
@@ -11,6 +14,7 @@ import (
 //
 // GestureDetector supports multiple gesture types that can be used together:
 //   - Tap: Simple tap/click detection via OnTap
+//   - Long press: A pointer held still via OnLongPress
 //   - Pan: Free-form drag in any direction via OnPanStart/Update/End
 //   - Horizontal drag: Constrained horizontal drag via OnHorizontalDrag*
 //   - Vertical drag: Constrained vertical drag via OnVerticalDrag*
@@ -37,6 +41,7 @@ type GestureDetector struct {
 	core.RenderObjectBase
 	Child       core.Widget
 	OnTap       func()
+	OnLongPress func(LongPressDetails)
 	OnPanStart  func(DragStartDetails)
 	OnPanUpdate func(DragUpdateDetails)
 	OnPanEnd    func(DragEndDetails)
@@ -75,6 +80,7 @@ type renderGestureDetector struct {
 	layout.RenderBoxBase
 	child          layout.RenderBox
 	tap            *gestures.TapGestureRecognizer
+	longPress      *gestures.LongPressGestureRecognizer
 	pan            *gestures.PanGestureRecognizer
 	horizontalDrag *gestures.HorizontalDragGestureRecognizer
 	verticalDrag   *gestures.VerticalDragGestureRecognizer
@@ -129,6 +135,13 @@ func (r *renderGestureDetector) HandlePointer(event gestures.PointerEvent) {
 			r.tap.HandleEvent(event)
 		}
 	}
+	if r.longPress != nil {
+		if isDown {
+			r.longPress.AddPointer(event)
+		} else {
+			r.longPress.HandleEvent(event)
+		}
+	}
 	if r.pan != nil {
 		if isDown {
 			r.pan.AddPointer(event)
@@ -152,8 +165,17 @@ func (r *renderGestureDetector) HandlePointer(event gestures.PointerEvent) {
 	}
 }
 
+// Dispose stops a pending long press.
+func (r *renderGestureDetector) Dispose() {
+	if r.longPress != nil {
+		r.longPress.Dispose()
+	}
+	r.RenderBoxBase.Dispose()
+}
+
 func (r *renderGestureDetector) configure(g GestureDetector) {
 	r.configureTap(g)
+	r.configureLongPress(g)
 	r.configurePan(g)
 	r.configureHorizontalDrag(g)
 	r.configureVerticalDrag(g)
@@ -173,6 +195,34 @@ func (r *renderGestureDetector) configureTap(g GestureDetector) {
 	r.tap.OnTap = g.OnTap
 }
 
+func (r *renderGestureDetector) configureLongPress(g GestureDetector) {
+	if g.OnLongPress == nil {
+		if r.longPress != nil {
+			r.longPress.Dispose()
+			r.longPress = nil
+		}
+		return
+	}
+	if r.longPress == nil {
+		r.longPress = gestures.NewLongPressGestureRecognizer(gestures.DefaultArena, frameTimer)
+	}
+	r.longPress.OnLongPress = g.OnLongPress
+}
+
+// frameTimer is a [gestures.Timer] driven by a ticker, so it fires on the UI
+// thread and follows the animation clock in tests.
+func frameTimer(d time.Duration, fire func()) func() {
+	var ticker *animation.Ticker
+	ticker = animation.NewTicker(func(elapsed time.Duration) {
+		if elapsed >= d {
+			ticker.Stop()
+			fire()
+		}
+	})
+	ticker.Start()
+	return ticker.Stop
+}
+
 func (r *renderGestureDetector) configurePan(g GestureDetector) {
 	hasPanHandler := g.OnPanStart != nil || g.OnPanUpdate != nil || g.OnPanEnd != nil || g.OnPanCancel != nil
 	// Don't use pan when axis-specific handlers are present (they would conflict)
@@ -238,6 +288,9 @@ func (r *renderGestureDetector) configureVerticalDrag(g GestureDetector) {
 	r.verticalDrag.OnCancel = g.OnVerticalDragCancel
 }
 
+// LongPressDetails describes a long press.
+type LongPressDetails = gestures.LongPressDetails
+
 // DragStartDetails describes the start of a drag.
 type DragStartDetails = gestures.DragStartDetails
 
@@ -35,6 +35,12 @@ import (
 //
 // Icon renders the glyph as a Text widget with MaxLines: 1 and the specified
 // size and color. Use Weight to control font weight if needed.
+//
+// # Right-to-Left
+//
+// Glyphs that point along the reading direction, such as back arrows and
+// chevrons, should set MatchTextDirection so they are mirrored when the
+// ambient [Directionality] is RTL.
 type Icon struct {
 	core.StatelessBase
 
@@ -46,6 +52,8 @@ type Icon struct {
 	Color graphics.Color
 	// Weight sets the font weight if non-zero.
 	Weight graphics.FontWeight
+	// MatchTextDirection mirrors the glyph horizontally in RTL.
+	MatchTextDirection bool
 }
 
 func (i Icon) Build(ctx core.BuildContext) core.Widget {
@@ -56,9 +64,16 @@ func (i Icon) Build(ctx core.BuildContext) core.Widget {
 		FontWeight: i.Weight,
 	}
 
-	return Text{
+	text := Text{
 		Content:  i.Glyph,
 		Style:    style,
 		MaxLines: 1,
 	}
+	if !i.MatchTextDirection {
+		return text
+	}
+	return mirrorBox{
+		mirror: DirectionalityOf(ctx) == graphics.TextDirectionRTL,
+		child:  text,
+	}
 }
@@ -227,7 +227,7 @@ func (r *renderImage) Paint(ctx *layout.PaintContext) {
 
 	ctx.Canvas.Save()
 	ctx.Canvas.ClipRect(graphics.RectFromLTWH(0, 0, size.Width, size.Height))
-	ctx.Canvas.DrawImageRect(r.cachedRGBA, srcRect, dstRect, graphics.FilterQualityLow, r.cacheKey())
+	ctx.Canvas.DrawImageRect(r.cachedRGBA, srcRect, dstRect, graphics.FilterQualityDefault, r.cacheKey())
 	ctx.Canvas.Restore()
 }
 
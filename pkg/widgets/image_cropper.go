@@ -0,0 +1,473 @@
+package widgets
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"math"
+
+	"golang.org/x/image/draw"
+	"golang.org/x/image/math/f64"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/layout"
+)
+
+// Aspect ratio presets for [ImageCropper], as width over height.
+const (
+	// CropAspectOriginal frames the crop at the image's own aspect ratio.
+	CropAspectOriginal = 0.0
+	CropAspectSquare   = 1.0
+	CropAspect4x3      = 4.0 / 3.0
+	CropAspect3x4      = 3.0 / 4.0
+	CropAspect16x9     = 16.0 / 9.0
+	CropAspect9x16     = 9.0 / 16.0
+)
+
+// CropShape is the outline of an [ImageCropper]'s frame.
+type CropShape int
+
+const (
+	// CropShapeRect frames a rectangle.
+	CropShapeRect CropShape = iota
+	// CropShapeOval frames an oval, or a circle with [CropAspectSquare],
+	// for avatars. The cropped image is still rectangular; mask it when
+	// displaying it.
+	CropShapeOval
+)
+
+// CropFormat is the encoding of [ImageCropController.CropBytes].
+type CropFormat int
+
+const (
+	// CropFormatPNG encodes losslessly, keeping transparency.
+	CropFormatPNG CropFormat = iota
+	// CropFormatJPEG encodes lossily, for photos.
+	CropFormatJPEG
+)
+
+// CropOptions configures [ImageCropController.Crop].
+type CropOptions struct {
+	// MaxSize limits the longer side of the result in pixels. The crop is
+	// returned at the source's resolution when it fits. Zero means no limit.
+	MaxSize int
+	// Format is the encoding used by CropBytes.
+	Format CropFormat
+	// Quality is the JPEG quality from 1 to 100. Zero means 90.
+	Quality int
+}
+
+// ErrImageCropperDetached is returned when cropping with a controller whose
+// [ImageCropper] isn't mounted or hasn't been laid out.
+var ErrImageCropperDetached = errors.New("widgets: image crop controller is not attached to an ImageCropper")
+
+// ImageCropController rotates, resets, and crops the image of an
+// [ImageCropper]:
+//
+//	s.crop = widgets.NewImageCropController()
+//
+//	// In Build
+//	widgets.ImageCropper{Source: photo, Controller: s.crop, AspectRatio: widgets.CropAspectSquare}
+//
+//	// When the user taps Done
+//	data, err := s.crop.CropBytes(widgets.CropOptions{MaxSize: 512, Format: widgets.CropFormatJPEG})
+//
+// A controller should drive one ImageCropper at a time.
+type ImageCropController struct {
+	cropper *imageCropperState
+}
+
+// NewImageCropController creates a controller with no cropper attached.
+func NewImageCropController() *ImageCropController {
+	return &ImageCropController{}
+}
+
+// Rotate turns the image clockwise by radians about the frame's center,
+// zooming in if needed to keep the frame covered. Use math.Pi/2 for a
+// quarter turn.
+func (c *ImageCropController) Rotate(radians float64) {
+	if c.cropper != nil {
+		c.cropper.rotate(radians)
+	}
+}
+
+// Reset undoes panning, zooming, and rotation, fitting the image to the
+// frame.
+func (c *ImageCropController) Reset() {
+	if c.cropper != nil {
+		c.cropper.fit()
+	}
+}
+
+// Crop returns the part of the image inside the frame.
+func (c *ImageCropController) Crop(opts CropOptions) (image.Image, error) {
+	if c.cropper == nil || c.cropper.frame.IsEmpty() {
+		return nil, ErrImageCropperDetached
+	}
+	w := c.cropper.Element().Widget().(ImageCropper)
+	if w.Source == nil {
+		return nil, errors.New("widgets: ImageCropper has no Source")
+	}
+	return cropImage(w.Source, c.cropper.viewer.Value(), c.cropper.frame, opts.MaxSize), nil
+}
+
+// CropBytes returns the part of the image inside the frame, encoded as
+// opts.Format.
+func (c *ImageCropController) CropBytes(opts CropOptions) ([]byte, error) {
+	img, err := c.Crop(opts)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	switch opts.Format {
+	case CropFormatJPEG:
+		quality := opts.Quality
+		if quality == 0 {
+			quality = 90
+		}
+		err = jpeg.Encode(&buf, img, &jpeg.Options{Quality: quality})
+	default:
+		err = png.Encode(&buf, img)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// ImageCropper shows an image behind a crop frame. The user pans and
+// pinch-zooms the image under the frame, and rotates it with two fingers
+// when Rotate is set. The image always covers the frame: when the user lets
+// go, it is moved and zoomed back as needed.
+//
+//	widgets.ImageCropper{
+//	    Source:       photo,
+//	    Controller:   s.crop,
+//	    AspectRatio:  widgets.CropAspectSquare,
+//	    Shape:        widgets.CropShapeOval,
+//	    Padding:      24,
+//	    OverlayColor: graphics.RGBA(0, 0, 0, 0.6),
+//	    BorderColor:  graphics.ColorWhite,
+//	    BorderWidth:  2,
+//	}
+//
+// The cropper fills the space its parent allows, with the frame as large as
+// fits inside Padding. Crop with [ImageCropController.Crop] or
+// [ImageCropController.CropBytes], which work on the full-resolution source
+// rather than the pixels on screen.
+//
+// Use theme.ImageCropperOf for a cropper styled by the current theme.
+type ImageCropper struct {
+	core.StatefulBase
+	// Source is the image to crop.
+	Source image.Image
+	// Controller rotates, resets, and crops the image. Optional.
+	Controller *ImageCropController
+	// AspectRatio is the frame's width over its height. See the CropAspect
+	// presets. Zero uses the image's aspect ratio.
+	AspectRatio float64
+	// Shape is the frame's outline.
+	Shape CropShape
+	// MaxScale limits zooming, as a multiple of the zoom at which the image
+	// just covers the frame. Zero means no limit.
+	MaxScale float64
+	// Rotate enables two-finger rotation.
+	Rotate bool
+	// Padding is the space between the frame and the cropper's edges.
+	Padding float64
+	// OverlayColor dims the image outside the frame. Zero means no dimming.
+	OverlayColor graphics.Color
+	// BorderColor is the frame's outline color. Zero means no outline.
+	BorderColor graphics.Color
+	// BorderWidth is the frame's outline width.
+	BorderWidth float64
+	// SemanticLabel describes the image to screen readers.
+	SemanticLabel string
+}
+
+func (c ImageCropper) CreateState() core.State {
+	return &imageCropperState{}
+}
+
+type imageCropperState struct {
+	core.StateBase
+	viewer     *TransformationController
+	controller *ImageCropController
+	// viewport is the cropper's size and frame the crop frame within it, as
+	// of the last layout.
+	viewport graphics.Size
+	frame    graphics.Rect
+}
+
+func (s *imageCropperState) InitState() {
+	s.viewer = NewTransformationController()
+	core.UseDisposable(s, s.viewer)
+	s.attach(s.Element().Widget().(ImageCropper).Controller)
+}
+
+func (s *imageCropperState) DidUpdateWidget(oldWidget core.StatefulWidget) {
+	old := oldWidget.(ImageCropper)
+	w := s.Element().Widget().(ImageCropper)
+	if old.Controller != w.Controller {
+		s.attach(w.Controller)
+	}
+	if old.Source != w.Source || old.AspectRatio != w.AspectRatio || old.Padding != w.Padding {
+		// Refit on the next layout.
+		s.frame = graphics.Rect{}
+	}
+}
+
+func (s *imageCropperState) Dispose() {
+	s.attach(nil)
+	s.StateBase.Dispose()
+}
+
+func (s *imageCropperState) attach(controller *ImageCropController) {
+	if s.controller != nil && s.controller.cropper == s {
+		s.controller.cropper = nil
+	}
+	s.controller = controller
+	if controller != nil {
+		controller.cropper = s
+	}
+}
+
+func (s *imageCropperState) Build(ctx core.BuildContext) core.Widget {
+	w := s.Element().Widget().(ImageCropper)
+	if w.Source == nil {
+		return SizedBox{}
+	}
+	bounds := w.Source.Bounds()
+	return LayoutBuilder{Builder: func(ctx core.BuildContext, constraints layout.Constraints) core.Widget {
+		viewport := constraints.Constrain(graphics.Size{Width: constraints.MaxWidth, Height: constraints.MaxHeight})
+		if viewport != s.viewport || s.frame.IsEmpty() {
+			s.viewport = viewport
+			s.frame = cropFrame(viewport, w.Padding, w.aspectRatio())
+			s.fit()
+		}
+		maxScale := 0.0
+		if w.MaxScale > 0 {
+			maxScale = w.MaxScale * s.coverScale(0)
+		}
+		return Stack{
+			Fit: StackFitExpand,
+			Children: []core.Widget{
+				InteractiveViewer{
+					Controller:       s.viewer,
+					Unconstrained:    true,
+					MaxScale:         maxScale,
+					Rotate:           w.Rotate,
+					OnInteractionEnd: s.clamp,
+					Child: Image{
+						Source:               w.Source,
+						Width:                float64(bounds.Dx()),
+						Height:               float64(bounds.Dy()),
+						Fit:                  ImageFitFill,
+						SemanticLabel:        w.SemanticLabel,
+						ExcludeFromSemantics: w.SemanticLabel == "",
+					},
+				},
+				cropOverlay{
+					frame:        s.frame,
+					shape:        w.Shape,
+					overlayColor: w.OverlayColor,
+					borderColor:  w.BorderColor,
+					borderWidth:  w.BorderWidth,
+				},
+			},
+		}
+	}}
+}
+
+func (c ImageCropper) aspectRatio() float64 {
+	if c.AspectRatio > 0 {
+		return c.AspectRatio
+	}
+	if c.Source != nil {
+		if b := c.Source.Bounds(); b.Dy() > 0 {
+			return float64(b.Dx()) / float64(b.Dy())
+		}
+	}
+	return 1
+}
+
+// cropFrame returns the largest rectangle of the given aspect ratio that
+// fits in viewport inside padding, centered.
+func cropFrame(viewport graphics.Size, padding, aspect float64) graphics.Rect {
+	width := max(viewport.Width-2*padding, 0)
+	height := max(viewport.Height-2*padding, 0)
+	if width/aspect > height {
+		width = height * aspect
+	} else {
+		height = width / aspect
+	}
+	return graphics.RectFromLTWH((viewport.Width-width)/2, (viewport.Height-height)/2, width, height)
+}
+
+// frameExtent returns the size of the frame's bounding box in the image's
+// axes when the image is rotated by rotation.
+func (s *imageCropperState) frameExtent(rotation float64) (width, height float64) {
+	sin, cos := math.Sincos(rotation)
+	sin, cos = math.Abs(sin), math.Abs(cos)
+	w, h := s.frame.Width(), s.frame.Height()
+	return w*cos + h*sin, w*sin + h*cos
+}
+
+// coverScale returns the smallest scale at which the image, rotated by
+// rotation, covers the frame.
+func (s *imageCropperState) coverScale(rotation float64) float64 {
+	bounds := s.Element().Widget().(ImageCropper).Source.Bounds()
+	if bounds.Empty() {
+		return 1
+	}
+	width, height := s.frameExtent(rotation)
+	return max(width/float64(bounds.Dx()), height/float64(bounds.Dy()))
+}
+
+// fit centers the image in the frame at the smallest scale that covers it.
+func (s *imageCropperState) fit() {
+	if s.frame.IsEmpty() {
+		return
+	}
+	bounds := s.Element().Widget().(ImageCropper).Source.Bounds()
+	center := graphics.Offset{X: float64(bounds.Dx()) / 2, Y: float64(bounds.Dy()) / 2}
+	s.place(center, s.coverScale(0), 0)
+}
+
+// rotate turns the image about the frame's center.
+func (s *imageCropperState) rotate(radians float64) {
+	if s.frame.IsEmpty() {
+		return
+	}
+	t := s.viewer.Value()
+	s.place(t.ApplyInverse(s.frame.Center()), t.Scale, t.Rotation+radians)
+}
+
+// clamp moves and zooms the image as needed to cover the frame.
+func (s *imageCropperState) clamp() {
+	if s.frame.IsEmpty() {
+		return
+	}
+	t := s.viewer.Value()
+	s.place(t.ApplyInverse(s.frame.Center()), t.Scale, t.Rotation)
+}
+
+// place shows the image point center at the frame's center, at scale and
+// rotation, after adjusting them to keep the frame covered.
+func (s *imageCropperState) place(center graphics.Offset, scale, rotation float64) {
+	bounds := s.Element().Widget().(ImageCropper).Source.Bounds()
+	scale = max(scale, s.coverScale(rotation))
+	width, height := s.frameExtent(rotation)
+	halfWidth, halfHeight := width/scale/2, height/scale/2
+	center.X = min(max(center.X, halfWidth), float64(bounds.Dx())-halfWidth)
+	center.Y = min(max(center.Y, halfHeight), float64(bounds.Dy())-halfHeight)
+
+	t := ViewTransform{Scale: scale, Rotation: rotation}
+	offset := t.Apply(center)
+	frameCenter := s.frame.Center()
+	t.Translation = graphics.Offset{X: frameCenter.X - offset.X, Y: frameCenter.Y - offset.Y}
+	s.viewer.SetValue(t)
+}
+
+// cropImage renders the part of src under frame, where t maps src pixels
+// (relative to its bounds' origin) to the cropper.
+func cropImage(src image.Image, t ViewTransform, frame graphics.Rect, maxSize int) image.Image {
+	// One output pixel per source pixel, unless limited by maxSize.
+	width, height := frame.Width()/t.Scale, frame.Height()/t.Scale
+	if longer := max(width, height); maxSize > 0 && longer > float64(maxSize) {
+		width, height = width*float64(maxSize)/longer, height*float64(maxSize)/longer
+	}
+	dst := image.NewRGBA(image.Rect(0, 0, max(int(math.Round(width)), 1), max(int(math.Round(height)), 1)))
+
+	// Map source pixels to the cropper with t, then the frame to dst.
+	k := float64(dst.Bounds().Dx()) / frame.Width()
+	sin, cos := math.Sincos(t.Rotation)
+	origin := src.Bounds().Min
+	tx := k * (t.Translation.X - frame.Left)
+	ty := k * (t.Translation.Y - frame.Top)
+	a, b := k*t.Scale*cos, -k*t.Scale*sin
+	c, d := k*t.Scale*sin, k*t.Scale*cos
+	// Account for the source bounds not starting at the origin.
+	tx -= a*float64(origin.X) + b*float64(origin.Y)
+	ty -= c*float64(origin.X) + d*float64(origin.Y)
+	draw.CatmullRom.Transform(dst, f64.Aff3{a, b, tx, c, d, ty}, src, src.Bounds(), draw.Src, nil)
+	return dst
+}
+
+// cropOverlay dims the area outside the frame and outlines it. It ignores
+// pointers so they reach the image below.
+type cropOverlay struct {
+	core.RenderObjectBase
+	frame        graphics.Rect
+	shape        CropShape
+	overlayColor graphics.Color
+	borderColor  graphics.Color
+	borderWidth  float64
+}
+
+func (o cropOverlay) CreateRenderObject(ctx core.BuildContext) layout.RenderObject {
+	r := &renderCropOverlay{}
+	r.SetSelf(r)
+	o.UpdateRenderObject(ctx, r)
+	return r
+}
+
+func (o cropOverlay) UpdateRenderObject(ctx core.BuildContext, renderObject layout.RenderObject) {
+	if r, ok := renderObject.(*renderCropOverlay); ok {
+		r.frame = o.frame
+		r.shape = o.shape
+		r.overlayColor = o.overlayColor
+		r.borderColor = o.borderColor
+		r.borderWidth = o.borderWidth
+		r.MarkNeedsPaint()
+	}
+}
+
+type renderCropOverlay struct {
+	layout.RenderBoxBase
+	frame        graphics.Rect
+	shape        CropShape
+	overlayColor graphics.Color
+	borderColor  graphics.Color
+	borderWidth  float64
+}
+
+func (r *renderCropOverlay) PerformLayout() {
+	constraints := r.Constraints()
+	r.SetSize(constraints.Constrain(graphics.Size{Width: constraints.MaxWidth, Height: constraints.MaxHeight}))
+}
+
+func (r *renderCropOverlay) hole() graphics.RRect {
+	if r.shape == CropShapeOval {
+		radius := graphics.Radius{X: r.frame.Width() / 2, Y: r.frame.Height() / 2}
+		return graphics.RRect{Rect: r.frame, TopLeft: radius, TopRight: radius, BottomRight: radius, BottomLeft: radius}
+	}
+	return graphics.RRectFromRectAndRadius(r.frame, graphics.Radius{})
+}
+
+func (r *renderCropOverlay) Paint(ctx *layout.PaintContext) {
+	size := r.Size()
+	hole := r.hole()
+	if r.overlayColor != 0 {
+		path := graphics.NewPathWithFillRule(graphics.FillRuleEvenOdd)
+		path.AddRect(graphics.RectFromLTWH(0, 0, size.Width, size.Height))
+		path.AddRRect(hole)
+		paint := graphics.DefaultPaint()
+		paint.Color = r.overlayColor
+		ctx.Canvas.DrawPath(path, paint)
+	}
+	if r.borderColor != 0 && r.borderWidth > 0 {
+		paint := graphics.DefaultPaint()
+		paint.Color = r.borderColor
+		paint.Style = graphics.PaintStyleStroke
+		paint.StrokeWidth = r.borderWidth
+		ctx.Canvas.DrawRRect(hole, paint)
+	}
+}
+
+func (r *renderCropOverlay) HitTest(position graphics.Offset, result *layout.HitTestResult) bool {
+	return false
+}
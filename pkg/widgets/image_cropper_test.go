@@ -0,0 +1,141 @@
+package widgets_test
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"math"
+	"testing"
+
+	"github.com/go-drift/drift/pkg/graphics"
+	drifttest "github.com/go-drift/drift/pkg/testing"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+var (
+	cropRed  = color.RGBA{R: 255, A: 255}
+	cropBlue = color.RGBA{B: 255, A: 255}
+)
+
+// halves returns a 200x100 image, red on the left half and blue on the
+// right.
+func halves() image.Image {
+	img := image.NewRGBA(image.Rect(0, 0, 200, 100))
+	for y := range 100 {
+		for x := range 200 {
+			c := cropRed
+			if x >= 100 {
+				c = cropBlue
+			}
+			img.SetRGBA(x, y, c)
+		}
+	}
+	return img
+}
+
+func sameColor(got color.Color, want color.RGBA) bool {
+	r, g, b, a := got.RGBA()
+	wr, wg, wb, wa := want.RGBA()
+	near := func(x, y uint32) bool { return max(x, y)-min(x, y) < 0x0800 }
+	return near(r, wr) && near(g, wg) && near(b, wb) && near(a, wa)
+}
+
+func TestViewTransform_ApplyInverse(t *testing.T) {
+	transform := widgets.ViewTransform{Translation: graphics.Offset{X: 10, Y: -5}, Scale: 2.5, Rotation: 0.7}
+	p := graphics.Offset{X: 3, Y: 4}
+	back := transform.ApplyInverse(transform.Apply(p))
+	if math.Abs(back.X-p.X) > 1e-9 || math.Abs(back.Y-p.Y) > 1e-9 {
+		t.Errorf("ApplyInverse(Apply(%v)) = %v", p, back)
+	}
+}
+
+func TestInteractiveViewer_PanAndPinch(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	tester.SetSize(graphics.Size{Width: 400, Height: 400})
+	controller := widgets.NewTransformationController()
+	tester.PumpWidget(widgets.InteractiveViewer{
+		Controller: controller,
+		MaxScale:   3,
+		Child:      widgets.SizedBox{},
+	})
+
+	tester.DragFrom(graphics.Offset{X: 100, Y: 100}, graphics.Offset{X: 50, Y: 20})
+	if got := controller.Value().Translation; got != (graphics.Offset{X: 50, Y: 20}) {
+		t.Errorf("Translation after drag = %v, want (50, 20)", got)
+	}
+
+	// Pinch out about (200, 200) to four times the distance; MaxScale
+	// stops it at three, keeping the point under the fingers in place.
+	controller.Reset()
+	tester.SendPointerDown(graphics.Offset{X: 190, Y: 200}, 101)
+	tester.SendPointerDown(graphics.Offset{X: 210, Y: 200}, 102)
+	tester.SendPointerMove(graphics.Offset{X: 160, Y: 200}, 101)
+	tester.SendPointerMove(graphics.Offset{X: 240, Y: 200}, 102)
+	tester.SendPointerUp(graphics.Offset{X: 160, Y: 200}, 101)
+	tester.SendPointerUp(graphics.Offset{X: 240, Y: 200}, 102)
+
+	value := controller.Value()
+	if value.Scale != 3 {
+		t.Errorf("Scale = %v, want 3", value.Scale)
+	}
+	if focal := value.ApplyInverse(graphics.Offset{X: 200, Y: 200}); math.Abs(focal.X-200) > 1e-9 || math.Abs(focal.Y-200) > 1e-9 {
+		t.Errorf("the focal point moved to %v in the child, want (200, 200)", focal)
+	}
+}
+
+func TestImageCropper_CropsFramedRegion(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	tester.SetSize(graphics.Size{Width: 400, Height: 400})
+	controller := widgets.NewImageCropController()
+
+	if _, err := controller.Crop(widgets.CropOptions{}); err != widgets.ErrImageCropperDetached {
+		t.Fatalf("Crop before mounting: err = %v, want ErrImageCropperDetached", err)
+	}
+
+	tester.PumpWidget(widgets.ImageCropper{
+		Source:      halves(),
+		Controller:  controller,
+		AspectRatio: widgets.CropAspectSquare,
+	})
+
+	// The square frame fills the cropper and the image's height, showing
+	// its middle: x 50 to 150.
+	img, err := controller.Crop(widgets.CropOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b := img.Bounds(); b.Dx() != 100 || b.Dy() != 100 {
+		t.Fatalf("crop is %dx%d, want 100x100", b.Dx(), b.Dy())
+	}
+	if !sameColor(img.At(10, 50), cropRed) || !sameColor(img.At(90, 50), cropBlue) {
+		t.Errorf("expected red then blue across the crop, got %v and %v", img.At(10, 50), img.At(90, 50))
+	}
+
+	// Dragging right past the image's left edge is pulled back on release,
+	// leaving the red half in the frame.
+	tester.DragFrom(graphics.Offset{X: 100, Y: 200}, graphics.Offset{X: 300})
+	tester.Pump()
+	img, _ = controller.Crop(widgets.CropOptions{MaxSize: 20})
+	if b := img.Bounds(); b.Dx() != 20 || b.Dy() != 20 {
+		t.Fatalf("crop is %dx%d, want 20x20", b.Dx(), b.Dy())
+	}
+	if !sameColor(img.At(2, 10), cropRed) || !sameColor(img.At(17, 10), cropRed) {
+		t.Errorf("expected the red half, got %v and %v", img.At(2, 10), img.At(17, 10))
+	}
+
+	// A quarter turn clockwise puts the red half on top.
+	controller.Reset()
+	controller.Rotate(math.Pi / 2)
+	data, err := controller.CropBytes(widgets.CropOptions{Format: widgets.CropFormatPNG})
+	if err != nil {
+		t.Fatal(err)
+	}
+	img, err = png.Decode(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !sameColor(img.At(50, 10), cropRed) || !sameColor(img.At(50, 90), cropBlue) {
+		t.Errorf("expected red above blue after rotating, got %v and %v", img.At(50, 10), img.At(50, 90))
+	}
+}
@@ -0,0 +1,114 @@
+package widgets
+
+import (
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/layout"
+)
+
+// ImageFiltered applies an image filter to its child when it is composited,
+// such as a blur or a custom [graphics.FragmentShader] effect.
+//
+// # Creation Pattern
+//
+// Use struct literal:
+//
+//	filter := graphics.NewShaderImageFilter(s.dissolve)
+//	widgets.ImageFiltered{
+//	    Filter: &filter,
+//	    Child:  content,
+//	}
+//
+// A nil Filter paints the child normally. The filter is applied within the
+// widget's bounds; a shader filter sees coordinates relative to the
+// widget's top-left corner.
+type ImageFiltered struct {
+	core.RenderObjectBase
+	// Filter is the image filter applied to the child.
+	Filter *graphics.ImageFilter
+	// Child is the widget to filter.
+	Child core.Widget
+}
+
+func (f ImageFiltered) ChildWidget() core.Widget {
+	return f.Child
+}
+
+func (f ImageFiltered) CreateRenderObject(ctx core.BuildContext) layout.RenderObject {
+	box := &renderImageFiltered{filter: f.Filter}
+	box.SetSelf(box)
+	return box
+}
+
+func (f ImageFiltered) UpdateRenderObject(ctx core.BuildContext, renderObject layout.RenderObject) {
+	if box, ok := renderObject.(*renderImageFiltered); ok {
+		box.filter = f.Filter
+		box.MarkNeedsPaint()
+	}
+}
+
+type renderImageFiltered struct {
+	layout.RenderBoxBase
+	child  layout.RenderBox
+	filter *graphics.ImageFilter
+}
+
+// IsRepaintBoundary returns true when a filter layer is used.
+func (r *renderImageFiltered) IsRepaintBoundary() bool {
+	return r.filter != nil
+}
+
+func (r *renderImageFiltered) SetChild(child layout.RenderObject) {
+	layout.SetParentOnChild(r.child, nil)
+	r.child = layout.AsRenderBox(child)
+	layout.SetParentOnChild(r.child, r)
+}
+
+func (r *renderImageFiltered) VisitChildren(visitor func(layout.RenderObject)) {
+	if r.child != nil {
+		visitor(r.child)
+	}
+}
+
+func (r *renderImageFiltered) PerformLayout() {
+	constraints := r.Constraints()
+	if r.child != nil {
+		r.child.Layout(constraints, true) // true: we read child.Size()
+		r.SetSize(r.child.Size())
+	} else {
+		r.SetSize(constraints.Constrain(graphics.Size{}))
+	}
+}
+
+func (r *renderImageFiltered) Paint(ctx *layout.PaintContext) {
+	if r.child == nil {
+		return
+	}
+	if r.filter == nil {
+		ctx.PaintChildWithLayer(r.child, getChildOffset(r.child))
+		return
+	}
+	size := r.Size()
+	bounds := graphics.RectFromLTWH(0, 0, size.Width, size.Height)
+	ctx.Canvas.SaveLayer(bounds, &graphics.Paint{
+		BlendMode:   graphics.BlendModeSrcOver,
+		Alpha:       1,
+		ImageFilter: r.filter,
+	})
+	ctx.PaintChildWithLayer(r.child, getChildOffset(r.child))
+	ctx.Canvas.Restore()
+}
+
+func (r *renderImageFiltered) HitTest(position graphics.Offset, result *layout.HitTestResult) bool {
+	if !layout.WithinBounds(position, r.Size()) {
+		return false
+	}
+	if r.child != nil {
+		offset := getChildOffset(r.child)
+		local := graphics.Offset{X: position.X - offset.X, Y: position.Y - offset.Y}
+		if r.child.HitTest(local, result) {
+			return true
+		}
+	}
+	return false
+}
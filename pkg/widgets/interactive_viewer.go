@@ -0,0 +1,352 @@
+package widgets
+
+import (
+	"math"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/gestures"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/layout"
+)
+
+// ViewTransform positions the child of an [InteractiveViewer]. A point p of
+// the child is shown at Translation + Rotate(Scale * p), rotating clockwise
+// by Rotation radians about the child's origin.
+type ViewTransform struct {
+	// Translation is where the child's origin is shown.
+	Translation graphics.Offset
+	// Scale is the child's zoom factor.
+	Scale float64
+	// Rotation is the child's clockwise rotation in radians.
+	Rotation float64
+}
+
+// IdentityViewTransform shows the child unmoved.
+var IdentityViewTransform = ViewTransform{Scale: 1}
+
+// Apply maps a point in the child to the viewer.
+func (t ViewTransform) Apply(p graphics.Offset) graphics.Offset {
+	sin, cos := math.Sincos(t.Rotation)
+	x, y := p.X*t.Scale, p.Y*t.Scale
+	return graphics.Offset{
+		X: t.Translation.X + x*cos - y*sin,
+		Y: t.Translation.Y + x*sin + y*cos,
+	}
+}
+
+// ApplyInverse maps a point in the viewer back to the child.
+func (t ViewTransform) ApplyInverse(p graphics.Offset) graphics.Offset {
+	if t.Scale == 0 {
+		return graphics.Offset{}
+	}
+	sin, cos := math.Sincos(-t.Rotation)
+	x, y := p.X-t.Translation.X, p.Y-t.Translation.Y
+	return graphics.Offset{
+		X: (x*cos - y*sin) / t.Scale,
+		Y: (x*sin + y*cos) / t.Scale,
+	}
+}
+
+// TransformationController reads and sets the transform of an
+// [InteractiveViewer], for example to reset the zoom:
+//
+//	s.transform = widgets.NewTransformationController()
+//	core.UseDisposable(s, s.transform)
+//
+//	// In Build
+//	widgets.InteractiveViewer{Controller: s.transform, MaxScale: 4, Child: photo}
+//
+//	// Later
+//	s.transform.Reset()
+//
+// A zero TransformationController starts at [IdentityViewTransform].
+type TransformationController struct {
+	value     ViewTransform
+	listeners core.Notifier
+}
+
+// NewTransformationController creates a controller at [IdentityViewTransform].
+func NewTransformationController() *TransformationController {
+	return &TransformationController{value: IdentityViewTransform}
+}
+
+// Value returns the current transform.
+func (c *TransformationController) Value() ViewTransform {
+	if c.value.Scale == 0 {
+		return IdentityViewTransform
+	}
+	return c.value
+}
+
+// SetValue sets the transform and notifies listeners. The viewer's scale
+// limits are not applied.
+func (c *TransformationController) SetValue(value ViewTransform) {
+	if value == c.value {
+		return
+	}
+	c.value = value
+	c.listeners.Notify()
+}
+
+// Reset returns to [IdentityViewTransform].
+func (c *TransformationController) Reset() {
+	c.SetValue(IdentityViewTransform)
+}
+
+// AddListener adds a callback that fires when the transform changes. Returns
+// an unsubscribe function.
+func (c *TransformationController) AddListener(fn func()) func() {
+	return c.listeners.AddListener(fn)
+}
+
+// Dispose releases listeners.
+func (c *TransformationController) Dispose() {
+	c.listeners.Dispose()
+}
+
+// InteractiveViewer lets the user pan and pinch-zoom its child, and
+// optionally rotate it with two fingers. The child is clipped to the
+// viewer's bounds.
+//
+//	widgets.InteractiveViewer{
+//	    MinScale: 1,
+//	    MaxScale: 4,
+//	    Child:    widgets.Image{Source: photo, Fit: widgets.ImageFitContain},
+//	}
+//
+// By default the child is laid out at the viewer's size. Set Unconstrained
+// to lay it out at its own size instead, for content larger than the
+// viewport such as a map or diagram.
+//
+// Set Controller to read or change the transform from app code.
+type InteractiveViewer struct {
+	core.RenderObjectBase
+	// Child is the content to pan and zoom.
+	Child core.Widget
+	// Controller holds the transform. When nil, the viewer keeps its own,
+	// starting at [IdentityViewTransform].
+	Controller *TransformationController
+	// MinScale is the smallest zoom factor. Zero means no lower limit.
+	MinScale float64
+	// MaxScale is the largest zoom factor. Zero means no upper limit.
+	MaxScale float64
+	// Rotate enables two-finger rotation.
+	Rotate bool
+	// Unconstrained lays the child out at its own size rather than the
+	// viewer's.
+	Unconstrained bool
+	// OnInteractionStart is called when the user starts panning or zooming.
+	OnInteractionStart func()
+	// OnInteractionEnd is called when the user lifts the last finger.
+	OnInteractionEnd func()
+}
+
+func (v InteractiveViewer) ChildWidget() core.Widget {
+	return v.Child
+}
+
+func (v InteractiveViewer) CreateRenderObject(ctx core.BuildContext) layout.RenderObject {
+	r := &renderInteractiveViewer{own: IdentityViewTransform}
+	r.SetSelf(r)
+	r.configure(v)
+	return r
+}
+
+func (v InteractiveViewer) UpdateRenderObject(ctx core.BuildContext, renderObject layout.RenderObject) {
+	if r, ok := renderObject.(*renderInteractiveViewer); ok {
+		if r.unconstrained != v.Unconstrained {
+			r.MarkNeedsLayout()
+		}
+		r.configure(v)
+		r.MarkNeedsPaint()
+	}
+}
+
+type renderInteractiveViewer struct {
+	layout.RenderBoxBase
+	child layout.RenderBox
+
+	controller     *TransformationController
+	removeListener func()
+	// own is the transform when there is no controller.
+	own ViewTransform
+
+	minScale, maxScale float64
+	rotate             bool
+	unconstrained      bool
+	onStart, onEnd     func()
+
+	scale       *gestures.ScaleGestureRecognizer
+	hitPosition graphics.Offset
+	// origin is the viewer's global position, found when a pointer lands.
+	origin graphics.Offset
+	// lastScale and lastRotation are the gesture's values at the previous
+	// update.
+	lastScale    float64
+	lastRotation float64
+}
+
+func (r *renderInteractiveViewer) configure(v InteractiveViewer) {
+	if r.controller != v.Controller {
+		if r.removeListener != nil {
+			r.removeListener()
+			r.removeListener = nil
+		}
+		r.controller = v.Controller
+		if r.controller != nil {
+			r.removeListener = r.controller.AddListener(r.MarkNeedsPaint)
+		}
+	}
+	r.minScale = v.MinScale
+	r.maxScale = v.MaxScale
+	r.rotate = v.Rotate
+	r.unconstrained = v.Unconstrained
+	r.onStart = v.OnInteractionStart
+	r.onEnd = v.OnInteractionEnd
+
+	if r.scale == nil {
+		r.scale = gestures.NewScaleGestureRecognizer(gestures.DefaultArena)
+		r.scale.OnStart = func(gestures.ScaleStartDetails) {
+			r.lastScale, r.lastRotation = 1, 0
+			if r.onStart != nil {
+				r.onStart()
+			}
+		}
+		r.scale.OnUpdate = r.handleScaleUpdate
+		r.scale.OnEnd = func(gestures.ScaleEndDetails) {
+			if r.onEnd != nil {
+				r.onEnd()
+			}
+		}
+	}
+}
+
+func (r *renderInteractiveViewer) transform() ViewTransform {
+	if r.controller != nil {
+		return r.controller.Value()
+	}
+	return r.own
+}
+
+func (r *renderInteractiveViewer) setTransform(t ViewTransform) {
+	if r.controller != nil {
+		r.controller.SetValue(t)
+		return
+	}
+	r.own = t
+	r.MarkNeedsPaint()
+}
+
+// handleScaleUpdate moves the child so the point under the previous focal
+// point follows the fingers, scaling and rotating about it.
+func (r *renderInteractiveViewer) handleScaleUpdate(d gestures.ScaleUpdateDetails) {
+	t := r.transform()
+	scaleBy := 1.0
+	if r.lastScale > 0 {
+		scaleBy = d.Scale / r.lastScale
+	}
+	newScale := t.Scale * scaleBy
+	if r.minScale > 0 {
+		newScale = max(newScale, r.minScale)
+	}
+	if r.maxScale > 0 {
+		newScale = min(newScale, r.maxScale)
+	}
+	scaleBy = newScale / t.Scale
+	rotateBy := 0.0
+	if r.rotate {
+		rotateBy = d.Rotation - r.lastRotation
+	}
+	r.lastScale, r.lastRotation = d.Scale, d.Rotation
+
+	focal := graphics.Offset{X: d.FocalPoint.X - r.origin.X, Y: d.FocalPoint.Y - r.origin.Y}
+	previous := graphics.Offset{X: focal.X - d.FocalPointDelta.X, Y: focal.Y - d.FocalPointDelta.Y}
+	sin, cos := math.Sincos(rotateBy)
+	x := (t.Translation.X - previous.X) * scaleBy
+	y := (t.Translation.Y - previous.Y) * scaleBy
+	r.setTransform(ViewTransform{
+		Translation: graphics.Offset{X: focal.X + x*cos - y*sin, Y: focal.Y + x*sin + y*cos},
+		Scale:       newScale,
+		Rotation:    t.Rotation + rotateBy,
+	})
+}
+
+func (r *renderInteractiveViewer) SetChild(child layout.RenderObject) {
+	layout.SetParentOnChild(r.child, nil)
+	r.child = layout.AsRenderBox(child)
+	layout.SetParentOnChild(r.child, r)
+}
+
+func (r *renderInteractiveViewer) VisitChildren(visitor func(layout.RenderObject)) {
+	if r.child != nil {
+		visitor(r.child)
+	}
+}
+
+func (r *renderInteractiveViewer) PerformLayout() {
+	constraints := r.Constraints()
+	r.SetSize(constraints.Constrain(graphics.Size{Width: constraints.MaxWidth, Height: constraints.MaxHeight}))
+	if r.child == nil {
+		return
+	}
+	childConstraints := layout.Tight(r.Size())
+	if r.unconstrained {
+		childConstraints = layout.Constraints{MaxWidth: math.MaxFloat64, MaxHeight: math.MaxFloat64}
+	}
+	r.child.Layout(childConstraints, false)
+	r.child.SetParentData(&layout.BoxParentData{})
+}
+
+func (r *renderInteractiveViewer) Paint(ctx *layout.PaintContext) {
+	if r.child == nil {
+		return
+	}
+	size := r.Size()
+	bounds := graphics.RectFromLTWH(0, 0, size.Width, size.Height)
+	t := r.transform()
+	ctx.Canvas.Save()
+	ctx.Canvas.ClipRect(bounds)
+	ctx.PushClipRect(bounds)
+	ctx.Canvas.Translate(t.Translation.X, t.Translation.Y)
+	ctx.Canvas.Rotate(t.Rotation)
+	ctx.Canvas.Scale(t.Scale, t.Scale)
+	ctx.PaintChildWithLayer(r.child, graphics.Offset{})
+	ctx.PopClipRect()
+	ctx.Canvas.Restore()
+}
+
+func (r *renderInteractiveViewer) HitTest(position graphics.Offset, result *layout.HitTestResult) bool {
+	if !layout.WithinBounds(position, r.Size()) {
+		return false
+	}
+	r.hitPosition = position
+	if r.child != nil {
+		local := r.transform().ApplyInverse(position)
+		if layout.WithinBounds(local, r.child.Size()) {
+			r.child.HitTest(local, result)
+		}
+	}
+	result.Add(r)
+	return true
+}
+
+func (r *renderInteractiveViewer) HandlePointer(event gestures.PointerEvent) {
+	if event.Phase == gestures.PointerPhaseDown {
+		r.origin = graphics.Offset{
+			X: event.Position.X - r.hitPosition.X,
+			Y: event.Position.Y - r.hitPosition.Y,
+		}
+		r.scale.AddPointer(event)
+		return
+	}
+	r.scale.HandleEvent(event)
+}
+
+// Dispose stops listening to the controller.
+func (r *renderInteractiveViewer) Dispose() {
+	if r.removeListener != nil {
+		r.removeListener()
+		r.removeListener = nil
+	}
+	r.RenderBoxBase.Dispose()
+}
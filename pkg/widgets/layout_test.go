@@ -648,10 +648,11 @@ func (c *mockCanvas) DrawRect(rect graphics.Rect, paint graphics.Paint)
 func (c *mockCanvas) DrawRRect(rect graphics.RRect, paint graphics.Paint)        {}
 func (c *mockCanvas) DrawCircle(center graphics.Offset, radius float64, paint graphics.Paint) {
 }
-func (c *mockCanvas) DrawLine(p1, p2 graphics.Offset, paint graphics.Paint)          {}
-func (c *mockCanvas) DrawPath(path *graphics.Path, paint graphics.Paint)             {}
-func (c *mockCanvas) DrawText(layout *graphics.TextLayout, position graphics.Offset) {}
-func (c *mockCanvas) DrawImage(img image.Image, position graphics.Offset)            {}
+func (c *mockCanvas) DrawLine(p1, p2 graphics.Offset, paint graphics.Paint)                    {}
+func (c *mockCanvas) DrawPath(path *graphics.Path, paint graphics.Paint)                       {}
+func (c *mockCanvas) DrawText(layout *graphics.TextLayout, position graphics.Offset)           {}
+func (c *mockCanvas) DrawImage(img image.Image, position graphics.Offset)                      {}
+func (c *mockCanvas) DrawVertices(v *graphics.Vertices, img image.Image, paint graphics.Paint) {}
 func (c *mockCanvas) DrawImageRect(img image.Image, src, dst graphics.Rect, q graphics.FilterQuality, key uintptr) {
 }
 func (c *mockCanvas) DrawRectShadow(rect graphics.Rect, shadow graphics.BoxShadow)   {}
@@ -660,6 +661,7 @@ func (c *mockCanvas) DrawSVG(svgPtr unsafe.Pointer, bounds graphics.Rect)
 func (c *mockCanvas) DrawSVGTinted(svgPtr unsafe.Pointer, bounds graphics.Rect, tint graphics.Color) {
 }
 func (c *mockCanvas) DrawLottie(animPtr unsafe.Pointer, bounds graphics.Rect, t float64) {}
+func (c *mockCanvas) DrawRive(rivePtr unsafe.Pointer, bounds graphics.Rect)              {}
 func (c *mockCanvas) EmbedPlatformView(viewID int64, size graphics.Size)                 {}
 func (c *mockCanvas) Size() graphics.Size                                                { return graphics.Size{Width: 800, Height: 600} }
 
@@ -0,0 +1,301 @@
+package widgets
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+)
+
+// ListOperationKind identifies a step in a [ListOperation] script.
+type ListOperationKind int
+
+const (
+	// ListOperationRemove removes the item at Index.
+	ListOperationRemove ListOperationKind = iota
+	// ListOperationMove removes the item at From and reinserts it at Index,
+	// where Index is counted after the removal.
+	ListOperationMove
+	// ListOperationInsert inserts the new list's item at Index.
+	ListOperationInsert
+	// ListOperationChange marks the item at Index as having the same key but
+	// different content, so it should be rebuilt in place.
+	ListOperationChange
+)
+
+// String returns the operation kind name.
+func (k ListOperationKind) String() string {
+	switch k {
+	case ListOperationRemove:
+		return "remove"
+	case ListOperationMove:
+		return "move"
+	case ListOperationInsert:
+		return "insert"
+	case ListOperationChange:
+		return "change"
+	default:
+		return fmt.Sprintf("ListOperationKind(%d)", int(k))
+	}
+}
+
+// ListOperation is one step of the script produced by [ListDiffer.Diff].
+type ListOperation struct {
+	Kind ListOperationKind
+	// Index is the position the operation applies to, in the list as it is
+	// when the operation runs. For inserts and changes this is also the
+	// item's index in the new list.
+	Index int
+	// From is the item's position before a move. Unused by other kinds.
+	From int
+}
+
+// ListDiffer computes the operations that turn one list into another, so a
+// list widget can animate or rebuild only the items that changed.
+//
+// Items are matched by Key, which must be unique within each list. The
+// largest set of items that keep their relative order stays in place; every
+// other item present in both lists becomes a single move.
+//
+//	differ := widgets.ListDiffer[Message, string]{
+//	    Key:   func(m Message) string { return m.ID },
+//	    Equal: func(a, b Message) bool { return a == b },
+//	}
+//	ops := differ.Diff(s.messages, updated)
+type ListDiffer[T any, K comparable] struct {
+	// Key identifies an item across both lists.
+	Key func(T) K
+	// Equal reports whether two items with the same key have the same
+	// content. Nil skips change detection.
+	Equal func(a, b T) bool
+}
+
+// Diff returns the operations that transform oldItems into newItems when
+// applied in order: removals first (from the end), then moves, then
+// inserts in ascending index order, then changes. [ApplyListOperations]
+// replays them.
+//
+// If either list contains a duplicate key, Diff falls back to removing every
+// old item and inserting every new one.
+func (d ListDiffer[T, K]) Diff(oldItems, newItems []T) []ListOperation {
+	oldKeys, okOld := d.keys(oldItems)
+	newKeys, okNew := d.keys(newItems)
+	if !okOld || !okNew {
+		return resetOperations(len(oldItems), len(newItems))
+	}
+
+	newIndex := make(map[K]int, len(newKeys))
+	for i, k := range newKeys {
+		newIndex[k] = i
+	}
+	oldIndex := make(map[K]int, len(oldKeys))
+	for i, k := range oldKeys {
+		oldIndex[k] = i
+	}
+
+	var ops []ListOperation
+
+	// Remove items that are not in the new list, from the end so earlier
+	// indices stay valid.
+	for i := len(oldKeys) - 1; i >= 0; i-- {
+		if _, ok := newIndex[oldKeys[i]]; !ok {
+			ops = append(ops, ListOperation{Kind: ListOperationRemove, Index: i})
+		}
+	}
+
+	// Items outside the longest common subsequence move. Placing them in
+	// ascending new-index order, each directly after its nearest preceding
+	// item that is already in place, yields the new order.
+	kept := keptKeys(newKeys, oldIndex)
+	ops = appendMoves(ops, oldKeys, newKeys, newIndex, oldIndex, kept)
+
+	for t, k := range newKeys {
+		if _, existed := oldIndex[k]; !existed {
+			ops = append(ops, ListOperation{Kind: ListOperationInsert, Index: t})
+		}
+	}
+
+	if d.Equal != nil {
+		for t, k := range newKeys {
+			if i, existed := oldIndex[k]; existed && !d.Equal(oldItems[i], newItems[t]) {
+				ops = append(ops, ListOperation{Kind: ListOperationChange, Index: t})
+			}
+		}
+	}
+	return ops
+}
+
+// keys returns the key of each item, and false if any key repeats.
+func (d ListDiffer[T, K]) keys(items []T) ([]K, bool) {
+	keys := make([]K, len(items))
+	seen := make(map[K]struct{}, len(items))
+	for i, item := range items {
+		k := d.Key(item)
+		if _, dup := seen[k]; dup {
+			return nil, false
+		}
+		seen[k] = struct{}{}
+		keys[i] = k
+	}
+	return keys, true
+}
+
+// resetOperations removes every old item and inserts every new one.
+func resetOperations(oldLen, newLen int) []ListOperation {
+	ops := make([]ListOperation, 0, oldLen+newLen)
+	for i := oldLen - 1; i >= 0; i-- {
+		ops = append(ops, ListOperation{Kind: ListOperationRemove, Index: i})
+	}
+	for i := range newLen {
+		ops = append(ops, ListOperation{Kind: ListOperationInsert, Index: i})
+	}
+	return ops
+}
+
+// keptKeys returns the keys of the largest set of shared items that keep
+// their relative order: the longest increasing subsequence of the items' old
+// indices, taken in new-list order. Keys are unique, so this is a longest
+// common subsequence of the two lists, found in O(n log n).
+func keptKeys[K comparable](newKeys []K, oldIndex map[K]int) map[K]bool {
+	var shared []K // shared keys in new-list order
+	for _, k := range newKeys {
+		if _, ok := oldIndex[k]; ok {
+			shared = append(shared, k)
+		}
+	}
+	if len(shared) == 0 {
+		return nil
+	}
+
+	// tails[l] is the position in shared of the smallest old index ending an
+	// increasing run of length l+1; prev links each position to the one
+	// before it in its run.
+	tails := make([]int, 0, len(shared))
+	prev := make([]int, len(shared))
+	for i, k := range shared {
+		old := oldIndex[k]
+		l, _ := slices.BinarySearchFunc(tails, old, func(t, target int) int {
+			return cmp.Compare(oldIndex[shared[t]], target)
+		})
+		prev[i] = -1
+		if l > 0 {
+			prev[i] = tails[l-1]
+		}
+		if l == len(tails) {
+			tails = append(tails, i)
+		} else {
+			tails[l] = i
+		}
+	}
+
+	kept := make(map[K]bool, len(tails))
+	for i := tails[len(tails)-1]; i >= 0; i = prev[i] {
+		kept[shared[i]] = true
+	}
+	return kept
+}
+
+// appendMoves appends the moves that bring the surviving old items into
+// new-list order, in O(n log n).
+//
+// Each item sits in a slot, and a Fenwick tree counting the occupied slots
+// gives an item's current index. Slots are ordered as the items are during
+// the moves: every surviving old item starts in the slot of its old index,
+// and each moving item ends in a slot directly after the kept item that
+// precedes it in the new list, behind the moving items placed there before
+// it.
+func appendMoves[K comparable](ops []ListOperation, oldKeys, newKeys []K, newIndex, oldIndex map[K]int, kept map[K]bool) []ListOperation {
+	// Group each moving item with the kept item before it in the new list,
+	// or with the front of the list (-1) when there is none.
+	anchor := make(map[K]int)
+	runs := make([]int, len(oldKeys)+1) // moving items after anchor i-1
+	last := -1
+	for _, k := range newKeys {
+		old, existed := oldIndex[k]
+		switch {
+		case !existed:
+		case kept[k]:
+			last = old
+		default:
+			anchor[k] = last
+			runs[last+1]++
+		}
+	}
+	if len(anchor) == 0 {
+		return ops
+	}
+
+	// base[i] is the first slot after anchor i-1: anchor i-1's own slot
+	// comes first, then the slots of the items moved behind it.
+	base := make([]int, len(oldKeys)+2)
+	for i := range len(oldKeys) + 1 {
+		base[i+1] = base[i] + runs[i] + 1
+	}
+	oldSlot := func(old int) int { return base[old+1] }
+
+	slots := make(fenwick, base[len(oldKeys)+1])
+	for i, k := range oldKeys {
+		if _, ok := newIndex[k]; ok {
+			slots.add(oldSlot(i), 1)
+		}
+	}
+	placed := make([]int, len(oldKeys)+1) // moving items already behind anchor i-1
+	for _, k := range newKeys {
+		a, moving := anchor[k]
+		if !moving {
+			continue
+		}
+		from := oldSlot(oldIndex[k])
+		fromIndex := slots.count(from)
+		slots.add(from, -1)
+		placed[a+1]++
+		to := base[a+1] + placed[a+1]
+		toIndex := slots.count(to)
+		slots.add(to, 1)
+		if fromIndex != toIndex {
+			ops = append(ops, ListOperation{Kind: ListOperationMove, From: fromIndex, Index: toIndex})
+		}
+	}
+	return ops
+}
+
+// fenwick is a Fenwick tree counting occupied slots.
+type fenwick []int
+
+// add adds delta to slot i.
+func (f fenwick) add(i, delta int) {
+	for i++; i <= len(f); i += i & -i {
+		f[i-1] += delta
+	}
+}
+
+// count returns the total of the slots before i.
+func (f fenwick) count(i int) int {
+	n := 0
+	for ; i > 0; i -= i & -i {
+		n += f[i-1]
+	}
+	return n
+}
+
+// ApplyListOperations replays ops from [ListDiffer.Diff] on a copy of
+// oldItems, taking inserted and changed items from newItems. The result
+// equals newItems; it is mainly useful for keeping parallel per-item state,
+// such as animation controllers, in step with the data.
+func ApplyListOperations[T any](oldItems, newItems []T, ops []ListOperation) []T {
+	items := append([]T(nil), oldItems...)
+	for _, op := range ops {
+		switch op.Kind {
+		case ListOperationRemove:
+			items = append(items[:op.Index], items[op.Index+1:]...)
+		case ListOperationMove:
+			item := items[op.From]
+			items = append(items[:op.From], items[op.From+1:]...)
+			items = append(items[:op.Index], append([]T{item}, items[op.Index:]...)...)
+		case ListOperationInsert:
+			items = append(items[:op.Index], append([]T{newItems[op.Index]}, items[op.Index:]...)...)
+		case ListOperationChange:
+			items[op.Index] = newItems[op.Index]
+		}
+	}
+	return items
+}
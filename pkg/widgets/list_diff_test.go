@@ -0,0 +1,154 @@
+package widgets
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+type diffItem struct {
+	id    int
+	label string
+}
+
+var itemDiffer = ListDiffer[diffItem, int]{
+	Key:   func(i diffItem) int { return i.id },
+	Equal: func(a, b diffItem) bool { return a == b },
+}
+
+func items(ids ...int) []diffItem {
+	out := make([]diffItem, len(ids))
+	for i, id := range ids {
+		out[i] = diffItem{id: id}
+	}
+	return out
+}
+
+func TestListDiffer_SingleMove(t *testing.T) {
+	ops := itemDiffer.Diff(items(1, 2, 3, 4), items(2, 3, 4, 1))
+	want := []ListOperation{{Kind: ListOperationMove, From: 0, Index: 3}}
+	if !slices.Equal(ops, want) {
+		t.Errorf("got %+v, want %+v", ops, want)
+	}
+}
+
+func TestListDiffer_InsertRemoveChange(t *testing.T) {
+	oldItems := items(1, 2, 3)
+	newItems := []diffItem{{id: 1}, {id: 3, label: "edited"}, {id: 4}}
+	ops := itemDiffer.Diff(oldItems, newItems)
+	want := []ListOperation{
+		{Kind: ListOperationRemove, Index: 1},
+		{Kind: ListOperationInsert, Index: 2},
+		{Kind: ListOperationChange, Index: 1},
+	}
+	if !slices.Equal(ops, want) {
+		t.Errorf("got %+v, want %+v", ops, want)
+	}
+}
+
+func TestListDiffer_DuplicateKeysReset(t *testing.T) {
+	ops := itemDiffer.Diff(items(1, 1), items(2))
+	want := []ListOperation{
+		{Kind: ListOperationRemove, Index: 1},
+		{Kind: ListOperationRemove, Index: 0},
+		{Kind: ListOperationInsert, Index: 0},
+	}
+	if !slices.Equal(ops, want) {
+		t.Errorf("got %+v, want %+v", ops, want)
+	}
+}
+
+func TestListDiffer_ApplyReproducesNewList(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for round := range 500 {
+		oldIDs := rng.Perm(rng.Intn(12))
+		newIDs := rng.Perm(rng.Intn(12) + 2)
+		for i := range newIDs {
+			newIDs[i] += rng.Intn(3) // shift some ids out of the old range
+		}
+		newIDs = uniqueInts(newIDs)
+		oldItems, newItems := items(oldIDs...), items(newIDs...)
+		if len(newItems) > 0 && rng.Intn(2) == 0 {
+			newItems[0].label = "changed"
+		}
+
+		ops := itemDiffer.Diff(oldItems, newItems)
+		got := ApplyListOperations(oldItems, newItems, ops)
+		if !slices.Equal(got, newItems) {
+			t.Fatalf("round %d: %v -> %v: ops %+v produced %v", round, oldIDs, newIDs, ops, got)
+		}
+	}
+}
+
+func TestListDiffer_LargeLists(t *testing.T) {
+	const n = 5000
+	oldIDs := make([]int, n)
+	replaced := make([]int, n)
+	for i := range n {
+		oldIDs[i] = i
+		replaced[i] = n + i
+	}
+	oldItems := items(oldIDs...)
+
+	// Replacing every item, as a refreshed feed does, shares no keys.
+	newItems := items(replaced...)
+	ops := itemDiffer.Diff(oldItems, newItems)
+	if len(ops) != 2*n {
+		t.Errorf("replace: got %d ops, want %d", len(ops), 2*n)
+	}
+	if got := ApplyListOperations(oldItems, newItems, ops); !slices.Equal(got, newItems) {
+		t.Error("replace: ops did not reproduce the new list")
+	}
+
+	// A shuffle keeps every key, so only moves are needed.
+	shuffled := slices.Clone(oldIDs)
+	rand.New(rand.NewSource(1)).Shuffle(n, func(i, j int) {
+		shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
+	})
+	newItems = items(shuffled...)
+	ops = itemDiffer.Diff(oldItems, newItems)
+	if got := ApplyListOperations(oldItems, newItems, ops); !slices.Equal(got, newItems) {
+		t.Error("shuffle: ops did not reproduce the new list")
+	}
+
+	// A reversal keeps only one item in place, so every other item moves.
+	newItems = items(reversedInts(n)...)
+	ops = itemDiffer.Diff(oldItems, newItems)
+	if len(ops) != n-1 {
+		t.Errorf("reverse: got %d ops, want %d", len(ops), n-1)
+	}
+	if got := ApplyListOperations(oldItems, newItems, ops); !slices.Equal(got, newItems) {
+		t.Error("reverse: ops did not reproduce the new list")
+	}
+}
+
+func BenchmarkListDiffer_Reverse(b *testing.B) {
+	const n = 40000
+	oldItems := items(reversedInts(n)...)
+	slices.Reverse(oldItems)
+	newItems := items(reversedInts(n)...)
+	b.ResetTimer()
+	for range b.N {
+		itemDiffer.Diff(oldItems, newItems)
+	}
+}
+
+func reversedInts(n int) []int {
+	out := make([]int, n)
+	for i := range out {
+		out[i] = n - 1 - i
+	}
+	return out
+}
+
+func uniqueInts(in []int) []int {
+	seen := map[int]bool{}
+	out := in[:0]
+	for _, v := range in {
+		if !seen[v] {
+			seen[v] = true
+			out = append(out, v)
+		}
+	}
+	return out
+}
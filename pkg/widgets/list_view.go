@@ -2,6 +2,7 @@ package widgets
 
 import (
 	"math"
+	"slices"
 
 	"github.com/go-drift/drift/pkg/core"
 	"github.com/go-drift/drift/pkg/layout"
@@ -43,6 +44,10 @@ type ListView struct {
 	MainAxisAlignment MainAxisAlignment
 	// MainAxisSize determines how much space the list takes along the scroll axis.
 	MainAxisSize MainAxisSize
+	// Reverse lists the children from the end of the viewport, so the first
+	// child is at the bottom (or right) and the list starts scrolled there.
+	// See [ScrollView].
+	Reverse bool
 }
 
 // ListViewBuilder builds list items on demand for efficient scrolling of large lists.
@@ -93,6 +98,10 @@ type ListViewBuilder struct {
 	MainAxisAlignment MainAxisAlignment
 	// MainAxisSize determines how much space the list takes along the scroll axis.
 	MainAxisSize MainAxisSize
+	// Reverse lists the children from the end of the viewport, so the first
+	// child is at the bottom (or right) and the list starts scrolled there.
+	// See [ScrollView].
+	Reverse bool
 }
 
 func (l ListView) Build(ctx core.BuildContext) core.Widget {
@@ -106,6 +115,7 @@ func (l ListView) Build(ctx core.BuildContext) core.Widget {
 		ScrollDirection: l.ScrollDirection,
 		Controller:      l.Controller,
 		Physics:         l.Physics,
+		Reverse:         l.Reverse,
 	}
 }
 
@@ -114,15 +124,20 @@ func (l ListViewBuilder) CreateState() core.State {
 }
 
 func (l ListView) buildContent() core.Widget {
+	children := l.Children
+	if l.Reverse {
+		children = slices.Clone(children)
+		slices.Reverse(children)
+	}
 	if l.ScrollDirection == AxisHorizontal {
 		return Row{
-			Children:          l.Children,
+			Children:          children,
 			MainAxisAlignment: l.MainAxisAlignment,
 			MainAxisSize:      l.MainAxisSize,
 		}
 	}
 	return Column{
-		Children:          l.Children,
+		Children:          children,
 		MainAxisAlignment: l.MainAxisAlignment,
 		MainAxisSize:      l.MainAxisSize,
 	}
@@ -165,6 +180,7 @@ func (s *listViewBuilderState) Build(ctx core.BuildContext) core.Widget {
 		Padding:           widgetValue.Padding,
 		MainAxisAlignment: widgetValue.MainAxisAlignment,
 		MainAxisSize:      widgetValue.MainAxisSize,
+		Reverse:           widgetValue.Reverse,
 	}
 }
 
@@ -328,9 +344,16 @@ func (l ListViewBuilder) visibleRange(controller *ScrollController) (int, int) {
 	return startIndex, endIndex
 }
 
+// paddingLeading returns the padding before the first item, which is at the
+// end when reversed.
 func (l ListViewBuilder) paddingLeading() float64 {
-	if l.ScrollDirection == AxisHorizontal {
+	switch {
+	case l.ScrollDirection == AxisHorizontal && l.Reverse:
+		return l.Padding.Right
+	case l.ScrollDirection == AxisHorizontal:
 		return l.Padding.Left
+	case l.Reverse:
+		return l.Padding.Bottom
 	}
 	return l.Padding.Top
 }
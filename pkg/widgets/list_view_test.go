@@ -0,0 +1,77 @@
+package widgets_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+	drifttest "github.com/go-drift/drift/pkg/testing"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+func TestListView_ReverseAnchorsAtEnd(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	tester.SetSize(graphics.Size{Width: 400, Height: 600})
+	tester.PumpWidget(widgets.ListView{
+		Reverse: true,
+		Children: []core.Widget{
+			widgets.SizedBox{Height: 50, Child: widgets.Text{Content: "first"}},
+			widgets.SizedBox{Height: 50, Child: widgets.Text{Content: "second"}},
+		},
+	})
+
+	// Content shorter than the viewport sits at the end, first child last.
+	if got := tester.Find(drifttest.ByText("first")).GlobalOffset().Y; got != 550 {
+		t.Errorf("first at y %v, want 550", got)
+	}
+	if got := tester.Find(drifttest.ByText("second")).GlobalOffset().Y; got != 500 {
+		t.Errorf("second at y %v, want 500", got)
+	}
+}
+
+func TestListViewBuilder_ReverseScrollsFromEnd(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	tester.SetSize(graphics.Size{Width: 400, Height: 600})
+	controller := &widgets.ScrollController{}
+	tapped := -1
+	tester.PumpWidget(widgets.ListViewBuilder{
+		Reverse:    true,
+		Controller: controller,
+		ItemCount:  100,
+		ItemExtent: 50,
+		ItemBuilder: func(ctx core.BuildContext, index int) core.Widget {
+			return widgets.Tap(func() { tapped = index }, widgets.Text{Content: fmt.Sprintf("item %d", index)})
+		},
+	})
+	// The first frame measures the viewport; the second builds what fits.
+	tester.Pump()
+
+	if got := tester.Find(drifttest.ByText("item 0")).GlobalOffset().Y; got != 550 {
+		t.Errorf("item 0 at y %v, want 550", got)
+	}
+	if tester.Find(drifttest.ByText("item 50")).Exists() {
+		t.Error("expected items far from the end not to be built")
+	}
+
+	// Dragging down scrolls toward the start of the list.
+	if err := tester.Drag(drifttest.ByText("item 3"), graphics.Offset{Y: 400}); err != nil {
+		t.Fatal(err)
+	}
+	tester.Pump()
+	if controller.Offset() <= 0 {
+		t.Fatalf("offset = %v, want > 0", controller.Offset())
+	}
+	controller.JumpTo(1000)
+	tester.Pump()
+	if got := tester.Find(drifttest.ByText("item 20")).GlobalOffset().Y; got != 550-1000+20*50 {
+		t.Errorf("item 20 at y %v, want %v", got, 550-1000+20*50)
+	}
+
+	if err := tester.Tap(drifttest.ByText("item 25")); err != nil {
+		t.Fatal(err)
+	}
+	if tapped != 25 {
+		t.Errorf("tapped = %d, want 25", tapped)
+	}
+}
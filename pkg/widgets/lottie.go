@@ -1,11 +1,17 @@
 package widgets
 
 import (
+	"context"
+	"errors"
+	"io/fs"
+	"maps"
+
 	"github.com/go-drift/drift/pkg/animation"
 	"github.com/go-drift/drift/pkg/core"
 	"github.com/go-drift/drift/pkg/graphics"
 	"github.com/go-drift/drift/pkg/layout"
 	"github.com/go-drift/drift/pkg/lottie"
+	"github.com/go-drift/drift/pkg/platform"
 )
 
 // LottieRepeat controls how a Lottie animation repeats after completing.
@@ -20,12 +26,15 @@ const (
 	LottieBounce
 )
 
-// Lottie renders a Lottie animation from a loaded [lottie.Animation].
+// Lottie renders a Lottie animation.
 //
 // # Loading
 //
-// The Source field requires a pre-loaded animation. Use [lottie.Load],
-// [lottie.LoadBytes], or [lottie.LoadFile] to obtain one.
+// Set Source to a pre-loaded animation from [lottie.Load], [lottie.LoadBytes],
+// or [lottie.LoadFile]. Alternatively, set Asset (with AssetFS) or URL and the
+// widget loads the animation in the background, showing Placeholder until it
+// is ready and ErrorBuilder's result if loading fails. Source takes
+// precedence over Asset and URL.
 //
 // # Auto-play Behavior
 //
@@ -34,10 +43,14 @@ const (
 //
 // # Programmatic Control
 //
-// Pass a Controller to take full control of playback. When a Controller is
-// provided, the widget does not auto-play and ignores Repeat and OnComplete.
-// The controller's Value (0.0 to 1.0) maps directly to animation progress.
-// Switching between external and self-managed control at runtime is supported.
+// Pass a [LottieController] as Playback to play, pause, seek, and change the
+// speed or loop mode. The widget does not auto-play and uses the controller's
+// loop mode instead of Repeat. OnFrame and OnComplete still fire.
+//
+// Alternatively, pass an [animation.AnimationController] as Controller to
+// drive progress directly: its Value (0.0 to 1.0) maps to animation progress,
+// and Repeat, OnFrame, and OnComplete are ignored. Switching between external
+// and self-managed control at runtime is supported.
 //
 // # Sizing Behavior
 //
@@ -48,7 +61,7 @@ const (
 // # Easing
 //
 // Lottie animations contain their own easing curves baked into keyframes,
-// so the internal controller uses linear interpolation by default.
+// so playback uses linear interpolation.
 //
 // # Creation Patterns
 //
@@ -67,6 +80,13 @@ const (
 //	// Intrinsic size
 //	widgets.Lottie{Source: anim, Repeat: widgets.LottieLoop}
 //
+//	// From embedded assets or the network
+//	widgets.Lottie{AssetFS: assetFS, Asset: "assets/confetti.json", Width: 200}
+//	widgets.Lottie{URL: "https://example.com/confetti.json", Width: 200}
+//
+//	// Playback controls
+//	widgets.Lottie{Source: anim, Playback: s.playback, Width: 200, Height: 200}
+//
 //	// Full programmatic control
 //	widgets.Lottie{Source: anim, Controller: ctrl, Width: 200, Height: 200}
 //
@@ -74,15 +94,31 @@ const (
 //
 // The Source must remain valid for as long as any widget or display list
 // references it. Do not call [lottie.Animation.Destroy] while widgets may
-// still render the animation.
+// still render the animation. Animations loaded from Asset or URL belong to
+// the widget and are destroyed after it unmounts or loads a replacement.
 type Lottie struct {
 	core.StatefulBase
 
 	// Source is the pre-loaded Lottie animation to render. Use [lottie.Load],
 	// [lottie.LoadBytes], or [lottie.LoadFile] to create one. If nil, the
-	// widget renders nothing.
+	// animation is loaded from Asset or URL, or the widget renders nothing.
 	Source *lottie.Animation
 
+	// AssetFS is the file system Asset is read from, typically the app's
+	// embedded assets.
+	AssetFS fs.FS
+
+	// Asset is the path of a Lottie JSON file in AssetFS, loaded when Source
+	// is nil.
+	Asset string
+
+	// URL is an HTTP(S) address of a Lottie JSON file, loaded when Source is
+	// nil and Asset is empty.
+	URL string
+
+	// Headers are optional HTTP headers added to the URL request.
+	Headers map[string]string
+
 	// Width is the desired width. If zero and Height is set, calculated from aspect ratio.
 	// If both zero, uses the animation's intrinsic width.
 	Width float64
@@ -91,17 +127,33 @@ type Lottie struct {
 	// If both zero, uses the animation's intrinsic height.
 	Height float64
 
-	// Repeat controls how the animation repeats. Ignored when Controller is set.
+	// Repeat controls how the animation repeats. Ignored when Playback or
+	// Controller is set.
 	Repeat LottieRepeat
 
+	// Playback controls play, pause, seeking, speed, and loop mode. When set,
+	// the widget does not auto-play.
+	Playback *LottieController
+
 	// Controller allows external control of the animation. When set, the widget
-	// does not auto-play and ignores Repeat and OnComplete. The controller's
-	// Value (0.0 to 1.0) maps directly to animation progress.
+	// does not auto-play and ignores Repeat, Playback, OnFrame, and OnComplete.
+	// The controller's Value (0.0 to 1.0) maps directly to animation progress.
 	Controller *animation.AnimationController
 
-	// OnComplete is called when the animation finishes playing.
-	// Only called in LottiePlayOnce mode without an external Controller.
+	// OnFrame is called each time the displayed frame changes, with the frame
+	// number counted from zero at the animation's authored frame rate.
+	OnFrame func(frame int)
+
+	// OnComplete is called when the animation finishes playing in
+	// LottiePlayOnce mode. Ignored when Controller is set.
 	OnComplete func()
+
+	// Placeholder is shown while Asset or URL is loading. Default: nothing.
+	Placeholder core.Widget
+
+	// ErrorBuilder builds a widget to show when Asset or URL fails to load.
+	// Default: nothing.
+	ErrorBuilder func(err error) core.Widget
 }
 
 func (l Lottie) CreateState() core.State {
@@ -110,154 +162,285 @@ func (l Lottie) CreateState() core.State {
 
 type lottieState struct {
 	core.StateBase
-	ownController   *animation.AnimationController
-	unsubListenable func()
+	ownController *LottieController
+	unsubscribe   []func()
+
+	// loaded is the animation loaded from Asset or URL, owned by the state.
+	loaded     *lottie.Animation
+	loadErr    error
+	cancelLoad context.CancelFunc
 }
 
-func (s *lottieState) subscribeListenable(c *animation.AnimationController) {
-	if s.unsubListenable != nil {
-		s.unsubListenable()
-	}
-	s.unsubListenable = c.AddListener(func() {
-		s.SetState(nil)
-	})
+func (s *lottieState) currentWidget() Lottie {
+	return s.Element().Widget().(Lottie)
 }
 
-func (s *lottieState) controller() *animation.AnimationController {
-	w := s.Element().Widget().(Lottie)
-	if w.Controller != nil {
-		return w.Controller
+// source returns the animation to render, if one is available.
+func (s *lottieState) source() *lottie.Animation {
+	if w := s.currentWidget(); w.Source != nil {
+		return w.Source
 	}
-	return s.ownController
+	return s.loaded
 }
 
-func (s *lottieState) InitState() {
-	w := s.Element().Widget().(Lottie)
-
-	// Create the internal controller when the source has a valid duration.
-	// This is created even when an external Controller is provided so that
-	// switching from external to self-managed control is seamless.
-	if w.Source != nil {
-		dur := w.Source.Duration()
-		if dur > 0 {
-			s.ownController = animation.NewAnimationController(dur)
-			s.ownController.Curve = animation.LinearCurve
-			core.UseDisposable(s, s.ownController)
-			s.ownController.AddStatusListener(func(status animation.AnimationStatus) {
-				s.onStatus(status)
-			})
-		}
+// playback returns the active LottieController, or nil when an external
+// AnimationController drives the widget.
+func (s *lottieState) playback() *LottieController {
+	w := s.currentWidget()
+	switch {
+	case w.Controller != nil:
+		return nil
+	case w.Playback != nil:
+		return w.Playback
+	default:
+		return s.ownController
 	}
+}
 
-	// Clean up the active listener on disposal.
-	s.OnDispose(func() {
-		if s.unsubListenable != nil {
-			s.unsubListenable()
-		}
-	})
+func isSelfManagedLottie(w Lottie) bool {
+	return w.Controller == nil && w.Playback == nil
+}
 
-	// Listen to whichever controller is active.
-	if c := s.controller(); c != nil {
-		s.subscribeListenable(c)
+// subscribe listens to whichever controller is active.
+func (s *lottieState) subscribe() {
+	for _, unsub := range s.unsubscribe {
+		unsub()
 	}
+	s.unsubscribe = nil
 
-	// Auto-play when self-managed.
-	if w.Controller == nil && s.ownController != nil {
-		s.ownController.Forward()
+	rebuild := func() { s.SetState(nil) }
+	if w := s.currentWidget(); w.Controller != nil {
+		s.unsubscribe = append(s.unsubscribe, w.Controller.AddListener(rebuild))
+		return
 	}
+	pb := s.playback()
+	s.unsubscribe = append(s.unsubscribe,
+		pb.AddListener(rebuild),
+		pb.AddFrameListener(func(frame int) {
+			if onFrame := s.currentWidget().OnFrame; onFrame != nil {
+				onFrame(frame)
+			}
+		}),
+		pb.AddCompleteListener(func() {
+			if onComplete := s.currentWidget().OnComplete; onComplete != nil {
+				onComplete()
+			}
+		}),
+	)
 }
 
-func (s *lottieState) onStatus(status animation.AnimationStatus) {
-	w := s.Element().Widget().(Lottie)
-	if w.Controller != nil {
-		return
+// attachSource gives the active LottieController the current animation's
+// timing.
+func (s *lottieState) attachSource() {
+	if pb := s.playback(); pb != nil {
+		src := s.source()
+		pb.attach(src.Duration(), src.FrameRate())
 	}
+}
 
-	switch status {
-	case animation.AnimationCompleted:
-		switch w.Repeat {
-		case LottieLoop:
-			s.ownController.Reset()
-			s.ownController.Forward()
-		case LottieBounce:
-			s.ownController.Reverse()
-		default:
-			if w.OnComplete != nil {
-				w.OnComplete()
-			}
-		}
-	case animation.AnimationDismissed:
-		if w.Repeat == LottieBounce {
-			s.ownController.Forward()
+func (s *lottieState) InitState() {
+	w := s.currentWidget()
+
+	// The internal controller is created even when Playback or Controller is
+	// provided so that switching to self-managed control is seamless.
+	s.ownController = NewLottieController()
+	s.ownController.SetRepeat(w.Repeat)
+	core.UseDisposable(s, s.ownController)
+
+	// Clean up listeners, pending loads, and loaded animations on disposal.
+	s.OnDispose(func() {
+		for _, unsub := range s.unsubscribe {
+			unsub()
 		}
+		s.stopLoad()
+		s.releaseLoaded()
+	})
+
+	s.subscribe()
+	s.attachSource()
+	s.startLoad(w)
+
+	// Auto-play when self-managed.
+	if isSelfManagedLottie(w) {
+		s.ownController.Play()
 	}
 }
 
 func (s *lottieState) DidUpdateWidget(oldWidget core.StatefulWidget) {
 	old := oldWidget.(Lottie)
-	w := s.Element().Widget().(Lottie)
+	w := s.currentWidget()
+	oldSource := old.Source
+	if oldSource == nil {
+		oldSource = s.loaded
+	}
 
-	if old.Controller != w.Controller {
-		// Re-subscribe to the newly active controller.
-		if c := s.controller(); c != nil {
-			s.subscribeListenable(c)
+	if lottieNeedsReload(old, w) {
+		s.stopLoad()
+		s.releaseLoaded()
+		s.loadErr = nil
+		s.startLoad(w)
+	}
+
+	// Repeat mode changed to a looping mode while animation is completed:
+	// restart playback so the new mode takes effect.
+	if old.Repeat != w.Repeat {
+		s.ownController.SetRepeat(w.Repeat)
+		if isSelfManagedLottie(w) && w.Repeat != LottiePlayOnce && s.ownController.IsCompleted() {
+			s.ownController.Play()
 		}
+	}
 
-		if old.Controller == nil && w.Controller != nil {
+	if old.Controller != w.Controller || old.Playback != w.Playback {
+		// Re-subscribe to the newly active controller.
+		s.subscribe()
+		s.attachSource()
+
+		if isSelfManagedLottie(old) && !isSelfManagedLottie(w) {
 			// Switched to external control: stop own playback.
-			if s.ownController != nil {
-				s.ownController.Stop()
-			}
-		} else if old.Controller != nil && w.Controller == nil {
+			s.ownController.Pause()
+		} else if !isSelfManagedLottie(old) && isSelfManagedLottie(w) {
 			// Switched to self-managed: restart playback.
-			if s.ownController != nil {
-				s.ownController.Reset()
-				s.ownController.Forward()
-			}
+			s.ownController.Seek(0)
+			s.ownController.Play()
 		}
 	}
 
-	// Repeat mode changed to a looping mode while animation is completed:
-	// restart playback so the new mode takes effect.
-	if old.Repeat != w.Repeat && w.Controller == nil && s.ownController != nil {
-		if (w.Repeat == LottieLoop || w.Repeat == LottieBounce) && s.ownController.IsCompleted() {
-			s.ownController.Reset()
-			s.ownController.Forward()
-		}
+	// Source changed: update timing and restart when self-managed.
+	if oldSource != s.source() {
+		s.onSourceChanged()
+	}
+}
+
+// onSourceChanged attaches a new animation, restarting playback from the
+// beginning when self-managed.
+func (s *lottieState) onSourceChanged() {
+	s.attachSource()
+	if isSelfManagedLottie(s.currentWidget()) && s.source() != nil {
+		s.ownController.Seek(0)
+		s.ownController.Play()
 	}
+}
+
+// lottieLoadKey identifies the animation a widget loads itself, or returns
+// "" when it renders Source or has nothing to load.
+func lottieLoadKey(w Lottie) string {
+	switch {
+	case w.Source != nil:
+		return ""
+	case w.Asset != "":
+		return "asset:" + w.Asset
+	case w.URL != "":
+		return "url:" + w.URL
+	default:
+		return ""
+	}
+}
+
+func lottieNeedsReload(old, next Lottie) bool {
+	key := lottieLoadKey(next)
+	if key != lottieLoadKey(old) {
+		return true
+	}
+	return key != "" && next.URL != "" && !maps.Equal(old.Headers, next.Headers)
+}
 
-	// Source changed while self-managed: update duration and restart,
-	// or stop the controller if the source was removed.
-	if old.Source != w.Source && w.Controller == nil && s.ownController != nil {
-		if w.Source != nil {
-			dur := w.Source.Duration()
-			if dur > 0 {
-				s.ownController.Duration = dur
-				s.ownController.Reset()
-				s.ownController.Forward()
+// startLoad loads the widget's Asset or URL on a background goroutine and
+// applies the result on the UI thread.
+func (s *lottieState) startLoad(w Lottie) {
+	if lottieLoadKey(w) == "" {
+		return
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancelLoad = cancel
+	go func() {
+		anim, err := loadLottie(ctx, w)
+		dispatched := platform.Dispatch(func() {
+			if ctx.Err() != nil {
+				// Superseded or unmounted while loading.
+				anim.Destroy()
+				return
 			}
-		} else {
-			s.ownController.Stop()
+			cancel()
+			s.cancelLoad = nil
+			s.SetState(func() {
+				s.loaded = anim
+				s.loadErr = err
+			})
+			s.onSourceChanged()
+		})
+		if !dispatched {
+			anim.Destroy()
+		}
+	}()
+}
+
+func loadLottie(ctx context.Context, w Lottie) (*lottie.Animation, error) {
+	if w.Asset != "" {
+		if w.AssetFS == nil {
+			return nil, errors.New("widgets: Lottie Asset requires AssetFS")
 		}
+		return lottie.LoadFS(w.AssetFS, w.Asset)
+	}
+	return lottie.LoadURL(ctx, nil, w.URL, w.Headers)
+}
+
+func (s *lottieState) stopLoad() {
+	if s.cancelLoad != nil {
+		s.cancelLoad()
+		s.cancelLoad = nil
+	}
+}
+
+// releaseLoaded destroys the loaded animation once the current frame, which
+// may still reference it, has been drawn.
+func (s *lottieState) releaseLoaded() {
+	anim := s.loaded
+	if anim == nil {
+		return
+	}
+	s.loaded = nil
+	if !platform.Dispatch(anim.Destroy) {
+		anim.Destroy()
 	}
 }
 
 func (s *lottieState) Build(ctx core.BuildContext) core.Widget {
-	w := s.Element().Widget().(Lottie)
+	w := s.currentWidget()
+	src := s.source()
+
+	if src == nil {
+		switch {
+		case s.loadErr != nil && w.ErrorBuilder != nil:
+			return s.wrapSize(w, w.ErrorBuilder(s.loadErr))
+		case s.cancelLoad != nil && w.Placeholder != nil:
+			return s.wrapSize(w, w.Placeholder)
+		}
+	}
 
 	var t float64
-	if c := s.controller(); c != nil {
-		t = c.Value
+	if w.Controller != nil {
+		t = w.Controller.Value
+	} else if pb := s.playback(); pb != nil {
+		t = pb.Progress()
 	}
 
 	return lottieRender{
-		source: w.Source,
+		source: src,
 		width:  w.Width,
 		height: w.Height,
 		t:      t,
 	}
 }
 
+// wrapSize sizes placeholder and error widgets like the animation when
+// explicit dimensions are set.
+func (s *lottieState) wrapSize(w Lottie, child core.Widget) core.Widget {
+	if w.Width > 0 || w.Height > 0 {
+		return SizedBox{Width: w.Width, Height: w.Height, Child: child}
+	}
+	return child
+}
+
 // lottieRender is the inner RenderObjectWidget for the Lottie animation.
 type lottieRender struct {
 	core.RenderObjectBase
@@ -0,0 +1,313 @@
+package widgets
+
+import (
+	"math"
+	"time"
+
+	"github.com/go-drift/drift/pkg/animation"
+	"github.com/go-drift/drift/pkg/core"
+)
+
+// LottieController controls playback of a [Lottie] widget: play, pause,
+// seeking by progress or frame, playback speed, and loop mode.
+//
+// Frame listeners are notified each time the displayed frame changes, using
+// the frame rate the animation was authored at, which suits syncing haptics
+// or sounds to specific frames:
+//
+//	s.playback = widgets.NewLottieController()
+//	core.UseDisposable(s, s.playback)
+//	s.playback.SetRepeat(widgets.LottieLoop)
+//	s.playback.AddFrameListener(func(frame int) {
+//	    if frame == 42 {
+//	        platform.Haptics.LightImpact()
+//	    }
+//	})
+//
+//	// In Build
+//	widgets.Lottie{Source: anim, Playback: s.playback, Width: 200, Height: 200}
+//
+// The controller is attached to the animation of the widget using it. Until
+// an animation is attached, Play only records that playback should start,
+// and frame-based methods report zero frames. A controller should drive one
+// Lottie widget at a time.
+type LottieController struct {
+	ctrl      *animation.AnimationController
+	duration  time.Duration
+	frameRate float64
+	speed     float64
+	repeat    LottieRepeat
+	playing   bool
+	completed bool
+	reversing bool
+	disposed  bool
+	lastFrame int
+
+	listeners         core.Notifier
+	frameListeners    map[int]func(frame int)
+	completeListeners map[int]func()
+	nextListenerID    int
+}
+
+// NewLottieController creates a paused controller at the first frame, with
+// speed 1 and [LottiePlayOnce] repeat.
+func NewLottieController() *LottieController {
+	c := &LottieController{
+		ctrl:              animation.NewAnimationController(0),
+		speed:             1,
+		lastFrame:         -1,
+		frameListeners:    make(map[int]func(frame int)),
+		completeListeners: make(map[int]func()),
+	}
+	c.ctrl.AddListener(c.update)
+	c.ctrl.AddStatusListener(c.onStatus)
+	return c
+}
+
+// Play starts or resumes playback. In [LottiePlayOnce] mode, playing from
+// the last frame restarts from the first.
+func (c *LottieController) Play() {
+	if c.disposed {
+		return
+	}
+	if !c.reversing && c.ctrl.Value >= 1 {
+		c.ctrl.Value = 0
+	}
+	c.playing = true
+	c.completed = false
+	c.resume()
+	c.notifyListeners()
+}
+
+// Pause stops playback at the current frame.
+func (c *LottieController) Pause() {
+	if !c.playing {
+		return
+	}
+	c.playing = false
+	c.ctrl.Stop()
+	c.notifyListeners()
+}
+
+// Seek jumps to progress, from 0.0 (first frame) to 1.0 (last frame).
+// Playback continues from the new position if it was playing.
+func (c *LottieController) Seek(progress float64) {
+	if c.disposed {
+		return
+	}
+	c.ctrl.Stop()
+	c.ctrl.Value = math.Max(0, math.Min(1, progress))
+	c.completed = false
+	if c.playing {
+		c.resume()
+	}
+	c.update()
+}
+
+// SeekFrame jumps to the start of frame, counted from zero. It does nothing
+// until an animation is attached.
+func (c *LottieController) SeekFrame(frame int) {
+	span := c.duration.Seconds() * c.frameRate
+	if span <= 0 {
+		return
+	}
+	c.Seek(float64(frame) / span)
+}
+
+// SetSpeed sets the playback rate, where 1 is the authored speed and 2 plays
+// twice as fast. Non-positive values are ignored.
+func (c *LottieController) SetSpeed(speed float64) {
+	if speed <= 0 || speed == c.speed {
+		return
+	}
+	c.speed = speed
+	c.ctrl.Duration = c.scaledDuration()
+	if c.ctrl.IsAnimating() {
+		// Restart the segment so the remaining time uses the new speed.
+		c.resume()
+	}
+	c.notifyListeners()
+}
+
+// SetRepeat sets what happens when playback reaches the end.
+func (c *LottieController) SetRepeat(repeat LottieRepeat) {
+	if repeat == c.repeat {
+		return
+	}
+	c.repeat = repeat
+	if repeat != LottieBounce && c.reversing {
+		c.reversing = false
+		if c.ctrl.IsAnimating() {
+			c.resume()
+		}
+	}
+	c.notifyListeners()
+}
+
+// Speed returns the playback rate.
+func (c *LottieController) Speed() float64 {
+	return c.speed
+}
+
+// Repeat returns the loop mode.
+func (c *LottieController) Repeat() LottieRepeat {
+	return c.repeat
+}
+
+// IsPlaying reports whether playback is running or waiting for an
+// animation to attach.
+func (c *LottieController) IsPlaying() bool {
+	return c.playing
+}
+
+// IsCompleted reports whether playback stopped at the end in
+// [LottiePlayOnce] mode.
+func (c *LottieController) IsCompleted() bool {
+	return c.completed
+}
+
+// Progress returns the playback position, from 0.0 to 1.0.
+func (c *LottieController) Progress() float64 {
+	return c.ctrl.Value
+}
+
+// Frame returns the frame displayed at the current position.
+func (c *LottieController) Frame() int {
+	count := c.FrameCount()
+	if count == 0 {
+		return 0
+	}
+	// The epsilon keeps frames reached through SeekFrame from rounding down
+	// to the previous frame.
+	frame := int(math.Floor(c.ctrl.Value*c.duration.Seconds()*c.frameRate + 1e-6))
+	return max(0, min(frame, count-1))
+}
+
+// FrameCount returns the number of frames in the attached animation.
+func (c *LottieController) FrameCount() int {
+	return int(math.Round(c.duration.Seconds() * c.frameRate))
+}
+
+// AddListener adds a callback that fires whenever the position or playback
+// state changes. Returns an unsubscribe function.
+func (c *LottieController) AddListener(fn func()) func() {
+	return c.listeners.AddListener(fn)
+}
+
+// AddFrameListener adds a callback that fires each time the displayed frame
+// changes, with the new frame number. Frames skipped by a slow or fast
+// display are not reported. Returns an unsubscribe function.
+func (c *LottieController) AddFrameListener(fn func(frame int)) func() {
+	id := c.nextListenerID
+	c.nextListenerID++
+	c.frameListeners[id] = fn
+	return func() {
+		delete(c.frameListeners, id)
+	}
+}
+
+// AddCompleteListener adds a callback that fires when playback reaches the
+// end in [LottiePlayOnce] mode. Returns an unsubscribe function.
+func (c *LottieController) AddCompleteListener(fn func()) func() {
+	id := c.nextListenerID
+	c.nextListenerID++
+	c.completeListeners[id] = fn
+	return func() {
+		delete(c.completeListeners, id)
+	}
+}
+
+// Dispose stops playback and releases listeners.
+func (c *LottieController) Dispose() {
+	c.disposed = true
+	c.playing = false
+	c.ctrl.Dispose()
+	c.listeners.Dispose()
+	c.frameListeners = nil
+	c.completeListeners = nil
+}
+
+// attach sets the timing of the animation being played. A zero duration
+// detaches the controller, holding playback until an animation is attached.
+func (c *LottieController) attach(duration time.Duration, frameRate float64) {
+	if c.disposed || (duration == c.duration && frameRate == c.frameRate) {
+		return
+	}
+	c.duration = duration
+	c.frameRate = frameRate
+	c.ctrl.Stop()
+	c.ctrl.Duration = c.scaledDuration()
+	c.lastFrame = -1
+	if c.playing {
+		c.resume()
+	}
+	c.update()
+}
+
+func (c *LottieController) scaledDuration() time.Duration {
+	return time.Duration(float64(c.duration) / c.speed)
+}
+
+// resume runs the underlying controller toward the end of the current
+// direction of play.
+func (c *LottieController) resume() {
+	if c.disposed || c.duration <= 0 {
+		return
+	}
+	if c.reversing {
+		c.ctrl.Reverse()
+	} else {
+		c.ctrl.Forward()
+	}
+}
+
+func (c *LottieController) onStatus(status animation.AnimationStatus) {
+	c.update()
+	if !c.playing {
+		return
+	}
+	switch status {
+	case animation.AnimationCompleted:
+		switch c.repeat {
+		case LottieLoop:
+			c.ctrl.Value = 0
+			c.ctrl.Forward()
+		case LottieBounce:
+			c.reversing = true
+			c.ctrl.Reverse()
+		default:
+			c.playing = false
+			c.completed = true
+			c.notifyListeners()
+			for _, listener := range c.completeListeners {
+				listener()
+			}
+		}
+	case animation.AnimationDismissed:
+		if c.reversing {
+			c.reversing = false
+			c.ctrl.Forward()
+		}
+	}
+}
+
+// update notifies listeners of a position change, and frame listeners when
+// the position lands on a new frame.
+func (c *LottieController) update() {
+	c.notifyListeners()
+	if c.FrameCount() == 0 {
+		return
+	}
+	frame := c.Frame()
+	if frame == c.lastFrame {
+		return
+	}
+	c.lastFrame = frame
+	for _, listener := range c.frameListeners {
+		listener(frame)
+	}
+}
+
+func (c *LottieController) notifyListeners() {
+	c.listeners.Notify()
+}
@@ -0,0 +1,142 @@
+package widgets
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/go-drift/drift/pkg/animation"
+)
+
+type lottieTestClock struct{ now time.Time }
+
+func (c *lottieTestClock) Now() time.Time { return c.now }
+
+// useLottieTestClock installs a manual animation clock for the test and
+// returns a function that advances it and steps active tickers.
+func useLottieTestClock(t *testing.T) func(d time.Duration) {
+	t.Helper()
+	clock := &lottieTestClock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+	prev := animation.SetClock(clock)
+	t.Cleanup(func() { animation.SetClock(prev) })
+	return func(d time.Duration) {
+		clock.now = clock.now.Add(d)
+		animation.StepTickers()
+	}
+}
+
+func TestLottieController_FrameListener(t *testing.T) {
+	advance := useLottieTestClock(t)
+	c := NewLottieController()
+	defer c.Dispose()
+
+	var frames []int
+	c.AddFrameListener(func(frame int) { frames = append(frames, frame) })
+	c.attach(time.Second, 30)
+	c.Play()
+
+	advance(100 * time.Millisecond)
+	if f := c.Frame(); f != 3 {
+		t.Fatalf("expected frame 3 after 100ms at 30fps, got %d", f)
+	}
+	if !slices.Equal(frames, []int{0, 3}) {
+		t.Fatalf("expected frame notifications [0 3], got %v", frames)
+	}
+
+	c.Pause()
+	c.SeekFrame(10)
+	if f := c.Frame(); f != 10 {
+		t.Fatalf("expected frame 10 after SeekFrame, got %d", f)
+	}
+	advance(100 * time.Millisecond)
+	if f := c.Frame(); f != 10 {
+		t.Fatalf("expected paused playback to hold frame 10, got %d", f)
+	}
+	if n := c.FrameCount(); n != 30 {
+		t.Fatalf("expected 30 frames, got %d", n)
+	}
+}
+
+func TestLottieController_Speed(t *testing.T) {
+	advance := useLottieTestClock(t)
+	c := NewLottieController()
+	defer c.Dispose()
+	c.attach(time.Second, 30)
+	c.SetSpeed(2)
+	c.Play()
+
+	advance(250 * time.Millisecond)
+	if p := c.Progress(); p < 0.49 || p > 0.51 {
+		t.Fatalf("expected progress 0.5 at double speed, got %v", p)
+	}
+
+	c.SetSpeed(0)
+	if s := c.Speed(); s != 2 {
+		t.Fatalf("expected non-positive speed to be ignored, got %v", s)
+	}
+}
+
+func TestLottieController_PlayOnceCompletes(t *testing.T) {
+	advance := useLottieTestClock(t)
+	c := NewLottieController()
+	defer c.Dispose()
+
+	completions := 0
+	c.AddCompleteListener(func() { completions++ })
+	c.attach(time.Second, 30)
+	c.Play()
+	advance(2 * time.Second)
+
+	if completions != 1 || !c.IsCompleted() || c.IsPlaying() {
+		t.Fatalf("expected one completion, got %d (completed=%v, playing=%v)", completions, c.IsCompleted(), c.IsPlaying())
+	}
+	if f := c.Frame(); f != 29 {
+		t.Fatalf("expected last frame 29, got %d", f)
+	}
+
+	// Playing again restarts from the first frame.
+	c.Play()
+	if p := c.Progress(); p != 0 {
+		t.Fatalf("expected replay to start at 0, got %v", p)
+	}
+}
+
+func TestLottieController_LoopAndBounce(t *testing.T) {
+	advance := useLottieTestClock(t)
+	c := NewLottieController()
+	defer c.Dispose()
+	c.AddCompleteListener(func() { t.Fatal("looping playback should not complete") })
+	c.attach(time.Second, 30)
+	c.SetRepeat(LottieLoop)
+	c.Play()
+
+	advance(time.Second)
+	advance(500 * time.Millisecond)
+	if p := c.Progress(); p < 0.49 || p > 0.51 {
+		t.Fatalf("expected loop to restart, got progress %v", p)
+	}
+
+	c.SetRepeat(LottieBounce)
+	advance(500 * time.Millisecond)
+	advance(250 * time.Millisecond)
+	if p := c.Progress(); p < 0.74 || p > 0.76 {
+		t.Fatalf("expected bounce to reverse, got progress %v", p)
+	}
+}
+
+func TestLottieController_PlayWaitsForAttach(t *testing.T) {
+	advance := useLottieTestClock(t)
+	c := NewLottieController()
+	defer c.Dispose()
+	c.Play()
+	advance(100 * time.Millisecond)
+	if p := c.Progress(); p != 0 {
+		t.Fatalf("expected no progress before attach, got %v", p)
+	}
+
+	c.attach(time.Second, 30)
+	advance(100 * time.Millisecond)
+	if p := c.Progress(); p < 0.09 || p > 0.11 {
+		t.Fatalf("expected playback to start on attach, got progress %v", p)
+	}
+}
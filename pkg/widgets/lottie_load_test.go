@@ -0,0 +1,40 @@
+package widgets_test
+
+import (
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+	drifttest "github.com/go-drift/drift/pkg/testing"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+func TestLottie_AssetLoadErrorShowsErrorBuilder(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	tester.SetSize(graphics.Size{Width: 400, Height: 400})
+
+	var loadErr error
+	tester.PumpWidget(widgets.SizedBox{Width: 400, Height: 400, Child: widgets.Lottie{
+		AssetFS:     fstest.MapFS{},
+		Asset:       "missing.json",
+		Width:       100,
+		Height:      100,
+		Placeholder: widgets.Text{Content: "Loading"},
+		ErrorBuilder: func(err error) core.Widget {
+			loadErr = err
+			return widgets.Text{Content: "Failed"}
+		},
+	}})
+	if !tester.Find(drifttest.ByText("Loading")).Exists() {
+		t.Fatal("expected placeholder while loading")
+	}
+
+	if err := tester.PumpUntil(func() bool { return loadErr != nil }, 2*time.Second); err != nil {
+		t.Fatal(err)
+	}
+	if !tester.Find(drifttest.ByText("Failed")).Exists() {
+		t.Fatal("expected error widget after load failure")
+	}
+}
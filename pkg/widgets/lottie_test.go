@@ -104,3 +104,45 @@ func TestRenderLottie_HitTest_NilSource(t *testing.T) {
 		t.Fatal("expected no hit outside zero-size widget")
 	}
 }
+
+func TestLottieNeedsReload(t *testing.T) {
+	tests := []struct {
+		name string
+		old  Lottie
+		next Lottie
+		want bool
+	}{
+		{
+			name: "same asset",
+			old:  Lottie{Asset: "a.json"},
+			next: Lottie{Asset: "a.json"},
+			want: false,
+		},
+		{
+			name: "asset changed",
+			old:  Lottie{Asset: "a.json"},
+			next: Lottie{Asset: "b.json"},
+			want: true,
+		},
+		{
+			name: "url headers changed",
+			old:  Lottie{URL: "https://example.com/a.json", Headers: map[string]string{"Authorization": "one"}},
+			next: Lottie{URL: "https://example.com/a.json", Headers: map[string]string{"Authorization": "two"}},
+			want: true,
+		},
+		{
+			name: "nothing to load",
+			old:  Lottie{},
+			next: Lottie{Width: 100},
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := lottieNeedsReload(tt.old, tt.next); got != tt.want {
+				t.Fatalf("lottieNeedsReload() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
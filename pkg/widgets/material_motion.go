@@ -0,0 +1,243 @@
+package widgets
+
+import (
+	"math"
+	"time"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/layout"
+)
+
+// Shared-axis motion values, following the Material motion guidelines.
+const (
+	// sharedAxisDistance is how far children slide on the X and Y axes, in
+	// logical pixels.
+	sharedAxisDistance = 30.0
+	// sharedAxisFadeSplit is the point where the outgoing child has faded
+	// out and the incoming child starts to fade in.
+	sharedAxisFadeSplit = 0.3
+	// sharedAxisZFarScale is the scale of a child beyond rest on the Z axis,
+	// and sharedAxisZNearScale the scale of one in front of it.
+	sharedAxisZFarScale  = 0.8
+	sharedAxisZNearScale = 1.1
+)
+
+// SharedAxis is the axis along which a shared-axis transition moves.
+type SharedAxis int
+
+const (
+	// SharedAxisX slides children horizontally, for moving between
+	// sibling pages such as steps in a flow.
+	SharedAxisX SharedAxis = iota
+	// SharedAxisY slides children vertically, for moving through
+	// vertically ordered content.
+	SharedAxisY
+	// SharedAxisZ scales children, for moving between parent and child
+	// levels of a hierarchy.
+	SharedAxisZ
+)
+
+// String returns a human-readable label for the axis.
+func (a SharedAxis) String() string {
+	switch a {
+	case SharedAxisX:
+		return "x"
+	case SharedAxisY:
+		return "y"
+	case SharedAxisZ:
+		return "z"
+	default:
+		return "unknown"
+	}
+}
+
+// SharedAxisSwitcher swaps its child with a Material shared-axis
+// transition: the old child fades out while moving along the axis, then the
+// new child fades in while moving along the same axis, so the two read as
+// one spatial step.
+//
+//	widgets.SharedAxisSwitcher{
+//	    Axis:     widgets.SharedAxisX,
+//	    Reverse:  s.step < s.previousStep,
+//	    Duration: 300 * time.Millisecond,
+//	    Child:    stepView{key: s.step},
+//	}
+//
+// Children are matched like in [AnimatedSwitcher], so give same-typed
+// children distinct keys.
+type SharedAxisSwitcher struct {
+	core.StatelessBase
+
+	// Axis is the axis the children move along.
+	Axis SharedAxis
+	// Reverse moves backward. Children normally move left on the X axis and
+	// up on the Y axis, with the incoming child growing into place on the Z
+	// axis; reversed, they move right or down, and the incoming child
+	// shrinks into place.
+	Reverse bool
+	// Duration is the length of each transition.
+	Duration time.Duration
+	// Curve transforms the animation progress. If nil, uses linear interpolation.
+	Curve func(float64) float64
+	// Alignment positions children of different sizes within the switcher.
+	// The zero value centers them.
+	Alignment layout.Alignment
+	// Child is the current child.
+	Child core.Widget
+}
+
+func (s SharedAxisSwitcher) Build(ctx core.BuildContext) core.Widget {
+	return AnimatedSwitcher{
+		Duration:  s.Duration,
+		Curve:     s.Curve,
+		Alignment: s.Alignment,
+		Transition: func(child core.Widget, t float64) core.Widget {
+			return sharedAxisMotion(s.Axis, s.Reverse, false, t, child)
+		},
+		LeavingTransition: func(child core.Widget, t float64) core.Widget {
+			return sharedAxisMotion(s.Axis, s.Reverse, true, t, child)
+		},
+		Child: s.Child,
+	}
+}
+
+// sharedAxisMotion positions child at progress t of a shared-axis
+// transition, where t runs from 0 (hidden) to 1 (shown) for both incoming
+// and outgoing children.
+func sharedAxisMotion(axis SharedAxis, reverse, leaving bool, t float64, child core.Widget) core.Widget {
+	// Distance from rest, from 0 (shown) to 1 (hidden), and which side of
+	// rest the child is on: incoming children arrive from ahead and
+	// outgoing children leave behind.
+	p := 1 - t
+	sign := 1.0
+	if leaving != reverse {
+		sign = -1
+	}
+
+	var opacity float64
+	if leaving {
+		opacity = 1 - math.Min(1, p/sharedAxisFadeSplit)
+	} else {
+		opacity = math.Max(0, (t-sharedAxisFadeSplit)/(1-sharedAxisFadeSplit))
+	}
+
+	box := motionBox{scale: 1, opacity: opacity, child: child}
+	switch axis {
+	case SharedAxisX:
+		box.offset.X = sign * sharedAxisDistance * p
+	case SharedAxisY:
+		box.offset.Y = sign * sharedAxisDistance * p
+	case SharedAxisZ:
+		// Children on the viewer's side of rest appear enlarged, those
+		// beyond it shrunk.
+		if sign < 0 {
+			box.scale = 1 + (sharedAxisZNearScale-1)*p
+		} else {
+			box.scale = 1 + (sharedAxisZFarScale-1)*p
+		}
+	}
+	return box
+}
+
+// motionBox paints its child translated, scaled about its center, and
+// faded. The box keeps its child's size, while hit testing follows the
+// transformed drawing.
+type motionBox struct {
+	core.RenderObjectBase
+	offset  graphics.Offset
+	scale   float64
+	opacity float64
+	child   core.Widget
+}
+
+func (m motionBox) ChildWidget() core.Widget {
+	return m.child
+}
+
+func (m motionBox) CreateRenderObject(ctx core.BuildContext) layout.RenderObject {
+	box := &renderMotionBox{offset: m.offset, scale: m.scale, opacity: m.opacity}
+	box.SetSelf(box)
+	return box
+}
+
+func (m motionBox) UpdateRenderObject(ctx core.BuildContext, renderObject layout.RenderObject) {
+	box, ok := renderObject.(*renderMotionBox)
+	if !ok || (box.offset == m.offset && box.scale == m.scale && box.opacity == m.opacity) {
+		return
+	}
+	box.offset = m.offset
+	box.scale = m.scale
+	box.opacity = m.opacity
+	box.MarkNeedsPaint()
+}
+
+type renderMotionBox struct {
+	layout.RenderBoxBase
+	child   layout.RenderBox
+	offset  graphics.Offset
+	scale   float64
+	opacity float64
+}
+
+func (r *renderMotionBox) SetChild(child layout.RenderObject) {
+	layout.SetParentOnChild(r.child, nil)
+	r.child = layout.AsRenderBox(child)
+	layout.SetParentOnChild(r.child, r)
+}
+
+func (r *renderMotionBox) VisitChildren(visitor func(layout.RenderObject)) {
+	if r.child != nil {
+		visitor(r.child)
+	}
+}
+
+func (r *renderMotionBox) PerformLayout() {
+	constraints := r.Constraints()
+	if r.child == nil {
+		r.SetSize(constraints.Constrain(graphics.Size{}))
+		return
+	}
+	r.child.Layout(constraints, true)
+	r.child.SetParentData(&layout.BoxParentData{})
+	r.SetSize(r.child.Size())
+}
+
+func (r *renderMotionBox) Paint(ctx *layout.PaintContext) {
+	if r.child == nil || r.scale <= 0 || r.opacity <= 0 {
+		return
+	}
+	size := r.Size()
+	cx, cy := size.Width/2, size.Height/2
+	if r.opacity < 1 {
+		bounds := graphics.RectFromLTWH(0, 0, size.Width, size.Height)
+		if r.scale > 1 {
+			// Grow the layer with the drawing so it is not clipped.
+			bounds = graphics.RectFromLTWH(cx-cx*r.scale, cy-cy*r.scale, size.Width*r.scale, size.Height*r.scale)
+		}
+		ctx.Canvas.SaveLayerAlpha(bounds.Translate(r.offset.X, r.offset.Y), r.opacity)
+	} else {
+		ctx.Canvas.Save()
+	}
+	ctx.Canvas.Translate(r.offset.X+cx, r.offset.Y+cy)
+	ctx.Canvas.Scale(r.scale, r.scale)
+	ctx.Canvas.Translate(-cx, -cy)
+	ctx.PaintChildWithLayer(r.child, graphics.Offset{})
+	ctx.Canvas.Restore()
+}
+
+func (r *renderMotionBox) HitTest(position graphics.Offset, result *layout.HitTestResult) bool {
+	if r.child == nil || r.scale <= 0 {
+		return false
+	}
+	// Map the position back through the transform to the child's coordinates.
+	cx, cy := r.Size().Width/2, r.Size().Height/2
+	local := graphics.Offset{
+		X: cx + (position.X-r.offset.X-cx)/r.scale,
+		Y: cy + (position.Y-r.offset.Y-cy)/r.scale,
+	}
+	if !layout.WithinBounds(local, r.Size()) {
+		return false
+	}
+	return r.child.HitTest(local, result)
+}
@@ -0,0 +1,41 @@
+package widgets
+
+import (
+	"math"
+	"testing"
+
+	"github.com/go-drift/drift/pkg/graphics"
+)
+
+func TestSharedAxisMotion(t *testing.T) {
+	tests := []struct {
+		name    string
+		axis    SharedAxis
+		reverse bool
+		leaving bool
+		t       float64
+		want    motionBox
+	}{
+		{"incoming x starts ahead and hidden", SharedAxisX, false, false, 0, motionBox{offset: graphics.Offset{X: 30}, scale: 1}},
+		{"incoming x fades in late", SharedAxisX, false, false, 0.65, motionBox{offset: graphics.Offset{X: 10.5}, scale: 1, opacity: 0.5}},
+		{"outgoing x leaves behind", SharedAxisX, false, true, 0.85, motionBox{offset: graphics.Offset{X: -4.5}, scale: 1, opacity: 0.5}},
+		{"reversed y arrives from above", SharedAxisY, true, false, 0.5, motionBox{offset: graphics.Offset{Y: -15}, scale: 1, opacity: 2.0 / 7}},
+		{"incoming z grows into place", SharedAxisZ, false, false, 0.5, motionBox{scale: 0.9, opacity: 2.0 / 7}},
+		{"outgoing z grows away", SharedAxisZ, false, true, 0, motionBox{scale: 1.1}},
+		{"reversed outgoing z shrinks away", SharedAxisZ, true, true, 0, motionBox{scale: 0.8}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := sharedAxisMotion(tt.axis, tt.reverse, tt.leaving, tt.t, nil).(motionBox)
+			if !near(got.offset.X, tt.want.offset.X) || !near(got.offset.Y, tt.want.offset.Y) ||
+				!near(got.scale, tt.want.scale) || !near(got.opacity, tt.want.opacity) {
+				t.Errorf("got offset %+v scale %v opacity %v, want offset %+v scale %v opacity %v",
+					got.offset, got.scale, got.opacity, tt.want.offset, tt.want.scale, tt.want.opacity)
+			}
+		})
+	}
+}
+
+func near(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
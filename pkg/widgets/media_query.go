@@ -0,0 +1,279 @@
+package widgets
+
+import (
+	"reflect"
+	"sync"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/layout"
+	"github.com/go-drift/drift/pkg/platform"
+)
+
+// MediaQueryData describes the screen and the user's display preferences.
+type MediaQueryData struct {
+	// Size is the logical size of the window.
+	Size graphics.Size
+	// DevicePixelRatio is the number of physical pixels per logical pixel.
+	DevicePixelRatio float64
+	// Padding is the area obscured by system UI such as the status bar and
+	// notch, as reported by [SafeAreaOf].
+	Padding layout.EdgeInsets
+	// ViewInsets is the area obscured by the on-screen keyboard.
+	ViewInsets layout.EdgeInsets
+	// TextScaleFactor is the user's preferred text size relative to the
+	// default.
+	TextScaleFactor float64
+	// PlatformBrightness is the system light or dark appearance.
+	PlatformBrightness platform.Brightness
+	// DynamicColors is the system color palette on Android 12 and later,
+	// and zero elsewhere.
+	DynamicColors platform.DynamicColors
+}
+
+// DefaultMediaQueryData returns the data used when no [MediaQuery] is in the
+// tree: a zero size with unit pixel ratio and text scale.
+func DefaultMediaQueryData() MediaQueryData {
+	return MediaQueryData{DevicePixelRatio: 1, TextScaleFactor: 1}
+}
+
+// MediaQueryAspect identifies which part of [MediaQueryData] a widget depends
+// on.
+type MediaQueryAspect int
+
+const (
+	MediaQueryAspectSize MediaQueryAspect = iota
+	MediaQueryAspectDevicePixelRatio
+	MediaQueryAspectPadding
+	MediaQueryAspectViewInsets
+	MediaQueryAspectTextScaleFactor
+	MediaQueryAspectPlatformBrightness
+	MediaQueryAspectDynamicColors
+)
+
+// MediaQuery provides [MediaQueryData] to descendants. The engine inserts one
+// above the app, kept up to date by [MediaQueryProvider]; insert another to
+// override the data for a subtree, for example in tests.
+//
+// It implements [core.AspectAwareInheritedWidget], so widgets reading a
+// single field with an accessor such as [MediaQuerySizeOf] only rebuild when
+// that field changes.
+type MediaQuery struct {
+	core.InheritedBase
+	Data  MediaQueryData
+	Child core.Widget
+}
+
+func (m MediaQuery) ChildWidget() core.Widget { return m.Child }
+
+func (m MediaQuery) ShouldRebuildDependents(oldWidget core.InheritedWidget) bool {
+	if old, ok := oldWidget.(MediaQuery); ok {
+		return m.Data != old.Data
+	}
+	return true
+}
+
+func (m MediaQuery) ShouldRebuildDependent(oldWidget core.InheritedWidget, aspects map[any]struct{}) bool {
+	old, ok := oldWidget.(MediaQuery)
+	if !ok {
+		return true
+	}
+	for aspect := range aspects {
+		switch aspect.(MediaQueryAspect) {
+		case MediaQueryAspectSize:
+			if m.Data.Size != old.Data.Size {
+				return true
+			}
+		case MediaQueryAspectDevicePixelRatio:
+			if m.Data.DevicePixelRatio != old.Data.DevicePixelRatio {
+				return true
+			}
+		case MediaQueryAspectPadding:
+			if m.Data.Padding != old.Data.Padding {
+				return true
+			}
+		case MediaQueryAspectViewInsets:
+			if m.Data.ViewInsets != old.Data.ViewInsets {
+				return true
+			}
+		case MediaQueryAspectTextScaleFactor:
+			if m.Data.TextScaleFactor != old.Data.TextScaleFactor {
+				return true
+			}
+		case MediaQueryAspectPlatformBrightness:
+			if m.Data.PlatformBrightness != old.Data.PlatformBrightness {
+				return true
+			}
+		case MediaQueryAspectDynamicColors:
+			if m.Data.DynamicColors != old.Data.DynamicColors {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+var _ core.AspectAwareInheritedWidget = MediaQuery{}
+
+var mediaQueryType = reflect.TypeFor[MediaQuery]()
+
+// MediaQueryOf returns the nearest [MediaQueryData], or
+// [DefaultMediaQueryData] if there is none. Widgets calling this rebuild
+// when any field changes; prefer the single-field accessors when only one is
+// needed.
+func MediaQueryOf(ctx core.BuildContext) MediaQueryData {
+	if mq, ok := ctx.DependOnInherited(mediaQueryType, nil).(MediaQuery); ok {
+		return mq.Data
+	}
+	return DefaultMediaQueryData()
+}
+
+// mediaQueryAspectOf depends on one aspect of the nearest MediaQuery and
+// returns its data.
+func mediaQueryAspectOf(ctx core.BuildContext, aspect MediaQueryAspect) MediaQueryData {
+	if mq, ok := ctx.DependOnInherited(mediaQueryType, aspect).(MediaQuery); ok {
+		return mq.Data
+	}
+	return DefaultMediaQueryData()
+}
+
+// MediaQuerySizeOf returns the logical window size.
+// Widgets calling this will only rebuild when the size changes.
+func MediaQuerySizeOf(ctx core.BuildContext) graphics.Size {
+	return mediaQueryAspectOf(ctx, MediaQueryAspectSize).Size
+}
+
+// MediaQueryDevicePixelRatioOf returns the number of physical pixels per
+// logical pixel.
+// Widgets calling this will only rebuild when the ratio changes.
+func MediaQueryDevicePixelRatioOf(ctx core.BuildContext) float64 {
+	return mediaQueryAspectOf(ctx, MediaQueryAspectDevicePixelRatio).DevicePixelRatio
+}
+
+// MediaQueryPaddingOf returns the area obscured by system UI.
+// Widgets calling this will only rebuild when the padding changes.
+func MediaQueryPaddingOf(ctx core.BuildContext) layout.EdgeInsets {
+	return mediaQueryAspectOf(ctx, MediaQueryAspectPadding).Padding
+}
+
+// MediaQueryViewInsetsOf returns the area obscured by the keyboard.
+// Widgets calling this will only rebuild when the keyboard insets change.
+func MediaQueryViewInsetsOf(ctx core.BuildContext) layout.EdgeInsets {
+	return mediaQueryAspectOf(ctx, MediaQueryAspectViewInsets).ViewInsets
+}
+
+// MediaQueryTextScaleFactorOf returns the user's preferred text scale.
+// Widgets calling this will only rebuild when the text scale changes.
+func MediaQueryTextScaleFactorOf(ctx core.BuildContext) float64 {
+	return mediaQueryAspectOf(ctx, MediaQueryAspectTextScaleFactor).TextScaleFactor
+}
+
+// MediaQueryPlatformBrightnessOf returns the system light or dark appearance.
+// Widgets calling this will only rebuild when dark mode is toggled.
+func MediaQueryPlatformBrightnessOf(ctx core.BuildContext) platform.Brightness {
+	return mediaQueryAspectOf(ctx, MediaQueryAspectPlatformBrightness).PlatformBrightness
+}
+
+// MediaQueryDynamicColorsOf returns the system color palette, which is zero
+// where the system has none.
+// Widgets calling this will only rebuild when the palette changes.
+func MediaQueryDynamicColorsOf(ctx core.BuildContext) platform.DynamicColors {
+	return mediaQueryAspectOf(ctx, MediaQueryAspectDynamicColors).DynamicColors
+}
+
+// MediaQueryProvider is a StatefulWidget that provides [MediaQuery] data
+// from the engine and platform. Size and DevicePixelRatio come from the
+// widget, Padding from the enclosing [SafeAreaProvider], and the keyboard
+// insets, text scale, brightness and dynamic colors from [platform.Keyboard] and
+// [platform.Appearance], whose changes rebuild the provider.
+type MediaQueryProvider struct {
+	core.StatefulBase
+
+	Size             graphics.Size
+	DevicePixelRatio float64
+	Child            core.Widget
+}
+
+func (m MediaQueryProvider) CreateState() core.State {
+	return &mediaQueryProviderState{}
+}
+
+type mediaQueryProviderState struct {
+	core.StateBase
+	viewInsets layout.EdgeInsets
+	appearance platform.AppearanceSettings
+	mu         sync.Mutex
+	scheduled  bool
+}
+
+func (s *mediaQueryProviderState) InitState() {
+	s.viewInsets = layoutInsets(platform.Keyboard.Insets())
+	s.appearance = platform.Appearance.Settings()
+
+	s.OnDispose(platform.Keyboard.AddHandler(func(platform.EdgeInsets) { s.scheduleUpdate() }))
+	s.OnDispose(platform.Appearance.AddHandler(func(platform.AppearanceSettings) { s.scheduleUpdate() }))
+}
+
+// scheduleUpdate applies platform changes on the UI thread, batching rapid
+// updates such as the frames of a keyboard animation.
+func (s *mediaQueryProviderState) scheduleUpdate() {
+	s.mu.Lock()
+	if s.scheduled {
+		s.mu.Unlock()
+		return
+	}
+	s.scheduled = true
+	s.mu.Unlock()
+
+	if !platform.Dispatch(s.applyUpdate) {
+		// Dispatch not available - clear scheduled so future updates can retry
+		s.mu.Lock()
+		s.scheduled = false
+		s.mu.Unlock()
+	}
+}
+
+func (s *mediaQueryProviderState) applyUpdate() {
+	s.mu.Lock()
+	s.scheduled = false
+	s.mu.Unlock()
+
+	viewInsets := layoutInsets(platform.Keyboard.Insets())
+	appearance := platform.Appearance.Settings()
+	if viewInsets == s.viewInsets && appearance == s.appearance {
+		return
+	}
+	s.SetState(func() {
+		s.viewInsets = viewInsets
+		s.appearance = appearance
+	})
+}
+
+func (s *mediaQueryProviderState) Build(ctx core.BuildContext) core.Widget {
+	w := s.Element().Widget().(MediaQueryProvider)
+	ratio := w.DevicePixelRatio
+	if ratio <= 0 {
+		ratio = 1
+	}
+	return MediaQuery{
+		Data: MediaQueryData{
+			Size:               w.Size,
+			DevicePixelRatio:   ratio,
+			Padding:            SafeAreaOf(ctx),
+			ViewInsets:         s.viewInsets,
+			TextScaleFactor:    s.appearance.TextScaleFactor,
+			PlatformBrightness: s.appearance.Brightness,
+			DynamicColors:      s.appearance.DynamicColors,
+		},
+		Child: w.Child,
+	}
+}
+
+func layoutInsets(insets platform.EdgeInsets) layout.EdgeInsets {
+	return layout.EdgeInsets{
+		Top:    insets.Top,
+		Bottom: insets.Bottom,
+		Left:   insets.Left,
+		Right:  insets.Right,
+	}
+}
@@ -0,0 +1,92 @@
+package widgets_test
+
+import (
+	"testing"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/layout"
+	"github.com/go-drift/drift/pkg/platform"
+	drifttest "github.com/go-drift/drift/pkg/testing"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+// mediaQueryProbe calls read on every build.
+type mediaQueryProbe struct {
+	core.StatelessBase
+	read func(ctx core.BuildContext)
+}
+
+func (p mediaQueryProbe) Build(ctx core.BuildContext) core.Widget {
+	p.read(ctx)
+	return widgets.SizedBox{}
+}
+
+func TestMediaQueryProvider_TracksPlatform(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	t.Cleanup(platform.ResetForTest)
+
+	var data widgets.MediaQueryData
+	builds := 0
+	tester.PumpWidget(widgets.MediaQueryProvider{
+		Size:             graphics.Size{Width: 390, Height: 844},
+		DevicePixelRatio: 3,
+		Child: mediaQueryProbe{read: func(ctx core.BuildContext) {
+			data = widgets.MediaQueryOf(ctx)
+			builds++
+		}},
+	})
+	if data.Size != (graphics.Size{Width: 390, Height: 844}) || data.DevicePixelRatio != 3 || data.TextScaleFactor != 1 {
+		t.Fatalf("unexpected initial data %+v", data)
+	}
+
+	platform.Keyboard.SetInsetsForTest(platform.EdgeInsets{Bottom: 291})
+	platform.Appearance.SetSettingsForTest(platform.AppearanceSettings{TextScaleFactor: 1.5, Brightness: platform.BrightnessDark})
+	tester.Pump()
+
+	if data.ViewInsets != (layout.EdgeInsets{Bottom: 291}) {
+		t.Errorf("expected keyboard insets, got %+v", data.ViewInsets)
+	}
+	if data.TextScaleFactor != 1.5 || data.PlatformBrightness != platform.BrightnessDark {
+		t.Errorf("expected appearance settings, got scale %v brightness %v", data.TextScaleFactor, data.PlatformBrightness)
+	}
+	if builds != 2 {
+		t.Errorf("expected both changes applied in one rebuild, got %d builds", builds)
+	}
+}
+
+func TestMediaQuery_ShouldRebuildDependent(t *testing.T) {
+	data := widgets.DefaultMediaQueryData()
+	data.Size = graphics.Size{Width: 400, Height: 800}
+	old := widgets.MediaQuery{Data: data}
+
+	data.PlatformBrightness = platform.BrightnessDark
+	updated := widgets.MediaQuery{Data: data}
+
+	if !updated.ShouldRebuildDependents(old) {
+		t.Error("expected dependents on all data to rebuild")
+	}
+	brightness := map[any]struct{}{widgets.MediaQueryAspectPlatformBrightness: {}}
+	if !updated.ShouldRebuildDependent(old, brightness) {
+		t.Error("expected brightness dependents to rebuild")
+	}
+	size := map[any]struct{}{widgets.MediaQueryAspectSize: {}, widgets.MediaQueryAspectViewInsets: {}}
+	if updated.ShouldRebuildDependent(old, size) {
+		t.Error("expected size and keyboard dependents not to rebuild")
+	}
+}
+
+func TestMediaQueryOf_Default(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	tester.SetSize(graphics.Size{Width: 320, Height: 480})
+
+	var size graphics.Size
+	var scale float64
+	tester.PumpWidget(mediaQueryProbe{read: func(ctx core.BuildContext) {
+		size = widgets.MediaQuerySizeOf(ctx)
+		scale = widgets.MediaQueryTextScaleFactorOf(ctx)
+	}})
+	if size != (graphics.Size{Width: 320, Height: 480}) || scale != 1 {
+		t.Errorf("expected the tester's media query, got size %v and text scale %v", size, scale)
+	}
+}
@@ -0,0 +1,62 @@
+package widgets
+
+import (
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/layout"
+)
+
+// MouseRegion shows Cursor while a mouse or trackpad pointer hovers over its
+// child, such as a resize cursor over a draggable divider. It has no effect
+// on touch input or on devices without a pointer.
+//
+// Android shows the matching system pointer icon. iPadOS has no resize
+// cursors and shows them as a beam along the boundary instead.
+//
+// When regions are nested, the innermost one under the pointer decides.
+type MouseRegion struct {
+	core.RenderObjectBase
+	// Cursor is the cursor to show while hovered.
+	Cursor layout.MouseCursor
+	// Child is the widget the cursor applies to.
+	Child core.Widget
+}
+
+func (m MouseRegion) ChildWidget() core.Widget {
+	return m.Child
+}
+
+func (m MouseRegion) CreateRenderObject(ctx core.BuildContext) layout.RenderObject {
+	box := &renderMouseRegion{cursor: m.Cursor}
+	box.SetSelf(box)
+	return box
+}
+
+func (m MouseRegion) UpdateRenderObject(ctx core.BuildContext, renderObject layout.RenderObject) {
+	if box, ok := renderObject.(*renderMouseRegion); ok {
+		box.cursor = m.Cursor
+	}
+}
+
+type renderMouseRegion struct {
+	renderPassthrough
+	cursor layout.MouseCursor
+}
+
+var _ layout.MouseCursorOwner = (*renderMouseRegion)(nil)
+
+// MouseCursor implements layout.MouseCursorOwner.
+func (r *renderMouseRegion) MouseCursor() layout.MouseCursor {
+	return r.cursor
+}
+
+func (r *renderMouseRegion) HitTest(position graphics.Offset, result *layout.HitTestResult) bool {
+	if !layout.WithinBounds(position, r.Size()) {
+		return false
+	}
+	if r.child != nil {
+		r.child.HitTest(position, result)
+	}
+	result.Add(r)
+	return true
+}
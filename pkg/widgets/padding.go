@@ -17,11 +17,19 @@ import (
 //	Padding{Padding: layout.EdgeInsetsSymmetric(24, 12), Child: child}
 //	Padding{Padding: layout.EdgeInsetsOnly(Left: 8, Right: 8), Child: child}
 //
+// For padding that follows the reading direction, set Directional instead;
+// its Start side is on the left in LTR and on the right in RTL:
+//
+//	Padding{Directional: layout.EdgeInsetsDirectionalOnly(16, 0, 8, 0), Child: child}
+//
 // For padding combined with background color, consider [Container] instead.
 type Padding struct {
 	core.RenderObjectBase
 	Padding layout.EdgeInsets
-	Child   core.Widget
+	// Directional is padding resolved against the ambient [Directionality]
+	// and added to Padding.
+	Directional layout.EdgeInsetsDirectional
+	Child       core.Widget
 }
 
 func (p Padding) ChildWidget() core.Widget {
@@ -29,19 +37,28 @@ func (p Padding) ChildWidget() core.Widget {
 }
 
 func (p Padding) CreateRenderObject(ctx core.BuildContext) layout.RenderObject {
-	pad := &renderPadding{padding: p.Padding}
+	pad := &renderPadding{padding: p.resolve(ctx)}
 	pad.SetSelf(pad)
 	return pad
 }
 
 func (p Padding) UpdateRenderObject(ctx core.BuildContext, renderObject layout.RenderObject) {
 	if pad, ok := renderObject.(*renderPadding); ok {
-		pad.padding = p.Padding
+		pad.padding = p.resolve(ctx)
 		pad.MarkNeedsLayout()
 		pad.MarkNeedsPaint()
 	}
 }
 
+// resolve returns the physical padding. The direction is only looked up
+// when Directional is set, so plain padding does not depend on it.
+func (p Padding) resolve(ctx core.BuildContext) layout.EdgeInsets {
+	if p.Directional == (layout.EdgeInsetsDirectional{}) {
+		return p.Padding
+	}
+	return p.Padding.AddInsets(p.Directional.Resolve(DirectionalityOf(ctx)))
+}
+
 type renderPadding struct {
 	layout.RenderBoxBase
 	child   layout.RenderBox
@@ -0,0 +1,70 @@
+package widgets
+
+import (
+	"reflect"
+
+	"github.com/go-drift/drift/pkg/core"
+)
+
+// PageStorageBucket holds state that outlives the widgets that save it, such
+// as the pane sizes of a [SplitView] inside a tab that is rebuilt each time
+// it is shown. Values are keyed by any comparable value, usually a string
+// naming the widget.
+//
+// A bucket lives in memory only; it does not survive the app being killed.
+// Use [platform.Restoration] for that.
+type PageStorageBucket struct {
+	values map[any]any
+}
+
+// Write stores value under key, replacing the previous value.
+func (b *PageStorageBucket) Write(key, value any) {
+	if b.values == nil {
+		b.values = make(map[any]any)
+	}
+	b.values[key] = value
+}
+
+// Read returns the value stored under key, and false if there is none.
+func (b *PageStorageBucket) Read(key any) (any, bool) {
+	value, ok := b.values[key]
+	return value, ok
+}
+
+// Remove deletes the value stored under key.
+func (b *PageStorageBucket) Remove(key any) {
+	delete(b.values, key)
+}
+
+// PageStorage provides a [PageStorageBucket] to its descendants. Keep the
+// bucket in state above the widgets that come and go, for example above a
+// tab view, so they find their saved values when they are built again:
+//
+//	s.storage = &widgets.PageStorageBucket{}
+//	...
+//	widgets.PageStorage{Bucket: s.storage, Child: tabs}
+type PageStorage struct {
+	core.InheritedBase
+	Bucket *PageStorageBucket
+	Child  core.Widget
+}
+
+func (p PageStorage) ChildWidget() core.Widget { return p.Child }
+
+func (p PageStorage) ShouldRebuildDependents(oldWidget core.InheritedWidget) bool {
+	if old, ok := oldWidget.(PageStorage); ok {
+		return p.Bucket != old.Bucket
+	}
+	return true
+}
+
+var pageStorageType = reflect.TypeFor[PageStorage]()
+
+// PageStorageOf returns the bucket of the nearest [PageStorage], or nil if
+// there is none.
+func PageStorageOf(ctx core.BuildContext) *PageStorageBucket {
+	if p, ok := ctx.DependOnInherited(pageStorageType, nil).(PageStorage); ok {
+		return p.Bucket
+	}
+	return nil
+}
@@ -0,0 +1,226 @@
+package widgets
+
+import (
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/layout"
+	"github.com/go-drift/drift/pkg/platform"
+)
+
+// PagedListView shows the items of a [PagingController] and fetches the
+// next page as the user scrolls near the end. The first page is requested
+// when the list is first shown.
+//
+// While the first page loads, fails, or comes back empty, the matching
+// builder replaces the whole list. Once items have loaded, the next-page
+// loading and error indicators are shown after the last item. Nil builders
+// show nothing.
+//
+// # Styling Model
+//
+// PagedListView is explicit: the indicators are whatever the builders
+// return. Use [theme.PagedListViewOf] for themed progress indicators and
+// retry buttons:
+//
+//	theme.PagedListViewOf(ctx, s.paging, func(ctx core.BuildContext, post Post, index int) core.Widget {
+//	    return postTile(post)
+//	})
+type PagedListView[K, T any] struct {
+	core.StatefulBase
+
+	// Controller loads the pages. Required.
+	Controller *PagingController[K, T]
+	// ItemBuilder builds the loaded item at index.
+	ItemBuilder func(ctx core.BuildContext, item T, index int) core.Widget
+
+	// FirstPageLoadingBuilder is shown while the first page loads.
+	FirstPageLoadingBuilder func(ctx core.BuildContext) core.Widget
+	// FirstPageErrorBuilder is shown when the first page fails to load.
+	// Call retry to try again.
+	FirstPageErrorBuilder func(ctx core.BuildContext, err error, retry func()) core.Widget
+	// NextPageLoadingBuilder is shown after the last item while the next
+	// page loads.
+	NextPageLoadingBuilder func(ctx core.BuildContext) core.Widget
+	// NextPageErrorBuilder is shown after the last item when the next page
+	// fails to load. Call retry to try again.
+	NextPageErrorBuilder func(ctx core.BuildContext, err error, retry func()) core.Widget
+	// NoItemsBuilder is shown when the list is empty.
+	NoItemsBuilder func(ctx core.BuildContext) core.Widget
+
+	// FetchThreshold is how close, in pixels, the scroll position must come
+	// to the end before the next page is fetched. Zero waits for the end.
+	FetchThreshold float64
+
+	// ItemExtent is the fixed extent of each item along the scroll axis. See
+	// [ListViewBuilder].
+	ItemExtent float64
+	// CacheExtent is the number of pixels to render beyond the visible area.
+	CacheExtent float64
+	// ScrollDirection is the axis along which the list scrolls. Defaults to vertical.
+	ScrollDirection Axis
+	// ScrollController manages scroll position and provides scroll notifications.
+	ScrollController *ScrollController
+	// Physics determines how the scroll view responds to user input.
+	Physics ScrollPhysics
+	// Padding is applied around the list content.
+	Padding layout.EdgeInsets
+}
+
+func (p PagedListView[K, T]) CreateState() core.State {
+	return &pagedListViewState[K, T]{}
+}
+
+type pagedListViewState[K, T any] struct {
+	core.StateBase
+	scroll            *ScrollController
+	unsubscribe       func()
+	unsubscribeScroll func()
+}
+
+func (s *pagedListViewState[K, T]) InitState() {
+	w := s.Element().Widget().(PagedListView[K, T])
+	s.attachController(w)
+	s.attachScroll(w)
+}
+
+func (s *pagedListViewState[K, T]) DidUpdateWidget(oldWidget core.StatefulWidget) {
+	old := oldWidget.(PagedListView[K, T])
+	w := s.Element().Widget().(PagedListView[K, T])
+	if old.Controller != w.Controller {
+		s.detachController()
+		s.attachController(w)
+	}
+	if old.ScrollController != w.ScrollController {
+		s.detachScroll()
+		s.attachScroll(w)
+	}
+}
+
+func (s *pagedListViewState[K, T]) Dispose() {
+	s.detachController()
+	s.detachScroll()
+	s.StateBase.Dispose()
+}
+
+func (s *pagedListViewState[K, T]) attachController(w PagedListView[K, T]) {
+	if w.Controller == nil {
+		return
+	}
+	s.unsubscribe = w.Controller.AddListener(func() {
+		s.SetState(nil)
+	})
+}
+
+func (s *pagedListViewState[K, T]) detachController() {
+	if s.unsubscribe != nil {
+		s.unsubscribe()
+		s.unsubscribe = nil
+	}
+}
+
+func (s *pagedListViewState[K, T]) attachScroll(w PagedListView[K, T]) {
+	s.scroll = w.ScrollController
+	if s.scroll == nil {
+		s.scroll = &ScrollController{}
+	}
+	// Scroll listeners can run mid-layout, before the extents are final, so
+	// check on the next frame.
+	s.unsubscribeScroll = s.scroll.AddListener(func() {
+		platform.Dispatch(s.maybeFetch)
+	})
+}
+
+func (s *pagedListViewState[K, T]) detachScroll() {
+	if s.unsubscribeScroll != nil {
+		s.unsubscribeScroll()
+		s.unsubscribeScroll = nil
+	}
+	s.scroll = nil
+}
+
+// maybeFetch requests the next page when the first page has not been
+// requested yet, or when the scroll position is within FetchThreshold of
+// the end. Content shorter than the viewport counts as scrolled to the end.
+func (s *pagedListViewState[K, T]) maybeFetch() {
+	if s.Element() == nil || s.scroll == nil {
+		return
+	}
+	w := s.Element().Widget().(PagedListView[K, T])
+	c := w.Controller
+	if c == nil {
+		return
+	}
+	switch c.Status() {
+	case PagingLoadingFirstPage:
+		c.FetchNextPage()
+	case PagingOngoing:
+		if s.scroll.ViewportExtent() <= 0 {
+			return // not laid out yet
+		}
+		if s.scroll.Offset() >= s.scroll.MaxScrollExtent()-w.FetchThreshold {
+			c.FetchNextPage()
+		}
+	}
+}
+
+func (s *pagedListViewState[K, T]) Build(ctx core.BuildContext) core.Widget {
+	w := s.Element().Widget().(PagedListView[K, T])
+	c := w.Controller
+	if c == nil {
+		return nil
+	}
+	// Check the extent once this frame has been laid out.
+	platform.Dispatch(s.maybeFetch)
+
+	switch c.Status() {
+	case PagingLoadingFirstPage:
+		return buildOptional(ctx, w.FirstPageLoadingBuilder)
+	case PagingFirstPageError:
+		if w.FirstPageErrorBuilder == nil {
+			return nil
+		}
+		return w.FirstPageErrorBuilder(ctx, c.Error(), c.Retry)
+	case PagingNoItems:
+		return buildOptional(ctx, w.NoItemsBuilder)
+	}
+
+	items := c.Items()
+	var footer core.Widget
+	switch c.Status() {
+	case PagingLoadingNextPage:
+		footer = buildOptional(ctx, w.NextPageLoadingBuilder)
+	case PagingNextPageError:
+		if w.NextPageErrorBuilder != nil {
+			footer = w.NextPageErrorBuilder(ctx, c.Error(), c.Retry)
+		}
+	}
+	count := len(items)
+	if footer != nil {
+		count++
+	}
+
+	return ListViewBuilder{
+		ItemCount: count,
+		ItemBuilder: func(ctx core.BuildContext, index int) core.Widget {
+			if index == len(items) {
+				return footer
+			}
+			if w.ItemBuilder == nil {
+				return nil
+			}
+			return w.ItemBuilder(ctx, items[index], index)
+		},
+		ItemExtent:      w.ItemExtent,
+		CacheExtent:     w.CacheExtent,
+		ScrollDirection: w.ScrollDirection,
+		Controller:      s.scroll,
+		Physics:         w.Physics,
+		Padding:         w.Padding,
+	}
+}
+
+func buildOptional(ctx core.BuildContext, builder func(ctx core.BuildContext) core.Widget) core.Widget {
+	if builder == nil {
+		return nil
+	}
+	return builder(ctx)
+}
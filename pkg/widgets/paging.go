@@ -0,0 +1,224 @@
+package widgets
+
+import (
+	"fmt"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/platform"
+)
+
+// PagingStatus describes where a [PagingController] is in loading its pages.
+type PagingStatus int
+
+const (
+	// PagingLoadingFirstPage means no page has loaded yet.
+	PagingLoadingFirstPage PagingStatus = iota
+	// PagingFirstPageError means the first page failed to load.
+	PagingFirstPageError
+	// PagingOngoing means some pages have loaded and more are available.
+	PagingOngoing
+	// PagingLoadingNextPage means a page after the first is loading.
+	PagingLoadingNextPage
+	// PagingNextPageError means a page after the first failed to load.
+	PagingNextPageError
+	// PagingCompleted means the last page has loaded.
+	PagingCompleted
+	// PagingNoItems means the first page was also the last, and was empty.
+	PagingNoItems
+)
+
+// String returns the status name.
+func (s PagingStatus) String() string {
+	switch s {
+	case PagingLoadingFirstPage:
+		return "loading-first-page"
+	case PagingFirstPageError:
+		return "first-page-error"
+	case PagingOngoing:
+		return "ongoing"
+	case PagingLoadingNextPage:
+		return "loading-next-page"
+	case PagingNextPageError:
+		return "next-page-error"
+	case PagingCompleted:
+		return "completed"
+	case PagingNoItems:
+		return "no-items"
+	default:
+		return fmt.Sprintf("PagingStatus(%d)", int(s))
+	}
+}
+
+// Page is one page of results returned by a [PagingController]'s fetch
+// function.
+type Page[K, T any] struct {
+	// Items are the page's items, appended after those already loaded.
+	Items []T
+	// NextKey is passed to the fetch function for the following page.
+	NextKey K
+	// Last reports that no pages follow this one.
+	Last bool
+}
+
+// PagingController loads a list one page at a time. K is the page key, such
+// as a page number or an opaque cursor, and T is the item type.
+//
+// The fetch function runs on a background goroutine; results are applied on
+// the UI thread through [platform.Dispatch], and listeners are notified of
+// every status change. A stale result, from before a [PagingController.Refresh]
+// or after [PagingController.Dispose], is discarded.
+//
+//	s.paging = widgets.NewPagingController(1, func(page int) (widgets.Page[int, Post], error) {
+//	    posts, err := api.Posts(page)
+//	    if err != nil {
+//	        return widgets.Page[int, Post]{}, err
+//	    }
+//	    return widgets.Page[int, Post]{Items: posts, NextKey: page + 1, Last: len(posts) == 0}, nil
+//	})
+//	core.UseDisposable(s, s.paging)
+//
+// [PagedListView] shows the items and requests pages as the user scrolls.
+type PagingController[K, T any] struct {
+	firstKey K
+	fetch    func(key K) (Page[K, T], error)
+
+	items      []T
+	nextKey    K
+	status     PagingStatus
+	err        error
+	loading    bool
+	generation int
+
+	listeners core.Notifier
+}
+
+// NewPagingController creates a controller that loads pages with fetch,
+// starting at firstKey. Nothing is fetched until [PagingController.FetchNextPage]
+// is called, which [PagedListView] does when it is shown.
+func NewPagingController[K, T any](firstKey K, fetch func(key K) (Page[K, T], error)) *PagingController[K, T] {
+	return &PagingController[K, T]{
+		firstKey: firstKey,
+		fetch:    fetch,
+		nextKey:  firstKey,
+	}
+}
+
+// Items returns the items loaded so far. The slice must not be modified.
+func (c *PagingController[K, T]) Items() []T {
+	return c.items
+}
+
+// Status returns the current loading status.
+func (c *PagingController[K, T]) Status() PagingStatus {
+	return c.status
+}
+
+// Error returns the error from the last failed fetch, or nil.
+func (c *PagingController[K, T]) Error() error {
+	return c.err
+}
+
+// NextKey returns the key of the next page to fetch.
+func (c *PagingController[K, T]) NextKey() K {
+	return c.nextKey
+}
+
+// FetchNextPage starts loading the next page. It does nothing while a page
+// is loading, after an error (use Retry), or once the last page has loaded.
+func (c *PagingController[K, T]) FetchNextPage() {
+	if c.loading || c.fetch == nil {
+		return
+	}
+	switch c.status {
+	case PagingLoadingFirstPage:
+	case PagingOngoing:
+		c.setStatus(PagingLoadingNextPage)
+	default:
+		return
+	}
+	c.loading = true
+
+	generation, key, fetch := c.generation, c.nextKey, c.fetch
+	go func() {
+		page, err := fetch(key)
+		platform.Dispatch(func() {
+			if generation == c.generation {
+				c.complete(page, err)
+			}
+		})
+	}()
+}
+
+// complete applies a fetch result.
+func (c *PagingController[K, T]) complete(page Page[K, T], err error) {
+	c.loading = false
+	first := c.status == PagingLoadingFirstPage
+	if err != nil {
+		c.err = err
+		if first {
+			c.setStatus(PagingFirstPageError)
+		} else {
+			c.setStatus(PagingNextPageError)
+		}
+		return
+	}
+	c.err = nil
+	c.items = append(c.items, page.Items...)
+	c.nextKey = page.NextKey
+	switch {
+	case page.Last && len(c.items) == 0:
+		c.setStatus(PagingNoItems)
+	case page.Last:
+		c.setStatus(PagingCompleted)
+	default:
+		c.setStatus(PagingOngoing)
+	}
+}
+
+// Retry fetches the page that failed to load.
+func (c *PagingController[K, T]) Retry() {
+	switch c.status {
+	case PagingFirstPageError:
+		c.setStatus(PagingLoadingFirstPage)
+	case PagingNextPageError:
+		c.setStatus(PagingOngoing)
+	default:
+		return
+	}
+	c.FetchNextPage()
+}
+
+// Refresh discards every loaded item and loads again from the first page.
+// A fetch already in flight is ignored when it completes.
+func (c *PagingController[K, T]) Refresh() {
+	c.generation++
+	c.items = nil
+	c.nextKey = c.firstKey
+	c.err = nil
+	c.loading = false
+	c.status = PagingLoadingFirstPage
+	c.notifyListeners()
+	c.FetchNextPage()
+}
+
+// AddListener registers a callback for status and item changes. Returns an
+// unsubscribe function.
+func (c *PagingController[K, T]) AddListener(listener func()) func() {
+	return c.listeners.AddListener(listener)
+}
+
+// Dispose discards any fetch in flight and removes all listeners.
+func (c *PagingController[K, T]) Dispose() {
+	c.generation++
+	c.loading = false
+	c.listeners.Dispose()
+}
+
+func (c *PagingController[K, T]) setStatus(status PagingStatus) {
+	c.status = status
+	c.notifyListeners()
+}
+
+func (c *PagingController[K, T]) notifyListeners() {
+	c.listeners.Notify()
+}
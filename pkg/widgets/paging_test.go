@@ -0,0 +1,135 @@
+package widgets_test
+
+import (
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+	drifttest "github.com/go-drift/drift/pkg/testing"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+func TestPagedListView_LoadsPagesOnScroll(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	tester.SetSize(graphics.Size{Width: 400, Height: 600})
+
+	paging := widgets.NewPagingController(0, func(page int) (widgets.Page[int, int], error) {
+		items := make([]int, 20)
+		for i := range items {
+			items[i] = page*20 + i
+		}
+		return widgets.Page[int, int]{Items: items, NextKey: page + 1, Last: page == 1}, nil
+	})
+	defer paging.Dispose()
+	scroll := &widgets.ScrollController{}
+
+	// The fixed-size parent keeps the root render object stable while the
+	// list replaces its loading state.
+	tester.PumpWidget(widgets.SizedBox{Width: 400, Height: 600, Child: widgets.PagedListView[int, int]{
+		Controller:       paging,
+		ScrollController: scroll,
+		ItemBuilder: func(ctx core.BuildContext, item, index int) core.Widget {
+			return widgets.SizedBox{Height: 50}
+		},
+	}})
+	tester.Pump()
+
+	if err := tester.PumpUntil(func() bool { return paging.Status() == widgets.PagingOngoing }, 2*time.Second); err != nil {
+		t.Fatal(err)
+	}
+	if n := len(paging.Items()); n != 20 {
+		t.Fatalf("expected 20 items after the first page, got %d", n)
+	}
+	// The first page fills the viewport, so nothing more loads until scrolled.
+	tester.Pump()
+	tester.Pump()
+	if s := paging.Status(); s != widgets.PagingOngoing {
+		t.Fatalf("expected no fetch before scrolling, got status %v", s)
+	}
+
+	scroll.JumpTo(scroll.MaxScrollExtent())
+	if err := tester.PumpUntil(func() bool { return paging.Status() == widgets.PagingCompleted }, 2*time.Second); err != nil {
+		t.Fatal(err)
+	}
+	if n := len(paging.Items()); n != 40 {
+		t.Errorf("expected 40 items after the last page, got %d", n)
+	}
+}
+
+func TestPagedListView_FirstPageErrorAndRetry(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	tester.SetSize(graphics.Size{Width: 400, Height: 600})
+
+	fail := true
+	paging := widgets.NewPagingController(0, func(page int) (widgets.Page[int, string], error) {
+		if fail {
+			return widgets.Page[int, string]{}, errors.New("offline")
+		}
+		return widgets.Page[int, string]{Items: []string{"a"}, Last: true}, nil
+	})
+	defer paging.Dispose()
+
+	var retry func()
+	tester.PumpWidget(widgets.SizedBox{Width: 400, Height: 600, Child: widgets.PagedListView[int, string]{
+		Controller: paging,
+		ItemBuilder: func(ctx core.BuildContext, item string, index int) core.Widget {
+			return widgets.Text{Content: item}
+		},
+		FirstPageErrorBuilder: func(ctx core.BuildContext, err error, r func()) core.Widget {
+			retry = r
+			return widgets.Text{Content: err.Error()}
+		},
+	}})
+	tester.Pump()
+
+	if err := tester.PumpUntil(func() bool { return paging.Status() == widgets.PagingFirstPageError }, 2*time.Second); err != nil {
+		t.Fatal(err)
+	}
+	tester.Pump()
+	if !tester.Find(drifttest.ByText("offline")).Exists() {
+		t.Fatal("expected the first page error to be shown")
+	}
+
+	fail = false
+	retry()
+	if err := tester.PumpUntil(func() bool { return paging.Status() == widgets.PagingCompleted }, 2*time.Second); err != nil {
+		t.Fatal(err)
+	}
+	tester.Pump()
+	if !tester.Find(drifttest.ByText("a")).Exists() {
+		t.Error("expected the loaded item after retry")
+	}
+}
+
+func TestPagingController_RefreshDiscardsStaleResults(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+
+	started, release := make(chan struct{}), make(chan struct{})
+	var calls atomic.Int32
+	paging := widgets.NewPagingController(0, func(page int) (widgets.Page[int, int], error) {
+		if calls.Add(1) == 1 {
+			close(started)
+			<-release
+			return widgets.Page[int, int]{Items: []int{-1}, Last: true}, nil
+		}
+		return widgets.Page[int, int]{Items: []int{1}, Last: true}, nil
+	})
+	defer paging.Dispose()
+
+	paging.FetchNextPage()
+	<-started
+	paging.Refresh()
+	if err := tester.PumpUntil(func() bool { return paging.Status() == widgets.PagingCompleted }, 2*time.Second); err != nil {
+		t.Fatal(err)
+	}
+	close(release)
+	time.Sleep(10 * time.Millisecond)
+	tester.Pump()
+
+	if items := paging.Items(); len(items) != 1 || items[0] != 1 {
+		t.Errorf("expected only the refreshed page, got %v", items)
+	}
+}
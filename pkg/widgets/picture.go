@@ -0,0 +1,272 @@
+package widgets
+
+import (
+	"math"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/layout"
+)
+
+// PictureCache records an app-composed drawing once and replays it until
+// invalidated. The draw function runs only when the drawing is first shown,
+// when the size it is shown at changes, or after [PictureCache.Invalidate],
+// which makes it suited to expensive static vector art such as charts,
+// maps, and illustrations:
+//
+//	s.chart = widgets.NewPictureCache(func(canvas graphics.Canvas, size graphics.Size) {
+//	    drawChart(canvas, size, s.series)
+//	})
+//	core.UseDisposable(s, s.chart)
+//
+//	// When the data changes
+//	s.series = newSeries
+//	s.chart.Invalidate()
+//
+// Show the drawing with [PictureWidget].
+type PictureCache struct {
+	draw    func(canvas graphics.Canvas, size graphics.Size)
+	picture *graphics.DisplayList
+
+	listeners core.Notifier
+}
+
+// NewPictureCache creates a cache that records drawings with draw.
+func NewPictureCache(draw func(canvas graphics.Canvas, size graphics.Size)) *PictureCache {
+	return &PictureCache{
+		draw: draw,
+	}
+}
+
+// Picture returns the recorded drawing at size, recording it first if
+// there is no recording at that size.
+func (c *PictureCache) Picture(size graphics.Size) *graphics.DisplayList {
+	if c.picture != nil && c.picture.Size() == size {
+		return c.picture
+	}
+	c.discard()
+	c.picture = graphics.RecordPicture(size, func(canvas graphics.Canvas) {
+		if c.draw != nil {
+			c.draw(canvas, size)
+		}
+	})
+	return c.picture
+}
+
+// IsRecorded reports whether a recording is cached.
+func (c *PictureCache) IsRecorded() bool {
+	return c.picture != nil
+}
+
+// Invalidate discards the recording, so the drawing is recorded again the
+// next time it is painted, and repaints widgets showing it.
+func (c *PictureCache) Invalidate() {
+	c.discard()
+	c.listeners.Notify()
+}
+
+// AddListener adds a callback that fires when the cache is invalidated.
+// Returns an unsubscribe function.
+func (c *PictureCache) AddListener(fn func()) func() {
+	return c.listeners.AddListener(fn)
+}
+
+// Dispose discards the recording and releases listeners.
+func (c *PictureCache) Dispose() {
+	c.discard()
+	c.listeners.Dispose()
+}
+
+func (c *PictureCache) discard() {
+	if c.picture != nil {
+		c.picture.Dispose()
+		c.picture = nil
+	}
+}
+
+// PictureWidget paints a recorded drawing, either a fixed
+// [graphics.DisplayList] or one recorded on demand by a [PictureCache].
+//
+// # Creation Patterns
+//
+//	// A drawing recorded ahead of time
+//	logo := graphics.RecordPicture(graphics.Size{Width: 120, Height: 40}, drawLogo)
+//	widgets.PictureWidget{Picture: logo}
+//
+//	// A drawing recorded at the widget's size and kept until invalidated
+//	widgets.PictureWidget{Cache: s.chart, Height: 240}
+//
+// # Sizing Behavior
+//
+// Width and Height set the widget's size; a zero dimension uses the
+// Picture's recorded size, or the largest size allowed by the parent when
+// drawing from a Cache (zero if the parent is unbounded). A Picture is drawn
+// at its recorded size from the top-left corner.
+//
+// The widget is a repaint boundary, so changes elsewhere in the tree replay
+// its layer without painting it again.
+type PictureWidget struct {
+	core.StatefulBase
+
+	// Picture is a pre-recorded drawing. Takes precedence over Cache.
+	Picture *graphics.DisplayList
+
+	// Cache records the drawing at the widget's size and replays it until
+	// invalidated.
+	Cache *PictureCache
+
+	// Width is the desired width. Zero uses the default described above.
+	Width float64
+
+	// Height is the desired height. Zero uses the default described above.
+	Height float64
+}
+
+func (p PictureWidget) CreateState() core.State {
+	return &pictureWidgetState{}
+}
+
+type pictureWidgetState struct {
+	core.StateBase
+	unsubCache func()
+	// version counts cache invalidations so the render object repaints.
+	version int
+}
+
+func (s *pictureWidgetState) InitState() {
+	s.subscribe(s.Element().Widget().(PictureWidget).Cache)
+	s.OnDispose(func() {
+		s.subscribe(nil)
+	})
+}
+
+func (s *pictureWidgetState) DidUpdateWidget(oldWidget core.StatefulWidget) {
+	if w := s.Element().Widget().(PictureWidget); w.Cache != oldWidget.(PictureWidget).Cache {
+		s.subscribe(w.Cache)
+	}
+}
+
+func (s *pictureWidgetState) subscribe(cache *PictureCache) {
+	if s.unsubCache != nil {
+		s.unsubCache()
+		s.unsubCache = nil
+	}
+	if cache != nil {
+		s.unsubCache = cache.AddListener(func() {
+			s.SetState(func() { s.version++ })
+		})
+	}
+}
+
+func (s *pictureWidgetState) Build(ctx core.BuildContext) core.Widget {
+	w := s.Element().Widget().(PictureWidget)
+	return pictureRender{
+		picture: w.Picture,
+		cache:   w.Cache,
+		width:   w.Width,
+		height:  w.Height,
+		version: s.version,
+	}
+}
+
+// pictureRender is the inner RenderObjectWidget for PictureWidget.
+type pictureRender struct {
+	core.RenderObjectBase
+	picture *graphics.DisplayList
+	cache   *PictureCache
+	width   float64
+	height  float64
+	version int
+}
+
+func (p pictureRender) CreateRenderObject(ctx core.BuildContext) layout.RenderObject {
+	r := &renderPicture{}
+	p.UpdateRenderObject(ctx, r)
+	r.SetSelf(r)
+	return r
+}
+
+func (p pictureRender) UpdateRenderObject(ctx core.BuildContext, renderObject layout.RenderObject) {
+	r, ok := renderObject.(*renderPicture)
+	if !ok {
+		return
+	}
+	layoutChanged := r.picture != p.picture || r.cache != p.cache || r.width != p.width || r.height != p.height
+	paintChanged := layoutChanged || r.version != p.version
+
+	r.picture = p.picture
+	r.cache = p.cache
+	r.width = p.width
+	r.height = p.height
+	r.version = p.version
+
+	if layoutChanged {
+		r.MarkNeedsLayout()
+	}
+	if paintChanged {
+		r.MarkNeedsPaint()
+	}
+}
+
+type renderPicture struct {
+	layout.RenderBoxBase
+	picture *graphics.DisplayList
+	cache   *PictureCache
+	width   float64
+	height  float64
+	version int
+}
+
+func (r *renderPicture) IsRepaintBoundary() bool {
+	return true
+}
+
+func (r *renderPicture) SetChild(child layout.RenderObject) {}
+
+func (r *renderPicture) PerformLayout() {
+	constraints := r.Constraints()
+	size := graphics.Size{Width: r.width, Height: r.height}
+	if r.picture != nil {
+		if size.Width == 0 {
+			size.Width = r.picture.Size().Width
+		}
+		if size.Height == 0 {
+			size.Height = r.picture.Size().Height
+		}
+	} else if r.cache != nil {
+		// Fill the parent, unless it is unbounded in that direction.
+		if size.Width == 0 && constraints.MaxWidth != math.MaxFloat64 {
+			size.Width = constraints.MaxWidth
+		}
+		if size.Height == 0 && constraints.MaxHeight != math.MaxFloat64 {
+			size.Height = constraints.MaxHeight
+		}
+	}
+	r.SetSize(constraints.Constrain(size))
+}
+
+func (r *renderPicture) Paint(ctx *layout.PaintContext) {
+	size := r.Size()
+	if size.Width <= 0 || size.Height <= 0 {
+		return
+	}
+	picture := r.picture
+	if picture == nil && r.cache != nil {
+		picture = r.cache.Picture(size)
+	}
+	if picture == nil {
+		return
+	}
+	ctx.Canvas.Save()
+	ctx.Canvas.ClipRect(graphics.RectFromLTWH(0, 0, size.Width, size.Height))
+	picture.Paint(ctx.Canvas)
+	ctx.Canvas.Restore()
+}
+
+func (r *renderPicture) HitTest(position graphics.Offset, result *layout.HitTestResult) bool {
+	if !layout.WithinBounds(position, r.Size()) {
+		return false
+	}
+	result.Add(r)
+	return true
+}
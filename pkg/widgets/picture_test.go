@@ -0,0 +1,85 @@
+package widgets_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+	drifttest "github.com/go-drift/drift/pkg/testing"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+func TestPictureWidget_CacheRecordsOnceUntilInvalidated(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	tester.SetSize(graphics.Size{Width: 400, Height: 400})
+
+	draws := 0
+	var drawnSize graphics.Size
+	cache := widgets.NewPictureCache(func(canvas graphics.Canvas, size graphics.Size) {
+		draws++
+		drawnSize = size
+		canvas.DrawRect(graphics.RectFromLTWH(0, 0, size.Width, size.Height), graphics.DefaultPaint())
+	})
+	defer cache.Dispose()
+
+	// CaptureSnapshot paints the whole tree, as a frame would.
+	build := func(width float64) *drifttest.Snapshot {
+		tester.PumpWidget(widgets.Column{Children: []core.Widget{
+			widgets.PictureWidget{Cache: cache, Width: width, Height: 50},
+		}})
+		return tester.CaptureSnapshot()
+	}
+	snap := build(100)
+	if draws != 1 || drawnSize != (graphics.Size{Width: 100, Height: 50}) {
+		t.Fatalf("expected one draw at 100x50, got %d at %v", draws, drawnSize)
+	}
+	if !slices.ContainsFunc(snap.DisplayOps, func(op drifttest.DisplayOp) bool { return op.Op == "drawRect" }) {
+		t.Fatalf("expected the recorded rect to be replayed, got %v", snap.DisplayOps)
+	}
+
+	build(100)
+	tester.CaptureSnapshot()
+	if draws != 1 {
+		t.Fatalf("expected cached picture to be reused, got %d draws", draws)
+	}
+
+	cache.Invalidate()
+	if cache.IsRecorded() {
+		t.Fatal("expected Invalidate to discard the recording")
+	}
+	tester.Pump()
+	tester.CaptureSnapshot()
+	if draws != 2 {
+		t.Fatalf("expected Invalidate to record again, got %d draws", draws)
+	}
+
+	build(200)
+	if draws != 3 || drawnSize.Width != 200 {
+		t.Fatalf("expected a size change to record again at width 200, got %d draws at %v", draws, drawnSize)
+	}
+}
+
+func TestPictureWidget_PictureUsesRecordedSize(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	tester.SetSize(graphics.Size{Width: 400, Height: 400})
+
+	picture := graphics.RecordPicture(graphics.Size{Width: 120, Height: 40}, func(canvas graphics.Canvas) {
+		canvas.DrawCircle(graphics.Offset{X: 20, Y: 20}, 20, graphics.DefaultPaint())
+	})
+	tester.PumpWidget(widgets.Row{Children: []core.Widget{
+		widgets.PictureWidget{Picture: picture},
+		probeBox{widgets.SizedBox{Width: 10, Height: 10}},
+	}})
+	if x := probeOffset(tester).X; x != 120 {
+		t.Fatalf("expected picture width 120, got %v", x)
+	}
+
+	tester.PumpWidget(widgets.Column{Children: []core.Widget{
+		widgets.PictureWidget{Picture: picture},
+		probeBox{widgets.SizedBox{Width: 10, Height: 10}},
+	}})
+	if y := probeOffset(tester).Y; y != 40 {
+		t.Fatalf("expected picture height 40, got %v", y)
+	}
+}
@@ -0,0 +1,391 @@
+package widgets
+
+import (
+	"math"
+	"strings"
+	"time"
+
+	"github.com/go-drift/drift/pkg/animation"
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/layout"
+	"github.com/go-drift/drift/pkg/platform"
+)
+
+const (
+	// pinShakeDistance is how far the boxes swing either side when an error
+	// appears.
+	pinShakeDistance = 8
+	// pinShakeSwings is the number of back-and-forth swings in a shake.
+	pinShakeSwings = 3
+)
+
+// PinCodeField shows a PIN or one-time code as a row of boxes, one per
+// character, for verification and passcode screens.
+//
+// A single invisible [TextInput] covers the boxes and receives every edit, so
+// typing advances from box to box, backspace steps back, and a code pasted or
+// autofilled in one go fills every box. Only digits are kept unless
+// Alphanumeric is set, so a pasted "123-456" or "Your code is 123456" enters
+// the code.
+//
+// # Styling Model
+//
+// PinCodeField is explicit by default: zero colors are transparent and zero
+// sizes are zero. For theme-styled boxes, use [theme.PinCodeFieldOf].
+//
+//	theme.PinCodeFieldOf(ctx, s.code).
+//	    WithOneTimeCode().
+//	    WithOnCompleted(s.verify)
+//
+// # Errors
+//
+// Setting ErrorText draws the boxes in ErrorColor, shows the message below
+// them, and shakes the row. Clear it as the user edits so the next wrong
+// code shakes again:
+//
+//	OnChanged: func(string) {
+//	    if s.errorText != "" {
+//	        s.SetState(func() { s.errorText = "" })
+//	    }
+//	},
+type PinCodeField struct {
+	core.StatefulBase
+
+	// Controller holds the entered code. When nil, the field keeps its own.
+	Controller *platform.TextEditingController
+	// Length is the number of boxes. Zero means 6.
+	Length int
+	// Alphanumeric accepts letters as well as digits, uppercased, and shows
+	// a text keyboard instead of the number pad.
+	Alphanumeric bool
+	// Obscure shows a bullet in place of each entered character.
+	Obscure bool
+	// OneTimeCode asks the keyboard to offer verification codes from
+	// incoming SMS messages, and on iOS from email.
+	OneTimeCode bool
+	// Disabled rejects input.
+	Disabled bool
+
+	// OnChanged is called when the user edits the code.
+	OnChanged func(string)
+	// OnCompleted is called once every box is filled, whether typed, pasted,
+	// autofilled, or set on the controller.
+	OnCompleted func(string)
+
+	// ErrorText is shown below the boxes when non-empty. Setting it, or
+	// changing it to a different message, shakes the boxes.
+	ErrorText string
+
+	// BoxWidth and BoxHeight size each box.
+	BoxWidth  float64
+	BoxHeight float64
+	// Spacing is the gap between boxes.
+	Spacing float64
+	// BorderRadius rounds each box's corners.
+	BorderRadius float64
+	// BorderWidth is each box's border stroke width. Zero means no border.
+	BorderWidth float64
+	// BackgroundColor fills each box.
+	BackgroundColor graphics.Color
+	// BorderColor is the border of boxes other than the one being entered.
+	BorderColor graphics.Color
+	// FocusColor is the border of the box being entered and its caret while
+	// the field has focus.
+	FocusColor graphics.Color
+	// ErrorColor is every box's border while ErrorText is set.
+	ErrorColor graphics.Color
+	// Style is the entered characters' text style.
+	Style graphics.TextStyle
+	// ErrorStyle is the style of ErrorText.
+	ErrorStyle graphics.TextStyle
+}
+
+// WithOnCompleted returns a copy with the specified completion callback.
+func (p PinCodeField) WithOnCompleted(fn func(string)) PinCodeField {
+	p.OnCompleted = fn
+	return p
+}
+
+// WithOnChanged returns a copy with the specified change callback.
+func (p PinCodeField) WithOnChanged(fn func(string)) PinCodeField {
+	p.OnChanged = fn
+	return p
+}
+
+// WithLength returns a copy with the specified number of boxes.
+func (p PinCodeField) WithLength(length int) PinCodeField {
+	p.Length = length
+	return p
+}
+
+// WithOneTimeCode returns a copy that offers codes from incoming messages
+// above the keyboard.
+func (p PinCodeField) WithOneTimeCode() PinCodeField {
+	p.OneTimeCode = true
+	return p
+}
+
+// WithObscure returns a copy that hides the entered characters.
+func (p PinCodeField) WithObscure(obscure bool) PinCodeField {
+	p.Obscure = obscure
+	return p
+}
+
+// WithErrorText returns a copy with the specified error message.
+func (p PinCodeField) WithErrorText(text string) PinCodeField {
+	p.ErrorText = text
+	return p
+}
+
+func (p PinCodeField) length() int {
+	if p.Length <= 0 {
+		return 6
+	}
+	return p.Length
+}
+
+func (p PinCodeField) CreateState() core.State {
+	return &pinCodeFieldState{}
+}
+
+type pinCodeFieldState struct {
+	core.StateBase
+	own            *platform.TextEditingController
+	controller     *platform.TextEditingController
+	removeListener func()
+	shake          *animation.AnimationController
+	focused        bool
+	// completed is the code last passed to OnCompleted, so it fires once
+	// per entry.
+	completed string
+}
+
+func (s *pinCodeFieldState) InitState() {
+	s.shake = animation.NewAnimationController(400 * time.Millisecond)
+	s.shake.Curve = animation.LinearCurve
+	core.UseDisposable(s, s.shake)
+	core.UseListenable(s, s.shake)
+	s.attach(s.Element().Widget().(PinCodeField).Controller)
+}
+
+func (s *pinCodeFieldState) DidUpdateWidget(oldWidget core.StatefulWidget) {
+	w := s.Element().Widget().(PinCodeField)
+	old := oldWidget.(PinCodeField)
+	if w.Controller != old.Controller {
+		s.attach(w.Controller)
+	}
+	if w.ErrorText != "" && w.ErrorText != old.ErrorText {
+		s.shake.Reset()
+		s.shake.Forward()
+	}
+}
+
+func (s *pinCodeFieldState) Dispose() {
+	if s.removeListener != nil {
+		s.removeListener()
+		s.removeListener = nil
+	}
+	s.StateBase.Dispose()
+}
+
+// attach listens to controller, or to a controller of the state's own when
+// nil.
+func (s *pinCodeFieldState) attach(controller *platform.TextEditingController) {
+	if s.removeListener != nil {
+		s.removeListener()
+	}
+	if controller == nil {
+		if s.own == nil {
+			s.own = platform.NewTextEditingController("")
+		}
+		controller = s.own
+	}
+	s.controller = controller
+	s.completed = ""
+	s.removeListener = controller.AddListener(s.onCodeChanged)
+}
+
+func (s *pinCodeFieldState) onCodeChanged() {
+	w := s.Element().Widget().(PinCodeField)
+	code := s.controller.Text()
+	if len(code) < w.length() {
+		s.completed = ""
+	} else if code != s.completed {
+		s.completed = code
+		if w.OnCompleted != nil {
+			w.OnCompleted(code)
+		}
+	}
+	s.SetState(func() {})
+}
+
+func (s *pinCodeFieldState) Build(ctx core.BuildContext) core.Widget {
+	w := s.Element().Widget().(PinCodeField)
+	length := w.length()
+	code := []rune(s.controller.Text())
+
+	boxes := make([]core.Widget, 0, 2*length-1)
+	for i := range length {
+		if i > 0 {
+			boxes = append(boxes, HSpace(w.Spacing))
+		}
+		boxes = append(boxes, s.buildBox(w, i, code))
+	}
+
+	// The boxes swing back and forth, settling as the shake runs out.
+	dx := 0.0
+	if t := s.shake.Value; s.shake.IsAnimating() {
+		dx = math.Sin(t*pinShakeSwings*2*math.Pi) * pinShakeDistance * (1 - t)
+	}
+
+	keyboard := platform.KeyboardTypeNumber
+	capitalization := platform.TextCapitalizationNone
+	if w.Alphanumeric {
+		keyboard = platform.KeyboardTypeText
+		capitalization = platform.TextCapitalizationCharacters
+	}
+	var hints []platform.AutofillHint
+	if w.OneTimeCode {
+		hints = []platform.AutofillHint{platform.AutofillHintOneTimeCode}
+	}
+
+	field := Stack{Children: []core.Widget{
+		motionBox{
+			offset:  graphics.Offset{X: dx},
+			scale:   1,
+			opacity: 1,
+			child: ExcludeSemantics{
+				Excluding: true,
+				// Codes read left to right in every language.
+				Child: Directionality{
+					TextDirection: graphics.TextDirectionLTR,
+					Child: Row{
+						MainAxisSize: MainAxisSizeMin,
+						Children:     boxes,
+					},
+				},
+			},
+		},
+		// The input's text and caret are invisible; the boxes show them.
+		Positioned(TextInput{
+			Controller:      s.controller,
+			Style:           graphics.TextStyle{FontSize: w.Style.FontSize},
+			KeyboardType:    keyboard,
+			InputAction:     platform.TextInputActionDone,
+			Capitalization:  capitalization,
+			Obscure:         w.Obscure,
+			HideCursor:      true,
+			AutofillHints:   hints,
+			InputFormatters: []platform.TextInputFormatter{pinCodeFormatter(length, w.Alphanumeric)},
+			OnChanged:       w.OnChanged,
+			OnFocusChange: func(focused bool) {
+				s.SetState(func() { s.focused = focused })
+			},
+			Disabled: w.Disabled,
+			Height:   w.BoxHeight,
+		}).Left(0).Top(0).Right(0).Bottom(0),
+	}}
+
+	if w.ErrorText == "" {
+		return field
+	}
+	return Column{
+		MainAxisSize:       MainAxisSizeMin,
+		CrossAxisAlignment: CrossAxisAlignmentStart,
+		Children: []core.Widget{
+			field,
+			VSpace(6),
+			Text{Content: w.ErrorText, Style: w.ErrorStyle},
+		},
+	}
+}
+
+// buildBox builds the box for the character at index i of the code.
+func (s *pinCodeFieldState) buildBox(w PinCodeField, i int, code []rune) core.Widget {
+	// The box being entered; the last box once the code is complete.
+	current := min(len(code), w.length()-1)
+	active := s.focused && !w.Disabled && i == current
+
+	borderColor := w.BorderColor
+	switch {
+	case w.ErrorText != "":
+		borderColor = w.ErrorColor
+	case active:
+		borderColor = w.FocusColor
+	}
+
+	var child core.Widget
+	switch {
+	case i < len(code):
+		char := string(code[i])
+		if w.Obscure {
+			char = "•"
+		}
+		child = Text{Content: char, Style: w.Style}
+	case active:
+		child = Container{Width: 2, Height: w.Style.FontSize, Color: w.FocusColor}
+	}
+
+	return Container{
+		Width:        w.BoxWidth,
+		Height:       w.BoxHeight,
+		Color:        w.BackgroundColor,
+		BorderColor:  borderColor,
+		BorderWidth:  w.BorderWidth,
+		BorderRadius: w.BorderRadius,
+		Alignment:    layout.AlignmentCenter,
+		Child:        child,
+	}
+}
+
+// pinCodeFormatter keeps the first length code characters of each edit with
+// the caret at the end. An edit that inserts a whole code, such as a paste or
+// autofill over a partly entered one, replaces what was there.
+func pinCodeFormatter(length int, alphanumeric bool) platform.TextInputFormatter {
+	return platform.TextInputFormatterFunc(func(oldValue, newValue platform.TextEditingValue) platform.TextEditingValue {
+		code := pinCodeChars(newValue.Text, alphanumeric)
+		if len(code) > length {
+			if inserted := pinCodeChars(insertedText(oldValue.Text, newValue.Text), alphanumeric); len(inserted) >= length {
+				code = inserted
+			}
+			code = code[:length]
+		}
+		return platform.TextEditingValue{
+			Text:           code,
+			Selection:      platform.TextSelectionCollapsed(len(code)),
+			ComposingRange: platform.TextRangeEmpty,
+		}
+	})
+}
+
+// pinCodeChars returns the ASCII digits in text, and the letters uppercased
+// when alphanumeric is set.
+func pinCodeChars(text string, alphanumeric bool) string {
+	var b strings.Builder
+	for _, r := range text {
+		switch {
+		case r >= '0' && r <= '9':
+			b.WriteRune(r)
+		case alphanumeric && r >= 'a' && r <= 'z':
+			b.WriteRune(r - 'a' + 'A')
+		case alphanumeric && r >= 'A' && r <= 'Z':
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// insertedText returns the part of after that replaced part of before.
+func insertedText(before, after string) string {
+	prefix := 0
+	for prefix < len(before) && prefix < len(after) && before[prefix] == after[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(before)-prefix && suffix < len(after)-prefix &&
+		before[len(before)-1-suffix] == after[len(after)-1-suffix] {
+		suffix++
+	}
+	return after[prefix : len(after)-suffix]
+}
@@ -0,0 +1,74 @@
+package widgets_test
+
+import (
+	"testing"
+
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/platform"
+	drifttest "github.com/go-drift/drift/pkg/testing"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+func TestPinCodeField_FillsBoxesAndCompletesOnce(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	controller := platform.NewTextEditingController("")
+	var completed []string
+	field := widgets.PinCodeField{
+		Controller:  controller,
+		Length:      4,
+		BoxWidth:    40,
+		BoxHeight:   48,
+		Spacing:     8,
+		Style:       graphics.TextStyle{FontSize: 20, Color: graphics.ColorBlack},
+		OnCompleted: func(code string) { completed = append(completed, code) },
+	}
+	tester.PumpWidget(widgets.Center{Child: field})
+
+	controller.SetText("12")
+	tester.Pump()
+	if !tester.Find(drifttest.ByText("1")).Exists() || !tester.Find(drifttest.ByText("2")).Exists() {
+		t.Error("expected the entered digits in the boxes")
+	}
+	if len(completed) != 0 {
+		t.Errorf("OnCompleted called early with %v", completed)
+	}
+
+	controller.SetText("1234")
+	controller.SetText("1234")
+	tester.Pump()
+	if len(completed) != 1 || completed[0] != "1234" {
+		t.Errorf("completed = %v, want [1234]", completed)
+	}
+
+	// Editing and completing again reports the new code.
+	controller.SetText("123")
+	controller.SetText("1235")
+	if len(completed) != 2 || completed[1] != "1235" {
+		t.Errorf("completed = %v, want [1234 1235]", completed)
+	}
+}
+
+func TestPinCodeField_ObscureAndError(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	controller := platform.NewTextEditingController("42")
+	field := widgets.PinCodeField{
+		Controller: controller,
+		Obscure:    true,
+		BoxWidth:   40,
+		BoxHeight:  48,
+		Style:      graphics.TextStyle{FontSize: 20, Color: graphics.ColorBlack},
+	}
+	tester.PumpWidget(widgets.Center{Child: field})
+	if tester.Find(drifttest.ByText("4")).Exists() {
+		t.Error("obscured digits should not be shown")
+	}
+	if got := tester.Find(drifttest.ByText("•")).Count(); got != 2 {
+		t.Errorf("found %d bullets, want 2", got)
+	}
+
+	field.ErrorText = "Wrong code"
+	tester.PumpWidget(widgets.Center{Child: field})
+	if !tester.Find(drifttest.ByText("Wrong code")).Exists() {
+		t.Error("expected the error text below the boxes")
+	}
+}
@@ -1,6 +1,9 @@
 package widgets
 
 import (
+	"math"
+	"slices"
+
 	"github.com/go-drift/drift/pkg/core"
 	"github.com/go-drift/drift/pkg/graphics"
 	"github.com/go-drift/drift/pkg/layout"
@@ -22,6 +25,19 @@ import (
 //	        graphics.Span("World").Bold(),
 //	    ),
 //	}.WithStyle(graphics.SpanStyle{Color: colors.OnSurface, FontSize: 16})
+//
+// Children are widgets that flow inline with the text, such as icons or
+// chips. Each is laid out at its natural size and shown at the next
+// [graphics.PlaceholderSpan] in Content, in order:
+//
+//	widgets.RichText{
+//	    Content: graphics.Spans(
+//	        graphics.Span("Assigned to "),
+//	        graphics.PlaceholderSpan(graphics.PlaceholderAlignmentMiddle),
+//	        graphics.Span(" yesterday"),
+//	    ),
+//	    Children: []core.Widget{userChip},
+//	}
 type RichText struct {
 	core.RenderObjectBase
 	// Content is the root span tree. Child spans inherit any style fields from
@@ -36,6 +52,18 @@ type RichText struct {
 	// ([graphics.TextWrapWrap]) wraps text at the constraint width.
 	// Set to [graphics.TextWrapNoWrap] for single-line text.
 	Wrap graphics.TextWrap
+	// Strut sets a minimum height for every line, so lines keep the same
+	// spacing whatever spans they contain. The zero value sets none.
+	Strut graphics.StrutStyle
+	// Children are the inline widgets shown at Content's placeholder spans,
+	// in order. Children without a placeholder, or whose placeholder is cut
+	// off by MaxLines, are not shown.
+	Children []core.Widget
+}
+
+// ChildrenWidgets returns the inline child widgets.
+func (r RichText) ChildrenWidgets() []core.Widget {
+	return r.Children
 }
 
 // WithStyle returns a copy with the given widget-level default style.
@@ -62,14 +90,22 @@ func (r RichText) WithAlign(align graphics.TextAlign) RichText {
 	return r
 }
 
+// WithStrut returns a copy with the specified strut.
+func (r RichText) WithStrut(strut graphics.StrutStyle) RichText {
+	r.Strut = strut
+	return r
+}
+
 func (r RichText) CreateRenderObject(ctx core.BuildContext) layout.RenderObject {
 	ro := &renderRichText{
 		span:      r.Content,
 		text:      r.Content.PlainText(),
 		baseStyle: r.Style,
 		align:     r.Align,
+		direction: DirectionalityOf(ctx),
 		maxLines:  r.MaxLines,
 		wrapMode:  r.Wrap,
+		strut:     r.Strut,
 	}
 	ro.SetSelf(ro)
 	return ro
@@ -81,8 +117,10 @@ func (r RichText) UpdateRenderObject(ctx core.BuildContext, renderObject layout.
 		ro.text = r.Content.PlainText()
 		ro.baseStyle = r.Style
 		ro.align = r.Align
+		ro.direction = DirectionalityOf(ctx)
 		ro.maxLines = r.MaxLines
 		ro.wrapMode = r.Wrap
+		ro.strut = r.Strut
 		ro.generation++
 		ro.MarkNeedsLayout()
 		ro.MarkNeedsPaint()
@@ -95,36 +133,71 @@ type renderRichText struct {
 	text       string
 	baseStyle  graphics.SpanStyle
 	align      graphics.TextAlign
+	direction  graphics.TextDirection
 	textLayout *graphics.TextLayout
 	maxLines   int
 	wrapMode   graphics.TextWrap
+	strut      graphics.StrutStyle
 	generation uint64
 	cache      richTextLayoutCache
+	children   []layout.RenderBox
+	// childSizes are the inline children's sizes at the last text layout,
+	// and childRects the boxes of the placeholders they are shown in.
+	childSizes []graphics.Size
+	childRects []graphics.Rect
 }
 
 type richTextLayoutCache struct {
 	generation uint64
 	align      graphics.TextAlign
+	direction  graphics.TextDirection
 	maxWidth   float64
 	maxLines   int
 	wrapMode   graphics.TextWrap
 }
 
+// SetChildren sets the inline child render objects.
+func (r *renderRichText) SetChildren(children []layout.RenderObject) {
+	for _, child := range r.children {
+		layout.SetParentOnChild(child, nil)
+	}
+	r.children = make([]layout.RenderBox, 0, len(children))
+	for _, child := range children {
+		if box, ok := child.(layout.RenderBox); ok {
+			r.children = append(r.children, box)
+			layout.SetParentOnChild(box, r)
+		}
+	}
+}
+
+// VisitChildren calls the visitor for each inline child.
+func (r *renderRichText) VisitChildren(visitor func(layout.RenderObject)) {
+	for _, child := range r.children {
+		visitor(child)
+	}
+}
+
 func (r *renderRichText) PerformLayout() {
 	constraints := r.Constraints()
 	maxWidth := constraints.MaxWidth // Default: wrap
 	if r.wrapMode == graphics.TextWrapNoWrap {
 		maxWidth = 0
 	}
+	childSizes := r.layoutChildren(constraints)
+	if !slices.Equal(childSizes, r.childSizes) {
+		r.childSizes = childSizes
+		r.textLayout = nil
+	}
 	current := richTextLayoutCache{
 		generation: r.generation,
 		align:      r.align,
+		direction:  r.direction,
 		maxWidth:   maxWidth,
 		maxLines:   r.maxLines,
 		wrapMode:   r.wrapMode,
 	}
 	if r.textLayout != nil && r.cache == current {
-		r.SetSize(constraints.Constrain(textLayoutSize(r.textLayout.Size, r.align, maxWidth)))
+		r.SetSize(constraints.Constrain(textLayoutSize(r.textLayout.Size, r.align, r.direction, maxWidth)))
 		return
 	}
 	r.cache = current
@@ -132,23 +205,96 @@ func (r *renderRichText) PerformLayout() {
 	manager, _ := graphics.DefaultFontManagerErr()
 	if manager == nil {
 		r.textLayout = nil
+		r.childRects = nil
 		r.SetSize(constraints.Constrain(graphics.Size{}))
 		return
 	}
 
-	tl, err := graphics.LayoutRichText(r.span, r.baseStyle, manager, graphics.ParagraphOptions{
-		MaxWidth:  maxWidth,
-		MaxLines:  r.maxLines,
-		TextAlign: r.align,
+	tl, err := graphics.LayoutRichText(sizePlaceholders(r.span, childSizes), r.baseStyle, manager, graphics.ParagraphOptions{
+		MaxWidth:      maxWidth,
+		MaxLines:      r.maxLines,
+		TextAlign:     r.align,
+		TextDirection: r.direction,
+		Strut:         r.strut,
 	})
 	if err != nil {
 		r.textLayout = nil
+		r.childRects = nil
 		r.SetSize(constraints.Constrain(graphics.Size{}))
 		return
 	}
 
 	r.textLayout = tl
-	r.SetSize(constraints.Constrain(textLayoutSize(tl.Size, r.align, maxWidth)))
+	r.placeChildren()
+	r.SetSize(constraints.Constrain(textLayoutSize(tl.Size, r.align, r.direction, maxWidth)))
+}
+
+// layoutChildren lays the inline children out at their natural sizes, no
+// wider than the text, and returns the sizes.
+func (r *renderRichText) layoutChildren(constraints layout.Constraints) []graphics.Size {
+	if len(r.children) == 0 {
+		return nil
+	}
+	childConstraints := layout.Constraints{MaxWidth: constraints.MaxWidth, MaxHeight: math.Inf(1)}
+	sizes := make([]graphics.Size, len(r.children))
+	for i, child := range r.children {
+		child.Layout(childConstraints, true)
+		sizes[i] = child.Size()
+	}
+	return sizes
+}
+
+// sizePlaceholders returns a copy of span whose placeholders are sized to
+// sizes, in order. Placeholders beyond the sizes reserve no space.
+func sizePlaceholders(span graphics.TextSpan, sizes []graphics.Size) graphics.TextSpan {
+	if len(sizes) == 0 {
+		return span
+	}
+	next := 0
+	var visit func(span graphics.TextSpan) graphics.TextSpan
+	visit = func(span graphics.TextSpan) graphics.TextSpan {
+		if span.Placeholder != nil {
+			var size graphics.Size
+			if next < len(sizes) {
+				size = sizes[next]
+			}
+			next++
+			span = span.WithPlaceholderSize(size)
+		}
+		if len(span.Children) > 0 {
+			children := make([]graphics.TextSpan, len(span.Children))
+			for i, child := range span.Children {
+				children[i] = visit(child)
+			}
+			span.Children = children
+		}
+		return span
+	}
+	return visit(span)
+}
+
+// placeChildren positions the inline children at their placeholders' boxes
+// in the text layout.
+func (r *renderRichText) placeChildren() {
+	r.childRects = nil
+	if len(r.children) == 0 {
+		return
+	}
+	r.childRects = r.textLayout.PlaceholderRects()
+	for i, rect := range r.childRects {
+		if i < len(r.children) {
+			r.children[i].SetParentData(&layout.BoxParentData{Offset: graphics.Offset{X: rect.Left, Y: rect.Top}})
+		}
+	}
+}
+
+// FontRegistered lays the text out again if any span used family.
+func (r *renderRichText) FontRegistered(family string) {
+	if r.textLayout != nil && r.textLayout.UsesFamily(family) {
+		r.textLayout = nil
+		r.MarkNeedsLayout()
+		r.MarkNeedsPaint()
+	}
 }
 
 func (r *renderRichText) Paint(ctx *layout.PaintContext) {
@@ -156,12 +302,23 @@ func (r *renderRichText) Paint(ctx *layout.PaintContext) {
 		return
 	}
 	ctx.Canvas.DrawText(r.textLayout, graphics.Offset{})
+	for _, child := range r.placedChildren() {
+		ctx.PaintChildWithLayer(child, getChildOffset(child))
+	}
+}
+
+// placedChildren returns the inline children shown at a placeholder.
+func (r *renderRichText) placedChildren() []layout.RenderBox {
+	return r.children[:min(len(r.children), len(r.childRects))]
 }
 
 func (r *renderRichText) HitTest(position graphics.Offset, result *layout.HitTestResult) bool {
 	if !layout.WithinBounds(position, r.Size()) {
 		return false
 	}
+	if hitTestChildrenReverse(r.placedChildren(), position, result) {
+		return true
+	}
 	result.Add(r)
 	return true
 }
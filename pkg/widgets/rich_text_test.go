@@ -3,7 +3,9 @@ package widgets_test
 import (
 	"testing"
 
+	"github.com/go-drift/drift/pkg/core"
 	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/layout"
 	drifttest "github.com/go-drift/drift/pkg/testing"
 	"github.com/go-drift/drift/pkg/widgets"
 )
@@ -174,3 +176,27 @@ func TestRichText_AlignCenter_ExpandsWidth(t *testing.T) {
 		t.Errorf("center-aligned rich text width: expected 300, got %v", size.Width)
 	}
 }
+
+func TestRichText_InlineChildrenAreLaidOut(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+
+	tester.PumpWidget(widgets.RichText{
+		Content: graphics.Spans(
+			graphics.Span("Assigned to "),
+			graphics.PlaceholderSpan(graphics.PlaceholderAlignmentMiddle),
+		),
+		Children: []core.Widget{widgets.SizedBox{Width: 40, Height: 20}},
+	})
+
+	result := tester.Find(drifttest.ByType[widgets.SizedBox]())
+	if !result.Exists() {
+		t.Fatal("expected inline child to be mounted")
+	}
+	box, ok := result.RenderObject().(layout.RenderBox)
+	if !ok {
+		t.Fatal("expected inline child to have a render box")
+	}
+	if got := box.Size(); got != (graphics.Size{Width: 40, Height: 20}) {
+		t.Errorf("inline child size = %v, want 40x20", got)
+	}
+}
@@ -0,0 +1,349 @@
+package widgets
+
+import (
+	"time"
+
+	"github.com/go-drift/drift/pkg/animation"
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/gestures"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/layout"
+	"github.com/go-drift/drift/pkg/platform"
+	"github.com/go-drift/drift/pkg/rive"
+)
+
+// Rive renders an interactive Rive animation driven by its state machine.
+//
+// # State Machine
+//
+// The widget creates its own instance of the named Artboard from File and
+// runs StateMachine on it, advancing every frame while the state machine is
+// animating and idling once it settles. Use a [RiveController] to set the
+// state machine's inputs from app state.
+//
+// # Pointer Input
+//
+// Presses, drags, and releases within the widget are forwarded to the state
+// machine, so listeners authored in the Rive editor (hover, press, and
+// release actions on shapes) work without extra wiring. Pointer positions
+// are mapped into artboard coordinates using the same fit as drawing.
+//
+// # Sizing Behavior
+//
+// The artboard is scaled to fit the widget and centered. When both Width
+// and Height are zero, the widget uses the artboard's intrinsic size; when
+// one is set, the other follows the artboard's aspect ratio.
+//
+// # Creation Patterns
+//
+//	// Default artboard and state machine
+//	widgets.Rive{File: s.file, Width: 200}
+//
+//	// Named artboard and state machine, with inputs set from app state
+//	widgets.Rive{
+//	    File:         s.file,
+//	    Artboard:     "Toggle",
+//	    StateMachine: "Toggle State",
+//	    Controller:   s.toggle,
+//	    Width:        80,
+//	    Height:       40,
+//	}
+//
+// # Lifetime
+//
+// The File must stay valid while the widget is mounted. The artboard
+// instance belongs to the widget and is destroyed after it unmounts.
+//
+// Rive requires a Skia build with the Rive runtime; see [rive.Supported].
+type Rive struct {
+	core.StatefulBase
+
+	// File is the Rive file to play. Load it with [rive.Load],
+	// [rive.LoadBytes], [rive.LoadFile], or [rive.LoadFS].
+	File *rive.File
+
+	// Artboard is the name of the artboard to show. Empty uses the file's
+	// default artboard.
+	Artboard string
+
+	// StateMachine is the name of the state machine to run. Empty uses the
+	// artboard's default state machine.
+	StateMachine string
+
+	// Controller sets the state machine's inputs.
+	Controller *RiveController
+
+	// Width is the desired width. If zero and Height is set, calculated from aspect ratio.
+	// If both zero, uses the artboard's intrinsic width.
+	Width float64
+
+	// Height is the desired height. If zero and Width is set, calculated from aspect ratio.
+	// If both zero, uses the artboard's intrinsic height.
+	Height float64
+
+	// ErrorBuilder builds a widget to show when the artboard or state
+	// machine cannot be created. Default: nothing.
+	ErrorBuilder func(err error) core.Widget
+}
+
+func (r Rive) CreateState() core.State {
+	return &riveState{}
+}
+
+type riveState struct {
+	core.StateBase
+	artboard *rive.Artboard
+	err      error
+
+	ticker      *animation.Ticker
+	lastElapsed time.Duration
+	// version counts advanced frames so the render object repaints.
+	version int
+}
+
+func (s *riveState) currentWidget() Rive {
+	return s.Element().Widget().(Rive)
+}
+
+func (s *riveState) InitState() {
+	s.ticker = animation.NewTicker(s.tick)
+	s.OnDispose(func() {
+		s.ticker.Stop()
+		s.release(s.currentWidget().Controller)
+	})
+	s.instantiate()
+}
+
+func (s *riveState) DidUpdateWidget(oldWidget core.StatefulWidget) {
+	old := oldWidget.(Rive)
+	w := s.currentWidget()
+	if old.File != w.File || old.Artboard != w.Artboard || old.StateMachine != w.StateMachine {
+		s.release(old.Controller)
+		s.err = nil
+		s.instantiate()
+		return
+	}
+	if old.Controller != w.Controller {
+		if old.Controller != nil {
+			old.Controller.attach(nil, nil)
+		}
+		s.attachController()
+	}
+}
+
+// instantiate creates the artboard instance for the current widget and
+// starts advancing it.
+func (s *riveState) instantiate() {
+	w := s.currentWidget()
+	if w.File == nil {
+		return
+	}
+	artboard, err := w.File.Instantiate(w.Artboard, w.StateMachine)
+	if err != nil {
+		s.err = err
+		return
+	}
+	s.artboard = artboard
+	s.attachController()
+	s.wake()
+}
+
+func (s *riveState) attachController() {
+	if c := s.currentWidget().Controller; c != nil && s.artboard != nil {
+		c.attach(s.artboard, s.wake)
+	}
+}
+
+// release detaches controller and destroys the artboard once the current
+// frame, which may still reference it, has been drawn.
+func (s *riveState) release(controller *RiveController) {
+	s.ticker.Stop()
+	if controller != nil {
+		controller.attach(nil, nil)
+	}
+	artboard := s.artboard
+	if artboard == nil {
+		return
+	}
+	s.artboard = nil
+	if !platform.Dispatch(artboard.Destroy) {
+		artboard.Destroy()
+	}
+}
+
+// wake resumes advancing the state machine after an input or pointer event.
+func (s *riveState) wake() {
+	if s.artboard == nil || s.ticker.IsActive() {
+		return
+	}
+	s.lastElapsed = 0
+	s.ticker.Start()
+}
+
+func (s *riveState) tick(elapsed time.Duration) {
+	if s.artboard == nil {
+		s.ticker.Stop()
+		return
+	}
+	dt := elapsed - s.lastElapsed
+	s.lastElapsed = elapsed
+	more := s.artboard.Advance(dt)
+	s.SetState(func() { s.version++ })
+	if !more {
+		s.ticker.Stop()
+	}
+}
+
+// handlePointer forwards a pointer event, in the widget's local
+// coordinates, to the state machine.
+func (s *riveState) handlePointer(phase gestures.PointerPhase, position graphics.Offset, size graphics.Size) {
+	if s.artboard == nil {
+		return
+	}
+	switch phase {
+	case gestures.PointerPhaseDown:
+		s.artboard.PointerDown(position, size)
+	case gestures.PointerPhaseMove:
+		s.artboard.PointerMove(position, size)
+	default:
+		s.artboard.PointerUp(position, size)
+	}
+	s.wake()
+}
+
+func (s *riveState) Build(ctx core.BuildContext) core.Widget {
+	w := s.currentWidget()
+	if s.err != nil && w.ErrorBuilder != nil {
+		child := w.ErrorBuilder(s.err)
+		if w.Width > 0 || w.Height > 0 {
+			return SizedBox{Width: w.Width, Height: w.Height, Child: child}
+		}
+		return child
+	}
+	return riveRender{
+		artboard: s.artboard,
+		width:    w.Width,
+		height:   w.Height,
+		version:  s.version,
+		state:    s,
+	}
+}
+
+// riveRender is the inner RenderObjectWidget for the Rive widget.
+type riveRender struct {
+	core.RenderObjectBase
+	artboard *rive.Artboard
+	width    float64
+	height   float64
+	version  int
+	state    *riveState
+}
+
+func (r riveRender) CreateRenderObject(ctx core.BuildContext) layout.RenderObject {
+	ro := &renderRive{}
+	r.UpdateRenderObject(ctx, ro)
+	ro.SetSelf(ro)
+	return ro
+}
+
+func (r riveRender) UpdateRenderObject(ctx core.BuildContext, renderObject layout.RenderObject) {
+	ro, ok := renderObject.(*renderRive)
+	if !ok {
+		return
+	}
+	layoutChanged := ro.artboard != r.artboard || ro.width != r.width || ro.height != r.height
+	paintChanged := layoutChanged || ro.version != r.version
+
+	ro.artboard = r.artboard
+	ro.width = r.width
+	ro.height = r.height
+	ro.version = r.version
+	ro.state = r.state
+
+	if layoutChanged {
+		ro.MarkNeedsLayout()
+	}
+	if paintChanged {
+		ro.MarkNeedsPaint()
+	}
+}
+
+type renderRive struct {
+	layout.RenderBoxBase
+	artboard *rive.Artboard
+	width    float64
+	height   float64
+	version  int
+	state    *riveState
+
+	// hitPosition is the local position of the last hit test, used to map
+	// the global positions of pointer events into local coordinates.
+	hitPosition graphics.Offset
+	origin      graphics.Offset
+}
+
+func (r *renderRive) IsRepaintBoundary() bool {
+	return true
+}
+
+func (r *renderRive) SetChild(child layout.RenderObject) {}
+
+func (r *renderRive) PerformLayout() {
+	constraints := r.Constraints()
+	var size graphics.Size
+
+	if r.artboard != nil {
+		intrinsic := r.artboard.Size()
+		aspectRatio := 1.0
+		if intrinsic.Height > 0 {
+			aspectRatio = intrinsic.Width / intrinsic.Height
+		}
+
+		switch {
+		case r.width > 0 && r.height > 0:
+			size = graphics.Size{Width: r.width, Height: r.height}
+		case r.width > 0:
+			size = graphics.Size{Width: r.width, Height: r.width / aspectRatio}
+		case r.height > 0:
+			size = graphics.Size{Width: r.height * aspectRatio, Height: r.height}
+		default:
+			size = intrinsic
+		}
+	} else {
+		size = graphics.Size{Width: r.width, Height: r.height}
+	}
+
+	r.SetSize(constraints.Constrain(size))
+}
+
+func (r *renderRive) Paint(ctx *layout.PaintContext) {
+	if r.artboard == nil {
+		return
+	}
+	bounds := graphics.RectFromLTWH(0, 0, r.Size().Width, r.Size().Height)
+	r.artboard.Draw(ctx.Canvas, bounds)
+}
+
+func (r *renderRive) HitTest(position graphics.Offset, result *layout.HitTestResult) bool {
+	if !layout.WithinBounds(position, r.Size()) {
+		return false
+	}
+	r.hitPosition = position
+	result.Add(r)
+	return true
+}
+
+// HandlePointer implements PointerHandler, forwarding pointer events to the
+// state machine for its listeners to hit test.
+func (r *renderRive) HandlePointer(event gestures.PointerEvent) {
+	if r.state == nil {
+		return
+	}
+	if event.Phase == gestures.PointerPhaseDown {
+		// Hit testing ran just before the down event, so the difference
+		// between the two positions is the widget's global origin.
+		r.origin = graphics.Offset{X: event.Position.X - r.hitPosition.X, Y: event.Position.Y - r.hitPosition.Y}
+	}
+	local := graphics.Offset{X: event.Position.X - r.origin.X, Y: event.Position.Y - r.origin.Y}
+	r.state.handlePointer(event.Phase, local, r.Size())
+}
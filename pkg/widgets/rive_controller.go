@@ -0,0 +1,178 @@
+package widgets
+
+import (
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/rive"
+)
+
+// riveStateMachine is the part of [rive.Artboard] a RiveController drives.
+type riveStateMachine interface {
+	Inputs() []rive.Input
+	SetBool(name string, value bool) bool
+	SetNumber(name string, value float64) bool
+	Fire(name string) bool
+	Value(name string) (float64, bool)
+}
+
+// RiveController sets the state machine inputs of a [Rive] widget, so app
+// state can drive the animation:
+//
+//	s.rive = widgets.NewRiveController()
+//	core.UseDisposable(s, s.rive)
+//
+//	// In Build
+//	widgets.Rive{File: s.file, StateMachine: "Button", Controller: s.rive, Width: 120}
+//
+//	// When app state changes
+//	s.rive.SetBool("isLoading", true)
+//	s.rive.Fire("success")
+//
+// Boolean and number values set before the widget's artboard is ready are
+// remembered and applied once it is; triggers fired before then are
+// dropped. A controller should drive one Rive widget at a time.
+type RiveController struct {
+	machine  riveStateMachine
+	pending  map[string]any
+	wake     func()
+	disposed bool
+
+	listeners core.Notifier
+}
+
+// NewRiveController creates a controller with no artboard attached.
+func NewRiveController() *RiveController {
+	return &RiveController{
+		pending: make(map[string]any),
+	}
+}
+
+// SetBool sets a boolean input. Returns false if the attached state machine
+// has no such input.
+func (c *RiveController) SetBool(name string, value bool) bool {
+	return c.set(name, value)
+}
+
+// SetNumber sets a number input. Returns false if the attached state machine
+// has no such input.
+func (c *RiveController) SetNumber(name string, value float64) bool {
+	return c.set(name, value)
+}
+
+// Fire fires a trigger input. Returns false if no artboard is attached or
+// its state machine has no such input.
+func (c *RiveController) Fire(name string) bool {
+	if c.disposed || c.machine == nil || !c.machine.Fire(name) {
+		return false
+	}
+	c.notifyInputChanged()
+	return true
+}
+
+// Bool returns the value of a boolean input.
+func (c *RiveController) Bool(name string) (value, ok bool) {
+	v, ok := c.value(name)
+	return v != 0, ok
+}
+
+// Number returns the value of a number input.
+func (c *RiveController) Number(name string) (float64, bool) {
+	return c.value(name)
+}
+
+// Inputs lists the inputs of the attached state machine, or nil if no
+// artboard is attached.
+func (c *RiveController) Inputs() []rive.Input {
+	if c.machine == nil {
+		return nil
+	}
+	return c.machine.Inputs()
+}
+
+// IsAttached reports whether the controller is driving an artboard.
+func (c *RiveController) IsAttached() bool {
+	return c.machine != nil
+}
+
+// AddListener adds a callback that fires when an artboard is attached or
+// detached. Returns an unsubscribe function.
+func (c *RiveController) AddListener(fn func()) func() {
+	return c.listeners.AddListener(fn)
+}
+
+// Dispose detaches the controller and releases listeners.
+func (c *RiveController) Dispose() {
+	c.disposed = true
+	c.machine = nil
+	c.wake = nil
+	c.pending = nil
+	c.listeners.Dispose()
+}
+
+// attach drives machine, applying values set while detached. wake is called
+// after each input change so the widget resumes advancing the state
+// machine. A nil machine detaches the controller.
+func (c *RiveController) attach(machine riveStateMachine, wake func()) {
+	if c.disposed {
+		return
+	}
+	c.machine = machine
+	c.wake = wake
+	if machine != nil {
+		for name, value := range c.pending {
+			c.apply(name, value)
+		}
+		if len(c.pending) > 0 {
+			c.notifyInputChanged()
+		}
+	}
+	c.listeners.Notify()
+}
+
+func (c *RiveController) set(name string, value any) bool {
+	if c.disposed {
+		return false
+	}
+	// Keep the value so it carries over to the next artboard attached, such
+	// as after the widget switches files.
+	c.pending[name] = value
+	if c.machine == nil {
+		return true
+	}
+	if !c.apply(name, value) {
+		return false
+	}
+	c.notifyInputChanged()
+	return true
+}
+
+func (c *RiveController) apply(name string, value any) bool {
+	switch v := value.(type) {
+	case bool:
+		return c.machine.SetBool(name, v)
+	case float64:
+		return c.machine.SetNumber(name, v)
+	}
+	return false
+}
+
+func (c *RiveController) value(name string) (float64, bool) {
+	if c.machine != nil {
+		return c.machine.Value(name)
+	}
+	switch v := c.pending[name].(type) {
+	case bool:
+		if v {
+			return 1, true
+		}
+		return 0, true
+	case float64:
+		return v, true
+	}
+	return 0, false
+}
+
+func (c *RiveController) notifyInputChanged() {
+	if c.wake != nil {
+		c.wake()
+	}
+}
@@ -0,0 +1,139 @@
+package widgets
+
+import (
+	"testing"
+
+	"github.com/go-drift/drift/pkg/rive"
+)
+
+// fakeRiveStateMachine records inputs in place of a Rive artboard.
+type fakeRiveStateMachine struct {
+	bools   map[string]bool
+	numbers map[string]float64
+	fired   []string
+}
+
+func newFakeRiveStateMachine() *fakeRiveStateMachine {
+	return &fakeRiveStateMachine{
+		bools:   map[string]bool{"hover": false},
+		numbers: map[string]float64{"level": 0},
+	}
+}
+
+func (m *fakeRiveStateMachine) Inputs() []rive.Input {
+	return []rive.Input{
+		{Name: "hover", Kind: rive.InputBool},
+		{Name: "level", Kind: rive.InputNumber},
+		{Name: "press", Kind: rive.InputTrigger},
+	}
+}
+
+func (m *fakeRiveStateMachine) SetBool(name string, value bool) bool {
+	if _, ok := m.bools[name]; !ok {
+		return false
+	}
+	m.bools[name] = value
+	return true
+}
+
+func (m *fakeRiveStateMachine) SetNumber(name string, value float64) bool {
+	if _, ok := m.numbers[name]; !ok {
+		return false
+	}
+	m.numbers[name] = value
+	return true
+}
+
+func (m *fakeRiveStateMachine) Fire(name string) bool {
+	if name != "press" {
+		return false
+	}
+	m.fired = append(m.fired, name)
+	return true
+}
+
+func (m *fakeRiveStateMachine) Value(name string) (float64, bool) {
+	if v, ok := m.bools[name]; ok {
+		if v {
+			return 1, true
+		}
+		return 0, true
+	}
+	v, ok := m.numbers[name]
+	return v, ok
+}
+
+func TestRiveController_AppliesPendingInputsOnAttach(t *testing.T) {
+	c := NewRiveController()
+	defer c.Dispose()
+
+	c.SetBool("hover", true)
+	c.SetNumber("level", 3)
+	if c.Fire("press") {
+		t.Fatal("expected triggers to be dropped while detached")
+	}
+	if v, ok := c.Number("level"); !ok || v != 3 {
+		t.Fatalf("expected pending level 3, got %v (ok=%v)", v, ok)
+	}
+
+	m := newFakeRiveStateMachine()
+	wakes := 0
+	attached := 0
+	c.AddListener(func() { attached++ })
+	c.attach(m, func() { wakes++ })
+
+	if !m.bools["hover"] || m.numbers["level"] != 3 {
+		t.Fatalf("expected pending inputs applied, got %v %v", m.bools, m.numbers)
+	}
+	if wakes != 1 || attached != 1 {
+		t.Fatalf("expected one wake and one listener call on attach, got %d and %d", wakes, attached)
+	}
+	if len(m.fired) != 0 {
+		t.Fatalf("expected dropped trigger not to fire, got %v", m.fired)
+	}
+}
+
+func TestRiveController_ForwardsInputs(t *testing.T) {
+	c := NewRiveController()
+	defer c.Dispose()
+	m := newFakeRiveStateMachine()
+	wakes := 0
+	c.attach(m, func() { wakes++ })
+
+	if !c.Fire("press") || len(m.fired) != 1 {
+		t.Fatalf("expected trigger to fire, got %v", m.fired)
+	}
+	if !c.SetBool("hover", true) {
+		t.Fatal("expected SetBool to succeed")
+	}
+	if v, ok := c.Bool("hover"); !ok || !v {
+		t.Fatalf("expected hover true, got %v (ok=%v)", v, ok)
+	}
+	if c.SetNumber("missing", 1) {
+		t.Fatal("expected unknown input to report false")
+	}
+	if wakes != 2 {
+		t.Fatalf("expected a wake per applied input, got %d", wakes)
+	}
+	if n := len(c.Inputs()); n != 3 {
+		t.Fatalf("expected 3 inputs, got %d", n)
+	}
+}
+
+func TestRiveController_KeepsValuesAcrossArtboards(t *testing.T) {
+	c := NewRiveController()
+	defer c.Dispose()
+	c.attach(newFakeRiveStateMachine(), nil)
+	c.SetNumber("level", 5)
+
+	c.attach(nil, nil)
+	if c.IsAttached() || c.Inputs() != nil {
+		t.Fatal("expected controller to be detached")
+	}
+
+	next := newFakeRiveStateMachine()
+	c.attach(next, nil)
+	if next.numbers["level"] != 5 {
+		t.Fatalf("expected level carried to the new artboard, got %v", next.numbers["level"])
+	}
+}
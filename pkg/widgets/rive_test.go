@@ -0,0 +1,42 @@
+package widgets_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/rive"
+	drifttest "github.com/go-drift/drift/pkg/testing"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+func TestRive_InstantiateErrorShowsErrorBuilder(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	tester.SetSize(graphics.Size{Width: 400, Height: 400})
+
+	var riveErr error
+	tester.PumpWidget(widgets.Column{Children: []core.Widget{
+		widgets.Rive{
+			File:   &rive.File{},
+			Width:  100,
+			Height: 50,
+			ErrorBuilder: func(err error) core.Widget {
+				riveErr = err
+				return widgets.Text{Content: "Unavailable"}
+			},
+		},
+		probeBox{SizedBox: widgets.SizedBox{Width: 10, Height: 10}},
+	}})
+
+	// Host builds have no Rive runtime, so instantiation always fails.
+	if !errors.Is(riveErr, rive.ErrUnsupported) {
+		t.Fatalf("expected ErrUnsupported, got %v", riveErr)
+	}
+	if !tester.Find(drifttest.ByText("Unavailable")).Exists() {
+		t.Fatal("expected error widget")
+	}
+	if off := probeOffset(tester); off.Y != 50 {
+		t.Fatalf("expected error widget sized to Height 50, got probe at %v", off)
+	}
+}
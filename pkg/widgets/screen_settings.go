@@ -0,0 +1,128 @@
+package widgets
+
+import (
+	"slices"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/platform"
+)
+
+// ScreenSettings applies display settings through [platform.Screen] while it
+// is mounted, and restores the previous settings when it is removed. Put it
+// at the root of a route's page to tie the settings to that route: they take
+// effect when the route is pushed and are undone when it is popped.
+//
+//	widgets.ScreenSettings{
+//	    Brightness: 1, // full brightness to scan a ticket barcode
+//	    KeepAwake:  true,
+//	    Child:      ticketPage,
+//	}
+//
+// Several ScreenSettings can be mounted at once, for example on stacked
+// routes. The most recently mounted one that sets Brightness or Orientation
+// decides that value, and the screen stays awake (or immersive) while any of
+// them sets KeepAwake (or Immersive).
+type ScreenSettings struct {
+	core.StatefulBase
+
+	// Brightness overrides the window brightness, from 0 to 1. Zero leaves
+	// the brightness unchanged.
+	Brightness float64
+
+	// KeepAwake keeps the screen from dimming and locking.
+	KeepAwake bool
+
+	// Orientation locks the window orientation. The zero value
+	// [platform.OrientationUnlocked] leaves the orientation unchanged.
+	Orientation platform.OrientationLock
+
+	// Immersive hides the system bars so the content fills the screen.
+	Immersive bool
+
+	// Child is the content shown with these settings.
+	Child core.Widget
+}
+
+// CreateState creates the state for ScreenSettings.
+func (s ScreenSettings) CreateState() core.State {
+	return &screenSettingsState{}
+}
+
+type screenSettingsState struct {
+	core.StateBase
+	settings ScreenSettings
+}
+
+func (s *screenSettingsState) InitState() {
+	s.settings = s.Element().Widget().(ScreenSettings)
+	activeScreenSettings = append(activeScreenSettings, s)
+	applyScreenSettings()
+}
+
+func (s *screenSettingsState) DidUpdateWidget(oldWidget core.StatefulWidget) {
+	s.settings = s.Element().Widget().(ScreenSettings)
+	applyScreenSettings()
+}
+
+func (s *screenSettingsState) Dispose() {
+	activeScreenSettings = slices.DeleteFunc(activeScreenSettings, func(st *screenSettingsState) bool {
+		return st == s
+	})
+	applyScreenSettings()
+	s.StateBase.Dispose()
+}
+
+func (s *screenSettingsState) Build(ctx core.BuildContext) core.Widget {
+	return s.settings.Child
+}
+
+// screenConfig is the combined effect of the mounted ScreenSettings.
+type screenConfig struct {
+	brightness  float64
+	keepAwake   bool
+	orientation platform.OrientationLock
+	immersive   bool
+}
+
+var (
+	// activeScreenSettings holds the mounted ScreenSettings, oldest first.
+	activeScreenSettings []*screenSettingsState
+
+	// appliedScreenConfig is the configuration last sent to the platform.
+	appliedScreenConfig screenConfig
+)
+
+// applyScreenSettings sends the settings that changed since the last call
+// to the platform. Failures are ignored; the settings are best effort.
+func applyScreenSettings() {
+	var config screenConfig
+	for _, st := range activeScreenSettings {
+		if st.settings.Brightness > 0 {
+			config.brightness = st.settings.Brightness
+		}
+		if st.settings.Orientation != platform.OrientationUnlocked {
+			config.orientation = st.settings.Orientation
+		}
+		config.keepAwake = config.keepAwake || st.settings.KeepAwake
+		config.immersive = config.immersive || st.settings.Immersive
+	}
+
+	applied := appliedScreenConfig
+	appliedScreenConfig = config
+	if config.brightness != applied.brightness {
+		if config.brightness > 0 {
+			platform.Screen.SetBrightness(config.brightness)
+		} else {
+			platform.Screen.ResetBrightness()
+		}
+	}
+	if config.keepAwake != applied.keepAwake {
+		platform.Screen.SetKeepAwake(config.keepAwake)
+	}
+	if config.orientation != applied.orientation {
+		platform.Screen.SetOrientationLock(config.orientation)
+	}
+	if config.immersive != applied.immersive {
+		platform.Screen.SetImmersive(config.immersive)
+	}
+}
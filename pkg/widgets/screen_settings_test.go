@@ -0,0 +1,102 @@
+package widgets_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/platform"
+	drifttest "github.com/go-drift/drift/pkg/testing"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+// screenBridge records drift/screen calls as "method:value" strings.
+type screenBridge struct {
+	calls []string
+}
+
+func (b *screenBridge) InvokeMethod(_ context.Context, channel, method string, argsData []byte) ([]byte, error) {
+	if channel == "drift/screen" {
+		call := method
+		if args, _ := platform.DefaultCodec.Decode(argsData); args != nil {
+			for _, v := range args.(map[string]any) {
+				call += fmt.Sprintf(":%v", v)
+			}
+		}
+		b.calls = append(b.calls, call)
+	}
+	return platform.DefaultCodec.Encode(nil)
+}
+
+func (b *screenBridge) StartEventStream(string) error { return nil }
+func (b *screenBridge) StopEventStream(string) error  { return nil }
+
+func (b *screenBridge) take() []string {
+	calls := b.calls
+	b.calls = nil
+	return calls
+}
+
+// childrenHost shows its children in a Column and lets the test replace them
+// without remounting the tree.
+type childrenHost struct {
+	core.StatefulBase
+	state **childrenHostState
+}
+
+func (h childrenHost) CreateState() core.State {
+	s := &childrenHostState{}
+	*h.state = s
+	return s
+}
+
+type childrenHostState struct {
+	core.StateBase
+	children []core.Widget
+}
+
+func (s *childrenHostState) show(children ...core.Widget) {
+	s.SetState(func() { s.children = children })
+}
+
+func (s *childrenHostState) Build(ctx core.BuildContext) core.Widget {
+	return widgets.Column{Children: s.children}
+}
+
+func TestScreenSettings_AppliesAndRestores(t *testing.T) {
+	bridge := &screenBridge{}
+	platform.SetNativeBridge(bridge)
+	t.Cleanup(platform.ResetForTest)
+	tester := drifttest.NewWidgetTesterWithT(t)
+
+	page := widgets.ScreenSettings{Brightness: 0.8, KeepAwake: true, Child: widgets.SizedBox{}}
+	player := widgets.ScreenSettings{Orientation: platform.OrientationLandscape, Immersive: true, Child: widgets.SizedBox{}}
+
+	var host *childrenHostState
+	tester.PumpWidget(childrenHost{state: &host})
+	host.show(page)
+	tester.Pump()
+	assertCalls(t, bridge.take(), "setBrightness:0.8", "setKeepAwake:true")
+
+	// A second mount adds its settings and keeps the first one's.
+	host.show(page, player)
+	tester.Pump()
+	assertCalls(t, bridge.take(), "setOrientationLock:landscape", "setImmersive:true")
+
+	// Removing it restores only what it changed.
+	host.show(page)
+	tester.Pump()
+	assertCalls(t, bridge.take(), "setOrientationLock:unlocked", "setImmersive:false")
+
+	host.show()
+	tester.Pump()
+	assertCalls(t, bridge.take(), "resetBrightness", "setKeepAwake:false")
+}
+
+func assertCalls(t *testing.T, got []string, want ...string) {
+	t.Helper()
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("screen calls: got %v, want %v", got, want)
+	}
+}
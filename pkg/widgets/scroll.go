@@ -45,6 +45,14 @@ import (
 //	    Child:   content,
 //	}
 //
+// # Reverse
+//
+// With Reverse set, the content is anchored at the end of the viewport: a
+// scroll offset of zero shows the end of the content, content shorter than
+// the viewport sits at the end, and scrolling toward the start increases
+// the offset. Content that grows at the end stays in view at offset zero,
+// as a chat transcript's newest message should.
+//
 // For scrollable lists, consider [ListView] or [ListViewBuilder] which provide
 // additional features like item-based layout and virtualization.
 type ScrollView struct {
@@ -57,6 +65,9 @@ type ScrollView struct {
 	Controller      *ScrollController
 	Physics         ScrollPhysics
 	Padding         layout.EdgeInsets
+	// Reverse anchors the content at the end of the viewport and measures
+	// the scroll offset from there.
+	Reverse bool
 }
 
 func (s ScrollView) Build(ctx core.BuildContext) core.Widget {
@@ -73,6 +84,7 @@ func (s ScrollView) Build(ctx core.BuildContext) core.Widget {
 		ScrollDirection: s.ScrollDirection,
 		Controller:      s.Controller,
 		Physics:         s.Physics,
+		Reverse:         s.Reverse,
 	}
 }
 
@@ -83,6 +95,7 @@ type scrollViewCore struct {
 	ScrollDirection Axis
 	Controller      *ScrollController
 	Physics         ScrollPhysics
+	Reverse         bool
 }
 
 func (s scrollViewCore) ChildWidget() core.Widget {
@@ -100,6 +113,7 @@ func (s scrollViewCore) CreateRenderObject(ctx core.BuildContext) layout.RenderO
 	}
 	scroll := &renderScrollView{
 		direction:  s.ScrollDirection,
+		reverse:    s.Reverse,
 		controller: controller,
 		physics:    physics,
 	}
@@ -115,6 +129,7 @@ func (s scrollViewCore) CreateRenderObject(ctx core.BuildContext) layout.RenderO
 func (s scrollViewCore) UpdateRenderObject(ctx core.BuildContext, renderObject layout.RenderObject) {
 	if scroll, ok := renderObject.(*renderScrollView); ok {
 		scroll.direction = s.ScrollDirection
+		scroll.reverse = s.Reverse
 		scroll.updateController(s.Controller)
 		scroll.updatePhysics(s.Physics)
 		scroll.configureDrag()
@@ -127,6 +142,7 @@ type renderScrollView struct {
 	layout.RenderBoxBase
 	child          layout.RenderBox
 	direction      Axis
+	reverse        bool
 	controller     *ScrollController
 	physics        ScrollPhysics
 	position       *ScrollPosition
@@ -235,16 +251,16 @@ func (r *renderScrollView) Paint(ctx *layout.PaintContext) {
 	// Push clip BEFORE scroll translation (clip is viewport-relative)
 	ctx.PushClipRect(clipRect)
 
-	offset := r.scrollOffset()
+	shift := r.contentShift()
 	if r.direction == AxisHorizontal {
-		ctx.Canvas.Translate(-offset, 0)
-		ctx.PushTranslation(-offset, 0)
+		ctx.Canvas.Translate(shift, 0)
+		ctx.PushTranslation(shift, 0)
 	} else {
-		ctx.Canvas.Translate(0, -offset)
-		ctx.PushTranslation(0, -offset)
+		ctx.Canvas.Translate(0, shift)
+		ctx.PushTranslation(0, shift)
 	}
 
-	if !r.paintCulled(ctx, size, offset) {
+	if !r.paintCulled(ctx, size, -shift) {
 		r.child.Paint(ctx)
 	}
 
@@ -261,11 +277,11 @@ func (r *renderScrollView) HitTest(position graphics.Offset, result *layout.HitT
 	}
 	if r.child != nil {
 		local := position
-		offset := r.scrollOffset()
+		shift := r.contentShift()
 		if r.direction == AxisHorizontal {
-			local.X += offset
+			local.X -= shift
 		} else {
-			local.Y += offset
+			local.Y -= shift
 		}
 		if r.child.HitTest(local, result) {
 			result.Add(r)
@@ -301,6 +317,12 @@ func (r *renderScrollView) HandlePointer(event gestures.PointerEvent) {
 }
 
 func (r *renderScrollView) configureDrag() {
+	// Dragging toward the end scrolls toward the start, which is a larger
+	// offset when reversed.
+	sign := -1.0
+	if r.reverse {
+		sign = 1
+	}
 	onStart := func(details gestures.DragStartDetails) {
 		if r.position != nil {
 			r.position.StopBallistic()
@@ -310,13 +332,13 @@ func (r *renderScrollView) configureDrag() {
 		if r.position == nil {
 			return
 		}
-		r.position.ApplyUserOffset(-details.PrimaryDelta)
+		r.position.ApplyUserOffset(sign * details.PrimaryDelta)
 	}
 	onEnd := func(details gestures.DragEndDetails) {
 		if r.position == nil {
 			return
 		}
-		r.position.StartBallistic(-details.PrimaryVelocity)
+		r.position.StartBallistic(sign * details.PrimaryVelocity)
 	}
 	onCancel := func() {
 		if r.position != nil {
@@ -384,27 +406,43 @@ func (r *renderScrollView) updateExtents() {
 	if r.position == nil {
 		return
 	}
-	size := r.Size()
-	content := 0.0
-	if r.child != nil {
-		childSize := r.child.Size()
-		if r.direction == AxisHorizontal {
-			content = childSize.Width
-		} else {
-			content = childSize.Height
-		}
-	}
-	viewport := size.Height
-	if r.direction == AxisHorizontal {
-		viewport = size.Width
-	}
-	max := content - viewport
+	max := r.contentExtent() - r.viewportExtent()
 	if max < 0 {
 		max = 0
 	}
 	r.position.SetExtents(0, max)
 }
 
+// viewportExtent returns the view's size along the scroll axis.
+func (r *renderScrollView) viewportExtent() float64 {
+	if r.direction == AxisHorizontal {
+		return r.Size().Width
+	}
+	return r.Size().Height
+}
+
+// contentExtent returns the child's size along the scroll axis.
+func (r *renderScrollView) contentExtent() float64 {
+	if r.child == nil {
+		return 0
+	}
+	if r.direction == AxisHorizontal {
+		return r.child.Size().Width
+	}
+	return r.child.Size().Height
+}
+
+// contentShift returns how far the child is moved along the scroll axis:
+// back by the scroll offset, or when reversed, from having its end at the
+// viewport's end.
+func (r *renderScrollView) contentShift() float64 {
+	offset := r.scrollOffset()
+	if !r.reverse {
+		return -offset
+	}
+	return r.viewportExtent() - r.contentExtent() + offset
+}
+
 func (r *renderScrollView) scrollOffset() float64 {
 	if r.position == nil {
 		return 0
@@ -413,21 +451,21 @@ func (r *renderScrollView) scrollOffset() float64 {
 }
 
 func (r *renderScrollView) ScrollOffset() graphics.Offset {
-	offset := r.scrollOffset()
+	shift := r.contentShift()
 	if r.direction == AxisHorizontal {
-		return graphics.Offset{X: -offset}
+		return graphics.Offset{X: shift}
 	}
-	return graphics.Offset{Y: -offset}
+	return graphics.Offset{Y: shift}
 }
 
 // SemanticScrollOffset implements layout.SemanticScrollOffsetProvider.
 // Returns the scroll offset to subtract from child positions in the semantics tree.
 func (r *renderScrollView) SemanticScrollOffset() graphics.Offset {
-	offset := r.scrollOffset()
+	shift := r.contentShift()
 	if r.direction == AxisHorizontal {
-		return graphics.Offset{X: offset}
+		return graphics.Offset{X: -shift}
 	}
-	return graphics.Offset{Y: offset}
+	return graphics.Offset{Y: -shift}
 }
 
 func (r *renderScrollView) paintCulled(ctx *layout.PaintContext, size graphics.Size, scrollOffset float64) bool {
@@ -489,8 +527,7 @@ type ScrollController struct {
 	InitialScrollOffset float64
 	positions           []*ScrollPosition
 	viewportExtent      float64
-	listeners           map[int]func()
-	nextListenerID      int
+	listeners           core.Notifier
 }
 
 // Offset returns the current scroll offset.
@@ -506,20 +543,21 @@ func (c *ScrollController) ViewportExtent() float64 {
 	return c.viewportExtent
 }
 
+// MaxScrollExtent returns the largest scroll offset, or zero when no scroll
+// view is attached.
+func (c *ScrollController) MaxScrollExtent() float64 {
+	if len(c.positions) > 0 {
+		return c.positions[0].max
+	}
+	return 0
+}
+
 // AddListener registers a callback for scroll changes.
 func (c *ScrollController) AddListener(listener func()) func() {
 	if listener == nil {
 		return func() {}
 	}
-	if c.listeners == nil {
-		c.listeners = make(map[int]func())
-	}
-	id := c.nextListenerID
-	c.nextListenerID++
-	c.listeners[id] = listener
-	return func() {
-		delete(c.listeners, id)
-	}
+	return c.listeners.AddListener(listener)
 }
 
 // JumpTo moves all attached positions to a new offset.
@@ -564,9 +602,7 @@ func (c *ScrollController) setViewportExtent(extent float64) {
 }
 
 func (c *ScrollController) notifyListeners() {
-	for _, listener := range c.listeners {
-		listener()
-	}
+	c.listeners.Notify()
 }
 
 // ScrollPosition stores the current scroll offset and extents.
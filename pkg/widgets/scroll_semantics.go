@@ -21,29 +21,34 @@ func (r *renderScrollView) DescribeSemanticsConfiguration(config *semantics.Sema
 		config.Properties.ScrollExtentMax = &maxExtent
 	}
 
-	// Add scroll actions
+	// Add scroll actions. Scrolling toward the start decreases the offset,
+	// or increases it when reversed.
 	config.Actions = semantics.NewSemanticsActions()
+	step := 100.0
+	if r.reverse {
+		step = -step
+	}
 
 	if r.direction == AxisVertical {
 		config.Actions.SetHandler(semantics.SemanticsActionScrollUp, func(args any) {
 			if r.position != nil {
-				r.position.SetOffset(r.position.Offset() - 100)
+				r.position.SetOffset(r.position.Offset() - step)
 			}
 		})
 		config.Actions.SetHandler(semantics.SemanticsActionScrollDown, func(args any) {
 			if r.position != nil {
-				r.position.SetOffset(r.position.Offset() + 100)
+				r.position.SetOffset(r.position.Offset() + step)
 			}
 		})
 	} else {
 		config.Actions.SetHandler(semantics.SemanticsActionScrollLeft, func(args any) {
 			if r.position != nil {
-				r.position.SetOffset(r.position.Offset() - 100)
+				r.position.SetOffset(r.position.Offset() - step)
 			}
 		})
 		config.Actions.SetHandler(semantics.SemanticsActionScrollRight, func(args any) {
 			if r.position != nil {
-				r.position.SetOffset(r.position.Offset() + 100)
+				r.position.SetOffset(r.position.Offset() + step)
 			}
 		})
 	}
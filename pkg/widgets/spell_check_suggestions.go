@@ -0,0 +1,109 @@
+package widgets
+
+import (
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/layout"
+	"github.com/go-drift/drift/pkg/platform"
+)
+
+// SpellCheckSuggestionsToolbar shows the spell checker's replacements for a
+// misspelled word as a row of tappable chips. Tapping a suggestion replaces
+// the word in Controller and calls OnSelected.
+//
+// Collect ranges with [SpellCheckConfiguration.OnMisspelledRanges] and show
+// the toolbar for the word under the caret:
+//
+//	if r, ok := platform.FindMisspelledRange(s.misspelled, controller.Selection().Start()); ok {
+//	    children = append(children, theme.SpellCheckSuggestionsToolbarOf(ctx, controller, r))
+//	}
+//
+// Like [Button], all visual properties are explicit; use
+// [theme.SpellCheckSuggestionsToolbarOf] for themed defaults. The toolbar
+// builds nothing when the range has no suggestions.
+type SpellCheckSuggestionsToolbar struct {
+	core.StatelessBase
+
+	// Controller holds the text containing the misspelled word.
+	Controller *platform.TextEditingController
+
+	// Range is the misspelled word and its suggestions.
+	Range platform.MisspelledRange
+
+	// MaxSuggestions limits how many suggestions are shown. Zero shows all.
+	MaxSuggestions int
+
+	// OnSelected is called with the chosen suggestion after it replaces the
+	// word, e.g. to clear the cached ranges until the next check.
+	OnSelected func(suggestion string)
+
+	// Color is the toolbar background color.
+	Color graphics.Color
+
+	// Style is the suggestion text style.
+	Style graphics.TextStyle
+
+	// Padding surrounds each suggestion.
+	Padding layout.EdgeInsets
+
+	// BorderRadius rounds the toolbar corners.
+	BorderRadius float64
+}
+
+// WithOnSelected returns a copy with the specified selection callback.
+func (t SpellCheckSuggestionsToolbar) WithOnSelected(fn func(suggestion string)) SpellCheckSuggestionsToolbar {
+	t.OnSelected = fn
+	return t
+}
+
+// WithMaxSuggestions returns a copy that shows at most n suggestions.
+func (t SpellCheckSuggestionsToolbar) WithMaxSuggestions(n int) SpellCheckSuggestionsToolbar {
+	t.MaxSuggestions = n
+	return t
+}
+
+func (t SpellCheckSuggestionsToolbar) Build(ctx core.BuildContext) core.Widget {
+	suggestions := t.Range.Suggestions
+	if t.MaxSuggestions > 0 && len(suggestions) > t.MaxSuggestions {
+		suggestions = suggestions[:t.MaxSuggestions]
+	}
+	if len(suggestions) == 0 {
+		return SizedBox{}
+	}
+
+	children := make([]core.Widget, 0, len(suggestions))
+	for _, suggestion := range suggestions {
+		children = append(children, Tappable("Replace with "+suggestion, func() {
+			t.apply(suggestion)
+		}, Container{
+			Padding: t.Padding,
+			Child:   Text{Content: suggestion, Style: t.Style, MaxLines: 1},
+		}))
+	}
+
+	return Container{
+		Color:        t.Color,
+		BorderRadius: t.BorderRadius,
+		Child: Row{
+			MainAxisSize: MainAxisSizeMin,
+			Children:     children,
+		},
+	}
+}
+
+// apply replaces the word with suggestion. A stale range, from text edited
+// since the check, is ignored rather than corrupting the text.
+func (t SpellCheckSuggestionsToolbar) apply(suggestion string) {
+	if t.Controller == nil {
+		return
+	}
+	text := t.Controller.Text()
+	r := t.Range
+	if r.Start < 0 || r.End > len(text) || r.Start > r.End || (r.Word != "" && text[r.Start:r.End] != r.Word) {
+		return
+	}
+	t.Controller.ReplaceRange(platform.TextRange{Start: r.Start, End: r.End}, suggestion)
+	if t.OnSelected != nil {
+		t.OnSelected(suggestion)
+	}
+}
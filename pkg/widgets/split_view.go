@@ -0,0 +1,540 @@
+package widgets
+
+import (
+	"math"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/layout"
+)
+
+// SplitView lays out panes side by side or stacked, separated by dividers
+// the user drags to resize them, such as a sidebar next to a detail view on
+// a tablet. Dragging a divider moves space between the two panes next to
+// it, within their MinSize and MaxSize. A pane with a CollapseThreshold
+// collapses when dragged smaller than it, and comes back when dragged out
+// again.
+//
+// Pane sizes are kept as fractions of the available space, so they scale
+// when the view is resized, for example on rotation. With a StorageKey they
+// are saved in the nearest [PageStorage] and restored when the view is built
+// again.
+//
+// On devices with a mouse or trackpad, hovering a divider shows a resize
+// cursor; see [MouseRegion].
+//
+// SplitView fills the space it is given, which must be bounded.
+//
+// # Styling Model
+//
+// SplitView is explicit: zero thickness and colors mean none. Use
+// [theme.SplitViewOf] for a themed divider:
+//
+//	theme.SplitViewOf(ctx, widgets.AxisHorizontal,
+//	    widgets.SplitPane{Child: sidebar, Weight: 1, MinSize: 200, CollapseThreshold: 120},
+//	    widgets.SplitPane{Child: detail, Weight: 2, MinSize: 320},
+//	).WithStorageKey("mail")
+type SplitView struct {
+	core.StatefulBase
+
+	// Direction is the axis the panes are laid out along: AxisHorizontal
+	// places them side by side, in reading order, and AxisVertical stacks
+	// them.
+	Direction Axis
+	// Panes are the panes in order. At least two are needed for a divider.
+	Panes []SplitPane
+
+	// DividerThickness is the space between panes, filled with DividerColor.
+	DividerThickness float64
+	// DividerHitExtent is the size of the area around a divider that can be
+	// dragged, centered on it and overlapping the panes. Values smaller
+	// than DividerThickness use DividerThickness.
+	DividerHitExtent float64
+	// DividerColor is the color of the dividers.
+	DividerColor graphics.Color
+
+	// StorageKey, when non-nil, saves the pane sizes under this key in the
+	// nearest [PageStorage]. It must be comparable.
+	StorageKey any
+}
+
+// SplitPane is one pane of a [SplitView] and its size limits, in logical
+// pixels along the view's direction.
+type SplitPane struct {
+	// Child is the pane's content. It is clipped to the pane.
+	Child core.Widget
+	// Weight is the pane's initial share of the space. If every pane has
+	// zero Weight, they share it equally; otherwise a pane with zero Weight
+	// starts at its MinSize, or collapsed if it can collapse.
+	Weight float64
+	// MinSize is the smallest the pane can be dragged to.
+	MinSize float64
+	// MaxSize is the largest the pane can be dragged to. Zero means no
+	// limit.
+	MaxSize float64
+	// CollapseThreshold, when positive, lets the pane collapse: dragged
+	// smaller than this it is hidden, and dragged back past it it returns
+	// at MinSize or larger. Its state is kept while collapsed.
+	CollapseThreshold float64
+}
+
+// WithStorageKey returns a copy that saves its pane sizes under key in the
+// nearest [PageStorage].
+func (v SplitView) WithStorageKey(key any) SplitView {
+	v.StorageKey = key
+	return v
+}
+
+func (v SplitView) CreateState() core.State {
+	return &splitViewState{}
+}
+
+type splitViewState struct {
+	core.StateBase
+	// fractions are the pane sizes as fractions of the available space.
+	fractions []float64
+	// sizes and available are from the last layout, in logical pixels.
+	sizes     []float64
+	available float64
+	// dragSizes are the sizes when the current drag started, and dragDelta
+	// its distance so far toward the end of the view.
+	dragSizes []float64
+	dragDelta float64
+	bucket    *PageStorageBucket
+}
+
+func (s *splitViewState) Build(ctx core.BuildContext) core.Widget {
+	w := s.Element().Widget().(SplitView)
+	s.bucket = PageStorageOf(ctx)
+	if len(s.fractions) != len(w.Panes) {
+		s.fractions = s.initialFractions(w)
+		s.dragSizes = nil
+	}
+	direction := DirectionalityOf(ctx)
+
+	children := make([]core.Widget, 0, 2*len(w.Panes))
+	for i, pane := range w.Panes {
+		collapsed := s.fractions[i] <= 0 && pane.CollapseThreshold > 0
+		children = append(children, Offstage{Offstage: collapsed, Child: pane.Child})
+	}
+	for i := range max(len(w.Panes)-1, 0) {
+		children = append(children, s.buildDivider(w, i, direction))
+	}
+	return splitLayout{
+		direction:     w.Direction,
+		textDirection: direction,
+		panes:         w.Panes,
+		fractions:     s.fractions,
+		thickness:     w.DividerThickness,
+		hitExtent:     max(w.DividerHitExtent, w.DividerThickness),
+		onLayout: func(sizes []float64, available float64) {
+			s.sizes, s.available = sizes, available
+		},
+		children: children,
+	}
+}
+
+// initialFractions returns the saved fractions, or fractions from the pane
+// weights.
+func (s *splitViewState) initialFractions(w SplitView) []float64 {
+	if s.bucket != nil && w.StorageKey != nil {
+		if value, ok := s.bucket.Read(w.StorageKey); ok {
+			if saved, ok := value.([]float64); ok && len(saved) == len(w.Panes) {
+				return append([]float64(nil), saved...)
+			}
+		}
+	}
+	total := 0.0
+	for _, pane := range w.Panes {
+		total += max(pane.Weight, 0)
+	}
+	fractions := make([]float64, len(w.Panes))
+	for i, pane := range w.Panes {
+		if total > 0 {
+			fractions[i] = max(pane.Weight, 0) / total
+		} else {
+			fractions[i] = 1 / float64(len(w.Panes))
+		}
+	}
+	return fractions
+}
+
+func (s *splitViewState) buildDivider(w SplitView, index int, direction graphics.TextDirection) core.Widget {
+	start := func(DragStartDetails) {
+		s.dragSizes = append([]float64(nil), s.sizes...)
+		s.dragDelta = 0
+	}
+	update := func(details DragUpdateDetails) {
+		if len(s.dragSizes) != len(w.Panes) {
+			return
+		}
+		delta := details.PrimaryDelta
+		if w.Direction == AxisHorizontal && direction == graphics.TextDirectionRTL {
+			delta = -delta
+		}
+		s.dragDelta += delta
+		s.resize(w, dragSplitSizes(s.dragSizes, w.Panes, index, s.dragDelta))
+	}
+	end := func(DragEndDetails) { s.dragSizes = nil }
+	cancel := func() { s.dragSizes = nil }
+
+	line := splitDividerLine{
+		direction: w.Direction,
+		thickness: w.DividerThickness,
+		color:     w.DividerColor,
+	}
+	if w.Direction == AxisHorizontal {
+		return GestureDetector{
+			OnHorizontalDragStart:  start,
+			OnHorizontalDragUpdate: update,
+			OnHorizontalDragEnd:    end,
+			OnHorizontalDragCancel: cancel,
+			Child:                  MouseRegion{Cursor: layout.MouseCursorResizeColumn, Child: line},
+		}
+	}
+	return GestureDetector{
+		OnVerticalDragStart:  start,
+		OnVerticalDragUpdate: update,
+		OnVerticalDragEnd:    end,
+		OnVerticalDragCancel: cancel,
+		Child:                MouseRegion{Cursor: layout.MouseCursorResizeRow, Child: line},
+	}
+}
+
+// resize applies new pane sizes and saves them.
+func (s *splitViewState) resize(w SplitView, sizes []float64) {
+	if s.available <= 0 {
+		return
+	}
+	fractions := make([]float64, len(sizes))
+	for i, size := range sizes {
+		fractions[i] = size / s.available
+	}
+	s.SetState(func() { s.fractions = fractions })
+	if s.bucket != nil && w.StorageKey != nil {
+		s.bucket.Write(w.StorageKey, append([]float64(nil), fractions...))
+	}
+}
+
+// resolvePaneSize returns the size pane takes when asked for size, at most
+// limit: zero if it collapses, else size within its limits.
+func resolvePaneSize(pane SplitPane, size, limit float64) float64 {
+	if pane.CollapseThreshold > 0 && size < pane.CollapseThreshold {
+		return 0
+	}
+	size = max(size, pane.MinSize)
+	if pane.MaxSize > 0 {
+		size = min(size, pane.MaxSize)
+	}
+	return min(max(size, 0), limit)
+}
+
+// dragSplitSizes returns sizes after the divider after pane index moves by
+// delta toward the end of the view. Only the two panes next to it change;
+// if they cannot both take the new sizes, sizes is returned unchanged.
+func dragSplitSizes(sizes []float64, panes []SplitPane, index int, delta float64) []float64 {
+	total := sizes[index] + sizes[index+1]
+	first := resolvePaneSize(panes[index], sizes[index]+delta, total)
+	second := resolvePaneSize(panes[index+1], total-first, total)
+	if first+second != total {
+		first = total - second
+		if resolvePaneSize(panes[index], first, total) != first {
+			return sizes
+		}
+	}
+	resized := append([]float64(nil), sizes...)
+	resized[index], resized[index+1] = first, second
+	return resized
+}
+
+// fitSplitSizes turns fractions of available into pane sizes within the
+// panes' limits that add up to available where the limits allow. Panes with
+// a zero fraction that can collapse stay collapsed.
+func fitSplitSizes(fractions []float64, panes []SplitPane, available float64) []float64 {
+	sizes := make([]float64, len(panes))
+	collapsed := make([]bool, len(panes))
+	for i, pane := range panes {
+		collapsed[i] = fractions[i] <= 0 && pane.CollapseThreshold > 0
+		if !collapsed[i] {
+			sizes[i] = max(fractions[i]*available, 0)
+		}
+	}
+	// Clamp to the limits and hand what is left over, or missing, to the
+	// panes that can still grow or shrink, in proportion to their sizes.
+	for range panes {
+		used := 0.0
+		for i, pane := range panes {
+			if collapsed[i] {
+				continue
+			}
+			sizes[i] = max(sizes[i], pane.MinSize)
+			if pane.MaxSize > 0 {
+				sizes[i] = min(sizes[i], pane.MaxSize)
+			}
+			used += sizes[i]
+		}
+		remaining := available - used
+		if math.Abs(remaining) < 0.5 {
+			break
+		}
+		weight := 0.0
+		for i, pane := range panes {
+			if !collapsed[i] && paneCanAbsorb(pane, sizes[i], remaining) {
+				weight += max(sizes[i], 1)
+			}
+		}
+		if weight == 0 {
+			break
+		}
+		for i, pane := range panes {
+			if !collapsed[i] && paneCanAbsorb(pane, sizes[i], remaining) {
+				sizes[i] += remaining * max(sizes[i], 1) / weight
+			}
+		}
+	}
+	return sizes
+}
+
+// paneCanAbsorb reports whether a pane of size can grow (remaining > 0) or
+// shrink (remaining < 0).
+func paneCanAbsorb(pane SplitPane, size, remaining float64) bool {
+	if remaining > 0 {
+		return pane.MaxSize <= 0 || size < pane.MaxSize
+	}
+	return size > pane.MinSize
+}
+
+// splitLayout sizes the panes of a SplitView from their fractions of the
+// space left by the dividers, followed by one divider per gap, centered on
+// it. onLayout receives the pane sizes of every layout.
+type splitLayout struct {
+	core.RenderObjectBase
+	direction     Axis
+	textDirection graphics.TextDirection
+	panes         []SplitPane
+	fractions     []float64
+	thickness     float64
+	hitExtent     float64
+	onLayout      func(sizes []float64, available float64)
+	children      []core.Widget
+}
+
+func (l splitLayout) ChildrenWidgets() []core.Widget {
+	return l.children
+}
+
+func (l splitLayout) CreateRenderObject(ctx core.BuildContext) layout.RenderObject {
+	r := &renderSplitLayout{}
+	r.SetSelf(r)
+	l.UpdateRenderObject(ctx, r)
+	return r
+}
+
+func (l splitLayout) UpdateRenderObject(ctx core.BuildContext, renderObject layout.RenderObject) {
+	if r, ok := renderObject.(*renderSplitLayout); ok {
+		r.direction = l.direction
+		r.textDirection = l.textDirection
+		r.panes = l.panes
+		r.fractions = l.fractions
+		r.thickness = l.thickness
+		r.hitExtent = l.hitExtent
+		r.onLayout = l.onLayout
+		r.MarkNeedsLayout()
+		r.MarkNeedsPaint()
+	}
+}
+
+type renderSplitLayout struct {
+	layout.RenderBoxBase
+	children      []layout.RenderBox
+	direction     Axis
+	textDirection graphics.TextDirection
+	panes         []SplitPane
+	fractions     []float64
+	thickness     float64
+	hitExtent     float64
+	onLayout      func(sizes []float64, available float64)
+	// sizes are the pane sizes from the last layout.
+	sizes []float64
+}
+
+func (r *renderSplitLayout) SetChildren(children []layout.RenderObject) {
+	for _, child := range r.children {
+		layout.SetParentOnChild(child, nil)
+	}
+	r.children = r.children[:0]
+	for _, child := range children {
+		if box, ok := child.(layout.RenderBox); ok {
+			r.children = append(r.children, box)
+			layout.SetParentOnChild(box, r)
+		}
+	}
+}
+
+func (r *renderSplitLayout) VisitChildren(visitor func(layout.RenderObject)) {
+	for _, child := range r.children {
+		visitor(child)
+	}
+}
+
+// paneChildren returns the pane children, in order.
+func (r *renderSplitLayout) paneChildren() []layout.RenderBox {
+	return r.children[:min(len(r.sizes), len(r.children))]
+}
+
+func (r *renderSplitLayout) PerformLayout() {
+	constraints := r.Constraints()
+	horizontal := r.direction == AxisHorizontal
+	size := constraints.Constrain(graphics.Size{Width: constraints.MaxWidth, Height: constraints.MaxHeight})
+	mainExtent, crossExtent := size.Height, size.Width
+	if horizontal {
+		mainExtent, crossExtent = size.Width, size.Height
+	}
+	// An unbounded view has no space to divide.
+	if math.IsInf(mainExtent, 0) || mainExtent >= math.MaxFloat64 {
+		mainExtent = 0
+	}
+	if math.IsInf(crossExtent, 0) || crossExtent >= math.MaxFloat64 {
+		crossExtent = 0
+	}
+	available := max(mainExtent-float64(max(len(r.panes)-1, 0))*r.thickness, 0)
+	r.sizes = nil
+	if len(r.fractions) == len(r.panes) {
+		r.sizes = fitSplitSizes(r.fractions, r.panes, available)
+	}
+	size = graphics.Size{Width: crossExtent, Height: mainExtent}
+	if horizontal {
+		size = graphics.Size{Width: mainExtent, Height: crossExtent}
+	}
+	r.SetSize(size)
+	if r.onLayout != nil {
+		r.onLayout(r.sizes, available)
+	}
+
+	// place lays out child with the given main axis extent at position,
+	// mirrored in a right-to-left row.
+	place := func(child layout.RenderBox, position, extent float64) {
+		var childConstraints layout.Constraints
+		var offset graphics.Offset
+		if horizontal {
+			childConstraints = layout.Tight(graphics.Size{Width: extent, Height: crossExtent})
+			if r.textDirection == graphics.TextDirectionRTL {
+				position = mainExtent - position - extent
+			}
+			offset = graphics.Offset{X: position}
+		} else {
+			childConstraints = layout.Tight(graphics.Size{Width: crossExtent, Height: extent})
+			offset = graphics.Offset{Y: position}
+		}
+		child.Layout(childConstraints, false)
+		child.SetParentData(&layout.BoxParentData{Offset: offset})
+	}
+
+	position := 0.0
+	panes := r.paneChildren()
+	for i, child := range panes {
+		place(child, position, r.sizes[i])
+		position += r.sizes[i]
+		if divider := len(panes) + i; i < len(panes)-1 && divider < len(r.children) {
+			place(r.children[divider], position+r.thickness/2-r.hitExtent/2, r.hitExtent)
+			position += r.thickness
+		}
+	}
+}
+
+func (r *renderSplitLayout) Paint(ctx *layout.PaintContext) {
+	for i, child := range r.paneChildren() {
+		if r.sizes[i] <= 0 {
+			continue
+		}
+		offset := getChildOffset(child)
+		clip := graphics.RectFromLTWH(offset.X, offset.Y, child.Size().Width, child.Size().Height)
+		ctx.Canvas.Save()
+		ctx.Canvas.ClipRect(clip)
+		ctx.PushClipRect(clip)
+		ctx.PaintChildWithLayer(child, offset)
+		ctx.PopClipRect()
+		ctx.Canvas.Restore()
+	}
+	for _, child := range r.children[len(r.paneChildren()):] {
+		ctx.PaintChildWithLayer(child, getChildOffset(child))
+	}
+}
+
+func (r *renderSplitLayout) HitTest(position graphics.Offset, result *layout.HitTestResult) bool {
+	if !layout.WithinBounds(position, r.Size()) {
+		return false
+	}
+	// Dividers are on top of the panes they overlap.
+	panes := r.paneChildren()
+	for i := len(r.children) - 1; i >= 0; i-- {
+		child := r.children[i]
+		if i < len(panes) && r.sizes[i] <= 0 {
+			continue
+		}
+		offset := getChildOffset(child)
+		local := graphics.Offset{X: position.X - offset.X, Y: position.Y - offset.Y}
+		if child.HitTest(local, result) {
+			return true
+		}
+	}
+	result.Add(r)
+	return true
+}
+
+// splitDividerLine paints a divider's line centered in its hit area.
+type splitDividerLine struct {
+	core.RenderObjectBase
+	direction Axis
+	thickness float64
+	color     graphics.Color
+}
+
+func (l splitDividerLine) CreateRenderObject(ctx core.BuildContext) layout.RenderObject {
+	r := &renderSplitDividerLine{}
+	r.SetSelf(r)
+	l.UpdateRenderObject(ctx, r)
+	return r
+}
+
+func (l splitDividerLine) UpdateRenderObject(ctx core.BuildContext, renderObject layout.RenderObject) {
+	if r, ok := renderObject.(*renderSplitDividerLine); ok {
+		r.direction = l.direction
+		r.thickness = l.thickness
+		r.color = l.color
+		r.MarkNeedsPaint()
+	}
+}
+
+type renderSplitDividerLine struct {
+	layout.RenderBoxBase
+	direction Axis
+	thickness float64
+	color     graphics.Color
+}
+
+func (r *renderSplitDividerLine) PerformLayout() {
+	r.SetSize(r.Constraints().Constrain(graphics.Size{}))
+}
+
+func (r *renderSplitDividerLine) Paint(ctx *layout.PaintContext) {
+	if r.thickness <= 0 || r.color == 0 {
+		return
+	}
+	size := r.Size()
+	paint := graphics.DefaultPaint()
+	paint.Color = r.color
+	if r.direction == AxisHorizontal {
+		ctx.Canvas.DrawRect(graphics.RectFromLTWH((size.Width-r.thickness)/2, 0, r.thickness, size.Height), paint)
+	} else {
+		ctx.Canvas.DrawRect(graphics.RectFromLTWH(0, (size.Height-r.thickness)/2, size.Width, r.thickness), paint)
+	}
+}
+
+func (r *renderSplitDividerLine) HitTest(position graphics.Offset, result *layout.HitTestResult) bool {
+	if !layout.WithinBounds(position, r.Size()) {
+		return false
+	}
+	result.Add(r)
+	return true
+}
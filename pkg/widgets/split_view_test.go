@@ -0,0 +1,94 @@
+package widgets_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/layout"
+	drifttest "github.com/go-drift/drift/pkg/testing"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+func paneWidths(tester *drifttest.WidgetTester) []float64 {
+	var widths []float64
+	for _, element := range tester.Find(drifttest.ByType[widgets.Offstage]()).All() {
+		box := element.(interface{ RenderObject() layout.RenderObject }).RenderObject().(layout.RenderBox)
+		widths = append(widths, box.Size().Width)
+	}
+	return widths
+}
+
+func sameWidths(got, want []float64) bool {
+	if len(got) != len(want) {
+		return false
+	}
+	for i := range got {
+		if math.Abs(got[i]-want[i]) > 0.01 {
+			return false
+		}
+	}
+	return true
+}
+
+func TestSplitView_DragCollapseAndRestore(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	tester.SetSize(graphics.Size{Width: 401, Height: 300})
+
+	bucket := &widgets.PageStorageBucket{}
+	split := func() core.Widget {
+		return widgets.PageStorage{
+			Bucket: bucket,
+			Child: widgets.SplitView{
+				Direction: widgets.AxisHorizontal,
+				Panes: []widgets.SplitPane{
+					{Child: widgets.Text{Content: "sidebar"}, Weight: 1, MinSize: 100, CollapseThreshold: 60},
+					{Child: widgets.Text{Content: "detail"}, Weight: 1, MinSize: 100},
+				},
+				DividerThickness: 1,
+				DividerHitExtent: 16,
+				DividerColor:     graphics.ColorBlack,
+				StorageKey:       "split",
+			},
+		}
+	}
+	tester.PumpWidget(split())
+	if got := paneWidths(tester); !sameWidths(got, []float64{200, 200}) {
+		t.Fatalf("initial widths = %v", got)
+	}
+
+	// The drag area extends past the 1-wide divider into the panes.
+	if err := tester.DragFrom(graphics.Offset{X: 195, Y: 150}, graphics.Offset{X: 260}); err != nil {
+		t.Fatal(err)
+	}
+	tester.Pump()
+	if got := paneWidths(tester); !sameWidths(got, []float64{300, 100}) {
+		t.Fatalf("widths after widening the sidebar past the detail's minimum = %v", got)
+	}
+
+	if err := tester.DragFrom(graphics.Offset{X: 300, Y: 150}, graphics.Offset{X: -250}); err != nil {
+		t.Fatal(err)
+	}
+	tester.Pump()
+	if got := paneWidths(tester); !sameWidths(got, []float64{0, 400}) {
+		t.Fatalf("widths after collapsing the sidebar = %v", got)
+	}
+
+	// The sizes come back when the view is built again.
+	tester.PumpWidget(widgets.SizedBox{})
+	tester.PumpWidget(split())
+	if got := paneWidths(tester); !sameWidths(got, []float64{0, 400}) {
+		t.Fatalf("restored widths = %v", got)
+	}
+
+	// Dragging the collapsed pane out past its threshold restores its
+	// minimum size.
+	if err := tester.DragFrom(graphics.Offset{X: 2, Y: 150}, graphics.Offset{X: 70}); err != nil {
+		t.Fatal(err)
+	}
+	tester.Pump()
+	if got := paneWidths(tester); !sameWidths(got, []float64{100, 300}) {
+		t.Fatalf("widths after expanding the sidebar = %v", got)
+	}
+}
@@ -621,6 +621,12 @@ func (r *renderIndexedStack) HitTest(position graphics.Offset, result *layout.Hi
 //	// Relative positioning from bottom-right corner
 //	widgets.Positioned(fab).Align(graphics.AlignBottomRight).Right(16).Bottom(16)
 //
+// Start and End position from the edges of the reading direction instead
+// of Left and Right, so the child moves to the mirrored side in RTL:
+//
+//	// Leading badge: top-left in LTR, top-right in RTL
+//	widgets.Positioned(badge).Start(8).Top(8)
+//
 // When both Left and Right are set (or Top and Bottom), the child stretches
 // to fill that dimension. Width/Height override the stretching behavior.
 //
@@ -633,6 +639,8 @@ type positioned struct {
 	top       *float64
 	right     *float64
 	bottom    *float64
+	start     *float64
+	end       *float64
 	width     *float64
 	height    *float64
 }
@@ -670,6 +678,22 @@ func (p positioned) Bottom(v float64) positioned {
 	return p
 }
 
+// Start sets the distance from the start edge of the Stack: the left edge
+// in LTR and the right edge in RTL, per the ambient [Directionality]. It
+// takes precedence over Left or Right on that edge.
+func (p positioned) Start(v float64) positioned {
+	p.start = &v
+	return p
+}
+
+// End sets the distance from the end edge of the Stack: the right edge in
+// LTR and the left edge in RTL. It takes precedence over Left or Right on
+// that edge.
+func (p positioned) End(v float64) positioned {
+	p.end = &v
+	return p
+}
+
 // Width overrides the child's width.
 func (p positioned) Width(v float64) positioned {
 	p.width = &v
@@ -725,11 +749,12 @@ func (p positioned) ChildWidget() core.Widget {
 
 // CreateRenderObject creates the renderPositioned.
 func (p positioned) CreateRenderObject(ctx core.BuildContext) layout.RenderObject {
+	left, right := p.resolveHorizontal(ctx)
 	pos := &renderPositioned{
 		alignment: p.alignment,
-		left:      p.left,
+		left:      left,
 		top:       p.top,
-		right:     p.right,
+		right:     right,
 		bottom:    p.bottom,
 		width:     p.width,
 		height:    p.height,
@@ -742,9 +767,8 @@ func (p positioned) CreateRenderObject(ctx core.BuildContext) layout.RenderObjec
 func (p positioned) UpdateRenderObject(ctx core.BuildContext, renderObject layout.RenderObject) {
 	if pos, ok := renderObject.(*renderPositioned); ok {
 		pos.alignment = p.alignment
-		pos.left = p.left
+		pos.left, pos.right = p.resolveHorizontal(ctx)
 		pos.top = p.top
-		pos.right = p.right
 		pos.bottom = p.bottom
 		pos.width = p.width
 		pos.height = p.height
@@ -752,6 +776,26 @@ func (p positioned) UpdateRenderObject(ctx core.BuildContext, renderObject layou
 	}
 }
 
+// resolveHorizontal returns the left and right offsets with Start and End
+// applied for the ambient direction.
+func (p positioned) resolveHorizontal(ctx core.BuildContext) (left, right *float64) {
+	left, right = p.left, p.right
+	if p.start == nil && p.end == nil {
+		return left, right
+	}
+	start, end := &left, &right
+	if DirectionalityOf(ctx) == graphics.TextDirectionRTL {
+		start, end = &right, &left
+	}
+	if p.start != nil {
+		*start = p.start
+	}
+	if p.end != nil {
+		*end = p.end
+	}
+	return left, right
+}
+
 type renderPositioned struct {
 	layout.RenderBoxBase
 	child     layout.RenderBox
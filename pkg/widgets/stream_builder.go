@@ -0,0 +1,169 @@
+package widgets
+
+import (
+	"sync"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/platform"
+)
+
+// Stream is a source of values delivered over time, such as a Go channel
+// wrapped with [StreamOf]. Listen starts delivery and returns a function that
+// cancels it; after cancel returns, no more callbacks should be made.
+// Callbacks may be called from any goroutine.
+//
+// Implementations should be comparable, typically pointers, so that
+// [StreamBuilder] can tell when its stream changes.
+type Stream[T any] interface {
+	Listen(onData func(T), onError func(error), onDone func()) (cancel func())
+}
+
+// StreamOf returns a [Stream] that delivers the values received from ch and
+// finishes when ch is closed. Each listener should have its own channel, as
+// values are not broadcast.
+//
+//	prices := make(chan float64)
+//	go watchPrices(prices) // sends prices, closes when finished
+//	stream := widgets.StreamOf(prices)
+func StreamOf[T any](ch <-chan T) Stream[T] {
+	return &channelStream[T]{ch: ch}
+}
+
+type channelStream[T any] struct {
+	ch <-chan T
+}
+
+func (s *channelStream[T]) Listen(onData func(T), onError func(error), onDone func()) func() {
+	stop := make(chan struct{})
+	go func() {
+		for {
+			select {
+			case value, ok := <-s.ch:
+				if !ok {
+					onDone()
+					return
+				}
+				onData(value)
+			case <-stop:
+				return
+			}
+		}
+	}()
+	var once sync.Once
+	return func() { once.Do(func() { close(stop) }) }
+}
+
+// StreamBuilder rebuilds whenever its [Stream] produces a value, an error, or
+// finishes, passing the latest [AsyncSnapshot] to Builder. It is the bridge
+// between goroutine data sources and the UI: events are applied on the UI
+// thread through [platform.Dispatch], so the source may send from any
+// goroutine.
+//
+// The subscription is cancelled when the widget is unmounted, or when Stream
+// changes, in which case the snapshot keeps its data and returns to
+// [ConnectionWaiting] for the new stream.
+//
+// Example:
+//
+//	widgets.StreamBuilder[float64]{
+//	    Stream: s.prices, // widgets.StreamOf(ch), created once in InitState
+//	    Builder: func(ctx core.BuildContext, snap widgets.AsyncSnapshot[float64]) core.Widget {
+//	        switch {
+//	        case snap.HasError():
+//	            return widgets.Text{Content: "Error: " + snap.Err.Error()}
+//	        case !snap.HasData:
+//	            return widgets.CircularProgressIndicator{}
+//	        }
+//	        return widgets.Text{Content: fmt.Sprintf("%.2f", snap.Data)}
+//	    },
+//	}
+//
+// Create the stream once, such as in InitState, rather than in a parent's
+// Build: a new stream on every build resubscribes each time.
+type StreamBuilder[T any] struct {
+	core.StatefulBase
+
+	// Stream is the source to listen to. A nil stream gives a snapshot in
+	// [ConnectionNone].
+	Stream Stream[T]
+
+	// Builder builds the widget for the latest snapshot. Required.
+	Builder func(ctx core.BuildContext, snapshot AsyncSnapshot[T]) core.Widget
+}
+
+func (StreamBuilder[T]) CreateState() core.State {
+	return &streamBuilderState[T]{}
+}
+
+type streamBuilderState[T any] struct {
+	core.StateBase
+	snapshot   AsyncSnapshot[T]
+	cancel     func()
+	generation int
+}
+
+func (s *streamBuilderState[T]) widget() StreamBuilder[T] {
+	return s.Element().Widget().(StreamBuilder[T])
+}
+
+func (s *streamBuilderState[T]) InitState() {
+	if s.widget().Builder == nil {
+		panic("StreamBuilder: Builder must not be nil")
+	}
+	s.subscribe(s.widget().Stream)
+	s.OnDispose(s.unsubscribe)
+}
+
+func (s *streamBuilderState[T]) DidUpdateWidget(old core.StatefulWidget) {
+	if s.widget().Builder == nil {
+		panic("StreamBuilder: Builder must not be nil")
+	}
+	if old.(StreamBuilder[T]).Stream != s.widget().Stream {
+		s.unsubscribe()
+		s.subscribe(s.widget().Stream)
+	}
+}
+
+// subscribe listens to stream, applying its events on the UI thread. Events
+// from an earlier subscription are discarded.
+func (s *streamBuilderState[T]) subscribe(stream Stream[T]) {
+	if stream == nil {
+		s.snapshot.State = ConnectionNone
+		return
+	}
+	s.snapshot.State = ConnectionWaiting
+	generation := s.generation
+	apply := func(update func(*AsyncSnapshot[T])) {
+		platform.Dispatch(func() {
+			if generation != s.generation || s.IsDisposed() {
+				return
+			}
+			s.SetState(func() { update(&s.snapshot) })
+		})
+	}
+	s.cancel = stream.Listen(func(value T) {
+		apply(func(snap *AsyncSnapshot[T]) {
+			snap.State, snap.Data, snap.HasData, snap.Err = ConnectionActive, value, true, nil
+		})
+	}, func(err error) {
+		apply(func(snap *AsyncSnapshot[T]) {
+			snap.State, snap.Err = ConnectionActive, err
+		})
+	}, func() {
+		apply(func(snap *AsyncSnapshot[T]) {
+			snap.State = ConnectionDone
+		})
+	})
+}
+
+func (s *streamBuilderState[T]) unsubscribe() {
+	s.generation++
+	if s.cancel != nil {
+		s.cancel()
+		s.cancel = nil
+	}
+}
+
+func (s *streamBuilderState[T]) Build(ctx core.BuildContext) core.Widget {
+	return s.widget().Builder(ctx, s.snapshot)
+}
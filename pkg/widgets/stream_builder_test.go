@@ -0,0 +1,114 @@
+package widgets_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-drift/drift/pkg/core"
+	drifttest "github.com/go-drift/drift/pkg/testing"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+// fakeStream is a Stream driven directly by the test.
+type fakeStream struct {
+	onData    func(int)
+	onError   func(error)
+	onDone    func()
+	cancelled bool
+}
+
+func (s *fakeStream) Listen(onData func(int), onError func(error), onDone func()) func() {
+	s.onData, s.onError, s.onDone = onData, onError, onDone
+	return func() { s.cancelled = true }
+}
+
+func snapshotText(ctx core.BuildContext, snap widgets.AsyncSnapshot[int]) core.Widget {
+	text := snap.State.String()
+	if snap.HasData {
+		text += " " + string(rune('0'+snap.Data))
+	}
+	if snap.HasError() {
+		text += " " + snap.Err.Error()
+	}
+	return widgets.Text{Content: text}
+}
+
+func TestStreamBuilder_Channel(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	ch := make(chan int)
+	tester.PumpWidget(widgets.StreamBuilder[int]{
+		Stream:  widgets.StreamOf(ch),
+		Builder: snapshotText,
+	})
+	if !tester.Find(drifttest.ByText("waiting")).Exists() {
+		t.Fatal("expected a waiting snapshot before the first value")
+	}
+
+	ch <- 1
+	ch <- 2
+	if err := tester.PumpUntil(func() bool { return tester.Find(drifttest.ByText("active 2")).Exists() }, 2*time.Second); err != nil {
+		t.Fatal(err)
+	}
+
+	close(ch)
+	if err := tester.PumpUntil(func() bool { return tester.Find(drifttest.ByText("done 2")).Exists() }, 2*time.Second); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestStreamBuilder_ErrorsAndCancel(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	stream := &fakeStream{}
+	current := core.NewValueNotifier[widgets.Stream[int]](stream)
+	tester.PumpWidget(core.ValueListenableBuilder[widgets.Stream[int]]{
+		ValueListenable: current,
+		Builder: func(ctx core.BuildContext, s widgets.Stream[int], _ core.Widget) core.Widget {
+			return widgets.StreamBuilder[int]{Stream: s, Builder: snapshotText}
+		},
+	})
+
+	stream.onData(3)
+	stream.onError(errors.New("offline"))
+	tester.Pump()
+	if !tester.Find(drifttest.ByText("active 3 offline")).Exists() {
+		t.Error("expected the error to keep the last value")
+	}
+	stream.onData(4)
+	tester.Pump()
+	if !tester.Find(drifttest.ByText("active 4")).Exists() {
+		t.Error("expected a value to clear the error")
+	}
+
+	// Swapping the stream cancels the old one and ignores its events
+	next := &fakeStream{}
+	current.Set(next)
+	tester.Pump()
+	if !stream.cancelled {
+		t.Error("expected the old stream cancelled")
+	}
+	stream.onData(5)
+	tester.Pump()
+	if !tester.Find(drifttest.ByText("waiting 4")).Exists() {
+		t.Error("expected the new stream to start waiting with the last value")
+	}
+
+	current.Set(nil)
+	tester.Pump()
+	if !next.cancelled {
+		t.Error("expected the stream cancelled when removed")
+	}
+	if !tester.Find(drifttest.ByText("none 4")).Exists() {
+		t.Error("expected a nil stream to give ConnectionNone")
+	}
+
+	current.Set(next)
+	tester.Pump()
+	next.cancelled = false
+	tester.PumpWidget(widgets.Text{Content: "gone"})
+	if !next.cancelled {
+		t.Error("expected the stream cancelled on unmount")
+	}
+	next.onData(6)
+	tester.Pump()
+}
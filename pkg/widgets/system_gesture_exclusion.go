@@ -0,0 +1,163 @@
+package widgets
+
+import (
+	"slices"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/layout"
+	"github.com/go-drift/drift/pkg/platform"
+)
+
+// SystemGestureExclusion keeps the system's edge gestures from taking swipes
+// that the app handles itself at the edge of the screen, such as dragging a
+// drawer open or moving a slider that reaches the edge.
+//
+// On Android, swipes that start on the child are not treated as back or home
+// gestures. Android limits exclusions to 200dp along each side edge, so
+// exclude only the parts that need it:
+//
+//	widgets.Positioned(widgets.SystemGestureExclusion{
+//	    Child: widgets.SizedBox{Width: 20, Height: 200}, // drawer drag handle
+//	}).Left(0).Top(300)
+//
+// On iOS, which has no back gesture, DeferEdges makes the system gestures
+// from those edges, such as going home from the bottom edge, wait for a
+// second swipe while the widget is shown.
+//
+// The excluded area is measured when the child paints, and released when
+// the widget is removed.
+type SystemGestureExclusion struct {
+	core.RenderObjectBase
+	// Child is the area to exclude from Android's gesture navigation.
+	Child core.Widget
+	// DeferEdges are the screen edges whose iOS system gestures wait for a
+	// second swipe while the widget is shown.
+	DeferEdges platform.ScreenEdges
+}
+
+func (e SystemGestureExclusion) ChildWidget() core.Widget {
+	return e.Child
+}
+
+func (e SystemGestureExclusion) CreateRenderObject(ctx core.BuildContext) layout.RenderObject {
+	box := &renderSystemGestureExclusion{deferEdges: e.DeferEdges}
+	box.SetSelf(box)
+	return box
+}
+
+func (e SystemGestureExclusion) UpdateRenderObject(ctx core.BuildContext, renderObject layout.RenderObject) {
+	if box, ok := renderObject.(*renderSystemGestureExclusion); ok && box.deferEdges != e.DeferEdges {
+		box.deferEdges = e.DeferEdges
+		if box.registered {
+			scheduleGestureExclusionUpdate()
+		}
+	}
+}
+
+type renderSystemGestureExclusion struct {
+	renderPassthrough
+	deferEdges platform.ScreenEdges
+	// rect is the excluded area in window coordinates, as of the last paint.
+	rect       graphics.Rect
+	registered bool
+}
+
+func (r *renderSystemGestureExclusion) Paint(ctx *layout.PaintContext) {
+	rect := globalBoundsOf(r)
+	if !r.registered {
+		r.registered = true
+		activeGestureExclusions = append(activeGestureExclusions, r)
+		r.rect = rect
+		scheduleGestureExclusionUpdate()
+	} else if rect != r.rect {
+		r.rect = rect
+		scheduleGestureExclusionUpdate()
+	}
+	r.renderPassthrough.Paint(ctx)
+}
+
+// Dispose releases the excluded area.
+func (r *renderSystemGestureExclusion) Dispose() {
+	if r.registered {
+		r.registered = false
+		activeGestureExclusions = slices.DeleteFunc(activeGestureExclusions, func(e *renderSystemGestureExclusion) bool {
+			return e == r
+		})
+		scheduleGestureExclusionUpdate()
+	}
+	r.RenderBoxBase.Dispose()
+}
+
+// globalBoundsOf returns a render box's bounds in window coordinates,
+// following the parent offsets and scroll positions of its ancestors.
+func globalBoundsOf(box layout.RenderBox) graphics.Rect {
+	var offset graphics.Offset
+	var node layout.RenderObject = box
+	for node != nil {
+		if data, ok := node.ParentData().(*layout.BoxParentData); ok && data != nil {
+			offset.X += data.Offset.X
+			offset.Y += data.Offset.Y
+		}
+		if provider, ok := node.(core.ScrollOffsetProvider); ok {
+			scrollOffset := provider.ScrollOffset()
+			offset.X += scrollOffset.X
+			offset.Y += scrollOffset.Y
+		}
+		parent, ok := node.(interface{ Parent() layout.RenderObject })
+		if !ok {
+			break
+		}
+		node = parent.Parent()
+	}
+	size := box.Size()
+	return graphics.RectFromLTWH(offset.X, offset.Y, size.Width, size.Height)
+}
+
+var (
+	// activeGestureExclusions holds the painted SystemGestureExclusions,
+	// in the order they were first painted.
+	activeGestureExclusions []*renderSystemGestureExclusion
+
+	// appliedExclusionRects and appliedDeferEdges were last sent to the
+	// platform.
+	appliedExclusionRects []graphics.Rect
+	appliedDeferEdges     platform.ScreenEdges
+
+	gestureExclusionUpdatePending bool
+)
+
+// scheduleGestureExclusionUpdate sends the exclusions to the platform once
+// the current frame is done, so areas that move in the same frame are sent
+// together.
+func scheduleGestureExclusionUpdate() {
+	if gestureExclusionUpdatePending {
+		return
+	}
+	gestureExclusionUpdatePending = true
+	if !platform.Dispatch(applyGestureExclusions) {
+		applyGestureExclusions()
+	}
+}
+
+// applyGestureExclusions sends the exclusions that changed since the last
+// call to the platform. Failures are ignored; exclusions are best effort.
+func applyGestureExclusions() {
+	gestureExclusionUpdatePending = false
+	var rects []graphics.Rect
+	var edges platform.ScreenEdges
+	for _, e := range activeGestureExclusions {
+		if e.rect.Width() > 0 && e.rect.Height() > 0 {
+			rects = append(rects, e.rect)
+		}
+		edges |= e.deferEdges
+	}
+	if !slices.Equal(rects, appliedExclusionRects) {
+		appliedExclusionRects = rects
+		platform.SystemGestures.SetExclusionRects(rects)
+	}
+	if edges != appliedDeferEdges {
+		appliedDeferEdges = edges
+		platform.SystemGestures.SetDeferredEdges(edges)
+	}
+}
@@ -0,0 +1,87 @@
+package widgets_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/go-drift/drift/pkg/platform"
+	drifttest "github.com/go-drift/drift/pkg/testing"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+// systemGesturesBridge records drift/system_gestures calls as
+// "method:args" strings.
+type systemGesturesBridge struct {
+	calls []string
+}
+
+func (b *systemGesturesBridge) InvokeMethod(_ context.Context, channel, method string, argsData []byte) ([]byte, error) {
+	if channel == "drift/system_gestures" {
+		args, _ := platform.DefaultCodec.Decode(argsData)
+		m, _ := args.(map[string]any)
+		switch method {
+		case "setExclusionRects":
+			call := method
+			rects, _ := m["rects"].([]any)
+			for _, r := range rects {
+				rect := r.(map[string]any)
+				call += fmt.Sprintf(":%v,%v,%v,%v", rect["left"], rect["top"], rect["right"], rect["bottom"])
+			}
+			b.calls = append(b.calls, call)
+		default:
+			b.calls = append(b.calls, fmt.Sprintf("%s:%v", method, m["edges"]))
+		}
+	}
+	return platform.DefaultCodec.Encode(nil)
+}
+
+func (b *systemGesturesBridge) StartEventStream(string) error { return nil }
+func (b *systemGesturesBridge) StopEventStream(string) error  { return nil }
+
+func (b *systemGesturesBridge) take() []string {
+	calls := b.calls
+	b.calls = nil
+	return calls
+}
+
+func TestSystemGestureExclusion_SendsAndClearsRects(t *testing.T) {
+	bridge := &systemGesturesBridge{}
+	platform.SetNativeBridge(bridge)
+	t.Cleanup(platform.ResetForTest)
+	tester := drifttest.NewWidgetTesterWithT(t)
+
+	handle := widgets.SystemGestureExclusion{
+		Child:      widgets.SizedBox{Width: 20, Height: 30},
+		DeferEdges: platform.ScreenEdgeBottom,
+	}
+
+	var host *childrenHostState
+	tester.PumpWidget(childrenHost{state: &host})
+	// The tester doesn't paint, so capture a snapshot to measure the child,
+	// and pump again to send the update.
+	host.show(widgets.SizedBox{Height: 10}, handle)
+	tester.Pump()
+	tester.CaptureSnapshot()
+	tester.Pump()
+	assertGestureCalls(t, bridge.take(), "setExclusionRects:0,10,20,40", "setDeferredEdges:4")
+
+	// Moving the child sends its new area.
+	host.show(widgets.SizedBox{Height: 50}, handle)
+	tester.Pump()
+	tester.CaptureSnapshot()
+	tester.Pump()
+	assertGestureCalls(t, bridge.take(), "setExclusionRects:0,50,20,80")
+
+	host.show()
+	tester.Pump()
+	tester.Pump()
+	assertGestureCalls(t, bridge.take(), "setExclusionRects", "setDeferredEdges:0")
+}
+
+func assertGestureCalls(t *testing.T, got []string, want ...string) {
+	t.Helper()
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("system gesture calls: got %v, want %v", got, want)
+	}
+}
@@ -1,6 +1,8 @@
 package widgets
 
 import (
+	"math"
+
 	"github.com/go-drift/drift/pkg/core"
 	"github.com/go-drift/drift/pkg/graphics"
 	"github.com/go-drift/drift/pkg/layout"
@@ -26,10 +28,10 @@ import (
 //
 //	theme.TextOf(ctx, "Welcome", textTheme.HeadlineMedium)
 //
-// # Text Wrapping, Line Limits, and Alignment
+// # Text Wrapping, Line Limits, Overflow, and Alignment
 //
-// The Wrap, MaxLines, and Align fields control how text flows, truncates,
-// and aligns:
+// The Wrap, MaxLines, Overflow, and Align fields control how text flows,
+// truncates, and aligns:
 //
 //   - Wrap=TextWrapWrap (default zero value): Text wraps at the constraint
 //     width, creating multiple lines. Use for paragraphs, descriptions, and
@@ -41,6 +43,11 @@ import (
 //   - MaxLines: Limits the number of visible lines. When text wraps and
 //     exceeds MaxLines, it truncates. When MaxLines=0 (default), no limit applies.
 //
+//   - Overflow: Controls how text that doesn't fit is shown: cut off with an
+//     ellipsis, faded out, clipped at the widget's bounds, or (the default)
+//     painted past them. It applies to text cut off by MaxLines, unwrapped
+//     text wider than the widget, and text taller than the widget.
+//
 //   - Align: Controls horizontal alignment of lines within the paragraph.
 //     Alignment only takes effect when text wraps, because unwrapped text
 //     has no paragraph width to align within. Use [Text.WithAlign] for chaining.
@@ -53,8 +60,11 @@ import (
 //	// Single line, may overflow
 //	Text{Content: "Label", Wrap: graphics.TextWrapNoWrap}
 //
-//	// Preview text limited to 2 lines
-//	Text{Content: description, MaxLines: 2}
+//	// Preview text limited to 2 lines, ending in "…"
+//	Text{Content: description, MaxLines: 2, Overflow: graphics.TextOverflowEllipsis}
+//
+//	// Single line that fades out where it is cut off
+//	Text{Content: title, Wrap: graphics.TextWrapNoWrap, Overflow: graphics.TextOverflowFade}
 //
 //	// Centered wrapping text
 //	Text{Content: longText, Align: graphics.TextAlignCenter}
@@ -65,7 +75,8 @@ type Text struct {
 	// Style controls the font, size, color, and other text properties.
 	Style graphics.TextStyle
 	// Align controls paragraph-level horizontal text alignment.
-	// Zero value is left-aligned. Only takes effect when text wraps;
+	// Zero value is left-aligned; use [graphics.TextAlignStart] to follow
+	// the ambient [Directionality]. Only takes effect when text wraps;
 	// unwrapped text has no paragraph width to align within.
 	Align graphics.TextAlign
 	// MaxLines limits the number of visible lines (0 = unlimited).
@@ -75,6 +86,14 @@ type Text struct {
 	// ([graphics.TextWrapWrap]) wraps text at the constraint width.
 	// Set to [graphics.TextWrapNoWrap] for single-line text.
 	Wrap graphics.TextWrap
+	// Overflow controls how text that doesn't fit is shown. The zero value
+	// ([graphics.TextOverflowVisible]) paints it past the widget's bounds.
+	// With [graphics.TextOverflowEllipsis], unwrapped text is cut off at the
+	// widget's width and wrapped text at MaxLines.
+	Overflow graphics.TextOverflow
+	// Strut sets a minimum height for every line, so lines keep the same
+	// spacing whatever they contain. The zero value sets none.
+	Strut graphics.StrutStyle
 }
 
 // WithWrap returns a copy of the text with the specified wrap mode.
@@ -95,6 +114,12 @@ func (t Text) WithMaxLines(maxLines int) Text {
 	return t
 }
 
+// WithOverflow returns a copy of the text with the specified overflow mode.
+func (t Text) WithOverflow(overflow graphics.TextOverflow) Text {
+	t.Overflow = overflow
+	return t
+}
+
 // WithAlign returns a copy of the text with the specified alignment.
 // Alignment only takes effect when text wraps. See [graphics.TextAlign]
 // for the available alignment options.
@@ -103,8 +128,14 @@ func (t Text) WithAlign(align graphics.TextAlign) Text {
 	return t
 }
 
+// WithStrut returns a copy of the text with the specified strut.
+func (t Text) WithStrut(strut graphics.StrutStyle) Text {
+	t.Strut = strut
+	return t
+}
+
 func (t Text) CreateRenderObject(ctx core.BuildContext) layout.RenderObject {
-	text := &renderText{text: t.Content, style: t.Style, align: t.Align, maxLines: t.MaxLines, wrapMode: t.Wrap}
+	text := &renderText{text: t.Content, style: t.Style, align: t.Align, direction: DirectionalityOf(ctx), maxLines: t.MaxLines, wrapMode: t.Wrap, overflow: t.Overflow, strut: t.Strut}
 	text.SetSelf(text)
 	return text
 }
@@ -114,8 +145,11 @@ func (t Text) UpdateRenderObject(ctx core.BuildContext, renderObject layout.Rend
 		text.text = t.Content
 		text.style = t.Style
 		text.align = t.Align
+		text.direction = DirectionalityOf(ctx)
 		text.maxLines = t.MaxLines
 		text.wrapMode = t.Wrap
+		text.overflow = t.Overflow
+		text.strut = t.Strut
 		text.MarkNeedsLayout()
 		text.MarkNeedsPaint()
 	}
@@ -123,34 +157,38 @@ func (t Text) UpdateRenderObject(ctx core.BuildContext, renderObject layout.Rend
 
 type renderText struct {
 	layout.RenderBoxBase
-	text     string
-	style    graphics.TextStyle
-	align    graphics.TextAlign
-	layout   *graphics.TextLayout
-	maxLines int
-	wrapMode graphics.TextWrap
-	cache    textLayoutCache
+	text      string
+	style     graphics.TextStyle
+	align     graphics.TextAlign
+	direction graphics.TextDirection
+	layout    *graphics.TextLayout
+	maxLines  int
+	wrapMode  graphics.TextWrap
+	overflow  graphics.TextOverflow
+	strut     graphics.StrutStyle
+	cache     textLayoutCache
 }
 
 type textLayoutCache struct {
-	text     string
-	style    graphics.TextStyle
-	align    graphics.TextAlign
-	maxWidth float64
-	maxLines int
-	wrapMode graphics.TextWrap
+	text      string
+	style     graphics.TextStyle
+	align     graphics.TextAlign
+	direction graphics.TextDirection
+	maxWidth  float64
+	maxLines  int
+	wrapMode  graphics.TextWrap
+	overflow  graphics.TextOverflow
+	strut     graphics.StrutStyle
 }
 
 // textLayoutSize returns the widget size for a laid-out paragraph. When text
 // alignment is non-left, Skia positions lines within the full paragraph layout
 // width, so the widget must claim that width for its bounds to agree with the
 // rendered text positions.
-func textLayoutSize(layoutSize graphics.Size, align graphics.TextAlign, maxWidth float64) graphics.Size {
-	switch align {
-	case graphics.TextAlignLeft, graphics.TextAlignStart:
-		// Left-flush alignments: use the tight (longest-line) width.
-		// TextAlignStart resolves to left in LTR; if RTL support is added,
-		// this case will need to check the text direction.
+func textLayoutSize(layoutSize graphics.Size, align graphics.TextAlign, direction graphics.TextDirection, maxWidth float64) graphics.Size {
+	switch align.Resolve(direction) {
+	case graphics.TextAlignLeft:
+		// Left-flush alignment: use the tight (longest-line) width.
 	default:
 		if maxWidth > 0 {
 			layoutSize.Width = maxWidth
@@ -162,19 +200,33 @@ func textLayoutSize(layoutSize graphics.Size, align graphics.TextAlign, maxWidth
 func (r *renderText) PerformLayout() {
 	constraints := r.Constraints()
 	maxWidth := constraints.MaxWidth // Default: wrap
+	maxLines := r.maxLines
 	if r.wrapMode == graphics.TextWrapNoWrap {
 		maxWidth = 0
+		if r.overflow == graphics.TextOverflowEllipsis && !math.IsInf(constraints.MaxWidth, 1) {
+			// Lay the single line out at the available width so the
+			// ellipsis lands where it is cut off.
+			maxWidth = constraints.MaxWidth
+			maxLines = 1
+		}
+	}
+	var ellipsis string
+	if r.overflow == graphics.TextOverflowEllipsis {
+		ellipsis = "\u2026"
 	}
 	current := textLayoutCache{
-		text:     r.text,
-		style:    r.style,
-		align:    r.align,
-		maxWidth: maxWidth,
-		maxLines: r.maxLines,
-		wrapMode: r.wrapMode,
+		text:      r.text,
+		style:     r.style,
+		align:     r.align,
+		direction: r.direction,
+		maxWidth:  maxWidth,
+		maxLines:  maxLines,
+		wrapMode:  r.wrapMode,
+		overflow:  r.overflow,
+		strut:     r.strut,
 	}
 	if r.layout != nil && r.cache == current {
-		r.SetSize(constraints.Constrain(textLayoutSize(r.layout.Size, r.align, maxWidth)))
+		r.SetSize(constraints.Constrain(textLayoutSize(r.layout.Size, r.align, r.direction, maxWidth)))
 		return
 	}
 	r.cache = current
@@ -188,9 +240,12 @@ func (r *renderText) PerformLayout() {
 	}
 
 	layout, err := graphics.LayoutTextWithOptions(r.text, r.style, manager, graphics.ParagraphOptions{
-		MaxWidth:  maxWidth,
-		MaxLines:  r.maxLines,
-		TextAlign: r.align,
+		MaxWidth:      maxWidth,
+		MaxLines:      maxLines,
+		TextAlign:     r.align,
+		TextDirection: r.direction,
+		Strut:         r.strut,
+		Ellipsis:      ellipsis,
 	})
 	if err != nil {
 		r.layout = nil
@@ -199,25 +254,76 @@ func (r *renderText) PerformLayout() {
 	}
 
 	r.layout = layout
-	r.SetSize(constraints.Constrain(textLayoutSize(layout.Size, r.align, maxWidth)))
+	r.SetSize(constraints.Constrain(textLayoutSize(layout.Size, r.align, r.direction, maxWidth)))
+}
+
+// FontRegistered lays the text out again if it used family.
+func (r *renderText) FontRegistered(family string) {
+	if r.layout != nil && r.layout.UsesFamily(family) {
+		r.layout = nil
+		r.MarkNeedsLayout()
+		r.MarkNeedsPaint()
+	}
 }
 
 func (r *renderText) Paint(ctx *layout.PaintContext) {
 	if r.layout == nil {
 		return
 	}
-	// NOTE: No clipping here (matches Flutter's Clip.none default) so text shadows
-	// can paint outside bounds. This means all text can overflow, not just shadows.
-	// If overflow becomes an issue, consider conditional clipping:
-	//
-	//   if r.layout.Style.Shadow == nil {
-	//       ctx.Canvas.Save()
-	//       size := r.Size()
-	//       ctx.Canvas.ClipRect(graphics.Rect{Left: 0, Top: 0, Right: size.Width, Bottom: size.Height})
-	//       defer ctx.Canvas.Restore()
-	//   }
-	//
-	ctx.Canvas.DrawText(r.layout, graphics.Offset{})
+	// Text that fits is not clipped, so text shadows can paint outside
+	// bounds (matching Flutter's Clip.none default).
+	size := r.Size()
+	if r.overflow == graphics.TextOverflowVisible || !r.overflows(size) {
+		ctx.Canvas.DrawText(r.layout, graphics.Offset{})
+		return
+	}
+	bounds := graphics.RectFromLTWH(0, 0, size.Width, size.Height)
+	ctx.Canvas.Save()
+	ctx.Canvas.ClipRect(bounds)
+	if r.overflow == graphics.TextOverflowFade {
+		ctx.Canvas.SaveLayer(bounds, nil)
+		ctx.Canvas.DrawText(r.layout, graphics.Offset{})
+		r.paintFade(ctx.Canvas, size)
+		ctx.Canvas.Restore()
+	} else {
+		ctx.Canvas.DrawText(r.layout, graphics.Offset{})
+	}
+	ctx.Canvas.Restore()
+}
+
+// overflows reports whether the laid-out text was cut off by the line limit
+// or does not fit size.
+func (r *renderText) overflows(size graphics.Size) bool {
+	return r.layout.DidExceedMaxLines || r.layout.Size.Width > size.Width || r.layout.Size.Height > size.Height
+}
+
+// paintFade masks the text layer with a gradient that fades the text out
+// over one line height toward the edge where it is cut off: the end of the
+// line when the text is too wide, or the bottom otherwise.
+func (r *renderText) paintFade(canvas graphics.Canvas, size graphics.Size) {
+	fade := r.layout.LineHeight
+	var rect graphics.Rect
+	var start, end graphics.Alignment
+	switch {
+	case r.layout.Size.Width > size.Width && r.direction == graphics.TextDirectionRTL:
+		rect = graphics.RectFromLTWH(0, 0, min(fade, size.Width), size.Height)
+		start, end = graphics.AlignCenterRight, graphics.AlignCenterLeft
+	case r.layout.Size.Width > size.Width:
+		width := min(fade, size.Width)
+		rect = graphics.RectFromLTWH(size.Width-width, 0, width, size.Height)
+		start, end = graphics.AlignCenterLeft, graphics.AlignCenterRight
+	default:
+		height := min(fade, size.Height)
+		rect = graphics.RectFromLTWH(0, size.Height-height, size.Width, height)
+		start, end = graphics.AlignTopCenter, graphics.AlignBottomCenter
+	}
+	mask := graphics.DefaultPaint()
+	mask.Gradient = graphics.NewLinearGradient(start, end, []graphics.GradientStop{
+		{Position: 0, Color: graphics.ColorBlack},
+		{Position: 1, Color: graphics.ColorTransparent},
+	})
+	mask.BlendMode = graphics.BlendModeDstIn
+	canvas.DrawRect(rect, mask)
 }
 
 func (r *renderText) HitTest(position graphics.Offset, result *layout.HitTestResult) bool {
@@ -1,6 +1,9 @@
 package widgets
 
 import (
+	"fmt"
+	"unicode/utf8"
+
 	"github.com/go-drift/drift/pkg/core"
 	"github.com/go-drift/drift/pkg/graphics"
 	"github.com/go-drift/drift/pkg/layout"
@@ -52,7 +55,8 @@ import (
 type TextField struct {
 	core.StatelessBase
 
-	// Controller manages the text content and selection.
+	// Controller manages the text content and selection. When nil, the
+	// field uses a controller of its own.
 	Controller *platform.TextEditingController
 	// Label is shown above the field.
 	Label string
@@ -68,14 +72,62 @@ type TextField struct {
 	InputAction platform.TextInputAction
 	// Obscure hides the text (for passwords).
 	Obscure bool
+	// ObscuringCharacter replaces each character while obscured. Empty uses
+	// the platform bullet. See [TextInput.ObscuringCharacter].
+	ObscuringCharacter string
+	// ObscureToggle shows a "Show"/"Hide" button inside the trailing edge of
+	// an obscured field that switches between hidden and visible text. The
+	// toggle keeps its own revealed state; Obscure sets the initial state and
+	// must be true for the toggle to appear.
+	ObscureToggle bool
+	// ObscureToggleStyle styles the visibility toggle label.
+	// Zero FontSize means the toggle is not rendered.
+	ObscureToggleStyle graphics.TextStyle
+	// ObscureToggleLabels sets the toggle's text and screen reader labels,
+	// such as for translation. Empty fields use the English defaults.
+	ObscureToggleLabels ObscureToggleLabels
 	// Autocorrect enables auto-correction.
 	Autocorrect bool
+	// KeyboardAppearance selects a light or dark keyboard (iOS).
+	// See [TextInput.KeyboardAppearance].
+	KeyboardAppearance platform.KeyboardAppearance
+	// EnablesReturnKeyAutomatically disables the return key while the field
+	// is empty.
+	EnablesReturnKeyAutomatically bool
+	// SmartDashes enables automatic dash substitution (iOS).
+	SmartDashes bool
+	// SmartQuotes enables automatic curly quote substitution (iOS).
+	SmartQuotes bool
+	// MaxLength limits the text to this many characters (Unicode code points)
+	// and shows a "count/max" counter below the field. Zero means unlimited.
+	MaxLength int
+	// MaxLengthEnforcement controls how MaxLength is applied. With
+	// [platform.MaxLengthEnforcementNone] the text may exceed MaxLength and
+	// the counter is drawn in ErrorColor while it does.
+	MaxLengthEnforcement platform.MaxLengthEnforcement
+	// HideCounter hides the character counter shown when MaxLength is set.
+	HideCounter bool
+	// InputFormatters rewrite each edit before it reaches the controller.
+	// See [TextInput.InputFormatters].
+	InputFormatters []platform.TextInputFormatter
 	// OnChanged is called when the text changes.
 	OnChanged func(string)
 	// OnSubmitted is called when the user submits.
 	OnSubmitted func(string)
 	// OnEditingComplete is called with the current text when editing is complete.
 	OnEditingComplete func(string)
+	// OnContentInserted is called when the user inserts an image or GIF from
+	// the keyboard, a paste, or a drag and drop. See [TextInput.OnContentInserted].
+	OnContentInserted func(platform.InsertedContent)
+	// ContentMimeTypes lists the MIME types OnContentInserted accepts.
+	// Empty uses [platform.DefaultContentMimeTypes].
+	ContentMimeTypes []string
+	// AutofillHints tells the platform's autofill service what the field
+	// holds. See [TextInput.AutofillHints].
+	AutofillHints []platform.AutofillHint
+	// SpellCheck configures native spell checking. Nil uses the platform
+	// default. See [SpellCheckConfiguration].
+	SpellCheck *SpellCheckConfiguration
 	// Disabled controls whether the field rejects input.
 	Disabled bool
 	// Width of the text field. Zero expands to fill available width.
@@ -185,12 +237,39 @@ func (t TextField) WithOnChanged(fn func(string)) TextField {
 	return t
 }
 
+// WithInputFormatters returns a copy with the specified input formatters,
+// applied in order.
+func (t TextField) WithInputFormatters(formatters ...platform.TextInputFormatter) TextField {
+	t.InputFormatters = formatters
+	return t
+}
+
 // WithOnSubmitted returns a copy with the specified submit callback.
 func (t TextField) WithOnSubmitted(fn func(string)) TextField {
 	t.OnSubmitted = fn
 	return t
 }
 
+// WithOnContentInserted returns a copy that accepts inserted images and GIFs
+// of the given MIME types (or [platform.DefaultContentMimeTypes] if none).
+func (t TextField) WithOnContentInserted(fn func(platform.InsertedContent), mimeTypes ...string) TextField {
+	t.OnContentInserted = fn
+	t.ContentMimeTypes = mimeTypes
+	return t
+}
+
+// WithAutofillHints returns a copy with the specified autofill hints.
+func (t TextField) WithAutofillHints(hints ...platform.AutofillHint) TextField {
+	t.AutofillHints = hints
+	return t
+}
+
+// WithSpellCheck returns a copy with the specified spell check configuration.
+func (t TextField) WithSpellCheck(config *SpellCheckConfiguration) TextField {
+	t.SpellCheck = config
+	return t
+}
+
 // WithOnEditingComplete returns a copy with the specified editing-complete callback.
 func (t TextField) WithOnEditingComplete(fn func(string)) TextField {
 	t.OnEditingComplete = fn
@@ -203,6 +282,26 @@ func (t TextField) WithObscure(obscure bool) TextField {
 	return t
 }
 
+// WithObscureToggle returns a copy with the password visibility toggle enabled
+// or disabled.
+func (t TextField) WithObscureToggle(enabled bool) TextField {
+	t.ObscureToggle = enabled
+	return t
+}
+
+// WithObscureToggleLabels returns a copy with the specified visibility
+// toggle labels.
+func (t TextField) WithObscureToggleLabels(labels ObscureToggleLabels) TextField {
+	t.ObscureToggleLabels = labels
+	return t
+}
+
+// WithObscuringCharacter returns a copy with the specified obscuring character.
+func (t TextField) WithObscuringCharacter(char string) TextField {
+	t.ObscuringCharacter = char
+	return t
+}
+
 // WithKeyboardType returns a copy with the specified keyboard type.
 func (t TextField) WithKeyboardType(kt platform.KeyboardType) TextField {
 	t.KeyboardType = kt
@@ -221,6 +320,38 @@ func (t TextField) WithAutocorrect(autocorrect bool) TextField {
 	return t
 }
 
+// WithKeyboardAppearance returns a copy with the specified keyboard appearance.
+func (t TextField) WithKeyboardAppearance(appearance platform.KeyboardAppearance) TextField {
+	t.KeyboardAppearance = appearance
+	return t
+}
+
+// WithEnablesReturnKeyAutomatically returns a copy that disables the return
+// key while the field is empty.
+func (t TextField) WithEnablesReturnKeyAutomatically(enabled bool) TextField {
+	t.EnablesReturnKeyAutomatically = enabled
+	return t
+}
+
+// WithSmartPunctuation returns a copy with smart dashes and smart quotes set.
+func (t TextField) WithSmartPunctuation(dashes, quotes bool) TextField {
+	t.SmartDashes = dashes
+	t.SmartQuotes = quotes
+	return t
+}
+
+// WithMaxLength returns a copy with the specified maximum length.
+func (t TextField) WithMaxLength(maxLength int) TextField {
+	t.MaxLength = maxLength
+	return t
+}
+
+// WithMaxLengthEnforcement returns a copy with the specified max length enforcement.
+func (t TextField) WithMaxLengthEnforcement(enforcement platform.MaxLengthEnforcement) TextField {
+	t.MaxLengthEnforcement = enforcement
+	return t
+}
+
 // WithDisabled returns a copy with the specified disabled state.
 func (t TextField) WithDisabled(disabled bool) TextField {
 	t.Disabled = disabled
@@ -228,6 +359,13 @@ func (t TextField) WithDisabled(disabled bool) TextField {
 }
 
 func (t TextField) Build(ctx core.BuildContext) core.Widget {
+	// Always the same widget, so switching Controller keeps the input's
+	// state and focus.
+	return textFieldBody{Field: t}
+}
+
+// build lays out the label, input, helper text and counter.
+func (t TextField) build() core.Widget {
 	// Fully explicit: zero means zero. Callers (or theme.TextFieldOf) must
 	// provide all visual properties.
 	borderColor := t.BorderColor
@@ -256,10 +394,22 @@ func (t TextField) Build(ctx core.BuildContext) core.Widget {
 	input.KeyboardType = t.KeyboardType
 	input.InputAction = t.InputAction
 	input.Obscure = t.Obscure
+	input.ObscuringCharacter = t.ObscuringCharacter
 	input.Autocorrect = t.Autocorrect
+	input.KeyboardAppearance = t.KeyboardAppearance
+	input.EnablesReturnKeyAutomatically = t.EnablesReturnKeyAutomatically
+	input.SmartDashes = t.SmartDashes
+	input.SmartQuotes = t.SmartQuotes
+	input.MaxLength = t.MaxLength
+	input.MaxLengthEnforcement = t.MaxLengthEnforcement
+	input.InputFormatters = t.InputFormatters
 	input.OnChanged = t.OnChanged
 	input.OnSubmitted = t.OnSubmitted
 	input.OnEditingComplete = t.OnEditingComplete
+	input.OnContentInserted = t.OnContentInserted
+	input.ContentMimeTypes = t.ContentMimeTypes
+	input.AutofillHints = t.AutofillHints
+	input.SpellCheck = t.SpellCheck
 	input.Disabled = t.Disabled
 	input.Width = t.Width
 	input.Height = t.Height
@@ -272,18 +422,42 @@ func (t TextField) Build(ctx core.BuildContext) core.Widget {
 	input.Style = t.Style
 	input.PlaceholderColor = t.PlaceholderColor
 
-	children = append(children, input)
+	if t.ObscureToggle && t.Obscure && t.ObscureToggleStyle.FontSize > 0 {
+		children = append(children, obscureToggleField{Input: input, Style: t.ObscureToggleStyle, Labels: t.ObscureToggleLabels})
+	} else {
+		children = append(children, input)
+	}
 
+	var helper core.Widget
 	if t.ErrorText != "" {
 		errorStyle := t.HelperStyle
 		if t.ErrorColor != 0 {
 			errorStyle.Color = t.ErrorColor
 		}
-		children = append(children, VSpace(6))
-		children = append(children, Text{Content: t.ErrorText, Style: errorStyle})
+		helper = Text{Content: t.ErrorText, Style: errorStyle}
 	} else if t.HelperText != "" {
+		helper = Text{Content: t.HelperText, Style: t.HelperStyle}
+	}
+
+	if t.MaxLength > 0 && !t.HideCounter {
+		// Helper/error text on the left, counter on the right.
+		row := make([]core.Widget, 0, 3)
+		if helper != nil {
+			row = append(row, Expanded{Child: helper}, HSpace(8))
+		} else {
+			row = append(row, Expanded{Child: SizedBox{}})
+		}
+		row = append(row, characterCounter{
+			Controller:    t.Controller,
+			MaxLength:     t.MaxLength,
+			Style:         t.HelperStyle,
+			OverflowColor: t.ErrorColor,
+		})
+		children = append(children, VSpace(6))
+		children = append(children, Row{Children: row})
+	} else if helper != nil {
 		children = append(children, VSpace(6))
-		children = append(children, Text{Content: t.HelperText, Style: t.HelperStyle})
+		children = append(children, helper)
 	}
 
 	return Column{
@@ -291,3 +465,183 @@ func (t TextField) Build(ctx core.BuildContext) core.Widget {
 		Children:     children,
 	}
 }
+
+// obscureToggleField overlays a "Show"/"Hide" button on the trailing edge of
+// an obscured [TextInput] and tracks whether the text is revealed.
+type obscureToggleField struct {
+	core.StatefulBase
+
+	Input  TextInput
+	Style  graphics.TextStyle
+	Labels ObscureToggleLabels
+}
+
+// ObscureToggleLabels holds the text of a [TextField]'s password visibility
+// toggle. Empty fields use the English defaults.
+type ObscureToggleLabels struct {
+	// Show labels the toggle while the text is hidden. Defaults to "Show".
+	Show string
+	// Hide labels the toggle while the text is visible. Defaults to "Hide".
+	Hide string
+	// ShowSemantics is announced by screen readers while the text is
+	// hidden. Defaults to "Show password".
+	ShowSemantics string
+	// HideSemantics is announced by screen readers while the text is
+	// visible. Defaults to "Hide password".
+	HideSemantics string
+}
+
+// withDefaults returns the labels with empty fields set to the English
+// defaults.
+func (l ObscureToggleLabels) withDefaults() ObscureToggleLabels {
+	if l.Show == "" {
+		l.Show = "Show"
+	}
+	if l.Hide == "" {
+		l.Hide = "Hide"
+	}
+	if l.ShowSemantics == "" {
+		l.ShowSemantics = "Show password"
+	}
+	if l.HideSemantics == "" {
+		l.HideSemantics = "Hide password"
+	}
+	return l
+}
+
+func (f obscureToggleField) CreateState() core.State {
+	return &obscureToggleFieldState{}
+}
+
+type obscureToggleFieldState struct {
+	core.StateBase
+	revealed bool
+}
+
+func (s *obscureToggleFieldState) Build(ctx core.BuildContext) core.Widget {
+	w := s.Element().Widget().(obscureToggleField)
+
+	labels := w.Labels.withDefaults()
+	label, semanticLabel := labels.Show, labels.ShowSemantics
+	if s.revealed {
+		label, semanticLabel = labels.Hide, labels.HideSemantics
+	}
+
+	// Reserve room so native text never runs under the toggle. The width
+	// fits the longer label, about 0.6em per character plus a margin, and
+	// keeps a comfortable touch target.
+	chars := max(utf8.RuneCountInString(labels.Show), utf8.RuneCountInString(labels.Hide))
+	toggleWidth := max(44, w.Style.FontSize*(0.6*float64(chars)+1.1))
+	input := w.Input
+	input.Obscure = !s.revealed
+	input.Padding.Right += toggleWidth
+
+	toggle := Tappable(semanticLabel, func() {
+		s.SetState(func() { s.revealed = !s.revealed })
+	}, SizedBox{
+		Width: toggleWidth,
+		Child: Center{Child: Text{Content: label, Style: w.Style, MaxLines: 1}},
+	})
+
+	return Stack{
+		Children: []core.Widget{
+			input,
+			Positioned(toggle).Top(0).Bottom(0).Right(0),
+		},
+	}
+}
+
+// textFieldBody builds a [TextField], using a controller of its own when
+// the field has no Controller, so the counter can count.
+type textFieldBody struct {
+	core.StatefulBase
+
+	Field TextField
+}
+
+func (f textFieldBody) CreateState() core.State {
+	return &textFieldBodyState{}
+}
+
+type textFieldBodyState struct {
+	core.StateBase
+	controller *platform.TextEditingController
+}
+
+func (s *textFieldBodyState) Build(ctx core.BuildContext) core.Widget {
+	field := s.Element().Widget().(textFieldBody).Field
+	if field.Controller == nil {
+		if s.controller == nil {
+			s.controller = platform.NewTextEditingController("")
+		}
+		field.Controller = s.controller
+	}
+	return field.build()
+}
+
+// characterCounter displays "count/max" for a [TextField] and rebuilds when
+// the controller's text changes.
+type characterCounter struct {
+	core.StatefulBase
+
+	Controller    *platform.TextEditingController
+	MaxLength     int
+	Style         graphics.TextStyle
+	OverflowColor graphics.Color
+}
+
+func (c characterCounter) CreateState() core.State {
+	return &characterCounterState{}
+}
+
+type characterCounterState struct {
+	core.StateBase
+	controller  *platform.TextEditingController
+	unsubscribe func()
+}
+
+func (s *characterCounterState) InitState() {
+	s.subscribe(s.Element().Widget().(characterCounter).Controller)
+}
+
+func (s *characterCounterState) DidUpdateWidget(oldWidget core.StatefulWidget) {
+	if c := s.Element().Widget().(characterCounter).Controller; c != s.controller {
+		s.subscribe(c)
+	}
+}
+
+func (s *characterCounterState) Dispose() {
+	s.subscribe(nil)
+	s.StateBase.Dispose()
+}
+
+func (s *characterCounterState) subscribe(controller *platform.TextEditingController) {
+	if s.unsubscribe != nil {
+		s.unsubscribe()
+		s.unsubscribe = nil
+	}
+	s.controller = controller
+	if controller != nil {
+		s.unsubscribe = controller.AddListener(func() {
+			s.SetState(func() {})
+		})
+	}
+}
+
+func (s *characterCounterState) Build(ctx core.BuildContext) core.Widget {
+	w := s.Element().Widget().(characterCounter)
+	count := 0
+	if w.Controller != nil {
+		count = characterCount(w.Controller.Text())
+	}
+	style := w.Style
+	if count > w.MaxLength && w.OverflowColor != 0 {
+		style.Color = w.OverflowColor
+	}
+	return Text{Content: fmt.Sprintf("%d/%d", count, w.MaxLength), Style: style}
+}
+
+// characterCount returns the length of text as counted against MaxLength.
+func characterCount(text string) int {
+	return utf8.RuneCountInString(text)
+}
@@ -0,0 +1,74 @@
+package widgets_test
+
+import (
+	"testing"
+
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/platform"
+	drifttest "github.com/go-drift/drift/pkg/testing"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+func TestTextField_CounterWithoutController(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	tester.PumpWidget(widgets.TextField{MaxLength: 10})
+
+	if !tester.Find(drifttest.ByText("0/10")).Exists() {
+		t.Fatal("expected the counter to show 0/10")
+	}
+	input := tester.Find(drifttest.ByType[widgets.TextInput]()).Widget().(widgets.TextInput)
+	if input.Controller == nil {
+		t.Fatal("expected the input to get the field's own controller")
+	}
+
+	input.Controller.SetText("héllo")
+	tester.Pump()
+	if !tester.Find(drifttest.ByText("5/10")).Exists() {
+		t.Error("expected the counter to count the typed text")
+	}
+}
+
+func TestTextField_SwitchingControllerKeepsInput(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	var host *childrenHostState
+	tester.PumpWidget(childrenHost{state: &host})
+	host.show(widgets.TextField{MaxLength: 10})
+	tester.Pump()
+	before := tester.Find(drifttest.ByType[widgets.TextInput]()).First()
+
+	host.show(widgets.TextField{MaxLength: 10, Controller: platform.NewTextEditingController("abc")})
+	tester.Pump()
+	if tester.Find(drifttest.ByType[widgets.TextInput]()).First() != before {
+		t.Fatal("expected setting a Controller to keep the input element")
+	}
+	if !tester.Find(drifttest.ByText("3/10")).Exists() {
+		t.Error("expected the counter to count the new controller's text")
+	}
+
+	host.show(widgets.TextField{MaxLength: 10})
+	tester.Pump()
+	if tester.Find(drifttest.ByType[widgets.TextInput]()).First() != before {
+		t.Error("expected clearing the Controller to keep the input element")
+	}
+	if !tester.Find(drifttest.ByText("0/10")).Exists() {
+		t.Error("expected the field's own controller back")
+	}
+}
+
+func TestTextField_ObscureToggleLabels(t *testing.T) {
+	field := widgets.TextField{
+		Obscure:            true,
+		ObscureToggle:      true,
+		ObscureToggleStyle: graphics.TextStyle{FontSize: 14},
+	}
+	tester := drifttest.NewWidgetTesterWithT(t)
+	tester.PumpWidget(field)
+	if !tester.Find(drifttest.ByText("Show")).Exists() {
+		t.Error("expected the English label by default")
+	}
+
+	tester.PumpWidget(field.WithObscureToggleLabels(widgets.ObscureToggleLabels{Show: "Afficher"}))
+	if !tester.Find(drifttest.ByText("Afficher")).Exists() {
+		t.Error("expected the custom label")
+	}
+}
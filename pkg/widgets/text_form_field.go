@@ -94,6 +94,14 @@ type TextFormField struct {
 	// Autocorrect enables auto-correction.
 	Autocorrect bool
 
+	// MaxLength limits the text length and shows a character counter.
+	// Zero means unlimited. See [TextField.MaxLength].
+	MaxLength int
+
+	// MaxLengthEnforcement controls how MaxLength is applied.
+	// See [TextField.MaxLengthEnforcement].
+	MaxLengthEnforcement platform.MaxLengthEnforcement
+
 	// OnSubmitted is called when the user submits.
 	OnSubmitted func(string)
 
@@ -200,6 +208,13 @@ func (t TextFormField) WithObscure(obscure bool) TextFormField {
 	return t
 }
 
+// WithObscureToggle sets whether an obscured field shows a "Show"/"Hide"
+// visibility toggle. See [TextField.ObscureToggle].
+func (t TextFormField) WithObscureToggle(enabled bool) TextFormField {
+	t.TextField.ObscureToggle = enabled
+	return t
+}
+
 // WithDisabled sets whether the field is disabled.
 func (t TextFormField) WithDisabled(disabled bool) TextFormField {
 	t.Disabled = disabled
@@ -343,6 +358,12 @@ func (s *textFormFieldState) Build(ctx core.BuildContext) core.Widget {
 	if w.Autocorrect {
 		tf.Autocorrect = true
 	}
+	if w.MaxLength != 0 {
+		tf.MaxLength = w.MaxLength
+	}
+	if w.MaxLengthEnforcement != 0 {
+		tf.MaxLengthEnforcement = w.MaxLengthEnforcement
+	}
 	if w.OnSubmitted != nil {
 		tf.OnSubmitted = w.OnSubmitted
 	}
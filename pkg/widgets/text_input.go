@@ -1,6 +1,9 @@
 package widgets
 
 import (
+	"slices"
+	"strings"
+
 	"github.com/go-drift/drift/pkg/core"
 	"github.com/go-drift/drift/pkg/focus"
 	"github.com/go-drift/drift/pkg/gestures"
@@ -77,18 +80,60 @@ type TextInput struct {
 	// Defaults to None. Set to TextCapitalizationSentences for standard text input.
 	Capitalization platform.TextCapitalization
 
-	// Obscure hides the text (for passwords).
+	// Obscure hides the text (for passwords). Changing it while the field is
+	// focused keeps the text and cursor position.
 	Obscure bool
 
+	// ObscuringCharacter is shown in place of each character while Obscure is
+	// set. Must be a single character; empty uses the platform bullet.
+	// Applied on Android only; iOS always uses the system bullet.
+	ObscuringCharacter string
+
+	// HideCursor hides the native caret and selection highlight, for widgets
+	// that draw their own text and cursor over an invisible input, such as
+	// [PinCodeField].
+	HideCursor bool
+
 	// Autocorrect enables auto-correction.
 	Autocorrect bool
 
+	// KeyboardAppearance selects a light or dark keyboard. Applied on iOS;
+	// Android keyboards follow the system theme.
+	KeyboardAppearance platform.KeyboardAppearance
+
+	// EnablesReturnKeyAutomatically disables the return key while the text is
+	// empty. On Android, where the key can't be disabled, the action is
+	// ignored instead.
+	EnablesReturnKeyAutomatically bool
+
+	// SmartDashes converts double hyphens to dashes as the user types (iOS only).
+	SmartDashes bool
+
+	// SmartQuotes converts straight quotes to curly quotes as the user types
+	// (iOS only).
+	SmartQuotes bool
+
 	// Multiline enables multiline text input.
 	Multiline bool
 
 	// MaxLines limits the number of lines (multiline only).
 	MaxLines int
 
+	// MaxLength limits the text to this many characters (Unicode code points).
+	// Zero means unlimited. The limit is enforced by the native text view so
+	// IME composition is not interrupted; see MaxLengthEnforcement.
+	MaxLength int
+
+	// MaxLengthEnforcement controls how MaxLength is applied. Defaults to
+	// truncating edits that would exceed the limit.
+	MaxLengthEnforcement platform.MaxLengthEnforcement
+
+	// InputFormatters rewrite each edit in order before it reaches the
+	// controller, for masks, digit grouping, or filtering. When the result
+	// differs from what the user typed, it is sent back to the native field.
+	// Formatters from the intl package handle numbers, currencies, and dates.
+	InputFormatters []platform.TextInputFormatter
+
 	// OnChanged is called when the text changes.
 	OnChanged func(string)
 
@@ -101,6 +146,25 @@ type TextInput struct {
 	// OnFocusChange is called when focus changes.
 	OnFocusChange func(bool)
 
+	// OnContentInserted is called when the user inserts an image or GIF from
+	// the keyboard, a paste, or a drag and drop. Content insertion is only
+	// enabled while this is set.
+	OnContentInserted func(platform.InsertedContent)
+
+	// ContentMimeTypes lists the MIME types OnContentInserted accepts.
+	// Wildcards like "image/*" are allowed. Empty uses
+	// [platform.DefaultContentMimeTypes].
+	ContentMimeTypes []string
+
+	// AutofillHints tells the platform's autofill service what the field
+	// holds, e.g. [platform.AutofillHintOneTimeCode] to offer a code from an
+	// incoming SMS above the keyboard.
+	AutofillHints []platform.AutofillHint
+
+	// SpellCheck configures native spell checking. Nil uses the platform
+	// default, where spell check follows Autocorrect.
+	SpellCheck *SpellCheckConfiguration
+
 	// Disabled controls whether the field rejects input.
 	Disabled bool
 
@@ -132,6 +196,76 @@ type TextInput struct {
 	PlaceholderColor graphics.Color
 }
 
+// SpellCheckConfiguration controls native spell checking for a [TextInput].
+type SpellCheckConfiguration struct {
+	// Disabled hides spell check underlines. On Android this also turns off
+	// keyboard suggestions, which share the same input flag.
+	Disabled bool
+
+	// OnMisspelledRanges is called with every flagged word each time the
+	// platform finishes checking the text, so apps can offer their own
+	// correction UI. Checks run shortly after typing pauses. Offsets are in
+	// the same units as the controller's selection. Pair it with
+	// [SpellCheckSuggestionsToolbar] to offer replacements.
+	OnMisspelledRanges func([]platform.MisspelledRange)
+
+	// MisspelledStyle styles flagged words in text Drift renders itself, via
+	// [SpellCheckConfiguration.TextSpan]. Native inputs draw the platform's
+	// own underline and ignore it. Zero uses [DefaultMisspelledStyle].
+	MisspelledStyle graphics.SpanStyle
+}
+
+// DefaultMisspelledStyle is the red wavy underline used for misspelled words
+// when [SpellCheckConfiguration.MisspelledStyle] is zero.
+var DefaultMisspelledStyle = graphics.SpanStyle{
+	Decoration:      graphics.TextDecorationUnderline,
+	DecorationStyle: graphics.TextDecorationStyleWavy,
+	DecorationColor: graphics.RGB(244, 67, 54),
+}
+
+// TextSpan splits text into spans with the misspelled ranges styled by
+// MisspelledStyle, for rendering with [RichText]. Ranges that fall outside
+// text or overlap an earlier range are skipped. A nil configuration uses
+// [DefaultMisspelledStyle].
+func (c *SpellCheckConfiguration) TextSpan(text string, ranges []platform.MisspelledRange) graphics.TextSpan {
+	style := DefaultMisspelledStyle
+	if c != nil && c.MisspelledStyle != (graphics.SpanStyle{}) {
+		style = c.MisspelledStyle
+	}
+
+	sorted := slices.Clone(ranges)
+	slices.SortFunc(sorted, func(a, b platform.MisspelledRange) int { return a.Start - b.Start })
+
+	var children []graphics.TextSpan
+	pos := 0
+	for _, r := range sorted {
+		if r.Start < pos || r.End <= r.Start || r.End > len(text) {
+			continue
+		}
+		if r.Start > pos {
+			children = append(children, graphics.Span(text[pos:r.Start]))
+		}
+		children = append(children, graphics.TextSpan{Text: text[r.Start:r.End], Style: style})
+		pos = r.End
+	}
+	if pos < len(text) {
+		children = append(children, graphics.Span(text[pos:]))
+	}
+	return graphics.Spans(children...)
+}
+
+// mode returns the platform spell check mode for the configuration.
+func (c *SpellCheckConfiguration) mode() platform.SpellCheckMode {
+	switch {
+	case c == nil:
+		return platform.SpellCheckDefault
+	case c.Disabled:
+		return platform.SpellCheckDisabled
+	default:
+		return platform.SpellCheckEnabled
+	}
+}
+
 // WithBackgroundColor returns a copy with the specified background color.
 func (n TextInput) WithBackgroundColor(c graphics.Color) TextInput {
 	n.BackgroundColor = c
@@ -322,24 +456,36 @@ func (s *textInputState) ensurePlatformView() {
 	config := s.buildPlatformViewConfig(w)
 
 	params := map[string]any{
-		"fontFamily":       config.FontFamily,
-		"fontSize":         config.FontSize,
-		"fontWeight":       config.FontWeight,
-		"textColor":        config.TextColor,
-		"placeholderColor": config.PlaceholderColor,
-		"textAlignment":    config.TextAlignment,
-		"multiline":        config.Multiline,
-		"maxLines":         config.MaxLines,
-		"obscure":          config.Obscure,
-		"autocorrect":      config.Autocorrect,
-		"keyboardType":     int(config.KeyboardType),
-		"inputAction":      int(config.InputAction),
-		"capitalization":   int(config.Capitalization),
-		"paddingLeft":      config.PaddingLeft,
-		"paddingTop":       config.PaddingTop,
-		"paddingRight":     config.PaddingRight,
-		"paddingBottom":    config.PaddingBottom,
-		"placeholder":      config.Placeholder,
+		"fontFamily":                    config.FontFamily,
+		"fontSize":                      config.FontSize,
+		"fontWeight":                    config.FontWeight,
+		"textColor":                     config.TextColor,
+		"placeholderColor":              config.PlaceholderColor,
+		"textAlignment":                 config.TextAlignment,
+		"multiline":                     config.Multiline,
+		"maxLines":                      config.MaxLines,
+		"obscure":                       config.Obscure,
+		"obscuringCharacter":            config.ObscuringCharacter,
+		"autocorrect":                   config.Autocorrect,
+		"keyboardType":                  int(config.KeyboardType),
+		"inputAction":                   int(config.InputAction),
+		"capitalization":                int(config.Capitalization),
+		"keyboardAppearance":            int(config.KeyboardAppearance),
+		"enablesReturnKeyAutomatically": config.EnablesReturnKeyAutomatically,
+		"smartDashes":                   config.SmartDashes,
+		"smartQuotes":                   config.SmartQuotes,
+		"maxLength":                     config.MaxLength,
+		"maxLengthEnforcement":          int(config.MaxLengthEnforcement),
+		"paddingLeft":                   config.PaddingLeft,
+		"paddingTop":                    config.PaddingTop,
+		"paddingRight":                  config.PaddingRight,
+		"paddingBottom":                 config.PaddingBottom,
+		"placeholder":                   config.Placeholder,
+		"contentMimeTypes":              config.ContentMimeTypes,
+		"autofillHints":                 config.AutofillHints,
+		"hideCursor":                    config.HideCursor,
+		"spellCheck":                    int(config.SpellCheck),
+		"reportMisspellings":            config.ReportMisspellings,
 	}
 
 	// Include initial text if controller is set
@@ -382,24 +528,59 @@ func (s *textInputState) buildPlatformViewConfig(w TextInput) platform.TextInput
 		inputAction = platform.TextInputActionNewline
 	}
 	return platform.TextInputViewConfig{
-		FontFamily:       w.Style.FontFamily,
-		FontSize:         w.Style.FontSize,
-		FontWeight:       int(w.Style.FontWeight),
-		TextColor:        uint32(w.Style.Color),
-		PlaceholderColor: uint32(w.PlaceholderColor),
-		Multiline:        w.Multiline,
-		MaxLines:         w.MaxLines,
-		Obscure:          w.Obscure,
-		Autocorrect:      w.Autocorrect,
-		KeyboardType:     w.KeyboardType,
-		InputAction:      inputAction,
-		Capitalization:   w.Capitalization,
-		PaddingLeft:      w.Padding.Left,
-		PaddingTop:       w.Padding.Top,
-		PaddingRight:     w.Padding.Right,
-		PaddingBottom:    w.Padding.Bottom,
-		Placeholder:      w.Placeholder,
-	}
+		FontFamily:                    w.Style.FontFamily,
+		FontSize:                      w.Style.FontSize,
+		FontWeight:                    int(w.Style.FontWeight),
+		TextColor:                     uint32(w.Style.Color),
+		PlaceholderColor:              uint32(w.PlaceholderColor),
+		Multiline:                     w.Multiline,
+		MaxLines:                      w.MaxLines,
+		Obscure:                       w.Obscure,
+		ObscuringCharacter:            w.ObscuringCharacter,
+		HideCursor:                    w.HideCursor,
+		Autocorrect:                   w.Autocorrect,
+		KeyboardType:                  w.KeyboardType,
+		InputAction:                   inputAction,
+		Capitalization:                w.Capitalization,
+		KeyboardAppearance:            w.KeyboardAppearance,
+		EnablesReturnKeyAutomatically: w.EnablesReturnKeyAutomatically,
+		SmartDashes:                   w.SmartDashes,
+		SmartQuotes:                   w.SmartQuotes,
+		MaxLength:                     w.MaxLength,
+		MaxLengthEnforcement:          w.MaxLengthEnforcement,
+		PaddingLeft:                   w.Padding.Left,
+		PaddingTop:                    w.Padding.Top,
+		PaddingRight:                  w.Padding.Right,
+		PaddingBottom:                 w.Padding.Bottom,
+		Placeholder:                   w.Placeholder,
+		ContentMimeTypes:              contentMimeTypes(w),
+		AutofillHints:                 autofillHints(w.AutofillHints),
+		SpellCheck:                    w.SpellCheck.mode(),
+		ReportMisspellings:            w.SpellCheck != nil && !w.SpellCheck.Disabled && w.SpellCheck.OnMisspelledRanges != nil,
+	}
+}
+
+// contentMimeTypes returns the accepted content types as the comma-separated
+// list the platform view config expects, or "" when insertion is disabled.
+func contentMimeTypes(w TextInput) string {
+	if w.OnContentInserted == nil {
+		return ""
+	}
+	types := w.ContentMimeTypes
+	if len(types) == 0 {
+		types = platform.DefaultContentMimeTypes
+	}
+	return strings.Join(types, ",")
+}
+
+// autofillHints joins hints into the comma-separated list the platform view
+// config expects.
+func autofillHints(hints []platform.AutofillHint) string {
+	names := make([]string, len(hints))
+	for i, hint := range hints {
+		names[i] = string(hint)
+	}
+	return strings.Join(names, ",")
 }
 
 func (s *textInputState) updatePlatformViewConfig(w TextInput) {
@@ -422,21 +603,36 @@ func (s *textInputState) OnTextChanged(text string, selectionBase, selectionExte
 		return
 	}
 
-	oldText := w.Controller.Text()
-
-	// Update controller
-	w.Controller.SetValue(platform.TextEditingValue{
+	oldValue := w.Controller.Value()
+	value := platform.TextEditingValue{
 		Text: text,
 		Selection: platform.TextSelection{
 			BaseOffset:   selectionBase,
 			ExtentOffset: selectionExtent,
 		},
 		ComposingRange: platform.TextRangeEmpty,
-	})
+	}
+
+	if len(w.InputFormatters) > 0 {
+		formatted := value
+		for _, formatter := range w.InputFormatters {
+			formatted = formatter.FormatEditUpdate(oldValue, formatted)
+		}
+		// Push the formatted value back so the native field shows it.
+		if formatted != value && s.platformView != nil {
+			s.updatingController = true
+			s.platformView.SetValue(formatted)
+			s.updatingController = false
+		}
+		value = formatted
+	}
+
+	// Update controller
+	w.Controller.SetValue(value)
 
 	// Only trigger OnChanged if text actually changed
-	if w.OnChanged != nil && text != oldText {
-		w.OnChanged(text)
+	if w.OnChanged != nil && value.Text != oldValue.Text {
+		w.OnChanged(value.Text)
 	}
 
 	s.SetState(func() {})
@@ -465,6 +661,22 @@ func (s *textInputState) OnAction(action platform.TextInputAction) {
 	}
 }
 
+// OnMisspelledRanges implements platform.TextInputSpellCheckClient.
+func (s *textInputState) OnMisspelledRanges(ranges []platform.MisspelledRange) {
+	w := s.Element().Widget().(TextInput)
+	if w.SpellCheck != nil && w.SpellCheck.OnMisspelledRanges != nil {
+		w.SpellCheck.OnMisspelledRanges(ranges)
+	}
+}
+
+// OnContentInserted implements platform.TextInputContentClient.
+func (s *textInputState) OnContentInserted(content platform.InsertedContent) {
+	w := s.Element().Widget().(TextInput)
+	if w.OnContentInserted != nil && !w.Disabled {
+		w.OnContentInserted(content)
+	}
+}
+
 // OnFocusChanged implements TextInputViewClient.
 func (s *textInputState) OnFocusChanged(focused bool) {
 	w := s.Element().Widget().(TextInput)
@@ -97,3 +97,159 @@ func TestBuildPlatformViewConfig_ConfigUnchangedOnRebuild(t *testing.T) {
 		t.Error("rebuild with identical config should not trigger config update")
 	}
 }
+
+func TestBuildPlatformViewConfig_DifferentMaxLength(t *testing.T) {
+	s := &textInputState{}
+	a := TextInput{MaxLength: 10}
+	b := TextInput{MaxLength: 10, MaxLengthEnforcement: platform.MaxLengthEnforcementBlock}
+	c := TextInput{MaxLength: 20}
+
+	if s.buildPlatformViewConfig(a) == s.buildPlatformViewConfig(b) {
+		t.Error("different max length enforcement should produce different configs")
+	}
+	if s.buildPlatformViewConfig(a) == s.buildPlatformViewConfig(c) {
+		t.Error("different max lengths should produce different configs")
+	}
+}
+
+func TestBuildPlatformViewConfig_ObscureChanges(t *testing.T) {
+	s := &textInputState{}
+	hidden := TextInput{Obscure: true}
+	shown := TextInput{Obscure: false}
+	masked := TextInput{Obscure: true, ObscuringCharacter: "*"}
+
+	if s.buildPlatformViewConfig(hidden) == s.buildPlatformViewConfig(shown) {
+		t.Error("toggling obscure should produce different configs")
+	}
+	if s.buildPlatformViewConfig(hidden) == s.buildPlatformViewConfig(masked) {
+		t.Error("different obscuring characters should produce different configs")
+	}
+}
+
+func TestBuildPlatformViewConfig_KeyboardOptions(t *testing.T) {
+	s := &textInputState{}
+	base := TextInput{}
+	variants := map[string]TextInput{
+		"keyboard appearance": {KeyboardAppearance: platform.KeyboardAppearanceDark},
+		"return key":          {EnablesReturnKeyAutomatically: true},
+		"smart dashes":        {SmartDashes: true},
+		"smart quotes":        {SmartQuotes: true},
+		"autofill hints":      {AutofillHints: []platform.AutofillHint{platform.AutofillHintOneTimeCode}},
+		"hidden cursor":       {HideCursor: true},
+	}
+	for name, w := range variants {
+		if s.buildPlatformViewConfig(base) == s.buildPlatformViewConfig(w) {
+			t.Errorf("different %s should produce different configs", name)
+		}
+	}
+}
+
+func TestContentMimeTypes(t *testing.T) {
+	if got := contentMimeTypes(TextInput{ContentMimeTypes: []string{"image/gif"}}); got != "" {
+		t.Errorf("expected insertion disabled without a callback, got %q", got)
+	}
+
+	onInsert := func(platform.InsertedContent) {}
+	if got := contentMimeTypes(TextInput{OnContentInserted: onInsert}); got != "image/png,image/gif,image/jpeg,image/webp" {
+		t.Errorf("expected default MIME types, got %q", got)
+	}
+	custom := TextInput{OnContentInserted: onInsert, ContentMimeTypes: []string{"image/gif", "video/*"}}
+	if got := contentMimeTypes(custom); got != "image/gif,video/*" {
+		t.Errorf("expected custom MIME types, got %q", got)
+	}
+}
+
+func TestBuildPlatformViewConfig_SpellCheck(t *testing.T) {
+	s := &textInputState{}
+	onRanges := func([]platform.MisspelledRange) {}
+	tests := []struct {
+		name   string
+		config *SpellCheckConfiguration
+		mode   platform.SpellCheckMode
+		report bool
+	}{
+		{"default", nil, platform.SpellCheckDefault, false},
+		{"enabled", &SpellCheckConfiguration{}, platform.SpellCheckEnabled, false},
+		{"reporting", &SpellCheckConfiguration{OnMisspelledRanges: onRanges}, platform.SpellCheckEnabled, true},
+		{"disabled", &SpellCheckConfiguration{Disabled: true, OnMisspelledRanges: onRanges}, platform.SpellCheckDisabled, false},
+	}
+	for _, tt := range tests {
+		config := s.buildPlatformViewConfig(TextInput{SpellCheck: tt.config})
+		if config.SpellCheck != tt.mode || config.ReportMisspellings != tt.report {
+			t.Errorf("%s: got mode %d report %v, want %d %v", tt.name, config.SpellCheck, config.ReportMisspellings, tt.mode, tt.report)
+		}
+	}
+}
+
+func TestSpellCheckConfiguration_TextSpan(t *testing.T) {
+	ranges := []platform.MisspelledRange{
+		{Start: 10, End: 15, Word: "wrold"},
+		{Start: 0, End: 3, Word: "teh"},
+		{Start: 12, End: 14, Word: "ol"}, // overlaps, skipped
+	}
+	span := (*SpellCheckConfiguration)(nil).TextSpan("teh hello wrold", ranges)
+
+	if got := span.PlainText(); got != "teh hello wrold" {
+		t.Fatalf("expected text to be preserved, got %q", got)
+	}
+	if len(span.Children) != 3 {
+		t.Fatalf("expected 3 spans, got %d", len(span.Children))
+	}
+	if span.Children[0].Style != DefaultMisspelledStyle || span.Children[2].Style != DefaultMisspelledStyle {
+		t.Error("expected misspelled words to use the default style")
+	}
+	if span.Children[1].Style != (graphics.SpanStyle{}) {
+		t.Error("expected correct text to be unstyled")
+	}
+
+	custom := graphics.SpanStyle{Decoration: graphics.TextDecorationUnderline}
+	span = (&SpellCheckConfiguration{MisspelledStyle: custom}).TextSpan("teh", ranges)
+	if len(span.Children) != 1 || span.Children[0].Style != custom {
+		t.Errorf("expected custom style and out-of-range words skipped, got %+v", span.Children)
+	}
+}
+
+func TestCharacterCount_CountsCodePoints(t *testing.T) {
+	if got := characterCount("héllo"); got != 5 {
+		t.Errorf("expected 5, got %d", got)
+	}
+	if got := characterCount("日本語"); got != 3 {
+		t.Errorf("expected 3, got %d", got)
+	}
+}
+
+func TestPinCodeFormatter(t *testing.T) {
+	value := func(text string) platform.TextEditingValue {
+		return platform.TextEditingValue{
+			Text:           text,
+			Selection:      platform.TextSelectionCollapsed(len(text)),
+			ComposingRange: platform.TextRangeEmpty,
+		}
+	}
+	tests := []struct {
+		name         string
+		alphanumeric bool
+		old, new     string
+		want         string
+	}{
+		{"typing advances", false, "12", "123", "123"},
+		{"backspace", false, "123", "12", "12"},
+		{"letters dropped", false, "12", "12a", "12"},
+		{"typing past the end", false, "123456", "1234567", "123456"},
+		{"pasted code with separators", false, "", "123-456", "123456"},
+		{"pasted message", false, "", "Your code is 654321.", "654321"},
+		{"paste replaces partial entry", false, "12", "12987654", "987654"},
+		{"paste mid-entry replaces it", false, "12", "1987654 2", "987654"},
+		{"short paste appends", false, "12", "1234", "1234"},
+		{"alphanumeric uppercases", true, "AB", "ABc", "ABC"},
+		{"alphanumeric drops symbols", true, "", "a1-b2", "A1B2"},
+	}
+	for _, tt := range tests {
+		formatter := pinCodeFormatter(6, tt.alphanumeric)
+		got := formatter.FormatEditUpdate(value(tt.old), value(tt.new))
+		if got != value(tt.want) {
+			t.Errorf("%s: got %q at %d, want %q with the caret at the end",
+				tt.name, got.Text, got.Selection.BaseOffset, tt.want)
+		}
+	}
+}
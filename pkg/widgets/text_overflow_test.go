@@ -0,0 +1,138 @@
+package widgets
+
+import (
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/layout"
+)
+
+// opCanvas records the name of each canvas call made while painting text,
+// and the rects drawn. Headless builds produce no text layouts, so these
+// tests paint a renderText holding a hand-built layout instead of going
+// through the widget tester.
+type opCanvas struct {
+	mockCanvas
+	ops   []string
+	rects []graphics.Rect
+}
+
+func (c *opCanvas) Save()                                    { c.ops = append(c.ops, "save") }
+func (c *opCanvas) Restore()                                 { c.ops = append(c.ops, "restore") }
+func (c *opCanvas) ClipRect(graphics.Rect)                   { c.ops = append(c.ops, "clipRect") }
+func (c *opCanvas) SaveLayer(graphics.Rect, *graphics.Paint) { c.ops = append(c.ops, "saveLayer") }
+func (c *opCanvas) DrawText(*graphics.TextLayout, graphics.Offset) {
+	c.ops = append(c.ops, "drawText")
+}
+func (c *opCanvas) DrawRect(rect graphics.Rect, paint graphics.Paint) {
+	c.ops = append(c.ops, "drawRect")
+	c.rects = append(c.rects, rect)
+}
+
+// paintTextLayout paints a renderText of size holding textLayout, and
+// returns the canvas that recorded the calls.
+func paintTextLayout(overflow graphics.TextOverflow, direction graphics.TextDirection, size graphics.Size, textLayout graphics.TextLayout) *opCanvas {
+	r := &renderText{overflow: overflow, direction: direction, layout: &textLayout}
+	r.SetSelf(r)
+	r.SetSize(size)
+	canvas := &opCanvas{}
+	r.Paint(&layout.PaintContext{Canvas: canvas})
+	return canvas
+}
+
+func TestRenderText_OverflowPaint(t *testing.T) {
+	size := graphics.Size{Width: 100, Height: 20}
+	wide := graphics.TextLayout{Size: graphics.Size{Width: 180, Height: 20}, LineHeight: 20}
+	fits := graphics.TextLayout{Size: graphics.Size{Width: 60, Height: 20}, LineHeight: 20}
+	cutOff := graphics.TextLayout{Size: graphics.Size{Width: 100, Height: 20}, LineHeight: 20, DidExceedMaxLines: true}
+
+	tests := []struct {
+		name     string
+		overflow graphics.TextOverflow
+		layout   graphics.TextLayout
+		want     []string
+	}{
+		{"visible overflowing", graphics.TextOverflowVisible, wide, []string{"drawText"}},
+		{"clip fitting", graphics.TextOverflowClip, fits, []string{"drawText"}},
+		{"fade fitting", graphics.TextOverflowFade, fits, []string{"drawText"}},
+		{"clip overflowing", graphics.TextOverflowClip, wide, []string{"save", "clipRect", "drawText", "restore"}},
+		{"ellipsis overflowing", graphics.TextOverflowEllipsis, wide, []string{"save", "clipRect", "drawText", "restore"}},
+		{"clip past max lines", graphics.TextOverflowClip, cutOff, []string{"save", "clipRect", "drawText", "restore"}},
+		{"fade overflowing", graphics.TextOverflowFade, wide,
+			[]string{"save", "clipRect", "saveLayer", "drawText", "drawRect", "restore", "restore"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			canvas := paintTextLayout(tt.overflow, graphics.TextDirectionLTR, size, tt.layout)
+			if !slices.Equal(canvas.ops, tt.want) {
+				t.Errorf("got ops %v, want %v", canvas.ops, tt.want)
+			}
+		})
+	}
+}
+
+func TestRenderText_Overflows(t *testing.T) {
+	size := graphics.Size{Width: 100, Height: 40}
+	tests := []struct {
+		name   string
+		layout graphics.TextLayout
+		want   bool
+	}{
+		{"fits", graphics.TextLayout{Size: graphics.Size{Width: 100, Height: 40}}, false},
+		{"too wide", graphics.TextLayout{Size: graphics.Size{Width: 101, Height: 40}}, true},
+		{"too tall", graphics.TextLayout{Size: graphics.Size{Width: 100, Height: 41}}, true},
+		{"past max lines", graphics.TextLayout{Size: graphics.Size{Width: 80, Height: 20}, DidExceedMaxLines: true}, true},
+	}
+	for _, tt := range tests {
+		r := &renderText{layout: &tt.layout}
+		if got := r.overflows(size); got != tt.want {
+			t.Errorf("%s: overflows = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestRenderText_FadeEdge(t *testing.T) {
+	size := graphics.Size{Width: 100, Height: 40}
+	wide := graphics.TextLayout{Size: graphics.Size{Width: 180, Height: 20}, LineHeight: 20}
+	tall := graphics.TextLayout{Size: graphics.Size{Width: 100, Height: 60}, LineHeight: 20, DidExceedMaxLines: true}
+
+	tests := []struct {
+		name      string
+		direction graphics.TextDirection
+		layout    graphics.TextLayout
+		want      graphics.Rect
+	}{
+		{"ltr fades the right edge", graphics.TextDirectionLTR, wide, graphics.RectFromLTWH(80, 0, 20, 40)},
+		{"rtl fades the left edge", graphics.TextDirectionRTL, wide, graphics.RectFromLTWH(0, 0, 20, 40)},
+		{"lines fade the bottom", graphics.TextDirectionRTL, tall, graphics.RectFromLTWH(0, 20, 100, 20)},
+	}
+	for _, tt := range tests {
+		canvas := paintTextLayout(graphics.TextOverflowFade, tt.direction, size, tt.layout)
+		if len(canvas.rects) != 1 || canvas.rects[0] != tt.want {
+			t.Errorf("%s: got fade rects %v, want %v", tt.name, canvas.rects, tt.want)
+		}
+	}
+}
+
+func TestRenderText_NoWrapEllipsisUsesConstraintWidth(t *testing.T) {
+	r := &renderText{text: "a long single line", wrapMode: graphics.TextWrapNoWrap, overflow: graphics.TextOverflowEllipsis}
+	r.SetSelf(r)
+
+	r.Layout(layout.Constraints{MaxWidth: 120, MaxHeight: 50}, false)
+	if r.cache.maxWidth != 120 || r.cache.maxLines != 1 {
+		t.Errorf("expected one line at the constraint width, got width %v and %d lines", r.cache.maxWidth, r.cache.maxLines)
+	}
+
+	r.Layout(layout.Constraints{MaxWidth: math.Inf(1), MaxHeight: 50}, false)
+	if r.cache.maxWidth != 0 || r.cache.maxLines != 0 {
+		t.Errorf("expected unbounded text left unwrapped, got width %v and %d lines", r.cache.maxWidth, r.cache.maxLines)
+	}
+
+	r.overflow = graphics.TextOverflowClip
+	r.Layout(layout.Constraints{MaxWidth: 120, MaxHeight: 50}, false)
+	if r.cache.maxWidth != 0 {
+		t.Errorf("expected clipped no-wrap text laid out unbounded, got width %v", r.cache.maxWidth)
+	}
+}
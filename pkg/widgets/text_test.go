@@ -116,6 +116,18 @@ func TestText_WithAlign(t *testing.T) {
 	}
 }
 
+func TestText_WithOverflow(t *testing.T) {
+	base := widgets.Text{Content: "hello", MaxLines: 1}
+	faded := base.WithOverflow(graphics.TextOverflowFade)
+
+	if faded.Overflow != graphics.TextOverflowFade {
+		t.Errorf("WithOverflow: expected %v, got %v", graphics.TextOverflowFade, faded.Overflow)
+	}
+	if base.Overflow != graphics.TextOverflowVisible {
+		t.Errorf("WithOverflow should not mutate receiver: expected %v, got %v", graphics.TextOverflowVisible, base.Overflow)
+	}
+}
+
 func TestText_AlignCenter_ExpandsWidth(t *testing.T) {
 	// Center-aligned wrapping text should expand to the full constraint
 	// width so Skia's centering within the paragraph layout width matches
@@ -0,0 +1,292 @@
+package widgets
+
+import (
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/platform"
+)
+
+// TreeController holds the nodes of a [TreeView]: which are expanded, the
+// children loaded so far, and the selected node. T identifies a node, such
+// as a file path or a category ID, and must be unique within the tree.
+//
+// Children are loaded the first time a node is expanded. The load function
+// runs on a background goroutine; results are applied on the UI thread
+// through [platform.Dispatch], and listeners are notified of every change.
+//
+//	s.tree = widgets.NewTreeController([]string{"/"},
+//	    func(path string) bool { return !isDir(path) },
+//	    func(path string) ([]string, error) { return listDir(path) },
+//	)
+//	core.UseDisposable(s, s.tree)
+type TreeController[T comparable] struct {
+	roots        []T
+	isLeaf       func(node T) bool
+	load         func(node T) ([]T, error)
+	nodes        map[T]*treeNodeState[T]
+	selected     T
+	hasSelection bool
+	disposed     bool
+	listeners    core.Notifier
+}
+
+// treeNodeState is what the controller knows about one node.
+type treeNodeState[T comparable] struct {
+	expanded bool
+	loaded   bool
+	loading  bool
+	children []T
+	err      error
+	// generation counts loads, so a stale result can be recognized.
+	generation int
+}
+
+// TreeRow is one visible row of a [TreeView]: a node, and how to draw it.
+type TreeRow[T comparable] struct {
+	// Node is the node shown on the row.
+	Node T
+	// Depth is the number of ancestors; roots have depth zero.
+	Depth int
+	// Expanded reports whether the node's children are shown, or are being
+	// loaded to be shown.
+	Expanded bool
+	// Leaf reports that the node has no children and cannot expand.
+	Leaf bool
+	// Loading reports that the node's children are being loaded.
+	Loading bool
+	// Selected reports whether the node is the controller's selection.
+	Selected bool
+	// Err is the error from the last failed load of the node's children.
+	Err error
+}
+
+// NewTreeController creates a controller for the trees rooted at roots, with
+// every node collapsed. isLeaf reports whether a node has no children
+// without loading them, so the tree can leave out its expander; nil means
+// any node may have children until a load returns none. loadChildren returns
+// a node's children in display order.
+func NewTreeController[T comparable](roots []T, isLeaf func(node T) bool, loadChildren func(node T) ([]T, error)) *TreeController[T] {
+	return &TreeController[T]{
+		roots:  roots,
+		isLeaf: isLeaf,
+		load:   loadChildren,
+		nodes:  make(map[T]*treeNodeState[T]),
+	}
+}
+
+// Roots returns the top-level nodes. The slice must not be modified.
+func (c *TreeController[T]) Roots() []T {
+	return c.roots
+}
+
+// SetRoots replaces the top-level nodes. Expansion and loaded children of
+// nodes that remain in the tree are kept.
+func (c *TreeController[T]) SetRoots(roots []T) {
+	c.roots = roots
+	c.notifyListeners()
+}
+
+// Children returns the loaded children of node, or nil if they have not
+// loaded. The slice must not be modified.
+func (c *TreeController[T]) Children(node T) []T {
+	if n := c.nodes[node]; n != nil {
+		return n.children
+	}
+	return nil
+}
+
+// IsExpanded reports whether node is expanded.
+func (c *TreeController[T]) IsExpanded(node T) bool {
+	n := c.nodes[node]
+	return n != nil && n.expanded
+}
+
+// IsLeaf reports whether node has no children: isLeaf says so, or its
+// children have loaded and there are none.
+func (c *TreeController[T]) IsLeaf(node T) bool {
+	if c.isLeaf != nil && c.isLeaf(node) {
+		return true
+	}
+	n := c.nodes[node]
+	return n != nil && n.loaded && len(n.children) == 0
+}
+
+// IsLoading reports whether node's children are being loaded.
+func (c *TreeController[T]) IsLoading(node T) bool {
+	n := c.nodes[node]
+	return n != nil && n.loading
+}
+
+// Error returns the error from the last failed load of node's children, or
+// nil.
+func (c *TreeController[T]) Error(node T) error {
+	if n := c.nodes[node]; n != nil {
+		return n.err
+	}
+	return nil
+}
+
+// Expand shows node's children, loading them first if needed. A node whose
+// load failed is loaded again.
+func (c *TreeController[T]) Expand(node T) {
+	if c.IsLeaf(node) {
+		return
+	}
+	n := c.node(node)
+	if n.expanded && (n.loaded || n.loading) {
+		return
+	}
+	n.expanded = true
+	if !n.loaded {
+		c.startLoad(node, n)
+	}
+	c.notifyListeners()
+}
+
+// Collapse hides node's children. They stay loaded for the next Expand.
+func (c *TreeController[T]) Collapse(node T) {
+	n := c.nodes[node]
+	if n == nil || !n.expanded {
+		return
+	}
+	n.expanded = false
+	c.notifyListeners()
+}
+
+// Toggle expands node if it is collapsed and collapses it otherwise.
+func (c *TreeController[T]) Toggle(node T) {
+	if c.IsExpanded(node) {
+		c.Collapse(node)
+	} else {
+		c.Expand(node)
+	}
+}
+
+// Reload discards node's loaded children, loading them again now if node is
+// expanded or on its next Expand otherwise.
+func (c *TreeController[T]) Reload(node T) {
+	n := c.nodes[node]
+	if n == nil {
+		return
+	}
+	n.generation++
+	n.loaded = false
+	n.loading = false
+	n.children = nil
+	n.err = nil
+	if n.expanded {
+		c.startLoad(node, n)
+	}
+	c.notifyListeners()
+}
+
+// Select makes node the selected node.
+func (c *TreeController[T]) Select(node T) {
+	if c.hasSelection && c.selected == node {
+		return
+	}
+	c.selected = node
+	c.hasSelection = true
+	c.notifyListeners()
+}
+
+// ClearSelection leaves no node selected.
+func (c *TreeController[T]) ClearSelection() {
+	if !c.hasSelection {
+		return
+	}
+	var zero T
+	c.selected = zero
+	c.hasSelection = false
+	c.notifyListeners()
+}
+
+// Selected returns the selected node, and false if there is none.
+func (c *TreeController[T]) Selected() (T, bool) {
+	return c.selected, c.hasSelection
+}
+
+// Rows returns the visible rows in display order: the roots, each followed
+// by the rows of its children when it is expanded.
+func (c *TreeController[T]) Rows() []TreeRow[T] {
+	var rows []TreeRow[T]
+	var visit func(nodes []T, depth int)
+	visit = func(nodes []T, depth int) {
+		for _, node := range nodes {
+			row := TreeRow[T]{
+				Node:     node,
+				Depth:    depth,
+				Leaf:     c.IsLeaf(node),
+				Selected: c.hasSelection && c.selected == node,
+			}
+			if n := c.nodes[node]; n != nil {
+				row.Expanded = n.expanded && !row.Leaf
+				row.Loading = n.loading
+				row.Err = n.err
+			}
+			rows = append(rows, row)
+			if row.Expanded {
+				visit(c.Children(node), depth+1)
+			}
+		}
+	}
+	visit(c.roots, 0)
+	return rows
+}
+
+// AddListener registers a callback for changes to the tree. Returns an
+// unsubscribe function.
+func (c *TreeController[T]) AddListener(listener func()) func() {
+	return c.listeners.AddListener(listener)
+}
+
+// Dispose discards loads in flight and removes all listeners.
+func (c *TreeController[T]) Dispose() {
+	c.disposed = true
+	c.listeners.Dispose()
+}
+
+func (c *TreeController[T]) node(node T) *treeNodeState[T] {
+	n := c.nodes[node]
+	if n == nil {
+		n = &treeNodeState[T]{}
+		c.nodes[node] = n
+	}
+	return n
+}
+
+// startLoad loads node's children in the background. A result arriving
+// after the node was reloaded or the controller disposed is discarded.
+func (c *TreeController[T]) startLoad(node T, n *treeNodeState[T]) {
+	if c.load == nil {
+		n.loaded = true
+		return
+	}
+	n.generation++
+	n.loading = true
+	n.err = nil
+	generation, load := n.generation, c.load
+	go func() {
+		children, err := load(node)
+		platform.Dispatch(func() {
+			if !c.disposed && generation == n.generation {
+				c.complete(n, children, err)
+			}
+		})
+	}()
+}
+
+// complete applies a load result.
+func (c *TreeController[T]) complete(n *treeNodeState[T], children []T, err error) {
+	n.loading = false
+	if err != nil {
+		n.err = err
+	} else {
+		n.loaded = true
+		n.children = children
+	}
+	c.notifyListeners()
+}
+
+func (c *TreeController[T]) notifyListeners() {
+	c.listeners.Notify()
+}
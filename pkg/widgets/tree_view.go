@@ -0,0 +1,618 @@
+package widgets
+
+import (
+	"math"
+	"time"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/focus"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/layout"
+)
+
+// TreeView shows hierarchical data, such as a file browser or nested
+// categories, as indented rows that expand to show their children. Children
+// are loaded by the [TreeController] the first time a node is expanded, and
+// rows slide in and out as nodes expand and collapse.
+//
+// Tapping a row selects it. Tapping the expander, or the whole row with
+// ExpandOnTap, expands or collapses it. Once a row is tapped the tree takes
+// keyboard focus, and hardware keys move through it:
+//
+//   - Up and Down select the previous and next row; Home and End the first
+//     and last
+//   - Right expands the selected node, or selects its first child
+//   - Left collapses the selected node, or selects its parent
+//   - Enter and Space expand or collapse the selected node, or call
+//     OnActivate for a leaf
+//
+// Left and Right are swapped in a right-to-left [Directionality].
+//
+// Like [AnimatedList], every visible row is built, so trees should expand a
+// few hundred rows at most.
+//
+// # Styling Model
+//
+// TreeView is explicit: zero sizes and colors mean none. Use
+// [theme.TreeViewOf] for themed rows, expanders, and guides:
+//
+//	theme.TreeViewOf(ctx, s.tree, func(path string) string { return filepath.Base(path) }).
+//	    WithOnSelect(s.open)
+type TreeView[T comparable] struct {
+	core.StatefulBase
+
+	// Controller holds the nodes, expansion, and selection. Required.
+	Controller *TreeController[T]
+	// NodeBuilder builds a row's content, drawn after its indentation and
+	// expander.
+	NodeBuilder func(ctx core.BuildContext, row TreeRow[T]) core.Widget
+	// LoadingBuilder is shown in place of the expander while a node's
+	// children load. Nil keeps the expander.
+	LoadingBuilder func(ctx core.BuildContext) core.Widget
+
+	// RowHeight is the height of every row. Zero sizes each row to its
+	// content, and keyboard navigation no longer scrolls the selected row
+	// into view.
+	RowHeight float64
+	// Indent is the horizontal space added for each level of depth.
+	Indent float64
+	// ExpanderSize is the width of the expander column, which holds a
+	// chevron for nodes that can expand. Zero leaves out the column, for
+	// node builders that draw their own.
+	ExpanderSize float64
+	// ExpanderColor is the color of the chevron.
+	ExpanderColor graphics.Color
+	// GuideColor is the color of the vertical lines marking each level of
+	// indentation.
+	GuideColor graphics.Color
+	// GuideWidth is the stroke width of the guides. Zero draws none.
+	GuideWidth float64
+	// SelectedColor is the background of the selected row.
+	SelectedColor graphics.Color
+
+	// ExpandOnTap expands or collapses a node when any part of its row is
+	// tapped, not only the expander.
+	ExpandOnTap bool
+	// OnSelect is called when the user selects a node by tapping it or with
+	// the keyboard.
+	OnSelect func(node T)
+	// OnActivate is called when Enter or Space is pressed on a selected leaf.
+	OnActivate func(node T)
+
+	// Duration is the length of the expand and collapse animation. Zero
+	// shows and hides children immediately.
+	Duration time.Duration
+	// Curve transforms the animation progress. If nil, uses linear
+	// interpolation.
+	Curve func(float64) float64
+
+	// ScrollController manages scroll position and provides scroll notifications.
+	ScrollController *ScrollController
+	// Physics determines how the scroll view responds to user input.
+	Physics ScrollPhysics
+	// Padding is applied around the rows.
+	Padding layout.EdgeInsets
+}
+
+// WithOnSelect returns a copy with the specified selection callback.
+func (t TreeView[T]) WithOnSelect(fn func(node T)) TreeView[T] {
+	t.OnSelect = fn
+	return t
+}
+
+// WithOnActivate returns a copy with the specified leaf activation callback.
+func (t TreeView[T]) WithOnActivate(fn func(node T)) TreeView[T] {
+	t.OnActivate = fn
+	return t
+}
+
+// WithExpandOnTap returns a copy that expands and collapses nodes when their
+// row is tapped.
+func (t TreeView[T]) WithExpandOnTap() TreeView[T] {
+	t.ExpandOnTap = true
+	return t
+}
+
+func (t TreeView[T]) CreateState() core.State {
+	return &treeViewState[T]{}
+}
+
+type treeViewState[T comparable] struct {
+	core.StateBase
+	controller  *TreeController[T]
+	rows        []TreeRow[T]
+	list        *AnimatedListController
+	scroll      *ScrollController
+	focusNode   *focus.FocusNode
+	direction   graphics.TextDirection
+	unsubscribe func()
+}
+
+func (s *treeViewState[T]) InitState() {
+	w := s.Element().Widget().(TreeView[T])
+	s.attach(w.Controller)
+	s.scroll = w.ScrollController
+	if s.scroll == nil {
+		s.scroll = &ScrollController{}
+	}
+
+	s.focusNode = &focus.FocusNode{
+		CanRequestFocus: true,
+		DebugLabel:      "TreeView",
+		Rect:            s,
+		OnKeyEvent:      s.handleKey,
+	}
+	if scope := focus.GetFocusManager().RootScope; scope != nil {
+		scope.Children = append(scope.Children, s.focusNode)
+	}
+}
+
+func (s *treeViewState[T]) DidUpdateWidget(oldWidget core.StatefulWidget) {
+	old := oldWidget.(TreeView[T])
+	w := s.Element().Widget().(TreeView[T])
+	if old.Controller != w.Controller {
+		s.detach()
+		s.attach(w.Controller)
+	}
+	if old.ScrollController != w.ScrollController {
+		s.scroll = w.ScrollController
+		if s.scroll == nil {
+			s.scroll = &ScrollController{}
+		}
+	}
+}
+
+func (s *treeViewState[T]) Dispose() {
+	s.detach()
+	if s.focusNode != nil {
+		s.focusNode.Unfocus()
+		if scope := focus.GetFocusManager().RootScope; scope != nil {
+			if scope.FocusedChild == s.focusNode {
+				scope.FocusedChild = nil
+			}
+			for i, child := range scope.Children {
+				if child == s.focusNode {
+					scope.Children = append(scope.Children[:i], scope.Children[i+1:]...)
+					break
+				}
+			}
+		}
+		s.focusNode = nil
+	}
+	s.StateBase.Dispose()
+}
+
+func (s *treeViewState[T]) attach(controller *TreeController[T]) {
+	s.controller = controller
+	if controller == nil {
+		s.rows = nil
+		s.list = NewAnimatedListController(0)
+		return
+	}
+	s.rows = controller.Rows()
+	s.list = NewAnimatedListController(len(s.rows))
+	s.unsubscribe = controller.AddListener(s.update)
+}
+
+func (s *treeViewState[T]) detach() {
+	if s.unsubscribe != nil {
+		s.unsubscribe()
+		s.unsubscribe = nil
+	}
+	if s.list != nil {
+		s.list.Dispose()
+		s.list = nil
+	}
+}
+
+// update animates the rows from the last ones shown to the controller's
+// current rows. Rows that disappear are drawn as they were while they
+// animate out.
+func (s *treeViewState[T]) update() {
+	old := s.rows
+	s.rows = s.controller.Rows()
+	differ := ListDiffer[TreeRow[T], T]{Key: func(row TreeRow[T]) T { return row.Node }}
+	s.list.ApplyOperations(differ.Diff(old, s.rows), func(oldIndex int) func(ctx core.BuildContext) core.Widget {
+		row := old[oldIndex]
+		return func(ctx core.BuildContext) core.Widget {
+			return s.buildRow(ctx, row)
+		}
+	})
+	s.SetState(nil)
+}
+
+func (s *treeViewState[T]) Build(ctx core.BuildContext) core.Widget {
+	w := s.Element().Widget().(TreeView[T])
+	s.direction = DirectionalityOf(ctx)
+	return AnimatedList{
+		Controller: s.list,
+		ItemBuilder: func(ctx core.BuildContext, index int) core.Widget {
+			if index >= len(s.rows) {
+				return nil
+			}
+			return s.buildRow(ctx, s.rows[index])
+		},
+		Duration:         w.Duration,
+		Curve:            w.Curve,
+		ScrollController: s.scroll,
+		Physics:          w.Physics,
+		Padding:          w.Padding,
+	}
+}
+
+func (s *treeViewState[T]) buildRow(ctx core.BuildContext, row TreeRow[T]) core.Widget {
+	w := s.Element().Widget().(TreeView[T])
+
+	var content core.Widget
+	if w.NodeBuilder != nil {
+		content = w.NodeBuilder(ctx, row)
+	}
+	children := make([]core.Widget, 0, 2)
+	if w.ExpanderSize > 0 {
+		var expander core.Widget = SizedBox{Width: w.ExpanderSize}
+		switch {
+		case row.Loading && w.LoadingBuilder != nil:
+			expander = SizedBox{Width: w.ExpanderSize, Child: Center{Child: w.LoadingBuilder(ctx)}}
+		case !row.Leaf:
+			node := row.Node
+			expander = GestureDetector{
+				OnTap: func() { s.controller.Toggle(node) },
+				Child: treeExpander{
+					size:      w.ExpanderSize,
+					color:     w.ExpanderColor,
+					expanded:  row.Expanded,
+					direction: s.direction,
+				},
+			}
+		}
+		children = append(children, expander)
+	}
+	children = append(children, Expanded{Child: content})
+
+	var child core.Widget = Padding{
+		Directional: layout.EdgeInsetsDirectionalOnly(float64(row.Depth)*w.Indent, 0, 0, 0),
+		Child:       Row{Children: children, CrossAxisAlignment: CrossAxisAlignmentCenter},
+	}
+	if w.RowHeight > 0 {
+		child = SizedBox{Height: w.RowHeight, Child: child}
+	}
+
+	background := graphics.Color(0)
+	if row.Selected {
+		background = w.SelectedColor
+	}
+	node, leaf := row.Node, row.Leaf
+	return GestureDetector{
+		OnTap: func() {
+			if s.focusNode != nil {
+				s.focusNode.RequestFocus()
+			}
+			s.selectNode(node)
+			if w.ExpandOnTap && !leaf {
+				s.controller.Toggle(node)
+			}
+		},
+		Child: treeRowBox{
+			depth:      row.Depth,
+			indent:     w.Indent,
+			guideColor: w.GuideColor,
+			guideWidth: w.GuideWidth,
+			background: background,
+			direction:  s.direction,
+			child:      child,
+		},
+	}
+}
+
+// selectNode selects node, tells OnSelect, and scrolls its row into view.
+func (s *treeViewState[T]) selectNode(node T) {
+	if s.controller == nil {
+		return
+	}
+	s.controller.Select(node)
+	w := s.Element().Widget().(TreeView[T])
+	if w.OnSelect != nil {
+		w.OnSelect(node)
+	}
+	s.revealRow(s.rowIndex(node))
+}
+
+// revealRow scrolls just enough to show the row at index, when rows have a
+// fixed height.
+func (s *treeViewState[T]) revealRow(index int) {
+	w := s.Element().Widget().(TreeView[T])
+	viewport := s.scroll.ViewportExtent()
+	if index < 0 || w.RowHeight <= 0 || viewport <= 0 {
+		return
+	}
+	top := w.Padding.Top + float64(index)*w.RowHeight
+	bottom := top + w.RowHeight
+	offset := s.scroll.Offset()
+	switch {
+	case top < offset:
+		s.scroll.JumpTo(top)
+	case bottom > offset+viewport:
+		s.scroll.JumpTo(bottom - viewport)
+	}
+}
+
+func (s *treeViewState[T]) rowIndex(node T) int {
+	for i, row := range s.rows {
+		if row.Node == node {
+			return i
+		}
+	}
+	return -1
+}
+
+// handleKey moves the selection and expands or collapses nodes for hardware
+// keys while the tree has focus.
+func (s *treeViewState[T]) handleKey(event focus.KeyEvent) focus.KeyEventResult {
+	if !event.IsPress() || s.controller == nil || len(s.rows) == 0 {
+		return focus.KeyEventIgnored
+	}
+	index := -1
+	if selected, ok := s.controller.Selected(); ok {
+		index = s.rowIndex(selected)
+	}
+	key := event.Key
+	if s.direction == graphics.TextDirectionRTL {
+		switch key {
+		case focus.KeyArrowLeft:
+			key = focus.KeyArrowRight
+		case focus.KeyArrowRight:
+			key = focus.KeyArrowLeft
+		}
+	}
+
+	switch key {
+	case focus.KeyArrowDown:
+		s.selectNode(s.rows[min(index+1, len(s.rows)-1)].Node)
+	case focus.KeyArrowUp:
+		if index < 0 {
+			index = len(s.rows)
+		}
+		s.selectNode(s.rows[max(index-1, 0)].Node)
+	case focus.KeyHome:
+		s.selectNode(s.rows[0].Node)
+	case focus.KeyEnd:
+		s.selectNode(s.rows[len(s.rows)-1].Node)
+	case focus.KeyArrowRight:
+		if index < 0 {
+			return focus.KeyEventIgnored
+		}
+		row := s.rows[index]
+		switch {
+		case !row.Leaf && !row.Expanded:
+			s.controller.Expand(row.Node)
+		case row.Expanded && index+1 < len(s.rows) && s.rows[index+1].Depth > row.Depth:
+			s.selectNode(s.rows[index+1].Node)
+		}
+	case focus.KeyArrowLeft:
+		if index < 0 {
+			return focus.KeyEventIgnored
+		}
+		row := s.rows[index]
+		if row.Expanded {
+			s.controller.Collapse(row.Node)
+			break
+		}
+		for i := index - 1; i >= 0; i-- {
+			if s.rows[i].Depth < row.Depth {
+				s.selectNode(s.rows[i].Node)
+				break
+			}
+		}
+	case focus.KeyEnter, focus.KeySpace:
+		if index < 0 {
+			return focus.KeyEventIgnored
+		}
+		row := s.rows[index]
+		if !row.Leaf {
+			s.controller.Toggle(row.Node)
+		} else if w := s.Element().Widget().(TreeView[T]); w.OnActivate != nil {
+			w.OnActivate(row.Node)
+		}
+	default:
+		return focus.KeyEventIgnored
+	}
+	return focus.KeyEventHandled
+}
+
+// FocusRect implements focus.RectProvider for directional navigation.
+func (s *treeViewState[T]) FocusRect() focus.FocusRect {
+	if s.Element() == nil {
+		return focus.FocusRect{}
+	}
+	offset := core.GlobalOffsetOf(s.Element())
+	if ro := s.Element().RenderObject(); ro != nil {
+		if sizer, ok := ro.(interface{ Size() graphics.Size }); ok {
+			size := sizer.Size()
+			return focus.FocusRect{
+				Left:   offset.X,
+				Top:    offset.Y,
+				Right:  offset.X + size.Width,
+				Bottom: offset.Y + size.Height,
+			}
+		}
+	}
+	return focus.FocusRect{Left: offset.X, Top: offset.Y, Right: offset.X, Bottom: offset.Y}
+}
+
+// treeRowBox paints a tree row's background and indentation guides behind
+// its child.
+type treeRowBox struct {
+	core.RenderObjectBase
+	depth      int
+	indent     float64
+	guideColor graphics.Color
+	guideWidth float64
+	background graphics.Color
+	direction  graphics.TextDirection
+	child      core.Widget
+}
+
+func (t treeRowBox) ChildWidget() core.Widget {
+	return t.child
+}
+
+func (t treeRowBox) CreateRenderObject(ctx core.BuildContext) layout.RenderObject {
+	box := &renderTreeRowBox{}
+	box.SetSelf(box)
+	t.UpdateRenderObject(ctx, box)
+	return box
+}
+
+func (t treeRowBox) UpdateRenderObject(ctx core.BuildContext, renderObject layout.RenderObject) {
+	if box, ok := renderObject.(*renderTreeRowBox); ok {
+		box.depth = t.depth
+		box.indent = t.indent
+		box.guideColor = t.guideColor
+		box.guideWidth = t.guideWidth
+		box.background = t.background
+		box.direction = t.direction
+		box.MarkNeedsPaint()
+	}
+}
+
+type renderTreeRowBox struct {
+	layout.RenderBoxBase
+	child      layout.RenderBox
+	depth      int
+	indent     float64
+	guideColor graphics.Color
+	guideWidth float64
+	background graphics.Color
+	direction  graphics.TextDirection
+}
+
+func (r *renderTreeRowBox) SetChild(child layout.RenderObject) {
+	layout.SetParentOnChild(r.child, nil)
+	r.child = layout.AsRenderBox(child)
+	layout.SetParentOnChild(r.child, r)
+}
+
+func (r *renderTreeRowBox) VisitChildren(visitor func(layout.RenderObject)) {
+	if r.child != nil {
+		visitor(r.child)
+	}
+}
+
+func (r *renderTreeRowBox) PerformLayout() {
+	constraints := r.Constraints()
+	if r.child == nil {
+		r.SetSize(constraints.Constrain(graphics.Size{}))
+		return
+	}
+	r.child.Layout(constraints, true)
+	r.child.SetParentData(&layout.BoxParentData{})
+	r.SetSize(constraints.Constrain(r.child.Size()))
+}
+
+func (r *renderTreeRowBox) Paint(ctx *layout.PaintContext) {
+	size := r.Size()
+	if r.background != 0 {
+		paint := graphics.DefaultPaint()
+		paint.Color = r.background
+		ctx.Canvas.DrawRect(graphics.RectFromLTWH(0, 0, size.Width, size.Height), paint)
+	}
+	if r.guideWidth > 0 && r.guideColor != 0 {
+		paint := graphics.DefaultPaint()
+		paint.Color = r.guideColor
+		for level := range r.depth {
+			x := (float64(level) + 0.5) * r.indent
+			if r.direction == graphics.TextDirectionRTL {
+				x = size.Width - x
+			}
+			ctx.Canvas.DrawRect(graphics.RectFromLTWH(x-r.guideWidth/2, 0, r.guideWidth, size.Height), paint)
+		}
+	}
+	if r.child != nil {
+		ctx.PaintChild(r.child, getChildOffset(r.child))
+	}
+}
+
+func (r *renderTreeRowBox) HitTest(position graphics.Offset, result *layout.HitTestResult) bool {
+	if !layout.WithinBounds(position, r.Size()) {
+		return false
+	}
+	if r.child != nil && r.child.HitTest(position, result) {
+		return true
+	}
+	result.Add(r)
+	return true
+}
+
+// treeExpander draws the chevron of a node that can expand: pointing toward
+// the reading direction when collapsed, and down when expanded.
+type treeExpander struct {
+	core.RenderObjectBase
+	size      float64
+	color     graphics.Color
+	expanded  bool
+	direction graphics.TextDirection
+}
+
+func (t treeExpander) CreateRenderObject(ctx core.BuildContext) layout.RenderObject {
+	expander := &renderTreeExpander{}
+	expander.SetSelf(expander)
+	t.UpdateRenderObject(ctx, expander)
+	return expander
+}
+
+func (t treeExpander) UpdateRenderObject(ctx core.BuildContext, renderObject layout.RenderObject) {
+	if expander, ok := renderObject.(*renderTreeExpander); ok {
+		expander.size = t.size
+		expander.color = t.color
+		expander.expanded = t.expanded
+		expander.direction = t.direction
+		expander.MarkNeedsLayout()
+		expander.MarkNeedsPaint()
+	}
+}
+
+type renderTreeExpander struct {
+	layout.RenderBoxBase
+	size      float64
+	color     graphics.Color
+	expanded  bool
+	direction graphics.TextDirection
+}
+
+func (r *renderTreeExpander) PerformLayout() {
+	r.SetSize(r.Constraints().Constrain(graphics.Size{Width: r.size, Height: r.size}))
+}
+
+func (r *renderTreeExpander) Paint(ctx *layout.PaintContext) {
+	size := r.Size()
+	cx, cy := size.Width/2, size.Height/2
+	arm := math.Min(size.Width, size.Height) * 0.18
+	path := graphics.NewPath()
+	switch {
+	case r.expanded:
+		path.MoveTo(cx-arm*2, cy-arm)
+		path.LineTo(cx, cy+arm)
+		path.LineTo(cx+arm*2, cy-arm)
+	case r.direction == graphics.TextDirectionRTL:
+		path.MoveTo(cx+arm, cy-arm*2)
+		path.LineTo(cx-arm, cy)
+		path.LineTo(cx+arm, cy+arm*2)
+	default:
+		path.MoveTo(cx-arm, cy-arm*2)
+		path.LineTo(cx+arm, cy)
+		path.LineTo(cx-arm, cy+arm*2)
+	}
+	paint := graphics.DefaultPaint()
+	paint.Color = r.color
+	paint.Style = graphics.PaintStyleStroke
+	paint.StrokeWidth = max(size.Width*0.08, 1.5)
+	ctx.Canvas.DrawPath(path, paint)
+}
+
+func (r *renderTreeExpander) HitTest(position graphics.Offset, result *layout.HitTestResult) bool {
+	if !layout.WithinBounds(position, r.Size()) {
+		return false
+	}
+	result.Add(r)
+	return true
+}
@@ -0,0 +1,171 @@
+package widgets_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/focus"
+	"github.com/go-drift/drift/pkg/graphics"
+	drifttest "github.com/go-drift/drift/pkg/testing"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+var treeData = map[string][]string{
+	"root": {"docs", "readme"},
+	"docs": {"guide"},
+}
+
+func newTestTree() *widgets.TreeController[string] {
+	return widgets.NewTreeController([]string{"root"},
+		func(node string) bool { return treeData[node] == nil },
+		func(node string) ([]string, error) { return treeData[node], nil },
+	)
+}
+
+func treeNodes(rows []widgets.TreeRow[string]) []string {
+	nodes := make([]string, len(rows))
+	for i, row := range rows {
+		nodes[i] = row.Node
+	}
+	return nodes
+}
+
+func sameNodes(got, want []string) bool {
+	if len(got) != len(want) {
+		return false
+	}
+	for i := range got {
+		if got[i] != want[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestTreeView_ExpandAndKeyboard(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	tester.SetSize(graphics.Size{Width: 400, Height: 600})
+
+	tree := newTestTree()
+	defer tree.Dispose()
+	var selected []string
+	tester.PumpWidget(widgets.TreeView[string]{
+		Controller: tree,
+		NodeBuilder: func(ctx core.BuildContext, row widgets.TreeRow[string]) core.Widget {
+			return widgets.Text{Content: row.Node}
+		},
+		RowHeight:    40,
+		Indent:       16,
+		ExpanderSize: 24,
+		Duration:     100 * time.Millisecond,
+		OnSelect:     func(node string) { selected = append(selected, node) },
+	})
+
+	// Tapping the expander loads and shows the children.
+	if err := tester.TapAt(graphics.Offset{X: 12, Y: 20}); err != nil {
+		t.Fatal(err)
+	}
+	if !tree.IsExpanded("root") || !tree.IsLoading("root") {
+		t.Fatal("expected root to expand and load its children")
+	}
+	if err := tester.PumpUntil(func() bool { return !tree.IsLoading("root") }, 2*time.Second); err != nil {
+		t.Fatal(err)
+	}
+	if err := tester.PumpAndSettle(time.Second); err != nil {
+		t.Fatal(err)
+	}
+	if !tester.Find(drifttest.ByText("readme")).Exists() {
+		t.Fatal("expected the loaded children to be shown")
+	}
+	if len(selected) != 0 {
+		t.Errorf("tapping the expander should not select, got %v", selected)
+	}
+
+	// Tapping the row selects it and focuses the tree for the keyboard.
+	if err := tester.TapAt(graphics.Offset{X: 200, Y: 20}); err != nil {
+		t.Fatal(err)
+	}
+	manager := focus.GetFocusManager()
+	press := func(key focus.Key) {
+		t.Helper()
+		if !manager.HandleKeyEvent(focus.KeyEvent{Key: key}) {
+			t.Fatalf("%s was not handled", key)
+		}
+		tester.Pump()
+	}
+	press(focus.KeyArrowDown)
+	press(focus.KeyArrowRight) // expands docs
+	if err := tester.PumpUntil(func() bool { return len(tree.Children("docs")) == 1 }, 2*time.Second); err != nil {
+		t.Fatal(err)
+	}
+	press(focus.KeyArrowRight) // selects guide
+	press(focus.KeyArrowLeft)  // back to docs
+	press(focus.KeyEnd)
+	if want := []string{"root", "docs", "guide", "docs", "readme"}; !sameNodes(selected, want) {
+		t.Errorf("selected %v, want %v", selected, want)
+	}
+	if got := treeNodes(tree.Rows()); !sameNodes(got, []string{"root", "docs", "guide", "readme"}) {
+		t.Errorf("rows = %v", got)
+	}
+
+	press(focus.KeyHome)
+	press(focus.KeyArrowLeft) // collapses root
+	if err := tester.PumpAndSettle(time.Second); err != nil {
+		t.Fatal(err)
+	}
+	if tester.Find(drifttest.ByText("readme")).Exists() {
+		t.Error("expected the children to be removed after collapsing")
+	}
+	if manager.HandleKeyEvent(focus.KeyEvent{Key: focus.KeyArrowDown, Action: focus.KeyActionUp}) {
+		t.Error("key releases should be ignored")
+	}
+}
+
+func TestTreeController_LeavesAndErrors(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+
+	fail := true
+	tree := widgets.NewTreeController([]string{"a", "b"}, nil, func(node string) ([]string, error) {
+		if node == "a" && fail {
+			return nil, errors.New("offline")
+		}
+		return nil, nil
+	})
+	defer tree.Dispose()
+
+	// Without isLeaf, a node is a leaf once it loads no children.
+	tree.Expand("b")
+	if err := tester.PumpUntil(func() bool { return !tree.IsLoading("b") }, 2*time.Second); err != nil {
+		t.Fatal(err)
+	}
+	if !tree.IsLeaf("b") || tree.Rows()[1].Expanded {
+		t.Error("expected b to become a leaf")
+	}
+
+	tree.Expand("a")
+	if err := tester.PumpUntil(func() bool { return !tree.IsLoading("a") }, 2*time.Second); err != nil {
+		t.Fatal(err)
+	}
+	if tree.Error("a") == nil || tree.IsLeaf("a") {
+		t.Fatal("expected a failed load to keep a expandable")
+	}
+	fail = false
+	tree.Expand("a") // retries
+	if err := tester.PumpUntil(func() bool { return !tree.IsLoading("a") }, 2*time.Second); err != nil {
+		t.Fatal(err)
+	}
+	if tree.Error("a") != nil || !tree.IsLeaf("a") {
+		t.Errorf("expected the retry to succeed, got %v", tree.Error("a"))
+	}
+
+	tree.Select("b")
+	if node, ok := tree.Selected(); !ok || node != "b" || !tree.Rows()[1].Selected {
+		t.Errorf("Selected() = %q, %v", node, ok)
+	}
+	tree.ClearSelection()
+	if _, ok := tree.Selected(); ok {
+		t.Error("expected no selection")
+	}
+}
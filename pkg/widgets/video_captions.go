@@ -0,0 +1,138 @@
+package widgets
+
+import (
+	"strings"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/layout"
+	"github.com/go-drift/drift/pkg/platform"
+)
+
+// CaptionStyle controls how [VideoCaptions] draws cues.
+type CaptionStyle struct {
+	// TextStyle styles the caption text.
+	TextStyle graphics.TextStyle
+
+	// BackgroundColor fills the box behind the text.
+	BackgroundColor graphics.Color
+
+	// Padding separates the text from the edges of its box.
+	Padding layout.EdgeInsets
+
+	// BorderRadius rounds the corners of the box.
+	BorderRadius float64
+
+	// BottomInset is the distance between the box and the bottom of the
+	// video, which keeps captions clear of transport controls.
+	BottomInset float64
+}
+
+// DefaultCaptionStyle is the white-on-translucent-black style used when
+// [VideoCaptions.Style] is zero.
+var DefaultCaptionStyle = CaptionStyle{
+	TextStyle: graphics.TextStyle{
+		Color:    graphics.ColorWhite,
+		FontSize: 16,
+	},
+	BackgroundColor: graphics.ColorBlack.WithAlpha(0.75),
+	Padding:         layout.EdgeInsetsSymmetric(8, 4),
+	BorderRadius:    4,
+	BottomInset:     24,
+}
+
+// VideoCaptions draws the cues of a [platform.VideoPlayerController]'s
+// selected subtitle track, centered at the bottom of the space it is given.
+// Stack it over a [VideoPlayer] of the same size:
+//
+//	widgets.Stack{
+//	    Children: []core.Widget{
+//	        widgets.VideoPlayer{Controller: s.video, Width: 400, Height: 225},
+//	        widgets.Positioned(widgets.VideoCaptions{Controller: s.video}).Fill(0),
+//	    },
+//	}
+//
+// Select a track with [platform.VideoPlayerController.SelectSubtitleTrack];
+// nothing is drawn while subtitles are off. For a custom caption UI, use
+// [platform.VideoPlayerController.OnCueChanged] instead.
+type VideoCaptions struct {
+	core.StatefulBase
+
+	// Controller supplies the cues.
+	Controller *platform.VideoPlayerController
+
+	// Style controls the appearance of the captions. Zero uses
+	// [DefaultCaptionStyle].
+	Style CaptionStyle
+}
+
+func (v VideoCaptions) CreateState() core.State {
+	return &videoCaptionsState{}
+}
+
+type videoCaptionsState struct {
+	core.StateBase
+	unsubCues func()
+}
+
+func (s *videoCaptionsState) InitState() {
+	s.subscribe(s.Element().Widget().(VideoCaptions).Controller)
+	s.OnDispose(func() {
+		s.subscribe(nil)
+	})
+}
+
+func (s *videoCaptionsState) DidUpdateWidget(oldWidget core.StatefulWidget) {
+	if w := s.Element().Widget().(VideoCaptions); w.Controller != oldWidget.(VideoCaptions).Controller {
+		s.subscribe(w.Controller)
+	}
+}
+
+func (s *videoCaptionsState) subscribe(controller *platform.VideoPlayerController) {
+	if s.unsubCues != nil {
+		s.unsubCues()
+		s.unsubCues = nil
+	}
+	if controller != nil {
+		s.unsubCues = controller.AddCueListener(func() {
+			s.SetState(nil)
+		})
+	}
+}
+
+func (s *videoCaptionsState) Build(ctx core.BuildContext) core.Widget {
+	w := s.Element().Widget().(VideoCaptions)
+	if w.Controller == nil {
+		return SizedBox{}
+	}
+	var lines []string
+	for _, cue := range w.Controller.Cues() {
+		if cue.Text != "" {
+			lines = append(lines, cue.Text)
+		}
+	}
+	if len(lines) == 0 {
+		return SizedBox{}
+	}
+
+	style := w.Style
+	if style == (CaptionStyle{}) {
+		style = DefaultCaptionStyle
+	}
+	return Padding{
+		Padding: layout.EdgeInsetsOnly(0, 0, 0, style.BottomInset),
+		Child: Align{
+			Alignment: layout.AlignmentBottomCenter,
+			Child: Container{
+				Color:        style.BackgroundColor,
+				BorderRadius: style.BorderRadius,
+				Padding:      style.Padding,
+				Child: Text{
+					Content: strings.Join(lines, "\n"),
+					Style:   style.TextStyle,
+					Align:   graphics.TextAlignCenter,
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,44 @@
+package widgets_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/platform"
+	drifttest "github.com/go-drift/drift/pkg/testing"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+func TestVideoCaptions_ShowsSelectedTrackCues(t *testing.T) {
+	platform.SetupTestBridge(t.Cleanup)
+	tester := drifttest.NewWidgetTesterWithT(t)
+	tester.SetSize(graphics.Size{Width: 400, Height: 225})
+
+	c := platform.NewVideoPlayerController()
+	defer c.Dispose()
+
+	tester.PumpWidget(widgets.VideoCaptions{Controller: c})
+	if tester.Find(drifttest.ByType[widgets.Text]()).Exists() {
+		t.Fatal("expected no captions before a track is selected")
+	}
+
+	track, err := c.AddSubtitleTrack("English", "en", []byte("1\n00:00:01,000 --> 00:00:02,000\nHello\nthere\n"))
+	if err != nil {
+		t.Fatalf("AddSubtitleTrack: %v", err)
+	}
+	if err := c.SelectSubtitleTrack(track.ID); err != nil {
+		t.Fatalf("SelectSubtitleTrack: %v", err)
+	}
+	c.SeekTo(1500 * time.Millisecond)
+	tester.PumpAndSettle(time.Second)
+	if !tester.Find(drifttest.ByText("Hello\nthere")).Exists() {
+		t.Fatal("expected the cue to be shown")
+	}
+
+	c.SeekTo(3 * time.Second)
+	tester.PumpAndSettle(time.Second)
+	if tester.Find(drifttest.ByType[widgets.Text]()).Exists() {
+		t.Error("expected the caption to clear after the cue ends")
+	}
+}
@@ -1,6 +1,8 @@
 package widgets
 
 import (
+	"slices"
+
 	"github.com/go-drift/drift/pkg/core"
 	"github.com/go-drift/drift/pkg/graphics"
 	"github.com/go-drift/drift/pkg/layout"
@@ -25,6 +27,12 @@ import (
 //
 // Width and Height set explicit dimensions. Use layout widgets such as [Expanded]
 // to fill available space.
+//
+// A controller has one native view. When several VideoPlayer widgets show the
+// same controller, the most recently mounted one displays the video and the
+// others paint a placeholder. Removing it hands the view back to the previous
+// one, so the video can move between pages (for example into a full-screen
+// route with navigation.EnterFullscreen) without interrupting playback.
 type VideoPlayer struct {
 	core.RenderObjectBase
 	// Controller provides the native video player surface and playback control.
@@ -50,22 +58,25 @@ func (v VideoPlayer) CreateRenderObject(ctx core.BuildContext) layout.RenderObje
 		height:       v.Height,
 		hideControls: v.HideControls,
 	}
-	if v.HideControls && v.Controller != nil {
-		v.Controller.SetShowControls(false)
-	}
 	r.SetSelf(r)
+	claimVideoSurface(r)
 	return r
 }
 
 // UpdateRenderObject updates the render object with new widget properties.
 func (v VideoPlayer) UpdateRenderObject(ctx core.BuildContext, renderObject layout.RenderObject) {
 	if r, ok := renderObject.(*renderVideoPlayer); ok {
-		r.controller = v.Controller
+		if v.Controller != r.controller {
+			releaseVideoSurface(r)
+			r.controller = v.Controller
+			r.hideControls = v.HideControls
+			claimVideoSurface(r)
+		}
 		r.width = v.Width
 		r.height = v.Height
 		if v.HideControls != r.hideControls {
 			r.hideControls = v.HideControls
-			if v.Controller != nil {
+			if v.Controller != nil && r.ownsSurface() {
 				v.Controller.SetShowControls(!v.HideControls)
 			}
 		}
@@ -99,14 +110,14 @@ func (r *renderVideoPlayer) Paint(ctx *layout.PaintContext) {
 	bgPaint.Color = graphics.Color(0xFF1A1A1A)
 	ctx.Canvas.DrawRect(graphics.RectFromLTWH(0, 0, size.Width, size.Height), bgPaint)
 
-	if r.controller != nil && r.controller.ViewID() != 0 {
+	if r.controller != nil && r.controller.ViewID() != 0 && r.ownsSurface() {
 		ctx.EmbedPlatformView(r.controller.ViewID(), size)
 	}
 }
 
 // PlatformViewID implements PlatformViewOwner.
 func (r *renderVideoPlayer) PlatformViewID() int64 {
-	if r.controller != nil && r.controller.ViewID() != 0 {
+	if r.controller != nil && r.controller.ViewID() != 0 && r.ownsSurface() {
 		return r.controller.ViewID()
 	}
 	return -1
@@ -119,3 +130,61 @@ func (r *renderVideoPlayer) HitTest(position graphics.Offset, result *layout.Hit
 	result.Add(r)
 	return true
 }
+
+// Dispose hands the native view back to the previous player of the controller.
+func (r *renderVideoPlayer) Dispose() {
+	releaseVideoSurface(r)
+	r.RenderBoxBase.Dispose()
+}
+
+// ownsSurface reports whether this player displays the controller's view.
+func (r *renderVideoPlayer) ownsSurface() bool {
+	players := videoSurfaces[r.controller]
+	return len(players) > 0 && players[len(players)-1] == r
+}
+
+// videoSurfaces lists the mounted players of each controller, oldest first.
+// The last one owns the native view.
+var videoSurfaces = map[*platform.VideoPlayerController][]*renderVideoPlayer{}
+
+// claimVideoSurface makes r the owner of its controller's view.
+func claimVideoSurface(r *renderVideoPlayer) {
+	if r.controller == nil {
+		return
+	}
+	players := videoSurfaces[r.controller]
+	if len(players) > 0 {
+		previous := players[len(players)-1]
+		previous.MarkNeedsPaint()
+		if previous.hideControls != r.hideControls {
+			r.controller.SetShowControls(!r.hideControls)
+		}
+	} else if r.hideControls {
+		r.controller.SetShowControls(false)
+	}
+	videoSurfaces[r.controller] = append(players, r)
+}
+
+// releaseVideoSurface removes r from its controller's players. If r owned the
+// view, the previous player takes it over with its own controls setting.
+func releaseVideoSurface(r *renderVideoPlayer) {
+	if r.controller == nil {
+		return
+	}
+	wasOwner := r.ownsSurface()
+	players := slices.DeleteFunc(videoSurfaces[r.controller], func(p *renderVideoPlayer) bool {
+		return p == r
+	})
+	if len(players) == 0 {
+		delete(videoSurfaces, r.controller)
+		return
+	}
+	videoSurfaces[r.controller] = players
+	if wasOwner {
+		next := players[len(players)-1]
+		next.MarkNeedsPaint()
+		if next.hideControls != r.hideControls {
+			r.controller.SetShowControls(!next.hideControls)
+		}
+	}
+}
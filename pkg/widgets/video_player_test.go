@@ -62,3 +62,30 @@ func TestVideoPlayer_HideControls(t *testing.T) {
 		t.Error("expected non-nil element")
 	}
 }
+
+func TestVideoPlayer_SurfaceMovesToNewestPlayer(t *testing.T) {
+	platform.SetupTestBridge(t.Cleanup)
+
+	c := platform.NewVideoPlayerController()
+	defer c.Dispose()
+
+	inline := VideoPlayer{Controller: c, Height: 225}.CreateRenderObject(nil).(*renderVideoPlayer)
+	if inline.PlatformViewID() != c.ViewID() {
+		t.Fatal("expected the only player to own the view")
+	}
+
+	fullscreen := VideoPlayer{Controller: c}.CreateRenderObject(nil).(*renderVideoPlayer)
+	if fullscreen.PlatformViewID() != c.ViewID() || inline.PlatformViewID() != -1 {
+		t.Error("expected the newest player to take over the view")
+	}
+
+	fullscreen.Dispose()
+	if inline.PlatformViewID() != c.ViewID() {
+		t.Error("expected the view to return to the inline player")
+	}
+
+	inline.Dispose()
+	if _, ok := videoSurfaces[c]; ok {
+		t.Error("expected the controller to be forgotten once no player shows it")
+	}
+}
@@ -0,0 +1,445 @@
+package widgets
+
+import (
+	"math"
+	"time"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/gestures"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/layout"
+	"github.com/go-drift/drift/pkg/platform"
+	"github.com/go-drift/drift/pkg/semantics"
+)
+
+// waveformSemanticStep is how far the accessibility increase and decrease
+// actions move the position, as a fraction of the duration.
+const waveformSemanticStep = 0.1
+
+// Waveform draws audio peaks as bars centered on a horizontal axis, with
+// the played part in ProgressColor, and lets the user scrub by tapping or
+// dragging across it.
+//
+// Given a [platform.AudioPlayerController], the waveform follows the
+// player's position and seeks it when scrubbed:
+//
+//	widgets.Waveform{
+//	    Data:          s.waveform.Value(), // from platform.Audio.ExtractWaveform
+//	    Controller:    s.player,
+//	    Height:        48,
+//	    BarWidth:      3,
+//	    BarSpacing:    2,
+//	    Color:         colors.OutlineVariant,
+//	    ProgressColor: colors.Primary,
+//	}
+//
+// Without a controller, set Progress and handle OnSeek. To show audio
+// while it is being recorded, rebuild with a growing
+// [platform.WaveformFromPCM] as samples arrive.
+//
+// Waveform is explicit: zero colors and a zero Height draw nothing.
+type Waveform struct {
+	core.StatefulBase
+
+	// Data holds the peaks to draw. They are resampled to fit the bars.
+	Data platform.Waveform
+
+	// Controller, if set, supplies the progress from its position and is
+	// seeked when the user scrubs.
+	Controller *platform.AudioPlayerController
+
+	// Progress is the played fraction, from 0 to 1. Ignored when Controller
+	// is set.
+	Progress float64
+
+	// OnSeek is called with the fraction the user scrubbed to, when they
+	// lift their finger. Scrubbing is disabled when both OnSeek and
+	// Controller are nil.
+	OnSeek func(progress float64)
+
+	// Height is the height of the waveform; the loudest bars fill it.
+	Height float64
+
+	// BarWidth is the width of each bar. Zero draws one bar per peak,
+	// sharing the width between them.
+	BarWidth float64
+
+	// BarSpacing is the gap between bars.
+	BarSpacing float64
+
+	// Color is the color of bars not yet played.
+	Color graphics.Color
+
+	// ProgressColor is the color of played bars.
+	ProgressColor graphics.Color
+}
+
+func (w Waveform) CreateState() core.State {
+	return &waveformState{}
+}
+
+type waveformState struct {
+	core.StateBase
+	unsubPosition func()
+
+	// scrubbing is set while the user drags, showing scrubProgress instead
+	// of the position.
+	scrubbing     bool
+	scrubProgress float64
+
+	// seekProgress is shown after seeking the controller, until it reports
+	// the new position. Negative when no seek is pending.
+	seekProgress float64
+}
+
+func (s *waveformState) InitState() {
+	s.seekProgress = -1
+	s.subscribe(s.Element().Widget().(Waveform).Controller)
+	s.OnDispose(func() {
+		s.subscribe(nil)
+	})
+}
+
+func (s *waveformState) DidUpdateWidget(oldWidget core.StatefulWidget) {
+	if w := s.Element().Widget().(Waveform); w.Controller != oldWidget.(Waveform).Controller {
+		s.seekProgress = -1
+		s.subscribe(w.Controller)
+	}
+}
+
+func (s *waveformState) subscribe(controller *platform.AudioPlayerController) {
+	if s.unsubPosition != nil {
+		s.unsubPosition()
+		s.unsubPosition = nil
+	}
+	if controller != nil {
+		s.unsubPosition = controller.AddPositionListener(func() {
+			s.SetState(func() {
+				s.seekProgress = -1
+			})
+		})
+	}
+}
+
+// progress returns the played fraction to draw.
+func (s *waveformState) progress(w Waveform) float64 {
+	switch {
+	case s.scrubbing:
+		return s.scrubProgress
+	case w.Controller == nil:
+		return w.Progress
+	case s.seekProgress >= 0:
+		return s.seekProgress
+	}
+	duration := s.duration(w)
+	if duration <= 0 {
+		return 0
+	}
+	return float64(w.Controller.Position()) / float64(duration)
+}
+
+// duration returns the controller's duration, falling back to the data's
+// before the player reports one.
+func (s *waveformState) duration(w Waveform) time.Duration {
+	if d := w.Controller.Duration(); d > 0 {
+		return d
+	}
+	return w.Data.Duration
+}
+
+func (s *waveformState) scrub(progress float64) {
+	s.SetState(func() {
+		s.scrubbing = true
+		s.scrubProgress = progress
+	})
+}
+
+func (s *waveformState) cancelScrub() {
+	s.SetState(func() {
+		s.scrubbing = false
+	})
+}
+
+func (s *waveformState) seek(progress float64) {
+	w := s.Element().Widget().(Waveform)
+	s.SetState(func() {
+		s.scrubbing = false
+		if w.Controller != nil {
+			s.seekProgress = progress
+		}
+	})
+	if w.Controller != nil {
+		duration := s.duration(w)
+		w.Controller.SeekTo(time.Duration(progress * float64(duration)))
+	}
+	if w.OnSeek != nil {
+		w.OnSeek(progress)
+	}
+}
+
+func (s *waveformState) Build(ctx core.BuildContext) core.Widget {
+	w := s.Element().Widget().(Waveform)
+	render := waveformRender{
+		peaks:         w.Data.Peaks,
+		progress:      min(max(s.progress(w), 0), 1),
+		height:        w.Height,
+		barWidth:      w.BarWidth,
+		barSpacing:    w.BarSpacing,
+		color:         w.Color,
+		progressColor: w.ProgressColor,
+	}
+	if w.Controller != nil || w.OnSeek != nil {
+		render.onScrub = s.scrub
+		render.onScrubEnd = s.seek
+		render.onScrubCancel = s.cancelScrub
+	}
+	return render
+}
+
+type waveformRender struct {
+	core.RenderObjectBase
+	peaks         []float64
+	progress      float64
+	height        float64
+	barWidth      float64
+	barSpacing    float64
+	color         graphics.Color
+	progressColor graphics.Color
+	onScrub       func(float64)
+	onScrubEnd    func(float64)
+	onScrubCancel func()
+}
+
+func (w waveformRender) CreateRenderObject(ctx core.BuildContext) layout.RenderObject {
+	r := &renderWaveform{}
+	r.SetSelf(r)
+	w.apply(r)
+	return r
+}
+
+func (w waveformRender) UpdateRenderObject(ctx core.BuildContext, renderObject layout.RenderObject) {
+	if r, ok := renderObject.(*renderWaveform); ok {
+		if r.height != w.height {
+			r.MarkNeedsLayout()
+		}
+		w.apply(r)
+		r.MarkNeedsPaint()
+	}
+}
+
+func (w waveformRender) apply(r *renderWaveform) {
+	r.peaks = w.peaks
+	r.progress = w.progress
+	r.height = w.height
+	r.barWidth = w.barWidth
+	r.barSpacing = w.barSpacing
+	r.color = w.color
+	r.progressColor = w.progressColor
+	r.onScrub = w.onScrub
+	r.onScrubEnd = w.onScrubEnd
+	r.onScrubCancel = w.onScrubCancel
+	r.configureGestures()
+}
+
+type renderWaveform struct {
+	layout.RenderBoxBase
+	peaks         []float64
+	progress      float64
+	height        float64
+	barWidth      float64
+	barSpacing    float64
+	color         graphics.Color
+	progressColor graphics.Color
+	onScrub       func(float64)
+	onScrubEnd    func(float64)
+	onScrubCancel func()
+
+	tap  *gestures.TapGestureRecognizer
+	drag *gestures.HorizontalDragGestureRecognizer
+
+	// Pointer events are in global coordinates; hitPosition is the local
+	// position of the latest hit test, from which pointer down events find
+	// the offset of this box.
+	hitPosition graphics.Offset
+	origin      graphics.Offset
+	downX       float64
+	scrubX      float64
+}
+
+func (r *renderWaveform) configureGestures() {
+	if r.onScrubEnd == nil {
+		if r.tap != nil {
+			r.tap.Dispose()
+			r.tap = nil
+		}
+		if r.drag != nil {
+			r.drag.Dispose()
+			r.drag = nil
+		}
+		return
+	}
+	if r.tap == nil {
+		r.tap = gestures.NewTapGestureRecognizer(gestures.DefaultArena)
+		r.tap.OnTap = func() {
+			if r.onScrubEnd != nil {
+				r.onScrubEnd(r.progressAt(r.downX))
+			}
+		}
+	}
+	if r.drag == nil {
+		r.drag = gestures.NewHorizontalDragGestureRecognizer(gestures.DefaultArena)
+		r.drag.OnStart = func(details gestures.DragStartDetails) {
+			r.scrubTo(details.Position.X - r.origin.X)
+		}
+		r.drag.OnUpdate = func(details gestures.DragUpdateDetails) {
+			r.scrubTo(details.Position.X - r.origin.X)
+		}
+		r.drag.OnEnd = func(details gestures.DragEndDetails) {
+			if r.onScrubEnd != nil {
+				r.onScrubEnd(r.progressAt(r.scrubX))
+			}
+		}
+		r.drag.OnCancel = func() {
+			if r.onScrubCancel != nil {
+				r.onScrubCancel()
+			}
+		}
+	}
+}
+
+func (r *renderWaveform) scrubTo(x float64) {
+	r.scrubX = x
+	if r.onScrub != nil {
+		r.onScrub(r.progressAt(x))
+	}
+}
+
+// progressAt returns the fraction of the width at local x.
+func (r *renderWaveform) progressAt(x float64) float64 {
+	width := r.Size().Width
+	if width <= 0 {
+		return 0
+	}
+	return min(max(x/width, 0), 1)
+}
+
+func (r *renderWaveform) PerformLayout() {
+	constraints := r.Constraints()
+	width := constraints.MaxWidth
+	if math.IsInf(width, 1) || width == math.MaxFloat64 {
+		// Unbounded: size to the bars.
+		n := float64(len(r.peaks))
+		barWidth := r.barWidth
+		if barWidth <= 0 {
+			barWidth = 1
+		}
+		width = max(n*barWidth+(n-1)*r.barSpacing, 0)
+	}
+	r.SetSize(constraints.Constrain(graphics.Size{Width: width, Height: r.height}))
+}
+
+// bars returns the number of bars that fit and their width.
+func (r *renderWaveform) bars() (int, float64) {
+	width := r.Size().Width
+	if r.barWidth > 0 {
+		n := int((width + r.barSpacing) / (r.barWidth + r.barSpacing))
+		return n, r.barWidth
+	}
+	n := len(r.peaks)
+	if n == 0 {
+		return 0, 0
+	}
+	return n, max((width-float64(n-1)*r.barSpacing)/float64(n), 0)
+}
+
+func (r *renderWaveform) Paint(ctx *layout.PaintContext) {
+	n, barWidth := r.bars()
+	if n <= 0 || barWidth <= 0 || len(r.peaks) == 0 {
+		return
+	}
+	size := r.Size()
+	peaks := platform.Waveform{Peaks: r.peaks}.Resample(n).Peaks
+
+	// Silent stretches still show as dots.
+	minHeight := min(barWidth, size.Height)
+	path := graphics.NewPath()
+	for i, peak := range peaks {
+		h := max(peak*size.Height, minHeight)
+		rect := graphics.RectFromLTWH(float64(i)*(barWidth+r.barSpacing), (size.Height-h)/2, barWidth, h)
+		path.AddRRect(graphics.RRectFromRectAndRadius(rect, graphics.CircularRadius(barWidth/2)))
+	}
+
+	split := size.Width * r.progress
+	paint := graphics.DefaultPaint()
+	if r.progressColor != 0 && split > 0 {
+		paint.Color = r.progressColor
+		ctx.Canvas.Save()
+		ctx.Canvas.ClipRect(graphics.RectFromLTWH(0, 0, split, size.Height))
+		ctx.Canvas.DrawPath(path, paint)
+		ctx.Canvas.Restore()
+	}
+	if r.color != 0 && split < size.Width {
+		paint.Color = r.color
+		ctx.Canvas.Save()
+		ctx.Canvas.ClipRect(graphics.RectFromLTWH(split, 0, size.Width-split, size.Height))
+		ctx.Canvas.DrawPath(path, paint)
+		ctx.Canvas.Restore()
+	}
+}
+
+func (r *renderWaveform) HitTest(position graphics.Offset, result *layout.HitTestResult) bool {
+	if r.onScrubEnd == nil || !layout.WithinBounds(position, r.Size()) {
+		return false
+	}
+	r.hitPosition = position
+	result.Add(r)
+	return true
+}
+
+func (r *renderWaveform) HandlePointer(event gestures.PointerEvent) {
+	if event.Phase == gestures.PointerPhaseDown {
+		r.origin = graphics.Offset{
+			X: event.Position.X - r.hitPosition.X,
+			Y: event.Position.Y - r.hitPosition.Y,
+		}
+		r.downX = r.hitPosition.X
+		r.scrubX = r.downX
+		if r.tap != nil {
+			r.tap.AddPointer(event)
+		}
+		if r.drag != nil {
+			r.drag.AddPointer(event)
+		}
+		return
+	}
+	if r.tap != nil {
+		r.tap.HandleEvent(event)
+	}
+	if r.drag != nil {
+		r.drag.HandleEvent(event)
+	}
+}
+
+// DescribeSemanticsConfiguration implements SemanticsDescriber for accessibility.
+func (r *renderWaveform) DescribeSemanticsConfiguration(config *semantics.SemanticsConfiguration) bool {
+	config.IsSemanticBoundary = true
+	config.Properties.Label = "Audio position"
+	config.Properties.Value = formatPercent(int(r.progress * 100))
+	if r.onScrubEnd == nil {
+		config.Properties.Role = semantics.SemanticsRoleProgressIndicator
+		return true
+	}
+	config.Properties.Role = semantics.SemanticsRoleSlider
+	config.Actions = semantics.NewSemanticsActions()
+	config.Actions.SetHandler(semantics.SemanticsActionIncrease, func(args any) {
+		if r.onScrubEnd != nil {
+			r.onScrubEnd(min(r.progress+waveformSemanticStep, 1))
+		}
+	})
+	config.Actions.SetHandler(semantics.SemanticsActionDecrease, func(args any) {
+		if r.onScrubEnd != nil {
+			r.onScrubEnd(max(r.progress-waveformSemanticStep, 0))
+		}
+	})
+	return true
+}
@@ -0,0 +1,48 @@
+package widgets_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/layout"
+	"github.com/go-drift/drift/pkg/platform"
+	drifttest "github.com/go-drift/drift/pkg/testing"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+func TestWaveform_TapAndDragSeek(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	tester.SetSize(graphics.Size{Width: 400, Height: 100})
+
+	var seeks []float64
+	tester.PumpWidget(widgets.Padding{
+		Padding: layout.EdgeInsetsOnly(100, 0, 0, 0),
+		Child: widgets.Waveform{
+			Data:          platform.Waveform{Peaks: []float64{0.2, 1, 0.5, 0}},
+			Height:        40,
+			BarWidth:      3,
+			BarSpacing:    2,
+			Color:         graphics.ColorBlack,
+			ProgressColor: graphics.ColorWhite,
+			OnSeek: func(progress float64) {
+				seeks = append(seeks, progress)
+			},
+		},
+	})
+
+	// The waveform spans x 100 to 400, so positions map to (x-100)/300.
+	tester.TapAt(graphics.Offset{X: 250, Y: 50})
+	tester.DragFrom(graphics.Offset{X: 130, Y: 50}, graphics.Offset{X: 90})
+	tester.Pump()
+
+	want := []float64{0.5, 0.4}
+	if len(seeks) != len(want) {
+		t.Fatalf("OnSeek calls: got %v, want %v", seeks, want)
+	}
+	for i := range want {
+		if math.Abs(seeks[i]-want[i]) > 1e-9 {
+			t.Errorf("seek[%d]: got %v, want %v", i, seeks[i], want[i])
+		}
+	}
+}
@@ -6,6 +6,13 @@ SKIA_DIR="$ROOT_DIR/third_party/skia"
 DRIFT_SKIA_OUT="$ROOT_DIR/third_party/drift_skia"
 SKIA_REV_FILE="$ROOT_DIR/SKIA_REV"
 
+# Optional Rive runtime. Set RIVE_RUNTIME_DIR to a rive-runtime checkout whose
+# static libraries are prebuilt for each target under lib/<platform>/<arch>.
+RIVE_FLAGS=""
+if [[ -n "${RIVE_RUNTIME_DIR:-}" ]]; then
+  RIVE_FLAGS="-DDRIFT_SKIA_ENABLE_RIVE -I$RIVE_RUNTIME_DIR/include -I$RIVE_RUNTIME_DIR/skia/renderer/include"
+fi
+
 if [[ ! -d "$SKIA_DIR" ]]; then
   echo "Skia not found at $SKIA_DIR. Run scripts/fetch_skia.sh first."
   exit 1
@@ -127,7 +134,7 @@ compile_bridge() {
 
   local clang="$ANDROID_NDK_HOME/toolchains/llvm/prebuilt/$HOST_TAG/bin/clang++"
 
-  local common_flags="--target=$target_triple -std=c++17 -fPIC -DSKIA_VULKAN $arch_flags -I. -I./include"
+  local common_flags="--target=$target_triple -std=c++17 -fPIC -DSKIA_VULKAN $arch_flags -I. -I./include $RIVE_FLAGS"
 
   # Compile shared bridge code
   "$clang" $common_flags \
@@ -146,6 +153,11 @@ compile_bridge() {
   for lib in ../lib*.a; do
     [ -f "$lib" ] && ar x "$lib"
   done
+  if [[ -n "${RIVE_RUNTIME_DIR:-}" ]]; then
+    for lib in "$RIVE_RUNTIME_DIR/lib/android/$arch"/*.a; do
+      ar x "$lib"
+    done
+  fi
   ar rcs ../libdrift_skia.a *.o ../skia_common.o ../skia_backend.o
   popd > /dev/null
   rm -rf "$out_dir/tmp" "$out_dir/skia_common.o" "$out_dir/skia_backend.o"
@@ -6,6 +6,13 @@ SKIA_DIR="$ROOT_DIR/third_party/skia"
 DRIFT_SKIA_OUT="$ROOT_DIR/third_party/drift_skia"
 SKIA_REV_FILE="$ROOT_DIR/SKIA_REV"
 
+# Optional Rive runtime. Set RIVE_RUNTIME_DIR to a rive-runtime checkout whose
+# static libraries are prebuilt for each target under lib/<platform>/<arch>.
+RIVE_FLAGS=""
+if [[ -n "${RIVE_RUNTIME_DIR:-}" ]]; then
+  RIVE_FLAGS="-DDRIFT_SKIA_ENABLE_RIVE -I$RIVE_RUNTIME_DIR/include -I$RIVE_RUNTIME_DIR/skia/renderer/include"
+fi
+
 if [[ ! -d "$SKIA_DIR" ]]; then
   echo "Skia not found at $SKIA_DIR. Run scripts/fetch_skia.sh first."
   exit 1
@@ -48,6 +55,13 @@ build_simulator() {
   ninja -C "$out_dir" skia svg skresources skparagraph skshaper skunicode skottie
 }
 
+# Lists the prebuilt Rive runtime libraries for a target, if configured.
+rive_libs() {
+  if [[ -n "${RIVE_RUNTIME_DIR:-}" ]]; then
+    ls "$RIVE_RUNTIME_DIR/lib/$1"/*.a
+  fi
+}
+
 # Compile bridge code and combine with Skia into libdrift_skia.a (device)
 compile_bridge_device() {
   local arch="$1"
@@ -56,7 +70,7 @@ compile_bridge_device() {
   echo "Compiling bridge for iOS device $arch..."
   echo "Skia out dir: $SKIA_DIR/$out_dir"
 
-  local common_flags="-arch $arch -isysroot $(xcrun --sdk iphoneos --show-sdk-path) -miphoneos-version-min=16.0 -std=c++17 -fPIC -DSKIA_METAL -I. -I./include"
+  local common_flags="-arch $arch -isysroot $(xcrun --sdk iphoneos --show-sdk-path) -miphoneos-version-min=16.0 -std=c++17 -fPIC -DSKIA_METAL -I. -I./include $RIVE_FLAGS"
 
   # Compile shared bridge code
   xcrun clang++ $common_flags \
@@ -71,7 +85,7 @@ compile_bridge_device() {
   # Combine using libtool (macOS) - include all static libraries
   rm -f "$out_dir/libdrift_skia.a"
   libtool -static -o "$out_dir/libdrift_skia.a" \
-    "$out_dir"/lib*.a "$out_dir/skia_common.o" "$out_dir/skia_backend.o"
+    "$out_dir"/lib*.a $(rive_libs "${out_dir#out/}") "$out_dir/skia_common.o" "$out_dir/skia_backend.o"
   rm "$out_dir/skia_common.o" "$out_dir/skia_backend.o"
 
   echo "Created $SKIA_DIR/$out_dir/libdrift_skia.a"
@@ -90,7 +104,7 @@ compile_bridge_simulator() {
 
   echo "Compiling bridge for iOS simulator $arch..."
 
-  local common_flags="-arch $clang_arch -isysroot $(xcrun --sdk iphonesimulator --show-sdk-path) -mios-simulator-version-min=16.0 -std=c++17 -fPIC -DSKIA_METAL -I. -I./include"
+  local common_flags="-arch $clang_arch -isysroot $(xcrun --sdk iphonesimulator --show-sdk-path) -mios-simulator-version-min=16.0 -std=c++17 -fPIC -DSKIA_METAL -I. -I./include $RIVE_FLAGS"
 
   # Compile shared bridge code
   xcrun clang++ $common_flags \
@@ -104,7 +118,7 @@ compile_bridge_simulator() {
 
   rm -f "$out_dir/libdrift_skia.a"
   libtool -static -o "$out_dir/libdrift_skia.a" \
-    "$out_dir"/lib*.a "$out_dir/skia_common.o" "$out_dir/skia_backend.o"
+    "$out_dir"/lib*.a $(rive_libs "${out_dir#out/}") "$out_dir/skia_common.o" "$out_dir/skia_backend.o"
   rm "$out_dir/skia_common.o" "$out_dir/skia_backend.o"
 
   echo "Created $SKIA_DIR/$out_dir/libdrift_skia.a"
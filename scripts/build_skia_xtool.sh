@@ -6,6 +6,13 @@ SKIA_DIR="$ROOT_DIR/third_party/skia"
 DRIFT_SKIA_OUT="$ROOT_DIR/third_party/drift_skia"
 SKIA_REV_FILE="$ROOT_DIR/SKIA_REV"
 
+# Optional Rive runtime. Set RIVE_RUNTIME_DIR to a rive-runtime checkout whose
+# static libraries are prebuilt for each target under lib/<platform>/<arch>.
+RIVE_FLAGS=""
+if [[ -n "${RIVE_RUNTIME_DIR:-}" ]]; then
+  RIVE_FLAGS="-DDRIFT_SKIA_ENABLE_RIVE -I$RIVE_RUNTIME_DIR/include -I$RIVE_RUNTIME_DIR/skia/renderer/include"
+fi
+
 if [[ ! -d "$SKIA_DIR" ]]; then
   echo "Skia not found at $SKIA_DIR. Run scripts/fetch_skia.sh first."
   exit 1
@@ -223,7 +230,7 @@ compile_bridge_device() {
   echo "Compiling bridge for iOS device $arch..."
   echo "Skia out dir: $SKIA_DIR/$out_dir"
 
-  local common_flags="-target ${arch}-apple-ios16.0 -isysroot $IPHONEOS_SDK -std=c++17 -fPIC -DSKIA_METAL -I. -I./include"
+  local common_flags="-target ${arch}-apple-ios16.0 -isysroot $IPHONEOS_SDK -std=c++17 -fPIC -DSKIA_METAL -I. -I./include $RIVE_FLAGS"
 
   # Compile shared bridge code
   "$CLANGXX" $common_flags \
@@ -242,6 +249,11 @@ compile_bridge_device() {
   for lib in ../lib*.a; do
     [ -f "$lib" ] && llvm-ar x "$lib"
   done
+  if [[ -n "${RIVE_RUNTIME_DIR:-}" ]]; then
+    for lib in "$RIVE_RUNTIME_DIR/lib/${out_dir#out/}"/*.a; do
+      llvm-ar x "$lib"
+    done
+  fi
   llvm-ar rcs ../libdrift_skia.a *.o ../skia_common.o ../skia_backend.o
   popd > /dev/null
   rm -rf "$out_dir/tmp" "$out_dir/skia_common.o" "$out_dir/skia_backend.o"
@@ -262,7 +274,7 @@ compile_bridge_simulator() {
   echo "Compiling bridge for iOS simulator $arch..."
   echo "Skia out dir: $SKIA_DIR/$out_dir"
 
-  local common_flags="-target ${arch}-apple-ios16.0-simulator -isysroot $IPHONESIMULATOR_SDK -std=c++17 -fPIC -DSKIA_METAL -I. -I./include"
+  local common_flags="-target ${arch}-apple-ios16.0-simulator -isysroot $IPHONESIMULATOR_SDK -std=c++17 -fPIC -DSKIA_METAL -I. -I./include $RIVE_FLAGS"
 
   # Compile shared bridge code
   "$CLANGXX" $common_flags \
@@ -281,6 +293,11 @@ compile_bridge_simulator() {
   for lib in ../lib*.a; do
     [ -f "$lib" ] && llvm-ar x "$lib"
   done
+  if [[ -n "${RIVE_RUNTIME_DIR:-}" ]]; then
+    for lib in "$RIVE_RUNTIME_DIR/lib/${out_dir#out/}"/*.a; do
+      llvm-ar x "$lib"
+    done
+  fi
   llvm-ar rcs ../libdrift_skia.a *.o ../skia_common.o ../skia_backend.o
   popd > /dev/null
   rm -rf "$out_dir/tmp" "$out_dir/skia_common.o" "$out_dir/skia_backend.o"